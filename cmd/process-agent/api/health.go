@@ -0,0 +1,43 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/DataDog/datadog-agent/pkg/process/status"
+)
+
+type healthResponse struct {
+	Healthy bool                 `json:"healthy"`
+	Checks  []status.CheckHealth `json:"checks"`
+}
+
+// healthHandler reports the health of the checks, it answers with a 503 status code when a check is unhealthy so
+// that orchestrators can restart a wedged process-agent
+func healthHandler(w http.ResponseWriter, _ *http.Request) {
+	response := healthResponse{
+		Healthy: true,
+		Checks:  status.GetChecksHealth(time.Now()),
+	}
+	for _, check := range response.Checks {
+		response.Healthy = response.Healthy && check.Healthy
+	}
+
+	body, err := json.Marshal(response)
+	if err != nil {
+		writeError(err, http.StatusInternalServerError, w)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if !response.Healthy {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	_, _ = w.Write(body)
+}
@@ -54,4 +54,5 @@ func SetupAPIServerHandlers(deps APIServerDeps, r *mux.Router) {
 		workloadList(w, true, deps.WorkloadMeta)
 	}).Methods("GET")
 	r.HandleFunc("/check/{check}", checkHandler).Methods("GET")
+	r.HandleFunc("/health", healthHandler).Methods("GET")
 }
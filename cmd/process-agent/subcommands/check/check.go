@@ -120,59 +120,7 @@ func MakeCommand(globalParamsGetter func() *command.GlobalParams, name string, a
 				bundleParams.LogParams = log.ForOneShot(string(command.LoggerName), "off", true)
 			}
 
-			return fxutil.OneShot(RunCheckCmd,
-				fx.Supply(cliParams, bundleParams),
-				core.Bundle(),
-				// Provide workloadmeta module
-
-				// Provide eventplatformimpl module
-				eventplatformreceiverimpl.Module(),
-				eventplatformimpl.Module(eventplatformimpl.NewDefaultParams()),
-
-				// Provide rdnsquerier module
-				rdnsquerierfx.Module(),
-
-				// Provide npcollector module
-				npcollectorimpl.Module(),
-				// Provide the corresponding workloadmeta Params to configure the catalog
-				wmcatalog.GetCatalog(),
-				workloadmetafx.ModuleWithProvider(func(config config.Component) workloadmeta.Params {
-
-					var catalog workloadmeta.AgentType
-					if config.GetBool("process_config.remote_workloadmeta") {
-						catalog = workloadmeta.Remote
-					} else {
-						catalog = workloadmeta.ProcessAgent
-					}
-
-					return workloadmeta.Params{AgentType: catalog}
-				}),
-
-				// Tagger must be initialized after agent config has been setup
-				dualTaggerfx.Module(tagger.DualParams{
-					UseRemote: func(c config.Component) bool {
-						return c.GetBool("process_config.remote_tagger")
-					},
-				}, tagger.Params{}, tagger.RemoteParams{
-					RemoteTarget: func(c config.Component) (string, error) {
-						return fmt.Sprintf(":%v", c.GetInt("cmd_port")), nil
-					},
-					RemoteTokenFetcher: func(c config.Component) func() (string, error) {
-						return func() (string, error) {
-							return security.FetchAuthToken(c)
-						}
-					},
-					RemoteFilter: taggerTypes.NewMatchAllFilter(),
-				}),
-				processComponent.Bundle(),
-				// InitSharedContainerProvider must be called before the application starts so the workloadmeta collector can be initiailized correctly.
-				// Since the tagger depends on the workloadmeta collector, we can not make the tagger a dependency of workloadmeta as it would create a circular dependency.
-				// TODO: (component) - once we remove the dependency of workloadmeta component from the tagger component
-				// we can include the tagger as part of the workloadmeta component.
-				fx.Invoke(func(wmeta workloadmeta.Component, tagger tagger.Component) {
-					proccontainers.InitSharedContainerProvider(wmeta, tagger)
-				}),
-			)
+			return fxutil.OneShot(RunCheckCmd, OneShotOptions(cliParams, bundleParams)...)
 		},
 		SilenceUsage: true,
 	}
@@ -183,6 +131,64 @@ func MakeCommand(globalParamsGetter func() *command.GlobalParams, name string, a
 	return checkCmd
 }
 
+// OneShotOptions returns the fx options providing the Dependencies needed to run the checks of the Process Agent from
+// a one shot command
+func OneShotOptions(cliParams *CliParams, bundleParams core.BundleParams) []fx.Option {
+	return []fx.Option{
+		fx.Supply(cliParams, bundleParams),
+		core.Bundle(),
+		// Provide workloadmeta module
+
+		// Provide eventplatformimpl module
+		eventplatformreceiverimpl.Module(),
+		eventplatformimpl.Module(eventplatformimpl.NewDefaultParams()),
+
+		// Provide rdnsquerier module
+		rdnsquerierfx.Module(),
+
+		// Provide npcollector module
+		npcollectorimpl.Module(),
+		// Provide the corresponding workloadmeta Params to configure the catalog
+		wmcatalog.GetCatalog(),
+		workloadmetafx.ModuleWithProvider(func(config config.Component) workloadmeta.Params {
+
+			var catalog workloadmeta.AgentType
+			if config.GetBool("process_config.remote_workloadmeta") {
+				catalog = workloadmeta.Remote
+			} else {
+				catalog = workloadmeta.ProcessAgent
+			}
+
+			return workloadmeta.Params{AgentType: catalog}
+		}),
+
+		// Tagger must be initialized after agent config has been setup
+		dualTaggerfx.Module(tagger.DualParams{
+			UseRemote: func(c config.Component) bool {
+				return c.GetBool("process_config.remote_tagger")
+			},
+		}, tagger.Params{}, tagger.RemoteParams{
+			RemoteTarget: func(c config.Component) (string, error) {
+				return fmt.Sprintf(":%v", c.GetInt("cmd_port")), nil
+			},
+			RemoteTokenFetcher: func(c config.Component) func() (string, error) {
+				return func() (string, error) {
+					return security.FetchAuthToken(c)
+				}
+			},
+			RemoteFilter: taggerTypes.NewMatchAllFilter(),
+		}),
+		processComponent.Bundle(),
+		// InitSharedContainerProvider must be called before the application starts so the workloadmeta collector can be initiailized correctly.
+		// Since the tagger depends on the workloadmeta collector, we can not make the tagger a dependency of workloadmeta as it would create a circular dependency.
+		// TODO: (component) - once we remove the dependency of workloadmeta component from the tagger component
+		// we can include the tagger as part of the workloadmeta component.
+		fx.Invoke(func(wmeta workloadmeta.Component, tagger tagger.Component) {
+			proccontainers.InitSharedContainerProvider(wmeta, tagger)
+		}),
+	}
+}
+
 func RunCheckCmd(deps Dependencies) error {
 	command.SetHostMountEnv(deps.Log)
 
@@ -204,14 +210,7 @@ func RunCheckCmd(deps Dependencies) error {
 
 		names = append(names, ch.Name())
 
-		_, processModuleEnabled := deps.Syscfg.SysProbeObject().EnabledModules[sysconfig.ProcessModule]
-		_, networkTracerModuleEnabled := deps.Syscfg.SysProbeObject().EnabledModules[sysconfig.NetworkTracerModule]
-		cfg := &checks.SysProbeConfig{
-			MaxConnsPerMessage:         deps.Syscfg.SysProbeObject().MaxConnsPerMessage,
-			SystemProbeAddress:         deps.Syscfg.SysProbeObject().SocketAddress,
-			ProcessModuleEnabled:       processModuleEnabled,
-			NetworkTracerModuleEnabled: networkTracerModuleEnabled,
-		}
+		cfg := NewSysProbeConfig(deps.Syscfg)
 
 		if !matchingCheck(deps.CliParams.checkName, ch) {
 			continue
@@ -227,6 +226,20 @@ func RunCheckCmd(deps Dependencies) error {
 	return deps.Log.Errorf("invalid check '%s', choose from: %v", deps.CliParams.checkName, names)
 }
 
+// NewSysProbeConfig returns the system-probe configuration used to initialize the checks
+func NewSysProbeConfig(syscfg sysprobeconfig.Component) *checks.SysProbeConfig {
+	_, processModuleEnabled := syscfg.SysProbeObject().EnabledModules[sysconfig.ProcessModule]
+	_, networkTracerModuleEnabled := syscfg.SysProbeObject().EnabledModules[sysconfig.NetworkTracerModule]
+	_, runQueueLatencyModuleEnabled := syscfg.SysProbeObject().EnabledModules[sysconfig.RunQueueLatencyProbeModule]
+	return &checks.SysProbeConfig{
+		MaxConnsPerMessage:           syscfg.SysProbeObject().MaxConnsPerMessage,
+		SystemProbeAddress:           syscfg.SysProbeObject().SocketAddress,
+		ProcessModuleEnabled:         processModuleEnabled,
+		NetworkTracerModuleEnabled:   networkTracerModuleEnabled,
+		RunQueueLatencyModuleEnabled: runQueueLatencyModuleEnabled,
+	}
+}
+
 func matchingCheck(checkName string, ch checks.Check) bool {
 	if ch.SupportsRunOptions() {
 		if checks.RTName(ch.Name()) == checkName {
@@ -13,6 +13,7 @@ import (
 	cmdevents "github.com/DataDog/datadog-agent/cmd/process-agent/subcommands/events"
 	cmdstatus "github.com/DataDog/datadog-agent/cmd/process-agent/subcommands/status"
 	cmdtaggerlist "github.com/DataDog/datadog-agent/cmd/process-agent/subcommands/taggerlist"
+	cmdtop "github.com/DataDog/datadog-agent/cmd/process-agent/subcommands/top"
 	cmdversion "github.com/DataDog/datadog-agent/cmd/process-agent/subcommands/version"
 	cmdworkloadlist "github.com/DataDog/datadog-agent/cmd/process-agent/subcommands/workloadlist"
 )
@@ -25,6 +26,7 @@ func ProcessAgentSubcommands() []command.SubcommandFactory {
 		cmdevents.Commands,
 		cmdstatus.Commands,
 		cmdtaggerlist.Commands,
+		cmdtop.Commands,
 		cmdversion.Commands,
 		cmdworkloadlist.Commands,
 	}
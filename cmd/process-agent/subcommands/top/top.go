@@ -0,0 +1,267 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package top implements the `top` command of the Process Agent
+package top
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"syscall"
+	"text/tabwriter"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+	"go.uber.org/fx"
+
+	"github.com/DataDog/agent-payload/v5/process"
+
+	"github.com/DataDog/datadog-agent/cmd/process-agent/command"
+	cmdcheck "github.com/DataDog/datadog-agent/cmd/process-agent/subcommands/check"
+	log "github.com/DataDog/datadog-agent/comp/core/log/def"
+	"github.com/DataDog/datadog-agent/pkg/process/checks"
+	"github.com/DataDog/datadog-agent/pkg/util/fxutil"
+)
+
+const (
+	sortByCPU = "cpu"
+	sortByRSS = "rss"
+
+	// clearScreen moves the cursor to the top left corner and clears the terminal
+	clearScreen = "\033[H\033[2J"
+)
+
+type cliParams struct {
+	*command.GlobalParams
+	interval   time.Duration
+	iterations int
+	limit      int
+	sortBy     string
+}
+
+// Commands returns a slice of subcommands for the `top` command in the Process Agent
+func Commands(globalParams *command.GlobalParams) []*cobra.Command {
+	cliParams := &cliParams{
+		GlobalParams: globalParams,
+	}
+
+	topCmd := &cobra.Command{
+		Use:   "top",
+		Short: "Display a live view of the processes collected by the process check, without submitting anything",
+		Long: `Run the process check locally at every interval and display the processes it collects, as they would be
+submitted, to validate the collection and the scrubbing configuration of the host.`,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			if cliParams.sortBy != sortByCPU && cliParams.sortBy != sortByRSS {
+				return fmt.Errorf("invalid sort '%s', choose from: %s, %s", cliParams.sortBy, sortByCPU, sortByRSS)
+			}
+			if cliParams.interval <= 0 {
+				return fmt.Errorf("invalid interval '%s'", cliParams.interval)
+			}
+
+			bundleParams := command.GetCoreBundleParamsForOneShot(globalParams)
+			// the logs would garble the view
+			bundleParams.LogParams = log.ForOneShot(string(command.LoggerName), "off", true)
+
+			checkParams := &cmdcheck.CliParams{GlobalParams: globalParams}
+			options := append(cmdcheck.OneShotOptions(checkParams, bundleParams), fx.Supply(cliParams))
+			return fxutil.OneShot(runTop, options...)
+		},
+		SilenceUsage: true,
+	}
+
+	topCmd.Flags().DurationVarP(&cliParams.interval, "interval", "i", 2*time.Second, "Interval between two refreshes")
+	topCmd.Flags().IntVarP(&cliParams.iterations, "iterations", "n", 0, "Number of refreshes before exiting, 0 to run until interrupted")
+	topCmd.Flags().IntVarP(&cliParams.limit, "limit", "l", 20, "Maximum number of processes displayed, 0 for all")
+	topCmd.Flags().StringVarP(&cliParams.sortBy, "sort", "s", sortByCPU, "Sort the processes by cpu or rss")
+
+	return []*cobra.Command{topCmd}
+}
+
+func runTop(deps cmdcheck.Dependencies, cliParams *cliParams) error {
+	command.SetHostMountEnv(deps.Log)
+
+	var ch checks.Check
+	for _, checkComponent := range deps.Checks {
+		if checkComponent.Object().Name() == checks.ProcessCheckName {
+			ch = checkComponent.Object()
+			break
+		}
+	}
+	if ch == nil {
+		return fmt.Errorf("the %s check isn't available", checks.ProcessCheckName)
+	}
+
+	if err := ch.Init(cmdcheck.NewSysProbeConfig(deps.Syscfg), deps.Hostinfo.Object(), true); err != nil {
+		return err
+	}
+	defer ch.Cleanup()
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	var groupID int32
+	nextGroupID := func() int32 {
+		groupID++
+		return groupID
+	}
+	options := &checks.RunOptions{
+		RunStandard: true,
+		NoChunking:  true,
+	}
+
+	// the first run initializes the stats, the rates rely on two datapoints
+	if _, err := ch.Run(nextGroupID, options); err != nil {
+		return fmt.Errorf("collection error: %s", err)
+	}
+
+	ticker := time.NewTicker(cliParams.interval)
+	defer ticker.Stop()
+
+	for iteration := 1; cliParams.iterations == 0 || iteration <= cliParams.iterations; iteration++ {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		result, err := ch.Run(nextGroupID, options)
+		if err != nil {
+			return fmt.Errorf("collection error: %s", err)
+		}
+
+		var msgs []process.MessageBody
+		if result != nil {
+			msgs = result.Payloads()
+		}
+
+		entries := newTopEntries(msgs)
+		sortTopEntries(entries, cliParams.sortBy)
+
+		fmt.Print(clearScreen)
+		if err := renderTop(os.Stdout, entries, cliParams.limit, time.Now()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// topEntry is a process of the view
+type topEntry struct {
+	pid       int32
+	user      string
+	cpu       float32
+	rss       uint64
+	container string
+	service   string
+	command   string
+}
+
+// newTopEntries returns the processes of the payloads produced by the process check
+func newTopEntries(msgs []process.MessageBody) []*topEntry {
+	var entries []*topEntry
+	for _, msg := range msgs {
+		collectorProc, ok := msg.(*process.CollectorProc)
+		if !ok {
+			continue
+		}
+
+		containerTags := make(map[string][]string, len(collectorProc.Containers))
+		for _, container := range collectorProc.Containers {
+			containerTags[container.Id] = container.Tags
+		}
+
+		for _, proc := range collectorProc.Processes {
+			entry := &topEntry{
+				pid:       proc.Pid,
+				container: proc.ContainerId,
+				service:   serviceTag(proc.ProcessContext, containerTags[proc.ContainerId]),
+			}
+			if proc.User != nil {
+				entry.user = proc.User.Name
+			}
+			if proc.Cpu != nil {
+				entry.cpu = proc.Cpu.TotalPct
+			}
+			if proc.Memory != nil {
+				entry.rss = proc.Memory.Rss
+			}
+			if proc.Command != nil {
+				entry.command = strings.Join(proc.Command.Args, " ")
+				if entry.command == "" {
+					entry.command = proc.Command.Exe
+				}
+			}
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+// serviceTag returns the service of a process, from its process context or the tags of its container
+func serviceTag(processContext []string, containerTags []string) string {
+	for _, tags := range [][]string{processContext, containerTags} {
+		for _, tag := range tags {
+			if service, found := strings.CutPrefix(tag, "service:"); found {
+				return service
+			}
+		}
+	}
+
+	for _, tag := range processContext {
+		if service, found := strings.CutPrefix(tag, "process_context:"); found {
+			return service
+		}
+	}
+	return ""
+}
+
+func sortTopEntries(entries []*topEntry, sortBy string) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		if sortBy == sortByRSS && entries[i].rss != entries[j].rss {
+			return entries[i].rss > entries[j].rss
+		}
+		if entries[i].cpu != entries[j].cpu {
+			return entries[i].cpu > entries[j].cpu
+		}
+		return entries[i].pid < entries[j].pid
+	})
+}
+
+func shortContainerID(containerID string) string {
+	if len(containerID) > 12 {
+		return containerID[:12]
+	}
+	return containerID
+}
+
+func renderTop(w io.Writer, entries []*topEntry, limit int, now time.Time) error {
+	fmt.Fprintf(w, "%s - %d processes, nothing is submitted\n\n", now.Format(time.TimeOnly), len(entries))
+
+	if limit > 0 && len(entries) > limit {
+		entries = entries[:limit]
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "PID\tUSER\tCPU%\tRSS\tCONTAINER\tSERVICE\tCOMMAND")
+	for _, entry := range entries {
+		fmt.Fprintf(tw, "%d\t%s\t%.1f\t%s\t%s\t%s\t%s\n",
+			entry.pid,
+			entry.user,
+			entry.cpu,
+			humanize.IBytes(entry.rss),
+			shortContainerID(entry.container),
+			entry.service,
+			entry.command,
+		)
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,100 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package top
+
+import (
+	"bytes"
+	"os"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/DataDog/agent-payload/v5/process"
+
+	"github.com/DataDog/datadog-agent/cmd/process-agent/command"
+	"github.com/DataDog/datadog-agent/pkg/util/fxutil"
+)
+
+func TestTopCommand(t *testing.T) {
+	fxutil.TestOneShotSubcommand(t,
+		Commands(newGlobalParamsTest(t)),
+		[]string{"top", "--sort", "rss", "--iterations", "1"},
+		runTop,
+		func(cliParams *cliParams) {
+			assert.Equal(t, sortByRSS, cliParams.sortBy)
+			assert.Equal(t, 1, cliParams.iterations)
+			assert.Equal(t, 2*time.Second, cliParams.interval)
+		},
+	)
+}
+
+func TestRenderTop(t *testing.T) {
+	msgs := []process.MessageBody{
+		&process.CollectorProc{
+			Processes: []*process.Process{
+				{
+					Pid:            1,
+					User:           &process.ProcessUser{Name: "root"},
+					Cpu:            &process.CPUStat{TotalPct: 0.5},
+					Memory:         &process.MemoryStat{Rss: 4 << 20},
+					Command:        &process.Command{Args: []string{"/sbin/init"}},
+					ProcessContext: []string{"process_context:init"},
+				},
+				{
+					Pid:         42,
+					User:        &process.ProcessUser{Name: "app"},
+					Cpu:         &process.CPUStat{TotalPct: 12.5},
+					Memory:      &process.MemoryStat{Rss: 1 << 20},
+					Command:     &process.Command{Args: []string{"python3", "app.py", "--password", "********"}},
+					ContainerId: "0123456789abcdef0123456789abcdef",
+				},
+			},
+			Containers: []*process.Container{
+				{Id: "0123456789abcdef0123456789abcdef", Tags: []string{"image_name:app", "service:checkout"}},
+			},
+		},
+	}
+
+	entries := newTopEntries(msgs)
+	require.Len(t, entries, 2)
+
+	sortTopEntries(entries, sortByCPU)
+	assert.Equal(t, int32(42), entries[0].pid)
+	assert.Equal(t, "checkout", entries[0].service)
+	assert.Equal(t, "init", entries[1].service)
+
+	sortTopEntries(entries, sortByRSS)
+	assert.Equal(t, int32(1), entries[0].pid)
+
+	var buf bytes.Buffer
+	require.NoError(t, renderTop(&buf, entries, 1, time.Now()))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 4)
+	assert.Contains(t, lines[0], "2 processes")
+	assert.Equal(t, []string{"PID", "USER", "CPU%", "RSS", "CONTAINER", "SERVICE", "COMMAND"}, strings.Fields(lines[2]))
+	assert.Equal(t, []string{"1", "root", "0.5", "4.0", "MiB", "init", "/sbin/init"}, strings.Fields(lines[3]))
+}
+
+func newGlobalParamsTest(t *testing.T) *command.GlobalParams {
+	// Because we uses fx.Invoke some components are built
+	// Since process agent could use the remote tagger we should disable here just in case
+	config := path.Join(t.TempDir(), "datadog.yaml")
+	configYaml := `hostname: tests
+process_config:
+  remote_tagger: false`
+
+	err := os.WriteFile(config, []byte(configYaml), 0644)
+	require.NoError(t, err)
+
+	return &command.GlobalParams{
+		ConfFilePath: config,
+	}
+}
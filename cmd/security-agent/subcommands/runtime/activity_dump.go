@@ -37,6 +37,8 @@ type activityDumpCliParams struct {
 
 	name                     string
 	containerID              string
+	cgroupID                 string
+	eventTypes               []string
 	file                     string
 	file2                    string
 	timeout                  string
@@ -157,6 +159,18 @@ func generateDumpCommands(globalParams *command.GlobalParams) []*cobra.Command {
 		"",
 		"a container identifier can be used to filter the activity dump from a specific container.",
 	)
+	activityDumpGenerateDumpCmd.Flags().StringVar(
+		&cliParams.cgroupID,
+		"cgroup-id",
+		"",
+		"a cgroup identifier can be used to filter the activity dump from the container of a specific cgroup.",
+	)
+	activityDumpGenerateDumpCmd.Flags().StringArrayVar(
+		&cliParams.eventTypes,
+		"event-type",
+		[]string{},
+		"event types to capture in the activity dump, among the event types traced by the activity dumps. All of them are captured by default.",
+	)
 	activityDumpGenerateDumpCmd.Flags().StringVar(
 		&cliParams.timeout,
 		"timeout",
@@ -199,6 +213,7 @@ func generateDumpCommands(globalParams *command.GlobalParams) []*cobra.Command {
 		[]string{},
 		fmt.Sprintf("remote storage output formats. Available options are %v.", secconfig.AllStorageFormats()),
 	)
+	activityDumpGenerateDumpCmd.MarkFlagsMutuallyExclusive("container-id", "cgroup-id")
 
 	return []*cobra.Command{activityDumpGenerateDumpCmd}
 }
@@ -461,6 +476,8 @@ func generateActivityDump(_ log.Component, _ config.Component, _ secrets.Compone
 
 	output, err := client.GenerateActivityDump(&api.ActivityDumpParams{
 		ContainerID:       activityDumpArgs.containerID,
+		CGroupID:          activityDumpArgs.cgroupID,
+		EventTypes:        activityDumpArgs.eventTypes,
 		Timeout:           activityDumpArgs.timeout,
 		DifferentiateArgs: activityDumpArgs.differentiateArgs,
 		Storage:           storage,
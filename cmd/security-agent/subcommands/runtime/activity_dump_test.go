@@ -10,6 +10,8 @@ package runtime
 import (
 	"testing"
 
+	"github.com/stretchr/testify/require"
+
 	"github.com/DataDog/datadog-agent/cmd/security-agent/command"
 	"github.com/DataDog/datadog-agent/pkg/util/fxutil"
 )
@@ -54,6 +56,17 @@ func TestDumpActivityDumpCommand(t *testing.T) {
 		func() {})
 }
 
+func TestDumpActivityDumpCommandWithCGroup(t *testing.T) {
+	fxutil.TestOneShotSubcommand(t,
+		Commands(&command.GlobalParams{}),
+		[]string{"runtime", "activity-dump", "generate", "dump", "--cgroup-id", "/docker/abc", "--event-type", "exec", "--event-type", "dns"},
+		generateActivityDump,
+		func(args *activityDumpCliParams) {
+			require.Equal(t, "/docker/abc", args.cgroupID)
+			require.Equal(t, []string{"exec", "dns"}, args.eventTypes)
+		})
+}
+
 func TestActivityDumpToWorkloadPolicyCommand(t *testing.T) {
 	fxutil.TestOneShotSubcommand(t,
 		Commands(&command.GlobalParams{}),
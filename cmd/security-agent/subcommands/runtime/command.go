@@ -71,6 +71,9 @@ func commonPolicyCommands(globalParams *command.GlobalParams) []*cobra.Command {
 	commonPolicyCmd.AddCommand(commonCheckPoliciesCommands(globalParams)...)
 	commonPolicyCmd.AddCommand(commonReloadPoliciesCommands(globalParams)...)
 	commonPolicyCmd.AddCommand(downloadPolicyCommands(globalParams)...)
+	commonPolicyCmd.AddCommand(policyCoverageCommands(globalParams)...)
+	commonPolicyCmd.AddCommand(policyReplayCommands(globalParams)...)
+	commonPolicyCmd.AddCommand(policyExportCommands(globalParams)...)
 
 	return []*cobra.Command{commonPolicyCmd}
 }
@@ -222,8 +225,9 @@ func downloadPolicyCommands(globalParams *command.GlobalParams) []*cobra.Command
 type processCacheDumpCliParams struct {
 	*command.GlobalParams
 
-	withArgs bool
-	format   string
+	withArgs    bool
+	format      string
+	containerID string
 }
 
 //nolint:unused // TODO(SEC) Fix unused linter
@@ -248,12 +252,14 @@ func processCacheCommands(globalParams *command.GlobalParams) []*cobra.Command {
 	}
 	processCacheDumpCmd.Flags().BoolVar(&cliParams.withArgs, "with-args", false, "add process arguments to the dump")
 	processCacheDumpCmd.Flags().StringVar(&cliParams.format, "format", "dot", "process cache dump format")
+	processCacheDumpCmd.Flags().StringVar(&cliParams.containerID, "container-id", "", "only dump the process tree of this container")
 
 	processCacheCmd := &cobra.Command{
 		Use:   "process-cache",
 		Short: "process cache",
 	}
 	processCacheCmd.AddCommand(processCacheDumpCmd)
+	processCacheCmd.AddCommand(processCacheQueryCommands(globalParams)...)
 
 	return []*cobra.Command{processCacheCmd}
 }
@@ -330,7 +336,7 @@ func dumpProcessCache(_ log.Component, _ config.Component, _ secrets.Component,
 	}
 	defer client.Close()
 
-	filename, err := client.DumpProcessCache(processCacheDumpArgs.withArgs, processCacheDumpArgs.format)
+	filename, err := client.DumpProcessCache(processCacheDumpArgs.withArgs, processCacheDumpArgs.format, processCacheDumpArgs.containerID)
 	if err != nil {
 		return fmt.Errorf("unable to get a process cache dump: %w", err)
 	}
@@ -534,6 +540,10 @@ func eventDataFromJSON(file string) (eval.Event, error) {
 		return nil, err
 	}
 
+	return newEventFromEventData(eventData)
+}
+
+func newEventFromEventData(eventData EventData) (eval.Event, error) {
 	kind := secconfig.ParseEvalEventType(eventData.Type)
 	if kind == model.UnknownEventType {
 		return nil, errors.New("unknown event type")
@@ -167,6 +167,14 @@ func TestDumpProcessCacheCommand(t *testing.T) {
 		func() {})
 }
 
+func TestQueryProcessCacheCommand(t *testing.T) {
+	fxutil.TestOneShotSubcommand(t,
+		Commands(&command.GlobalParams{}),
+		[]string{"runtime", "process-cache", "query", "--pid", "42"},
+		queryProcessCache,
+		func() {})
+}
+
 func TestDumpNetworkNamespaceCommand(t *testing.T) {
 	fxutil.TestOneShotSubcommand(t,
 		Commands(&command.GlobalParams{}),
@@ -214,3 +222,46 @@ func TestRunRuntimeSelfTestCommand(t *testing.T) {
 		runRuntimeSelfTest,
 		func() {})
 }
+
+func TestPolicyCoverageCommand(t *testing.T) {
+	fxutil.TestOneShotSubcommand(t,
+		Commands(&command.GlobalParams{}),
+		[]string{"runtime", "policy", "coverage"},
+		policyCoverage,
+		func() {})
+}
+
+func TestReportPolicyCoverage(t *testing.T) {
+	m := mocks.NewSecurityModuleClientWrapper(t)
+	m.On("GetStatus").Return(&api.Status{
+		PoliciesStatus: []*api.PolicyStatus{
+			{
+				Name: "default.policy",
+				Status: []*api.RuleStatus{
+					{ID: "exec_shell", Status: "loaded"},
+					{ID: "bpf_load", Status: "unsupported_kernel", Error: "the hook points of the event type don't exist on this kernel"},
+					{ID: "dns_tunnel", Status: "event_type_disabled", Error: "event type not enabled"},
+				},
+			},
+			{
+				Name: "custom.policy",
+				Status: []*api.RuleStatus{
+					{ID: "open_shadow", Status: "loaded"},
+					{ID: "imds_creds", Status: "event_type_disabled", Error: "event type disabled by the probe configuration"},
+				},
+			},
+		},
+	}, nil)
+
+	var output bytes.Buffer
+	require.NoError(t, reportPolicyCoverage(m, false, &output))
+	assert.Equal(t, `2/5 rules can fire on this host
+
+event_type_disabled:
+  custom.policy/imds_creds: event type disabled by the probe configuration
+  default.policy/dns_tunnel: event type not enabled
+
+unsupported_kernel:
+  default.policy/bpf_load: the hook points of the event type don't exist on this kernel
+`, output.String())
+}
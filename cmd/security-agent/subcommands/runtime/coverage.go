@@ -0,0 +1,160 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux || windows
+
+// Package runtime holds runtime related files
+package runtime
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/spf13/cobra"
+	"go.uber.org/fx"
+
+	"github.com/DataDog/datadog-agent/cmd/security-agent/command"
+	"github.com/DataDog/datadog-agent/comp/core"
+	"github.com/DataDog/datadog-agent/comp/core/config"
+	log "github.com/DataDog/datadog-agent/comp/core/log/def"
+	"github.com/DataDog/datadog-agent/comp/core/secrets"
+	secagent "github.com/DataDog/datadog-agent/pkg/security/agent"
+	"github.com/DataDog/datadog-agent/pkg/util/fxutil"
+)
+
+type policyCoverageCliParams struct {
+	*command.GlobalParams
+
+	json bool
+}
+
+// policyCoverageRule describes a rule that can never fire on this host
+type policyCoverageRule struct {
+	Policy  string `json:"policy"`
+	ID      string `json:"id"`
+	Status  string `json:"status"`
+	Message string `json:"message,omitempty"`
+}
+
+// policyCoverageReport cross-references the deployed rules with what the host can actually monitor
+type policyCoverageReport struct {
+	Total    int                  `json:"total"`
+	Active   int                  `json:"active"`
+	Inactive []policyCoverageRule `json:"inactive"`
+}
+
+func policyCoverageCommands(globalParams *command.GlobalParams) []*cobra.Command {
+	cliParams := &policyCoverageCliParams{
+		GlobalParams: globalParams,
+	}
+
+	policyCoverageCmd := &cobra.Command{
+		Use:   "coverage",
+		Short: "Report the loaded rules that can never fire on this host",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return fxutil.OneShot(policyCoverage,
+				fx.Supply(cliParams),
+				fx.Supply(core.BundleParams{
+					ConfigParams: config.NewSecurityAgentParams(globalParams.ConfigFilePaths, config.WithFleetPoliciesDirPath(globalParams.FleetPoliciesDirPath)),
+					SecretParams: secrets.NewEnabledParams(),
+					LogParams:    log.ForOneShot(command.LoggerName, "off", false)}),
+				core.Bundle(),
+			)
+		},
+	}
+
+	policyCoverageCmd.Flags().BoolVar(&cliParams.json, "json", false, "Output the report in JSON format")
+
+	return []*cobra.Command{policyCoverageCmd}
+}
+
+func policyCoverage(_ log.Component, _ config.Component, _ secrets.Component, args *policyCoverageCliParams) error {
+	client, err := secagent.NewRuntimeSecurityClient()
+	if err != nil {
+		return fmt.Errorf("unable to create a runtime security client instance: %w", err)
+	}
+	defer client.Close()
+
+	return reportPolicyCoverage(client, args.json, os.Stdout)
+}
+
+// newPolicyCoverageReport returns the coverage report of the policies loaded by the running module. Every rule that
+// isn't loaded, or whose event type can't be monitored (missing probes, disabled event type, unsupported kernel), is
+// reported as inactive.
+func newPolicyCoverageReport(client secagent.SecurityModuleClientWrapper) (*policyCoverageReport, error) {
+	status, err := client.GetStatus()
+	if err != nil {
+		return nil, fmt.Errorf("unable to send request to system-probe: %w", err)
+	}
+
+	report := &policyCoverageReport{
+		Inactive: []policyCoverageRule{},
+	}
+	for _, policy := range status.GetPoliciesStatus() {
+		for _, rule := range policy.GetStatus() {
+			report.Total++
+			if rule.GetStatus() == "loaded" {
+				report.Active++
+				continue
+			}
+			report.Inactive = append(report.Inactive, policyCoverageRule{
+				Policy:  policy.GetName(),
+				ID:      rule.GetID(),
+				Status:  rule.GetStatus(),
+				Message: rule.GetError(),
+			})
+		}
+	}
+
+	sort.Slice(report.Inactive, func(i, j int) bool {
+		a, b := report.Inactive[i], report.Inactive[j]
+		if a.Status != b.Status {
+			return a.Status < b.Status
+		}
+		if a.Policy != b.Policy {
+			return a.Policy < b.Policy
+		}
+		return a.ID < b.ID
+	})
+
+	return report, nil
+}
+
+func reportPolicyCoverage(client secagent.SecurityModuleClientWrapper, asJSON bool, writer io.Writer) error {
+	report, err := newPolicyCoverageReport(client)
+	if err != nil {
+		return err
+	}
+
+	if asJSON {
+		content, _ := json.MarshalIndent(report, "", "\t")
+		if _, err := fmt.Fprintf(writer, "%s\n", string(content)); err != nil {
+			return fmt.Errorf("unable to write out report: %w", err)
+		}
+		return nil
+	}
+
+	if _, err := fmt.Fprintf(writer, "%d/%d rules can fire on this host\n", report.Active, report.Total); err != nil {
+		return fmt.Errorf("unable to write out report: %w", err)
+	}
+
+	var status string
+	for _, rule := range report.Inactive {
+		if rule.Status != status {
+			status = rule.Status
+			fmt.Fprintf(writer, "\n%s:\n", status)
+		}
+		fmt.Fprintf(writer, "  %s/%s", rule.Policy, rule.ID)
+		if rule.Message != "" {
+			fmt.Fprintf(writer, ": %s", rule.Message)
+		}
+		fmt.Fprintln(writer)
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux || windows
+
+// Package runtime holds runtime related files
+package runtime
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"go.uber.org/fx"
+	"gopkg.in/yaml.v2"
+
+	"github.com/DataDog/datadog-agent/cmd/security-agent/command"
+	"github.com/DataDog/datadog-agent/comp/core"
+	"github.com/DataDog/datadog-agent/comp/core/config"
+	log "github.com/DataDog/datadog-agent/comp/core/log/def"
+	"github.com/DataDog/datadog-agent/comp/core/secrets"
+	secagent "github.com/DataDog/datadog-agent/pkg/security/agent"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/rules"
+	"github.com/DataDog/datadog-agent/pkg/util/fxutil"
+)
+
+type policyExportCliParams struct {
+	*command.GlobalParams
+
+	format string
+}
+
+// exportedRuleProvenance describes where a loaded rule comes from
+type exportedRuleProvenance struct {
+	Policy     string   `yaml:"policy" json:"policy"`
+	Source     string   `yaml:"source" json:"source"`
+	Version    string   `yaml:"version,omitempty" json:"version,omitempty"`
+	ModifiedBy []string `yaml:"modified_by,omitempty" json:"modified_by,omitempty"`
+}
+
+// exportedRule is a rule as loaded by the rule engine, after the merge of all the policies
+type exportedRule struct {
+	rules.RuleDefinition `yaml:",inline"`
+	Provenance           exportedRuleProvenance `yaml:"provenance" json:"provenance"`
+}
+
+// exportedRuleSet is the rule set loaded by the rule engine
+type exportedRuleSet struct {
+	Rules []*exportedRule `yaml:"rules" json:"rules"`
+}
+
+func policyExportCommands(globalParams *command.GlobalParams) []*cobra.Command {
+	cliParams := &policyExportCliParams{
+		GlobalParams: globalParams,
+	}
+
+	policyExportCmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export the rule set currently loaded by the rule engine, with the policy of each rule",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return fxutil.OneShot(policyExport,
+				fx.Supply(cliParams),
+				fx.Supply(core.BundleParams{
+					ConfigParams: config.NewSecurityAgentParams(globalParams.ConfigFilePaths, config.WithFleetPoliciesDirPath(globalParams.FleetPoliciesDirPath)),
+					SecretParams: secrets.NewEnabledParams(),
+					LogParams:    log.ForOneShot(command.LoggerName, "off", false)}),
+				core.Bundle(),
+			)
+		},
+	}
+
+	policyExportCmd.Flags().StringVar(&cliParams.format, "format", "yaml", "Output format, yaml or json")
+
+	return []*cobra.Command{policyExportCmd}
+}
+
+func policyExport(_ log.Component, _ config.Component, _ secrets.Component, args *policyExportCliParams) error {
+	if args.format != "yaml" && args.format != "json" {
+		return fmt.Errorf("unsupported format `%s`, expected yaml or json", args.format)
+	}
+
+	client, err := secagent.NewRuntimeSecurityClient()
+	if err != nil {
+		return fmt.Errorf("unable to create a runtime security client instance: %w", err)
+	}
+	defer client.Close()
+
+	return exportRuleSet(client, args.format, os.Stdout)
+}
+
+// newExportedRuleSet returns the rule set loaded by the running module
+func newExportedRuleSet(client secagent.SecurityModuleClientWrapper) (*exportedRuleSet, error) {
+	output, err := client.ExportRuleSet()
+	if err != nil {
+		return nil, fmt.Errorf("unable to send request to system-probe: %w", err)
+	}
+	if output.GetError() != "" {
+		return nil, errors.New(output.GetError())
+	}
+
+	ruleSet := &exportedRuleSet{
+		Rules: make([]*exportedRule, 0, len(output.GetRules())),
+	}
+	for _, rule := range output.GetRules() {
+		entry := &exportedRule{
+			Provenance: exportedRuleProvenance{
+				Policy:     rule.GetPolicyName(),
+				Source:     rule.GetPolicySource(),
+				Version:    rule.GetPolicyVersion(),
+				ModifiedBy: rule.GetModifiedBy(),
+			},
+		}
+		if err := json.Unmarshal(rule.GetDefinition(), &entry.RuleDefinition); err != nil {
+			return nil, fmt.Errorf("unable to decode rule `%s`: %w", rule.GetID(), err)
+		}
+		ruleSet.Rules = append(ruleSet.Rules, entry)
+	}
+
+	return ruleSet, nil
+}
+
+func exportRuleSet(client secagent.SecurityModuleClientWrapper, format string, writer io.Writer) error {
+	ruleSet, err := newExportedRuleSet(client)
+	if err != nil {
+		return err
+	}
+
+	var content []byte
+	if format == "json" {
+		content, err = json.MarshalIndent(ruleSet, "", "\t")
+		content = append(content, '\n')
+	} else {
+		content, err = yaml.Marshal(ruleSet)
+	}
+	if err != nil {
+		return fmt.Errorf("unable to encode rule set: %w", err)
+	}
+
+	if _, err := writer.Write(content); err != nil {
+		return fmt.Errorf("unable to write out rule set: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+package runtime
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+
+	"github.com/DataDog/datadog-agent/pkg/security/agent/mocks"
+	"github.com/DataDog/datadog-agent/pkg/security/proto/api"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/rules"
+)
+
+func TestPolicyExport(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "base.policy"), []byte(`
+version: 1.2.3
+rules:
+  - id: shadow_open
+    expression: open.file.path == "/etc/shadow"
+  - id: curl_exec
+    expression: exec.file.name == "curl"
+    tags:
+      severity: low
+`), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "custom.policy"), []byte(`
+rules:
+  - id: shadow_open
+    expression: open.file.path in ["/etc/shadow", "/etc/gshadow"]
+    combine: override
+`), 0644))
+
+	provider, err := rules.NewPoliciesDirProvider(dir, false)
+	require.NoError(t, err)
+
+	ruleSet := rules.NewRuleSet(&model.Model{}, newFakeEvent, rules.NewRuleOpts(map[string]bool{"*": true}), newEvalOpts(false))
+	require.NoError(t, ruleSet.LoadPolicies(rules.NewPolicyLoader(provider), rules.PolicyLoaderOpts{}).ErrorOrNil())
+
+	exported, err := api.FromRuleSetToProtoExportedRules(ruleSet)
+	require.NoError(t, err)
+
+	client := mocks.NewSecurityModuleClientWrapper(t)
+	client.On("ExportRuleSet").Return(&api.ExportRuleSetMessage{Rules: exported}, nil)
+
+	var output bytes.Buffer
+	require.NoError(t, exportRuleSet(client, "json", &output))
+
+	var fromJSON exportedRuleSet
+	require.NoError(t, json.Unmarshal(output.Bytes(), &fromJSON))
+	require.Len(t, fromJSON.Rules, 2)
+
+	curl := fromJSON.Rules[0]
+	assert.Equal(t, "curl_exec", curl.ID)
+	assert.Equal(t, map[string]string{"severity": "low"}, curl.Tags)
+	assert.Equal(t, exportedRuleProvenance{Policy: "base.policy", Source: rules.PolicyProviderTypeDir, Version: "1.2.3"}, curl.Provenance)
+
+	shadow := fromJSON.Rules[1]
+	assert.Equal(t, "shadow_open", shadow.ID)
+	assert.Equal(t, `open.file.path in ["/etc/shadow", "/etc/gshadow"]`, shadow.Expression)
+	assert.Equal(t, "base.policy", shadow.Provenance.Policy)
+	assert.Equal(t, []string{"custom.policy"}, shadow.Provenance.ModifiedBy)
+
+	output.Reset()
+	require.NoError(t, exportRuleSet(client, "yaml", &output))
+
+	var fromYAML exportedRuleSet
+	require.NoError(t, yaml.Unmarshal(output.Bytes(), &fromYAML))
+	assert.Equal(t, fromJSON, fromYAML)
+}
@@ -0,0 +1,462 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux || windows
+
+// Package runtime holds runtime related files
+package runtime
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"go.uber.org/fx"
+
+	"github.com/DataDog/datadog-agent/cmd/security-agent/command"
+	"github.com/DataDog/datadog-agent/comp/core"
+	"github.com/DataDog/datadog-agent/comp/core/config"
+	log "github.com/DataDog/datadog-agent/comp/core/log/def"
+	"github.com/DataDog/datadog-agent/comp/core/secrets"
+	pkgconfigsetup "github.com/DataDog/datadog-agent/pkg/config/setup"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/eval"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/rules"
+	"github.com/DataDog/datadog-agent/pkg/security/seclog"
+	"github.com/DataDog/datadog-agent/pkg/util/fxutil"
+)
+
+type policyReplayCliParams struct {
+	*command.GlobalParams
+
+	dir        string
+	eventsFile string
+	json       bool
+}
+
+// policyReplayEvent is the result of the replay of an event
+type policyReplayEvent struct {
+	Index   int            `json:"index"`
+	Type    eval.EventType `json:"type"`
+	RuleIDs []string       `json:"rule_ids"`
+	Ignored []string       `json:"ignored_fields,omitempty"`
+}
+
+// policyReplayReport lists the rules that would have fired on the replayed events
+type policyReplayReport struct {
+	Events  []policyReplayEvent `json:"events"`
+	Matched int                 `json:"matched"`
+	Rules   map[string]int      `json:"rules"`
+}
+
+// policyReplayListener collects the rules matching the event being replayed
+type policyReplayListener struct {
+	ruleIDs []string
+}
+
+// RuleMatch is called when a rule matches the event being replayed
+func (l *policyReplayListener) RuleMatch(rule *rules.Rule, _ eval.Event) bool {
+	l.ruleIDs = append(l.ruleIDs, rule.ID)
+	return true
+}
+
+// EventDiscarderFound is called when a discarder is found
+func (l *policyReplayListener) EventDiscarderFound(_ *rules.RuleSet, _ eval.Event, _ eval.Field, _ eval.EventType) {
+}
+
+func policyReplayCommands(globalParams *command.GlobalParams) []*cobra.Command {
+	cliParams := &policyReplayCliParams{
+		GlobalParams: globalParams,
+	}
+
+	policyReplayCmd := &cobra.Command{
+		Use:   "test",
+		Short: "Replay captured events against the policies and report the rules that would fire",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return fxutil.OneShot(policyReplay,
+				fx.Supply(cliParams),
+				fx.Supply(core.BundleParams{
+					ConfigParams: config.NewSecurityAgentParams(globalParams.ConfigFilePaths, config.WithFleetPoliciesDirPath(globalParams.FleetPoliciesDirPath)),
+					SecretParams: secrets.NewEnabledParams(),
+					LogParams:    log.ForOneShot(command.LoggerName, "off", false)}),
+				core.Bundle(),
+			)
+		},
+	}
+
+	policyReplayCmd.Flags().StringVar(&cliParams.dir, "policies-dir", pkgconfigsetup.DefaultRuntimePoliciesDir, "Path to policies directory")
+	policyReplayCmd.Flags().StringVar(&cliParams.eventsFile, "events", "", "File of the events to replay, either in the format of the eval command or as serialized by the agent")
+	_ = policyReplayCmd.MarkFlagRequired("events")
+	policyReplayCmd.Flags().BoolVar(&cliParams.json, "json", false, "Output the report in JSON format")
+
+	return []*cobra.Command{policyReplayCmd}
+}
+
+func policyReplay(_ log.Component, _ config.Component, _ secrets.Component, args *policyReplayCliParams) error {
+	f, err := os.Open(args.eventsFile)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	events, err := readReplayEvents(f)
+	if err != nil {
+		return fmt.Errorf("unable to read events from %s: %w", args.eventsFile, err)
+	}
+
+	provider, err := rules.NewPoliciesDirProvider(args.dir, false)
+	if err != nil {
+		return err
+	}
+
+	report, err := replayEvents(rules.NewPolicyLoader(provider), events)
+	if err != nil {
+		return err
+	}
+
+	return writePolicyReplayReport(report, args.json, os.Stdout)
+}
+
+// replayEvent is an event to replay along with the serialized fields that couldn't be set on it
+type replayEvent struct {
+	event   eval.Event
+	ignored []string
+}
+
+// readReplayEvents decodes the events of a JSON array, or of a stream of JSON objects, one per line for instance
+func readReplayEvents(reader io.Reader) ([]replayEvent, error) {
+	buffered := bufio.NewReader(reader)
+	decoder := json.NewDecoder(buffered)
+	decoder.UseNumber()
+
+	var raws []map[string]interface{}
+	if first, err := peekNonSpace(buffered); err == nil && first == '[' {
+		if err := decoder.Decode(&raws); err != nil {
+			return nil, err
+		}
+	} else {
+		for {
+			var raw map[string]interface{}
+			if err := decoder.Decode(&raw); err == io.EOF {
+				break
+			} else if err != nil {
+				return nil, fmt.Errorf("event %d: %w", len(raws), err)
+			}
+			raws = append(raws, raw)
+		}
+	}
+
+	events := make([]replayEvent, 0, len(raws))
+	for i, raw := range raws {
+		event, err := newReplayEvent(raw)
+		if err != nil {
+			return nil, fmt.Errorf("event %d: %w", i, err)
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+func peekNonSpace(reader *bufio.Reader) (byte, error) {
+	for {
+		b, err := reader.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		if !strings.ContainsRune(" \t\r\n", rune(b)) {
+			return b, reader.UnreadByte()
+		}
+	}
+}
+
+// serializedMetadata lists the top level objects of a serialized event describing the event itself, not its content
+var serializedMetadata = []string{"evt", "date", "agent", "title", "status", "service", "hostname", "timestamp"}
+
+// newReplayEvent returns the event of either an event data object, as used by the eval command, or an event as
+// serialized by the agent
+func newReplayEvent(raw map[string]interface{}) (replayEvent, error) {
+	if eventType, ok := raw["Type"].(string); ok {
+		values, _ := raw["Values"].(map[string]interface{})
+		event, err := newEventFromEventData(EventData{Type: eventType, Values: values})
+		return replayEvent{event: event}, err
+	}
+
+	evt, _ := raw["evt"].(map[string]interface{})
+	eventType, _ := evt["name"].(string)
+	if eventType == "" {
+		return replayEvent{}, errors.New("neither an event data object nor a serialized event")
+	}
+
+	for _, key := range serializedMetadata {
+		delete(raw, key)
+	}
+
+	values := make(map[string]interface{})
+	flattenSerializedEvent("", raw, values)
+
+	// the serialized fields don't all map to SECL fields, the ones unknown to the model are reported
+	event, err := newEventFromEventData(EventData{Type: eventType})
+	if err != nil {
+		return replayEvent{}, err
+	}
+
+	var ignored []string
+	for key, value := range values {
+		if ancestors, ok := value.([]interface{}); ok && key == "process.ancestors" {
+			ignored = append(ignored, setSerializedAncestors(event, eventType, ancestors)...)
+		} else if !setSerializedFieldValue(event, eventType, key, value) {
+			ignored = append(ignored, key)
+		}
+	}
+	sort.Strings(ignored)
+
+	return replayEvent{event: event, ignored: ignored}, nil
+}
+
+// flattenSerializedEvent flattens the objects of a serialized event into dotted SECL like field names. The
+// executable of a serialized process is the file of a SECL process, its credentials are fields of the process itself
+// and its args and envs are the SECL argv and envp.
+func flattenSerializedEvent(prefix string, object map[string]interface{}, values map[string]interface{}) {
+	for key, value := range object {
+		switch key {
+		case "executable":
+			key = "file"
+		case "args":
+			key = "argv"
+		case "envs":
+			key = "envp"
+		case "credentials":
+			if child, ok := value.(map[string]interface{}); ok {
+				flattenSerializedEvent(prefix, child, values)
+				continue
+			}
+		}
+		if prefix != "" {
+			key = prefix + "." + key
+		}
+
+		if child, ok := value.(map[string]interface{}); ok {
+			flattenSerializedEvent(key, child, values)
+		} else {
+			values[key] = value
+		}
+	}
+}
+
+// setSerializedAncestors links the serialized ancestors of the process, from the closest to the farthest, so that
+// the iterator of the SECL ancestors goes through all of them. It returns the fields that couldn't be set.
+func setSerializedAncestors(event eval.Event, eventType string, ancestors []interface{}) []string {
+	ev, ok := event.(*model.Event)
+	if !ok {
+		return []string{"process.ancestors"}
+	}
+
+	var (
+		ignored []string
+		last    *model.ProcessCacheEntry
+	)
+	for _, ancestor := range ancestors {
+		object, ok := ancestor.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		// the ancestor fields are set on a scratch event and its ancestor is moved to the end of the chain
+		scratch, err := newEventFromEventData(EventData{Type: eventType})
+		if err != nil {
+			return []string{"process.ancestors"}
+		}
+
+		values := make(map[string]interface{})
+		flattenSerializedEvent("process.ancestors", object, values)
+		for key, value := range values {
+			if !setSerializedFieldValue(scratch, eventType, key, value) && !slices.Contains(ignored, key) {
+				ignored = append(ignored, key)
+			}
+		}
+
+		entry := scratch.(*model.Event).ProcessContext.Ancestor
+		if entry == nil {
+			continue
+		}
+		if last == nil {
+			ev.ProcessContext.Ancestor = entry
+		} else {
+			last.Ancestor = entry
+		}
+		last = entry
+	}
+	return ignored
+}
+
+// setSerializedFieldValue sets a serialized value either on the field of the same name or on the field of the event
+// type, as the serialized file of an open event is the SECL open.file for instance. It returns whether the value was
+// set.
+func setSerializedFieldValue(event eval.Event, eventType string, key string, value interface{}) bool {
+	fields := []string{eventType + "." + key, key}
+
+	switch v := value.(type) {
+	case json.Number:
+		i, err := v.Int64()
+		if err != nil {
+			return false
+		}
+		value = int(i)
+	case []interface{}:
+		if stringSlice, ok := anySliceToStringSlice(v); ok {
+			value = stringSlice
+		} else if intSlice, ok := anySliceToIntSlice(v); ok {
+			value = intSlice
+		}
+
+		// the flags of a serialized file are the names of the SECL flags of the event, O_CREAT for instance
+		if stringSlice, ok := value.([]string); ok && (key == "file.flags" || strings.HasSuffix(key, ".file.flags")) {
+			flags, ok := constantsToFlags(stringSlice)
+			if !ok {
+				return false
+			}
+			value = flags
+			fields = []string{eventType + "." + strings.TrimSuffix(key, "file.flags") + "flags"}
+		}
+	}
+
+	for _, field := range fields {
+		if err := event.SetFieldValue(field, value); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+func anySliceToIntSlice(in []any) ([]int, bool) {
+	out := make([]int, len(in))
+	for i, v := range in {
+		number, ok := v.(json.Number)
+		if !ok {
+			return nil, false
+		}
+		value, err := number.Int64()
+		if err != nil {
+			return nil, false
+		}
+		out[i] = int(value)
+	}
+	return out, true
+}
+
+// constantsToFlags returns the flags of the given SECL constant names
+func constantsToFlags(names []string) (int, bool) {
+	constants := model.SECLConstants()
+
+	var flags int
+	for _, name := range names {
+		constant, ok := constants[name].(*eval.IntEvaluator)
+		if !ok {
+			return 0, false
+		}
+		flags |= constant.Value
+	}
+	return flags, true
+}
+
+// replayEvents evaluates the events, in order, against the rules of the policies
+func replayEvents(loader *rules.PolicyLoader, events []replayEvent) (*policyReplayReport, error) {
+	// enabled all the rules
+	enabled := map[eval.EventType]bool{"*": true}
+
+	ruleOpts := rules.NewRuleOpts(enabled)
+	evalOpts := newEvalOpts(false)
+	ruleOpts.WithLogger(seclog.DefaultLogger)
+
+	agentVersionFilter, err := newAgentVersionFilter()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create agent version filter: %w", err)
+	}
+
+	loaderOpts := rules.PolicyLoaderOpts{
+		MacroFilters: []rules.MacroFilter{
+			agentVersionFilter,
+		},
+		RuleFilters: []rules.RuleFilter{
+			agentVersionFilter,
+		},
+	}
+
+	ruleSet := rules.NewRuleSet(&model.Model{}, newFakeEvent, ruleOpts, evalOpts)
+	if err := ruleSet.LoadPolicies(loader, loaderOpts); err.ErrorOrNil() != nil {
+		return nil, err
+	}
+
+	listener := &policyReplayListener{}
+	ruleSet.AddListener(listener)
+
+	report := &policyReplayReport{
+		Events: []policyReplayEvent{},
+		Rules:  make(map[string]int),
+	}
+	for i, event := range events {
+		listener.ruleIDs = nil
+
+		// the events are evaluated in order so that the variables set by the rules are visible to the next events
+		if ruleSet.Evaluate(event.event) {
+			report.Matched++
+		}
+
+		sort.Strings(listener.ruleIDs)
+		for _, id := range listener.ruleIDs {
+			report.Rules[id]++
+		}
+		report.Events = append(report.Events, policyReplayEvent{
+			Index:   i,
+			Type:    event.event.GetType(),
+			RuleIDs: append([]string{}, listener.ruleIDs...),
+			Ignored: event.ignored,
+		})
+	}
+
+	return report, nil
+}
+
+func writePolicyReplayReport(report *policyReplayReport, asJSON bool, writer io.Writer) error {
+	if asJSON {
+		content, _ := json.MarshalIndent(report, "", "\t")
+		if _, err := fmt.Fprintf(writer, "%s\n", string(content)); err != nil {
+			return fmt.Errorf("unable to write out report: %w", err)
+		}
+		return nil
+	}
+
+	for _, event := range report.Events {
+		if len(event.Ignored) != 0 {
+			fmt.Fprintf(writer, "event %d (%s): ignored fields %s\n", event.Index, event.Type, strings.Join(event.Ignored, ", "))
+		}
+		if len(event.RuleIDs) == 0 {
+			continue
+		}
+		fmt.Fprintf(writer, "event %d (%s): %s\n", event.Index, event.Type, strings.Join(event.RuleIDs, ", "))
+	}
+
+	if _, err := fmt.Fprintf(writer, "\n%d/%d events matched at least one rule\n", report.Matched, len(report.Events)); err != nil {
+		return fmt.Errorf("unable to write out report: %w", err)
+	}
+
+	ids := make([]string, 0, len(report.Rules))
+	for id := range report.Rules {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		fmt.Fprintf(writer, "  %s: %d\n", id, report.Rules[id])
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+package runtime
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/DataDog/datadog-agent/pkg/security/secl/rules"
+)
+
+const replayTestPolicy = `
+rules:
+  - id: shadow_open
+    expression: open.file.path == "/etc/shadow"
+  - id: curl_exec
+    expression: exec.file.name == "curl"
+    actions:
+      - set:
+          name: curl_seen
+          value: true
+  - id: open_after_curl
+    expression: open.file.path =~ "/tmp/*" && ${curl_seen}
+  - id: root_creat_from_sshd
+    expression: open.flags & O_CREAT > 0 && process.uid == 0 && process.argv in ["-c"] && process.ancestors.file.name == "sshd"
+`
+
+func TestPolicyReplay(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "test.policy"), []byte(replayTestPolicy), 0644))
+
+	input := `
+{"Type": "open", "Values": {"open.file.path": "/tmp/payload"}}
+{"evt": {"name": "exec", "category": "Process Activity"}, "process": {"pid": 42, "executable": {"path": "/usr/bin/curl", "name": "curl"}}}
+{"evt": {"name": "open"}, "file": {"path": "/tmp/payload"}, "process": {"executable": {"path": "/usr/bin/curl"}}}
+{"Type": "open", "Values": {"open.file.path": "/etc/shadow"}}
+{"evt": {"name": "open"}, "date": "2024-01-01T00:00:00Z", "file": {"path": "/var/log/app.log", "flags": ["O_WRONLY", "O_CREAT"]}, "process": {"credentials": {"uid": 0, "euid": 0}, "args": ["-c"], "unknown_field": 1, "ancestors": [{"executable": {"name": "bash"}}, {"executable": {"name": "sshd"}}]}}
+`
+
+	events, err := readReplayEvents(strings.NewReader(input))
+	require.NoError(t, err)
+	require.Len(t, events, 5)
+
+	provider, err := rules.NewPoliciesDirProvider(dir, false)
+	require.NoError(t, err)
+
+	report, err := replayEvents(rules.NewPolicyLoader(provider), events)
+	require.NoError(t, err)
+
+	require.Len(t, report.Events, 5)
+	assert.Empty(t, report.Events[0].RuleIDs)
+	assert.Equal(t, []string{"curl_exec"}, report.Events[1].RuleIDs)
+	assert.Equal(t, []string{"open_after_curl"}, report.Events[2].RuleIDs)
+	assert.Equal(t, []string{"shadow_open"}, report.Events[3].RuleIDs)
+	assert.Equal(t, 4, report.Matched)
+	assert.Equal(t, map[string]int{"curl_exec": 1, "open_after_curl": 1, "shadow_open": 1, "root_creat_from_sshd": 1}, report.Rules)
+
+	// the credentials, args, flags and ancestors of a serialized event are replayed, the unknown fields are reported
+	assert.Equal(t, []string{"root_creat_from_sshd"}, report.Events[4].RuleIDs)
+	assert.Equal(t, []string{"process.unknown_field"}, report.Events[4].Ignored)
+
+	var output bytes.Buffer
+	require.NoError(t, writePolicyReplayReport(report, false, &output))
+	assert.Contains(t, output.String(), "event 2 (open): open_after_curl")
+	assert.Contains(t, output.String(), "event 4 (open): ignored fields process.unknown_field")
+	assert.Contains(t, output.String(), "4/5 events matched at least one rule")
+
+	_, err = readReplayEvents(strings.NewReader(`[{"evt": {}}]`))
+	assert.Error(t, err)
+}
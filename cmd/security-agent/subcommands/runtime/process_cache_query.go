@@ -0,0 +1,170 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux || windows
+
+// Package runtime holds runtime related files
+package runtime
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"go.uber.org/fx"
+
+	"github.com/DataDog/datadog-agent/cmd/security-agent/command"
+	"github.com/DataDog/datadog-agent/comp/core"
+	"github.com/DataDog/datadog-agent/comp/core/config"
+	log "github.com/DataDog/datadog-agent/comp/core/log/def"
+	"github.com/DataDog/datadog-agent/comp/core/secrets"
+	secagent "github.com/DataDog/datadog-agent/pkg/security/agent"
+	"github.com/DataDog/datadog-agent/pkg/security/proto/api"
+	"github.com/DataDog/datadog-agent/pkg/util/fxutil"
+)
+
+type processCacheQueryCliParams struct {
+	*command.GlobalParams
+
+	pid         uint32
+	containerID string
+	path        string
+	limit       uint32
+	format      string
+}
+
+func processCacheQueryCommands(globalParams *command.GlobalParams) []*cobra.Command {
+	cliParams := &processCacheQueryCliParams{
+		GlobalParams: globalParams,
+	}
+
+	processCacheQueryCmd := &cobra.Command{
+		Use:   "query",
+		Short: "Query the process cache by pid, container ID or binary path, without dumping it",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return fxutil.OneShot(queryProcessCache,
+				fx.Supply(cliParams),
+				fx.Supply(core.BundleParams{
+					ConfigParams: config.NewSecurityAgentParams(globalParams.ConfigFilePaths, config.WithFleetPoliciesDirPath(globalParams.FleetPoliciesDirPath)),
+					SecretParams: secrets.NewEnabledParams(),
+					LogParams:    log.ForOneShot(command.LoggerName, "off", false)}),
+				core.Bundle(),
+			)
+		},
+	}
+
+	processCacheQueryCmd.Flags().Uint32Var(&cliParams.pid, "pid", 0, "PID of the process")
+	processCacheQueryCmd.Flags().StringVar(&cliParams.containerID, "container-id", "", "ID of the container of the processes")
+	processCacheQueryCmd.Flags().StringVar(&cliParams.path, "path", "", "Path of the binary of the processes, wildcards are supported")
+	processCacheQueryCmd.Flags().Uint32Var(&cliParams.limit, "limit", 0, "Maximum number of processes returned, 100 by default")
+	processCacheQueryCmd.Flags().StringVar(&cliParams.format, "format", "text", "Output format, text or json")
+
+	return []*cobra.Command{processCacheQueryCmd}
+}
+
+func queryProcessCache(_ log.Component, _ config.Component, _ secrets.Component, args *processCacheQueryCliParams) error {
+	if args.format != "text" && args.format != "json" {
+		return fmt.Errorf("unsupported format `%s`, expected text or json", args.format)
+	}
+	if args.pid == 0 && args.containerID == "" && args.path == "" {
+		return errors.New("at least one of --pid, --container-id or --path is required")
+	}
+
+	client, err := secagent.NewRuntimeSecurityClient()
+	if err != nil {
+		return fmt.Errorf("unable to create a runtime security client instance: %w", err)
+	}
+	defer client.Close()
+
+	params := &api.ProcessCacheQueryParams{
+		Pid:         args.pid,
+		ContainerID: args.containerID,
+		Path:        args.path,
+		Limit:       args.limit,
+	}
+	return printProcessCacheQuery(client, params, args.format, os.Stdout)
+}
+
+func printProcessCacheQuery(client secagent.SecurityModuleClientWrapper, params *api.ProcessCacheQueryParams, format string, writer io.Writer) error {
+	output, err := client.QueryProcessCache(params)
+	if err != nil {
+		return fmt.Errorf("unable to send request to system-probe: %w", err)
+	}
+	if output.GetError() != "" {
+		return errors.New(output.GetError())
+	}
+
+	if format == "json" {
+		content, err := json.MarshalIndent(output.GetEntries(), "", "\t")
+		if err != nil {
+			return fmt.Errorf("unable to encode the processes: %w", err)
+		}
+		_, err = fmt.Fprintf(writer, "%s\n", content)
+		return err
+	}
+
+	if len(output.GetEntries()) == 0 {
+		_, err := fmt.Fprintln(writer, "no process found")
+		return err
+	}
+
+	for _, entry := range output.GetEntries() {
+		printProcessCacheEntry(writer, entry)
+	}
+	if output.GetTruncated() {
+		fmt.Fprintln(writer, "more processes match the query, use --limit to list them")
+	}
+	return nil
+}
+
+func printProcessCacheEntry(writer io.Writer, entry *api.ProcessCacheEntryMessage) {
+	fmt.Fprintf(writer, "%d (ppid %d) %s\n", entry.GetPid(), entry.GetPPid(), entry.GetPath())
+	fmt.Fprintf(writer, "  comm: %s\n", entry.GetComm())
+	fmt.Fprintf(writer, "  args: %s%s\n", strings.Join(entry.GetArgs(), " "), truncatedSuffix(entry.GetArgsTruncated()))
+	fmt.Fprintf(writer, "  env keys: %s%s\n", strings.Join(entry.GetEnvKeys(), ", "), truncatedSuffix(entry.GetEnvsTruncated()))
+	fmt.Fprintf(writer, "  credentials: %s\n", formatProcessCredentials(entry.GetCredentials()))
+	if entry.GetContainerID() != "" {
+		fmt.Fprintf(writer, "  container: %s\n", entry.GetContainerID())
+	}
+	if entry.GetCGroupID() != "" {
+		fmt.Fprintf(writer, "  cgroup: %s\n", entry.GetCGroupID())
+	}
+	fmt.Fprintf(writer, "  source: %s, exec: %t, parent missing: %t\n", entry.GetSource(), entry.GetIsExec(), entry.GetIsParentMissing())
+	for _, t := range []struct {
+		name string
+		ts   int64
+	}{{"fork", entry.GetForkTime()}, {"exec", entry.GetExecTime()}, {"exit", entry.GetExitTime()}} {
+		if t.ts != 0 {
+			fmt.Fprintf(writer, "  %s time: %s\n", t.name, time.Unix(0, t.ts).Format(time.RFC3339Nano))
+		}
+	}
+
+	if len(entry.GetLineage()) > 0 {
+		fmt.Fprintln(writer, "  lineage:")
+		for _, ancestor := range entry.GetLineage() {
+			fmt.Fprintf(writer, "    %d %s %s (%s)\n", ancestor.GetPid(), ancestor.GetPath(), strings.Join(ancestor.GetArgs(), " "), formatProcessCredentials(ancestor.GetCredentials()))
+		}
+	}
+	fmt.Fprintln(writer)
+}
+
+func formatProcessCredentials(creds *api.ProcessCredentialsMessage) string {
+	return fmt.Sprintf("uid=%d(%s) gid=%d(%s) euid=%d(%s) egid=%d(%s) auid=%d cap_effective=%#x cap_permitted=%#x",
+		creds.GetUID(), creds.GetUser(), creds.GetGID(), creds.GetGroup(),
+		creds.GetEUID(), creds.GetEUser(), creds.GetEGID(), creds.GetEGroup(),
+		creds.GetAUID(), creds.GetCapEffective(), creds.GetCapPermitted())
+}
+
+func truncatedSuffix(truncated bool) string {
+	if truncated {
+		return " [truncated]"
+	}
+	return ""
+}
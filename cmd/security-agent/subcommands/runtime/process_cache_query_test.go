@@ -0,0 +1,79 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+package runtime
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/DataDog/datadog-agent/pkg/security/agent/mocks"
+	"github.com/DataDog/datadog-agent/pkg/security/proto/api"
+)
+
+func TestProcessCacheQuery(t *testing.T) {
+	params := &api.ProcessCacheQueryParams{ContainerID: "0123456789abcdef"}
+	entries := []*api.ProcessCacheEntryMessage{
+		{
+			Pid:         42,
+			PPid:        1,
+			Path:        "/usr/sbin/mysqld",
+			Args:        []string{"mysqld", "--password=********"},
+			EnvKeys:     []string{"DD_SERVICE", "PATH"},
+			ContainerID: "0123456789abcdef",
+			Credentials: &api.ProcessCredentialsMessage{UID: 999, User: "mysql"},
+			Lineage: []*api.ProcessAncestorMessage{
+				{Pid: 1, Path: "/usr/bin/containerd-shim", Credentials: &api.ProcessCredentialsMessage{}},
+			},
+		},
+	}
+
+	client := mocks.NewSecurityModuleClientWrapper(t)
+	client.On("QueryProcessCache", params).Return(&api.ProcessCacheQueryMessage{Entries: entries, Truncated: true}, nil)
+
+	t.Run("text", func(t *testing.T) {
+		var output bytes.Buffer
+		require.NoError(t, printProcessCacheQuery(client, params, "text", &output))
+
+		assert.Contains(t, output.String(), "42 (ppid 1) /usr/sbin/mysqld")
+		assert.Contains(t, output.String(), "args: mysqld --password=********")
+		assert.Contains(t, output.String(), "env keys: DD_SERVICE, PATH")
+		assert.Contains(t, output.String(), "uid=999(mysql)")
+		assert.Contains(t, output.String(), "    1 /usr/bin/containerd-shim")
+		assert.Contains(t, output.String(), "use --limit")
+	})
+
+	t.Run("json", func(t *testing.T) {
+		var output bytes.Buffer
+		require.NoError(t, printProcessCacheQuery(client, params, "json", &output))
+
+		var decoded []*api.ProcessCacheEntryMessage
+		require.NoError(t, json.Unmarshal(output.Bytes(), &decoded))
+		if assert.Len(t, decoded, 1) {
+			assert.Equal(t, uint32(42), decoded[0].GetPid())
+			assert.Equal(t, []string{"DD_SERVICE", "PATH"}, decoded[0].GetEnvKeys())
+		}
+	})
+}
+
+func TestProcessCacheQueryError(t *testing.T) {
+	params := &api.ProcessCacheQueryParams{Path: "/usr/[bin"}
+
+	client := mocks.NewSecurityModuleClientWrapper(t)
+	client.On("QueryProcessCache", params).Return(&api.ProcessCacheQueryMessage{Error: "invalid path pattern"}, nil).Once()
+	client.On("QueryProcessCache", params).Return(nil, errors.New("connection refused")).Once()
+
+	var output bytes.Buffer
+	assert.EqualError(t, printProcessCacheQuery(client, params, "text", &output), "invalid path pattern")
+	assert.ErrorContains(t, printProcessCacheQuery(client, params, "text", &output), "connection refused")
+	assert.Empty(t, output.String())
+}
@@ -33,6 +33,7 @@ const (
 	NetworkTracerModule          types.ModuleName = "network_tracer"
 	OOMKillProbeModule           types.ModuleName = "oom_kill_probe"
 	TCPQueueLengthTracerModule   types.ModuleName = "tcp_queue_length_tracer"
+	RunQueueLatencyProbeModule   types.ModuleName = "run_queue_latency_probe"
 	ProcessModule                types.ModuleName = "process"
 	EventMonitorModule           types.ModuleName = "event_monitor"
 	DynamicInstrumentationModule types.ModuleName = "dynamic_instrumentation"
@@ -134,6 +135,9 @@ func load() (*types.Config, error) {
 	if cfg.GetBool(spNS("enable_oom_kill")) {
 		c.EnabledModules[OOMKillProbeModule] = struct{}{}
 	}
+	if cfg.GetBool(spNS("enable_run_queue_latency")) {
+		c.EnabledModules[RunQueueLatencyProbeModule] = struct{}{}
+	}
 	if cfg.GetBool(secNS("enabled")) ||
 		cfg.GetBool(secNS("fim_enabled")) ||
 		cfg.GetBool(evNS("process.enabled")) ||
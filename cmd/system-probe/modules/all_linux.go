@@ -20,6 +20,7 @@ var All = []module.Factory{
 	NetworkTracer,
 	TCPQueueLength,
 	OOMKillProbe,
+	RunQueueLatencyProbe,
 	// there is a dependency from EventMonitor -> NetworkTracer
 	// so EventMonitor has to follow NetworkTracer
 	EventMonitor,
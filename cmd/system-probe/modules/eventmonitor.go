@@ -51,6 +51,8 @@ func createEventMonitorModule(_ *sysconfigtypes.Config, deps module.FactoryDepen
 		return nil, module.ErrNotEnabled
 	}
 
+	registerProcessLookup(evm)
+
 	if secconfig.RuntimeSecurity.IsRuntimeEnabled() {
 		cws, err := secmodule.NewCWSConsumer(evm, secconfig.RuntimeSecurity, deps.WMeta, secmoduleOpts)
 		if err != nil {
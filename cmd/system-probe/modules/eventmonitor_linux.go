@@ -12,6 +12,7 @@ import (
 	"github.com/DataDog/datadog-agent/cmd/system-probe/config"
 	pkgconfigsetup "github.com/DataDog/datadog-agent/pkg/config/setup"
 	"github.com/DataDog/datadog-agent/pkg/eventmonitor"
+	"github.com/DataDog/datadog-agent/pkg/eventmonitor/consumers"
 	netconfig "github.com/DataDog/datadog-agent/pkg/network/config"
 	usmconfig "github.com/DataDog/datadog-agent/pkg/network/usm/config"
 	usmstate "github.com/DataDog/datadog-agent/pkg/network/usm/state"
@@ -35,3 +36,8 @@ func createProcessMonitorConsumer(evm *eventmonitor.EventMonitor, config *netcon
 
 	return procmon.NewProcessMonitorEventConsumer(evm)
 }
+
+// registerProcessLookup shares the process cache of the event monitor with the USM and NPM modules
+func registerProcessLookup(evm *eventmonitor.EventMonitor) {
+	consumers.RegisterProcessLookup(evm)
+}
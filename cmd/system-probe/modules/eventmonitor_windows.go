@@ -28,3 +28,6 @@ func createProcessMonitorConsumer(_ *eventmonitor.EventMonitor, _ *netconfig.Con
 func createGPUProcessEventConsumer(_ *eventmonitor.EventMonitor) error {
 	return nil
 }
+
+func registerProcessLookup(_ *eventmonitor.EventMonitor) {
+}
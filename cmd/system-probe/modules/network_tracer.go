@@ -158,6 +158,17 @@ func (nt *networkTracer) Register(httpMux *module.Router) error {
 		utils.WriteAsJSON(w, stats)
 	})
 
+	httpMux.HandleFunc("/debug/dns_stats_by_process", func(w http.ResponseWriter, req *http.Request) {
+		stats, err := nt.tracer.DebugDNSStatsByProcess(getClientID(req))
+		if err != nil {
+			log.Errorf("unable to retrieve DNS stats by process: %s", err)
+			w.WriteHeader(500)
+			return
+		}
+
+		utils.WriteAsJSON(w, stats)
+	})
+
 	httpMux.HandleFunc("/debug/http_monitoring", func(w http.ResponseWriter, req *http.Request) {
 		if !coreconfig.SystemProbe().GetBool("service_monitoring_config.enable_http_monitoring") {
 			writeDisabledProtocolMessage("http", w)
@@ -0,0 +1,67 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+package modules
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"go.uber.org/atomic"
+
+	"github.com/DataDog/datadog-agent/cmd/system-probe/api/module"
+	"github.com/DataDog/datadog-agent/cmd/system-probe/config"
+	sysconfigtypes "github.com/DataDog/datadog-agent/cmd/system-probe/config/types"
+	"github.com/DataDog/datadog-agent/cmd/system-probe/utils"
+	"github.com/DataDog/datadog-agent/pkg/collector/corechecks/ebpf/probe/runqueuelatency"
+	"github.com/DataDog/datadog-agent/pkg/ebpf"
+	"github.com/DataDog/datadog-agent/pkg/util/log"
+)
+
+// RunQueueLatencyProbe Factory
+var RunQueueLatencyProbe = module.Factory{
+	Name:             config.RunQueueLatencyProbeModule,
+	ConfigNamespaces: []string{},
+	Fn: func(_ *sysconfigtypes.Config, _ module.FactoryDependencies) (module.Module, error) {
+		log.Infof("Starting the run queue latency probe")
+		p, err := runqueuelatency.NewProbe(ebpf.NewConfig())
+		if err != nil {
+			return nil, fmt.Errorf("unable to start the run queue latency probe: %w", err)
+		}
+		return &runQueueLatencyModule{
+			Probe:     p,
+			lastCheck: atomic.NewInt64(0),
+		}, nil
+	},
+	NeedsEBPF: func() bool {
+		return true
+	},
+}
+
+var _ module.Module = &runQueueLatencyModule{}
+
+type runQueueLatencyModule struct {
+	*runqueuelatency.Probe
+	lastCheck *atomic.Int64
+}
+
+func (r *runQueueLatencyModule) Register(httpMux *module.Router) error {
+	httpMux.HandleFunc("/check", utils.WithConcurrencyLimit(utils.DefaultMaxConcurrentRequests, func(w http.ResponseWriter, _ *http.Request) {
+		r.lastCheck.Store(time.Now().Unix())
+		stats := r.Probe.GetAndFlush()
+		utils.WriteAsJSON(w, stats)
+	}))
+
+	return nil
+}
+
+func (r *runQueueLatencyModule) GetStats() map[string]interface{} {
+	return map[string]interface{}{
+		"last_check": r.lastCheck.Load(),
+	}
+}
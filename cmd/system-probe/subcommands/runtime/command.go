@@ -65,6 +65,7 @@ func commonPolicyCommands(globalParams *command.GlobalParams) []*cobra.Command {
 	commonPolicyCmd.AddCommand(commonCheckPoliciesCommands(globalParams)...)
 	commonPolicyCmd.AddCommand(commonReloadPoliciesCommands(globalParams)...)
 	commonPolicyCmd.AddCommand(downloadPolicyCommands(globalParams)...)
+	commonPolicyCmd.AddCommand(effectiveRulesCommands(globalParams)...)
 
 	return []*cobra.Command{commonPolicyCmd}
 }
@@ -216,8 +217,9 @@ func downloadPolicyCommands(globalParams *command.GlobalParams) []*cobra.Command
 type processCacheDumpCliParams struct {
 	*command.GlobalParams
 
-	withArgs bool
-	format   string
+	withArgs    bool
+	format      string
+	containerID string
 }
 
 //nolint:unused // TODO(SEC) Fix unused linter
@@ -241,7 +243,8 @@ func processCacheCommands(globalParams *command.GlobalParams) []*cobra.Command {
 		},
 	}
 	processCacheDumpCmd.Flags().BoolVar(&cliParams.withArgs, "with-args", false, "add process arguments to the dump")
-	processCacheDumpCmd.Flags().StringVar(&cliParams.format, "format", "dot", "process cache dump format")
+	processCacheDumpCmd.Flags().StringVar(&cliParams.format, "format", "dot", "process cache dump format, one of dot, json or json_v1 (the format of the dumps of the agents that predate the versioned json schema)")
+	processCacheDumpCmd.Flags().StringVar(&cliParams.containerID, "container-id", "", "only dump the process tree of this container")
 
 	processCacheCmd := &cobra.Command{
 		Use:   "process-cache",
@@ -324,7 +327,7 @@ func dumpProcessCache(_ log.Component, _ config.Component, _ secrets.Component,
 	}
 	defer client.Close()
 
-	filename, err := client.DumpProcessCache(processCacheDumpArgs.withArgs, processCacheDumpArgs.format)
+	filename, err := client.DumpProcessCache(processCacheDumpArgs.withArgs, processCacheDumpArgs.format, processCacheDumpArgs.containerID)
 	if err != nil {
 		return fmt.Errorf("unable to get a process cache dump: %w", err)
 	}
@@ -14,6 +14,9 @@ import (
 	secagent "github.com/DataDog/datadog-agent/pkg/security/agent"
 	"github.com/DataDog/datadog-agent/pkg/security/agent/mocks"
 	"github.com/DataDog/datadog-agent/pkg/security/proto/api"
+	secrules "github.com/DataDog/datadog-agent/pkg/security/rules"
+	"github.com/DataDog/datadog-agent/pkg/security/rules/monitor"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/rules"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -214,3 +217,39 @@ func TestRunRuntimeSelfTestCommand(t *testing.T) {
 		runRuntimeSelfTest,
 		func() {})
 }
+
+func TestEffectiveRulesCommand(t *testing.T) {
+	fxutil.TestOneShotSubcommand(t,
+		effectiveRulesCommands(&command.GlobalParams{}),
+		[]string{"effective", "--json"},
+		printEffectiveRules,
+		func(cliParams *effectiveRulesCliParams) {
+			require.True(t, cliParams.json)
+		},
+	)
+}
+
+func TestWriteEffectiveRules(t *testing.T) {
+	effectiveRules := []*secrules.EffectiveRule{
+		{
+			RuleDefinition: &rules.RuleDefinition{
+				ID:         "shell_exec",
+				Expression: `exec.file.name == "bash"`,
+				Actions:    []*rules.ActionDefinition{{Kill: &rules.KillDefinition{Signal: "SIGKILL"}}},
+			},
+			Policy: &monitor.PolicyState{Name: "threats.policy", Source: rules.PolicyProviderTypeRC},
+			ModifiedBy: []*monitor.PolicyState{
+				{Name: "local.policy", Source: rules.PolicyProviderTypeDir},
+			},
+		},
+	}
+
+	var buffer bytes.Buffer
+	require.NoError(t, writeEffectiveRules(&buffer, effectiveRules, false))
+	assert.Equal(t, `shell_exec
+  policy: threats.policy (remote-config)
+  modified by: local.policy (file)
+  expression: exec.file.name == "bash"
+  actions: kill
+`, buffer.String())
+}
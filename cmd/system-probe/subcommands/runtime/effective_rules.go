@@ -0,0 +1,127 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux || windows
+
+package runtime
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"go.uber.org/fx"
+
+	"github.com/DataDog/datadog-agent/cmd/system-probe/api/client"
+	"github.com/DataDog/datadog-agent/cmd/system-probe/command"
+	"github.com/DataDog/datadog-agent/comp/core"
+	"github.com/DataDog/datadog-agent/comp/core/config"
+	log "github.com/DataDog/datadog-agent/comp/core/log/def"
+	"github.com/DataDog/datadog-agent/comp/core/sysprobeconfig"
+	"github.com/DataDog/datadog-agent/comp/core/sysprobeconfig/sysprobeconfigimpl"
+	"github.com/DataDog/datadog-agent/pkg/security/module"
+	secrules "github.com/DataDog/datadog-agent/pkg/security/rules"
+	"github.com/DataDog/datadog-agent/pkg/security/rules/monitor"
+	"github.com/DataDog/datadog-agent/pkg/util/fxutil"
+)
+
+const effectiveRulesURL = "http://localhost/event_monitor" + module.EffectiveRulesRoute
+
+type effectiveRulesCliParams struct {
+	*command.GlobalParams
+
+	json bool
+}
+
+func effectiveRulesCommands(globalParams *command.GlobalParams) []*cobra.Command {
+	cliParams := &effectiveRulesCliParams{
+		GlobalParams: globalParams,
+	}
+
+	effectiveRulesCmd := &cobra.Command{
+		Use:   "effective",
+		Short: "Print the loaded rules once the policies of all the sources were merged",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return fxutil.OneShot(printEffectiveRules,
+				fx.Supply(cliParams),
+				fx.Supply(core.BundleParams{
+					ConfigParams:         config.NewAgentParams("", config.WithConfigMissingOK(true)),
+					SysprobeConfigParams: sysprobeconfigimpl.NewParams(sysprobeconfigimpl.WithSysProbeConfFilePath(globalParams.ConfFilePath), sysprobeconfigimpl.WithFleetPoliciesDirPath(globalParams.FleetPoliciesDirPath)),
+					LogParams:            log.ForOneShot("SYS-PROBE", "off", false),
+				}),
+				core.Bundle(),
+			)
+		},
+	}
+
+	effectiveRulesCmd.Flags().BoolVar(&cliParams.json, "json", false, "Print the rules in JSON format")
+
+	return []*cobra.Command{effectiveRulesCmd}
+}
+
+func printEffectiveRules(sysprobeconfig sysprobeconfig.Component, args *effectiveRulesCliParams) error {
+	hc := client.Get(sysprobeconfig.SysProbeObject().SocketAddress)
+	resp, err := hc.Get(effectiveRulesURL)
+	if err != nil {
+		return fmt.Errorf("could not reach system-probe: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("effective rules request failed: %s", strings.TrimSpace(string(body)))
+	}
+
+	var effectiveRules []*secrules.EffectiveRule
+	if err := json.Unmarshal(body, &effectiveRules); err != nil {
+		return err
+	}
+
+	return writeEffectiveRules(os.Stdout, effectiveRules, args.json)
+}
+
+func formatPolicyState(policy *monitor.PolicyState) string {
+	return fmt.Sprintf("%s (%s)", policy.Name, policy.Source)
+}
+
+func writeEffectiveRules(writer io.Writer, effectiveRules []*secrules.EffectiveRule, asJSON bool) error {
+	if asJSON {
+		content, err := json.MarshalIndent(effectiveRules, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(writer, "%s\n", content)
+		return err
+	}
+
+	for _, rule := range effectiveRules {
+		fmt.Fprintf(writer, "%s\n", rule.ID)
+		fmt.Fprintf(writer, "  policy: %s\n", formatPolicyState(rule.Policy))
+		if len(rule.ModifiedBy) > 0 {
+			modifiedBy := make([]string, 0, len(rule.ModifiedBy))
+			for _, policy := range rule.ModifiedBy {
+				modifiedBy = append(modifiedBy, formatPolicyState(policy))
+			}
+			fmt.Fprintf(writer, "  modified by: %s\n", strings.Join(modifiedBy, ", "))
+		}
+		fmt.Fprintf(writer, "  expression: %s\n", rule.Expression)
+		if len(rule.Actions) > 0 {
+			actions := make([]string, 0, len(rule.Actions))
+			for _, action := range rule.Actions {
+				actions = append(actions, action.Name())
+			}
+			fmt.Fprintf(writer, "  actions: %s\n", strings.Join(actions, ", "))
+		}
+	}
+
+	return nil
+}
@@ -44,6 +44,7 @@ func securityProfileCommands(globalParams *command.GlobalParams) []*cobra.Comman
 	securityProfileCmd.AddCommand(securityProfileShowCommands(globalParams)...)
 	securityProfileCmd.AddCommand(listSecurityProfileCommands(globalParams)...)
 	securityProfileCmd.AddCommand(saveSecurityProfileCommands(globalParams)...)
+	securityProfileCmd.AddCommand(suppressionCommands(globalParams)...)
 
 	return []*cobra.Command{securityProfileCmd}
 }
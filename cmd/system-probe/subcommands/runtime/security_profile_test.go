@@ -37,3 +37,19 @@ func TestSaveSecurityProfileCommand(t *testing.T) {
 		saveSecurityProfile,
 		func() {})
 }
+
+func TestStartSuppressionWindowCommand(t *testing.T) {
+	fxutil.TestOneShotSubcommand(t,
+		Commands(&command.GlobalParams{}),
+		[]string{"runtime", "security-profile", "suppression", "start", "--name", "name", "--duration", "30m"},
+		startSuppressionWindow,
+		func() {})
+}
+
+func TestListSuppressionWindowsCommand(t *testing.T) {
+	fxutil.TestOneShotSubcommand(t,
+		Commands(&command.GlobalParams{}),
+		[]string{"runtime", "security-profile", "suppression", "list"},
+		listSuppressionWindows,
+		func() {})
+}
@@ -0,0 +1,149 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+package runtime
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"go.uber.org/fx"
+
+	"github.com/DataDog/datadog-agent/cmd/system-probe/api/client"
+	"github.com/DataDog/datadog-agent/cmd/system-probe/command"
+	"github.com/DataDog/datadog-agent/comp/core"
+	"github.com/DataDog/datadog-agent/comp/core/config"
+	log "github.com/DataDog/datadog-agent/comp/core/log/def"
+	"github.com/DataDog/datadog-agent/comp/core/sysprobeconfig"
+	"github.com/DataDog/datadog-agent/comp/core/sysprobeconfig/sysprobeconfigimpl"
+	"github.com/DataDog/datadog-agent/pkg/security/module"
+	"github.com/DataDog/datadog-agent/pkg/security/security_profile/profile"
+	"github.com/DataDog/datadog-agent/pkg/util/fxutil"
+)
+
+const suppressionWindowsURL = "http://localhost/event_monitor" + module.SuppressionWindowsRoute
+
+type suppressionCliParams struct {
+	*command.GlobalParams
+
+	imageName string
+	imageTag  string
+	duration  time.Duration
+}
+
+func suppressionCommands(globalParams *command.GlobalParams) []*cobra.Command {
+	suppressionCmd := &cobra.Command{
+		Use:   "suppression",
+		Short: "pause the anomaly detections of a workload, for instance during a deployment",
+	}
+
+	suppressionCmd.AddCommand(suppressionCommand(globalParams, "start", "open a suppression window for a workload", startSuppressionWindow))
+	suppressionCmd.AddCommand(suppressionCommand(globalParams, "stop", "close the suppression window of a workload", stopSuppressionWindow))
+	suppressionCmd.AddCommand(suppressionCommand(globalParams, "list", "list the open suppression windows", listSuppressionWindows))
+
+	return []*cobra.Command{suppressionCmd}
+}
+
+func suppressionCommand(globalParams *command.GlobalParams, use string, short string, fct func(sysprobeconfig.Component, *suppressionCliParams) error) *cobra.Command {
+	cliParams := &suppressionCliParams{
+		GlobalParams: globalParams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return fxutil.OneShot(fct,
+				fx.Supply(cliParams),
+				fx.Supply(core.BundleParams{
+					ConfigParams:         config.NewAgentParams("", config.WithConfigMissingOK(true)),
+					SysprobeConfigParams: sysprobeconfigimpl.NewParams(sysprobeconfigimpl.WithSysProbeConfFilePath(globalParams.ConfFilePath), sysprobeconfigimpl.WithFleetPoliciesDirPath(globalParams.FleetPoliciesDirPath)),
+					LogParams:            log.ForOneShot("SYS-PROBE", "off", false),
+				}),
+				core.Bundle(),
+			)
+		},
+	}
+
+	if use != "list" {
+		cmd.Flags().StringVar(&cliParams.imageName, "name", "", "image name of the workload")
+		_ = cmd.MarkFlagRequired("name")
+		cmd.Flags().StringVar(&cliParams.imageTag, "tag", "", "image tag of the workload, all the tags of the image if empty")
+	}
+	if use == "start" {
+		cmd.Flags().DurationVar(&cliParams.duration, "duration", 0, "duration of the suppression window, the configured default duration if empty")
+	}
+
+	return cmd
+}
+
+func startSuppressionWindow(sysprobeconfig sysprobeconfig.Component, args *suppressionCliParams) error {
+	return doSuppressionWindowsRequest(sysprobeconfig, http.MethodPost, args)
+}
+
+func stopSuppressionWindow(sysprobeconfig sysprobeconfig.Component, args *suppressionCliParams) error {
+	return doSuppressionWindowsRequest(sysprobeconfig, http.MethodDelete, args)
+}
+
+func listSuppressionWindows(sysprobeconfig sysprobeconfig.Component, args *suppressionCliParams) error {
+	return doSuppressionWindowsRequest(sysprobeconfig, http.MethodGet, args)
+}
+
+func doSuppressionWindowsRequest(sysprobeconfig sysprobeconfig.Component, method string, args *suppressionCliParams) error {
+	params := url.Values{}
+	if args.imageName != "" {
+		params.Set("image_name", args.imageName)
+	}
+	if args.imageTag != "" {
+		params.Set("image_tag", args.imageTag)
+	}
+	if args.duration != 0 {
+		params.Set("duration", args.duration.String())
+	}
+
+	req, err := http.NewRequest(method, suppressionWindowsURL+"?"+params.Encode(), nil)
+	if err != nil {
+		return err
+	}
+
+	hc := client.Get(sysprobeconfig.SysProbeObject().SocketAddress)
+	resp, err := hc.Do(req)
+	if err != nil {
+		return fmt.Errorf("could not reach system-probe: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("suppression window request failed: %s", strings.TrimSpace(string(body)))
+	}
+
+	var windows []profile.SuppressionWindow
+	if err := json.Unmarshal(body, &windows); err != nil {
+		return err
+	}
+
+	if len(windows) == 0 {
+		fmt.Println("no suppression window open")
+		return nil
+	}
+
+	fmt.Println("suppression windows:")
+	for _, window := range windows {
+		fmt.Printf("  %s until %s\n", window.Selector, window.Until.Format(time.RFC3339))
+	}
+	return nil
+}
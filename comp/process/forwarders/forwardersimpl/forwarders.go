@@ -55,25 +55,82 @@ func newForwarders(deps dependencies) (forwarders.Component, error) {
 		return nil, err
 	}
 
-	eventForwarderOpts := createParams(deps.Config, deps.Logger, queueBytes, eventsAPIEndpoints)
+	eventForwarder, err := createForwarders(deps, queueBytes, eventsAPIEndpoints)
+	if err != nil {
+		return nil, err
+	}
 
 	processAPIEndpoints, err := endpoint.GetAPIEndpoints(config)
 	if err != nil {
 		return nil, err
 	}
 
-	processForwarderOpts := createParams(deps.Config, deps.Logger, queueBytes, processAPIEndpoints)
+	processForwarder, err := createForwarders(deps, queueBytes, processAPIEndpoints)
+	if err != nil {
+		return nil, err
+	}
+
+	rtProcessForwarder, err := createForwarders(deps, queueBytes, processAPIEndpoints)
+	if err != nil {
+		return nil, err
+	}
+
+	connectionsAPIEndpoints, err := endpoint.GetConnectionsAPIEndpoints(config)
+	if err != nil {
+		return nil, err
+	}
+
+	connectionsForwarder, err := createForwarders(deps, queueBytes, connectionsAPIEndpoints)
+	if err != nil {
+		return nil, err
+	}
 
 	return &forwardersComp{
-		eventForwarder:       createForwarder(deps, eventForwarderOpts),
-		processForwarder:     createForwarder(deps, processForwarderOpts),
-		rtProcessForwarder:   createForwarder(deps, processForwarderOpts),
-		connectionsForwarder: createForwarder(deps, processForwarderOpts),
+		eventForwarder:       eventForwarder,
+		processForwarder:     processForwarder,
+		rtProcessForwarder:   rtProcessForwarder,
+		connectionsForwarder: connectionsForwarder,
+	}, nil
+}
+
+// createForwarders creates a forwarder per group of endpoints sharing the same proxy settings. All the groups are
+// submitted to through the returned forwarder.
+func createForwarders(deps dependencies, queueBytes int, endpoints []apicfg.Endpoint) (defaultforwarder.Component, error) {
+	groups, err := endpoint.GroupEndpointsByProxy(deps.Config, endpoints)
+	if err != nil {
+		return nil, err
+	}
+
+	var forwarders []defaultforwarder.Component
+	for _, group := range groups {
+		var cfg config.Component = deps.Config
+		if group.Override {
+			deps.Logger.Infof("Using proxy overrides for process endpoints %s", endpointsString(group.Endpoints))
+			cfg = &proxyConfig{Component: deps.Config, proxies: group.Proxies}
+		}
+		forwarders = append(forwarders, createForwarder(deps, cfg, createParams(cfg, deps.Logger, queueBytes, group.Endpoints)))
+	}
+
+	if len(forwarders) == 1 {
+		return forwarders[0], nil
+	}
+
+	return &multiForwarder{
+		Component:   forwarders[0],
+		secondaries: forwarders[1:],
 	}, nil
 }
 
-func createForwarder(deps dependencies, options *defaultforwarder.Options) defaultforwarder.Component {
-	return defaultforwarder.NewForwarder(deps.Config, deps.Logger, deps.Lc, false, options).Comp
+func endpointsString(endpoints []apicfg.Endpoint) []string {
+	eps := make([]string, 0, len(endpoints))
+	for _, e := range endpoints {
+		eps = append(eps, e.Endpoint.String())
+	}
+	return eps
+}
+
+func createForwarder(deps dependencies, config config.Component, options *defaultforwarder.Options) defaultforwarder.Component {
+	return defaultforwarder.NewForwarder(config, deps.Logger, deps.Lc, false, options).Comp
 }
 
 func createParams(config config.Component, log log.Component, queueBytes int, endpoints []apicfg.Endpoint) *defaultforwarder.Options {
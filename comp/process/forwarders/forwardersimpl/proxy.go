@@ -0,0 +1,127 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package forwardersimpl
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/DataDog/datadog-agent/comp/core/config"
+	"github.com/DataDog/datadog-agent/comp/forwarder/defaultforwarder"
+	"github.com/DataDog/datadog-agent/comp/forwarder/defaultforwarder/transaction"
+	pkgconfigmodel "github.com/DataDog/datadog-agent/pkg/config/model"
+)
+
+// proxyConfig overrides the proxy settings of the agent configuration for a group of endpoints
+type proxyConfig struct {
+	config.Component
+	proxies *pkgconfigmodel.Proxy
+}
+
+// GetProxies returns the proxy settings of the group of endpoints
+func (c *proxyConfig) GetProxies() *pkgconfigmodel.Proxy {
+	return c.proxies
+}
+
+// multiForwarder submits process payloads to a primary forwarder and to the forwarders of the endpoints using
+// a different proxy. Other payload types are only handled by the primary forwarder.
+type multiForwarder struct {
+	defaultforwarder.Component
+	secondaries []defaultforwarder.Component
+}
+
+type submitFunc func(fwd defaultforwarder.Component) (chan defaultforwarder.Response, error)
+
+func (f *multiForwarder) submit(fn submitFunc) (chan defaultforwarder.Response, error) {
+	responses, err := fn(f.Component)
+	if err != nil {
+		return nil, err
+	}
+
+	all := []chan defaultforwarder.Response{responses}
+	for _, fwd := range f.secondaries {
+		// errors from secondary endpoints must not prevent the payload from being sent to the primary ones
+		if responses, err := fn(fwd); err == nil {
+			all = append(all, responses)
+		}
+	}
+
+	return mergeResponses(all), nil
+}
+
+func mergeResponses(all []chan defaultforwarder.Response) chan defaultforwarder.Response {
+	if len(all) == 1 {
+		return all[0]
+	}
+
+	var wg sync.WaitGroup
+	merged := make(chan defaultforwarder.Response)
+	for _, responses := range all {
+		wg.Add(1)
+		go func(responses chan defaultforwarder.Response) {
+			defer wg.Done()
+			for response := range responses {
+				merged <- response
+			}
+		}(responses)
+	}
+
+	go func() {
+		wg.Wait()
+		close(merged)
+	}()
+
+	return merged
+}
+
+// SubmitProcessChecks sends process checks
+func (f *multiForwarder) SubmitProcessChecks(payload transaction.BytesPayloads, extra http.Header) (chan defaultforwarder.Response, error) {
+	return f.submit(func(fwd defaultforwarder.Component) (chan defaultforwarder.Response, error) {
+		return fwd.SubmitProcessChecks(payload, extra)
+	})
+}
+
+// SubmitProcessDiscoveryChecks sends process discovery checks
+func (f *multiForwarder) SubmitProcessDiscoveryChecks(payload transaction.BytesPayloads, extra http.Header) (chan defaultforwarder.Response, error) {
+	return f.submit(func(fwd defaultforwarder.Component) (chan defaultforwarder.Response, error) {
+		return fwd.SubmitProcessDiscoveryChecks(payload, extra)
+	})
+}
+
+// SubmitProcessEventChecks sends process events checks
+func (f *multiForwarder) SubmitProcessEventChecks(payload transaction.BytesPayloads, extra http.Header) (chan defaultforwarder.Response, error) {
+	return f.submit(func(fwd defaultforwarder.Component) (chan defaultforwarder.Response, error) {
+		return fwd.SubmitProcessEventChecks(payload, extra)
+	})
+}
+
+// SubmitRTProcessChecks sends real time process checks
+func (f *multiForwarder) SubmitRTProcessChecks(payload transaction.BytesPayloads, extra http.Header) (chan defaultforwarder.Response, error) {
+	return f.submit(func(fwd defaultforwarder.Component) (chan defaultforwarder.Response, error) {
+		return fwd.SubmitRTProcessChecks(payload, extra)
+	})
+}
+
+// SubmitContainerChecks sends container checks
+func (f *multiForwarder) SubmitContainerChecks(payload transaction.BytesPayloads, extra http.Header) (chan defaultforwarder.Response, error) {
+	return f.submit(func(fwd defaultforwarder.Component) (chan defaultforwarder.Response, error) {
+		return fwd.SubmitContainerChecks(payload, extra)
+	})
+}
+
+// SubmitRTContainerChecks sends real time container checks
+func (f *multiForwarder) SubmitRTContainerChecks(payload transaction.BytesPayloads, extra http.Header) (chan defaultforwarder.Response, error) {
+	return f.submit(func(fwd defaultforwarder.Component) (chan defaultforwarder.Response, error) {
+		return fwd.SubmitRTContainerChecks(payload, extra)
+	})
+}
+
+// SubmitConnectionChecks sends connection checks
+func (f *multiForwarder) SubmitConnectionChecks(payload transaction.BytesPayloads, extra http.Header) (chan defaultforwarder.Response, error) {
+	return f.submit(func(fwd defaultforwarder.Component) (chan defaultforwarder.Response, error) {
+		return fwd.SubmitConnectionChecks(payload, extra)
+	})
+}
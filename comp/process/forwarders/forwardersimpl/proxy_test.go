@@ -0,0 +1,119 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package forwardersimpl
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/DataDog/datadog-agent/comp/forwarder/defaultforwarder"
+	"github.com/DataDog/datadog-agent/comp/forwarder/defaultforwarder/transaction"
+)
+
+// fakeForwarder records the payloads it was asked to submit and replies with one response per payload
+type fakeForwarder struct {
+	defaultforwarder.Component
+	domain    string
+	err       error
+	submitted []transaction.BytesPayloads
+}
+
+func (f *fakeForwarder) respond(payload transaction.BytesPayloads) (chan defaultforwarder.Response, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.submitted = append(f.submitted, payload)
+
+	responses := make(chan defaultforwarder.Response, len(payload))
+	for range payload {
+		responses <- defaultforwarder.Response{Domain: f.domain, StatusCode: http.StatusAccepted}
+	}
+	close(responses)
+	return responses, nil
+}
+
+func (f *fakeForwarder) SubmitProcessChecks(payload transaction.BytesPayloads, _ http.Header) (chan defaultforwarder.Response, error) {
+	return f.respond(payload)
+}
+
+func (f *fakeForwarder) SubmitConnectionChecks(payload transaction.BytesPayloads, _ http.Header) (chan defaultforwarder.Response, error) {
+	return f.respond(payload)
+}
+
+func collectDomains(t *testing.T, responses chan defaultforwarder.Response) []string {
+	t.Helper()
+
+	var domains []string
+	for response := range responses {
+		domains = append(domains, response.Domain)
+	}
+	return domains
+}
+
+func TestMultiForwarderFanOut(t *testing.T) {
+	primary := &fakeForwarder{domain: "primary"}
+	secondary1 := &fakeForwarder{domain: "secondary1"}
+	secondary2 := &fakeForwarder{domain: "secondary2"}
+
+	fwd := &multiForwarder{
+		Component:   primary,
+		secondaries: []defaultforwarder.Component{secondary1, secondary2},
+	}
+
+	payload := transaction.NewBytesPayloadsWithoutMetaData([]*[]byte{{0x1}})
+
+	responses, err := fwd.SubmitProcessChecks(payload, nil)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"primary", "secondary1", "secondary2"}, collectDomains(t, responses))
+
+	responses, err = fwd.SubmitConnectionChecks(payload, nil)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"primary", "secondary1", "secondary2"}, collectDomains(t, responses))
+
+	for _, f := range []*fakeForwarder{primary, secondary1, secondary2} {
+		assert.Len(t, f.submitted, 2, f.domain)
+	}
+}
+
+func TestMultiForwarderPartialFailure(t *testing.T) {
+	payload := transaction.NewBytesPayloadsWithoutMetaData([]*[]byte{{0x1}})
+
+	t.Run("secondary", func(t *testing.T) {
+		primary := &fakeForwarder{domain: "primary"}
+		failing := &fakeForwarder{domain: "failing", err: errors.New("queue full")}
+		secondary := &fakeForwarder{domain: "secondary"}
+
+		fwd := &multiForwarder{
+			Component:   primary,
+			secondaries: []defaultforwarder.Component{failing, secondary},
+		}
+
+		// a failing secondary doesn't prevent the payload from reaching the other endpoints
+		responses, err := fwd.SubmitProcessChecks(payload, nil)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"primary", "secondary"}, collectDomains(t, responses))
+		assert.Empty(t, failing.submitted)
+	})
+
+	t.Run("primary", func(t *testing.T) {
+		primary := &fakeForwarder{domain: "primary", err: errors.New("queue full")}
+		secondary := &fakeForwarder{domain: "secondary"}
+
+		fwd := &multiForwarder{
+			Component:   primary,
+			secondaries: []defaultforwarder.Component{secondary},
+		}
+
+		// the error of the primary forwarder is reported, the payload isn't sent to the secondaries
+		_, err := fwd.SubmitProcessChecks(payload, nil)
+		assert.Error(t, err)
+		assert.Empty(t, secondary.submitted)
+	})
+}
@@ -10,6 +10,7 @@ import (
 	"go.uber.org/fx"
 
 	"github.com/DataDog/datadog-agent/comp/core/config"
+	workloadmeta "github.com/DataDog/datadog-agent/comp/core/workloadmeta/def"
 	"github.com/DataDog/datadog-agent/comp/process/processeventscheck"
 	"github.com/DataDog/datadog-agent/comp/process/types"
 	"github.com/DataDog/datadog-agent/pkg/process/checks"
@@ -32,6 +33,7 @@ type dependencies struct {
 	fx.In
 
 	Config config.Component
+	WMeta  workloadmeta.Component
 }
 
 type result struct {
@@ -43,7 +45,7 @@ type result struct {
 
 func newCheck(deps dependencies) result {
 	c := &check{
-		processEventsCheck: checks.NewProcessEventsCheck(deps.Config),
+		processEventsCheck: checks.NewProcessEventsCheck(deps.Config, deps.WMeta),
 	}
 	return result{
 		Check: types.ProvidesCheck{
@@ -17,6 +17,8 @@ import (
 type Payload struct {
 	CheckName string
 	Message   []model.MessageBody
+	// OnSubmitted, when set, is called once the messages were submitted, with whether they were all delivered
+	OnSubmitted func(delivered bool)
 }
 
 // CheckComponent defines an interface implemented by checks
@@ -0,0 +1,15 @@
+#ifndef RUN_QUEUE_LATENCY_KERN_USER_H
+#define RUN_QUEUE_LATENCY_KERN_USER_H
+
+#include "ktypes.h"
+
+struct run_queue_latency_stats {
+    // Total time spent by the threads of the process in the run queue, in nanoseconds
+    __u64 total_ns;
+    // Longest time spent by a thread of the process in the run queue, in nanoseconds
+    __u64 max_ns;
+    // Number of times the threads of the process were scheduled after waiting in the run queue
+    __u64 count;
+};
+
+#endif /* defined(RUN_QUEUE_LATENCY_KERN_USER_H) */
@@ -0,0 +1,117 @@
+#include "ktypes.h"
+#include "bpf_metadata.h"
+
+#ifdef COMPILE_RUNTIME
+#include "kconfig.h"
+#include <linux/sched.h>
+
+#if LINUX_VERSION_CODE < KERNEL_VERSION(4, 17, 0)
+// 4.17 is the first version where raw tracepoints are available
+#error Versions of Linux previous to 4.17.0 are not supported by this probe
+#endif
+
+#endif
+
+#include "run-queue-latency-kern-user.h"
+
+#include "bpf_tracing.h"
+#include "bpf_core_read.h"
+#include "map-defs.h"
+
+#ifndef TASK_RUNNING
+#define TASK_RUNNING 0
+#endif
+
+#ifndef COMPILE_RUNTIME
+// the state field of the task_struct was renamed __state in 5.14
+struct task_struct___old {
+    long state;
+} __attribute__((preserve_access_index));
+#endif
+
+static __always_inline long get_task_state(struct task_struct *p) {
+#ifdef COMPILE_RUNTIME
+#if LINUX_VERSION_CODE < KERNEL_VERSION(5, 14, 0)
+    return BPF_CORE_READ(p, state);
+#else
+    return BPF_CORE_READ(p, __state);
+#endif
+#else
+    if (bpf_core_field_exists(p->__state)) {
+        return BPF_CORE_READ(p, __state);
+    }
+    return BPF_CORE_READ((struct task_struct___old *)p, state);
+#endif
+}
+
+/*
+ * The `run_queue_enqueued` map holds the time at which each thread was put in the run queue
+ */
+BPF_LRU_MAP(run_queue_enqueued, u32, u64, 10240)
+
+/*
+ * The `run_queue_latency_stats` map is used to share with the userland program system-probe
+ * the run queue latency per process
+ */
+BPF_HASH_MAP(run_queue_latency_stats, u32, struct run_queue_latency_stats, 10240)
+
+static __always_inline void record_enqueue(struct task_struct *p) {
+    u32 tid = BPF_CORE_READ(p, pid);
+    // the idle task is never waiting in the run queue
+    if (tid == 0) {
+        return;
+    }
+
+    u64 ts = bpf_ktime_get_ns();
+    bpf_map_update_elem(&run_queue_enqueued, &tid, &ts, BPF_ANY);
+}
+
+SEC("raw_tracepoint/sched_wakeup")
+int raw_tracepoint__sched_wakeup(struct bpf_raw_tracepoint_args *ctx) {
+    record_enqueue((struct task_struct *)ctx->args[0]);
+    return 0;
+}
+
+SEC("raw_tracepoint/sched_wakeup_new")
+int raw_tracepoint__sched_wakeup_new(struct bpf_raw_tracepoint_args *ctx) {
+    record_enqueue((struct task_struct *)ctx->args[0]);
+    return 0;
+}
+
+SEC("raw_tracepoint/sched_switch")
+int raw_tracepoint__sched_switch(struct bpf_raw_tracepoint_args *ctx) {
+    struct task_struct *prev = (struct task_struct *)ctx->args[1];
+    struct task_struct *next = (struct task_struct *)ctx->args[2];
+
+    // a thread switched out while still runnable goes back to the run queue, the other ones are put in it by
+    // their next wakeup
+    if (get_task_state(prev) == TASK_RUNNING) {
+        record_enqueue(prev);
+    }
+
+    u32 tid = BPF_CORE_READ(next, pid);
+    u64 *enqueued = bpf_map_lookup_elem(&run_queue_enqueued, &tid);
+    if (!enqueued) {
+        return 0;
+    }
+    u64 delta = bpf_ktime_get_ns() - *enqueued;
+    bpf_map_delete_elem(&run_queue_enqueued, &tid);
+
+    u32 tgid = BPF_CORE_READ(next, tgid);
+    struct run_queue_latency_stats zero = {};
+    bpf_map_update_elem(&run_queue_latency_stats, &tgid, &zero, BPF_NOEXIST);
+    struct run_queue_latency_stats *stats = bpf_map_lookup_elem(&run_queue_latency_stats, &tgid);
+    if (!stats) {
+        return 0;
+    }
+
+    __sync_fetch_and_add(&stats->total_ns, delta);
+    __sync_fetch_and_add(&stats->count, 1);
+    if (delta > stats->max_ns) {
+        stats->max_ns = delta;
+    }
+
+    return 0;
+}
+
+char _license[] SEC("license") = "GPL";
@@ -0,0 +1,15 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2024-present Datadog, Inc.
+
+//go:build ignore
+
+package runqueuelatency
+
+/*
+#include "../../c/runtime/run-queue-latency-kern-user.h"
+*/
+import "C"
+
+type runQueueLatencyStats C.struct_run_queue_latency_stats
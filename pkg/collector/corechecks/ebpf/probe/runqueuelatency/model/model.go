@@ -0,0 +1,24 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package model is the types for the run queue latency probe
+package model
+
+// RunQueueLatencyStats contains the time spent by the threads of a process waiting in the scheduler run queue since
+// the previous collection
+type RunQueueLatencyStats struct {
+	Pid     uint32 `json:"pid"`
+	TotalNs uint64 `json:"totalNs"`
+	MaxNs   uint64 `json:"maxNs"`
+	Count   uint64 `json:"count"`
+}
+
+// AvgNs returns the average time spent in the run queue before being scheduled
+func (s RunQueueLatencyStats) AvgNs() uint64 {
+	if s.Count == 0 {
+		return 0
+	}
+	return s.TotalNs / s.Count
+}
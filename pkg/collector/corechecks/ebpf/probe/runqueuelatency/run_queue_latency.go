@@ -0,0 +1,196 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux_bpf
+
+//go:generate $GOPATH/bin/include_headers pkg/collector/corechecks/ebpf/c/runtime/run-queue-latency-kern.c pkg/ebpf/bytecode/build/runtime/run-queue-latency.c pkg/ebpf/c
+//go:generate $GOPATH/bin/integrity pkg/ebpf/bytecode/build/runtime/run-queue-latency.c pkg/ebpf/bytecode/runtime/run-queue-latency.go runtime
+
+// Package runqueuelatency is the system-probe side of the run queue latency collection
+package runqueuelatency
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	cebpf "github.com/cilium/ebpf"
+	"golang.org/x/sys/unix"
+
+	manager "github.com/DataDog/ebpf-manager"
+
+	"github.com/DataDog/datadog-agent/pkg/collector/corechecks/ebpf/probe/runqueuelatency/model"
+	"github.com/DataDog/datadog-agent/pkg/ebpf"
+	"github.com/DataDog/datadog-agent/pkg/ebpf/bytecode"
+	"github.com/DataDog/datadog-agent/pkg/ebpf/bytecode/runtime"
+	"github.com/DataDog/datadog-agent/pkg/ebpf/maps"
+	"github.com/DataDog/datadog-agent/pkg/process/statsd"
+	"github.com/DataDog/datadog-agent/pkg/util/kernel"
+	"github.com/DataDog/datadog-agent/pkg/util/log"
+)
+
+const statsMapName = "run_queue_latency_stats"
+
+// Probe is the eBPF side of the run queue latency collection
+type Probe struct {
+	m        *manager.Manager
+	statsMap *maps.GenericMap[uint32, runQueueLatencyStats]
+
+	lookupAndDeleteUnsupported bool
+}
+
+// NewProbe creates a [Probe]
+func NewProbe(cfg *ebpf.Config) (*Probe, error) {
+	if cfg.EnableCORE {
+		probe, err := loadRunQueueLatencyCOREProbe()
+		if err == nil {
+			return probe, nil
+		}
+
+		if !cfg.AllowRuntimeCompiledFallback {
+			return nil, fmt.Errorf("error loading CO-RE run-queue-latency probe: %s. set system_probe_config.allow_runtime_compiled_fallback to true to allow fallback to runtime compilation", err)
+		}
+		log.Warnf("error loading CO-RE run-queue-latency probe: %s. falling back to runtime compiled probe", err)
+	}
+
+	return loadRunQueueLatencyRuntimeCompiledProbe(cfg)
+}
+
+func loadRunQueueLatencyCOREProbe() (*Probe, error) {
+	kv, err := kernel.HostVersion()
+	if err != nil {
+		return nil, fmt.Errorf("error detecting kernel version: %s", err)
+	}
+	if kv < kernel.VersionCode(4, 17, 0) {
+		return nil, fmt.Errorf("detected kernel version %s, but run-queue-latency probe requires a kernel version of at least 4.17.0", kv)
+	}
+
+	var probe *Probe
+	err = ebpf.LoadCOREAsset("run-queue-latency.o", func(buf bytecode.AssetReader, opts manager.Options) error {
+		probe, err = startRunQueueLatencyProbe(buf, opts)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("successfully loaded CO-RE version of run-queue-latency probe")
+	return probe, nil
+}
+
+func loadRunQueueLatencyRuntimeCompiledProbe(cfg *ebpf.Config) (*Probe, error) {
+	buf, err := runtime.RunQueueLatency.Compile(cfg, getCFlags(cfg), statsd.Client)
+	if err != nil {
+		return nil, err
+	}
+	defer buf.Close()
+
+	return startRunQueueLatencyProbe(buf, manager.Options{})
+}
+
+func getCFlags(config *ebpf.Config) []string {
+	cflags := []string{"-g"}
+	if config.BPFDebug {
+		cflags = append(cflags, "-DDEBUG=1")
+	}
+	return cflags
+}
+
+func startRunQueueLatencyProbe(buf bytecode.AssetReader, managerOptions manager.Options) (*Probe, error) {
+	m := &manager.Manager{
+		Probes: []*manager.Probe{
+			{ProbeIdentificationPair: manager.ProbeIdentificationPair{EBPFFuncName: "raw_tracepoint__sched_wakeup", UID: "runqlat"}, TracepointName: "sched_wakeup"},
+			{ProbeIdentificationPair: manager.ProbeIdentificationPair{EBPFFuncName: "raw_tracepoint__sched_wakeup_new", UID: "runqlat"}, TracepointName: "sched_wakeup_new"},
+			{ProbeIdentificationPair: manager.ProbeIdentificationPair{EBPFFuncName: "raw_tracepoint__sched_switch", UID: "runqlat"}, TracepointName: "sched_switch"},
+		},
+		Maps: []*manager.Map{
+			{Name: "run_queue_enqueued"},
+			{Name: statsMapName},
+		},
+	}
+
+	managerOptions.RLimit = &unix.Rlimit{
+		Cur: math.MaxUint64,
+		Max: math.MaxUint64,
+	}
+
+	if err := m.InitWithOptions(buf, managerOptions); err != nil {
+		return nil, fmt.Errorf("failed to init manager: %w", err)
+	}
+
+	if err := m.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start manager: %w", err)
+	}
+
+	statsMap, err := maps.GetMap[uint32, runQueueLatencyStats](m, statsMapName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get map '%s': %w", statsMapName, err)
+	}
+	ebpf.AddNameMappings(m, "run_queue_latency")
+
+	return &Probe{
+		m:        m,
+		statsMap: statsMap,
+	}, nil
+}
+
+// Close releases all associated resources
+func (k *Probe) Close() {
+	ebpf.RemoveNameMappings(k.m)
+	if err := k.m.Stop(manager.CleanAll); err != nil {
+		log.Errorf("error stopping run queue latency probe: %s", err)
+	}
+}
+
+// GetAndFlush gets the run queue latency of the processes since the previous call. The stats of each process are
+// swapped out of the map atomically, so that the updates racing with the flush are reported by the next call.
+func (k *Probe) GetAndFlush() (results []model.RunQueueLatencyStats) {
+	var allPids []uint32
+	var pid uint32
+	var stat runQueueLatencyStats
+	it := k.statsMap.Iterate()
+	for it.Next(&pid, &stat) {
+		allPids = append(allPids, pid)
+	}
+
+	if err := it.Err(); err != nil {
+		log.Warnf("failed to iterate on run queue latency stats while flushing: %s", err)
+	}
+
+	for _, pid := range allPids {
+		if err := k.lookupAndDelete(&pid, &stat); err != nil {
+			if !errors.Is(err, cebpf.ErrKeyNotExist) {
+				log.Warnf("failed to flush stat: %s", err)
+			}
+			continue
+		}
+
+		results = append(results, model.RunQueueLatencyStats{
+			Pid:     pid,
+			TotalNs: stat.Total_ns,
+			MaxNs:   stat.Max_ns,
+			Count:   stat.Count,
+		})
+	}
+
+	return results
+}
+
+// lookupAndDelete removes the stats of a process from the map and returns them. The hash maps support the atomic
+// lookup and delete operation from 5.14, older kernels fall back to a lookup followed by a delete.
+func (k *Probe) lookupAndDelete(pid *uint32, stat *runQueueLatencyStats) error {
+	if !k.lookupAndDeleteUnsupported {
+		err := k.statsMap.Map().LookupAndDelete(pid, stat)
+		if !errors.Is(err, cebpf.ErrNotSupported) {
+			return err
+		}
+		k.lookupAndDeleteUnsupported = true
+	}
+
+	if err := k.statsMap.Lookup(pid, stat); err != nil {
+		return err
+	}
+	return k.statsMap.Delete(pid)
+}
@@ -88,6 +88,9 @@ const (
 
 	// DefaultConnectionsMaxCheckInterval is the maximum interval allowed for the connections check
 	DefaultConnectionsMaxCheckInterval = 5 * time.Minute
+
+	// DefaultScrubbingReplacement is the default string replacing the scrubbed values
+	DefaultScrubbingReplacement = "********"
 )
 
 // setupProcesses is meant to be called multiple times for different configs, but overrides apply to all configs, so
@@ -170,6 +173,16 @@ func setupProcesses(config pkgconfigmodel.Setup) {
 		"DD_STRIP_PROCESS_ARGS",
 		"DD_PROCESS_CONFIG_STRIP_PROC_ARGUMENTS",
 		"DD_PROCESS_AGENT_STRIP_PROC_ARGUMENTS")
+	procBindEnvAndSetDefault(config, "process_config.field_scrubbing.drop", []string{})
+	procBindEnvAndSetDefault(config, "process_config.field_scrubbing.hash", []string{})
+	procBindEnvAndSetDefault(config, "process_config.cmdline_dedup.enabled", false)
+	procBindEnvAndSetDefault(config, "process_config.cmdline_dedup.window", 30*time.Minute)
+	// Scrubbing policy shared by the process checks and the runtime security module
+	procBindEnvAndSetDefault(config, "process_config.scrubbing.sensitive_words", []string{})
+	procBindEnvAndSetDefault(config, "process_config.scrubbing.patterns", []string{})
+	procBindEnvAndSetDefault(config, "process_config.scrubbing.replacement", DefaultScrubbingReplacement)
+	procBindEnvAndSetDefault(config, "process_config.scrubbing.envs_with_value", []string{})
+	procBindEnvAndSetDefault(config, "process_config.scrubbing.envs_without_value", []string{})
 	// Use PDH API to collect performance counter data for process check on Windows
 	procBindEnvAndSetDefault(config, "process_config.windows.use_perf_counters", false)
 	config.BindEnvAndSetDefault("process_config.additional_endpoints", make(map[string][]string),
@@ -178,6 +191,8 @@ func setupProcesses(config pkgconfigmodel.Setup) {
 		"DD_PROCESS_ADDITIONAL_ENDPOINTS",
 	)
 	procBindEnvAndSetDefault(config, "process_config.events_additional_endpoints", make(map[string][]string))
+	procBindEnvAndSetDefault(config, "process_config.connections_additional_endpoints", make(map[string][]string))
+	procBindEnvAndSetDefault(config, "process_config.endpoints_proxy", make(map[string]string))
 	procBindEnvAndSetDefault(config, "process_config.intervals.connections", 30*time.Second)
 	procBindEnvAndSetDefault(config, "process_config.expvar_port", DefaultProcessExpVarPort)
 	procBindEnvAndSetDefault(config, "process_config.log_file", DefaultProcessAgentLogFile)
@@ -187,6 +202,9 @@ func setupProcesses(config pkgconfigmodel.Setup) {
 	procBindEnvAndSetDefault(config, "process_config.remote_workloadmeta", false) // This flag might change. It's still being tested.
 	procBindEnvAndSetDefault(config, "process_config.disable_realtime_checks", false)
 	procBindEnvAndSetDefault(config, "process_config.ignore_zombie_processes", false)
+	procBindEnvAndSetDefault(config, "process_config.pressure_stall.enabled", false)
+	procBindEnvAndSetDefault(config, "process_config.health.runs", 5)
+	procBindEnvAndSetDefault(config, "process_config.health.interval_budget_factor", 1.0)
 
 	// Process Discovery Check
 	config.BindEnvAndSetDefault("process_config.process_discovery.enabled", true,
@@ -94,6 +94,30 @@ func TestProcessDefaultConfig(t *testing.T) {
 			key:          "process_config.events_additional_endpoints",
 			defaultValue: make(map[string][]string),
 		},
+		{
+			key:          "process_config.connections_additional_endpoints",
+			defaultValue: make(map[string][]string),
+		},
+		{
+			key:          "process_config.endpoints_proxy",
+			defaultValue: make(map[string]string),
+		},
+		{
+			key:          "process_config.field_scrubbing.drop",
+			defaultValue: []string{},
+		},
+		{
+			key:          "process_config.field_scrubbing.hash",
+			defaultValue: []string{},
+		},
+		{
+			key:          "process_config.cmdline_dedup.enabled",
+			defaultValue: false,
+		},
+		{
+			key:          "process_config.cmdline_dedup.window",
+			defaultValue: 30 * time.Minute,
+		},
 		{
 			key:          "process_config.internal_profiling.enabled",
 			defaultValue: false,
@@ -500,6 +524,15 @@ func TestEnvVarOverride(t *testing.T) {
 			},
 		}, cfg.GetStringMapStringSlice("process_config.events_additional_endpoints"))
 	})
+
+	t.Run("DD_PROCESS_CONFIG_CONNECTIONS_ADDITIONAL_ENDPOINTS", func(t *testing.T) {
+		t.Setenv("DD_PROCESS_CONFIG_CONNECTIONS_ADDITIONAL_ENDPOINTS", `{"https://process.datadoghq.eu": ["fakeAPIKey"]}`)
+		assert.Equal(t, map[string][]string{
+			"https://process.datadoghq.eu": {
+				"fakeAPIKey",
+			},
+		}, cfg.GetStringMapStringSlice("process_config.connections_additional_endpoints"))
+	})
 }
 
 func readCfgWithType(cfg pkgconfigmodel.Config, key, expType string) interface{} {
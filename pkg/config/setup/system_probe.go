@@ -202,6 +202,8 @@ func InitSystemProbeConfig(cfg pkgconfigmodel.Config) {
 	cfg.BindEnvAndSetDefault(join(spNS, "collect_dns_domains"), true, "DD_COLLECT_DNS_DOMAINS")
 	cfg.BindEnvAndSetDefault(join(spNS, "max_dns_stats"), 20000)
 	cfg.BindEnvAndSetDefault(join(spNS, "dns_timeout_in_s"), 15)
+	cfg.BindEnvAndSetDefault(join(netNS, "enable_dns_stats_by_process"), false)
+	cfg.BindEnvAndSetDefault(join(netNS, "dns_top_domains_per_process"), 5)
 
 	cfg.BindEnvAndSetDefault(join(spNS, "enable_conntrack"), true)
 	cfg.BindEnvAndSetDefault(join(spNS, "conntrack_max_state_size"), 65536*2)
@@ -317,6 +319,9 @@ func InitSystemProbeConfig(cfg pkgconfigmodel.Config) {
 
 	// tcp_queue_length module
 	cfg.BindEnvAndSetDefault(join(spNS, "enable_tcp_queue_length"), false)
+
+	// run_queue_latency module
+	cfg.BindEnvAndSetDefault(join(spNS, "enable_run_queue_latency"), false)
 	// process module
 	// nested within system_probe_config to not conflict with process-agent's process_config
 	cfg.BindEnvAndSetDefault(join(spNS, "process_config.enabled"), false, "DD_SYSTEM_PROBE_PROCESS_ENABLED")
@@ -356,13 +361,42 @@ func InitSystemProbeConfig(cfg pkgconfigmodel.Config) {
 	eventMonitorBindEnv(cfg, join(evNS, "enable_approvers"))
 	eventMonitorBindEnv(cfg, join(evNS, "enable_discarders"))
 	eventMonitorBindEnvAndSetDefault(cfg, join(evNS, "flush_discarder_window"), 3)
+	eventMonitorBindEnvAndSetDefault(cfg, join(evNS, "discarded_inodes_cache_size"), 8192)
 	eventMonitorBindEnvAndSetDefault(cfg, join(evNS, "pid_cache_size"), 10000)
+	eventMonitorBindEnvAndSetDefault(cfg, join(evNS, "process_resolver.entry_cache"), "map")
+	eventMonitorBindEnvAndSetDefault(cfg, join(evNS, "process_resolver.entry_cache_shards"), 16)
+	eventMonitorBindEnvAndSetDefault(cfg, join(evNS, "process_resolver.max_entries"), 0)
+	eventMonitorBindEnvAndSetDefault(cfg, join(evNS, "process_resolver.memory_budget"), 0)
+	eventMonitorBindEnvAndSetDefault(cfg, join(evNS, "process_resolver.sweep_interval"), 120)
+	eventMonitorBindEnvAndSetDefault(cfg, join(evNS, "process_resolver.reconciliation_interval"), 300)
+	eventMonitorBindEnvAndSetDefault(cfg, join(evNS, "process_resolver.envs_refresh_interval"), 0)
+	eventMonitorBindEnvAndSetDefault(cfg, join(evNS, "process_resolver.snapshot_workers"), 4)
+	eventMonitorBindEnvAndSetDefault(cfg, join(evNS, "process_resolver.exited_retention.period"), 60)
+	eventMonitorBindEnvAndSetDefault(cfg, join(evNS, "process_resolver.exited_retention.referenced_period"), 0)
+	eventMonitorBindEnvAndSetDefault(cfg, join(evNS, "process_resolver.procfs_workers"), 2)
+	eventMonitorBindEnvAndSetDefault(cfg, join(evNS, "process_resolver.procfs_queue_size"), 512)
+	eventMonitorBindEnvAndSetDefault(cfg, join(evNS, "process_resolver.args_envs_cache_size"), 512)
+	eventMonitorBindEnvAndSetDefault(cfg, join(evNS, "process_resolver.args_envs_value_cache_size"), 8192)
+	eventMonitorBindEnvAndSetDefault(cfg, join(evNS, "process_resolver.args_procfs_fallback"), true)
+	eventMonitorBindEnvAndSetDefault(cfg, join(evNS, "process_resolver.shebang_detection"), false)
+	eventMonitorBindEnvAndSetDefault(cfg, join(evNS, "process_resolver.lineage_repair"), true)
+	eventMonitorBindEnvAndSetDefault(cfg, join(evNS, "process_resolver.audit_reconciliation.enabled"), false)
+	eventMonitorBindEnvAndSetDefault(cfg, join(evNS, "process_resolver.audit_reconciliation.window"), 10)
+	eventMonitorBindEnvAndSetDefault(cfg, join(evNS, "process_resolver.exec_sampling.enabled"), false)
+	eventMonitorBindEnvAndSetDefault(cfg, join(evNS, "process_resolver.exec_sampling.rate"), 10)
+	eventMonitorBindEnvAndSetDefault(cfg, join(evNS, "process_resolver.exec_sampling.window"), 60)
 	eventMonitorBindEnvAndSetDefault(cfg, join(evNS, "events_stats.tags_cardinality"), "high")
 	eventMonitorBindEnvAndSetDefault(cfg, join(evNS, "custom_sensitive_words"), []string{})
 	eventMonitorBindEnvAndSetDefault(cfg, join(evNS, "erpc_dentry_resolution_enabled"), true)
 	eventMonitorBindEnvAndSetDefault(cfg, join(evNS, "map_dentry_resolution_enabled"), true)
 	eventMonitorBindEnvAndSetDefault(cfg, join(evNS, "dentry_cache_size"), 1024)
+	eventMonitorBindEnvAndSetDefault(cfg, join(evNS, "path_cache.size"), 4096)
+	eventMonitorBindEnvAndSetDefault(cfg, join(evNS, "path_cache.negative_ttl"), 5)
 	eventMonitorBindEnvAndSetDefault(cfg, join(evNS, "runtime_monitor.enabled"), false)
+	eventMonitorBindEnvAndSetDefault(cfg, join(evNS, "profiling_endpoints.enabled"), false)
+	eventMonitorBindEnvAndSetDefault(cfg, join(evNS, "profiling_endpoints.mutex_profile_fraction"), 0)
+	eventMonitorBindEnvAndSetDefault(cfg, join(evNS, "diagnose.lost_events_threshold"), 0.01)
+	eventMonitorBindEnvAndSetDefault(cfg, join(evNS, "diagnose.process_resolver_miss_threshold"), 0.1)
 	eventMonitorBindEnvAndSetDefault(cfg, join(evNS, "network.lazy_interface_prefixes"), []string{})
 	eventMonitorBindEnvAndSetDefault(cfg, join(evNS, "network.classifier_priority"), 10)
 	eventMonitorBindEnvAndSetDefault(cfg, join(evNS, "network.classifier_handle"), 0)
@@ -372,6 +406,10 @@ func InitSystemProbeConfig(cfg pkgconfigmodel.Config) {
 	eventMonitorBindEnvAndSetDefault(cfg, join(evNS, "event_stream.use_fentry_amd64"), false)
 	eventMonitorBindEnvAndSetDefault(cfg, join(evNS, "event_stream.use_fentry_arm64"), false)
 	eventMonitorBindEnv(cfg, join(evNS, "event_stream.buffer_size"))
+	eventMonitorBindEnvAndSetDefault(cfg, join(evNS, "event_stream.expected_event_rate"), 60000)
+	eventMonitorBindEnvAndSetDefault(cfg, join(evNS, "event_stream.priority_lanes.enabled"), false)
+	eventMonitorBindEnvAndSetDefault(cfg, join(evNS, "event_stream.priority_lanes.queue_size"), 4096)
+	eventMonitorBindEnvAndSetDefault(cfg, join(evNS, "event_stream.priority_lanes.drop_policy"), []string{})
 	eventMonitorBindEnvAndSetDefault(cfg, join(evNS, "envs_with_value"), []string{"LD_PRELOAD", "LD_LIBRARY_PATH", "PATH", "HISTSIZE", "HISTFILESIZE", "GLIBC_TUNABLES"})
 	eventMonitorBindEnvAndSetDefault(cfg, join(evNS, "runtime_compilation.enabled"), false)
 	eventMonitorBindEnv(cfg, join(evNS, "runtime_compilation.compiled_constants_enabled"))
@@ -24,9 +24,18 @@ func initCWSSystemProbeConfig(cfg pkgconfigmodel.Config) {
 	cfg.BindEnvAndSetDefault("runtime_security_config.policies.monitor.enabled", false)
 	cfg.BindEnvAndSetDefault("runtime_security_config.policies.monitor.per_rule_enabled", false)
 	cfg.BindEnvAndSetDefault("runtime_security_config.policies.monitor.report_internal_policies", false)
+	cfg.BindEnvAndSetDefault("runtime_security_config.policies.index.enabled", true)
+	cfg.BindEnvAndSetDefault("runtime_security_config.policies.digest.period", "24h")
+	cfg.BindEnvAndSetDefault("runtime_security_config.policies.digest.max_entries", 1000)
+	cfg.BindEnvAndSetDefault("runtime_security_config.policies.runaway.enabled", false)
+	cfg.BindEnvAndSetDefault("runtime_security_config.policies.runaway.max_rate", 1000)
+	cfg.BindEnvAndSetDefault("runtime_security_config.policies.runaway.period", "30s")
+	cfg.BindEnvAndSetDefault("runtime_security_config.policies.runaway.cooldown", "10m")
 	cfg.BindEnvAndSetDefault("runtime_security_config.event_server.burst", 40)
 	cfg.BindEnvAndSetDefault("runtime_security_config.event_server.retention", "6s")
 	cfg.BindEnvAndSetDefault("runtime_security_config.event_server.rate", 10)
+	cfg.BindEnvAndSetDefault("runtime_security_config.event_server.max_event_size", 256*1024)
+	cfg.BindEnvAndSetDefault("runtime_security_config.event_server.compact_ancestors", false)
 	cfg.BindEnvAndSetDefault("runtime_security_config.cookie_cache_size", 100)
 	cfg.BindEnvAndSetDefault("runtime_security_config.internal_monitoring.enabled", false)
 	cfg.BindEnvAndSetDefault("runtime_security_config.log_patterns", []string{})
@@ -81,6 +90,21 @@ func initCWSSystemProbeConfig(cfg pkgconfigmodel.Config) {
 	cfg.BindEnvAndSetDefault("runtime_security_config.sbom.workloads_cache_size", 10)
 	cfg.BindEnvAndSetDefault("runtime_security_config.sbom.host.enabled", false)
 
+	// CWS - Canary files
+	cfg.BindEnvAndSetDefault("runtime_security_config.canary_files.enabled", false)
+	cfg.BindEnvAndSetDefault("runtime_security_config.canary_files.paths", []string{"/root/.aws/credentials.bak", "/root/.ssh/id_rsa.bak", "/var/backups/shadow.bak"})
+
+	// CWS - GPU access detection
+	cfg.BindEnvAndSetDefault("runtime_security_config.gpu_access_detection.enabled", false)
+	cfg.BindEnvAndSetDefault("runtime_security_config.gpu_access_detection.allowed_images", []string{})
+
+	// CWS - Container escape detection
+	cfg.BindEnvAndSetDefault("runtime_security_config.container_escape.enabled", false)
+	cfg.BindEnvAndSetDefault("runtime_security_config.container_escape.nsenter.enabled", true)
+	cfg.BindEnvAndSetDefault("runtime_security_config.container_escape.core_pattern.enabled", true)
+	cfg.BindEnvAndSetDefault("runtime_security_config.container_escape.release_agent.enabled", true)
+	cfg.BindEnvAndSetDefault("runtime_security_config.container_escape.proc_sys_kernel.enabled", true)
+
 	// CWS - Security Profiles
 	cfg.BindEnvAndSetDefault("runtime_security_config.security_profile.enabled", true)
 	cfg.BindEnvAndSetDefault("runtime_security_config.security_profile.max_image_tags", 20)
@@ -108,6 +132,13 @@ func initCWSSystemProbeConfig(cfg pkgconfigmodel.Config) {
 	cfg.BindEnvAndSetDefault("runtime_security_config.security_profile.anomaly_detection.tag_rules.enabled", true)
 	cfg.BindEnvAndSetDefault("runtime_security_config.security_profile.anomaly_detection.silent_rule_events.enabled", false)
 	cfg.BindEnvAndSetDefault("runtime_security_config.security_profile.anomaly_detection.enabled", true)
+	cfg.BindEnvAndSetDefault("runtime_security_config.security_profile.anomaly_detection.suppression_window.default_duration", "15m")
+	cfg.BindEnvAndSetDefault("runtime_security_config.security_profile.anomaly_detection.suppression_window.max_duration", "2h")
+	cfg.BindEnvAndSetDefault("runtime_security_config.security_profile.anomaly_detection.auto_activity_dump.enabled", false)
+	cfg.BindEnvAndSetDefault("runtime_security_config.security_profile.anomaly_detection.auto_activity_dump.anomaly_threshold", 3)
+	cfg.BindEnvAndSetDefault("runtime_security_config.security_profile.anomaly_detection.auto_activity_dump.anomaly_window", "1h")
+	cfg.BindEnvAndSetDefault("runtime_security_config.security_profile.anomaly_detection.auto_activity_dump.duration", "5m")
+	cfg.BindEnvAndSetDefault("runtime_security_config.security_profile.anomaly_detection.auto_activity_dump.max_dumps_per_day", 5)
 
 	// CWS - Hash algorithms
 	cfg.BindEnvAndSetDefault("runtime_security_config.hash_resolver.enabled", true)
@@ -119,15 +150,37 @@ func initCWSSystemProbeConfig(cfg pkgconfigmodel.Config) {
 	cfg.BindEnvAndSetDefault("runtime_security_config.hash_resolver.cache_size", 500)
 	cfg.BindEnvAndSetDefault("runtime_security_config.hash_resolver.replace", map[string]string{})
 
+	// CWS - Process resolver procfs fallback
+	cfg.BindEnvAndSetDefault("runtime_security_config.process_resolver.procfs_fallback.max_resolutions", 1)
+	cfg.BindEnvAndSetDefault("runtime_security_config.process_resolver.procfs_fallback.period", "30s")
+	cfg.BindEnvAndSetDefault("runtime_security_config.process_resolver.procfs_fallback.overrides", map[string]string{})
+
+	// CWS - Event types matrix
+	cfg.BindEnvAndSetDefault("runtime_security_config.event_types", map[string]bool{})
+
 	// CWS - UserSessions
 	cfg.BindEnvAndSetDefault("runtime_security_config.user_sessions.cache_size", 1024)
 
+	// CWS - CGroup usage
+	cfg.BindEnvAndSetDefault("runtime_security_config.cgroup_usage.enabled", false)
+
+	// CWS - User and group resolution
+	cfg.BindEnvAndSetDefault("runtime_security_config.user_group.nss.enabled", false)
+
+	// CWS - Container metadata
+	cfg.BindEnvAndSetDefault("runtime_security_config.container_metadata.enabled", false)
+
+	// CWS - Path anonymization
+	cfg.BindEnvAndSetDefault("runtime_security_config.path_anonymization.enabled", false)
+	cfg.BindEnvAndSetDefault("runtime_security_config.path_anonymization.patterns", []string{`^/home/([^/]+)`, `^/run/user/([^/]+)`})
+
 	// CWS -eBPF Less
 	cfg.BindEnvAndSetDefault("runtime_security_config.ebpfless.enabled", false)
 	cfg.BindEnvAndSetDefault("runtime_security_config.ebpfless.socket", constants.DefaultEBPFLessProbeAddr)
 
 	// CWS - IMDS
 	cfg.BindEnvAndSetDefault("runtime_security_config.imds_ipv4", "169.254.169.254")
+	cfg.BindEnvAndSetDefault("runtime_security_config.imds_cross_container_credentials.enabled", false)
 
 	// CWS enforcement capabilities
 	cfg.BindEnvAndSetDefault("runtime_security_config.enforcement.enabled", true)
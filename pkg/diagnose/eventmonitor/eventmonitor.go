@@ -0,0 +1,78 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package eventmonitor provides a diagnose suite for the event monitoring module of system-probe
+package eventmonitor
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/DataDog/datadog-agent/cmd/system-probe/api/client"
+	pkgconfigsetup "github.com/DataDog/datadog-agent/pkg/config/setup"
+	"github.com/DataDog/datadog-agent/pkg/diagnose/diagnosis"
+)
+
+const diagnoseURL = "http://localhost/event_monitor/diagnose"
+
+// Diagnose returns the diagnoses reported by the event monitoring module of system-probe. Nothing is reported when
+// the module isn't running.
+func Diagnose() []diagnosis.Diagnosis {
+	hc := client.Get(pkgconfigsetup.SystemProbe().GetString("system_probe_config.sysprobe_socket"))
+	return diagnose(hc, diagnoseURL, pkgconfigsetup.SystemProbe().GetBool("runtime_security_config.enabled"))
+}
+
+func diagnose(hc *http.Client, url string, expected bool) []diagnosis.Diagnosis {
+	diagnoses, found, err := fetchDiagnoses(hc, url)
+	if err != nil {
+		// system-probe may not be running at all, only report it when the module is expected to run
+		if !expected {
+			return nil
+		}
+
+		return []diagnosis.Diagnosis{{
+			Name:        "Event monitoring module",
+			Result:      diagnosis.DiagnosisUnexpectedError,
+			Diagnosis:   "Unable to query the event monitoring module of system-probe",
+			Remediation: "Check that system-probe is running",
+			RawError:    err.Error(),
+		}}
+	}
+
+	if !found && expected {
+		return []diagnosis.Diagnosis{{
+			Name:        "Event monitoring module",
+			Result:      diagnosis.DiagnosisFail,
+			Diagnosis:   "The event monitoring module isn't running in system-probe",
+			Remediation: "Check the system-probe logs for module loading errors",
+		}}
+	}
+
+	return diagnoses
+}
+
+func fetchDiagnoses(hc *http.Client, url string) ([]diagnosis.Diagnosis, bool, error) {
+	resp, err := hc.Get(url)
+	if err != nil {
+		return nil, false, err
+	}
+	defer resp.Body.Close()
+
+	// the routes of the module are only registered when it is enabled
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, false, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, false, fmt.Errorf("got non-success status code: url: %s, status_code: %d", url, resp.StatusCode)
+	}
+
+	var diagnoses []diagnosis.Diagnosis
+	if err := json.NewDecoder(resp.Body).Decode(&diagnoses); err != nil {
+		return nil, false, err
+	}
+	return diagnoses, true, nil
+}
@@ -0,0 +1,58 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package eventmonitor
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/DataDog/datadog-agent/pkg/diagnose/diagnosis"
+)
+
+func TestDiagnose(t *testing.T) {
+	expected := []diagnosis.Diagnosis{{
+		Name:      "Lost events",
+		Category:  "event-monitoring",
+		Result:    diagnosis.DiagnosisWarning,
+		Diagnosis: "Lost events: 5.00% (5 out of 100), threshold is 1.00%",
+	}}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/event_monitor/diagnose", func(w http.ResponseWriter, _ *http.Request) {
+		require.NoError(t, json.NewEncoder(w).Encode(expected))
+	})
+	mux.HandleFunc("/event_monitor/broken", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	t.Run("module running", func(t *testing.T) {
+		assert.Equal(t, expected, diagnose(server.Client(), server.URL+"/event_monitor/diagnose", true))
+	})
+
+	t.Run("module not running", func(t *testing.T) {
+		assert.Empty(t, diagnose(server.Client(), server.URL+"/unknown", false))
+
+		diagnoses := diagnose(server.Client(), server.URL+"/unknown", true)
+		require.Len(t, diagnoses, 1)
+		assert.Equal(t, diagnosis.DiagnosisFail, diagnoses[0].Result)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		assert.Empty(t, diagnose(server.Client(), server.URL+"/event_monitor/broken", false))
+
+		diagnoses := diagnose(server.Client(), server.URL+"/event_monitor/broken", true)
+		require.Len(t, diagnoses, 1)
+		assert.Equal(t, diagnosis.Result(diagnosis.DiagnosisUnexpectedError), diagnoses[0].Result)
+		assert.NotEmpty(t, diagnoses[0].RawError)
+	})
+}
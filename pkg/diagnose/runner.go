@@ -31,6 +31,7 @@ import (
 	pkgconfigsetup "github.com/DataDog/datadog-agent/pkg/config/setup"
 	"github.com/DataDog/datadog-agent/pkg/diagnose/connectivity"
 	"github.com/DataDog/datadog-agent/pkg/diagnose/diagnosis"
+	"github.com/DataDog/datadog-agent/pkg/diagnose/eventmonitor"
 	"github.com/DataDog/datadog-agent/pkg/diagnose/ports"
 )
 
@@ -492,6 +493,7 @@ func buildSuites(diagCfg diagnosis.Config, checkDatadog func() []diagnosis.Diagn
 		RegisterConnectivityAutodiscovery,
 		RegisterConnectivityDatadogEventPlatform,
 		RegisterPortConflict,
+		RegisterEventMonitoring,
 	)
 }
 
@@ -534,3 +536,11 @@ func RegisterPortConflict(catalog *diagnosis.Catalog) {
 		catalog.Register("port-conflict", func() []diagnosis.Diagnosis { return ports.DiagnosePortSuite() })
 	}
 }
+
+// RegisterEventMonitoring registers the event-monitoring diagnose suite.
+func RegisterEventMonitoring(catalog *diagnosis.Catalog) {
+	// event-monitoring suite available in linux only for now
+	if runtime.GOOS == "linux" {
+		catalog.Register("event-monitoring", eventmonitor.Diagnose)
+	}
+}
@@ -29,6 +29,19 @@ type Config struct {
 	ProcessConsumerEnabled bool
 
 	EnvVarsResolutionEnabled bool
+
+	// ProfilingEndpointsEnabled defines if the profiling endpoints of the module are exposed
+	ProfilingEndpointsEnabled bool
+
+	// ProfilingMutexFraction defines the rate of mutex contention events reported in the mutex profile
+	ProfilingMutexFraction int
+
+	// DiagnoseLostEventsThreshold defines the ratio of lost events above which the module is reported as unhealthy
+	DiagnoseLostEventsThreshold float64
+
+	// DiagnoseProcessResolverMissThreshold defines the ratio of process resolution misses above which the module is
+	// reported as unhealthy
+	DiagnoseProcessResolverMissThreshold float64
 }
 
 // NewConfig creates a config for the event monitoring module
@@ -43,6 +56,14 @@ func NewConfig() *Config {
 
 		// options
 		EnvVarsResolutionEnabled: pkgconfigsetup.SystemProbe().GetBool(sysconfig.FullKeyPath(evNS, "env_vars_resolution.enabled")),
+
+		// debug
+		ProfilingEndpointsEnabled: pkgconfigsetup.SystemProbe().GetBool(sysconfig.FullKeyPath(evNS, "profiling_endpoints.enabled")),
+		ProfilingMutexFraction:    pkgconfigsetup.SystemProbe().GetInt(sysconfig.FullKeyPath(evNS, "profiling_endpoints.mutex_profile_fraction")),
+
+		// diagnose
+		DiagnoseLostEventsThreshold:          pkgconfigsetup.SystemProbe().GetFloat64(sysconfig.FullKeyPath(evNS, "diagnose.lost_events_threshold")),
+		DiagnoseProcessResolverMissThreshold: pkgconfigsetup.SystemProbe().GetFloat64(sysconfig.FullKeyPath(evNS, "diagnose.process_resolver_miss_threshold")),
 	}
 }
 
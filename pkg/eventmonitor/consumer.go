@@ -6,7 +6,14 @@
 // Package eventmonitor holds eventmonitor related files
 package eventmonitor
 
-import "github.com/DataDog/datadog-agent/pkg/security/probe"
+import (
+	"github.com/DataDog/datadog-agent/cmd/system-probe/api/module"
+	"github.com/DataDog/datadog-agent/pkg/diagnose/diagnosis"
+	"github.com/DataDog/datadog-agent/pkg/security/probe"
+)
+
+// DiagnoseCategory is the category of the diagnoses reported by the event monitoring module
+const DiagnoseCategory = "event-monitoring"
 
 // EventConsumerHandler provides an interface for event consumer handlers
 type EventConsumerHandler interface {
@@ -29,3 +36,15 @@ type EventConsumerPostProbeStartHandler interface {
 	// PostProbeStart is called after the event stream (the probe) is started
 	PostProbeStart() error
 }
+
+// EventConsumerHTTPHandler defines an event consumer exposing endpoints on the router of the module
+type EventConsumerHTTPHandler interface {
+	// RegisterHTTPHandlers registers the endpoints of the event consumer
+	RegisterHTTPHandlers(httpMux *module.Router)
+}
+
+// EventConsumerDiagnoseHandler defines an event consumer that can report diagnoses about its health
+type EventConsumerDiagnoseHandler interface {
+	// Diagnose returns the diagnoses of the event consumer, reported by the agent diagnose command
+	Diagnose() []diagnosis.Diagnosis
+}
@@ -6,4 +6,5 @@
 // Package consumers contains consumers that can be readily used by other packages without
 // having to implement the EventConsumerHandler interface manually:
 // - ProcessConsumer (process.go): a consumer of process exec/exit events that can be subscribed to via callbacks
+// - ProcessLookup (process_lookup.go): a lookup of the process attributes cached by the event monitor
 package consumers
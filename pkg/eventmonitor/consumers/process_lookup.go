@@ -0,0 +1,52 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2024-present Datadog, Inc.
+
+//go:build linux
+
+package consumers
+
+import (
+	"sync/atomic"
+
+	"github.com/DataDog/datadog-agent/pkg/eventmonitor"
+	"github.com/DataDog/datadog-agent/pkg/security/probe"
+	"github.com/DataDog/datadog-agent/pkg/security/resolvers/process"
+)
+
+// ProcessInfo holds the attributes of a process resolved from the event monitor process cache
+type ProcessInfo = process.Info
+
+// ProcessLookup resolves the attributes of processes from the event monitor process cache, so that other
+// system-probe modules don't have to scrape procfs themselves
+type ProcessLookup interface {
+	// LookupProcess returns the attributes of the provided pid, and false if the pid isn't in the cache
+	LookupProcess(pid uint32) (ProcessInfo, bool)
+}
+
+var processLookup atomic.Pointer[ProcessLookup]
+
+// RegisterProcessLookup makes the process cache of the event monitor available through GetProcessLookup. This
+// function should be called with the EventMonitor instance created in
+// cmd/system-probe/modules/eventmonitor.go:createEventMonitorModule.
+func RegisterProcessLookup(evm *eventmonitor.EventMonitor) {
+	if evm.Probe == nil {
+		return
+	}
+
+	// the process cache is only available with the eBPF probe
+	if lookup, ok := evm.Probe.PlatformProbe.(*probe.EBPFProbe); ok {
+		var l ProcessLookup = lookup
+		processLookup.Store(&l)
+	}
+}
+
+// GetProcessLookup returns the process lookup of the event monitor, or nil if event monitoring isn't enabled. The
+// event monitor module may be loaded after the caller, the lookup should be retrieved when needed rather than once.
+func GetProcessLookup() ProcessLookup {
+	if l := processLookup.Load(); l != nil {
+		return *l
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux || windows
+
+package eventmonitor
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/DataDog/datadog-agent/cmd/system-probe/api/module"
+	"github.com/DataDog/datadog-agent/pkg/diagnose/diagnosis"
+	"github.com/DataDog/datadog-agent/pkg/security/probe"
+	"github.com/DataDog/datadog-agent/pkg/util/log"
+)
+
+// registerDiagnoseEndpoint registers the endpoint queried by the agent diagnose command
+func (m *EventMonitor) registerDiagnoseEndpoint(httpMux *module.Router) {
+	httpMux.HandleFunc("/diagnose", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(m.Diagnose()); err != nil {
+			log.Errorf("unable to encode event monitoring diagnoses: %v", err)
+		}
+	})
+}
+
+// Diagnose returns the diagnoses of the module and of its event consumers
+func (m *EventMonitor) Diagnose() []diagnosis.Diagnosis {
+	diagnoses := []diagnosis.Diagnosis{m.diagnoseSnapshot()}
+
+	if report := m.Probe.GetHealthReport(); report != nil {
+		diagnoses = append(diagnoses, diagnoseHealthReport(report, m.Config.DiagnoseLostEventsThreshold, m.Config.DiagnoseProcessResolverMissThreshold)...)
+	}
+
+	for _, em := range m.eventConsumers {
+		if dh, ok := em.(EventConsumerDiagnoseHandler); ok {
+			diagnoses = append(diagnoses, dh.Diagnose()...)
+		}
+	}
+
+	return diagnoses
+}
+
+func (m *EventMonitor) diagnoseSnapshot() diagnosis.Diagnosis {
+	d := diagnosis.Diagnosis{
+		Name:        "Snapshot",
+		Category:    DiagnoseCategory,
+		Description: "Checks that the state of the system was collected when the module started",
+	}
+
+	if m.snapshotDone.Load() {
+		d.Result = diagnosis.DiagnosisSuccess
+		d.Diagnosis = "The snapshot of the running processes and mount points completed"
+	} else {
+		d.Result = diagnosis.DiagnosisFail
+		d.Diagnosis = "The snapshot of the running processes and mount points didn't complete, events of processes started before the module may lack context"
+		d.Remediation = "Check the system-probe logs for snapshot errors"
+	}
+
+	return d
+}
+
+func diagnoseHealthReport(report *probe.HealthReport, lostEventsThreshold float64, missThreshold float64) []diagnosis.Diagnosis {
+	maps := diagnosis.Diagnosis{
+		Name:        "Kernel maps",
+		Category:    DiagnoseCategory,
+		Description: "Checks that the kernel maps required to collect events are loaded",
+	}
+	if len(report.MissingMaps) == 0 {
+		maps.Result = diagnosis.DiagnosisSuccess
+		maps.Diagnosis = "All the required kernel maps are loaded"
+	} else {
+		maps.Result = diagnosis.DiagnosisFail
+		maps.Diagnosis = fmt.Sprintf("Missing kernel maps: %s", strings.Join(report.MissingMaps, ", "))
+		maps.Remediation = "Check the system-probe logs for eBPF loading errors"
+	}
+
+	lost := diagnoseRatio(
+		"Lost events",
+		"Checks the ratio of events lost by the kernel because the event stream was full",
+		"Lost events",
+		float64(report.LostEvents), float64(report.KernelEvents+report.LostEvents), lostEventsThreshold,
+	)
+	if lost.Result != diagnosis.DiagnosisSuccess {
+		lost.Remediation = "Increase the size of the event stream or reduce the volume of monitored events"
+	}
+	if report.RecommendedEventStreamBufferSize > 0 {
+		if lost.Result == diagnosis.DiagnosisSuccess {
+			lost.Result = diagnosis.DiagnosisWarning
+		}
+		lost.Remediation = fmt.Sprintf("Events were lost during several consecutive intervals, set event_monitoring_config.event_stream.buffer_size to %d", report.RecommendedEventStreamBufferSize)
+	}
+
+	miss := diagnoseRatio(
+		"Process resolution",
+		"Checks the ratio of events whose process couldn't be resolved",
+		"Process resolution misses",
+		float64(report.ProcessResolutionMisses), float64(report.ProcessResolutionHits+report.ProcessResolutionMisses), missThreshold,
+	)
+	if miss.Result != diagnosis.DiagnosisSuccess {
+		miss.Remediation = "Check that the events aren't lost and that /proc is accessible to system-probe"
+	}
+
+	return []diagnosis.Diagnosis{maps, lost, miss}
+}
+
+// diagnoseRatio reports a warning when the ratio of count over total exceeds the given threshold
+func diagnoseRatio(name string, description string, label string, count float64, total float64, threshold float64) diagnosis.Diagnosis {
+	d := diagnosis.Diagnosis{
+		Name:        name,
+		Category:    DiagnoseCategory,
+		Description: description,
+		Result:      diagnosis.DiagnosisSuccess,
+	}
+
+	var ratio float64
+	if total > 0 {
+		ratio = count / total
+	}
+
+	d.Diagnosis = fmt.Sprintf("%s: %.2f%% (%.0f out of %.0f), threshold is %.2f%%", label, ratio*100, count, total, threshold*100)
+	if ratio > threshold {
+		d.Result = diagnosis.DiagnosisWarning
+	}
+
+	return d
+}
@@ -16,6 +16,7 @@ import (
 	"time"
 
 	"github.com/DataDog/datadog-go/v5/statsd"
+	"go.uber.org/atomic"
 	"google.golang.org/grpc"
 
 	"github.com/DataDog/datadog-agent/cmd/system-probe/api/module"
@@ -54,17 +55,31 @@ type EventMonitor struct {
 	cancelFnc      context.CancelFunc
 	sendStatsChan  chan chan bool
 	eventConsumers []EventConsumer
+	snapshotDone   atomic.Bool
 	wg             sync.WaitGroup
 }
 
 var _ module.Module = &EventMonitor{}
 
 // Register the event monitoring module
-func (m *EventMonitor) Register(_ *module.Router) error {
+func (m *EventMonitor) Register(httpMux *module.Router) error {
 	if err := m.Init(); err != nil {
 		return err
 	}
 
+	m.registerDiagnoseEndpoint(httpMux)
+	m.registerProcessTreeEndpoint(httpMux)
+
+	for _, em := range m.eventConsumers {
+		if hh, ok := em.(EventConsumerHTTPHandler); ok {
+			hh.RegisterHTTPHandlers(httpMux)
+		}
+	}
+
+	if m.Config.ProfilingEndpointsEnabled {
+		m.registerProfilingEndpoints(httpMux)
+	}
+
 	return m.Start()
 }
 
@@ -125,6 +140,7 @@ func (m *EventMonitor) Start() error {
 	if err := m.Probe.Snapshot(); err != nil {
 		return err
 	}
+	m.snapshotDone.Store(true)
 
 	// start event consumers
 	for _, em := range m.eventConsumers {
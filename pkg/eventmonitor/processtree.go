@@ -0,0 +1,26 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux || windows
+
+package eventmonitor
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/DataDog/datadog-agent/cmd/system-probe/api/module"
+	"github.com/DataDog/datadog-agent/pkg/util/log"
+)
+
+// registerProcessTreeEndpoint registers the endpoint returning the live process tree, rendered by the agent status
+func (m *EventMonitor) registerProcessTreeEndpoint(httpMux *module.Router) {
+	httpMux.HandleFunc("/process_tree", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(m.Probe.GetProcessTree()); err != nil {
+			log.Errorf("unable to encode the process tree: %v", err)
+		}
+	}).Methods(http.MethodGet)
+}
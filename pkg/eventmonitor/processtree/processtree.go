@@ -0,0 +1,126 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package processtree holds the compact live process tree exposed by the event monitoring module
+package processtree
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+)
+
+const (
+	// shortContainerIDLen is the length of the container IDs when rendered
+	shortContainerIDLen = 12
+	// MaxProcesses is the maximal number of processes of the tree, bounding the size of the status output on the hosts
+	// running many processes
+	MaxProcesses = 1000
+)
+
+// Process holds the attributes of a process used to build the tree
+type Process struct {
+	Pid         uint32
+	PPid        uint32
+	Comm        string
+	ContainerID string
+}
+
+// Node is a process of the tree
+type Node struct {
+	Pid  uint32 `json:"pid"`
+	Comm string `json:"comm"`
+	// ContainerID is only set on the processes whose container differs from the one of their parent, so that the
+	// container boundaries stand out without repeating the ID on every process
+	ContainerID string  `json:"container_id,omitempty"`
+	Children    []*Node `json:"children,omitempty"`
+}
+
+// Tree is the live process tree
+type Tree struct {
+	Roots []*Node `json:"roots"`
+	// Dropped is the number of processes left out of the tree once it reached MaxProcesses
+	Dropped int `json:"dropped,omitempty"`
+}
+
+// Build returns the tree of the provided processes. The processes whose parent isn't part of the provided ones are
+// roots. Only the MaxProcesses processes with the lowest PIDs, usually the longest running ones, are kept.
+func Build(processes []Process) *Tree {
+	tree := &Tree{}
+	if len(processes) > MaxProcesses {
+		processes = slices.Clone(processes)
+		slices.SortFunc(processes, func(a, b Process) int {
+			return cmp.Compare(a.Pid, b.Pid)
+		})
+		tree.Dropped = len(processes) - MaxProcesses
+		processes = processes[:MaxProcesses]
+	}
+
+	nodes := make(map[uint32]*Node, len(processes))
+	for _, p := range processes {
+		nodes[p.Pid] = &Node{Pid: p.Pid, Comm: p.Comm}
+	}
+
+	containers := make(map[uint32]string, len(processes))
+	for _, p := range processes {
+		containers[p.Pid] = p.ContainerID
+	}
+
+	for _, p := range processes {
+		node := nodes[p.Pid]
+
+		parent, found := nodes[p.PPid]
+		if !found || p.PPid == p.Pid {
+			node.ContainerID = p.ContainerID
+			tree.Roots = append(tree.Roots, node)
+			continue
+		}
+
+		if p.ContainerID != containers[p.PPid] {
+			node.ContainerID = p.ContainerID
+		}
+		parent.Children = append(parent.Children, node)
+	}
+
+	sortNodes(tree.Roots)
+	return tree
+}
+
+func sortNodes(nodes []*Node) {
+	slices.SortFunc(nodes, func(a, b *Node) int {
+		return cmp.Compare(a.Pid, b.Pid)
+	})
+	for _, node := range nodes {
+		sortNodes(node.Children)
+	}
+}
+
+// Render writes an indented text rendering of the tree, one process per line
+func Render(w io.Writer, tree *Tree, indent string) {
+	for _, root := range tree.Roots {
+		render(w, root, indent, 0)
+	}
+	if tree.Dropped > 0 {
+		fmt.Fprintf(w, "%s... %d more processes\n", indent, tree.Dropped)
+	}
+}
+
+func render(w io.Writer, node *Node, indent string, depth int) {
+	fmt.Fprintf(w, "%s%s%d %s", indent, strings.Repeat("  ", depth), node.Pid, node.Comm)
+	if node.ContainerID != "" {
+		containerID := node.ContainerID
+		if len(containerID) > shortContainerIDLen {
+			containerID = containerID[:shortContainerIDLen]
+		}
+		fmt.Fprintf(w, " [container %s]", containerID)
+	}
+	fmt.Fprintln(w)
+
+	for _, child := range node.Children {
+		render(w, child, indent, depth+1)
+	}
+}
@@ -0,0 +1,57 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package processtree
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProcessTree(t *testing.T) {
+	tree := Build([]Process{
+		{Pid: 20, PPid: 10, Comm: "nginx", ContainerID: "0123456789abcdef"},
+		{Pid: 1, PPid: 0, Comm: "systemd"},
+		{Pid: 21, PPid: 20, Comm: "nginx", ContainerID: "0123456789abcdef"},
+		{Pid: 10, PPid: 1, Comm: "containerd-shim"},
+		{Pid: 5, PPid: 1, Comm: "sshd"},
+		{Pid: 42, PPid: 99, Comm: "orphan", ContainerID: "fedcba"},
+	})
+
+	assert.Len(t, tree.Roots, 2)
+	assert.Zero(t, tree.Dropped)
+	assert.Equal(t, uint32(1), tree.Roots[0].Pid)
+	assert.Equal(t, uint32(42), tree.Roots[1].Pid)
+	assert.Equal(t, "fedcba", tree.Roots[1].ContainerID)
+
+	var rendered strings.Builder
+	Render(&rendered, tree, "> ")
+	assert.Equal(t, `> 1 systemd
+>   5 sshd
+>   10 containerd-shim
+>     20 nginx [container 0123456789ab]
+>       21 nginx
+> 42 orphan [container fedcba]
+`, rendered.String())
+}
+
+func TestProcessTreeMaxProcesses(t *testing.T) {
+	processes := []Process{{Pid: 1, Comm: "systemd"}}
+	for pid := uint32(MaxProcesses + 10); pid > 1; pid-- {
+		processes = append(processes, Process{Pid: pid, PPid: 1, Comm: "worker"})
+	}
+
+	tree := Build(processes)
+	assert.Len(t, tree.Roots, 1)
+	assert.Len(t, tree.Roots[0].Children, MaxProcesses-1)
+	assert.Equal(t, uint32(MaxProcesses), tree.Roots[0].Children[MaxProcesses-2].Pid)
+	assert.Equal(t, 10, tree.Dropped)
+
+	var rendered strings.Builder
+	Render(&rendered, tree, "")
+	assert.True(t, strings.HasSuffix(rendered.String(), "\n... 10 more processes\n"))
+}
@@ -0,0 +1,182 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux || windows
+
+package eventmonitor
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"runtime"
+	"runtime/pprof"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/pprof/profile"
+
+	"github.com/DataDog/datadog-agent/cmd/system-probe/api/module"
+	sysconfig "github.com/DataDog/datadog-agent/cmd/system-probe/config"
+	"github.com/DataDog/datadog-agent/pkg/util/log"
+)
+
+const (
+	// moduleLabel is the pprof label set by the system-probe module loader on the goroutines of each module
+	moduleLabel = "module"
+
+	resolversPackagePrefix = "github.com/DataDog/datadog-agent/pkg/security/resolvers/"
+
+	defaultCPUProfileDuration = 30 * time.Second
+	maxCPUProfileDuration     = 5 * time.Minute
+)
+
+// registerProfilingEndpoints registers the profiling endpoints of the module. CPU and goroutine profiles
+// only contain the samples of the event monitoring module, heap and mutex profiles can't be scoped
+// to a module and are reported for the whole system-probe process.
+func (m *EventMonitor) registerProfilingEndpoints(httpMux *module.Router) {
+	if m.Config.ProfilingMutexFraction > 0 {
+		runtime.SetMutexProfileFraction(m.Config.ProfilingMutexFraction)
+	}
+
+	httpMux.HandleFunc("/debug/pprof/heap", func(w http.ResponseWriter, _ *http.Request) {
+		writeProfile(w, "heap")
+	})
+	httpMux.HandleFunc("/debug/pprof/mutex", func(w http.ResponseWriter, _ *http.Request) {
+		writeProfile(w, "mutex")
+	})
+	httpMux.HandleFunc("/debug/pprof/goroutine", func(w http.ResponseWriter, _ *http.Request) {
+		var buf bytes.Buffer
+		if err := pprof.Lookup("goroutine").WriteTo(&buf, 0); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		writeModuleProfile(w, &buf)
+	})
+	httpMux.HandleFunc("/debug/pprof/profile", m.handleCPUProfile)
+	httpMux.HandleFunc("/debug/resolvers/goroutines", func(w http.ResponseWriter, _ *http.Request) {
+		var buf bytes.Buffer
+		if err := pprof.Lookup("goroutine").WriteTo(&buf, 0); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		p, err := profile.Parse(&buf)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(resolverGoroutineCounts(p)); err != nil {
+			log.Errorf("unable to encode resolver goroutine stats: %v", err)
+		}
+	})
+}
+
+func (m *EventMonitor) handleCPUProfile(w http.ResponseWriter, req *http.Request) {
+	duration := defaultCPUProfileDuration
+	if s := req.FormValue("seconds"); s != "" {
+		seconds, err := strconv.Atoi(s)
+		if err != nil || seconds <= 0 {
+			http.Error(w, "invalid seconds parameter", http.StatusBadRequest)
+			return
+		}
+		duration = min(time.Duration(seconds)*time.Second, maxCPUProfileDuration)
+	}
+
+	var buf bytes.Buffer
+	if err := pprof.StartCPUProfile(&buf); err != nil {
+		// another CPU profile is already running
+		http.Error(w, fmt.Sprintf("unable to start CPU profile: %v", err), http.StatusConflict)
+		return
+	}
+
+	select {
+	case <-time.After(duration):
+	case <-req.Context().Done():
+	case <-m.ctx.Done():
+	}
+	pprof.StopCPUProfile()
+
+	writeModuleProfile(w, &buf)
+}
+
+func writeProfile(w http.ResponseWriter, name string) {
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, name))
+	if err := pprof.Lookup(name).WriteTo(w, 0); err != nil {
+		log.Errorf("unable to write %s profile: %v", name, err)
+	}
+}
+
+// writeModuleProfile writes the samples of the given profile that belong to the event monitoring module
+func writeModuleProfile(w http.ResponseWriter, r io.Reader) {
+	p, err := profile.Parse(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	filterProfileByModule(p, string(sysconfig.EventMonitorModule))
+
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("Content-Disposition", `attachment; filename="profile"`)
+	if err := p.Write(w); err != nil {
+		log.Errorf("unable to write profile: %v", err)
+	}
+}
+
+// filterProfileByModule removes the samples that don't belong to the given module
+func filterProfileByModule(p *profile.Profile, moduleName string) {
+	p.Sample = slices.DeleteFunc(p.Sample, func(s *profile.Sample) bool {
+		return !slices.Contains(s.Label[moduleLabel], moduleName)
+	})
+}
+
+// resolverGoroutineCounts returns the number of goroutines of each resolver. A goroutine is attributed to the
+// innermost resolver found in its stack.
+func resolverGoroutineCounts(p *profile.Profile) map[string]int64 {
+	counts := make(map[string]int64)
+
+	for _, s := range p.Sample {
+		if len(s.Value) == 0 {
+			continue
+		}
+
+		if resolver := sampleResolver(s); resolver != "" {
+			counts[resolver] += s.Value[0]
+		}
+	}
+
+	return counts
+}
+
+func sampleResolver(s *profile.Sample) string {
+	// locations are ordered from the leaf to the root of the stack
+	for _, loc := range s.Location {
+		for _, line := range loc.Line {
+			if line.Function == nil {
+				continue
+			}
+
+			name, found := strings.CutPrefix(line.Function.Name, resolversPackagePrefix)
+			if !found {
+				continue
+			}
+
+			// keep the resolver package name, e.g. process from process.(*EBPFResolver).Start
+			if i := strings.IndexAny(name, "./"); i > 0 {
+				name = name[:i]
+			}
+			return name
+		}
+	}
+	return ""
+}
@@ -72,6 +72,12 @@ type Config struct {
 	// These stats objects get flushed on every client request (default 30s check interval)
 	MaxDNSStats int
 
+	// DNSStatsByProcess specifies whether DNS stats should also be aggregated by process
+	DNSStatsByProcess bool
+
+	// DNSTopDomainsPerProcess determines the number of most queried domains reported for each process
+	DNSTopDomainsPerProcess int
+
 	// EnableHTTPMonitoring specifies whether the tracer should monitor HTTP traffic
 	EnableHTTPMonitoring bool
 
@@ -328,6 +334,9 @@ func New() *Config {
 		MaxDNSStatsBuffered: 75000,
 		DNSTimeout:          time.Duration(cfg.GetInt(sysconfig.FullKeyPath(spNS, "dns_timeout_in_s"))) * time.Second,
 
+		DNSStatsByProcess:       cfg.GetBool(sysconfig.FullKeyPath(netNS, "enable_dns_stats_by_process")),
+		DNSTopDomainsPerProcess: cfg.GetInt(sysconfig.FullKeyPath(netNS, "dns_top_domains_per_process")),
+
 		ProtocolClassificationEnabled: cfg.GetBool(sysconfig.FullKeyPath(netNS, "enable_protocol_classification")),
 
 		NPMRingbuffersEnabled: cfg.GetBool(sysconfig.FullKeyPath(netNS, "enable_ringbuffers")),
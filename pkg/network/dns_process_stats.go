@@ -0,0 +1,225 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package network
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/DataDog/datadog-agent/pkg/network/dns"
+)
+
+// The DNS stats by process are shipped in the connection telemetry of the connections payload, under keys of the form
+// dns_stats_by_pid.<pid>.<counter> and dns_stats_by_pid.<pid>.top_domain.<domain>. Only the processes issuing the most
+// queries are shipped, the counters of the others are summed under dns_stats_by_pid.other.<counter>.
+const (
+	dnsStatsByPIDTelemetryPrefix = "dns_stats_by_pid."
+	dnsStatsOtherProcessesKey    = "other"
+	dnsStatsQueriesKey           = "queries"
+	dnsStatsFailuresKey          = "failures"
+	dnsStatsTimeoutsKey          = "timeouts"
+	dnsStatsTopDomainKeyPrefix   = "top_domain."
+
+	// MaxDNSStatsByPIDProcesses is the maximum number of processes whose DNS stats are shipped individually
+	MaxDNSStatsByPIDProcesses = 100
+)
+
+// DomainQueryCount holds the number of DNS queries issued for a given domain
+type DomainQueryCount struct {
+	Domain string `json:"domain"`
+	Count  uint32 `json:"count"`
+}
+
+// ProcessDNSStats holds the DNS statistics of all the connections of a single process
+type ProcessDNSStats struct {
+	Queries    uint32             `json:"queries"`
+	Failures   uint32             `json:"failures"`
+	Timeouts   uint32             `json:"timeouts"`
+	TopDomains []DomainQueryCount `json:"top_domains,omitempty"`
+}
+
+// FailureRate returns the ratio of queries that either failed or timed out
+func (s *ProcessDNSStats) FailureRate() float64 {
+	if s == nil || s.Queries == 0 {
+		return 0
+	}
+	return float64(s.Failures+s.Timeouts) / float64(s.Queries)
+}
+
+// AggregateDNSStatsByPID aggregates the DNS stats attached to the given connections by PID.
+// At most topDomains domains, ordered by query count, are kept for each process.
+func AggregateDNSStatsByPID(conns []ConnectionStats, topDomains int) map[uint32]*ProcessDNSStats {
+	var (
+		stats   = make(map[uint32]*ProcessDNSStats)
+		domains = make(map[uint32]map[dns.Hostname]uint32)
+	)
+
+	for i := range conns {
+		c := &conns[i]
+		if len(c.DNSStats) == 0 {
+			continue
+		}
+
+		ps, ok := stats[c.Pid]
+		if !ok {
+			ps = &ProcessDNSStats{}
+			stats[c.Pid] = ps
+			domains[c.Pid] = make(map[dns.Hostname]uint32)
+		}
+
+		for domain, byType := range c.DNSStats {
+			for _, s := range byType {
+				var queries uint32
+				for rcode, count := range s.CountByRcode {
+					queries += count
+					if rcode != DNSResponseCodeNoError {
+						ps.Failures += count
+					}
+				}
+				queries += s.Timeouts
+				ps.Timeouts += s.Timeouts
+				ps.Queries += queries
+				domains[c.Pid][domain] += queries
+			}
+		}
+	}
+
+	if topDomains <= 0 {
+		return stats
+	}
+
+	for pid, ps := range stats {
+		ps.TopDomains = topDomainQueryCounts(domains[pid], topDomains)
+	}
+	return stats
+}
+
+func topDomainQueryCounts(domains map[dns.Hostname]uint32, n int) []DomainQueryCount {
+	counts := make([]DomainQueryCount, 0, len(domains))
+	for domain, count := range domains {
+		if domain == nil || count == 0 {
+			continue
+		}
+		counts = append(counts, DomainQueryCount{Domain: dns.ToString(domain), Count: count})
+	}
+
+	sortDomainQueryCounts(counts)
+
+	if len(counts) > n {
+		counts = counts[:n]
+	}
+	return counts
+}
+
+// DNSStatsByPIDToTelemetry flattens the DNS stats by process into connection telemetry entries. The stats of the
+// MaxDNSStatsByPIDProcesses processes issuing the most queries are kept, the counters of the others are aggregated.
+func DNSStatsByPIDToTelemetry(stats map[uint32]*ProcessDNSStats) map[string]int64 {
+	pids := make([]uint32, 0, len(stats))
+	for pid := range stats {
+		pids = append(pids, pid)
+	}
+	sort.Slice(pids, func(i, j int) bool {
+		if stats[pids[i]].Queries != stats[pids[j]].Queries {
+			return stats[pids[i]].Queries > stats[pids[j]].Queries
+		}
+		return pids[i] < pids[j]
+	})
+
+	tel := make(map[string]int64, min(len(pids), MaxDNSStatsByPIDProcesses+1)*3)
+	for _, pid := range pids[:min(len(pids), MaxDNSStatsByPIDProcesses)] {
+		ps := stats[pid]
+		prefix := dnsStatsByPIDTelemetryPrefix + strconv.FormatUint(uint64(pid), 10) + "."
+		addDNSStatsCounters(tel, prefix, ps)
+		for _, d := range ps.TopDomains {
+			tel[prefix+dnsStatsTopDomainKeyPrefix+d.Domain] = int64(d.Count)
+		}
+	}
+
+	if len(pids) > MaxDNSStatsByPIDProcesses {
+		var other ProcessDNSStats
+		for _, pid := range pids[MaxDNSStatsByPIDProcesses:] {
+			other.Queries += stats[pid].Queries
+			other.Failures += stats[pid].Failures
+			other.Timeouts += stats[pid].Timeouts
+		}
+		addDNSStatsCounters(tel, dnsStatsByPIDTelemetryPrefix+dnsStatsOtherProcessesKey+".", &other)
+	}
+	return tel
+}
+
+func addDNSStatsCounters(tel map[string]int64, prefix string, ps *ProcessDNSStats) {
+	tel[prefix+dnsStatsQueriesKey] = int64(ps.Queries)
+	tel[prefix+dnsStatsFailuresKey] = int64(ps.Failures)
+	tel[prefix+dnsStatsTimeoutsKey] = int64(ps.Timeouts)
+}
+
+// DNSStatsByPIDFromTelemetry rebuilds the DNS stats by process from the connection telemetry of a connections payload.
+// The top domains are ordered by query count. The aggregated counters of the processes that were not shipped
+// individually are returned separately, nil if there are none.
+func DNSStatsByPIDFromTelemetry(tel map[string]int64) (map[uint32]*ProcessDNSStats, *ProcessDNSStats) {
+	var (
+		stats = make(map[uint32]*ProcessDNSStats)
+		other *ProcessDNSStats
+	)
+	for key, value := range tel {
+		rest, found := strings.CutPrefix(key, dnsStatsByPIDTelemetryPrefix)
+		if !found {
+			continue
+		}
+
+		pidStr, counter, found := strings.Cut(rest, ".")
+		if !found {
+			continue
+		}
+
+		var ps *ProcessDNSStats
+		if pidStr == dnsStatsOtherProcessesKey {
+			if other == nil {
+				other = &ProcessDNSStats{}
+			}
+			ps = other
+		} else {
+			pid, err := strconv.ParseUint(pidStr, 10, 32)
+			if err != nil {
+				continue
+			}
+
+			var ok bool
+			if ps, ok = stats[uint32(pid)]; !ok {
+				ps = &ProcessDNSStats{}
+				stats[uint32(pid)] = ps
+			}
+		}
+
+		switch counter {
+		case dnsStatsQueriesKey:
+			ps.Queries = uint32(value)
+		case dnsStatsFailuresKey:
+			ps.Failures = uint32(value)
+		case dnsStatsTimeoutsKey:
+			ps.Timeouts = uint32(value)
+		default:
+			if domain, found := strings.CutPrefix(counter, dnsStatsTopDomainKeyPrefix); found && ps != other {
+				ps.TopDomains = append(ps.TopDomains, DomainQueryCount{Domain: domain, Count: uint32(value)})
+			}
+		}
+	}
+
+	for _, ps := range stats {
+		sortDomainQueryCounts(ps.TopDomains)
+	}
+	return stats, other
+}
+
+func sortDomainQueryCounts(counts []DomainQueryCount) {
+	sort.Slice(counts, func(i, j int) bool {
+		if counts[i].Count != counts[j].Count {
+			return counts[i].Count > counts[j].Count
+		}
+		return counts[i].Domain < counts[j].Domain
+	})
+}
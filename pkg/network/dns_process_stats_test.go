@@ -0,0 +1,107 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package network
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/DataDog/datadog-agent/pkg/network/dns"
+)
+
+func TestAggregateDNSStatsByPID(t *testing.T) {
+	var (
+		foo = dns.ToHostname("foo.com")
+		bar = dns.ToHostname("bar.com")
+		baz = dns.ToHostname("baz.com")
+	)
+
+	conns := []ConnectionStats{
+		{
+			ConnectionTuple: ConnectionTuple{Pid: 1, DPort: 53},
+			DNSStats: map[dns.Hostname]map[dns.QueryType]dns.Stats{
+				foo: {
+					dns.TypeA:    {CountByRcode: map[uint32]uint32{DNSResponseCodeNoError: 3}},
+					dns.TypeAAAA: {CountByRcode: map[uint32]uint32{DNSResponseCodeNoError: 1, 3: 1}},
+				},
+				bar: {
+					dns.TypeA: {CountByRcode: map[uint32]uint32{2: 2}, Timeouts: 1},
+				},
+			},
+		},
+		{
+			ConnectionTuple: ConnectionTuple{Pid: 1, DPort: 53},
+			DNSStats: map[dns.Hostname]map[dns.QueryType]dns.Stats{
+				baz: {
+					dns.TypeA: {CountByRcode: map[uint32]uint32{DNSResponseCodeNoError: 1}},
+				},
+			},
+		},
+		{
+			ConnectionTuple: ConnectionTuple{Pid: 2, DPort: 53},
+			DNSStats: map[dns.Hostname]map[dns.QueryType]dns.Stats{
+				foo: {
+					dns.TypeA: {CountByRcode: map[uint32]uint32{DNSResponseCodeNoError: 1}},
+				},
+			},
+		},
+		// connections without DNS stats are ignored
+		{ConnectionTuple: ConnectionTuple{Pid: 3, DPort: 443}},
+	}
+
+	stats := AggregateDNSStatsByPID(conns, 2)
+	require.Len(t, stats, 2)
+
+	p1 := stats[1]
+	require.NotNil(t, p1)
+	assert.Equal(t, uint32(9), p1.Queries)
+	assert.Equal(t, uint32(3), p1.Failures)
+	assert.Equal(t, uint32(1), p1.Timeouts)
+	assert.InDelta(t, 4.0/9.0, p1.FailureRate(), 0.0001)
+	assert.Equal(t, []DomainQueryCount{
+		{Domain: "foo.com", Count: 5},
+		{Domain: "bar.com", Count: 3},
+	}, p1.TopDomains)
+
+	p2 := stats[2]
+	require.NotNil(t, p2)
+	assert.Equal(t, uint32(1), p2.Queries)
+	assert.Zero(t, p2.FailureRate())
+	assert.Equal(t, []DomainQueryCount{{Domain: "foo.com", Count: 1}}, p2.TopDomains)
+
+	t.Run("no top domains", func(t *testing.T) {
+		stats := AggregateDNSStatsByPID(conns, 0)
+		require.Len(t, stats, 2)
+		assert.Empty(t, stats[1].TopDomains)
+		assert.Equal(t, uint32(9), stats[1].Queries)
+	})
+}
+
+func TestDNSStatsByPIDToTelemetryAggregatesTail(t *testing.T) {
+	stats := make(map[uint32]*ProcessDNSStats)
+	for pid := uint32(1); pid <= MaxDNSStatsByPIDProcesses+2; pid++ {
+		stats[pid] = &ProcessDNSStats{
+			Queries:    pid * 10,
+			Failures:   pid,
+			Timeouts:   1,
+			TopDomains: []DomainQueryCount{{Domain: "foo.com", Count: pid * 10}},
+		}
+	}
+
+	tel := DNSStatsByPIDToTelemetry(stats)
+	assert.Len(t, tel, MaxDNSStatsByPIDProcesses*4+3)
+
+	byPID, other := DNSStatsByPIDFromTelemetry(tel)
+	assert.Len(t, byPID, MaxDNSStatsByPIDProcesses)
+	assert.NotContains(t, byPID, uint32(1))
+	assert.NotContains(t, byPID, uint32(2))
+	assert.Equal(t, stats[MaxDNSStatsByPIDProcesses+2], byPID[MaxDNSStatsByPIDProcesses+2])
+
+	require.NotNil(t, other)
+	assert.Equal(t, &ProcessDNSStats{Queries: 30, Failures: 3, Timeouts: 2}, other)
+}
@@ -721,14 +721,14 @@ func TestHTTP2SerializationWithLocalhostTraffic(t *testing.T) {
 				Raddr:             &model.Addr{Ip: "127.0.0.1", Port: int32(serverPort)},
 				Http2Aggregations: http2OutBlob,
 				RouteIdx:          -1,
-				Protocol:          marshal.FormatProtocolStack(protocols.Stack{}, 0),
+				Protocol:          marshal.FormatProtocolStack(protocols.Stack{Application: protocols.HTTP2}, 0),
 			},
 			{
 				Laddr:             &model.Addr{Ip: "127.0.0.1", Port: int32(serverPort)},
 				Raddr:             &model.Addr{Ip: "127.0.0.1", Port: int32(clientPort)},
 				Http2Aggregations: http2OutBlob,
 				RouteIdx:          -1,
-				Protocol:          marshal.FormatProtocolStack(protocols.Stack{}, 0),
+				Protocol:          marshal.FormatProtocolStack(protocols.Stack{Application: protocols.HTTP2}, 0),
 			},
 		},
 		AgentConfiguration: &model.AgentConfiguration{
@@ -1006,3 +1006,51 @@ func TestKafkaSerializationWithLocalhostTraffic(t *testing.T) {
 
 	require.Equal(t, out, result)
 }
+
+func TestDNSStatsByPIDSerialization(t *testing.T) {
+	configmock.NewSystemProbe(t)
+
+	stats := map[uint32]*network.ProcessDNSStats{
+		42: {
+			Queries:  10,
+			Failures: 3,
+			Timeouts: 1,
+			TopDomains: []network.DomainQueryCount{
+				{Domain: "foo.example.com", Count: 7},
+				{Domain: "bar.example.com", Count: 3},
+			},
+		},
+		1337: {
+			Queries: 2,
+		},
+	}
+
+	in := &network.Connections{
+		BufferedData: network.BufferedData{
+			Conns: []network.ConnectionStats{
+				{ConnectionTuple: network.ConnectionTuple{
+					Source: util.AddressFromString("10.1.1.1"),
+					Dest:   util.AddressFromString("10.2.2.2"),
+					Pid:    42,
+					SPort:  1000,
+					DPort:  53,
+					Type:   network.UDP,
+				}},
+			},
+		},
+		DNSStatsByPID: stats,
+	}
+
+	for _, contentType := range []string{"application/json", "application/protobuf"} {
+		t.Run(contentType, func(t *testing.T) {
+			blobWriter := getBlobWriter(t, assert.New(t), in, contentType)
+
+			result, err := unmarshal.GetUnmarshaler(contentType).Unmarshal(blobWriter.Bytes())
+			require.NoError(t, err)
+
+			byPID, other := network.DNSStatsByPIDFromTelemetry(result.ConnTelemetryMap)
+			assert.Equal(t, stats, byPID)
+			assert.Nil(t, other)
+		})
+	}
+}
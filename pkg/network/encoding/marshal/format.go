@@ -99,13 +99,6 @@ func FormatConnection(builder *model.ConnectionBuilder, conn network.ConnectionS
 	builder.SetIntraHost(conn.IntraHost)
 	builder.SetLastTcpEstablished(uint32(conn.Last.TCPEstablished))
 	builder.SetLastTcpClosed(uint32(conn.Last.TCPClosed))
-	builder.SetProtocol(func(w *model.ProtocolStackBuilder) {
-		ps := FormatProtocolStack(conn.ProtocolStack, conn.StaticTags)
-		for _, p := range ps.Stack {
-			w.AddStack(uint64(p))
-		}
-	})
-
 	builder.SetRouteIdx(formatRouteIdx(conn.Via, routes))
 	dnsFormatter.FormatConnectionDNS(conn, builder)
 
@@ -119,7 +112,11 @@ func FormatConnection(builder *model.ConnectionBuilder, conn network.ConnectionS
 	}
 
 	httpStaticTags, httpDynamicTags := httpEncoder.GetHTTPAggregationsAndTags(conn, builder)
-	http2StaticTags, http2DynamicTags := http2Encoder.WriteHTTP2AggregationsAndTags(conn, builder)
+	http2StaticTags, http2DynamicTags, http2Stack := http2Encoder.WriteHTTP2AggregationsAndTags(conn, builder)
+
+	// The HTTP/2 traffic observed by USM completes the protocol stack of connections the
+	// classifier couldn't identify, such as HTTP/2 and gRPC over TLS
+	conn.ProtocolStack.MergeWith(http2Stack)
 
 	staticTags := httpStaticTags | http2StaticTags
 	dynamicTags := mergeDynamicTags(httpDynamicTags, http2DynamicTags)
@@ -129,6 +126,13 @@ func FormatConnection(builder *model.ConnectionBuilder, conn network.ConnectionS
 	staticTags |= redisEncoder.WriteRedisAggregations(conn, builder)
 
 	conn.StaticTags |= staticTags
+	builder.SetProtocol(func(w *model.ProtocolStackBuilder) {
+		ps := FormatProtocolStack(conn.ProtocolStack, conn.StaticTags)
+		for _, p := range ps.Stack {
+			w.AddStack(uint64(p))
+		}
+	})
+
 	tags, tagChecksum := formatTags(conn, tagsSet, dynamicTags)
 	for _, t := range tags {
 		builder.AddTags(t)
@@ -173,6 +177,20 @@ func FormatConnectionTelemetry(builder *model.ConnectionsBuilder, tel map[networ
 
 }
 
+// FormatDNSStatsByPID writes the DNS stats aggregated by process into the connection telemetry of a connections payload
+func FormatDNSStatsByPID(builder *model.ConnectionsBuilder, stats map[uint32]*network.ProcessDNSStats) {
+	if len(stats) == 0 {
+		return
+	}
+
+	for k, v := range network.DNSStatsByPIDToTelemetry(stats) {
+		builder.AddConnTelemetryMap(func(w *model.Connections_ConnTelemetryMapEntryBuilder) {
+			w.SetKey(k)
+			w.SetValue(v)
+		})
+	}
+}
+
 // FormatCORETelemetry writes the CORETelemetryByAsset map into a connections payload
 func FormatCORETelemetry(builder *model.ConnectionsBuilder, telByAsset map[string]int32) {
 	if telByAsset == nil {
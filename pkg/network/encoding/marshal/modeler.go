@@ -108,6 +108,7 @@ func (c *ConnectionsModeler) modelConnections(builder *model.ConnectionsBuilder,
 	}
 
 	FormatConnectionTelemetry(builder, conns.ConnTelemetry)
+	FormatDNSStatsByPID(builder, conns.DNSStatsByPID)
 	FormatCompilationTelemetry(builder, conns.CompilationTelemetryByAsset)
 	FormatCORETelemetry(builder, conns.CORETelemetryByAsset)
 	builder.SetKernelHeaderFetchResult(uint64(conns.KernelHeaderFetchResult))
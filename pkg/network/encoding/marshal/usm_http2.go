@@ -8,11 +8,13 @@ package marshal
 import (
 	"bytes"
 	"io"
+	"strings"
 
 	model "github.com/DataDog/agent-payload/v5/process"
 	"github.com/gogo/protobuf/proto"
 
 	"github.com/DataDog/datadog-agent/pkg/network"
+	"github.com/DataDog/datadog-agent/pkg/network/protocols"
 	"github.com/DataDog/datadog-agent/pkg/network/protocols/http"
 	"github.com/DataDog/datadog-agent/pkg/network/types"
 )
@@ -35,14 +37,18 @@ func newHTTP2Encoder(http2Payloads map[http.Key]*http.RequestStats) *http2Encode
 	}
 }
 
-func (e *http2Encoder) WriteHTTP2AggregationsAndTags(c network.ConnectionStats, builder *model.ConnectionBuilder) (uint64, map[string]struct{}) {
+// WriteHTTP2AggregationsAndTags writes the HTTP/2 aggregations of the given connection and returns the
+// associated tags, along with the protocol stack inferred from the observed HTTP/2 traffic. The latter
+// allows classifying connections whose payload isn't visible to the socket filter classifier, such as
+// HTTP/2 traffic captured by the TLS uprobes.
+func (e *http2Encoder) WriteHTTP2AggregationsAndTags(c network.ConnectionStats, builder *model.ConnectionBuilder) (uint64, map[string]struct{}, protocols.Stack) {
 	if e == nil {
-		return 0, nil
+		return 0, nil, protocols.Stack{}
 	}
 
 	connectionData := e.byConnection.Find(c)
 	if connectionData == nil || len(connectionData.Data) == 0 || connectionData.IsPIDCollision(c) {
-		return 0, nil
+		return 0, nil, protocols.Stack{}
 	}
 
 	var (
@@ -53,7 +59,37 @@ func (e *http2Encoder) WriteHTTP2AggregationsAndTags(c network.ConnectionStats,
 	builder.SetHttp2Aggregations(func(b *bytes.Buffer) {
 		staticTags, dynamicTags = e.encodeData(connectionData, b)
 	})
-	return staticTags, dynamicTags
+	return staticTags, dynamicTags, inferHTTP2ProtocolStack(connectionData)
+}
+
+// inferHTTP2ProtocolStack returns the protocol stack matching the HTTP/2 requests of a connection.
+// A connection is considered to carry gRPC traffic when all its requests are gRPC calls.
+func inferHTTP2ProtocolStack(connectionData *USMConnectionData[http.Key, *http.RequestStats]) protocols.Stack {
+	stack := protocols.Stack{Application: protocols.HTTP2}
+	for _, kvPair := range connectionData.Data {
+		if !isGRPCRequest(kvPair.Key) {
+			return stack
+		}
+	}
+
+	stack.API = protocols.GRPC
+	return stack
+}
+
+// isGRPCRequest returns true if the request looks like a gRPC call, that is a POST request
+// whose path is of the form `/<package>.<Service>/<Method>`
+func isGRPCRequest(key http.Key) bool {
+	if key.Method != http.MethodPost || !key.Path.FullPath {
+		return false
+	}
+
+	path := key.Path.Content.Get()
+	if len(path) < 2 || path[0] != '/' {
+		return false
+	}
+
+	service, method, found := strings.Cut(path[1:], "/")
+	return found && service != "" && method != "" && !strings.Contains(method, "/")
 }
 
 func (e *http2Encoder) encodeData(connectionData *USMConnectionData[http.Key, *http.RequestStats], w io.Writer) (uint64, map[string]struct{}) {
@@ -17,6 +17,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/DataDog/datadog-agent/pkg/network"
+	"github.com/DataDog/datadog-agent/pkg/network/protocols"
 	"github.com/DataDog/datadog-agent/pkg/network/protocols/http"
 	"github.com/DataDog/datadog-agent/pkg/process/util"
 )
@@ -332,9 +333,68 @@ func (s *HTTP2Suite) TestHTTP2LocalhostScenario() {
 	assert.Equal(uint32(1), aggregations.EndpointAggregations[0].StatsByStatusCode[int32(103)].Count)
 }
 
+func (s *HTTP2Suite) TestHTTP2ProtocolStack() {
+	t := s.T()
+
+	var (
+		clientPort = uint16(52800)
+		serverPort = uint16(8080)
+		localhost  = util.AddressFromString("127.0.0.1")
+	)
+
+	conn := network.ConnectionStats{ConnectionTuple: network.ConnectionTuple{
+		Source: localhost,
+		Dest:   localhost,
+		SPort:  clientPort,
+		DPort:  serverPort,
+	}}
+
+	newKey := func(path string, method http.Method) http.Key {
+		return http.NewKey(localhost, localhost, clientPort, serverPort, []byte(path), true, method)
+	}
+
+	tests := []struct {
+		name     string
+		keys     []http.Key
+		expected protocols.Stack
+	}{
+		{
+			name:     "rest",
+			keys:     []http.Key{newKey("/api/v1/users", http.MethodGet)},
+			expected: protocols.Stack{Application: protocols.HTTP2},
+		},
+		{
+			name:     "grpc",
+			keys:     []http.Key{newKey("/helloworld.Greeter/SayHello", http.MethodPost), newKey("/helloworld.Greeter/SayBye", http.MethodPost)},
+			expected: protocols.Stack{Application: protocols.HTTP2, API: protocols.GRPC},
+		},
+		{
+			name:     "mixed",
+			keys:     []http.Key{newKey("/helloworld.Greeter/SayHello", http.MethodPost), newKey("/api/v1/users/1", http.MethodPost)},
+			expected: protocols.Stack{Application: protocols.HTTP2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payload := make(map[http.Key]*http.RequestStats)
+			for _, key := range tt.keys {
+				stats := http.NewRequestStats()
+				stats.AddRequest(200, 10, 0, nil)
+				payload[key] = stats
+			}
+
+			encoder := newHTTP2Encoder(payload)
+			streamer := NewProtoTestStreamer[*model.Connection]()
+			_, _, stack := encoder.WriteHTTP2AggregationsAndTags(conn, model.NewConnectionBuilder(streamer))
+			assert.Equal(t, tt.expected, stack)
+		})
+	}
+}
+
 func getHTTP2Aggregations(t *testing.T, encoder *http2Encoder, c network.ConnectionStats) (*model.HTTP2Aggregations, uint64, map[string]struct{}) {
 	streamer := NewProtoTestStreamer[*model.Connection]()
-	staticTags, dynamicTags := encoder.WriteHTTP2AggregationsAndTags(c, model.NewConnectionBuilder(streamer))
+	staticTags, dynamicTags, _ := encoder.WriteHTTP2AggregationsAndTags(c, model.NewConnectionBuilder(streamer))
 
 	var conn model.Connection
 	streamer.Unwrap(t, &conn)
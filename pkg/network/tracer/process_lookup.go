@@ -0,0 +1,53 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux_bpf
+
+package tracer
+
+import (
+	"go4.org/intern"
+
+	"github.com/DataDog/datadog-agent/pkg/eventmonitor/consumers"
+	"github.com/DataDog/datadog-agent/pkg/network/events"
+)
+
+// processFromLookup returns the attributes of a process from the process cache of the event monitor. This cache is
+// populated from procfs at startup, unlike the process cache of the tracer which only knows the processes started
+// since then, or whose events weren't dropped. The process is only returned if it was running at the provided
+// timestamp, so that a reused pid doesn't tag the connection with another process.
+func processFromLookup(lookup consumers.ProcessLookup, pid uint32, ts int64) (*events.Process, bool) {
+	if lookup == nil {
+		return nil, false
+	}
+
+	info, found := lookup.LookupProcess(pid)
+	if !found {
+		return nil, false
+	}
+
+	if !info.ExecTime.IsZero() && ts < info.ExecTime.UnixNano() {
+		return nil, false
+	}
+	if !info.ExitTime.IsZero() && ts > info.ExitTime.UnixNano() {
+		return nil, false
+	}
+
+	p := &events.Process{Pid: pid}
+	if !info.ExecTime.IsZero() {
+		p.StartTime = info.ExecTime.UnixNano()
+	}
+	if info.Service != "" {
+		p.Tags = []*intern.Value{intern.GetByString("service:" + info.Service)}
+	}
+	if info.ContainerID != "" {
+		p.ContainerID = intern.GetByString(info.ContainerID)
+	}
+
+	if len(p.Tags) == 0 && p.ContainerID == nil {
+		return nil, false
+	}
+	return p, true
+}
@@ -0,0 +1,63 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux_bpf
+
+package tracer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go4.org/intern"
+
+	"github.com/DataDog/datadog-agent/pkg/eventmonitor/consumers"
+)
+
+type fakeProcessLookup map[uint32]consumers.ProcessInfo
+
+func (l fakeProcessLookup) LookupProcess(pid uint32) (consumers.ProcessInfo, bool) {
+	info, found := l[pid]
+	return info, found
+}
+
+func TestProcessFromLookup(t *testing.T) {
+	now := time.Now()
+	lookup := fakeProcessLookup{
+		1: {Pid: 1, ContainerID: "0123456789abcdef", Service: "billing", ExecTime: now.Add(-time.Hour)},
+		2: {Pid: 2},
+		3: {Pid: 3, Service: "billing", ExecTime: now.Add(-time.Hour), ExitTime: now.Add(-time.Minute)},
+	}
+
+	p, found := processFromLookup(lookup, 1, now.UnixNano())
+	require.True(t, found)
+	assert.Equal(t, uint32(1), p.Pid)
+	assert.Equal(t, now.Add(-time.Hour).UnixNano(), p.StartTime)
+	assert.Equal(t, intern.GetByString("0123456789abcdef"), p.ContainerID)
+	assert.Equal(t, []*intern.Value{intern.GetByString("service:billing")}, p.Tags)
+
+	// the connections older than the process belong to a previous owner of the pid
+	_, found = processFromLookup(lookup, 1, now.Add(-2*time.Hour).UnixNano())
+	assert.False(t, found)
+
+	// the connections more recent than the exit of the process belong to the next owner of the pid
+	_, found = processFromLookup(lookup, 3, now.UnixNano())
+	assert.False(t, found)
+	_, found = processFromLookup(lookup, 3, now.Add(-2*time.Minute).UnixNano())
+	assert.True(t, found)
+
+	// the processes without attribute to tag the connections with are ignored
+	_, found = processFromLookup(lookup, 2, now.UnixNano())
+	assert.False(t, found)
+
+	_, found = processFromLookup(lookup, 4, now.UnixNano())
+	assert.False(t, found)
+
+	// the event monitor isn't loaded
+	_, found = processFromLookup(nil, 1, now.UnixNano())
+	assert.False(t, found)
+}
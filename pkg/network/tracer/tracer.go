@@ -25,6 +25,7 @@ import (
 	ddebpf "github.com/DataDog/datadog-agent/pkg/ebpf"
 	"github.com/DataDog/datadog-agent/pkg/ebpf/bytecode/runtime"
 	ebpftelemetry "github.com/DataDog/datadog-agent/pkg/ebpf/telemetry"
+	"github.com/DataDog/datadog-agent/pkg/eventmonitor/consumers"
 	"github.com/DataDog/datadog-agent/pkg/network"
 	"github.com/DataDog/datadog-agent/pkg/network/config"
 	"github.com/DataDog/datadog-agent/pkg/network/config/sysctl"
@@ -87,6 +88,9 @@ type Tracer struct {
 
 	bufferLock sync.Mutex
 
+	// Latest DNS stats aggregated by process for each client, guarded by bufferLock
+	dnsStatsByPID map[string]map[uint32]*network.ProcessDNSStats
+
 	// Connections for the tracer to exclude
 	sourceExcludes []*network.ConnectionFilter
 	destExcludes   []*network.ConnectionFilter
@@ -153,6 +157,7 @@ func newTracer(cfg *config.Config, telemetryComponent telemetryComponent.Compone
 	tr := &Tracer{
 		config:                     cfg,
 		lastCheck:                  atomic.NewInt64(time.Now().Unix()),
+		dnsStatsByPID:              make(map[string]map[uint32]*network.ProcessDNSStats),
 		sysctlUDPConnTimeout:       sysctl.NewInt(cfg.ProcRoot, "net/netfilter/nf_conntrack_udp_timeout", time.Minute),
 		sysctlUDPConnStreamTimeout: sysctl.NewInt(cfg.ProcRoot, "net/netfilter/nf_conntrack_udp_timeout_stream", time.Minute),
 		telemetryComp:              telemetryComponent,
@@ -343,7 +348,9 @@ func (t *Tracer) addProcessInfo(c *network.ConnectionStats) {
 	ts := t.timeResolver.ResolveMonotonicTimestamp(c.LastUpdateEpoch)
 	p, ok := t.processCache.Get(c.Pid, ts.UnixNano())
 	if !ok {
-		return
+		if p, ok = processFromLookup(consumers.GetProcessLookup(), c.Pid, ts.UnixNano()); !ok {
+			return
+		}
 	}
 
 	if log.ShouldLog(seelog.TraceLvl) {
@@ -446,6 +453,10 @@ func (t *Tracer) GetActiveConnections(clientID string) (*network.Connections, er
 	buffer.ConnectionBuffer.Assign(delta.Conns)
 	conns := network.NewConnections(buffer)
 	conns.DNS = t.reverseDNS.Resolve(ips)
+	if t.config.DNSStatsByProcess {
+		conns.DNSStatsByPID = network.AggregateDNSStatsByPID(delta.Conns, t.config.DNSTopDomainsPerProcess)
+		t.dnsStatsByPID[clientID] = conns.DNSStatsByPID
+	}
 	conns.HTTP = delta.HTTP
 	conns.HTTP2 = delta.HTTP2
 	conns.Kafka = delta.Kafka
@@ -469,6 +480,10 @@ func (t *Tracer) RegisterClient(clientID string) error {
 
 func (t *Tracer) removeClient(clientID string) {
 	t.state.RemoveClient(clientID)
+
+	t.bufferLock.Lock()
+	delete(t.dnsStatsByPID, clientID)
+	t.bufferLock.Unlock()
 }
 
 func (t *Tracer) getConnTelemetry(mapSize int) map[network.ConnTelemetryType]int64 {
@@ -705,6 +720,17 @@ func (t *Tracer) DebugNetworkState(clientID string) (map[string]interface{}, err
 	return t.state.DumpState(clientID), nil
 }
 
+// DebugDNSStatsByProcess returns the DNS stats aggregated by process during the last connections check of the client
+func (t *Tracer) DebugDNSStatsByProcess(clientID string) (map[uint32]*network.ProcessDNSStats, error) {
+	if !t.config.DNSStatsByProcess {
+		return nil, fmt.Errorf("DNS stats by process are disabled")
+	}
+
+	t.bufferLock.Lock()
+	defer t.bufferLock.Unlock()
+	return t.dnsStatsByPID[clientID], nil
+}
+
 // DebugNetworkMaps returns all connections stored in the BPF maps without modifications from network state
 func (t *Tracer) DebugNetworkMaps() (*network.Connections, error) {
 	activeBuffer := network.NewConnectionBuffer(512, 512)
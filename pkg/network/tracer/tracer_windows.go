@@ -289,6 +289,11 @@ func (t *Tracer) DebugNetworkMaps() (*network.Connections, error) {
 	return nil, ebpf.ErrNotImplemented
 }
 
+// DebugDNSStatsByProcess is not implemented on this OS for Tracer
+func (t *Tracer) DebugDNSStatsByProcess(_ string) (map[uint32]*network.ProcessDNSStats, error) {
+	return nil, ebpf.ErrNotImplemented
+}
+
 // DebugEBPFMaps is not implemented on this OS for Tracer
 func (t *Tracer) DebugEBPFMaps(_ io.Writer, _ ...string) error {
 	return ebpf.ErrNotImplemented
@@ -37,6 +37,8 @@ type SysProbeConfig struct {
 	ProcessModuleEnabled bool
 	// System probe network_tracer module on/off configuration
 	NetworkTracerModuleEnabled bool
+	// System probe run_queue_latency_probe module on/off configuration
+	RunQueueLatencyModuleEnabled bool
 }
 
 // Check is an interface for Agent checks that collect data. Each check returns
@@ -88,10 +90,26 @@ func (p StandardRunResult) RealtimePayloads() []model.MessageBody {
 	return nil
 }
 
+// SubmissionListener is implemented by the run results that need to know whether their standard payloads were
+// delivered
+type SubmissionListener interface {
+	// OnSubmitted is called once the standard payloads were submitted, with whether they were all delivered
+	OnSubmitted(delivered bool)
+}
+
 // CombinedRunResult is a run result containing payloads for standard and realtime runs
 type CombinedRunResult struct {
 	Standard []model.MessageBody
 	Realtime []model.MessageBody
+	// Submitted, when set, is notified of the delivery of the standard payloads
+	Submitted func(delivered bool)
+}
+
+// OnSubmitted notifies the check of the delivery of the standard payloads
+func (p CombinedRunResult) OnSubmitted(delivered bool) {
+	if p.Submitted != nil {
+		p.Submitted(delivered)
+	}
 }
 
 //nolint:revive // TODO(PROC) Fix revive linter
@@ -114,7 +132,7 @@ func All(config, sysprobeYamlCfg pkgconfigmodel.ReaderWriter, syscfg *sysconfigt
 		NewRTContainerCheck(config, wmeta),
 		NewConnectionsCheck(config, sysprobeYamlCfg, syscfg, wmeta, npCollector),
 		NewProcessDiscoveryCheck(config),
-		NewProcessEventsCheck(config),
+		NewProcessEventsCheck(config, wmeta),
 	}
 }
 
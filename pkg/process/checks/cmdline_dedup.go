@@ -0,0 +1,126 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package checks
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"sync"
+	"time"
+
+	model "github.com/DataDog/agent-payload/v5/process"
+
+	pkgconfigmodel "github.com/DataDog/datadog-agent/pkg/config/model"
+	"github.com/DataDog/datadog-agent/pkg/util/log"
+)
+
+const (
+	configCmdlineDedupEnabled = configPrefix + "cmdline_dedup.enabled"
+	configCmdlineDedupWindow  = configPrefix + "cmdline_dedup.window"
+
+	cmdlineHashTagName = "cmdline_hash"
+)
+
+// cmdlineDeduplicator submits the arguments of a unique binary and command line only once per window, the other
+// processes with the same command line only reference it by its hash
+type cmdlineDeduplicator struct {
+	window time.Duration
+	// key of the HMAC of the command lines, so that the hashes can't be reversed by hashing candidate command lines
+	key []byte
+
+	// the deliveries are notified by the submitter, concurrently with the check runs
+	sync.Mutex
+	windowStart time.Time
+	seen        map[string]struct{}
+}
+
+// newCmdlineDeduplicator returns a new command line deduplicator, or nil if the deduplication is disabled
+func newCmdlineDeduplicator(config pkgconfigmodel.Reader) *cmdlineDeduplicator {
+	if !config.GetBool(configCmdlineDedupEnabled) {
+		return nil
+	}
+
+	window := config.GetDuration(configCmdlineDedupWindow)
+	if window <= 0 {
+		log.Warnf("Invalid %s: %s, command line deduplication disabled", configCmdlineDedupWindow, window)
+		return nil
+	}
+
+	log.Debugf("Starting process collection with command line deduplication: window=%s", window)
+
+	return &cmdlineDeduplicator{
+		window: window,
+		key:    installHashKey(config),
+		seen:   make(map[string]struct{}),
+	}
+}
+
+// dedup tags the processes with the hash of their command line, and drops the arguments of the command lines already
+// delivered during the current window. The returned function marks the command lines submitted by this run as
+// delivered, it must only be called once the payloads were successfully sent.
+func (d *cmdlineDeduplicator) dedup(procsByCtr map[string][]*model.Process, now time.Time) func(delivered bool) {
+	if d == nil {
+		return nil
+	}
+
+	d.Lock()
+	defer d.Unlock()
+
+	if now.Sub(d.windowStart) >= d.window {
+		d.windowStart = now
+		clear(d.seen)
+	}
+
+	submitted := make(map[string]struct{})
+	for _, ctrProcs := range procsByCtr {
+		for _, proc := range ctrProcs {
+			if proc.Command == nil || len(proc.Command.Args) == 0 {
+				continue
+			}
+
+			hash := d.hash(proc.Command.Args)
+			proc.ProcessContext = append(proc.ProcessContext, cmdlineHashTagName+":"+hash)
+
+			key := proc.Command.Exe + "\x00" + hash
+			if _, seen := d.seen[key]; seen {
+				proc.Command.Args = nil
+				continue
+			}
+			if _, seen := submitted[key]; seen {
+				proc.Command.Args = nil
+				continue
+			}
+			submitted[key] = struct{}{}
+		}
+	}
+
+	windowStart := d.windowStart
+	return func(delivered bool) {
+		if !delivered || len(submitted) == 0 {
+			return
+		}
+
+		d.Lock()
+		defer d.Unlock()
+
+		// the command lines of a previous window are submitted again anyway
+		if !d.windowStart.Equal(windowStart) {
+			return
+		}
+		for key := range submitted {
+			d.seen[key] = struct{}{}
+		}
+	}
+}
+
+// hash returns the hash referencing a command line
+func (d *cmdlineDeduplicator) hash(args []string) string {
+	mac := hmac.New(sha256.New, d.key)
+	mac.Write([]byte(strings.Join(args, "\x00")))
+	return hex.EncodeToString(mac.Sum(nil)[:8])
+}
@@ -0,0 +1,90 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package checks
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	model "github.com/DataDog/agent-payload/v5/process"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	configmock "github.com/DataDog/datadog-agent/pkg/config/mock"
+)
+
+func TestCmdlineDeduplicator(t *testing.T) {
+	cfg := configmock.New(t)
+	assert.Nil(t, newCmdlineDeduplicator(cfg))
+
+	cfg.SetWithoutSource(configCmdlineDedupEnabled, true)
+	cfg.SetWithoutSource(configCmdlineDedupWindow, "10m")
+	cfg.SetWithoutSource("run_path", t.TempDir())
+	d := newCmdlineDeduplicator(cfg)
+	require.NotNil(t, d)
+
+	newProcs := func() map[string][]*model.Process {
+		return map[string][]*model.Process{
+			"": {
+				{Pid: 1, Command: &model.Command{Exe: "/usr/bin/python3", Args: []string{"python3", "app.py"}}},
+				{Pid: 2, Command: &model.Command{Exe: "/usr/bin/python3", Args: []string{"python3", "app.py"}}},
+				{Pid: 3, Command: &model.Command{Exe: "/usr/bin/python3", Args: []string{"python3", "worker.py"}}},
+			},
+			"ctr": {
+				{Pid: 4, Command: &model.Command{Exe: "/usr/local/bin/python3", Args: []string{"python3", "app.py"}}},
+				{Pid: 5, Command: &model.Command{Exe: "/bin/true"}},
+			},
+		}
+	}
+
+	now := time.Now()
+	procs := newProcs()
+	submitted := d.dedup(procs, now)
+
+	app := d.hash([]string{"python3", "app.py"})
+	// keyed hash
+	sum := sha256.Sum256([]byte("python3\x00app.py"))
+	assert.NotEqual(t, hex.EncodeToString(sum[:8]), app)
+	assert.Equal(t, []string{"python3", "app.py"}, procs[""][0].Command.Args)
+	assert.Equal(t, []string{cmdlineHashTagName + ":" + app}, procs[""][0].ProcessContext)
+	assert.Nil(t, procs[""][1].Command.Args)
+	assert.Equal(t, []string{cmdlineHashTagName + ":" + app}, procs[""][1].ProcessContext)
+	assert.Equal(t, []string{"python3", "worker.py"}, procs[""][2].Command.Args)
+	// same command line, other binary
+	assert.Equal(t, []string{"python3", "app.py"}, procs["ctr"][0].Command.Args)
+	assert.Empty(t, procs["ctr"][1].ProcessContext)
+
+	// not delivered, submitted again
+	submitted(false)
+	procs = newProcs()
+	submitted = d.dedup(procs, now.Add(time.Minute))
+	assert.Equal(t, []string{"python3", "app.py"}, procs[""][0].Command.Args)
+	assert.Nil(t, procs[""][1].Command.Args)
+
+	// already delivered during the window
+	submitted(true)
+	procs = newProcs()
+	d.dedup(procs, now.Add(2*time.Minute))
+	assert.Nil(t, procs[""][0].Command.Args)
+	assert.Nil(t, procs[""][2].Command.Args)
+	assert.Nil(t, procs["ctr"][0].Command.Args)
+
+	// submitted again in the next window
+	procs = newProcs()
+	submitted = d.dedup(procs, now.Add(11*time.Minute))
+	assert.Equal(t, []string{"python3", "app.py"}, procs[""][0].Command.Args)
+	assert.Nil(t, procs[""][1].Command.Args)
+
+	// delivered after the end of its window
+	procs = newProcs()
+	d.dedup(procs, now.Add(22*time.Minute))
+	submitted(true)
+	procs = newProcs()
+	d.dedup(procs, now.Add(23*time.Minute))
+	assert.Equal(t, []string{"python3", "app.py"}, procs[""][0].Command.Args)
+}
@@ -46,6 +46,7 @@ type ContainerCheck struct {
 	networkID         string
 
 	containerFailedLogLimit *log.Limit
+	fieldScrubber           *fieldScrubber
 
 	maxBatchSize int
 	wmeta        workloadmeta.Component
@@ -76,6 +77,7 @@ func (c *ContainerCheck) Init(syscfg *SysProbeConfig, info *HostInfo, _ bool) er
 	c.networkID = networkID
 
 	c.containerFailedLogLimit = log.NewLogLimit(10, time.Minute*10)
+	c.fieldScrubber = newFieldScrubber(c.config)
 	c.maxBatchSize = getMaxBatchSize(c.config)
 	return nil
 }
@@ -128,6 +130,10 @@ func (c *ContainerCheck) Run(nextGroupID func() int32, options *RunOptions) (Run
 		return nil, nil
 	}
 
+	for _, ctr := range containers {
+		c.fieldScrubber.scrubContainer(ctr)
+	}
+
 	groupSize := len(containers) / c.maxBatchSize
 	if len(containers)%c.maxBatchSize != 0 {
 		groupSize++
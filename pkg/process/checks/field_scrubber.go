@@ -0,0 +1,261 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package checks
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	model "github.com/DataDog/agent-payload/v5/process"
+
+	pkgconfigmodel "github.com/DataDog/datadog-agent/pkg/config/model"
+	"github.com/DataDog/datadog-agent/pkg/util/log"
+)
+
+const (
+	configFieldScrubbingDrop = configPrefix + "field_scrubbing.drop"
+	configFieldScrubbingHash = configPrefix + "field_scrubbing.hash"
+
+	scrubbedFieldUsername = "username"
+	scrubbedFieldCwd      = "cwd"
+	scrubbedFieldExe      = "exe"
+	scrubbedTagPrefix     = "tag:"
+
+	// fieldScrubbingKeyFile is the file, in the run path, holding the key of the hashes of the scrubbed fields
+	fieldScrubbingKeyFile = "process_field_scrubbing.key"
+	fieldScrubbingKeySize = 32
+)
+
+type fieldScrubbingAction int
+
+const (
+	fieldScrubbingDrop fieldScrubbingAction = iota + 1
+	fieldScrubbingHash
+)
+
+// fieldScrubber drops or hashes payload fields before submission, on top of the command line scrubbing
+type fieldScrubber struct {
+	fields map[string]fieldScrubbingAction
+	tags   map[string]fieldScrubbingAction
+	// key of the HMAC of the hashed fields, so that the hashes can't be reversed by hashing candidate values
+	key []byte
+}
+
+func newFieldScrubber(config pkgconfigmodel.Reader) *fieldScrubber {
+	f := &fieldScrubber{
+		fields: make(map[string]fieldScrubbingAction),
+		tags:   make(map[string]fieldScrubbingAction),
+	}
+
+	f.addFields(config.GetStringSlice(configFieldScrubbingHash), fieldScrubbingHash)
+	// dropping a field takes precedence over hashing it
+	f.addFields(config.GetStringSlice(configFieldScrubbingDrop), fieldScrubbingDrop)
+
+	if f.hashes() {
+		f.key = installHashKey(config)
+	}
+
+	if f.enabled() {
+		log.Debugf("Starting process collection with field scrubbing: fields=%v tags=%v", f.fields, f.tags)
+	}
+
+	return f
+}
+
+// installHashKey returns the key of the hashes of the install, or a temporary one if it can't be loaded
+func installHashKey(config pkgconfigmodel.Reader) []byte {
+	key, err := loadFieldScrubbingKey(filepath.Join(config.GetString("run_path"), fieldScrubbingKeyFile))
+	if err != nil {
+		// the hashes are still keyed but they won't be stable across restarts
+		log.Warnf("Unable to load the field scrubbing key, using a temporary one: %s", err)
+		key = make([]byte, fieldScrubbingKeySize)
+		_, _ = rand.Read(key)
+	}
+	return key
+}
+
+// loadFieldScrubbingKey returns the key of the install stored at path, generating it if it doesn't exist yet
+func loadFieldScrubbingKey(path string) ([]byte, error) {
+	key, err := readFieldScrubbingKey(path)
+	if err == nil || !errors.Is(err, fs.ErrNotExist) {
+		return key, err
+	}
+
+	key = make([]byte, fieldScrubbingKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+
+	// write the key to a temporary file first and link it to its final path, so that the checks generating it
+	// concurrently all end up using the same key
+	tmp, err := os.CreateTemp(filepath.Dir(path), fieldScrubbingKeyFile+".*")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = tmp.WriteString(hex.EncodeToString(key))
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.Link(tmp.Name(), path); err != nil && !errors.Is(err, fs.ErrExist) {
+		return nil, err
+	}
+	return readFieldScrubbingKey(path)
+}
+
+func readFieldScrubbingKey(path string) ([]byte, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := hex.DecodeString(string(bytes.TrimSpace(content)))
+	if err != nil || len(key) != fieldScrubbingKeySize {
+		return nil, fmt.Errorf("invalid field scrubbing key in %s", path)
+	}
+	return key, nil
+}
+
+func (f *fieldScrubber) addFields(fields []string, action fieldScrubbingAction) {
+	for _, field := range fields {
+		field = strings.ToLower(strings.TrimSpace(field))
+		switch {
+		case strings.HasPrefix(field, scrubbedTagPrefix) && len(field) > len(scrubbedTagPrefix):
+			f.tags[strings.TrimPrefix(field, scrubbedTagPrefix)] = action
+		case field == scrubbedFieldUsername, field == scrubbedFieldCwd, field == scrubbedFieldExe:
+			f.fields[field] = action
+		default:
+			log.Warnf("Ignoring unknown process_config.field_scrubbing field: %s", field)
+		}
+	}
+}
+
+func (f *fieldScrubber) enabled() bool {
+	return f != nil && (len(f.fields) > 0 || len(f.tags) > 0)
+}
+
+// hashes returns true if at least one field or tag is hashed
+func (f *fieldScrubber) hashes() bool {
+	for _, action := range f.fields {
+		if action == fieldScrubbingHash {
+			return true
+		}
+	}
+	for _, action := range f.tags {
+		if action == fieldScrubbingHash {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *fieldScrubber) hashFieldValue(value string) string {
+	if value == "" {
+		return ""
+	}
+	mac := hmac.New(sha256.New, f.key)
+	mac.Write([]byte(value))
+	return hex.EncodeToString(mac.Sum(nil)[:8])
+}
+
+func (f *fieldScrubber) scrubField(field, value string) string {
+	switch f.fields[field] {
+	case fieldScrubbingDrop:
+		return ""
+	case fieldScrubbingHash:
+		return f.hashFieldValue(value)
+	}
+	return value
+}
+
+func (f *fieldScrubber) scrubTags(tags []string) []string {
+	if len(f.tags) == 0 || len(tags) == 0 {
+		return tags
+	}
+
+	// tags can be shared with the tagger, never scrub them in place
+	scrubbed := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		key, value, _ := strings.Cut(tag, ":")
+		switch f.tags[strings.ToLower(key)] {
+		case fieldScrubbingDrop:
+			continue
+		case fieldScrubbingHash:
+			tag = key + ":" + f.hashFieldValue(value)
+		}
+		scrubbed = append(scrubbed, tag)
+	}
+	return scrubbed
+}
+
+// scrubProcess applies the field scrubbing policy to a process of the payload
+func (f *fieldScrubber) scrubProcess(proc *model.Process) {
+	if !f.enabled() {
+		return
+	}
+
+	if proc.User != nil {
+		proc.User.Name = f.scrubField(scrubbedFieldUsername, proc.User.Name)
+	}
+	if proc.Command != nil {
+		proc.Command.Cwd = f.scrubField(scrubbedFieldCwd, proc.Command.Cwd)
+		proc.Command.Exe = f.scrubField(scrubbedFieldExe, proc.Command.Exe)
+	}
+	proc.ProcessContext = f.scrubTags(proc.ProcessContext)
+}
+
+// scrubContainer applies the field scrubbing policy to a container of the payload
+func (f *fieldScrubber) scrubContainer(ctr *model.Container) {
+	if !f.enabled() {
+		return
+	}
+
+	ctr.Tags = f.scrubTags(ctr.Tags)
+}
+
+// scrubProcessDiscovery applies the field scrubbing policy to a process discovery of the payload
+func (f *fieldScrubber) scrubProcessDiscovery(proc *model.ProcessDiscovery) {
+	if !f.enabled() {
+		return
+	}
+
+	if proc.User != nil {
+		proc.User.Name = f.scrubField(scrubbedFieldUsername, proc.User.Name)
+	}
+	if proc.Command != nil {
+		proc.Command.Cwd = f.scrubField(scrubbedFieldCwd, proc.Command.Cwd)
+		proc.Command.Exe = f.scrubField(scrubbedFieldExe, proc.Command.Exe)
+	}
+}
+
+// scrubProcessEvent applies the field scrubbing policy to a process lifecycle event of the payload
+func (f *fieldScrubber) scrubProcessEvent(e *model.ProcessEvent) {
+	if !f.enabled() {
+		return
+	}
+
+	if e.User != nil {
+		e.User.Name = f.scrubField(scrubbedFieldUsername, e.User.Name)
+	}
+	if e.Command != nil {
+		e.Command.Cwd = f.scrubField(scrubbedFieldCwd, e.Command.Cwd)
+		e.Command.Exe = f.scrubField(scrubbedFieldExe, e.Command.Exe)
+	}
+}
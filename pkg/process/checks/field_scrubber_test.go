@@ -0,0 +1,123 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package checks
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	model "github.com/DataDog/agent-payload/v5/process"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	configmock "github.com/DataDog/datadog-agent/pkg/config/mock"
+)
+
+func TestFieldScrubberDisabled(t *testing.T) {
+	cfg := configmock.New(t)
+	f := newFieldScrubber(cfg)
+	assert.False(t, f.enabled())
+
+	proc := &model.Process{
+		User:    &model.ProcessUser{Name: "root"},
+		Command: &model.Command{Cwd: "/root", Exe: "/bin/bash"},
+	}
+	f.scrubProcess(proc)
+	assert.Equal(t, "root", proc.User.Name)
+	assert.Equal(t, "/root", proc.Command.Cwd)
+	assert.Equal(t, "/bin/bash", proc.Command.Exe)
+
+	var nilScrubber *fieldScrubber
+	assert.False(t, nilScrubber.enabled())
+}
+
+func TestFieldScrubberProcess(t *testing.T) {
+	cfg := configmock.New(t)
+	cfg.SetWithoutSource("process_config.field_scrubbing.drop", []string{"cwd", "tag:team", "unknown"})
+	cfg.SetWithoutSource("process_config.field_scrubbing.hash", []string{"username", "cwd", "tag:env"})
+	cfg.SetWithoutSource("run_path", t.TempDir())
+	f := newFieldScrubber(cfg)
+	assert.True(t, f.enabled())
+
+	proc := &model.Process{
+		User:           &model.ProcessUser{Name: "root"},
+		Command:        &model.Command{Cwd: "/root", Exe: "/bin/bash"},
+		ProcessContext: []string{"env:prod", "team:sre", "service:web"},
+	}
+	f.scrubProcess(proc)
+
+	assert.Equal(t, f.hashFieldValue("root"), proc.User.Name)
+	assert.NotEqual(t, "root", proc.User.Name)
+	// drop takes precedence over hash
+	assert.Empty(t, proc.Command.Cwd)
+	assert.Equal(t, "/bin/bash", proc.Command.Exe)
+	assert.Equal(t, []string{"env:" + f.hashFieldValue("prod"), "service:web"}, proc.ProcessContext)
+}
+
+func TestFieldScrubberContainer(t *testing.T) {
+	cfg := configmock.New(t)
+	cfg.SetWithoutSource("process_config.field_scrubbing.drop", []string{"tag:owner"})
+	f := newFieldScrubber(cfg)
+
+	tags := []string{"owner:alice", "image_name:nginx"}
+	ctr := &model.Container{Tags: tags}
+	f.scrubContainer(ctr)
+
+	assert.Equal(t, []string{"image_name:nginx"}, ctr.Tags)
+	// the original tags must not be modified
+	assert.Equal(t, []string{"owner:alice", "image_name:nginx"}, tags)
+}
+
+func TestFieldScrubberProcessDiscovery(t *testing.T) {
+	cfg := configmock.New(t)
+	cfg.SetWithoutSource("process_config.field_scrubbing.drop", []string{"username", "exe"})
+	f := newFieldScrubber(cfg)
+
+	proc := &model.ProcessDiscovery{
+		User:    &model.ProcessUser{Name: "root"},
+		Command: &model.Command{Cwd: "/root", Exe: "/bin/bash"},
+	}
+	f.scrubProcessDiscovery(proc)
+
+	assert.Empty(t, proc.User.Name)
+	assert.Empty(t, proc.Command.Exe)
+	assert.Equal(t, "/root", proc.Command.Cwd)
+}
+
+func TestFieldScrubberKey(t *testing.T) {
+	runPath := t.TempDir()
+	cfg := configmock.New(t)
+	cfg.SetWithoutSource("process_config.field_scrubbing.hash", []string{"username"})
+	cfg.SetWithoutSource("run_path", runPath)
+
+	// the key is generated once and shared by the scrubbers of the install
+	f := newFieldScrubber(cfg)
+	require.Len(t, f.key, fieldScrubbingKeySize)
+	assert.FileExists(t, filepath.Join(runPath, fieldScrubbingKeyFile))
+	assert.Equal(t, f.hashFieldValue("root"), newFieldScrubber(cfg).hashFieldValue("root"))
+
+	// the hashes depend on the key of the install
+	require.NoError(t, os.Remove(filepath.Join(runPath, fieldScrubbingKeyFile)))
+	assert.NotEqual(t, f.hashFieldValue("root"), newFieldScrubber(cfg).hashFieldValue("root"))
+}
+
+func TestFieldScrubberProcessEvent(t *testing.T) {
+	cfg := configmock.New(t)
+	cfg.SetWithoutSource("process_config.field_scrubbing.drop", []string{"exe"})
+	cfg.SetWithoutSource("process_config.field_scrubbing.hash", []string{"username"})
+	cfg.SetWithoutSource("run_path", t.TempDir())
+	f := newFieldScrubber(cfg)
+
+	e := &model.ProcessEvent{
+		User:    &model.ProcessUser{Name: "root"},
+		Command: &model.Command{Exe: "/bin/bash"},
+	}
+	f.scrubProcessEvent(e)
+
+	assert.Equal(t, f.hashFieldValue("root"), e.User.Name)
+	assert.Empty(t, e.Command.Exe)
+}
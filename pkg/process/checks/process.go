@@ -10,14 +10,19 @@ import (
 	"fmt"
 	"math"
 	"regexp"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
 	model "github.com/DataDog/agent-payload/v5/process"
+	ddstatsd "github.com/DataDog/datadog-go/v5/statsd"
 	"github.com/shirou/gopsutil/v3/cpu"
 	"go.uber.org/atomic"
 
+	sysconfig "github.com/DataDog/datadog-agent/cmd/system-probe/config"
 	workloadmetacomp "github.com/DataDog/datadog-agent/comp/core/workloadmeta/def"
+	runqueuelatency "github.com/DataDog/datadog-agent/pkg/collector/corechecks/ebpf/probe/runqueuelatency/model"
 	pkgconfigmodel "github.com/DataDog/datadog-agent/pkg/config/model"
 	pkgconfigsetup "github.com/DataDog/datadog-agent/pkg/config/setup"
 	"github.com/DataDog/datadog-agent/pkg/process/metadata"
@@ -75,6 +80,10 @@ type ProcessCheck struct {
 	probe procutil.Probe
 	// scrubber is a DataScrubber to hide command line sensitive words
 	scrubber *procutil.DataScrubber
+	// fieldScrubber drops or hashes payload fields
+	fieldScrubber *fieldScrubber
+	// cmdlineDeduplicator submits each unique command line once per window, nil when disabled
+	cmdlineDeduplicator *cmdlineDeduplicator
 
 	// disallowList to hide processes
 	disallowList []*regexp.Regexp
@@ -167,6 +176,8 @@ func (p *ProcessCheck) Init(syscfg *SysProbeConfig, info *HostInfo, oneShot bool
 	}
 
 	initScrubber(p.config, p.scrubber)
+	p.fieldScrubber = newFieldScrubber(p.config)
+	p.cmdlineDeduplicator = newCmdlineDeduplicator(p.config)
 
 	p.disallowList = initDisallowList(p.config)
 
@@ -283,6 +294,7 @@ func (p *ProcessCheck) run(groupID int32, collectRealTime bool) (RunResult, erro
 		cacheValidity = cacheValidityRT
 	}
 
+	previousContainerRates := p.lastContainerRates
 	containers, lastContainerRates, pidToCid, err = p.containerProvider.GetContainers(cacheValidity, p.lastContainerRates)
 	if err == nil {
 		p.lastContainerRates = lastContainerRates
@@ -318,6 +330,22 @@ func (p *ProcessCheck) run(groupID int32, collectRealTime bool) (RunResult, erro
 
 	connsRates := p.getLastConnRates()
 	procsByCtr := fmtProcesses(p.scrubber, p.disallowList, procs, p.lastProcs, pidToCid, cpuTimes[0], p.lastCPUTime, p.lastRun, connsRates, p.lookupIdProbe, p.ignoreZombieProcesses, p.serviceExtractor)
+	addPressureStallContext(procsByCtr, containers)
+	addContainerInitContext(p.wmeta, procsByCtr, containers)
+	addKubernetesOwnershipContext(p.wmeta, containers)
+	sendPressureStallMetrics(statsd.Client, containers, p.lastContainerRates, previousContainerRates)
+	addRunQueueLatencyContext(procsByCtr, p.getRunQueueLatency())
+	if p.fieldScrubber.enabled() {
+		for _, ctrProcs := range procsByCtr {
+			for _, proc := range ctrProcs {
+				p.fieldScrubber.scrubProcess(proc)
+			}
+		}
+		for _, ctr := range containers {
+			p.fieldScrubber.scrubContainer(ctr)
+		}
+	}
+	cmdlinesSubmitted := p.cmdlineDeduplicator.dedup(procsByCtr, start)
 	messages, totalProcs, totalContainers := createProcCtrMessages(p.hostInfo, procsByCtr, containers, p.maxBatchSize, p.maxBatchBytes, groupID, p.networkID, collectorProcHints)
 
 	// Store the last state for comparison on the next run.
@@ -327,7 +355,8 @@ func (p *ProcessCheck) run(groupID int32, collectRealTime bool) (RunResult, erro
 	p.lastRun = time.Now()
 
 	result := &CombinedRunResult{
-		Standard: messages,
+		Standard:  messages,
+		Submitted: cmdlinesSubmitted,
 	}
 	if collectRealTime {
 		stats := procsToStats(p.lastProcs)
@@ -475,6 +504,112 @@ func chunkProcessesAndContainers(
 	return chunker.GetChunks(), totalProcs, totalContainers
 }
 
+// addPressureStallContext attributes the pressure stall levels of the containers to their processes
+func addPressureStallContext(procsByCtr map[string][]*model.Process, containers []*model.Container) {
+	for _, ctr := range containers {
+		tags := proccontainers.PressureStallTags(ctr)
+		if len(tags) == 0 {
+			continue
+		}
+		for _, proc := range procsByCtr[ctr.Id] {
+			proc.ProcessContext = append(proc.ProcessContext, tags...)
+		}
+	}
+}
+
+// sendPressureStallMetrics reports the distribution of the pressure stall of the containers since the previous run,
+// the pressure of each container being attached to its payload
+func sendPressureStallMetrics(client ddstatsd.ClientInterface, containers []*model.Container, rates, previousRates map[string]*proccontainers.ContainerRateMetrics) {
+	for _, ctr := range containers {
+		current, previous := rates[ctr.Id], previousRates[ctr.Id]
+		if current == nil || previous == nil {
+			continue
+		}
+
+		pressure := proccontainers.ComputePressureStall(current, previous)
+		for _, metric := range []struct {
+			name  string
+			value float64
+		}{
+			{"process.container.pressure.cpu", pressure.CPU},
+			{"process.container.pressure.memory", pressure.Memory},
+			{"process.container.pressure.io", pressure.IO},
+		} {
+			if metric.value >= 0 {
+				client.Histogram(metric.name, metric.value, nil, 1) //nolint:errcheck
+			}
+		}
+	}
+}
+
+// containerInitPidTagName is the tag of a container holding the pid of its init process, started as its entrypoint,
+// as opposed to the processes started later in the container with an exec
+const containerInitPidTagName = "container_init_pid"
+
+// addContainerInitContext tags each container with the pid of its init process. The init process is the one reported
+// by the container runtime, or the first process of the pid namespace of the container when the runtime doesn't
+// report it.
+func addContainerInitContext(wmeta workloadmetacomp.Component, procsByCtr map[string][]*model.Process, containers []*model.Container) {
+	for _, ctr := range containers {
+		initPid := int32(0)
+		if wmeta != nil {
+			if container, err := wmeta.GetContainer(ctr.Id); err == nil {
+				initPid = int32(container.PID)
+			}
+		}
+
+		for _, proc := range procsByCtr[ctr.Id] {
+			if (initPid != 0 && proc.Pid == initPid) || (initPid == 0 && proc.NsPid == 1) {
+				// the tags can be shared with the tagger, never append to them in place
+				ctr.Tags = append(slices.Clip(ctr.Tags), containerInitPidTagName+":"+strconv.Itoa(int(proc.Pid)))
+				break
+			}
+		}
+	}
+}
+
+// runQueueLatencyLevel returns the level of a run queue latency, bounding the cardinality of the run queue latency
+// process contexts
+func runQueueLatencyLevel(latency time.Duration) string {
+	switch {
+	case latency < 100*time.Microsecond:
+		return "low"
+	case latency < 5*time.Millisecond:
+		return "medium"
+	default:
+		return "high"
+	}
+}
+
+// addRunQueueLatencyContext attributes to the processes the level of the time their threads spent waiting in the
+// scheduler run queue since the previous run, which distinguishes the processes starved of CPU from the ones using
+// little CPU
+func addRunQueueLatencyContext(procsByCtr map[string][]*model.Process, stats []runqueuelatency.RunQueueLatencyStats) {
+	if len(stats) == 0 {
+		return
+	}
+
+	statsByPid := make(map[int32]runqueuelatency.RunQueueLatencyStats, len(stats))
+	for _, s := range stats {
+		if s.Count > 0 {
+			statsByPid[int32(s.Pid)] = s
+		}
+	}
+
+	for _, ctrProcs := range procsByCtr {
+		for _, proc := range ctrProcs {
+			s, found := statsByPid[proc.Pid]
+			if !found {
+				continue
+			}
+			proc.ProcessContext = append(proc.ProcessContext,
+				"run_queue_latency_avg:"+runQueueLatencyLevel(time.Duration(s.AvgNs())),
+				"run_queue_latency_max:"+runQueueLatencyLevel(time.Duration(s.MaxNs)),
+			)
+		}
+	}
+}
+
 // fmtProcesses goes through each process, converts them to process object and group them by containers
 // non-container processes would be in a single group with key as empty string ""
 func fmtProcesses(
@@ -519,6 +654,12 @@ func fmtProcesses(
 		if connRates != nil {
 			proc.Networks = connRates[fp.Pid]
 		}
+
+		// the identity allows to join the process with the records of the other agent products
+		if identity := procutil.ProcessIdentity(uint32(fp.Pid), fp.Stats.StartTicks); identity != "" {
+			proc.ProcessContext = append(proc.ProcessContext, procutil.ProcessIdentityTagName+":"+identity)
+		}
+
 		_, ok := procsByCtr[proc.ContainerId]
 		if !ok {
 			procsByCtr[proc.ContainerId] = make([]*model.Process, 0)
@@ -676,6 +817,34 @@ func (p *ProcessCheck) getRemoteSysProbeUtil() net.SysProbeUtil {
 	return pu
 }
 
+// getRunQueueLatency returns the run queue latency of the processes collected by system-probe since the previous call
+func (p *ProcessCheck) getRunQueueLatency() []runqueuelatency.RunQueueLatencyStats {
+	if !p.sysProbeConfig.RunQueueLatencyModuleEnabled {
+		return nil
+	}
+
+	pu, err := net.GetRemoteSystemProbeUtil(p.sysProbeConfig.SystemProbeAddress)
+	if err != nil {
+		if p.notInitializedLogLimit.ShouldLog() {
+			log.Warnf("could not initialize system-probe connection in process check: %v (will only log every 10 minutes)", err)
+		}
+		return nil
+	}
+
+	data, err := pu.GetCheck(sysconfig.RunQueueLatencyProbeModule)
+	if err != nil {
+		log.Debugf("cannot get the run queue latency from system-probe for process check: %s", err)
+		return nil
+	}
+
+	stats, ok := data.([]runqueuelatency.RunQueueLatencyStats)
+	if !ok {
+		log.Debugf("unexpected run queue latency type from system-probe: %T", data)
+		return nil
+	}
+	return stats
+}
+
 // mergeProcWithSysprobeStats takes a process by PID map and fill the stats from system probe into the processes in the map
 func mergeProcWithSysprobeStats(pids []int32, procs map[int32]*procutil.Process, pu net.SysProbeUtil) {
 	pStats, err := pu.GetProcStats(pids)
@@ -711,6 +880,9 @@ func initScrubber(config pkgconfigmodel.Reader, scrubber *procutil.DataScrubber)
 		log.Debug("Adding custom sensitives words to Scrubber:", words)
 	}
 
+	// The scrubbing policy shared with the runtime security module
+	procutil.NewScrubbingConfig(config).Apply(scrubber)
+
 	// Strips all process arguments
 	if config.GetBool(configStripProcArgs) {
 		log.Debug("Strip all process arguments enabled")
@@ -73,6 +73,9 @@ func makeProcessModel(t *testing.T, process *procutil.Process, processContext []
 	stats := process.Stats
 	mem := stats.MemInfo
 	cpu := stats.CPUPercent
+	if identity := procutil.ProcessIdentity(uint32(process.Pid), stats.StartTicks); identity != "" {
+		processContext = append(processContext, procutil.ProcessIdentityTagName+":"+identity)
+	}
 	return &model.Process{
 		Pid:     process.Pid,
 		Command: &model.Command{Args: process.Cmdline},
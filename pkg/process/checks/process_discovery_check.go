@@ -34,11 +34,12 @@ func NewProcessDiscoveryCheck(config pkgconfigmodel.Reader) *ProcessDiscoveryChe
 type ProcessDiscoveryCheck struct {
 	config pkgconfigmodel.Reader
 
-	probe      procutil.Probe
-	scrubber   *procutil.DataScrubber
-	userProbe  *LookupIdProbe
-	info       *HostInfo
-	initCalled bool
+	probe         procutil.Probe
+	scrubber      *procutil.DataScrubber
+	fieldScrubber *fieldScrubber
+	userProbe     *LookupIdProbe
+	info          *HostInfo
+	initCalled    bool
 
 	maxBatchSize int
 }
@@ -48,6 +49,7 @@ func (d *ProcessDiscoveryCheck) Init(syscfg *SysProbeConfig, info *HostInfo, _ b
 	d.info = info
 	d.initCalled = true
 	initScrubber(d.config, d.scrubber)
+	d.fieldScrubber = newFieldScrubber(d.config)
 	d.probe = newProcessProbe(d.config, procutil.WithPermission(syscfg.ProcessModuleEnabled))
 
 	d.maxBatchSize = getMaxBatchSize(d.config)
@@ -107,6 +109,9 @@ func (d *ProcessDiscoveryCheck) Run(nextGroupID func() int32, options *RunOption
 	}
 
 	procDiscoveries := pidMapToProcDiscoveries(procs, d.userProbe, d.scrubber)
+	for _, proc := range procDiscoveries {
+		d.fieldScrubber.scrubProcessDiscovery(proc)
+	}
 
 	// For no chunking, set max batch size as number of process discoveries to ensure one chunk
 	runMaxBatchSize := d.maxBatchSize
@@ -10,11 +10,12 @@ package checks
 import (
 	"errors"
 
+	workloadmeta "github.com/DataDog/datadog-agent/comp/core/workloadmeta/def"
 	pkgconfigmodel "github.com/DataDog/datadog-agent/pkg/config/model"
 )
 
 // NewProcessEventsCheck returns an instance of the ProcessEventsCheck.
-func NewProcessEventsCheck(config pkgconfigmodel.Reader) *ProcessEventsCheck {
+func NewProcessEventsCheck(config pkgconfigmodel.Reader, _ workloadmeta.Component) *ProcessEventsCheck {
 	return &ProcessEventsCheck{
 		config: config,
 	}
@@ -0,0 +1,147 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package checks
+
+import (
+	"slices"
+	"strings"
+
+	payload "github.com/DataDog/agent-payload/v5/process"
+
+	"github.com/DataDog/datadog-agent/comp/core/tagger/tags"
+	workloadmeta "github.com/DataDog/datadog-agent/comp/core/workloadmeta/def"
+	"github.com/DataDog/datadog-agent/pkg/process/events/model"
+	"github.com/DataDog/datadog-agent/pkg/util/kubernetes"
+)
+
+// workloadKindTags maps the kinds of the Kubernetes workloads to the tag of their name
+var workloadKindTags = map[string]string{
+	kubernetes.DeploymentKind:  tags.KubeDeployment,
+	kubernetes.ReplicaSetKind:  tags.KubeReplicaSet,
+	kubernetes.StatefulSetKind: tags.KubeStatefulSet,
+	kubernetes.DaemonSetKind:   tags.KubeDaemonSet,
+	kubernetes.JobKind:         tags.KubeJob,
+	kubernetes.CronJobKind:     tags.KubeCronjob,
+}
+
+// podOwnership is the Kubernetes ownership of a container
+type podOwnership struct {
+	podName      string
+	podNamespace string
+	workloadKind string
+	workloadName string
+}
+
+// getPodOwnership returns the pod of a container and the workload owning the pod, if any
+func getPodOwnership(wmeta workloadmeta.Component, containerID string) *podOwnership {
+	container, err := wmeta.GetContainer(containerID)
+	if err != nil || container.Owner == nil || container.Owner.Kind != workloadmeta.KindKubernetesPod {
+		return nil
+	}
+
+	pod, err := wmeta.GetKubernetesPod(container.Owner.ID)
+	if err != nil {
+		return nil
+	}
+
+	ownership := &podOwnership{
+		podName:      pod.Name,
+		podNamespace: pod.Namespace,
+	}
+	if len(pod.Owners) == 0 {
+		return ownership
+	}
+
+	// report the workload managing the pod rather than the intermediate object created by the workload controller
+	owner := pod.Owners[0]
+	ownership.workloadKind, ownership.workloadName = owner.Kind, owner.Name
+	switch owner.Kind {
+	case kubernetes.ReplicaSetKind:
+		if deployment := kubernetes.ParseDeploymentForReplicaSet(owner.Name); deployment != "" {
+			ownership.workloadKind, ownership.workloadName = kubernetes.DeploymentKind, deployment
+		}
+	case kubernetes.JobKind:
+		if cronJob, _ := kubernetes.ParseCronJobForJob(owner.Name); cronJob != "" {
+			ownership.workloadKind, ownership.workloadName = kubernetes.CronJobKind, cronJob
+		}
+	}
+
+	return ownership
+}
+
+// enrichProcessEvents adds the Kubernetes ownership of their container to the process events, so that the
+// consumers of the events don't have to join them with the pods
+func enrichProcessEvents(wmeta workloadmeta.Component, events []*model.ProcessEvent) {
+	if wmeta == nil {
+		return
+	}
+
+	ownerships := make(map[string]*podOwnership)
+	for _, e := range events {
+		if e.ContainerID == "" {
+			continue
+		}
+
+		ownership, found := ownerships[e.ContainerID]
+		if !found {
+			ownership = getPodOwnership(wmeta, e.ContainerID)
+			ownerships[e.ContainerID] = ownership
+		}
+		if ownership == nil {
+			continue
+		}
+
+		e.PodName = ownership.podName
+		e.PodNamespace = ownership.podNamespace
+		e.WorkloadKind = ownership.workloadKind
+		e.WorkloadName = ownership.workloadName
+	}
+}
+
+// tags returns the tags of the Kubernetes ownership of a container
+func (o *podOwnership) tags() []string {
+	kubeTags := []string{
+		tags.KubePod + ":" + o.podName,
+		tags.KubeNamespace + ":" + o.podNamespace,
+	}
+	if o.workloadKind != "" {
+		kubeTags = append(kubeTags,
+			tags.KubeOwnerRefKind+":"+strings.ToLower(o.workloadKind),
+			tags.KubeOwnerRefName+":"+o.workloadName,
+		)
+		if tag, found := workloadKindTags[o.workloadKind]; found {
+			kubeTags = append(kubeTags, tag+":"+o.workloadName)
+		}
+	}
+	return kubeTags
+}
+
+// addKubernetesOwnershipContext adds the tags of the Kubernetes ownership of the containers missing from their tags.
+// The process events reference their container by its ID, so that their consumers find the pod and the workload of
+// the process on the container entries.
+func addKubernetesOwnershipContext(wmeta workloadmeta.Component, containers []*payload.Container) {
+	if wmeta == nil {
+		return
+	}
+
+	for _, ctr := range containers {
+		ownership := getPodOwnership(wmeta, ctr.Id)
+		if ownership == nil {
+			continue
+		}
+
+		var missing []string
+		for _, tag := range ownership.tags() {
+			if !slices.Contains(ctr.Tags, tag) {
+				missing = append(missing, tag)
+			}
+		}
+		if len(missing) > 0 {
+			// the tags can be shared with the tagger, never append to them in place
+			ctr.Tags = append(slices.Clip(ctr.Tags), missing...)
+		}
+	}
+}
@@ -16,6 +16,7 @@ import (
 
 	payload "github.com/DataDog/agent-payload/v5/process"
 
+	workloadmeta "github.com/DataDog/datadog-agent/comp/core/workloadmeta/def"
 	pkgconfigmodel "github.com/DataDog/datadog-agent/pkg/config/model"
 	"github.com/DataDog/datadog-agent/pkg/process/events"
 	"github.com/DataDog/datadog-agent/pkg/process/events/model"
@@ -24,9 +25,10 @@ import (
 )
 
 // NewProcessEventsCheck returns an instance of the ProcessEventsCheck.
-func NewProcessEventsCheck(config pkgconfigmodel.Reader) *ProcessEventsCheck {
+func NewProcessEventsCheck(config pkgconfigmodel.Reader, wmeta workloadmeta.Component) *ProcessEventsCheck {
 	return &ProcessEventsCheck{
 		config: config,
+		wmeta:  wmeta,
 	}
 }
 
@@ -35,12 +37,16 @@ type ProcessEventsCheck struct {
 	initMutex sync.Mutex
 
 	config pkgconfigmodel.Reader
+	wmeta  workloadmeta.Component
 
 	store    events.Store
 	listener *events.SysProbeListener
 	hostInfo *HostInfo
 
 	maxBatchSize int
+
+	// fieldScrubber drops or hashes payload fields
+	fieldScrubber *fieldScrubber
 }
 
 // Init initializes the ProcessEventsCheck.
@@ -55,6 +61,7 @@ func (e *ProcessEventsCheck) Init(_ *SysProbeConfig, info *HostInfo, _ bool) err
 	log.Info("Initializing process_events check")
 	e.hostInfo = info
 	e.maxBatchSize = getMaxBatchSize(e.config)
+	e.fieldScrubber = newFieldScrubber(e.config)
 
 	store, err := events.NewRingStore(e.config, statsd.Client)
 	if err != nil {
@@ -115,7 +122,11 @@ func (e *ProcessEventsCheck) Run(nextGroupID func() int32, _ *RunOptions) (RunRe
 		return nil, fmt.Errorf("can't pull events from the Event Store: %v", err)
 	}
 
+	enrichProcessEvents(e.wmeta, events)
 	payloadEvents := FmtProcessEvents(events)
+	for _, pE := range payloadEvents {
+		e.fieldScrubber.scrubProcessEvent(pE)
+	}
 	chunks := chunkProcessEvents(payloadEvents, e.maxBatchSize)
 
 	messages := make([]payload.MessageBody, len(chunks))
@@ -17,12 +17,18 @@ import (
 	"github.com/DataDog/datadog-go/v5/statsd"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/fx"
 
+	"github.com/DataDog/datadog-agent/comp/core"
+	workloadmeta "github.com/DataDog/datadog-agent/comp/core/workloadmeta/def"
+	workloadmetafxmock "github.com/DataDog/datadog-agent/comp/core/workloadmeta/fx-mock"
+	workloadmetamock "github.com/DataDog/datadog-agent/comp/core/workloadmeta/mock"
 	configmock "github.com/DataDog/datadog-agent/pkg/config/mock"
 	"github.com/DataDog/datadog-agent/pkg/eventmonitor/proto/api"
 	"github.com/DataDog/datadog-agent/pkg/eventmonitor/proto/api/mocks"
 	"github.com/DataDog/datadog-agent/pkg/process/events"
 	"github.com/DataDog/datadog-agent/pkg/process/events/model"
+	"github.com/DataDog/datadog-agent/pkg/util/fxutil"
 )
 
 type eventTestData struct {
@@ -288,3 +294,73 @@ func TestProcessEventsChunking(t *testing.T) {
 		assert.Len(t, chunks, tc.chunkCount)
 	}
 }
+
+func TestProcessEventsKubernetesEnrichment(t *testing.T) {
+	store := fxutil.Test[workloadmetamock.Mock](t, fx.Options(
+		core.MockBundle(),
+		fx.Supply(context.Background()),
+		workloadmetafxmock.MockModule(workloadmeta.NewParams()),
+	))
+
+	store.Set(&workloadmeta.KubernetesPod{
+		EntityID: workloadmeta.EntityID{
+			Kind: workloadmeta.KindKubernetesPod,
+			ID:   "pod-uid",
+		},
+		EntityMeta: workloadmeta.EntityMeta{
+			Name:      "web-5d8f7b9c6-x2x4z",
+			Namespace: "prod",
+		},
+		Owners: []workloadmeta.KubernetesPodOwner{
+			{Kind: "ReplicaSet", Name: "web-5d8f7b9c6", ID: "rs-uid"},
+		},
+	})
+	store.Set(&workloadmeta.Container{
+		EntityID: workloadmeta.EntityID{
+			Kind: workloadmeta.KindContainer,
+			ID:   "0123456789abcdef",
+		},
+		Owner: &workloadmeta.EntityID{
+			Kind: workloadmeta.KindKubernetesPod,
+			ID:   "pod-uid",
+		},
+	})
+
+	now := time.Now()
+	events := []*model.ProcessEvent{
+		model.NewMockedExecEvent(now, 23, "/usr/bin/curl", []string{"curl"}),
+		model.NewMockedExitEvent(now, 23, "/usr/bin/curl", []string{"curl"}, 0),
+		model.NewMockedExecEvent(now, 24, "/usr/bin/ls", []string{"ls"}),
+	}
+	events[0].ContainerID = "0123456789abcdef"
+	events[1].ContainerID = "0123456789abcdef"
+
+	enrichProcessEvents(store, events)
+
+	for _, e := range events[:2] {
+		assert.Equal(t, "web-5d8f7b9c6-x2x4z", e.PodName)
+		assert.Equal(t, "prod", e.PodNamespace)
+		assert.Equal(t, "Deployment", e.WorkloadKind)
+		assert.Equal(t, "web", e.WorkloadName)
+	}
+	assert.Empty(t, events[2].PodName)
+
+	// the ownership isn't reported as host tags, but on the container entries referenced by the events
+	payloadEvents := FmtProcessEvents(events)
+	require.Len(t, payloadEvents, 3)
+	assert.Nil(t, payloadEvents[0].Host)
+
+	tags := []string{"image_name:web", "pod_name:web-5d8f7b9c6-x2x4z"}
+	containers := []*payload.Container{{Id: "0123456789abcdef", Tags: tags}, {Id: "fedcba9876543210"}}
+	addKubernetesOwnershipContext(store, containers)
+	assert.ElementsMatch(t, []string{
+		"image_name:web",
+		"pod_name:web-5d8f7b9c6-x2x4z",
+		"kube_namespace:prod",
+		"kube_ownerref_kind:deployment",
+		"kube_ownerref_name:web",
+		"kube_deployment:web",
+	}, containers[0].Tags)
+	assert.Equal(t, []string{"image_name:web", "pod_name:web-5d8f7b9c6-x2x4z"}, tags)
+	assert.Empty(t, containers[1].Tags)
+}
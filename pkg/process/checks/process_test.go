@@ -13,6 +13,8 @@ import (
 	"time"
 
 	model "github.com/DataDog/agent-payload/v5/process"
+	mockStatsd "github.com/DataDog/datadog-go/v5/statsd/mocks"
+	"github.com/golang/mock/gomock"
 	"github.com/shirou/gopsutil/v3/cpu"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -24,6 +26,7 @@ import (
 	workloadmeta "github.com/DataDog/datadog-agent/comp/core/workloadmeta/def"
 	workloadmetafxmock "github.com/DataDog/datadog-agent/comp/core/workloadmeta/fx-mock"
 	workloadmetamock "github.com/DataDog/datadog-agent/comp/core/workloadmeta/mock"
+	runqueuelatency "github.com/DataDog/datadog-agent/pkg/collector/corechecks/ebpf/probe/runqueuelatency/model"
 	configmock "github.com/DataDog/datadog-agent/pkg/config/mock"
 	"github.com/DataDog/datadog-agent/pkg/process/metadata"
 	"github.com/DataDog/datadog-agent/pkg/process/metadata/parser"
@@ -335,6 +338,107 @@ func TestOnlyEnvConfigArgsScrubbingDisabled(t *testing.T) {
 	}
 }
 
+func TestSharedScrubbingConfig(t *testing.T) {
+	cfg := configmock.New(t)
+	cfg.SetWithoutSource("process_config.scrubbing.sensitive_words", []string{"consul_token"})
+	cfg.SetWithoutSource("process_config.scrubbing.replacement", "[redacted]")
+
+	scrubber := procutil.NewDefaultDataScrubber()
+	initScrubber(cfg, scrubber)
+
+	cmdline, changed := scrubber.ScrubCommand([]string{"spidly", "--password=123", "consul_token", "1234"})
+	assert.True(t, changed)
+	assert.Equal(t, []string{"spidly", "--password=[redacted]", "consul_token", "[redacted]"}, cmdline)
+}
+
+func TestSendPressureStallMetrics(t *testing.T) {
+	now := time.Now()
+	containers := []*model.Container{{Id: "abc"}, {Id: "def"}, {Id: "ghi"}}
+	previousRates := map[string]*proccontainers.ContainerRateMetrics{
+		"abc": {ContainerStatsTimestamp: now.Add(-10 * time.Second), CPUPressure: 1e9, MemoryPressure: 5e8, IOPressure: -1},
+		"def": {CPUPressure: 1e9, MemoryPressure: 5e8, IOPressure: -1},
+	}
+	rates := map[string]*proccontainers.ContainerRateMetrics{
+		"abc": {ContainerStatsTimestamp: now, CPUPressure: 3e9, MemoryPressure: 1.5e9, IOPressure: -1},
+		"def": {ContainerStatsTimestamp: now, CPUPressure: 3e9, MemoryPressure: 1.5e9, IOPressure: -1},
+		"ghi": {ContainerStatsTimestamp: now, CPUPressure: 3e9, MemoryPressure: 1.5e9, IOPressure: -1},
+	}
+
+	// only the containers collected twice with their stall times are reported
+	ctrl := gomock.NewController(t)
+	statsdClient := mockStatsd.NewMockClientInterface(ctrl)
+	statsdClient.EXPECT().Histogram("process.container.pressure.cpu", float64(20), nil, float64(1)).Times(1)
+	statsdClient.EXPECT().Histogram("process.container.pressure.memory", float64(10), nil, float64(1)).Times(1)
+
+	sendPressureStallMetrics(statsdClient, containers, rates, previousRates)
+}
+
+func TestAddPressureStallContext(t *testing.T) {
+	procsByCtr := map[string][]*model.Process{
+		"":    {{Pid: 1}},
+		"abc": {{Pid: 2, ProcessContext: []string{"process_context:nginx"}}},
+	}
+	containers := []*model.Container{
+		{Id: "abc", Tags: []string{"image_name:nginx", "cpu_pressure:medium", "io_pressure:low"}},
+	}
+
+	addPressureStallContext(procsByCtr, containers)
+	assert.Empty(t, procsByCtr[""][0].ProcessContext)
+	assert.Equal(t, []string{"process_context:nginx", "cpu_pressure:medium", "io_pressure:low"}, procsByCtr["abc"][0].ProcessContext)
+}
+
+func TestAddContainerInitContext(t *testing.T) {
+	store := fxutil.Test[workloadmetamock.Mock](t, fx.Options(
+		core.MockBundle(),
+		workloadmetafxmock.MockModule(workloadmeta.NewParams()),
+	))
+	store.Set(&workloadmeta.Container{
+		EntityID: workloadmeta.EntityID{
+			Kind: workloadmeta.KindContainer,
+			ID:   "abc",
+		},
+		PID: 20,
+	})
+
+	procsByCtr := map[string][]*model.Process{
+		"":    {{Pid: 1, NsPid: 1}},
+		"abc": {{Pid: 20, NsPid: 1}, {Pid: 21, NsPid: 7}},
+		"def": {{Pid: 30, NsPid: 5}, {Pid: 31, NsPid: 1}},
+	}
+	containers := []*model.Container{{Id: "abc"}, {Id: "def", Tags: []string{"env:prod"}}}
+
+	addContainerInitContext(store, procsByCtr, containers)
+	assert.Equal(t, []string{containerInitPidTagName + ":20"}, containers[0].Tags)
+
+	// the runtime doesn't report the init process of the container, the first process of its pid namespace is used
+	assert.Equal(t, []string{"env:prod", containerInitPidTagName + ":31"}, containers[1].Tags)
+
+	// the process contexts are left untouched
+	for _, procs := range procsByCtr {
+		for _, proc := range procs {
+			assert.Empty(t, proc.ProcessContext)
+		}
+	}
+}
+
+func TestAddRunQueueLatencyContext(t *testing.T) {
+	procsByCtr := map[string][]*model.Process{
+		"":    {{Pid: 1}, {Pid: 2}},
+		"abc": {{Pid: 3, ProcessContext: []string{"process_context:nginx"}}},
+	}
+	stats := []runqueuelatency.RunQueueLatencyStats{
+		{Pid: 1, TotalNs: 3000000, MaxNs: 25000000, Count: 2},
+		{Pid: 3, TotalNs: 40000, MaxNs: 40000, Count: 1},
+		{Pid: 4, TotalNs: 1000, MaxNs: 1000, Count: 1},
+	}
+
+	// only the reported processes are accounted
+	addRunQueueLatencyContext(procsByCtr, stats)
+	assert.Equal(t, []string{"run_queue_latency_avg:medium", "run_queue_latency_max:high"}, procsByCtr[""][0].ProcessContext)
+	assert.Empty(t, procsByCtr[""][1].ProcessContext)
+	assert.Equal(t, []string{"process_context:nginx", "run_queue_latency_avg:low", "run_queue_latency_max:low"}, procsByCtr["abc"][0].ProcessContext)
+}
+
 func TestDisallowList(t *testing.T) {
 	testDisallowList := []string{
 		"^getty",
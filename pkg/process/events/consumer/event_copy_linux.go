@@ -69,5 +69,8 @@ func (p *ProcessConsumer) Copy(event *smodel.Event) any {
 		valueExitCode := event.GetExitCode()
 		result.ExitCode = valueExitCode
 	}
+
+	valueIdentity := event.GetProcessIdentity()
+	result.Identity = valueIdentity
 	return &result
 }
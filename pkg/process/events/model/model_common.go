@@ -70,6 +70,13 @@ type ProcessEvent struct {
 	ExecTime       time.Time `json:"exec_time,omitempty" msg:"exec_time,omitempty" copy:"GetProcessExecTime;event:ExecEventType"`
 	ExitTime       time.Time `json:"exit_time,omitempty" msg:"exit_time,omitempty" copy:"GetProcessExitTime;event:ExitEventType"`
 	ExitCode       uint32    `json:"exit_code,omitempty" msg:"exit_code,omitempty" copy:"GetExitCode;event:ExitEventType"`
+	Identity       string    `json:"identity,omitempty" msg:"identity,omitempty" copy_linux:"GetProcessIdentity;event:*"`
+
+	// Kubernetes ownership of the container of the process, resolved by the process-agent
+	PodName      string `json:"pod_name,omitempty" msg:"-"`
+	PodNamespace string `json:"pod_namespace,omitempty" msg:"-"`
+	WorkloadKind string `json:"workload_kind,omitempty" msg:"-"`
+	WorkloadName string `json:"workload_name,omitempty" msg:"-"`
 }
 
 // NewMockedForkEvent creates a mocked Fork event for tests
@@ -185,6 +185,12 @@ func (z *ProcessEvent) DecodeMsg(dc *msgp.Reader) (err error) {
 				err = msgp.WrapError(err, "ExitCode")
 				return
 			}
+		case "identity":
+			z.Identity, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Identity")
+				return
+			}
 		default:
 			err = dc.Skip()
 			if err != nil {
@@ -198,9 +204,9 @@ func (z *ProcessEvent) DecodeMsg(dc *msgp.Reader) (err error) {
 
 // EncodeMsg implements msgp.Encodable
 func (z *ProcessEvent) EncodeMsg(en *msgp.Writer) (err error) {
-	// omitempty: check for empty values
-	zb0001Len := uint32(15)
-	var zb0001Mask uint16 /* 15 bits */
+	// check for omitted fields
+	zb0001Len := uint32(16)
+	var zb0001Mask uint16 /* 16 bits */
 	_ = zb0001Mask
 	if z.ForkTime == (time.Time{}) {
 		zb0001Len--
@@ -218,8 +224,12 @@ func (z *ProcessEvent) EncodeMsg(en *msgp.Writer) (err error) {
 		zb0001Len--
 		zb0001Mask |= 0x4000
 	}
+	if z.Identity == "" {
+		zb0001Len--
+		zb0001Mask |= 0x8000
+	}
 	// variable map header, size zb0001Len
-	err = en.Append(0x80 | uint8(zb0001Len))
+	err = en.WriteMapHeader(zb0001Len)
 	if err != nil {
 		return
 	}
@@ -343,7 +353,7 @@ func (z *ProcessEvent) EncodeMsg(en *msgp.Writer) (err error) {
 			return
 		}
 	}
-	if (zb0001Mask & 0x800) == 0 { // if not empty
+	if (zb0001Mask & 0x800) == 0 { // if not omitted
 		// write "fork_time"
 		err = en.Append(0xa9, 0x66, 0x6f, 0x72, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65)
 		if err != nil {
@@ -355,7 +365,7 @@ func (z *ProcessEvent) EncodeMsg(en *msgp.Writer) (err error) {
 			return
 		}
 	}
-	if (zb0001Mask & 0x1000) == 0 { // if not empty
+	if (zb0001Mask & 0x1000) == 0 { // if not omitted
 		// write "exec_time"
 		err = en.Append(0xa9, 0x65, 0x78, 0x65, 0x63, 0x5f, 0x74, 0x69, 0x6d, 0x65)
 		if err != nil {
@@ -367,7 +377,7 @@ func (z *ProcessEvent) EncodeMsg(en *msgp.Writer) (err error) {
 			return
 		}
 	}
-	if (zb0001Mask & 0x2000) == 0 { // if not empty
+	if (zb0001Mask & 0x2000) == 0 { // if not omitted
 		// write "exit_time"
 		err = en.Append(0xa9, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65)
 		if err != nil {
@@ -379,7 +389,7 @@ func (z *ProcessEvent) EncodeMsg(en *msgp.Writer) (err error) {
 			return
 		}
 	}
-	if (zb0001Mask & 0x4000) == 0 { // if not empty
+	if (zb0001Mask & 0x4000) == 0 { // if not omitted
 		// write "exit_code"
 		err = en.Append(0xa9, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65)
 		if err != nil {
@@ -391,15 +401,27 @@ func (z *ProcessEvent) EncodeMsg(en *msgp.Writer) (err error) {
 			return
 		}
 	}
+	if (zb0001Mask & 0x8000) == 0 { // if not omitted
+		// write "identity"
+		err = en.Append(0xa8, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79)
+		if err != nil {
+			return
+		}
+		err = en.WriteString(z.Identity)
+		if err != nil {
+			err = msgp.WrapError(err, "Identity")
+			return
+		}
+	}
 	return
 }
 
 // MarshalMsg implements msgp.Marshaler
 func (z *ProcessEvent) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// omitempty: check for empty values
-	zb0001Len := uint32(15)
-	var zb0001Mask uint16 /* 15 bits */
+	// check for omitted fields
+	zb0001Len := uint32(16)
+	var zb0001Mask uint16 /* 16 bits */
 	_ = zb0001Mask
 	if z.ForkTime == (time.Time{}) {
 		zb0001Len--
@@ -417,8 +439,12 @@ func (z *ProcessEvent) MarshalMsg(b []byte) (o []byte, err error) {
 		zb0001Len--
 		zb0001Mask |= 0x4000
 	}
+	if z.Identity == "" {
+		zb0001Len--
+		zb0001Mask |= 0x8000
+	}
 	// variable map header, size zb0001Len
-	o = append(o, 0x80|uint8(zb0001Len))
+	o = msgp.AppendMapHeader(o, zb0001Len)
 	if zb0001Len == 0 {
 		return
 	}
@@ -458,26 +484,31 @@ func (z *ProcessEvent) MarshalMsg(b []byte) (o []byte, err error) {
 	for za0001 := range z.Cmdline {
 		o = msgp.AppendString(o, z.Cmdline[za0001])
 	}
-	if (zb0001Mask & 0x800) == 0 { // if not empty
+	if (zb0001Mask & 0x800) == 0 { // if not omitted
 		// string "fork_time"
 		o = append(o, 0xa9, 0x66, 0x6f, 0x72, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65)
 		o = msgp.AppendTime(o, z.ForkTime)
 	}
-	if (zb0001Mask & 0x1000) == 0 { // if not empty
+	if (zb0001Mask & 0x1000) == 0 { // if not omitted
 		// string "exec_time"
 		o = append(o, 0xa9, 0x65, 0x78, 0x65, 0x63, 0x5f, 0x74, 0x69, 0x6d, 0x65)
 		o = msgp.AppendTime(o, z.ExecTime)
 	}
-	if (zb0001Mask & 0x2000) == 0 { // if not empty
+	if (zb0001Mask & 0x2000) == 0 { // if not omitted
 		// string "exit_time"
 		o = append(o, 0xa9, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65)
 		o = msgp.AppendTime(o, z.ExitTime)
 	}
-	if (zb0001Mask & 0x4000) == 0 { // if not empty
+	if (zb0001Mask & 0x4000) == 0 { // if not omitted
 		// string "exit_code"
 		o = append(o, 0xa9, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65)
 		o = msgp.AppendUint32(o, z.ExitCode)
 	}
+	if (zb0001Mask & 0x8000) == 0 { // if not omitted
+		// string "identity"
+		o = append(o, 0xa8, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79)
+		o = msgp.AppendString(o, z.Identity)
+	}
 	return
 }
 
@@ -606,6 +637,12 @@ func (z *ProcessEvent) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				err = msgp.WrapError(err, "ExitCode")
 				return
 			}
+		case "identity":
+			z.Identity, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Identity")
+				return
+			}
 		default:
 			bts, err = msgp.Skip(bts)
 			if err != nil {
@@ -620,10 +657,10 @@ func (z *ProcessEvent) UnmarshalMsg(bts []byte) (o []byte, err error) {
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
 func (z *ProcessEvent) Msgsize() (s int) {
-	s = 1 + 11 + msgp.Int32Size + 16 + msgp.TimeSize + 4 + msgp.Uint32Size + 13 + msgp.StringPrefixSize + len(z.ContainerID) + 5 + msgp.Uint32Size + 4 + msgp.Uint32Size + 4 + msgp.Uint32Size + 9 + msgp.StringPrefixSize + len(z.Username) + 6 + msgp.StringPrefixSize + len(z.Group) + 4 + msgp.StringPrefixSize + len(z.Exe) + 8 + msgp.ArrayHeaderSize
+	s = 3 + 11 + msgp.Int32Size + 16 + msgp.TimeSize + 4 + msgp.Uint32Size + 13 + msgp.StringPrefixSize + len(z.ContainerID) + 5 + msgp.Uint32Size + 4 + msgp.Uint32Size + 4 + msgp.Uint32Size + 9 + msgp.StringPrefixSize + len(z.Username) + 6 + msgp.StringPrefixSize + len(z.Group) + 4 + msgp.StringPrefixSize + len(z.Exe) + 8 + msgp.ArrayHeaderSize
 	for za0001 := range z.Cmdline {
 		s += msgp.StringPrefixSize + len(z.Cmdline[za0001])
 	}
-	s += 10 + msgp.TimeSize + 10 + msgp.TimeSize + 10 + msgp.TimeSize + 10 + msgp.Uint32Size
+	s += 10 + msgp.TimeSize + 10 + msgp.TimeSize + 10 + msgp.TimeSize + 10 + msgp.Uint32Size + 9 + msgp.StringPrefixSize + len(z.Identity)
 	return
 }
@@ -18,6 +18,7 @@ import (
 	sysconfigtypes "github.com/DataDog/datadog-agent/cmd/system-probe/config/types"
 	ebpfcheck "github.com/DataDog/datadog-agent/pkg/collector/corechecks/ebpf/probe/ebpfcheck/model"
 	oomkill "github.com/DataDog/datadog-agent/pkg/collector/corechecks/ebpf/probe/oomkill/model"
+	runqueuelatency "github.com/DataDog/datadog-agent/pkg/collector/corechecks/ebpf/probe/runqueuelatency/model"
 	tcpqueuelength "github.com/DataDog/datadog-agent/pkg/collector/corechecks/ebpf/probe/tcpqueuelength/model"
 	gpu "github.com/DataDog/datadog-agent/pkg/collector/corechecks/gpu/model"
 )
@@ -61,6 +62,13 @@ func (r *RemoteSysProbeUtil) GetCheck(module sysconfigtypes.ModuleName) (interfa
 			return nil, err
 		}
 		return stats, nil
+	} else if module == sysconfig.RunQueueLatencyProbeModule {
+		var stats []runqueuelatency.RunQueueLatencyStats
+		err = json.Unmarshal(body, &stats)
+		if err != nil {
+			return nil, err
+		}
+		return stats, nil
 	} else if module == sysconfig.EBPFModule {
 		var stats ebpfcheck.EBPFStats
 		err = json.Unmarshal(body, &stats)
@@ -9,8 +9,10 @@ package procutil
 import (
 	"bytes"
 	"regexp"
+	"slices"
 	"strings"
 
+	pkgconfigsetup "github.com/DataDog/datadog-agent/pkg/config/setup"
 	"github.com/DataDog/datadog-agent/pkg/util/log"
 )
 
@@ -35,10 +37,13 @@ type DataScrubber struct {
 	Enabled           bool
 	StripAllArguments bool
 	SensitivePatterns []DataScrubberPattern
-	seenProcess       map[processCacheKey]struct{}
-	scrubbedCmdlines  map[processCacheKey][]string
-	cacheCycles       uint32 // used to control the cache age
-	cacheMaxCycles    uint32 // number of cycles before resetting the cache content
+	// CustomPatterns are matched against each argument, their matches being replaced as a whole
+	CustomPatterns   []*regexp.Regexp
+	Replacement      string
+	seenProcess      map[processCacheKey]struct{}
+	scrubbedCmdlines map[processCacheKey][]string
+	cacheCycles      uint32 // used to control the cache age
+	cacheMaxCycles   uint32 // number of cycles before resetting the cache content
 }
 
 // NewDefaultDataScrubber creates a DataScrubber with the default behavior: enabled
@@ -48,6 +53,7 @@ func NewDefaultDataScrubber() *DataScrubber {
 	newDataScrubber := &DataScrubber{
 		Enabled:           true,
 		SensitivePatterns: patterns,
+		Replacement:       pkgconfigsetup.DefaultScrubbingReplacement,
 		seenProcess:       make(map[processCacheKey]struct{}),
 		scrubbedCmdlines:  make(map[processCacheKey][]string),
 		cacheCycles:       0,
@@ -168,6 +174,7 @@ func (ds *DataScrubber) ScrubCommand(cmdline []string) ([]string, bool) {
 	rawCmdline := strings.Join(cmdline, " ")
 	lowerCaseCmdline := strings.ToLower(rawCmdline)
 	changed := false
+	replacement := "${key}${delimiter}" + strings.ReplaceAll(ds.Replacement, "$", "$$")
 	for _, pattern := range ds.SensitivePatterns {
 		// fast check with direct pattern
 		if !strings.Contains(lowerCaseCmdline, pattern.FastCheck) {
@@ -176,16 +183,45 @@ func (ds *DataScrubber) ScrubCommand(cmdline []string) ([]string, bool) {
 
 		if pattern.Re.MatchString(rawCmdline) {
 			changed = true
-			rawCmdline = pattern.Re.ReplaceAllString(rawCmdline, "${key}${delimiter}********")
+			rawCmdline = pattern.Re.ReplaceAllString(rawCmdline, replacement)
 		}
 	}
 
 	if changed {
 		newCmdline = strings.Split(rawCmdline, " ")
 	}
+
+	for i, arg := range newCmdline {
+		for _, re := range ds.CustomPatterns {
+			scrubbed := re.ReplaceAllLiteralString(arg, ds.Replacement)
+			if scrubbed == arg {
+				continue
+			}
+			if !changed {
+				// never modify the command line of the caller
+				newCmdline = slices.Clone(newCmdline)
+				changed = true
+			}
+			arg = scrubbed
+			newCmdline[i] = arg
+		}
+	}
+
 	return newCmdline, changed
 }
 
+// AddCustomPatterns adds regular expressions whose matches are scrubbed from each argument
+func (ds *DataScrubber) AddCustomPatterns(patterns []string) {
+	for _, pattern := range patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			log.Warnf("data scrubber: invalid pattern %s skipped: %s", pattern, err)
+			continue
+		}
+		ds.CustomPatterns = append(ds.CustomPatterns, re)
+	}
+}
+
 // AddCustomSensitiveWords adds custom sensitive words on the DataScrubber object
 func (ds *DataScrubber) AddCustomSensitiveWords(words []string) {
 	newPatterns := CompileStringsToRegex(words)
@@ -0,0 +1,38 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package procutil
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+)
+
+// ProcessIdentityTagName is the name of the tag holding the identity of a process
+const ProcessIdentityTagName = "process_identity"
+
+// ProcessIdentity returns a token identifying a process across the agent products, so that the records about the same
+// process can be joined. It is computed from the boot ID of the host, the pid of the process and its start time in
+// clock ticks since boot, as reported by the field 22 of /proc/[pid]/stat, so that it remains stable across the
+// executions of the process and is never reused, even when the pid is. The start time is used as is, rather than
+// converted to an absolute time, so that all the products compute the same token regardless of the precision of their
+// boot time. An empty token is returned when the boot ID isn't available.
+func ProcessIdentity(pid uint32, startTicks uint64) string {
+	bootID := getBootID()
+	if bootID == "" {
+		return ""
+	}
+
+	h := sha256.New()
+	h.Write([]byte(bootID))
+
+	var buf [12]byte
+	binary.LittleEndian.PutUint32(buf[0:4], pid)
+	binary.LittleEndian.PutUint64(buf[4:12], startTicks)
+	h.Write(buf[:])
+
+	return hex.EncodeToString(h.Sum(nil)[:8])
+}
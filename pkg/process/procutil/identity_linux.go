@@ -0,0 +1,68 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package procutil
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/DataDog/datadog-agent/pkg/util/funcs"
+	"github.com/DataDog/datadog-agent/pkg/util/kernel"
+)
+
+// getBootID returns the ID of the current boot of the host
+var getBootID = funcs.MemoizeNoError(func() string {
+	content, err := os.ReadFile(kernel.HostProc("sys/kernel/random/boot_id"))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(content))
+})
+
+// GetProcessStartTicks returns the start time of the process in clock ticks since boot, from the field 22 of
+// /proc/[pid]/stat
+func GetProcessStartTicks(pid uint32) (uint64, error) {
+	content, err := os.ReadFile(kernel.HostProc(strconv.FormatUint(uint64(pid), 10), "stat"))
+	if err != nil {
+		return 0, err
+	}
+	return parseStartTicks(content)
+}
+
+// parseStartTicks returns the field 22 of the provided content of a stat file
+func parseStartTicks(content []byte) (uint64, error) {
+	// the command name, 2nd field, is wrapped in parenthesis and may contain spaces and parenthesis
+	index := bytes.LastIndexByte(content, ')')
+	if index == -1 {
+		return 0, errors.New("invalid stat content")
+	}
+
+	// the fields following the command name start at the 3rd one
+	fields := strings.Fields(string(content[index+1:]))
+	if len(fields) < 20 {
+		return 0, errors.New("invalid stat content")
+	}
+	return strconv.ParseUint(fields[19], 10, 64)
+}
+
+// nsPerClockTick is the duration of the clock ticks in which procfs reports the start times, USER_HZ is part of the
+// kernel ABI
+const nsPerClockTick = uint64(time.Second) / uint64(DefaultClockTicks)
+
+// StartTicksFromBootTime converts a start time in nanoseconds since boot, as provided by the kernel, to the clock ticks
+// reported by /proc/[pid]/stat
+func StartTicksFromBootTime(ns uint64) uint64 {
+	return ns / nsPerClockTick
+}
+
+// BootTimeFromStartTicks converts a start time in clock ticks since boot to nanoseconds since boot
+func BootTimeFromStartTicks(ticks uint64) uint64 {
+	return ticks * nsPerClockTick
+}
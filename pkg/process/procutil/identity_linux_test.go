@@ -0,0 +1,40 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package procutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseStartTicks(t *testing.T) {
+	ticks, err := parseStartTicks([]byte("1 ((sd pam)) S 0 1 1 0 -1 4194560 425768 306165945 70 4299 4890 2184 563120 375308 20 0 1 0 1534 189849600 1541"))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1534), ticks)
+
+	_, err = parseStartTicks([]byte("1 (systemd) S 0 1 1"))
+	assert.Error(t, err)
+}
+
+func TestGetProcessStartTicks(t *testing.T) {
+	t.Setenv("HOST_PROC", "resources/test_procfs/proc/")
+
+	// the start time matches the one reported in the stats of the process, so that both compute the same identity
+	ticks, err := GetProcessStartTicks(1)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(15), ticks)
+
+	_, err = GetProcessStartTicks(2)
+	assert.Error(t, err)
+}
+
+func TestStartTicksFromBootTime(t *testing.T) {
+	// the kernel truncates the start time to the clock tick
+	assert.Equal(t, uint64(1534), StartTicksFromBootTime(15_349_999_999))
+	assert.Equal(t, uint64(1534), StartTicksFromBootTime(BootTimeFromStartTicks(1534)))
+}
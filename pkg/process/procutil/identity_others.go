@@ -0,0 +1,13 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build !linux
+
+package procutil
+
+// getBootID returns the ID of the current boot of the host, which isn't available on this platform
+var getBootID = func() string {
+	return ""
+}
@@ -0,0 +1,29 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package procutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProcessIdentity(t *testing.T) {
+	defer func(fn func() string) { getBootID = fn }(getBootID)
+
+	getBootID = func() string { return "" }
+	assert.Empty(t, ProcessIdentity(42, 123456))
+
+	getBootID = func() string { return "0b3e6a5e-5a61-4d8e-9d55-6d3f0d1f3c2a" }
+	identity := ProcessIdentity(42, 123456)
+	assert.Len(t, identity, 16)
+	assert.Equal(t, identity, ProcessIdentity(42, 123456))
+	assert.NotEqual(t, identity, ProcessIdentity(43, 123456))
+	assert.NotEqual(t, identity, ProcessIdentity(42, 123457))
+
+	getBootID = func() string { return "5c1f2e7d-2b44-4f0e-8f8a-1a2b3c4d5e6f" }
+	assert.NotEqual(t, identity, ProcessIdentity(42, 123456))
+}
@@ -70,6 +70,7 @@ type statusInfo struct {
 type statInfo struct {
 	ppid       int32
 	createTime int64
+	startTicks uint64
 	nice       int32
 	flags      uint32
 	cpuStat    *CPUTimesStat
@@ -211,6 +212,7 @@ func (p *probe) StatsForPIDs(pids []int32, now time.Time) (map[int32]*Stats, err
 
 		stats := &Stats{
 			CreateTime:  statInfo.createTime,       // /proc/[pid]/stat
+			StartTicks:  statInfo.startTicks,       // /proc/[pid]/stat
 			Status:      string(statusInfo.status), // /proc/[pid]/status
 			Nice:        statInfo.nice,             // /proc/[pid]/stat
 			CPUTime:     statInfo.cpuStat,          // /proc/[pid]/stat
@@ -291,6 +293,7 @@ func (p *probe) ProcessesByPID(now time.Time, collectStats bool) (map[int32]*Pro
 			NsPid:   statusInfo.nspid,                          // /proc/[pid]/status
 			Stats: &Stats{
 				CreateTime:  statInfo.createTime,       // /proc/[pid]/stat
+				StartTicks:  statInfo.startTicks,       // /proc/[pid]/stat
 				Status:      string(statusInfo.status), // /proc/[pid]/status
 				Nice:        statInfo.nice,             // /proc/[pid]/stat
 				CPUTime:     statInfo.cpuStat,          // /proc/[pid]/stat
@@ -649,6 +652,7 @@ func (p *probe) parseStatContent(statContent []byte, sInfo *statInfo, pid int32,
 					}
 				case 20:
 					if t, err := strconv.ParseUint(string(buffer), 10, 64); err == nil {
+						sInfo.startTicks = t
 						ctime := (t / uint64(p.clockTicks)) + p.bootTime.Load()
 						// convert create time into milliseconds
 						sInfo.createTime = int64(ctime * 1000)
@@ -859,6 +859,7 @@ func TestParseStatContent(t *testing.T) {
 			expected: &statInfo{
 				ppid:       0,
 				createTime: 1606181252000,
+				startTicks: 15,
 				cpuStat: &CPUTimesStat{
 					User:      48.9,
 					System:    21.84,
@@ -874,6 +875,7 @@ func TestParseStatContent(t *testing.T) {
 			expected: &statInfo{
 				ppid:       0,
 				createTime: 1606181252000,
+				startTicks: 15,
 				cpuStat: &CPUTimesStat{
 					User:      48.9,
 					System:    21.84,
@@ -889,6 +891,7 @@ func TestParseStatContent(t *testing.T) {
 			expected: &statInfo{
 				ppid:       2,
 				createTime: 1606181252000,
+				startTicks: 17,
 				cpuStat: &CPUTimesStat{
 					User:      0,
 					System:    0,
@@ -904,6 +907,7 @@ func TestParseStatContent(t *testing.T) {
 			expected: &statInfo{
 				ppid:       2,
 				createTime: 1606181252000,
+				startTicks: 31,
 				cpuStat: &CPUTimesStat{
 					User:      0,
 					System:    0,
@@ -76,6 +76,8 @@ func (p *Process) DeepCopy() *Process {
 // Stats holds all relevant stats metrics of a process
 type Stats struct {
 	CreateTime int64
+	// StartTicks is the start time of the process in clock ticks since boot, only available on Linux
+	StartTicks uint64
 	// Status returns the process status.
 	// Return value could be one of these.
 	// R: Running S: Sleep T: Stop I: Idle
@@ -99,6 +101,7 @@ func (s *Stats) DeepCopy() *Stats {
 	//nolint:revive // TODO(PROC) Fix revive linter
 	copy := &Stats{
 		CreateTime:  s.CreateTime,
+		StartTicks:  s.StartTicks,
 		Status:      s.Status,
 		Nice:        s.Nice,
 		OpenFdCount: s.OpenFdCount,
@@ -0,0 +1,73 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package procutil
+
+import (
+	"slices"
+	"strings"
+
+	pkgconfigmodel "github.com/DataDog/datadog-agent/pkg/config/model"
+)
+
+const scrubbingConfigPrefix = "process_config.scrubbing."
+
+// ScrubbingConfig defines the scrubbing policy of the process command lines and environment variables. It is
+// configured once in the `process_config.scrubbing` section and shared by the process checks and the runtime security
+// module.
+type ScrubbingConfig struct {
+	// SensitiveWords lists the words, on top of the default ones, whose argument values are scrubbed
+	SensitiveWords []string
+	// Patterns lists the regular expressions whose matches are scrubbed from each argument
+	Patterns []string
+	// Replacement replaces the scrubbed values
+	Replacement string
+	// EnvsWithValue lists the patterns of the environment variables whose value can be exported
+	EnvsWithValue []string
+	// EnvsWithoutValue lists the patterns of the environment variables whose value must never be exported
+	EnvsWithoutValue []string
+}
+
+// NewScrubbingConfig reads the shared scrubbing policy from the provided config
+func NewScrubbingConfig(config pkgconfigmodel.Reader) ScrubbingConfig {
+	return ScrubbingConfig{
+		SensitiveWords:   config.GetStringSlice(scrubbingConfigPrefix + "sensitive_words"),
+		Patterns:         config.GetStringSlice(scrubbingConfigPrefix + "patterns"),
+		Replacement:      config.GetString(scrubbingConfigPrefix + "replacement"),
+		EnvsWithValue:    config.GetStringSlice(scrubbingConfigPrefix + "envs_with_value"),
+		EnvsWithoutValue: config.GetStringSlice(scrubbingConfigPrefix + "envs_without_value"),
+	}
+}
+
+// Apply applies the scrubbing policy to the provided data scrubber
+func (c ScrubbingConfig) Apply(ds *DataScrubber) {
+	if len(c.SensitiveWords) > 0 {
+		ds.AddCustomSensitiveWords(c.SensitiveWords)
+	}
+	if len(c.Patterns) > 0 {
+		ds.AddCustomPatterns(c.Patterns)
+	}
+	if c.Replacement != "" {
+		ds.Replacement = c.Replacement
+	}
+}
+
+// FilterEnvsWithValue merges the provided environment variable patterns with the ones of the policy whose value can
+// be exported, and appends the ones whose value must never be exported as negated patterns, `!<pattern>`, so that they
+// win over the others, including the globs
+func (c ScrubbingConfig) FilterEnvsWithValue(envs []string) []string {
+	var filtered []string
+	for _, env := range slices.Concat(envs, c.EnvsWithValue) {
+		if !slices.Contains(filtered, env) {
+			filtered = append(filtered, env)
+		}
+	}
+	for _, env := range c.EnvsWithoutValue {
+		if deny := "!" + strings.TrimPrefix(env, "!"); env != "" && !slices.Contains(filtered, deny) {
+			filtered = append(filtered, deny)
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,55 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package procutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScrubbingConfigApply(t *testing.T) {
+	scrubbing := ScrubbingConfig{
+		SensitiveWords: []string{"*secret*"},
+		Replacement:    "$1",
+	}
+
+	scrubber := NewDefaultDataScrubber()
+	scrubbing.Apply(scrubber)
+
+	cmdline, changed := scrubber.ScrubCommand([]string{"agent", "--my_secret_value", "1234", "--password=abc"})
+	assert.True(t, changed)
+	assert.Equal(t, []string{"agent", "--my_secret_value", "$1", "--password=$1"}, cmdline)
+}
+
+func TestScrubbingConfigPatterns(t *testing.T) {
+	scrubbing := ScrubbingConfig{
+		Patterns: []string{`ghp_[A-Za-z0-9]{8}`, `(invalid`},
+	}
+
+	scrubber := NewDefaultDataScrubber()
+	scrubbing.Apply(scrubber)
+	assert.Len(t, scrubber.CustomPatterns, 1)
+
+	cmdline := []string{"git", "clone", "https://ghp_abcd1234@github.com/org/repo", "--password=abc"}
+	scrubbed, changed := scrubber.ScrubCommand(cmdline)
+	assert.True(t, changed)
+	assert.Equal(t, []string{"git", "clone", "https://********@github.com/org/repo", "--password=********"}, scrubbed)
+	assert.Equal(t, "https://ghp_abcd1234@github.com/org/repo", cmdline[2])
+
+	_, changed = scrubber.ScrubCommand([]string{"git", "status"})
+	assert.False(t, changed)
+}
+
+func TestScrubbingConfigFilterEnvsWithValue(t *testing.T) {
+	scrubbing := ScrubbingConfig{
+		EnvsWithValue:    []string{"LANG", "PATH"},
+		EnvsWithoutValue: []string{"LD_PRELOAD", "*_TOKEN"},
+	}
+
+	assert.Equal(t, []string{"LD_PRELOAD", "PATH", "LANG", "!LD_PRELOAD", "!*_TOKEN"}, scrubbing.FilterEnvsWithValue([]string{"LD_PRELOAD", "PATH"}))
+	assert.Empty(t, ScrubbingConfig{}.FilterEnvsWithValue(nil))
+}
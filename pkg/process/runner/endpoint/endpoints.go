@@ -26,7 +26,14 @@ func GetEventsAPIEndpoints(config pkgconfigmodel.Reader) (eps []apicfg.Endpoint,
 	return getAPIEndpointsWithKeys(config, "https://process-events.", "process_config.events_dd_url", "process_config.events_additional_endpoints")
 }
 
-func getAPIEndpointsWithKeys(config pkgconfigmodel.Reader, prefix, defaultEpKey, additionalEpsKey string) (eps []apicfg.Endpoint, err error) {
+// GetConnectionsAPIEndpoints returns the list of api endpoints used to ship connections payloads. Connections are sent
+// to every process endpoint, plus the connections specific additional endpoints, which allows dual-shipping network
+// data only (e.g. while migrating NPM to another org or region).
+func GetConnectionsAPIEndpoints(config pkgconfigmodel.Reader) (eps []apicfg.Endpoint, err error) {
+	return getAPIEndpointsWithKeys(config, "https://process.", "process_config.process_dd_url", "process_config.additional_endpoints", "process_config.connections_additional_endpoints")
+}
+
+func getAPIEndpointsWithKeys(config pkgconfigmodel.Reader, prefix, defaultEpKey string, additionalEpsKeys ...string) (eps []apicfg.Endpoint, err error) {
 	// Setup main endpoint
 	mainEndpointURL, err := url.Parse(utils.GetMainEndpoint(pkgconfigsetup.Datadog(), prefix, defaultEpKey))
 	if err != nil {
@@ -38,17 +45,94 @@ func getAPIEndpointsWithKeys(config pkgconfigmodel.Reader, prefix, defaultEpKey,
 	})
 
 	// Optional additional pairs of endpoint_url => []apiKeys to submit to other locations.
-	for endpointURL, apiKeys := range config.GetStringMapStringSlice(additionalEpsKey) {
+	// The same endpoint/key pair can be listed under several keys, only ship to it once.
+	seen := map[string]bool{
+		endpointKey(mainEndpointURL, eps[0].APIKey): true,
+	}
+	for _, additionalEpsKey := range additionalEpsKeys {
+		for endpointURL, apiKeys := range config.GetStringMapStringSlice(additionalEpsKey) {
+			u, err := url.Parse(endpointURL)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s url '%s': %s", additionalEpsKey, endpointURL, err)
+			}
+			for _, k := range apiKeys {
+				apiKey := utils.SanitizeAPIKey(k)
+				if seen[endpointKey(u, apiKey)] {
+					continue
+				}
+				seen[endpointKey(u, apiKey)] = true
+
+				eps = append(eps, apicfg.Endpoint{
+					APIKey:   apiKey,
+					Endpoint: u,
+				})
+			}
+		}
+	}
+	return
+}
+
+// ProxyGroup is a set of endpoints that must be reached through the same proxy settings
+type ProxyGroup struct {
+	// Proxies holds the proxy settings of the group, nil means that the endpoints are reached directly
+	Proxies *pkgconfigmodel.Proxy
+	// Override is false for the group using the agent wide proxy settings
+	Override  bool
+	Endpoints []apicfg.Endpoint
+}
+
+// GroupEndpointsByProxy splits the endpoints according to the `process_config.endpoints_proxy` setting, which maps an
+// endpoint URL to the proxy URL to use for it (an empty proxy URL means that the endpoint is reached without proxy).
+// Endpoints without override are returned first, within the group using the agent wide proxy settings.
+func GroupEndpointsByProxy(config pkgconfigmodel.Reader, eps []apicfg.Endpoint) ([]ProxyGroup, error) {
+	overrides := make(map[string]string)
+	for endpointURL, proxyURL := range config.GetStringMapString("process_config.endpoints_proxy") {
 		u, err := url.Parse(endpointURL)
 		if err != nil {
-			return nil, fmt.Errorf("invalid %s url '%s': %s", additionalEpsKey, endpointURL, err)
+			return nil, fmt.Errorf("invalid process_config.endpoints_proxy url '%s': %s", endpointURL, err)
 		}
-		for _, k := range apiKeys {
-			eps = append(eps, apicfg.Endpoint{
-				APIKey:   utils.SanitizeAPIKey(k),
-				Endpoint: u,
-			})
+		if proxyURL != "" {
+			if _, err := url.Parse(proxyURL); err != nil {
+				return nil, fmt.Errorf("invalid process_config.endpoints_proxy proxy for '%s': %s", endpointURL, err)
+			}
 		}
+		overrides[u.Scheme+"://"+u.Host] = proxyURL
 	}
-	return
+
+	groups := []ProxyGroup{{}}
+	groupIndexes := make(map[string]int)
+	for _, ep := range eps {
+		proxyURL, found := overrides[ep.Endpoint.Scheme+"://"+ep.Endpoint.Host]
+		if !found {
+			groups[0].Endpoints = append(groups[0].Endpoints, ep)
+			continue
+		}
+
+		index, exists := groupIndexes[proxyURL]
+		if !exists {
+			group := ProxyGroup{Override: true}
+			if proxyURL != "" {
+				group.Proxies = &pkgconfigmodel.Proxy{
+					HTTP:  proxyURL,
+					HTTPS: proxyURL,
+				}
+			}
+
+			index = len(groups)
+			groupIndexes[proxyURL] = index
+			groups = append(groups, group)
+		}
+		groups[index].Endpoints = append(groups[index].Endpoints, ep)
+	}
+
+	if len(groups[0].Endpoints) == 0 {
+		groups = groups[1:]
+	}
+
+	return groups, nil
+}
+
+// endpointKey identifies an endpoint/key pair, the separator can't be part of either of them
+func endpointKey(u *url.URL, apiKey string) string {
+	return u.String() + " " + apiKey
 }
@@ -310,3 +310,168 @@ func TestGetConcurrentAPIEndpoints(t *testing.T) {
 		})
 	}
 }
+
+// TestGetConnectionsAPIEndpoints ensures that connections are shipped to the process endpoints plus the connections
+// specific additional endpoints, without duplicates
+func TestGetConnectionsAPIEndpoints(t *testing.T) {
+	for _, tc := range []struct {
+		name                           string
+		additionalEndpoints            map[string][]string
+		connectionsAdditionalEndpoints map[string][]string
+		expected                       []apicfg.Endpoint
+	}{
+		{
+			name: "default",
+			expected: []apicfg.Endpoint{
+				{
+					APIKey:   "test",
+					Endpoint: mkurl(pkgconfigsetup.DefaultProcessEndpoint),
+				},
+			},
+		},
+		{
+			name: "connections only eps",
+			connectionsAdditionalEndpoints: map[string][]string{
+				"https://process.datadoghq.eu": {
+					"key1",
+				},
+			},
+			expected: []apicfg.Endpoint{
+				{
+					APIKey:   "test",
+					Endpoint: mkurl(pkgconfigsetup.DefaultProcessEndpoint),
+				},
+				{
+					APIKey:   "key1",
+					Endpoint: mkurl("https://process.datadoghq.eu"),
+				},
+			},
+		},
+		{
+			name: "duplicated eps",
+			additionalEndpoints: map[string][]string{
+				"https://process.datadoghq.eu": {
+					"key1",
+				},
+			},
+			connectionsAdditionalEndpoints: map[string][]string{
+				"https://process.datadoghq.eu": {
+					"key1",
+					"key2",
+				},
+				pkgconfigsetup.DefaultProcessEndpoint: {
+					"test",
+				},
+			},
+			expected: []apicfg.Endpoint{
+				{
+					APIKey:   "test",
+					Endpoint: mkurl(pkgconfigsetup.DefaultProcessEndpoint),
+				},
+				{
+					APIKey:   "key1",
+					Endpoint: mkurl("https://process.datadoghq.eu"),
+				},
+				{
+					APIKey:   "key2",
+					Endpoint: mkurl("https://process.datadoghq.eu"),
+				},
+			},
+		},
+		{
+			name: "ambiguous concatenation",
+			additionalEndpoints: map[string][]string{
+				"https://process.datadoghq.eu/a": {
+					"bkey",
+				},
+			},
+			connectionsAdditionalEndpoints: map[string][]string{
+				"https://process.datadoghq.eu/ab": {
+					"key",
+				},
+			},
+			expected: []apicfg.Endpoint{
+				{
+					APIKey:   "test",
+					Endpoint: mkurl(pkgconfigsetup.DefaultProcessEndpoint),
+				},
+				{
+					APIKey:   "bkey",
+					Endpoint: mkurl("https://process.datadoghq.eu/a"),
+				},
+				{
+					APIKey:   "key",
+					Endpoint: mkurl("https://process.datadoghq.eu/ab"),
+				},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := configmock.New(t)
+			cfg.SetWithoutSource("api_key", "test")
+			if tc.additionalEndpoints != nil {
+				cfg.SetWithoutSource("process_config.additional_endpoints", tc.additionalEndpoints)
+			}
+			if tc.connectionsAdditionalEndpoints != nil {
+				cfg.SetWithoutSource("process_config.connections_additional_endpoints", tc.connectionsAdditionalEndpoints)
+			}
+
+			eps, err := endpoint.GetConnectionsAPIEndpoints(cfg)
+			assert.NoError(t, err)
+			assert.ElementsMatch(t, tc.expected, eps)
+
+			// connections specific endpoints must not leak into the process endpoints
+			processEps, err := endpoint.GetAPIEndpoints(cfg)
+			assert.NoError(t, err)
+			for _, ep := range processEps {
+				assert.NotEqual(t, "key2", ep.APIKey)
+			}
+		})
+	}
+}
+
+func TestGroupEndpointsByProxy(t *testing.T) {
+	cfg := configmock.New(t)
+	cfg.SetWithoutSource("api_key", "test")
+	cfg.SetWithoutSource("process_config.additional_endpoints", map[string][]string{
+		"https://process.datadoghq.eu":  {"key1"},
+		"https://process.ddog-gov.com":  {"key2"},
+		"https://process.us5.datad0g.o": {"key3"},
+	})
+	cfg.SetWithoutSource("process_config.endpoints_proxy", map[string]string{
+		"https://process.datadoghq.eu":  "http://proxy-eu:3128",
+		"https://process.ddog-gov.com":  "",
+		"https://process.us5.datad0g.o": "http://proxy-eu:3128",
+	})
+
+	eps, err := endpoint.GetAPIEndpoints(cfg)
+	assert.NoError(t, err)
+
+	groups, err := endpoint.GroupEndpointsByProxy(cfg, eps)
+	assert.NoError(t, err)
+	assert.Len(t, groups, 3)
+
+	// the main endpoint uses the agent wide proxy settings
+	assert.False(t, groups[0].Override)
+	assert.Equal(t, []apicfg.Endpoint{{APIKey: "test", Endpoint: mkurl(pkgconfigsetup.DefaultProcessEndpoint)}}, groups[0].Endpoints)
+
+	for _, group := range groups[1:] {
+		assert.True(t, group.Override)
+		if group.Proxies == nil {
+			assert.Equal(t, []apicfg.Endpoint{{APIKey: "key2", Endpoint: mkurl("https://process.ddog-gov.com")}}, group.Endpoints)
+		} else {
+			assert.Equal(t, "http://proxy-eu:3128", group.Proxies.HTTPS)
+			assert.ElementsMatch(t, []apicfg.Endpoint{
+				{APIKey: "key1", Endpoint: mkurl("https://process.datadoghq.eu")},
+				{APIKey: "key3", Endpoint: mkurl("https://process.us5.datad0g.o")},
+			}, group.Endpoints)
+		}
+	}
+
+	// without override, every endpoint belongs to the same group
+	cfg.SetWithoutSource("process_config.endpoints_proxy", map[string]string{})
+	groups, err = endpoint.GroupEndpointsByProxy(cfg, eps)
+	assert.NoError(t, err)
+	assert.Len(t, groups, 1)
+	assert.ElementsMatch(t, eps, groups[0].Endpoints)
+}
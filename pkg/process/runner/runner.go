@@ -32,6 +32,9 @@ type checkResult struct {
 	name        string
 	payloads    []checkPayload
 	sizeInBytes int64
+	// complete is false when some of the messages couldn't be encoded
+	complete    bool
+	onSubmitted func(delivered bool)
 }
 
 func (cr *checkResult) Weight() int64 {
@@ -110,6 +113,7 @@ func NewRunner(
 		// If the sysprobe module is enabled, the process check can call out to the sysprobe for privileged stats
 		_, processModuleEnabled := sysCfg.EnabledModules[sysconfig.ProcessModule]
 		sysProbeCfg.ProcessModuleEnabled = processModuleEnabled
+		_, sysProbeCfg.RunQueueLatencyModuleEnabled = sysCfg.EnabledModules[sysconfig.RunQueueLatencyProbeModule]
 		sysProbeCfg.MaxConnsPerMessage = sysCfg.MaxConnsPerMessage
 		sysProbeCfg.SystemProbeAddress = sysCfg.SocketAddress
 	}
@@ -160,6 +164,9 @@ func (l *CheckRunner) runCheck(c checks.Check) {
 	status.UpdateLastCollectTime(start)
 
 	result, err := c.Run(l.nextGroupID, nil)
+	if !c.Realtime() {
+		status.UpdateCheckHealth(c.Name(), time.Now(), time.Since(start), err)
+	}
 	if err != nil {
 		log.Errorf("Unable to run check '%s': %s", c.Name(), err)
 		return
@@ -177,8 +184,9 @@ func (l *CheckRunner) runCheck(c checks.Check) {
 	}
 
 	msg := &types.Payload{
-		CheckName: c.Name(),
-		Message:   result.Payloads(),
+		CheckName:   c.Name(),
+		Message:     result.Payloads(),
+		OnSubmitted: submissionListener(result),
 	}
 	l.Submitter.Submit(start, c.Name(), msg)
 
@@ -193,6 +201,9 @@ func (l *CheckRunner) runCheckWithRealTime(c checks.Check, options *checks.RunOp
 	status.UpdateLastCollectTime(start)
 
 	result, err := c.Run(l.nextGroupID, options)
+	if options.RunStandard {
+		status.UpdateCheckHealth(c.Name(), time.Now(), time.Since(start), err)
+	}
 	if err != nil {
 		log.Errorf("Unable to run check '%s': %s", c.Name(), err)
 		return
@@ -204,8 +215,9 @@ func (l *CheckRunner) runCheckWithRealTime(c checks.Check, options *checks.RunOp
 	}
 
 	msg := &types.Payload{
-		CheckName: c.Name(),
-		Message:   result.Payloads(),
+		CheckName:   c.Name(),
+		Message:     result.Payloads(),
+		OnSubmitted: submissionListener(result),
 	}
 	l.Submitter.Submit(start, c.Name(), msg)
 	if options.RunStandard {
@@ -289,10 +301,20 @@ func (l *CheckRunner) Run() error {
 		l.listenForRTUpdates()
 	}
 
+	healthRuns := l.config.GetInt("process_config.health.runs")
+	healthBudgetFactor := l.config.GetFloat64("process_config.health.interval_budget_factor")
+
 	for _, c := range l.enabledChecks {
 		if err := c.Init(l.sysProbeCfg, l.hostInfo, false); err != nil {
 			return err
 		}
+
+		// the realtime checks only run while realtime is enabled, they can't be expected to run on a steady interval
+		if !c.Realtime() {
+			interval := checks.GetInterval(l.config, c.Name())
+			status.RegisterCheckHealth(c.Name(), interval, time.Duration(float64(interval)*healthBudgetFactor), healthRuns)
+		}
+
 		runner, err := l.runnerForCheck(c)
 		if err != nil {
 			return fmt.Errorf("error starting check %s: %s", c.Name(), err)
@@ -488,17 +510,21 @@ func getContainerCount(mb model.MessageBody) int {
 	return 0
 }
 
-func readResponseStatuses(checkName string, responses <-chan defaultforwarder.Response) []*model.CollectorStatus {
+// readResponseStatuses returns the statuses of the responses, and whether they were all successful
+func readResponseStatuses(checkName string, responses <-chan defaultforwarder.Response) ([]*model.CollectorStatus, bool) {
 	var statuses []*model.CollectorStatus
+	delivered := true
 
 	for response := range responses {
 		if response.Err != nil {
 			log.Errorf("[%s] Error from %s: %s", checkName, response.Domain, response.Err)
+			delivered = false
 			continue
 		}
 
 		if response.StatusCode >= 300 {
 			log.Errorf("[%s] Invalid response from %s: %d -> %v", checkName, response.Domain, response.StatusCode, response.Err)
+			delivered = false
 			continue
 		}
 
@@ -526,7 +552,7 @@ func readResponseStatuses(checkName string, responses <-chan defaultforwarder.Re
 		}
 	}
 
-	return statuses
+	return statuses, delivered
 }
 
 func ignoreResponseBody(checkName string) bool {
@@ -537,3 +563,11 @@ func ignoreResponseBody(checkName string) bool {
 		return false
 	}
 }
+
+// submissionListener returns the function notifying the check of the delivery of its standard payloads, if any
+func submissionListener(result checks.RunResult) func(delivered bool) {
+	if listener, ok := result.(checks.SubmissionListener); ok {
+		return listener.OnSubmitted
+	}
+	return nil
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/DataDog/datadog-agent/comp/core"
 	workloadmeta "github.com/DataDog/datadog-agent/comp/core/workloadmeta/def"
 	workloadmetafxmock "github.com/DataDog/datadog-agent/comp/core/workloadmeta/fx-mock"
+	"github.com/DataDog/datadog-agent/comp/forwarder/defaultforwarder"
 	"github.com/DataDog/datadog-agent/comp/process/types"
 	configmock "github.com/DataDog/datadog-agent/pkg/config/mock"
 	"github.com/DataDog/datadog-agent/pkg/process/checks"
@@ -163,6 +164,29 @@ func TestIgnoreResponseBody(t *testing.T) {
 	}
 }
 
+func TestReadResponseStatusesDelivered(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		responses []defaultforwarder.Response
+		delivered bool
+	}{
+		{name: "success", responses: []defaultforwarder.Response{{StatusCode: 202}}, delivered: true},
+		{name: "error", responses: []defaultforwarder.Response{{StatusCode: 202}, {Err: assert.AnError}}, delivered: false},
+		{name: "invalid status", responses: []defaultforwarder.Response{{StatusCode: 500}}, delivered: false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			responses := make(chan defaultforwarder.Response, len(tc.responses))
+			for _, response := range tc.responses {
+				responses <- response
+			}
+			close(responses)
+
+			_, delivered := readResponseStatuses(checks.ProcessEventsCheckName, responses)
+			assert.Equal(t, tc.delivered, delivered)
+		})
+	}
+}
+
 func TestCollectorRunCheckWithRealTime(t *testing.T) {
 	check := checkmocks.NewCheck(t)
 
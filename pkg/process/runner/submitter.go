@@ -65,6 +65,7 @@ type CheckSubmitter struct {
 	// Endpoints for logging purposes
 	processAPIEndpoints       []apicfg.Endpoint
 	processEventsAPIEndpoints []apicfg.Endpoint
+	connectionsAPIEndpoints   []apicfg.Endpoint
 
 	hostname string
 
@@ -136,6 +137,11 @@ func NewSubmitter(config config.Component, log log.Component, forwarders forward
 		return nil, err
 	}
 
+	connectionsAPIEndpoints, err := endpoint.GetConnectionsAPIEndpoints(config)
+	if err != nil {
+		return nil, err
+	}
+
 	return &CheckSubmitter{
 		log:                log,
 		processResults:     processResults,
@@ -150,6 +156,7 @@ func NewSubmitter(config config.Component, log log.Component, forwarders forward
 
 		processAPIEndpoints:       processAPIEndpoints,
 		processEventsAPIEndpoints: processEventsAPIEndpoints,
+		connectionsAPIEndpoints:   connectionsAPIEndpoints,
 
 		hostname: hostname,
 
@@ -169,7 +176,7 @@ func NewSubmitter(config config.Component, log log.Component, forwarders forward
 	}, nil
 }
 
-func printStartMessage(log log.Component, hostname string, processAPIEndpoints []apicfg.Endpoint, processEventsAPIEndpoints []apicfg.Endpoint) {
+func printStartMessage(log log.Component, hostname string, processAPIEndpoints []apicfg.Endpoint, processEventsAPIEndpoints []apicfg.Endpoint, connectionsAPIEndpoints []apicfg.Endpoint) {
 	eps := make([]string, 0, len(processAPIEndpoints))
 	for _, e := range processAPIEndpoints {
 		eps = append(eps, e.Endpoint.String())
@@ -178,19 +185,23 @@ func printStartMessage(log log.Component, hostname string, processAPIEndpoints [
 	for _, e := range processEventsAPIEndpoints {
 		eventsEps = append(eventsEps, e.Endpoint.String())
 	}
+	connectionsEps := make([]string, 0, len(connectionsAPIEndpoints))
+	for _, e := range connectionsAPIEndpoints {
+		connectionsEps = append(connectionsEps, e.Endpoint.String())
+	}
 
-	log.Infof("Starting CheckSubmitter for host=%s, endpoints=%s, events endpoints=%s", hostname, eps, eventsEps)
+	log.Infof("Starting CheckSubmitter for host=%s, endpoints=%s, events endpoints=%s, connections endpoints=%s", hostname, eps, eventsEps, connectionsEps)
 }
 
 //nolint:revive // TODO(PROC) Fix revive linter
 func (s *CheckSubmitter) Submit(start time.Time, name string, messages *types.Payload) {
 	results := s.resultsQueueForCheck(name)
-	s.messagesToResultsQueue(start, name, messages.Message, results)
+	s.messagesToResultsQueue(start, name, messages.Message, messages.OnSubmitted, results)
 }
 
 //nolint:revive // TODO(PROC) Fix revive linter
 func (s *CheckSubmitter) Start() error {
-	printStartMessage(s.log, s.hostname, s.processAPIEndpoints, s.processEventsAPIEndpoints)
+	printStartMessage(s.log, s.hostname, s.processAPIEndpoints, s.processEventsAPIEndpoints, s.connectionsAPIEndpoints)
 
 	s.wg.Add(1)
 	go func() {
@@ -289,6 +300,7 @@ func (s *CheckSubmitter) consumePayloads(results *api.WeightedQueue, fwd forward
 			return
 		}
 		result := item.(*checkResult)
+		delivered := result.complete
 		for _, payload := range result.payloads {
 			var (
 				forwarderPayload = transaction.NewBytesPayloadsWithoutMetaData([]*[]byte{&payload.body})
@@ -298,6 +310,7 @@ func (s *CheckSubmitter) consumePayloads(results *api.WeightedQueue, fwd forward
 			)
 
 			if s.shouldDropPayload(result.name) {
+				delivered = false
 				continue
 			}
 
@@ -327,15 +340,24 @@ func (s *CheckSubmitter) consumePayloads(results *api.WeightedQueue, fwd forward
 
 			if err != nil {
 				s.log.Errorf("Unable to submit payload: %s", err)
+				delivered = false
 				continue
 			}
 
-			if statuses := readResponseStatuses(result.name, responses); len(statuses) > 0 {
+			statuses, ok := readResponseStatuses(result.name, responses)
+			if !ok {
+				delivered = false
+			}
+			if len(statuses) > 0 {
 				if updateRTStatus {
 					notifyRTStatusChange(s.rtNotifierChan, statuses)
 				}
 			}
 		}
+
+		if result.onSubmitted != nil {
+			result.onSubmitted(delivered)
+		}
 	}
 }
 
@@ -375,11 +397,12 @@ func (s *CheckSubmitter) logQueuesSize() {
 	)
 }
 
-func (s *CheckSubmitter) messagesToResultsQueue(start time.Time, name string, messages []model.MessageBody, queue *api.WeightedQueue) {
+func (s *CheckSubmitter) messagesToResultsQueue(start time.Time, name string, messages []model.MessageBody, onSubmitted func(delivered bool), queue *api.WeightedQueue) {
 	result := s.messagesToCheckResult(start, name, messages)
 	if result == nil {
 		return
 	}
+	result.onSubmitted = onSubmitted
 	queue.Add(result)
 	// update proc and container count for info
 	status.UpdateProcContainerCount(messages)
@@ -432,6 +455,7 @@ func (s *CheckSubmitter) messagesToCheckResult(start time.Time, name string, mes
 		name:        name,
 		payloads:    payloads,
 		sizeInBytes: int64(sizeInBytes),
+		complete:    len(payloads) == len(messages),
 	}
 }
 
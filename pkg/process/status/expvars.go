@@ -282,5 +282,6 @@ func InitExpvars(_ pkgconfigmodel.Reader, hostname string, processModuleEnabled,
 		processExpvars.Set("workloadmeta_extractor_cache_size", publishInt(&infoWlmExtractorCacheSize))
 		processExpvars.Set("workloadmeta_extractor_stale_diffs", publishInt(&infoWlmExtractorStaleDiffs))
 		processExpvars.Set("workloadmeta_extractor_diffs_dropped", publishInt(&infoWlmExtractorDiffsDropped))
+		processExpvars.Set("checks_health", expvar.Func(publishChecksHealth))
 	})
 }
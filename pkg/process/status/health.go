@@ -0,0 +1,131 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package status
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+)
+
+// checkRun is a completed run of a check
+type checkRun struct {
+	end      time.Time
+	duration time.Duration
+	err      error
+}
+
+// checkHealthTracker holds the last runs of a check
+type checkHealthTracker struct {
+	interval   time.Duration
+	budget     time.Duration
+	registered time.Time
+	runs       []checkRun
+	maxRuns    int
+}
+
+var (
+	checksHealthMutex sync.RWMutex
+	checksHealth      = map[string]*checkHealthTracker{}
+)
+
+// CheckHealth is the health of a check, computed over its last runs
+type CheckHealth struct {
+	Name    string `json:"name"`
+	Healthy bool   `json:"healthy"`
+	Reason  string `json:"reason,omitempty"`
+	// Interval is the expected interval between two runs of the check
+	Interval string `json:"interval"`
+	// Budget is the time within which a run of the check is expected to complete
+	Budget           string `json:"budget"`
+	LastRun          string `json:"last_run,omitempty"`
+	Runs             int    `json:"runs"`
+	RunsWithinBudget int    `json:"runs_within_budget"`
+}
+
+// RegisterCheckHealth starts tracking the health of a check. The check is healthy as long as it completes a run
+// within its interval plus its budget, and at least one of its last maxRuns runs succeeded within its budget.
+func RegisterCheckHealth(name string, interval, budget time.Duration, maxRuns int) {
+	checksHealthMutex.Lock()
+	defer checksHealthMutex.Unlock()
+
+	checksHealth[name] = &checkHealthTracker{
+		interval:   interval,
+		budget:     budget,
+		registered: time.Now(),
+		maxRuns:    max(maxRuns, 1),
+	}
+}
+
+// UpdateCheckHealth records a completed run of a check
+func UpdateCheckHealth(name string, end time.Time, duration time.Duration, err error) {
+	checksHealthMutex.Lock()
+	defer checksHealthMutex.Unlock()
+
+	tracker, found := checksHealth[name]
+	if !found {
+		return
+	}
+
+	tracker.runs = append(tracker.runs, checkRun{end: end, duration: duration, err: err})
+	if len(tracker.runs) > tracker.maxRuns {
+		tracker.runs = tracker.runs[len(tracker.runs)-tracker.maxRuns:]
+	}
+}
+
+// GetChecksHealth returns the health of the tracked checks, sorted by name
+func GetChecksHealth(now time.Time) []CheckHealth {
+	checksHealthMutex.RLock()
+	defer checksHealthMutex.RUnlock()
+
+	health := make([]CheckHealth, 0, len(checksHealth))
+	for name, tracker := range checksHealth {
+		health = append(health, tracker.health(name, now))
+	}
+	slices.SortFunc(health, func(a, b CheckHealth) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+	return health
+}
+
+func (t *checkHealthTracker) health(name string, now time.Time) CheckHealth {
+	health := CheckHealth{
+		Name:     name,
+		Healthy:  true,
+		Interval: t.interval.String(),
+		Budget:   t.budget.String(),
+		Runs:     len(t.runs),
+	}
+
+	lastCompletion := t.registered
+	if len(t.runs) > 0 {
+		lastCompletion = t.runs[len(t.runs)-1].end
+		health.LastRun = lastCompletion.Format("2006-01-02 15:04:05")
+	}
+
+	for _, run := range t.runs {
+		if run.err == nil && run.duration <= t.budget {
+			health.RunsWithinBudget++
+		}
+	}
+
+	// a check is expected to complete a run at least every interval, plus the time the run can take
+	if since := now.Sub(lastCompletion); since > t.interval+t.budget {
+		health.Healthy = false
+		health.Reason = fmt.Sprintf("no run completed in the last %s", since.Truncate(time.Second))
+	} else if len(t.runs) == t.maxRuns && health.RunsWithinBudget == 0 {
+		health.Healthy = false
+		health.Reason = fmt.Sprintf("none of the last %d runs succeeded within %s", t.maxRuns, t.budget)
+	}
+
+	return health
+}
+
+func publishChecksHealth() interface{} {
+	return GetChecksHealth(time.Now())
+}
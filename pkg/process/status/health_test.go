@@ -0,0 +1,60 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package status
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckHealth(t *testing.T) {
+	now := time.Now()
+	tracker := &checkHealthTracker{
+		interval:   10 * time.Second,
+		budget:     10 * time.Second,
+		registered: now,
+		maxRuns:    2,
+	}
+
+	health := tracker.health("process", now.Add(15*time.Second))
+	assert.True(t, health.Healthy)
+	assert.Empty(t, health.LastRun)
+
+	// no run completed within the interval plus the budget
+	health = tracker.health("process", now.Add(25*time.Second))
+	assert.False(t, health.Healthy)
+
+	tracker.runs = []checkRun{
+		{end: now.Add(20 * time.Second), duration: 20 * time.Second},
+		{end: now.Add(30 * time.Second), duration: time.Second, err: errors.New("failed")},
+	}
+	health = tracker.health("process", now.Add(35*time.Second))
+	assert.False(t, health.Healthy)
+	assert.Equal(t, 0, health.RunsWithinBudget)
+	assert.Equal(t, 2, health.Runs)
+
+	tracker.runs[1].err = nil
+	health = tracker.health("process", now.Add(35*time.Second))
+	assert.True(t, health.Healthy)
+	assert.Equal(t, 1, health.RunsWithinBudget)
+}
+
+func TestUpdateCheckHealth(t *testing.T) {
+	RegisterCheckHealth("container", 10*time.Second, 10*time.Second, 3)
+	for i := 0; i < 5; i++ {
+		UpdateCheckHealth("container", time.Now(), time.Second, nil)
+	}
+	UpdateCheckHealth("unknown", time.Now(), time.Second, nil)
+
+	health := GetChecksHealth(time.Now())
+	assert.Len(t, health, 1)
+	assert.Equal(t, "container", health[0].Name)
+	assert.True(t, health[0].Healthy)
+	assert.Equal(t, 3, health[0].Runs)
+}
@@ -7,6 +7,8 @@
 package containers
 
 import (
+	"math"
+	"strings"
 	"sync"
 	"time"
 
@@ -15,6 +17,7 @@ import (
 	tagger "github.com/DataDog/datadog-agent/comp/core/tagger/def"
 	"github.com/DataDog/datadog-agent/comp/core/tagger/types"
 	workloadmeta "github.com/DataDog/datadog-agent/comp/core/workloadmeta/def"
+	pkgconfigsetup "github.com/DataDog/datadog-agent/pkg/config/setup"
 	"github.com/DataDog/datadog-agent/pkg/util/containers"
 	"github.com/DataDog/datadog-agent/pkg/util/containers/metrics"
 	"github.com/DataDog/datadog-agent/pkg/util/containers/metrics/provider"
@@ -25,6 +28,10 @@ import (
 
 const (
 	floatNanoseconds float64 = float64(time.Second)
+
+	cpuPressureTagName    = "cpu_pressure"
+	memoryPressureTagName = "memory_pressure"
+	ioPressureTagName     = "io_pressure"
 )
 
 // ContainerRateMetrics holds previous values for a container,
@@ -41,6 +48,9 @@ type ContainerRateMetrics struct {
 	NetworkSentBytes        float64
 	NetworkRcvdPackets      float64
 	NetworkSentPackets      float64
+	CPUPressure             float64
+	MemoryPressure          float64
+	IOPressure              float64
 }
 
 // NullContainerRates can be safely used for containers that have no
@@ -49,6 +59,10 @@ var NullContainerRates = ContainerRateMetrics{
 	UserCPU:   -1,
 	SystemCPU: -1,
 	TotalCPU:  -1,
+
+	CPUPressure:    -1,
+	MemoryPressure: -1,
+	IOPressure:     -1,
 }
 
 var (
@@ -84,6 +98,9 @@ type containerProvider struct {
 	metadataStore   workloadmeta.Component
 	filter          *containers.Filter
 	tagger          tagger.Component
+
+	// pressureStallEnabled collects the pressure stall information of the containers
+	pressureStallEnabled bool
 }
 
 // NewContainerProvider returns a ContainerProvider instance
@@ -104,7 +121,13 @@ func NewDefaultContainerProvider(wmeta workloadmeta.Component, tagger tagger.Com
 	}
 
 	// TODO(components): stop relying on globals and use injected components instead whenever possible.
-	return NewContainerProvider(metrics.GetProvider(optional.NewOption(wmeta)), wmeta, containerFilter, tagger)
+	return &containerProvider{
+		metricsProvider:      metrics.GetProvider(optional.NewOption(wmeta)),
+		metadataStore:        wmeta,
+		filter:               containerFilter,
+		tagger:               tagger,
+		pressureStallEnabled: pkgconfigsetup.Datadog().GetBool("process_config.pressure_stall.enabled"),
+	}
 }
 
 // GetContainers returns containers found on the machine
@@ -175,6 +198,10 @@ func (p *containerProvider) GetContainers(cacheValidity time.Duration, previousC
 			continue
 		}
 		computeContainerStats(container, containerStats, previousContainerRates, &outPreviousStats, processContainer)
+		if p.pressureStallEnabled {
+			computeContainerPressureStats(containerStats, &outPreviousStats)
+			processContainer.Tags = append(processContainer.Tags, ComputePressureStall(&outPreviousStats, previousContainerRates).Tags()...)
+		}
 
 		// Building PID to CID mapping for NPM
 		pids, err := collector.GetPIDs(container.Namespace, container.ID, cacheValidity)
@@ -300,6 +327,91 @@ func computeContainerStats(container *workloadmeta.Container, inStats *metrics.C
 	}
 }
 
+// computeContainerPressureStats records the cumulative time during which some of the tasks of the container were
+// stalled on cpu, memory or io, as reported by the pressure stall information of its cgroup
+func computeContainerPressureStats(inStats *metrics.ContainerStats, outPreviousStats *ContainerRateMetrics) {
+	if inStats.CPU != nil {
+		outPreviousStats.CPUPressure = statValue(inStats.CPU.PartialStallTime, -1)
+	}
+	if inStats.Memory != nil {
+		outPreviousStats.MemoryPressure = statValue(inStats.Memory.PartialStallTime, -1)
+	}
+	if inStats.IO != nil {
+		outPreviousStats.IOPressure = statValue(inStats.IO.PartialStallTime, -1)
+	}
+}
+
+// PressureStall holds the share, in percent, of an interval during which some of the tasks of a container were stalled
+// on cpu, memory or io, -1 when not available
+type PressureStall struct {
+	CPU    float64
+	Memory float64
+	IO     float64
+}
+
+// ComputePressureStall returns the pressure stall of a container between two of its collections
+func ComputePressureStall(current, previous *ContainerRateMetrics) PressureStall {
+	// the stall times are cumulative, the first collection of a container can't be reported
+	if previous.ContainerStatsTimestamp.IsZero() {
+		return PressureStall{CPU: -1, Memory: -1, IO: -1}
+	}
+
+	pct := func(currentValue, previousValue float64) float64 {
+		value := cpuRatePctValue(currentValue, previousValue, current.ContainerStatsTimestamp, previous.ContainerStatsTimestamp)
+		if value < 0 {
+			return -1
+		}
+		return math.Min(value, 100)
+	}
+	return PressureStall{
+		CPU:    pct(current.CPUPressure, previous.CPUPressure),
+		Memory: pct(current.MemoryPressure, previous.MemoryPressure),
+		IO:     pct(current.IOPressure, previous.IOPressure),
+	}
+}
+
+// pressureStallLevel returns the level of a pressure stall share, bounding the cardinality of the pressure stall tags
+func pressureStallLevel(pct float64) string {
+	switch {
+	case pct < 10:
+		return "low"
+	case pct < 40:
+		return "medium"
+	default:
+		return "high"
+	}
+}
+
+// Tags returns the pressure stall levels of a container, attached to its payload
+func (ps PressureStall) Tags() []string {
+	var tags []string
+	for _, pressure := range []struct {
+		tagName string
+		pct     float64
+	}{
+		{cpuPressureTagName, ps.CPU},
+		{memoryPressureTagName, ps.Memory},
+		{ioPressureTagName, ps.IO},
+	} {
+		if pressure.pct >= 0 {
+			tags = append(tags, pressure.tagName+":"+pressureStallLevel(pressure.pct))
+		}
+	}
+	return tags
+}
+
+// PressureStallTags returns the pressure stall tags of the provided container
+func PressureStallTags(container *model.Container) []string {
+	var tags []string
+	for _, tag := range container.Tags {
+		name, _, _ := strings.Cut(tag, ":")
+		if name == cpuPressureTagName || name == memoryPressureTagName || name == ioPressureTagName {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
 func computeContainerNetworkStats(inStats *metrics.ContainerNetworkStats, previousStats, outPreviousStats *ContainerRateMetrics, outStats *model.Container) {
 	if inStats == nil {
 		return
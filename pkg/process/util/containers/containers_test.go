@@ -456,6 +456,9 @@ func TestGetContainers(t *testing.T) {
 			NetworkSentBytes:        42,
 			NetworkRcvdPackets:      421,
 			NetworkSentPackets:      420,
+			CPUPressure:             -1,
+			MemoryPressure:          -1,
+			IOPressure:              -1,
 		},
 		"cID4": {
 			ContainerStatsTimestamp: testTime,
@@ -469,6 +472,9 @@ func TestGetContainers(t *testing.T) {
 			NetworkSentBytes:        42,
 			NetworkRcvdPackets:      421,
 			NetworkSentPackets:      420,
+			CPUPressure:             -1,
+			MemoryPressure:          -1,
+			IOPressure:              -1,
 		},
 		"cID5": {
 			ContainerStatsTimestamp: testTime,
@@ -482,6 +488,9 @@ func TestGetContainers(t *testing.T) {
 			NetworkSentBytes:        42,
 			NetworkRcvdPackets:      421,
 			NetworkSentPackets:      420,
+			CPUPressure:             -1,
+			MemoryPressure:          -1,
+			IOPressure:              -1,
 		},
 	}, lastRates)
 	assert.Equal(t, map[int]string{
@@ -668,6 +677,9 @@ func TestGetContainers(t *testing.T) {
 			NetworkSentBytes:        82,
 			NetworkRcvdPackets:      821,
 			NetworkSentPackets:      820,
+			CPUPressure:             -1,
+			MemoryPressure:          -1,
+			IOPressure:              -1,
 		},
 		"cID4": {
 			ContainerStatsTimestamp: testTime,
@@ -681,6 +693,9 @@ func TestGetContainers(t *testing.T) {
 			NetworkSentBytes:        42,
 			NetworkRcvdPackets:      421,
 			NetworkSentPackets:      420,
+			CPUPressure:             -1,
+			MemoryPressure:          -1,
+			IOPressure:              -1,
 		},
 		"cID5": {
 			ContainerStatsTimestamp: testTime,
@@ -694,6 +709,9 @@ func TestGetContainers(t *testing.T) {
 			NetworkSentBytes:        42,
 			NetworkRcvdPackets:      421,
 			NetworkSentPackets:      420,
+			CPUPressure:             -1,
+			MemoryPressure:          -1,
+			IOPressure:              -1,
 		},
 	}, lastRates)
 	assert.Equal(t, map[int]string{
@@ -717,3 +735,32 @@ func compareResults(a, b interface{}) string {
 		}),
 	)
 }
+
+func TestComputeContainerPressureStats(t *testing.T) {
+	now := time.Now()
+
+	inStats := &provider.ContainerStats{
+		Timestamp: now,
+		CPU:       &provider.ContainerCPUStats{PartialStallTime: pointer.Ptr(3e9)},
+		Memory:    &provider.ContainerMemStats{PartialStallTime: pointer.Ptr(1.5e9)},
+		IO:        &provider.ContainerIOStats{},
+	}
+
+	outStats := NullContainerRates
+	outStats.ContainerStatsTimestamp = now
+	computeContainerPressureStats(inStats, &outStats)
+	assert.Equal(t, 3e9, outStats.CPUPressure)
+	assert.Equal(t, 1.5e9, outStats.MemoryPressure)
+	assert.Equal(t, -1.0, outStats.IOPressure)
+
+	// the stall times are cumulative, the first collection of a container can't be reported
+	assert.Equal(t, PressureStall{CPU: -1, Memory: -1, IO: -1}, ComputePressureStall(&outStats, &NullContainerRates))
+
+	previousStats := NullContainerRates
+	previousStats.ContainerStatsTimestamp = now.Add(-10 * time.Second)
+	previousStats.CPUPressure = 1e9
+	previousStats.MemoryPressure = 5e8
+	assert.Equal(t, PressureStall{CPU: 20, Memory: 10, IO: -1}, ComputePressureStall(&outStats, &previousStats))
+	assert.Equal(t, []string{"cpu_pressure:medium", "memory_pressure:medium"}, ComputePressureStall(&outStats, &previousStats).Tags())
+	assert.Equal(t, []string{"cpu_pressure:high", "memory_pressure:low"}, PressureStall{CPU: 55, Memory: 2, IO: -1}.Tags())
+}
@@ -63,6 +63,18 @@ type MemInfo struct {
 	Alloc uint64 `json:"alloc"`
 }
 
+// CheckHealth holds the health of a check of process-agent
+type CheckHealth struct {
+	Name             string `json:"name"`
+	Healthy          bool   `json:"healthy"`
+	Reason           string `json:"reason"`
+	Interval         string `json:"interval"`
+	Budget           string `json:"budget"`
+	LastRun          string `json:"last_run"`
+	Runs             int    `json:"runs"`
+	RunsWithinBudget int    `json:"runs_within_budget"`
+}
+
 type ExpvarsMap struct {
 	Pid                             int                 `json:"pid"`
 	Uptime                          int                 `json:"uptime"`
@@ -94,6 +106,7 @@ type ExpvarsMap struct {
 	WlmExtractorCacheSize           int                 `json:"workloadmeta_extractor_cache_size"`
 	WlmExtractorStaleDiffs          int                 `json:"workloadmeta_extractor_stale_diffs"`
 	WlmExtractorDiffsDropped        int                 `json:"workloadmeta_extractor_diffs_dropped"`
+	ChecksHealth                    []CheckHealth       `json:"checks_health,omitempty"`
 }
 
 // ProcessExpvars holds values fetched from the exp var server
@@ -18,6 +18,7 @@ var validProducts = map[string]struct{}{
 	ProductCWSDD:                        {},
 	ProductCWSCustom:                    {},
 	ProductCWSProfiles:                  {},
+	ProductCWSSuppressionWindows:        {},
 	ProductCSMSideScanning:              {},
 	ProductASM:                          {},
 	ProductASMFeatures:                  {},
@@ -59,6 +60,8 @@ const (
 	ProductCWSCustom = "CWS_CUSTOM"
 	// ProductCWSProfiles is the cloud workload security profile product
 	ProductCWSProfiles = "CWS_SECURITY_PROFILES"
+	// ProductCWSSuppressionWindows is the cloud workload security anomaly detection suppression windows product
+	ProductCWSSuppressionWindows = "CWS_SUPPRESSION_WINDOWS"
 	// ProductCSMSideScanning is the side scanning product
 	ProductCSMSideScanning = "CSM_SIDE_SCANNING"
 	// ProductASM is the ASM product used by customers to issue rules configurations
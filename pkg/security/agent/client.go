@@ -32,7 +32,8 @@ type RuntimeSecurityClient struct {
 // SecurityModuleClientWrapper represents a security module client
 type SecurityModuleClientWrapper interface {
 	DumpDiscarders() (string, error)
-	DumpProcessCache(withArgs bool, format string) (string, error)
+	DumpProcessCache(withArgs bool, format string, containerID string) (string, error)
+	QueryProcessCache(params *api.ProcessCacheQueryParams) (*api.ProcessCacheQueryMessage, error)
 	GenerateActivityDump(request *api.ActivityDumpParams) (*api.ActivityDumpMessage, error)
 	ListActivityDumps() (*api.ActivityDumpListMessage, error)
 	StopActivityDump(name, containerid string) (*api.ActivityDumpStopMessage, error)
@@ -43,6 +44,7 @@ type SecurityModuleClientWrapper interface {
 	RunSelfTest() (*api.SecuritySelfTestResultMessage, error)
 	ReloadPolicies() (*api.ReloadPoliciesResultMessage, error)
 	GetRuleSetReport() (*api.GetRuleSetReportResultMessage, error)
+	ExportRuleSet() (*api.ExportRuleSetMessage, error)
 	GetEvents() (api.SecurityModule_GetEventsClient, error)
 	GetActivityDumpStream() (api.SecurityModule_GetActivityDumpStreamClient, error)
 	ListSecurityProfiles(includeCache bool) (*api.SecurityProfileListMessage, error)
@@ -61,8 +63,8 @@ func (c *RuntimeSecurityClient) DumpDiscarders() (string, error) {
 }
 
 // DumpProcessCache sends a process cache dump request
-func (c *RuntimeSecurityClient) DumpProcessCache(withArgs bool, format string) (string, error) {
-	response, err := c.apiClient.DumpProcessCache(context.Background(), &api.DumpProcessCacheParams{WithArgs: withArgs, Format: format})
+func (c *RuntimeSecurityClient) DumpProcessCache(withArgs bool, format string, containerID string) (string, error) {
+	response, err := c.apiClient.DumpProcessCache(context.Background(), &api.DumpProcessCacheParams{WithArgs: withArgs, Format: format, ContainerID: containerID})
 	if err != nil {
 		return "", err
 	}
@@ -93,6 +95,15 @@ func (c *RuntimeSecurityClient) GenerateEncoding(request *api.TranscodingRequest
 	return c.apiClient.TranscodingRequest(context.Background(), request)
 }
 
+// QueryProcessCache sends a process cache query request
+func (c *RuntimeSecurityClient) QueryProcessCache(params *api.ProcessCacheQueryParams) (*api.ProcessCacheQueryMessage, error) {
+	response, err := c.apiClient.QueryProcessCache(context.Background(), params)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 // DumpNetworkNamespace sends a network namespace cache dump request
 func (c *RuntimeSecurityClient) DumpNetworkNamespace(snapshotInterfaces bool) (*api.DumpNetworkNamespaceMessage, error) {
 	return c.apiClient.DumpNetworkNamespace(context.Background(), &api.DumpNetworkNamespaceParams{SnapshotInterfaces: snapshotInterfaces})
@@ -140,6 +151,15 @@ func (c *RuntimeSecurityClient) GetRuleSetReport() (*api.GetRuleSetReportResultM
 	return response, nil
 }
 
+// ExportRuleSet gets the rules loaded by the system probe, after the merge of all the policies
+func (c *RuntimeSecurityClient) ExportRuleSet() (*api.ExportRuleSetMessage, error) {
+	response, err := c.apiClient.ExportRuleSet(context.Background(), &api.ExportRuleSetParams{})
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 // GetEvents returns a stream of events
 func (c *RuntimeSecurityClient) GetEvents() (api.SecurityModule_GetEventsClient, error) {
 	stream, err := c.apiClient.GetEvents(context.Background(), &api.GetEventParams{})
@@ -75,9 +75,9 @@ func (_m *SecurityModuleClientWrapper) DumpNetworkNamespace(snapshotInterfaces b
 	return r0, r1
 }
 
-// DumpProcessCache provides a mock function with given fields: withArgs, format
-func (_m *SecurityModuleClientWrapper) DumpProcessCache(withArgs bool, format string) (string, error) {
-	ret := _m.Called(withArgs, format)
+// DumpProcessCache provides a mock function with given fields: withArgs, format, containerID
+func (_m *SecurityModuleClientWrapper) DumpProcessCache(withArgs bool, format string, containerID string) (string, error) {
+	ret := _m.Called(withArgs, format, containerID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DumpProcessCache")
@@ -85,17 +85,47 @@ func (_m *SecurityModuleClientWrapper) DumpProcessCache(withArgs bool, format st
 
 	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(bool, string) (string, error)); ok {
-		return rf(withArgs, format)
+	if rf, ok := ret.Get(0).(func(bool, string, string) (string, error)); ok {
+		return rf(withArgs, format, containerID)
 	}
-	if rf, ok := ret.Get(0).(func(bool, string) string); ok {
-		r0 = rf(withArgs, format)
+	if rf, ok := ret.Get(0).(func(bool, string, string) string); ok {
+		r0 = rf(withArgs, format, containerID)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(bool, string) error); ok {
-		r1 = rf(withArgs, format)
+	if rf, ok := ret.Get(1).(func(bool, string, string) error); ok {
+		r1 = rf(withArgs, format, containerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExportRuleSet provides a mock function with given fields:
+func (_m *SecurityModuleClientWrapper) ExportRuleSet() (*api.ExportRuleSetMessage, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportRuleSet")
+	}
+
+	var r0 *api.ExportRuleSetMessage
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*api.ExportRuleSetMessage, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *api.ExportRuleSetMessage); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.ExportRuleSetMessage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -373,6 +403,36 @@ func (_m *SecurityModuleClientWrapper) ListSecurityProfiles(includeCache bool) (
 	return r0, r1
 }
 
+// QueryProcessCache provides a mock function with given fields: params
+func (_m *SecurityModuleClientWrapper) QueryProcessCache(params *api.ProcessCacheQueryParams) (*api.ProcessCacheQueryMessage, error) {
+	ret := _m.Called(params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QueryProcessCache")
+	}
+
+	var r0 *api.ProcessCacheQueryMessage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*api.ProcessCacheQueryParams) (*api.ProcessCacheQueryMessage, error)); ok {
+		return rf(params)
+	}
+	if rf, ok := ret.Get(0).(func(*api.ProcessCacheQueryParams) *api.ProcessCacheQueryMessage); ok {
+		r0 = rf(params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.ProcessCacheQueryMessage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*api.ProcessCacheQueryParams) error); ok {
+		r1 = rf(params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ReloadPolicies provides a mock function with given fields:
 func (_m *SecurityModuleClientWrapper) ReloadPolicies() (*api.ReloadPoliciesResultMessage, error) {
 	ret := _m.Called()
@@ -57,6 +57,7 @@ func (s statusProvider) populateStatus(stats map[string]interface{}) {
 					"kernelLockdown": cfStatus.Environment.KernelLockdown,
 					"mmapableMaps":   cfStatus.Environment.UseMmapableMaps,
 					"ringBuffer":     cfStatus.Environment.UseRingBuffer,
+					"disabledEvents": cfStatus.Environment.DisabledEventTypes,
 				}
 				if cfStatus.Environment.Constants != nil {
 					environment["constantFetchers"] = cfStatus.Environment.Constants
@@ -10,6 +10,7 @@ import (
 	"encoding/binary"
 	"fmt"
 	"net"
+	"strconv"
 	"strings"
 	"time"
 
@@ -52,6 +53,21 @@ type RuntimeSecurityConfig struct {
 	PolicyMonitorPerRuleEnabled bool
 	// PolicyMonitorReportInternalPolicies enable internal policies monitoring
 	PolicyMonitorReportInternalPolicies bool
+	// PolicyIndexEnabled defines whether the rules and the values of their `in` comparisons are indexed, instead of
+	// being evaluated one by one
+	PolicyIndexEnabled bool
+	// RuleDigestPeriod defines the period of the digest event reporting the matches of the rules in digest mode
+	RuleDigestPeriod time.Duration
+	// RuleDigestMaxEntries defines the maximum number of container and process entries reported per rule in a digest
+	RuleDigestMaxEntries int
+	// RunawayRulesEnabled defines whether the rules matching too often are temporarily disabled
+	RunawayRulesEnabled bool
+	// RunawayRulesMaxRate defines the number of matches per second above which a rule is considered as runaway
+	RunawayRulesMaxRate int
+	// RunawayRulesPeriod defines for how long a rule has to exceed the maximum rate to be disabled
+	RunawayRulesPeriod time.Duration
+	// RunawayRulesCooldown defines for how long a runaway rule is disabled
+	RunawayRulesCooldown time.Duration
 	// SocketPath is the path to the socket that is used to communicate with the security agent
 	SocketPath string
 	// EventServerBurst defines the maximum burst of events that can be sent over the grpc server
@@ -60,6 +76,12 @@ type RuntimeSecurityConfig struct {
 	EventServerRate int
 	// EventServerRetention defines an event retention period so that some fields can be resolved
 	EventServerRetention time.Duration
+	// EventServerMaxSize defines the maximum size of a serialized event, the fields listed in
+	// serializers.TrimmedFields being stripped, in that order, from the larger events. 0 disables the limit.
+	EventServerMaxSize int
+	// EventServerCompactAncestors defines if the parent and the ancestors of the process are reduced to their pid,
+	// executable path and container in the serialized events
+	EventServerCompactAncestors bool
 	// FIMEnabled determines whether fim rules will be loaded
 	FIMEnabled bool
 	// SelfTestEnabled defines if the self tests should be executed at startup or not
@@ -199,6 +221,23 @@ type RuntimeSecurityConfig struct {
 	AnomalyDetectionSilentRuleEventsEnabled bool
 	// AnomalyDetectionEnabled defines if we should send anomaly detection events
 	AnomalyDetectionEnabled bool
+	// AnomalyDetectionSuppressionDefaultDuration defines the duration of the suppression windows opened without an
+	// explicit duration
+	AnomalyDetectionSuppressionDefaultDuration time.Duration
+	// AnomalyDetectionSuppressionMaxDuration defines the maximum duration of a suppression window
+	AnomalyDetectionSuppressionMaxDuration time.Duration
+	// AnomalyDetectionAutoDumpEnabled defines if an activity dump of a workload should be triggered automatically once
+	// it raised repeated anomalies
+	AnomalyDetectionAutoDumpEnabled bool
+	// AnomalyDetectionAutoDumpThreshold defines the number of anomalies a workload has to raise to trigger an activity dump
+	AnomalyDetectionAutoDumpThreshold int
+	// AnomalyDetectionAutoDumpWindow defines the period over which the anomalies of a workload are counted, 0 keeping
+	// them until a dump is triggered
+	AnomalyDetectionAutoDumpWindow time.Duration
+	// AnomalyDetectionAutoDumpDuration defines the duration of the activity dumps triggered by anomalies
+	AnomalyDetectionAutoDumpDuration time.Duration
+	// AnomalyDetectionAutoDumpMaxPerDay defines the maximum number of activity dumps triggered by anomalies per day
+	AnomalyDetectionAutoDumpMaxPerDay int
 
 	// SBOMResolverEnabled defines if the SBOM resolver should be enabled
 	SBOMResolverEnabled bool
@@ -208,6 +247,28 @@ type RuntimeSecurityConfig struct {
 	// SBOMResolverHostEnabled defines if the SBOM resolver should compute the host's SBOM
 	SBOMResolverHostEnabled bool
 
+	// CanaryFilesEnabled defines if decoy files should be deployed and monitored
+	CanaryFilesEnabled bool
+	// CanaryFilesPaths defines the paths of the decoy files. Any access to them by a process other than the agent
+	// generates an event.
+	CanaryFilesPaths []string
+
+	// GPUAccessDetectionEnabled defines if the accesses of containers to the GPU devices should generate events
+	GPUAccessDetectionEnabled bool
+	// GPUAccessAllowedImages defines the images of the containers allowed to access the GPU devices
+	GPUAccessAllowedImages []string
+
+	// ContainerEscapeEnabled defines if the bundled rules detecting the container escape techniques should be loaded
+	ContainerEscapeEnabled bool
+	// ContainerEscapeNsenter defines if the containers entering the namespaces of the host should be detected
+	ContainerEscapeNsenter bool
+	// ContainerEscapeCorePattern defines if the containers modifying the core dump handler should be detected
+	ContainerEscapeCorePattern bool
+	// ContainerEscapeReleaseAgent defines if the containers modifying a cgroup release agent should be detected
+	ContainerEscapeReleaseAgent bool
+	// ContainerEscapeProcSysKernel defines if the containers modifying the kernel parameters should be detected
+	ContainerEscapeProcSysKernel bool
+
 	// HashResolverEnabled defines if the hash resolver should be enabled
 	HashResolverEnabled bool
 	// HashResolverMaxFileSize defines the maximum size of the files that the hash resolver is allowed to hash
@@ -225,9 +286,40 @@ type RuntimeSecurityConfig struct {
 	// HashResolverReplace is used to apply specific hash to specific file path
 	HashResolverReplace map[string]string
 
+	// ProcfsFallbackMaxResolutions defines the number of times the process resolver may fall back to procfs for a
+	// given pid per period, 0 disabling the fallback
+	ProcfsFallbackMaxResolutions int
+	// ProcfsFallbackPeriod defines the period of the procfs fallback limiter
+	ProcfsFallbackPeriod time.Duration
+	// ProcfsFallbackOverrides overrides the number of procfs resolutions per period of the processes of the
+	// workloads, keyed by image name or by image name and tag
+	ProcfsFallbackOverrides map[string]int
+
+	// EventTypesMatrix enables or disables the event families and event types, the entries of the event types
+	// taking precedence over the ones of their family
+	EventTypesMatrix map[string]bool
+
 	// UserSessionsCacheSize defines the size of the User Sessions cache size
 	UserSessionsCacheSize int
 
+	// CGroupUsageEnabled defines if the events should be enriched with the cpu, memory and pids usage of the cgroup of
+	// their process
+	CGroupUsageEnabled bool
+
+	// UserGroupNSSEnabled defines if the users and groups of the host unknown to its passwd and group files should be
+	// resolved through the name service switch, to resolve the ones of the directories like LDAP
+	UserGroupNSSEnabled bool
+
+	// ContainerMetadataEnabled defines if the image and the pod sandbox of the containers should be queried from the
+	// container runtime
+	ContainerMetadataEnabled bool
+
+	// PathAnonymizationEnabled defines if the user identifying components of the paths should be redacted from the
+	// events sent to the backend
+	PathAnonymizationEnabled bool
+	// PathAnonymizationPatterns defines the patterns of the paths to anonymize, their capture groups being redacted
+	PathAnonymizationPatterns []string
+
 	// EBPFLessEnabled enables the ebpfless probe
 	EBPFLessEnabled bool
 	// EBPFLessSocket defines the socket used for the communication between system-probe and the ebpfless source
@@ -274,6 +366,9 @@ type RuntimeSecurityConfig struct {
 
 	// IMDSIPv4 is used to provide a custom IP address for the IMDS endpoint
 	IMDSIPv4 uint32
+	// IMDSCrossContainerCredentialsEnabled defines if the uses of AWS credentials by a workload other than the one that
+	// received them from the IMDS should generate events
+	IMDSCrossContainerCredentialsEnabled bool
 }
 
 // Config defines a security config
@@ -338,10 +433,12 @@ func NewRuntimeSecurityConfig() (*RuntimeSecurityConfig, error) {
 		WindowsWriteEventRateLimiterMaxAllowed: pkgconfigsetup.SystemProbe().GetInt("runtime_security_config.windows_write_event_rate_limiter_max_allowed"),
 		WindowsWriteEventRateLimiterPeriod:     pkgconfigsetup.SystemProbe().GetDuration("runtime_security_config.windows_write_event_rate_limiter_period"),
 
-		SocketPath:           pkgconfigsetup.SystemProbe().GetString("runtime_security_config.socket"),
-		EventServerBurst:     pkgconfigsetup.SystemProbe().GetInt("runtime_security_config.event_server.burst"),
-		EventServerRate:      pkgconfigsetup.SystemProbe().GetInt("runtime_security_config.event_server.rate"),
-		EventServerRetention: pkgconfigsetup.SystemProbe().GetDuration("runtime_security_config.event_server.retention"),
+		SocketPath:                  pkgconfigsetup.SystemProbe().GetString("runtime_security_config.socket"),
+		EventServerBurst:            pkgconfigsetup.SystemProbe().GetInt("runtime_security_config.event_server.burst"),
+		EventServerRate:             pkgconfigsetup.SystemProbe().GetInt("runtime_security_config.event_server.rate"),
+		EventServerRetention:        pkgconfigsetup.SystemProbe().GetDuration("runtime_security_config.event_server.retention"),
+		EventServerMaxSize:          pkgconfigsetup.SystemProbe().GetInt("runtime_security_config.event_server.max_event_size"),
+		EventServerCompactAncestors: pkgconfigsetup.SystemProbe().GetBool("runtime_security_config.event_server.compact_ancestors"),
 
 		SelfTestEnabled:                 pkgconfigsetup.SystemProbe().GetBool("runtime_security_config.self_test.enabled"),
 		SelfTestSendReport:              pkgconfigsetup.SystemProbe().GetBool("runtime_security_config.self_test.send_report"),
@@ -358,6 +455,13 @@ func NewRuntimeSecurityConfig() (*RuntimeSecurityConfig, error) {
 		PolicyMonitorEnabled:                pkgconfigsetup.SystemProbe().GetBool("runtime_security_config.policies.monitor.enabled"),
 		PolicyMonitorPerRuleEnabled:         pkgconfigsetup.SystemProbe().GetBool("runtime_security_config.policies.monitor.per_rule_enabled"),
 		PolicyMonitorReportInternalPolicies: pkgconfigsetup.SystemProbe().GetBool("runtime_security_config.policies.monitor.report_internal_policies"),
+		RuleDigestPeriod:                    pkgconfigsetup.SystemProbe().GetDuration("runtime_security_config.policies.digest.period"),
+		RuleDigestMaxEntries:                pkgconfigsetup.SystemProbe().GetInt("runtime_security_config.policies.digest.max_entries"),
+		PolicyIndexEnabled:                  pkgconfigsetup.SystemProbe().GetBool("runtime_security_config.policies.index.enabled"),
+		RunawayRulesEnabled:                 pkgconfigsetup.SystemProbe().GetBool("runtime_security_config.policies.runaway.enabled"),
+		RunawayRulesMaxRate:                 pkgconfigsetup.SystemProbe().GetInt("runtime_security_config.policies.runaway.max_rate"),
+		RunawayRulesPeriod:                  pkgconfigsetup.SystemProbe().GetDuration("runtime_security_config.policies.runaway.period"),
+		RunawayRulesCooldown:                pkgconfigsetup.SystemProbe().GetDuration("runtime_security_config.policies.runaway.cooldown"),
 
 		LogPatterns: pkgconfigsetup.SystemProbe().GetStringSlice("runtime_security_config.log_patterns"),
 		LogTags:     pkgconfigsetup.SystemProbe().GetStringSlice("runtime_security_config.log_tags"),
@@ -401,6 +505,21 @@ func NewRuntimeSecurityConfig() (*RuntimeSecurityConfig, error) {
 		SBOMResolverWorkloadsCacheSize: pkgconfigsetup.SystemProbe().GetInt("runtime_security_config.sbom.workloads_cache_size"),
 		SBOMResolverHostEnabled:        pkgconfigsetup.SystemProbe().GetBool("runtime_security_config.sbom.host.enabled"),
 
+		// Canary files
+		CanaryFilesEnabled: pkgconfigsetup.SystemProbe().GetBool("runtime_security_config.canary_files.enabled"),
+		CanaryFilesPaths:   pkgconfigsetup.SystemProbe().GetStringSlice("runtime_security_config.canary_files.paths"),
+
+		// GPU access detection
+		GPUAccessDetectionEnabled: pkgconfigsetup.SystemProbe().GetBool("runtime_security_config.gpu_access_detection.enabled"),
+		GPUAccessAllowedImages:    pkgconfigsetup.SystemProbe().GetStringSlice("runtime_security_config.gpu_access_detection.allowed_images"),
+
+		// Container escape detection
+		ContainerEscapeEnabled:       pkgconfigsetup.SystemProbe().GetBool("runtime_security_config.container_escape.enabled"),
+		ContainerEscapeNsenter:       pkgconfigsetup.SystemProbe().GetBool("runtime_security_config.container_escape.nsenter.enabled"),
+		ContainerEscapeCorePattern:   pkgconfigsetup.SystemProbe().GetBool("runtime_security_config.container_escape.core_pattern.enabled"),
+		ContainerEscapeReleaseAgent:  pkgconfigsetup.SystemProbe().GetBool("runtime_security_config.container_escape.release_agent.enabled"),
+		ContainerEscapeProcSysKernel: pkgconfigsetup.SystemProbe().GetBool("runtime_security_config.container_escape.proc_sys_kernel.enabled"),
+
 		// Hash resolver
 		HashResolverEnabled:        pkgconfigsetup.SystemProbe().GetBool("runtime_security_config.hash_resolver.enabled"),
 		HashResolverEventTypes:     parseEventTypeStringSlice(pkgconfigsetup.SystemProbe().GetStringSlice("runtime_security_config.hash_resolver.event_types")),
@@ -411,6 +530,13 @@ func NewRuntimeSecurityConfig() (*RuntimeSecurityConfig, error) {
 		HashResolverCacheSize:      pkgconfigsetup.SystemProbe().GetInt("runtime_security_config.hash_resolver.cache_size"),
 		HashResolverReplace:        pkgconfigsetup.SystemProbe().GetStringMapString("runtime_security_config.hash_resolver.replace"),
 
+		// process resolver procfs fallback
+		ProcfsFallbackMaxResolutions: pkgconfigsetup.SystemProbe().GetInt("runtime_security_config.process_resolver.procfs_fallback.max_resolutions"),
+		ProcfsFallbackPeriod:         pkgconfigsetup.SystemProbe().GetDuration("runtime_security_config.process_resolver.procfs_fallback.period"),
+
+		// event types matrix
+		EventTypesMatrix: parseEventTypesMatrix(pkgconfigsetup.SystemProbe()),
+
 		// security profiles
 		SecurityProfileEnabled:          pkgconfigsetup.SystemProbe().GetBool("runtime_security_config.security_profile.enabled"),
 		SecurityProfileMaxImageTags:     pkgconfigsetup.SystemProbe().GetInt("runtime_security_config.security_profile.max_image_tags"),
@@ -437,6 +563,13 @@ func NewRuntimeSecurityConfig() (*RuntimeSecurityConfig, error) {
 		AnomalyDetectionTagRulesEnabled:              pkgconfigsetup.SystemProbe().GetBool("runtime_security_config.security_profile.anomaly_detection.tag_rules.enabled"),
 		AnomalyDetectionSilentRuleEventsEnabled:      pkgconfigsetup.SystemProbe().GetBool("runtime_security_config.security_profile.anomaly_detection.silent_rule_events.enabled"),
 		AnomalyDetectionEnabled:                      pkgconfigsetup.SystemProbe().GetBool("runtime_security_config.security_profile.anomaly_detection.enabled"),
+		AnomalyDetectionSuppressionDefaultDuration:   pkgconfigsetup.SystemProbe().GetDuration("runtime_security_config.security_profile.anomaly_detection.suppression_window.default_duration"),
+		AnomalyDetectionSuppressionMaxDuration:       pkgconfigsetup.SystemProbe().GetDuration("runtime_security_config.security_profile.anomaly_detection.suppression_window.max_duration"),
+		AnomalyDetectionAutoDumpEnabled:              pkgconfigsetup.SystemProbe().GetBool("runtime_security_config.security_profile.anomaly_detection.auto_activity_dump.enabled"),
+		AnomalyDetectionAutoDumpThreshold:            pkgconfigsetup.SystemProbe().GetInt("runtime_security_config.security_profile.anomaly_detection.auto_activity_dump.anomaly_threshold"),
+		AnomalyDetectionAutoDumpWindow:               pkgconfigsetup.SystemProbe().GetDuration("runtime_security_config.security_profile.anomaly_detection.auto_activity_dump.anomaly_window"),
+		AnomalyDetectionAutoDumpDuration:             pkgconfigsetup.SystemProbe().GetDuration("runtime_security_config.security_profile.anomaly_detection.auto_activity_dump.duration"),
+		AnomalyDetectionAutoDumpMaxPerDay:            pkgconfigsetup.SystemProbe().GetInt("runtime_security_config.security_profile.anomaly_detection.auto_activity_dump.max_dumps_per_day"),
 
 		// enforcement
 		EnforcementEnabled:                      pkgconfigsetup.SystemProbe().GetBool("runtime_security_config.enforcement.enabled"),
@@ -453,12 +586,26 @@ func NewRuntimeSecurityConfig() (*RuntimeSecurityConfig, error) {
 		// User Sessions
 		UserSessionsCacheSize: pkgconfigsetup.SystemProbe().GetInt("runtime_security_config.user_sessions.cache_size"),
 
+		// CGroup usage
+		CGroupUsageEnabled: pkgconfigsetup.SystemProbe().GetBool("runtime_security_config.cgroup_usage.enabled"),
+
+		// User and group resolution
+		UserGroupNSSEnabled: pkgconfigsetup.SystemProbe().GetBool("runtime_security_config.user_group.nss.enabled"),
+
+		// Container metadata
+		ContainerMetadataEnabled: pkgconfigsetup.SystemProbe().GetBool("runtime_security_config.container_metadata.enabled"),
+
+		// Path anonymization
+		PathAnonymizationEnabled:  pkgconfigsetup.SystemProbe().GetBool("runtime_security_config.path_anonymization.enabled"),
+		PathAnonymizationPatterns: pkgconfigsetup.SystemProbe().GetStringSlice("runtime_security_config.path_anonymization.patterns"),
+
 		// ebpf less
 		EBPFLessEnabled: pkgconfigsetup.SystemProbe().GetBool("runtime_security_config.ebpfless.enabled"),
 		EBPFLessSocket:  pkgconfigsetup.SystemProbe().GetString("runtime_security_config.ebpfless.socket"),
 
 		// IMDS
-		IMDSIPv4: parseIMDSIPv4(),
+		IMDSIPv4:                             parseIMDSIPv4(),
+		IMDSCrossContainerCredentialsEnabled: pkgconfigsetup.SystemProbe().GetBool("runtime_security_config.imds_cross_container_credentials.enabled"),
 	}
 
 	if err := rsConfig.sanitize(); err != nil {
@@ -526,11 +673,46 @@ func (c *RuntimeSecurityConfig) sanitize() error {
 		return fmt.Errorf("invalid value for runtime_security_config.enforcement.disarmer.executable.max_allowed: %d", c.EnforcementDisarmerExecutableMaxAllowed)
 	}
 
+	if c.RunawayRulesEnabled && c.RunawayRulesMaxRate <= 0 {
+		return fmt.Errorf("invalid value for runtime_security_config.policies.runaway.max_rate: %d", c.RunawayRulesMaxRate)
+	}
+
+	if err := c.sanitizeEventTypesMatrix(); err != nil {
+		return err
+	}
+
+	if err := c.sanitizeProcfsFallback(); err != nil {
+		return err
+	}
+
 	c.sanitizePlatform()
 
 	return c.sanitizeRuntimeSecurityConfigActivityDump()
 }
 
+// sanitizeProcfsFallback ensures that runtime_security_config.process_resolver.procfs_fallback is properly configured
+func (c *RuntimeSecurityConfig) sanitizeProcfsFallback() error {
+	if c.ProcfsFallbackMaxResolutions < 0 {
+		return fmt.Errorf("invalid value for runtime_security_config.process_resolver.procfs_fallback.max_resolutions: %d", c.ProcfsFallbackMaxResolutions)
+	}
+
+	if c.ProcfsFallbackPeriod <= 0 {
+		return fmt.Errorf("invalid value for runtime_security_config.process_resolver.procfs_fallback.period: %s", c.ProcfsFallbackPeriod)
+	}
+
+	overrides := pkgconfigsetup.SystemProbe().GetStringMapString("runtime_security_config.process_resolver.procfs_fallback.overrides")
+	c.ProcfsFallbackOverrides = make(map[string]int, len(overrides))
+	for workload, value := range overrides {
+		maxResolutions, err := strconv.Atoi(value)
+		if err != nil || maxResolutions < 0 {
+			return fmt.Errorf("invalid value for runtime_security_config.process_resolver.procfs_fallback.overrides of `%s`: %s", workload, value)
+		}
+		c.ProcfsFallbackOverrides[workload] = maxResolutions
+	}
+
+	return nil
+}
+
 // sanitizeRuntimeSecurityConfigActivityDump ensures that runtime_security_config.activity_dump is properly configured
 func (c *RuntimeSecurityConfig) sanitizeRuntimeSecurityConfigActivityDump() error {
 	var execFound bool
@@ -0,0 +1,83 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package config
+
+import (
+	"fmt"
+	"slices"
+
+	pkgconfigmodel "github.com/DataDog/datadog-agent/pkg/config/model"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/eval"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
+)
+
+const eventTypesConfigPrefix = "runtime_security_config.event_types"
+
+// eventFamilies maps the event families of the event types matrix to the event categories
+var eventFamilies = map[string]model.EventCategory{
+	"file":    model.FIMCategory,
+	"process": model.ProcessCategory,
+	"kernel":  model.KernelCategory,
+	"network": model.NetworkCategory,
+}
+
+// getEventFamily returns the family of the matrix of the provided event type
+func getEventFamily(eventType eval.EventType) string {
+	category := model.GetEventTypeCategory(eventType)
+	for family, familyCategory := range eventFamilies {
+		if familyCategory == category {
+			return family
+		}
+	}
+	return ""
+}
+
+// parseEventTypesMatrix reads the event types matrix, indexed either by event family or by event type
+func parseEventTypesMatrix(cfg pkgconfigmodel.Config) map[string]bool {
+	entries := cfg.GetStringMap(eventTypesConfigPrefix)
+	matrix := make(map[string]bool, len(entries))
+	for entry := range entries {
+		matrix[entry] = cfg.GetBool(eventTypesConfigPrefix + "." + entry)
+	}
+	return matrix
+}
+
+// sanitizeEventTypesMatrix ensures that the entries of the event types matrix are known event families or event types
+func (c *RuntimeSecurityConfig) sanitizeEventTypesMatrix() error {
+	for entry := range c.EventTypesMatrix {
+		if _, isFamily := eventFamilies[entry]; isFamily {
+			continue
+		}
+		if ParseEvalEventType(entry) == model.UnknownEventType {
+			return fmt.Errorf("invalid entry for %s: '%s' is neither an event family nor an event type", eventTypesConfigPrefix, entry)
+		}
+	}
+	return nil
+}
+
+// IsEventTypeEnabled returns whether the provided event type is enabled by the event types matrix. The entry of the
+// event type, if any, takes precedence over the entry of its family. Event types without entry are enabled.
+func (c *RuntimeSecurityConfig) IsEventTypeEnabled(eventType eval.EventType) bool {
+	if enabled, exists := c.EventTypesMatrix[eventType]; exists {
+		return enabled
+	}
+	if enabled, exists := c.EventTypesMatrix[getEventFamily(eventType)]; exists {
+		return enabled
+	}
+	return true
+}
+
+// GetDisabledEventTypes returns the sorted list of the SECL event types disabled by the event types matrix
+func (c *RuntimeSecurityConfig) GetDisabledEventTypes() []eval.EventType {
+	var disabled []eval.EventType
+	for _, eventType := range (&model.Model{}).GetEventTypes() {
+		if !c.IsEventTypeEnabled(eventType) {
+			disabled = append(disabled, eventType)
+		}
+	}
+	slices.Sort(disabled)
+	return disabled
+}
@@ -0,0 +1,41 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventTypesMatrix(t *testing.T) {
+	cfg := &RuntimeSecurityConfig{
+		EventTypesMatrix: map[string]bool{
+			"network": false,
+			"dns":     true,
+			"bpf":     false,
+		},
+	}
+	assert.NoError(t, cfg.sanitizeEventTypesMatrix())
+
+	assert.True(t, cfg.IsEventTypeEnabled("open"))
+	assert.True(t, cfg.IsEventTypeEnabled("exec"))
+	assert.True(t, cfg.IsEventTypeEnabled("dns"))
+	assert.False(t, cfg.IsEventTypeEnabled("imds"))
+	assert.False(t, cfg.IsEventTypeEnabled("bpf"))
+	assert.True(t, cfg.IsEventTypeEnabled("module"))
+
+	disabled := cfg.GetDisabledEventTypes()
+	assert.Contains(t, disabled, "bpf")
+	assert.Contains(t, disabled, "imds")
+	assert.NotContains(t, disabled, "dns")
+	assert.NotContains(t, disabled, "open")
+
+	cfg.EventTypesMatrix["unknown"] = false
+	assert.Error(t, cfg.sanitizeEventTypesMatrix())
+}
@@ -32,6 +32,7 @@
 #define FAKE_INODE_MSW 0xdeadc001UL
 #define DR_MAX_TAIL_CALL 29
 #define DR_MAX_ITERATION_DEPTH 47
+#define DR_MAX_PATH_DEPTH (DR_MAX_TAIL_CALL * DR_MAX_ITERATION_DEPTH)
 #define DR_MAX_SEGMENT_LENGTH 255
 #define DR_NO_CALLBACK -1
 
@@ -48,6 +49,11 @@ enum DENTRY_RESOLVER_KEYS
 
 #define DR_ERPC_BUFFER_LENGTH 8 * 4096
 
+// layout of the batched path resolution requests, the header being the one of the single requests
+#define DR_ERPC_BATCH_COUNT_OFFSET 32
+#define DR_ERPC_BATCH_KEYS_OFFSET 40
+#define DR_ERPC_MAX_BATCH_SIZE 13
+
 enum DENTRY_ERPC_RESOLUTION_CODE
 {
     DR_ERPC_OK,
@@ -193,5 +199,8 @@ static __attribute__((always_inline)) u64 get_imds_ip() {
 #define CGROUP_MANAGER_PODMAN 3
 #define CGROUP_MANAGER_CRI 4
 #define CGROUP_MANAGER_SYSTEMD 5
+#define CGROUP_MANAGER_LXC 6
+
+#define MPROTECT_FLAG_ANONYMOUS (1 << 0)
 
 #endif
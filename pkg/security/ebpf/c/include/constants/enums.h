@@ -203,6 +203,7 @@ enum erpc_op
     BUMP_DISCARDERS_REVISION,
     GET_RINGBUF_USAGE,
     USER_SESSION_CONTEXT_OP,
+    RESOLVE_PATHS_OP,
 };
 
 enum selinux_source_event_t
@@ -39,4 +39,76 @@ u64 __attribute__((always_inline)) get_task_struct_pid_offset() {
     return task_struct_pid_offset;
 }
 
+u64 __attribute__((always_inline)) get_task_struct_start_boottime_offset() {
+    u64 task_struct_start_boottime_offset;
+    LOAD_CONSTANT("task_struct_start_boottime_offset", task_struct_start_boottime_offset);
+    return task_struct_start_boottime_offset;
+}
+
+u64 __attribute__((always_inline)) get_task_struct_nsproxy_offset() {
+    u64 task_struct_nsproxy_offset;
+    LOAD_CONSTANT("task_struct_nsproxy_offset", task_struct_nsproxy_offset);
+    return task_struct_nsproxy_offset;
+}
+
+u64 __attribute__((always_inline)) get_task_struct_cred_offset() {
+    u64 task_struct_cred_offset;
+    LOAD_CONSTANT("task_struct_cred_offset", task_struct_cred_offset);
+    return task_struct_cred_offset;
+}
+
+u64 __attribute__((always_inline)) get_cred_user_ns_offset() {
+    u64 cred_user_ns_offset;
+    LOAD_CONSTANT("cred_user_ns_offset", cred_user_ns_offset);
+    return cred_user_ns_offset;
+}
+
+u64 __attribute__((always_inline)) get_nsproxy_uts_ns_offset() {
+    u64 nsproxy_uts_ns_offset;
+    LOAD_CONSTANT("nsproxy_uts_ns_offset", nsproxy_uts_ns_offset);
+    return nsproxy_uts_ns_offset;
+}
+
+u64 __attribute__((always_inline)) get_nsproxy_mnt_ns_offset() {
+    u64 nsproxy_mnt_ns_offset;
+    LOAD_CONSTANT("nsproxy_mnt_ns_offset", nsproxy_mnt_ns_offset);
+    return nsproxy_mnt_ns_offset;
+}
+
+u64 __attribute__((always_inline)) get_upid_ns_offset() {
+    u64 upid_ns_offset;
+    LOAD_CONSTANT("upid_ns_offset", upid_ns_offset);
+    return upid_ns_offset;
+}
+
+u64 __attribute__((always_inline)) get_pid_namespace_ns_offset() {
+    u64 pid_namespace_ns_offset;
+    LOAD_CONSTANT("pid_namespace_ns_offset", pid_namespace_ns_offset);
+    return pid_namespace_ns_offset;
+}
+
+u64 __attribute__((always_inline)) get_user_namespace_ns_offset() {
+    u64 user_namespace_ns_offset;
+    LOAD_CONSTANT("user_namespace_ns_offset", user_namespace_ns_offset);
+    return user_namespace_ns_offset;
+}
+
+u64 __attribute__((always_inline)) get_mnt_namespace_ns_offset() {
+    u64 mnt_namespace_ns_offset;
+    LOAD_CONSTANT("mnt_namespace_ns_offset", mnt_namespace_ns_offset);
+    return mnt_namespace_ns_offset;
+}
+
+u64 __attribute__((always_inline)) get_uts_namespace_ns_offset() {
+    u64 uts_namespace_ns_offset;
+    LOAD_CONSTANT("uts_namespace_ns_offset", uts_namespace_ns_offset);
+    return uts_namespace_ns_offset;
+}
+
+u64 __attribute__((always_inline)) get_ns_common_inum_offset() {
+    u64 ns_common_inum_offset;
+    LOAD_CONSTANT("ns_common_inum_offset", ns_common_inum_offset);
+    return ns_common_inum_offset;
+}
+
 #endif
@@ -277,6 +277,7 @@ struct mprotect_event_t {
     u64 vm_end;
     u64 vm_protection;
     u64 req_protection;
+    u64 flags;
 };
 
 struct net_device_event_t {
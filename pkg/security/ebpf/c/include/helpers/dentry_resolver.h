@@ -68,19 +68,78 @@ u32 __attribute__((always_inline)) parse_erpc_request(struct dr_erpc_state_t *st
     state->iteration = 0;
     state->ret = 0;
     state->cursor = 0;
+    state->key_count = 1;
+    state->key_index = 0;
+    state->key_depth = 0;
+    state->empty_leaf.len = 1;
 
 exit:
     return err;
 }
 
-int __attribute__((always_inline)) handle_dr_request(ctx_t *ctx, void *data, u32 dr_erpc_key) {
+u32 __attribute__((always_inline)) parse_erpc_batch_request(struct dr_erpc_state_t *state, void *data) {
+    u32 err = parse_erpc_request(state, data);
+    if (err > 0) {
+        return err;
+    }
+
+    int ret = bpf_probe_read(&state->key_count, sizeof(state->key_count), data + DR_ERPC_BATCH_COUNT_OFFSET);
+    if (ret < 0) {
+        return DR_ERPC_READ_PAGE_FAULT;
+    }
+    ret = bpf_probe_read(&state->keys, sizeof(state->keys), data + DR_ERPC_BATCH_KEYS_OFFSET);
+    if (ret < 0) {
+        return DR_ERPC_READ_PAGE_FAULT;
+    }
+
+    if (state->key_count > DR_ERPC_MAX_BATCH_SIZE) {
+        state->key_count = DR_ERPC_MAX_BATCH_SIZE;
+    }
+
+    return 0;
+}
+
+// next_erpc_key moves on to the next key of a batched request, the paths being written one after the other. Each key
+// gets the depth budget of a single request.
+int __attribute__((always_inline)) next_erpc_key(struct dr_erpc_state_t *state) {
+    u32 index = state->key_index + 1;
+    if (index >= state->key_count || index >= DR_ERPC_MAX_BATCH_SIZE) {
+        return 0;
+    }
+
+    state->key_index = index;
+    state->key = state->keys[index];
+    state->key_depth = 0;
+    return 1;
+}
+
+// lookup_erpc_leaf returns the leaf of the current key of the request. The empty leaf is returned on a cache miss or
+// once the depth budget of the key is exhausted, its empty name ending the path so that the following keys of a batched
+// request can still be resolved.
+struct path_leaf_t *__attribute__((always_inline)) lookup_erpc_leaf(struct dr_erpc_state_t *state) {
+    struct path_key_t key = state->key;
+
+    if (state->key_depth >= DR_MAX_PATH_DEPTH) {
+        return &state->empty_leaf;
+    }
+    state->key_depth++;
+
+    struct path_leaf_t *map_value = bpf_map_lookup_elem(&pathnames, &key);
+    if (map_value == NULL) {
+        monitor_resolution_err(DR_ERPC_CACHE_MISS);
+        return &state->empty_leaf;
+    }
+    return map_value;
+}
+
+int __attribute__((always_inline)) handle_dr_request(ctx_t *ctx, void *data, u32 dr_erpc_key, int batch) {
     u32 key = 0;
     struct dr_erpc_state_t *state = bpf_map_lookup_elem(&dr_erpc_state, &key);
     if (state == NULL) {
         return 0;
     }
 
-    u32 resolution_err = parse_erpc_request(state, data);
+    u32 resolution_err = batch ? parse_erpc_batch_request(state, data) : parse_erpc_request(state, data);
     if (resolution_err > 0) {
         goto exit;
     }
@@ -93,7 +93,9 @@ int __attribute__((always_inline)) handle_erpc_request(ctx_t *ctx) {
     case DISCARD_INODE_OP:
         return handle_discard_inode(data);
     case RESOLVE_PATH_OP:
-        return handle_dr_request(ctx, data, DR_ERPC_KEY);
+        return handle_dr_request(ctx, data, DR_ERPC_KEY, 0);
+    case RESOLVE_PATHS_OP:
+        return handle_dr_request(ctx, data, DR_ERPC_KEY, 1);
     case USER_SESSION_CONTEXT_OP:
         return handle_register_user_session(data);
     case REGISTER_SPAN_TLS_OP:
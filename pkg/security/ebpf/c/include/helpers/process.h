@@ -8,6 +8,7 @@
 #include "events_definition.h"
 
 #include "container.h"
+#include "utils.h"
 
 static __attribute__((always_inline)) void send_signal(u32 pid) {
     if (is_send_signal_available()) {
@@ -53,11 +54,88 @@ void __attribute__((always_inline)) copy_pid_cache_except_exit_ts(struct pid_cac
     dst->user_session_id = src->user_session_id;
     dst->ppid = src->ppid;
     dst->fork_timestamp = src->fork_timestamp;
+    dst->start_boottime = src->start_boottime;
+    dst->namespaces = src->namespaces;
     dst->credentials = src->credentials;
 }
 
-struct proc_cache_t __attribute__((always_inline)) * get_proc_from_cookie(u64 cookie) {
-    if (!cookie) {
+// get_task_start_boottime returns the start time of the task in nanoseconds since boot, procfs reports it in clock ticks
+u64 __attribute__((always_inline)) get_task_start_boottime(struct task_struct *task) {
+    u64 start_boottime = 0;
+    bpf_probe_read(&start_boottime, sizeof(start_boottime), (void *)task + get_task_struct_start_boottime_offset());
+    return start_boottime;
+}
+
+// get_ns_inum returns the inode number of a namespace, given the offset of its ns_common structure
+u32 __attribute__((always_inline)) get_ns_inum(void *ns, u64 ns_common_offset) {
+    if (ns == NULL) {
+        return 0;
+    }
+
+    u32 inum = 0;
+    bpf_probe_read(&inum, sizeof(inum), ns + ns_common_offset + get_ns_common_inum_offset());
+    return inum;
+}
+
+// fill_task_namespaces reads the namespaces of the task, they are left to 0 when the offsets are unavailable so that
+// user space falls back to the namespaces of the parent
+void __attribute__((always_inline)) fill_task_namespaces(struct task_struct *task, struct namespaces_t *namespaces) {
+    u64 task_struct_nsproxy_offset = get_task_struct_nsproxy_offset();
+    u64 task_struct_cred_offset = get_task_struct_cred_offset();
+    if (!task_struct_nsproxy_offset || !task_struct_cred_offset) {
+        return;
+    }
+
+    void *nsproxy = NULL;
+    bpf_probe_read(&nsproxy, sizeof(nsproxy), (void *)task + task_struct_nsproxy_offset);
+    if (nsproxy != NULL) {
+        void *ns = NULL;
+        bpf_probe_read(&ns, sizeof(ns), nsproxy + get_nsproxy_mnt_ns_offset());
+        namespaces->mntns = get_ns_inum(ns, get_mnt_namespace_ns_offset());
+
+        ns = NULL;
+        bpf_probe_read(&ns, sizeof(ns), nsproxy + get_nsproxy_uts_ns_offset());
+        namespaces->utsns = get_ns_inum(ns, get_uts_namespace_ns_offset());
+    }
+
+    // nsproxy only holds the pid namespace of the children, the one of the task is the namespace of its pid at its own level
+    struct pid *pid = NULL;
+    bpf_probe_read(&pid, sizeof(pid), (void *)task + get_task_struct_pid_offset());
+    if (pid != NULL) {
+        u32 pid_level = 0;
+        bpf_probe_read(&pid_level, sizeof(pid_level), (void *)pid + get_pid_level_offset());
+
+        void *ns = NULL;
+        bpf_probe_read(&ns, sizeof(ns), (void *)pid + get_pid_numbers_offset() + pid_level * get_sizeof_upid() + get_upid_ns_offset());
+        namespaces->pidns = get_ns_inum(ns, get_pid_namespace_ns_offset());
+    }
+
+    void *cred = NULL;
+    bpf_probe_read(&cred, sizeof(cred), (void *)task + task_struct_cred_offset);
+    if (cred != NULL) {
+        void *ns = NULL;
+        bpf_probe_read(&ns, sizeof(ns), cred + get_cred_user_ns_offset());
+        namespaces->userns = get_ns_inum(ns, get_user_namespace_ns_offset());
+    }
+}
+
+// new_process_cookie returns a 128 bits cookie, made of a random part and of the current time so that two executions
+// can only collide if they start during the same nanosecond
+struct process_cookie_t __attribute__((always_inline)) new_process_cookie() {
+    struct process_cookie_t cookie = {
+        .lo = (u64)rand32() << 32 | rand32(),
+        .hi = bpf_ktime_get_ns(),
+    };
+    return cookie;
+}
+
+u8 __attribute__((always_inline)) is_process_cookie_set(struct process_cookie_t *cookie) {
+    return cookie->lo || cookie->hi;
+}
+
+// the cookie is passed by value so that the map lookup is done with a key on the stack
+struct proc_cache_t __attribute__((always_inline)) * get_proc_from_cookie(struct process_cookie_t cookie) {
+    if (!is_process_cookie_set(&cookie)) {
         return NULL;
     }
 
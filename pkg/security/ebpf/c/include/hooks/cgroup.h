@@ -63,7 +63,7 @@ static __attribute__((always_inline)) int trace__cgroup_write(ctx_t *ctx) {
     struct proc_cache_t new_entry = {};
     struct proc_cache_t *old_entry;
     u8 new_cookie = 0;
-    u64 cookie = 0;
+    struct process_cookie_t cookie = {};
 
     // Retrieve the cookie of the process
     struct pid_cache_t *pid_entry = (struct pid_cache_t *)bpf_map_lookup_elem(&pid_cache, &pid);
@@ -81,7 +81,7 @@ static __attribute__((always_inline)) int trace__cgroup_write(ctx_t *ctx) {
         }
     } else {
         new_cookie = 1;
-        cookie = rand64();
+        cookie = new_process_cookie();
     }
 
     struct dentry *container_d;
@@ -133,14 +133,21 @@ static __attribute__((always_inline)) int trace__cgroup_write(ctx_t *ctx) {
 
         u64 inode = get_dentry_ino(container_d);
         resolver->key.ino = inode;
-        struct file_t *entry = bpf_map_lookup_elem(&exec_file_cache, &inode);
+        struct exec_file_cache_entry_t *entry = bpf_map_lookup_elem(&exec_file_cache, &inode);
         if (entry == NULL) {
             return 0;
         }
-        else {
-            resolver->key.mount_id = entry->path_key.mount_id;
+
+        // the inode number may have been reused since the entry was cached, ignore the entries of a previous owner
+        u32 generation = 0;
+        struct inode *d_inode = get_dentry_inode(container_d);
+        bpf_probe_read(&generation, sizeof(generation), &d_inode->i_generation);
+        if (entry->generation != generation) {
+            return 0;
         }
 
+        resolver->key.mount_id = entry->file.path_key.mount_id;
+
         resolver->dentry = container_d;
 
         if (is_docker_cgroup(ctx, container_d)) {
@@ -183,6 +190,13 @@ static __attribute__((always_inline)) int trace__cgroup_write(ctx_t *ctx) {
         container_id += 15; // skip "cri-containerd-"
         cgroup_flags = CGROUP_MANAGER_CRI;
     }
+    else if ((*prefix)[0] == 'l' && (*prefix)[1] == 'x' && (*prefix)[2] == 'c' && (*prefix)[3] == '.' && (*prefix)[4] == 'p'
+        && (*prefix)[5] == 'a' && (*prefix)[6] == 'y' && (*prefix)[7] == 'l' && (*prefix)[8] == 'o' && (*prefix)[9] == 'a'
+        && (*prefix)[10] == 'd' && (*prefix)[11] == '.') {
+        // the LXC containers are identified by their name, the legacy lxc/<name> hierarchy being resolved from procfs
+        container_id += 12; // skip "lxc.payload."
+        cgroup_flags = CGROUP_MANAGER_LXC;
+    }
 
 #ifdef DEBUG_CGROUP
     bpf_printk("container id: %s\n", container_qstr.name);
@@ -176,7 +176,6 @@ int __attribute__((always_inline)) dentry_resolver_erpc_write_user(void *ctx, in
     u32 key = 0;
     u32 resolution_err = 0;
     struct path_leaf_t *map_value = 0;
-    struct path_key_t iteration_key = {};
 
     struct dr_erpc_state_t *state = bpf_map_lookup_elem(&dr_erpc_state, &key);
     if (state == NULL) {
@@ -187,12 +186,7 @@ int __attribute__((always_inline)) dentry_resolver_erpc_write_user(void *ctx, in
 
 #pragma unroll
     for (int i = 0; i < DR_MAX_ITERATION_DEPTH; i++) {
-        iteration_key = state->key;
-        map_value = bpf_map_lookup_elem(&pathnames, &iteration_key);
-        if (map_value == NULL) {
-            resolution_err = DR_ERPC_CACHE_MISS;
-            goto exit;
-        }
+        map_value = lookup_erpc_leaf(state);
 
         // make sure we do not write outside of the provided buffer
         if (state->cursor + sizeof(state->key) >= state->buffer_size) {
@@ -230,7 +224,7 @@ int __attribute__((always_inline)) dentry_resolver_erpc_write_user(void *ctx, in
         state->key.ino = map_value->parent.ino;
         state->key.path_id = map_value->parent.path_id;
         state->key.mount_id = map_value->parent.mount_id;
-        if (state->key.ino == 0) {
+        if (state->key.ino == 0 && !next_erpc_key(state)) {
             goto exit;
         }
     }
@@ -253,7 +247,6 @@ int __attribute__((always_inline)) dentry_resolver_erpc_mmap(void *ctx, int dr_t
     u32 key = 0;
     u32 resolution_err = 0;
     struct path_leaf_t *map_value = 0;
-    struct path_key_t iteration_key = {};
     char *mmapped_userspace_buffer = NULL;
 
     struct dr_erpc_state_t *state = bpf_map_lookup_elem(&dr_erpc_state, &key);
@@ -270,12 +263,7 @@ int __attribute__((always_inline)) dentry_resolver_erpc_mmap(void *ctx, int dr_t
 
 #pragma unroll
     for (int i = 0; i < DR_MAX_ITERATION_DEPTH; i++) {
-        iteration_key = state->key;
-        map_value = bpf_map_lookup_elem(&pathnames, &iteration_key);
-        if (map_value == NULL) {
-            resolution_err = DR_ERPC_CACHE_MISS;
-            goto exit;
-        }
+        map_value = lookup_erpc_leaf(state);
 
         // make sure we do not write outside of the provided buffer
         if (state->cursor + sizeof(state->key) >= state->buffer_size) {
@@ -314,7 +302,7 @@ int __attribute__((always_inline)) dentry_resolver_erpc_mmap(void *ctx, int dr_t
         state->key.ino = map_value->parent.ino;
         state->key.path_id = map_value->parent.path_id;
         state->key.mount_id = map_value->parent.mount_id;
-        if (state->key.ino == 0) {
+        if (state->key.ino == 0 && !next_erpc_key(state)) {
             goto exit;
         }
     }
@@ -165,6 +165,20 @@ int hook__do_fork(ctx_t *ctx) {
     return handle_do_fork(ctx);
 }
 
+// cgroup_post_fork is called at the end of copy_process, once the start time of the child is set
+HOOK_ENTRY("cgroup_post_fork")
+int hook_cgroup_post_fork(ctx_t *ctx) {
+    struct syscall_cache_t *syscall = peek_syscall(EVENT_FORK);
+    if (!syscall) {
+        return 0;
+    }
+
+    struct task_struct *child = (struct task_struct *)CTX_PARM1(ctx);
+    syscall->fork.start_boottime = get_task_start_boottime(child);
+    fill_task_namespaces(child, &syscall->fork.namespaces);
+    return 0;
+}
+
 SEC("tracepoint/sched/sched_process_fork")
 int sched_process_fork(struct _tracepoint_sched_process_fork *args) {
     u64 sched_process_fork_parent_pid_offset;
@@ -208,6 +222,8 @@ int sched_process_fork(struct _tracepoint_sched_process_fork *args) {
     }
 
     event->pid_entry.fork_timestamp = ts;
+    event->pid_entry.start_boottime = syscall->fork.start_boottime;
+    event->pid_entry.namespaces = syscall->fork.namespaces;
 
     struct process_context_t *on_stack_process = &event->process;
     fill_process_context(on_stack_process);
@@ -239,7 +255,7 @@ int sched_process_fork(struct _tracepoint_sched_process_fork *args) {
         event->pid_entry.credentials = parent_pid_entry->credentials;
 
         // fetch the parent proc cache entry
-        u64 on_stack_cookie = event->pid_entry.cookie;
+        struct process_cookie_t on_stack_cookie = event->pid_entry.cookie;
         struct proc_cache_t *parent_pc = get_proc_from_cookie(on_stack_cookie);
         if (parent_pc) {
             fill_container_context(parent_pc, &event->container);
@@ -685,7 +701,7 @@ int __attribute__((always_inline)) send_exec_event(ctx_t *ctx) {
     struct pid_cache_t *fork_entry = (struct pid_cache_t *)bpf_map_lookup_elem(&pid_cache, &tgid);
     if (fork_entry) {
         // Fetch the parent proc cache entry
-        u64 parent_cookie = fork_entry->cookie;
+        struct process_cookie_t parent_cookie = fork_entry->cookie;
         struct proc_cache_t *parent_pc = get_proc_from_cookie(parent_cookie);
         if (parent_pc) {
             parent_inode = parent_pc->entry.executable.path_key.ino;
@@ -698,7 +714,7 @@ int __attribute__((always_inline)) send_exec_event(ctx_t *ctx) {
 
     // Insert new proc cache entry (Note: do not move the order of this block with the previous one, we need to inherit
     // the container ID before saving the entry in proc_cache. Modifying entry after insertion won't work.)
-    u64 cookie = rand64();
+    struct process_cookie_t cookie = new_process_cookie();
     bpf_map_update_elem(&proc_cache, &cookie, &pc, BPF_ANY);
 
     // update pid <-> cookie mapping
@@ -716,6 +732,14 @@ int __attribute__((always_inline)) send_exec_event(ctx_t *ctx) {
         }
     }
 
+    // the processes forked before the start of the probe don't have a start time yet, it is kept across exec
+    if (!fork_entry->start_boottime) {
+        fork_entry->start_boottime = get_task_start_boottime((struct task_struct *)bpf_get_current_task());
+    }
+
+    // the namespaces may have been changed by setns or unshare since the fork
+    fill_task_namespaces((struct task_struct *)bpf_get_current_task(), &fork_entry->namespaces);
+
     struct process_event_t *event = new_process_event(0);
     if (event == NULL) {
         return 0;
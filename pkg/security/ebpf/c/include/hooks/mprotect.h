@@ -30,6 +30,8 @@ int hook_security_file_mprotect(ctx_t *ctx) {
 
     u64 flags_offset;
     LOAD_CONSTANT("vm_area_struct_flags_offset", flags_offset);
+    u64 file_offset;
+    LOAD_CONSTANT("vm_area_struct_file_offset", file_offset);
 
     // Retrieve vma information
     struct vm_area_struct *vma = (struct vm_area_struct *)CTX_PARM1(ctx);
@@ -37,6 +39,13 @@ int hook_security_file_mprotect(ctx_t *ctx) {
     bpf_probe_read(&syscall->mprotect.vm_start, sizeof(syscall->mprotect.vm_start), &vma->vm_start);
     bpf_probe_read(&syscall->mprotect.vm_end, sizeof(syscall->mprotect.vm_end), &vma->vm_end);
     syscall->mprotect.req_protection = (u64)CTX_PARM2(ctx);
+
+    // a memory segment not backed by a file is anonymous, like the code generated by JIT compilers or in-memory loaders
+    struct file *vm_file = NULL;
+    bpf_probe_read(&vm_file, sizeof(vm_file), (char *)vma + file_offset);
+    if (vm_file == NULL) {
+        syscall->mprotect.flags |= MPROTECT_FLAG_ANONYMOUS;
+    }
     return 0;
 }
 
@@ -55,6 +64,7 @@ int __attribute__((always_inline)) sys_mprotect_ret(void *ctx, int retval) {
         .req_protection = syscall->mprotect.req_protection,
         .vm_start = syscall->mprotect.vm_start,
         .vm_end = syscall->mprotect.vm_end,
+        .flags = syscall->mprotect.flags,
     };
 
     struct proc_cache_t *entry = fill_process_context(&event.process);
@@ -14,15 +14,20 @@ static __attribute__((always_inline)) void cache_file(struct dentry *dentry, u32
         set_overlayfs_ino(dentry, &inode, &flags);
     }
 
-    struct file_t entry = {
-        .path_key = {
-            .ino = inode,
-            .mount_id = mount_id,
+    struct exec_file_cache_entry_t entry = {
+        .file = {
+            .path_key = {
+                .ino = inode,
+                .mount_id = mount_id,
+            },
+            .flags = flags,
         },
-        .flags = flags,
     };
 
-    fill_file(dentry, &entry);
+    fill_file(dentry, &entry.file);
+
+    struct inode *d_inode = get_dentry_inode(dentry);
+    bpf_probe_read(&entry.generation, sizeof(entry.generation), &d_inode->i_generation);
 
     // why not inode + mount id ?
     bpf_map_update_elem(&exec_file_cache, &inode, &entry, BPF_ANY);
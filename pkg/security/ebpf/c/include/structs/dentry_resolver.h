@@ -18,6 +18,12 @@ struct path_leaf_t {
 struct dr_erpc_state_t {
     char *userspace_buffer;
     struct path_key_t key;
+    struct path_key_t keys[DR_ERPC_MAX_BATCH_SIZE];
+    u32 key_count;
+    u32 key_index;
+    u32 key_depth;
+    // written in place of the segments that can't be resolved, its name being left empty
+    struct path_leaf_t empty_leaf;
     int ret;
     int iteration;
     u32 buffer_size;
@@ -14,6 +14,13 @@ struct mount_ref_t {
     s32 counter;
 };
 
+struct exec_file_cache_entry_t {
+    struct file_t file;
+    // inode numbers are reused once a file is deleted, the generation tells the inodes sharing a number apart
+    u32 generation;
+    u32 padding;
+};
+
 struct mount_fields_t {
     struct path_key_t root_key;
     struct path_key_t mountpoint_key;
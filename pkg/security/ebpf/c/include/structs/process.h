@@ -31,13 +31,29 @@ struct credentials_t {
     u64 cap_permitted;
 };
 
+// namespaces_t holds the inode numbers of the namespaces of a process, 0 when they couldn't be read
+struct namespaces_t {
+    u32 pidns;
+    u32 userns;
+    u32 mntns;
+    u32 utsns;
+};
+
+// process_cookie_t identifies an execution of a process, it is shared by the forks of the process until their next exec
+struct process_cookie_t {
+    u64 lo;
+    u64 hi;
+};
+
 struct pid_cache_t {
-    u64 cookie;
+    struct process_cookie_t cookie;
     u32 ppid;
     u32 padding;
     u64 fork_timestamp;
     u64 exit_timestamp;
     u64 user_session_id;
+    u64 start_boottime;
+    struct namespaces_t namespaces;
     struct credentials_t credentials;
 };
 
@@ -134,6 +134,8 @@ struct syscall_cache_t {
         struct {
             u32 is_thread;
             u32 is_kthread;
+            u64 start_boottime;
+            struct namespaces_t namespaces;
         } fork;
 
         struct {
@@ -172,6 +174,7 @@ struct syscall_cache_t {
             u64 vm_end;
             u64 vm_protection;
             u64 req_protection;
+            u64 flags;
         } mprotect;
 
         struct {
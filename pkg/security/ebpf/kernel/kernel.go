@@ -98,6 +98,8 @@ var (
 	Kernel6_2 = kernel.VersionCode(6, 2, 0)
 	// Kernel6_3 is the KernelVersion representation of kernel version 6.3
 	Kernel6_3 = kernel.VersionCode(6, 3, 0)
+	// Kernel6_4 is the KernelVersion representation of kernel version 6.4
+	Kernel6_4 = kernel.VersionCode(6, 4, 0)
 	// Kernel6_5 is the KernelVersion representation of kernel version 6.5
 	Kernel6_5 = kernel.VersionCode(6, 5, 0)
 	// Kernel6_6 is the KernelVersion representation of kernel version 6.6
@@ -50,6 +50,59 @@ func computeDefaultEventsRingBufferSize() uint32 {
 	return uint32(16 * 256 * os.Getpagesize())
 }
 
+const (
+	// eventsBufferAverageEventSize is the average size of an event written to the event stream
+	eventsBufferAverageEventSize = 512
+	// eventsBufferRetentionDivisor sizes the event stream to hold the events expected in 1/eventsBufferRetentionDivisor
+	// of a second, the time needed by user space to catch up with a burst
+	eventsBufferRetentionDivisor = 2
+)
+
+// MinEventsBufferSize returns the minimum size of the event stream, per CPU for perf buffers
+func MinEventsBufferSize(useRingBuffers bool) int {
+	if useRingBuffers {
+		return 8 * 256 * os.Getpagesize()
+	}
+	return 64 * os.Getpagesize()
+}
+
+// MaxEventsBufferSize returns the maximum size of the event stream, per CPU for perf buffers
+func MaxEventsBufferSize(useRingBuffers bool) int {
+	if useRingBuffers {
+		return 64 * 256 * os.Getpagesize()
+	}
+	return 1024 * os.Getpagesize()
+}
+
+// ComputeEventsBufferSize returns the size of the event stream able to absorb the expected event rate, per CPU for perf
+// buffers. The size is a power of 2 and a multiple of the page size. Without expected event rate, the default size is
+// returned.
+func ComputeEventsBufferSize(numCPU int, expectedEventRate int, useRingBuffers bool) int {
+	if expectedEventRate <= 0 {
+		if useRingBuffers {
+			return int(computeDefaultEventsRingBufferSize())
+		}
+		return EventsPerfRingBufferSize
+	}
+
+	size := expectedEventRate * eventsBufferAverageEventSize / eventsBufferRetentionDivisor
+	if !useRingBuffers && numCPU > 0 {
+		size /= numCPU
+	}
+
+	return RoundEventsBufferSize(size, useRingBuffers)
+}
+
+// RoundEventsBufferSize rounds up the provided size to a valid size of the event stream, between the minimum and the
+// maximum sizes
+func RoundEventsBufferSize(size int, useRingBuffers bool) int {
+	rounded := MinEventsBufferSize(useRingBuffers)
+	for rounded < size && rounded < MaxEventsBufferSize(useRingBuffers) {
+		rounded *= 2
+	}
+	return rounded
+}
+
 // AllProbes returns the list of all the probes of the runtime security module
 func AllProbes(fentry bool) []*manager.Probe {
 	var allProbes []*manager.Probe
@@ -111,6 +111,7 @@ func GetSelectorsPerEventType(fentry bool) map[eval.EventType][]manager.ProbesSe
 				kprobeOrFentry("commit_creds"),
 				kprobeOrFentry("switch_task_namespaces"),
 				kprobeOrFentry("do_coredump"),
+				kprobeOrFentry("cgroup_post_fork"),
 				kprobeOrFentry("audit_set_loginuid"),
 				kretprobeOrFexit("audit_set_loginuid"),
 			}},
@@ -140,6 +140,12 @@ func getExecProbes(fentry bool) []*manager.Probe {
 				EBPFFuncName: "hook_do_coredump",
 			},
 		},
+		{
+			ProbeIdentificationPair: manager.ProbeIdentificationPair{
+				UID:          SecurityAgentUID,
+				EBPFFuncName: "hook_cgroup_post_fork",
+			},
+		},
 		{
 			ProbeIdentificationPair: manager.ProbeIdentificationPair{
 				UID:          SecurityAgentUID,
@@ -63,6 +63,31 @@ const (
 	InternalCoreDumpRuleID = "internal_core_dump"
 	// InternalCoreDumpRuleDesc internal core dump
 	InternalCoreDumpRuleDesc = "Internal Core Dump"
+
+	// RuleDigestRuleID is the rule ID for the rule_digest events
+	RuleDigestRuleID = "rule_digest"
+	// RuleDigestRuleDesc is the rule description for the rule_digest events
+	RuleDigestRuleDesc = "Digest of the rule matches"
+
+	// AWSCredentialsCrossContainerRuleID is the rule ID for the aws_credentials_cross_container events
+	AWSCredentialsCrossContainerRuleID = "aws_credentials_cross_container"
+	// AWSCredentialsCrossContainerRuleDesc is the rule description for the aws_credentials_cross_container events
+	AWSCredentialsCrossContainerRuleDesc = "AWS credentials used outside of the workload that received them"
+
+	// RunawayRuleRuleID is the rule ID for the runaway_rule events
+	RunawayRuleRuleID = "runaway_rule"
+	// RunawayRuleRuleDesc is the rule description for the runaway_rule events
+	RunawayRuleRuleDesc = "Rule disabled because of its match rate"
+
+	// EnvVarsChangedRuleID is the rule ID for the env_vars_changed events
+	EnvVarsChangedRuleID = "env_vars_changed"
+	// EnvVarsChangedRuleDesc is the rule description for the env_vars_changed events
+	EnvVarsChangedRuleDesc = "Environment of a running process modified"
+
+	// ProcessCacheMemoryBudgetRuleID is the rule ID for the process_cache_memory_budget events
+	ProcessCacheMemoryBudgetRuleID = "process_cache_memory_budget"
+	// ProcessCacheMemoryBudgetRuleDesc is the rule description for the process_cache_memory_budget events
+	ProcessCacheMemoryBudgetRuleDesc = "Process cache entries shed because of its memory budget"
 )
 
 // AgentContainerContext is like model.ContainerContext, but without event based resolvers
@@ -105,6 +130,11 @@ func AllCustomRuleIDs() []string {
 		NoProcessContextErrorRuleID,
 		BrokenProcessLineageErrorRuleID,
 		InternalCoreDumpRuleID,
+		RuleDigestRuleID,
+		AWSCredentialsCrossContainerRuleID,
+		RunawayRuleRuleID,
+		EnvVarsChangedRuleID,
+		ProcessCacheMemoryBudgetRuleID,
 	}
 }
 
@@ -19,6 +19,10 @@ var (
 	// security-agent was not processing them fast enough
 	// Tags: rule_id
 	MetricEventServerExpired = newRuntimeMetric(".rules.event_server.expired")
+	// MetricEventServerTrimmed is the name of the metric used to count the number of events from which a field was
+	// stripped because the serialized event exceeded the configured maximum size
+	// Tags: field
+	MetricEventServerTrimmed = newRuntimeMetric(".rules.event_server.trimmed")
 	// MetricProcessEventsServerExpired is the name of the metric used to count the number of process events that
 	// expired because the process-agent was not processing them fast enough
 	// Tags: -
@@ -38,6 +42,13 @@ var (
 	// MetricRulesSuppressed is the name of the metric used to count the number of auto suppressed events
 	// Tags: rule_id
 	MetricRulesSuppressed = newRuntimeMetric(".rules.suppressed")
+	// MetricRulesRunawayDisabled is the name of the metric used to count the rules disabled because of their match rate
+	// Tags: rule_id
+	MetricRulesRunawayDisabled = newRuntimeMetric(".rules.runaway_disabled")
+	// MetricContainerEscapeMatches is the name of the metric used to count the matches of the bundled container escape
+	// rules, apart from the user policies
+	// Tags: technique, bundle_version
+	MetricContainerEscapeMatches = newRuntimeMetric(".rules.container_escape.matches")
 
 	// Rule action metrics
 
@@ -69,6 +80,18 @@ var (
 	// Tags: ret
 	MetricDentryERPC = newRuntimeMetric(".dentry_resolver.erpc")
 
+	// Path Resolver metrics
+
+	// MetricPathResolverCacheHits is the counter of path resolutions served by the path cache
+	// Tags: -
+	MetricPathResolverCacheHits = newRuntimeMetric(".path_resolver.cache.hits")
+	// MetricPathResolverCacheMiss is the counter of path resolutions not found in the path cache
+	// Tags: -
+	MetricPathResolverCacheMiss = newRuntimeMetric(".path_resolver.cache.miss")
+	// MetricPathResolverCacheNegativeHits is the counter of failed path resolutions served by the path cache
+	// Tags: -
+	MetricPathResolverCacheNegativeHits = newRuntimeMetric(".path_resolver.cache.negative_hits")
+
 	// filtering metrics
 
 	// MetricDiscarderAdded is the number of discarder added
@@ -77,6 +100,12 @@ var (
 	// MetricEventDiscarded is the number of event discarded
 	// Tags: discarder_type, event_type
 	MetricEventDiscarded = newRuntimeMetric(".discarders.event_discarded")
+	// MetricDiscardedInodesLookups is the number of events looked up in the filter of the recently discarded inodes
+	// Tags: -
+	MetricDiscardedInodesLookups = newRuntimeMetric(".discarders.discarded_inodes.lookups")
+	// MetricDiscardedInodesSkipped is the number of events of recently discarded inodes dropped in user space
+	// Tags: -
+	MetricDiscardedInodesSkipped = newRuntimeMetric(".discarders.discarded_inodes.skipped")
 	// MetricApproverAdded is the number of approvers added
 	// Tags: approver_type, event_type
 	MetricApproverAdded = newRuntimeMetric(".approvers.approver_added")
@@ -117,6 +146,10 @@ var (
 	// MetricPerfBufferBytesInUse is the name of the metric used to count the percentage of space left in the ring buffer
 	// Tags: map
 	MetricPerfBufferBytesInUse = newRuntimeMetric(".perf_buffer.bytes.in_use")
+	// MetricPerfBufferRecommendedSize is the name of the metric used to report the size recommended for the event stream
+	// after sustained event loss, per CPU for perf buffers
+	// Tags: map
+	MetricPerfBufferRecommendedSize = newRuntimeMetric(".perf_buffer.recommended_size")
 	// MetricPerfBufferSortingError is the name of the metric used to report events reordering issues.
 	// Tags: map, event_type
 	MetricPerfBufferSortingError = newRuntimeMetric(".perf_buffer.sorting_error")
@@ -134,6 +167,20 @@ var (
 	// Tags: map, cause
 	MetricPerfBufferInvalidEventsBytes = newRuntimeMetric(".perf_buffer.invalid_events.bytes")
 
+	// Event stream priority lanes metrics
+
+	// MetricEventStreamLaneEvents is the name of the metric used to count the number of events handled by a priority lane
+	// Tags: lane
+	MetricEventStreamLaneEvents = newRuntimeMetric(".event_stream.lane.events")
+	// MetricEventStreamLaneDropped is the name of the metric used to count the number of events dropped by a priority
+	// lane because its queue was full, or filled up to the threshold of the drop policy
+	// Tags: lane, event_type
+	MetricEventStreamLaneDropped = newRuntimeMetric(".event_stream.lane.dropped")
+	// MetricEventStreamLaneLag is the name of the metric used to report the maximum time, in milliseconds, spent by an
+	// event in the queue of a priority lane
+	// Tags: lane
+	MetricEventStreamLaneLag = newRuntimeMetric(".event_stream.lane.lag")
+
 	// Process Resolver metrics
 
 	// MetricProcessResolverCacheSize is the name of the metric used to report the size of the user space
@@ -159,18 +206,103 @@ var (
 	// MetricProcessResolverFlushed is the name of the metric used to report the number cache flush
 	// Tags: -
 	MetricProcessResolverFlushed = newRuntimeMetric(".process_resolver.flushed")
+	// MetricProcessResolverProcfsDropped is the name of the metric used to report the number of procfs resolutions
+	// dropped because the procfs worker queue was full
+	// Tags: -
+	MetricProcessResolverProcfsDropped = newRuntimeMetric(".process_resolver.procfs.dropped")
+	// MetricProcessResolverProcfsFallbackDropped is the name of the metric used to report the number of procfs
+	// resolutions dropped by the procfs fallback limiter
+	// Tags: workload (only for the workloads of the overrides)
+	MetricProcessResolverProcfsFallbackDropped = newRuntimeMetric(".process_resolver.procfs_fallback.dropped")
+	// MetricProcessResolverEvicted is the name of the metric used to report the number of entries evicted from the
+	// cache because it reached its maximum number of entries
+	// Tags: type (exited, leaf)
+	MetricProcessResolverEvicted = newRuntimeMetric(".process_resolver.evicted")
+	// MetricProcessResolverCookieCollisions is the name of the metric used to report the number of executions whose
+	// cookie was already used by another execution
+	// Tags: -
+	MetricProcessResolverCookieCollisions = newRuntimeMetric(".process_resolver.cookie_collisions")
+	// MetricProcessResolverSubscriptionsDropped is the name of the metric used to report the number of process tree
+	// updates dropped because a subscriber didn't keep up with them
+	// Tags: -
+	MetricProcessResolverSubscriptionsDropped = newRuntimeMetric(".process_resolver.subscriptions.dropped")
+	// MetricProcessResolverMemoryUsage is the name of the metric used to report the estimated memory used by the cache
+	// entries and the pending args and envs
+	// Tags: -
+	MetricProcessResolverMemoryUsage = newRuntimeMetric(".process_resolver.memory_usage")
+	// MetricProcessResolverMemoryBudgetShed is the name of the metric used to report the number of entries evicted
+	// from the cache because its estimated memory usage exceeded the memory budget
+	// Tags: type (exited, leaf)
+	MetricProcessResolverMemoryBudgetShed = newRuntimeMetric(".process_resolver.memory_budget.shed")
+	// MetricProcessResolverReconciliationDrift is the name of the metric used to report the number of divergences
+	// between the kernel maps and the user space cache found by the reconciliation, tagged by kind of drift
+	// Tags: drift
+	MetricProcessResolverReconciliationDrift = newRuntimeMetric(".process_resolver.reconciliation.drift")
+	// MetricProcessResolverReconciliationRepaired is the name of the metric used to report the number of divergences
+	// between the kernel maps and the user space cache repaired by the reconciliation, tagged by kind of drift
+	// Tags: drift
+	MetricProcessResolverReconciliationRepaired = newRuntimeMetric(".process_resolver.reconciliation.repaired")
+	// MetricProcessResolverExitedQueueDepth is the name of the metric used to report the number of exited processes
+	// waiting to be flushed from the cache
+	// Tags: -
+	MetricProcessResolverExitedQueueDepth = newRuntimeMetric(".process_resolver.exited_queue.depth")
+	// MetricProcessResolverExitedQueueDropped is the name of the metric used to report the number of exited processes
+	// dropped because the exited queue was full
+	// Tags: -
+	MetricProcessResolverExitedQueueDropped = newRuntimeMetric(".process_resolver.exited_queue.dropped")
 	// MetricProcessResolverArgsTruncated is the name of the metric used to report the number of args truncated
 	// Tags: -
 	MetricProcessResolverArgsTruncated = newRuntimeMetric(".process_resolver.args.truncated")
 	// MetricProcessResolverArgsSize is the name of the metric used to report the number of args size
 	// Tags: -
 	MetricProcessResolverArgsSize = newRuntimeMetric(".process_resolver.args.size")
+	// MetricProcessResolverArgsLost is the name of the metric used to report the number of execs whose args were lost
+	// Tags: -
+	MetricProcessResolverArgsLost = newRuntimeMetric(".process_resolver.args.lost")
+	// MetricProcessResolverArgsEnvsEvicted is the name of the metric used to report the number of args and envs of
+	// starting processes evicted from the args and envs cache before their exec event was received
+	// Tags: -
+	MetricProcessResolverArgsEnvsEvicted = newRuntimeMetric(".process_resolver.args_envs_cache.evicted")
+	// MetricProcessResolverArgsEnvsValuesEvicted is the name of the metric used to report the number of args and envs
+	// values evicted from the deduplication cache
+	// Tags: -
+	MetricProcessResolverArgsEnvsValuesEvicted = newRuntimeMetric(".process_resolver.args_envs_value_cache.evicted")
+	// MetricProcessResolverArgsProcfsFallback is the name of the metric used to report the number of lost args
+	// completed from procfs
+	// Tags: -
+	MetricProcessResolverArgsProcfsFallback = newRuntimeMetric(".process_resolver.args.procfs_fallback")
 	// MetricProcessResolverEnvsTruncated is the name of the metric used to report the number of envs truncated
 	// Tags: -
 	MetricProcessResolverEnvsTruncated = newRuntimeMetric(".process_resolver.envs.truncated")
 	// MetricProcessResolverEnvsSize is the name of the metric used to report the number of envs size
 	// Tags: -
 	MetricProcessResolverEnvsSize = newRuntimeMetric(".process_resolver.envs.size")
+	// MetricProcessResolverEnvsLost is the name of the metric used to report the number of execs whose envs were lost
+	// Tags: -
+	MetricProcessResolverEnvsLost = newRuntimeMetric(".process_resolver.envs.lost")
+	// MetricProcessResolverEnvsChanged is the name of the metric used to report the number of environments of running
+	// processes found modified by the periodic refresh
+	// Tags: -
+	MetricProcessResolverEnvsChanged = newRuntimeMetric(".process_resolver.envs.changed")
+	// MetricProcessResolverAuditExecs is the name of the metric used to report the reconciliation of the execs reported
+	// by the kernel audit subsystem with the execs collected with eBPF
+	// Tags: status ('matched', 'ebpf_only', 'audit_only')
+	MetricProcessResolverAuditExecs = newRuntimeMetric(".process_resolver.audit.execs")
+	// MetricProcessResolverAuditPathMismatch is the name of the metric used to report the number of execs whose path
+	// differs between the kernel audit subsystem and eBPF
+	// Tags: -
+	MetricProcessResolverAuditPathMismatch = newRuntimeMetric(".process_resolver.audit.path_mismatch")
+	// MetricProcessResolverAuditLost is the name of the metric used to report the number of audit events lost
+	// Tags: -
+	MetricProcessResolverAuditLost = newRuntimeMetric(".process_resolver.audit.lost")
+	// MetricProcessResolverExecSampled is the name of the metric used to report the number of identical exec events
+	// dropped by the exec sampling
+	// Tags: -
+	MetricProcessResolverExecSampled = newRuntimeMetric(".process_resolver.exec_sampling.dropped")
+	// MetricProcessResolverLineageRepaired is the name of the metric used to report the broken lineages repaired from
+	// procfs
+	// Tags: -
+	MetricProcessResolverLineageRepaired = newRuntimeMetric(".process_resolver.lineage_repaired")
 	// MetricProcessEventBrokenLineage is the name of the metric used to report a broken lineage
 	// Tags: -
 	MetricProcessEventBrokenLineage = newRuntimeMetric(".process_resolver.event_broken_lineage")
@@ -178,6 +310,13 @@ var (
 	// Tags: -
 	MetricProcessInodeError = newRuntimeMetric(".process_resolver.inode_error")
 
+	// Time resolver metrics
+
+	// MetricTimeResolverSuspendDrift is the name of the metric used to report the time, in nanoseconds, the host spent
+	// suspended since the start of the time resolver, corrected in the timestamps taken before the suspensions
+	// Tags: -
+	MetricTimeResolverSuspendDrift = newRuntimeMetric(".time_resolver.suspend_drift")
+
 	// Mount resolver metrics
 
 	// MetricMountResolverCacheSize is the name of the metric used to report the size of the user space
@@ -190,6 +329,24 @@ var (
 	// MetricMountResolverMiss is the counter of unsuccessful mount resolution
 	// Tags: cache, procfs
 	MetricMountResolverMiss = newRuntimeMetric(".mount_resolver.miss")
+	// MetricMountResolverNamespaces is the name of the metric used to report the number of mount namespaces whose
+	// processes are tracked by the mount resolver
+	// Tags: -
+	MetricMountResolverNamespaces = newRuntimeMetric(".mount_resolver.mount_ns")
+	// MetricMountResolverReleasedNamespaces is the counter of mount namespaces released after the exit of their last
+	// process
+	// Tags: -
+	MetricMountResolverReleasedNamespaces = newRuntimeMetric(".mount_resolver.mount_ns_released")
+	// MetricMountResolverReleasedMounts is the counter of mounts released with their mount namespace
+	// Tags: -
+	MetricMountResolverReleasedMounts = newRuntimeMetric(".mount_resolver.mounts_released")
+	// MetricMountResolverProcfsPathHits is the counter of file paths recovered from procfs on NFS and FUSE mounts
+	// Tags: source
+	MetricMountResolverProcfsPathHits = newRuntimeMetric(".mount_resolver.procfs_path.hits")
+	// MetricMountResolverProcfsPathMiss is the counter of file paths that couldn't be recovered from procfs on NFS and
+	// FUSE mounts
+	// Tags: -
+	MetricMountResolverProcfsPathMiss = newRuntimeMetric(".mount_resolver.procfs_path.miss")
 
 	// Activity dump metrics
 
@@ -237,6 +394,9 @@ var (
 	// MetricActivityDumpWorkloadDenyListHits is the name of the metric used to report the count of dumps that were dismissed because their workload is in the deny list
 	// Tags: -
 	MetricActivityDumpWorkloadDenyListHits = newRuntimeMetric(".activity_dump.workload_deny_list_hits")
+	// MetricActivityDumpAnomalyDumps is the name of the metric used to report the count of activity dumps triggered by the anomalies of a workload
+	// Tags: -
+	MetricActivityDumpAnomalyDumps = newRuntimeMetric(".activity_dump.anomaly_dumps")
 	// MetricActivityDumpLocalStorageCount is the name of the metric used to count the number of dumps stored locally
 	// Tags: -
 	MetricActivityDumpLocalStorageCount = newAgentMetric(".activity_dump.local_storage.count")
@@ -293,6 +453,10 @@ var (
 	// MetricSecurityProfileVersions is the name of the metric used to track the number of versions a profile can have
 	// Tags: security_profile_image_name
 	MetricSecurityProfileVersions = newAgentMetric(".security_profile.versions")
+	// MetricSecurityProfileAnomalyDetectionSuppressed is the name of the metric used to count the anomaly detections
+	// dropped because a suppression window was open for the workload
+	// Tags: -
+	MetricSecurityProfileAnomalyDetectionSuppressed = newAgentMetric(".security_profile.anomaly_detection.suppressed")
 
 	// Hash resolver metrics
 
@@ -10,7 +10,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
 	"runtime"
+	"strings"
 	"sync"
 	"time"
 
@@ -18,13 +20,16 @@ import (
 	"go.uber.org/atomic"
 
 	workloadmeta "github.com/DataDog/datadog-agent/comp/core/workloadmeta/def"
+	"github.com/DataDog/datadog-agent/pkg/diagnose/diagnosis"
 	"github.com/DataDog/datadog-agent/pkg/eventmonitor"
+	"github.com/DataDog/datadog-agent/pkg/security/canary"
 	"github.com/DataDog/datadog-agent/pkg/security/config"
 	"github.com/DataDog/datadog-agent/pkg/security/events"
 	"github.com/DataDog/datadog-agent/pkg/security/metrics"
 	"github.com/DataDog/datadog-agent/pkg/security/probe"
 	"github.com/DataDog/datadog-agent/pkg/security/probe/selftests"
 	"github.com/DataDog/datadog-agent/pkg/security/proto/api"
+	"github.com/DataDog/datadog-agent/pkg/security/rconfig"
 	rulesmodule "github.com/DataDog/datadog-agent/pkg/security/rules"
 	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/eval"
 	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
@@ -61,6 +66,9 @@ type CWSConsumer struct {
 	selfTestRetry *atomic.Int32
 	reloader      ReloaderInterface
 	crtelemetry   *telemetry.ContainersRunningTelemetry
+	canaryFiles   *canary.Files
+
+	suppressionWindowsProvider *rconfig.RCSuppressionWindowsProvider
 }
 
 // NewCWSConsumer initializes the module with options
@@ -164,6 +172,15 @@ func (c *CWSConsumer) Start() error {
 	// start api server
 	c.apiServer.Start(c.ctx)
 
+	// deploy the canary files before the rules monitoring them are loaded
+	if c.config.CanaryFilesEnabled {
+		canaryFiles, err := canary.Deploy(os.Getenv("HOST_ROOT"), c.config.CanaryFilesPaths)
+		if err != nil {
+			seclog.Errorf("failed to deploy canary files: %s", err)
+		}
+		c.canaryFiles = canaryFiles
+	}
+
 	if err := c.ruleEngine.Start(c.ctx, c.reloader.Chan(), &c.wg); err != nil {
 		return err
 	}
@@ -176,6 +193,10 @@ func (c *CWSConsumer) Start() error {
 		go c.crtelemetry.Run(c.ctx)
 	}
 
+	if c.suppressionWindowsProvider != nil {
+		c.suppressionWindowsProvider.Start()
+	}
+
 	seclog.Infof("runtime security started")
 
 	// we can now wait for self test events
@@ -264,12 +285,27 @@ func (c *CWSConsumer) reportSelfTest(success []eval.RuleID, fails []eval.RuleID,
 func (c *CWSConsumer) Stop() {
 	c.reloader.Stop()
 
+	// the matches accounted since the last digest are reported before the event server is stopped
+	c.ruleEngine.FlushDigest()
+
 	if c.apiServer != nil {
 		c.apiServer.Stop()
 	}
 
 	c.ruleEngine.Stop()
 
+	if c.suppressionWindowsProvider != nil {
+		if err := c.suppressionWindowsProvider.Close(); err != nil {
+			seclog.Errorf("failed to close the suppression windows provider: %s", err)
+		}
+	}
+
+	if c.canaryFiles != nil {
+		if err := c.canaryFiles.Remove(); err != nil {
+			seclog.Errorf("failed to remove canary files: %s", err)
+		}
+	}
+
 	c.cancelFnc()
 	c.wg.Wait()
 
@@ -291,6 +327,26 @@ func (c *CWSConsumer) SendEvent(rule *rules.Rule, event events.Event, extTagsCb
 	}
 }
 
+// Diagnose returns the diagnoses of the CWS consumer
+func (c *CWSConsumer) Diagnose() []diagnosis.Diagnosis {
+	d := diagnosis.Diagnosis{
+		Name:        "Policies",
+		Category:    eventmonitor.DiagnoseCategory,
+		Description: "Checks that the rules of the loaded policies are valid",
+	}
+
+	if errs := c.apiServer.getRuleLoadErrors(); len(errs) > 0 {
+		d.Result = diagnosis.DiagnosisFail
+		d.Diagnosis = fmt.Sprintf("%d rules failed to load:\n%s", len(errs), strings.Join(errs, "\n"))
+		d.Remediation = "Fix the expressions of the listed rules"
+	} else {
+		d.Result = diagnosis.DiagnosisSuccess
+		d.Diagnosis = "All the rules of the loaded policies are valid"
+	}
+
+	return []diagnosis.Diagnosis{d}
+}
+
 // APIServer returns the api server
 func (c *CWSConsumer) APIServer() *APIServer {
 	return c.apiServer
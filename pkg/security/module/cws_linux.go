@@ -9,6 +9,7 @@ import (
 	"github.com/DataDog/datadog-agent/pkg/eventmonitor"
 	"github.com/DataDog/datadog-agent/pkg/security/config"
 	"github.com/DataDog/datadog-agent/pkg/security/probe"
+	"github.com/DataDog/datadog-agent/pkg/security/rconfig"
 )
 
 // UpdateEventMonitorOpts adapt the event monitor options
@@ -27,11 +28,20 @@ func DisableRuntimeSecurity(config *config.Config) {
 }
 
 // platform specific init function
-func (c *CWSConsumer) init(evm *eventmonitor.EventMonitor, _ *config.RuntimeSecurityConfig, _ Opts) error {
+func (c *CWSConsumer) init(evm *eventmonitor.EventMonitor, cfg *config.RuntimeSecurityConfig, _ Opts) error {
 	// Activity dumps related
 	if p, ok := evm.Probe.PlatformProbe.(*probe.EBPFProbe); ok {
 		p.AddActivityDumpHandler(c)
 	}
 
+	// anomaly detection suppression windows pushed through remote config
+	if cfg.RemoteConfigurationEnabled && cfg.SecurityProfileEnabled {
+		provider, err := rconfig.NewRCSuppressionWindowsProvider(c.setRemoteSuppressionWindows)
+		if err != nil {
+			return err
+		}
+		c.suppressionWindowsProvider = provider
+	}
+
 	return nil
 }
@@ -8,6 +8,7 @@
 package module
 
 import (
+	"github.com/DataDog/datadog-agent/cmd/system-probe/api/module"
 	"github.com/DataDog/datadog-agent/pkg/eventmonitor"
 	"github.com/DataDog/datadog-agent/pkg/security/config"
 )
@@ -22,3 +23,6 @@ func DisableRuntimeSecurity(_ *config.Config) {}
 func (c *CWSConsumer) init(_ *eventmonitor.EventMonitor, _ *config.RuntimeSecurityConfig, _ Opts) error {
 	return nil
 }
+
+// registerPlatformHTTPHandlers registers the platform specific endpoints of the CWS consumer
+func (c *CWSConsumer) registerPlatformHTTPHandlers(_ *module.Router) {}
@@ -0,0 +1,39 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package module holds module related files
+package module
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/DataDog/datadog-agent/cmd/system-probe/api/module"
+	"github.com/DataDog/datadog-agent/pkg/security/seclog"
+)
+
+// EffectiveRulesRoute is the route, relative to the event monitoring module, of the endpoint returning the rules
+// loaded once the policies of all the sources were merged
+const EffectiveRulesRoute = "/policies/effective_rules"
+
+// RegisterHTTPHandlers registers the endpoints of the CWS consumer
+func (c *CWSConsumer) RegisterHTTPHandlers(httpMux *module.Router) {
+	httpMux.HandleFunc(EffectiveRulesRoute, c.handleEffectiveRules).Methods(http.MethodGet)
+	c.registerPlatformHTTPHandlers(httpMux)
+}
+
+// handleEffectiveRules returns the rules of the current rule set, with the policies they come from and the policies
+// that overrode them
+func (c *CWSConsumer) handleEffectiveRules(w http.ResponseWriter, _ *http.Request) {
+	if c.ruleEngine == nil {
+		http.Error(w, "no rule engine", http.StatusServiceUnavailable)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(c.ruleEngine.GetEffectiveRules()); err != nil {
+		seclog.Errorf("unable to encode the effective rules: %v", err)
+	}
+}
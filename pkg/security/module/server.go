@@ -32,6 +32,7 @@ import (
 	"github.com/DataDog/datadog-agent/pkg/security/probe/kfilters"
 	"github.com/DataDog/datadog-agent/pkg/security/probe/selftests"
 	"github.com/DataDog/datadog-agent/pkg/security/proto/api"
+	"github.com/DataDog/datadog-agent/pkg/security/rules/bundled"
 	"github.com/DataDog/datadog-agent/pkg/security/rules/monitor"
 	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
 	"github.com/DataDog/datadog-agent/pkg/security/secl/rules"
@@ -69,7 +70,9 @@ func (p *pendingMsg) isResolved() bool {
 	return true
 }
 
-func (p *pendingMsg) toJSON() ([]byte, error) {
+// toJSON serializes the message. When the serialized message exceeds maxSize, the fields listed in
+// serializers.TrimmedFields are stripped, in that order, until it fits. onTrim is called for every stripped field.
+func (p *pendingMsg) toJSON(maxSize int, onTrim func(field serializers.TrimmedField)) ([]byte, error) {
 	p.backendEvent.RuleActions = []json.RawMessage{}
 
 	for _, report := range p.actionReports {
@@ -97,6 +100,21 @@ func (p *pendingMsg) toJSON() ([]byte, error) {
 		return nil, err
 	}
 
+	for _, field := range serializers.TrimmedFields {
+		if maxSize <= 0 || len(backendEventJSON)+len(eventJSON) <= maxSize {
+			break
+		}
+
+		if !p.eventSerializer.Trim(field) {
+			continue
+		}
+		onTrim(field)
+
+		if eventJSON, err = p.eventSerializer.ToJSON(); err != nil {
+			return nil, err
+		}
+	}
+
 	return mergeJSON(backendEventJSON, eventJSON), nil
 }
 
@@ -114,6 +132,7 @@ type APIServer struct {
 	expiredEventsLock  sync.RWMutex
 	expiredEvents      map[rules.RuleID]*atomic.Int64
 	expiredDumps       *atomic.Int64
+	trimmedFields      map[serializers.TrimmedField]*atomic.Int64
 	statsdClient       statsd.ClientInterface
 	probe              *sprobe.Probe
 	queueLock          sync.Mutex
@@ -263,7 +282,12 @@ func (a *APIServer) start(ctx context.Context) {
 					return false
 				}
 
-				data, err := msg.toJSON()
+				// the images allowed to access the GPU devices are only known once the container tags are resolved
+				if msg.ruleID == bundled.GPUDeviceAccessRuleID && bundled.IsGPUAccessAllowed(a.cfg.GPUAccessAllowedImages, msg.tags) {
+					return true
+				}
+
+				data, err := msg.toJSON(a.cfg.EventServerMaxSize, a.trimField)
 				if err != nil {
 					seclog.Errorf("failed to marshal event context: %v", err)
 					return true
@@ -358,10 +382,15 @@ func (a *APIServer) SendEvent(rule *rules.Rule, event events.Event, extTagsCb fu
 			}
 		}
 
+		eventSerializer := serializers.NewEventSerializer(ev, rule.Opts)
+		if a.cfg.EventServerCompactAncestors {
+			eventSerializer.CompactAncestors()
+		}
+
 		msg := &pendingMsg{
 			ruleID:          ruleID,
 			backendEvent:    backendEvent,
-			eventSerializer: serializers.NewEventSerializer(ev, rule.Opts),
+			eventSerializer: eventSerializer,
 			extTagsCb:       extTagsCb,
 			service:         service,
 			sendAfter:       time.Now().Add(retention),
@@ -429,6 +458,11 @@ func (a *APIServer) expireDump(dump *api.ActivityDumpStreamMessage) {
 	seclog.Tracef("the activity dump server channel is full, a dump of [%s] was dropped\n", dump.GetDump().GetMetadata().GetName())
 }
 
+// trimField updates the count of events from which the provided field was stripped
+func (a *APIServer) trimField(field serializers.TrimmedField) {
+	a.trimmedFields[field].Inc()
+}
+
 // GetStats returns a map indexed by ruleIDs that describes the amount of events
 // that were expired or rate limited before reaching
 func (a *APIServer) GetStats() map[string]int64 {
@@ -452,6 +486,15 @@ func (a *APIServer) SendStats() error {
 			}
 		}
 	}
+
+	for field, count := range a.trimmedFields {
+		if val := count.Swap(0); val > 0 {
+			tags := []string{"field:" + string(field)}
+			if err := a.statsdClient.Count(metrics.MetricEventServerTrimmed, val, tags, 1.0); err != nil {
+				return err
+			}
+		}
+	}
 	return nil
 }
 
@@ -495,6 +538,27 @@ func (a *APIServer) GetRuleSetReport(_ context.Context, _ *api.GetRuleSetReportP
 	}, nil
 }
 
+// ExportRuleSet exports the rules loaded, as merged from all the policies
+func (a *APIServer) ExportRuleSet(_ context.Context, _ *api.ExportRuleSetParams) (*api.ExportRuleSetMessage, error) {
+	if a.cwsConsumer == nil || a.cwsConsumer.ruleEngine == nil {
+		return nil, errors.New("no rule engine")
+	}
+
+	ruleSet := a.cwsConsumer.ruleEngine.GetRuleSet()
+	if ruleSet == nil {
+		return nil, fmt.Errorf("failed to get loaded rule set")
+	}
+
+	exported, err := api.FromRuleSetToProtoExportedRules(ruleSet)
+	if err != nil {
+		return &api.ExportRuleSetMessage{Error: err.Error()}, nil
+	}
+
+	return &api.ExportRuleSetMessage{
+		Rules: exported,
+	}, nil
+}
+
 // ApplyRuleIDs the rule ids
 func (a *APIServer) ApplyRuleIDs(ruleIDs []rules.RuleID) {
 	a.expiredEventsLock.Lock()
@@ -530,6 +594,22 @@ func (a *APIServer) ApplyPolicyStates(policies []*monitor.PolicyState) {
 	}
 }
 
+// getRuleLoadErrors returns the errors of the rules that failed to load, excluding the rules that were filtered out
+func (a *APIServer) getRuleLoadErrors() []string {
+	a.policiesStatusLock.RLock()
+	defer a.policiesStatusLock.RUnlock()
+
+	var errs []string
+	for _, policy := range a.policiesStatus {
+		for _, rule := range policy.Status {
+			if rule.Status == string(rules.SyntaxErrType) || rule.Status == string(rules.UnknownErrType) {
+				errs = append(errs, fmt.Sprintf("%s/%s: %s", policy.Name, rule.ID, rule.Error))
+			}
+		}
+	}
+	return errs
+}
+
 // Stop stops the API server
 func (a *APIServer) Stop() {
 	a.stopper.Stop()
@@ -549,6 +629,7 @@ func NewAPIServer(cfg *config.RuntimeSecurityConfig, probe *sprobe.Probe, msgSen
 		activityDumps: make(chan *api.ActivityDumpStreamMessage, model.MaxTracedCgroupsCount*2),
 		expiredEvents: make(map[rules.RuleID]*atomic.Int64),
 		expiredDumps:  atomic.NewInt64(0),
+		trimmedFields: make(map[serializers.TrimmedField]*atomic.Int64, len(serializers.TrimmedFields)),
 		statsdClient:  client,
 		probe:         probe,
 		retention:     cfg.EventServerRetention,
@@ -559,6 +640,10 @@ func NewAPIServer(cfg *config.RuntimeSecurityConfig, probe *sprobe.Probe, msgSen
 		msgSender:     msgSender,
 	}
 
+	for _, field := range serializers.TrimmedFields {
+		as.trimmedFields[field] = atomic.NewInt64(0)
+	}
+
 	if as.msgSender == nil {
 		if pkgconfigsetup.SystemProbe().GetBool("runtime_security_config.direct_send_from_system_probe") {
 			msgSender, err := NewDirectMsgSender(stopper)
@@ -15,6 +15,8 @@ import (
 
 	"github.com/DataDog/datadog-agent/pkg/security/probe"
 	"github.com/DataDog/datadog-agent/pkg/security/proto/api"
+	"github.com/DataDog/datadog-agent/pkg/security/resolvers/process"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/containerutils"
 	"github.com/DataDog/datadog-agent/pkg/security/seclog"
 	"github.com/DataDog/datadog-agent/pkg/util/kernel"
 )
@@ -38,15 +40,17 @@ func (a *APIServer) DumpProcessCache(_ context.Context, params *api.DumpProcessC
 	}
 
 	var (
-		filename string
-		err      error
+		filename    string
+		err         error
+		containerID = containerutils.ContainerID(params.GetContainerID())
 	)
 
 	switch params.Format {
-	case "json":
-		jsonContent, err := p.Resolvers.ProcessResolver.ToJSON(true)
-		if err != nil {
-			return nil, err
+	case "json", "json_v1":
+		schemaVersion := process.CacheDumpSchemaVersion
+		if params.Format == "json_v1" {
+			// the format of the dumps produced before the schema was versioned, for the tools that only read it
+			schemaVersion = process.LegacyCacheDumpSchemaVersion
 		}
 
 		dump, err := os.CreateTemp("/tmp", "process-cache-dump-*.json")
@@ -61,12 +65,16 @@ func (a *APIServer) DumpProcessCache(_ context.Context, params *api.DumpProcessC
 			return nil, err
 		}
 
-		if _, err := dump.Write(jsonContent); err != nil {
+		if err := p.Resolvers.ProcessResolver.WriteJSON(dump, containerID, true, schemaVersion); err != nil {
 			return nil, err
 		}
 
+		if err := dump.Close(); err != nil {
+			return nil, fmt.Errorf("could not close file [%s]: %w", dump.Name(), err)
+		}
+
 	case "dot", "":
-		filename, err = p.Resolvers.ProcessResolver.ToDot(params.WithArgs)
+		filename, err = p.Resolvers.ProcessResolver.ToDot(containerID, params.WithArgs)
 		if err != nil {
 			return nil, err
 		}
@@ -77,6 +85,16 @@ func (a *APIServer) DumpProcessCache(_ context.Context, params *api.DumpProcessC
 	}, nil
 }
 
+// QueryProcessCache handles process cache query requests
+func (a *APIServer) QueryProcessCache(_ context.Context, params *api.ProcessCacheQueryParams) (*api.ProcessCacheQueryMessage, error) {
+	p, ok := a.probe.PlatformProbe.(*probe.EBPFProbe)
+	if !ok {
+		return nil, fmt.Errorf("not supported")
+	}
+
+	return p.Resolvers.ProcessResolver.QueryCache(params), nil
+}
+
 // DumpActivity handles an activity dump request
 func (a *APIServer) DumpActivity(_ context.Context, params *api.ActivityDumpParams) (*api.ActivityDumpMessage, error) {
 	p, ok := a.probe.PlatformProbe.(*probe.EBPFProbe)
@@ -215,9 +233,10 @@ func (a *APIServer) GetStatus(_ context.Context, _ *api.GetStatusParams) (*api.S
 				Fetchers: status.Fetchers,
 				Values:   constants,
 			},
-			KernelLockdown:  string(kernel.GetLockdownMode()),
-			UseMmapableMaps: p.GetKernelVersion().HaveMmapableMaps(),
-			UseRingBuffer:   p.UseRingBuffers(),
+			KernelLockdown:     string(kernel.GetLockdownMode()),
+			UseMmapableMaps:    p.GetKernelVersion().HaveMmapableMaps(),
+			UseRingBuffer:      p.UseRingBuffers(),
+			DisabledEventTypes: a.cfg.GetDisabledEventTypes(),
 		}
 
 		envErrors := p.VerifyEnvironment()
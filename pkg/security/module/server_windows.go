@@ -22,6 +22,11 @@ func (a *APIServer) DumpProcessCache(_ context.Context, _ *api.DumpProcessCacheP
 	return nil, errors.New("not supported")
 }
 
+// QueryProcessCache handles process cache query requests
+func (a *APIServer) QueryProcessCache(_ context.Context, _ *api.ProcessCacheQueryParams) (*api.ProcessCacheQueryMessage, error) {
+	return nil, errors.New("not supported")
+}
+
 // ListActivityDumps returns the list of active dumps
 func (a *APIServer) ListActivityDumps(_ context.Context, _ *api.ActivityDumpListParams) (*api.ActivityDumpListMessage, error) {
 	return nil, errors.New("not supported")
@@ -0,0 +1,124 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package module holds module related files
+package module
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/DataDog/datadog-agent/cmd/system-probe/api/module"
+	"github.com/DataDog/datadog-agent/pkg/security/probe"
+	"github.com/DataDog/datadog-agent/pkg/security/rconfig"
+	cgroupModel "github.com/DataDog/datadog-agent/pkg/security/resolvers/cgroup/model"
+	"github.com/DataDog/datadog-agent/pkg/security/seclog"
+	"github.com/DataDog/datadog-agent/pkg/security/security_profile/profile"
+)
+
+// SuppressionWindowsRoute is the route, relative to the event monitoring module, of the anomaly detection suppression
+// windows endpoint
+const SuppressionWindowsRoute = "/security_profile/suppression_windows"
+
+// registerPlatformHTTPHandlers registers the linux specific endpoints of the CWS consumer
+func (c *CWSConsumer) registerPlatformHTTPHandlers(httpMux *module.Router) {
+	httpMux.HandleFunc(SuppressionWindowsRoute, c.handleSuppressionWindows)
+}
+
+func (c *CWSConsumer) getSecurityProfileManager() *profile.SecurityProfileManager {
+	p, ok := c.probe.PlatformProbe.(*probe.EBPFProbe)
+	if !ok {
+		return nil
+	}
+
+	if managers := p.GetProfileManagers(); managers != nil {
+		return managers.GetSecurityProfileManager()
+	}
+	return nil
+}
+
+// setRemoteSuppressionWindows applies the suppression windows received from remote config
+func (c *CWSConsumer) setRemoteSuppressionWindows(windows []rconfig.SuppressionWindow) {
+	manager := c.getSecurityProfileManager()
+	if manager == nil {
+		seclog.Warnf("ignoring %d remote suppression windows: %s", len(windows), probe.ErrSecurityProfileManagerDisabled)
+		return
+	}
+
+	suppressionWindows := make([]profile.SuppressionWindow, 0, len(windows))
+	for _, window := range windows {
+		tag := window.ImageTag
+		if tag == "" {
+			tag = "*"
+		}
+		selector, err := cgroupModel.NewWorkloadSelector(window.ImageName, tag)
+		if err != nil {
+			seclog.Errorf("invalid remote suppression window: %s", err)
+			continue
+		}
+		suppressionWindows = append(suppressionWindows, profile.SuppressionWindow{Selector: selector, Until: window.Until})
+	}
+
+	manager.SetRemoteSuppressionWindows(suppressionWindows)
+	seclog.Infof("%d remote suppression windows applied", len(suppressionWindows))
+}
+
+// handleSuppressionWindows lists (GET), opens (POST) or closes (DELETE) the windows during which the anomaly
+// detections of a workload are paused. The workload is selected with the `image_name` and `image_tag` query
+// parameters, `image_tag` defaulting to all the tags of the image. The duration of the window opened by a POST
+// request is set with the `duration` query parameter.
+func (c *CWSConsumer) handleSuppressionWindows(w http.ResponseWriter, r *http.Request) {
+	manager := c.getSecurityProfileManager()
+	if manager == nil {
+		http.Error(w, probe.ErrSecurityProfileManagerDisabled.Error(), http.StatusServiceUnavailable)
+		return
+	}
+
+	if r.Method != http.MethodGet {
+		tag := r.URL.Query().Get("image_tag")
+		if tag == "" {
+			tag = "*"
+		}
+		selector, err := cgroupModel.NewWorkloadSelector(r.URL.Query().Get("image_name"), tag)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodPost:
+			var duration time.Duration
+			if value := r.URL.Query().Get("duration"); value != "" {
+				if duration, err = time.ParseDuration(value); err != nil {
+					http.Error(w, fmt.Sprintf("invalid duration: %s", err), http.StatusBadRequest)
+					return
+				}
+			}
+
+			window, err := manager.SuppressAnomalyDetections(selector, duration)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			seclog.Infof("anomaly detections of %s suppressed until %s", window.Selector, window.Until.Format(time.RFC3339))
+		case http.MethodDelete:
+			if !manager.ResumeAnomalyDetections(selector) {
+				http.Error(w, fmt.Sprintf("no suppression window for %s", selector), http.StatusNotFound)
+				return
+			}
+			seclog.Infof("anomaly detections of %s resumed", selector)
+		default:
+			http.Error(w, fmt.Sprintf("unsupported method %s", r.Method), http.StatusMethodNotAllowed)
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(manager.ListSuppressionWindows()); err != nil {
+		seclog.Errorf("unable to encode the suppression windows: %v", err)
+	}
+}
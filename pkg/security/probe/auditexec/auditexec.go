@@ -0,0 +1,295 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+// Package auditexec holds auditexec related files
+package auditexec
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/DataDog/datadog-go/v5/statsd"
+	"go.uber.org/atomic"
+	"golang.org/x/sys/unix"
+
+	"github.com/DataDog/datadog-agent/pkg/security/metrics"
+	"github.com/DataDog/datadog-agent/pkg/security/seclog"
+)
+
+const (
+	// auditNlgrpReadlog is the netlink multicast group of the audit records
+	auditNlgrpReadlog = 1
+	// auditEOE is the type of the record ending a multi-record event
+	auditEOE = 1320
+
+	netlinkHeaderSize = 16
+	readTimeout       = time.Second
+	maxPendingEvents  = 1024
+)
+
+type source int
+
+const (
+	ebpfSource source = iota
+	auditSource
+)
+
+type execRecord struct {
+	source source
+	path   string
+	seenAt time.Time
+}
+
+// Reconciler consumes the exec records of the kernel audit subsystem and reconciles them with the execs collected with
+// eBPF, reporting the execs seen by only one of the sources
+type Reconciler struct {
+	ctx    context.Context
+	fd     int
+	window time.Duration
+
+	// audit records of the events not ended yet, by serial
+	pending map[uint64]*auditEvent
+
+	lock  sync.Mutex
+	execs map[uint32][]*execRecord
+	// the audit rules usually don't cover all the execs, and the audit records can be lost. An exec seen only with
+	// eBPF is reported as a divergence only when audit reported a later exec and no record was lost since then.
+	lastAuditExec time.Time
+	lastLoss      time.Time
+
+	matched      *atomic.Uint64
+	ebpfOnly     *atomic.Uint64
+	auditOnly    *atomic.Uint64
+	pathMismatch *atomic.Uint64
+	lost         *atomic.Uint64
+}
+
+// New returns a new reconciler, bound to the audit multicast group
+func New(ctx context.Context, window time.Duration) (*Reconciler, error) {
+	if window <= 0 {
+		return nil, fmt.Errorf("invalid reconciliation window: %s", window)
+	}
+
+	fd, err := unix.Socket(unix.AF_NETLINK, unix.SOCK_RAW|unix.SOCK_CLOEXEC, unix.NETLINK_AUDIT)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create audit netlink socket: %w", err)
+	}
+
+	if err := unix.Bind(fd, &unix.SockaddrNetlink{Family: unix.AF_NETLINK, Groups: auditNlgrpReadlog}); err != nil {
+		unix.Close(fd)
+		return nil, fmt.Errorf("failed to join the audit multicast group: %w", err)
+	}
+
+	timeout := unix.NsecToTimeval(readTimeout.Nanoseconds())
+	if err := unix.SetsockoptTimeval(fd, unix.SOL_SOCKET, unix.SO_RCVTIMEO, &timeout); err != nil {
+		unix.Close(fd)
+		return nil, fmt.Errorf("failed to set the audit socket timeout: %w", err)
+	}
+
+	return newReconciler(ctx, fd, window), nil
+}
+
+func newReconciler(ctx context.Context, fd int, window time.Duration) *Reconciler {
+	return &Reconciler{
+		ctx:          ctx,
+		fd:           fd,
+		window:       window,
+		pending:      make(map[uint64]*auditEvent),
+		execs:        make(map[uint32][]*execRecord),
+		matched:      atomic.NewUint64(0),
+		ebpfOnly:     atomic.NewUint64(0),
+		auditOnly:    atomic.NewUint64(0),
+		pathMismatch: atomic.NewUint64(0),
+		lost:         atomic.NewUint64(0),
+	}
+}
+
+// Start reads the audit records and expires the unmatched execs until the context is done
+func (r *Reconciler) Start(wg *sync.WaitGroup) {
+	defer wg.Done()
+	defer unix.Close(r.fd)
+
+	wg.Add(1)
+	go r.expireLoop(wg)
+
+	buf := make([]byte, unix.Getpagesize()*2)
+	for {
+		if r.ctx.Err() != nil {
+			return
+		}
+
+		n, _, err := unix.Recvfrom(r.fd, buf, 0)
+		if err != nil {
+			switch {
+			case errors.Is(err, unix.EAGAIN), errors.Is(err, unix.EINTR):
+			case errors.Is(err, unix.ENOBUFS):
+				r.markLost(1, time.Now())
+			default:
+				seclog.Errorf("failed to read audit records: %s", err)
+				return
+			}
+			continue
+		}
+
+		if n < netlinkHeaderSize {
+			continue
+		}
+		// the audit records are multicasted one per message
+		msgType := binary.NativeEndian.Uint16(buf[4:6])
+		r.handleRecord(msgType, buf[netlinkHeaderSize:n])
+	}
+}
+
+func (r *Reconciler) expireLoop(wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	ticker := time.NewTicker(r.window)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.ctx.Done():
+			return
+		case now := <-ticker.C:
+			r.expire(now)
+		}
+	}
+}
+
+func (r *Reconciler) handleRecord(msgType uint16, data []byte) {
+	serial, fields, ok := parseRecord(data)
+	if !ok {
+		return
+	}
+
+	switch msgType {
+	case unix.AUDIT_SYSCALL:
+		if len(r.pending) >= maxPendingEvents {
+			// the end of the events was lost, start over
+			r.markLost(len(r.pending), time.Now())
+			clear(r.pending)
+		}
+		r.pending[serial] = newAuditEvent(fields)
+	case unix.AUDIT_EXECVE:
+		if event := r.pending[serial]; event != nil {
+			event.exec = true
+		}
+	case auditEOE:
+		if event := r.pending[serial]; event != nil {
+			delete(r.pending, serial)
+			if event.exec && event.success {
+				r.observe(auditSource, event.pid, event.exe, time.Now())
+			}
+		}
+	}
+}
+
+func (r *Reconciler) markLost(count int, now time.Time) {
+	r.lost.Add(uint64(count))
+
+	r.lock.Lock()
+	r.lastLoss = now
+	r.lock.Unlock()
+}
+
+// ObserveExec reports an exec collected with eBPF
+func (r *Reconciler) ObserveExec(pid uint32, path string) {
+	r.observe(ebpfSource, pid, path, time.Now())
+}
+
+func (r *Reconciler) observe(src source, pid uint32, path string, now time.Time) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if src == auditSource {
+		r.lastAuditExec = now
+	}
+
+	records := r.execs[pid]
+	for i, record := range records {
+		if record.source == src {
+			continue
+		}
+
+		r.matched.Inc()
+		if path != "" && record.path != "" && path != record.path {
+			r.pathMismatch.Inc()
+		}
+
+		if len(records) == 1 {
+			delete(r.execs, pid)
+		} else {
+			r.execs[pid] = append(records[:i], records[i+1:]...)
+		}
+		return
+	}
+
+	r.execs[pid] = append(records, &execRecord{source: src, path: path, seenAt: now})
+}
+
+// expire reports the execs that weren't seen by the other source during the window
+func (r *Reconciler) expire(now time.Time) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	for pid, records := range r.execs {
+		kept := records[:0]
+		for _, record := range records {
+			if now.Sub(record.seenAt) < r.window {
+				kept = append(kept, record)
+				continue
+			}
+
+			if record.source == ebpfSource {
+				if r.lastAuditExec.After(record.seenAt) && !r.lastLoss.After(record.seenAt) {
+					r.ebpfOnly.Inc()
+				}
+			} else {
+				r.auditOnly.Inc()
+			}
+		}
+
+		if len(kept) == 0 {
+			delete(r.execs, pid)
+		} else {
+			r.execs[pid] = kept
+		}
+	}
+}
+
+// SendStats sends the reconciliation metrics
+func (r *Reconciler) SendStats(statsdClient statsd.ClientInterface) error {
+	for status, counter := range map[string]*atomic.Uint64{
+		"matched":    r.matched,
+		"ebpf_only":  r.ebpfOnly,
+		"audit_only": r.auditOnly,
+	} {
+		if count := counter.Swap(0); count > 0 {
+			if err := statsdClient.Count(metrics.MetricProcessResolverAuditExecs, int64(count), []string{"status:" + status}, 1.0); err != nil {
+				return fmt.Errorf("failed to send audit execs metric: %w", err)
+			}
+		}
+	}
+
+	if count := r.pathMismatch.Swap(0); count > 0 {
+		if err := statsdClient.Count(metrics.MetricProcessResolverAuditPathMismatch, int64(count), []string{}, 1.0); err != nil {
+			return fmt.Errorf("failed to send audit path mismatch metric: %w", err)
+		}
+	}
+
+	if count := r.lost.Swap(0); count > 0 {
+		if err := statsdClient.Count(metrics.MetricProcessResolverAuditLost, int64(count), []string{}, 1.0); err != nil {
+			return fmt.Errorf("failed to send audit lost metric: %w", err)
+		}
+	}
+
+	return nil
+}
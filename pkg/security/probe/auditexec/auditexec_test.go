@@ -0,0 +1,99 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+// Package auditexec holds auditexec related files
+package auditexec
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sys/unix"
+)
+
+func TestParseRecord(t *testing.T) {
+	serial, fields, ok := parseRecord([]byte(`audit(1700000000.123:4567): arch=c000003e syscall=59 success=yes exit=0 ppid=1 pid=42 comm="ls" exe="/usr/bin/ls" key=(null)` + "\x00"))
+	require.True(t, ok)
+	assert.Equal(t, uint64(4567), serial)
+	assert.Equal(t, "42", fields["pid"])
+	assert.Equal(t, "/usr/bin/ls", fields["exe"])
+	assert.Equal(t, "ls", fields["comm"])
+	assert.Equal(t, "(null)", fields["key"])
+
+	// paths with spaces are hex encoded
+	_, fields, ok = parseRecord([]byte(`audit(1700000000.123:4568): pid=43 exe=2F746D702F6D7920617070`))
+	require.True(t, ok)
+	assert.Equal(t, "/tmp/my app", fields["exe"])
+
+	_, _, ok = parseRecord([]byte(`type=SYSCALL msg=audit(1700000000.123:4567): pid=42`))
+	assert.False(t, ok)
+}
+
+func TestReconciler(t *testing.T) {
+	r := newReconciler(context.Background(), -1, 10*time.Second)
+	now := time.Now()
+
+	// exec seen by both sources, through the audit records of the event
+	r.handleRecord(unix.AUDIT_SYSCALL, []byte(`audit(1700000000.123:1): syscall=59 success=yes pid=42 exe="/usr/bin/ls"`))
+	r.handleRecord(unix.AUDIT_EXECVE, []byte(`audit(1700000000.123:1): argc=1 a0="ls"`))
+	r.handleRecord(auditEOE, []byte(`audit(1700000000.123:1): `))
+	r.ObserveExec(42, "/usr/bin/ls")
+
+	// failed exec and other syscall, ignored
+	r.handleRecord(unix.AUDIT_SYSCALL, []byte(`audit(1700000000.123:2): syscall=59 success=no pid=43 exe="/usr/bin/bash"`))
+	r.handleRecord(unix.AUDIT_EXECVE, []byte(`audit(1700000000.123:2): argc=1 a0="nope"`))
+	r.handleRecord(auditEOE, []byte(`audit(1700000000.123:2): `))
+	r.handleRecord(unix.AUDIT_SYSCALL, []byte(`audit(1700000000.123:3): syscall=2 success=yes pid=43 exe="/usr/bin/bash"`))
+	r.handleRecord(auditEOE, []byte(`audit(1700000000.123:3): `))
+	assert.Empty(t, r.pending)
+
+	// seen by a single source, or with a different path
+	r.observe(ebpfSource, 45, "/usr/bin/wget", now)
+	r.observe(ebpfSource, 46, "/tmp/script.sh", now)
+	r.observe(auditSource, 46, "/usr/bin/bash", now)
+	r.observe(auditSource, 44, "/usr/bin/curl", now.Add(time.Millisecond))
+
+	r.expire(now.Add(time.Second))
+	assert.Len(t, r.execs, 2)
+
+	r.expire(now.Add(11 * time.Second))
+	assert.Empty(t, r.execs)
+
+	assert.Equal(t, uint64(2), r.matched.Load())
+	assert.Equal(t, uint64(1), r.pathMismatch.Load())
+	assert.Equal(t, uint64(1), r.ebpfOnly.Load())
+	assert.Equal(t, uint64(1), r.auditOnly.Load())
+}
+
+func TestReconcilerEBPFOnly(t *testing.T) {
+	r := newReconciler(context.Background(), -1, 10*time.Second)
+	now := time.Now()
+
+	// audit didn't report any later exec, the exec isn't covered by the audit rules
+	r.observe(ebpfSource, 42, "/usr/bin/ls", now)
+	r.expire(now.Add(11 * time.Second))
+	assert.Empty(t, r.execs)
+	assert.Equal(t, uint64(0), r.ebpfOnly.Load())
+
+	// audit records were lost after the exec
+	r.observe(ebpfSource, 43, "/usr/bin/ls", now)
+	r.markLost(1, now.Add(time.Millisecond))
+	r.observe(auditSource, 44, "/usr/bin/curl", now.Add(2*time.Millisecond))
+	r.expire(now.Add(11 * time.Second))
+	assert.Equal(t, uint64(0), r.ebpfOnly.Load())
+	assert.Equal(t, uint64(1), r.lost.Load())
+	assert.Equal(t, uint64(1), r.auditOnly.Load())
+
+	// audit reported a later exec without any loss
+	r.observe(ebpfSource, 45, "/usr/bin/ls", now.Add(time.Second))
+	r.observe(auditSource, 46, "/usr/bin/curl", now.Add(2*time.Second))
+	r.expire(now.Add(20 * time.Second))
+	assert.Equal(t, uint64(1), r.ebpfOnly.Load())
+}
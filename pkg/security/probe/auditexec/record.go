@@ -0,0 +1,80 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+// Package auditexec holds auditexec related files
+package auditexec
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strconv"
+	"strings"
+)
+
+// auditEvent holds the fields of the SYSCALL record of an audit event, until the end of the event
+type auditEvent struct {
+	pid     uint32
+	exe     string
+	success bool
+	// exec is set when the event has an EXECVE record
+	exec bool
+}
+
+func newAuditEvent(fields map[string]string) *auditEvent {
+	event := &auditEvent{
+		exe:     fields["exe"],
+		success: fields["success"] == "yes",
+	}
+	if pid, err := strconv.ParseUint(fields["pid"], 10, 32); err == nil {
+		event.pid = uint32(pid)
+	}
+	return event
+}
+
+// parseRecord parses an audit record like `audit(1700000000.123:4567): pid=42 exe="/usr/bin/ls"`, returning the serial
+// of the event of the record and its fields. The unquoted values of the exe field are hex encoded by the kernel.
+func parseRecord(data []byte) (uint64, map[string]string, bool) {
+	data = bytes.TrimRight(data, "\x00\n")
+
+	const prefix = "audit("
+	if !bytes.HasPrefix(data, []byte(prefix)) {
+		return 0, nil, false
+	}
+	end := bytes.Index(data, []byte("):"))
+	if end < 0 {
+		return 0, nil, false
+	}
+
+	stamp := string(data[len(prefix):end])
+	sep := strings.IndexByte(stamp, ':')
+	if sep < 0 {
+		return 0, nil, false
+	}
+	serial, err := strconv.ParseUint(stamp[sep+1:], 10, 64)
+	if err != nil {
+		return 0, nil, false
+	}
+
+	fields := make(map[string]string)
+	for _, field := range strings.Fields(string(data[end+2:])) {
+		key, value, found := strings.Cut(field, "=")
+		if !found {
+			continue
+		}
+
+		if unquoted, err := strconv.Unquote(value); err == nil {
+			value = unquoted
+		} else if key == "exe" {
+			if decoded, err := hex.DecodeString(value); err == nil {
+				value = string(decoded)
+			}
+		}
+		fields[key] = value
+	}
+
+	return serial, fields, true
+}
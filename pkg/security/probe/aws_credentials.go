@@ -0,0 +1,111 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+// Package probe holds probe related files
+package probe
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/golang-lru/v2/simplelru"
+
+	"github.com/DataDog/datadog-agent/pkg/security/secl/containerutils"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
+)
+
+const awsCredentialsTrackerSize = 1024
+
+// awsAccessKeyIDPattern matches the AWS access key IDs, long term (AKIA) or temporary (ASIA) ones
+var awsAccessKeyIDPattern = regexp.MustCompile(`\b(?:AKIA|ASIA)[A-Z0-9]{16}\b`)
+
+// awsCredentialsOwner is the workload that first received an access key from the IMDS
+type awsCredentialsOwner struct {
+	accessKeyID string
+	containerID containerutils.ContainerID
+	pid         uint32
+	path        string
+	firstSeen   time.Time
+	expiration  time.Time
+
+	// workloads already reported as using the access key
+	reported map[containerutils.ContainerID]bool
+}
+
+// awsCredentialsTracker records the workloads receiving AWS credentials from the IMDS, and reports the uses of these
+// credentials from other workloads, which indicates that the credentials were stolen from the workload owning them
+type awsCredentialsTracker struct {
+	owners *simplelru.LRU[string, *awsCredentialsOwner]
+}
+
+func newAWSCredentialsTracker() (*awsCredentialsTracker, error) {
+	owners, err := simplelru.NewLRU[string, *awsCredentialsOwner](awsCredentialsTrackerSize, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &awsCredentialsTracker{owners: owners}, nil
+}
+
+// observeIMDS records the workload of an IMDS answer holding credentials, unless the credentials are already owned
+func (t *awsCredentialsTracker) observeIMDS(event *model.Event) {
+	creds := &event.IMDS.AWS.SecurityCredentials
+	if event.IMDS.Type != model.IMDSResponseType || creds.AccessKeyID == "" {
+		return
+	}
+
+	eventTime := event.ResolveEventTime()
+	if owner, found := t.owners.Get(creds.AccessKeyID); found && (owner.expiration.IsZero() || owner.expiration.After(eventTime)) {
+		return
+	}
+
+	owner := &awsCredentialsOwner{
+		accessKeyID: creds.AccessKeyID,
+		containerID: event.ContainerContext.ContainerID,
+		firstSeen:   eventTime,
+		expiration:  creds.Expiration,
+	}
+	if event.ProcessCacheEntry != nil {
+		owner.pid = event.ProcessCacheEntry.Pid
+		owner.path = event.ProcessCacheEntry.FileEvent.PathnameStr
+	}
+	t.owners.Add(creds.AccessKeyID, owner)
+}
+
+// checkUsage looks for the known access keys in the provided values, the arguments and the environment variables of
+// an exec for instance. It returns the owner of the first key used from a workload other than its owner, unless this
+// use was already reported.
+func (t *awsCredentialsTracker) checkUsage(event *model.Event, values ...[]string) *awsCredentialsOwner {
+	if t.owners.Len() == 0 {
+		return nil
+	}
+
+	containerID := event.ContainerContext.ContainerID
+	eventTime := event.ResolveEventTime()
+
+	for _, list := range values {
+		for _, value := range list {
+			for _, accessKeyID := range awsAccessKeyIDPattern.FindAllString(value, -1) {
+				owner, found := t.owners.Get(accessKeyID)
+				if !found || owner.containerID == containerID || owner.reported[containerID] {
+					continue
+				}
+				if !owner.expiration.IsZero() && owner.expiration.Before(eventTime) {
+					continue
+				}
+
+				if owner.reported == nil {
+					owner.reported = make(map[containerutils.ContainerID]bool)
+				}
+				owner.reported[containerID] = true
+
+				return owner
+			}
+		}
+	}
+
+	return nil
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/DataDog/datadog-agent/pkg/config/env"
 	pkgconfigsetup "github.com/DataDog/datadog-agent/pkg/config/setup"
 	"github.com/DataDog/datadog-agent/pkg/ebpf"
+	"github.com/DataDog/datadog-agent/pkg/process/procutil"
 	"github.com/DataDog/datadog-agent/pkg/util/filesystem"
 	"github.com/DataDog/datadog-agent/pkg/util/log"
 )
@@ -58,6 +59,11 @@ type Config struct {
 	// This is used during reload to avoid removing all the discarders at the same time.
 	FlushDiscarderWindow int
 
+	// DiscardedInodesCacheSize defines the number of recently discarded inodes whose events still in flight are
+	// dropped in user space, 0 to disable. The cache starts over once full, the inodes being only kept for a few
+	// seconds.
+	DiscardedInodesCacheSize int
+
 	// SocketPath is the path to the socket that is used to communicate with the security agent and process agent
 	SocketPath string
 
@@ -73,6 +79,9 @@ type Config struct {
 	// CustomSensitiveWords defines words to add to the scrubber
 	CustomSensitiveWords []string
 
+	// Scrubbing defines the scrubbing policy shared with the process checks
+	Scrubbing procutil.ScrubbingConfig
+
 	// ERPCDentryResolutionEnabled determines if the ERPC dentry resolution is enabled
 	ERPCDentryResolutionEnabled bool
 
@@ -82,10 +91,103 @@ type Config struct {
 	// DentryCacheSize is the size of the user space dentry cache
 	DentryCacheSize int
 
+	// PathCacheSize is the number of resolved paths cached in user space, failed resolutions included
+	PathCacheSize int
+
+	// PathCacheNegativeTTL is the period during which a failed path resolution is cached
+	PathCacheNegativeTTL time.Duration
+
 	// NOTE(safchain) need to revisit this one as it can impact multiple event consumers
 	// EnvsWithValue lists environnement variables that will be fully exported
 	EnvsWithValue []string
 
+	// ProcessResolverEntryCache defines the data structure storing the entries of the process resolver, either `map`
+	// or `pid_table`
+	ProcessResolverEntryCache string
+
+	// ProcessResolverEntryCacheShards defines the number of lock stripes the entries of the process resolver are
+	// sharded into by pid, the cache hits only locking the stripe of their pid
+	ProcessResolverEntryCacheShards int
+
+	// ProcessResolverMaxEntries defines the maximum number of entries of the process resolver, the entries of the exited
+	// processes then of the least recently used processes without children being evicted beyond it. 0 doesn't bound
+	// the cache.
+	ProcessResolverMaxEntries int
+
+	// ProcessResolverMemoryBudget defines the estimated memory, in bytes, the entries of the process resolver and the
+	// pending args and envs can use, the entries of the exited processes then of the least recently used processes
+	// without children being shed beyond it. 0 doesn't bound the memory.
+	ProcessResolverMemoryBudget int64
+
+	// ProcessResolverSweepInterval defines the interval between two sweeps of the process cache, removing the entries
+	// of the processes whose exit event was lost
+	ProcessResolverSweepInterval time.Duration
+
+	// ProcessResolverReconcileInterval defines the interval between two reconciliations of the kernel maps and the
+	// user space cache of the process resolver, repairing their divergences
+	ProcessResolverReconcileInterval time.Duration
+
+	// ProcessResolverEnvsRefreshInterval defines the interval between two reads of the environment of the long-lived
+	// processes, to report the environments modified after their execution. 0 disables it.
+	ProcessResolverEnvsRefreshInterval time.Duration
+
+	// ProcessResolverExitedRetention defines how long the entries of the processes that are no longer running are kept
+	// after their execution
+	ProcessResolverExitedRetention time.Duration
+
+	// ProcessResolverRefExitedRetention defines how long the entries of the processes that are no longer running are
+	// kept after the last event referencing them, 0 disables it
+	ProcessResolverRefExitedRetention time.Duration
+
+	// ProcessResolverSnapshotWorkers defines the number of workers reading /proc concurrently during the snapshot of
+	// the processes at startup
+	ProcessResolverSnapshotWorkers int
+
+	// ProcessResolverProcfsWorkers defines the number of workers resolving the processes from /proc outside of the
+	// event handling path, 0 resolves them inline
+	ProcessResolverProcfsWorkers int
+
+	// ProcessResolverProcfsQueueSize defines the number of procfs resolutions that can be queued for the workers
+	ProcessResolverProcfsQueueSize int
+
+	// ProcessResolverArgsEnvsCacheSize defines the number of args and envs of starting processes kept until their
+	// exec event is received
+	ProcessResolverArgsEnvsCacheSize int
+
+	// ProcessResolverArgsEnvsValueCacheSize defines the number of distinct args and envs values kept to deduplicate
+	// the args and envs of the processes
+	ProcessResolverArgsEnvsValueCacheSize int
+
+	// ProcessResolverArgsProcfsFallback defines if the args lost because of the args and envs cache size should be
+	// read from /proc
+	ProcessResolverArgsProcfsFallback bool
+
+	// ProcessResolverLineageRepair defines if the broken lineages should be repaired by reading the missing ancestors
+	// from /proc
+	ProcessResolverLineageRepair bool
+
+	// ProcessResolverShebangDetection defines if the `#!` line of the scripts run by the processes resolved from /proc
+	// should be read to detect their interpreter
+	ProcessResolverShebangDetection bool
+
+	// ProcessResolverAuditEnabled defines if the execs reported by the kernel audit subsystem should be reconciled
+	// with the execs collected with eBPF
+	ProcessResolverAuditEnabled bool
+
+	// ProcessResolverAuditWindow defines how long an exec reported by only one of the audit subsystem and eBPF waits
+	// for the other source before being reported as a divergence
+	ProcessResolverAuditWindow time.Duration
+
+	// ExecSamplingEnabled defines if only 1 in ExecSamplingRate identical exec events of the same binary should be
+	// emitted, per container and per ExecSamplingWindow
+	ExecSamplingEnabled bool
+
+	// ExecSamplingRate defines the sampling rate of the identical exec events
+	ExecSamplingRate int
+
+	// ExecSamplingWindow defines the window during which the identical exec events are sampled
+	ExecSamplingWindow time.Duration
+
 	// RuntimeMonitor defines if the Go runtime and system monitor should be enabled
 	RuntimeMonitor bool
 
@@ -95,6 +197,21 @@ type Config struct {
 	// EventStreamBufferSize specifies the buffer size of the eBPF map used for events
 	EventStreamBufferSize int
 
+	// EventStreamExpectedEventRate specifies the number of events per second the event stream is sized for, when its
+	// buffer size isn't set. The default rate matches the static default size of the ring buffer, 0 disables the sizing
+	// from the event rate, the static default size being used.
+	EventStreamExpectedEventRate int
+
+	// EventStreamPriorityLanes specifies whether the process lineage and credentials events should be handled before
+	// the other events when the event pipeline is saturated
+	EventStreamPriorityLanes bool
+
+	// EventStreamPriorityLanesQueueSize specifies the number of events each priority lane can queue
+	EventStreamPriorityLanesQueueSize int
+
+	// EventStreamDropPolicy lists the event types dropped first, in order, when the low priority lane fills up
+	EventStreamDropPolicy []string
+
 	// EventStreamUseFentry specifies whether to use eBPF fentry when available instead of kprobes
 	EventStreamUseFentry bool
 
@@ -155,35 +272,67 @@ func NewConfig() (*Config, error) {
 
 	setEnv()
 
+	scrubbing := procutil.NewScrubbingConfig(pkgconfigsetup.Datadog())
+
 	c := &Config{
-		Config:                       *ebpf.NewConfig(),
-		EnableAllProbes:              getBool("enable_all_probes"),
-		EnableKernelFilters:          getBool("enable_kernel_filters"),
-		EnableApprovers:              getBool("enable_approvers"),
-		EnableDiscarders:             getBool("enable_discarders"),
-		FlushDiscarderWindow:         getInt("flush_discarder_window"),
-		PIDCacheSize:                 getInt("pid_cache_size"),
-		StatsTagsCardinality:         getString("events_stats.tags_cardinality"),
-		CustomSensitiveWords:         getStringSlice("custom_sensitive_words"),
-		ERPCDentryResolutionEnabled:  getBool("erpc_dentry_resolution_enabled"),
-		MapDentryResolutionEnabled:   getBool("map_dentry_resolution_enabled"),
-		DentryCacheSize:              getInt("dentry_cache_size"),
-		RuntimeMonitor:               getBool("runtime_monitor.enabled"),
-		NetworkLazyInterfacePrefixes: getStringSlice("network.lazy_interface_prefixes"),
-		NetworkClassifierPriority:    uint16(getInt("network.classifier_priority")),
-		NetworkClassifierHandle:      uint16(getInt("network.classifier_handle")),
-		RawNetworkClassifierHandle:   uint16(getInt("network.raw_classifier_handle")),
-		EventStreamUseRingBuffer:     getBool("event_stream.use_ring_buffer"),
-		EventStreamBufferSize:        getInt("event_stream.buffer_size"),
-		EventStreamUseFentry:         getEventStreamFentryValue(),
-		EnvsWithValue:                getStringSlice("envs_with_value"),
-		NetworkEnabled:               getBool("network.enabled"),
-		NetworkIngressEnabled:        getBool("network.ingress.enabled"),
-		NetworkRawPacketEnabled:      getBool("network.raw_packet.enabled"),
-		NetworkPrivateIPRanges:       getStringSlice("network.private_ip_ranges"),
-		NetworkExtraPrivateIPRanges:  getStringSlice("network.extra_private_ip_ranges"),
-		StatsPollingInterval:         time.Duration(getInt("events_stats.polling_interval")) * time.Second,
-		SyscallsMonitorEnabled:       getBool("syscalls_monitor.enabled"),
+		Config:                                *ebpf.NewConfig(),
+		EnableAllProbes:                       getBool("enable_all_probes"),
+		EnableKernelFilters:                   getBool("enable_kernel_filters"),
+		EnableApprovers:                       getBool("enable_approvers"),
+		EnableDiscarders:                      getBool("enable_discarders"),
+		FlushDiscarderWindow:                  getInt("flush_discarder_window"),
+		DiscardedInodesCacheSize:              getInt("discarded_inodes_cache_size"),
+		PIDCacheSize:                          getInt("pid_cache_size"),
+		StatsTagsCardinality:                  getString("events_stats.tags_cardinality"),
+		CustomSensitiveWords:                  getStringSlice("custom_sensitive_words"),
+		Scrubbing:                             scrubbing,
+		ERPCDentryResolutionEnabled:           getBool("erpc_dentry_resolution_enabled"),
+		MapDentryResolutionEnabled:            getBool("map_dentry_resolution_enabled"),
+		DentryCacheSize:                       getInt("dentry_cache_size"),
+		PathCacheSize:                         getInt("path_cache.size"),
+		PathCacheNegativeTTL:                  time.Duration(getInt("path_cache.negative_ttl")) * time.Second,
+		RuntimeMonitor:                        getBool("runtime_monitor.enabled"),
+		NetworkLazyInterfacePrefixes:          getStringSlice("network.lazy_interface_prefixes"),
+		NetworkClassifierPriority:             uint16(getInt("network.classifier_priority")),
+		NetworkClassifierHandle:               uint16(getInt("network.classifier_handle")),
+		RawNetworkClassifierHandle:            uint16(getInt("network.raw_classifier_handle")),
+		EventStreamUseRingBuffer:              getBool("event_stream.use_ring_buffer"),
+		EventStreamBufferSize:                 getInt("event_stream.buffer_size"),
+		EventStreamExpectedEventRate:          getInt("event_stream.expected_event_rate"),
+		EventStreamPriorityLanes:              getBool("event_stream.priority_lanes.enabled"),
+		EventStreamPriorityLanesQueueSize:     getInt("event_stream.priority_lanes.queue_size"),
+		EventStreamDropPolicy:                 getStringSlice("event_stream.priority_lanes.drop_policy"),
+		EventStreamUseFentry:                  getEventStreamFentryValue(),
+		EnvsWithValue:                         scrubbing.FilterEnvsWithValue(getStringSlice("envs_with_value")),
+		ProcessResolverEntryCache:             getString("process_resolver.entry_cache"),
+		ProcessResolverEntryCacheShards:       getInt("process_resolver.entry_cache_shards"),
+		ProcessResolverMaxEntries:             getInt("process_resolver.max_entries"),
+		ProcessResolverMemoryBudget:           int64(getInt("process_resolver.memory_budget")),
+		ProcessResolverSweepInterval:          time.Duration(getInt("process_resolver.sweep_interval")) * time.Second,
+		ProcessResolverReconcileInterval:      time.Duration(getInt("process_resolver.reconciliation_interval")) * time.Second,
+		ProcessResolverEnvsRefreshInterval:    time.Duration(getInt("process_resolver.envs_refresh_interval")) * time.Second,
+		ProcessResolverSnapshotWorkers:        getInt("process_resolver.snapshot_workers"),
+		ProcessResolverExitedRetention:        time.Duration(getInt("process_resolver.exited_retention.period")) * time.Second,
+		ProcessResolverRefExitedRetention:     time.Duration(getInt("process_resolver.exited_retention.referenced_period")) * time.Second,
+		ProcessResolverProcfsWorkers:          getInt("process_resolver.procfs_workers"),
+		ProcessResolverProcfsQueueSize:        getInt("process_resolver.procfs_queue_size"),
+		ProcessResolverArgsEnvsCacheSize:      getInt("process_resolver.args_envs_cache_size"),
+		ProcessResolverArgsEnvsValueCacheSize: getInt("process_resolver.args_envs_value_cache_size"),
+		ProcessResolverArgsProcfsFallback:     getBool("process_resolver.args_procfs_fallback"),
+		ProcessResolverShebangDetection:       getBool("process_resolver.shebang_detection"),
+		ProcessResolverLineageRepair:          getBool("process_resolver.lineage_repair"),
+		ProcessResolverAuditEnabled:           getBool("process_resolver.audit_reconciliation.enabled"),
+		ProcessResolverAuditWindow:            time.Duration(getInt("process_resolver.audit_reconciliation.window")) * time.Second,
+		ExecSamplingEnabled:                   getBool("process_resolver.exec_sampling.enabled"),
+		ExecSamplingRate:                      getInt("process_resolver.exec_sampling.rate"),
+		ExecSamplingWindow:                    time.Duration(getInt("process_resolver.exec_sampling.window")) * time.Second,
+		NetworkEnabled:                        getBool("network.enabled"),
+		NetworkIngressEnabled:                 getBool("network.ingress.enabled"),
+		NetworkRawPacketEnabled:               getBool("network.raw_packet.enabled"),
+		NetworkPrivateIPRanges:                getStringSlice("network.private_ip_ranges"),
+		NetworkExtraPrivateIPRanges:           getStringSlice("network.extra_private_ip_ranges"),
+		StatsPollingInterval:                  time.Duration(getInt("events_stats.polling_interval")) * time.Second,
+		SyscallsMonitorEnabled:                getBool("syscalls_monitor.enabled"),
 
 		// event server
 		SocketPath:       pkgconfigsetup.SystemProbe().GetString(join(evNS, "socket")),
@@ -233,6 +382,26 @@ func (c *Config) sanitize() error {
 		return fmt.Errorf("runtime_security_config.event_stream.buffer_size must be a power of 2 and a multiple of %d", os.Getpagesize())
 	}
 
+	if c.EventStreamPriorityLanes && c.EventStreamPriorityLanesQueueSize <= 0 {
+		return fmt.Errorf("invalid value for event_monitoring_config.event_stream.priority_lanes.queue_size: %d, expected a positive value", c.EventStreamPriorityLanesQueueSize)
+	}
+
+	if !c.EventStreamPriorityLanes && len(c.EventStreamDropPolicy) > 0 {
+		log.Warnf("event_monitoring_config.event_stream.priority_lanes.drop_policy is ignored: the priority lanes are disabled")
+	}
+
+	if c.ProcessResolverEntryCache != "map" && c.ProcessResolverEntryCache != "pid_table" {
+		return fmt.Errorf("invalid value for event_monitoring_config.process_resolver.entry_cache: %s, expected map or pid_table", c.ProcessResolverEntryCache)
+	}
+
+	if c.ProcessResolverEntryCacheShards <= 0 {
+		return fmt.Errorf("invalid value for event_monitoring_config.process_resolver.entry_cache_shards: %d, expected a positive value", c.ProcessResolverEntryCacheShards)
+	}
+
+	if c.ProcessResolverProcfsWorkers > 0 && c.ProcessResolverProcfsQueueSize <= 0 {
+		return fmt.Errorf("invalid value for event_monitoring_config.process_resolver.procfs_queue_size: %d, expected a positive value", c.ProcessResolverProcfsQueueSize)
+	}
+
 	if !isSet("enable_approvers") && c.EnableKernelFilters {
 		c.EnableApprovers = true
 	}
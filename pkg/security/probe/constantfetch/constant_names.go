@@ -25,6 +25,7 @@ const (
 	OffsetNameLinuxBinprmArgc           = "linux_binprm_argc_offset"
 	OffsetNameLinuxBinprmEnvc           = "linux_binprm_envc_offset"
 	OffsetNameVMAreaStructFlags         = "vm_area_struct_flags_offset"
+	OffsetNameVMAreaStructFile          = "vm_area_struct_file_offset"
 	OffsetNameKernelCloneArgsExitSignal = "kernel_clone_args_exit_signal_offset"
 	OffsetNameFileFinode                = "file_f_inode_offset"
 	OffsetNameFileFpath                 = "file_f_path_offset"
@@ -63,6 +64,22 @@ const (
 	OffsetNameTaskStructPIDLink = "task_struct_pid_link_offset" // kernels < 4.19
 	OffsetNamePIDLinkStructPID  = "pid_link_pid_offset"         // kernels < 4.19
 
+	// process identity offsets
+	OffsetNameTaskStructStartBoottime = "task_struct_start_boottime_offset"
+
+	// process namespaces offsets
+	OffsetNameTaskStructNsproxy     = "task_struct_nsproxy_offset"
+	OffsetNameTaskStructCred        = "task_struct_cred_offset"
+	OffsetNameCredStructUserNS      = "cred_user_ns_offset"
+	OffsetNameNsproxyStructUTSNS    = "nsproxy_uts_ns_offset"
+	OffsetNameNsproxyStructMntNS    = "nsproxy_mnt_ns_offset"
+	OffsetNameUPIDStructNS          = "upid_ns_offset"
+	OffsetNamePIDNamespaceStructNS  = "pid_namespace_ns_offset"
+	OffsetNameUserNamespaceStructNS = "user_namespace_ns_offset"
+	OffsetNameMntNamespaceStructNS  = "mnt_namespace_ns_offset"
+	OffsetNameUTSNamespaceStructNS  = "uts_namespace_ns_offset"
+	OffsetNameNSCommonStructInum    = "ns_common_inum_offset"
+
 	// splice event
 	OffsetNamePipeInodeInfoStructBufs     = "pipe_inode_info_bufs_offset"
 	OffsetNamePipeInodeInfoStructNrbufs   = "pipe_inode_info_nrbufs_offset"    // kernels < 5.5
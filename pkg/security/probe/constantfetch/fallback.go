@@ -133,6 +133,8 @@ func (f *FallbackConstantFetcher) appendRequest(id string) {
 		value = getLinuxBinPrmEnvcOffset(f.kernelVersion)
 	case OffsetNameVMAreaStructFlags:
 		value = getVMAreaStructFlagsOffset(f.kernelVersion)
+	case OffsetNameVMAreaStructFile:
+		value = getVMAreaStructFileOffset(f.kernelVersion)
 	case OffsetNameKernelCloneArgsExitSignal:
 		value = getKernelCloneArgsExitSignalOffset(f.kernelVersion)
 	case OffsetNameFileFinode:
@@ -968,6 +970,17 @@ func getVMAreaStructFlagsOffset(kv *kernel.Version) uint64 {
 	return 80
 }
 
+func getVMAreaStructFileOffset(kv *kernel.Version) uint64 {
+	switch {
+	// CONFIG_PER_VMA_LOCK, enabled by default on x86_64 and arm64, adds the per-VMA lock fields before `shared`
+	case kv.Code >= kernel.Kernel6_4:
+		return 136
+	case kv.Code >= kernel.Kernel6_1:
+		return 112
+	}
+	return 160
+}
+
 func getTaskStructPIDOffset(_ *kernel.Version) uint64 {
 	// do not use fallback for offsets inside task_struct
 	return ErrorSentinel
@@ -0,0 +1,20 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package probe holds probe related files
+package probe
+
+import (
+	"errors"
+)
+
+var (
+	// ErrEventTypeDisabled is returned when an event type is disabled by the configuration of the probe
+	ErrEventTypeDisabled = errors.New("event type disabled by the probe configuration")
+	// ErrMissingProbes is returned when none of the probes of an event type could be attached
+	ErrMissingProbes = errors.New("none of the probes of the event type are attached")
+	// ErrUnsupportedKernel is returned when the hook points of an event type don't exist on the running kernel
+	ErrUnsupportedKernel = errors.New("the hook points of the event type don't exist on this kernel")
+)
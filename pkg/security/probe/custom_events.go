@@ -11,9 +11,12 @@
 package probe
 
 import (
+	"time"
+
 	"github.com/DataDog/datadog-agent/pkg/process/procutil"
 	"github.com/DataDog/datadog-agent/pkg/security/events"
 	"github.com/DataDog/datadog-agent/pkg/security/proto/ebpfless"
+	"github.com/DataDog/datadog-agent/pkg/security/resolvers/process"
 	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
 	"github.com/DataDog/datadog-agent/pkg/security/secl/rules"
 	"github.com/DataDog/datadog-agent/pkg/security/serializers"
@@ -90,3 +93,130 @@ func NewEBPFLessHelloMsgEvent(acc *events.AgentContainerContext, msg *ebpfless.H
 
 	return events.NewCustomRule(events.EBPFLessHelloMessageRuleID, events.EBPFLessHelloMessageRuleDesc), events.NewCustomEvent(model.CustomEventType, evt)
 }
+
+// AWSCredentialsOwnerSerializer serializes the workload that received AWS credentials from the IMDS
+// easyjson:json
+type AWSCredentialsOwnerSerializer struct {
+	ContainerID string    `json:"container_id,omitempty"`
+	PID         uint32    `json:"pid,omitempty"`
+	Path        string    `json:"path,omitempty"`
+	FirstSeen   time.Time `json:"first_seen"`
+}
+
+// AWSCredentialsCrossContainerEvent is used to report that AWS credentials were used by a workload other than the one
+// that received them from the IMDS
+// easyjson:json
+type AWSCredentialsCrossContainerEvent struct {
+	events.CustomEventCommonFields
+	Event       *serializers.EventSerializer  `json:"triggering_event"`
+	AccessKeyID string                        `json:"access_key_id"`
+	Owner       AWSCredentialsOwnerSerializer `json:"credentials_owner"`
+}
+
+// ToJSON marshal using json format
+func (a AWSCredentialsCrossContainerEvent) ToJSON() ([]byte, error) {
+	return utils.MarshalEasyJSON(a)
+}
+
+// NewAWSCredentialsCrossContainerEvent returns the rule and a populated custom event for a use of AWS credentials
+// outside of the workload that received them
+func NewAWSCredentialsCrossContainerEvent(acc *events.AgentContainerContext, event *model.Event, owner *awsCredentialsOwner) (*rules.Rule, *events.CustomEvent) {
+	marshalerCtor := func() events.EventMarshaler {
+		evt := AWSCredentialsCrossContainerEvent{
+			Event:       serializers.NewEventSerializer(event, nil),
+			AccessKeyID: owner.accessKeyID,
+			Owner: AWSCredentialsOwnerSerializer{
+				ContainerID: string(owner.containerID),
+				PID:         owner.pid,
+				Path:        owner.path,
+				FirstSeen:   owner.firstSeen,
+			},
+		}
+		evt.FillCustomEventCommonFields(acc)
+		// Overwrite common timestamp with event timestamp
+		evt.Timestamp = event.ResolveEventTime()
+
+		return evt
+	}
+
+	return events.NewCustomRule(events.AWSCredentialsCrossContainerRuleID, events.AWSCredentialsCrossContainerRuleDesc), events.NewCustomEventLazy(model.CustomEventType, marshalerCtor)
+}
+
+// EnvVarsChangedProcessSerializer serializes the process whose environment was modified
+// easyjson:json
+type EnvVarsChangedProcessSerializer struct {
+	Pid         uint32 `json:"pid"`
+	PPid        uint32 `json:"ppid,omitempty"`
+	ContainerID string `json:"container_id,omitempty"`
+	Path        string `json:"path,omitempty"`
+	Comm        string `json:"comm,omitempty"`
+}
+
+// EnvVarsChangedEvent is used to report that the environment of a running process was modified after its execution.
+// Only the names of the variables are reported, unless their value is exported.
+// easyjson:json
+type EnvVarsChangedEvent struct {
+	events.CustomEventCommonFields
+	Process  EnvVarsChangedProcessSerializer `json:"process"`
+	Added    []string                        `json:"added,omitempty"`
+	Removed  []string                        `json:"removed,omitempty"`
+	Modified []string                        `json:"modified,omitempty"`
+}
+
+// ToJSON marshal using json format
+func (e EnvVarsChangedEvent) ToJSON() ([]byte, error) {
+	return utils.MarshalEasyJSON(e)
+}
+
+// NewEnvVarsChangedEvent returns the rule and a populated custom event for a modification of the environment of a
+// running process
+func NewEnvVarsChangedEvent(acc *events.AgentContainerContext, update process.ProcessTreeUpdate) (*rules.Rule, *events.CustomEvent) {
+	evt := EnvVarsChangedEvent{
+		Process: EnvVarsChangedProcessSerializer{
+			Pid:         update.Pid,
+			PPid:        update.PPid,
+			ContainerID: string(update.ContainerID),
+			Path:        update.Pathname,
+			Comm:        update.Comm,
+		},
+	}
+	if update.Envs != nil {
+		evt.Added = update.Envs.Added
+		evt.Removed = update.Envs.Removed
+		evt.Modified = update.Envs.Modified
+	}
+	evt.FillCustomEventCommonFields(acc)
+	evt.Timestamp = update.Time
+
+	return events.NewCustomRule(events.EnvVarsChangedRuleID, events.EnvVarsChangedRuleDesc), events.NewCustomEvent(model.CustomEventType, evt)
+}
+
+// ProcessCacheMemoryBudgetEvent is used to report that entries of the process cache were shed during the last stats
+// interval because the cache exceeded its memory budget
+// easyjson:json
+type ProcessCacheMemoryBudgetEvent struct {
+	events.CustomEventCommonFields
+	Budget     int64 `json:"budget"`
+	Usage      int64 `json:"usage"`
+	ShedExited int64 `json:"shed_exited"`
+	ShedLeaves int64 `json:"shed_leaves"`
+}
+
+// ToJSON marshal using json format
+func (e ProcessCacheMemoryBudgetEvent) ToJSON() ([]byte, error) {
+	return utils.MarshalEasyJSON(e)
+}
+
+// NewProcessCacheMemoryBudgetEvent returns the rule and a populated custom event for the entries of the process cache
+// shed because of its memory budget
+func NewProcessCacheMemoryBudgetEvent(acc *events.AgentContainerContext, report process.MemoryBudgetExceeded) (*rules.Rule, *events.CustomEvent) {
+	evt := ProcessCacheMemoryBudgetEvent{
+		Budget:     report.Budget,
+		Usage:      report.Usage,
+		ShedExited: report.ShedExited,
+		ShedLeaves: report.ShedLeaves,
+	}
+	evt.FillCustomEventCommonFields(acc)
+
+	return events.NewCustomRule(events.ProcessCacheMemoryBudgetRuleID, events.ProcessCacheMemoryBudgetRuleDesc), events.NewCustomEvent(model.CustomEventType, evt)
+}
@@ -0,0 +1,159 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+// Package probe holds probe related files
+package probe
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/DataDog/datadog-go/v5/statsd"
+	"go.uber.org/atomic"
+
+	"github.com/DataDog/datadog-agent/pkg/security/metrics"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
+)
+
+// the events of discarded inodes still in flight are received within this delay
+const discardedInodesTimeout = uint64(5 * time.Second)
+
+type discardedInodeKey struct {
+	mountID   uint32
+	inode     uint64
+	eventType model.EventType
+}
+
+// discardedInodes keeps track of the inodes recently discarded, so that the events of these inodes still in flight
+// when the discarders were pushed are dropped before any path resolution
+type discardedInodes struct {
+	sync.Mutex
+	entries map[discardedInodeKey]uint64
+	size    int
+
+	// the file of the events of each event type having inode discarders
+	getters map[model.EventType]inodeEventGetter
+
+	lookups *atomic.Uint64
+	skipped *atomic.Uint64
+}
+
+func newDiscardedInodes(size int) *discardedInodes {
+	return &discardedInodes{
+		entries: make(map[discardedInodeKey]uint64, size),
+		size:    size,
+		getters: inodeEventGetters,
+		lookups: atomic.NewUint64(0),
+		skipped: atomic.NewUint64(0),
+	}
+}
+
+func (d *discardedInodes) eventKey(event *model.Event) (discardedInodeKey, bool) {
+	getter, exists := d.getters[event.GetEventType()]
+	if !exists {
+		return discardedInodeKey{}, false
+	}
+	_, fileEvent, _ := getter(event)
+	if fileEvent.PathKey.Inode == 0 {
+		return discardedInodeKey{}, false
+	}
+
+	return discardedInodeKey{
+		mountID:   fileEvent.PathKey.MountID,
+		inode:     fileEvent.PathKey.Inode,
+		eventType: event.GetEventType(),
+	}, true
+}
+
+// add records the inode of the event, discarded for the event type of the event
+func (d *discardedInodes) add(event *model.Event) {
+	key, ok := d.eventKey(event)
+	if !ok {
+		return
+	}
+
+	d.Lock()
+	defer d.Unlock()
+
+	// start a new generation once full, the entries older than the timeout being useless anyway
+	if len(d.entries) >= d.size {
+		d.reset()
+	}
+	d.entries[key] = event.TimestampRaw
+}
+
+// isDiscarded returns whether the inode of the event was recently discarded for the event type of the event
+func (d *discardedInodes) isDiscarded(event *model.Event) bool {
+	key, ok := d.eventKey(event)
+	if !ok {
+		return false
+	}
+
+	d.lookups.Inc()
+
+	d.Lock()
+	defer d.Unlock()
+
+	discardedAt, exists := d.entries[key]
+	if !exists {
+		return false
+	}
+
+	if event.TimestampRaw < discardedAt || event.TimestampRaw-discardedAt > discardedInodesTimeout {
+		return false
+	}
+
+	d.skipped.Inc()
+	return true
+}
+
+// invalidate forgets the discarded inode of the path key, for all the event types, or all the discarded inodes of its
+// mount for a directory, whose discarders are invalidated in kernel when their path changes
+func (d *discardedInodes) invalidate(pathKey model.PathKey, isDir bool) {
+	if pathKey.Inode == 0 {
+		return
+	}
+
+	d.Lock()
+	defer d.Unlock()
+
+	for key := range d.entries {
+		if key.mountID == pathKey.MountID && (isDir || key.inode == pathKey.Inode) {
+			delete(d.entries, key)
+		}
+	}
+}
+
+func (d *discardedInodes) reset() {
+	clear(d.entries)
+}
+
+// flush forgets all the discarded inodes, when the discarders are invalidated
+func (d *discardedInodes) flush() {
+	d.Lock()
+	defer d.Unlock()
+
+	d.reset()
+}
+
+// SendStats sends the metrics of the recently discarded inodes
+func (d *discardedInodes) SendStats(statsdClient statsd.ClientInterface) error {
+	if count := d.lookups.Swap(0); count > 0 {
+		if err := statsdClient.Count(metrics.MetricDiscardedInodesLookups, int64(count), []string{}, 1.0); err != nil {
+			return fmt.Errorf("failed to send discarded inodes lookups metric: %w", err)
+		}
+	}
+
+	if count := d.skipped.Swap(0); count > 0 {
+		if err := statsdClient.Count(metrics.MetricDiscardedInodesSkipped, int64(count), []string{}, 1.0); err != nil {
+			return fmt.Errorf("failed to send discarded inodes skipped metric: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+// Package probe holds probe related files
+package probe
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
+)
+
+func TestDiscardedInodes(t *testing.T) {
+	newOpenEvent := func(mountID uint32, inode uint64, timestamp uint64) *model.Event {
+		event := model.NewFakeEvent()
+		event.Type = uint32(model.FileOpenEventType)
+		event.Open.File.PathKey = model.PathKey{MountID: mountID, Inode: inode}
+		event.TimestampRaw = timestamp
+		return event
+	}
+
+	d := newDiscardedInodes(16)
+	start := uint64(time.Second)
+
+	d.add(newOpenEvent(1, 42, start))
+
+	// events in flight when the discarder was pushed
+	assert.True(t, d.isDiscarded(newOpenEvent(1, 42, start+uint64(time.Millisecond))))
+	// events of other inodes or event types
+	assert.False(t, d.isDiscarded(newOpenEvent(2, 42, start)))
+	assert.False(t, d.isDiscarded(newOpenEvent(1, 43, start)))
+	chmod := model.NewFakeEvent()
+	chmod.Type = uint32(model.FileChmodEventType)
+	chmod.Chmod.File.PathKey = model.PathKey{MountID: 1, Inode: 42}
+	chmod.TimestampRaw = start
+	assert.False(t, d.isDiscarded(chmod))
+	// events too late, the kernel discarder is in place
+	assert.False(t, d.isDiscarded(newOpenEvent(1, 42, start+uint64(time.Minute))))
+	// event types without inode discarders
+	exec := model.NewFakeEvent()
+	exec.Type = uint32(model.ExecEventType)
+	assert.False(t, d.isDiscarded(exec))
+
+	assert.Equal(t, uint64(1), d.skipped.Load())
+	assert.Equal(t, uint64(5), d.lookups.Load())
+
+	// a full filter starts over
+	for i := uint64(0); i < 16; i++ {
+		d.add(newOpenEvent(3, 100+i, start))
+	}
+	assert.Len(t, d.entries, 1)
+	assert.False(t, d.isDiscarded(newOpenEvent(1, 42, start)))
+
+	// a path change only forgets the inode, or the inodes of the mount for a directory
+	d.add(newOpenEvent(3, 116, start))
+	d.add(newOpenEvent(4, 116, start))
+	d.invalidate(model.PathKey{MountID: 3, Inode: 116}, false)
+	assert.False(t, d.isDiscarded(newOpenEvent(3, 116, start)))
+	assert.True(t, d.isDiscarded(newOpenEvent(3, 115, start)))
+	assert.True(t, d.isDiscarded(newOpenEvent(4, 116, start)))
+	d.invalidate(model.PathKey{MountID: 3, Inode: 1}, true)
+	assert.False(t, d.isDiscarded(newOpenEvent(3, 115, start)))
+	assert.True(t, d.isDiscarded(newOpenEvent(4, 116, start)))
+
+	// the invalidation of the discarders forgets the discarded inodes
+	d.flush()
+	assert.False(t, d.isDiscarded(newOpenEvent(4, 116, start)))
+}
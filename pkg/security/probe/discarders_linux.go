@@ -426,7 +426,14 @@ func (id *inodeDiscarders) discardParentInode(req *erpc.Request, rs *rules.RuleS
 // function used to retrieve discarder information, *.file.path, FileEvent, file deleted
 type inodeEventGetter = func(event *model.Event) (eval.Field, *model.FileEvent, bool)
 
+// inodeEventGetters holds the getter of the file of the events of each event type having inode discarders
+var inodeEventGetters = make(map[model.EventType]inodeEventGetter)
+
 func filenameDiscarderWrapper(eventType model.EventType, getter inodeEventGetter) onDiscarderHandler {
+	if _, exists := inodeEventGetters[eventType]; !exists {
+		inodeEventGetters[eventType] = getter
+	}
+
 	return func(rs *rules.RuleSet, event *model.Event, probe *EBPFProbe, discarder Discarder) (bool, error) {
 		field, fileEvent, isDeleted := getter(event)
 
@@ -45,6 +45,8 @@ const (
 	GetRingbufUsage
 	// UserSessionContextOp is used to inject the Kubernetes User context
 	UserSessionContextOp
+	// ResolvePathsOp resolves the requested paths in a single request
+	ResolvePathsOp
 )
 
 // ERPC defines a krpc object
@@ -0,0 +1,305 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+// Package lanes holds priority lanes related files
+package lanes
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/DataDog/datadog-go/v5/statsd"
+	"go.uber.org/atomic"
+
+	"github.com/DataDog/datadog-agent/pkg/security/metrics"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
+	ddsync "github.com/DataDog/datadog-agent/pkg/util/sync"
+)
+
+// Lane defines a priority lane
+type Lane int
+
+const (
+	// HighPriorityLane handles the events keeping the process lineage and credentials up to date
+	HighPriorityLane Lane = iota
+	// LowPriorityLane handles all the other events
+	LowPriorityLane
+
+	laneCount
+)
+
+func (l Lane) String() string {
+	if l == HighPriorityLane {
+		return "high"
+	}
+	return "low"
+}
+
+// DefaultHighPriorityEventTypes lists the event types handled by the high priority lane by default
+var DefaultHighPriorityEventTypes = []model.EventType{
+	model.ExecEventType,
+	model.ForkEventType,
+	model.ExitEventType,
+	model.SetuidEventType,
+	model.SetgidEventType,
+	model.CapsetEventType,
+}
+
+type record struct {
+	cpu      int
+	pid      uint32
+	data     []byte
+	queuedAt time.Time
+}
+
+type laneStats struct {
+	events *atomic.Int64
+	maxLag *atomic.Int64
+}
+
+// Lanes queues the events of the event stream in two lanes, the high priority lane being always drained first. Events
+// of the high priority lane are never dropped, a full queue blocks the event stream until the handler catches up.
+// Events of the low priority lane are dropped when its queue is full, or earlier according to the drop policy.
+// The order of the events of a same pid is preserved across the lanes: a high priority event is handled only once
+// the low priority events queued before it for its pid were handled.
+type Lanes struct {
+	ctx          context.Context
+	handler      func(int, []byte)
+	highPriority [model.MaxKernelEventType]bool
+	queues       [laneCount]chan *record
+	recordPool   *ddsync.TypedPool[record]
+	stats        [laneCount]laneStats
+
+	// shedThresholds holds, for each event type, the length of the low priority queue from which the events of this
+	// type are dropped
+	shedThresholds [model.MaxKernelEventType]int
+	dropped        [model.MaxKernelEventType]*atomic.Int64
+
+	// pendingLock protects pending, the number of events queued in the low priority lane per pid
+	pendingLock sync.Mutex
+	pending     map[uint32]int
+}
+
+// New returns new priority lanes calling the provided handler. The event types of the drop policy are shed in order
+// as the low priority queue fills up: with n event types, the i-th one is dropped once the queue is (i+1)/(n+1) full.
+// The other event types are dropped only when the queue is full.
+func New(ctx context.Context, handler func(int, []byte), highPriorityEventTypes []model.EventType, dropPolicy []model.EventType, queueSize int) *Lanes {
+	l := &Lanes{
+		ctx:        ctx,
+		handler:    handler,
+		recordPool: ddsync.NewDefaultTypedPool[record](),
+		pending:    make(map[uint32]int),
+	}
+
+	for _, eventType := range highPriorityEventTypes {
+		if eventType < model.MaxKernelEventType {
+			l.highPriority[eventType] = true
+		}
+	}
+
+	for i := range l.queues {
+		l.queues[i] = make(chan *record, queueSize)
+		l.stats[i] = laneStats{
+			events: atomic.NewInt64(0),
+			maxLag: atomic.NewInt64(0),
+		}
+	}
+
+	for i := range l.shedThresholds {
+		l.shedThresholds[i] = queueSize
+		l.dropped[i] = atomic.NewInt64(0)
+	}
+	for i, eventType := range dropPolicy {
+		if eventType < model.MaxKernelEventType {
+			l.shedThresholds[eventType] = min(l.shedThresholds[eventType], queueSize*(i+1)/(len(dropPolicy)+1))
+		}
+	}
+
+	return l
+}
+
+// ParseEventTypes returns the kernel event types of the provided names
+func ParseEventTypes(names []string) ([]model.EventType, error) {
+	eventTypes := make([]model.EventType, 0, len(names))
+
+NAMES:
+	for _, name := range names {
+		for eventType := model.FirstEventType; eventType < model.MaxKernelEventType; eventType++ {
+			if eventType.String() == name {
+				eventTypes = append(eventTypes, eventType)
+				continue NAMES
+			}
+		}
+		return nil, fmt.Errorf("unknown event type: %s", name)
+	}
+
+	return eventTypes, nil
+}
+
+// eventTypeOf returns the type of the provided raw event
+func eventTypeOf(data []byte) model.EventType {
+	if len(data) < 12 {
+		return model.UnknownEventType
+	}
+
+	eventType := model.EventType(binary.NativeEndian.Uint32(data[8:12]))
+	if eventType >= model.MaxKernelEventType {
+		return model.UnknownEventType
+	}
+	return eventType
+}
+
+// pidOf returns the pid of the process context following the header of the provided raw event, 0 if the event is too
+// short to hold one. Events without process context yield an arbitrary pid, which only orders them more strictly.
+func pidOf(data []byte) uint32 {
+	if len(data) < 20 {
+		return 0
+	}
+	return binary.NativeEndian.Uint32(data[16:20])
+}
+
+func (l *Lanes) addPending(pid uint32, delta int) {
+	if pid == 0 {
+		return
+	}
+
+	l.pendingLock.Lock()
+	if count := l.pending[pid] + delta; count > 0 {
+		l.pending[pid] = count
+	} else {
+		delete(l.pending, pid)
+	}
+	l.pendingLock.Unlock()
+}
+
+func (l *Lanes) hasPending(pid uint32) bool {
+	if pid == 0 {
+		return false
+	}
+
+	l.pendingLock.Lock()
+	defer l.pendingLock.Unlock()
+	return l.pending[pid] > 0
+}
+
+// HandleEvent queues an event of the event stream in its lane. The data is copied, the caller can reuse it.
+func (l *Lanes) HandleEvent(cpu int, data []byte) {
+	eventType := eventTypeOf(data)
+
+	if !l.highPriority[eventType] && len(l.queues[LowPriorityLane]) >= l.shedThresholds[eventType] {
+		l.dropped[eventType].Inc()
+		return
+	}
+
+	record := l.recordPool.Get()
+	record.cpu = cpu
+	record.pid = pidOf(data)
+	record.data = append(record.data[:0], data...)
+	record.queuedAt = time.Now()
+
+	if l.highPriority[eventType] {
+		select {
+		case l.queues[HighPriorityLane] <- record:
+		case <-l.ctx.Done():
+			l.recordPool.Put(record)
+		}
+		return
+	}
+
+	// counted before being queued so that a high priority event dequeued right after never overtakes it
+	l.addPending(record.pid, 1)
+
+	select {
+	case l.queues[LowPriorityLane] <- record:
+	default:
+		l.addPending(record.pid, -1)
+		l.dropped[eventType].Inc()
+		l.recordPool.Put(record)
+	}
+}
+
+func (l *Lanes) handleRecord(lane Lane, record *record) {
+	if lane == LowPriorityLane {
+		l.addPending(record.pid, -1)
+	}
+
+	stats := l.stats[lane]
+	stats.events.Inc()
+	if lag := time.Since(record.queuedAt).Milliseconds(); lag > stats.maxLag.Load() {
+		stats.maxLag.Store(lag)
+	}
+
+	l.handler(record.cpu, record.data)
+	l.recordPool.Put(record)
+}
+
+// handleHighPriorityRecord handles a record of the high priority lane, after the low priority records queued before it
+// for the same pid. The low priority lane being a FIFO, it is drained up to the last of them.
+func (l *Lanes) handleHighPriorityRecord(record *record) {
+	low := l.queues[LowPriorityLane]
+	for l.hasPending(record.pid) {
+		// a pending record is either queued or about to be, the receive can't block for long
+		l.handleRecord(LowPriorityLane, <-low)
+	}
+	l.handleRecord(HighPriorityLane, record)
+}
+
+// Start dequeues the events until the context is done, the high priority lane first
+func (l *Lanes) Start(wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	high, low := l.queues[HighPriorityLane], l.queues[LowPriorityLane]
+	for {
+		select {
+		case record := <-high:
+			l.handleHighPriorityRecord(record)
+			continue
+		default:
+		}
+
+		select {
+		case record := <-high:
+			l.handleHighPriorityRecord(record)
+		case record := <-low:
+			l.handleRecord(LowPriorityLane, record)
+		case <-l.ctx.Done():
+			return
+		}
+	}
+}
+
+// SendStats sends the metrics of the lanes
+func (l *Lanes) SendStats(statsdClient statsd.ClientInterface) error {
+	for lane := HighPriorityLane; lane < laneCount; lane++ {
+		stats := l.stats[lane]
+		tags := []string{"lane:" + lane.String()}
+
+		if count := stats.events.Swap(0); count > 0 {
+			if err := statsdClient.Count(metrics.MetricEventStreamLaneEvents, count, tags, 1.0); err != nil {
+				return fmt.Errorf("failed to send %s lane events metric: %w", lane, err)
+			}
+		}
+
+		if err := statsdClient.Gauge(metrics.MetricEventStreamLaneLag, float64(stats.maxLag.Swap(0)), tags, 1.0); err != nil {
+			return fmt.Errorf("failed to send %s lane lag metric: %w", lane, err)
+		}
+	}
+
+	for eventType, dropped := range l.dropped {
+		if count := dropped.Swap(0); count > 0 {
+			tags := []string{"lane:" + LowPriorityLane.String(), "event_type:" + model.EventType(eventType).String()}
+			if err := statsdClient.Count(metrics.MetricEventStreamLaneDropped, count, tags, 1.0); err != nil {
+				return fmt.Errorf("failed to send lane dropped metric: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+// Package lanes holds priority lanes related files
+package lanes
+
+import (
+	"context"
+	"encoding/binary"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
+)
+
+func newRawEvent(eventType model.EventType, id byte) []byte {
+	data := make([]byte, 17)
+	binary.NativeEndian.PutUint32(data[8:12], uint32(eventType))
+	data[16] = id
+	return data
+}
+
+func TestLanes(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var handled []byte
+	done := make(chan struct{})
+	lanes := New(ctx, func(_ int, data []byte) {
+		handled = append(handled, data[16])
+		if len(handled) == 4 {
+			close(done)
+		}
+	}, DefaultHighPriorityEventTypes, nil, 2)
+
+	// the handler isn't started, the third low priority event is dropped
+	data := newRawEvent(model.FileOpenEventType, 1)
+	lanes.HandleEvent(0, data)
+	data[16] = 2
+	lanes.HandleEvent(0, data)
+	data[16] = 3
+	lanes.HandleEvent(0, data)
+	lanes.HandleEvent(0, newRawEvent(model.ExecEventType, 4))
+	lanes.HandleEvent(0, newRawEvent(model.ExitEventType, 5))
+
+	assert.Equal(t, int64(1), lanes.dropped[model.FileOpenEventType].Load())
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go lanes.Start(&wg)
+
+	<-done
+	cancel()
+	wg.Wait()
+
+	// the high priority events are handled first, the data was copied when queued
+	assert.Equal(t, []byte{4, 5, 1, 2}, handled)
+	assert.Equal(t, int64(2), lanes.stats[HighPriorityLane].events.Load())
+	assert.Equal(t, int64(2), lanes.stats[LowPriorityLane].events.Load())
+}
+
+func newRawProcessEvent(eventType model.EventType, pid uint32, id byte) []byte {
+	data := make([]byte, 21)
+	binary.NativeEndian.PutUint32(data[8:12], uint32(eventType))
+	binary.NativeEndian.PutUint32(data[16:20], pid)
+	data[20] = id
+	return data
+}
+
+func TestLanesPidOrder(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var handled []byte
+	done := make(chan struct{})
+	lanes := New(ctx, func(_ int, data []byte) {
+		handled = append(handled, data[20])
+		if len(handled) == 5 {
+			close(done)
+		}
+	}, DefaultHighPriorityEventTypes, nil, 4)
+
+	lanes.HandleEvent(0, newRawProcessEvent(model.FileOpenEventType, 100, 1))
+	lanes.HandleEvent(0, newRawProcessEvent(model.FileOpenEventType, 200, 2))
+	lanes.HandleEvent(0, newRawProcessEvent(model.FileOpenEventType, 300, 3))
+	lanes.HandleEvent(0, newRawProcessEvent(model.ExitEventType, 200, 4))
+	lanes.HandleEvent(0, newRawProcessEvent(model.ExecEventType, 400, 5))
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go lanes.Start(&wg)
+
+	<-done
+	cancel()
+	wg.Wait()
+
+	// the exit of pid 200 waits for its open event, and the ones queued before it, the exec of pid 400 doesn't wait
+	assert.Equal(t, []byte{1, 2, 4, 5, 3}, handled)
+	assert.Empty(t, lanes.pending)
+}
+
+func TestDropPolicy(t *testing.T) {
+	dropPolicy, err := ParseEventTypes([]string{"open", "unlink"})
+	assert.NoError(t, err)
+	assert.Equal(t, []model.EventType{model.FileOpenEventType, model.FileUnlinkEventType}, dropPolicy)
+
+	_, err = ParseEventTypes([]string{"unknown"})
+	assert.Error(t, err)
+
+	lanes := New(context.Background(), func(_ int, _ []byte) {}, DefaultHighPriorityEventTypes, dropPolicy, 9)
+
+	// open events are shed once the queue is a third full, unlink events once it is two thirds full
+	for i := 0; i < 12; i++ {
+		for _, eventType := range []model.EventType{model.FileOpenEventType, model.FileUnlinkEventType, model.ConnectEventType} {
+			lanes.HandleEvent(0, newRawEvent(eventType, 0))
+		}
+	}
+
+	counts := make(map[model.EventType]int)
+	for len(lanes.queues[LowPriorityLane]) > 0 {
+		counts[eventTypeOf((<-lanes.queues[LowPriorityLane]).data)]++
+	}
+	assert.Equal(t, map[model.EventType]int{
+		model.FileOpenEventType:   1,
+		model.FileUnlinkEventType: 3,
+		model.ConnectEventType:    5,
+	}, counts)
+	assert.Equal(t, int64(11), lanes.dropped[model.FileOpenEventType].Load())
+	assert.Equal(t, int64(9), lanes.dropped[model.FileUnlinkEventType].Load())
+	assert.Equal(t, int64(7), lanes.dropped[model.ConnectEventType].Load())
+}
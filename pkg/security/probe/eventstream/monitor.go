@@ -116,6 +116,9 @@ type Monitor struct {
 
 	// call that can be used to get notify when events are lost
 	onEventLost func(perfMapName string, perEvent map[string]uint64)
+
+	// bufferSizeAdvisors recommend a larger event stream after sustained loss, indexed by map name
+	bufferSizeAdvisors map[string]*bufferSizeAdvisor
 }
 
 type ringBufferStatMap struct {
@@ -146,7 +149,8 @@ func NewEventStreamMonitor(config *config.Config, eRPC *erpc.ERPC, manager *mana
 		sortingErrorStats: make(map[string][model.MaxKernelEventType]*atomic.Int64),
 		invalidEventStats: make(map[string][maxInvalidEventCause]*invalidEventStats),
 
-		onEventLost: onEventLost,
+		onEventLost:        onEventLost,
+		bufferSizeAdvisors: make(map[string]*bufferSizeAdvisor),
 	}
 	numCPU, err := utils.NumCPU()
 	if err != nil {
@@ -222,6 +226,7 @@ func NewEventStreamMonitor(config *config.Config, eRPC *erpc.ERPC, manager *mana
 		pbm.readLostEvents[mapName] = usrLostEvents
 		pbm.sortingErrorStats[mapName] = sortingErrorStats
 		pbm.invalidEventStats[mapName] = invalidEventStats
+		pbm.bufferSizeAdvisors[mapName] = newBufferSizeAdvisor(maps[mapName], useRingBuffers)
 	}
 	log.Debugf("monitoring perf ring buffer on %d CPU, %d events", pbm.numCPU, model.MaxKernelEventType)
 	return &pbm, nil
@@ -303,6 +308,21 @@ func (pbm *Monitor) GetKernelLostCount(perfMap string, cpu int, evtTypes ...mode
 	return total
 }
 
+// GetKernelEventStats returns the number of events written and lost by the kernel for a given map, across all the
+// cpus and event types. The counters are updated each time the statistics are sent.
+func (pbm *Monitor) GetKernelEventStats(perfMap string) (uint64, uint64) {
+	var count, lost uint64
+
+	for cpu := range pbm.kernelStats[perfMap] {
+		for evtType := range pbm.kernelStats[perfMap][cpu] {
+			count += pbm.getKernelEventCount(model.EventType(evtType), perfMap, cpu)
+			lost += pbm.getKernelLostCount(model.EventType(evtType), perfMap, cpu)
+		}
+	}
+
+	return count, lost
+}
+
 // getAndResetReadLostCount is an internal function, it can segfault if its parameters are incorrect.
 func (pbm *Monitor) getAndResetReadLostCount(perfMap string, cpu int) uint64 {
 	return pbm.readLostEvents[perfMap][cpu].Swap(0)
@@ -557,8 +577,8 @@ func (pbm *Monitor) collectAndSendKernelStats(client statsd.ClientInterface) err
 
 	// loop through the statistics buffers of each perf map
 	for perfMapName, statsMap := range pbm.perfBufferStatsMaps {
-		// total and perEvent are used for alerting
-		var total uint64
+		// total and perEvent are used for alerting, written is used to recommend the size of the event stream
+		var total, written uint64
 		perEvent := map[string]uint64{}
 		mapNameTag := fmt.Sprintf("map:%s", perfMapName)
 		tags[1] = mapNameTag
@@ -605,6 +625,7 @@ func (pbm *Monitor) collectAndSendKernelStats(client statsd.ClientInterface) err
 					return err
 				}
 				total += stats.Lost.Load()
+				written += stats.Count.Load()
 				perEvent[evtType.String()] += stats.Lost.Load()
 			}
 		}
@@ -626,6 +647,18 @@ func (pbm *Monitor) collectAndSendKernelStats(client statsd.ClientInterface) err
 			}
 		}
 
+		if advisor := pbm.bufferSizeAdvisors[perfMapName]; advisor != nil {
+			if recommended := advisor.observe(written, total); recommended > 0 {
+				log.Warnf("events were lost on %s during %d consecutive intervals, consider setting event_monitoring_config.event_stream.buffer_size to %d", perfMapName, sustainedLossIntervals, recommended)
+			}
+
+			if recommended := advisor.recommended.Load(); recommended > 0 {
+				if err := client.Gauge(metrics.MetricPerfBufferRecommendedSize, float64(recommended), []string{pbm.config.StatsTagsCardinality, mapNameTag}, 1.0); err != nil {
+					return err
+				}
+			}
+		}
+
 		// send an alert if events were lost
 		if total > 0 {
 			if pbm.onEventLost != nil {
@@ -0,0 +1,75 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+// Package eventstream holds eventstream related files
+package eventstream
+
+import (
+	"go.uber.org/atomic"
+
+	"github.com/DataDog/datadog-agent/pkg/security/ebpf/probes"
+)
+
+const (
+	// sustainedLossIntervals is the number of consecutive stats intervals with lost events after which a larger
+	// event stream is recommended
+	sustainedLossIntervals = 6
+	// sustainedLossRatio is the minimum ratio of lost events of an interval to count it as lossy
+	sustainedLossRatio = 0.001
+)
+
+// bufferSizeAdvisor recommends a larger event stream when events are lost during several consecutive intervals
+type bufferSizeAdvisor struct {
+	useRingBuffers bool
+	size           int
+	lossyIntervals int
+	recommended    *atomic.Int64
+}
+
+func newBufferSizeAdvisor(size int, useRingBuffers bool) *bufferSizeAdvisor {
+	return &bufferSizeAdvisor{
+		useRingBuffers: useRingBuffers,
+		size:           size,
+		recommended:    atomic.NewInt64(0),
+	}
+}
+
+// observe records the events written and lost during an interval. It returns the new recommended size, or 0 if the
+// recommendation didn't change.
+func (a *bufferSizeAdvisor) observe(count uint64, lost uint64) int {
+	if lost == 0 || float64(lost) < float64(count+lost)*sustainedLossRatio {
+		a.lossyIntervals = 0
+		return 0
+	}
+
+	if a.lossyIntervals++; a.lossyIntervals < sustainedLossIntervals {
+		return 0
+	}
+	a.lossyIntervals = 0
+
+	base := a.size
+	if recommended := int(a.recommended.Load()); recommended > base {
+		base = recommended
+	}
+
+	recommended := probes.RoundEventsBufferSize(base*2, a.useRingBuffers)
+	if recommended <= base {
+		return 0
+	}
+	a.recommended.Store(int64(recommended))
+
+	return recommended
+}
+
+// GetRecommendedBufferSize returns the size recommended for the provided map after sustained loss, per CPU for perf
+// buffers, or 0 if the current size is enough
+func (pbm *Monitor) GetRecommendedBufferSize(mapName string) int {
+	if advisor := pbm.bufferSizeAdvisors[mapName]; advisor != nil {
+		return int(advisor.recommended.Load())
+	}
+	return 0
+}
@@ -0,0 +1,66 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+// Package eventstream holds eventstream related files
+package eventstream
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/DataDog/datadog-agent/pkg/security/ebpf/probes"
+)
+
+func TestBufferSizeAdvisor(t *testing.T) {
+	size := probes.MinEventsBufferSize(true)
+	advisor := newBufferSizeAdvisor(size, true)
+
+	// a lossless interval resets the count of lossy intervals
+	for i := 0; i < sustainedLossIntervals-1; i++ {
+		assert.Zero(t, advisor.observe(1000, 10))
+	}
+	assert.Zero(t, advisor.observe(1000, 0))
+
+	for i := 0; i < sustainedLossIntervals-1; i++ {
+		assert.Zero(t, advisor.observe(1000, 10))
+	}
+	assert.Equal(t, size*2, advisor.observe(1000, 10))
+
+	// the recommendation keeps growing while events are lost, up to the maximum size
+	for recommended := size * 2; recommended < probes.MaxEventsBufferSize(true); recommended *= 2 {
+		for i := 0; i < sustainedLossIntervals-1; i++ {
+			assert.Zero(t, advisor.observe(1000, 10))
+		}
+		assert.Equal(t, recommended*2, advisor.observe(1000, 10))
+	}
+
+	for i := 0; i < sustainedLossIntervals; i++ {
+		assert.Zero(t, advisor.observe(1000, 10))
+	}
+	assert.Equal(t, int64(probes.MaxEventsBufferSize(true)), advisor.recommended.Load())
+}
+
+func TestComputeEventsBufferSize(t *testing.T) {
+	// without expected event rate, the defaults are used
+	assert.Equal(t, probes.EventsPerfRingBufferSize, probes.ComputeEventsBufferSize(4, 0, false))
+
+	// perf buffers are sized per CPU
+	assert.Equal(t, probes.MinEventsBufferSize(false), probes.ComputeEventsBufferSize(64, 1000, false))
+	assert.Equal(t, probes.MaxEventsBufferSize(false), probes.ComputeEventsBufferSize(1, 1000000, false))
+
+	size := probes.ComputeEventsBufferSize(4, 200000, true)
+	assert.GreaterOrEqual(t, size, 200000*512/2)
+	assert.Zero(t, size&(size-1))
+	assert.Equal(t, probes.MaxEventsBufferSize(true), probes.ComputeEventsBufferSize(4, 100000000, true))
+
+	// the default expected event rate keeps the static default size of the ring buffer
+	if os.Getpagesize() == 4096 {
+		assert.Equal(t, 16*256*os.Getpagesize(), probes.ComputeEventsBufferSize(4, 60000, true))
+	}
+}
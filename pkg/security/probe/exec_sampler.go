@@ -0,0 +1,114 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+// Package probe holds probe related files
+package probe
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sync"
+	"time"
+
+	"github.com/DataDog/datadog-go/v5/statsd"
+	"go.uber.org/atomic"
+
+	"github.com/DataDog/datadog-agent/pkg/security/metrics"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/containerutils"
+)
+
+// maximum number of identical execs tracked per container and per window, the others are kept
+const execSamplerMaxEntries = 4096
+
+type execSamplerBucket struct {
+	start  time.Time
+	counts map[uint64]uint64
+}
+
+// execSampler keeps 1 in N identical exec events of the same binary, per container and per window. The execs are
+// sampled once the process cache is updated so that the process trees stay complete.
+type execSampler struct {
+	sync.Mutex
+	rate    uint64
+	window  time.Duration
+	buckets map[containerutils.ContainerID]*execSamplerBucket
+
+	dropped *atomic.Uint64
+}
+
+func newExecSampler(rate int, window time.Duration) *execSampler {
+	return &execSampler{
+		rate:    uint64(rate),
+		window:  window,
+		buckets: make(map[containerutils.ContainerID]*execSamplerBucket),
+		dropped: atomic.NewUint64(0),
+	}
+}
+
+func execSamplerKey(path string, argv []string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(path))
+	for _, arg := range argv {
+		_, _ = h.Write([]byte{0})
+		_, _ = h.Write([]byte(arg))
+	}
+	return h.Sum64()
+}
+
+// sample returns whether the exec of the provided binary and arguments should be kept
+func (s *execSampler) sample(containerID containerutils.ContainerID, path string, argv []string, now time.Time) bool {
+	s.Lock()
+	defer s.Unlock()
+
+	bucket := s.buckets[containerID]
+	if bucket == nil || now.Sub(bucket.start) >= s.window {
+		bucket = &execSamplerBucket{
+			start:  now,
+			counts: make(map[uint64]uint64),
+		}
+		s.buckets[containerID] = bucket
+	}
+
+	key := execSamplerKey(path, argv)
+	count, exists := bucket.counts[key]
+	if !exists && len(bucket.counts) >= execSamplerMaxEntries {
+		return true
+	}
+	bucket.counts[key] = count + 1
+
+	if count%s.rate == 0 {
+		return true
+	}
+
+	s.dropped.Inc()
+	return false
+}
+
+// expire removes the buckets of the windows ended, of the deleted containers for instance
+func (s *execSampler) expire(now time.Time) {
+	s.Lock()
+	defer s.Unlock()
+
+	for containerID, bucket := range s.buckets {
+		if now.Sub(bucket.start) >= s.window {
+			delete(s.buckets, containerID)
+		}
+	}
+}
+
+// SendStats sends the metrics of the exec sampler
+func (s *execSampler) SendStats(statsdClient statsd.ClientInterface) error {
+	s.expire(time.Now())
+
+	if count := s.dropped.Swap(0); count > 0 {
+		if err := statsdClient.Count(metrics.MetricProcessResolverExecSampled, int64(count), []string{}, 1.0); err != nil {
+			return fmt.Errorf("failed to send exec sampled metric: %w", err)
+		}
+	}
+
+	return nil
+}
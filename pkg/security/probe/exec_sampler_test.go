@@ -0,0 +1,44 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+// Package probe holds probe related files
+package probe
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExecSampler(t *testing.T) {
+	sampler := newExecSampler(3, time.Minute)
+	now := time.Now()
+
+	argv := []string{"-c", "main.c"}
+
+	var kept []bool
+	for i := 0; i < 7; i++ {
+		kept = append(kept, sampler.sample("ctr1", "/usr/bin/gcc", argv, now))
+	}
+	assert.Equal(t, []bool{true, false, false, true, false, false, true}, kept)
+	assert.EqualValues(t, 4, sampler.dropped.Load())
+
+	// other container, binary or arguments
+	assert.True(t, sampler.sample("ctr2", "/usr/bin/gcc", argv, now))
+	assert.True(t, sampler.sample("ctr1", "/usr/bin/cc", argv, now))
+	assert.True(t, sampler.sample("ctr1", "/usr/bin/gcc", []string{"-c", "util.c"}, now))
+
+	// new window
+	assert.True(t, sampler.sample("ctr1", "/usr/bin/gcc", argv, now.Add(time.Minute)))
+	assert.False(t, sampler.sample("ctr1", "/usr/bin/gcc", argv, now.Add(time.Minute)))
+
+	sampler.expire(now.Add(time.Minute))
+	assert.Len(t, sampler.buckets, 1)
+	sampler.expire(now.Add(2 * time.Minute))
+	assert.Empty(t, sampler.buckets)
+}
@@ -19,11 +19,13 @@ import (
 	workloadmeta "github.com/DataDog/datadog-agent/comp/core/workloadmeta/def"
 	"github.com/DataDog/datadog-agent/pkg/security/config"
 	"github.com/DataDog/datadog-agent/pkg/security/resolvers"
+	spath "github.com/DataDog/datadog-agent/pkg/security/resolvers/path"
 	sprocess "github.com/DataDog/datadog-agent/pkg/security/resolvers/process"
 	"github.com/DataDog/datadog-agent/pkg/security/secl/containerutils"
 
 	"github.com/DataDog/datadog-agent/pkg/security/secl/args"
 	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
+	utilkernel "github.com/DataDog/datadog-agent/pkg/util/kernel"
 )
 
 // EBPFFieldHandlers defines a field handlers
@@ -31,6 +33,10 @@ type EBPFFieldHandlers struct {
 	*BaseFieldHandlers
 	resolvers *resolvers.EBPFResolvers
 	onDemand  *OnDemandProbesManager
+
+	// security state of the kernel of the host, detected at startup
+	kernelLockdown   string
+	kernelSecureBoot string
 }
 
 // NewEBPFFieldHandlers returns a new EBPFFieldHandlers
@@ -44,9 +50,16 @@ func NewEBPFFieldHandlers(config *config.Config, resolvers *resolvers.EBPFResolv
 		BaseFieldHandlers: bfh,
 		resolvers:         resolvers,
 		onDemand:          onDemand,
+		kernelLockdown:    string(utilkernel.GetLockdownMode()),
+		kernelSecureBoot:  string(utilkernel.GetSecureBootStatus()),
 	}, nil
 }
 
+// GetPathAnonymizer returns the anonymizer applied to the paths of the events sent to the backend
+func (fh *EBPFFieldHandlers) GetPathAnonymizer() *spath.Anonymizer {
+	return fh.resolvers.PathAnonymizer
+}
+
 // ResolveProcessCacheEntry queries the ProcessResolver to retrieve the ProcessContext of the event
 func (fh *EBPFFieldHandlers) ResolveProcessCacheEntry(ev *model.Event, newEntryCb func(*model.ProcessCacheEntry, error)) (*model.ProcessCacheEntry, bool) {
 	if ev.PIDContext.IsKworker {
@@ -54,7 +67,14 @@ func (fh *EBPFFieldHandlers) ResolveProcessCacheEntry(ev *model.Event, newEntryC
 	}
 
 	if ev.ProcessCacheEntry == nil && ev.PIDContext.Pid != 0 {
-		ev.ProcessCacheEntry = fh.resolvers.ProcessResolver.Resolve(ev.PIDContext.Pid, ev.PIDContext.Tid, ev.PIDContext.ExecInode, true, newEntryCb)
+		if !fh.resolvers.ProcessResolver.IsSnapshotted() {
+			ev.AddToFlags(model.EventFlagsPreSnapshot)
+		}
+		var containerID containerutils.ContainerID
+		if ev.ContainerContext != nil {
+			containerID = ev.ContainerContext.ContainerID
+		}
+		ev.ProcessCacheEntry = fh.resolvers.ProcessResolver.Resolve(ev.PIDContext.Pid, ev.PIDContext.Tid, ev.PIDContext.ExecInode, containerID, true, newEntryCb)
 	}
 
 	if ev.ProcessCacheEntry == nil {
@@ -109,6 +129,36 @@ func (fh *EBPFFieldHandlers) ResolveFileFilesystem(ev *model.Event, f *model.Fil
 	return f.Filesystem
 }
 
+func (fh *EBPFFieldHandlers) resolveFileLayer(ev *model.Event, f *model.FileEvent) {
+	if f.IsLayerResolved {
+		return
+	}
+	f.IsLayerResolved = true
+
+	if fh.ResolveFileFilesystem(ev, f) != model.OverlayFS {
+		return
+	}
+
+	layerPath, layerDigest, err := fh.resolvers.PathResolver.ResolveFileLayer(&f.FileFields, &ev.PIDContext, ev.ContainerContext)
+	if err != nil {
+		return
+	}
+	f.LayerPath = layerPath
+	f.LayerDigest = layerDigest
+}
+
+// ResolveFileLayerPath resolves the path of the file in the OverlayFS layer backing it
+func (fh *EBPFFieldHandlers) ResolveFileLayerPath(ev *model.Event, f *model.FileEvent) string {
+	fh.resolveFileLayer(ev, f)
+	return f.LayerPath
+}
+
+// ResolveFileLayerDigest resolves the digest of the container image layer backing the file
+func (fh *EBPFFieldHandlers) ResolveFileLayerDigest(ev *model.Event, f *model.FileEvent) string {
+	fh.resolveFileLayer(ev, f)
+	return f.LayerDigest
+}
+
 // ResolveProcessArgsFlags resolves the arguments flags of the event
 func (fh *EBPFFieldHandlers) ResolveProcessArgsFlags(ev *model.Event, process *model.Process) (flags []string) {
 	return args.ParseProcessFlags(fh.ResolveProcessArgv(ev, process))
@@ -221,6 +271,8 @@ func getContainerRuntime(flags containerutils.CGroupFlags) string {
 		return string(workloadmeta.ContainerRuntimeDocker)
 	case containerutils.CGroupManagerPodman:
 		return string(workloadmeta.ContainerRuntimePodman)
+	case containerutils.CGroupManagerLXC:
+		return containerutils.ContainerRuntimeLXC
 	default:
 		return ""
 	}
@@ -234,7 +286,7 @@ func (fh *EBPFFieldHandlers) ResolveRights(_ *model.Event, e *model.FileFields)
 // ResolveChownUID resolves the ResolveProcessCacheEntry id of a chown event to a username
 func (fh *EBPFFieldHandlers) ResolveChownUID(ev *model.Event, e *model.ChownEvent) string {
 	if len(e.User) == 0 {
-		e.User, _ = fh.resolvers.UserGroupResolver.ResolveUser(int(e.UID), string(ev.ContainerContext.ContainerID))
+		e.User, _ = fh.resolvers.UserGroupResolver.ResolveUser(int(e.UID), string(ev.ContainerContext.ContainerID), ev.ProcessContext.Pid)
 	}
 	return e.User
 }
@@ -242,7 +294,7 @@ func (fh *EBPFFieldHandlers) ResolveChownUID(ev *model.Event, e *model.ChownEven
 // ResolveChownGID resolves the group id of a chown event to a group name
 func (fh *EBPFFieldHandlers) ResolveChownGID(ev *model.Event, e *model.ChownEvent) string {
 	if len(e.Group) == 0 {
-		e.Group, _ = fh.resolvers.UserGroupResolver.ResolveGroup(int(e.GID), string(ev.ContainerContext.ContainerID))
+		e.Group, _ = fh.resolvers.UserGroupResolver.ResolveGroup(int(e.GID), string(ev.ContainerContext.ContainerID), ev.ProcessContext.Pid)
 	}
 	return e.Group
 }
@@ -293,6 +345,76 @@ func (fh *EBPFFieldHandlers) ResolveProcessArgsTruncated(_ *model.Event, process
 	return truncated
 }
 
+// ResolveProcessCmdLineObfuscationScore resolves the obfuscation score of the command line of the process
+func (fh *EBPFFieldHandlers) ResolveProcessCmdLineObfuscationScore(_ *model.Event, process *model.Process) int {
+	return sprocess.GetProcessCmdLineObfuscationScore(process)
+}
+
+// ResolveProcessSystemdUnit resolves the systemd unit owning the cgroup of the process
+func (fh *EBPFFieldHandlers) ResolveProcessSystemdUnit(ev *model.Event, process *model.Process) string {
+	fh.resolveProcessSystemdUnit(ev, process)
+	return process.SystemdUnit
+}
+
+// ResolveProcessSystemdSlice resolves the systemd slice the unit of the process is nested in
+func (fh *EBPFFieldHandlers) ResolveProcessSystemdSlice(ev *model.Event, process *model.Process) string {
+	fh.resolveProcessSystemdUnit(ev, process)
+	return process.SystemdSlice
+}
+
+// resolveProcessSystemdUnit resolves the systemd unit of the process from its cgroup, the cgroup of the process of
+// the event being resolved lazily
+func (fh *EBPFFieldHandlers) resolveProcessSystemdUnit(ev *model.Event, process *model.Process) {
+	if process.SystemdUnit == "" && process.CGroup.CGroupID == "" && ev.ProcessContext != nil && process == &ev.ProcessContext.Process {
+		fh.ResolveCGroupID(ev, &process.CGroup)
+	}
+	sprocess.SetProcessSystemdUnit(process)
+}
+
+// ResolveProcessPIDNamespace returns the inode number of the PID namespace of the process, as read by the kernel
+func (fh *EBPFFieldHandlers) ResolveProcessPIDNamespace(_ *model.Event, process *model.Process) int {
+	return int(process.PIDNamespace)
+}
+
+// ResolveProcessUserNamespace returns the inode number of the user namespace of the process, as read by the kernel
+func (fh *EBPFFieldHandlers) ResolveProcessUserNamespace(_ *model.Event, process *model.Process) int {
+	return int(process.UserNamespace)
+}
+
+// ResolveProcessMountNamespace returns the inode number of the mount namespace of the process, as read by the kernel
+func (fh *EBPFFieldHandlers) ResolveProcessMountNamespace(_ *model.Event, process *model.Process) int {
+	return int(process.MountNamespace)
+}
+
+// ResolveProcessUTSNamespace returns the inode number of the UTS namespace of the process, as read by the kernel
+func (fh *EBPFFieldHandlers) ResolveProcessUTSNamespace(_ *model.Event, process *model.Process) int {
+	return int(process.UTSNamespace)
+}
+
+// ResolveProcessELFBuildID resolves the GNU build ID of the ELF executable of the process
+func (fh *EBPFFieldHandlers) ResolveProcessELFBuildID(_ *model.Event, process *model.Process) string {
+	fh.resolvers.ProcessResolver.SetProcessELFMetadata(process)
+	return process.ELFBuildID
+}
+
+// ResolveProcessELFInterpreter resolves the program interpreter of the ELF executable of the process
+func (fh *EBPFFieldHandlers) ResolveProcessELFInterpreter(_ *model.Event, process *model.Process) string {
+	fh.resolvers.ProcessResolver.SetProcessELFMetadata(process)
+	return process.ELFInterpreter
+}
+
+// ResolveProcessELFIsStatic resolves whether it is statically linked of the ELF executable of the process
+func (fh *EBPFFieldHandlers) ResolveProcessELFIsStatic(_ *model.Event, process *model.Process) bool {
+	fh.resolvers.ProcessResolver.SetProcessELFMetadata(process)
+	return process.ELFIsStatic
+}
+
+// ResolveProcessELFIsStripped resolves whether it is stripped of the ELF executable of the process
+func (fh *EBPFFieldHandlers) ResolveProcessELFIsStripped(_ *model.Event, process *model.Process) bool {
+	fh.resolvers.ProcessResolver.SetProcessELFMetadata(process)
+	return process.ELFIsStripped
+}
+
 // ResolveProcessEnvsTruncated returns whether the envs are truncated
 func (fh *EBPFFieldHandlers) ResolveProcessEnvsTruncated(_ *model.Event, process *model.Process) bool {
 	_, truncated := fh.resolvers.ProcessResolver.GetProcessEnvs(process)
@@ -313,7 +435,7 @@ func (fh *EBPFFieldHandlers) ResolveProcessIsThread(_ *model.Event, process *mod
 // ResolveSetuidUser resolves the user of the Setuid event
 func (fh *EBPFFieldHandlers) ResolveSetuidUser(ev *model.Event, e *model.SetuidEvent) string {
 	if len(e.User) == 0 {
-		e.User, _ = fh.resolvers.UserGroupResolver.ResolveUser(int(e.UID), string(ev.ContainerContext.ContainerID))
+		e.User, _ = fh.resolvers.UserGroupResolver.ResolveUser(int(e.UID), string(ev.ContainerContext.ContainerID), ev.ProcessContext.Pid)
 	}
 	return e.User
 }
@@ -321,7 +443,7 @@ func (fh *EBPFFieldHandlers) ResolveSetuidUser(ev *model.Event, e *model.SetuidE
 // ResolveSetuidEUser resolves the effective user of the Setuid event
 func (fh *EBPFFieldHandlers) ResolveSetuidEUser(ev *model.Event, e *model.SetuidEvent) string {
 	if len(e.EUser) == 0 {
-		e.EUser, _ = fh.resolvers.UserGroupResolver.ResolveUser(int(e.EUID), string(ev.ContainerContext.ContainerID))
+		e.EUser, _ = fh.resolvers.UserGroupResolver.ResolveUser(int(e.EUID), string(ev.ContainerContext.ContainerID), ev.ProcessContext.Pid)
 	}
 	return e.EUser
 }
@@ -329,7 +451,7 @@ func (fh *EBPFFieldHandlers) ResolveSetuidEUser(ev *model.Event, e *model.Setuid
 // ResolveSetuidFSUser resolves the file-system user of the Setuid event
 func (fh *EBPFFieldHandlers) ResolveSetuidFSUser(ev *model.Event, e *model.SetuidEvent) string {
 	if len(e.FSUser) == 0 {
-		e.FSUser, _ = fh.resolvers.UserGroupResolver.ResolveUser(int(e.FSUID), string(ev.ContainerContext.ContainerID))
+		e.FSUser, _ = fh.resolvers.UserGroupResolver.ResolveUser(int(e.FSUID), string(ev.ContainerContext.ContainerID), ev.ProcessContext.Pid)
 	}
 	return e.FSUser
 }
@@ -337,7 +459,7 @@ func (fh *EBPFFieldHandlers) ResolveSetuidFSUser(ev *model.Event, e *model.Setui
 // ResolveSetgidGroup resolves the group of the Setgid event
 func (fh *EBPFFieldHandlers) ResolveSetgidGroup(ev *model.Event, e *model.SetgidEvent) string {
 	if len(e.Group) == 0 {
-		e.Group, _ = fh.resolvers.UserGroupResolver.ResolveUser(int(e.GID), string(ev.ContainerContext.ContainerID))
+		e.Group, _ = fh.resolvers.UserGroupResolver.ResolveGroup(int(e.GID), string(ev.ContainerContext.ContainerID), ev.ProcessContext.Pid)
 	}
 	return e.Group
 }
@@ -345,7 +467,7 @@ func (fh *EBPFFieldHandlers) ResolveSetgidGroup(ev *model.Event, e *model.Setgid
 // ResolveSetgidEGroup resolves the effective group of the Setgid event
 func (fh *EBPFFieldHandlers) ResolveSetgidEGroup(ev *model.Event, e *model.SetgidEvent) string {
 	if len(e.EGroup) == 0 {
-		e.EGroup, _ = fh.resolvers.UserGroupResolver.ResolveUser(int(e.EGID), string(ev.ContainerContext.ContainerID))
+		e.EGroup, _ = fh.resolvers.UserGroupResolver.ResolveGroup(int(e.EGID), string(ev.ContainerContext.ContainerID), ev.ProcessContext.Pid)
 	}
 	return e.EGroup
 }
@@ -353,7 +475,7 @@ func (fh *EBPFFieldHandlers) ResolveSetgidEGroup(ev *model.Event, e *model.Setgi
 // ResolveSetgidFSGroup resolves the file-system group of the Setgid event
 func (fh *EBPFFieldHandlers) ResolveSetgidFSGroup(ev *model.Event, e *model.SetgidEvent) string {
 	if len(e.FSGroup) == 0 {
-		e.FSGroup, _ = fh.resolvers.UserGroupResolver.ResolveUser(int(e.FSGID), string(ev.ContainerContext.ContainerID))
+		e.FSGroup, _ = fh.resolvers.UserGroupResolver.ResolveGroup(int(e.FSGID), string(ev.ContainerContext.ContainerID), ev.ProcessContext.Pid)
 	}
 	return e.FSGroup
 }
@@ -372,7 +494,7 @@ func (fh *EBPFFieldHandlers) ResolveSELinuxBoolName(_ *model.Event, e *model.SEL
 
 // GetProcessCacheEntry queries the ProcessResolver to retrieve the ProcessContext of the event
 func (fh *EBPFFieldHandlers) GetProcessCacheEntry(ev *model.Event, newEntryCb func(*model.ProcessCacheEntry, error)) (*model.ProcessCacheEntry, bool) {
-	ev.ProcessCacheEntry = fh.resolvers.ProcessResolver.Resolve(ev.PIDContext.Pid, ev.PIDContext.Tid, ev.PIDContext.ExecInode, false, newEntryCb)
+	ev.ProcessCacheEntry = fh.resolvers.ProcessResolver.Resolve(ev.PIDContext.Pid, ev.PIDContext.Tid, ev.PIDContext.ExecInode, "", false, newEntryCb)
 	if ev.ProcessCacheEntry == nil {
 		ev.ProcessCacheEntry = model.GetPlaceholderProcessCacheEntry(ev.PIDContext.Pid, ev.PIDContext.Tid, false)
 		return ev.ProcessCacheEntry, false
@@ -383,7 +505,7 @@ func (fh *EBPFFieldHandlers) GetProcessCacheEntry(ev *model.Event, newEntryCb fu
 // ResolveFileFieldsGroup resolves the group id of the file to a group name
 func (fh *EBPFFieldHandlers) ResolveFileFieldsGroup(ev *model.Event, e *model.FileFields) string {
 	if len(e.Group) == 0 {
-		e.Group, _ = fh.resolvers.UserGroupResolver.ResolveGroup(int(e.GID), string(ev.ContainerContext.ContainerID))
+		e.Group, _ = fh.resolvers.UserGroupResolver.ResolveGroup(int(e.GID), string(ev.ContainerContext.ContainerID), ev.ProcessContext.Pid)
 	}
 	return e.Group
 }
@@ -403,7 +525,7 @@ func (fh *EBPFFieldHandlers) ResolveNetworkDeviceIfName(_ *model.Event, device *
 // ResolveFileFieldsUser resolves the user id of the file to a username
 func (fh *EBPFFieldHandlers) ResolveFileFieldsUser(ev *model.Event, e *model.FileFields) string {
 	if len(e.User) == 0 {
-		e.User, _ = fh.resolvers.UserGroupResolver.ResolveUser(int(e.UID), string(ev.ContainerContext.ContainerID))
+		e.User, _ = fh.resolvers.UserGroupResolver.ResolveUser(int(e.UID), string(ev.ContainerContext.ContainerID), ev.ProcessContext.Pid)
 	}
 	return e.User
 }
@@ -488,6 +610,22 @@ func (fh *EBPFFieldHandlers) ResolveModuleArgv(_ *model.Event, module *model.Loa
 	return module.Argv
 }
 
+// ResolveKernelLockdown resolves the kernel lockdown mode of the host
+func (fh *EBPFFieldHandlers) ResolveKernelLockdown(_ *model.Event, e *model.KernelSecurityContext) string {
+	if e.Lockdown == "" {
+		e.Lockdown = fh.kernelLockdown
+	}
+	return e.Lockdown
+}
+
+// ResolveKernelSecureBoot resolves the secure boot status of the host
+func (fh *EBPFFieldHandlers) ResolveKernelSecureBoot(_ *model.Event, e *model.KernelSecurityContext) string {
+	if e.SecureBoot == "" {
+		e.SecureBoot = fh.kernelSecureBoot
+	}
+	return e.SecureBoot
+}
+
 // ResolveModuleArgs resolves the correct args if the arguments were truncated, if not return module.Args
 func (fh *EBPFFieldHandlers) ResolveModuleArgs(_ *model.Event, module *model.LoadModuleEvent) string {
 	if module.ArgsTruncated {
@@ -550,6 +688,82 @@ func (fh *EBPFFieldHandlers) ResolveCGroupManager(ev *model.Event, _ *model.CGro
 	return ""
 }
 
+// ResolveCGroupAncestors resolves the ancestors of the cgroup in the cgroup v2 hierarchy
+func (fh *EBPFFieldHandlers) ResolveCGroupAncestors(ev *model.Event, e *model.CGroupContext) []string {
+	if e.CGroupAncestors == nil {
+		ancestors := containerutils.GetCGroupAncestors(containerutils.CGroupID(fh.ResolveCGroupID(ev, e)))
+		e.CGroupAncestors = make([]string, 0, len(ancestors))
+		for _, ancestor := range ancestors {
+			e.CGroupAncestors = append(e.CGroupAncestors, string(ancestor))
+		}
+	}
+	return e.CGroupAncestors
+}
+
+// ResolveCGroupControllers resolves the controllers available to the cgroup. The cgroups are loaded in the background,
+// the controllers of a cgroup not loaded yet being resolved again by the next events.
+func (fh *EBPFFieldHandlers) ResolveCGroupControllers(ev *model.Event, e *model.CGroupContext) []string {
+	if e.CGroupControllers == nil {
+		node, found := fh.resolvers.CGroupResolver.GetCGroupNode(containerutils.CGroupID(fh.ResolveCGroupID(ev, e)))
+		if !found {
+			return nil
+		}
+
+		e.CGroupControllers = []string{}
+		if node.Controllers != nil {
+			e.CGroupControllers = node.Controllers
+		}
+	}
+	return e.CGroupControllers
+}
+
+// resolveCGroupUsage returns the usage of the cgroup, read once per event. It isn't stored in the context, the one of a
+// process cache entry being shared with the other events of the process.
+func (fh *EBPFFieldHandlers) resolveCGroupUsage(ev *model.Event, e *model.CGroupContext) model.CGroupUsage {
+	if !fh.config.RuntimeSecurity.CGroupUsageEnabled {
+		return model.CGroupUsage{}
+	}
+
+	cgroupID := containerutils.CGroupID(fh.ResolveCGroupID(ev, e))
+	for _, usage := range ev.CGroupUsages {
+		if usage.CGroupID == cgroupID {
+			return usage
+		}
+	}
+
+	// the cgroups whose usage can't be read are kept too, so that they are looked up once
+	usage := model.CGroupUsage{CGroupID: cgroupID}
+	if cgroupUsage, found := fh.resolvers.CGroupResolver.GetCGroupUsage(cgroupID); found {
+		usage.MemoryCurrent = cgroupUsage.MemoryCurrent
+		usage.MemoryHighExceeded = cgroupUsage.MemoryHighExceeded
+		usage.PidsCurrent = cgroupUsage.PidsCurrent
+		usage.CPUUsage = cgroupUsage.CPUUsage
+	}
+	ev.CGroupUsages = append(ev.CGroupUsages, usage)
+
+	return usage
+}
+
+// ResolveCGroupMemoryCurrent resolves the memory usage of the cgroup
+func (fh *EBPFFieldHandlers) ResolveCGroupMemoryCurrent(ev *model.Event, e *model.CGroupContext) int {
+	return int(fh.resolveCGroupUsage(ev, e).MemoryCurrent)
+}
+
+// ResolveCGroupMemoryHighExceeded resolves whether the memory usage of the cgroup is above its throttle limit
+func (fh *EBPFFieldHandlers) ResolveCGroupMemoryHighExceeded(ev *model.Event, e *model.CGroupContext) bool {
+	return fh.resolveCGroupUsage(ev, e).MemoryHighExceeded
+}
+
+// ResolveCGroupPidsCurrent resolves the number of processes of the cgroup
+func (fh *EBPFFieldHandlers) ResolveCGroupPidsCurrent(ev *model.Event, e *model.CGroupContext) int {
+	return int(fh.resolveCGroupUsage(ev, e).PidsCurrent)
+}
+
+// ResolveCGroupCPUUsage resolves the cpu time consumed by the cgroup
+func (fh *EBPFFieldHandlers) ResolveCGroupCPUUsage(ev *model.Event, e *model.CGroupContext) int {
+	return int(fh.resolveCGroupUsage(ev, e).CPUUsage)
+}
+
 // ResolveContainerID resolves the container ID of the event
 func (fh *EBPFFieldHandlers) ResolveContainerID(ev *model.Event, e *model.ContainerContext) string {
 	if len(e.ContainerID) == 0 {
@@ -627,9 +841,46 @@ func (fh *EBPFFieldHandlers) ResolveProcessCmdArgv(ev *model.Event, process *mod
 	return append(cmdline, fh.ResolveProcessArgv(ev, process)...)
 }
 
-// ResolveAWSSecurityCredentials resolves and updates the AWS security credentials of the input process entry
-func (fh *EBPFFieldHandlers) ResolveAWSSecurityCredentials(e *model.Event) []model.AWSSecurityCredentials {
-	return fh.resolvers.ProcessResolver.FetchAWSSecurityCredentials(e)
+// ResolveCloudCredentials resolves and updates the cloud credentials of the input process entry
+func (fh *EBPFFieldHandlers) ResolveCloudCredentials(e *model.Event) []model.CloudCredentials {
+	return fh.resolvers.ProcessResolver.FetchCloudCredentials(e)
+}
+
+// ResolveProcessCloudCredentialsProviders resolves the cloud providers of the unexpired credentials of the process
+func (fh *EBPFFieldHandlers) ResolveProcessCloudCredentialsProviders(ev *model.Event, process *model.Process) []string {
+	return resolveCloudCredentialsValues(ev, process, func(creds *model.CloudCredentials) string {
+		return creds.CloudProvider
+	})
+}
+
+// ResolveProcessCloudCredentialsTypes resolves the types of the unexpired credentials of the process
+func (fh *EBPFFieldHandlers) ResolveProcessCloudCredentialsTypes(ev *model.Event, process *model.Process) []string {
+	return resolveCloudCredentialsValues(ev, process, func(creds *model.CloudCredentials) string {
+		return creds.Type
+	})
+}
+
+// ResolveProcessCloudCredentialsResources resolves the resources of the unexpired access tokens of the process
+func (fh *EBPFFieldHandlers) ResolveProcessCloudCredentialsResources(ev *model.Event, process *model.Process) []string {
+	return resolveCloudCredentialsValues(ev, process, func(creds *model.CloudCredentials) string {
+		return creds.Resource
+	})
+}
+
+// resolveCloudCredentialsValues returns the non empty values of the credentials of the process that are still valid
+// at the time of the event. They aren't cached on the process as they expire over time.
+func resolveCloudCredentialsValues(ev *model.Event, process *model.Process, value func(creds *model.CloudCredentials) string) []string {
+	if len(process.CloudCredentials) == 0 {
+		return nil
+	}
+
+	var values []string
+	for _, creds := range process.GetValidCloudCredentials(ev.ResolveEventTime()) {
+		if v := value(&creds); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
 }
 
 // ResolveSyscallCtxArgs resolve syscall ctx
@@ -14,6 +14,7 @@ import (
 
 	"github.com/DataDog/datadog-agent/pkg/security/config"
 	"github.com/DataDog/datadog-agent/pkg/security/resolvers"
+	spath "github.com/DataDog/datadog-agent/pkg/security/resolvers/path"
 	sprocess "github.com/DataDog/datadog-agent/pkg/security/resolvers/process"
 
 	"github.com/DataDog/datadog-agent/pkg/security/secl/args"
@@ -40,6 +41,11 @@ func NewEBPFLessFieldHandlers(config *config.Config, resolvers *resolvers.EBPFLe
 	}, nil
 }
 
+// GetPathAnonymizer returns the anonymizer applied to the paths of the events sent to the backend
+func (fh *EBPFLessFieldHandlers) GetPathAnonymizer() *spath.Anonymizer {
+	return fh.resolvers.PathAnonymizer
+}
+
 // ResolveProcessCacheEntry queries the ProcessResolver to retrieve the ProcessContext of the event
 func (fh *EBPFLessFieldHandlers) ResolveProcessCacheEntry(ev *model.Event, _ func(*model.ProcessCacheEntry, error)) (*model.ProcessCacheEntry, bool) {
 	if ev.ProcessCacheEntry == nil && ev.PIDContext.Pid != 0 {
@@ -117,6 +123,67 @@ func (fh *EBPFLessFieldHandlers) ResolveProcessArgsTruncated(_ *model.Event, pro
 	return truncated
 }
 
+// ResolveProcessCmdLineObfuscationScore resolves the obfuscation score of the command line of the process
+func (fh *EBPFLessFieldHandlers) ResolveProcessCmdLineObfuscationScore(_ *model.Event, process *model.Process) int {
+	return sprocess.GetProcessCmdLineObfuscationScore(process)
+}
+
+// ResolveProcessSystemdUnit resolves the systemd unit owning the cgroup of the process
+func (fh *EBPFLessFieldHandlers) ResolveProcessSystemdUnit(_ *model.Event, process *model.Process) string {
+	sprocess.SetProcessSystemdUnit(process)
+	return process.SystemdUnit
+}
+
+// ResolveProcessSystemdSlice resolves the systemd slice the unit of the process is nested in
+func (fh *EBPFLessFieldHandlers) ResolveProcessSystemdSlice(_ *model.Event, process *model.Process) string {
+	sprocess.SetProcessSystemdUnit(process)
+	return process.SystemdSlice
+}
+
+// ResolveProcessPIDNamespace resolves the inode number of the PID namespace of the process
+func (fh *EBPFLessFieldHandlers) ResolveProcessPIDNamespace(_ *model.Event, process *model.Process) int {
+	sprocess.SetProcessNamespaces(process)
+	return int(process.PIDNamespace)
+}
+
+// ResolveProcessUserNamespace resolves the inode number of the user namespace of the process
+func (fh *EBPFLessFieldHandlers) ResolveProcessUserNamespace(_ *model.Event, process *model.Process) int {
+	sprocess.SetProcessNamespaces(process)
+	return int(process.UserNamespace)
+}
+
+// ResolveProcessMountNamespace resolves the inode number of the mount namespace of the process
+func (fh *EBPFLessFieldHandlers) ResolveProcessMountNamespace(_ *model.Event, process *model.Process) int {
+	sprocess.SetProcessNamespaces(process)
+	return int(process.MountNamespace)
+}
+
+// ResolveProcessUTSNamespace resolves the inode number of the UTS namespace of the process
+func (fh *EBPFLessFieldHandlers) ResolveProcessUTSNamespace(_ *model.Event, process *model.Process) int {
+	sprocess.SetProcessNamespaces(process)
+	return int(process.UTSNamespace)
+}
+
+// ResolveProcessELFBuildID returns the GNU build ID of the ELF executable, unresolved without eBPF
+func (fh *EBPFLessFieldHandlers) ResolveProcessELFBuildID(_ *model.Event, process *model.Process) string {
+	return process.ELFBuildID
+}
+
+// ResolveProcessELFInterpreter returns the program interpreter of the ELF executable, unresolved without eBPF
+func (fh *EBPFLessFieldHandlers) ResolveProcessELFInterpreter(_ *model.Event, process *model.Process) string {
+	return process.ELFInterpreter
+}
+
+// ResolveProcessELFIsStatic returns whether the ELF executable is statically linked, unresolved without eBPF
+func (fh *EBPFLessFieldHandlers) ResolveProcessELFIsStatic(_ *model.Event, process *model.Process) bool {
+	return process.ELFIsStatic
+}
+
+// ResolveProcessELFIsStripped returns whether the ELF executable is stripped, unresolved without eBPF
+func (fh *EBPFLessFieldHandlers) ResolveProcessELFIsStripped(_ *model.Event, process *model.Process) bool {
+	return process.ELFIsStripped
+}
+
 // ResolveProcessEnvsTruncated returns whether the envs are truncated
 func (fh *EBPFLessFieldHandlers) ResolveProcessEnvsTruncated(_ *model.Event, process *model.Process) bool {
 	_, truncated := fh.resolvers.ProcessResolver.GetProcessEnvs(process)
@@ -165,6 +232,36 @@ func (fh *EBPFLessFieldHandlers) ResolveCGroupManager(_ *model.Event, _ *model.C
 	return ""
 }
 
+// ResolveCGroupAncestors resolves the ancestors of the cgroup in the cgroup v2 hierarchy
+func (fh *EBPFLessFieldHandlers) ResolveCGroupAncestors(_ *model.Event, _ *model.CGroupContext) []string {
+	return nil
+}
+
+// ResolveCGroupControllers resolves the controllers available to the cgroup
+func (fh *EBPFLessFieldHandlers) ResolveCGroupControllers(_ *model.Event, _ *model.CGroupContext) []string {
+	return nil
+}
+
+// ResolveCGroupMemoryCurrent resolves the memory usage of the cgroup
+func (fh *EBPFLessFieldHandlers) ResolveCGroupMemoryCurrent(_ *model.Event, e *model.CGroupContext) int {
+	return int(e.CGroupMemoryCurrent)
+}
+
+// ResolveCGroupMemoryHighExceeded resolves whether the memory usage of the cgroup is above its throttle limit
+func (fh *EBPFLessFieldHandlers) ResolveCGroupMemoryHighExceeded(_ *model.Event, e *model.CGroupContext) bool {
+	return e.CGroupMemoryHighExceeded
+}
+
+// ResolveCGroupPidsCurrent resolves the number of processes of the cgroup
+func (fh *EBPFLessFieldHandlers) ResolveCGroupPidsCurrent(_ *model.Event, e *model.CGroupContext) int {
+	return int(e.CGroupPidsCurrent)
+}
+
+// ResolveCGroupCPUUsage resolves the cpu time consumed by the cgroup
+func (fh *EBPFLessFieldHandlers) ResolveCGroupCPUUsage(_ *model.Event, e *model.CGroupContext) int {
+	return int(e.CGroupCPUUsage)
+}
+
 // ResolveContainerContext retrieve the ContainerContext of the event
 func (fh *EBPFLessFieldHandlers) ResolveContainerContext(ev *model.Event) (*model.ContainerContext, bool) {
 	return ev.ContainerContext, ev.ContainerContext != nil
@@ -276,6 +373,16 @@ func (fh *EBPFLessFieldHandlers) ResolveModuleArgv(_ *model.Event, e *model.Load
 	return e.Argv
 }
 
+// ResolveKernelLockdown resolves the kernel lockdown mode of the host
+func (fh *EBPFLessFieldHandlers) ResolveKernelLockdown(_ *model.Event, e *model.KernelSecurityContext) string {
+	return e.Lockdown
+}
+
+// ResolveKernelSecureBoot resolves the secure boot status of the host
+func (fh *EBPFLessFieldHandlers) ResolveKernelSecureBoot(_ *model.Event, e *model.KernelSecurityContext) string {
+	return e.SecureBoot
+}
+
 // ResolveMountPointPath resolves a mount point path
 func (fh *EBPFLessFieldHandlers) ResolveMountPointPath(_ *model.Event, e *model.MountEvent) string {
 	return e.MountPointPath
@@ -296,6 +403,16 @@ func (fh *EBPFLessFieldHandlers) ResolveNetworkDeviceIfName(_ *model.Event, e *m
 	return e.IfName
 }
 
+// ResolveFileLayerPath resolves the path of the file in the OverlayFS layer backing it
+func (fh *EBPFLessFieldHandlers) ResolveFileLayerPath(_ *model.Event, e *model.FileEvent) string {
+	return e.LayerPath
+}
+
+// ResolveFileLayerDigest resolves the digest of the container image layer backing the file
+func (fh *EBPFLessFieldHandlers) ResolveFileLayerDigest(_ *model.Event, e *model.FileEvent) string {
+	return e.LayerDigest
+}
+
 // ResolvePackageName resolves the name of the package providing this file
 func (fh *EBPFLessFieldHandlers) ResolvePackageName(_ *model.Event, e *model.FileEvent) string {
 	return e.PkgName
@@ -380,8 +497,23 @@ func (fh *EBPFLessFieldHandlers) ResolveProcessCmdArgv(ev *model.Event, process
 	return append(cmdline, fh.ResolveProcessArgv(ev, process)...)
 }
 
-// ResolveAWSSecurityCredentials resolves and updates the AWS security credentials of the input process entry
-func (fh *EBPFLessFieldHandlers) ResolveAWSSecurityCredentials(_ *model.Event) []model.AWSSecurityCredentials {
+// ResolveCloudCredentials resolves and updates the cloud credentials of the input process entry
+func (fh *EBPFLessFieldHandlers) ResolveCloudCredentials(_ *model.Event) []model.CloudCredentials {
+	return nil
+}
+
+// ResolveProcessCloudCredentialsProviders resolves the cloud providers of the unexpired credentials of the process
+func (fh *EBPFLessFieldHandlers) ResolveProcessCloudCredentialsProviders(_ *model.Event, _ *model.Process) []string {
+	return nil
+}
+
+// ResolveProcessCloudCredentialsTypes resolves the types of the unexpired credentials of the process
+func (fh *EBPFLessFieldHandlers) ResolveProcessCloudCredentialsTypes(_ *model.Event, _ *model.Process) []string {
+	return nil
+}
+
+// ResolveProcessCloudCredentialsResources resolves the resources of the unexpired access tokens of the process
+func (fh *EBPFLessFieldHandlers) ResolveProcessCloudCredentialsResources(_ *model.Event, _ *model.Process) []string {
 	return nil
 }
 
@@ -0,0 +1,39 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux || windows
+
+// Package probe holds probe related files
+package probe
+
+// HealthReport holds the indicators used to diagnose the health of a probe
+type HealthReport struct {
+	// MissingMaps lists the required kernel maps that couldn't be found
+	MissingMaps []string
+	// KernelEvents is the number of events written by the kernel to the event stream
+	KernelEvents uint64
+	// LostEvents is the number of events the kernel failed to write to the event stream
+	LostEvents uint64
+	// RecommendedEventStreamBufferSize is the event stream buffer size recommended after sustained event loss, 0 if
+	// the current size is enough
+	RecommendedEventStreamBufferSize int
+	// ProcessResolutionHits is the number of processes successfully resolved
+	ProcessResolutionHits int64
+	// ProcessResolutionMisses is the number of processes that couldn't be resolved
+	ProcessResolutionMisses int64
+}
+
+// healthReporter is implemented by the platform probes that can report their health
+type healthReporter interface {
+	GetHealthReport() *HealthReport
+}
+
+// GetHealthReport returns the health report of the platform probe, or nil if the platform probe can't report its health
+func (p *Probe) GetHealthReport() *HealthReport {
+	if hr, ok := p.PlatformProbe.(healthReporter); ok {
+		return hr.GetHealthReport()
+	}
+	return nil
+}
@@ -26,6 +26,11 @@ func NewWindowsModel(_ *WindowsProbe) *model.Model {
 				!strings.HasPrefix(field, "rename.") &&
 				!strings.HasPrefix(field, "set.") &&
 				!strings.HasPrefix(field, "delete.") &&
+				!strings.HasPrefix(field, "delete_key_value.") &&
+				!strings.HasPrefix(field, "create_named_pipe.") &&
+				!strings.HasPrefix(field, "connect_named_pipe.") &&
+				!strings.HasPrefix(field, "create_service.") &&
+				!strings.HasPrefix(field, "modify_service.") &&
 				!strings.HasPrefix(field, "write.") &&
 				!strings.HasPrefix(field, "process.") &&
 				!strings.HasPrefix(field, "change_permission") {
@@ -125,7 +125,7 @@ func newProbe(config *config.Config, opts Opts) *Probe {
 		Opts:            opts,
 		Config:          config,
 		StatsdClient:    opts.StatsdClient,
-		scrubber:        newProcScrubber(config.Probe.CustomSensitiveWords),
+		scrubber:        newProcScrubber(config.Probe.CustomSensitiveWords, config.Probe.Scrubbing),
 		ruleActionStats: make(map[actionStatsTags]*atomic.Int64),
 	}
 }
@@ -405,6 +405,7 @@ func (p *Probe) NewRuleSet(eventTypeEnabled map[eval.EventType]bool) *rules.Rule
 	ruleOpts.WithSupportedDiscarders(SupportedDiscarders)
 	ruleOpts.WithSupportedMultiDiscarder(SupportedMultiDiscarder)
 	ruleOpts.WithRuleActionPerformedCb(p.onRuleActionPerformed)
+	evalOpts.WithIndexesDisabled(!p.Config.RuntimeSecurity.PolicyIndexEnabled)
 
 	eventCtor := func() eval.Event {
 		return p.PlatformProbe.NewEvent()
@@ -413,6 +414,20 @@ func (p *Probe) NewRuleSet(eventTypeEnabled map[eval.EventType]bool) *rules.Rule
 	return rules.NewRuleSet(p.PlatformProbe.NewModel(), eventCtor, ruleOpts, evalOpts)
 }
 
+// coverageReporter is implemented by the platform probes that can report the event types they can't monitor
+type coverageReporter interface {
+	GetInactiveEventTypes(eventTypes []eval.EventType) map[eval.EventType]error
+}
+
+// GetInactiveEventTypes returns, among the provided event types, the ones that can't be monitored on this host along
+// with the reason. It returns nil if the platform probe can't report its coverage.
+func (p *Probe) GetInactiveEventTypes(eventTypes []eval.EventType) map[eval.EventType]error {
+	if cr, ok := p.PlatformProbe.(coverageReporter); ok {
+		return cr.GetInactiveEventTypes(eventTypes)
+	}
+	return nil
+}
+
 // IsNetworkEnabled returns whether network is enabled
 func (p *Probe) IsNetworkEnabled() bool {
 	return p.Config.Probe.NetworkEnabled
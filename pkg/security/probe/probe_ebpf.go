@@ -9,6 +9,7 @@
 package probe
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -36,16 +37,19 @@ import (
 	"github.com/DataDog/datadog-agent/pkg/config/env"
 	ddebpf "github.com/DataDog/datadog-agent/pkg/ebpf"
 	ebpftelemetry "github.com/DataDog/datadog-agent/pkg/ebpf/telemetry"
+	"github.com/DataDog/datadog-agent/pkg/eventmonitor/processtree"
 	"github.com/DataDog/datadog-agent/pkg/security/config"
 	"github.com/DataDog/datadog-agent/pkg/security/ebpf"
 	"github.com/DataDog/datadog-agent/pkg/security/ebpf/kernel"
 	"github.com/DataDog/datadog-agent/pkg/security/ebpf/probes"
 	"github.com/DataDog/datadog-agent/pkg/security/events"
 	"github.com/DataDog/datadog-agent/pkg/security/metrics"
+	"github.com/DataDog/datadog-agent/pkg/security/probe/auditexec"
 	pconfig "github.com/DataDog/datadog-agent/pkg/security/probe/config"
 	"github.com/DataDog/datadog-agent/pkg/security/probe/constantfetch"
 	"github.com/DataDog/datadog-agent/pkg/security/probe/erpc"
 	"github.com/DataDog/datadog-agent/pkg/security/probe/eventstream"
+	"github.com/DataDog/datadog-agent/pkg/security/probe/eventstream/lanes"
 	"github.com/DataDog/datadog-agent/pkg/security/probe/eventstream/reorderer"
 	"github.com/DataDog/datadog-agent/pkg/security/probe/eventstream/ringbuffer"
 	"github.com/DataDog/datadog-agent/pkg/security/probe/kfilters"
@@ -80,6 +84,9 @@ const (
 	// MaxOnDemandEventsPerSecond represents the maximum number of on demand events per second
 	// allowed before we switch off the subsystem
 	MaxOnDemandEventsPerSecond = 1_000
+
+	// envVarsChangesBufferSize is the number of process tree updates buffered before the environment changes are dropped
+	envVarsChangesBufferSize = 100
 )
 
 var (
@@ -155,9 +162,32 @@ type EBPFProbe struct {
 	// hash action
 	fileHasher *FileHasher
 
+	// AWS credentials received from the IMDS, nil when the cross container detection is disabled
+	awsCredentialsTracker *awsCredentialsTracker
+
 	// snapshot
 	ruleSetVersion    uint64
 	playSnapShotState *atomic.Bool
+
+	// priority lanes, nil when disabled
+	priorityLanes *lanes.Lanes
+
+	// reconciliation of the execs with the audit subsystem, nil when disabled
+	auditExecReconciler *auditexec.Reconciler
+
+	// recently discarded inodes, nil when disabled
+	discardedInodes *discardedInodes
+
+	// sampling of the identical exec events, nil when disabled
+	execSampler *execSampler
+
+	// entries inserted by the procfs workers of the process resolver, dispatched by the event handler
+	procfsEntriesLock sync.Mutex
+	procfsEntries     []*model.ProcessCacheEntry
+
+	// events waiting for the procfs workers to resolve their process, handled again once it is resolved
+	deferredEvents          []deferredEvent
+	replayingDeferredEvents bool
 }
 
 // GetProfileManager returns the Profile Managers
@@ -419,9 +449,45 @@ func (p *EBPFProbe) Start() error {
 	// start new tc classifier loop
 	go p.startSetupNewTCClassifierLoop()
 
+	if p.priorityLanes != nil {
+		p.wg.Add(1)
+		go p.priorityLanes.Start(&p.wg)
+	}
+
+	if p.auditExecReconciler != nil {
+		p.wg.Add(1)
+		go p.auditExecReconciler.Start(&p.wg)
+	}
+
+	if p.config.Probe.ProcessResolverEnvsRefreshInterval > 0 {
+		p.wg.Add(1)
+		go p.forwardEnvVarsChanges(p.Resolvers.ProcessResolver.SubscribeProcessTree(envVarsChangesBufferSize))
+	}
+
 	return p.eventStream.Start(&p.wg)
 }
 
+// forwardEnvVarsChanges sends a custom event for each modification of the environment of a running process detected
+// by the process resolver
+func (p *EBPFProbe) forwardEnvVarsChanges(subscription *process.ProcessTreeSubscription) {
+	defer p.wg.Done()
+	defer p.Resolvers.ProcessResolver.UnsubscribeProcessTree(subscription)
+
+	for {
+		select {
+		case <-p.ctx.Done():
+			return
+		case update, ok := <-subscription.Updates():
+			if !ok {
+				return
+			}
+			if update.Type == process.ProcessTreeEnvs {
+				p.probe.DispatchCustomEvent(NewEnvVarsChangedEvent(p.probe.GetAgentContainerContext(), update))
+			}
+		}
+	}
+}
+
 // PlaySnapshot plays a snapshot
 func (p *EBPFProbe) playSnapshot(notifyConsumers bool) {
 	seclog.Debugf("playing the snapshot")
@@ -449,6 +515,111 @@ func (p *EBPFProbe) playSnapshot(notifyConsumers bool) {
 	}
 }
 
+// queueProcfsEntry queues an entry inserted by the procfs workers, so that it is dispatched from the event handler
+func (p *EBPFProbe) queueProcfsEntry(entry *model.ProcessCacheEntry, _ error) {
+	// all Execs will be forwarded since used by AD. Forks will be forwarded all if there are consumers
+	if !entry.IsExec && p.probe.eventConsumers[model.ForkEventType] == nil {
+		return
+	}
+	entry.Retain()
+
+	p.procfsEntriesLock.Lock()
+	p.procfsEntries = append(p.procfsEntries, entry)
+	p.procfsEntriesLock.Unlock()
+}
+
+// dispatchProcfsEntries dispatches the entries inserted by the procfs workers since the last event
+func (p *EBPFProbe) dispatchProcfsEntries() {
+	p.procfsEntriesLock.Lock()
+	entries := p.procfsEntries
+	p.procfsEntries = nil
+	p.procfsEntriesLock.Unlock()
+
+	for _, entry := range entries {
+		p.DispatchEvent(newEBPFEventFromPCE(entry, p.fieldHandlers), true)
+		entry.Release()
+	}
+}
+
+// checkAWSCredentials records the AWS credentials received from the IMDS, and returns the owner of the credentials
+// passed to an exec by a workload other than the one that received them
+func (p *EBPFProbe) checkAWSCredentials(event *model.Event) *awsCredentialsOwner {
+	if p.awsCredentialsTracker == nil {
+		return nil
+	}
+
+	switch event.GetEventType() {
+	case model.IMDSEventType:
+		p.awsCredentialsTracker.observeIMDS(event)
+	case model.ExecEventType:
+		if event.ProcessCacheEntry == nil {
+			return nil
+		}
+		var argv, envp []string
+		if entry := event.ProcessCacheEntry.ArgsEntry; entry != nil {
+			argv = entry.Values
+		}
+		if entry := event.ProcessCacheEntry.EnvsEntry; entry != nil {
+			envp = entry.Values
+		}
+		return p.awsCredentialsTracker.checkUsage(event, argv, envp)
+	}
+	return nil
+}
+
+// maxDeferredEvents is the maximum number of events waiting for the procfs workers to resolve their process
+const maxDeferredEvents = 256
+
+// deferredEvent is an event waiting for the procfs workers to resolve its process
+type deferredEvent struct {
+	cpu  int
+	pid  uint32
+	data []byte
+}
+
+// deferEvent keeps a copy of an event whose process is being resolved by the procfs workers, so that it is handled
+// again with its process context once resolved. The fork and exec events, which update the cache before the
+// resolution of the process context, are never deferred.
+func (p *EBPFProbe) deferEvent(cpu int, data []byte, eventType model.EventType, pid uint32) bool {
+	if p.replayingDeferredEvents || eventType == model.ForkEventType || eventType == model.ExecEventType ||
+		len(p.deferredEvents) >= maxDeferredEvents || !p.Resolvers.ProcessResolver.IsProcfsResolutionPending(pid) {
+		return false
+	}
+
+	p.deferredEvents = append(p.deferredEvents, deferredEvent{
+		cpu:  cpu,
+		pid:  pid,
+		data: bytes.Clone(data),
+	})
+	return true
+}
+
+// replayDeferredEvents handles again the deferred events whose process resolution is completed. An event whose
+// process couldn't be resolved is reported without process context, as it would have been without the workers.
+func (p *EBPFProbe) replayDeferredEvents() {
+	if len(p.deferredEvents) == 0 || p.replayingDeferredEvents {
+		return
+	}
+
+	var ready []deferredEvent
+	pending := p.deferredEvents[:0]
+	for _, e := range p.deferredEvents {
+		if p.Resolvers.ProcessResolver.IsProcfsResolutionPending(e.pid) {
+			pending = append(pending, e)
+		} else {
+			ready = append(ready, e)
+		}
+	}
+	clear(p.deferredEvents[len(pending):])
+	p.deferredEvents = pending
+
+	p.replayingDeferredEvents = true
+	for _, e := range ready {
+		p.handleEvent(e.cpu, e.data)
+	}
+	p.replayingDeferredEvents = false
+}
+
 func (p *EBPFProbe) sendAnomalyDetection(event *model.Event) {
 	tags := p.probe.GetEventTags(string(event.ContainerContext.ContainerID))
 	if service := p.probe.GetService(event); service != "" {
@@ -499,6 +670,9 @@ func (p *EBPFProbe) DispatchEvent(event *model.Event, notifyConsumers bool) {
 		imageTag := utils.GetTagValue("image_tag", event.ContainerContext.Tags)
 		p.profileManagers.securityProfileManager.FillProfileContextFromContainerID(event.FieldHandlers.ResolveContainerID(event, event.ContainerContext), &event.SecurityProfileContext, imageTag)
 		if p.config.RuntimeSecurity.AnomalyDetectionEnabled {
+			if p.profileManagers.activityDumpManager != nil {
+				p.profileManagers.activityDumpManager.HandleAnomaly(event)
+			}
 			p.sendAnomalyDetection(event)
 		}
 	} else if event.Error == nil {
@@ -520,6 +694,30 @@ func (p *EBPFProbe) SendStats() error {
 		return err
 	}
 
+	if p.priorityLanes != nil {
+		if err := p.priorityLanes.SendStats(p.statsdClient); err != nil {
+			return err
+		}
+	}
+
+	if p.auditExecReconciler != nil {
+		if err := p.auditExecReconciler.SendStats(p.statsdClient); err != nil {
+			return err
+		}
+	}
+
+	if p.discardedInodes != nil {
+		if err := p.discardedInodes.SendStats(p.statsdClient); err != nil {
+			return err
+		}
+	}
+
+	if p.execSampler != nil {
+		if err := p.execSampler.SendStats(p.statsdClient); err != nil {
+			return err
+		}
+	}
+
 	return p.monitors.SendStats()
 }
 
@@ -528,6 +726,61 @@ func (p *EBPFProbe) GetMonitors() *EBPFMonitors {
 	return p.monitors
 }
 
+// requiredMaps lists the kernel maps without which events can't be collected or resolved
+var requiredMaps = []string{
+	eventstream.EventStreamMap,
+	"enabled_events",
+	"proc_cache",
+	"pid_cache",
+	"pathnames",
+	"inode_discarders",
+}
+
+// LookupProcess returns the attributes of a process from the process cache, without resolving it from the kernel maps
+// or procfs
+func (p *EBPFProbe) LookupProcess(pid uint32) (process.Info, bool) {
+	return p.Resolvers.ProcessResolver.LookupInfo(pid)
+}
+
+// GetProcessTree returns the tree of the live processes of the process cache
+func (p *EBPFProbe) GetProcessTree() *processtree.Tree {
+	var processes []processtree.Process
+	p.Resolvers.ProcessResolver.Walk(func(entry *model.ProcessCacheEntry) {
+		if !entry.ExitTime.IsZero() {
+			return
+		}
+		processes = append(processes, processtree.Process{
+			Pid:         entry.Pid,
+			PPid:        entry.PPid,
+			Comm:        entry.Comm,
+			ContainerID: string(entry.ContainerID),
+		})
+	})
+	return processtree.Build(processes)
+}
+
+// GetHealthReport returns the health indicators of the probe
+func (p *EBPFProbe) GetHealthReport() *HealthReport {
+	report := &HealthReport{}
+
+	for _, name := range requiredMaps {
+		if _, found, err := p.Manager.GetMap(name); !found || err != nil {
+			report.MissingMaps = append(report.MissingMaps, name)
+		}
+	}
+
+	if p.monitors != nil && p.monitors.eventStreamMonitor != nil {
+		report.KernelEvents, report.LostEvents = p.monitors.eventStreamMonitor.GetKernelEventStats(eventstream.EventStreamMap)
+		report.RecommendedEventStreamBufferSize = p.monitors.eventStreamMonitor.GetRecommendedBufferSize(eventstream.EventStreamMap)
+	}
+
+	if p.Resolvers != nil && p.Resolvers.ProcessResolver != nil {
+		report.ProcessResolutionHits, report.ProcessResolutionMisses = p.Resolvers.ProcessResolver.GetResolutionStats()
+	}
+
+	return report
+}
+
 // EventMarshallerCtor returns the event marshaller ctor
 func (p *EBPFProbe) EventMarshallerCtor(event *model.Event) func() events.EventMarshaler {
 	return func() events.EventMarshaler {
@@ -589,6 +842,12 @@ func (p *EBPFProbe) onEventLost(_ string, perEvent map[string]uint64) {
 
 // setProcessContext set the process context, should return false if the event shouldn't be dispatched
 func (p *EBPFProbe) setProcessContext(eventType model.EventType, event *model.Event, newEntryCb func(entry *model.ProcessCacheEntry, err error)) bool {
+	// the lineages repaired, the environments refreshed and the users and groups resolved in the background are
+	// applied before the resolution of the event
+	p.Resolvers.ProcessResolver.ApplyLineageRepairs()
+	p.Resolvers.ProcessResolver.ApplyEnvsRefreshes()
+	p.Resolvers.ProcessResolver.ApplyUsersGroupsResolutions()
+
 	entry, isResolved := p.fieldHandlers.ResolveProcessCacheEntry(event, newEntryCb)
 	event.ProcessCacheEntry = entry
 	if event.ProcessCacheEntry == nil {
@@ -611,6 +870,7 @@ func (p *EBPFProbe) setProcessContext(eventType model.EventType, event *model.Ev
 		} else if _, err := entry.HasValidLineage(); err != nil {
 			event.Error = &model.ErrProcessBrokenLineage{Err: err}
 			p.Resolvers.ProcessResolver.CountBrokenLineage()
+			p.Resolvers.ProcessResolver.QueueLineageRepair(entry)
 		}
 	}
 
@@ -634,6 +894,9 @@ func (p *EBPFProbe) handleEvent(CPU int, data []byte) {
 		p.playSnapshot(false)
 	}
 
+	p.dispatchProcfsEntries()
+	p.replayDeferredEvents()
+
 	var (
 		offset        = 0
 		event         = p.zeroEvent()
@@ -686,6 +949,7 @@ func (p *EBPFProbe) handleEvent(CPU int, data []byte) {
 
 		// Remove all dentry entries belonging to the mountID
 		p.Resolvers.DentryResolver.DelCacheEntries(event.MountReleased.MountID)
+		p.Resolvers.PathResolver.DelCacheEntries(event.MountReleased.MountID)
 
 		// Delete new mount point from cache
 		if err = p.Resolvers.MountResolver.Delete(event.MountReleased.MountID); err != nil {
@@ -720,21 +984,30 @@ func (p *EBPFProbe) handleEvent(CPU int, data []byte) {
 			return
 		}
 
-		pce := p.Resolvers.ProcessResolver.Resolve(event.CgroupWrite.Pid, event.CgroupWrite.Pid, 0, false, newEntryCb)
+		pce := p.Resolvers.ProcessResolver.Resolve(event.CgroupWrite.Pid, event.CgroupWrite.Pid, 0, "", false, newEntryCb)
 		if pce != nil {
 			path, err := p.Resolvers.DentryResolver.Resolve(event.CgroupWrite.File.PathKey, true)
 			if err == nil && path != "" {
 				path = filepath.Dir(string(path))
 				pce.CGroup.CGroupID = containerutils.CGroupID(path)
 				pce.Process.CGroup.CGroupID = containerutils.CGroupID(path)
+				// the kernel only classifies the leaf of the cgroup, the container and its runtime are derived from
+				// the whole path so that the containers nested in a parent cgroup, such as lxc.payload.<name>, are
+				// detected
 				cgroupFlags := containerutils.CGroupFlags(event.CgroupWrite.CGroupFlags)
-				if cgroupFlags.IsContainer() {
+				if containerID, flags := containerutils.FindContainerID(path); containerutils.CGroupFlags(flags).IsContainer() {
+					cgroupFlags = containerutils.CGroupFlags(flags)
+					pce.ContainerID = containerutils.ContainerID(containerID)
+					pce.Process.ContainerID = containerutils.ContainerID(containerID)
+				} else if cgroupFlags.IsContainer() {
 					containerID, _ := containerutils.GetContainerFromCgroup(path)
 					pce.ContainerID = containerutils.ContainerID(containerID)
 					pce.Process.ContainerID = containerutils.ContainerID(containerID)
 				}
 				pce.CGroup.CGroupFlags = cgroupFlags
 				pce.Process.CGroup = pce.CGroup
+				// the systemd unit is resolved again from the new cgroup
+				pce.SystemdUnit, pce.SystemdSlice = "", ""
 			} else {
 				seclog.Debugf("failed to resolve cgroup file %v", event.CgroupWrite.File)
 			}
@@ -799,6 +1072,10 @@ func (p *EBPFProbe) handleEvent(CPU int, data []byte) {
 			p.Resolvers.ProcessResolver.AddExecEntry(event.ProcessCacheEntry, event.PIDContext.ExecInode)
 		}
 
+		if p.auditExecReconciler != nil {
+			p.auditExecReconciler.ObserveExec(event.ProcessCacheEntry.Pid, event.ProcessCacheEntry.FileEvent.PathnameStr)
+		}
+
 		event.Exec.Process = &event.ProcessCacheEntry.Process
 	}
 
@@ -806,6 +1083,12 @@ func (p *EBPFProbe) handleEvent(CPU int, data []byte) {
 		return
 	}
 
+	// the process of the event is being resolved from /proc by a worker, the event is handled again once resolved
+	// instead of being reported without its process context
+	if errors.Is(event.Error, model.ErrNoProcessContext) && p.deferEvent(CPU, data, eventType, event.PIDContext.Pid) {
+		return
+	}
+
 	switch eventType {
 
 	case model.FileMountEventType:
@@ -860,16 +1143,20 @@ func (p *EBPFProbe) handleEvent(CPU int, data []byte) {
 			seclog.Errorf("failed to decode rmdir event: %s (offset %d, len %d)", err, offset, dataLen)
 			return
 		}
+		// the cached path is kept until the event is dispatched
+		defer p.Resolvers.PathResolver.InvalidatePath(event.Rmdir.File.PathKey, false)
 	case model.FileUnlinkEventType:
 		if _, err = event.Unlink.UnmarshalBinary(data[offset:]); err != nil {
 			seclog.Errorf("failed to decode unlink event: %s (offset %d, len %d)", err, offset, dataLen)
 			return
 		}
+		defer p.Resolvers.PathResolver.InvalidatePath(event.Unlink.File.PathKey, false)
 	case model.FileRenameEventType:
 		if _, err = event.Rename.UnmarshalBinary(data[offset:]); err != nil {
 			seclog.Errorf("failed to decode rename event: %s (offset %d, len %d)", err, offset, dataLen)
 			return
 		}
+		defer p.invalidateRenamedPaths(event)
 	case model.FileChdirEventType:
 		if _, err = event.Chdir.UnmarshalBinary(data[offset:]); err != nil {
 			seclog.Errorf("failed to decode chdir event: %s (offset %d, len %d)", err, offset, dataLen)
@@ -993,7 +1280,7 @@ func (p *EBPFProbe) handleEvent(CPU int, data []byte) {
 		if event.PTrace.PID == 0 { // pid can be 0 for a PTRACE_TRACEME request
 			pce = newPlaceholderProcessCacheEntryPTraceMe()
 		} else {
-			pce = p.Resolvers.ProcessResolver.Resolve(event.PTrace.PID, event.PTrace.PID, 0, false, newEntryCb)
+			pce = p.Resolvers.ProcessResolver.Resolve(event.PTrace.PID, event.PTrace.PID, 0, "", false, newEntryCb)
 			if pce == nil {
 				pce = model.NewPlaceholderProcessCacheEntry(event.PTrace.PID, event.PTrace.PID, false)
 			}
@@ -1038,7 +1325,7 @@ func (p *EBPFProbe) handleEvent(CPU int, data []byte) {
 		// resolve target process context
 		var pce *model.ProcessCacheEntry
 		if event.Signal.PID > 0 { // Linux accepts a kill syscall with both negative and zero pid
-			pce = p.Resolvers.ProcessResolver.Resolve(event.Signal.PID, event.Signal.PID, 0, false, newEntryCb)
+			pce = p.Resolvers.ProcessResolver.Resolve(event.Signal.PID, event.Signal.PID, 0, "", false, newEntryCb)
 		}
 		if pce == nil {
 			pce = model.NewPlaceholderProcessCacheEntry(event.Signal.PID, event.Signal.PID, false)
@@ -1078,6 +1365,7 @@ func (p *EBPFProbe) handleEvent(CPU int, data []byte) {
 
 			return
 		}
+		p.Resolvers.DNSResolver.ResolveQueryStats(event.PIDContext.Pid, &event.DNS)
 	case model.IMDSEventType:
 		if read, err = event.NetworkContext.UnmarshalBinary(data[offset:]); err != nil {
 			seclog.Errorf("failed to decode Network Context")
@@ -1089,7 +1377,7 @@ func (p *EBPFProbe) handleEvent(CPU int, data []byte) {
 			seclog.Debugf("failed to decode IMDS event: %s (offset %d, len %d)", err, offset, len(data))
 			return
 		}
-		defer p.Resolvers.ProcessResolver.UpdateAWSSecurityCredentials(event.PIDContext.Pid, event)
+		defer p.Resolvers.ProcessResolver.UpdateCloudCredentials(event.PIDContext.Pid, event)
 	case model.RawPacketEventType:
 		if _, err = event.RawPacket.UnmarshalBinary(data[offset:]); err != nil {
 			seclog.Errorf("failed to decode RawPacket event: %s (offset %d, len %d)", err, offset, len(data))
@@ -1132,6 +1420,21 @@ func (p *EBPFProbe) handleEvent(CPU int, data []byte) {
 	}
 	relatedEvents = relatedEvents[0:0]
 
+	// the credentials are looked up before the dispatch as the arguments are scrubbed once serialized, and before the
+	// discarders and the exec sampling so that a discarded or sampled out event still records and reports them
+	if awsCredentialsOwner := p.checkAWSCredentials(event); awsCredentialsOwner != nil {
+		p.probe.DispatchCustomEvent(NewAWSCredentialsCrossContainerEvent(p.probe.GetAgentContainerContext(), event, awsCredentialsOwner))
+	}
+
+	if p.discardedInodes != nil && p.isDiscardedInode(event) {
+		return
+	}
+
+	// the process cache is already updated, only the emission of the event is sampled
+	if p.execSampler != nil && eventType == model.ExecEventType && !p.sampleExec(event) {
+		return
+	}
+
 	p.DispatchEvent(event, true)
 
 	if eventType == model.ExitEventType {
@@ -1291,6 +1594,11 @@ func (p *EBPFProbe) isNeededForSecurityProfile(eventType eval.EventType) bool {
 }
 
 func (p *EBPFProbe) validEventTypeForConfig(eventType string) bool {
+	// the default event types are required by the resolvers, the matrix only disables the rules using them
+	if eventType != "*" && !slices.Contains(defaultEventTypes, eventType) && !p.config.RuntimeSecurity.IsEventTypeEnabled(eventType) {
+		return false
+	}
+
 	switch eventType {
 	case "dns":
 		return p.probe.IsNetworkEnabled()
@@ -1302,6 +1610,54 @@ func (p *EBPFProbe) validEventTypeForConfig(eventType string) bool {
 	return true
 }
 
+// GetInactiveEventTypes returns, among the provided event types, the ones none of the probes are attached for
+func (p *EBPFProbe) GetInactiveEventTypes(eventTypes []eval.EventType) map[eval.EventType]error {
+	inactive := make(map[eval.EventType]error)
+
+	selectorsPerEventType := probes.GetSelectorsPerEventType(p.useFentry)
+	for _, eventType := range eventTypes {
+		if !p.validEventTypeForConfig(eventType) {
+			inactive[eventType] = ErrEventTypeDisabled
+			continue
+		}
+
+		// event types without selectors rely on other hook points, like the TC classifiers
+		selectors, exists := selectorsPerEventType[eventType]
+		if !exists {
+			continue
+		}
+
+		var attached, hookPointMissing, known bool
+		for _, selector := range selectors {
+			for _, id := range selector.GetProbesIdentificationPairList() {
+				probe, ok := p.Manager.GetProbe(id)
+				if !ok {
+					continue
+				}
+				known = true
+
+				if probe.IsRunning() {
+					attached = true
+					break
+				}
+				if errors.Is(probe.GetLastError(), manager.ErrKProbeHookPointNotExist) {
+					hookPointMissing = true
+				}
+			}
+		}
+
+		switch {
+		case attached || !known:
+		case hookPointMissing:
+			inactive[eventType] = ErrUnsupportedKernel
+		default:
+			inactive[eventType] = ErrMissingProbes
+		}
+	}
+
+	return inactive
+}
+
 // updateProbes applies the loaded set of rules and returns a report
 // of the applied approvers for it.
 func (p *EBPFProbe) updateProbes(ruleEventTypes []eval.EventType, needRawSyscalls bool) error {
@@ -1468,9 +1824,66 @@ func (p *EBPFProbe) DumpDiscarders() (string, error) {
 
 // FlushDiscarders flush the discarders
 func (p *EBPFProbe) FlushDiscarders() error {
+	if p.discardedInodes != nil {
+		p.discardedInodes.flush()
+	}
 	return bumpDiscardersRevision(p.Erpc)
 }
 
+// invalidateRenamedPaths removes the cached paths changed by a rename, those of all the files of the mount when a
+// directory was renamed
+func (p *EBPFProbe) invalidateRenamedPaths(event *model.Event) {
+	isDir := event.Rename.Old.Mode&unix.S_IFMT == unix.S_IFDIR
+	p.Resolvers.PathResolver.InvalidatePath(event.Rename.Old.PathKey, isDir)
+	p.Resolvers.PathResolver.InvalidatePath(event.Rename.New.PathKey, isDir)
+}
+
+// isDiscardedInode returns whether the event is about an inode discarded while the event was in flight, in which case
+// the event can be dropped without resolving its path
+func (p *EBPFProbe) isDiscardedInode(event *model.Event) bool {
+	// the discarders of the inodes are invalidated in kernel when their path changes
+	switch event.GetEventType() {
+	case model.FileRenameEventType:
+		p.discardedInodes.invalidate(event.Rename.Old.PathKey, event.Rename.Old.Mode&unix.S_IFMT == unix.S_IFDIR)
+		return false
+	case model.FileUnlinkEventType:
+		p.discardedInodes.invalidate(event.Unlink.File.PathKey, false)
+		return false
+	case model.FileRmdirEventType:
+		p.discardedInodes.invalidate(event.Rmdir.File.PathKey, false)
+		return false
+	case model.FileLinkEventType:
+		p.discardedInodes.invalidate(event.Link.Source.PathKey, false)
+		return false
+	}
+
+	// the events saved by the activity dumps or needed by the consumers bypass the discarders
+	if event.IsSavedByActivityDumps() || len(p.probe.eventConsumers[event.GetEventType()]) > 0 {
+		return false
+	}
+
+	return p.discardedInodes.isDiscarded(event)
+}
+
+// sampleExec returns whether the exec event should be emitted
+func (p *EBPFProbe) sampleExec(event *model.Event) bool {
+	if event.ProcessCacheEntry == nil {
+		return true
+	}
+
+	// the events saved by the activity dumps or needed by the consumers bypass the sampling
+	if event.IsSavedByActivityDumps() || len(p.probe.eventConsumers[event.GetEventType()]) > 0 {
+		return true
+	}
+
+	var argv []string
+	if entry := event.ProcessCacheEntry.ArgsEntry; entry != nil {
+		argv = entry.Values
+	}
+
+	return p.execSampler.sample(event.ProcessCacheEntry.ContainerID, event.ProcessCacheEntry.FileEvent.PathnameStr, argv, time.Now())
+}
+
 // RefreshUserCache refreshes the user cache
 func (p *EBPFProbe) RefreshUserCache(containerID string) error {
 	return p.Resolvers.UserGroupResolver.RefreshCache(containerID)
@@ -1603,6 +2016,7 @@ func (p *EBPFProbe) handleNewMount(ev *model.Event, m *model.Mount) error {
 	// Our dentry resolution of the exec event causes the inode/mount_id to be put in cache,
 	// so we remove all dentry entries belonging to the mountID.
 	p.Resolvers.DentryResolver.DelCacheEntries(m.MountID)
+	p.Resolvers.PathResolver.DelCacheEntries(m.MountID)
 
 	// Resolve mount point
 	if err := p.Resolvers.PathResolver.SetMountPoint(ev, m); err != nil {
@@ -1707,6 +2121,11 @@ func (p *EBPFProbe) ApplyRuleSet(rs *rules.RuleSet) (*kfilters.ApplyRuleSetRepor
 		p.onDemandManager.setHookPoints(rs.GetOnDemandHookPoints())
 	}
 
+	// the policies can extend the environment variables whose value is kept, the scrubbing still applies
+	p.Resolvers.ProcessResolver.SetPolicyEnvsWithValue(p.config.Probe.Scrubbing.FilterEnvsWithValue(rs.GetEnvsWithValue()))
+	// and the scrubbing of the arguments of the processes with per executable rules
+	p.Resolvers.ProcessResolver.SetPolicyArgsScrubbing(rs.GetArgsScrubbing())
+
 	if err := p.updateProbes(eventTypes, needRawSyscalls); err != nil {
 		return nil, fmt.Errorf("failed to select probes: %w", err)
 	}
@@ -1747,7 +2166,7 @@ func (p *EBPFProbe) GetFieldHandlers() model.FieldHandlers {
 
 // DumpProcessCache dumps the process cache
 func (p *EBPFProbe) DumpProcessCache(withArgs bool) (string, error) {
-	return p.Resolvers.ProcessResolver.ToDot(withArgs)
+	return p.Resolvers.ProcessResolver.ToDot("", withArgs)
 }
 
 // EnableEnforcement sets the enforcement mode
@@ -1828,6 +2247,10 @@ func NewEBPFProbe(probe *Probe, config *config.Config, opts Opts, telemetry tele
 		return nil, fmt.Errorf("failed to parse CPU count: %w", err)
 	}
 
+	if config.Probe.EventStreamBufferSize == 0 {
+		config.Probe.EventStreamBufferSize = probes.ComputeEventsBufferSize(numCPU, config.Probe.EventStreamExpectedEventRate, useRingBuffers)
+	}
+
 	p.managerOptions.MapSpecEditors = probes.AllMapSpecEditors(numCPU, probes.MapSpecEditorOpts{
 		TracedCgroupSize:        config.RuntimeSecurity.ActivityDumpTracedCgroupsCount,
 		UseRingBuffers:          useRingBuffers,
@@ -2022,9 +2445,43 @@ func NewEBPFProbe(probe *Probe, config *config.Config, opts Opts, telemetry tele
 	if err != nil {
 		return nil, err
 	}
+	p.Resolvers.ProcessResolver.SetProcfsCallback(p.queueProcfsEntry)
+	if config.Probe.ProcessResolverMemoryBudget > 0 {
+		p.Resolvers.ProcessResolver.SetMemoryBudgetExceededHandler(func(report process.MemoryBudgetExceeded) {
+			p.probe.DispatchCustomEvent(NewProcessCacheMemoryBudgetEvent(p.probe.GetAgentContainerContext(), report))
+		})
+	}
 
 	p.fileHasher = NewFileHasher(config, p.Resolvers.HashResolver)
 
+	if config.RuntimeSecurity.IMDSCrossContainerCredentialsEnabled {
+		if p.awsCredentialsTracker, err = newAWSCredentialsTracker(); err != nil {
+			return nil, err
+		}
+	}
+
+	if config.Probe.EnableDiscarders && config.Probe.DiscardedInodesCacheSize > 0 {
+		p.discardedInodes = newDiscardedInodes(config.Probe.DiscardedInodesCacheSize)
+		p.AddDiscarderPushedCallback(func(_ string, event *model.Event, _ string) {
+			p.discardedInodes.add(event)
+		})
+	}
+
+	if config.Probe.ExecSamplingEnabled {
+		if config.Probe.ExecSamplingRate > 1 && config.Probe.ExecSamplingWindow > 0 {
+			p.execSampler = newExecSampler(config.Probe.ExecSamplingRate, config.Probe.ExecSamplingWindow)
+		} else {
+			seclog.Warnf("invalid exec sampling rate %d or window %s, exec sampling disabled", config.Probe.ExecSamplingRate, config.Probe.ExecSamplingWindow)
+		}
+	}
+
+	if config.Probe.ProcessResolverAuditEnabled {
+		// the reconciliation is optional, the probe works without it
+		if p.auditExecReconciler, err = auditexec.New(p.ctx, config.Probe.ProcessResolverAuditWindow); err != nil {
+			seclog.Errorf("failed to start the reconciliation of the execs with the audit subsystem: %s", err)
+		}
+	}
+
 	hostname, err := utils.GetHostname()
 	if err != nil || hostname == "" {
 		hostname = "unknown"
@@ -2043,13 +2500,24 @@ func NewEBPFProbe(probe *Probe, config *config.Config, opts Opts, telemetry tele
 	}
 	p.fieldHandlers = fh
 
+	handleEvent := p.handleEvent
+	if config.Probe.EventStreamPriorityLanes {
+		dropPolicy, err := lanes.ParseEventTypes(config.Probe.EventStreamDropPolicy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid drop policy: %w", err)
+		}
+
+		p.priorityLanes = lanes.New(p.ctx, p.handleEvent, lanes.DefaultHighPriorityEventTypes, dropPolicy, config.Probe.EventStreamPriorityLanesQueueSize)
+		handleEvent = p.priorityLanes.HandleEvent
+	}
+
 	if useRingBuffers {
-		p.eventStream = ringbuffer.New(p.handleEvent)
+		p.eventStream = ringbuffer.New(handleEvent)
 		p.managerOptions.SkipRingbufferReaderStartup = map[string]bool{
 			eventstream.EventStreamMap: true,
 		}
 	} else {
-		p.eventStream, err = reorderer.NewOrderedPerfMap(p.ctx, p.handleEvent, probe.StatsdClient)
+		p.eventStream, err = reorderer.NewOrderedPerfMap(p.ctx, handleEvent, probe.StatsdClient)
 		if err != nil {
 			return nil, err
 		}
@@ -2228,6 +2696,7 @@ func AppendProbeRequestsToFetcher(constantFetcher constantfetch.ConstantFetcher,
 	constantFetcher.AppendOffsetofRequest(constantfetch.OffsetNameLinuxBinprmArgc, "struct linux_binprm", "argc", "linux/binfmts.h")
 	constantFetcher.AppendOffsetofRequest(constantfetch.OffsetNameLinuxBinprmEnvc, "struct linux_binprm", "envc", "linux/binfmts.h")
 	constantFetcher.AppendOffsetofRequest(constantfetch.OffsetNameVMAreaStructFlags, "struct vm_area_struct", "vm_flags", "linux/mm_types.h")
+	constantFetcher.AppendOffsetofRequest(constantfetch.OffsetNameVMAreaStructFile, "struct vm_area_struct", "vm_file", "linux/mm_types.h")
 	constantFetcher.AppendOffsetofRequest(constantfetch.OffsetNameFileFinode, "struct file", "f_inode", "linux/fs.h")
 	constantFetcher.AppendOffsetofRequest(constantfetch.OffsetNameFileFpath, "struct file", "f_path", "linux/fs.h")
 	constantFetcher.AppendOffsetofRequest(constantfetch.OffsetNameMountMntID, "struct mount", "mnt_id", "")
@@ -2279,6 +2748,26 @@ func AppendProbeRequestsToFetcher(constantFetcher constantfetch.ConstantFetcher,
 		constantFetcher.AppendOffsetofRequest(constantfetch.OffsetNameTaskStructPID, "struct task_struct", "thread_pid", "linux/sched.h")
 	}
 
+	// process identity offsets, the field was renamed in 5.5
+	if kv.Code >= kernel.Kernel5_5 {
+		constantFetcher.AppendOffsetofRequest(constantfetch.OffsetNameTaskStructStartBoottime, "struct task_struct", "start_boottime", "linux/sched.h")
+	} else {
+		constantFetcher.AppendOffsetofRequest(constantfetch.OffsetNameTaskStructStartBoottime, "struct task_struct", "real_start_time", "linux/sched.h")
+	}
+
+	// process namespaces offsets, the mount namespace is defined in a private header
+	constantFetcher.AppendOffsetofRequest(constantfetch.OffsetNameTaskStructNsproxy, "struct task_struct", "nsproxy", "linux/sched.h")
+	constantFetcher.AppendOffsetofRequest(constantfetch.OffsetNameTaskStructCred, "struct task_struct", "cred", "linux/sched.h")
+	constantFetcher.AppendOffsetofRequest(constantfetch.OffsetNameCredStructUserNS, "struct cred", "user_ns", "linux/cred.h")
+	constantFetcher.AppendOffsetofRequest(constantfetch.OffsetNameNsproxyStructUTSNS, "struct nsproxy", "uts_ns", "linux/nsproxy.h")
+	constantFetcher.AppendOffsetofRequest(constantfetch.OffsetNameNsproxyStructMntNS, "struct nsproxy", "mnt_ns", "linux/nsproxy.h")
+	constantFetcher.AppendOffsetofRequest(constantfetch.OffsetNameUPIDStructNS, "struct upid", "ns", "linux/pid.h")
+	constantFetcher.AppendOffsetofRequest(constantfetch.OffsetNamePIDNamespaceStructNS, "struct pid_namespace", "ns", "linux/pid_namespace.h")
+	constantFetcher.AppendOffsetofRequest(constantfetch.OffsetNameUserNamespaceStructNS, "struct user_namespace", "ns", "linux/user_namespace.h")
+	constantFetcher.AppendOffsetofRequest(constantfetch.OffsetNameMntNamespaceStructNS, "struct mnt_namespace", "ns", "")
+	constantFetcher.AppendOffsetofRequest(constantfetch.OffsetNameUTSNamespaceStructNS, "struct uts_namespace", "ns", "linux/utsname.h")
+	constantFetcher.AppendOffsetofRequest(constantfetch.OffsetNameNSCommonStructInum, "struct ns_common", "inum", "linux/ns_common.h")
+
 	// splice event
 	constantFetcher.AppendOffsetofRequest(constantfetch.OffsetNamePipeInodeInfoStructBufs, "struct pipe_inode_info", "bufs", "linux/pipe_fs_i.h")
 	if kv.HaveLegacyPipeInodeInfoStruct() {
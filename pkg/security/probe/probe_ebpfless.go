@@ -18,6 +18,7 @@ import (
 	"path/filepath"
 	"strings"
 	"sync"
+	"syscall"
 	"time"
 
 	"github.com/vmihailenco/msgpack/v5"
@@ -251,6 +252,8 @@ func (p *EBPFLessProbe) handleSyscallMsg(cl *client, syscallMsg *ebpfless.Syscal
 		event.Chmod.Retval = syscallMsg.Retval
 		event.Chmod.Mode = syscallMsg.Chmod.Mode
 		copyFileAttributes(&syscallMsg.Chmod.File, &event.Chmod.File)
+		// the file metadata are collected at the syscall entry, before the change is applied
+		event.Chmod.PreviousMode = uint32(event.Chmod.File.Mode) &^ syscall.S_IFMT
 
 	case ebpfless.SyscallTypeChown:
 		event.Type = uint32(model.FileChownEventType)
@@ -260,6 +263,8 @@ func (p *EBPFLessProbe) handleSyscallMsg(cl *client, syscallMsg *ebpfless.Syscal
 		event.Chown.GID = int64(syscallMsg.Chown.GID)
 		event.Chown.Group = syscallMsg.Chown.Group
 		copyFileAttributes(&syscallMsg.Chown.File, &event.Chown.File)
+		event.Chown.PreviousUID = int64(event.Chown.File.UID)
+		event.Chown.PreviousGID = int64(event.Chown.File.GID)
 
 	case ebpfless.SyscallTypeUnloadModule:
 		event.Type = uint32(model.UnloadModuleEventType)
@@ -581,7 +586,9 @@ func (p *EBPFLessProbe) FlushDiscarders() error {
 }
 
 // ApplyRuleSet applies the new ruleset
-func (p *EBPFLessProbe) ApplyRuleSet(_ *rules.RuleSet) (*kfilters.ApplyRuleSetReport, error) {
+func (p *EBPFLessProbe) ApplyRuleSet(rs *rules.RuleSet) (*kfilters.ApplyRuleSetReport, error) {
+	p.Resolvers.ProcessResolver.SetPolicyEnvsWithValue(p.config.Probe.Scrubbing.FilterEnvsWithValue(rs.GetEnvsWithValue()))
+	p.Resolvers.ProcessResolver.SetPolicyArgsScrubbing(rs.GetArgsScrubbing())
 	return &kfilters.ApplyRuleSetReport{}, nil
 }
 
@@ -136,7 +136,7 @@ The Parameters.Create.FileAttributes and Parameters.Create.EaLength members are
 	by file systems and file system filter drivers. For more information, see the IRP_MJ_CREATE topic in
 	the Installable File System (IFS) documentation.
 */
-func (wp *WindowsProbe) parseCreateHandleArgs(e *etw.DDEventRecord) (*createHandleArgs, error) {
+func parseCreateHandleFields(e *etw.DDEventRecord) (*createHandleArgs, error) {
 	ca := &createHandleArgs{
 		DDEventHeader: e.EventHeader,
 	}
@@ -163,7 +163,19 @@ func (wp *WindowsProbe) parseCreateHandleArgs(e *etw.DDEventRecord) (*createHand
 	} else {
 		return nil, fmt.Errorf("unknown version %v", e.EventHeader.EventDescriptor.Version)
 	}
+	return ca, nil
+}
+
+func (wp *WindowsProbe) parseCreateHandleArgs(e *etw.DDEventRecord) (*createHandleArgs, error) {
+	ca, err := parseCreateHandleFields(e)
+	if err != nil {
+		return nil, err
+	}
+	return wp.filterCreateHandleArgs(ca)
+}
 
+// filterCreateHandleArgs applies the FIM approvers and discarders to a create handle notification
+func (wp *WindowsProbe) filterCreateHandleArgs(ca *createHandleArgs) (*createHandleArgs, error) {
 	// not amazing to double compute the basename..
 	basename := filepath.Base(ca.fileName)
 
@@ -0,0 +1,89 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build windows
+
+// Package probe holds probe related files
+package probe
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
+)
+
+const (
+	// namedPipeDevicePrefix is the device path under which the kernel reports named pipes
+	namedPipeDevicePrefix = `\device\namedpipe\`
+	// namedPipeUserPrefix is the path used by user space to reference named pipes
+	namedPipeUserPrefix = `\\.\pipe\`
+)
+
+// namedPipeArgs holds the information of a create handle notification targeting a named pipe
+type namedPipeArgs struct {
+	*createHandleArgs
+	pipeName  string
+	create    bool
+	serverPID uint32
+}
+
+// namedPipeName returns the name of the named pipe referenced by the given kernel path
+func namedPipeName(fileName string) (string, bool) {
+	if len(fileName) <= len(namedPipeDevicePrefix) || !strings.EqualFold(fileName[:len(namedPipeDevicePrefix)], namedPipeDevicePrefix) {
+		return "", false
+	}
+	return fileName[len(namedPipeDevicePrefix):], true
+}
+
+func (wp *WindowsProbe) isNamedPipeEventEnabled() bool {
+	wp.enabledEventTypesLock.RLock()
+	defer wp.enabledEventTypesLock.RUnlock()
+
+	return wp.enabledEventTypes[model.CreateNamedPipeEventType.String()] || wp.enabledEventTypes[model.ConnectNamedPipeEventType.String()]
+}
+
+// parseNamedPipeArgs returns the named pipe arguments of a create handle notification, or nil
+// if the notification doesn't target a named pipe.
+// The server side creates the pipe instances while the clients open the existing ones, the
+// disposition of the request is then used to tell both apart.
+func (wp *WindowsProbe) parseNamedPipeArgs(ca *createHandleArgs) *namedPipeArgs {
+	pipeName, ok := namedPipeName(ca.fileName)
+	if !ok || !wp.isNamedPipeEventEnabled() {
+		return nil
+	}
+
+	npa := &namedPipeArgs{
+		createHandleArgs: ca,
+		pipeName:         pipeName,
+		create:           (ca.createOptions >> 24) != kernelDisposition_FILE_OPEN,
+	}
+
+	// pipe names are case insensitive
+	key := strings.ToLower(pipeName)
+	if npa.create {
+		// lru is thread safe, has its own locking
+		wp.namedPipeServers.Add(key, ca.ProcessID)
+	} else if pid, found := wp.namedPipeServers.Get(key); found {
+		npa.serverPID = pid
+	}
+
+	return npa
+}
+
+// nolint: unused
+func (npa *namedPipeArgs) String() string {
+	var output strings.Builder
+
+	if npa.create {
+		output.WriteString("CREATE_NAMED_PIPE PID: " + strconv.Itoa(int(npa.ProcessID)) + "\n")
+	} else {
+		output.WriteString("CONNECT_NAMED_PIPE PID: " + strconv.Itoa(int(npa.ProcessID)) + "\n")
+		output.WriteString("         Server: " + strconv.Itoa(int(npa.serverPID)) + "\n")
+	}
+	output.WriteString("         Name: " + npa.pipeName + "\n")
+
+	return output.String()
+}
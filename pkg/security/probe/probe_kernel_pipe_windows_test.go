@@ -0,0 +1,37 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build windows
+
+// Package probe holds probe related files
+package probe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNamedPipeName(t *testing.T) {
+	tests := []struct {
+		fileName string
+		expected string
+		isPipe   bool
+	}{
+		{`\Device\NamedPipe\lsarpc`, "lsarpc", true},
+		{`\device\namedpipe\msagent_1234`, "msagent_1234", true},
+		{`\Device\NamedPipe\`, "", false},
+		{`\Device\HarddiskVolume1\Windows\NamedPipe\foo`, "", false},
+		{`\Device\Mailslot\foo`, "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fileName, func(t *testing.T) {
+			name, ok := namedPipeName(tt.fileName)
+			assert.Equal(t, tt.isPipe, ok)
+			assert.Equal(t, tt.expected, name)
+		})
+	}
+}
@@ -0,0 +1,56 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build windows
+
+// Package probe holds probe related files
+package probe
+
+import (
+	"encoding/binary"
+	"testing"
+	"unicode/utf16"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func encodeUTF16(s string) []byte {
+	u16 := utf16.Encode([]rune(s))
+	b := make([]byte, len(u16)*2)
+	for i, c := range u16 {
+		binary.LittleEndian.PutUint16(b[i*2:], c)
+	}
+	return b
+}
+
+func TestRegistryValueDataString(t *testing.T) {
+	dword := make([]byte, 4)
+	binary.LittleEndian.PutUint32(dword, 2)
+
+	qword := make([]byte, 8)
+	binary.LittleEndian.PutUint64(qword, 1<<40)
+
+	tests := []struct {
+		name     string
+		dataType uint32
+		data     []byte
+		expected string
+	}{
+		{"sz", regSZ, encodeUTF16("C:\\Windows\\System32\\evil.exe\x00"), "C:\\Windows\\System32\\evil.exe"},
+		{"expand_sz", regExpandSZ, encodeUTF16("%SystemRoot%\\evil.exe\x00"), "%SystemRoot%\\evil.exe"},
+		{"truncated_sz", regSZ, encodeUTF16("powershell -enc"), "powershell -enc"},
+		{"multi_sz", regMultiSZ, encodeUTF16("a\x00b\x00\x00"), "a;b"},
+		{"dword", regDWORD, dword, "2"},
+		{"short_dword", regDWORD, dword[:2], ""},
+		{"qword", regQWORD, qword, "1099511627776"},
+		{"binary", 3, []byte{0xde, 0xad}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, registryValueDataString(tt.dataType, tt.data))
+		})
+	}
+}
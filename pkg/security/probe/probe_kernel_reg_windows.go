@@ -7,8 +7,10 @@
 package probe
 
 import (
+	"encoding/binary"
 	"strconv"
 	"strings"
+	"unicode/utf16"
 
 	"github.com/DataDog/datadog-agent/comp/etw"
 	etwimpl "github.com/DataDog/datadog-agent/comp/etw/impl"
@@ -19,6 +21,7 @@ const (
 	idRegOpenKey       = uint16(2)  // CraeteKeyArgs
 	idRegDeleteKey     = uint16(3)  // DeleteKeyArgs
 	idRegSetValueKey   = uint16(5)  // setValueKeyArgs
+	idRegDeleteValue   = uint16(6)  // deleteValueKeyArgs
 	idRegFlushKey      = uint16(12) // deleteKeyArgs
 	idRegCloseKey      = uint16(13) // deleteKeyArgs
 	idQuerySecurityKey = uint16(14) // deleteKeyArgs
@@ -26,6 +29,16 @@ const (
 
 )
 
+// registry value types, see https://learn.microsoft.com/en-us/windows/win32/sysinfo/registry-value-types
+const (
+	regSZ             = uint32(1)
+	regExpandSZ       = uint32(2)
+	regDWORD          = uint32(4)
+	regDWORDBigEndian = uint32(5)
+	regMultiSZ        = uint32(7)
+	regQWORD          = uint32(11)
+)
+
 type regObjectPointer uint64
 
 var (
@@ -106,9 +119,29 @@ type setValueKeyArgs struct {
 	previousDataSize         uint32
 	capturedPreviousDataSize uint16 //nolint:golint,unused
 	previousData             []byte
+	valueData                string
 	computedFullPath         string
 }
 
+/*
+<template tid="task_0DeleteValueKeyArgs">
+
+	<data name="KeyObject" inType="win:Pointer"/>
+	<data name="Status" inType="win:UInt32"/>
+	<data name="KeyName" inType="win:UnicodeString"/>
+	<data name="ValueName" inType="win:UnicodeString"/>
+
+</template>
+*/
+type deleteValueKeyArgs struct {
+	etw.DDEventHeader
+	keyObject        regObjectPointer
+	status           uint32
+	keyName          string
+	valueName        string
+	computedFullPath string
+}
+
 func (wp *WindowsProbe) parseCreateRegistryKey(e *etw.DDEventRecord) (*createKeyArgs, error) {
 
 	crc := &createKeyArgs{
@@ -337,6 +370,7 @@ func (wp *WindowsProbe) parseSetValueKey(e *etw.DDEventRecord) (*setValueKeyArgs
 	nextOffset += 4
 
 	sv.previousData = data.Bytes(nextOffset, int(sv.previousDataSize))
+	sv.valueData = registryValueDataString(sv.dataType, sv.capturedData)
 
 	if s, ok := wp.regPathResolver.Get(sv.keyObject); ok {
 		sv.computedFullPath = s
@@ -359,3 +393,86 @@ func (sv *setValueKeyArgs) String() string {
 	return output.String()
 
 }
+
+func (wp *WindowsProbe) parseDeleteValueKey(e *etw.DDEventRecord) (*deleteValueKeyArgs, error) {
+
+	dv := &deleteValueKeyArgs{
+		DDEventHeader: e.EventHeader,
+	}
+
+	data := etwimpl.GetUserData(e)
+
+	dv.keyObject = regObjectPointer(data.GetUint64(0))
+	dv.status = data.GetUint32(8)
+	var nextOffset int
+	dv.keyName, nextOffset, _, _ = data.ParseUnicodeString(12)
+	if nextOffset == -1 {
+		nextOffset = 14
+	}
+	dv.valueName, _, _, _ = data.ParseUnicodeString(nextOffset)
+
+	if s, ok := wp.regPathResolver.Get(dv.keyObject); ok {
+		dv.computedFullPath = s
+	}
+
+	return dv, nil
+}
+
+func (dv *deleteValueKeyArgs) String() string {
+	var output strings.Builder
+
+	output.WriteString("  PID: " + strconv.Itoa(int(dv.ProcessID)) + "\n")
+	output.WriteString("  Status: " + strconv.Itoa(int(dv.status)) + "\n")
+	output.WriteString("  keyObject: " + strconv.FormatUint(uint64(dv.keyObject), 16) + "\n")
+	output.WriteString("  keyName: " + dv.keyName + "\n")
+	output.WriteString("  valueName: " + dv.valueName + "\n")
+	output.WriteString("  computed path: " + dv.computedFullPath + "\n")
+
+	return output.String()
+}
+
+// registryValueDataString returns a string representation of the captured data of a registry value. Only
+// string and integer values are decoded, binary values are ignored. Note that ETW only captures the first
+// bytes of the data, so long values may be truncated.
+func registryValueDataString(dataType uint32, data []byte) string {
+	switch dataType {
+	case regSZ, regExpandSZ:
+		return decodeUTF16String(data)
+	case regMultiSZ:
+		var values []string
+		for _, value := range strings.Split(decodeUTF16(data), "\x00") {
+			if value != "" {
+				values = append(values, value)
+			}
+		}
+		return strings.Join(values, ";")
+	case regDWORD:
+		if len(data) >= 4 {
+			return strconv.FormatUint(uint64(binary.LittleEndian.Uint32(data)), 10)
+		}
+	case regDWORDBigEndian:
+		if len(data) >= 4 {
+			return strconv.FormatUint(uint64(binary.BigEndian.Uint32(data)), 10)
+		}
+	case regQWORD:
+		if len(data) >= 8 {
+			return strconv.FormatUint(binary.LittleEndian.Uint64(data), 10)
+		}
+	}
+	return ""
+}
+
+// decodeUTF16 decodes a little endian UTF-16 buffer, including any embedded null character
+func decodeUTF16(data []byte) string {
+	u16 := make([]uint16, len(data)/2)
+	for i := range u16 {
+		u16[i] = binary.LittleEndian.Uint16(data[i*2:])
+	}
+	return string(utf16.Decode(u16))
+}
+
+// decodeUTF16String decodes a null terminated little endian UTF-16 string
+func decodeUTF16String(data []byte) string {
+	value, _, _ := strings.Cut(decodeUTF16(data), "\x00")
+	return value
+}
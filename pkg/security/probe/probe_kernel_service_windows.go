@@ -0,0 +1,234 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build windows
+
+// Package probe holds probe related files
+package probe
+
+import (
+	"encoding/binary"
+	"regexp"
+	"strings"
+
+	"github.com/DataDog/datadog-agent/comp/etw"
+	etwimpl "github.com/DataDog/datadog-agent/comp/etw/impl"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
+)
+
+const (
+	serviceImagePathValue = "imagepath"
+	serviceStartValue     = "start"
+
+	serviceControlManagerName = "services.exe"
+)
+
+// Microsoft-Windows-RPC event IDs
+const (
+	idRPCClientCallStart = uint16(5)
+	idRPCServerCallStart = uint16(6)
+	idRPCServerCallStop  = uint16(8)
+)
+
+// svcctlInterfaceGUID is the RPC interface of the service control manager, {367abb81-9844-35f1-ad32-98f038001003}
+var svcctlInterfaceGUID = etw.DDGUID{
+	Data1: 0x367abb81,
+	Data2: 0x9844,
+	Data3: 0x35f1,
+	Data4: [8]uint8{0xad, 0x32, 0x98, 0xf0, 0x38, 0x00, 0x10, 0x03},
+}
+
+// scmCall holds an RPC call to the service control manager, served by one of its threads
+type scmCall struct {
+	serverPID uint32
+	clientPID uint32
+}
+
+// the service control manager stores the configuration of each service under its own registry key
+var serviceKeyRegexp = regexp.MustCompile(`(?i)^HKEY_LOCAL_MACHINE\\SYSTEM\\(?:CurrentControlSet|ControlSet\d{3})\\Services\\([^\\]+)$`)
+
+// serviceInfo holds the last known configuration of a service
+type serviceInfo struct {
+	imagePath string
+	startType string
+}
+
+// serviceArgs holds the information of a set value notification targeting the configuration of a service
+type serviceArgs struct {
+	*setValueKeyArgs
+	serviceName       string
+	create            bool
+	imagePath         string
+	previousImagePath string
+	startType         string
+	previousStartType string
+}
+
+// serviceStartTypeString returns the name of a service start type, as stored in the Start value of the service key
+func serviceStartTypeString(dataType uint32, data []byte) string {
+	if dataType != regDWORD || len(data) < 4 {
+		return ""
+	}
+
+	switch binary.LittleEndian.Uint32(data) {
+	case 0:
+		return "boot"
+	case 1:
+		return "system"
+	case 2:
+		return "automatic"
+	case 3:
+		return "manual"
+	case 4:
+		return "disabled"
+	}
+	return ""
+}
+
+// serviceName returns the name of the service configured by the given registry key
+func serviceName(keyPath string) (string, bool) {
+	matches := serviceKeyRegexp.FindStringSubmatch(translateRegistryBasePath(keyPath))
+	if matches == nil {
+		return "", false
+	}
+	return matches[1], true
+}
+
+func (wp *WindowsProbe) isServiceEventEnabled() bool {
+	wp.enabledEventTypesLock.RLock()
+	defer wp.enabledEventTypesLock.RUnlock()
+
+	return wp.enabledEventTypes[model.CreateServiceEventType.String()] || wp.enabledEventTypes[model.ModifyServiceEventType.String()]
+}
+
+// rpcInterfaceGUID returns the interface of an RPC call start notification
+func rpcInterfaceGUID(e *etw.DDEventRecord) (etw.DDGUID, bool) {
+	data := etwimpl.GetUserData(e)
+	if data.Length() < 16 {
+		return etw.DDGUID{}, false
+	}
+
+	guid := etw.DDGUID{
+		Data1: data.GetUint32(0),
+		Data2: data.GetUint16(4),
+		Data3: data.GetUint16(6),
+	}
+	copy(guid.Data4[:], data.Bytes(8, 8))
+	return guid, true
+}
+
+// handleRPCNotification tracks the RPC calls to the service control manager. The RPC runtime propagates the activity
+// ID of a call from its client to its server, so the client pid, known from the header of the client call start
+// notification, is bound to the server thread when it starts serving the call.
+func (wp *WindowsProbe) handleRPCNotification(e *etw.DDEventRecord) {
+	switch e.EventHeader.EventDescriptor.ID {
+	case idRPCClientCallStart:
+		if guid, ok := rpcInterfaceGUID(e); ok && guid == svcctlInterfaceGUID {
+			wp.scmClients.Add(e.EventHeader.ActivityID, e.EventHeader.ProcessID)
+		}
+	case idRPCServerCallStart:
+		if guid, ok := rpcInterfaceGUID(e); ok && guid == svcctlInterfaceGUID {
+			if clientPID, ok := wp.scmClients.Get(e.EventHeader.ActivityID); ok {
+				wp.scmCalls.Add(e.EventHeader.ThreadID, scmCall{
+					serverPID: e.EventHeader.ProcessID,
+					clientPID: clientPID,
+				})
+			}
+		}
+	case idRPCServerCallStop:
+		wp.scmCalls.Remove(e.EventHeader.ThreadID)
+		wp.scmClients.Remove(e.EventHeader.ActivityID)
+	}
+}
+
+// serviceCaller returns the pid of the process changing the configuration of a service. The changes made through the
+// service control manager are written by the thread serving the RPC call, and are attributed to the client of the call.
+func (wp *WindowsProbe) serviceCaller(header *etw.DDEventHeader) uint32 {
+	if call, ok := wp.scmCalls.Get(header.ThreadID); ok && call.serverPID == header.ProcessID {
+		return call.clientPID
+	}
+	return header.ProcessID
+}
+
+// isServiceControlManager returns whether the given process is the service control manager
+func (wp *WindowsProbe) isServiceControlManager(pid uint32) bool {
+	entry := wp.Resolvers.ProcessResolver.Resolve(pid)
+	return entry != nil && strings.EqualFold(entry.FileEvent.BasenameStr, serviceControlManagerName)
+}
+
+// parseServiceArgs returns the service arguments of a set value notification, or nil if the notification
+// doesn't change the image path or the start type of a service.
+// The service control manager writes the start type of a new service before its image path, so a service
+// is reported as created when its image path is set for the first time.
+func (wp *WindowsProbe) parseServiceArgs(svk *setValueKeyArgs) *serviceArgs {
+	valueName := strings.ToLower(svk.valueName)
+	if valueName != serviceImagePathValue && valueName != serviceStartValue {
+		return nil
+	}
+
+	name, ok := serviceName(svk.computedFullPath)
+	if !ok || !wp.isServiceEventEnabled() {
+		return nil
+	}
+
+	// service names are case insensitive
+	key := strings.ToLower(name)
+	info, _ := wp.serviceInfos.Get(key)
+
+	sa := &serviceArgs{
+		setValueKeyArgs: svk,
+		serviceName:     name,
+	}
+
+	switch valueName {
+	case serviceImagePathValue:
+		sa.imagePath = svk.valueData
+		sa.startType = info.startType
+		if svk.previousDataSize == 0 {
+			sa.create = true
+		} else {
+			sa.previousImagePath = registryValueDataString(svk.previousDataType, svk.previousData)
+			sa.previousStartType = info.startType
+		}
+		info.imagePath = sa.imagePath
+	case serviceStartValue:
+		sa.startType = serviceStartTypeString(svk.dataType, svk.capturedData)
+		info.startType = sa.startType
+
+		// the start type of a new service is reported along with its creation
+		if svk.previousDataSize == 0 {
+			wp.serviceInfos.Add(key, info)
+			return nil
+		}
+
+		sa.previousStartType = serviceStartTypeString(svk.previousDataType, svk.previousData)
+		if sa.startType == sa.previousStartType {
+			return nil
+		}
+		sa.imagePath = info.imagePath
+		sa.previousImagePath = info.imagePath
+	}
+
+	// lru is thread safe, has its own locking
+	wp.serviceInfos.Add(key, info)
+
+	return sa
+}
+
+func (sa *serviceArgs) String() string {
+	var output strings.Builder
+
+	if sa.create {
+		output.WriteString("CREATE_SERVICE ")
+	} else {
+		output.WriteString("MODIFY_SERVICE ")
+	}
+	output.WriteString(sa.serviceName + "\n")
+	output.WriteString("  imagePath: " + sa.imagePath + " previous: " + sa.previousImagePath + "\n")
+	output.WriteString("  startType: " + sa.startType + " previous: " + sa.previousStartType + "\n")
+	output.WriteString(sa.setValueKeyArgs.String())
+
+	return output.String()
+}
@@ -0,0 +1,107 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build windows
+
+// Package probe holds probe related files
+package probe
+
+import (
+	"encoding/binary"
+	"testing"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/DataDog/datadog-agent/comp/etw"
+)
+
+func TestServiceName(t *testing.T) {
+	tests := []struct {
+		keyPath   string
+		expected  string
+		isService bool
+	}{
+		{`HKEY_LOCAL_MACHINE\SYSTEM\CurrentControlSet\Services\evil`, "evil", true},
+		{`\REGISTRY\MACHINE\SYSTEM\ControlSet001\Services\WinDefend`, "WinDefend", true},
+		{`HKEY_LOCAL_MACHINE\system\controlset002\services\Spooler`, "Spooler", true},
+		{`HKEY_LOCAL_MACHINE\SYSTEM\CurrentControlSet\Services\evil\Parameters`, "", false},
+		{`HKEY_LOCAL_MACHINE\SYSTEM\CurrentControlSet\Services`, "", false},
+		{`HKEY_USERS\S-1-5-21\SYSTEM\CurrentControlSet\Services\evil`, "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.keyPath, func(t *testing.T) {
+			name, ok := serviceName(tt.keyPath)
+			assert.Equal(t, tt.isService, ok)
+			assert.Equal(t, tt.expected, name)
+		})
+	}
+}
+
+func TestServiceStartTypeString(t *testing.T) {
+	start := make([]byte, 4)
+	binary.LittleEndian.PutUint32(start, 4)
+	assert.Equal(t, "disabled", serviceStartTypeString(regDWORD, start))
+
+	binary.LittleEndian.PutUint32(start, 2)
+	assert.Equal(t, "automatic", serviceStartTypeString(regDWORD, start))
+
+	binary.LittleEndian.PutUint32(start, 42)
+	assert.Equal(t, "", serviceStartTypeString(regDWORD, start))
+
+	assert.Equal(t, "", serviceStartTypeString(regSZ, start))
+	assert.Equal(t, "", serviceStartTypeString(regDWORD, start[:2]))
+}
+
+func newRPCEventRecord(id uint16, pid uint32, tid uint32, activityID etw.DDGUID, userData []byte) *etw.DDEventRecord {
+	e := &etw.DDEventRecord{
+		EventHeader: etw.DDEventHeader{
+			ThreadID:   tid,
+			ProcessID:  pid,
+			ActivityID: activityID,
+		},
+		UserDataLength: uint16(len(userData)),
+	}
+	e.EventHeader.EventDescriptor.ID = id
+	if len(userData) > 0 {
+		e.UserData = &userData[0]
+	}
+	return e
+}
+
+func TestServiceCaller(t *testing.T) {
+	scmClients, _ := lru.New[etw.DDGUID, uint32](16)
+	scmCalls, _ := lru.New[uint32, scmCall](16)
+	wp := &WindowsProbe{
+		scmClients: scmClients,
+		scmCalls:   scmCalls,
+	}
+
+	svcctl := make([]byte, 16)
+	binary.LittleEndian.PutUint32(svcctl[0:4], svcctlInterfaceGUID.Data1)
+	binary.LittleEndian.PutUint16(svcctl[4:6], svcctlInterfaceGUID.Data2)
+	binary.LittleEndian.PutUint16(svcctl[6:8], svcctlInterfaceGUID.Data3)
+	copy(svcctl[8:], svcctlInterfaceGUID.Data4[:])
+
+	activityID := etw.DDGUID{Data1: 42}
+	const clientPID, scmPID, scmTID = uint32(1234), uint32(600), uint32(700)
+
+	wp.handleRPCNotification(newRPCEventRecord(idRPCClientCallStart, clientPID, 1235, activityID, svcctl))
+	wp.handleRPCNotification(newRPCEventRecord(idRPCServerCallStart, scmPID, scmTID, activityID, svcctl))
+
+	// the writes of the thread serving the call are attributed to the client
+	assert.Equal(t, clientPID, wp.serviceCaller(&etw.DDEventHeader{ProcessID: scmPID, ThreadID: scmTID}))
+	assert.Equal(t, scmPID, wp.serviceCaller(&etw.DDEventHeader{ProcessID: scmPID, ThreadID: scmTID + 1}))
+	assert.Equal(t, uint32(4321), wp.serviceCaller(&etw.DDEventHeader{ProcessID: 4321, ThreadID: 4322}))
+
+	wp.handleRPCNotification(newRPCEventRecord(idRPCServerCallStop, scmPID, scmTID, activityID, nil))
+	assert.Equal(t, scmPID, wp.serviceCaller(&etw.DDEventHeader{ProcessID: scmPID, ThreadID: scmTID}))
+
+	// the calls to the other interfaces are ignored
+	wp.handleRPCNotification(newRPCEventRecord(idRPCClientCallStart, clientPID, 1235, activityID, make([]byte, 16)))
+	wp.handleRPCNotification(newRPCEventRecord(idRPCServerCallStart, scmPID, scmTID, activityID, make([]byte, 16)))
+	assert.Equal(t, scmPID, wp.serviceCaller(&etw.DDEventHeader{ProcessID: scmPID, ThreadID: scmTID}))
+}
@@ -13,6 +13,7 @@ import (
 	"fmt"
 
 	"github.com/DataDog/datadog-agent/pkg/security/events"
+	"github.com/DataDog/datadog-agent/pkg/security/metrics"
 	"github.com/DataDog/datadog-agent/pkg/security/probe/eventstream"
 	"github.com/DataDog/datadog-agent/pkg/security/probe/monitors/approver"
 	"github.com/DataDog/datadog-agent/pkg/security/probe/monitors/cgroups"
@@ -94,6 +95,10 @@ func (m *EBPFMonitors) SendStats() error {
 			return fmt.Errorf("failed to send process_resolver stats: %w", err)
 		}
 
+		if err := resolvers.PathResolver.SendStats(); err != nil {
+			return fmt.Errorf("failed to send path_resolver stats: %w", err)
+		}
+
 		if err := resolvers.NamespaceResolver.SendStats(); err != nil {
 			return fmt.Errorf("failed to send namespace_resolver stats: %w", err)
 		}
@@ -102,6 +107,10 @@ func (m *EBPFMonitors) SendStats() error {
 			return fmt.Errorf("failed to send mount_resolver stats: %w", err)
 		}
 
+		if err := m.ebpfProbe.statsdClient.Gauge(metrics.MetricTimeResolverSuspendDrift, float64(resolvers.TimeResolver.GetSuspendDrift()), []string{}, 1.0); err != nil {
+			return fmt.Errorf("failed to send time_resolver stats: %w", err)
+		}
+
 		if resolvers.SBOMResolver != nil {
 			if err := resolvers.SBOMResolver.SendStats(); err != nil {
 				return fmt.Errorf("failed to send sbom_resolver stats: %w", err)
@@ -71,6 +71,11 @@ func (p *Probe) GetEventTags(_ string) []string {
 	return nil
 }
 
+// GetInactiveEventTypes returns the event types that can't be monitored on this host
+func (p *Probe) GetInactiveEventTypes(_ []eval.EventType) map[eval.EventType]error {
+	return nil
+}
+
 // IsNetworkEnabled returns whether network is enabled
 func (p *Probe) IsNetworkEnabled() bool {
 	return p.Config.Probe.NetworkEnabled
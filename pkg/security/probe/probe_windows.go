@@ -11,6 +11,7 @@ import (
 	"errors"
 	"fmt"
 	"path/filepath"
+	"slices"
 	"sync"
 	"time"
 
@@ -68,6 +69,7 @@ type WindowsProbe struct {
 	// ETW component for FIM
 	fileguid  windows.GUID
 	regguid   windows.GUID
+	rpcguid   windows.GUID
 	auditguid windows.GUID
 
 	//etwcomp    etw.Component
@@ -89,6 +91,16 @@ type WindowsProbe struct {
 	// state tracking
 	renamePreArgs *lru.Cache[uint64, fileCache]
 
+	// map of named pipe names to the pid of the process that created them
+	namedPipeServers *lru.Cache[string, uint32]
+
+	// map of service names to their last known configuration
+	serviceInfos *lru.Cache[string, serviceInfo]
+	// map of the activity IDs of the RPC calls to the service control manager to the pid of their client
+	scmClients *lru.Cache[etw.DDGUID, uint32]
+	// map of the threads of the service control manager to the RPC call they are serving
+	scmCalls *lru.Cache[uint32, scmCall]
+
 	// stats
 	stats stats
 
@@ -268,6 +280,12 @@ func (p *WindowsProbe) initEtwFIM() error {
 		log.Errorf("Error converting guid %v", err)
 		return err
 	}
+	// <provider name="Microsoft-Windows-RPC" guid="{6ad52b32-d609-4be9-ae07-ce8dae937e39}"
+	p.rpcguid, err = windows.GUIDFromString("{6ad52b32-d609-4be9-ae07-ce8dae937e39}")
+	if err != nil {
+		log.Errorf("Error converting guid %v", err)
+		return err
+	}
 	//  <provider name="Microsoft-Windows-Security-Auditing" guid="{54849625-5478-4994-a5ba-3e3b0328c30d}"
 	p.auditguid, err = windows.GUIDFromString("{54849625-5478-4994-a5ba-3e3b0328c30d}")
 	if err != nil {
@@ -373,6 +391,18 @@ func (p *WindowsProbe) reconfigureProvider() error {
 		if p.enabledEventTypes[model.SetRegistryKeyValueEventType.String()] {
 			regIDs = append(regIDs, idRegSetValueKey)
 		}
+		if p.enabledEventTypes[model.DeleteRegistryKeyValueEventType.String()] {
+			regIDs = append(regIDs, idRegDeleteValue)
+		}
+		// service events are derived from the set value notifications, which need the create and open
+		// notifications to resolve the path of the service keys
+		if p.enabledEventTypes[model.CreateServiceEventType.String()] || p.enabledEventTypes[model.ModifyServiceEventType.String()] {
+			for _, id := range []uint16{idRegCreateKey, idRegOpenKey, idRegSetValueKey} {
+				if !slices.Contains(regIDs, id) {
+					regIDs = append(regIDs, id)
+				}
+			}
+		}
 
 		cfg.EnabledIDs = regIDs
 	})
@@ -393,6 +423,21 @@ func (p *WindowsProbe) reconfigureProvider() error {
 		return err
 	}
 
+	// the RPC calls to the service control manager identify the processes changing the services through it
+	if p.enabledEventTypes[model.CreateServiceEventType.String()] || p.enabledEventTypes[model.ModifyServiceEventType.String()] {
+		p.fimSession.ConfigureProvider(p.rpcguid, func(cfg *etw.ProviderConfiguration) {
+			cfg.TraceLevel = etw.TRACE_LEVEL_VERBOSE
+			cfg.PIDs = pidsList
+			cfg.EnabledIDs = []uint16{idRPCClientCallStart, idRPCServerCallStart, idRPCServerCallStop}
+		})
+		if err := p.fimSession.EnableProvider(p.rpcguid); err != nil {
+			log.Warnf("Error enabling provider %v", err)
+			return err
+		}
+	} else if err := p.fimSession.DisableProvider(p.rpcguid); err != nil {
+		log.Debugf("Error disabling provider %v", err)
+	}
+
 	return nil
 }
 
@@ -515,7 +560,21 @@ func (p *WindowsProbe) setupEtw(ecb etwCallback) error {
 				}
 
 			case idCreate:
-				if ca, err := p.parseCreateHandleArgs(e); err == nil {
+				ca, err := parseCreateHandleFields(e)
+				if err != nil {
+					break
+				}
+
+				// named pipes aren't subject to the FIM approvers and discarders
+				if npa := p.parseNamedPipeArgs(ca); npa != nil {
+					log.Tracef("Received named pipe idCreate event %d %s\n", e.EventHeader.EventDescriptor.ID, npa)
+
+					p.stats.fpnLock.Lock()
+					p.stats.fileProcessedNotifications[e.EventHeader.EventDescriptor.ID]++
+					p.stats.fpnLock.Unlock()
+
+					ecb(npa, e.EventHeader.ProcessID)
+				} else if ca, err := p.filterCreateHandleArgs(ca); err == nil {
 					log.Tracef("Received idCreate event %d %s\n", e.EventHeader.EventDescriptor.ID, ca)
 
 					p.stats.fpnLock.Lock()
@@ -653,6 +712,9 @@ func (p *WindowsProbe) setupEtw(ecb etwCallback) error {
 				}
 			}
 
+		case etw.DDGUID(p.rpcguid):
+			p.handleRPCNotification(e)
+
 		case etw.DDGUID(p.regguid):
 			p.stats.rnLock.Lock()
 			p.stats.regNotifications[e.EventHeader.EventDescriptor.ID]++
@@ -728,6 +790,19 @@ func (p *WindowsProbe) setupEtw(ecb etwCallback) error {
 					log.Tracef("Got idRegSetValueKey %s", svk)
 
 					ecb(svk, e.EventHeader.ProcessID)
+					if sa := p.parseServiceArgs(svk); sa != nil {
+						log.Tracef("Got service change %s", sa)
+						ecb(sa, p.serviceCaller(&e.EventHeader))
+					}
+					p.stats.rpnLock.Lock()
+					p.stats.regProcessedNotifications[e.EventHeader.EventDescriptor.ID]++
+					p.stats.rpnLock.Unlock()
+				}
+			case idRegDeleteValue:
+				if dvk, err := p.parseDeleteValueKey(e); err == nil {
+					log.Tracef("Got idRegDeleteValue %s", dvk)
+
+					ecb(dvk, e.EventHeader.ProcessID)
 					p.stats.rpnLock.Lock()
 					p.stats.regProcessedNotifications[e.EventHeader.EventDescriptor.ID]++
 					p.stats.rpnLock.Unlock()
@@ -1009,6 +1084,56 @@ func (p *WindowsProbe) handleETWNotification(ev *model.Event, notif etwNotificat
 				KeyPath: arg.computedFullPath,
 			},
 			ValueName: arg.valueName,
+			ValueData: arg.valueData,
+		}
+	case *deleteValueKeyArgs:
+		ev.Type = uint32(model.DeleteRegistryKeyValueEventType)
+		ev.DeleteRegistryKeyValue = model.DeleteRegistryKeyValueEvent{
+			Registry: model.RegistryEvent{
+				KeyName: filepath.Base(arg.computedFullPath),
+				KeyPath: arg.computedFullPath,
+			},
+			ValueName: arg.valueName,
+		}
+	case *namedPipeArgs:
+		pipe := model.NamedPipeEvent{
+			Name: arg.pipeName,
+			Path: namedPipeUserPrefix + arg.pipeName,
+		}
+		if arg.create {
+			ev.Type = uint32(model.CreateNamedPipeEventType)
+			ev.CreateNamedPipe = model.CreateNamedPipeEvent{
+				Pipe: pipe,
+			}
+		} else {
+			ev.Type = uint32(model.ConnectNamedPipeEventType)
+			ev.ConnectNamedPipe = model.ConnectNamedPipeEvent{
+				Pipe:      pipe,
+				ServerPid: arg.serverPID,
+			}
+			if arg.serverPID != 0 {
+				if pce := p.Resolvers.ProcessResolver.GetEntry(process.Pid(arg.serverPID)); pce != nil {
+					ev.ConnectNamedPipe.ServerPath = pce.Process.FileEvent.PathnameStr
+				}
+			}
+		}
+	case *serviceArgs:
+		if arg.create {
+			ev.Type = uint32(model.CreateServiceEventType)
+			ev.CreateService = model.CreateServiceEvent{
+				Name:      arg.serviceName,
+				ImagePath: arg.imagePath,
+				StartType: arg.startType,
+			}
+		} else {
+			ev.Type = uint32(model.ModifyServiceEventType)
+			ev.ModifyService = model.ModifyServiceEvent{
+				Name:              arg.serviceName,
+				ImagePath:         arg.imagePath,
+				PreviousImagePath: arg.previousImagePath,
+				StartType:         arg.startType,
+				PreviousStartType: arg.previousStartType,
+			}
 		}
 	case *objectPermsChange:
 		ev.Type = uint32(model.ChangePermissionEventType)
@@ -1027,6 +1152,14 @@ func (p *WindowsProbe) handleETWNotification(ev *model.Event, notif etwNotificat
 		return false
 	}
 
+	// the changes made through the service control manager are written by services.exe on behalf of its RPC clients.
+	// When the client of the call couldn't be identified, the service event isn't attributed to any process.
+	if _, ok := notif.arg.(*serviceArgs); ok && p.isServiceControlManager(notif.pid) {
+		ev.ProcessCacheEntry = model.GetPlaceholderProcessCacheEntry(0)
+		ev.ProcessContext = &ev.ProcessCacheEntry.ProcessContext
+		return true
+	}
+
 	errRes := p.setProcessContext(notif.pid, ev)
 	if errRes != nil {
 		log.Debugf("%v", errRes)
@@ -1233,6 +1366,26 @@ func initializeWindowsProbe(config *config.Config, opts Opts) (*WindowsProbe, er
 		return nil, err
 	}
 
+	nps, err := lru.New[string, uint32](1 << 10)
+	if err != nil {
+		return nil, err
+	}
+
+	svc, err := lru.New[string, serviceInfo](1 << 10)
+	if err != nil {
+		return nil, err
+	}
+
+	scmClients, err := lru.New[etw.DDGUID, uint32](1 << 10)
+	if err != nil {
+		return nil, err
+	}
+
+	scmCalls, err := lru.New[uint32, scmCall](1 << 8)
+	if err != nil {
+		return nil, err
+	}
+
 	bocs := config.RuntimeSecurity.WindowsProbeBlockOnChannelSend
 
 	etwNotificationSize := config.RuntimeSecurity.ETWEventsChannelSize
@@ -1261,6 +1414,11 @@ func initializeWindowsProbe(config *config.Config, opts Opts) (*WindowsProbe, er
 
 		renamePreArgs: rnc,
 
+		namedPipeServers: nps,
+		serviceInfos:     svc,
+		scmClients:       scmClients,
+		scmCalls:         scmCalls,
+
 		discardedPaths:     discardedPaths,
 		discardedUserPaths: discardedUserPaths,
 		discardedBasenames: discardedBasenames,
@@ -0,0 +1,24 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux || windows
+
+// Package probe holds probe related files
+package probe
+
+import "github.com/DataDog/datadog-agent/pkg/eventmonitor/processtree"
+
+// processTreeProvider is implemented by the platform probes that can report the live process tree
+type processTreeProvider interface {
+	GetProcessTree() *processtree.Tree
+}
+
+// GetProcessTree returns the live process tree of the platform probe, or nil if the platform probe can't report it
+func (p *Probe) GetProcessTree() *processtree.Tree {
+	if pt, ok := p.PlatformProbe.(processTreeProvider); ok {
+		return pt.GetProcessTree()
+	}
+	return nil
+}
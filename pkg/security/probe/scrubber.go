@@ -14,15 +14,16 @@ import (
 	"github.com/DataDog/datadog-agent/pkg/process/procutil"
 )
 
-func newProcScrubber(customSensitiveWords []string) *procutil.DataScrubber {
+func newProcScrubber(customSensitiveWords []string, scrubbing procutil.ScrubbingConfig) *procutil.DataScrubber {
 	scrubber := procutil.NewDefaultDataScrubber()
 	scrubber.AddCustomSensitiveWords(customSensitiveWords)
+	scrubbing.Apply(scrubber)
 
 	// token is not always a sensitive word so we cannot change the default sensitive patterns
 	// in the case of CWS we can assume token is something we want to scrub so we add it here
 	additionals := []string{"*token*"}
 	for _, additional := range additionals {
-		if !slices.Contains(customSensitiveWords, additional) {
+		if !slices.Contains(customSensitiveWords, additional) && !slices.Contains(scrubbing.SensitiveWords, additional) {
 			scrubber.AddCustomSensitiveWords([]string{additional})
 		}
 	}
@@ -165,6 +165,43 @@ func (_m *SecurityModuleClient) DumpProcessCache(ctx context.Context, in *api.Du
 	return r0, r1
 }
 
+// ExportRuleSet provides a mock function with given fields: ctx, in, opts
+func (_m *SecurityModuleClient) ExportRuleSet(ctx context.Context, in *api.ExportRuleSetParams, opts ...grpc.CallOption) (*api.ExportRuleSetMessage, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportRuleSet")
+	}
+
+	var r0 *api.ExportRuleSetMessage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *api.ExportRuleSetParams, ...grpc.CallOption) (*api.ExportRuleSetMessage, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *api.ExportRuleSetParams, ...grpc.CallOption) *api.ExportRuleSetMessage); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.ExportRuleSetMessage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *api.ExportRuleSetParams, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetActivityDumpStream provides a mock function with given fields: ctx, in, opts
 func (_m *SecurityModuleClient) GetActivityDumpStream(ctx context.Context, in *api.ActivityDumpStreamParams, opts ...grpc.CallOption) (api.SecurityModule_GetActivityDumpStreamClient, error) {
 	_va := make([]interface{}, len(opts))
@@ -424,6 +461,43 @@ func (_m *SecurityModuleClient) ListSecurityProfiles(ctx context.Context, in *ap
 	return r0, r1
 }
 
+// QueryProcessCache provides a mock function with given fields: ctx, in, opts
+func (_m *SecurityModuleClient) QueryProcessCache(ctx context.Context, in *api.ProcessCacheQueryParams, opts ...grpc.CallOption) (*api.ProcessCacheQueryMessage, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QueryProcessCache")
+	}
+
+	var r0 *api.ProcessCacheQueryMessage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *api.ProcessCacheQueryParams, ...grpc.CallOption) (*api.ProcessCacheQueryMessage, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *api.ProcessCacheQueryParams, ...grpc.CallOption) *api.ProcessCacheQueryMessage); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.ProcessCacheQueryMessage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *api.ProcessCacheQueryParams, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ReloadPolicies provides a mock function with given fields: ctx, in, opts
 func (_m *SecurityModuleClient) ReloadPolicies(ctx context.Context, in *api.ReloadPoliciesParams, opts ...grpc.CallOption) (*api.ReloadPoliciesResultMessage, error) {
 	_va := make([]interface{}, len(opts))
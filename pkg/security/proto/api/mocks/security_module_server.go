@@ -135,6 +135,36 @@ func (_m *SecurityModuleServer) DumpProcessCache(_a0 context.Context, _a1 *api.D
 	return r0, r1
 }
 
+// ExportRuleSet provides a mock function with given fields: _a0, _a1
+func (_m *SecurityModuleServer) ExportRuleSet(_a0 context.Context, _a1 *api.ExportRuleSetParams) (*api.ExportRuleSetMessage, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportRuleSet")
+	}
+
+	var r0 *api.ExportRuleSetMessage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *api.ExportRuleSetParams) (*api.ExportRuleSetMessage, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *api.ExportRuleSetParams) *api.ExportRuleSetMessage); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.ExportRuleSetMessage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *api.ExportRuleSetParams) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetActivityDumpStream provides a mock function with given fields: _a0, _a1
 func (_m *SecurityModuleServer) GetActivityDumpStream(_a0 *api.ActivityDumpStreamParams, _a1 api.SecurityModule_GetActivityDumpStreamServer) error {
 	ret := _m.Called(_a0, _a1)
@@ -321,6 +351,36 @@ func (_m *SecurityModuleServer) ListSecurityProfiles(_a0 context.Context, _a1 *a
 	return r0, r1
 }
 
+// QueryProcessCache provides a mock function with given fields: _a0, _a1
+func (_m *SecurityModuleServer) QueryProcessCache(_a0 context.Context, _a1 *api.ProcessCacheQueryParams) (*api.ProcessCacheQueryMessage, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QueryProcessCache")
+	}
+
+	var r0 *api.ProcessCacheQueryMessage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *api.ProcessCacheQueryParams) (*api.ProcessCacheQueryMessage, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *api.ProcessCacheQueryParams) *api.ProcessCacheQueryMessage); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.ProcessCacheQueryMessage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *api.ProcessCacheQueryParams) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ReloadPolicies provides a mock function with given fields: _a0, _a1
 func (_m *SecurityModuleServer) ReloadPolicies(_a0 context.Context, _a1 *api.ReloadPoliciesParams) (*api.ReloadPoliciesResultMessage, error) {
 	ret := _m.Called(_a0, _a1)
@@ -7,6 +7,9 @@
 package api
 
 import (
+	"encoding/json"
+	"fmt"
+	"sort"
 	"strconv"
 
 	"github.com/DataDog/datadog-agent/pkg/security/probe/kfilters"
@@ -106,3 +109,44 @@ func FromKFiltersToProtoApprovers(approvers rules.Approvers) *Approvers {
 
 	return protoApprovers
 }
+
+// FromRuleSetToProtoExportedRules transforms the rules of a rule set to exported rules, sorted by ID. The definition of
+// each rule is the one resulting from the merge of all the policies, serialized in JSON.
+func FromRuleSetToProtoExportedRules(rs *rules.RuleSet) ([]*ExportedRule, error) {
+	exported := make([]*ExportedRule, 0, len(rs.GetRules()))
+	for _, rule := range rs.GetRules() {
+		if rule.PolicyRule == nil || rule.Def == nil {
+			continue
+		}
+
+		definition, err := json.Marshal(rule.Def)
+		if err != nil {
+			return nil, fmt.Errorf("failed to serialize rule `%s`: %w", rule.Def.ID, err)
+		}
+
+		entry := &ExportedRule{
+			ID:         rule.Def.ID,
+			Definition: definition,
+		}
+		if rule.Policy != nil {
+			entry.PolicyName = rule.Policy.Name
+			entry.PolicySource = rule.Policy.Source
+			if rule.Policy.Def != nil {
+				entry.PolicyVersion = rule.Policy.Def.Version
+			}
+		}
+		for _, modifier := range rule.ModifiedBy {
+			if modifier.Policy != nil {
+				entry.ModifiedBy = append(entry.ModifiedBy, modifier.Policy.Name)
+			}
+		}
+
+		exported = append(exported, entry)
+	}
+
+	sort.Slice(exported, func(i, j int) bool {
+		return exported[i].ID < exported[j].ID
+	})
+
+	return exported, nil
+}
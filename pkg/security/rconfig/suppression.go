@@ -0,0 +1,119 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package rconfig holds rconfig related files
+package rconfig
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/atomic"
+
+	"github.com/DataDog/datadog-agent/pkg/api/security"
+	"github.com/DataDog/datadog-agent/pkg/config/remote/client"
+	pkgconfigsetup "github.com/DataDog/datadog-agent/pkg/config/setup"
+	"github.com/DataDog/datadog-agent/pkg/remoteconfig/state"
+	"github.com/DataDog/datadog-agent/pkg/security/utils"
+	"github.com/DataDog/datadog-agent/pkg/util/log"
+)
+
+// SuppressionWindow is an anomaly detection suppression window pushed through remote config, for instance by a
+// deployment pipeline
+type SuppressionWindow struct {
+	ImageName string    `json:"image_name"`
+	ImageTag  string    `json:"image_tag"`
+	Until     time.Time `json:"until"`
+}
+
+// RCSuppressionWindowsProvider forwards the anomaly detection suppression windows received from remote config
+type RCSuppressionWindowsProvider struct {
+	client   *client.Client
+	onUpdate func(windows []SuppressionWindow)
+
+	isStarted *atomic.Bool
+}
+
+// NewRCSuppressionWindowsProvider returns a new Remote Config based suppression windows provider. onUpdate is called
+// with the complete set of windows every time it changes.
+func NewRCSuppressionWindowsProvider(onUpdate func(windows []SuppressionWindow)) (*RCSuppressionWindowsProvider, error) {
+	agentVersion, err := utils.GetAgentSemverVersion()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse agent version: %w", err)
+	}
+
+	ipcAddress, err := pkgconfigsetup.GetIPCAddress(pkgconfigsetup.Datadog())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ipc address: %w", err)
+	}
+
+	c, err := client.NewGRPCClient(ipcAddress, pkgconfigsetup.GetIPCPort(), func() (string, error) { return security.FetchAuthToken(pkgconfigsetup.Datadog()) },
+		client.WithAgent(agentName, agentVersion.String()),
+		client.WithProducts(state.ProductCWSSuppressionWindows),
+		client.WithPollInterval(securityAgentRCPollInterval),
+		client.WithDirectorRootOverride(pkgconfigsetup.Datadog().GetString("site"), pkgconfigsetup.Datadog().GetString("remote_configuration.director_root")),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RCSuppressionWindowsProvider{
+		client:    c,
+		onUpdate:  onUpdate,
+		isStarted: atomic.NewBool(false),
+	}, nil
+}
+
+// Start starts the Remote Config suppression windows provider and subscribes to updates
+func (r *RCSuppressionWindowsProvider) Start() {
+	log.Info("remote-config suppression windows provider started")
+
+	r.client.Subscribe(state.ProductCWSSuppressionWindows, r.rcUpdateCallback)
+	r.client.Start()
+
+	r.isStarted.Store(true)
+}
+
+func (r *RCSuppressionWindowsProvider) rcUpdateCallback(configs map[string]state.RawConfig, applyStateCallback func(string, state.ApplyStatus)) {
+	windows := make([]SuppressionWindow, 0, len(configs))
+	for cfgPath, c := range configs {
+		window, err := parseSuppressionWindow(c.Config)
+		if err != nil {
+			log.Errorf("invalid suppression window %s: %s", c.Metadata.ID, err)
+			applyStateCallback(cfgPath, state.ApplyStatus{State: state.ApplyStateError, Error: err.Error()})
+			continue
+		}
+		windows = append(windows, window)
+		applyStateCallback(cfgPath, state.ApplyStatus{State: state.ApplyStateAcknowledged})
+	}
+
+	r.onUpdate(windows)
+}
+
+func parseSuppressionWindow(data []byte) (SuppressionWindow, error) {
+	var window SuppressionWindow
+	if err := json.Unmarshal(data, &window); err != nil {
+		return window, err
+	}
+	if window.ImageName == "" {
+		return window, errors.New("no image name provided")
+	}
+	if window.Until.IsZero() {
+		return window, errors.New("no end time provided")
+	}
+	return window, nil
+}
+
+// Close stops the client
+func (r *RCSuppressionWindowsProvider) Close() error {
+	if !r.isStarted.Load() {
+		return nil
+	}
+
+	r.client.Close()
+	return nil
+}
@@ -0,0 +1,238 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+// Package cgroup holds cgroup related files
+package cgroup
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/golang-lru/v2/simplelru"
+
+	cgroupModel "github.com/DataDog/datadog-agent/pkg/security/resolvers/cgroup/model"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/containerutils"
+	"github.com/DataDog/datadog-agent/pkg/security/utils"
+)
+
+const (
+	cgroupNodesCacheSize = 4096
+	// maximum number of cgroups waiting to be loaded
+	cgroupNodesQueueSize = 256
+	// period after which the controllers and the limits of a cgroup are read again
+	cgroupNodeRefreshPeriod = 30 * time.Second
+)
+
+// findCGroupV2Root returns the path of the cgroup v2 hierarchy, mounted at the root of the cgroupfs on unified hosts
+// and in its unified directory on hybrid hosts
+func findCGroupV2Root() string {
+	for _, controller := range []string{"", "unified"} {
+		if _, err := os.Stat(utils.CgroupSysPath(controller, "/", "cgroup.controllers")); err == nil {
+			return utils.CgroupSysPath(controller, "/", "")
+		}
+	}
+	return ""
+}
+
+// hierarchy holds the cgroups of the cgroup v2 hierarchy. The nodes are immutable, a refreshed cgroup being replaced
+// by a new node, so that they can be shared without lock. They are loaded from cgroupfs by a worker, so that the event
+// path never reads the files of a cgroup.
+type hierarchy struct {
+	sync.Mutex
+	// path of the cgroup v2 hierarchy, empty when the host doesn't have one
+	root    string
+	nodes   *simplelru.LRU[containerutils.CGroupID, *cgroupModel.CGroupNode]
+	usages  *simplelru.LRU[containerutils.CGroupID, *cgroupModel.CGroupUsage]
+	pending map[containerutils.CGroupID]struct{}
+	queue   chan containerutils.CGroupID
+}
+
+func newHierarchy(root string) (*hierarchy, error) {
+	nodes, err := simplelru.NewLRU[containerutils.CGroupID, *cgroupModel.CGroupNode](cgroupNodesCacheSize, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	usages, err := simplelru.NewLRU[containerutils.CGroupID, *cgroupModel.CGroupUsage](cgroupUsageCacheSize, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &hierarchy{
+		root:    root,
+		nodes:   nodes,
+		usages:  usages,
+		pending: make(map[containerutils.CGroupID]struct{}),
+		queue:   make(chan containerutils.CGroupID, cgroupNodesQueueSize),
+	}, nil
+}
+
+// readFile returns the trimmed content of a file of a cgroup
+func (h *hierarchy) readFile(path containerutils.CGroupID, file string) (string, bool) {
+	if h.root == "" {
+		return "", false
+	}
+
+	data, err := os.ReadFile(filepath.Join(h.root, string(path), file))
+	if err != nil {
+		return "", false
+	}
+	return strings.TrimSpace(string(data)), true
+}
+
+// readLimit returns the limit of a cgroup file holding either a value or "max"
+func (h *hierarchy) readLimit(path containerutils.CGroupID, file string) uint64 {
+	value, ok := h.readFile(path, file)
+	if !ok {
+		return 0
+	}
+	return parseLimit(value)
+}
+
+// parseLimit parses a cgroup limit, "max" meaning that the resource isn't limited
+func parseLimit(value string) uint64 {
+	limit, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return limit
+}
+
+// parseCPUMax parses the content of a cpu.max file, "$MAX $PERIOD"
+func parseCPUMax(value string) (uint64, uint64) {
+	quota, period, ok := strings.Cut(value, " ")
+	if !ok {
+		return 0, 0
+	}
+
+	quotaValue := parseLimit(quota)
+	if quotaValue == 0 {
+		return 0, 0
+	}
+	return quotaValue, parseLimit(period)
+}
+
+// load reads the controllers and the limits of a cgroup
+func (h *hierarchy) load(node *cgroupModel.CGroupNode) {
+	if controllers, ok := h.readFile(node.Path, "cgroup.controllers"); ok {
+		node.Controllers = strings.Fields(controllers)
+	}
+
+	node.Limits.MemoryMax = h.readLimit(node.Path, "memory.max")
+	node.Limits.MemoryHigh = h.readLimit(node.Path, "memory.high")
+	node.Limits.PidsMax = h.readLimit(node.Path, "pids.max")
+	if cpuMax, ok := h.readFile(node.Path, "cpu.max"); ok {
+		node.Limits.CPUQuota, node.Limits.CPUPeriod = parseCPUMax(cpuMax)
+	}
+}
+
+// getNode returns the node of the cgroup, and whether it was loaded. The cgroups not loaded yet, or loaded for longer
+// than the refresh period, are queued for loading, the stale node being returned meanwhile. It has to be called with
+// the hierarchy lock held.
+func (h *hierarchy) getNode(path containerutils.CGroupID, now time.Time) (*cgroupModel.CGroupNode, bool) {
+	node, found := h.nodes.Get(path)
+	if !found || now.Sub(node.LoadedAt) >= cgroupNodeRefreshPeriod {
+		h.queueNode(path)
+	}
+	return node, found
+}
+
+// queueNode queues the loading of the cgroup. The cgroups which can't be queued, the queue being full, are queued
+// again by a later lookup. It has to be called with the hierarchy lock held.
+func (h *hierarchy) queueNode(path containerutils.CGroupID) {
+	if h.root == "" {
+		return
+	}
+
+	if _, found := h.pending[path]; found {
+		return
+	}
+
+	select {
+	case h.queue <- path:
+		h.pending[path] = struct{}{}
+	default:
+	}
+}
+
+// run loads the queued cgroups until the context is done
+func (h *hierarchy) run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case path := <-h.queue:
+			h.loadNode(path, time.Now())
+		}
+	}
+}
+
+// loadNode reads the cgroup, and its ancestors not loaded yet, from cgroupfs and adds them to the hierarchy. The files
+// are read without the hierarchy lock.
+func (h *hierarchy) loadNode(path containerutils.CGroupID, now time.Time) *cgroupModel.CGroupNode {
+	var parent *cgroupModel.CGroupNode
+	if path != "/" {
+		parentPath := containerutils.CGroupID(filepath.Dir(string(path)))
+
+		h.Lock()
+		parent, _ = h.nodes.Get(parentPath)
+		h.Unlock()
+
+		if parent == nil {
+			parent = h.loadNode(parentPath, now)
+		}
+	}
+
+	node := &cgroupModel.CGroupNode{
+		Path:     path,
+		Parent:   parent,
+		LoadedAt: now,
+	}
+	h.load(node)
+
+	h.Lock()
+	h.nodes.Add(path, node)
+	delete(h.pending, path)
+	h.Unlock()
+
+	return node
+}
+
+// prefetchNode queues the loading of a created cgroup, so that it is loaded before its first lookup
+func (h *hierarchy) prefetchNode(path containerutils.CGroupID) {
+	if !strings.HasPrefix(string(path), "/") {
+		return
+	}
+
+	path = containerutils.CGroupID(filepath.Clean(string(path)))
+
+	h.Lock()
+	defer h.Unlock()
+
+	if !h.nodes.Contains(path) {
+		h.queueNode(path)
+	}
+}
+
+// GetCGroupNode returns the cgroup of the cgroup v2 hierarchy at the provided path, linked to its ancestors. Only the
+// cgroups identified by their absolute path can be placed in the hierarchy. The cgroups are loaded in the background,
+// the ones not loaded yet being reported as not found.
+func (cr *Resolver) GetCGroupNode(path containerutils.CGroupID) (*cgroupModel.CGroupNode, bool) {
+	if !strings.HasPrefix(string(path), "/") {
+		return nil, false
+	}
+
+	cr.hierarchy.Lock()
+	defer cr.hierarchy.Unlock()
+
+	return cr.hierarchy.getNode(containerutils.CGroupID(filepath.Clean(string(path))), time.Now())
+}
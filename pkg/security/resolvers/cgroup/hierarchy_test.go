@@ -0,0 +1,141 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+// Package cgroup holds cgroup related files
+package cgroup
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	cgroupModel "github.com/DataDog/datadog-agent/pkg/security/resolvers/cgroup/model"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/containerutils"
+)
+
+func TestCGroupHierarchy(t *testing.T) {
+	root := t.TempDir()
+	writeFile := func(path string, file string, content string) {
+		assert.NoError(t, os.MkdirAll(filepath.Join(root, path), 0755))
+		assert.NoError(t, os.WriteFile(filepath.Join(root, path, file), []byte(content+"\n"), 0644))
+	}
+
+	writeFile("/", "cgroup.controllers", "cpuset cpu io memory pids")
+	writeFile("/system.slice", "cgroup.controllers", "cpu memory pids")
+	writeFile("/system.slice", "memory.max", "1073741824")
+	writeFile("/system.slice", "pids.max", "max")
+	writeFile("/system.slice/nginx.service", "cgroup.controllers", "memory pids")
+	writeFile("/system.slice/nginx.service", "memory.max", "max")
+	writeFile("/system.slice/nginx.service", "memory.high", "536870912")
+	writeFile("/system.slice/nginx.service", "pids.max", "100")
+	writeFile("/system.slice/nginx.service", "cpu.max", "50000 100000")
+
+	cr, err := NewResolver()
+	assert.NoError(t, err)
+	cr.hierarchy, err = newHierarchy(root)
+	assert.NoError(t, err)
+
+	// the cgroup is loaded in the background
+	_, found := cr.GetCGroupNode("/system.slice/nginx.service/")
+	assert.False(t, found)
+	_, found = cr.GetCGroupNode("/system.slice/nginx.service")
+	assert.False(t, found)
+	assert.Len(t, cr.hierarchy.queue, 1)
+	loadQueuedNodes(cr.hierarchy)
+
+	node, found := cr.GetCGroupNode("/system.slice/nginx.service/")
+	assert.True(t, found)
+	assert.Equal(t, containerutils.CGroupID("/system.slice/nginx.service"), node.Path)
+	assert.Equal(t, []string{"memory", "pids"}, node.Controllers)
+	assert.Equal(t, []containerutils.CGroupID{"/system.slice", "/"}, node.Ancestors())
+	assert.True(t, node.IsUnder("/system.slice"))
+	assert.False(t, node.IsUnder("/user.slice"))
+
+	assert.Equal(t, cgroupModel.CGroupLimits{
+		MemoryHigh: 536870912,
+		PidsMax:    100,
+		CPUQuota:   50000,
+		CPUPeriod:  100000,
+	}, node.Limits)
+
+	// the ancestors are shared by the nodes of the subtree
+	cr.hierarchy.prefetchNode("/system.slice/sshd.service")
+	loadQueuedNodes(cr.hierarchy)
+	sibling, found := cr.GetCGroupNode("/system.slice/sshd.service")
+	assert.True(t, found)
+	assert.Same(t, node.Parent, sibling.Parent)
+
+	// the stale nodes are served while they are loaded again
+	cr.hierarchy.Lock()
+	_, found = cr.hierarchy.getNode(node.Path, node.LoadedAt.Add(cgroupNodeRefreshPeriod))
+	cr.hierarchy.Unlock()
+	assert.True(t, found)
+	assert.Len(t, cr.hierarchy.queue, 1)
+	loadQueuedNodes(cr.hierarchy)
+
+	_, found = cr.GetCGroupNode("docker-1234")
+	assert.False(t, found)
+	assert.Empty(t, cr.hierarchy.queue)
+}
+
+// loadQueuedNodes loads the queued cgroups, as the worker would
+func loadQueuedNodes(h *hierarchy) {
+	for len(h.queue) > 0 {
+		h.loadNode(<-h.queue, time.Now())
+	}
+}
+
+func TestCGroupUsage(t *testing.T) {
+	root := t.TempDir()
+	writeFile := func(path string, file string, content string) {
+		assert.NoError(t, os.MkdirAll(filepath.Join(root, path), 0755))
+		assert.NoError(t, os.WriteFile(filepath.Join(root, path, file), []byte(content+"\n"), 0644))
+	}
+
+	writeFile("/", "cgroup.controllers", "cpu memory pids")
+	writeFile("/user.slice", "memory.high", "1000")
+	writeFile("/user.slice", "memory.current", "1500")
+	writeFile("/user.slice/app.scope", "memory.high", "max")
+	writeFile("/user.slice/app.scope", "memory.current", "800")
+	writeFile("/user.slice/app.scope", "pids.current", "12")
+	writeFile("/user.slice/app.scope", "cpu.stat", "usage_usec 4242\nuser_usec 4000\nsystem_usec 242")
+	writeFile("/system.slice/nginx.service", "memory.high", "1000")
+	writeFile("/system.slice/nginx.service", "memory.current", "500")
+
+	cr, err := NewResolver()
+	assert.NoError(t, err)
+	cr.hierarchy, err = newHierarchy(root)
+	assert.NoError(t, err)
+
+	cr.hierarchy.prefetchNode("/user.slice/app.scope")
+	cr.hierarchy.prefetchNode("/system.slice/nginx.service")
+	loadQueuedNodes(cr.hierarchy)
+
+	// the usage of the slice is above its throttle limit
+	usage, found := cr.GetCGroupUsage("/user.slice/app.scope")
+	assert.True(t, found)
+	assert.Equal(t, uint64(800), usage.MemoryCurrent)
+	assert.True(t, usage.MemoryHighExceeded)
+	assert.Equal(t, uint64(12), usage.PidsCurrent)
+	assert.Equal(t, uint64(4242), usage.CPUUsage)
+
+	usage, found = cr.GetCGroupUsage("/system.slice/nginx.service")
+	assert.True(t, found)
+	assert.False(t, usage.MemoryHighExceeded)
+
+	// the usage is cached
+	writeFile("/system.slice/nginx.service", "memory.current", "1500")
+	usage, _ = cr.GetCGroupUsage("/system.slice/nginx.service")
+	assert.Equal(t, uint64(500), usage.MemoryCurrent)
+
+	// the cgroup ids which aren't paths of the hierarchy are ignored
+	_, found = cr.GetCGroupUsage("docker-abc.scope")
+	assert.False(t, found)
+}
@@ -0,0 +1,68 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+// Package model holds model related files
+package model
+
+import (
+	"time"
+
+	"github.com/DataDog/datadog-agent/pkg/security/secl/containerutils"
+)
+
+// CGroupLimits holds the resource limits of a cgroup, a zero value meaning that the resource isn't limited
+type CGroupLimits struct {
+	// MemoryMax is the memory usage hard limit, in bytes, from memory.max
+	MemoryMax uint64
+	// MemoryHigh is the memory usage throttle limit, in bytes, from memory.high
+	MemoryHigh uint64
+	// PidsMax is the maximum number of processes, from pids.max
+	PidsMax uint64
+	// CPUQuota is the cpu time allowed per CPUPeriod, in microseconds, from cpu.max
+	CPUQuota uint64
+	// CPUPeriod is the period of the cpu quota, in microseconds, from cpu.max
+	CPUPeriod uint64
+}
+
+// CGroupNode is a cgroup of the cgroup v2 hierarchy
+type CGroupNode struct {
+	Path        containerutils.CGroupID
+	Parent      *CGroupNode
+	Controllers []string
+	Limits      CGroupLimits
+	// LoadedAt is the time the controllers and the limits were read from cgroupfs
+	LoadedAt time.Time
+}
+
+// IsUnder returns whether the cgroup is the provided cgroup or one of its descendants
+func (n *CGroupNode) IsUnder(ancestor containerutils.CGroupID) bool {
+	return containerutils.IsCGroupUnder(n.Path, ancestor)
+}
+
+// Ancestors returns the paths of the ancestors of the cgroup, from its parent to the root of the hierarchy
+func (n *CGroupNode) Ancestors() []containerutils.CGroupID {
+	var ancestors []containerutils.CGroupID
+	for parent := n.Parent; parent != nil; parent = parent.Parent {
+		ancestors = append(ancestors, parent.Path)
+	}
+	return ancestors
+}
+
+// CGroupUsage holds the instantaneous resource usage of a cgroup and of its descendants
+type CGroupUsage struct {
+	// MemoryCurrent is the memory usage, in bytes, from memory.current
+	MemoryCurrent uint64
+	// MemoryHighExceeded is set when the memory usage of the cgroup or of one of its ancestors is above its memory.high
+	// throttle limit
+	MemoryHighExceeded bool
+	// PidsCurrent is the number of processes, from pids.current
+	PidsCurrent uint64
+	// CPUUsage is the cpu time consumed, in microseconds, from the usage_usec entry of cpu.stat
+	CPUUsage uint64
+	// ReadAt is the time the usage was read from cgroupfs
+	ReadAt time.Time
+}
@@ -36,10 +36,8 @@ func NewCacheEntry(containerID string, cgroupFlags uint64, pids ...uint32) (*Cac
 			CGroupID:    containerutils.GetCgroupFromContainer(containerutils.ContainerID(containerID), containerutils.CGroupFlags(cgroupFlags)),
 			CGroupFlags: containerutils.CGroupFlags(cgroupFlags),
 		},
-		ContainerContext: model.ContainerContext{
-			ContainerID: containerutils.ContainerID(containerID),
-		},
-		PIDs: make(map[uint32]bool, 10),
+		ContainerContext: model.NewContainerContext(containerutils.ContainerID(containerID)),
+		PIDs:             make(map[uint32]bool, 10),
 	}
 
 	for _, pid := range pids {
@@ -92,6 +90,12 @@ func (cgce *CacheEntry) SetTags(tags []string) {
 	}
 }
 
+// SetContainerMetadata sets the runtime metadata of the container of the provided workload. The metadata is replaced
+// as a whole, so that the events pointing to the container context of the workload read it without its lock.
+func (cgce *CacheEntry) SetContainerMetadata(metadata *model.ContainerMetadata) {
+	cgce.ContainerContext.Metadata.Store(metadata)
+}
+
 // GetWorkloadSelectorCopy returns a copy of the workload selector of this cgroup
 func (cgce *CacheEntry) GetWorkloadSelectorCopy() *WorkloadSelector {
 	cgce.Lock()
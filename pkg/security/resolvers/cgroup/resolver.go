@@ -48,6 +48,7 @@ type Resolver struct {
 	*utils.Notifier[Event, *cgroupModel.CacheEntry]
 	sync.RWMutex
 	workloads *simplelru.LRU[string, *cgroupModel.CacheEntry]
+	hierarchy *hierarchy
 }
 
 // NewResolver returns a new cgroups monitor
@@ -65,11 +66,21 @@ func NewResolver() (*Resolver, error) {
 		return nil, err
 	}
 	cr.workloads = workloads
+
+	hierarchy, err := newHierarchy(findCGroupV2Root())
+	if err != nil {
+		return nil, err
+	}
+	cr.hierarchy = hierarchy
+
 	return cr, nil
 }
 
-// Start starts the goroutine of the SBOM resolver
-func (cr *Resolver) Start(_ context.Context) {
+// Start starts the worker loading the cgroups of the cgroup v2 hierarchy
+func (cr *Resolver) Start(ctx context.Context) {
+	if cr.hierarchy.root != "" {
+		go cr.hierarchy.run(ctx)
+	}
 }
 
 // AddPID associates a container id and a pid which is expected to be the pid 1
@@ -95,6 +106,9 @@ func (cr *Resolver) AddPID(process *model.ProcessCacheEntry) {
 	// add the new CGroup to the cache
 	cr.workloads.Add(string(process.ContainerID), newCGroup)
 
+	// the cgroup is loaded before the evaluation of the rules on its hierarchy
+	cr.hierarchy.prefetchNode(process.CGroup.CGroupID)
+
 	cr.NotifyListeners(CGroupCreated, newCGroup)
 }
 
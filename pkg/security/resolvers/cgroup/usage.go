@@ -0,0 +1,90 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+// Package cgroup holds cgroup related files
+package cgroup
+
+import (
+	"path/filepath"
+	"strings"
+	"time"
+
+	cgroupModel "github.com/DataDog/datadog-agent/pkg/security/resolvers/cgroup/model"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/containerutils"
+)
+
+const (
+	cgroupUsageCacheSize = 1024
+	// period during which the usage read from cgroupfs is reused, the events of a cgroup often coming in bursts
+	cgroupUsageCacheTTL = time.Second
+)
+
+// parseCPUStatUsage returns the usage_usec entry of the content of a cpu.stat file
+func parseCPUStatUsage(value string) uint64 {
+	for _, line := range strings.Split(value, "\n") {
+		if name, usage, ok := strings.Cut(line, " "); ok && name == "usage_usec" {
+			return parseLimit(usage)
+		}
+	}
+	return 0
+}
+
+// readMemoryCurrent returns the memory usage of a cgroup, cached like the usage
+func (h *hierarchy) readMemoryCurrent(path containerutils.CGroupID, now time.Time) uint64 {
+	if usage, found := h.usages.Get(path); found && now.Sub(usage.ReadAt) < cgroupUsageCacheTTL {
+		return usage.MemoryCurrent
+	}
+	return h.readLimit(path, "memory.current")
+}
+
+// getUsage returns the usage of the cgroup of the node. It has to be called with the hierarchy lock held.
+func (h *hierarchy) getUsage(node *cgroupModel.CGroupNode, now time.Time) *cgroupModel.CGroupUsage {
+	if usage, found := h.usages.Get(node.Path); found && now.Sub(usage.ReadAt) < cgroupUsageCacheTTL {
+		return usage
+	}
+
+	usage := &cgroupModel.CGroupUsage{
+		MemoryCurrent: h.readLimit(node.Path, "memory.current"),
+		PidsCurrent:   h.readLimit(node.Path, "pids.current"),
+		ReadAt:        now,
+	}
+	if cpuStat, ok := h.readFile(node.Path, "cpu.stat"); ok {
+		usage.CPUUsage = parseCPUStatUsage(cpuStat)
+	}
+
+	// the memory usage of the ancestors includes the one of the other cgroups of their subtree
+	usage.MemoryHighExceeded = node.Limits.MemoryHigh != 0 && usage.MemoryCurrent > node.Limits.MemoryHigh
+	for ancestor := node.Parent; ancestor != nil && !usage.MemoryHighExceeded; ancestor = ancestor.Parent {
+		if ancestor.Limits.MemoryHigh != 0 {
+			usage.MemoryHighExceeded = h.readMemoryCurrent(ancestor.Path, now) > ancestor.Limits.MemoryHigh
+		}
+	}
+
+	h.usages.Add(node.Path, usage)
+
+	return usage
+}
+
+// GetCGroupUsage returns the instantaneous cpu, memory and pids usage of the cgroup at the provided path, read from
+// cgroupfs and cached for a second
+func (cr *Resolver) GetCGroupUsage(path containerutils.CGroupID) (*cgroupModel.CGroupUsage, bool) {
+	if cr.hierarchy.root == "" || !strings.HasPrefix(string(path), "/") {
+		return nil, false
+	}
+
+	now := time.Now()
+
+	// the node and its usage are resolved under the same lock
+	cr.hierarchy.Lock()
+	defer cr.hierarchy.Unlock()
+
+	node, found := cr.hierarchy.getNode(containerutils.CGroupID(filepath.Clean(string(path))), now)
+	if !found {
+		return nil, false
+	}
+	return cr.hierarchy.getUsage(node, now), true
+}
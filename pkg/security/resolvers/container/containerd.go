@@ -0,0 +1,80 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux && containerd
+
+// Package container holds container related files
+package container
+
+import (
+	"context"
+
+	dderrors "github.com/DataDog/datadog-agent/pkg/errors"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/containerutils"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
+	"github.com/DataDog/datadog-agent/pkg/util/containerd"
+)
+
+const (
+	// annotation set by the CRI plugin on the spec of the containers of a pod
+	sandboxIDAnnotation = "io.kubernetes.cri.sandbox-id"
+	maxSpecSize         = 2 * 1024 * 1024
+)
+
+// containerdClient queries the metadata of the containers from containerd, which backs both the CRI and docker
+type containerdClient struct {
+	client containerd.ContainerdItf
+}
+
+func newContainerdClient() (metadataClient, error) {
+	client, err := containerd.NewContainerdUtil()
+	if err != nil {
+		return nil, err
+	}
+	return &containerdClient{client: client}, nil
+}
+
+// GetContainerMetadata returns the metadata of a container, or nil if containerd doesn't know about it
+func (c *containerdClient) GetContainerMetadata(ctx context.Context, containerID containerutils.ContainerID) (*model.ContainerMetadata, error) {
+	namespaces, err := c.client.Namespaces(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, namespace := range namespaces {
+		ctn, err := c.client.ContainerWithContext(ctx, namespace, string(containerID))
+		if err != nil {
+			if dderrors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+
+		metadata := &model.ContainerMetadata{}
+
+		if image, err := c.client.ImageOfContainer(namespace, ctn); err == nil {
+			metadata.ImageName = image.Name()
+			metadata.ImageDigest = image.Target().Digest.String()
+		}
+
+		if labels, err := c.client.LabelsWithContext(ctx, namespace, ctn); err == nil {
+			metadata.PodName = labels[podNameLabel]
+			metadata.PodNamespace = labels[podNamespaceLabel]
+			metadata.PodUID = labels[podUIDLabel]
+		}
+
+		if metadata.PodUID != "" {
+			if info, err := c.client.Info(namespace, ctn); err == nil {
+				if spec, err := c.client.Spec(namespace, info, maxSpecSize); err == nil {
+					metadata.SandboxID = spec.Annotations[sandboxIDAnnotation]
+				}
+			}
+		}
+
+		return metadata, nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,68 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux && cri
+
+// Package container holds container related files
+package container
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	criv1 "k8s.io/cri-api/pkg/apis/runtime/v1"
+
+	"github.com/DataDog/datadog-agent/pkg/security/secl/containerutils"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
+	"github.com/DataDog/datadog-agent/pkg/util/containers/cri"
+)
+
+// criClient queries the metadata of the containers from the CRI socket, for the runtimes not backed by containerd
+type criClient struct {
+	client *cri.CRIUtil
+}
+
+func newCRIClient() (metadataClient, error) {
+	client, err := cri.GetUtil()
+	if err != nil {
+		return nil, err
+	}
+	return &criClient{client: client}, nil
+}
+
+// GetContainerMetadata returns the metadata of a container, or nil if the runtime doesn't know about it
+func (c *criClient) GetContainerMetadata(ctx context.Context, containerID containerutils.ContainerID) (*model.ContainerMetadata, error) {
+	ctnStatus, err := c.client.GetContainerStatus(ctx, string(containerID))
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	labels := ctnStatus.GetLabels()
+	metadata := &model.ContainerMetadata{
+		ImageName:    ctnStatus.GetImage().GetImage(),
+		PodName:      labels[podNameLabel],
+		PodNamespace: labels[podNamespaceLabel],
+		PodUID:       labels[podUIDLabel],
+	}
+
+	// the image reference is the repository digest of the image, `<repository>@<digest>`
+	if _, digest, found := strings.Cut(ctnStatus.GetImageRef(), "@"); found {
+		metadata.ImageDigest = digest
+	}
+
+	// the status doesn't hold the sandbox of the container
+	if metadata.PodUID != "" {
+		if containers, err := c.client.ListContainers(ctx, &criv1.ContainerFilter{Id: string(containerID)}); err == nil && len(containers) > 0 {
+			metadata.SandboxID = containers[0].GetPodSandboxId()
+		}
+	}
+
+	return metadata, nil
+}
@@ -0,0 +1,15 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux && !cri
+
+// Package container holds container related files
+package container
+
+import "errors"
+
+func newCRIClient() (metadataClient, error) {
+	return nil, errors.New("CRI support not compiled in")
+}
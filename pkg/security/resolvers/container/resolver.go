@@ -9,15 +9,258 @@
 package container
 
 import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/golang-lru/v2/simplelru"
+	"golang.org/x/time/rate"
+
+	cgroupModel "github.com/DataDog/datadog-agent/pkg/security/resolvers/cgroup/model"
 	"github.com/DataDog/datadog-agent/pkg/security/secl/containerutils"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
 	"github.com/DataDog/datadog-agent/pkg/security/utils"
+	"github.com/DataDog/datadog-agent/pkg/util/log"
 )
 
+const (
+	metadataCacheSize  = 1024
+	metadataQueueSize  = 100
+	metadataQueryRate  = 10
+	metadataQueryBurst = 20
+	// maximum duration of a query to the container runtime
+	metadataQueryTimeout = 2 * time.Second
+	// the failed queries are retried with an exponential backoff, up to metadataMaxRetries times
+	metadataRetryInitialDelay = time.Second
+	metadataRetryMaxDelay     = time.Minute
+	metadataMaxRetries        = 8
+	metadataRetryPeriod       = time.Second
+	// maximum number of workloads waiting for a retry
+	metadataMaxPending = 1024
+
+	// labels set by the kubelet on the containers of a pod
+	podNameLabel      = "io.kubernetes.pod.name"
+	podNamespaceLabel = "io.kubernetes.pod.namespace"
+	podUIDLabel       = "io.kubernetes.pod.uid"
+)
+
+// metadataClient queries the metadata of the containers from their runtime
+type metadataClient interface {
+	GetContainerMetadata(ctx context.Context, containerID containerutils.ContainerID) (*model.ContainerMetadata, error)
+}
+
+// pendingWorkload holds a workload whose runtime metadata should be queried again
+type pendingWorkload struct {
+	retries int
+	retryAt time.Time
+}
+
 // Resolver is used to resolve the container context of the events
-type Resolver struct{}
+type Resolver struct {
+	client  metadataClient
+	limiter *rate.Limiter
+	queue   chan *cgroupModel.CacheEntry
+
+	pendingLock sync.Mutex
+	// the workloads which couldn't be queued, or whose query failed, are retried from there
+	pending map[*cgroupModel.CacheEntry]*pendingWorkload
+
+	cacheLock sync.Mutex
+	// the containers unknown to the runtime are cached with a nil metadata so that they aren't queried again
+	cache *simplelru.LRU[containerutils.ContainerID, *model.ContainerMetadata]
+}
+
+// NewResolver returns a new container resolver. The metadata of the containers are queried from the container runtime
+// only when metadataEnabled is set.
+func NewResolver(metadataEnabled bool) (*Resolver, error) {
+	cr := &Resolver{}
+	if !metadataEnabled {
+		return cr, nil
+	}
+
+	client, err := newMetadataClient()
+	if err != nil {
+		log.Warnf("container runtime metadata won't be resolved: %v", err)
+		return cr, nil
+	}
+
+	return newResolverWithClient(client)
+}
+
+// newMetadataClient returns a client of containerd, which backs both its CRI plugin and docker, or of the CRI socket
+// for the other runtimes, such as CRI-O
+func newMetadataClient() (metadataClient, error) {
+	client, containerdErr := newContainerdClient()
+	if containerdErr == nil {
+		return client, nil
+	}
+
+	client, criErr := newCRIClient()
+	if criErr == nil {
+		return client, nil
+	}
+
+	return nil, errors.Join(containerdErr, criErr)
+}
+
+func newResolverWithClient(client metadataClient) (*Resolver, error) {
+	cache, err := simplelru.NewLRU[containerutils.ContainerID, *model.ContainerMetadata](metadataCacheSize, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Resolver{
+		client:  client,
+		limiter: rate.NewLimiter(rate.Limit(metadataQueryRate), metadataQueryBurst),
+		queue:   make(chan *cgroupModel.CacheEntry, metadataQueueSize),
+		pending: make(map[*cgroupModel.CacheEntry]*pendingWorkload),
+		cache:   cache,
+	}, nil
+}
+
+// Start the resolver
+func (cr *Resolver) Start(ctx context.Context) {
+	if cr.client == nil {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(metadataRetryPeriod)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case workload := <-cr.queue:
+				if err := cr.limiter.Wait(ctx); err != nil {
+					return
+				}
+				cr.handleWorkload(ctx, workload, 0)
+			case now := <-ticker.C:
+				for workload, pending := range cr.popPendingWorkloads(now) {
+					if err := cr.limiter.Wait(ctx); err != nil {
+						return
+					}
+					cr.handleWorkload(ctx, workload, pending.retries)
+				}
+			}
+		}
+	}()
+}
 
 // GetContainerContext returns the container id of the given pid along with its flags
 func (cr *Resolver) GetContainerContext(pid uint32) (containerutils.ContainerID, containerutils.CGroupFlags, error) {
 	// Parse /proc/[pid]/task/[pid]/cgroup
 	return utils.GetProcContainerContext(pid, pid)
 }
+
+// GetContainerMetadata returns the cached runtime metadata of a container
+func (cr *Resolver) GetContainerMetadata(containerID containerutils.ContainerID) (*model.ContainerMetadata, bool) {
+	if cr.cache == nil {
+		return nil, false
+	}
+
+	cr.cacheLock.Lock()
+	defer cr.cacheLock.Unlock()
+
+	metadata, found := cr.cache.Get(containerID)
+	return metadata, found && metadata != nil
+}
+
+// OnCGroupCreatedEvent is used to schedule the resolution of the runtime metadata of the new containers. It is called
+// with the cgroup resolver lock held and thus doesn't query the runtime itself.
+func (cr *Resolver) OnCGroupCreatedEvent(workload *cgroupModel.CacheEntry) {
+	if cr.client == nil || workload.ContainerID == "" || !workload.CGroupFlags.IsContainer() {
+		return
+	}
+
+	select {
+	case cr.queue <- workload:
+	default:
+		// the queue is full, the workload will be picked up by the next retry pass
+		cr.schedulePendingWorkload(workload, 0, time.Now())
+	}
+}
+
+// handleWorkload resolves the runtime metadata of the provided workload, scheduling a retry on failure
+func (cr *Resolver) handleWorkload(ctx context.Context, workload *cgroupModel.CacheEntry, retries int) {
+	if err := cr.resolveMetadata(ctx, workload); err != nil {
+		log.Debugf("failed to resolve the runtime metadata of container %s: %v", workload.ContainerID, err)
+		cr.schedulePendingWorkload(workload, retries+1, time.Now())
+	}
+}
+
+// schedulePendingWorkload schedules a new resolution of the runtime metadata of the provided workload, the delay
+// doubling with each retry
+func (cr *Resolver) schedulePendingWorkload(workload *cgroupModel.CacheEntry, retries int, now time.Time) {
+	if retries > metadataMaxRetries {
+		return
+	}
+
+	var delay time.Duration
+	if retries > 0 {
+		delay = min(metadataRetryInitialDelay<<(retries-1), metadataRetryMaxDelay)
+	}
+
+	cr.pendingLock.Lock()
+	defer cr.pendingLock.Unlock()
+
+	if _, exists := cr.pending[workload]; !exists && len(cr.pending) >= metadataMaxPending {
+		return
+	}
+	cr.pending[workload] = &pendingWorkload{
+		retries: retries,
+		retryAt: now.Add(delay),
+	}
+}
+
+// popPendingWorkloads returns the pending workloads whose retry is due, removing them from the pending ones
+func (cr *Resolver) popPendingWorkloads(now time.Time) map[*cgroupModel.CacheEntry]*pendingWorkload {
+	cr.pendingLock.Lock()
+	defer cr.pendingLock.Unlock()
+
+	var due map[*cgroupModel.CacheEntry]*pendingWorkload
+	for workload, pending := range cr.pending {
+		if pending.retryAt.After(now) {
+			continue
+		}
+		if due == nil {
+			due = make(map[*cgroupModel.CacheEntry]*pendingWorkload)
+		}
+		due[workload] = pending
+		delete(cr.pending, workload)
+	}
+	return due
+}
+
+// resolveMetadata attaches the runtime metadata of its container to the provided workload
+func (cr *Resolver) resolveMetadata(ctx context.Context, workload *cgroupModel.CacheEntry) error {
+	if workload.Deleted.Load() {
+		return nil
+	}
+
+	cr.cacheLock.Lock()
+	metadata, found := cr.cache.Get(workload.ContainerID)
+	cr.cacheLock.Unlock()
+
+	if !found {
+		queryCtx, cancel := context.WithTimeout(ctx, metadataQueryTimeout)
+		defer cancel()
+
+		var err error
+		if metadata, err = cr.client.GetContainerMetadata(queryCtx, workload.ContainerID); err != nil {
+			return err
+		}
+
+		cr.cacheLock.Lock()
+		cr.cache.Add(workload.ContainerID, metadata)
+		cr.cacheLock.Unlock()
+	}
+
+	if metadata != nil {
+		workload.SetContainerMetadata(metadata)
+	}
+	return nil
+}
@@ -0,0 +1,186 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+// Package container holds container related files
+package container
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	cgroupModel "github.com/DataDog/datadog-agent/pkg/security/resolvers/cgroup/model"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/containerutils"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
+)
+
+type fakeMetadataClient struct {
+	metadata map[containerutils.ContainerID]*model.ContainerMetadata
+	err      error
+	queries  int
+}
+
+func (c *fakeMetadataClient) GetContainerMetadata(_ context.Context, containerID containerutils.ContainerID) (*model.ContainerMetadata, error) {
+	c.queries++
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.metadata[containerID], nil
+}
+
+func TestResolveMetadata(t *testing.T) {
+	client := &fakeMetadataClient{
+		metadata: map[containerutils.ContainerID]*model.ContainerMetadata{
+			"aaaa": {
+				ImageName:    "docker.io/library/nginx:1.27",
+				ImageDigest:  "sha256:0123456789abcdef",
+				PodName:      "nginx-7c5ddbdf54-x7k2p",
+				PodNamespace: "default",
+				PodUID:       "4f2b5e0e-2f47-4d4b-9c44-3d1b7d1e6a10",
+				SandboxID:    "bbbb",
+			},
+		},
+	}
+
+	cr, err := newResolverWithClient(client)
+	assert.NoError(t, err)
+
+	newWorkload := func(containerID string) *cgroupModel.CacheEntry {
+		workload, err := cgroupModel.NewCacheEntry(containerID, uint64(containerutils.CGroupManagerCRI))
+		assert.NoError(t, err)
+		return workload
+	}
+
+	t.Run("known", func(t *testing.T) {
+		workload := newWorkload("aaaa")
+		assert.NoError(t, cr.resolveMetadata(context.Background(), workload))
+		assert.Equal(t, "docker.io/library/nginx:1.27", workload.GetMetadata().ImageName)
+		assert.Equal(t, "sha256:0123456789abcdef", workload.GetMetadata().ImageDigest)
+		assert.Equal(t, "nginx-7c5ddbdf54-x7k2p", workload.GetMetadata().PodName)
+		assert.Equal(t, "default", workload.GetMetadata().PodNamespace)
+		assert.Equal(t, "bbbb", workload.GetMetadata().SandboxID)
+
+		metadata, found := cr.GetContainerMetadata("aaaa")
+		assert.True(t, found)
+		assert.Equal(t, "4f2b5e0e-2f47-4d4b-9c44-3d1b7d1e6a10", metadata.PodUID)
+	})
+
+	t.Run("cached", func(t *testing.T) {
+		queries := client.queries
+		workload := newWorkload("aaaa")
+		assert.NoError(t, cr.resolveMetadata(context.Background(), workload))
+		assert.Equal(t, "docker.io/library/nginx:1.27", workload.GetMetadata().ImageName)
+		assert.Equal(t, queries, client.queries)
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		workload := newWorkload("cccc")
+		assert.NoError(t, cr.resolveMetadata(context.Background(), workload))
+		assert.Nil(t, workload.GetMetadata())
+
+		_, found := cr.GetContainerMetadata("cccc")
+		assert.False(t, found)
+
+		queries := client.queries
+		assert.NoError(t, cr.resolveMetadata(context.Background(), workload))
+		assert.Equal(t, queries, client.queries)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		client.err = errors.New("connection refused")
+		defer func() { client.err = nil }()
+
+		assert.Error(t, cr.resolveMetadata(context.Background(), newWorkload("dddd")))
+		_, found := cr.GetContainerMetadata("dddd")
+		assert.False(t, found)
+
+		// failed queries are retried
+		client.err = nil
+		queries := client.queries
+		assert.NoError(t, cr.resolveMetadata(context.Background(), newWorkload("dddd")))
+		assert.Equal(t, queries+1, client.queries)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		cr, err := NewResolver(false)
+		assert.NoError(t, err)
+
+		workload := newWorkload("aaaa")
+		cr.OnCGroupCreatedEvent(workload)
+		_, found := cr.GetContainerMetadata("aaaa")
+		assert.False(t, found)
+	})
+}
+
+func TestResolveMetadataRetry(t *testing.T) {
+	client := &fakeMetadataClient{
+		metadata: map[containerutils.ContainerID]*model.ContainerMetadata{
+			"aaaa": {ImageName: "docker.io/library/nginx:1.27"},
+		},
+		err: errors.New("connection refused"),
+	}
+
+	cr, err := newResolverWithClient(client)
+	assert.NoError(t, err)
+
+	workload, err := cgroupModel.NewCacheEntry("aaaa", uint64(containerutils.CGroupManagerCRI))
+	assert.NoError(t, err)
+
+	// the failed query is retried after the initial delay
+	now := time.Now()
+	cr.handleWorkload(context.Background(), workload, 0)
+	assert.Nil(t, workload.GetMetadata())
+	assert.Empty(t, cr.popPendingWorkloads(now))
+
+	due := cr.popPendingWorkloads(now.Add(metadataRetryInitialDelay + time.Second))
+	assert.Len(t, due, 1)
+	assert.Equal(t, 1, due[workload].retries)
+
+	// the delay doubles with each retry
+	cr.handleWorkload(context.Background(), workload, due[workload].retries)
+	assert.Empty(t, cr.popPendingWorkloads(now.Add(metadataRetryInitialDelay+time.Second)))
+	due = cr.popPendingWorkloads(now.Add(2*metadataRetryInitialDelay + time.Second))
+	assert.Len(t, due, 1)
+	assert.Equal(t, 2, due[workload].retries)
+
+	client.err = nil
+	cr.handleWorkload(context.Background(), workload, due[workload].retries)
+	assert.Equal(t, "docker.io/library/nginx:1.27", workload.GetMetadata().ImageName)
+	assert.Empty(t, cr.popPendingWorkloads(now.Add(metadataRetryMaxDelay*2)))
+
+	// the workload is dropped after the last retry
+	client.err = errors.New("connection refused")
+	cr.handleWorkload(context.Background(), workload, metadataMaxRetries)
+	assert.Empty(t, cr.popPendingWorkloads(now.Add(metadataRetryMaxDelay*2)))
+}
+
+func TestOnCGroupCreatedEventQueueFull(t *testing.T) {
+	cr, err := newResolverWithClient(&fakeMetadataClient{})
+	assert.NoError(t, err)
+
+	newWorkload := func(containerID string) *cgroupModel.CacheEntry {
+		workload, err := cgroupModel.NewCacheEntry(containerID, uint64(containerutils.CGroupManagerCRI))
+		assert.NoError(t, err)
+		return workload
+	}
+
+	for i := 0; i < metadataQueueSize; i++ {
+		cr.OnCGroupCreatedEvent(newWorkload(fmt.Sprintf("%064x", i)))
+	}
+	assert.Empty(t, cr.popPendingWorkloads(time.Now()))
+
+	// the workloads which don't fit in the queue are picked up by the next retry pass
+	workload := newWorkload("eeee")
+	cr.OnCGroupCreatedEvent(workload)
+	due := cr.popPendingWorkloads(time.Now())
+	assert.Len(t, due, 1)
+	assert.Contains(t, due, workload)
+}
@@ -9,9 +9,14 @@
 package dentry
 
 import (
+	"encoding/binary"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/DataDog/datadog-agent/pkg/security/probe/config"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
 )
 
 func TestComputeFilenameFromParts(t *testing.T) {
@@ -44,3 +49,89 @@ func TestComputeFilenameFromParts(t *testing.T) {
 		})
 	}
 }
+
+func TestParseERPCPath(t *testing.T) {
+	const challenge = 42
+
+	segment := make([]byte, 4096)
+	cursor := 0
+	writeEntry := func(inode uint64, name string, challenge uint32) {
+		binary.NativeEndian.PutUint64(segment[cursor:cursor+8], inode)
+		binary.NativeEndian.PutUint32(segment[cursor+8:cursor+12], 1)
+		binary.NativeEndian.PutUint32(segment[cursor+12:cursor+16], challenge)
+		cursor += 16
+		cursor += copy(segment[cursor:], name+"\x00")
+	}
+
+	// /etc/passwd, a key missing from the kernel cache, then /usr, followed by a key the kernel didn't get to
+	writeEntry(12, "passwd", challenge)
+	writeEntry(11, "etc", challenge)
+	writeEntry(2, "/", challenge)
+	writeEntry(15, "", challenge)
+	writeEntry(13, "usr", challenge)
+	writeEntry(2, "/", challenge)
+	writeEntry(14, "stale", challenge-1)
+
+	dr := &Resolver{
+		erpcSegment:     segment,
+		erpcSegmentSize: len(segment),
+	}
+
+	next, depth, path, err := dr.parseERPCPath(0, challenge, false)
+	assert.NoError(t, err)
+	assert.Equal(t, "/etc/passwd", path)
+	assert.Equal(t, int64(3), depth)
+
+	next, _, _, err = dr.parseERPCPath(next, challenge, false)
+	assert.ErrorIs(t, err, errERPCResolution)
+
+	next, depth, path, err = dr.parseERPCPath(next, challenge, false)
+	assert.NoError(t, err)
+	assert.Equal(t, "/usr", path)
+	assert.Equal(t, int64(2), depth)
+
+	_, _, _, err = dr.parseERPCPath(next, challenge, false)
+	assert.ErrorIs(t, err, errERPCRequestNotProcessed)
+}
+
+func TestResolverConcurrentAccess(t *testing.T) {
+	dr, err := NewResolver(&config.Config{DentryCacheSize: 64}, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	root := model.PathKey{Inode: 2, MountID: 1}
+	etc := model.PathKey{Inode: 11, MountID: 1}
+	passwd := model.PathKey{Inode: 12, MountID: 1}
+
+	// the resolutions may run from the event path and from the background workers of the process resolver, the cache
+	// being filled and flushed concurrently
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(3)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				dr.Lock()
+				_ = dr.cacheEntries([]model.PathKey{passwd, etc, root}, []string{"passwd", "etc", "/"})
+				dr.Unlock()
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				if path, err := dr.ResolveFromCache(passwd); err == nil {
+					assert.Equal(t, "/etc/passwd", path)
+				}
+				_, _ = dr.GetParent(passwd)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				dr.DelCacheEntries(1)
+			}
+		}()
+	}
+	wg.Wait()
+}
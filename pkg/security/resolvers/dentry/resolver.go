@@ -17,6 +17,7 @@ import (
 	"math/rand"
 	"os"
 	"strings"
+	"sync"
 	"unsafe"
 
 	"github.com/DataDog/datadog-go/v5/statsd"
@@ -40,6 +41,14 @@ var (
 	fakeInodeMSW = uint64(0xdeadc001)
 )
 
+const (
+	// layout of the batched path resolution requests, the header being the one of the single requests
+	erpcBatchCountOffset = 32
+	erpcBatchKeysOffset  = 40
+	// erpcMaxBatchSize is the number of path keys fitting in the data of a batched request
+	erpcMaxBatchSize = (erpc.ERPCDefaultDataSize - erpcBatchKeysOffset) / model.PathKeySize
+)
+
 type counterEntry struct {
 	resolutionType string
 	resolution     string
@@ -51,6 +60,9 @@ func (ce *counterEntry) Tags() []string {
 
 // Resolver resolves inode/mountID to full paths
 type Resolver struct {
+	// the lock serializes the resolutions, which share the eRPC request and segment, the buffers and the cache
+	sync.Mutex
+
 	config                *config.Config
 	statsdClient          statsd.ClientInterface
 	pathnames             *lib.Map
@@ -183,6 +195,9 @@ func (dr *Resolver) sendERPCStats() error {
 
 // DelCacheEntries removes all the entries belonging to a mountID
 func (dr *Resolver) DelCacheEntries(mountID uint32) {
+	dr.Lock()
+	defer dr.Unlock()
+
 	delete(dr.cache, mountID)
 }
 
@@ -221,6 +236,14 @@ func (dr *Resolver) cacheInode(key model.PathKey, path PathEntry) error {
 
 // ResolveNameFromCache returns the name
 func (dr *Resolver) ResolveNameFromCache(pathKey model.PathKey) (string, error) {
+	dr.Lock()
+	defer dr.Unlock()
+
+	return dr.resolveNameFromCache(pathKey)
+}
+
+// resolveNameFromCache returns the name, with the resolver lock held
+func (dr *Resolver) resolveNameFromCache(pathKey model.PathKey) (string, error) {
 	entry := counterEntry{
 		resolutionType: metrics.CacheTag,
 		resolution:     metrics.SegmentResolutionTag,
@@ -253,6 +276,14 @@ func newPathEntry(parent model.PathKey, name string) PathEntry {
 
 // ResolveNameFromMap resolves the name of the provided inode
 func (dr *Resolver) ResolveNameFromMap(pathKey model.PathKey) (string, error) {
+	dr.Lock()
+	defer dr.Unlock()
+
+	return dr.resolveNameFromMap(pathKey)
+}
+
+// resolveNameFromMap resolves the name of the provided inode, with the resolver lock held
+func (dr *Resolver) resolveNameFromMap(pathKey model.PathKey) (string, error) {
 	entry := counterEntry{
 		resolutionType: metrics.KernelMapsTag,
 		resolution:     metrics.SegmentResolutionTag,
@@ -279,9 +310,17 @@ func (dr *Resolver) ResolveNameFromMap(pathKey model.PathKey) (string, error) {
 
 // ResolveName resolves an inode/mount ID pair to a file basename
 func (dr *Resolver) ResolveName(pathKey model.PathKey) string {
-	name, err := dr.ResolveNameFromCache(pathKey)
+	dr.Lock()
+	defer dr.Unlock()
+
+	return dr.resolveName(pathKey)
+}
+
+// resolveName resolves an inode/mount ID pair to a file basename, with the resolver lock held
+func (dr *Resolver) resolveName(pathKey model.PathKey) string {
+	name, err := dr.resolveNameFromCache(pathKey)
 	if err != nil && dr.config.MapDentryResolutionEnabled {
-		name, err = dr.ResolveNameFromMap(pathKey)
+		name, err = dr.resolveNameFromMap(pathKey)
 	}
 
 	if err != nil {
@@ -292,6 +331,14 @@ func (dr *Resolver) ResolveName(pathKey model.PathKey) string {
 
 // ResolveFromCache resolves path from the cache
 func (dr *Resolver) ResolveFromCache(pathKey model.PathKey) (string, error) {
+	dr.Lock()
+	defer dr.Unlock()
+
+	return dr.resolveFromCache(pathKey)
+}
+
+// resolveFromCache resolves path from the cache, with the resolver lock held
+func (dr *Resolver) resolveFromCache(pathKey model.PathKey) (string, error) {
 	var path PathEntry
 	var err error
 	depth := int64(0)
@@ -357,6 +404,14 @@ func computeFilenameFromParts(parts []string) string {
 
 // ResolveFromMap resolves the path of the provided inode / mount id / path id
 func (dr *Resolver) ResolveFromMap(pathKey model.PathKey, cache bool) (string, error) {
+	dr.Lock()
+	defer dr.Unlock()
+
+	return dr.resolveFromMap(pathKey, cache)
+}
+
+// resolveFromMap resolves the path of the provided inode / mount id / path id, with the resolver lock held
+func (dr *Resolver) resolveFromMap(pathKey model.PathKey, cache bool) (string, error) {
 	var resolutionErr error
 
 	keyBuffer, err := pathKey.MarshalBinary()
@@ -465,6 +520,35 @@ func (dr *Resolver) requestResolve(op uint8, pathKey model.PathKey) (uint32, err
 	return challenge, dr.erpc.Request(dr.erpcRequest)
 }
 
+// requestResolveBatch sends an eRPC request resolving the paths of the provided path keys, which are written one after
+// the other in the eRPC segment
+func (dr *Resolver) requestResolveBatch(pathKeys []model.PathKey) (uint32, error) {
+	challenge := dr.challenge
+	dr.challenge++
+
+	// the header of the request is the one of a single request for the first path key
+	dr.erpcRequest.OP = erpc.ResolvePathsOp
+	binary.NativeEndian.PutUint64(dr.erpcRequest.Data[0:8], pathKeys[0].Inode)
+	binary.NativeEndian.PutUint32(dr.erpcRequest.Data[8:12], pathKeys[0].MountID)
+	binary.NativeEndian.PutUint32(dr.erpcRequest.Data[12:16], pathKeys[0].PathID)
+	// 16-28 populated at start
+	binary.NativeEndian.PutUint32(dr.erpcRequest.Data[28:32], challenge)
+	binary.NativeEndian.PutUint32(dr.erpcRequest.Data[erpcBatchCountOffset:erpcBatchCountOffset+4], uint32(len(pathKeys)))
+
+	for i, pathKey := range pathKeys {
+		offset := erpcBatchKeysOffset + i*model.PathKeySize
+		binary.NativeEndian.PutUint64(dr.erpcRequest.Data[offset:offset+8], pathKey.Inode)
+		binary.NativeEndian.PutUint32(dr.erpcRequest.Data[offset+8:offset+12], pathKey.MountID)
+		binary.NativeEndian.PutUint32(dr.erpcRequest.Data[offset+12:offset+16], pathKey.PathID)
+	}
+
+	if dr.useBPFProgWriteUser {
+		dr.preventSegmentMajorPageFault()
+	}
+
+	return challenge, dr.erpc.Request(dr.erpcRequest)
+}
+
 func (dr *Resolver) cacheEntries(keys []model.PathKey, names []string) error {
 	if len(keys) != len(names) {
 		return errors.New("out of bound")
@@ -507,6 +591,14 @@ func (dr *Resolver) computeSegmentCount() int {
 
 // ResolveFromERPC resolves the path of the provided inode / mount id / path id
 func (dr *Resolver) ResolveFromERPC(pathKey model.PathKey, cache bool) (string, error) {
+	dr.Lock()
+	defer dr.Unlock()
+
+	return dr.resolveFromERPC(pathKey, cache)
+}
+
+// resolveFromERPC resolves the path of the provided inode / mount id / path id, with the resolver lock held
+func (dr *Resolver) resolveFromERPC(pathKey model.PathKey, cache bool) (string, error) {
 	var resolutionErr error
 	depth := int64(0)
 
@@ -585,25 +677,233 @@ func (dr *Resolver) ResolveFromERPC(pathKey model.PathKey, cache bool) (string,
 	return computeFilenameFromParts(dr.filenameParts), resolutionErr
 }
 
+// parseERPCPath parses the path written by the kernel at the provided offset of the eRPC segment, returning the offset
+// of the following path along with the depth of the path
+func (dr *Resolver) parseERPCPath(i int, challenge uint32, cache bool) (int, int64, string, error) {
+	var pathKey model.PathKey
+	depth := int64(0)
+
+	dr.prepareBuffersWithCapacity(32)
+
+	for {
+		// make sure that we keep room for at least one pathKey + character + \0 => (sizeof(pathID) + 1 = 17)
+		if i >= dr.erpcSegmentSize-17 {
+			return i, depth, "", errERPCRequestNotProcessed
+		}
+		depth++
+
+		pathKey.Inode = binary.NativeEndian.Uint64(dr.erpcSegment[i : i+8])
+		pathKey.MountID = binary.NativeEndian.Uint32(dr.erpcSegment[i+8 : i+12])
+
+		// the keys the kernel didn't get to aren't followed by the challenge of the request
+		if challenge != binary.NativeEndian.Uint32(dr.erpcSegment[i+12:i+16]) {
+			if depth >= model.MaxPathDepth {
+				return i, depth, "", errTruncatedParentsERPC
+			}
+			return i, depth, "", errERPCRequestNotProcessed
+		}
+
+		// skip PathID
+		i += 16
+
+		// the empty segments end the paths the kernel failed to resolve, the following path starting right after
+		if dr.erpcSegment[i] == 0 {
+			if depth >= model.MaxPathDepth {
+				return i + 1, depth, "", errTruncatedParentsERPC
+			}
+			return i + 1, depth, "", errERPCResolution
+		}
+
+		segment := model.NullTerminatedString(dr.erpcSegment[i:])
+		i += len(segment) + 1
+
+		if segment[0] == '/' {
+			break
+		}
+
+		dr.filenameParts = append(dr.filenameParts, segment)
+
+		if !IsFakeInode(pathKey.Inode) && cache {
+			dr.keys = append(dr.keys, pathKey)
+			dr.cacheNameEntries = append(dr.cacheNameEntries, segment)
+		}
+	}
+
+	if len(dr.keys) > 0 {
+		if err := dr.cacheEntries(dr.keys, dr.cacheNameEntries); err != nil {
+			return i, depth, "", err
+		}
+	}
+
+	return i, depth, computeFilenameFromParts(dr.filenameParts), nil
+}
+
+// ResolveBatchFromERPC resolves the paths of the provided path keys, several of them per eRPC request. The paths that
+// couldn't be resolved are reported with an error, ErrERPCRequestNotProcessed for the ones the kernel didn't get to.
+func (dr *Resolver) ResolveBatchFromERPC(pathKeys []model.PathKey, cache bool) ([]string, []error) {
+	dr.Lock()
+	defer dr.Unlock()
+
+	return dr.resolveBatchFromERPC(pathKeys, cache)
+}
+
+// resolveBatchFromERPC resolves the paths of the provided path keys, several of them per eRPC request. The paths that
+// couldn't be resolved are reported with an error, ErrERPCRequestNotProcessed for the ones the kernel didn't get to.
+// The resolver lock has to be held.
+func (dr *Resolver) resolveBatchFromERPC(pathKeys []model.PathKey, cache bool) ([]string, []error) {
+	paths := make([]string, len(pathKeys))
+	errs := make([]error, len(pathKeys))
+
+	entry := counterEntry{
+		resolutionType: metrics.ERPCTag,
+		resolution:     metrics.PathResolutionTag,
+	}
+
+	for start := 0; start < len(pathKeys); {
+		end := min(start+erpcMaxBatchSize, len(pathKeys))
+
+		challenge, err := dr.requestResolveBatch(pathKeys[start:end])
+		if err != nil {
+			err = fmt.Errorf("unable to resolve a batch of %d paths with eRPC: %w", end-start, err)
+			for i := start; i < end; i++ {
+				errs[i] = err
+			}
+			dr.missCounters[entry].Add(int64(end - start))
+			start = end
+			continue
+		}
+
+		cursor, next := 0, end
+		for i := start; i < end; i++ {
+			var depth int64
+			cursor, depth, paths[i], errs[i] = dr.parseERPCPath(cursor, challenge, cache)
+			if errs[i] == errERPCRequestNotProcessed {
+				// the kernel ran out of tail calls, the keys it didn't get to are sent in the next request
+				next = i
+				break
+			}
+
+			if errs[i] != nil {
+				dr.missCounters[entry].Inc()
+			} else {
+				dr.hitsCounters[entry].Add(depth)
+			}
+		}
+
+		// the keys of a request the kernel didn't process at all are left to the single requests
+		if next == start {
+			for i := start; i < end; i++ {
+				errs[i] = errERPCRequestNotProcessed
+			}
+			dr.missCounters[entry].Add(int64(end - start))
+			next = end
+		}
+		start = next
+	}
+
+	return paths, errs
+}
+
+// ResolveBatch resolves the pathnames of the provided path keys. The ones missing from the cache are resolved with
+// batched eRPC requests, the kernel maps being used for the ones the eRPC requests failed to resolve.
+func (dr *Resolver) ResolveBatch(pathKeys []model.PathKey, cache bool) ([]string, []error) {
+	dr.Lock()
+	defer dr.Unlock()
+
+	return dr.resolveBatch(pathKeys, cache)
+}
+
+// resolveBatch resolves the pathnames of the provided path keys. The ones missing from the cache are resolved with
+// batched eRPC requests, the kernel maps being used for the ones the eRPC requests failed to resolve. The resolver lock
+// has to be held.
+func (dr *Resolver) resolveBatch(pathKeys []model.PathKey, cache bool) ([]string, []error) {
+	paths := make([]string, len(pathKeys))
+	errs := make([]error, len(pathKeys))
+
+	var pending []int
+	for i, pathKey := range pathKeys {
+		if cache {
+			if paths[i], errs[i] = dr.resolveFromCache(pathKey); errs[i] == nil {
+				continue
+			}
+		}
+		pending = append(pending, i)
+	}
+
+	if len(pending) == 0 {
+		return paths, errs
+	}
+
+	if !dr.config.ERPCDentryResolutionEnabled {
+		for _, i := range pending {
+			paths[i], errs[i] = "", ErrEntryNotFound
+			if dr.config.MapDentryResolutionEnabled {
+				paths[i], errs[i] = dr.resolveFromMap(pathKeys[i], cache)
+			}
+		}
+		return paths, errs
+	}
+
+	keys := make([]model.PathKey, len(pending))
+	for j, i := range pending {
+		keys[j] = pathKeys[i]
+	}
+	batchPaths, batchErrs := dr.resolveBatchFromERPC(keys, cache)
+
+	for j, i := range pending {
+		paths[i], errs[i] = batchPaths[j], batchErrs[j]
+
+		switch {
+		case errs[i] == nil, errs[i] == errTruncatedParentsERPC:
+		case errs[i] == errERPCRequestNotProcessed:
+			// the keys the kernel didn't get to are resolved one by one
+			paths[i], errs[i] = dr.resolveFromERPC(pathKeys[i], cache)
+			if errs[i] != nil && errs[i] != errTruncatedParentsERPC && dr.config.MapDentryResolutionEnabled {
+				paths[i], errs[i] = dr.resolveFromMap(pathKeys[i], cache)
+			}
+		case dr.config.MapDentryResolutionEnabled:
+			paths[i], errs[i] = dr.resolveFromMap(pathKeys[i], cache)
+		}
+	}
+
+	return paths, errs
+}
+
 // Resolve the pathname of a dentry, starting at the pathnameKey in the pathnames table
 func (dr *Resolver) Resolve(pathKey model.PathKey, cache bool) (string, error) {
+	dr.Lock()
+	defer dr.Unlock()
+
+	return dr.resolve(pathKey, cache)
+}
+
+// resolve resolves the pathname of a dentry, starting at the pathnameKey in the pathnames table, with the resolver lock held
+func (dr *Resolver) resolve(pathKey model.PathKey, cache bool) (string, error) {
 	var path string
 	var err = ErrEntryNotFound
 
 	if cache {
-		path, err = dr.ResolveFromCache(pathKey)
+		path, err = dr.resolveFromCache(pathKey)
 	}
 	if err != nil && dr.config.ERPCDentryResolutionEnabled {
-		path, err = dr.ResolveFromERPC(pathKey, cache)
+		path, err = dr.resolveFromERPC(pathKey, cache)
 	}
 	if err != nil && err != errTruncatedParentsERPC && dr.config.MapDentryResolutionEnabled {
-		path, err = dr.ResolveFromMap(pathKey, cache)
+		path, err = dr.resolveFromMap(pathKey, cache)
 	}
 	return path, err
 }
 
 // ResolveParentFromCache resolves the parent
 func (dr *Resolver) ResolveParentFromCache(pathKey model.PathKey) (model.PathKey, error) {
+	dr.Lock()
+	defer dr.Unlock()
+
+	return dr.resolveParentFromCache(pathKey)
+}
+
+// resolveParentFromCache resolves the parent, with the resolver lock held
+func (dr *Resolver) resolveParentFromCache(pathKey model.PathKey) (model.PathKey, error) {
 	entry := counterEntry{
 		resolutionType: metrics.CacheTag,
 		resolution:     metrics.ParentResolutionTag,
@@ -621,6 +921,14 @@ func (dr *Resolver) ResolveParentFromCache(pathKey model.PathKey) (model.PathKey
 
 // ResolveParentFromMap resolves the parent
 func (dr *Resolver) ResolveParentFromMap(pathKey model.PathKey) (model.PathKey, error) {
+	dr.Lock()
+	defer dr.Unlock()
+
+	return dr.resolveParentFromMap(pathKey)
+}
+
+// resolveParentFromMap resolves the parent, with the resolver lock held
+func (dr *Resolver) resolveParentFromMap(pathKey model.PathKey) (model.PathKey, error) {
 	entry := counterEntry{
 		resolutionType: metrics.KernelMapsTag,
 		resolution:     metrics.ParentResolutionTag,
@@ -638,9 +946,17 @@ func (dr *Resolver) ResolveParentFromMap(pathKey model.PathKey) (model.PathKey,
 
 // GetParent returns the parent mount_id/inode
 func (dr *Resolver) GetParent(pathKey model.PathKey) (model.PathKey, error) {
-	pathKey, err := dr.ResolveParentFromCache(pathKey)
+	dr.Lock()
+	defer dr.Unlock()
+
+	return dr.getParent(pathKey)
+}
+
+// getParent returns the parent mount_id/inode, with the resolver lock held
+func (dr *Resolver) getParent(pathKey model.PathKey) (model.PathKey, error) {
+	pathKey, err := dr.resolveParentFromCache(pathKey)
 	if err != nil && dr.config.MapDentryResolutionEnabled {
-		pathKey, err = dr.ResolveParentFromMap(pathKey)
+		pathKey, err = dr.resolveParentFromMap(pathKey)
 	}
 
 	if pathKey.Inode == 0 {
@@ -730,6 +1046,9 @@ func (dr *Resolver) Start(manager *manager.Manager) error {
 
 // ToJSON return a json version of the cache
 func (dr *Resolver) ToJSON() ([]byte, error) {
+	dr.Lock()
+	defer dr.Unlock()
+
 	dump := struct {
 		Entries []json.RawMessage
 	}{}
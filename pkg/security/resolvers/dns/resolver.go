@@ -0,0 +1,109 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+// Package dns holds dns related files
+package dns
+
+import (
+	"hash/fnv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/golang-lru/v2/simplelru"
+	"golang.org/x/net/publicsuffix"
+
+	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
+	"github.com/DataDog/datadog-agent/pkg/security/utils"
+)
+
+const (
+	// trackingWindow is the duration over which the queries of a process to a domain are counted
+	trackingWindow = time.Minute
+	// maxTrackedDomains is the maximum number of (process, domain) pairs tracked at the same time
+	maxTrackedDomains = 4096
+	// maxTrackedSubdomains is the maximum number of distinct subdomains counted per (process, domain) pair, the count
+	// saturates above it
+	maxTrackedSubdomains = 1024
+)
+
+type domainKey struct {
+	pid    uint32
+	domain string
+}
+
+type domainStats struct {
+	windowStart time.Time
+	queries     int
+	subdomains  map[uint64]struct{}
+}
+
+// Resolver tracks the DNS queries of each process to compute the heuristics used to detect DNS tunneling: the number
+// of distinct subdomains of a domain, the query rate to a domain and the entropy of the queried subdomain
+type Resolver struct {
+	sync.Mutex
+	stats *simplelru.LRU[domainKey, *domainStats]
+	now   func() time.Time
+}
+
+// NewResolver returns a new DNS resolver
+func NewResolver() (*Resolver, error) {
+	stats, err := simplelru.NewLRU[domainKey, *domainStats](maxTrackedDomains, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Resolver{
+		stats: stats,
+		now:   time.Now,
+	}, nil
+}
+
+// splitName returns the registered domain of the name, according to the public suffix list, and the subdomain
+// preceding it
+func splitName(name string) (string, string) {
+	name = strings.TrimSuffix(strings.ToLower(name), ".")
+
+	domain, err := publicsuffix.EffectiveTLDPlusOne(name)
+	if err != nil || domain == name {
+		return name, ""
+	}
+	return domain, strings.TrimSuffix(name, "."+domain)
+}
+
+// ResolveQueryStats records the query of the event and fills its heuristics
+func (r *Resolver) ResolveQueryStats(pid uint32, event *model.DNSEvent) {
+	domain, subdomain := splitName(event.Name)
+	if subdomain != "" {
+		event.LabelEntropy = int(utils.ShannonEntropy(strings.ReplaceAll(subdomain, ".", "")) * 100)
+	}
+
+	now := r.now()
+	key := domainKey{pid: pid, domain: domain}
+
+	r.Lock()
+	defer r.Unlock()
+
+	stats, found := r.stats.Get(key)
+	if !found || now.Sub(stats.windowStart) >= trackingWindow {
+		stats = &domainStats{
+			windowStart: now,
+			subdomains:  make(map[uint64]struct{}),
+		}
+		r.stats.Add(key, stats)
+	}
+
+	stats.queries++
+	if subdomain != "" && len(stats.subdomains) < maxTrackedSubdomains {
+		h := fnv.New64a()
+		_, _ = h.Write([]byte(subdomain))
+		stats.subdomains[h.Sum64()] = struct{}{}
+	}
+
+	event.DomainQueryRate = stats.queries
+	event.UniqueSubdomains = len(stats.subdomains)
+}
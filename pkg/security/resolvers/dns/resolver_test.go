@@ -0,0 +1,79 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+// Package dns holds dns related files
+package dns
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
+)
+
+func TestSplitName(t *testing.T) {
+	for name, expected := range map[string][2]string{
+		"datadoghq.com":                  {"datadoghq.com", ""},
+		"app.datadoghq.com.":             {"datadoghq.com", "app"},
+		"a.b.Example.co.uk":              {"example.co.uk", "a.b"},
+		"bbc.co.uk":                      {"bbc.co.uk", ""},
+		"aGVsbG8.tunnel.attacker.io":     {"attacker.io", "agvsbg8.tunnel"},
+		"localhost":                      {"localhost", ""},
+		"intake.logs.datadoghq.eu":       {"datadoghq.eu", "intake.logs"},
+		"s3.eu-west-3.amazonaws.com":     {"s3.eu-west-3.amazonaws.com", ""},
+		"a.b.s3.eu-west-3.amazonaws.com": {"b.s3.eu-west-3.amazonaws.com", "a"},
+		"a.b.c.k8s.io":                   {"k8s.io", "a.b.c"},
+		"www.example.com.br":             {"example.com.br", "www"},
+		"ec2.us-east-1.amazonaws.co.jp":  {"amazonaws.co.jp", "ec2.us-east-1"},
+	} {
+		domain, subdomain := splitName(name)
+		assert.Equal(t, expected, [2]string{domain, subdomain}, name)
+	}
+}
+
+func TestResolveQueryStats(t *testing.T) {
+	resolver, err := NewResolver()
+	assert.NoError(t, err)
+
+	now := time.Now()
+	resolver.now = func() time.Time { return now }
+
+	var event model.DNSEvent
+	for i := 0; i < 10; i++ {
+		event = model.DNSEvent{Name: fmt.Sprintf("%x.tunnel.attacker.io", i*7919)}
+		resolver.ResolveQueryStats(42, &event)
+	}
+	assert.Equal(t, 10, event.DomainQueryRate)
+	assert.Equal(t, 10, event.UniqueSubdomains)
+
+	// the same subdomain is counted once, other processes are tracked separately
+	event = model.DNSEvent{Name: "0.tunnel.attacker.io"}
+	resolver.ResolveQueryStats(42, &event)
+	assert.Equal(t, 11, event.DomainQueryRate)
+	assert.Equal(t, 10, event.UniqueSubdomains)
+
+	event = model.DNSEvent{Name: "0.tunnel.attacker.io"}
+	resolver.ResolveQueryStats(43, &event)
+	assert.Equal(t, 1, event.DomainQueryRate)
+	assert.Equal(t, 1, event.UniqueSubdomains)
+
+	// the counters are reset every window
+	now = now.Add(trackingWindow)
+	event = model.DNSEvent{Name: "datadoghq.com"}
+	resolver.ResolveQueryStats(42, &event)
+	event = model.DNSEvent{Name: "1.tunnel.attacker.io"}
+	resolver.ResolveQueryStats(42, &event)
+	assert.Equal(t, 1, event.DomainQueryRate)
+	assert.Equal(t, 1, event.UniqueSubdomains)
+
+	event = model.DNSEvent{Name: "mzxw6ytboi2dsnzrgm3tkmjwhe.attacker.io"}
+	resolver.ResolveQueryStats(42, &event)
+	assert.Greater(t, event.LabelEntropy, 350)
+}
@@ -9,6 +9,8 @@
 package envvars
 
 import (
+	"strings"
+
 	"github.com/DataDog/datadog-agent/pkg/security/probe/config"
 	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
 	"github.com/DataDog/datadog-agent/pkg/security/utils"
@@ -26,9 +28,21 @@ func NewEnvVarsResolver(cfg *config.Config) *Resolver {
 		envsWithValue = cfg.EnvsWithValue
 	}
 
-	pe := make([]string, 0, len(envsWithValue)+1)
-	pe = append(pe, "DD_SERVICE")
-	pe = append(pe, envsWithValue...)
+	// the unified service tags and the trace agent url are used to correlate the events with the APM traces
+	pe := make([]string, 0, len(envsWithValue)+4)
+	pe = append(pe, "DD_SERVICE", "DD_ENV", "DD_VERSION", "DD_TRACE_AGENT_URL")
+	for _, env := range envsWithValue {
+		// the globs are collected in priority from their literal prefix, the regexes and negated patterns are not
+		if strings.HasPrefix(env, "!") || strings.HasPrefix(env, "/") {
+			continue
+		}
+		if i := strings.IndexAny(env, "*?"); i >= 0 {
+			env = env[:i]
+		}
+		if env != "" {
+			pe = append(pe, env)
+		}
+	}
 
 	return &Resolver{
 		priorityEnvs: pe,
@@ -32,3 +32,12 @@ type ErrMountNotFound struct {
 func (e *ErrMountNotFound) Error() string {
 	return fmt.Sprintf("mount ID not found: %d", e.MountID)
 }
+
+// ErrProcfsPathNotFound is used when the path of a file can't be recovered from procfs
+type ErrProcfsPathNotFound struct {
+	Inode uint64
+}
+
+func (e *ErrProcfsPathNotFound) Error() string {
+	return fmt.Sprintf("path of inode %d not found in procfs", e.Inode)
+}
@@ -9,20 +9,27 @@
 package mount
 
 import (
+	"context"
+
 	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
 )
 
 // ResolverInterface defines the resolver interface
 type ResolverInterface interface {
+	Start(ctx context.Context)
 	IsMountIDValid(mountID uint32) (bool, error)
 	SyncCache(pid uint32) error
 	Delete(mountID uint32) error
 	ResolveFilesystem(mountID uint32, device uint32, pid uint32, containerID string) (string, error)
 	Insert(m model.Mount, pid uint32) error
 	DelPid(pid uint32)
+	RetainMountNamespace(mntns uint32)
+	ReleaseMountNamespace(mntns uint32)
 	ResolveMountRoot(mountID uint32, device uint32, pid uint32, containerID string) (string, model.MountSource, model.MountOrigin, error)
 	ResolveMountPath(mountID uint32, device uint32, pid uint32, containerID string) (string, model.MountSource, model.MountOrigin, error)
 	ResolveMount(mountID uint32, device uint32, pid uint32, containerID string) (*model.Mount, model.MountSource, model.MountOrigin, error)
+	ResolveOverlayLayer(mountID uint32, device uint32, pid uint32, containerID string, fsPath string, inUpperLayer bool) (string, string, error)
+	ResolvePathFromProcfs(pid uint32, device uint32, inode uint64) (string, error)
 	SendStats() error
 	ToJSON() ([]byte, error)
 }
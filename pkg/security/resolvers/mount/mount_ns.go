@@ -0,0 +1,111 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+// Package mount holds mount related files
+package mount
+
+import (
+	"go.uber.org/atomic"
+)
+
+// mountNamespaces counts the process cache entries living in each mount namespace, the references being taken and
+// dropped on fork, exec and exit, so that the mounts of a namespace can be released once its last process exited
+type mountNamespaces struct {
+	getMountNS func(pid uint32) (uint32, error)
+
+	// pidToNS caches the namespace of the pids the mounts were seen through
+	pidToNS  map[uint32]uint32
+	refs     map[uint32]int
+	nsMounts map[uint32]map[uint32]struct{}
+
+	// stats
+	releasedNamespaces *atomic.Int64
+	releasedMounts     *atomic.Int64
+}
+
+func newMountNamespaces(getMountNS func(pid uint32) (uint32, error)) *mountNamespaces {
+	return &mountNamespaces{
+		getMountNS:         getMountNS,
+		pidToNS:            make(map[uint32]uint32),
+		refs:               make(map[uint32]int),
+		nsMounts:           make(map[uint32]map[uint32]struct{}),
+		releasedNamespaces: atomic.NewInt64(0),
+		releasedMounts:     atomic.NewInt64(0),
+	}
+}
+
+// addMount records that the mount was seen through the provided pid, and so belongs to its mount namespace
+func (n *mountNamespaces) addMount(pid uint32, mountID uint32) {
+	mntns, known := n.pidToNS[pid]
+	if !known {
+		// a pid whose namespace can't be read is recorded anyway so that /proc isn't read for each of its mounts, the
+		// mounts of an unknown namespace are never released
+		mntns, _ = n.getMountNS(pid)
+		n.pidToNS[pid] = mntns
+	}
+
+	if mntns == 0 {
+		return
+	}
+
+	mounts := n.nsMounts[mntns]
+	if mounts == nil {
+		mounts = make(map[uint32]struct{})
+		n.nsMounts[mntns] = mounts
+	}
+	mounts[mountID] = struct{}{}
+}
+
+// delPid forgets the namespace of an exited pid
+func (n *mountNamespaces) delPid(pid uint32) {
+	delete(n.pidToNS, pid)
+}
+
+// retain records a process cache entry living in the mount namespace
+func (n *mountNamespaces) retain(mntns uint32) {
+	n.refs[mntns]++
+}
+
+// release drops the reference of a process cache entry to the mount namespace. When it was the last one, the
+// namespace is forgotten and the IDs of its mounts are returned so that they can be released.
+func (n *mountNamespaces) release(mntns uint32) []uint32 {
+	refs, exists := n.refs[mntns]
+	if !exists {
+		return nil
+	}
+	if refs > 1 {
+		n.refs[mntns] = refs - 1
+		return nil
+	}
+
+	mounts := n.nsMounts[mntns]
+	delete(n.refs, mntns)
+	delete(n.nsMounts, mntns)
+
+	mountIDs := make([]uint32, 0, len(mounts))
+	for mountID := range mounts {
+		mountIDs = append(mountIDs, mountID)
+	}
+
+	n.releasedNamespaces.Inc()
+	return mountIDs
+}
+
+// isReferenced returns whether a mount is still referenced by another mount namespace
+func (n *mountNamespaces) isReferenced(mountID uint32) bool {
+	for _, mounts := range n.nsMounts {
+		if _, exists := mounts[mountID]; exists {
+			return true
+		}
+	}
+	return false
+}
+
+// count returns the number of mount namespaces with live processes
+func (n *mountNamespaces) count() int {
+	return len(n.refs)
+}
@@ -9,6 +9,7 @@
 package mount
 
 import (
+	"context"
 	"errors"
 
 	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
@@ -46,6 +47,12 @@ func (mr *NoOpResolver) Insert(_ model.Mount, _ uint32) error {
 // DelPid removes the pid form the pid mapping
 func (mr *NoOpResolver) DelPid(_ uint32) {}
 
+// RetainMountNamespace records a process cache entry living in the provided mount namespace
+func (mr *NoOpResolver) RetainMountNamespace(_ uint32) {}
+
+// ReleaseMountNamespace drops the reference of a process cache entry to the provided mount namespace
+func (mr *NoOpResolver) ReleaseMountNamespace(_ uint32) {}
+
 // ResolveMountRoot returns the root of a mount identified by its mount ID.
 func (mr *NoOpResolver) ResolveMountRoot(_ uint32, _ uint32, _ uint32, _ string) (string, model.MountSource, model.MountOrigin, error) {
 	return "", model.MountSourceUnknown, model.MountOriginUnknown, nil
@@ -61,6 +68,19 @@ func (mr *NoOpResolver) ResolveMount(_ uint32, _ uint32, _ uint32, _ string) (*m
 	return nil, model.MountSourceUnknown, model.MountOriginUnknown, errors.New("not available")
 }
 
+// ResolveOverlayLayer returns the layer path and digest of a file
+func (mr *NoOpResolver) ResolveOverlayLayer(_ uint32, _ uint32, _ uint32, _ string, _ string, _ bool) (string, string, error) {
+	return "", "", nil
+}
+
+// ResolvePathFromProcfs recovers the path of a file from procfs
+func (mr *NoOpResolver) ResolvePathFromProcfs(_ uint32, _ uint32, _ uint64) (string, error) {
+	return "", errors.New("not available")
+}
+
+// Start starts the resolver
+func (mr *NoOpResolver) Start(_ context.Context) {}
+
 // SendStats sends metrics about the current state of the mount resolver
 func (mr *NoOpResolver) SendStats() error {
 	return nil
@@ -0,0 +1,342 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+// Package mount holds mount related files
+package mount
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/golang-lru/v2/simplelru"
+
+	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
+	"github.com/DataDog/datadog-agent/pkg/security/utils"
+	"github.com/DataDog/datadog-agent/pkg/util/kernel"
+)
+
+const (
+	overlayLayerCacheSize = 4096
+	// size of the queue of the layer lookups performed by the worker
+	overlayRequestQueueSize = 512
+	// minimum delay between two scans of the same docker layer database
+	layerDBSyncPeriod = time.Minute
+)
+
+// parseOverlayOptions returns the upper directory and the lower directories, from the top most one, of an overlayfs
+// mount from its super block options
+func parseOverlayOptions(options string) (string, []string) {
+	var (
+		upperDir  string
+		lowerDirs []string
+	)
+
+	for _, opt := range strings.Split(options, ",") {
+		name, val, ok := strings.Cut(opt, "=")
+		if !ok {
+			continue
+		}
+
+		switch name {
+		case "upperdir":
+			upperDir = val
+		case "lowerdir":
+			lowerDirs = splitLowerDirs(val)
+		case "lowerdir+":
+			lowerDirs = append(lowerDirs, val)
+		}
+	}
+
+	return upperDir, lowerDirs
+}
+
+// splitLowerDirs splits the lowerdir option on the unescaped colons. The data-only layers, following a double colon,
+// are kept as they can't hold the files resolved from the merged directory anyway.
+func splitLowerDirs(value string) []string {
+	var (
+		dirs    []string
+		current strings.Builder
+	)
+
+	for i := 0; i < len(value); i++ {
+		switch {
+		case value[i] == '\\' && i+1 < len(value):
+			i++
+			current.WriteByte(value[i])
+		case value[i] == ':':
+			if current.Len() > 0 {
+				dirs = append(dirs, current.String())
+			}
+			current.Reset()
+		default:
+			current.WriteByte(value[i])
+		}
+	}
+	if current.Len() > 0 {
+		dirs = append(dirs, current.String())
+	}
+
+	return dirs
+}
+
+type layerCacheKey struct {
+	upperDir    string
+	topLowerDir string
+	path        string
+}
+
+type layerDigestIndex struct {
+	digests  map[string]string
+	syncedAt time.Time
+	syncing  bool
+}
+
+// overlayRequest is a layer lookup performed by the worker: the lookup of the lower layer holding a file, or the sync
+// of a docker layer database
+type overlayRequest struct {
+	key       layerCacheKey
+	lowerDirs []string
+	layerDB   string
+}
+
+// overlayLayers resolves the layers of the overlayfs mounts backing the files. The file system accesses are performed
+// by a worker, the lookups from the event path only reading the caches and queuing the missing entries.
+type overlayLayers struct {
+	sync.Mutex
+	// root through which the layer directories, relative to the host mount namespace, are accessed
+	root         string
+	lowerLayers  *simplelru.LRU[layerCacheKey, string]
+	layerIndexes map[string]*layerDigestIndex
+
+	requests chan overlayRequest
+	pending  map[layerCacheKey]struct{}
+}
+
+func newOverlayLayers() (*overlayLayers, error) {
+	lowerLayers, err := simplelru.NewLRU[layerCacheKey, string](overlayLayerCacheSize, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &overlayLayers{
+		root:         utils.ProcRootPath(1),
+		lowerLayers:  lowerLayers,
+		layerIndexes: make(map[string]*layerDigestIndex),
+		requests:     make(chan overlayRequest, overlayRequestQueueSize),
+		pending:      make(map[layerCacheKey]struct{}),
+	}, nil
+}
+
+// run performs the queued layer lookups until the context is done
+func (o *overlayLayers) run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case req := <-o.requests:
+			o.handleRequest(req)
+		}
+	}
+}
+
+// handleRequest performs a layer lookup, without holding the lock while accessing the file system
+func (o *overlayLayers) handleRequest(req overlayRequest) {
+	if req.layerDB != "" {
+		digests := o.readLayerDigests(req.layerDB)
+
+		o.Lock()
+		defer o.Unlock()
+
+		if index, found := o.layerIndexes[req.layerDB]; found {
+			for cacheID, diffID := range digests {
+				index.digests[cacheID] = diffID
+			}
+			index.syncedAt = time.Now()
+			index.syncing = false
+		}
+		return
+	}
+
+	layerDir := o.lookupLowerLayer(req.lowerDirs, req.key.path)
+
+	o.Lock()
+	defer o.Unlock()
+
+	o.lowerLayers.Add(req.key, layerDir)
+	delete(o.pending, req.key)
+}
+
+// queue queues a layer lookup, it returns false when the queue is full
+func (o *overlayLayers) queue(req overlayRequest) bool {
+	select {
+	case o.requests <- req:
+		return true
+	default:
+		return false
+	}
+}
+
+// hostPath returns the path through which a path of the host mount namespace can be accessed
+func (o *overlayLayers) hostPath(path string) string {
+	return filepath.Join(o.root, path)
+}
+
+// resolveLayerDir follows the short symlinks used by docker in the lowerdir option, to keep it below the page size
+func (o *overlayLayers) resolveLayerDir(dir string) string {
+	link, err := os.Readlink(o.hostPath(dir))
+	if err != nil || filepath.IsAbs(link) {
+		return dir
+	}
+	return filepath.Join(filepath.Dir(dir), link)
+}
+
+// findLowerLayer returns the top most lower directory holding the file. An empty directory is returned, and the lookup
+// queued, when the file wasn't looked up yet.
+func (o *overlayLayers) findLowerLayer(upperDir string, lowerDirs []string, fsPath string) string {
+	if len(lowerDirs) == 0 {
+		return ""
+	}
+
+	key := layerCacheKey{upperDir: upperDir, topLowerDir: lowerDirs[0], path: fsPath}
+
+	o.Lock()
+	defer o.Unlock()
+
+	if dir, found := o.lowerLayers.Get(key); found {
+		return dir
+	}
+
+	if _, found := o.pending[key]; !found && o.queue(overlayRequest{key: key, lowerDirs: lowerDirs}) {
+		o.pending[key] = struct{}{}
+	}
+
+	return ""
+}
+
+// lookupLowerLayer returns the top most lower directory holding the file
+func (o *overlayLayers) lookupLowerLayer(lowerDirs []string, fsPath string) string {
+	for _, dir := range lowerDirs {
+		if _, err := os.Lstat(o.hostPath(filepath.Join(dir, fsPath))); err == nil {
+			return o.resolveLayerDir(dir)
+		}
+	}
+	return ""
+}
+
+// readLayerDigests returns the diff IDs of the layers of a docker layer database indexed by their cache ID
+func (o *overlayLayers) readLayerDigests(layerDB string) map[string]string {
+	digests := make(map[string]string)
+
+	chainIDs, err := os.ReadDir(o.hostPath(layerDB))
+	if err != nil {
+		return digests
+	}
+
+	for _, chainID := range chainIDs {
+		cacheID, err := os.ReadFile(o.hostPath(filepath.Join(layerDB, chainID.Name(), "cache-id")))
+		if err != nil {
+			continue
+		}
+		diffID, err := os.ReadFile(o.hostPath(filepath.Join(layerDB, chainID.Name(), "diff")))
+		if err != nil {
+			continue
+		}
+		digests[strings.TrimSpace(string(cacheID))] = strings.TrimSpace(string(diffID))
+	}
+
+	return digests
+}
+
+// resolveLayerDigest returns the digest of the image layer stored in the provided directory. Only the layers of the
+// docker overlay2 storage driver, laid out as <root>/overlay2/<cache ID>/diff, are resolved. An empty digest is
+// returned, and the sync of the layer database queued, when the layer isn't indexed yet.
+func (o *overlayLayers) resolveLayerDigest(layerDir string) string {
+	if filepath.Base(layerDir) != "diff" {
+		return ""
+	}
+
+	cacheDir := filepath.Dir(layerDir)
+	storageDir := filepath.Dir(cacheDir)
+	if filepath.Base(storageDir) != "overlay2" {
+		return ""
+	}
+	layerDB := filepath.Join(filepath.Dir(storageDir), "image", "overlay2", "layerdb", "sha256")
+	cacheID := filepath.Base(cacheDir)
+
+	o.Lock()
+	defer o.Unlock()
+
+	index, found := o.layerIndexes[layerDB]
+	if !found {
+		index = &layerDigestIndex{digests: make(map[string]string)}
+		o.layerIndexes[layerDB] = index
+	}
+
+	digest, found := index.digests[cacheID]
+	if !found && !index.syncing && time.Since(index.syncedAt) > layerDBSyncPeriod {
+		index.syncing = o.queue(overlayRequest{layerDB: layerDB})
+	}
+
+	return digest
+}
+
+// syncOverlayDirs fills the layer directories of an overlayfs mount inserted from an event, the mount options not
+// being sent by the kernel. It has to be called with the resolver lock held.
+func (mr *Resolver) syncOverlayDirs(mount *model.Mount, pid uint32) {
+	mnts, err := kernel.ParseMountInfoFile(int32(pid))
+	if err != nil {
+		return
+	}
+
+	for _, mnt := range mnts {
+		if uint32(mnt.ID) == mount.MountID && mnt.FSType == model.OverlayFS {
+			mount.UpperDir, mount.LowerDirs = parseOverlayOptions(mnt.VFSOptions)
+			return
+		}
+	}
+}
+
+// ResolveOverlayLayer returns the path of a file in the overlayfs layer backing it, along with the digest of the
+// matching container image layer when it can be found. The layers are looked up in the background, the layers of the
+// files that weren't looked up yet being reported by the next events. The path of the file is relative to the root of the overlayfs
+// mount. Empty values are returned when the mount isn't an overlayfs mount.
+func (mr *Resolver) ResolveOverlayLayer(mountID uint32, device uint32, pid uint32, containerID string, fsPath string, inUpperLayer bool) (string, string, error) {
+	mr.lock.Lock()
+	mount, _, _, err := mr.resolveMount(mountID, device, pid, containerID)
+	if err != nil {
+		mr.lock.Unlock()
+		return "", "", err
+	}
+
+	if mount.FSType != model.OverlayFS {
+		mr.lock.Unlock()
+		return "", "", nil
+	}
+
+	if mount.UpperDir == "" && len(mount.LowerDirs) == 0 {
+		mr.syncOverlayDirs(mount, pid)
+	}
+	upperDir, lowerDirs := mount.UpperDir, mount.LowerDirs
+	mr.lock.Unlock()
+
+	var layerDir string
+	if inUpperLayer {
+		layerDir = upperDir
+	} else {
+		layerDir = mr.overlayLayers.findLowerLayer(upperDir, lowerDirs, fsPath)
+	}
+
+	if layerDir == "" {
+		return "", "", nil
+	}
+
+	return filepath.Join(layerDir, fsPath), mr.overlayLayers.resolveLayerDigest(layerDir), nil
+}
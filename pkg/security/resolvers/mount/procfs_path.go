@@ -0,0 +1,176 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+// Package mount holds mount related files
+package mount
+
+import (
+	"bufio"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/sys/unix"
+
+	"github.com/DataDog/datadog-agent/pkg/security/utils"
+	"github.com/DataDog/datadog-agent/pkg/util/kernel"
+)
+
+const (
+	numAllowedProcfsPathResolutionsPerPeriod = 5
+	deletedSuffix                            = " (deleted)"
+	// fdsResolutionTimeout bounds the time spent looking for a file among the files opened by a process
+	fdsResolutionTimeout = 10 * time.Millisecond
+)
+
+// IsDentryUnreliableFS returns whether the dentries of a filesystem can't be trusted to resolve the paths of its files,
+// the remote and user space filesystems invalidating or building them outside of the kernel hooks
+func IsDentryUnreliableFS(fsType string) bool {
+	switch fsType {
+	case "nfs", "nfs4", "fuse", "fuseblk":
+		return true
+	default:
+		return strings.HasPrefix(fsType, "fuse.")
+	}
+}
+
+// sameFile returns whether the device and inode of a file match the provided ones, an undefined device matching any
+// device
+func sameFile(device uint32, inode uint64, fileDevice uint32, fileInode uint64) bool {
+	return inode == fileInode && (device == 0 || device == fileDevice)
+}
+
+// parseFDInfoInode returns the inode of the file of a /proc/<pid>/fdinfo/<fd> file, only reported by kernels >= 5.14
+func parseFDInfoInode(data []byte) (uint64, bool) {
+	for _, line := range strings.Split(string(data), "\n") {
+		value, found := strings.CutPrefix(line, "ino:")
+		if !found {
+			continue
+		}
+		inode, err := strconv.ParseUint(strings.TrimSpace(value), 10, 64)
+		return inode, err == nil
+	}
+	return 0, false
+}
+
+// resolvePathFromFDs looks for the file among the files opened by the process. The fds are matched by the inode
+// reported by their fdinfo, a stat of each fd would query the server of the remote filesystems, only the matching fd
+// is checked against the device. The lookup gives up after fdsResolutionTimeout, and on the kernels whose fdinfo
+// doesn't report the inode.
+func (mr *Resolver) resolvePathFromFDs(pid uint32, device uint32, inode uint64) (string, bool) {
+	procDir := kernel.HostProc(strconv.FormatUint(uint64(pid), 10))
+	fds, err := os.ReadDir(procDir + "/fdinfo")
+	if err != nil {
+		return "", false
+	}
+
+	deadline := time.Now().Add(fdsResolutionTimeout)
+	for _, fd := range fds {
+		if time.Now().After(deadline) {
+			return "", false
+		}
+
+		data, err := os.ReadFile(procDir + "/fdinfo/" + fd.Name())
+		if err != nil {
+			continue
+		}
+		fileInode, ok := parseFDInfoInode(data)
+		if !ok {
+			return "", false
+		}
+		if fileInode != inode {
+			continue
+		}
+
+		fdPath := procDir + "/fd/" + fd.Name()
+		if device != 0 {
+			var stat unix.Stat_t
+			if err := unix.Stat(fdPath, &stat); err != nil || !sameFile(device, inode, utils.Mkdev(unix.Major(stat.Dev), unix.Minor(stat.Dev)), stat.Ino) {
+				continue
+			}
+		}
+
+		if path, err := os.Readlink(fdPath); err == nil && strings.HasPrefix(path, "/") {
+			return strings.TrimSuffix(path, deletedSuffix), true
+		}
+	}
+
+	return "", false
+}
+
+// parseMapsLine returns the device, the inode and the path of a line of /proc/<pid>/maps
+func parseMapsLine(line string) (uint32, uint64, string, bool) {
+	// address perms offset dev inode pathname
+	fields := strings.Fields(line)
+	if len(fields) < 6 || !strings.HasPrefix(fields[5], "/") {
+		return 0, 0, "", false
+	}
+
+	major, minor, ok := strings.Cut(fields[3], ":")
+	if !ok {
+		return 0, 0, "", false
+	}
+	majorValue, err := strconv.ParseUint(major, 16, 32)
+	if err != nil {
+		return 0, 0, "", false
+	}
+	minorValue, err := strconv.ParseUint(minor, 16, 32)
+	if err != nil {
+		return 0, 0, "", false
+	}
+	inode, err := strconv.ParseUint(fields[4], 10, 64)
+	if err != nil {
+		return 0, 0, "", false
+	}
+
+	// the path may contain spaces
+	path := strings.Join(fields[5:], " ")
+
+	return utils.Mkdev(uint32(majorValue), uint32(minorValue)), inode, strings.TrimSuffix(path, deletedSuffix), true
+}
+
+// resolvePathFromMaps looks for the file among the files mapped by the process
+func (mr *Resolver) resolvePathFromMaps(pid uint32, device uint32, inode uint64) (string, bool) {
+	f, err := os.Open(kernel.HostProc(strconv.FormatUint(uint64(pid), 10), "maps"))
+	if err != nil {
+		return "", false
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fileDevice, fileInode, path, ok := parseMapsLine(scanner.Text())
+		if ok && sameFile(device, inode, fileDevice, fileInode) {
+			return path, true
+		}
+	}
+
+	return "", false
+}
+
+// ResolvePathFromProcfs recovers the path of a file from the files opened or mapped by the process, when its dentries
+// can't be used to resolve it. The returned path is relative to the root of the process.
+func (mr *Resolver) ResolvePathFromProcfs(pid uint32, device uint32, inode uint64) (string, error) {
+	if pid == 0 || inode == 0 || !mr.procfsPathLimiter.Allow(inode) {
+		mr.procfsPathMissStats.Inc()
+		return "", &ErrProcfsPathNotFound{Inode: inode}
+	}
+
+	if path, found := mr.resolvePathFromFDs(pid, device, inode); found {
+		mr.procfsPathFDHitsStats.Inc()
+		return path, nil
+	}
+
+	if path, found := mr.resolvePathFromMaps(pid, device, inode); found {
+		mr.procfsPathMapsHitsStats.Inc()
+		return path, nil
+	}
+
+	mr.procfsPathMissStats.Inc()
+	return "", &ErrProcfsPathNotFound{Inode: inode}
+}
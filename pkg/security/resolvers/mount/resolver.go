@@ -9,6 +9,7 @@
 package mount
 
 import (
+	"context"
 	"encoding/json"
 	"path"
 	"slices"
@@ -64,6 +65,12 @@ func newMountFromMountInfo(mnt *mountinfo.Info) *model.Mount {
 		}
 	}
 
+	var upperDir string
+	var lowerDirs []string
+	if mnt.FSType == model.OverlayFS {
+		upperDir, lowerDirs = parseOverlayOptions(mnt.VFSOptions)
+	}
+
 	// create a Mount out of the parsed MountInfo
 	return &model.Mount{
 		MountID: uint32(mnt.ID),
@@ -76,6 +83,8 @@ func newMountFromMountInfo(mnt *mountinfo.Info) *model.Mount {
 		Path:          mnt.Mountpoint,
 		RootStr:       root,
 		Origin:        model.MountOriginProcfs,
+		UpperDir:      upperDir,
+		LowerDirs:     lowerDirs,
 	}
 }
 
@@ -95,12 +104,20 @@ type Resolver struct {
 	minMountID      uint32 // used to find the first userspace visible mount ID
 	redemption      *simplelru.LRU[uint32, *redemptionEntry]
 	fallbackLimiter *utils.Limiter[uint64]
+	namespaces      *mountNamespaces
+	overlayLayers   *overlayLayers
+	// limiter of the path resolutions from procfs, by inode
+	procfsPathLimiter *utils.Limiter[uint64]
 
 	// stats
 	cacheHitsStats *atomic.Int64
 	cacheMissStats *atomic.Int64
 	procHitsStats  *atomic.Int64
 	procMissStats  *atomic.Int64
+
+	procfsPathFDHitsStats   *atomic.Int64
+	procfsPathMapsHitsStats *atomic.Int64
+	procfsPathMissStats     *atomic.Int64
 }
 
 // IsMountIDValid returns whether the mountID is valid
@@ -258,6 +275,8 @@ func (mr *Resolver) updatePidMapping(m *model.Mount, pid uint32) {
 		mr.pidToMounts[pid] = mounts
 	}
 	mounts[m.MountID] = m
+
+	mr.namespaces.addMount(pid, m.MountID)
 }
 
 // DelPid removes the pid form the pid mapping
@@ -270,6 +289,43 @@ func (mr *Resolver) DelPid(pid uint32) {
 	defer mr.lock.Unlock()
 
 	delete(mr.pidToMounts, pid)
+	mr.namespaces.delPid(pid)
+}
+
+// RetainMountNamespace records a process cache entry living in the provided mount namespace
+func (mr *Resolver) RetainMountNamespace(mntns uint32) {
+	if mntns == 0 {
+		return
+	}
+
+	mr.lock.Lock()
+	defer mr.lock.Unlock()
+
+	mr.namespaces.retain(mntns)
+}
+
+// ReleaseMountNamespace drops the reference of a process cache entry, that exited or executed in another namespace, to
+// the provided mount namespace. The mounts of the namespace are released once its last process is gone.
+func (mr *Resolver) ReleaseMountNamespace(mntns uint32) {
+	if mntns == 0 {
+		return
+	}
+
+	mr.lock.Lock()
+	defer mr.lock.Unlock()
+
+	// the released mounts go through the redemption period so that the events still in flight can be resolved, and
+	// are resynchronized from procfs if a process of the namespace wasn't known
+	cacheSize := len(mr.mounts)
+	for _, mountID := range mr.namespaces.release(mntns) {
+		if mr.namespaces.isReferenced(mountID) {
+			continue
+		}
+		if m, exists := mr.mounts[mountID]; exists {
+			mr.delete(m)
+		}
+	}
+	mr.namespaces.releasedMounts.Add(int64(cacheSize - len(mr.mounts)))
 }
 
 func (mr *Resolver) insert(m *model.Mount, pid uint32) {
@@ -575,6 +631,11 @@ func GetVFSRemovexattrDentryPosition(kernelVersion *skernel.Version) uint64 {
 	return position
 }
 
+// Start starts the worker looking up the overlayfs layers backing the files
+func (mr *Resolver) Start(ctx context.Context) {
+	go mr.overlayLayers.run(ctx)
+}
+
 // SendStats sends metrics about the current state of the mount resolver
 func (mr *Resolver) SendStats() error {
 	mr.lock.RLock()
@@ -596,6 +657,30 @@ func (mr *Resolver) SendStats() error {
 		return err
 	}
 
+	if err := mr.statsdClient.Count(metrics.MetricMountResolverProcfsPathHits, mr.procfsPathFDHitsStats.Swap(0), []string{"source:fd"}, 1.0); err != nil {
+		return err
+	}
+
+	if err := mr.statsdClient.Count(metrics.MetricMountResolverProcfsPathHits, mr.procfsPathMapsHitsStats.Swap(0), []string{"source:maps"}, 1.0); err != nil {
+		return err
+	}
+
+	if err := mr.statsdClient.Count(metrics.MetricMountResolverProcfsPathMiss, mr.procfsPathMissStats.Swap(0), []string{}, 1.0); err != nil {
+		return err
+	}
+
+	if err := mr.statsdClient.Count(metrics.MetricMountResolverReleasedNamespaces, mr.namespaces.releasedNamespaces.Swap(0), []string{}, 1.0); err != nil {
+		return err
+	}
+
+	if err := mr.statsdClient.Count(metrics.MetricMountResolverReleasedMounts, mr.namespaces.releasedMounts.Swap(0), []string{}, 1.0); err != nil {
+		return err
+	}
+
+	if err := mr.statsdClient.Gauge(metrics.MetricMountResolverNamespaces, float64(mr.namespaces.count()), []string{}, 1.0); err != nil {
+		return err
+	}
+
 	return mr.statsdClient.Gauge(metrics.MetricMountResolverCacheSize, float64(len(mr.mounts)), []string{}, 1.0)
 }
 
@@ -621,16 +706,20 @@ func (mr *Resolver) ToJSON() ([]byte, error) {
 // NewResolver instantiates a new mount resolver
 func NewResolver(statsdClient statsd.ClientInterface, cgroupsResolver *cgroup.Resolver, opts ResolverOpts) (*Resolver, error) {
 	mr := &Resolver{
-		opts:            opts,
-		statsdClient:    statsdClient,
-		cgroupsResolver: cgroupsResolver,
-		lock:            sync.RWMutex{},
-		mounts:          make(map[uint32]*model.Mount),
-		pidToMounts:     make(map[uint32]map[uint32]*model.Mount),
-		cacheHitsStats:  atomic.NewInt64(0),
-		procHitsStats:   atomic.NewInt64(0),
-		cacheMissStats:  atomic.NewInt64(0),
-		procMissStats:   atomic.NewInt64(0),
+		opts:                    opts,
+		statsdClient:            statsdClient,
+		cgroupsResolver:         cgroupsResolver,
+		lock:                    sync.RWMutex{},
+		mounts:                  make(map[uint32]*model.Mount),
+		pidToMounts:             make(map[uint32]map[uint32]*model.Mount),
+		cacheHitsStats:          atomic.NewInt64(0),
+		procHitsStats:           atomic.NewInt64(0),
+		cacheMissStats:          atomic.NewInt64(0),
+		procMissStats:           atomic.NewInt64(0),
+		procfsPathFDHitsStats:   atomic.NewInt64(0),
+		procfsPathMapsHitsStats: atomic.NewInt64(0),
+		procfsPathMissStats:     atomic.NewInt64(0),
+		namespaces:              newMountNamespaces(utils.GetProcessMountNamespace),
 	}
 
 	redemption, err := simplelru.NewLRU(1024, func(_ uint32, entry *redemptionEntry) {
@@ -648,5 +737,17 @@ func NewResolver(statsdClient statsd.ClientInterface, cgroupsResolver *cgroup.Re
 	}
 	mr.fallbackLimiter = limiter
 
+	procfsPathLimiter, err := utils.NewLimiter[uint64](64, numAllowedProcfsPathResolutionsPerPeriod, fallbackLimiterPeriod)
+	if err != nil {
+		return nil, err
+	}
+	mr.procfsPathLimiter = procfsPathLimiter
+
+	overlayLayers, err := newOverlayLayers()
+	if err != nil {
+		return nil, err
+	}
+	mr.overlayLayers = overlayLayers
+
 	return mr, nil
 }
@@ -10,13 +10,17 @@ package mount
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/sys/unix"
 
 	"github.com/DataDog/datadog-agent/pkg/security/resolvers/cgroup"
 	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
+	"github.com/DataDog/datadog-agent/pkg/security/utils"
 )
 
 func TestMountResolver(t *testing.T) {
@@ -571,3 +575,237 @@ func BenchmarkGetParentPath(b *testing.B) {
 		_, _, _, _ = mr.getMountPath(100, 44, 1)
 	}
 }
+
+func TestMountNamespaceRelease(t *testing.T) {
+	cr, _ := cgroup.NewResolver()
+	mr, _ := NewResolver(nil, cr, ResolverOpts{})
+
+	// pid 1 lives in the host namespace, pids 100 and 101 in the namespace of a container
+	const hostNS, containerNS = 4026531840, 4026532000
+	namespaces := map[uint32]uint32{1: hostNS, 100: containerNS, 101: containerNS}
+	mr.namespaces = newMountNamespaces(func(pid uint32) (uint32, error) {
+		if mntns, exists := namespaces[pid]; exists {
+			return mntns, nil
+		}
+		return 0, fmt.Errorf("unknown pid %d", pid)
+	})
+
+	newMount := func(mountID, parentID uint32, mountPoint string) *model.Mount {
+		return &model.Mount{
+			MountID:       mountID,
+			ParentPathKey: model.PathKey{MountID: parentID},
+			MountPointStr: mountPoint,
+		}
+	}
+
+	// the references are taken by the process cache entries, on fork, exec or procfs snapshot
+	mr.RetainMountNamespace(hostNS)
+	mr.RetainMountNamespace(containerNS)
+	mr.RetainMountNamespace(containerNS)
+	// a child of pid 101, forked before the container mounts were seen
+	mr.RetainMountNamespace(containerNS)
+
+	mr.insert(newMount(10, 0, "/"), 1)
+	mr.insert(newMount(11, 10, "proc"), 1)
+	mr.insert(newMount(20, 0, "/"), 100)
+	mr.insert(newMount(21, 20, "etc/hosts"), 101)
+	// the namespace of pid 200 is unknown
+	mr.insert(newMount(30, 0, "/"), 200)
+	assert.Equal(t, 2, mr.namespaces.count())
+
+	// the container namespace still has processes
+	mr.DelPid(100)
+	mr.ReleaseMountNamespace(containerNS)
+	mr.DelPid(101)
+	mr.ReleaseMountNamespace(containerNS)
+	assert.Contains(t, mr.mounts, uint32(20))
+	assert.Contains(t, mr.mounts, uint32(21))
+
+	// the last process of the namespace, that never triggered a mount event, exits
+	mr.ReleaseMountNamespace(containerNS)
+	assert.NotContains(t, mr.mounts, uint32(20))
+	assert.NotContains(t, mr.mounts, uint32(21))
+	assert.Equal(t, int64(1), mr.namespaces.releasedNamespaces.Load())
+	assert.Equal(t, int64(2), mr.namespaces.releasedMounts.Load())
+	assert.Equal(t, 1, mr.namespaces.count())
+
+	// the released mounts can still be resolved during the redemption period
+	path, _, _, err := mr.ResolveMountPath(21, 0, 101, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "/etc/hosts", path)
+
+	// the mounts of the host namespace and of the unknown namespace are kept
+	mr.DelPid(200)
+	mr.ReleaseMountNamespace(containerNS)
+	assert.Contains(t, mr.mounts, uint32(10))
+	assert.Contains(t, mr.mounts, uint32(11))
+	assert.Contains(t, mr.mounts, uint32(30))
+	assert.Equal(t, int64(1), mr.namespaces.releasedNamespaces.Load())
+}
+
+func TestParseOverlayOptions(t *testing.T) {
+	upperDir, lowerDirs := parseOverlayOptions("rw,lowerdir=/var/lib/docker/overlay2/l/AAA:/var/lib/docker/overlay2/l/BBB,upperdir=/var/lib/docker/overlay2/123/diff,workdir=/var/lib/docker/overlay2/123/work")
+	assert.Equal(t, "/var/lib/docker/overlay2/123/diff", upperDir)
+	assert.Equal(t, []string{"/var/lib/docker/overlay2/l/AAA", "/var/lib/docker/overlay2/l/BBB"}, lowerDirs)
+
+	upperDir, lowerDirs = parseOverlayOptions(`ro,lowerdir=/a\:b:/c::/data`)
+	assert.Empty(t, upperDir)
+	assert.Equal(t, []string{"/a:b", "/c", "/data"}, lowerDirs)
+}
+
+func TestResolveOverlayLayer(t *testing.T) {
+	root := t.TempDir()
+	writeFile := func(path string, content string) {
+		assert.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(root, path)), 0755))
+		assert.NoError(t, os.WriteFile(filepath.Join(root, path), []byte(content), 0644))
+	}
+
+	// docker layout: the lower dirs are short symlinks to the layer directories indexed by the layer database
+	writeFile("/docker/overlay2/cache1/diff/etc/passwd", "root")
+	writeFile("/docker/overlay2/cache2/diff/etc/passwd", "root")
+	writeFile("/docker/overlay2/cache2/diff/bin/sh", "")
+	writeFile("/docker/image/overlay2/layerdb/sha256/chain1/cache-id", "cache1")
+	writeFile("/docker/image/overlay2/layerdb/sha256/chain1/diff", "sha256:1111")
+	writeFile("/docker/image/overlay2/layerdb/sha256/chain2/cache-id", "cache2")
+	writeFile("/docker/image/overlay2/layerdb/sha256/chain2/diff", "sha256:2222")
+	assert.NoError(t, os.MkdirAll(filepath.Join(root, "/docker/overlay2/l"), 0755))
+	assert.NoError(t, os.Symlink("../cache1/diff", filepath.Join(root, "/docker/overlay2/l/AAA")))
+	assert.NoError(t, os.Symlink("../cache2/diff", filepath.Join(root, "/docker/overlay2/l/BBB")))
+
+	cr, _ := cgroup.NewResolver()
+	mr, _ := NewResolver(nil, cr, ResolverOpts{})
+	mr.overlayLayers.root = root
+
+	mr.insert(&model.Mount{
+		MountID:       42,
+		FSType:        model.OverlayFS,
+		MountPointStr: "/",
+		UpperDir:      "/docker/overlay2/upper/diff",
+		LowerDirs:     []string{"/docker/overlay2/l/AAA", "/docker/overlay2/l/BBB"},
+	}, 1)
+	mr.insert(&model.Mount{
+		MountID:       43,
+		FSType:        "ext4",
+		MountPointStr: "/",
+	}, 1)
+
+	// performs the queued lookups like the worker does
+	drainRequests := func() {
+		for len(mr.overlayLayers.requests) > 0 {
+			mr.overlayLayers.handleRequest(<-mr.overlayLayers.requests)
+		}
+	}
+
+	// the file system isn't accessed from the event path, the lookups are queued for the worker
+	layerPath, digest, err := mr.ResolveOverlayLayer(42, 0, 1, "", "/etc/passwd", false)
+	assert.NoError(t, err)
+	assert.Empty(t, layerPath)
+	assert.Empty(t, digest)
+	layerPath, _, _ = mr.ResolveOverlayLayer(42, 0, 1, "", "/etc/passwd", false)
+	assert.Empty(t, layerPath)
+	assert.Len(t, mr.overlayLayers.requests, 1)
+
+	_, _, _ = mr.ResolveOverlayLayer(42, 0, 1, "", "/bin/sh", false)
+	drainRequests()
+
+	// the layer database is synced once the layer is known
+	layerPath, digest, err = mr.ResolveOverlayLayer(42, 0, 1, "", "/etc/passwd", false)
+	assert.NoError(t, err)
+	assert.Equal(t, "/docker/overlay2/cache1/diff/etc/passwd", layerPath)
+	assert.Empty(t, digest)
+	drainRequests()
+
+	// the top most layer holding the file is reported
+	layerPath, digest, err = mr.ResolveOverlayLayer(42, 0, 1, "", "/etc/passwd", false)
+	assert.NoError(t, err)
+	assert.Equal(t, "/docker/overlay2/cache1/diff/etc/passwd", layerPath)
+	assert.Equal(t, "sha256:1111", digest)
+
+	layerPath, digest, err = mr.ResolveOverlayLayer(42, 0, 1, "", "/bin/sh", false)
+	assert.NoError(t, err)
+	assert.Equal(t, "/docker/overlay2/cache2/diff/bin/sh", layerPath)
+	assert.Equal(t, "sha256:2222", digest)
+
+	// the upper layer isn't an image layer
+	layerPath, digest, err = mr.ResolveOverlayLayer(42, 0, 1, "", "/tmp/file", true)
+	assert.NoError(t, err)
+	assert.Equal(t, "/docker/overlay2/upper/diff/tmp/file", layerPath)
+	assert.Empty(t, digest)
+
+	layerPath, digest, err = mr.ResolveOverlayLayer(43, 0, 1, "", "/etc/passwd", false)
+	assert.NoError(t, err)
+	assert.Empty(t, layerPath)
+	assert.Empty(t, digest)
+}
+
+func TestParseMapsLine(t *testing.T) {
+	device, inode, path, ok := parseMapsLine("7f3c2a000000-7f3c2a022000 r--p 00000000 00:2d 1234567                    /mnt/nfs/lib/libfoo.so (deleted)")
+	assert.True(t, ok)
+	assert.Equal(t, utils.Mkdev(0, 0x2d), device)
+	assert.Equal(t, uint64(1234567), inode)
+	assert.Equal(t, "/mnt/nfs/lib/libfoo.so", path)
+
+	_, _, path, ok = parseMapsLine("7ffd5a3e5000-7ffd5a406000 rw-p 00000000 00:00 0                          [stack]")
+	assert.False(t, ok)
+	assert.Empty(t, path)
+}
+
+func TestResolvePathFromProcfs(t *testing.T) {
+	cr, _ := cgroup.NewResolver()
+	mr, _ := NewResolver(nil, cr, ResolverOpts{})
+
+	f, err := os.CreateTemp(t.TempDir(), "procfs-path")
+	assert.NoError(t, err)
+	defer f.Close()
+
+	var stat unix.Stat_t
+	assert.NoError(t, unix.Fstat(int(f.Fd()), &stat))
+
+	path, err := mr.ResolvePathFromProcfs(uint32(os.Getpid()), 0, stat.Ino)
+	assert.NoError(t, err)
+	assert.Equal(t, f.Name(), path)
+	assert.Equal(t, int64(1), mr.procfsPathFDHitsStats.Load())
+
+	_, err = mr.ResolvePathFromProcfs(uint32(os.Getpid()), 0, stat.Ino+1)
+	assert.Error(t, err)
+	assert.Equal(t, int64(1), mr.procfsPathMissStats.Load())
+}
+
+func TestParseFDInfoInode(t *testing.T) {
+	inode, ok := parseFDInfoInode([]byte("pos:\t0\nflags:\t02100000\nmnt_id:\t29\nino:\t1234567\n"))
+	assert.True(t, ok)
+	assert.Equal(t, uint64(1234567), inode)
+
+	// the kernels < 5.14 don't report the inode
+	_, ok = parseFDInfoInode([]byte("pos:\t0\nflags:\t02100000\nmnt_id:\t29\n"))
+	assert.False(t, ok)
+}
+
+func TestResolvePathFromFDs(t *testing.T) {
+	f, err := os.CreateTemp(t.TempDir(), "fd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	var stat unix.Stat_t
+	if err := unix.Fstat(int(f.Fd()), &stat); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(fmt.Sprintf("/proc/self/fdinfo/%d", f.Fd()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := parseFDInfoInode(data); !ok {
+		t.Skip("the inodes of the fds aren't reported by this kernel")
+	}
+
+	mr := &Resolver{}
+	path, found := mr.resolvePathFromFDs(uint32(os.Getpid()), utils.Mkdev(unix.Major(stat.Dev), unix.Minor(stat.Dev)), stat.Ino)
+	assert.True(t, found)
+	assert.Equal(t, f.Name(), path)
+
+	_, found = mr.resolvePathFromFDs(uint32(os.Getpid()), utils.Mkdev(unix.Major(stat.Dev), unix.Minor(stat.Dev))+1, stat.Ino)
+	assert.False(t, found)
+}
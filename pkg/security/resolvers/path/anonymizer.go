@@ -0,0 +1,157 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+// Package path holds path related files
+package path
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// RedactedPathComponent replaces the anonymized components of the paths
+const RedactedPathComponent = "<redacted>"
+
+// Anonymizer redacts the user identifying components of the paths, like the home directories or the tenant IDs,
+// before they are attached to the outgoing events. The rules are still evaluated against the original paths.
+type Anonymizer struct {
+	patterns []*regexp.Regexp
+	// unanchored holds the patterns without their leading `^` anchor, applied to the values embedding paths anywhere,
+	// like the `--config=/home/user/x` arguments or the `PATH` environment variable
+	unanchored []*regexp.Regexp
+}
+
+// NewAnonymizer returns a new anonymizer. The capture groups of the patterns, which shouldn't be nested, are redacted
+// from the matching paths.
+func NewAnonymizer(patterns []string) (*Anonymizer, error) {
+	anonymizer := &Anonymizer{}
+
+	for _, pattern := range patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid path anonymization pattern `%s`: %w", pattern, err)
+		}
+		if re.NumSubexp() == 0 {
+			return nil, fmt.Errorf("path anonymization pattern `%s` has no capture group to redact", pattern)
+		}
+		anonymizer.patterns = append(anonymizer.patterns, re)
+
+		unanchored := re
+		if trimmed, found := strings.CutPrefix(pattern, "^"); found {
+			if unanchored, err = regexp.Compile(trimmed); err != nil {
+				return nil, fmt.Errorf("invalid path anonymization pattern `%s`: %w", pattern, err)
+			}
+		}
+		anonymizer.unanchored = append(anonymizer.unanchored, unanchored)
+	}
+
+	return anonymizer, nil
+}
+
+// Anonymize returns the path with its user identifying components redacted
+func (a *Anonymizer) Anonymize(path string) string {
+	if a == nil || path == "" {
+		return path
+	}
+
+	for _, re := range a.patterns {
+		if indexes := re.FindStringSubmatchIndex(path); indexes != nil {
+			path = redactGroups(path, indexes)
+		}
+	}
+
+	return path
+}
+
+// embeddedPathRegexp matches the segments of the values, like the arguments or the environment variables, that may
+// hold a path, the paths being delimited by the shell and the list separators
+var embeddedPathRegexp = regexp.MustCompile(`[^\s"'=:;,|&<>()]+`)
+
+// anonymizeEmbedded returns the value with the user identifying components of all the paths it embeds redacted
+func (a *Anonymizer) anonymizeEmbedded(value string) string {
+	segments := embeddedPathRegexp.FindAllStringIndex(value, -1)
+	// replace the segments from the last one so that the indexes of the previous ones remain valid
+	for i := len(segments) - 1; i >= 0; i-- {
+		start, end := segments[i][0], segments[i][1]
+		segment := value[start:end]
+		for _, re := range a.unanchored {
+			if indexes := re.FindStringSubmatchIndex(segment); indexes != nil {
+				segment = redactGroups(segment, indexes)
+			}
+		}
+		value = value[:start] + segment + value[end:]
+	}
+
+	return value
+}
+
+// redactGroups replaces the capture groups of a match, described by its submatch indexes, with the redacted component
+func redactGroups(value string, indexes []int) string {
+	// replace the groups from the last one so that the indexes of the previous ones remain valid
+	for group := len(indexes)/2 - 1; group > 0; group-- {
+		start, end := indexes[2*group], indexes[2*group+1]
+		if start < 0 || start == end {
+			continue
+		}
+		value = value[:start] + RedactedPathComponent + value[end:]
+	}
+	return value
+}
+
+// AnonymizeAll returns the values, like the arguments of a process, with the user identifying components of the paths
+// they embed redacted, wherever the paths appear in the values. The input slice is never modified, a copy being returned when at least
+// one of the values was anonymized.
+func (a *Anonymizer) AnonymizeAll(values []string) []string {
+	if a == nil {
+		return values
+	}
+
+	var anonymized []string
+	for i, value := range values {
+		if redacted := a.anonymizeEmbedded(value); redacted != value {
+			if anonymized == nil {
+				anonymized = slices.Clone(values)
+			}
+			anonymized[i] = redacted
+		}
+	}
+
+	if anonymized == nil {
+		return values
+	}
+	return anonymized
+}
+
+// AnonymizeEnvs returns the environment variables with the user identifying components of the paths embedded in their
+// values redacted. The input slice is never modified.
+func (a *Anonymizer) AnonymizeEnvs(envs []string) []string {
+	if a == nil {
+		return envs
+	}
+
+	var anonymized []string
+	for i, env := range envs {
+		name, value, found := strings.Cut(env, "=")
+		if !found {
+			continue
+		}
+
+		if redacted := a.anonymizeEmbedded(value); redacted != value {
+			if anonymized == nil {
+				anonymized = slices.Clone(envs)
+			}
+			anonymized[i] = name + "=" + redacted
+		}
+	}
+
+	if anonymized == nil {
+		return envs
+	}
+	return anonymized
+}
@@ -0,0 +1,55 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+// Package path holds path related files
+package path
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnonymizer(t *testing.T) {
+	anonymizer, err := NewAnonymizer([]string{`^/home/([^/]+)`, `^/srv/tenants/([^/]+)/users/([^/]+)`})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "/home/<redacted>/.ssh/id_rsa", anonymizer.Anonymize("/home/alice/.ssh/id_rsa"))
+	assert.Equal(t, "/home/<redacted>", anonymizer.Anonymize("/home/alice"))
+	assert.Equal(t, "/srv/tenants/<redacted>/users/<redacted>/data", anonymizer.Anonymize("/srv/tenants/acme/users/bob/data"))
+	assert.Equal(t, "/etc/passwd", anonymizer.Anonymize("/etc/passwd"))
+	assert.Equal(t, "", anonymizer.Anonymize(""))
+
+	// a nil anonymizer leaves the paths untouched
+	var disabled *Anonymizer
+	assert.Equal(t, "/home/alice", disabled.Anonymize("/home/alice"))
+	assert.Equal(t, []string{"/home/alice"}, disabled.AnonymizeAll([]string{"/home/alice"}))
+	assert.Equal(t, []string{"HOME=/home/alice"}, disabled.AnonymizeEnvs([]string{"HOME=/home/alice"}))
+
+	_, err = NewAnonymizer([]string{`^/home/[^/]+`})
+	assert.Error(t, err)
+	_, err = NewAnonymizer([]string{`^/home/([^/]+`})
+	assert.Error(t, err)
+}
+
+func TestAnonymizerAll(t *testing.T) {
+	anonymizer, err := NewAnonymizer([]string{`^/home/([^/]+)`})
+	assert.NoError(t, err)
+
+	args := []string{"cat", "/home/alice/.bashrc"}
+	assert.Equal(t, []string{"cat", "/home/<redacted>/.bashrc"}, anonymizer.AnonymizeAll(args))
+	// the input slice, which may be shared with the process cache, is left untouched
+	assert.Equal(t, []string{"cat", "/home/alice/.bashrc"}, args)
+
+	// the paths embedded in the arguments are redacted too
+	args = []string{"app", "--config=/home/alice/app.yaml", "-f/home/bob", "sh", "-c", "cd /home/carol && ls /home/dave"}
+	assert.Equal(t, []string{"app", "--config=/home/<redacted>/app.yaml", "-f/home/<redacted>", "sh", "-c", "cd /home/<redacted> && ls /home/<redacted>"}, anonymizer.AnonymizeAll(args))
+
+	envs := []string{"HOME=/home/alice", "PWD=/home/alice/src", "PATH=/usr/bin:/home/alice/bin", "USER"}
+	assert.Equal(t, []string{"HOME=/home/<redacted>", "PWD=/home/<redacted>/src", "PATH=/usr/bin:/home/<redacted>/bin", "USER"}, anonymizer.AnonymizeEnvs(envs))
+	assert.Equal(t, "HOME=/home/alice", envs[0])
+}
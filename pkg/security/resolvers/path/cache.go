@@ -0,0 +1,164 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+// Package path holds path related files
+package path
+
+import (
+	"sync"
+	"time"
+
+	"github.com/DataDog/datadog-go/v5/statsd"
+	"github.com/hashicorp/golang-lru/v2/simplelru"
+	"go.uber.org/atomic"
+
+	"github.com/DataDog/datadog-agent/pkg/security/metrics"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
+)
+
+type pathCacheKey struct {
+	mountID uint32
+	inode   uint64
+}
+
+type pathCacheEntry struct {
+	// the path id is bumped in kernel when the dentry is invalidated, an entry with a different one is stale
+	pathID uint32
+	path   string
+	err    error
+	// negative entries only, the renames of the parents of the file may make its path resolvable
+	expiresAt time.Time
+}
+
+// pathCache caches the full paths resolved for the files. The dentry resolver caches the dentries one by one, so that
+// the files of a directory share their parents, but resolving a path from it still takes a lookup per path segment
+// and the concatenation of the segments, and its entries are dropped once they are evicted from the kernel maps. The
+// full paths of the hot files are served from here in a single lookup instead. The paths deeper than the kernel can
+// walk are cached too, with their error, so that they aren't walked again on each event.
+type pathCache struct {
+	sync.Mutex
+	entries     *simplelru.LRU[pathCacheKey, *pathCacheEntry]
+	negativeTTL time.Duration
+	now         func() time.Time
+
+	hits         *atomic.Int64
+	misses       *atomic.Int64
+	negativeHits *atomic.Int64
+}
+
+func newPathCache(size int, negativeTTL time.Duration) (*pathCache, error) {
+	entries, err := simplelru.NewLRU[pathCacheKey, *pathCacheEntry](size, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pathCache{
+		entries:      entries,
+		negativeTTL:  negativeTTL,
+		now:          time.Now,
+		hits:         atomic.NewInt64(0),
+		misses:       atomic.NewInt64(0),
+		negativeHits: atomic.NewInt64(0),
+	}, nil
+}
+
+// get returns the cached resolution of a path key, along with whether it was found
+func (c *pathCache) get(pathKey model.PathKey) (*pathCacheEntry, bool) {
+	c.Lock()
+	defer c.Unlock()
+
+	key := pathCacheKey{mountID: pathKey.MountID, inode: pathKey.Inode}
+
+	entry, found := c.entries.Get(key)
+	if !found {
+		c.misses.Inc()
+		return nil, false
+	}
+
+	if entry.pathID != pathKey.PathID || (entry.err != nil && !c.now().Before(entry.expiresAt)) {
+		c.entries.Remove(key)
+		c.misses.Inc()
+		return nil, false
+	}
+
+	if entry.err != nil {
+		c.negativeHits.Inc()
+	} else {
+		c.hits.Inc()
+	}
+
+	return entry, true
+}
+
+// contains returns whether a valid resolution of a path key is cached, without updating its recency nor the metrics
+func (c *pathCache) contains(pathKey model.PathKey) bool {
+	c.Lock()
+	defer c.Unlock()
+
+	entry, found := c.entries.Peek(pathCacheKey{mountID: pathKey.MountID, inode: pathKey.Inode})
+	return found && entry.pathID == pathKey.PathID && (entry.err == nil || c.now().Before(entry.expiresAt))
+}
+
+// add caches the resolution of a path key
+func (c *pathCache) add(pathKey model.PathKey, path string, err error) {
+	c.Lock()
+	defer c.Unlock()
+
+	entry := &pathCacheEntry{
+		pathID: pathKey.PathID,
+		path:   path,
+		err:    err,
+	}
+	if err != nil {
+		entry.expiresAt = c.now().Add(c.negativeTTL)
+	}
+
+	c.entries.Add(pathCacheKey{mountID: pathKey.MountID, inode: pathKey.Inode}, entry)
+}
+
+// invalidate removes the cached resolution of a path key
+func (c *pathCache) invalidate(pathKey model.PathKey) {
+	c.Lock()
+	defer c.Unlock()
+
+	c.entries.Remove(pathCacheKey{mountID: pathKey.MountID, inode: pathKey.Inode})
+}
+
+// invalidateMount removes the cached resolutions of all the files of a mount
+func (c *pathCache) invalidateMount(mountID uint32) {
+	c.Lock()
+	defer c.Unlock()
+
+	for _, key := range c.entries.Keys() {
+		if key.mountID == mountID {
+			c.entries.Remove(key)
+		}
+	}
+}
+
+// sendStats sends the path cache metrics
+func (c *pathCache) sendStats(statsdClient statsd.ClientInterface) error {
+	if val := c.hits.Swap(0); val > 0 {
+		if err := statsdClient.Count(metrics.MetricPathResolverCacheHits, val, []string{}, 1.0); err != nil {
+			return err
+		}
+	}
+
+	if val := c.misses.Swap(0); val > 0 {
+		if err := statsdClient.Count(metrics.MetricPathResolverCacheMiss, val, []string{}, 1.0); err != nil {
+			return err
+		}
+	}
+
+	if val := c.negativeHits.Swap(0); val > 0 {
+		if err := statsdClient.Count(metrics.MetricPathResolverCacheNegativeHits, val, []string{}, 1.0); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
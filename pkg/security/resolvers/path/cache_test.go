@@ -0,0 +1,105 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+// Package path holds path related files
+package path
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/DataDog/datadog-agent/pkg/security/resolvers/dentry"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
+)
+
+func TestPathCache(t *testing.T) {
+	cache, err := newPathCache(16, 5*time.Second)
+	require.NoError(t, err)
+
+	now := time.Now()
+	cache.now = func() time.Time { return now }
+
+	etc := model.PathKey{MountID: 1, Inode: 10, PathID: 1}
+	missing := model.PathKey{MountID: 1, Inode: 11, PathID: 1}
+	other := model.PathKey{MountID: 2, Inode: 10, PathID: 1}
+
+	_, found := cache.get(etc)
+	assert.False(t, found)
+
+	cache.add(etc, "/etc/passwd", nil)
+	cache.add(missing, "", errors.New("not found"))
+	cache.add(other, "/usr/bin/ls", nil)
+
+	t.Run("hit", func(t *testing.T) {
+		entry, found := cache.get(etc)
+		require.True(t, found)
+		assert.Equal(t, "/etc/passwd", entry.path)
+		assert.NoError(t, entry.err)
+	})
+
+	t.Run("negative-hit", func(t *testing.T) {
+		entry, found := cache.get(missing)
+		require.True(t, found)
+		assert.Error(t, entry.err)
+
+		now = now.Add(5 * time.Second)
+		_, found = cache.get(missing)
+		assert.False(t, found)
+
+		// the positive entries don't expire
+		_, found = cache.get(etc)
+		assert.True(t, found)
+	})
+
+	t.Run("stale-path-id", func(t *testing.T) {
+		_, found := cache.get(model.PathKey{MountID: 1, Inode: 10, PathID: 2})
+		assert.False(t, found)
+
+		_, found = cache.get(etc)
+		assert.False(t, found)
+	})
+
+	t.Run("invalidate", func(t *testing.T) {
+		cache.add(etc, "/etc/passwd", nil)
+		cache.invalidate(etc)
+
+		_, found := cache.get(etc)
+		assert.False(t, found)
+	})
+
+	t.Run("invalidate-mount", func(t *testing.T) {
+		cache.add(etc, "/etc/passwd", nil)
+		cache.invalidateMount(1)
+
+		_, found := cache.get(etc)
+		assert.False(t, found)
+
+		_, found = cache.get(other)
+		assert.True(t, found)
+	})
+
+	assert.Equal(t, int64(3), cache.hits.Load())
+	assert.Equal(t, int64(1), cache.negativeHits.Load())
+	assert.Equal(t, int64(6), cache.misses.Load())
+}
+
+func TestIsCacheableResolution(t *testing.T) {
+	assert.True(t, isCacheableResolution(nil))
+	assert.True(t, isCacheableResolution(dentry.ErrTruncatedParents{}))
+	assert.True(t, isCacheableResolution(dentry.ErrTruncatedParentsERPC{}))
+
+	// the transient failures aren't cached
+	assert.False(t, isCacheableResolution(dentry.ErrERPCRequestNotProcessed{}))
+	assert.False(t, isCacheableResolution(dentry.ErrERPCResolution{}))
+	assert.False(t, isCacheableResolution(dentry.ErrKernelMapResolution{}))
+	assert.False(t, isCacheableResolution(&dentry.ErrDentryPathKeyNotFound{}))
+	assert.False(t, isCacheableResolution(dentry.ErrEntryNotFound))
+}
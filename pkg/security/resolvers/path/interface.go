@@ -17,8 +17,13 @@ type ResolverInterface interface {
 	ResolveBasename(e *model.FileFields) string
 	ResolveFilePath(e *model.FileFields, pidCtx *model.PIDContext, ctrCtx *model.ContainerContext) (string, error)
 	ResolveFileFieldsPath(e *model.FileFields, pidCtx *model.PIDContext, ctrCtx *model.ContainerContext) (string, string, model.MountSource, model.MountOrigin, error)
+	ResolveFileLayer(e *model.FileFields, pidCtx *model.PIDContext, ctrCtx *model.ContainerContext) (string, string, error)
 	SetMountRoot(ev *model.Event, e *model.Mount) error
 	ResolveMountRoot(ev *model.Event, e *model.Mount) (string, error)
 	SetMountPoint(ev *model.Event, e *model.Mount) error
 	ResolveMountPoint(ev *model.Event, e *model.Mount) (string, error)
+	PrefetchFilePaths(files ...*model.FileFields)
+	InvalidatePath(pathKey model.PathKey, isDir bool)
+	DelCacheEntries(mountID uint32)
+	SendStats() error
 }
@@ -31,6 +31,11 @@ func (n *NoOpResolver) ResolveFileFieldsPath(_ *model.FileFields, _ *model.PIDCo
 	return "", "", model.MountSourceUnknown, model.MountOriginUnknown, nil
 }
 
+// ResolveFileLayer resolves the overlayfs layer path and digest of a file
+func (n *NoOpResolver) ResolveFileLayer(_ *model.FileFields, _ *model.PIDContext, _ *model.ContainerContext) (string, string, error) {
+	return "", "", nil
+}
+
 // SetMountRoot set the mount point information
 func (n *NoOpResolver) SetMountRoot(_ *model.Event, _ *model.Mount) error {
 	return nil
@@ -50,3 +55,17 @@ func (n *NoOpResolver) SetMountPoint(_ *model.Event, _ *model.Mount) error {
 func (n *NoOpResolver) ResolveMountPoint(_ *model.Event, _ *model.Mount) (string, error) {
 	return "", nil
 }
+
+// PrefetchFilePaths resolves the paths of the provided files
+func (n *NoOpResolver) PrefetchFilePaths(_ ...*model.FileFields) {}
+
+// InvalidatePath removes the cached path of a file
+func (n *NoOpResolver) InvalidatePath(_ model.PathKey, _ bool) {}
+
+// DelCacheEntries removes the cached paths of the files of a mount
+func (n *NoOpResolver) DelCacheEntries(_ uint32) {}
+
+// SendStats sends the path resolver metrics
+func (n *NoOpResolver) SendStats() error {
+	return nil
+}
@@ -11,22 +11,136 @@ package path
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
+	"time"
+
+	"github.com/DataDog/datadog-go/v5/statsd"
 
 	"github.com/DataDog/datadog-agent/pkg/security/resolvers/dentry"
 	"github.com/DataDog/datadog-agent/pkg/security/resolvers/mount"
 	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
 )
 
+// ResolverOpts defines path resolver options
+type ResolverOpts struct {
+	// CacheSize is the number of resolved paths cached, 0 disabling the cache
+	CacheSize int
+	// CacheNegativeTTL is the period during which a failed resolution is cached
+	CacheNegativeTTL time.Duration
+}
+
 // Resolver describes a resolvers for path and file names
 type Resolver struct {
 	dentryResolver *dentry.Resolver
 	mountResolver  mount.ResolverInterface
+	statsdClient   statsd.ClientInterface
+	cache          *pathCache
 }
 
 // NewResolver returns a new path resolver
-func NewResolver(dentryResolver *dentry.Resolver, mountResolver mount.ResolverInterface) *Resolver {
-	return &Resolver{dentryResolver: dentryResolver, mountResolver: mountResolver}
+func NewResolver(dentryResolver *dentry.Resolver, mountResolver mount.ResolverInterface, statsdClient statsd.ClientInterface, opts ResolverOpts) (*Resolver, error) {
+	r := &Resolver{
+		dentryResolver: dentryResolver,
+		mountResolver:  mountResolver,
+		statsdClient:   statsdClient,
+	}
+
+	if opts.CacheSize > 0 {
+		cache, err := newPathCache(opts.CacheSize, opts.CacheNegativeTTL)
+		if err != nil {
+			return nil, err
+		}
+		r.cache = cache
+	}
+
+	return r, nil
+}
+
+// resolveDentryPath resolves the dentry path of a path key, through the path cache when it can be cached
+func (r *Resolver) resolveDentryPath(pathKey model.PathKey, cache bool) (string, error) {
+	if r.cache == nil || !cache || pathKey.Inode == 0 || dentry.IsFakeInode(pathKey.Inode) {
+		return r.dentryResolver.Resolve(pathKey, cache)
+	}
+
+	if entry, found := r.cache.get(pathKey); found {
+		return entry.path, entry.err
+	}
+
+	pathStr, err := r.dentryResolver.Resolve(pathKey, cache)
+	if isCacheableResolution(err) {
+		r.cache.add(pathKey, pathStr, err)
+	}
+
+	return pathStr, err
+}
+
+// isCacheableResolution returns whether the result of a dentry resolution can be cached. The only failures cached are
+// the paths deeper than the kernel can walk, the other ones being transient: the eRPC requests left unprocessed, and
+// the dentries missing from the kernel maps which may be filled by a later event.
+func isCacheableResolution(err error) bool {
+	return err == nil || errors.Is(err, dentry.ErrTruncatedParents{}) || errors.Is(err, dentry.ErrTruncatedParentsERPC{})
+}
+
+// PrefetchFilePaths resolves the paths of the provided files with as few eRPC requests as possible, so that their
+// following resolutions are served by the caches
+func (r *Resolver) PrefetchFilePaths(files ...*model.FileFields) {
+	var pathKeys []model.PathKey
+	for _, e := range files {
+		// the paths of the files with hard links aren't cached
+		if e.HasHardLinks() || e.Inode == 0 || dentry.IsFakeInode(e.Inode) || slices.Contains(pathKeys, e.PathKey) {
+			continue
+		}
+		if r.cache != nil && r.cache.contains(e.PathKey) {
+			continue
+		}
+		pathKeys = append(pathKeys, e.PathKey)
+	}
+
+	// a single path is resolved as efficiently when needed
+	if len(pathKeys) < 2 {
+		return
+	}
+
+	paths, errs := r.dentryResolver.ResolveBatch(pathKeys, true)
+	if r.cache == nil {
+		return
+	}
+
+	for i, pathKey := range pathKeys {
+		if isCacheableResolution(errs[i]) {
+			r.cache.add(pathKey, paths[i], errs[i])
+		}
+	}
+}
+
+// InvalidatePath removes the cached path of a file whose path changed. The paths of all the files of the mount are
+// removed for a directory, the paths of its descendants changing as well.
+func (r *Resolver) InvalidatePath(pathKey model.PathKey, isDir bool) {
+	if r.cache == nil {
+		return
+	}
+
+	if isDir {
+		r.cache.invalidateMount(pathKey.MountID)
+	} else {
+		r.cache.invalidate(pathKey)
+	}
+}
+
+// DelCacheEntries removes the cached paths of all the files of a mount
+func (r *Resolver) DelCacheEntries(mountID uint32) {
+	if r.cache != nil {
+		r.cache.invalidateMount(mountID)
+	}
+}
+
+// SendStats sends the path resolver metrics
+func (r *Resolver) SendStats() error {
+	if r.cache == nil {
+		return nil
+	}
+	return r.cache.sendStats(r.statsdClient)
 }
 
 // ResolveBasename resolves an inode/mount ID pair to a file basename
@@ -36,7 +150,7 @@ func (r *Resolver) ResolveBasename(e *model.FileFields) string {
 
 // ResolveFilePath resolves an inode/mount ID pair to a full path
 func (r *Resolver) ResolveFilePath(e *model.FileFields, _ *model.PIDContext, _ *model.ContainerContext) (string, error) {
-	pathStr, err := r.dentryResolver.Resolve(e.PathKey, !e.HasHardLinks())
+	pathStr, err := r.resolveDentryPath(e.PathKey, !e.HasHardLinks())
 	if err != nil {
 		if _, err := r.mountResolver.IsMountIDValid(e.MountID); errors.Is(err, mount.ErrMountKernelID) {
 			return pathStr, &ErrPathResolutionNotCritical{Err: err}
@@ -47,10 +161,38 @@ func (r *Resolver) ResolveFilePath(e *model.FileFields, _ *model.PIDContext, _ *
 	return pathStr, nil
 }
 
+// resolveFromProcfs recovers the full path of a file of a NFS or FUSE mount from procfs, the dentries of these
+// filesystems being unreliable
+func (r *Resolver) resolveFromProcfs(e *model.FileFields, pidCtx *model.PIDContext, ctrCtx *model.ContainerContext) (string, string, model.MountSource, model.MountOrigin, bool) {
+	if e.IsFileless() {
+		return "", "", model.MountSourceUnknown, model.MountOriginUnknown, false
+	}
+
+	fs, err := r.mountResolver.ResolveFilesystem(e.MountID, e.Device, pidCtx.Pid, string(ctrCtx.ContainerID))
+	if err != nil || !mount.IsDentryUnreliableFS(fs) {
+		return "", "", model.MountSourceUnknown, model.MountOriginUnknown, false
+	}
+
+	pathStr, err := r.mountResolver.ResolvePathFromProcfs(pidCtx.Pid, e.Device, e.Inode)
+	if err != nil {
+		return "", "", model.MountSourceUnknown, model.MountOriginUnknown, false
+	}
+
+	mountPath, source, origin, err := r.mountResolver.ResolveMountPath(e.MountID, e.Device, pidCtx.Pid, string(ctrCtx.ContainerID))
+	if err != nil {
+		return pathStr, "", model.MountSourceUnknown, model.MountOriginUnknown, true
+	}
+
+	return pathStr, mountPath, source, origin, true
+}
+
 // ResolveFileFieldsPath resolves an inode/mount ID pair to a full path along with its mount path
 func (r *Resolver) ResolveFileFieldsPath(e *model.FileFields, pidCtx *model.PIDContext, ctrCtx *model.ContainerContext) (string, string, model.MountSource, model.MountOrigin, error) {
 	pathStr, err := r.ResolveFilePath(e, pidCtx, ctrCtx)
 	if err != nil {
+		if fallbackPath, mountPath, source, origin, ok := r.resolveFromProcfs(e, pidCtx, ctrCtx); ok {
+			return fallbackPath, mountPath, source, origin, nil
+		}
 		return pathStr, "", model.MountSourceUnknown, model.MountOriginUnknown, err
 	}
 
@@ -90,6 +232,27 @@ func (r *Resolver) ResolveFileFieldsPath(e *model.FileFields, pidCtx *model.PIDC
 	return pathStr, mountPath, source, origin, nil
 }
 
+// ResolveFileLayer resolves an inode/mount ID pair to the path of the file in the overlayfs layer backing it, along
+// with the digest of the matching container image layer
+func (r *Resolver) ResolveFileLayer(e *model.FileFields, pidCtx *model.PIDContext, ctrCtx *model.ContainerContext) (string, string, error) {
+	if e.IsFileless() {
+		return "", "", nil
+	}
+
+	// the dentry path is relative to the root of the overlayfs mount, as are the files in its layers
+	pathStr, err := r.ResolveFilePath(e, pidCtx, ctrCtx)
+	if err != nil {
+		return "", "", err
+	}
+
+	layerPath, layerDigest, err := r.mountResolver.ResolveOverlayLayer(e.MountID, e.Device, pidCtx.Pid, string(ctrCtx.ContainerID), pathStr, e.GetInUpperLayer())
+	if err != nil {
+		return "", "", &ErrPathResolutionNotCritical{Err: err}
+	}
+
+	return layerPath, layerDigest, nil
+}
+
 // SetMountRoot set the mount point information
 func (r *Resolver) SetMountRoot(_ *model.Event, e *model.Mount) error {
 	var err error
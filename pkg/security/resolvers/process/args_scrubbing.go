@@ -0,0 +1,110 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+// Package process holds process related files
+package process
+
+import (
+	"slices"
+	"strings"
+
+	"go.uber.org/atomic"
+
+	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/eval"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/rules"
+	"github.com/DataDog/datadog-agent/pkg/security/seclog"
+)
+
+// argsScrubbingRule is a compiled scrubbing rule of the policies
+type argsScrubbingRule struct {
+	path     *eval.Glob
+	dropArgs bool
+	args     []string
+}
+
+// appliesTo returns whether the rule applies to the executable at the given path
+func (r *argsScrubbingRule) appliesTo(path string) bool {
+	return r.path == nil || r.path.Matches(path)
+}
+
+// argsScrubbingRules holds the scrubbing rules of the process arguments requested by the loaded policies, on top of
+// the global scrubber. They are replaced on each policy load.
+type argsScrubbingRules struct {
+	current atomic.Pointer[[]*argsScrubbingRule]
+}
+
+// set compiles and replaces the rules, the rules with an invalid path are skipped
+func (s *argsScrubbingRules) set(defs []rules.ArgsScrubbingDefinition) {
+	compiled := make([]*argsScrubbingRule, 0, len(defs))
+	for _, def := range defs {
+		rule := &argsScrubbingRule{
+			dropArgs: def.DropArgs,
+			args:     def.Args,
+		}
+
+		if def.Path != "" {
+			glob, err := eval.NewGlob(def.Path, false, false)
+			if err != nil {
+				seclog.Warnf("invalid args scrubbing path `%s`: %v", def.Path, err)
+				continue
+			}
+			rule.path = glob
+		}
+		compiled = append(compiled, rule)
+	}
+	s.current.Store(&compiled)
+}
+
+// scrub applies the rules matching the path of the executable to its arguments, without the first one. The provided
+// arguments are left untouched.
+func (s *argsScrubbingRules) scrub(path string, args []string, replacement string) []string {
+	current := s.current.Load()
+	if current == nil {
+		return args
+	}
+
+	cloned := false
+	for _, rule := range *current {
+		if !rule.appliesTo(path) {
+			continue
+		}
+
+		if rule.dropArgs {
+			return nil
+		}
+
+		for i := 0; i < len(args); i++ {
+			if !matchesOneArg(args[i], rule.args) {
+				continue
+			}
+
+			if !cloned {
+				args = slices.Clone(args)
+				cloned = true
+			}
+
+			// the value is either the one of `--key=value` or the next argument of `--key value`
+			if key, _, found := strings.Cut(args[i], "="); found {
+				args[i] = key + "=" + replacement
+			} else if i+1 < len(args) {
+				i++
+				args[i] = replacement
+			}
+		}
+	}
+
+	return args
+}
+
+func matchesOneArg(arg string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if eval.PatternMatches(pattern, arg, false) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,97 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+// Package process holds process related files
+package process
+
+import (
+	"slices"
+	"time"
+
+	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
+)
+
+// NewCloudCredentials returns the credentials, free of secrets, delivered by the IMDS response of the given event: the
+// security credentials of the AWS IMDS, or the OAuth access tokens of the GCP and Azure IMDS. The expiration of the
+// access tokens is computed from their lifetime when the response doesn't provide it.
+func NewCloudCredentials(e *model.Event) (model.CloudCredentials, bool) {
+	if e.IMDS.Type != model.IMDSResponseType {
+		return model.CloudCredentials{}, false
+	}
+
+	switch {
+	case len(e.IMDS.AWS.SecurityCredentials.AccessKeyID) > 0:
+		return model.CloudCredentials{
+			CloudProvider: model.IMDSAWSCloudProvider,
+			ID:            e.IMDS.AWS.SecurityCredentials.AccessKeyID,
+			Type:          e.IMDS.AWS.SecurityCredentials.Type,
+			Expiration:    e.IMDS.AWS.SecurityCredentials.Expiration,
+			AcquiredAt:    e.ResolveEventTime(),
+			AWS:           e.IMDS.AWS.SecurityCredentials,
+		}, true
+	case len(e.IMDS.AccessToken.Fingerprint) > 0:
+		token := &e.IMDS.AccessToken
+
+		var expiration time.Time
+		if token.ExpiresOn > 0 {
+			expiration = time.Unix(token.ExpiresOn, 0)
+		} else if token.ExpiresIn > 0 {
+			expiration = e.ResolveEventTime().Add(time.Duration(token.ExpiresIn) * time.Second)
+		}
+
+		return model.CloudCredentials{
+			CloudProvider: e.IMDS.CloudProvider,
+			ID:            token.Fingerprint,
+			Type:          token.Type,
+			Resource:      token.Resource,
+			Expiration:    expiration,
+			AcquiredAt:    e.ResolveEventTime(),
+		}, true
+	default:
+		return model.CloudCredentials{}, false
+	}
+}
+
+// UpdateCloudCredentials records the cloud credentials delivered by an IMDS response on the entry of the process
+// that received them
+func (p *EBPFResolver) UpdateCloudCredentials(pid uint32, e *model.Event) {
+	creds, ok := NewCloudCredentials(e)
+	if !ok {
+		return
+	}
+
+	p.Lock()
+	defer p.Unlock()
+
+	entry := p.entryCache.Get(pid)
+	if entry != nil {
+		// check if these credentials are already in cache
+		for _, known := range entry.CloudCredentials {
+			if known.CloudProvider == creds.CloudProvider && known.ID == creds.ID {
+				return
+			}
+		}
+		entry.CloudCredentials = append(entry.CloudCredentials, creds)
+	}
+}
+
+// FetchCloudCredentials returns the list of cloud credentials valid at the time of the event, and prunes expired
+// entries
+func (p *EBPFResolver) FetchCloudCredentials(e *model.Event) []model.CloudCredentials {
+	p.Lock()
+	defer p.Unlock()
+
+	entry := p.entryCache.Get(e.ProcessContext.Pid)
+	if entry != nil {
+		eventTime := e.ResolveEventTime()
+		entry.CloudCredentials = slices.DeleteFunc(entry.CloudCredentials, func(creds model.CloudCredentials) bool {
+			return creds.IsExpired(eventTime)
+		})
+		return entry.CloudCredentials
+	}
+	return nil
+}
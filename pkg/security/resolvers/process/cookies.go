@@ -0,0 +1,84 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+// Package process holds process related files
+package process
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
+)
+
+// newProcessCookie returns a new 128 bits cookie, made of a random part and of the current time like the cookies
+// generated by the kernel
+func newProcessCookie() model.ProcessCookie {
+	return model.ProcessCookie{
+		Lo: rand.Uint64(),
+		Hi: uint64(time.Now().UnixNano()),
+	}
+}
+
+// cookieIndex holds the entry of the execution that introduced each cookie, so that two executions sharing a cookie,
+// and then a proc_cache kernel entry, are detected. The forks of an execution share its cookie and aren't indexed.
+type cookieIndex struct {
+	sync.Mutex
+	entries map[model.ProcessCookie]*model.ProcessCacheEntry
+}
+
+func newCookieIndex() *cookieIndex {
+	return &cookieIndex{
+		entries: make(map[model.ProcessCookie]*model.ProcessCacheEntry),
+	}
+}
+
+// sameExecution returns whether both entries describe the same execution, the cookie of the processes resolved from
+// the kernel maps being the one of their forked ancestor
+func sameExecution(a, b *model.ProcessCacheEntry) bool {
+	return a.FileEvent.Inode == b.FileEvent.Inode && a.ExecTime.Equal(b.ExecTime)
+}
+
+// add indexes the cookie of the entry. It returns whether the cookie is already used by another execution, the cookie
+// then remaining indexed by the first one.
+func (c *cookieIndex) add(entry *model.ProcessCacheEntry) bool {
+	if entry.Cookie.IsZero() {
+		return false
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	if prev, found := c.entries[entry.Cookie]; found && prev != entry {
+		return !sameExecution(prev, entry)
+	}
+	c.entries[entry.Cookie] = entry
+	return false
+}
+
+// remove removes the cookie of the entry from the index, unless it was indexed by another entry since
+func (c *cookieIndex) remove(entry *model.ProcessCacheEntry) {
+	c.Lock()
+	defer c.Unlock()
+
+	if c.entries[entry.Cookie] == entry {
+		delete(c.entries, entry.Cookie)
+	}
+}
+
+// indexCookie indexes the cookie of a new execution and counts the collisions. The cookies generated in user space are
+// replaced until they are unique, the ones generated by the kernel are already shared with the kernel maps.
+func (p *EBPFResolver) indexCookie(entry *model.ProcessCacheEntry, regenerate bool) {
+	for p.cookies.add(entry) {
+		p.cookieCollisions.Inc()
+		if !regenerate {
+			return
+		}
+		entry.Cookie = newProcessCookie()
+	}
+}
@@ -0,0 +1,223 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+// Package process holds process related files
+package process
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/DataDog/datadog-agent/pkg/security/secl/containerutils"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
+)
+
+// dotDefaultColor is the fill color of the nodes of the sources without a specific color
+const dotDefaultColor = "lightgray"
+
+// dotSourceColors defines the fill color of the nodes of each process source
+var dotSourceColors = map[uint64]string{
+	model.ProcessCacheEntryFromEvent:     "palegreen",
+	model.ProcessCacheEntryFromKernelMap: "lightskyblue",
+	model.ProcessCacheEntryFromProcFS:    "orange",
+	model.ProcessCacheEntryFromSnapshot:  "khaki",
+}
+
+// dotCluster groups the nodes of the processes of a container or a cgroup
+type dotCluster struct {
+	label string
+	nodes []string
+}
+
+// dotGraph is a graphviz version of the process tree, the nodes being grouped by container or cgroup before being
+// written
+type dotGraph struct {
+	withArgs bool
+	already  map[string]bool
+	nodes    []string
+	clusters map[string]*dotCluster
+	edges    []string
+}
+
+func newDotGraph(withArgs bool) *dotGraph {
+	return &dotGraph{
+		withArgs: withArgs,
+		already:  make(map[string]bool),
+		clusters: make(map[string]*dotCluster),
+	}
+}
+
+func dotEscape(s string) string {
+	return strings.ReplaceAll(s, `"`, `\"`)
+}
+
+func dotNodeID(entry *model.ProcessCacheEntry) string {
+	return fmt.Sprintf(`"%d:%s"`, entry.Pid, dotEscape(entry.Comm))
+}
+
+func (g *dotGraph) addNode(p *EBPFResolver, entry *model.ProcessCacheEntry) {
+	id := dotNodeID(entry)
+	if g.already[id] {
+		return
+	}
+	g.already[id] = true
+
+	label := fmt.Sprintf("%s:%d", dotEscape(entry.Comm), entry.Pid)
+	style := "filled"
+	// the exited processes are bracketed with a dashed border
+	if !entry.ExitTime.IsZero() {
+		label = "[" + label + "]"
+		style = "filled,dashed"
+	}
+
+	color, found := dotSourceColors[entry.Source]
+	if !found {
+		color = dotDefaultColor
+	}
+
+	node := fmt.Sprintf(`%s [label="%s", style="%s", fillcolor="%s"`, id, label, style, color)
+	if g.withArgs {
+		argv, _ := p.GetProcessArgvScrubbed(&entry.Process)
+		node += fmt.Sprintf(`, comment="%s"`, dotEscape(strings.Join(argv, " ")))
+	}
+	node += "];"
+
+	var cluster *dotCluster
+	switch {
+	case entry.ContainerID != "":
+		cluster = g.cluster("container " + string(entry.ContainerID))
+	case entry.CGroup.CGroupID != "":
+		cluster = g.cluster("cgroup " + string(entry.CGroup.CGroupID))
+	default:
+		g.nodes = append(g.nodes, node)
+		return
+	}
+	cluster.nodes = append(cluster.nodes, node)
+}
+
+func (g *dotGraph) cluster(label string) *dotCluster {
+	cluster, found := g.clusters[label]
+	if !found {
+		cluster = &dotCluster{label: label}
+		g.clusters[label] = cluster
+	}
+	return cluster
+}
+
+func (g *dotGraph) addEdge(ancestor, entry *model.ProcessCacheEntry) {
+	edge := fmt.Sprintf(`%s -> %s`, dotNodeID(ancestor), dotNodeID(entry))
+	if g.already[edge] {
+		return
+	}
+	g.already[edge] = true
+
+	// an ancestor sharing the pid of the process is its previous execution
+	if ancestor.Pid == entry.Pid {
+		edge += ` [style=dashed, label="exec"]`
+	}
+	g.edges = append(g.edges, edge+";")
+}
+
+// add adds the process and its ancestors, up to the process that started the container when a container ID is
+// provided
+func (g *dotGraph) add(p *EBPFResolver, entry *model.ProcessCacheEntry, containerID containerutils.ContainerID) {
+	for entry != nil {
+		g.addNode(p, entry)
+
+		if entry.Ancestor == nil || (containerID != "" && entry.Ancestor.ContainerID != containerID) {
+			return
+		}
+
+		g.addEdge(entry.Ancestor, entry)
+		entry = entry.Ancestor
+	}
+}
+
+func (g *dotGraph) write(w io.Writer) {
+	fmt.Fprintln(w, "digraph ProcessTree {")
+
+	for _, node := range g.nodes {
+		fmt.Fprintln(w, node)
+	}
+
+	labels := make([]string, 0, len(g.clusters))
+	for label := range g.clusters {
+		labels = append(labels, label)
+	}
+	sort.Strings(labels)
+
+	for i, label := range labels {
+		fmt.Fprintf(w, "subgraph cluster_%d {\n", i)
+		fmt.Fprintf(w, "label=\"%s\";\n", dotEscape(label))
+		fmt.Fprintln(w, "style=rounded;")
+		for _, node := range g.clusters[label].nodes {
+			fmt.Fprintln(w, node)
+		}
+		fmt.Fprintln(w, "}")
+	}
+
+	for _, edge := range g.edges {
+		fmt.Fprintln(w, edge)
+	}
+
+	// legend of the colors of the sources
+	fmt.Fprintln(w, "subgraph cluster_legend {")
+	fmt.Fprintln(w, `label="sources";`)
+	for _, source := range []uint64{model.ProcessCacheEntryFromEvent, model.ProcessCacheEntryFromKernelMap, model.ProcessCacheEntryFromProcFS, model.ProcessCacheEntryFromSnapshot} {
+		name := model.ProcessSourceToString(source)
+		fmt.Fprintf(w, "\"legend_%s\" [label=\"%s\", shape=box, style=filled, fillcolor=\"%s\"];\n", name, name, dotSourceColors[source])
+	}
+	fmt.Fprintln(w, "}")
+
+	fmt.Fprint(w, "}")
+}
+
+// ToDot create a temp file and dump the cache, or the processes of the container when a container ID is provided
+func (p *EBPFResolver) ToDot(containerID containerutils.ContainerID, withArgs bool) (string, error) {
+	dump, err := os.CreateTemp("/tmp", "process-cache-dump-")
+	if err != nil {
+		return "", err
+	}
+
+	defer dump.Close()
+
+	if err := os.Chmod(dump.Name(), 0400); err != nil {
+		return "", err
+	}
+
+	if err := p.WriteDot(dump, containerID, withArgs); err != nil {
+		return "", err
+	}
+
+	if err = dump.Close(); err != nil {
+		return "", fmt.Errorf("could not close file [%s]: %w", dump.Name(), err)
+	}
+	return dump.Name(), nil
+}
+
+// WriteDot writes a graphviz version of the cache, or of the processes of the container when a container ID is provided.
+// The processes are grouped by container or cgroup, colored by source, and the executions are drawn as dashed edges.
+// The graph is built under the resolver lock and only written once it is released.
+func (p *EBPFResolver) WriteDot(w io.Writer, containerID containerutils.ContainerID, withArgs bool) error {
+	graph := newDotGraph(withArgs)
+
+	p.RLock()
+	p.rangeEntries(containerID, func(entry *model.ProcessCacheEntry) bool {
+		graph.add(p, entry, containerID)
+		return true
+	})
+	p.RUnlock()
+
+	bw := bufio.NewWriterSize(w, dumpBufferSize)
+	graph.write(bw)
+
+	return bw.Flush()
+}
@@ -0,0 +1,303 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+// Package process holds process related files
+package process
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
+)
+
+const (
+	// CacheDumpSchemaVersion is the version of the JSON schema of the process cache dumps. It must be
+	// incremented on any change that isn't backward compatible, DecodeCacheDump being updated accordingly.
+	CacheDumpSchemaVersion = 2
+	// LegacyCacheDumpSchemaVersion is the version of the dumps produced before the schema was versioned, which
+	// listed the entries under Entries with camel case fields
+	LegacyCacheDumpSchemaVersion = 1
+)
+
+// CacheDump is the JSON structure of a process cache dump
+type CacheDump struct {
+	// SchemaVersion is the version of the schema of the dump. Dumps produced before the schema was
+	// versioned are decoded with LegacyCacheDumpSchemaVersion.
+	SchemaVersion int `json:"schema_version"`
+	// Entries lists the entries of the process cache
+	Entries []CacheDumpEntry `json:"entries"`
+}
+
+// CacheDumpEntry describes a process cache entry
+type CacheDumpEntry struct {
+	PID             uint32     `json:"pid"`
+	PPID            uint32     `json:"ppid"`
+	Comm            string     `json:"comm,omitempty"`
+	Path            string     `json:"path,omitempty"`
+	Inode           uint64     `json:"inode,omitempty"`
+	MountID         uint32     `json:"mount_id,omitempty"`
+	Source          string     `json:"source,omitempty"`
+	ExecInode       uint64     `json:"exec_inode,omitempty"`
+	IsExec          bool       `json:"is_exec"`
+	IsParentMissing bool       `json:"is_parent_missing"`
+	ForkTime        *time.Time `json:"fork_time,omitempty"`
+	ExecTime        *time.Time `json:"exec_time,omitempty"`
+	ExitTime        *time.Time `json:"exit_time,omitempty"`
+
+	// Container holds the container context of the process
+	Container *CacheDumpContainer `json:"container,omitempty"`
+	// Lineage lists the ancestors of the process, from the parent to the root of the process tree
+	Lineage []CacheDumpAncestor `json:"lineage,omitempty"`
+	// ExecHistory lists the previous executions of the process, from the most recent one
+	ExecHistory []CacheDumpExec `json:"exec_history,omitempty"`
+
+	// Raw holds the complete cache entry when a raw dump was requested. Its structure follows the
+	// internal model and isn't part of the versioned schema.
+	Raw json.RawMessage `json:"raw,omitempty"`
+}
+
+// CacheDumpContainer describes the container context of a process cache entry
+type CacheDumpContainer struct {
+	ID     string `json:"id,omitempty"`
+	CGroup string `json:"cgroup,omitempty"`
+}
+
+// CacheDumpAncestor describes an ancestor of a process cache entry
+type CacheDumpAncestor struct {
+	PID    uint32 `json:"pid"`
+	Comm   string `json:"comm,omitempty"`
+	Path   string `json:"path,omitempty"`
+	IsExec bool   `json:"is_exec"`
+}
+
+// CacheDumpExec describes a previous execution of a process cache entry
+type CacheDumpExec struct {
+	Comm     string     `json:"comm,omitempty"`
+	Path     string     `json:"path,omitempty"`
+	ExecTime *time.Time `json:"exec_time,omitempty"`
+	ExitTime *time.Time `json:"exit_time,omitempty"`
+}
+
+// legacyCacheDump is the structure of the dumps produced before the schema was versioned
+type legacyCacheDump struct {
+	Entries []json.RawMessage
+}
+
+// legacyCacheDumpEntryV1 is the structure of the entries of the legacy dumps in the default format
+type legacyCacheDumpEntryV1 struct {
+	PID             uint32
+	PPID            uint32
+	Path            string
+	Inode           uint64
+	MountID         uint32
+	Source          string
+	ExecInode       uint64
+	IsExec          bool
+	IsParentMissing bool
+	CGroup          string
+	ContainerID     string
+}
+
+// newLegacyCacheDumpEntry encodes an entry following the LegacyCacheDumpSchemaVersion schema, for the readers that
+// don't support the versioned schema
+func newLegacyCacheDumpEntry(entry *model.ProcessCacheEntry, raw bool) (json.RawMessage, error) {
+	if raw {
+		return json.Marshal(entry)
+	}
+
+	return json.Marshal(legacyCacheDumpEntryV1{
+		PID:             entry.Pid,
+		PPID:            entry.PPid,
+		Path:            entry.FileEvent.PathnameStr,
+		Inode:           entry.FileEvent.Inode,
+		MountID:         entry.FileEvent.MountID,
+		Source:          model.ProcessSourceToString(entry.Source),
+		ExecInode:       entry.ExecInode,
+		IsExec:          entry.IsExec,
+		IsParentMissing: entry.IsParentMissing,
+		CGroup:          string(entry.CGroup.CGroupID),
+		ContainerID:     string(entry.ContainerID),
+	})
+}
+
+// legacyCacheDumpEntry holds the fields of the legacy dump entries, in both the default and the raw formats
+type legacyCacheDumpEntry struct {
+	PID             uint32
+	PPID            uint32
+	Path            string
+	Inode           uint64
+	MountID         uint32
+	Source          string
+	ExecInode       uint64
+	IsExec          bool
+	IsParentMissing bool
+	CGroup          legacyCGroup
+	ContainerID     string
+
+	// raw entries
+	Comm      string
+	FileEvent *struct {
+		PathnameStr string
+	}
+}
+
+// legacyCGroup is either the cgroup ID of the default format or the cgroup context of the raw format
+type legacyCGroup string
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (cg *legacyCGroup) UnmarshalJSON(data []byte) error {
+	var id string
+	if err := json.Unmarshal(data, &id); err == nil {
+		*cg = legacyCGroup(id)
+		return nil
+	}
+
+	var ctx struct {
+		CGroupID string
+	}
+	if err := json.Unmarshal(data, &ctx); err != nil {
+		return err
+	}
+	*cg = legacyCGroup(ctx.CGroupID)
+	return nil
+}
+
+func timeIfNotZero(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+func newCacheDumpEntry(entry *model.ProcessCacheEntry, raw bool) (CacheDumpEntry, error) {
+	e := CacheDumpEntry{
+		PID:             entry.Pid,
+		PPID:            entry.PPid,
+		Comm:            entry.Comm,
+		Path:            entry.FileEvent.PathnameStr,
+		Inode:           entry.FileEvent.Inode,
+		MountID:         entry.FileEvent.MountID,
+		Source:          model.ProcessSourceToString(entry.Source),
+		ExecInode:       entry.ExecInode,
+		IsExec:          entry.IsExec,
+		IsParentMissing: entry.IsParentMissing,
+		ForkTime:        timeIfNotZero(entry.ForkTime),
+		ExecTime:        timeIfNotZero(entry.ExecTime),
+		ExitTime:        timeIfNotZero(entry.ExitTime),
+	}
+
+	if entry.ContainerID != "" || entry.CGroup.CGroupID != "" {
+		e.Container = &CacheDumpContainer{
+			ID:     string(entry.ContainerID),
+			CGroup: string(entry.CGroup.CGroupID),
+		}
+	}
+
+	// the previous executions of the process are the ancestors sharing its pid
+	for ancestor := entry.Ancestor; ancestor != nil && ancestor.Pid == entry.Pid; ancestor = ancestor.Ancestor {
+		e.ExecHistory = append(e.ExecHistory, CacheDumpExec{
+			Comm:     ancestor.Comm,
+			Path:     ancestor.FileEvent.PathnameStr,
+			ExecTime: timeIfNotZero(ancestor.ExecTime),
+			ExitTime: timeIfNotZero(ancestor.ExitTime),
+		})
+	}
+
+	for ancestor := entry.Ancestor; ancestor != nil; ancestor = ancestor.Ancestor {
+		e.Lineage = append(e.Lineage, CacheDumpAncestor{
+			PID:    ancestor.Pid,
+			Comm:   ancestor.Comm,
+			Path:   ancestor.FileEvent.PathnameStr,
+			IsExec: ancestor.IsExec,
+		})
+	}
+
+	if raw {
+		data, err := json.Marshal(entry)
+		if err != nil {
+			return e, err
+		}
+		e.Raw = data
+	}
+
+	return e, nil
+}
+
+// DecodeCacheDump decodes a process cache dump, whatever the version of the agent that produced it
+func DecodeCacheDump(data []byte) (*CacheDump, error) {
+	var header struct {
+		SchemaVersion *int `json:"schema_version"`
+	}
+	if err := json.Unmarshal(data, &header); err != nil {
+		return nil, err
+	}
+
+	if header.SchemaVersion == nil {
+		return decodeLegacyCacheDump(data)
+	}
+
+	if *header.SchemaVersion > CacheDumpSchemaVersion {
+		return nil, fmt.Errorf("unsupported process cache dump schema version %d", *header.SchemaVersion)
+	}
+
+	var dump CacheDump
+	if err := json.Unmarshal(data, &dump); err != nil {
+		return nil, err
+	}
+	return &dump, nil
+}
+
+func decodeLegacyCacheDump(data []byte) (*CacheDump, error) {
+	var legacy legacyCacheDump
+	if err := json.Unmarshal(data, &legacy); err != nil {
+		return nil, err
+	}
+
+	dump := &CacheDump{
+		SchemaVersion: LegacyCacheDumpSchemaVersion,
+		Entries:       make([]CacheDumpEntry, 0, len(legacy.Entries)),
+	}
+
+	for _, data := range legacy.Entries {
+		var le legacyCacheDumpEntry
+		if err := json.Unmarshal(data, &le); err != nil {
+			return nil, err
+		}
+
+		e := CacheDumpEntry{
+			PID:             le.PID,
+			PPID:            le.PPID,
+			Comm:            le.Comm,
+			Path:            le.Path,
+			Inode:           le.Inode,
+			MountID:         le.MountID,
+			Source:          le.Source,
+			ExecInode:       le.ExecInode,
+			IsExec:          le.IsExec,
+			IsParentMissing: le.IsParentMissing,
+		}
+
+		if le.ContainerID != "" || le.CGroup != "" {
+			e.Container = &CacheDumpContainer{
+				ID:     le.ContainerID,
+				CGroup: string(le.CGroup),
+			}
+		}
+
+		// raw entries are the marshalled cache entries
+		if le.FileEvent != nil {
+			e.Path = le.FileEvent.PathnameStr
+			e.Raw = data
+		}
+
+		dump.Entries = append(dump.Entries, e)
+	}
+
+	return dump, nil
+}
@@ -0,0 +1,240 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+// Package process holds process related files
+package process
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
+	"github.com/DataDog/datadog-go/v5/statsd"
+)
+
+func TestCacheDumpRoundTrip(t *testing.T) {
+	resolver, err := NewEBPFResolver(nil, nil, &statsd.NoOpClient{}, nil, nil, nil, nil, nil, nil, nil, nil, NewResolverOpts())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parent := resolver.NewProcessCacheEntry(model.PIDContext{Pid: 1, Tid: 1})
+	parent.ForkTime = time.Now()
+	parent.Comm = "systemd"
+	parent.FileEvent.PathnameStr = "/usr/lib/systemd/systemd"
+
+	child := resolver.NewProcessCacheEntry(model.PIDContext{Pid: 2, Tid: 2})
+	child.PPid = parent.Pid
+	child.ForkTime = time.Now()
+
+	resolver.AddForkEntry(parent, 0, nil)
+	resolver.AddForkEntry(child, 0, nil)
+	child.ContainerID = "0123456789abcdef"
+
+	for _, raw := range []bool{false, true} {
+		data, err := resolver.ToJSON(raw)
+		require.NoError(t, err)
+
+		dump, err := DecodeCacheDump(data)
+		require.NoError(t, err)
+		assert.Equal(t, CacheDumpSchemaVersion, dump.SchemaVersion)
+		require.Len(t, dump.Entries, 2)
+
+		for _, e := range dump.Entries {
+			assert.Equal(t, raw, len(e.Raw) > 0)
+			if e.PID != child.Pid {
+				continue
+			}
+
+			require.NotNil(t, e.Container)
+			assert.Equal(t, "0123456789abcdef", e.Container.ID)
+			require.Len(t, e.Lineage, 1)
+			assert.Equal(t, CacheDumpAncestor{PID: 1, Comm: "systemd", Path: "/usr/lib/systemd/systemd"}, e.Lineage[0])
+		}
+	}
+}
+
+func TestWriteDot(t *testing.T) {
+	resolver, err := NewEBPFResolver(nil, nil, &statsd.NoOpClient{}, nil, nil, nil, nil, nil, nil, nil, nil, NewResolverOpts())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parent := resolver.NewProcessCacheEntry(model.PIDContext{Pid: 1, Tid: 1})
+	parent.ForkTime = time.Now()
+	parent.Comm = "systemd"
+
+	child := resolver.NewProcessCacheEntry(model.PIDContext{Pid: 2, Tid: 2})
+	child.PPid = parent.Pid
+	child.ForkTime = time.Now()
+
+	resolver.AddForkEntry(parent, 0, nil)
+	resolver.AddForkEntry(child, 0, nil)
+	child.Comm = "bash"
+
+	var buf bytes.Buffer
+	require.NoError(t, resolver.WriteDot(&buf, "", false))
+
+	dot := buf.String()
+	assert.Equal(t, "digraph ProcessTree {\n", dot[:len("digraph ProcessTree {\n")])
+	assert.Contains(t, dot, `"1:systemd" [label="systemd:1", style="filled", fillcolor="palegreen"];`)
+	assert.Contains(t, dot, `"1:systemd" -> "2:bash";`)
+	assert.NotContains(t, dot, "subgraph cluster_0")
+	assert.Equal(t, 1, bytes.Count(buf.Bytes(), []byte(`"1:systemd" [label`)))
+	assert.Equal(t, byte('}'), dot[len(dot)-1])
+}
+
+func TestContainerDump(t *testing.T) {
+	resolver, err := NewEBPFResolver(nil, nil, &statsd.NoOpClient{}, nil, nil, nil, nil, nil, nil, nil, nil, NewResolverOpts())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// systemd -> containerd-shim -> sh, exec'ed into nginx, in the container
+	//         -> bash
+	systemd := resolver.NewProcessCacheEntry(model.PIDContext{Pid: 1, Tid: 1})
+	systemd.ForkTime = time.Now()
+	systemd.Comm = "systemd"
+	resolver.AddForkEntry(systemd, 0, nil)
+
+	shim := resolver.NewProcessCacheEntry(model.PIDContext{Pid: 2, Tid: 2})
+	shim.PPid = systemd.Pid
+	shim.ForkTime = time.Now()
+	resolver.AddForkEntry(shim, 0, nil)
+	shim.Comm = "containerd-shim"
+
+	bash := resolver.NewProcessCacheEntry(model.PIDContext{Pid: 3, Tid: 3})
+	bash.PPid = systemd.Pid
+	bash.ForkTime = time.Now()
+	resolver.AddForkEntry(bash, 0, nil)
+	bash.Comm = "bash"
+
+	sh := resolver.NewProcessCacheEntry(model.PIDContext{Pid: 4, Tid: 4})
+	sh.PPid = shim.Pid
+	sh.ForkTime = time.Now()
+	resolver.AddForkEntry(sh, 0, nil)
+	sh.Comm = "sh"
+	sh.FileEvent.PathnameStr = "/bin/sh"
+	sh.FileEvent.Inode = 1
+	sh.ContainerID = "0123456789abcdef"
+	sh.Source = model.ProcessCacheEntryFromProcFS
+
+	nginx := resolver.NewProcessCacheEntry(model.PIDContext{Pid: 4, Tid: 4})
+	nginx.PPid = shim.Pid
+	nginx.ExecTime = time.Now()
+	nginx.Comm = "nginx"
+	nginx.FileEvent.PathnameStr = "/usr/sbin/nginx"
+	nginx.FileEvent.Inode = 2
+	nginx.ContainerID = "0123456789abcdef"
+	nginx.Source = model.ProcessCacheEntryFromEvent
+	resolver.AddExecEntry(nginx, 0)
+
+	var pids []uint32
+	resolver.WalkContainer("0123456789abcdef", func(entry *model.ProcessCacheEntry) {
+		pids = append(pids, entry.Pid)
+	})
+	assert.Equal(t, []uint32{4}, pids)
+
+	t.Run("json", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, resolver.WriteJSON(&buf, "0123456789abcdef", false, CacheDumpSchemaVersion))
+
+		dump, err := DecodeCacheDump(buf.Bytes())
+		require.NoError(t, err)
+		require.Len(t, dump.Entries, 1)
+
+		e := dump.Entries[0]
+		assert.Equal(t, "nginx", e.Comm)
+		require.Len(t, e.ExecHistory, 1)
+		assert.Equal(t, "sh", e.ExecHistory[0].Comm)
+		assert.Equal(t, "/bin/sh", e.ExecHistory[0].Path)
+		assert.NotNil(t, e.ExecHistory[0].ExitTime)
+		assert.Len(t, e.Lineage, 3)
+	})
+
+	t.Run("json v1", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, resolver.WriteJSON(&buf, "0123456789abcdef", false, LegacyCacheDumpSchemaVersion))
+		assert.True(t, bytes.HasPrefix(buf.Bytes(), []byte(`{"Entries":[`)))
+
+		dump, err := DecodeCacheDump(buf.Bytes())
+		require.NoError(t, err)
+		assert.Equal(t, LegacyCacheDumpSchemaVersion, dump.SchemaVersion)
+		require.Len(t, dump.Entries, 1)
+		assert.Equal(t, uint32(4), dump.Entries[0].PID)
+		assert.Equal(t, "0123456789abcdef", dump.Entries[0].Container.ID)
+
+		assert.Error(t, resolver.WriteJSON(&buf, "", false, CacheDumpSchemaVersion+1))
+	})
+
+	t.Run("dot", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, resolver.WriteDot(&buf, "0123456789abcdef", false))
+
+		dot := buf.String()
+		assert.Contains(t, dot, "subgraph cluster_0 {\nlabel=\"container 0123456789abcdef\";\n")
+		assert.Contains(t, dot, `"4:sh" [label="[sh:4]", style="filled,dashed", fillcolor="orange"];`)
+		assert.Contains(t, dot, `"4:nginx" [label="nginx:4", style="filled", fillcolor="palegreen"];`)
+		assert.Contains(t, dot, `"4:sh" -> "4:nginx" [style=dashed, label="exec"];`)
+		assert.NotContains(t, dot, "containerd-shim")
+		assert.NotContains(t, dot, "bash")
+		assert.NotContains(t, dot, "systemd")
+	})
+
+	t.Run("unknown container", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, resolver.WriteJSON(&buf, "fedcba9876543210", false, CacheDumpSchemaVersion))
+
+		dump, err := DecodeCacheDump(buf.Bytes())
+		require.NoError(t, err)
+		assert.Empty(t, dump.Entries)
+	})
+}
+
+func TestDecodeLegacyCacheDump(t *testing.T) {
+	t.Run("default", func(t *testing.T) {
+		data := []byte(`{"Entries":[{"PID":42,"PPID":1,"Path":"/usr/bin/bash","Inode":123,"MountID":7,"Source":"event","ExecInode":0,"IsExec":true,"IsParentMissing":false,"CGroup":"/system.slice/foo","ContainerID":"abc"}]}`)
+
+		dump, err := DecodeCacheDump(data)
+		require.NoError(t, err)
+		assert.Equal(t, LegacyCacheDumpSchemaVersion, dump.SchemaVersion)
+		assert.Equal(t, []CacheDumpEntry{{
+			PID:       42,
+			PPID:      1,
+			Path:      "/usr/bin/bash",
+			Inode:     123,
+			MountID:   7,
+			Source:    "event",
+			IsExec:    true,
+			Container: &CacheDumpContainer{ID: "abc", CGroup: "/system.slice/foo"},
+		}}, dump.Entries)
+	})
+
+	t.Run("raw", func(t *testing.T) {
+		data := []byte(`{"Entries":[{"Pid":42,"PPid":1,"Comm":"bash","FileEvent":{"PathnameStr":"/usr/bin/bash"},"CGroup":{"CGroupID":"/system.slice/foo"},"ContainerID":""}]}`)
+
+		dump, err := DecodeCacheDump(data)
+		require.NoError(t, err)
+		require.Len(t, dump.Entries, 1)
+
+		e := dump.Entries[0]
+		assert.Equal(t, uint32(42), e.PID)
+		assert.Equal(t, "bash", e.Comm)
+		assert.Equal(t, "/usr/bin/bash", e.Path)
+		assert.Equal(t, &CacheDumpContainer{CGroup: "/system.slice/foo"}, e.Container)
+		assert.NotEmpty(t, e.Raw)
+	})
+
+	t.Run("unsupported version", func(t *testing.T) {
+		_, err := DecodeCacheDump([]byte(`{"schema_version":999,"entries":[]}`))
+		assert.Error(t, err)
+	})
+}
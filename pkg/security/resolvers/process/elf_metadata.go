@@ -0,0 +1,162 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+// Package process holds process related files
+package process
+
+import (
+	"context"
+	"sync"
+	"syscall"
+
+	"github.com/hashicorp/golang-lru/v2/simplelru"
+
+	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
+	"github.com/DataDog/datadog-agent/pkg/security/utils"
+)
+
+const (
+	elfMetadataCacheSize = 1024
+	elfMetadataQueueSize = 256
+)
+
+// elfMetadataKey identifies an executable, its modification time changing when it is rewritten in place
+type elfMetadataKey struct {
+	mountID uint32
+	inode   uint64
+	mtime   uint64
+}
+
+// elfMetadataRequest is an executable whose ELF headers have to be parsed, read through one of its processes
+type elfMetadataRequest struct {
+	key  elfMetadataKey
+	pid  uint32
+	path string
+}
+
+// elfMetadataEntry holds the ELF metadata of an executable, or the failure to parse them
+type elfMetadataEntry struct {
+	metadata utils.ELFMetadata
+	valid    bool
+}
+
+// elfMetadataResolver parses the ELF headers of the executables in the background. The metadata are cached by
+// executable, the field handlers only look them up, so that the first events of an executable not parsed yet are
+// evaluated without them.
+type elfMetadataResolver struct {
+	sync.Mutex
+	cache   *simplelru.LRU[elfMetadataKey, elfMetadataEntry]
+	pending map[elfMetadataKey]struct{}
+	queue   chan elfMetadataRequest
+}
+
+func newELFMetadataResolver() (*elfMetadataResolver, error) {
+	cache, err := simplelru.NewLRU[elfMetadataKey, elfMetadataEntry](elfMetadataCacheSize, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &elfMetadataResolver{
+		cache:   cache,
+		pending: make(map[elfMetadataKey]struct{}),
+		queue:   make(chan elfMetadataRequest, elfMetadataQueueSize),
+	}, nil
+}
+
+// lookup returns the metadata of the executable, and whether they were parsed. The executables not parsed yet are
+// queued, unless the queue is full in which case a later lookup queues them again.
+func (r *elfMetadataResolver) lookup(req elfMetadataRequest) (elfMetadataEntry, bool) {
+	r.Lock()
+	defer r.Unlock()
+
+	if entry, found := r.cache.Get(req.key); found {
+		return entry, true
+	}
+
+	if _, found := r.pending[req.key]; found {
+		return elfMetadataEntry{}, false
+	}
+
+	select {
+	case r.queue <- req:
+		r.pending[req.key] = struct{}{}
+	default:
+	}
+	return elfMetadataEntry{}, false
+}
+
+// run parses the queued executables until the context is done
+func (r *elfMetadataResolver) run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case req := <-r.queue:
+			entry, found := parseELFMetadata(req)
+
+			// the executable is parsed again through another process if this one already exited
+			r.Lock()
+			if found {
+				r.cache.Add(req.key, entry)
+			}
+			delete(r.pending, req.key)
+			r.Unlock()
+		}
+	}
+}
+
+// parseELFMetadata parses the ELF headers of the executable through /proc/[pid]/exe, or through the root of the
+// process if the process executed another binary since. A file is only parsed if its inode is the one of the executable.
+func parseELFMetadata(req elfMetadataRequest) (elfMetadataEntry, bool) {
+	for _, candidate := range []string{utils.ProcExePath(req.pid), utils.ProcRootFilePath(req.pid, req.path)} {
+		var stat syscall.Stat_t
+		if err := syscall.Stat(candidate, &stat); err != nil || stat.Ino != req.key.inode {
+			continue
+		}
+
+		metadata, err := utils.ParseELFMetadata(candidate)
+		if err != nil {
+			return elfMetadataEntry{}, true
+		}
+		return elfMetadataEntry{metadata: *metadata, valid: true}, true
+	}
+	return elfMetadataEntry{}, false
+}
+
+// SetProcessELFMetadata sets the ELF metadata of the executable of the process once they were parsed in the
+// background. The processes whose executable isn't identified by its inode are left without.
+func (p *EBPFResolver) SetProcessELFMetadata(pr *model.Process) {
+	if pr.ELFResolved || p.elfMetadata == nil {
+		return
+	}
+
+	if pr.IsKworker || pr.FileEvent.PathnameStr == "" || pr.FileEvent.Inode == 0 {
+		pr.ELFResolved = true
+		return
+	}
+
+	entry, parsed := p.elfMetadata.lookup(elfMetadataRequest{
+		key: elfMetadataKey{
+			mountID: pr.FileEvent.MountID,
+			inode:   pr.FileEvent.Inode,
+			mtime:   pr.FileEvent.MTime,
+		},
+		pid:  pr.Pid,
+		path: pr.FileEvent.PathnameStr,
+	})
+	if !parsed {
+		return
+	}
+	pr.ELFResolved = true
+
+	if entry.valid {
+		pr.ELFBuildID = entry.metadata.BuildID
+		pr.ELFInterpreter = entry.metadata.Interpreter
+		pr.ELFIsStatic = entry.metadata.IsStatic
+		pr.ELFIsStripped = entry.metadata.IsStripped
+	}
+}
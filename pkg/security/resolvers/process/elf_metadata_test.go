@@ -0,0 +1,74 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+// Package process holds process related files
+package process
+
+import (
+	"os"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseELFMetadata(t *testing.T) {
+	exe, err := os.Executable()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var stat syscall.Stat_t
+	if err := syscall.Stat(exe, &stat); err != nil {
+		t.Fatal(err)
+	}
+
+	req := elfMetadataRequest{
+		key:  elfMetadataKey{inode: stat.Ino},
+		pid:  uint32(os.Getpid()),
+		path: exe,
+	}
+
+	t.Run("matching-inode", func(t *testing.T) {
+		entry, found := parseELFMetadata(req)
+		assert.True(t, found)
+		assert.True(t, entry.valid)
+	})
+
+	t.Run("other-inode", func(t *testing.T) {
+		other := req
+		other.key.inode = stat.Ino + 1
+		_, found := parseELFMetadata(other)
+		assert.False(t, found)
+	})
+
+	t.Run("lookup", func(t *testing.T) {
+		resolver, err := newELFMetadataResolver()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, parsed := resolver.lookup(req)
+		assert.False(t, parsed)
+		assert.Len(t, resolver.queue, 1)
+
+		// queued only once
+		_, parsed = resolver.lookup(req)
+		assert.False(t, parsed)
+		assert.Len(t, resolver.queue, 1)
+
+		queued := <-resolver.queue
+		entry, found := parseELFMetadata(queued)
+		resolver.cache.Add(queued.key, entry)
+		delete(resolver.pending, queued.key)
+		assert.True(t, found)
+
+		entry, parsed = resolver.lookup(req)
+		assert.True(t, parsed)
+		assert.True(t, entry.valid)
+	})
+}
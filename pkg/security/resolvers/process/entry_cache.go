@@ -0,0 +1,369 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+// Package process holds process related files
+package process
+
+import (
+	"math/bits"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"go.uber.org/atomic"
+
+	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
+	"github.com/DataDog/datadog-agent/pkg/util/kernel"
+)
+
+const (
+	// MapEntryCache stores the process cache entries in a go map
+	MapEntryCache = "map"
+	// PIDTableEntryCache stores the process cache entries in an open addressing table indexed by pid
+	PIDTableEntryCache = "pid_table"
+
+	// pidMaxLimit is the maximum value of pid_max on 64 bits systems
+	pidMaxLimit = 1 << 22
+	// pidTableInitialSize is the number of slots preallocated by the pid table
+	pidTableInitialSize = 1 << 14
+	// pidTableHashMultiplier spreads sequential pids across the table, see home
+	pidTableHashMultiplier = 0x9e3779b1
+	// cacheLineSize is used to keep the locks of the shards on different cache lines
+	cacheLineSize = 64
+)
+
+// entryCache stores the process cache entries of the resolver, indexed by pid
+type entryCache interface {
+	// Get returns the entry of the given pid, or nil if there is none
+	Get(pid uint32) *model.ProcessCacheEntry
+	// Set sets the entry of the given pid, the entry can't be nil
+	Set(pid uint32, entry *model.ProcessCacheEntry)
+	// Delete deletes the entry of the given pid
+	Delete(pid uint32)
+	// Len returns the number of entries
+	Len() int
+	// Range calls f on each entry until f returns false. The cache can't be modified by f.
+	Range(f func(pid uint32, entry *model.ProcessCacheEntry) bool)
+}
+
+// newEntryCache returns an entry cache of the given kind, holding up to pidMax pids before growing
+func newEntryCache(kind string, initialSize int, pidMax int) entryCache {
+	if kind == PIDTableEntryCache {
+		return newPIDTableEntryCache(initialSize, pidMax)
+	}
+	return make(mapEntryCache)
+}
+
+type entryCacheShard struct {
+	sync.RWMutex
+	cache entryCache
+	_     [cacheLineSize]byte
+}
+
+// shardedEntryCache stripes the entries by pid into shards having their own lock, so that the operations on the
+// entries of different pids don't contend. The operations on all the entries lock all the shards, in order, so that
+// they see a consistent cache.
+type shardedEntryCache struct {
+	shards []entryCacheShard
+	len    *atomic.Int64
+}
+
+// newShardedEntryCache returns an entry cache of the given kind, striped into the given number of shards
+func newShardedEntryCache(kind string, shards int) *shardedEntryCache {
+	shards = max(shards, 1)
+
+	// the pids are spread evenly across the shards
+	pidMax := readPIDMax()
+	c := &shardedEntryCache{
+		shards: make([]entryCacheShard, shards),
+		len:    atomic.NewInt64(0),
+	}
+	for i := range c.shards {
+		c.shards[i].cache = newEntryCache(kind, max(pidTableInitialSize/shards, 1), max(pidMax/shards, 1))
+	}
+	return c
+}
+
+func (c *shardedEntryCache) shard(pid uint32) *entryCacheShard {
+	return &c.shards[pid%uint32(len(c.shards))]
+}
+
+// Get implements the entryCache interface
+func (c *shardedEntryCache) Get(pid uint32) *model.ProcessCacheEntry {
+	shard := c.shard(pid)
+	shard.RLock()
+	defer shard.RUnlock()
+	return shard.cache.Get(pid)
+}
+
+// View calls f with the entry of the given pid, or nil if there is none, while holding the read lock of its shard. It
+// returns the entry returned by f, f can't modify the entry.
+func (c *shardedEntryCache) View(pid uint32, f func(entry *model.ProcessCacheEntry) *model.ProcessCacheEntry) *model.ProcessCacheEntry {
+	shard := c.shard(pid)
+	shard.RLock()
+	defer shard.RUnlock()
+	return f(shard.cache.Get(pid))
+}
+
+// Swap calls f with the entry of the given pid, or nil if there is none, and replaces it with the entry returned by
+// f, nil deleting it, while holding the lock of its shard. It returns the previous entry.
+func (c *shardedEntryCache) Swap(pid uint32, f func(prev *model.ProcessCacheEntry) *model.ProcessCacheEntry) *model.ProcessCacheEntry {
+	shard := c.shard(pid)
+	shard.Lock()
+	defer shard.Unlock()
+
+	prev := shard.cache.Get(pid)
+	next := f(prev)
+	switch {
+	case next == prev:
+	case next == nil:
+		shard.cache.Delete(pid)
+		c.len.Dec()
+	default:
+		if prev == nil {
+			c.len.Inc()
+		}
+		shard.cache.Set(pid, next)
+	}
+	return prev
+}
+
+// Do calls f with the entry of the given pid, or nil if there is none, while holding the lock of its shard. It
+// returns the entry returned by f.
+func (c *shardedEntryCache) Do(pid uint32, f func(entry *model.ProcessCacheEntry) *model.ProcessCacheEntry) *model.ProcessCacheEntry {
+	shard := c.shard(pid)
+	shard.Lock()
+	defer shard.Unlock()
+	return f(shard.cache.Get(pid))
+}
+
+// Set implements the entryCache interface
+func (c *shardedEntryCache) Set(pid uint32, entry *model.ProcessCacheEntry) {
+	shard := c.shard(pid)
+	shard.Lock()
+	defer shard.Unlock()
+	if shard.cache.Get(pid) == nil {
+		c.len.Inc()
+	}
+	shard.cache.Set(pid, entry)
+}
+
+// Delete implements the entryCache interface
+func (c *shardedEntryCache) Delete(pid uint32) {
+	shard := c.shard(pid)
+	shard.Lock()
+	defer shard.Unlock()
+	if shard.cache.Get(pid) != nil {
+		c.len.Dec()
+	}
+	shard.cache.Delete(pid)
+}
+
+// Len implements the entryCache interface. It doesn't lock the shards, so that it can be checked on each insertion.
+func (c *shardedEntryCache) Len() int {
+	return int(c.len.Load())
+}
+
+// Range implements the entryCache interface. f can't call the other methods of the cache.
+func (c *shardedEntryCache) Range(f func(pid uint32, entry *model.ProcessCacheEntry) bool) {
+	c.rlockAll()
+	defer c.runlockAll()
+
+	for i := range c.shards {
+		stopped := false
+		c.shards[i].cache.Range(func(pid uint32, entry *model.ProcessCacheEntry) bool {
+			stopped = !f(pid, entry)
+			return !stopped
+		})
+		if stopped {
+			return
+		}
+	}
+}
+
+func (c *shardedEntryCache) rlockAll() {
+	for i := range c.shards {
+		c.shards[i].RLock()
+	}
+}
+
+func (c *shardedEntryCache) runlockAll() {
+	for i := len(c.shards) - 1; i >= 0; i-- {
+		c.shards[i].RUnlock()
+	}
+}
+
+// mapEntryCache is an entry cache backed by a go map
+type mapEntryCache map[uint32]*model.ProcessCacheEntry
+
+// Get implements the entryCache interface
+func (c mapEntryCache) Get(pid uint32) *model.ProcessCacheEntry {
+	return c[pid]
+}
+
+// Set implements the entryCache interface
+func (c mapEntryCache) Set(pid uint32, entry *model.ProcessCacheEntry) {
+	c[pid] = entry
+}
+
+// Delete implements the entryCache interface
+func (c mapEntryCache) Delete(pid uint32) {
+	delete(c, pid)
+}
+
+// Len implements the entryCache interface
+func (c mapEntryCache) Len() int {
+	return len(c)
+}
+
+// Range implements the entryCache interface
+func (c mapEntryCache) Range(f func(pid uint32, entry *model.ProcessCacheEntry) bool) {
+	for pid, entry := range c {
+		if !f(pid, entry) {
+			return
+		}
+	}
+}
+
+// pidTableEntryCache is an open addressing table using linear probing. The table grows until it can hold pid_max
+// slots, at which point a pid always lands in its own slot.
+type pidTableEntryCache struct {
+	pids    []uint32
+	entries []*model.ProcessCacheEntry
+	mask    uint32
+	maxSize int
+	len     int
+}
+
+func newPIDTableEntryCache(initialSize int, pidMax int) *pidTableEntryCache {
+	maxSize := nextPowerOfTwo(pidMax)
+	size := min(nextPowerOfTwo(initialSize), maxSize)
+
+	return &pidTableEntryCache{
+		pids:    make([]uint32, size),
+		entries: make([]*model.ProcessCacheEntry, size),
+		mask:    uint32(size - 1),
+		maxSize: maxSize,
+	}
+}
+
+// home returns the first slot of the probe sequence of the given pid. Pids are allocated sequentially by the kernel,
+// using them as slots would build a single cluster of live processes that deletions have to scan. Multiplying by an
+// odd number is a bijection modulo the size of the table, so pids below the size of the table never collide.
+func (t *pidTableEntryCache) home(pid uint32) uint32 {
+	return (pid * pidTableHashMultiplier) & t.mask
+}
+
+// slot returns the slot of the given pid, or the empty slot where it should be inserted
+func (t *pidTableEntryCache) slot(pid uint32) uint32 {
+	i := t.home(pid)
+	for t.entries[i] != nil && t.pids[i] != pid {
+		i = (i + 1) & t.mask
+	}
+	return i
+}
+
+// Get implements the entryCache interface
+func (t *pidTableEntryCache) Get(pid uint32) *model.ProcessCacheEntry {
+	return t.entries[t.slot(pid)]
+}
+
+// Set implements the entryCache interface
+func (t *pidTableEntryCache) Set(pid uint32, entry *model.ProcessCacheEntry) {
+	i := t.slot(pid)
+	if t.entries[i] == nil {
+		if t.shouldGrow() {
+			t.grow()
+			i = t.slot(pid)
+		}
+		t.len++
+	}
+	t.pids[i], t.entries[i] = pid, entry
+}
+
+// shouldGrow returns whether the table should grow before inserting a new entry. Past pid_max, the table only grows
+// to keep empty slots for the pids above pid_max, pid_max being changeable at runtime.
+func (t *pidTableEntryCache) shouldGrow() bool {
+	size := len(t.entries)
+	if size < t.maxSize {
+		return (t.len+1)*2 > size
+	}
+	return (t.len+1)*4 > size*3
+}
+
+func (t *pidTableEntryCache) grow() {
+	pids, entries := t.pids, t.entries
+
+	size := len(entries) * 2
+	t.pids = make([]uint32, size)
+	t.entries = make([]*model.ProcessCacheEntry, size)
+	t.mask = uint32(size - 1)
+
+	for i, entry := range entries {
+		if entry != nil {
+			j := t.slot(pids[i])
+			t.pids[j], t.entries[j] = pids[i], entry
+		}
+	}
+}
+
+// Delete implements the entryCache interface. The following entries of the probe sequence are shifted back so that
+// lookups never need tombstones.
+func (t *pidTableEntryCache) Delete(pid uint32) {
+	i := t.slot(pid)
+	if t.entries[i] == nil {
+		return
+	}
+	t.entries[i] = nil
+	t.len--
+
+	for j := (i + 1) & t.mask; t.entries[j] != nil; j = (j + 1) & t.mask {
+		// the entry can't move before its home slot
+		home := t.home(t.pids[j])
+		if (j > i && (home <= i || home > j)) || (j < i && home <= i && home > j) {
+			t.pids[i], t.entries[i] = t.pids[j], t.entries[j]
+			t.entries[j] = nil
+			i = j
+		}
+	}
+}
+
+// Len implements the entryCache interface
+func (t *pidTableEntryCache) Len() int {
+	return t.len
+}
+
+// Range implements the entryCache interface
+func (t *pidTableEntryCache) Range(f func(pid uint32, entry *model.ProcessCacheEntry) bool) {
+	for i, entry := range t.entries {
+		if entry != nil && !f(t.pids[i], entry) {
+			return
+		}
+	}
+}
+
+func nextPowerOfTwo(n int) int {
+	if n <= 1 {
+		return 1
+	}
+	return 1 << bits.Len(uint(n-1))
+}
+
+// readPIDMax returns the maximum pid value of the host
+func readPIDMax() int {
+	data, err := os.ReadFile(kernel.HostProc("sys/kernel/pid_max"))
+	if err != nil {
+		return pidMaxLimit
+	}
+
+	pidMax, err := strconv.Atoi(strings.TrimSpace(string(data)))
+	if err != nil || pidMax <= 0 {
+		return pidMaxLimit
+	}
+	return pidMax
+}
@@ -0,0 +1,286 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+// Package process holds process related files
+package process
+
+import (
+	"math/rand"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
+)
+
+func TestPIDTableEntryCache(t *testing.T) {
+	for _, pidMax := range []int{32768, 1 << 10} {
+		// pids above pid_max exercise the growth past pid_max
+		maxPid := uint32(pidMax * 2)
+
+		expected := make(mapEntryCache)
+		table := newPIDTableEntryCache(16, pidMax)
+
+		rnd := rand.New(rand.NewSource(42))
+		for i := 0; i < 200000; i++ {
+			pid := uint32(rnd.Int63n(int64(maxPid)))
+
+			switch rnd.Intn(3) {
+			case 0, 1:
+				entry := &model.ProcessCacheEntry{}
+				expected.Set(pid, entry)
+				table.Set(pid, entry)
+			case 2:
+				expected.Delete(pid)
+				table.Delete(pid)
+			}
+
+			if !assert.Same(t, expected.Get(pid), table.Get(pid)) {
+				return
+			}
+		}
+
+		assert.Equal(t, expected.Len(), table.Len())
+		for pid := uint32(0); pid < maxPid; pid++ {
+			if !assert.Same(t, expected.Get(pid), table.Get(pid), "pid %d", pid) {
+				return
+			}
+		}
+
+		var count int
+		table.Range(func(pid uint32, entry *model.ProcessCacheEntry) bool {
+			assert.Same(t, expected.Get(pid), entry)
+			count++
+			return true
+		})
+		assert.Equal(t, expected.Len(), count)
+	}
+}
+
+func TestPIDTableEntryCacheWrapAround(t *testing.T) {
+	table := newPIDTableEntryCache(8, 1<<20)
+	entries := make([]*model.ProcessCacheEntry, 3)
+
+	// pids congruent modulo the size of the table share the last slot, the probe sequence wraps around the table
+	for i := range entries {
+		entries[i] = &model.ProcessCacheEntry{}
+		table.Set(uint32(7+i*8), entries[i])
+	}
+
+	table.Delete(7)
+	assert.Nil(t, table.Get(7))
+	assert.Same(t, entries[1], table.Get(15))
+	assert.Same(t, entries[2], table.Get(23))
+	assert.Equal(t, 2, table.Len())
+}
+
+// benchmarkEntryCache simulates the workload of the process resolver: processes are forked with increasing pids,
+// looked up several times and reaped once they exit.
+func benchmarkEntryCache(b *testing.B, newCache func() entryCache, liveProcesses int) {
+	const pidMax = 1 << 22
+
+	entry := &model.ProcessCacheEntry{}
+	cache := newCache()
+
+	pid := uint32(1)
+	for i := 0; i < liveProcesses; i++ {
+		cache.Set(pid, entry)
+		pid++
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		cache.Set(pid, entry)
+		for j := uint32(0); j < 4; j++ {
+			_ = cache.Get(pid - j*uint32(liveProcesses)/4)
+		}
+		cache.Delete(pid - uint32(liveProcesses))
+
+		if pid++; pid == pidMax {
+			pid = uint32(liveProcesses) + 1
+		}
+	}
+}
+
+func BenchmarkEntryCache(b *testing.B) {
+	for _, liveProcesses := range []int{1000, 10000, 100000} {
+		b.Run(MapEntryCache+"/"+strconv.Itoa(liveProcesses), func(b *testing.B) {
+			benchmarkEntryCache(b, func() entryCache { return make(mapEntryCache) }, liveProcesses)
+		})
+		b.Run(PIDTableEntryCache+"/"+strconv.Itoa(liveProcesses), func(b *testing.B) {
+			benchmarkEntryCache(b, func() entryCache { return newPIDTableEntryCache(pidTableInitialSize, 1<<22) }, liveProcesses)
+		})
+	}
+}
+
+func TestShardedEntryCache(t *testing.T) {
+	for _, kind := range []string{MapEntryCache, PIDTableEntryCache} {
+		t.Run(kind, func(t *testing.T) {
+			cache := newShardedEntryCache(kind, 8)
+			assert.Len(t, cache.shards, 8)
+
+			entries := make(map[uint32]*model.ProcessCacheEntry)
+			for pid := uint32(1); pid <= 100; pid++ {
+				entry := &model.ProcessCacheEntry{}
+				entry.Pid = pid
+				entries[pid] = entry
+				cache.Set(pid, entry)
+			}
+			assert.Equal(t, 100, cache.Len())
+
+			for pid := uint32(1); pid <= 100; pid += 2 {
+				cache.Delete(pid)
+				delete(entries, pid)
+			}
+			assert.Equal(t, 50, cache.Len())
+
+			for pid, entry := range entries {
+				assert.Equal(t, entry, cache.Get(pid))
+			}
+			assert.Nil(t, cache.Get(1))
+
+			seen := make(map[uint32]*model.ProcessCacheEntry)
+			cache.Range(func(pid uint32, entry *model.ProcessCacheEntry) bool {
+				seen[pid] = entry
+				return true
+			})
+			assert.Equal(t, entries, seen)
+
+			var count int
+			cache.Range(func(_ uint32, _ *model.ProcessCacheEntry) bool {
+				count++
+				return count < 10
+			})
+			assert.Equal(t, 10, count)
+
+			entry := cache.Do(2, func(entry *model.ProcessCacheEntry) *model.ProcessCacheEntry {
+				entry.Tid = 3
+				return entry
+			})
+			assert.Equal(t, uint32(3), entry.Tid)
+			assert.Nil(t, cache.Do(1, func(entry *model.ProcessCacheEntry) *model.ProcessCacheEntry { return entry }))
+			assert.Equal(t, entry, cache.View(2, func(entry *model.ProcessCacheEntry) *model.ProcessCacheEntry { return entry }))
+
+			// swapping replaces, inserts or deletes the entry of the pid
+			replacement := &model.ProcessCacheEntry{}
+			assert.Equal(t, entry, cache.Swap(2, func(_ *model.ProcessCacheEntry) *model.ProcessCacheEntry { return replacement }))
+			assert.Equal(t, replacement, cache.Get(2))
+			assert.Nil(t, cache.Swap(1, func(_ *model.ProcessCacheEntry) *model.ProcessCacheEntry { return entry }))
+			assert.Equal(t, 51, cache.Len())
+			assert.Equal(t, entry, cache.Swap(1, func(_ *model.ProcessCacheEntry) *model.ProcessCacheEntry { return nil }))
+			assert.Nil(t, cache.Get(1))
+			assert.Equal(t, 50, cache.Len())
+		})
+	}
+}
+
+// BenchmarkShardedEntryCacheContention simulates the event handling goroutines resolving processes in parallel, while
+// one in 16 operations is an exec or an exit updating the cache. The updates take the lock of the resolver for the
+// whole insertion, the lineage and the accounting included, as they do in both cases. The `resolver_lock` case is the
+// baseline before the sharding, where every resolution took the lock of the resolver to update the thread and the
+// last reference of the entry. With the shards, the lookups only wait for the lock of their own shard, and only take
+// its read lock when the entry was recently referenced.
+func BenchmarkShardedEntryCacheContention(b *testing.B) {
+	const (
+		liveProcesses = 10000
+		// the bookkeeping done by an insertion under the lock of the resolver
+		insertionWork = 64
+	)
+
+	var sink atomic.Uint64
+	bookkeeping := func(pid uint32) {
+		h := uint64(pid)
+		for i := 0; i < insertionWork; i++ {
+			h = h*pidTableHashMultiplier + uint64(i)
+		}
+		sink.Add(h)
+	}
+
+	// the events of a process are mostly triggered by its main thread
+	run := func(b *testing.B, lookup func(pid, tid uint32), update func(pid uint32)) {
+		var seed atomic.Int64
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			r := rand.New(rand.NewSource(seed.Add(1)))
+			for i := 0; pb.Next(); i++ {
+				pid := uint32(r.Intn(liveProcesses)) + 1
+				if i%16 == 0 {
+					update(pid)
+					continue
+				}
+				lookup(pid, pid)
+			}
+		})
+	}
+
+	newEntry := func(pid uint32) *model.ProcessCacheEntry {
+		entry := &model.ProcessCacheEntry{}
+		entry.Pid, entry.Tid = pid, pid
+		entry.LastReferenced = time.Now()
+		return entry
+	}
+
+	b.Run("resolver_lock", func(b *testing.B) {
+		var lock sync.Mutex
+		cache := make(mapEntryCache)
+		for pid := uint32(1); pid <= liveProcesses; pid++ {
+			cache.Set(pid, newEntry(pid))
+		}
+
+		run(b, func(pid, tid uint32) {
+			lock.Lock()
+			if entry := cache.Get(pid); entry != nil {
+				entry.Tid = tid
+				entry.LastReferenced = time.Now()
+			}
+			lock.Unlock()
+		}, func(pid uint32) {
+			lock.Lock()
+			cache.Delete(pid)
+			cache.Set(pid, newEntry(pid))
+			bookkeeping(pid)
+			lock.Unlock()
+		})
+	})
+
+	for _, shards := range []int{1, 4, 16, 64} {
+		b.Run(strconv.Itoa(shards), func(b *testing.B) {
+			var lock sync.Mutex
+			cache := newShardedEntryCache(MapEntryCache, shards)
+			for pid := uint32(1); pid <= liveProcesses; pid++ {
+				cache.Set(pid, newEntry(pid))
+			}
+
+			run(b, func(pid, tid uint32) {
+				now := time.Now()
+				var stale bool
+				entry := cache.View(pid, func(entry *model.ProcessCacheEntry) *model.ProcessCacheEntry {
+					stale = entry != nil && (entry.Tid != tid || now.Sub(entry.LastReferenced) >= lastReferencedPrecision)
+					return entry
+				})
+				if stale {
+					_ = cache.Do(pid, func(entry *model.ProcessCacheEntry) *model.ProcessCacheEntry {
+						entry.Tid, entry.LastReferenced = tid, now
+						return entry
+					})
+				}
+				_ = entry
+			}, func(pid uint32) {
+				lock.Lock()
+				cache.Swap(pid, func(_ *model.ProcessCacheEntry) *model.ProcessCacheEntry { return newEntry(pid) })
+				bookkeeping(pid)
+				lock.Unlock()
+			})
+		})
+	}
+}
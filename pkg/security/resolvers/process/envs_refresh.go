@@ -0,0 +1,241 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+// Package process holds process related files
+package process
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/atomic"
+
+	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
+)
+
+// EnvsChange describes the variables of the environment of a process that changed between two refreshes. The values
+// are only reported for the variables whose value is exported.
+type EnvsChange struct {
+	Added    []string
+	Removed  []string
+	Modified []string
+}
+
+// envsSnapshot holds the environment of a process read during the previous refresh
+type envsSnapshot struct {
+	execTime time.Time
+	values   []string
+}
+
+// envsRefresh is a changed environment read by the refresh loop, applied to the cache entry from the event path
+type envsRefresh struct {
+	pid       uint32
+	execTime  time.Time
+	readTime  time.Time
+	values    []string
+	truncated bool
+	change    *EnvsChange
+}
+
+// envsRefreshQueue holds the changed environments waiting to be applied
+type envsRefreshQueue struct {
+	sync.Mutex
+	refreshes []envsRefresh
+	ready     atomic.Bool
+}
+
+// push queues a changed environment
+func (q *envsRefreshQueue) push(refresh envsRefresh) {
+	q.Lock()
+	defer q.Unlock()
+
+	q.refreshes = append(q.refreshes, refresh)
+	q.ready.Store(true)
+}
+
+// pop returns the changed environments queued since the previous call
+func (q *envsRefreshQueue) pop() []envsRefresh {
+	if !q.ready.Load() {
+		return nil
+	}
+
+	q.Lock()
+	defer q.Unlock()
+
+	refreshes := q.refreshes
+	q.refreshes = nil
+	q.ready.Store(false)
+	return refreshes
+}
+
+// splitEnv returns the name and the value of an environment variable
+func splitEnv(env string) (string, string) {
+	name, value, _ := strings.Cut(env, "=")
+	return name, value
+}
+
+// diffEnvs returns the change between two environments, or nil if they hold the same variables
+func diffEnvs(previous []string, current []string, keepValue func(string) bool) *EnvsChange {
+	previousValues := make(map[string]string, len(previous))
+	for _, env := range previous {
+		name, value := splitEnv(env)
+		previousValues[name] = value
+	}
+
+	format := func(name string, env string) string {
+		if keepValue(name) {
+			return env
+		}
+		return name
+	}
+
+	var change EnvsChange
+	for _, env := range current {
+		name, value := splitEnv(env)
+		previousValue, found := previousValues[name]
+		switch {
+		case !found:
+			change.Added = append(change.Added, format(name, env))
+		case previousValue != value:
+			change.Modified = append(change.Modified, format(name, env))
+		}
+		delete(previousValues, name)
+	}
+
+	// the variables left weren't found in the current environment, they are reported in their previous order
+	for _, env := range previous {
+		name, _ := splitEnv(env)
+		if _, removed := previousValues[name]; removed {
+			change.Removed = append(change.Removed, name)
+			delete(previousValues, name)
+		}
+	}
+
+	if len(change.Added) == 0 && len(change.Removed) == 0 && len(change.Modified) == 0 {
+		return nil
+	}
+	return &change
+}
+
+// refreshEnvsLoop periodically reads again the environment of the long-lived processes
+func (p *EBPFResolver) refreshEnvsLoop(ctx context.Context) {
+	ticker := time.NewTicker(p.opts.envsRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case now := <-ticker.C:
+			p.refreshEnvs(now)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// refreshEnvs reads again the environment of the processes running for longer than the refresh interval, queuing the
+// environments that changed since the previous refresh. The first read of a process is compared to the environment
+// captured at its execution when it wasn't truncated, and only recorded otherwise, the truncation of the two reads
+// differing. The cache entries aren't updated, the changes being applied from the event path.
+func (p *EBPFResolver) refreshEnvs(now time.Time) {
+	type candidate struct {
+		pid      uint32
+		execTime time.Time
+		// environment captured at the execution, nil if it was truncated
+		execEnvs []string
+	}
+
+	var candidates []candidate
+	p.RLock()
+	p.entryCache.Range(func(pid uint32, entry *model.ProcessCacheEntry) bool {
+		if entry.IsKworker || !entry.ExitTime.IsZero() || now.Sub(entry.ExecTime) < p.opts.envsRefreshInterval {
+			return true
+		}
+		c := candidate{pid: pid, execTime: entry.ExecTime}
+		if entry.EnvsEntry != nil && !entry.EnvsEntry.Truncated {
+			// the values of an entry are never modified, a refresh replaces the whole entry
+			c.execEnvs = entry.EnvsEntry.Values
+		}
+		candidates = append(candidates, c)
+		return true
+	})
+	p.RUnlock()
+
+	var snapshotsSize int64
+	snapshots := make(map[uint32]envsSnapshot, len(candidates))
+	for _, c := range candidates {
+		envs, truncated, err := p.envVarsResolver.ResolveEnvVars(c.pid)
+		if err != nil {
+			continue
+		}
+		snapshots[c.pid] = envsSnapshot{execTime: c.execTime, values: envs}
+		snapshotsSize += stringsMemorySize(envs)
+
+		var previousEnvs []string
+		if previous, found := p.envsSnapshots[c.pid]; found && previous.execTime.Equal(c.execTime) {
+			previousEnvs = previous.values
+		} else if c.execEnvs != nil {
+			previousEnvs = c.execEnvs
+		} else {
+			continue
+		}
+
+		change := diffEnvs(previousEnvs, envs, p.envsWithValue.get().keepValue)
+		if change == nil {
+			continue
+		}
+
+		p.envsRefreshes.push(envsRefresh{
+			pid:       c.pid,
+			execTime:  c.execTime,
+			readTime:  now,
+			values:    envs,
+			truncated: truncated,
+			change:    change,
+		})
+	}
+
+	// the processes that exited are forgotten, the snapshots being accounted in the memory usage of the cache
+	p.envsSnapshots = snapshots
+	p.memoryUsage.Add(snapshotsSize - p.envsSnapshotsSize)
+	p.envsSnapshotsSize = snapshotsSize
+}
+
+// ApplyEnvsRefreshes replaces the environment of the cache entries with the changed ones read by the refresh loop,
+// notifying the subscribers. It is called from the event path, the entries being only updated there.
+func (p *EBPFResolver) ApplyEnvsRefreshes() {
+	refreshes := p.envsRefreshes.pop()
+	if len(refreshes) == 0 {
+		return
+	}
+
+	p.Lock()
+	defer p.Unlock()
+
+	for _, refresh := range refreshes {
+		// the process may have exited, or executed another binary, since its environment was read
+		entry := p.entryCache.Get(refresh.pid)
+		if entry == nil || !entry.ExecTime.Equal(refresh.execTime) {
+			continue
+		}
+
+		entry.EnvsEntry = &model.EnvsEntry{
+			Values:    refresh.values,
+			Truncated: refresh.truncated,
+		}
+		p.accountEntryMemory(entry)
+		p.snapshotLookupInfo(entry)
+		p.envsChanged.Inc()
+
+		p.subscribers.notify(func() ProcessTreeUpdate {
+			update := newProcessTreeUpdate(ProcessTreeEnvs, entry, refresh.readTime)
+			update.Envs = refresh.change
+			return update
+		})
+	}
+}
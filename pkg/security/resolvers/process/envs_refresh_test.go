@@ -0,0 +1,152 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+// Package process holds process related files
+package process
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DataDog/datadog-go/v5/statsd"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/DataDog/datadog-agent/pkg/security/resolvers/envvars"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
+)
+
+func TestDiffEnvs(t *testing.T) {
+	keepValue := func(name string) bool {
+		return name == "PATH" || name == "LANG"
+	}
+
+	t.Run("unchanged", func(t *testing.T) {
+		envs := []string{"PATH=/bin", "SECRET=abc"}
+		assert.Nil(t, diffEnvs(envs, envs, keepValue))
+	})
+
+	t.Run("reordered", func(t *testing.T) {
+		assert.Nil(t, diffEnvs([]string{"PATH=/bin", "SECRET=abc"}, []string{"SECRET=abc", "PATH=/bin"}, keepValue))
+	})
+
+	t.Run("changed", func(t *testing.T) {
+		previous := []string{"PATH=/bin", "SECRET=abc", "TOKEN=xyz", "HOME=/root"}
+		current := []string{"PATH=/usr/bin", "SECRET=def", "HOME=/root", "LANG=C", "API_KEY=123"}
+
+		change := diffEnvs(previous, current, keepValue)
+		if assert.NotNil(t, change) {
+			assert.Equal(t, []string{"LANG=C", "API_KEY"}, change.Added)
+			assert.Equal(t, []string{"TOKEN"}, change.Removed)
+			assert.Equal(t, []string{"PATH=/usr/bin", "SECRET"}, change.Modified)
+		}
+	})
+
+	t.Run("removed-order", func(t *testing.T) {
+		change := diffEnvs([]string{"C=1", "A=1", "B=1"}, nil, keepValue)
+		if assert.NotNil(t, change) {
+			assert.Equal(t, []string{"C", "A", "B"}, change.Removed)
+			assert.Empty(t, change.Added)
+			assert.Empty(t, change.Modified)
+		}
+	})
+}
+
+func TestApplyEnvsRefreshes(t *testing.T) {
+	resolver, err := NewEBPFResolver(nil, nil, &statsd.NoOpClient{}, nil, nil, nil, nil, nil, nil, nil, nil, NewResolverOpts())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	execTime := time.Now().Add(-time.Hour)
+	entry := resolver.NewProcessCacheEntry(model.PIDContext{Pid: 1, Tid: 1})
+	entry.ExecTime = execTime
+	entry.EnvsEntry = &model.EnvsEntry{Values: []string{"PATH=/bin"}}
+	resolver.insertEntry(entry, nil, model.ProcessCacheEntryFromEvent, ProcessTreeInsert, time.Now())
+
+	subscription := resolver.SubscribeProcessTree(10)
+	defer resolver.UnsubscribeProcessTree(subscription)
+
+	// the subscription starts with the processes of the cache
+	if update := <-subscription.Updates(); assert.Equal(t, ProcessTreeInsert, update.Type) {
+		assert.Equal(t, uint32(1), update.Pid)
+	}
+
+	change := &EnvsChange{Added: []string{"LANG"}}
+	resolver.envsRefreshes.push(envsRefresh{
+		pid:      1,
+		execTime: execTime,
+		readTime: time.Now(),
+		values:   []string{"PATH=/bin", "LANG=C"},
+		change:   change,
+	})
+	// the refresh of a process that executed another binary since its environment was read is dropped
+	resolver.envsRefreshes.push(envsRefresh{
+		pid:      1,
+		execTime: execTime.Add(-time.Hour),
+		values:   []string{"PATH=/usr/bin"},
+		change:   &EnvsChange{Modified: []string{"PATH"}},
+	})
+
+	// the entry is only updated from the event path
+	assert.Equal(t, []string{"PATH=/bin"}, entry.EnvsEntry.Values)
+
+	resolver.ApplyEnvsRefreshes()
+	assert.Equal(t, []string{"PATH=/bin", "LANG=C"}, entry.EnvsEntry.Values)
+	assert.Equal(t, int64(1), resolver.envsChanged.Load())
+	assert.Equal(t, computeMemoryUsage(resolver), resolver.memoryUsage.Load())
+
+	if assert.Len(t, subscription.Updates(), 1) {
+		update := <-subscription.Updates()
+		assert.Equal(t, ProcessTreeEnvs, update.Type)
+		assert.Equal(t, change, update.Envs)
+	}
+
+	// the queue is emptied
+	resolver.ApplyEnvsRefreshes()
+	assert.Equal(t, int64(1), resolver.envsChanged.Load())
+}
+
+func TestRefreshEnvs(t *testing.T) {
+	resolver, err := NewEBPFResolver(nil, nil, &statsd.NoOpClient{}, nil, nil, nil, nil, nil, nil, nil, envvars.NewEnvVarsResolver(nil), NewResolverOpts().WithEnvsRefreshInterval(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pid := uint32(os.Getpid())
+	current, truncated, err := resolver.envVarsResolver.ResolveEnvVars(pid)
+	if err != nil || truncated || len(current) == 0 {
+		t.Skip("the environment of the test process can't be read entirely")
+	}
+	name, _ := splitEnv(current[0])
+
+	// the environment captured at the execution lacks a variable
+	entry := resolver.NewProcessCacheEntry(model.PIDContext{Pid: pid, Tid: pid})
+	entry.ExecTime = time.Now().Add(-time.Hour)
+	entry.EnvsEntry = &model.EnvsEntry{Values: current[1:]}
+	resolver.insertEntry(entry, nil, model.ProcessCacheEntryFromEvent, ProcessTreeInsert, time.Now())
+
+	// the first read is compared to the environment captured at the execution
+	resolver.refreshEnvs(time.Now())
+	refreshes := resolver.envsRefreshes.pop()
+	if assert.Len(t, refreshes, 1) && assert.Len(t, refreshes[0].change.Added, 1) {
+		assert.True(t, strings.HasPrefix(refreshes[0].change.Added[0], name))
+	}
+	assert.Equal(t, computeMemoryUsage(resolver), resolver.memoryUsage.Load())
+
+	// the next reads are compared to the previous one
+	resolver.refreshEnvs(time.Now())
+	assert.Empty(t, resolver.envsRefreshes.pop())
+
+	// the first read is only recorded when the environment captured at the execution was truncated
+	resolver.envsSnapshots = nil
+	entry.EnvsEntry.Truncated = true
+	resolver.refreshEnvs(time.Now())
+	assert.Empty(t, resolver.envsRefreshes.pop())
+	assert.Equal(t, computeMemoryUsage(resolver), resolver.memoryUsage.Load())
+}
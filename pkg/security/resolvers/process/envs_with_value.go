@@ -0,0 +1,151 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+// Package process holds process related files
+package process
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+
+	"go.uber.org/atomic"
+
+	"github.com/DataDog/datadog-agent/pkg/security/seclog"
+)
+
+// envsWithValueFilter decides which environment variables are exported with their value. Its patterns are either:
+//   - the exact name of a variable, `PATH`
+//   - a glob, `DD_*`, where `*` matches any sequence of characters and `?` a single one
+//   - a regular expression surrounded by slashes, `/^AWS_[A-Z]+_REGION$/`
+//
+// and are negated when prefixed with `!`, `!*_TOKEN`. A variable matching a negated pattern never has its value
+// exported, whatever the other patterns.
+type envsWithValueFilter struct {
+	patterns []string
+	names    map[string]bool
+	allowed  []*regexp.Regexp
+	denied   []*regexp.Regexp
+}
+
+// compileEnvPattern returns the regular expression of a glob or regex pattern, or nil for an exact variable name
+func compileEnvPattern(pattern string) (*regexp.Regexp, error) {
+	if len(pattern) > 2 && strings.HasPrefix(pattern, "/") && strings.HasSuffix(pattern, "/") {
+		return regexp.Compile(pattern[1 : len(pattern)-1])
+	}
+
+	if !strings.ContainsAny(pattern, "*?") {
+		return nil, nil
+	}
+
+	var expr strings.Builder
+	expr.WriteString("^")
+	for _, r := range pattern {
+		switch r {
+		case '*':
+			expr.WriteString(".*")
+		case '?':
+			expr.WriteString(".")
+		default:
+			expr.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	expr.WriteString("$")
+	return regexp.Compile(expr.String())
+}
+
+// newEnvsWithValueFilter compiles the provided patterns, the invalid ones are skipped
+func newEnvsWithValueFilter(patterns []string) *envsWithValueFilter {
+	f := &envsWithValueFilter{
+		names: make(map[string]bool),
+	}
+
+	for _, pattern := range patterns {
+		if pattern == "" || slices.Contains(f.patterns, pattern) {
+			continue
+		}
+
+		name, deny := strings.CutPrefix(pattern, "!")
+		re, err := compileEnvPattern(name)
+		if err != nil {
+			seclog.Warnf("invalid environment variable pattern `%s`: %v", pattern, err)
+			continue
+		}
+
+		switch {
+		case deny && re == nil:
+			re = regexp.MustCompile("^" + regexp.QuoteMeta(name) + "$")
+			fallthrough
+		case deny:
+			f.denied = append(f.denied, re)
+		case re != nil:
+			f.allowed = append(f.allowed, re)
+		default:
+			f.names[name] = true
+		}
+		f.patterns = append(f.patterns, pattern)
+	}
+	slices.Sort(f.patterns)
+
+	return f
+}
+
+// keepValue returns whether the value of the given variable is exported
+func (f *envsWithValueFilter) keepValue(name string) bool {
+	for _, re := range f.denied {
+		if re.MatchString(name) {
+			return false
+		}
+	}
+
+	if f.names[name] {
+		return true
+	}
+
+	for _, re := range f.allowed {
+		if re.MatchString(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// envsWithValueSet is the set of the environment variables whose value is kept. It is made of the variables of the
+// configuration and of the ones requested by the loaded policies, the latter being replaced on each policy load.
+type envsWithValueSet struct {
+	configured []string
+	current    atomic.Pointer[envsWithValueFilter]
+}
+
+func newEnvsWithValueSet(configured map[string]bool) *envsWithValueSet {
+	s := &envsWithValueSet{}
+	for env := range configured {
+		s.configured = append(s.configured, env)
+	}
+	s.current.Store(newEnvsWithValueFilter(s.configured))
+	return s
+}
+
+// get returns the current filter of variables
+func (s *envsWithValueSet) get() *envsWithValueFilter {
+	return s.current.Load()
+}
+
+// setPolicyEnvs replaces the variables requested by the policies. It returns whether the set changed.
+func (s *envsWithValueSet) setPolicyEnvs(envs []string) bool {
+	current := newEnvsWithValueFilter(slices.Concat(s.configured, envs))
+	if slices.Equal(current.patterns, s.get().patterns) {
+		return false
+	}
+	s.current.Store(current)
+	return true
+}
+
+// list returns the sorted list of the current patterns
+func (s *envsWithValueSet) list() []string {
+	return s.get().patterns
+}
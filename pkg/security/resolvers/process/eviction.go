@@ -0,0 +1,128 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+// Package process holds process related files
+package process
+
+import (
+	"sort"
+	"time"
+
+	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
+)
+
+// evictionLowWatermark is the ratio of the maximum number of entries, or of the memory budget, the eviction shrinks
+// the cache to, so that the cache isn't walked on each insertion once it is full
+const evictionLowWatermark = 0.9
+
+// evictionCandidate is an entry that can be evicted from the cache
+type evictionCandidate struct {
+	entry      *model.ProcessCacheEntry
+	exited     bool
+	lastActive time.Time
+}
+
+// evictEntries evicts entries once the cache holds more than the maximum number of entries, or once its estimated
+// memory usage exceeds the memory budget. The entries of the exited processes are evicted first, then the ones of the
+// processes without children in the cache, least recently used first. An entry is used when its process forks, execs or
+// is referenced by an event. The ancestors of the remaining entries, and the entry just inserted, are never evicted.
+func (p *EBPFResolver) evictEntries(inserted *model.ProcessCacheEntry) {
+	overMaxEntries := p.opts.maxEntries > 0 && p.entryCache.Len() > p.opts.maxEntries
+	overBudget := p.isOverMemoryBudget()
+	if !overMaxEntries && !overBudget {
+		return
+	}
+
+	// the entries are read while the cache is walked, holding the locks of all its shards, as the cache hits update
+	// them under the lock of their shard only
+	parents := make(map[*model.ProcessCacheEntry]struct{})
+	var entries []evictionCandidate
+	p.entryCache.Range(func(_ uint32, entry *model.ProcessCacheEntry) bool {
+		if entry.Ancestor != nil {
+			parents[entry.Ancestor] = struct{}{}
+		}
+
+		lastActive := entry.ForkTime
+		if entry.ExecTime.After(lastActive) {
+			lastActive = entry.ExecTime
+		}
+		if entry.LastReferenced.After(lastActive) {
+			lastActive = entry.LastReferenced
+		}
+		entries = append(entries, evictionCandidate{entry: entry, exited: !entry.ExitTime.IsZero(), lastActive: lastActive})
+		return true
+	})
+
+	candidates := make([]evictionCandidate, 0, len(entries))
+	for _, candidate := range entries {
+		if candidate.entry == inserted {
+			continue
+		}
+
+		if _, isParent := parents[candidate.entry]; isParent && !candidate.exited {
+			continue
+		}
+
+		candidates = append(candidates, candidate)
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].exited != candidates[j].exited {
+			return candidates[i].exited
+		}
+		return candidates[i].lastActive.Before(candidates[j].lastActive)
+	})
+
+	var toEvict int
+	if overMaxEntries {
+		toEvict = p.entryCache.Len() - int(float64(p.opts.maxEntries)*evictionLowWatermark)
+	}
+
+	// the memory of an evicted entry is only released once it is no longer referenced, the usage is then tracked
+	// locally to not evict the whole cache while the entries are still referenced by events or children
+	var toShed int64
+	if overBudget {
+		toShed = p.memoryUsage.Load() - int64(float64(p.opts.memoryBudget)*evictionLowWatermark)
+	}
+
+	for _, candidate := range candidates {
+		if toEvict <= 0 && toShed <= 0 {
+			break
+		}
+
+		if toEvict > 0 {
+			if candidate.exited {
+				p.evictedExited.Inc()
+			} else {
+				p.evictedLeaves.Inc()
+			}
+		} else if candidate.exited {
+			p.shedExited.Inc()
+		} else {
+			p.shedLeaves.Inc()
+		}
+
+		toShed -= entryFreedMemorySize(candidate.entry)
+		toEvict--
+
+		p.evictEntry(candidate.entry)
+	}
+}
+
+// evictEntry removes the entry from the cache, unlike deleteEntry the process isn't marked as exited
+func (p *EBPFResolver) evictEntry(entry *model.ProcessCacheEntry) {
+	if p.cgroupResolver != nil {
+		p.cgroupResolver.DelPIDWithID(string(entry.ContainerID), entry.Pid)
+	}
+
+	p.entryCache.Delete(entry.Pid)
+	p.releaseMountNamespace(entry)
+
+	p.notifyProcessTree(ProcessTreeEvict, entry, time.Now())
+
+	entry.Release()
+}
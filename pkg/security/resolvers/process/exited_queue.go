@@ -0,0 +1,73 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+package process
+
+import (
+	"sync"
+
+	"go.uber.org/atomic"
+)
+
+// exitedQueue is a bounded ring of the pids whose entries are candidates for a flush. It has its own lock so that
+// checking for pending pids doesn't require the resolver lock.
+type exitedQueue struct {
+	sync.Mutex
+
+	pids []uint32
+	head int
+	len  int
+
+	depth   *atomic.Int64
+	dropped *atomic.Int64
+}
+
+func newExitedQueue(size int) *exitedQueue {
+	return &exitedQueue{
+		pids:    make([]uint32, size),
+		depth:   atomic.NewInt64(0),
+		dropped: atomic.NewInt64(0),
+	}
+}
+
+// push queues a pid, it returns false and drops the pid when the queue is full
+func (q *exitedQueue) push(pid uint32) bool {
+	q.Lock()
+	defer q.Unlock()
+
+	if q.len == len(q.pids) {
+		q.dropped.Inc()
+		return false
+	}
+
+	q.pids[(q.head+q.len)%len(q.pids)] = pid
+	q.len++
+	q.depth.Store(int64(q.len))
+
+	return true
+}
+
+// pop dequeues up to count pids, oldest first
+func (q *exitedQueue) pop(count int) []uint32 {
+	if q.depth.Load() == 0 {
+		return nil
+	}
+
+	q.Lock()
+	defer q.Unlock()
+
+	n := min(count, q.len)
+	pids := make([]uint32, n)
+	for i := range pids {
+		pids[i] = q.pids[(q.head+i)%len(q.pids)]
+	}
+	q.head = (q.head + n) % len(q.pids)
+	q.len -= n
+	q.depth.Store(int64(q.len))
+
+	return pids
+}
@@ -0,0 +1,190 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+// Package process holds process related files
+package process
+
+import (
+	"context"
+	"sync"
+
+	"go.uber.org/atomic"
+
+	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
+)
+
+const lineageRepairQueueSize = 256
+
+// lineageRepair is a missing parent found from /proc for a link of a broken lineage
+type lineageRepair struct {
+	pid    uint32
+	link   *model.ProcessCacheEntry
+	parent *model.ProcessCacheEntry
+}
+
+// lineageRepairQueue holds the pids whose lineage has to be repaired, a pid being queued only once until its repair
+// is attempted, and the repairs found by the worker, applied from the event path
+type lineageRepairQueue struct {
+	sync.Mutex
+	pending  map[uint32]struct{}
+	requests chan uint32
+	repairs  []lineageRepair
+	ready    atomic.Bool
+}
+
+func newLineageRepairQueue(size int) *lineageRepairQueue {
+	return &lineageRepairQueue{
+		pending:  make(map[uint32]struct{}),
+		requests: make(chan uint32, size),
+	}
+}
+
+// push queues the pid, it never blocks. The pid is dropped if the queue is full.
+func (q *lineageRepairQueue) push(pid uint32) {
+	q.Lock()
+	defer q.Unlock()
+
+	if _, found := q.pending[pid]; found {
+		return
+	}
+
+	select {
+	case q.requests <- pid:
+		q.pending[pid] = struct{}{}
+	default:
+	}
+}
+
+// done allows the pid to be queued again once its repair, if any, is applied
+func (q *lineageRepairQueue) done(pid uint32, repair *lineageRepair) {
+	q.Lock()
+	defer q.Unlock()
+
+	if repair != nil {
+		q.repairs = append(q.repairs, *repair)
+		q.ready.Store(true)
+	}
+	delete(q.pending, pid)
+}
+
+// pop returns the repairs found since the previous call
+func (q *lineageRepairQueue) pop() []lineageRepair {
+	if !q.ready.Load() {
+		return nil
+	}
+
+	q.Lock()
+	defer q.Unlock()
+
+	repairs := q.repairs
+	q.repairs = nil
+	q.ready.Store(false)
+	return repairs
+}
+
+// QueueLineageRepair schedules the repair of the broken lineage of the provided entry. The missing ancestors are read
+// from /proc asynchronously, the following events of the process get the repaired lineage.
+func (p *EBPFResolver) QueueLineageRepair(entry *model.ProcessCacheEntry) {
+	if p.lineageRepairs == nil || !p.IsSnapshotted() {
+		return
+	}
+	p.lineageRepairs.push(entry.Pid)
+}
+
+// lineageRepairWorker looks for the missing parents of the queued lineages until the context is cancelled
+func (p *EBPFResolver) lineageRepairWorker(ctx context.Context) {
+	for {
+		select {
+		case pid := <-p.lineageRepairs.requests:
+			p.lineageRepairs.done(pid, p.findLineageRepair(pid))
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// ApplyLineageRepairs links the broken lineages to the missing parents found by the worker. It is called from the
+// event path, the entries being only updated there.
+func (p *EBPFResolver) ApplyLineageRepairs() {
+	if p.lineageRepairs == nil {
+		return
+	}
+
+	repairs := p.lineageRepairs.pop()
+	if len(repairs) == 0 {
+		return
+	}
+
+	p.Lock()
+	defer p.Unlock()
+
+	for _, repair := range repairs {
+		// the lineage may have changed since the repair was found
+		if repair.link.Ancestor == nil {
+			repair.link.RepairLineage(repair.parent)
+
+			if entry := p.entryCache.Get(repair.pid); entry != nil {
+				if valid, _ := entry.HasValidLineage(); valid {
+					p.lineageRepaired.Inc()
+				}
+			}
+		}
+
+		repair.link.Release()
+		repair.parent.Release()
+	}
+}
+
+// brokenLink returns the first entry of the lineage of the provided entry whose parent is missing
+func brokenLink(entry *model.ProcessCacheEntry) *model.ProcessCacheEntry {
+	for entry != nil {
+		if entry.Pid == 1 {
+			return nil
+		}
+		if entry.Ancestor == nil {
+			return entry
+		}
+		entry = entry.Ancestor
+	}
+	return nil
+}
+
+// findLineageRepair looks for the missing parent of the broken lineage of the cache entry of the provided pid, by
+// reading it from /proc. Only the links without ancestor are repaired, an ancestor mismatching the parent of its child
+// is kept. The parent pid of the link may have been reused since its fork, a parent started after the link is
+// rejected. The cache entries aren't updated, the repair is applied from the event path.
+func (p *EBPFResolver) findLineageRepair(pid uint32) *lineageRepair {
+	p.Lock()
+	entry := p.entryCache.Get(pid)
+	if entry == nil {
+		p.Unlock()
+		return nil
+	}
+	link := brokenLink(entry)
+	if link == nil || link.PPid == 0 {
+		p.Unlock()
+		return nil
+	}
+	ppid, linkStart := link.PPid, link.StartBootTime
+	link.Retain()
+	p.Unlock()
+
+	// read /proc without holding the resolver lock, the ancestors found there are inserted in the cache
+	p.resolveFromProcfsInWorker(ppid, linkStart)
+
+	p.Lock()
+	defer p.Unlock()
+
+	parent := p.entryCache.Get(ppid)
+	if parent == nil || parent == link || !isStartedBefore(parent.StartBootTime, linkStart) {
+		link.Release()
+		return nil
+	}
+	parent.Retain()
+
+	return &lineageRepair{pid: pid, link: link, parent: parent}
+}
@@ -0,0 +1,97 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+// Package process holds process related files
+package process
+
+import (
+	"hash/fnv"
+	"strings"
+	"time"
+
+	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
+)
+
+// serviceEnvVar is the environment variable holding the unified service tag of a process
+const serviceEnvVar = "DD_SERVICE"
+
+// Info holds the attributes of a process shared with the other system-probe modules
+type Info struct {
+	Pid         uint32
+	ContainerID string
+	Service     string
+	// CmdLineHash is the hash of the scrubbed command line, stable for the lifetime of the process
+	CmdLineHash uint64
+	// ExecTime is the time at which the process executed its binary, or was forked if it didn't
+	ExecTime time.Time
+	// ExitTime is the time at which the process exited, zero if it is still running
+	ExitTime time.Time
+}
+
+// LookupInfo returns the attributes of the provided pid from the cache. Unlike Resolve, it never falls back to the
+// kernel maps or procfs, so that it can be called from other modules without competing with the event stream. Only
+// the attributes snapshotted when the entry was inserted are read, the args and envs of the entry being left alone.
+func (p *EBPFResolver) LookupInfo(pid uint32) (Info, bool) {
+	p.RLock()
+	defer p.RUnlock()
+
+	entry := p.entryCache.Get(pid)
+	if entry == nil {
+		return Info{}, false
+	}
+
+	info := Info{
+		Pid:         pid,
+		ContainerID: string(entry.ContainerID),
+		Service:     entry.Service,
+		CmdLineHash: entry.CmdLineHash,
+		ExecTime:    entry.ExecTime,
+		ExitTime:    entry.ExitTime,
+	}
+	if info.ExecTime.IsZero() {
+		info.ExecTime = entry.ForkTime
+	}
+
+	return info, true
+}
+
+// snapshotLookupInfo computes the attributes of the entry returned by LookupInfo. It is called before the insertion
+// of the entry, and when its envs are replaced, with the resolver lock held.
+func (p *EBPFResolver) snapshotLookupInfo(entry *model.ProcessCacheEntry) {
+	entry.Service = serviceFromEnvs(entry.EnvsEntry)
+	entry.CmdLineHash = p.cmdLineHash(&entry.Process)
+}
+
+// serviceFromEnvs returns the unified service tag of the provided environment. The values are scanned rather than
+// looked up with Get, which lazily builds a map shared with the readers of the entry.
+func serviceFromEnvs(envs *model.EnvsEntry) string {
+	if envs == nil {
+		return ""
+	}
+
+	for _, env := range envs.Values {
+		if value, found := strings.CutPrefix(env, serviceEnvVar+"="); found {
+			return value
+		}
+	}
+	return ""
+}
+
+// cmdLineHash returns the hash of the scrubbed command line of the process, whether the args of the entry were
+// already scrubbed or not
+func (p *EBPFResolver) cmdLineHash(pr *model.Process) uint64 {
+	if pr.ArgsEntry == nil || len(pr.ArgsEntry.Values) == 0 {
+		return 0
+	}
+
+	h := fnv.New64a()
+	for _, value := range p.scrubArgs(pr) {
+		_, _ = h.Write([]byte(value))
+		_, _ = h.Write([]byte{0})
+	}
+	return h.Sum64()
+}
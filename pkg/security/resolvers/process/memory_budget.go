@@ -0,0 +1,123 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+// Package process holds process related files
+package process
+
+import (
+	"unsafe"
+
+	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
+)
+
+var (
+	processCacheEntrySize  = int64(unsafe.Sizeof(model.ProcessCacheEntry{}))
+	stringHeaderSize       = int64(unsafe.Sizeof(""))
+	argsEnvsCacheEntrySize = int64(unsafe.Sizeof(argsEnvsCacheEntry{}))
+)
+
+// stringsMemorySize returns the estimated memory used by the provided strings
+func stringsMemorySize(values []string) int64 {
+	size := int64(len(values)) * stringHeaderSize
+	for _, value := range values {
+		size += int64(len(value))
+	}
+	return size
+}
+
+// entryMemorySize returns the estimated memory used by a cache entry, its args and envs excluded
+func entryMemorySize(entry *model.ProcessCacheEntry) int64 {
+	return processCacheEntrySize +
+		int64(len(entry.FileEvent.PathnameStr)+len(entry.FileEvent.BasenameStr)) +
+		int64(len(entry.LinuxBinprm.FileEvent.PathnameStr)+len(entry.LinuxBinprm.FileEvent.BasenameStr)) +
+		int64(len(entry.Comm)+len(entry.TTYName)+len(entry.ContainerID))
+}
+
+// entryFreedMemorySize returns the estimated memory freed by the release of a cache entry, its args and envs included
+// when no other entry shares them
+func entryFreedMemorySize(entry *model.ProcessCacheEntry) int64 {
+	size := entryMemorySize(entry)
+	if args := entry.MemoryArgsEntry; args != nil && args.MemoryRefs() == 1 {
+		size += stringsMemorySize(args.Values)
+	}
+	if envs := entry.MemoryEnvsEntry; envs != nil && envs.MemoryRefs() == 1 {
+		size += stringsMemorySize(envs.Values)
+	}
+	return size
+}
+
+// argsEnvsMemorySize returns the estimated memory used by args or envs waiting for their exec event
+func argsEnvsMemorySize(entry *argsEnvsCacheEntry) int64 {
+	return argsEnvsCacheEntrySize + stringsMemorySize(entry.values)
+}
+
+// accountEntryMemory updates the memory usage with the current size of the entry. It is called on the insertion of
+// the entry, and each time its args or envs are replaced, the size accounted for the entry being subtracted on its
+// release so that the estimation errors don't accumulate. The args and envs, shared by the forks of a process, are
+// accounted once for all the entries referencing them. It has to be called with the resolver lock held.
+func (p *EBPFResolver) accountEntryMemory(entry *model.ProcessCacheEntry) {
+	size := entryMemorySize(entry)
+	p.memoryUsage.Add(size - entry.MemorySize)
+	entry.MemorySize = size
+
+	if args := entry.ArgsEntry; args != entry.MemoryArgsEntry {
+		p.releaseArgsMemory(entry)
+		if args != nil {
+			p.memoryUsage.Add(args.RetainMemory(func() int64 { return stringsMemorySize(args.Values) }))
+		}
+		entry.MemoryArgsEntry = args
+	}
+
+	if envs := entry.EnvsEntry; envs != entry.MemoryEnvsEntry {
+		p.releaseEnvsMemory(entry)
+		if envs != nil {
+			p.memoryUsage.Add(envs.RetainMemory(func() int64 { return stringsMemorySize(envs.Values) }))
+		}
+		entry.MemoryEnvsEntry = envs
+	}
+}
+
+// releaseEntryMemory subtracts the memory accounted for an entry released from the cache
+func (p *EBPFResolver) releaseEntryMemory(entry *model.ProcessCacheEntry) {
+	p.memoryUsage.Sub(entry.MemorySize)
+	entry.MemorySize = 0
+	p.releaseArgsMemory(entry)
+	p.releaseEnvsMemory(entry)
+}
+
+func (p *EBPFResolver) releaseArgsMemory(entry *model.ProcessCacheEntry) {
+	if entry.MemoryArgsEntry != nil {
+		p.memoryUsage.Sub(entry.MemoryArgsEntry.ReleaseMemory())
+		entry.MemoryArgsEntry = nil
+	}
+}
+
+func (p *EBPFResolver) releaseEnvsMemory(entry *model.ProcessCacheEntry) {
+	if entry.MemoryEnvsEntry != nil {
+		p.memoryUsage.Sub(entry.MemoryEnvsEntry.ReleaseMemory())
+		entry.MemoryEnvsEntry = nil
+	}
+}
+
+// isOverMemoryBudget returns whether the estimated memory usage exceeds the configured budget
+func (p *EBPFResolver) isOverMemoryBudget() bool {
+	return p.opts.memoryBudget > 0 && p.memoryUsage.Load() > p.opts.memoryBudget
+}
+
+// MemoryBudgetExceeded reports the entries shed during a stats interval because the cache exceeded its memory budget
+type MemoryBudgetExceeded struct {
+	Budget     int64
+	Usage      int64
+	ShedExited int64
+	ShedLeaves int64
+}
+
+// SetMemoryBudgetExceededHandler sets the handler called, at most once per stats interval, when entries were shed
+// because the cache exceeded its memory budget
+func (p *EBPFResolver) SetMemoryBudgetExceededHandler(handler func(MemoryBudgetExceeded)) {
+	p.memoryBudgetExceeded = handler
+}
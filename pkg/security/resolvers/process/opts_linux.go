@@ -6,11 +6,46 @@
 // Package process holds process related files
 package process
 
+import (
+	"time"
+
+	"github.com/DataDog/datadog-agent/comp/core/telemetry"
+)
+
+const (
+	defaultSweepInterval                = 2 * time.Minute
+	defaultReconciliationInterval       = 5 * time.Minute
+	defaultExitedRetention              = time.Minute
+	defaultProcfsFallbackMaxResolutions = 1
+	defaultProcfsFallbackPeriod         = 30 * time.Second
+)
+
 // ResolverOpts options of resolver
 type ResolverOpts struct {
-	ttyFallbackEnabled    bool
-	envsResolutionEnabled bool
-	envsWithValue         map[string]bool
+	ttyFallbackEnabled     bool
+	envsResolutionEnabled  bool
+	envsWithValue          map[string]bool
+	entryCacheKind         string
+	entryCacheShards       int
+	maxEntries             int
+	memoryBudget           int64
+	sweepInterval          time.Duration
+	reconcileInterval      time.Duration
+	exitedRetention        time.Duration
+	referencedRetention    time.Duration
+	procfsWorkers          int
+	procfsQueueSize        int
+	argsEnvsCacheSize      int
+	argsEnvsValueCacheSize int
+	argsProcfsFallback     bool
+	shebangDetection       bool
+	lineageRepair          bool
+	envsRefreshInterval    time.Duration
+	telemetry              telemetry.Component
+
+	procfsFallbackMaxResolutions int
+	procfsFallbackPeriod         time.Duration
+	procfsFallbackOverrides      map[string]int
 }
 
 // WithEnvsValue specifies envs with value
@@ -33,9 +68,119 @@ func (o *ResolverOpts) WithEnvsResolutionEnabled() *ResolverOpts {
 	return o
 }
 
+// WithEntryCache specifies the data structure storing the process cache entries, and the number of lock stripes the
+// entries are sharded into by pid
+func (o *ResolverOpts) WithEntryCache(kind string, shards int) *ResolverOpts {
+	o.entryCacheKind = kind
+	o.entryCacheShards = shards
+	return o
+}
+
+// WithMaxEntries specifies the maximum number of entries of the cache, the exited processes then the oldest processes
+// without children being evicted beyond it. Zero doesn't bound the cache.
+func (o *ResolverOpts) WithMaxEntries(maxEntries int) *ResolverOpts {
+	o.maxEntries = maxEntries
+	return o
+}
+
+// WithMemoryBudget specifies the estimated memory, in bytes, the cache entries and the pending args and envs can use,
+// the exited processes then the oldest processes without children being shed beyond it. Zero doesn't bound the memory.
+func (o *ResolverOpts) WithMemoryBudget(budget int64) *ResolverOpts {
+	o.memoryBudget = budget
+	return o
+}
+
+// WithSweepInterval specifies the interval between two sweeps of the entries of the processes that are no longer
+// running, a zero interval disables the sweep
+func (o *ResolverOpts) WithSweepInterval(interval time.Duration) *ResolverOpts {
+	o.sweepInterval = interval
+	return o
+}
+
+// WithEnvsRefreshInterval specifies the interval between two reads of the environment of the processes running for
+// longer than it, to detect the environments modified after the execution. A zero interval disables the refresh.
+func (o *ResolverOpts) WithEnvsRefreshInterval(interval time.Duration) *ResolverOpts {
+	o.envsRefreshInterval = interval
+	return o
+}
+
+// WithReconciliationInterval specifies the interval between two reconciliations of the kernel maps and the user
+// space cache, a zero interval disables the reconciliation
+func (o *ResolverOpts) WithReconciliationInterval(interval time.Duration) *ResolverOpts {
+	o.reconcileInterval = interval
+	return o
+}
+
+// WithExitedRetention specifies how long the entries of the processes that are no longer running are kept after their
+// execution, and how long the ones referenced by an event are kept after this event, zero disabling the latter
+func (o *ResolverOpts) WithExitedRetention(retention time.Duration, referencedRetention time.Duration) *ResolverOpts {
+	o.exitedRetention = retention
+	o.referencedRetention = referencedRetention
+	return o
+}
+
+// WithProcfsWorkers specifies the number of workers resolving the processes from procfs outside of the event
+// handling path, and the number of pending resolutions they can queue. Zero workers resolves the processes inline.
+func (o *ResolverOpts) WithProcfsWorkers(workers int, queueSize int) *ResolverOpts {
+	o.procfsWorkers = workers
+	o.procfsQueueSize = queueSize
+	return o
+}
+
+// WithArgsEnvsCache specifies the number of args and envs of starting processes kept until their exec event is
+// received, and whether the args lost because of this limit are completed from procfs
+func (o *ResolverOpts) WithArgsEnvsCache(size int, procfsFallback bool) *ResolverOpts {
+	o.argsEnvsCacheSize = size
+	o.argsProcfsFallback = procfsFallback
+	return o
+}
+
+// WithArgsEnvsValueCache specifies the number of distinct args and envs values kept to deduplicate the args and envs
+// of the processes
+func (o *ResolverOpts) WithArgsEnvsValueCache(size int) *ResolverOpts {
+	o.argsEnvsValueCacheSize = size
+	return o
+}
+
+// WithShebangDetection enables the detection of the interpreters of the processes resolved from procfs from the `#!`
+// line of the scripts they run
+func (o *ResolverOpts) WithShebangDetection() *ResolverOpts {
+	o.shebangDetection = true
+	return o
+}
+
+// WithProcfsFallbackLimits specifies the number of times the resolution of a given pid may fall back to procfs per
+// period, zero disabling the fallback, and the overrides of this number for the processes of some workloads, keyed by
+// image name or by image name and tag
+func (o *ResolverOpts) WithProcfsFallbackLimits(maxResolutions int, period time.Duration, overrides map[string]int) *ResolverOpts {
+	o.procfsFallbackMaxResolutions = maxResolutions
+	o.procfsFallbackPeriod = period
+	o.procfsFallbackOverrides = overrides
+	return o
+}
+
+// WithLineageRepair enables the repair of the broken lineages, the missing ancestors being read from /proc
+func (o *ResolverOpts) WithLineageRepair() *ResolverOpts {
+	o.lineageRepair = true
+	return o
+}
+
+// WithTelemetry publishes the metrics of the resolver on the agent telemetry endpoint
+func (o *ResolverOpts) WithTelemetry(tm telemetry.Component) *ResolverOpts {
+	o.telemetry = tm
+	return o
+}
+
 // NewResolverOpts returns a new set of process resolver options
 func NewResolverOpts() *ResolverOpts {
 	return &ResolverOpts{
-		envsWithValue: make(map[string]bool),
+		envsWithValue:     make(map[string]bool),
+		sweepInterval:     defaultSweepInterval,
+		reconcileInterval: defaultReconciliationInterval,
+		exitedRetention:   defaultExitedRetention,
+		entryCacheShards:  1,
+
+		procfsFallbackMaxResolutions: defaultProcfsFallbackMaxResolutions,
+		procfsFallbackPeriod:         defaultProcfsFallbackPeriod,
 	}
 }
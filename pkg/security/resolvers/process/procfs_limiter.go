@@ -0,0 +1,124 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+// Package process holds process related files
+package process
+
+import (
+	"time"
+
+	"go.uber.org/atomic"
+
+	cgroupModel "github.com/DataDog/datadog-agent/pkg/security/resolvers/cgroup/model"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/containerutils"
+	"github.com/DataDog/datadog-agent/pkg/security/utils"
+)
+
+// procfsFallbackLimiterSize is the number of pids tracked by each limiter
+const procfsFallbackLimiterSize = 128
+
+// procfsFallbackBucket limits the procfs resolutions of the processes of a workload
+type procfsFallbackBucket struct {
+	// limiter is nil when the fallback is disabled
+	limiter *utils.Limiter[uint32]
+	dropped *atomic.Int64
+	tags    []string
+}
+
+func newProcfsFallbackBucket(maxResolutions int, period time.Duration, tags []string) (*procfsFallbackBucket, error) {
+	bucket := &procfsFallbackBucket{
+		dropped: atomic.NewInt64(0),
+		tags:    tags,
+	}
+
+	if maxResolutions > 0 {
+		limiter, err := utils.NewLimiter[uint32](procfsFallbackLimiterSize, maxResolutions, period)
+		if err != nil {
+			return nil, err
+		}
+		bucket.limiter = limiter
+	}
+
+	return bucket, nil
+}
+
+func (b *procfsFallbackBucket) allow(pid uint32) bool {
+	if b.limiter == nil || !b.limiter.Allow(pid) {
+		b.dropped.Inc()
+		return false
+	}
+	return true
+}
+
+// procfsFallbackLimiter limits the number of times the resolution of a given pid falls back to procfs per period. The
+// processes of the workloads listed in the overrides get the limit of their workload instead of the default one.
+type procfsFallbackLimiter struct {
+	defaultBucket *procfsFallbackBucket
+	overrides     map[string]*procfsFallbackBucket
+	getWorkload   func(containerID containerutils.ContainerID) (cgroupModel.WorkloadSelector, bool)
+}
+
+func newProcfsFallbackLimiter(maxResolutions int, period time.Duration, overrides map[string]int, getWorkload func(containerutils.ContainerID) (cgroupModel.WorkloadSelector, bool)) (*procfsFallbackLimiter, error) {
+	if period <= 0 {
+		period = defaultProcfsFallbackPeriod
+	}
+
+	defaultBucket, err := newProcfsFallbackBucket(maxResolutions, period, []string{})
+	if err != nil {
+		return nil, err
+	}
+
+	l := &procfsFallbackLimiter{
+		defaultBucket: defaultBucket,
+		overrides:     make(map[string]*procfsFallbackBucket, len(overrides)),
+		getWorkload:   getWorkload,
+	}
+
+	for workload, workloadMaxResolutions := range overrides {
+		bucket, err := newProcfsFallbackBucket(workloadMaxResolutions, period, []string{"workload:" + workload})
+		if err != nil {
+			return nil, err
+		}
+		l.overrides[workload] = bucket
+	}
+
+	return l, nil
+}
+
+// bucket returns the bucket of the workload of the container, the overrides of an image and a tag taking precedence
+// over the ones of the image only
+func (l *procfsFallbackLimiter) bucket(containerID containerutils.ContainerID) *procfsFallbackBucket {
+	if len(l.overrides) == 0 || containerID == "" || l.getWorkload == nil {
+		return l.defaultBucket
+	}
+
+	selector, found := l.getWorkload(containerID)
+	if !found || !selector.IsReady() {
+		return l.defaultBucket
+	}
+
+	if bucket, found := l.overrides[selector.Image+":"+selector.Tag]; found {
+		return bucket
+	}
+	if bucket, found := l.overrides[selector.Image]; found {
+		return bucket
+	}
+	return l.defaultBucket
+}
+
+// Allow returns whether the resolution of the pid, running in the provided container, may fall back to procfs
+func (l *procfsFallbackLimiter) Allow(pid uint32, containerID containerutils.ContainerID) bool {
+	return l.bucket(containerID).allow(pid)
+}
+
+// Walk calls the callback on the buckets of the limiter
+func (l *procfsFallbackLimiter) Walk(cb func(bucket *procfsFallbackBucket)) {
+	cb(l.defaultBucket)
+	for _, bucket := range l.overrides {
+		cb(bucket)
+	}
+}
@@ -0,0 +1,88 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+// Package process holds process related files
+package process
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cgroupModel "github.com/DataDog/datadog-agent/pkg/security/resolvers/cgroup/model"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/containerutils"
+)
+
+func TestProcfsFallbackLimiter(t *testing.T) {
+	workloads := map[containerutils.ContainerID]cgroupModel.WorkloadSelector{
+		"nginx":    {Image: "nginx", Tag: "1.25"},
+		"redis":    {Image: "redis", Tag: "7.0"},
+		"redis6":   {Image: "redis", Tag: "6.2"},
+		"postgres": {Image: "postgres", Tag: "16"},
+	}
+	getWorkload := func(containerID containerutils.ContainerID) (cgroupModel.WorkloadSelector, bool) {
+		selector, found := workloads[containerID]
+		return selector, found
+	}
+
+	overrides := map[string]int{
+		"nginx":     3,
+		"redis":     0,
+		"redis:6.2": 2,
+	}
+	limiter, err := newProcfsFallbackLimiter(1, time.Minute, overrides, getWorkload)
+	require.NoError(t, err)
+
+	allowed := func(pid uint32, containerID containerutils.ContainerID) int {
+		var count int
+		for i := 0; i < 5; i++ {
+			if limiter.Allow(pid, containerID) {
+				count++
+			}
+		}
+		return count
+	}
+
+	// default limit, for the hosts processes, the unknown containers and the workloads without override
+	assert.Equal(t, 1, allowed(1, ""))
+	assert.Equal(t, 1, allowed(2, "unknown"))
+	assert.Equal(t, 1, allowed(3, "postgres"))
+
+	// the limit applies per pid
+	assert.Equal(t, 3, allowed(4, "nginx"))
+	assert.Equal(t, 3, allowed(5, "nginx"))
+
+	// the override of the image and the tag takes precedence, 0 disabling the fallback
+	assert.Equal(t, 0, allowed(6, "redis"))
+	assert.Equal(t, 2, allowed(7, "redis6"))
+
+	dropped := make(map[string]int64)
+	limiter.Walk(func(bucket *procfsFallbackBucket) {
+		var workload string
+		if len(bucket.tags) > 0 {
+			workload = bucket.tags[0]
+		}
+		dropped[workload] = bucket.dropped.Load()
+	})
+	assert.Equal(t, map[string]int64{
+		"":                   12,
+		"workload:nginx":     4,
+		"workload:redis":     5,
+		"workload:redis:6.2": 3,
+	}, dropped)
+}
+
+func TestProcfsFallbackLimiterDisabled(t *testing.T) {
+	limiter, err := newProcfsFallbackLimiter(0, time.Minute, nil, nil)
+	require.NoError(t, err)
+
+	assert.False(t, limiter.Allow(1, ""))
+	assert.False(t, limiter.Allow(1, "0123456789abcdef"))
+	assert.EqualValues(t, 2, limiter.defaultBucket.dropped.Load())
+}
@@ -0,0 +1,118 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+// Package process holds process related files
+package process
+
+import (
+	"context"
+
+	"github.com/DataDog/datadog-agent/pkg/process/procutil"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
+)
+
+// SetProcfsCallback sets the callback called by the procfs workers for each entry they insert in the cache. The
+// callback is called with the resolver lock held and must not block. It has to be set before the resolver starts.
+func (p *EBPFResolver) SetProcfsCallback(callback func(*model.ProcessCacheEntry, error)) {
+	p.procfsCallback = callback
+}
+
+// queueProcfsResolution queues the resolution of the provided pid from /proc, it never blocks. The resolution is
+// dropped if the queue is full. The resolver lock must be held.
+func (p *EBPFResolver) queueProcfsResolution(pid uint32) {
+	if _, exists := p.procfsPending[pid]; exists {
+		return
+	}
+
+	select {
+	case p.procfsRequests <- pid:
+		p.procfsPending[pid] = struct{}{}
+	default:
+		p.procfsDropped.Inc()
+	}
+}
+
+// IsProcfsResolutionPending returns whether the resolution of the provided pid was queued for the procfs workers and
+// isn't completed yet. The events of the process can be handled again once it is completed, to get their process
+// context.
+func (p *EBPFResolver) IsProcfsResolutionPending(pid uint32) bool {
+	if p.procfsPending == nil {
+		return false
+	}
+
+	p.RLock()
+	defer p.RUnlock()
+
+	_, exists := p.procfsPending[pid]
+	return exists
+}
+
+// procfsWorker resolves the queued pids until the context is cancelled
+func (p *EBPFResolver) procfsWorker(ctx context.Context) {
+	for {
+		select {
+		case pid := <-p.procfsRequests:
+			p.resolveFromProcfsInWorker(pid, 0)
+
+			p.Lock()
+			delete(p.procfsPending, pid)
+			p.Unlock()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// resolveFromProcfsInWorker reads /proc for the provided pid and its ancestors missing from the cache, then inserts
+// the entries from the oldest ancestor. /proc is read without holding the resolver lock, an entry is discarded if an
+// event inserted the same pid in the meantime. When the pid is looked up as the parent of a process started at
+// childStart, in nanoseconds since boot, a process started after it reuses the pid and isn't inserted.
+func (p *EBPFResolver) resolveFromProcfsInWorker(pid uint32, childStart uint64) {
+	var lineage []*model.ProcessCacheEntry
+	for current := pid; current != 0 && len(lineage) < procResolveMaxDepth; {
+		if p.Get(current) != nil {
+			break
+		}
+
+		entry := p.newEntryFromPid(current)
+		if entry == nil {
+			break
+		}
+		if !isStartedBefore(entry.StartBootTime, childStart) {
+			entry.Release()
+			break
+		}
+		lineage = append(lineage, entry)
+		current, childStart = entry.PPid, entry.StartBootTime
+	}
+
+	if len(lineage) == 0 {
+		return
+	}
+
+	p.Lock()
+	defer p.Unlock()
+
+	for i := len(lineage) - 1; i >= 0; i-- {
+		entry := lineage[i]
+		if p.entryCache.Get(entry.Pid) != nil {
+			entry.Release()
+			continue
+		}
+		p.syncEntryWithKernelMaps(entry, model.ProcessCacheEntryFromProcFS, p.procfsCallback)
+	}
+}
+
+// isStartedBefore returns whether the process started at the provided time can be the parent of the process started
+// at childStart, both in nanoseconds since boot. A pid reused after the fork of the child fails the check, an unknown
+// start time passes it. The times are compared in clock ticks, the precision of /proc.
+func isStartedBefore(start, childStart uint64) bool {
+	if start == 0 || childStart == 0 {
+		return true
+	}
+	return procutil.StartTicksFromBootTime(start) <= procutil.StartTicksFromBootTime(childStart)
+}
@@ -0,0 +1,189 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+// Package process holds process related files
+package process
+
+import (
+	"errors"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/DataDog/datadog-agent/pkg/security/proto/api"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
+)
+
+// defaultQueryLimit is the maximum number of entries returned by a query that doesn't set a limit
+const defaultQueryLimit = 100
+
+// processCacheQuery filters the entries of the process cache
+type processCacheQuery struct {
+	pid         uint32
+	containerID string
+	pathPattern string
+}
+
+func newProcessCacheQuery(params *api.ProcessCacheQueryParams) (*processCacheQuery, error) {
+	if _, err := path.Match(params.GetPath(), ""); err != nil {
+		return nil, errors.New("invalid path pattern")
+	}
+
+	return &processCacheQuery{
+		pid:         params.GetPid(),
+		containerID: params.GetContainerID(),
+		pathPattern: params.GetPath(),
+	}, nil
+}
+
+func (q *processCacheQuery) match(entry *model.ProcessCacheEntry) bool {
+	if q.pid != 0 && entry.Pid != q.pid {
+		return false
+	}
+	if q.containerID != "" && string(entry.ContainerID) != q.containerID {
+		return false
+	}
+	if q.pathPattern != "" {
+		if matched, _ := path.Match(q.pathPattern, entry.FileEvent.PathnameStr); !matched {
+			return false
+		}
+	}
+	return true
+}
+
+// QueryCache returns the entries of the process cache matching the pid, the container ID and the path pattern of the
+// query. Unlike Resolve, it never falls back to the kernel maps or procfs, and the entries aren't modified.
+func (p *EBPFResolver) QueryCache(params *api.ProcessCacheQueryParams) *api.ProcessCacheQueryMessage {
+	query, err := newProcessCacheQuery(params)
+	if err != nil {
+		return &api.ProcessCacheQueryMessage{Error: err.Error()}
+	}
+
+	limit := int(params.GetLimit())
+	if limit == 0 {
+		limit = defaultQueryLimit
+	}
+
+	msg := &api.ProcessCacheQueryMessage{}
+
+	p.RLock()
+	defer p.RUnlock()
+
+	if query.pid != 0 {
+		p.entryCache.Do(query.pid, func(entry *model.ProcessCacheEntry) *model.ProcessCacheEntry {
+			if entry != nil && query.match(entry) {
+				msg.Entries = append(msg.Entries, p.newProcessCacheEntryMessage(entry))
+			}
+			return entry
+		})
+		return msg
+	}
+
+	p.entryCache.Range(func(_ uint32, entry *model.ProcessCacheEntry) bool {
+		if !query.match(entry) {
+			return true
+		}
+		if len(msg.Entries) >= limit {
+			msg.Truncated = true
+			return false
+		}
+		msg.Entries = append(msg.Entries, p.newProcessCacheEntryMessage(entry))
+		return true
+	})
+
+	return msg
+}
+
+func (p *EBPFResolver) newProcessCacheEntryMessage(entry *model.ProcessCacheEntry) *api.ProcessCacheEntryMessage {
+	args, argsTruncated := p.queryArgs(&entry.Process)
+	envKeys, envsTruncated := queryEnvKeys(&entry.Process)
+
+	msg := &api.ProcessCacheEntryMessage{
+		Pid:             entry.Pid,
+		PPid:            entry.PPid,
+		Comm:            entry.Comm,
+		Path:            entry.FileEvent.PathnameStr,
+		Args:            args,
+		ArgsTruncated:   argsTruncated,
+		EnvKeys:         envKeys,
+		EnvsTruncated:   envsTruncated,
+		ContainerID:     string(entry.ContainerID),
+		CGroupID:        string(entry.CGroup.CGroupID),
+		Credentials:     newProcessCredentialsMessage(&entry.Credentials),
+		Source:          model.ProcessSourceToString(entry.Source),
+		IsExec:          entry.IsExec,
+		IsParentMissing: entry.IsParentMissing,
+		ForkTime:        unixNanoIfNotZero(entry.ForkTime),
+		ExecTime:        unixNanoIfNotZero(entry.ExecTime),
+		ExitTime:        unixNanoIfNotZero(entry.ExitTime),
+	}
+
+	for ancestor := entry.Ancestor; ancestor != nil; ancestor = ancestor.Ancestor {
+		ancestorArgs, _ := p.queryArgs(&ancestor.Process)
+		msg.Lineage = append(msg.Lineage, &api.ProcessAncestorMessage{
+			Pid:         ancestor.Pid,
+			Comm:        ancestor.Comm,
+			Path:        ancestor.FileEvent.PathnameStr,
+			Args:        ancestorArgs,
+			IsExec:      ancestor.IsExec,
+			ContainerID: string(ancestor.ContainerID),
+			Credentials: newProcessCredentialsMessage(&ancestor.Credentials),
+		})
+	}
+
+	return msg
+}
+
+// queryArgs returns the scrubbed args of the process. Unlike GetProcessArgvScrubbed, the scrubbed args aren't cached in
+// the entry as the query only holds the read locks of the cache.
+func (p *EBPFResolver) queryArgs(pr *model.Process) ([]string, bool) {
+	if pr.ArgsEntry == nil || len(pr.ArgsEntry.Values) == 0 {
+		return pr.Argv, pr.ArgsTruncated
+	}
+
+	return p.scrubArgs(pr), pr.ArgsTruncated || pr.ArgsEntry.Truncated
+}
+
+// queryEnvKeys returns the names of the environment variables of the process, never their values
+func queryEnvKeys(pr *model.Process) ([]string, bool) {
+	envs, truncated := pr.Envs, pr.EnvsTruncated
+	if pr.EnvsEntry != nil {
+		envs, truncated = pr.EnvsEntry.Values, truncated || pr.EnvsEntry.Truncated
+	}
+
+	keys := make([]string, 0, len(envs))
+	for _, env := range envs {
+		key, _, _ := strings.Cut(env, "=")
+		keys = append(keys, key)
+	}
+	return keys, truncated
+}
+
+func newProcessCredentialsMessage(creds *model.Credentials) *api.ProcessCredentialsMessage {
+	return &api.ProcessCredentialsMessage{
+		UID:          creds.UID,
+		GID:          creds.GID,
+		User:         creds.User,
+		Group:        creds.Group,
+		EUID:         creds.EUID,
+		EGID:         creds.EGID,
+		EUser:        creds.EUser,
+		EGroup:       creds.EGroup,
+		FSUID:        creds.FSUID,
+		FSGID:        creds.FSGID,
+		AUID:         creds.AUID,
+		CapEffective: creds.CapEffective,
+		CapPermitted: creds.CapPermitted,
+	}
+}
+
+func unixNanoIfNotZero(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixNano()
+}
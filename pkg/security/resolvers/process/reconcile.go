@@ -0,0 +1,255 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+// Package process holds process related files
+package process
+
+import (
+	"context"
+	"encoding/binary"
+	"time"
+
+	"go.uber.org/atomic"
+
+	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
+	"github.com/DataDog/datadog-agent/pkg/security/seclog"
+	"github.com/DataDog/datadog-agent/pkg/util/kernel"
+)
+
+const (
+	// driftMissingInCache is the drift of a process tracked by the kernel maps but not by the user space cache
+	driftMissingInCache = "missing_in_cache"
+	// driftMissingInKernel is the drift of a process tracked by the user space cache but not by the kernel maps
+	driftMissingInKernel = "missing_in_kernel"
+	// driftCookieMismatch is the drift of a process whose execution differs between the kernel maps and the user
+	// space cache
+	driftCookieMismatch = "cookie_mismatch"
+)
+
+var allDriftKinds = []string{driftMissingInCache, driftMissingInKernel, driftCookieMismatch}
+
+// cacheDrift is a divergence between the kernel maps and the user space cache for a pid
+type cacheDrift struct {
+	kind   string
+	cookie model.ProcessCookie
+}
+
+// reconciliationStats counts the drifts found, and repaired, by kind
+type reconciliationStats struct {
+	drifts   map[string]*atomic.Int64
+	repaired map[string]*atomic.Int64
+}
+
+func newReconciliationStats() *reconciliationStats {
+	stats := &reconciliationStats{
+		drifts:   make(map[string]*atomic.Int64, len(allDriftKinds)),
+		repaired: make(map[string]*atomic.Int64, len(allDriftKinds)),
+	}
+	for _, kind := range allDriftKinds {
+		stats.drifts[kind] = atomic.NewInt64(0)
+		stats.repaired[kind] = atomic.NewInt64(0)
+	}
+	return stats
+}
+
+// reconcileCache periodically compares the kernel maps with the user space cache and repairs their divergences
+func (p *EBPFResolver) reconcileCache(ctx context.Context) {
+	if p.opts.reconcileInterval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(p.opts.reconcileInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := p.reconcile(kernel.ProcFSRoot()); err != nil {
+				seclog.Debugf("failed to reconcile the process cache: %v", err)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// reconcile compares the pids running in the given procfs with the kernel maps and the user space cache
+func (p *EBPFResolver) reconcile(procRoot string) error {
+	pids, err := kernel.AllPidsProcs(procRoot)
+	if err != nil {
+		return err
+	}
+
+	running := make(map[uint32]struct{}, len(pids))
+	for _, pid := range pids {
+		running[uint32(pid)] = struct{}{}
+	}
+
+	// the kernel maps are read without holding the resolver lock
+	kernelCookies := p.readKernelCookies(running)
+
+	p.Lock()
+	defer p.Unlock()
+
+	p.reconcileWith(running, kernelCookies)
+
+	return nil
+}
+
+// readKernelCookies returns the cookies of the running processes tracked by the pid_cache kernel map
+func (p *EBPFResolver) readKernelCookies(running map[uint32]struct{}) map[uint32]model.ProcessCookie {
+	cookies := make(map[uint32]model.ProcessCookie)
+	if p.pidCacheMap == nil {
+		return cookies
+	}
+
+	var (
+		pid   uint32
+		value []byte
+	)
+	for entries := p.pidCacheMap.Iterate(); entries.Next(&pid, &value); {
+		if _, exists := running[pid]; !exists || len(value) < 40 {
+			continue
+		}
+		// the exit time of the process is set, the entry is about to be removed
+		if binary.NativeEndian.Uint64(value[32:40]) != 0 {
+			continue
+		}
+		var cookie model.ProcessCookie
+		if _, err := cookie.UnmarshalBinary(value); err != nil {
+			continue
+		}
+		cookies[pid] = cookie
+	}
+
+	return cookies
+}
+
+// findDrifts returns the divergences between the kernel maps and the user space cache for the running processes
+func (p *EBPFResolver) findDrifts(running map[uint32]struct{}, kernelCookies map[uint32]model.ProcessCookie) map[uint32]cacheDrift {
+	drifts := make(map[uint32]cacheDrift)
+
+	for pid, cookie := range kernelCookies {
+		entry := p.entryCache.Get(pid)
+		if entry == nil || !entry.ExitTime.IsZero() {
+			drifts[pid] = cacheDrift{kind: driftMissingInCache, cookie: cookie}
+		} else if entry.Cookie != cookie {
+			drifts[pid] = cacheDrift{kind: driftCookieMismatch, cookie: cookie}
+		}
+	}
+
+	p.entryCache.Range(func(pid uint32, entry *model.ProcessCacheEntry) bool {
+		if _, exists := running[pid]; !exists || !entry.ExitTime.IsZero() || entry.IsKworker {
+			return true
+		}
+		if _, exists := kernelCookies[pid]; !exists {
+			drifts[pid] = cacheDrift{kind: driftMissingInKernel, cookie: entry.Cookie}
+		}
+		return true
+	})
+
+	return drifts
+}
+
+// reconcileWith counts the drifts between the kernel maps and the user space cache, and repairs the ones already
+// found by the previous reconciliation. A drift has to be seen twice to be repaired, the events in flight while
+// the kernel maps were read being the most common cause of transient divergences.
+func (p *EBPFResolver) reconcileWith(running map[uint32]struct{}, kernelCookies map[uint32]model.ProcessCookie) {
+	drifts := p.findDrifts(running, kernelCookies)
+
+	repairs := make(map[uint32]cacheDrift)
+	for pid, drift := range drifts {
+		p.reconciliationStats.drifts[drift.kind].Inc()
+
+		if previous, found := p.reconciliationSuspects[pid]; found && previous == drift {
+			repairs[pid] = drift
+		}
+	}
+
+	p.prefetchExecPaths(repairs)
+
+	for pid, drift := range repairs {
+		if p.repairDrift(pid, drift) {
+			p.reconciliationStats.repaired[drift.kind].Inc()
+			delete(drifts, pid)
+		}
+	}
+
+	p.reconciliationSuspects = drifts
+}
+
+// repairDrift resolves the process from the kernel maps when the user space cache is behind, and pushes the entry
+// of the user space cache to the kernel maps otherwise
+func (p *EBPFResolver) repairDrift(pid uint32, drift cacheDrift) bool {
+	switch drift.kind {
+	case driftMissingInCache, driftCookieMismatch:
+		file, found := p.kernelExecFile(drift.cookie)
+		if !found {
+			return false
+		}
+		return p.resolveFromKernelMaps(pid, pid, file.Inode, nil) != nil
+	case driftMissingInKernel:
+		entry := p.entryCache.Get(pid)
+		if entry == nil {
+			return false
+		}
+		if err := p.pushToKernelMaps(entry); err != nil {
+			seclog.Debugf("failed to repair the kernel maps entry of %d: %v", pid, err)
+			return false
+		}
+		return true
+	}
+	return false
+}
+
+// prefetchExecPaths resolves in batch the paths of the executables of the processes to resolve from the kernel maps
+func (p *EBPFResolver) prefetchExecPaths(repairs map[uint32]cacheDrift) {
+	var files []*model.FileFields
+	for _, drift := range repairs {
+		if drift.kind != driftMissingInCache && drift.kind != driftCookieMismatch {
+			continue
+		}
+		if file, found := p.kernelExecFile(drift.cookie); found {
+			files = append(files, &file)
+		}
+	}
+
+	if len(files) > 1 {
+		p.pathResolver.PrefetchFilePaths(files...)
+	}
+}
+
+// kernelExecFile returns the file of the executable of the proc_cache entry of the cookie
+func (p *EBPFResolver) kernelExecFile(cookie model.ProcessCookie) (model.FileFields, bool) {
+	if p.procCacheMap == nil {
+		return model.FileFields{}, false
+	}
+
+	procCache, err := p.procCacheMap.LookupBytes(cookie)
+	if err != nil || procCache == nil {
+		return model.FileFields{}, false
+	}
+
+	var ctrCtx model.ContainerContext
+	read, err := ctrCtx.UnmarshalBinary(procCache)
+	if err != nil {
+		return model.FileFields{}, false
+	}
+
+	var cgroupCtx model.CGroupContext
+	cgroupRead, err := cgroupCtx.UnmarshalBinary(procCache)
+	if err != nil {
+		return model.FileFields{}, false
+	}
+
+	var process model.Process
+	if _, err := process.UnmarshalProcEntryBinary(procCache[read+cgroupRead:]); err != nil {
+		return model.FileFields{}, false
+	}
+
+	return process.FileEvent.FileFields, true
+}
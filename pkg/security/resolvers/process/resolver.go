@@ -28,7 +28,7 @@ func (p *Pool) Put(pce *model.ProcessCacheEntry) {
 }
 
 // NewProcessCacheEntryPool returns a new Pool
-func NewProcessCacheEntryPool(onRelease func()) *Pool {
+func NewProcessCacheEntryPool(onRelease func(pce *model.ProcessCacheEntry)) *Pool {
 	pcep := Pool{}
 	pcep.pool = ddsync.NewTypedPool(func() *model.ProcessCacheEntry {
 		return model.NewProcessCacheEntry(func(pce *model.ProcessCacheEntry) {
@@ -36,7 +36,7 @@ func NewProcessCacheEntryPool(onRelease func()) *Pool {
 				pce.Ancestor.Release()
 			}
 
-			onRelease()
+			onRelease(pce)
 
 			pcep.Put(pce)
 		})
@@ -9,6 +9,8 @@
 package process
 
 import (
+	"bufio"
+	"bytes"
 	"context"
 	"encoding/binary"
 	"encoding/json"
@@ -30,11 +32,13 @@ import (
 	"go.uber.org/atomic"
 	"golang.org/x/sys/unix"
 
+	pkgconfigsetup "github.com/DataDog/datadog-agent/pkg/config/setup"
 	"github.com/DataDog/datadog-agent/pkg/process/procutil"
 	"github.com/DataDog/datadog-agent/pkg/security/metrics"
 	"github.com/DataDog/datadog-agent/pkg/security/probe/config"
 	"github.com/DataDog/datadog-agent/pkg/security/probe/managerhelper"
 	"github.com/DataDog/datadog-agent/pkg/security/resolvers/cgroup"
+	cgroupModel "github.com/DataDog/datadog-agent/pkg/security/resolvers/cgroup/model"
 	"github.com/DataDog/datadog-agent/pkg/security/resolvers/container"
 	"github.com/DataDog/datadog-agent/pkg/security/resolvers/envvars"
 	"github.com/DataDog/datadog-agent/pkg/security/resolvers/mount"
@@ -42,8 +46,10 @@ import (
 	"github.com/DataDog/datadog-agent/pkg/security/resolvers/usergroup"
 	"github.com/DataDog/datadog-agent/pkg/security/secl/containerutils"
 	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/rules"
 	"github.com/DataDog/datadog-agent/pkg/security/seclog"
 	"github.com/DataDog/datadog-agent/pkg/security/utils"
+	"github.com/DataDog/datadog-agent/pkg/util/kernel"
 	stime "github.com/DataDog/datadog-agent/pkg/util/ktime"
 )
 
@@ -53,11 +59,14 @@ const (
 )
 
 const (
-	procResolveMaxDepth              = 16
-	maxParallelArgsEnvs              = 512 // == number of parallel starting processes
-	argsEnvsValueCacheSize           = 8192
-	numAllowedPIDsToResolvePerPeriod = 1
-	procFallbackLimiterPeriod        = 30 * time.Second // proc fallback period by pid
+	procResolveMaxDepth      = 16
+	maxParallelArgsEnvs      = 512  // == number of parallel starting processes
+	argsEnvsValueCacheSize   = 8192 // == number of distinct args and envs values deduplicated
+	dumpBufferSize           = 64 * 1024
+	fsIocGetVersion          = 0x80087601 // FS_IOC_GETVERSION, _IOR('v', 1, long)
+	exitedQueueSize          = 16384
+	maxDequeuedExitedPerCall = 64          // bounds the time spent holding the resolver lock per call
+	lastReferencedPrecision  = time.Second // the cache hits within this delay only take the read lock of the shard
 )
 
 // EBPFResolver resolved process context
@@ -82,11 +91,15 @@ type EBPFResolver struct {
 	procCacheMap     *lib.Map
 	pidCacheMap      *lib.Map
 	opts             ResolverOpts
+	envsWithValue    *envsWithValueSet
+	argsScrubbing    argsScrubbingRules
 
 	// stats
 	cacheSize                 *atomic.Int64
 	hitsStats                 map[string]*atomic.Int64
 	missStats                 *atomic.Int64
+	totalHits                 *atomic.Int64
+	totalMisses               *atomic.Int64
 	addedEntriesFromEvent     *atomic.Int64
 	addedEntriesFromKernelMap *atomic.Int64
 	addedEntriesFromProcFS    *atomic.Int64
@@ -94,24 +107,76 @@ type EBPFResolver struct {
 	pathErrStats              *atomic.Int64
 	argsTruncated             *atomic.Int64
 	argsSize                  *atomic.Int64
+	argsLost                  *atomic.Int64
+	argsEnvsEvicted           *atomic.Int64
+	argsEnvsValuesEvicted     *atomic.Int64
+	argsProcfsFallback        *atomic.Int64
 	envsTruncated             *atomic.Int64
 	envsSize                  *atomic.Int64
+	envsLost                  *atomic.Int64
+	envsChanged               *atomic.Int64
 	brokenLineage             *atomic.Int64
+	lineageRepaired           *atomic.Int64
 	inodeErrStats             *atomic.Int64
-
-	entryCache    map[uint32]*model.ProcessCacheEntry
-	argsEnvsCache *simplelru.LRU[uint64, *argsEnvsCacheEntry]
+	procfsDropped             *atomic.Int64
+	evictedExited             *atomic.Int64
+	evictedLeaves             *atomic.Int64
+	memoryUsage               *atomic.Int64
+	shedExited                *atomic.Int64
+	shedLeaves                *atomic.Int64
+	cookieCollisions          *atomic.Int64
+
+	entryCache       *shardedEntryCache
+	argsEnvsCache    *simplelru.LRU[uint64, *argsEnvsCacheEntry]
+	elfMetadata      *elfMetadataResolver
+	argsEnvsInterner *utils.LRUStringInterner
+	cookies          *cookieIndex
+	subscribers      *processTreeSubscribers
 
 	processCacheEntryPool *Pool
 
 	// limiters
-	procFallbackLimiter *utils.Limiter[uint32]
+	procFallbackLimiter *procfsFallbackLimiter
+
+	// procfs workers
+	procfsRequests chan uint32
+	procfsPending  map[uint32]struct{}
+	procfsCallback func(*model.ProcessCacheEntry, error)
+
+	// repair of the broken lineages
+	lineageRepairs *lineageRepairQueue
+
+	// called when entries were shed because the cache exceeded its memory budget
+	memoryBudgetExceeded func(MemoryBudgetExceeded)
 
-	exitedQueue []uint32
+	// environments read during the previous refresh, and their estimated memory usage, only accessed by the refresh
+	// loop
+	envsSnapshots     map[uint32]envsSnapshot
+	envsSnapshotsSize int64
+	// changed environments waiting to be applied from the event path
+	envsRefreshes envsRefreshQueue
+
+	// metrics published on the agent telemetry endpoint
+	telemetry *resolverTelemetry
+
+	exitedQueue *exitedQueue
+	// entries kept after their exit event for the referenced retention period
+	retainedExits *retainedExits
+
+	// reconciliation of the kernel maps and the user space cache
+	reconciliationSuspects map[uint32]cacheDrift
+	reconciliationStats    *reconciliationStats
 }
 
-// DequeueExited dequeue exited process
+// DequeueExited flushes a bounded number of the queued exited processes, the remaining ones are flushed by the next
+// calls
 func (p *EBPFResolver) DequeueExited() {
+	now := time.Now()
+	pids := p.exitedQueue.pop(maxDequeuedExitedPerCall)
+	if len(pids) == 0 && !p.retainedExits.expired(now) {
+		return
+	}
+
 	p.Lock()
 	defer p.Unlock()
 
@@ -120,30 +185,41 @@ func (p *EBPFResolver) DequeueExited() {
 		p.flushedEntries.Inc()
 	}
 
-	now := time.Now()
-	for _, pid := range p.exitedQueue {
-		entry := p.entryCache[pid]
+	p.flushRetainedExits(now)
+
+	for _, pid := range pids {
+		// the last reference is updated by the cache hits under the lock of the shard of the pid only
+		var lastReferenced time.Time
+		entry := p.entryCache.View(pid, func(entry *model.ProcessCacheEntry) *model.ProcessCacheEntry {
+			if entry != nil {
+				lastReferenced = entry.LastReferenced
+			}
+			return entry
+		})
 		if entry == nil {
 			continue
 		}
 
-		if tm := entry.ExecTime; !tm.IsZero() && tm.Add(time.Minute).Before(now) {
+		// the processes referenced by recent events are kept longer so that the late events can resolve their lineage
+		if !lastReferenced.IsZero() && lastReferenced.Add(p.opts.referencedRetention).After(now) {
+			continue
+		}
+
+		if tm := entry.ExecTime; !tm.IsZero() && tm.Add(p.opts.exitedRetention).Before(now) {
 			delEntry(pid, now)
-		} else if tm := entry.ForkTime; !tm.IsZero() && tm.Add(time.Minute).Before(now) {
+		} else if tm := entry.ForkTime; !tm.IsZero() && tm.Add(p.opts.exitedRetention).Before(now) {
 			delEntry(pid, now)
 		} else if entry.ForkTime.IsZero() && entry.ExecTime.IsZero() {
 			delEntry(pid, now)
 		}
 	}
-
-	p.exitedQueue = p.exitedQueue[0:0]
 }
 
 // NewProcessCacheEntry returns a new process cache entry
 func (p *EBPFResolver) NewProcessCacheEntry(pidContext model.PIDContext) *model.ProcessCacheEntry {
 	entry := p.processCacheEntryPool.Get()
 	entry.PIDContext = pidContext
-	entry.Cookie = utils.NewCookie()
+	entry.Cookie = newProcessCookie()
 	return entry
 }
 
@@ -152,8 +228,30 @@ func (p *EBPFResolver) CountBrokenLineage() {
 	p.brokenLineage.Inc()
 }
 
+// GetResolutionStats returns the number of process resolutions that succeeded and failed since the resolver
+// started. The counters are updated each time the metrics are sent.
+func (p *EBPFResolver) GetResolutionStats() (int64, int64) {
+	return p.totalHits.Load(), p.totalMisses.Load()
+}
+
 // SendStats sends process resolver metrics
 func (p *EBPFResolver) SendStats() error {
+	hits := make(map[string]int64, len(metrics.AllTypesTags))
+	for _, resolutionType := range metrics.AllTypesTags {
+		if count := p.hitsStats[resolutionType].Swap(0); count > 0 {
+			hits[resolutionType] = count
+			p.totalHits.Add(count)
+		}
+	}
+
+	misses := p.missStats.Swap(0)
+	p.totalMisses.Add(misses)
+
+	// the telemetry is updated first so that it doesn't depend on the statsd client
+	if p.telemetry != nil {
+		p.telemetry.setStats(p.getCacheSize(), p.getEntryCacheSize(), hits, misses)
+	}
+
 	if err := p.statsdClient.Gauge(metrics.MetricProcessResolverCacheSize, p.getCacheSize(), []string{}, 1.0); err != nil {
 		return fmt.Errorf("failed to send process_resolver cache_size metric: %w", err)
 	}
@@ -163,15 +261,15 @@ func (p *EBPFResolver) SendStats() error {
 	}
 
 	for _, resolutionType := range metrics.AllTypesTags {
-		if count := p.hitsStats[resolutionType].Swap(0); count > 0 {
+		if count := hits[resolutionType]; count > 0 {
 			if err := p.statsdClient.Count(metrics.MetricProcessResolverHits, count, []string{resolutionType}, 1.0); err != nil {
 				return fmt.Errorf("failed to send process_resolver with `%s` metric: %w", resolutionType, err)
 			}
 		}
 	}
 
-	if count := p.missStats.Swap(0); count > 0 {
-		if err := p.statsdClient.Count(metrics.MetricProcessResolverMiss, count, []string{}, 1.0); err != nil {
+	if misses > 0 {
+		if err := p.statsdClient.Count(metrics.MetricProcessResolverMiss, misses, []string{}, 1.0); err != nil {
 			return fmt.Errorf("failed to send process_resolver misses metric: %w", err)
 		}
 	}
@@ -200,6 +298,100 @@ func (p *EBPFResolver) SendStats() error {
 		}
 	}
 
+	if count := p.procfsDropped.Swap(0); count > 0 {
+		if err := p.statsdClient.Count(metrics.MetricProcessResolverProcfsDropped, count, []string{}, 1.0); err != nil {
+			return fmt.Errorf("failed to send process_resolver procfs dropped metric: %w", err)
+		}
+	}
+
+	if count := p.evictedExited.Swap(0); count > 0 {
+		if err := p.statsdClient.Count(metrics.MetricProcessResolverEvicted, count, []string{"type:exited"}, 1.0); err != nil {
+			return fmt.Errorf("failed to send process_resolver evicted metric: %w", err)
+		}
+	}
+
+	if count := p.evictedLeaves.Swap(0); count > 0 {
+		if err := p.statsdClient.Count(metrics.MetricProcessResolverEvicted, count, []string{"type:leaf"}, 1.0); err != nil {
+			return fmt.Errorf("failed to send process_resolver evicted metric: %w", err)
+		}
+	}
+
+	if count := p.subscribers.dropped.Swap(0); count > 0 {
+		if err := p.statsdClient.Count(metrics.MetricProcessResolverSubscriptionsDropped, count, []string{}, 1.0); err != nil {
+			return fmt.Errorf("failed to send process_resolver subscriptions dropped metric: %w", err)
+		}
+	}
+
+	if count := p.cookieCollisions.Swap(0); count > 0 {
+		if err := p.statsdClient.Count(metrics.MetricProcessResolverCookieCollisions, count, []string{}, 1.0); err != nil {
+			return fmt.Errorf("failed to send process_resolver cookie collisions metric: %w", err)
+		}
+	}
+
+	usage := p.memoryUsage.Load()
+	if err := p.statsdClient.Gauge(metrics.MetricProcessResolverMemoryUsage, float64(usage), []string{}, 1.0); err != nil {
+		return fmt.Errorf("failed to send process_resolver memory usage metric: %w", err)
+	}
+
+	shedExited, shedLeaves := p.shedExited.Swap(0), p.shedLeaves.Swap(0)
+	if shedExited+shedLeaves > 0 {
+		seclog.Warnf("process cache over its memory budget of %d bytes, %d exited and %d running processes shed", p.opts.memoryBudget, shedExited, shedLeaves)
+		if p.memoryBudgetExceeded != nil {
+			p.memoryBudgetExceeded(MemoryBudgetExceeded{
+				Budget:     p.opts.memoryBudget,
+				Usage:      usage,
+				ShedExited: shedExited,
+				ShedLeaves: shedLeaves,
+			})
+		}
+	}
+	if shedExited > 0 {
+		if err := p.statsdClient.Count(metrics.MetricProcessResolverMemoryBudgetShed, shedExited, []string{"type:exited"}, 1.0); err != nil {
+			return fmt.Errorf("failed to send process_resolver memory budget shed metric: %w", err)
+		}
+	}
+	if shedLeaves > 0 {
+		if err := p.statsdClient.Count(metrics.MetricProcessResolverMemoryBudgetShed, shedLeaves, []string{"type:leaf"}, 1.0); err != nil {
+			return fmt.Errorf("failed to send process_resolver memory budget shed metric: %w", err)
+		}
+	}
+
+	var err error
+	p.procFallbackLimiter.Walk(func(bucket *procfsFallbackBucket) {
+		if count := bucket.dropped.Swap(0); count > 0 && err == nil {
+			if err = p.statsdClient.Count(metrics.MetricProcessResolverProcfsFallbackDropped, count, bucket.tags, 1.0); err != nil {
+				err = fmt.Errorf("failed to send process_resolver procfs fallback dropped metric: %w", err)
+			}
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, kind := range allDriftKinds {
+		tags := []string{"drift:" + kind}
+		if count := p.reconciliationStats.drifts[kind].Swap(0); count > 0 {
+			if err := p.statsdClient.Count(metrics.MetricProcessResolverReconciliationDrift, count, tags, 1.0); err != nil {
+				return fmt.Errorf("failed to send process_resolver reconciliation drift metric: %w", err)
+			}
+		}
+		if count := p.reconciliationStats.repaired[kind].Swap(0); count > 0 {
+			if err := p.statsdClient.Count(metrics.MetricProcessResolverReconciliationRepaired, count, tags, 1.0); err != nil {
+				return fmt.Errorf("failed to send process_resolver reconciliation repaired metric: %w", err)
+			}
+		}
+	}
+
+	if err := p.statsdClient.Gauge(metrics.MetricProcessResolverExitedQueueDepth, float64(p.exitedQueue.depth.Load()), []string{}, 1.0); err != nil {
+		return fmt.Errorf("failed to send process_resolver exited queue depth metric: %w", err)
+	}
+
+	if count := p.exitedQueue.dropped.Swap(0); count > 0 {
+		if err := p.statsdClient.Count(metrics.MetricProcessResolverExitedQueueDropped, count, []string{}, 1.0); err != nil {
+			return fmt.Errorf("failed to send process_resolver exited queue dropped metric: %w", err)
+		}
+	}
+
 	if count := p.pathErrStats.Swap(0); count > 0 {
 		if err := p.statsdClient.Count(metrics.MetricProcessResolverPathError, count, []string{}, 1.0); err != nil {
 			return fmt.Errorf("failed to send process_resolver path error metric: %w", err)
@@ -218,6 +410,30 @@ func (p *EBPFResolver) SendStats() error {
 		}
 	}
 
+	if count := p.argsLost.Swap(0); count > 0 {
+		if err := p.statsdClient.Count(metrics.MetricProcessResolverArgsLost, count, []string{}, 1.0); err != nil {
+			return fmt.Errorf("failed to send args lost metric: %w", err)
+		}
+	}
+
+	if count := p.argsEnvsEvicted.Swap(0); count > 0 {
+		if err := p.statsdClient.Count(metrics.MetricProcessResolverArgsEnvsEvicted, count, []string{}, 1.0); err != nil {
+			return fmt.Errorf("failed to send args envs evicted metric: %w", err)
+		}
+	}
+
+	if count := p.argsEnvsValuesEvicted.Swap(0); count > 0 {
+		if err := p.statsdClient.Count(metrics.MetricProcessResolverArgsEnvsValuesEvicted, count, []string{}, 1.0); err != nil {
+			return fmt.Errorf("failed to send args envs values evicted metric: %w", err)
+		}
+	}
+
+	if count := p.argsProcfsFallback.Swap(0); count > 0 {
+		if err := p.statsdClient.Count(metrics.MetricProcessResolverArgsProcfsFallback, count, []string{}, 1.0); err != nil {
+			return fmt.Errorf("failed to send args procfs fallback metric: %w", err)
+		}
+	}
+
 	if count := p.envsTruncated.Swap(0); count > 0 {
 		if err := p.statsdClient.Count(metrics.MetricProcessResolverEnvsTruncated, count, []string{}, 1.0); err != nil {
 			return fmt.Errorf("failed to send envs truncated metric: %w", err)
@@ -230,6 +446,24 @@ func (p *EBPFResolver) SendStats() error {
 		}
 	}
 
+	if count := p.envsLost.Swap(0); count > 0 {
+		if err := p.statsdClient.Count(metrics.MetricProcessResolverEnvsLost, count, []string{}, 1.0); err != nil {
+			return fmt.Errorf("failed to send envs lost metric: %w", err)
+		}
+	}
+
+	if count := p.envsChanged.Swap(0); count > 0 {
+		if err := p.statsdClient.Count(metrics.MetricProcessResolverEnvsChanged, count, []string{}, 1.0); err != nil {
+			return fmt.Errorf("failed to send envs changed metric: %w", err)
+		}
+	}
+
+	if count := p.lineageRepaired.Swap(0); count > 0 {
+		if err := p.statsdClient.Count(metrics.MetricProcessResolverLineageRepaired, count, []string{}, 1.0); err != nil {
+			return fmt.Errorf("failed to send process_resolver lineage repaired metric: %w", err)
+		}
+	}
+
 	if count := p.brokenLineage.Swap(0); count > 0 {
 		if err := p.statsdClient.Count(metrics.MetricProcessEventBrokenLineage, count, []string{}, 1.0); err != nil {
 			return fmt.Errorf("failed to send process_resolver broken lineage metric: %w", err)
@@ -250,9 +484,7 @@ type argsEnvsCacheEntry struct {
 	truncated bool
 }
 
-var argsEnvsInterner = utils.NewLRUStringInterner(argsEnvsValueCacheSize)
-
-func parseStringArray(data []byte) ([]string, bool) {
+func parseStringArray(data []byte, interner *utils.LRUStringInterner) ([]string, bool) {
 	truncated := false
 	values, err := model.UnmarshalStringArray(data)
 	if err != nil || len(data) == model.MaxArgEnvSize {
@@ -262,20 +494,20 @@ func parseStringArray(data []byte) ([]string, bool) {
 		truncated = true
 	}
 
-	argsEnvsInterner.DeduplicateSlice(values)
+	interner.DeduplicateSlice(values)
 	return values, truncated
 }
 
-func newArgsEnvsCacheEntry(event *model.ArgsEnvsEvent) *argsEnvsCacheEntry {
-	values, truncated := parseStringArray(event.ValuesRaw[:event.Size])
+func newArgsEnvsCacheEntry(event *model.ArgsEnvsEvent, interner *utils.LRUStringInterner) *argsEnvsCacheEntry {
+	values, truncated := parseStringArray(event.ValuesRaw[:event.Size], interner)
 	return &argsEnvsCacheEntry{
 		values:    values,
 		truncated: truncated,
 	}
 }
 
-func (e *argsEnvsCacheEntry) extend(event *model.ArgsEnvsEvent) {
-	values, truncated := parseStringArray(event.ValuesRaw[:event.Size])
+func (e *argsEnvsCacheEntry) extend(event *model.ArgsEnvsEvent, interner *utils.LRUStringInterner) {
+	values, truncated := parseStringArray(event.ValuesRaw[:event.Size], interner)
 	if truncated {
 		e.truncated = true
 	}
@@ -285,9 +517,17 @@ func (e *argsEnvsCacheEntry) extend(event *model.ArgsEnvsEvent) {
 // UpdateArgsEnvs updates arguments or environment variables of the given id
 func (p *EBPFResolver) UpdateArgsEnvs(event *model.ArgsEnvsEvent) {
 	if list, found := p.argsEnvsCache.Get(event.ID); found {
-		list.extend(event)
+		size := argsEnvsMemorySize(list)
+		list.extend(event, p.argsEnvsInterner)
+		p.memoryUsage.Add(argsEnvsMemorySize(list) - size)
 	} else {
-		p.argsEnvsCache.Add(event.ID, newArgsEnvsCacheEntry(event))
+		// the args and envs of a starting process evicted before its exec event is received are lost, their memory
+		// being released by the eviction callback of the cache
+		list := newArgsEnvsCacheEntry(event, p.argsEnvsInterner)
+		p.memoryUsage.Add(argsEnvsMemorySize(list))
+		if evicted := p.argsEnvsCache.Add(event.ID, list); evicted {
+			p.argsEnvsEvicted.Inc()
+		}
 	}
 }
 
@@ -315,6 +555,16 @@ func (p *EBPFResolver) AddExecEntry(entry *model.ProcessCacheEntry, inode uint64
 	p.insertExecEntry(entry, inode, model.ProcessCacheEntryFromEvent)
 }
 
+// setSystemdUnitFromProc resolves the systemd unit owning the cgroup of the process, and its slice, from /proc
+func (p *EBPFResolver) setSystemdUnitFromProc(entry *model.ProcessCacheEntry) {
+	unit, slice, err := utils.GetProcSystemdUnit(entry.Pid, entry.Pid)
+	if err != nil {
+		seclog.Tracef("couldn't resolve the systemd unit of %d: %s", entry.Pid, err)
+		return
+	}
+	entry.SystemdUnit, entry.SystemdSlice = unit, slice
+}
+
 // enrichEventFromProc uses /proc to enrich a ProcessCacheEntry with additional metadata
 func (p *EBPFResolver) enrichEventFromProc(entry *model.ProcessCacheEntry, proc *process.Process, filledProc *utils.FilledProcess) error {
 	// the provided process is a kernel process if its virtual memory size is null
@@ -386,6 +636,10 @@ func (p *EBPFResolver) enrichEventFromProc(entry *model.ProcessCacheEntry, proc
 		}
 	}
 
+	p.setSystemdUnitFromProc(entry)
+	// there is no kernel event for the processes read from /proc, their namespaces are read along with the rest
+	SetProcessNamespaces(&entry.Process)
+
 	if entry.FileEvent.IsFileless() {
 		entry.FileEvent.Filesystem = model.TmpFS
 	} else {
@@ -398,6 +652,9 @@ func (p *EBPFResolver) enrichEventFromProc(entry *model.ProcessCacheEntry, proc
 
 	entry.ExecTime = time.Unix(0, filledProc.CreateTime*int64(time.Millisecond))
 	entry.ForkTime = entry.ExecTime
+	if startTicks, err := procutil.GetProcessStartTicks(pid); err == nil {
+		entry.StartBootTime = procutil.BootTimeFromStartTicks(startTicks)
+	}
 	entry.Comm = filledProc.Name
 	entry.PPid = uint32(filledProc.Ppid)
 	entry.TTYName = utils.PidTTY(uint32(filledProc.Pid))
@@ -437,6 +694,12 @@ func (p *EBPFResolver) enrichEventFromProc(entry *model.ProcessCacheEntry, proc
 		entry.EnvsEntry.Truncated = truncated
 	}
 
+	// When enabled, the `#!` line of the scripts passed as argument or on the standard input of the process is read to
+	// detect reliably that the process is an interpreter, the heuristic below being used otherwise
+	if p.opts.shebangDetection && IsShebangInterpreter(&entry.Process, entry.ArgsEntry.Values) {
+		entry.LinuxBinprm.FileEvent = entry.FileEvent
+	}
+
 	// Heuristic to detect likely interpreter event
 	// Cannot detect when a script if as follows:
 	// perl <<__HERE__
@@ -455,7 +718,7 @@ func (p *EBPFResolver) enrichEventFromProc(entry *model.ProcessCacheEntry, proc
 	// print "Hello from Perl\n";
 	//
 	// EOF
-	if values := entry.ArgsEntry.Values; len(values) > 1 {
+	if values := entry.ArgsEntry.Values; len(values) > 1 && !entry.HasInterpreter() {
 		firstArg := values[0]
 		lastArg := values[len(values)-1]
 		// Example result: comm value: pyscript.py | args: [/usr/bin/python3 ./pyscript.py]
@@ -502,7 +765,8 @@ func (p *EBPFResolver) retrieveExecFileFields(procExecPath string) (*model.FileF
 	}
 
 	var fileFields model.FileFields
-	if _, err := fileFields.UnmarshalBinary(data); err != nil {
+	read, err := fileFields.UnmarshalBinary(data)
+	if err != nil {
 		return nil, fmt.Errorf("unable to unmarshal entry for inode `%d`", inode)
 	}
 
@@ -510,15 +774,49 @@ func (p *EBPFResolver) retrieveExecFileFields(procExecPath string) (*model.FileF
 		return nil, errors.New("not found")
 	}
 
+	// inode numbers are reused once a file is deleted, make sure the entry wasn't cached for a previous owner of the
+	// inode. Overlayfs exposes the generation of the underlying inode in user space, the check is skipped there.
+	if len(data) >= read+4 && fileFields.Flags&(model.LowerLayer|model.UpperLayer) == 0 {
+		cachedGeneration := binary.NativeEndian.Uint32(data[read : read+4])
+		if generation, err := getInodeGeneration(procExecPath); err == nil && cachedGeneration != 0 && generation != cachedGeneration {
+			return nil, fmt.Errorf("stale entry for inode `%d`: generation %d instead of %d", inode, cachedGeneration, generation)
+		}
+	}
+
 	return &fileFields, nil
 }
 
-func (p *EBPFResolver) insertEntry(entry, prev *model.ProcessCacheEntry, source uint64) {
+// getInodeGeneration returns the generation of the inode of the provided file, on the filesystems exposing it
+func getInodeGeneration(path string) (uint32, error) {
+	fd, err := unix.Open(path, unix.O_RDONLY|unix.O_CLOEXEC|unix.O_NONBLOCK, 0)
+	if err != nil {
+		return 0, err
+	}
+	defer unix.Close(fd)
+
+	return unix.IoctlGetUint32(fd, fsIocGetVersion)
+}
+
+// insertEntry inserts the entry in the cache, replacing the previous entry of the pid, and notifies the subscribers
+func (p *EBPFResolver) insertEntry(entry, prev *model.ProcessCacheEntry, source uint64, updateType ProcessTreeUpdateType, tm time.Time) {
 	entry.Source = source
-	p.entryCache[entry.Pid] = entry
+	if entry.Identity == "" && !entry.IsKworker && entry.StartBootTime != 0 {
+		// the start time is provided by the kernel events, or read from procfs for the snapshotted processes, and
+		// converted to the clock ticks from which the other agent products compute the identity
+		entry.Identity = procutil.ProcessIdentity(entry.Pid, procutil.StartTicksFromBootTime(entry.StartBootTime))
+	}
+	// the attributes shared with the other modules are computed before the entry is visible to them
+	p.snapshotLookupInfo(entry)
+
+	p.entryCache.Set(entry.Pid, entry)
 	entry.Retain()
 
+	// the namespaces are set by the kernel event, or by the snapshot, before the insertion so that the mount namespace
+	// referenced by the entry doesn't change while it is in the cache
+	p.retainMountNamespace(entry)
+
 	if prev != nil {
+		p.releaseMountNamespace(prev)
 		prev.Release()
 	}
 
@@ -536,6 +834,13 @@ func (p *EBPFResolver) insertEntry(entry, prev *model.ProcessCacheEntry, source
 		p.addedEntriesFromProcFS.Inc()
 	}
 
+	p.accountEntryMemory(entry)
+
+	// the subscribers are notified before the evictions that this insertion may trigger
+	p.notifyProcessTree(updateType, entry, tm)
+
+	p.evictEntries(entry)
+
 	p.cacheSize.Inc()
 }
 
@@ -544,16 +849,25 @@ func (p *EBPFResolver) insertForkEntry(entry *model.ProcessCacheEntry, inode uin
 		return
 	}
 
-	prev := p.entryCache[entry.Pid]
-	if prev != nil {
-		// this shouldn't happen but it is better to exit the prev and let the new one replace it
-		prev.Exit(entry.ForkTime)
+	// the entry of an exited process retained for the late events is flushed before its pid is reused, the resolver
+	// lock protecting the exit time
+	if prev := p.entryCache.Get(entry.Pid); prev != nil && !prev.ExitTime.IsZero() {
+		p.deleteEntry(entry.Pid, prev.ExitTime)
 	}
 
+	// the entries of the cache are only modified under the lock of the shard of their pid
+	prev := p.entryCache.Do(entry.Pid, func(prev *model.ProcessCacheEntry) *model.ProcessCacheEntry {
+		if prev != nil {
+			// this shouldn't happen but it is better to exit the prev and let the new one replace it
+			prev.Exit(entry.ForkTime)
+		}
+		return prev
+	})
+
 	if entry.Pid != 1 {
-		parent := p.entryCache[entry.PPid]
+		parent := p.entryCache.Get(entry.PPid)
 		if entry.PPid >= 1 && inode != 0 && (parent == nil || parent.FileEvent.Inode != inode) {
-			if candidate := p.resolve(entry.PPid, entry.PPid, inode, true, newEntryCb); candidate != nil {
+			if candidate := p.resolve(entry.PPid, entry.PPid, inode, entry.ContainerID, true, newEntryCb); candidate != nil {
 				parent = candidate
 			} else {
 				entry.IsParentMissing = true
@@ -568,7 +882,7 @@ func (p *EBPFResolver) insertForkEntry(entry *model.ProcessCacheEntry, inode uin
 		}
 	}
 
-	p.insertEntry(entry, prev, source)
+	p.insertEntry(entry, prev, source, ProcessTreeFork, entry.ForkTime)
 }
 
 func (p *EBPFResolver) insertExecEntry(entry *model.ProcessCacheEntry, inode uint64, source uint64) {
@@ -576,31 +890,52 @@ func (p *EBPFResolver) insertExecEntry(entry *model.ProcessCacheEntry, inode uin
 		return
 	}
 
-	prev := p.entryCache[entry.Pid]
-	if prev != nil {
+	// the entries of the cache are only modified under the lock of the shard of their pid
+	var execBomb bool
+	prev := p.entryCache.Do(entry.Pid, func(prev *model.ProcessCacheEntry) *model.ProcessCacheEntry {
+		if prev == nil {
+			entry.IsParentMissing = true
+			return nil
+		}
+
 		if inode != 0 && prev.FileEvent.Inode != inode {
 			entry.IsParentMissing = true
 			p.inodeErrStats.Inc()
 		}
 
 		// check exec bomb
-		if prev.Equals(entry) {
+		if execBomb = prev.Equals(entry); execBomb {
 			prev.ApplyExecTimeOf(entry)
-			return
+			return prev
 		}
 
 		prev.Exec(entry)
-	} else {
-		entry.IsParentMissing = true
+
+		// the process identity doesn't change across executions
+		entry.Identity = prev.Identity
+		return prev
+	})
+	if execBomb {
+		return
 	}
 
-	p.insertEntry(entry, prev, source)
+	p.indexCookie(entry, false)
+
+	p.insertEntry(entry, prev, source, ProcessTreeExec, entry.ExecTime)
+
+	// the executable is queued for parsing right away so that its ELF metadata are ready for the next events
+	p.SetProcessELFMetadata(&entry.Process)
 }
 
 func (p *EBPFResolver) deleteEntry(pid uint32, exitTime time.Time) {
-	// Start by updating the exit timestamp of the pid cache entry
-	entry, ok := p.entryCache[pid]
-	if !ok {
+	// Start by updating the exit timestamp of the pid cache entry, and remove it, under the lock of the shard of the pid
+	entry := p.entryCache.Swap(pid, func(entry *model.ProcessCacheEntry) *model.ProcessCacheEntry {
+		if entry != nil {
+			entry.Exit(exitTime)
+		}
+		return nil
+	})
+	if entry == nil {
 		return
 	}
 
@@ -608,62 +943,174 @@ func (p *EBPFResolver) deleteEntry(pid uint32, exitTime time.Time) {
 		p.cgroupResolver.DelPIDWithID(string(entry.ContainerID), entry.Pid)
 	}
 
-	entry.Exit(exitTime)
-	delete(p.entryCache, entry.Pid)
+	p.releaseMountNamespace(entry)
+
+	p.notifyProcessTree(ProcessTreeExit, entry, exitTime)
+
 	entry.Release()
 }
 
-// DeleteEntry tries to delete an entry in the process cache
+// retainMountNamespace references the mount namespace of an entry inserted in the cache, so that the mounts of the
+// namespace are kept as long as one of its processes is in the cache
+func (p *EBPFResolver) retainMountNamespace(entry *model.ProcessCacheEntry) {
+	if p.mountResolver != nil {
+		p.mountResolver.RetainMountNamespace(entry.MountNamespace)
+	}
+}
+
+// releaseMountNamespace drops the reference of an entry removed from the cache to its mount namespace
+func (p *EBPFResolver) releaseMountNamespace(entry *model.ProcessCacheEntry) {
+	if p.mountResolver != nil {
+		p.mountResolver.ReleaseMountNamespace(entry.MountNamespace)
+	}
+}
+
+// DeleteEntry tries to delete an entry in the process cache. When the referenced retention is set, the entry of the
+// exited process is kept until no event referenced it for the retention period.
 func (p *EBPFResolver) DeleteEntry(pid uint32, exitTime time.Time) {
 	p.Lock()
 	defer p.Unlock()
 
+	if p.opts.referencedRetention > 0 && p.retainExited(pid, exitTime) {
+		return
+	}
+
 	p.deleteEntry(pid, exitTime)
 }
 
+// retainExited marks the entry of a process as exited and keeps it in the cache so that the late events can still
+// resolve their lineage, it returns false when the entry can't be retained
+func (p *EBPFResolver) retainExited(pid uint32, exitTime time.Time) bool {
+	var cookie model.ProcessCookie
+	entry := p.entryCache.Do(pid, func(entry *model.ProcessCacheEntry) *model.ProcessCacheEntry {
+		if entry != nil {
+			entry.Exit(exitTime)
+			cookie = entry.Cookie
+		}
+		return entry
+	})
+	if entry == nil {
+		return false
+	}
+
+	return p.retainedExits.push(retainedExit{
+		pid:      pid,
+		cookie:   cookie,
+		deadline: time.Now().Add(p.opts.referencedRetention),
+	})
+}
+
+// flushRetainedExits deletes a bounded number of the retained entries that weren't referenced by an event for the
+// retention period, the ones referenced since their exit being retained again
+func (p *EBPFResolver) flushRetainedExits(now time.Time) {
+	for i := 0; i < maxDequeuedExitedPerCall; i++ {
+		exit, ok := p.retainedExits.pop(now)
+		if !ok {
+			return
+		}
+
+		// the last reference is updated by the cache hits under the lock of the shard of the pid only
+		var lastReferenced, exitTime time.Time
+		entry := p.entryCache.View(exit.pid, func(entry *model.ProcessCacheEntry) *model.ProcessCacheEntry {
+			// the pid may have been reused by a new process since the exit
+			if entry == nil || entry.Cookie != exit.cookie {
+				return nil
+			}
+			lastReferenced, exitTime = entry.LastReferenced, entry.ExitTime
+			return entry
+		})
+		if entry == nil {
+			continue
+		}
+
+		if deadline := lastReferenced.Add(p.opts.referencedRetention); deadline.After(now) {
+			exit.deadline = deadline
+			if p.retainedExits.push(exit) {
+				continue
+			}
+		}
+
+		p.deleteEntry(exit.pid, exitTime)
+		p.flushedEntries.Inc()
+	}
+}
+
 // Resolve returns the cache entry for the given pid
-func (p *EBPFResolver) Resolve(pid, tid uint32, inode uint64, useProcFS bool, newEntryCb func(*model.ProcessCacheEntry, error)) *model.ProcessCacheEntry {
+func (p *EBPFResolver) Resolve(pid, tid uint32, inode uint64, containerID containerutils.ContainerID, useProcFS bool, newEntryCb func(*model.ProcessCacheEntry, error)) *model.ProcessCacheEntry {
 	if pid == 0 {
 		return nil
 	}
 
-	p.Lock()
-	defer p.Unlock()
-
-	return p.resolve(pid, tid, inode, useProcFS, newEntryCb)
-}
+	var start time.Time
+	if p.telemetry != nil {
+		start = time.Now()
+	}
 
-func (p *EBPFResolver) resolve(pid, tid uint32, inode uint64, useProcFS bool, newEntryCb func(*model.ProcessCacheEntry, error)) *model.ProcessCacheEntry {
+	// the cache hits only lock the shard of the pid
 	if entry := p.resolveFromCache(pid, tid, inode); entry != nil {
 		p.hitsStats[metrics.CacheTag].Inc()
+		p.observeResolution(metrics.CacheTag, start)
 		return entry
 	}
 
-	if p.state.Load() != Snapshotted {
-		return nil
+	p.Lock()
+	entry, source := p.resolveWithSource(pid, tid, inode, containerID, useProcFS, newEntryCb)
+	p.Unlock()
+
+	// the lost args of the entries resolved from the kernel maps are completed from procfs out of the lock
+	if entry != nil && source == metrics.KernelMapsTag && entry.ArgsEntry == nil {
+		p.completeArgsFromProcfs(entry)
+	}
+
+	p.observeResolution(source, start)
+	return entry
+}
+
+// observeResolution records the latency of a resolution on the agent telemetry
+func (p *EBPFResolver) observeResolution(resolutionType string, start time.Time) {
+	if p.telemetry != nil {
+		p.telemetry.observeResolution(sourceLabel(resolutionType), start)
+	}
+}
+
+func (p *EBPFResolver) resolve(pid, tid uint32, inode uint64, containerID containerutils.ContainerID, useProcFS bool, newEntryCb func(*model.ProcessCacheEntry, error)) *model.ProcessCacheEntry {
+	entry, _ := p.resolveWithSource(pid, tid, inode, containerID, useProcFS, newEntryCb)
+	return entry
+}
+
+// resolveWithSource resolves the entry and returns the resolution type tag of the source it was resolved from
+func (p *EBPFResolver) resolveWithSource(pid, tid uint32, inode uint64, containerID containerutils.ContainerID, useProcFS bool, newEntryCb func(*model.ProcessCacheEntry, error)) (*model.ProcessCacheEntry, string) {
+	if entry := p.resolveFromCache(pid, tid, inode); entry != nil {
+		p.hitsStats[metrics.CacheTag].Inc()
+		return entry, metrics.CacheTag
 	}
 
-	// fallback to the kernel maps directly, the perf event may be delayed / may have been lost
+	// fallback to the kernel maps directly, the perf event may be delayed / may have been lost. This is also the best
+	// effort resolution while snapshotting, the entries of the in-kernel cache being populated before the snapshot.
 	if entry := p.resolveFromKernelMaps(pid, tid, inode, newEntryCb); entry != nil {
 		p.hitsStats[metrics.KernelMapsTag].Inc()
-		return entry
+		return entry, metrics.KernelMapsTag
 	}
 
-	if !useProcFS {
+	// the snapshot is already walking /proc, the entry will be inserted by it
+	if !useProcFS || !p.IsSnapshotted() {
 		p.missStats.Inc()
-		return nil
+		return nil, missSource
 	}
 
-	if p.procFallbackLimiter.Allow(pid) {
+	if p.procFallbackLimiter.Allow(pid, containerID) {
 		// fallback to /proc, the in-kernel LRU may have deleted the entry
-		if entry := p.resolveFromProcfs(pid, procResolveMaxDepth, newEntryCb); entry != nil {
+		if p.procfsRequests != nil {
+			// the entry will be inserted by a procfs worker, see IsProcfsResolutionPending
+			p.queueProcfsResolution(pid)
+		} else if entry := p.resolveFromProcfs(pid, procResolveMaxDepth, newEntryCb); entry != nil {
 			p.hitsStats[metrics.ProcFSTag].Inc()
-			return entry
+			return entry, metrics.ProcFSTag
 		}
 	}
 
 	p.missStats.Inc()
-	return nil
+	return nil, missSource
 }
 
 func (p *EBPFResolver) resolveFileFieldsPath(e *model.FileFields, pce *model.ProcessCacheEntry, ctrCtx *model.ContainerContext) (string, string, model.MountSource, model.MountOrigin, error) {
@@ -680,8 +1127,8 @@ func (p *EBPFResolver) resolveFileFieldsPath(e *model.FileFields, pce *model.Pro
 		if err == nil {
 			return pathnameStr, mountPath, source, origin, nil
 		}
-		parent, exists := p.entryCache[pce.PPid]
-		if !exists {
+		parent := p.entryCache.Get(pce.PPid)
+		if parent == nil {
 			break
 		}
 
@@ -761,26 +1208,58 @@ func (p *EBPFResolver) ResolveFromCache(pid, tid uint32, inode uint64) *model.Pr
 }
 
 func (p *EBPFResolver) resolveFromCache(pid, tid uint32, inode uint64) *model.ProcessCacheEntry {
-	entry, exists := p.entryCache[pid]
-	if !exists {
-		return nil
-	}
+	// the last reference both extends the retention of the exited processes and orders the evictions
+	trackReferences := p.opts.referencedRetention > 0 || p.opts.maxEntries > 0 || p.opts.memoryBudget > 0
+	now := time.Now()
 
 	// Compare inode to ensure that the cache is up-to-date.
 	// Be sure to compare with the file inode and not the pidcontext which can be empty
 	// if the entry originates from procfs.
-	if inode != 0 && inode != entry.Process.FileEvent.Inode {
-		return nil
+	matches := func(entry *model.ProcessCacheEntry) bool {
+		return entry != nil && (inode == 0 || inode == entry.Process.FileEvent.Inode)
 	}
 
-	// make to update the tid with the that triggers the resolution
-	entry.Tid = tid
+	// most hits are on an entry already referenced by the same thread, they only take the read lock of the shard
+	var stale bool
+	entry := p.entryCache.View(pid, func(entry *model.ProcessCacheEntry) *model.ProcessCacheEntry {
+		if !matches(entry) {
+			return nil
+		}
+		stale = entry.Tid != tid || (trackReferences && now.Sub(entry.LastReferenced) >= lastReferencedPrecision)
+		return entry
+	})
+	if entry == nil || !stale {
+		return entry
+	}
 
-	return entry
+	return p.entryCache.Do(pid, func(entry *model.ProcessCacheEntry) *model.ProcessCacheEntry {
+		if !matches(entry) {
+			return nil
+		}
+
+		// make to update the tid with the that triggers the resolution. The tid and the last reference are only
+		// written under the lock of the shard, their readers have to lock it too.
+		entry.Tid = tid
+		if trackReferences {
+			entry.LastReferenced = now
+		}
+
+		return entry
+	})
 }
 
 // ResolveNewProcessCacheEntry resolves the context fields of a new process cache entry parsed from kernel data
 func (p *EBPFResolver) ResolveNewProcessCacheEntry(entry *model.ProcessCacheEntry, ctrCtx *model.ContainerContext) error {
+	return p.resolveNewProcessCacheEntry(entry, ctrCtx, true)
+}
+
+// resolveNewProcessCacheEntry resolves the context fields of a new process cache entry. The lost args are only
+// completed from procfs when the resolver lock isn't held.
+func (p *EBPFResolver) resolveNewProcessCacheEntry(entry *model.ProcessCacheEntry, ctrCtx *model.ContainerContext, argsFromProcfs bool) error {
+	if entry.HasInterpreter() {
+		p.pathResolver.PrefetchFilePaths(&entry.FileEvent.FileFields, &entry.LinuxBinprm.FileEvent.FileFields)
+	}
+
 	if _, err := p.SetProcessPath(&entry.FileEvent, entry, ctrCtx); err != nil {
 		return &spath.ErrPathResolution{Err: fmt.Errorf("failed to resolve exec path: %w", err)}
 	}
@@ -795,7 +1274,7 @@ func (p *EBPFResolver) ResolveNewProcessCacheEntry(entry *model.ProcessCacheEntr
 		entry.LinuxBinprm.FileEvent.SetBasenameStr("")
 	}
 
-	p.SetProcessArgs(entry)
+	p.setProcessArgs(entry, argsFromProcfs)
 	p.SetProcessEnvs(entry)
 	p.SetProcessTTY(entry)
 	p.SetProcessUsersGroups(entry)
@@ -815,7 +1294,8 @@ func (p *EBPFResolver) ResolveFromKernelMaps(pid, tid uint32, inode uint64, newE
 }
 
 func (p *EBPFResolver) resolveFromKernelMaps(pid, tid uint32, inode uint64, newEntryCb func(*model.ProcessCacheEntry, error)) *model.ProcessCacheEntry {
-	if pid == 0 {
+	// the kernel maps are only available once the resolver is started
+	if pid == 0 || p.pidCacheMap == nil {
 		return nil
 	}
 
@@ -831,7 +1311,7 @@ func (p *EBPFResolver) resolveFromKernelMaps(pid, tid uint32, inode uint64, newE
 		return nil
 	}
 
-	// first 4 bytes are the actual cookie
+	// the pid_cache entry starts with the cookie of the proc_cache entry
 	procCache, err := p.procCacheMap.LookupBytes(pidCache[0:model.SizeOfCookie])
 	if err != nil {
 		// LookupBytes doesn't return an error if the key is not found thus it is a critical error
@@ -881,8 +1361,10 @@ func (p *EBPFResolver) resolveFromKernelMaps(pid, tid uint32, inode uint64, newE
 		}
 	}
 
-	// resolve paths and other context fields
-	if err = p.ResolveNewProcessCacheEntry(entry, &ctrCtx); err != nil {
+	// the systemd unit is resolved from the cgroup of the process when requested
+
+	// resolve paths and other context fields, the lost args are completed by Resolve once the lock is released
+	if err = p.resolveNewProcessCacheEntry(entry, &ctrCtx, false); err != nil {
 		if newEntryCb != nil {
 			newEntryCb(entry, err)
 		}
@@ -921,6 +1403,20 @@ func (p *EBPFResolver) resolveFromProcfs(pid uint32, maxDepth int, newEntryCb fu
 		return nil
 	}
 
+	entry := p.newEntryFromPid(pid)
+	if entry == nil {
+		return nil
+	}
+
+	if entry.PPid != 0 && p.entryCache.Get(entry.PPid) == nil {
+		p.resolveFromProcfs(entry.PPid, maxDepth-1, newEntryCb)
+	}
+
+	return p.syncEntryWithKernelMaps(entry, model.ProcessCacheEntryFromProcFS, newEntryCb)
+}
+
+// newEntryFromPid reads /proc to create the entry of the provided pid. The resolver lock isn't required.
+func (p *EBPFResolver) newEntryFromPid(pid uint32) *model.ProcessCacheEntry {
 	proc, err := process.NewProcess(int32(pid))
 	if err != nil {
 		seclog.Tracef("unable to find pid: %d", pid)
@@ -938,16 +1434,18 @@ func (p *EBPFResolver) resolveFromProcfs(pid uint32, maxDepth int, newEntryCb fu
 		return nil
 	}
 
-	ppid := uint32(filledProc.Ppid)
-	if ppid != 0 && p.entryCache[ppid] == nil {
-		p.resolveFromProcfs(ppid, maxDepth-1, newEntryCb)
-	}
-
-	return p.newEntryFromProcfsAndSyncKernelMaps(proc, filledProc, model.ProcessCacheEntryFromProcFS, newEntryCb)
+	return p.newEntryFromProcfs(proc, filledProc)
 }
 
 // SetProcessArgs set arguments to cache entry
 func (p *EBPFResolver) SetProcessArgs(pce *model.ProcessCacheEntry) {
+	p.setProcessArgs(pce, true)
+}
+
+// setProcessArgs attaches the args sent by the kernel to the entry. The entries of the exec events, fromEvent, are the
+// only ones whose args must still be in the cache: a miss is counted as a loss and, the resolver lock not being held,
+// completed from procfs. The entries resolved from the kernel maps have their args completed once the lock is released.
+func (p *EBPFResolver) setProcessArgs(pce *model.ProcessCacheEntry, fromEvent bool) {
 	if entry, found := p.argsEnvsCache.Get(pce.ArgsID); found {
 		if pce.ArgsTruncated {
 			p.argsTruncated.Inc()
@@ -962,24 +1460,130 @@ func (p *EBPFResolver) SetProcessArgs(pce *model.ProcessCacheEntry) {
 
 		// no need to keep it in LRU now as attached to a process
 		p.argsEnvsCache.Remove(pce.ArgsID)
+		return
+	}
+
+	// no args were sent for this exec, or they were consumed by the exec event before the entry was evicted
+	if pce.ArgsID == 0 || !fromEvent {
+		return
 	}
+
+	// the args of each exec are sent by the kernel, they were evicted from the cache by the args of other processes
+	// starting simultaneously
+	p.argsLost.Inc()
+
+	if p.opts.argsProcfsFallback {
+		if cmdline := p.readProcCmdline(pce); cmdline != nil {
+			pce.ArgsEntry = &model.ArgsEntry{
+				Values: cmdline,
+			}
+		}
+	}
+}
+
+// completeArgsFromProcfs completes the lost args of an entry of the cache with its command line, read from procfs
+// before taking the resolver lock
+func (p *EBPFResolver) completeArgsFromProcfs(pce *model.ProcessCacheEntry) {
+	if !p.opts.argsProcfsFallback {
+		return
+	}
+
+	cmdline := p.readProcCmdline(pce)
+	if cmdline == nil {
+		return
+	}
+
+	p.Lock()
+	defer p.Unlock()
+
+	// the command line belongs to a later execution of the process if one was inserted while procfs was read
+	if current := p.entryCache.Get(pce.Pid); current != nil && current.ExecTime.After(pce.ExecTime) {
+		return
+	}
+
+	if pce.ArgsEntry == nil {
+		pce.ArgsEntry = &model.ArgsEntry{
+			Values: cmdline,
+		}
+		p.accountEntryMemory(pce)
+	}
+}
+
+// readProcCmdline reads the command line of a process from procfs, to replace its lost args. It is only used if the
+// process is still the execution of the entry: same start time, same executable and not exited.
+func (p *EBPFResolver) readProcCmdline(pce *model.ProcessCacheEntry) []string {
+	if !pce.ExitTime.IsZero() || !isProcessExecution(pce) {
+		return nil
+	}
+
+	cmdline, err := utils.ProcCmdline(pce.Pid)
+	if err != nil || len(cmdline) == 0 {
+		return nil
+	}
+
+	p.argsEnvsInterner.DeduplicateSlice(cmdline)
+	p.argsProcfsFallback.Inc()
+
+	return cmdline
 }
 
-// GetProcessArgvScrubbed returns the scrubbed args of the event as an array
+// isProcessExecution returns whether the process running with the pid of the entry is the execution of the entry. The
+// pid may have been reused, or the process may have executed another binary since. The unknown values are ignored.
+func isProcessExecution(pce *model.ProcessCacheEntry) bool {
+	if pce.StartBootTime != 0 {
+		startTicks, err := procutil.GetProcessStartTicks(pce.Pid)
+		if err != nil || startTicks != procutil.StartTicksFromBootTime(pce.StartBootTime) {
+			return false
+		}
+	}
+
+	if pce.FileEvent.Inode != 0 {
+		var stat syscall.Stat_t
+		if err := syscall.Stat(utils.ProcExePath(pce.Pid), &stat); err != nil || stat.Ino != pce.FileEvent.Inode {
+			return false
+		}
+	}
+
+	return true
+}
+
+// GetProcessArgvScrubbed returns the scrubbed args of the event as an array. The args of the entry are left untouched,
+// the scrubbed ones being kept apart.
 func (p *EBPFResolver) GetProcessArgvScrubbed(pr *model.Process) ([]string, bool) {
-	if pr.ArgsEntry == nil || pr.ScrubbedArgvResolved {
+	if pr.ArgsEntry == nil {
 		return pr.Argv, pr.ArgsTruncated
 	}
 
-	if p.scrubber != nil && len(pr.ArgsEntry.Values) > 0 {
-		// replace with the scrubbed version
-		argv, _ := p.scrubber.ScrubCommand(pr.ArgsEntry.Values[1:])
-		pr.ArgsEntry.Values = []string{pr.ArgsEntry.Values[0]}
-		pr.ArgsEntry.Values = append(pr.ArgsEntry.Values, argv...)
+	if !pr.ScrubbedArgvResolved {
+		pr.ArgvScrubbed = nil
+		if argv := p.scrubArgs(pr); len(argv) > 0 {
+			pr.ArgvScrubbed = argv[1:]
+		}
+		pr.ScrubbedArgvResolved = true
+	}
+	pr.ArgsTruncated = pr.ArgsTruncated || pr.ArgsEntry.Truncated
+
+	return pr.ArgvScrubbed, pr.ArgsTruncated
+}
+
+// scrubArgs returns the args of the process, the first one included, scrubbed by the global scrubber and by the rules
+// of the policies. The entry is left untouched.
+func (p *EBPFResolver) scrubArgs(pr *model.Process) []string {
+	values := pr.ArgsEntry.Values
+	if len(values) == 0 {
+		return values
+	}
+
+	argv, replacement := values[1:], pkgconfigsetup.DefaultScrubbingReplacement
+	if p.scrubber != nil {
+		argv, _ = p.scrubber.ScrubCommand(argv)
+		replacement = p.scrubber.Replacement
 	}
-	pr.ScrubbedArgvResolved = true
 
-	return GetProcessArgv(pr)
+	// the rules of the policies apply on top of the global scrubber
+	argv = p.argsScrubbing.scrub(pr.FileEvent.PathnameStr, argv, replacement)
+
+	return append([]string{values[0]}, argv...)
 }
 
 // SetProcessEnvs set envs to cache entry
@@ -1002,7 +1606,10 @@ func (p *EBPFResolver) SetProcessEnvs(pce *model.ProcessCacheEntry) {
 
 		// no need to keep it in LRU now as attached to a process
 		p.argsEnvsCache.Remove(pce.EnvsID)
+		return
 	}
+
+	p.envsLost.Inc()
 }
 
 // GetProcessEnvs returns the envs of the event
@@ -1011,12 +1618,26 @@ func (p *EBPFResolver) GetProcessEnvs(pr *model.Process) ([]string, bool) {
 		return pr.Envs, pr.EnvsTruncated
 	}
 
-	keys, truncated := pr.EnvsEntry.FilterEnvs(p.opts.envsWithValue)
+	keys, truncated := pr.EnvsEntry.FilterEnvsFunc(p.envsWithValue.get().keepValue)
 	pr.Envs = keys
 	pr.EnvsTruncated = pr.EnvsTruncated || truncated
 	return pr.Envs, pr.EnvsTruncated
 }
 
+// SetPolicyArgsScrubbing sets the scrubbing rules of the process arguments requested by the loaded policies. They
+// apply on top of the global scrubber.
+func (p *EBPFResolver) SetPolicyArgsScrubbing(defs []rules.ArgsScrubbingDefinition) {
+	p.argsScrubbing.set(defs)
+}
+
+// SetPolicyEnvsWithValue sets the environment variables whose value is kept at the request of the loaded policies,
+// in addition to the configured ones
+func (p *EBPFResolver) SetPolicyEnvsWithValue(envs []string) {
+	if p.envsWithValue.setPolicyEnvs(envs) {
+		seclog.Infof("environment variables with value: %s", strings.Join(p.envsWithValue.list(), ", "))
+	}
+}
+
 // GetProcessEnvp returns the unscrubbed envs of the event with their values. Use with caution.
 func (p *EBPFResolver) GetProcessEnvp(pr *model.Process) ([]string, bool) {
 	if pr.EnvsEntry == nil {
@@ -1039,20 +1660,71 @@ func (p *EBPFResolver) SetProcessTTY(pce *model.ProcessCacheEntry) string {
 
 // SetProcessUsersGroups resolves and set users and groups
 func (p *EBPFResolver) SetProcessUsersGroups(pce *model.ProcessCacheEntry) {
-	pce.User, _ = p.userGroupResolver.ResolveUser(int(pce.Credentials.UID), string(pce.ContainerID))
-	pce.EUser, _ = p.userGroupResolver.ResolveUser(int(pce.Credentials.EUID), string(pce.ContainerID))
-	pce.FSUser, _ = p.userGroupResolver.ResolveUser(int(pce.Credentials.FSUID), string(pce.ContainerID))
+	pce.User, _ = p.userGroupResolver.ResolveUser(int(pce.Credentials.UID), string(pce.ContainerID), pce.Pid)
+	pce.EUser, _ = p.userGroupResolver.ResolveUser(int(pce.Credentials.EUID), string(pce.ContainerID), pce.Pid)
+	pce.FSUser, _ = p.userGroupResolver.ResolveUser(int(pce.Credentials.FSUID), string(pce.ContainerID), pce.Pid)
 
-	pce.Group, _ = p.userGroupResolver.ResolveGroup(int(pce.Credentials.GID), string(pce.ContainerID))
-	pce.EGroup, _ = p.userGroupResolver.ResolveGroup(int(pce.Credentials.EGID), string(pce.ContainerID))
-	pce.FSGroup, _ = p.userGroupResolver.ResolveGroup(int(pce.Credentials.FSGID), string(pce.ContainerID))
+	pce.Group, _ = p.userGroupResolver.ResolveGroup(int(pce.Credentials.GID), string(pce.ContainerID), pce.Pid)
+	pce.EGroup, _ = p.userGroupResolver.ResolveGroup(int(pce.Credentials.EGID), string(pce.ContainerID), pce.Pid)
+	pce.FSGroup, _ = p.userGroupResolver.ResolveGroup(int(pce.Credentials.FSGID), string(pce.ContainerID), pce.Pid)
+}
+
+// ApplyUsersGroupsResolutions sets the names of the users and groups of the host resolved in the background through
+// the name service switch to the cached processes which were missing them. It is called from the event path.
+func (p *EBPFResolver) ApplyUsersGroupsResolutions() {
+	if p.userGroupResolver == nil {
+		return
+	}
+
+	uids, gids := p.userGroupResolver.PopNSSResolved()
+	if len(uids) == 0 && len(gids) == 0 {
+		return
+	}
+
+	p.Lock()
+	defer p.Unlock()
+
+	var pids []uint32
+	p.entryCache.Range(func(pid uint32, entry *model.ProcessCacheEntry) bool {
+		if entry.ContainerID == "" && isMissingResolvedName(&entry.Credentials, uids, gids) {
+			pids = append(pids, pid)
+		}
+		return true
+	})
+
+	// the entries are only modified under the lock of the shard of their pid
+	for _, pid := range pids {
+		p.entryCache.Do(pid, func(entry *model.ProcessCacheEntry) *model.ProcessCacheEntry {
+			if entry != nil {
+				p.SetProcessUsersGroups(entry)
+			}
+			return entry
+		})
+	}
+}
+
+// isMissingResolvedName returns whether one of the names of the credentials is missing while its id was resolved
+func isMissingResolvedName(creds *model.Credentials, uids, gids map[int]struct{}) bool {
+	missingUser := func(name string, id uint32) bool {
+		_, resolved := uids[int(id)]
+		return name == "" && resolved
+	}
+	missingGroup := func(name string, id uint32) bool {
+		_, resolved := gids[int(id)]
+		return name == "" && resolved
+	}
+
+	return missingUser(creds.User, creds.UID) ||
+		missingUser(creds.EUser, creds.EUID) ||
+		missingUser(creds.FSUser, creds.FSUID) ||
+		missingGroup(creds.Group, creds.GID) ||
+		missingGroup(creds.EGroup, creds.EGID) ||
+		missingGroup(creds.FSGroup, creds.FSGID)
 }
 
 // Get returns the cache entry for a specified pid
 func (p *EBPFResolver) Get(pid uint32) *model.ProcessCacheEntry {
-	p.RLock()
-	defer p.RUnlock()
-	return p.entryCache[pid]
+	return p.entryCache.Get(pid)
 }
 
 // UpdateUID updates the credentials of the provided pid
@@ -1063,7 +1735,7 @@ func (p *EBPFResolver) UpdateUID(pid uint32, e *model.Event) {
 
 	p.Lock()
 	defer p.Unlock()
-	entry := p.entryCache[pid]
+	entry := p.entryCache.Get(pid)
 	if entry != nil {
 		entry.Credentials.UID = e.SetUID.UID
 		entry.Credentials.User = e.FieldHandlers.ResolveSetuidUser(e, &e.SetUID)
@@ -1082,7 +1754,7 @@ func (p *EBPFResolver) UpdateGID(pid uint32, e *model.Event) {
 
 	p.Lock()
 	defer p.Unlock()
-	entry := p.entryCache[pid]
+	entry := p.entryCache.Get(pid)
 	if entry != nil {
 		entry.Credentials.GID = e.SetGID.GID
 		entry.Credentials.Group = e.FieldHandlers.ResolveSetgidGroup(e, &e.SetGID)
@@ -1101,7 +1773,7 @@ func (p *EBPFResolver) UpdateCapset(pid uint32, e *model.Event) {
 
 	p.Lock()
 	defer p.Unlock()
-	entry := p.entryCache[pid]
+	entry := p.entryCache.Get(pid)
 	if entry != nil {
 		entry.Credentials.CapEffective = e.Capset.CapEffective
 		entry.Credentials.CapPermitted = e.Capset.CapPermitted
@@ -1116,59 +1788,12 @@ func (p *EBPFResolver) UpdateLoginUID(pid uint32, e *model.Event) {
 
 	p.Lock()
 	defer p.Unlock()
-	entry := p.entryCache[pid]
+	entry := p.entryCache.Get(pid)
 	if entry != nil {
 		entry.Credentials.AUID = e.LoginUIDWrite.AUID
 	}
 }
 
-// UpdateAWSSecurityCredentials updates the list of AWS Security Credentials
-func (p *EBPFResolver) UpdateAWSSecurityCredentials(pid uint32, e *model.Event) {
-	if len(e.IMDS.AWS.SecurityCredentials.AccessKeyID) == 0 {
-		return
-	}
-
-	p.Lock()
-	defer p.Unlock()
-
-	entry := p.entryCache[pid]
-	if entry != nil {
-		// check if this key is already in cache
-		for _, key := range entry.AWSSecurityCredentials {
-			if key.AccessKeyID == e.IMDS.AWS.SecurityCredentials.AccessKeyID {
-				return
-			}
-		}
-		entry.AWSSecurityCredentials = append(entry.AWSSecurityCredentials, e.IMDS.AWS.SecurityCredentials)
-	}
-}
-
-// FetchAWSSecurityCredentials returns the list of AWS Security Credentials valid at the time of the event, and prunes
-// expired entries
-func (p *EBPFResolver) FetchAWSSecurityCredentials(e *model.Event) []model.AWSSecurityCredentials {
-	p.Lock()
-	defer p.Unlock()
-
-	entry := p.entryCache[e.ProcessContext.Pid]
-	if entry != nil {
-		// check if we should delete
-		var toDelete []int
-		for id, key := range entry.AWSSecurityCredentials {
-			if key.Expiration.Before(e.ResolveEventTime()) {
-				toDelete = append([]int{id}, toDelete...)
-			}
-		}
-
-		// delete expired entries
-		for _, id := range toDelete {
-			entry.AWSSecurityCredentials = append(entry.AWSSecurityCredentials[0:id], entry.AWSSecurityCredentials[id+1:]...)
-		}
-
-		return entry.AWSSecurityCredentials
-	}
-	return nil
-}
-
 // Start starts the resolver
 func (p *EBPFResolver) Start(ctx context.Context) error {
 	var err error
@@ -1184,67 +1809,109 @@ func (p *EBPFResolver) Start(ctx context.Context) error {
 		return err
 	}
 
-	go p.cacheFlush(ctx)
+	go p.sweepCache(ctx)
+	go p.reconcileCache(ctx)
+
+	for i := 0; i < p.opts.procfsWorkers; i++ {
+		go p.procfsWorker(ctx)
+	}
+
+	if p.lineageRepairs != nil {
+		go p.lineageRepairWorker(ctx)
+	}
+
+	if p.opts.envsRefreshInterval > 0 && p.envVarsResolver != nil {
+		go p.refreshEnvsLoop(ctx)
+	}
+
+	go p.elfMetadata.run(ctx)
 
 	return nil
 }
 
-func (p *EBPFResolver) cacheFlush(ctx context.Context) {
-	ticker := time.NewTicker(2 * time.Minute)
+// sweepCache periodically queues for deletion the entries of the processes that are no longer running. Entries
+// are deleted when their exit event is received, the sweep only catches the exits whose event was lost.
+func (p *EBPFResolver) sweepCache(ctx context.Context) {
+	if p.opts.sweepInterval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(p.opts.sweepInterval)
 	defer ticker.Stop()
 
 	for {
 		select {
 		case <-ticker.C:
-			procPids, err := process.Pids()
-			if err != nil {
-				continue
+			if err := p.sweep(kernel.ProcFSRoot()); err != nil {
+				seclog.Debugf("failed to sweep the process cache: %v", err)
 			}
-			procPidsMap := make(map[uint32]bool)
-			for _, pid := range procPids {
-				procPidsMap[uint32(pid)] = true
-			}
-
-			p.Lock()
-			for pid := range p.entryCache {
-				if _, exists := procPidsMap[pid]; !exists {
-					if entry := p.entryCache[pid]; entry != nil {
-						p.exitedQueue = append(p.exitedQueue, pid)
-					}
-				}
-			}
-			p.Unlock()
 		case <-ctx.Done():
 			return
 		}
 	}
 }
 
-// SyncCache snapshots /proc for the provided pid.
-func (p *EBPFResolver) SyncCache(proc *process.Process) {
-	// Only a R lock is necessary to check if the entry exists, but if it exists, we'll update it, so a RW lock is
-	// required.
+// sweep queues the entries whose pid isn't listed in the given procfs
+func (p *EBPFResolver) sweep(procRoot string) error {
+	pids, err := kernel.AllPidsProcs(procRoot)
+	if err != nil {
+		return err
+	}
+
+	running := make(map[uint32]struct{}, len(pids))
+	for _, pid := range pids {
+		running[uint32(pid)] = struct{}{}
+	}
+
 	p.Lock()
 	defer p.Unlock()
 
+	p.entryCache.Range(func(pid uint32, _ *model.ProcessCacheEntry) bool {
+		if _, exists := running[pid]; !exists {
+			p.exitedQueue.push(pid)
+		}
+		return true
+	})
+
+	return nil
+}
+
+// SyncCache snapshots /proc for the provided pid.
+func (p *EBPFResolver) SyncCache(proc *process.Process) {
+	if entry := p.NewSnapshotEntry(proc); entry != nil {
+		p.InsertSnapshotEntry(entry)
+	}
+}
+
+// NewSnapshotEntry snapshots /proc for the provided pid without holding the resolver lock, so that several processes
+// can be snapshotted concurrently. The entry has to be inserted with InsertSnapshotEntry, parents before children.
+func (p *EBPFResolver) NewSnapshotEntry(proc *process.Process) *model.ProcessCacheEntry {
 	filledProc, err := utils.GetFilledProcess(proc)
 	if err != nil {
 		seclog.Tracef("unable to get a filled process for %d: %v", proc.Pid, err)
-		return
+		return nil
 	}
 
-	p.newEntryFromProcfsAndSyncKernelMaps(proc, filledProc, model.ProcessCacheEntryFromSnapshot, nil)
+	return p.newEntryFromProcfs(proc, filledProc)
+}
+
+// InsertSnapshotEntry inserts an entry returned by NewSnapshotEntry in the cache and sync the kernel maps
+func (p *EBPFResolver) InsertSnapshotEntry(entry *model.ProcessCacheEntry) {
+	p.Lock()
+	defer p.Unlock()
+
+	p.syncEntryWithKernelMaps(entry, model.ProcessCacheEntryFromSnapshot, nil)
 }
 
 func (p *EBPFResolver) setAncestor(pce *model.ProcessCacheEntry) {
-	parent := p.entryCache[pce.PPid]
+	parent := p.entryCache.Get(pce.PPid)
 	if parent != nil {
 		pce.SetAncestor(parent)
 	}
 }
 
-// newEntryFromProcfsAndSyncKernelMaps snapshots /proc for the provided pid and sync the kernel maps
-func (p *EBPFResolver) newEntryFromProcfsAndSyncKernelMaps(proc *process.Process, filledProc *utils.FilledProcess, source uint64, newEntryCb func(*model.ProcessCacheEntry, error)) *model.ProcessCacheEntry {
+// newEntryFromProcfs snapshots /proc for the provided pid. The resolver lock isn't required.
+func (p *EBPFResolver) newEntryFromProcfs(proc *process.Process, filledProc *utils.FilledProcess) *model.ProcessCacheEntry {
 	pid := uint32(proc.Pid)
 
 	entry := p.NewProcessCacheEntry(model.PIDContext{Pid: pid, Tid: pid})
@@ -1259,11 +1926,18 @@ func (p *EBPFResolver) newEntryFromProcfsAndSyncKernelMaps(proc *process.Process
 
 	entry.IsKworker = filledProc.Ppid == 0 && filledProc.Pid != 1
 
-	parent := p.entryCache[entry.PPid]
+	return entry
+}
+
+// syncEntryWithKernelMaps inserts an entry snapshotted from /proc in the cache and sync the kernel maps
+func (p *EBPFResolver) syncEntryWithKernelMaps(entry *model.ProcessCacheEntry, source uint64, newEntryCb func(*model.ProcessCacheEntry, error)) *model.ProcessCacheEntry {
+	pid := entry.Pid
+
+	parent := p.entryCache.Get(entry.PPid)
 	if parent != nil {
 		if parent.Equals(entry) {
 			entry.SetForkParent(parent)
-		} else if prev := p.entryCache[pid]; prev != nil { // exec-exec
+		} else if prev := p.entryCache.Get(pid); prev != nil { // exec-exec
 			entry.SetExecParent(prev)
 		} else { // exec
 			entry.SetExecParent(parent)
@@ -1274,28 +1948,13 @@ func (p *EBPFResolver) newEntryFromProcfsAndSyncKernelMaps(proc *process.Process
 		seclog.Debugf("unable to set the type of process, not pid 1, no parent in cache: %+v", entry)
 	}
 
-	p.insertEntry(entry, p.entryCache[pid], source)
+	// the cookie is pushed to the kernel maps, it can still be replaced if it collides with another execution
+	p.indexCookie(entry, true)
 
-	bootTime := p.timeResolver.GetBootTime()
+	p.insertEntry(entry, p.entryCache.Get(pid), source, ProcessTreeInsert, time.Now())
 
-	// insert new entry in kernel maps
-	procCacheEntryB := make([]byte, 248)
-	_, err := entry.Process.MarshalProcCache(procCacheEntryB, bootTime)
-	if err != nil {
-		seclog.Errorf("couldn't marshal proc_cache entry: %s", err)
-	} else {
-		if err = p.procCacheMap.Put(entry.Cookie, procCacheEntryB); err != nil {
-			seclog.Errorf("couldn't push proc_cache entry to kernel space: %s", err)
-		}
-	}
-	pidCacheEntryB := make([]byte, 88)
-	_, err = entry.Process.MarshalPidCache(pidCacheEntryB, bootTime)
-	if err != nil {
-		seclog.Errorf("couldn't marshal pid_cache entry: %s", err)
-	} else {
-		if err = p.pidCacheMap.Put(pid, pidCacheEntryB); err != nil {
-			seclog.Errorf("couldn't push pid_cache entry to kernel space: %s", err)
-		}
+	if err := p.pushToKernelMaps(entry); err != nil {
+		seclog.Errorf("%s", err)
 	}
 
 	seclog.Tracef("New process cache entry added: %s %s %d/%d", entry.Comm, entry.FileEvent.PathnameStr, pid, entry.FileEvent.Inode)
@@ -1307,126 +1966,102 @@ func (p *EBPFResolver) newEntryFromProcfsAndSyncKernelMaps(proc *process.Process
 	return entry
 }
 
-// ToJSON return a json version of the cache
-func (p *EBPFResolver) ToJSON(raw bool) ([]byte, error) {
-	dump := struct {
-		Entries []json.RawMessage
-	}{}
-
-	p.Walk(func(entry *model.ProcessCacheEntry) {
-		var (
-			d   []byte
-			err error
-		)
-
-		if raw {
-			d, err = json.Marshal(entry)
-		} else {
-			e := struct {
-				PID             uint32
-				PPID            uint32
-				Path            string
-				Inode           uint64
-				MountID         uint32
-				Source          string
-				ExecInode       uint64
-				IsExec          bool
-				IsParentMissing bool
-				CGroup          string
-				ContainerID     string
-			}{
-				PID:             entry.Pid,
-				PPID:            entry.PPid,
-				Path:            entry.FileEvent.PathnameStr,
-				Inode:           entry.FileEvent.Inode,
-				MountID:         entry.FileEvent.MountID,
-				Source:          model.ProcessSourceToString(entry.Source),
-				ExecInode:       entry.ExecInode,
-				IsExec:          entry.IsExec,
-				IsParentMissing: entry.IsParentMissing,
-				CGroup:          string(entry.CGroup.CGroupID),
-				ContainerID:     string(entry.ContainerID),
-			}
-
-			d, err = json.Marshal(e)
-		}
-
-		if err == nil {
-			dump.Entries = append(dump.Entries, d)
-		}
-	})
-
-	return json.Marshal(dump)
-}
-
-func (p *EBPFResolver) toDot(writer io.Writer, entry *model.ProcessCacheEntry, already map[string]bool, withArgs bool) {
-	for entry != nil {
-		label := fmt.Sprintf("%s:%d", entry.Comm, entry.Pid)
-		if _, exists := already[label]; !exists {
-			if !entry.ExitTime.IsZero() {
-				label = "[" + label + "]"
-			}
-
-			if withArgs {
-				argv, _ := p.GetProcessArgvScrubbed(&entry.Process)
-				fmt.Fprintf(writer, `"%d:%s" [label="%s", comment="%s"];`, entry.Pid, entry.Comm, label, strings.Join(argv, " "))
-			} else {
-				fmt.Fprintf(writer, `"%d:%s" [label="%s"];`, entry.Pid, entry.Comm, label)
-			}
-			fmt.Fprintln(writer)
+// pushToKernelMaps inserts the entry in the kernel maps
+func (p *EBPFResolver) pushToKernelMaps(entry *model.ProcessCacheEntry) error {
+	if p.procCacheMap == nil || p.pidCacheMap == nil {
+		return errors.New("kernel maps not available")
+	}
 
-			already[label] = true
-		}
+	bootTime := p.timeResolver.GetBootTime()
 
-		if entry.Ancestor != nil {
-			relation := fmt.Sprintf(`"%d:%s" -> "%d:%s";`, entry.Ancestor.Pid, entry.Ancestor.Comm, entry.Pid, entry.Comm)
-			if _, exists := already[relation]; !exists {
-				fmt.Fprintln(writer, relation)
+	var errs []error
 
-				already[relation] = true
-			}
-		}
+	procCacheEntryB := make([]byte, 248)
+	if _, err := entry.Process.MarshalProcCache(procCacheEntryB, bootTime); err != nil {
+		errs = append(errs, fmt.Errorf("couldn't marshal proc_cache entry: %w", err))
+	} else if err = p.procCacheMap.Put(entry.Cookie, procCacheEntryB); err != nil {
+		errs = append(errs, fmt.Errorf("couldn't push proc_cache entry to kernel space: %w", err))
+	}
 
-		entry = entry.Ancestor
+	pidCacheEntryB := make([]byte, 120)
+	if _, err := entry.Process.MarshalPidCache(pidCacheEntryB, bootTime); err != nil {
+		errs = append(errs, fmt.Errorf("couldn't marshal pid_cache entry: %w", err))
+	} else if err = p.pidCacheMap.Put(entry.Pid, pidCacheEntryB); err != nil {
+		errs = append(errs, fmt.Errorf("couldn't push pid_cache entry to kernel space: %w", err))
 	}
+
+	return errors.Join(errs...)
 }
 
-// ToDot create a temp file and dump the cache
-func (p *EBPFResolver) ToDot(withArgs bool) (string, error) {
-	dump, err := os.CreateTemp("/tmp", "process-cache-dump-")
-	if err != nil {
-		return "", err
+// ToJSON return a json version of the cache, following the CacheDump schema
+func (p *EBPFResolver) ToJSON(raw bool) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := p.WriteJSON(&buf, "", raw, CacheDumpSchemaVersion); err != nil {
+		return nil, err
 	}
+	return buf.Bytes(), nil
+}
 
-	defer dump.Close()
+// WriteJSON writes a json version of the cache, following the CacheDump schema of the provided version. Only
+// CacheDumpSchemaVersion and LegacyCacheDumpSchemaVersion, for the readers that predate the versioned schema, can be
+// written. The entries are collected under the resolver lock, then encoded one at a time once it is released so that
+// writing to a slow destination doesn't block the resolver. When a container ID is provided, only the processes of
+// this container are written.
+func (p *EBPFResolver) WriteJSON(w io.Writer, containerID containerutils.ContainerID, raw bool, schemaVersion int) error {
+	var (
+		entries []any
+		header  string
+	)
 
-	if err := os.Chmod(dump.Name(), 0400); err != nil {
-		return "", err
+	switch schemaVersion {
+	case CacheDumpSchemaVersion:
+		header = fmt.Sprintf(`{"schema_version":%d,"entries":[`, CacheDumpSchemaVersion)
+	case LegacyCacheDumpSchemaVersion:
+		header = `{"Entries":[`
+	default:
+		return fmt.Errorf("unsupported process cache dump schema version %d", schemaVersion)
 	}
 
 	p.RLock()
-	defer p.RUnlock()
+	p.rangeEntries(containerID, func(entry *model.ProcessCacheEntry) bool {
+		if schemaVersion == LegacyCacheDumpSchemaVersion {
+			if e, err := newLegacyCacheDumpEntry(entry, raw); err == nil {
+				entries = append(entries, e)
+			}
+		} else if e, err := newCacheDumpEntry(entry, raw); err == nil {
+			entries = append(entries, e)
+		}
+		return true
+	})
+	p.RUnlock()
 
-	fmt.Fprintf(dump, "digraph ProcessTree {\n")
+	bw := bufio.NewWriterSize(w, dumpBufferSize)
+	encoder := json.NewEncoder(bw)
 
-	already := make(map[string]bool)
-	for _, entry := range p.entryCache {
-		p.toDot(dump, entry, already, withArgs)
+	if _, err := bw.WriteString(header); err != nil {
+		return err
 	}
 
-	fmt.Fprintf(dump, `}`)
+	for i, e := range entries {
+		if i > 0 {
+			if err := bw.WriteByte(','); err != nil {
+				return err
+			}
+		}
+		if err := encoder.Encode(e); err != nil {
+			return err
+		}
+	}
 
-	if err = dump.Close(); err != nil {
-		return "", fmt.Errorf("could not close file [%s]: %w", dump.Name(), err)
+	if _, err := bw.WriteString("]}"); err != nil {
+		return err
 	}
-	return dump.Name(), nil
+	return bw.Flush()
 }
 
 // getCacheSize returns the cache size of the process resolver
 func (p *EBPFResolver) getCacheSize() float64 {
-	p.RLock()
-	defer p.RUnlock()
-	return float64(len(p.entryCache))
+	return float64(p.entryCache.Len())
 }
 
 // getEntryCacheSize returns the cache size of the process resolver
@@ -1439,14 +2074,56 @@ func (p *EBPFResolver) SetState(state int64) {
 	p.state.Store(state)
 }
 
+// IsSnapshotted returns whether the snapshot of the processes is done
+func (p *EBPFResolver) IsSnapshotted() bool {
+	return p.state.Load() == Snapshotted
+}
+
+// getWorkloadSelector returns the workload selector of the container
+func (p *EBPFResolver) getWorkloadSelector(containerID containerutils.ContainerID) (cgroupModel.WorkloadSelector, bool) {
+	if p.cgroupResolver == nil {
+		return cgroupModel.WorkloadSelector{}, false
+	}
+
+	workload, found := p.cgroupResolver.GetWorkload(string(containerID))
+	if !found {
+		return cgroupModel.WorkloadSelector{}, false
+	}
+
+	return *workload.GetWorkloadSelectorCopy(), true
+}
+
 // Walk iterates through the entire tree and call the provided callback on each entry
 func (p *EBPFResolver) Walk(callback func(entry *model.ProcessCacheEntry)) {
 	p.RLock()
 	defer p.RUnlock()
 
-	for _, entry := range p.entryCache {
+	p.entryCache.Range(func(_ uint32, entry *model.ProcessCacheEntry) bool {
 		callback(entry)
-	}
+		return true
+	})
+}
+
+// WalkContainer iterates through the processes of the container and call the provided callback on each entry
+func (p *EBPFResolver) WalkContainer(containerID containerutils.ContainerID, callback func(entry *model.ProcessCacheEntry)) {
+	p.RLock()
+	defer p.RUnlock()
+
+	p.rangeEntries(containerID, func(entry *model.ProcessCacheEntry) bool {
+		callback(entry)
+		return true
+	})
+}
+
+// rangeEntries calls f on the entries of the cache, or on the entries of the container when a container ID is
+// provided, until f returns false. The caller must hold the resolver lock.
+func (p *EBPFResolver) rangeEntries(containerID containerutils.ContainerID, f func(entry *model.ProcessCacheEntry) bool) {
+	p.entryCache.Range(func(_ uint32, entry *model.ProcessCacheEntry) bool {
+		if containerID != "" && entry.ContainerID != containerID {
+			return true
+		}
+		return f(entry)
+	})
 }
 
 // NewEBPFResolver returns a new process resolver
@@ -1454,7 +2131,31 @@ func NewEBPFResolver(manager *manager.Manager, config *config.Config, statsdClie
 	scrubber *procutil.DataScrubber, containerResolver *container.Resolver, mountResolver mount.ResolverInterface,
 	cgroupResolver *cgroup.Resolver, userGroupResolver *usergroup.Resolver, timeResolver *stime.Resolver,
 	pathResolver spath.ResolverInterface, envVarsResolver *envvars.Resolver, opts *ResolverOpts) (*EBPFResolver, error) {
-	argsEnvsCache, err := simplelru.NewLRU[uint64, *argsEnvsCacheEntry](maxParallelArgsEnvs, nil)
+	argsEnvsCacheSize := opts.argsEnvsCacheSize
+	if argsEnvsCacheSize <= 0 {
+		argsEnvsCacheSize = maxParallelArgsEnvs
+	}
+
+	// the memory of the args and envs is released when they are attached to their process or evicted
+	memoryUsage := atomic.NewInt64(0)
+	argsEnvsCache, err := simplelru.NewLRU[uint64, *argsEnvsCacheEntry](argsEnvsCacheSize, func(_ uint64, entry *argsEnvsCacheEntry) {
+		memoryUsage.Sub(argsEnvsMemorySize(entry))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	valueCacheSize := opts.argsEnvsValueCacheSize
+	if valueCacheSize <= 0 {
+		valueCacheSize = argsEnvsValueCacheSize
+	}
+
+	argsEnvsValuesEvicted := atomic.NewInt64(0)
+	argsEnvsInterner := utils.NewLRUStringInternerWithEvictCallback(valueCacheSize, func(_ string) {
+		argsEnvsValuesEvicted.Inc()
+	})
+
+	elfMetadata, err := newELFMetadataResolver()
 	if err != nil {
 		return nil, err
 	}
@@ -1464,13 +2165,18 @@ func NewEBPFResolver(manager *manager.Manager, config *config.Config, statsdClie
 		config:                    config,
 		statsdClient:              statsdClient,
 		scrubber:                  scrubber,
-		entryCache:                make(map[uint32]*model.ProcessCacheEntry),
+		entryCache:                newShardedEntryCache(opts.entryCacheKind, opts.entryCacheShards),
 		opts:                      *opts,
+		envsWithValue:             newEnvsWithValueSet(opts.envsWithValue),
 		argsEnvsCache:             argsEnvsCache,
+		elfMetadata:               elfMetadata,
+		argsEnvsInterner:          argsEnvsInterner,
 		state:                     atomic.NewInt64(Snapshotting),
 		hitsStats:                 map[string]*atomic.Int64{},
 		cacheSize:                 atomic.NewInt64(0),
 		missStats:                 atomic.NewInt64(0),
+		totalHits:                 atomic.NewInt64(0),
+		totalMisses:               atomic.NewInt64(0),
 		addedEntriesFromEvent:     atomic.NewInt64(0),
 		addedEntriesFromKernelMap: atomic.NewInt64(0),
 		addedEntriesFromProcFS:    atomic.NewInt64(0),
@@ -1478,10 +2184,29 @@ func NewEBPFResolver(manager *manager.Manager, config *config.Config, statsdClie
 		pathErrStats:              atomic.NewInt64(0),
 		argsTruncated:             atomic.NewInt64(0),
 		argsSize:                  atomic.NewInt64(0),
+		argsLost:                  atomic.NewInt64(0),
+		argsEnvsEvicted:           atomic.NewInt64(0),
+		argsEnvsValuesEvicted:     argsEnvsValuesEvicted,
+		argsProcfsFallback:        atomic.NewInt64(0),
 		envsTruncated:             atomic.NewInt64(0),
 		envsSize:                  atomic.NewInt64(0),
+		envsLost:                  atomic.NewInt64(0),
+		envsChanged:               atomic.NewInt64(0),
 		brokenLineage:             atomic.NewInt64(0),
+		lineageRepaired:           atomic.NewInt64(0),
 		inodeErrStats:             atomic.NewInt64(0),
+		procfsDropped:             atomic.NewInt64(0),
+		evictedExited:             atomic.NewInt64(0),
+		evictedLeaves:             atomic.NewInt64(0),
+		memoryUsage:               memoryUsage,
+		shedExited:                atomic.NewInt64(0),
+		shedLeaves:                atomic.NewInt64(0),
+		cookieCollisions:          atomic.NewInt64(0),
+		cookies:                   newCookieIndex(),
+		subscribers:               newProcessTreeSubscribers(),
+		reconciliationStats:       newReconciliationStats(),
+		exitedQueue:               newExitedQueue(exitedQueueSize),
+		retainedExits:             newRetainedExits(exitedQueueSize),
 		containerResolver:         containerResolver,
 		mountResolver:             mountResolver,
 		cgroupResolver:            cgroupResolver,
@@ -1493,14 +2218,30 @@ func NewEBPFResolver(manager *manager.Manager, config *config.Config, statsdClie
 	for _, t := range metrics.AllTypesTags {
 		p.hitsStats[t] = atomic.NewInt64(0)
 	}
-	p.processCacheEntryPool = NewProcessCacheEntryPool(func() { p.cacheSize.Dec() })
+	p.processCacheEntryPool = NewProcessCacheEntryPool(func(pce *model.ProcessCacheEntry) {
+		p.cacheSize.Dec()
+		p.releaseEntryMemory(pce)
+		p.cookies.remove(pce)
+	})
 
-	// Create rate limiter that allows for 128 pids
-	limiter, err := utils.NewLimiter[uint32](128, numAllowedPIDsToResolvePerPeriod, procFallbackLimiterPeriod)
+	limiter, err := newProcfsFallbackLimiter(opts.procfsFallbackMaxResolutions, opts.procfsFallbackPeriod, opts.procfsFallbackOverrides, p.getWorkloadSelector)
 	if err != nil {
 		return nil, err
 	}
 	p.procFallbackLimiter = limiter
 
+	if opts.procfsWorkers > 0 {
+		p.procfsRequests = make(chan uint32, opts.procfsQueueSize)
+		p.procfsPending = make(map[uint32]struct{}, opts.procfsQueueSize)
+	}
+
+	if opts.lineageRepair {
+		p.lineageRepairs = newLineageRepairQueue(lineageRepairQueueSize)
+	}
+
+	if opts.telemetry != nil {
+		p.telemetry = newResolverTelemetry(opts.telemetry)
+	}
+
 	return p, nil
 }
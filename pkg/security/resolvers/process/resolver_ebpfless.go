@@ -21,11 +21,14 @@ import (
 
 	"github.com/DataDog/datadog-go/v5/statsd"
 
+	pkgconfigsetup "github.com/DataDog/datadog-agent/pkg/config/setup"
 	"github.com/DataDog/datadog-agent/pkg/process/procutil"
 	"github.com/DataDog/datadog-agent/pkg/security/metrics"
 	"github.com/DataDog/datadog-agent/pkg/security/probe/config"
 	"github.com/DataDog/datadog-agent/pkg/security/secl/containerutils"
 	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/rules"
+	"github.com/DataDog/datadog-agent/pkg/security/seclog"
 )
 
 // CacheResolverKey is used to store and retrieve processes from the cache
@@ -37,10 +40,12 @@ type CacheResolverKey struct {
 // EBPFLessResolver defines a resolver
 type EBPFLessResolver struct {
 	sync.RWMutex
-	entryCache   map[CacheResolverKey]*model.ProcessCacheEntry
-	opts         ResolverOpts
-	scrubber     *procutil.DataScrubber
-	statsdClient statsd.ClientInterface
+	entryCache    map[CacheResolverKey]*model.ProcessCacheEntry
+	opts          ResolverOpts
+	envsWithValue *envsWithValueSet
+	argsScrubbing argsScrubbingRules
+	scrubber      *procutil.DataScrubber
+	statsdClient  statsd.ClientInterface
 
 	// stats
 	cacheSize *atomic.Int64
@@ -51,14 +56,15 @@ type EBPFLessResolver struct {
 // NewEBPFLessResolver returns a new process resolver
 func NewEBPFLessResolver(_ *config.Config, statsdClient statsd.ClientInterface, scrubber *procutil.DataScrubber, opts *ResolverOpts) (*EBPFLessResolver, error) {
 	p := &EBPFLessResolver{
-		entryCache:   make(map[CacheResolverKey]*model.ProcessCacheEntry),
-		opts:         *opts,
-		scrubber:     scrubber,
-		cacheSize:    atomic.NewInt64(0),
-		statsdClient: statsdClient,
+		entryCache:    make(map[CacheResolverKey]*model.ProcessCacheEntry),
+		opts:          *opts,
+		envsWithValue: newEnvsWithValueSet(opts.envsWithValue),
+		scrubber:      scrubber,
+		cacheSize:     atomic.NewInt64(0),
+		statsdClient:  statsdClient,
 	}
 
-	p.processCacheEntryPool = NewProcessCacheEntryPool(func() { p.cacheSize.Dec() })
+	p.processCacheEntryPool = NewProcessCacheEntryPool(func(_ *model.ProcessCacheEntry) { p.cacheSize.Dec() })
 
 	return p, nil
 }
@@ -327,21 +333,30 @@ func (p *EBPFLessResolver) Dump(_ bool) (string, error) {
 	return "", errors.New("not supported")
 }
 
-// GetProcessArgvScrubbed returns the scrubbed args of the event as an array
+// GetProcessArgvScrubbed returns the scrubbed args of the event as an array. The args of the entry are left untouched,
+// the scrubbed ones being kept apart.
 func (p *EBPFLessResolver) GetProcessArgvScrubbed(pr *model.Process) ([]string, bool) {
-	if pr.ArgsEntry == nil || pr.ScrubbedArgvResolved {
+	if pr.ArgsEntry == nil {
 		return pr.Argv, pr.ArgsTruncated
 	}
 
-	if p.scrubber != nil && len(pr.ArgsEntry.Values) > 0 {
-		// replace with the scrubbed version
-		argv, _ := p.scrubber.ScrubCommand(pr.ArgsEntry.Values[1:])
-		pr.ArgsEntry.Values = []string{pr.ArgsEntry.Values[0]}
-		pr.ArgsEntry.Values = append(pr.ArgsEntry.Values, argv...)
+	if !pr.ScrubbedArgvResolved {
+		pr.ArgvScrubbed = nil
+		if len(pr.ArgsEntry.Values) > 0 {
+			argv, replacement := pr.ArgsEntry.Values[1:], pkgconfigsetup.DefaultScrubbingReplacement
+			if p.scrubber != nil {
+				argv, _ = p.scrubber.ScrubCommand(argv)
+				replacement = p.scrubber.Replacement
+			}
+
+			// the rules of the policies apply on top of the global scrubber
+			pr.ArgvScrubbed = p.argsScrubbing.scrub(pr.FileEvent.PathnameStr, argv, replacement)
+		}
+		pr.ScrubbedArgvResolved = true
 	}
-	pr.ScrubbedArgvResolved = true
+	pr.ArgsTruncated = pr.ArgsTruncated || pr.ArgsEntry.Truncated
 
-	return GetProcessArgv(pr)
+	return pr.ArgvScrubbed, pr.ArgsTruncated
 }
 
 // GetProcessEnvs returns the envs of the event
@@ -350,12 +365,26 @@ func (p *EBPFLessResolver) GetProcessEnvs(pr *model.Process) ([]string, bool) {
 		return pr.Envs, pr.EnvsTruncated
 	}
 
-	keys, truncated := pr.EnvsEntry.FilterEnvs(p.opts.envsWithValue)
+	keys, truncated := pr.EnvsEntry.FilterEnvsFunc(p.envsWithValue.get().keepValue)
 	pr.Envs = keys
 	pr.EnvsTruncated = pr.EnvsTruncated || truncated
 	return pr.Envs, pr.EnvsTruncated
 }
 
+// SetPolicyArgsScrubbing sets the scrubbing rules of the process arguments requested by the loaded policies. They
+// apply on top of the global scrubber.
+func (p *EBPFLessResolver) SetPolicyArgsScrubbing(defs []rules.ArgsScrubbingDefinition) {
+	p.argsScrubbing.set(defs)
+}
+
+// SetPolicyEnvsWithValue sets the environment variables whose value is kept at the request of the loaded policies,
+// in addition to the configured ones
+func (p *EBPFLessResolver) SetPolicyEnvsWithValue(envs []string) {
+	if p.envsWithValue.setPolicyEnvs(envs) {
+		seclog.Infof("environment variables with value: %s", strings.Join(p.envsWithValue.list(), ", "))
+	}
+}
+
 // GetProcessEnvp returns the unscrubbed envs of the event with their values. Use with caution.
 func (p *EBPFLessResolver) GetProcessEnvp(pr *model.Process) ([]string, bool) {
 	if pr.EnvsEntry == nil {
@@ -9,8 +9,11 @@ package process
 import (
 	"path"
 	"strings"
+	"syscall"
 
+	"github.com/DataDog/datadog-agent/pkg/security/secl/containerutils"
 	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
+	"github.com/DataDog/datadog-agent/pkg/security/utils"
 )
 
 const memfdPrefix = "memfd:"
@@ -66,3 +69,121 @@ func GetProcessArgv0(pr *model.Process) (string, bool) {
 	pr.ArgsTruncated = pr.ArgsTruncated || pr.ArgsEntry.Truncated
 	return pr.Argv0, pr.ArgsTruncated
 }
+
+// GetProcessCmdLineObfuscationScore returns the obfuscation score of the command line of the process. The score is
+// computed once per args entry, from the unscrubbed arguments.
+func GetProcessCmdLineObfuscationScore(pr *model.Process) int {
+	if pr.ArgsEntry == nil {
+		return pr.CmdLineObfuscationScore
+	}
+
+	if !pr.ArgsEntry.ObfuscationScoreResolved {
+		argv0, _ := GetProcessArgv0(pr)
+		argv, _ := GetProcessArgv(pr)
+		pr.ArgsEntry.ObfuscationScore = utils.CmdLineObfuscationScore(argv0, argv)
+		pr.ArgsEntry.ObfuscationScoreResolved = true
+	}
+	pr.CmdLineObfuscationScore = pr.ArgsEntry.ObfuscationScore
+
+	return pr.CmdLineObfuscationScore
+}
+
+// SetProcessSystemdUnit resolves the systemd unit of the process, and the slice it is nested in, from the path of its
+// cgroup when the unit wasn't resolved from /proc. The unit isn't inherited from the parent, as the processes started by
+// systemd are forked from its own scope before being moved to the cgroup of their unit.
+func SetProcessSystemdUnit(pr *model.Process) {
+	if pr.SystemdUnit != "" || pr.CGroup.CGroupID == "" {
+		return
+	}
+	pr.SystemdUnit, pr.SystemdSlice = containerutils.GetSystemdUnitFromCgroup(string(pr.CGroup.CGroupID))
+}
+
+// maxShebangCandidates bounds the number of arguments of a process checked for a script starting with a `#!` line
+const maxShebangCandidates = 4
+
+// IsShebangInterpreter returns whether the process interprets a script whose `#!` line declares the executable of the
+// process as interpreter. The script is looked for among the first arguments of the process, then on its standard
+// input, either a regular file or a pipe depending on the version of the shell passing the script with a heredoc.
+func IsShebangInterpreter(pr *model.Process, args []string) bool {
+	if pr.FileEvent.PathnameStr == "" {
+		return false
+	}
+
+	var candidates []string
+	for _, arg := range args[min(len(args), 1):] {
+		if len(candidates) == maxShebangCandidates {
+			break
+		}
+		if arg == "" || arg[0] == '-' {
+			continue
+		}
+
+		if path.IsAbs(arg) {
+			candidates = append(candidates, utils.ProcRootFilePath(pr.Pid, arg))
+		} else {
+			candidates = append(candidates, utils.ProcCwdFilePath(pr.Pid, arg))
+		}
+	}
+	candidates = append(candidates, utils.ProcFDPath(pr.Pid, 0))
+
+	for _, candidate := range candidates {
+		shebang, err := utils.ReadShebang(candidate)
+		if err != nil {
+			continue
+		}
+
+		if isShebangOf(pr, shebang) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isShebangOf returns whether the interpreter declared by a `#!` line is the executable of the process, either the
+// same file or a version of the same program, python3.11 for python3 for example
+func isShebangOf(pr *model.Process, shebang utils.Shebang) bool {
+	if path.IsAbs(shebang.Interpreter) && pr.FileEvent.Inode != 0 {
+		var stat syscall.Stat_t
+		if err := syscall.Stat(utils.ProcRootFilePath(pr.Pid, shebang.Interpreter), &stat); err == nil && stat.Ino == pr.FileEvent.Inode {
+			return true
+		}
+	}
+
+	name, exeName := shebang.Name(), path.Base(pr.FileEvent.PathnameStr)
+	if !strings.HasPrefix(exeName, name) {
+		return false
+	}
+
+	suffix := exeName[len(name):]
+	return suffix == "" || suffix[0] == '.' || (suffix[0] >= '0' && suffix[0] <= '9')
+}
+
+// SetProcessNamespaces resolves, once per process entry, the inode numbers of the pid, user, mount and uts namespaces
+// of the process from /proc. It is only used for the entries read from /proc and without eBPF, the kernel events carry
+// the namespaces otherwise. The namespaces inherited from the parent are kept for the entries that exited and for the
+// namespaces that can't be read.
+func SetProcessNamespaces(pr *model.Process) {
+	if pr.NamespacesResolved {
+		return
+	}
+	pr.NamespacesResolved = true
+
+	if !pr.ExitTime.IsZero() {
+		return
+	}
+
+	for _, ns := range []struct {
+		nsType string
+		inode  *uint32
+	}{
+		{nsType: "pid", inode: &pr.PIDNamespace},
+		{nsType: "user", inode: &pr.UserNamespace},
+		{nsType: "mnt", inode: &pr.MountNamespace},
+		{nsType: "uts", inode: &pr.UTSNamespace},
+	} {
+		if inode, err := utils.GetProcessNamespace(pr.Pid, ns.nsType); err == nil {
+			*ns.inode = inode
+		}
+	}
+}
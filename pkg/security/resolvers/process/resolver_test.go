@@ -9,14 +9,21 @@
 package process
 
 import (
+	"encoding/binary"
 	"fmt"
+	"os"
+	"path/filepath"
+	"syscall"
 	"testing"
 	"time"
 
 	"github.com/avast/retry-go/v4"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/DataDog/datadog-agent/pkg/process/procutil"
+	"github.com/DataDog/datadog-agent/pkg/security/proto/api"
 	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/rules"
 	"github.com/DataDog/datadog-go/v5/statsd"
 )
 
@@ -48,26 +55,26 @@ func TestFork1st(t *testing.T) {
 
 	// parent
 	resolver.AddForkEntry(parent, 0, nil)
-	assert.Equal(t, parent, resolver.entryCache[parent.Pid])
-	assert.Equal(t, 1, len(resolver.entryCache))
+	assert.Equal(t, parent, resolver.entryCache.Get(parent.Pid))
+	assert.Equal(t, 1, resolver.entryCache.Len())
 	assert.EqualValues(t, 1, resolver.cacheSize.Load())
 
 	// parent
 	//     \ child
 	resolver.AddForkEntry(child, 0, nil)
-	assert.Equal(t, child, resolver.entryCache[child.Pid])
-	assert.Equal(t, 2, len(resolver.entryCache))
+	assert.Equal(t, child, resolver.entryCache.Get(child.Pid))
+	assert.Equal(t, 2, resolver.entryCache.Len())
 	assert.Equal(t, parent, child.Ancestor)
 	assert.EqualValues(t, 2, resolver.cacheSize.Load())
 
 	// parent
 	resolver.DeleteEntry(child.Pid, time.Now())
-	assert.Nil(t, resolver.entryCache[child.Pid])
-	assert.Equal(t, 1, len(resolver.entryCache))
+	assert.Nil(t, resolver.entryCache.Get(child.Pid))
+	assert.Equal(t, 1, resolver.entryCache.Len())
 
 	// nothing
 	resolver.DeleteEntry(parent.Pid, time.Now())
-	assert.Zero(t, len(resolver.entryCache))
+	assert.Zero(t, resolver.entryCache.Len())
 
 	testCacheSize(t, resolver)
 }
@@ -87,28 +94,28 @@ func TestFork2nd(t *testing.T) {
 
 	// parent
 	resolver.AddForkEntry(parent, 0, nil)
-	assert.Equal(t, parent, resolver.entryCache[parent.Pid])
-	assert.Equal(t, 1, len(resolver.entryCache))
+	assert.Equal(t, parent, resolver.entryCache.Get(parent.Pid))
+	assert.Equal(t, 1, resolver.entryCache.Len())
 	assert.EqualValues(t, 1, resolver.cacheSize.Load())
 
 	// parent
 	//     \ child
 	resolver.AddForkEntry(child, 0, nil)
-	assert.Equal(t, child, resolver.entryCache[child.Pid])
-	assert.Equal(t, 2, len(resolver.entryCache))
+	assert.Equal(t, child, resolver.entryCache.Get(child.Pid))
+	assert.Equal(t, 2, resolver.entryCache.Len())
 	assert.Equal(t, parent, child.Ancestor)
 	assert.EqualValues(t, 2, resolver.cacheSize.Load())
 
 	// [parent]
 	//     \ child
 	resolver.DeleteEntry(parent.Pid, time.Now())
-	assert.Nil(t, resolver.entryCache[parent.Pid])
-	assert.Equal(t, 1, len(resolver.entryCache))
+	assert.Nil(t, resolver.entryCache.Get(parent.Pid))
+	assert.Equal(t, 1, resolver.entryCache.Len())
 	assert.Equal(t, parent, child.Ancestor)
 
 	// nothing
 	resolver.DeleteEntry(child.Pid, time.Now())
-	assert.Zero(t, len(resolver.entryCache))
+	assert.Zero(t, resolver.entryCache.Len())
 
 	testCacheSize(t, resolver)
 }
@@ -133,23 +140,23 @@ func TestForkExec(t *testing.T) {
 
 	// parent
 	resolver.AddForkEntry(parent, 0, nil)
-	assert.Equal(t, parent, resolver.entryCache[parent.Pid])
-	assert.Equal(t, 1, len(resolver.entryCache))
+	assert.Equal(t, parent, resolver.entryCache.Get(parent.Pid))
+	assert.Equal(t, 1, resolver.entryCache.Len())
 	assert.EqualValues(t, 1, resolver.cacheSize.Load())
 
 	// parent
 	//     \ child
 	resolver.AddForkEntry(child, 0, nil)
-	assert.Equal(t, child, resolver.entryCache[child.Pid])
-	assert.Equal(t, 2, len(resolver.entryCache))
+	assert.Equal(t, child, resolver.entryCache.Get(child.Pid))
+	assert.Equal(t, 2, resolver.entryCache.Len())
 	assert.Equal(t, parent, child.Ancestor)
 	assert.EqualValues(t, 2, resolver.cacheSize.Load())
 
 	// parent
 	//     \ [child] -> exec
 	resolver.AddExecEntry(exec, 0)
-	assert.Equal(t, exec, resolver.entryCache[exec.Pid])
-	assert.Equal(t, 2, len(resolver.entryCache))
+	assert.Equal(t, exec, resolver.entryCache.Get(exec.Pid))
+	assert.Equal(t, 2, resolver.entryCache.Len())
 	assert.Equal(t, child, exec.Ancestor)
 	assert.Equal(t, parent, exec.Ancestor.Ancestor)
 	assert.EqualValues(t, 3, resolver.cacheSize.Load())
@@ -157,14 +164,14 @@ func TestForkExec(t *testing.T) {
 	// [parent]
 	//     \ [child] -> exec
 	resolver.DeleteEntry(parent.Pid, time.Now())
-	assert.Nil(t, resolver.entryCache[parent.Pid])
-	assert.Equal(t, 1, len(resolver.entryCache))
+	assert.Nil(t, resolver.entryCache.Get(parent.Pid))
+	assert.Equal(t, 1, resolver.entryCache.Len())
 	assert.Equal(t, child, exec.Ancestor)
 	assert.Equal(t, parent, exec.Ancestor.Ancestor)
 
 	// nothing
 	resolver.DeleteEntry(exec.Pid, time.Now())
-	assert.Zero(t, len(resolver.entryCache))
+	assert.Zero(t, resolver.entryCache.Len())
 
 	testCacheSize(t, resolver)
 }
@@ -190,37 +197,37 @@ func TestOrphanExec(t *testing.T) {
 
 	// parent
 	resolver.AddForkEntry(parent, 0, nil)
-	assert.Equal(t, parent, resolver.entryCache[parent.Pid])
-	assert.Equal(t, 1, len(resolver.entryCache))
+	assert.Equal(t, parent, resolver.entryCache.Get(parent.Pid))
+	assert.Equal(t, 1, resolver.entryCache.Len())
 	assert.EqualValues(t, 1, resolver.cacheSize.Load())
 
 	// parent
 	//     \ child
 	resolver.AddForkEntry(child, 0, nil)
-	assert.Equal(t, child, resolver.entryCache[child.Pid])
-	assert.Equal(t, 2, len(resolver.entryCache))
+	assert.Equal(t, child, resolver.entryCache.Get(child.Pid))
+	assert.Equal(t, 2, resolver.entryCache.Len())
 	assert.Equal(t, parent, child.Ancestor)
 	assert.EqualValues(t, 2, resolver.cacheSize.Load())
 
 	// [parent]
 	//     \ child
 	resolver.DeleteEntry(parent.Pid, time.Now())
-	assert.Nil(t, resolver.entryCache[parent.Pid])
-	assert.Equal(t, 1, len(resolver.entryCache))
+	assert.Nil(t, resolver.entryCache.Get(parent.Pid))
+	assert.Equal(t, 1, resolver.entryCache.Len())
 	assert.Equal(t, parent, child.Ancestor)
 
 	// [parent]
 	//     \ [child] -> exec
 	resolver.AddExecEntry(exec, 0)
-	assert.Equal(t, exec, resolver.entryCache[exec.Pid])
-	assert.Equal(t, 1, len(resolver.entryCache))
+	assert.Equal(t, exec, resolver.entryCache.Get(exec.Pid))
+	assert.Equal(t, 1, resolver.entryCache.Len())
 	assert.Equal(t, child, exec.Ancestor)
 	assert.Equal(t, parent, exec.Ancestor.Ancestor)
 	assert.EqualValues(t, 3, resolver.cacheSize.Load())
 
 	// nothing
 	resolver.DeleteEntry(exec.Pid, time.Now())
-	assert.Zero(t, len(resolver.entryCache))
+	assert.Zero(t, resolver.entryCache.Len())
 
 	testCacheSize(t, resolver)
 }
@@ -251,30 +258,30 @@ func TestForkExecExec(t *testing.T) {
 
 	// parent
 	resolver.AddForkEntry(parent, 0, nil)
-	assert.Equal(t, parent, resolver.entryCache[parent.Pid])
-	assert.Equal(t, 1, len(resolver.entryCache))
+	assert.Equal(t, parent, resolver.entryCache.Get(parent.Pid))
+	assert.Equal(t, 1, resolver.entryCache.Len())
 	assert.EqualValues(t, 1, resolver.cacheSize.Load())
 
 	// parent
 	//     \ child
 	resolver.AddForkEntry(child, 0, nil)
-	assert.Equal(t, child, resolver.entryCache[child.Pid])
-	assert.Equal(t, 2, len(resolver.entryCache))
+	assert.Equal(t, child, resolver.entryCache.Get(child.Pid))
+	assert.Equal(t, 2, resolver.entryCache.Len())
 	assert.Equal(t, parent, child.Ancestor)
 	assert.EqualValues(t, 2, resolver.cacheSize.Load())
 
 	// [parent]
 	//     \ child
 	resolver.DeleteEntry(parent.Pid, time.Now())
-	assert.Nil(t, resolver.entryCache[parent.Pid])
-	assert.Equal(t, 1, len(resolver.entryCache))
+	assert.Nil(t, resolver.entryCache.Get(parent.Pid))
+	assert.Equal(t, 1, resolver.entryCache.Len())
 	assert.Equal(t, parent, child.Ancestor)
 
 	// [parent]
 	//     \ [child] -> exec1
 	resolver.AddExecEntry(exec1, 0)
-	assert.Equal(t, exec1, resolver.entryCache[exec1.Pid])
-	assert.Equal(t, 1, len(resolver.entryCache))
+	assert.Equal(t, exec1, resolver.entryCache.Get(exec1.Pid))
+	assert.Equal(t, 1, resolver.entryCache.Len())
 	assert.Equal(t, child, exec1.Ancestor)
 	assert.Equal(t, parent, exec1.Ancestor.Ancestor)
 	assert.EqualValues(t, 3, resolver.cacheSize.Load())
@@ -282,8 +289,8 @@ func TestForkExecExec(t *testing.T) {
 	// [parent]
 	//     \ [child] -> [exec1] -> exec2
 	resolver.AddExecEntry(exec2, 0)
-	assert.Equal(t, exec2, resolver.entryCache[exec2.Pid])
-	assert.Equal(t, 1, len(resolver.entryCache))
+	assert.Equal(t, exec2, resolver.entryCache.Get(exec2.Pid))
+	assert.Equal(t, 1, resolver.entryCache.Len())
 	assert.Equal(t, exec1, exec2.Ancestor)
 	assert.Equal(t, child, exec2.Ancestor.Ancestor)
 	assert.Equal(t, parent, exec2.Ancestor.Ancestor.Ancestor)
@@ -291,7 +298,7 @@ func TestForkExecExec(t *testing.T) {
 
 	// nothing
 	resolver.DeleteEntry(exec2.Pid, time.Now())
-	assert.Zero(t, len(resolver.entryCache))
+	assert.Zero(t, resolver.entryCache.Len())
 
 	testCacheSize(t, resolver)
 }
@@ -323,30 +330,30 @@ func TestForkReuse(t *testing.T) {
 
 	// parent1
 	resolver.AddForkEntry(parent1, 0, nil)
-	assert.Equal(t, parent1, resolver.entryCache[parent1.Pid])
-	assert.Equal(t, 1, len(resolver.entryCache))
+	assert.Equal(t, parent1, resolver.entryCache.Get(parent1.Pid))
+	assert.Equal(t, 1, resolver.entryCache.Len())
 	assert.EqualValues(t, 1, resolver.cacheSize.Load())
 
 	// parent1
 	//     \ child1
 	resolver.AddForkEntry(child1, 0, nil)
-	assert.Equal(t, child1, resolver.entryCache[child1.Pid])
-	assert.Equal(t, 2, len(resolver.entryCache))
+	assert.Equal(t, child1, resolver.entryCache.Get(child1.Pid))
+	assert.Equal(t, 2, resolver.entryCache.Len())
 	assert.Equal(t, parent1, child1.Ancestor)
 	assert.EqualValues(t, 2, resolver.cacheSize.Load())
 
 	// [parent1]
 	//     \ child1
 	resolver.DeleteEntry(parent1.Pid, time.Now())
-	assert.Nil(t, resolver.entryCache[parent1.Pid])
-	assert.Equal(t, 1, len(resolver.entryCache))
+	assert.Nil(t, resolver.entryCache.Get(parent1.Pid))
+	assert.Equal(t, 1, resolver.entryCache.Len())
 	assert.Equal(t, parent1, child1.Ancestor)
 
 	// [parent1]
 	//     \ [child1] -> exec1
 	resolver.AddExecEntry(exec1, 0)
-	assert.Equal(t, exec1, resolver.entryCache[exec1.Pid])
-	assert.Equal(t, 1, len(resolver.entryCache))
+	assert.Equal(t, exec1, resolver.entryCache.Get(exec1.Pid))
+	assert.Equal(t, 1, resolver.entryCache.Len())
 	assert.Equal(t, child1, exec1.Ancestor)
 	assert.Equal(t, parent1, exec1.Ancestor.Ancestor)
 	assert.EqualValues(t, 3, resolver.cacheSize.Load())
@@ -356,8 +363,8 @@ func TestForkReuse(t *testing.T) {
 	//
 	// parent2:pid1
 	resolver.AddForkEntry(parent2, 0, nil)
-	assert.Equal(t, parent2, resolver.entryCache[parent2.Pid])
-	assert.Equal(t, 2, len(resolver.entryCache))
+	assert.Equal(t, parent2, resolver.entryCache.Get(parent2.Pid))
+	assert.Equal(t, 2, resolver.entryCache.Len())
 	assert.EqualValues(t, 4, resolver.cacheSize.Load())
 
 	// [parent1:pid1]
@@ -366,27 +373,27 @@ func TestForkReuse(t *testing.T) {
 	// parent2:pid1
 	//     \ child2
 	resolver.AddForkEntry(child2, 0, nil)
-	assert.Equal(t, child2, resolver.entryCache[child2.Pid])
-	assert.Equal(t, 3, len(resolver.entryCache))
+	assert.Equal(t, child2, resolver.entryCache.Get(child2.Pid))
+	assert.Equal(t, 3, resolver.entryCache.Len())
 	assert.Equal(t, parent2, child2.Ancestor)
 	assert.EqualValues(t, 5, resolver.cacheSize.Load())
 
 	// parent2:pid1
 	//     \ child2
 	resolver.DeleteEntry(exec1.Pid, time.Now())
-	assert.Nil(t, resolver.entryCache[exec1.Pid])
-	assert.Equal(t, 2, len(resolver.entryCache))
+	assert.Nil(t, resolver.entryCache.Get(exec1.Pid))
+	assert.Equal(t, 2, resolver.entryCache.Len())
 
 	// [parent2:pid1]
 	//     \ child2
 	resolver.DeleteEntry(parent2.Pid, time.Now())
-	assert.Nil(t, resolver.entryCache[parent2.Pid])
-	assert.Equal(t, 1, len(resolver.entryCache))
+	assert.Nil(t, resolver.entryCache.Get(parent2.Pid))
+	assert.Equal(t, 1, resolver.entryCache.Len())
 	assert.Equal(t, parent2, child2.Ancestor)
 
 	// nothing
 	resolver.DeleteEntry(child2.Pid, time.Now())
-	assert.Zero(t, len(resolver.entryCache))
+	assert.Zero(t, resolver.entryCache.Len())
 
 	testCacheSize(t, resolver)
 }
@@ -416,22 +423,22 @@ func TestForkForkExec(t *testing.T) {
 
 	// parent
 	resolver.AddForkEntry(parent, 0, nil)
-	assert.Equal(t, parent, resolver.entryCache[parent.Pid])
-	assert.Equal(t, 1, len(resolver.entryCache))
+	assert.Equal(t, parent, resolver.entryCache.Get(parent.Pid))
+	assert.Equal(t, 1, resolver.entryCache.Len())
 
 	// parent
 	//     \ child
 	resolver.AddForkEntry(child, 0, nil)
-	assert.Equal(t, child, resolver.entryCache[child.Pid])
-	assert.Equal(t, 2, len(resolver.entryCache))
+	assert.Equal(t, child, resolver.entryCache.Get(child.Pid))
+	assert.Equal(t, 2, resolver.entryCache.Len())
 	assert.Equal(t, parent, child.Ancestor)
 
 	// parent
 	//     \ child
 	//          \ grandChild
 	resolver.AddForkEntry(grandChild, 0, nil)
-	assert.Equal(t, grandChild, resolver.entryCache[grandChild.Pid])
-	assert.Equal(t, 3, len(resolver.entryCache))
+	assert.Equal(t, grandChild, resolver.entryCache.Get(grandChild.Pid))
+	assert.Equal(t, 3, resolver.entryCache.Len())
 	assert.Equal(t, child, grandChild.Ancestor)
 	assert.Equal(t, parent, grandChild.Ancestor.Ancestor)
 
@@ -439,8 +446,8 @@ func TestForkForkExec(t *testing.T) {
 	//     \ [child] -> childExec
 	//          \ grandChild
 	resolver.AddExecEntry(childExec, 0)
-	assert.Equal(t, childExec, resolver.entryCache[childExec.Pid])
-	assert.Equal(t, 3, len(resolver.entryCache))
+	assert.Equal(t, childExec, resolver.entryCache.Get(childExec.Pid))
+	assert.Equal(t, 3, resolver.entryCache.Len())
 	assert.Equal(t, child, childExec.Ancestor)
 	assert.Equal(t, parent, childExec.Ancestor.Ancestor)
 	assert.Equal(t, child, grandChild.Ancestor)
@@ -450,19 +457,19 @@ func TestForkForkExec(t *testing.T) {
 	//     \ [child] -> childExec
 	//          \ grandChild
 	resolver.DeleteEntry(parent.Pid, time.Now())
-	assert.Nil(t, resolver.entryCache[parent.Pid])
-	assert.Equal(t, 2, len(resolver.entryCache))
+	assert.Nil(t, resolver.entryCache.Get(parent.Pid))
+	assert.Equal(t, 2, resolver.entryCache.Len())
 
 	// [parent]
 	//     \ [child]
 	//          \ grandChild
 	resolver.DeleteEntry(childExec.Pid, time.Now())
-	assert.Nil(t, resolver.entryCache[childExec.Pid])
-	assert.Equal(t, 1, len(resolver.entryCache))
+	assert.Nil(t, resolver.entryCache.Get(childExec.Pid))
+	assert.Equal(t, 1, resolver.entryCache.Len())
 
 	// nothing
 	resolver.DeleteEntry(grandChild.Pid, time.Now())
-	assert.Zero(t, len(resolver.entryCache))
+	assert.Zero(t, resolver.entryCache.Len())
 
 	testCacheSize(t, resolver)
 }
@@ -493,30 +500,30 @@ func TestExecBomb(t *testing.T) {
 
 	// parent
 	resolver.AddForkEntry(parent, 0, nil)
-	assert.Equal(t, parent, resolver.entryCache[parent.Pid])
-	assert.Equal(t, 1, len(resolver.entryCache))
+	assert.Equal(t, parent, resolver.entryCache.Get(parent.Pid))
+	assert.Equal(t, 1, resolver.entryCache.Len())
 	assert.EqualValues(t, 1, resolver.cacheSize.Load())
 
 	// parent
 	//     \ child
 	resolver.AddForkEntry(child, 0, nil)
-	assert.Equal(t, child, resolver.entryCache[child.Pid])
-	assert.Equal(t, 2, len(resolver.entryCache))
+	assert.Equal(t, child, resolver.entryCache.Get(child.Pid))
+	assert.Equal(t, 2, resolver.entryCache.Len())
 	assert.Equal(t, parent, child.Ancestor)
 	assert.EqualValues(t, 2, resolver.cacheSize.Load())
 
 	// [parent]
 	//     \ child
 	resolver.DeleteEntry(parent.Pid, time.Now())
-	assert.Nil(t, resolver.entryCache[parent.Pid])
-	assert.Equal(t, 1, len(resolver.entryCache))
+	assert.Nil(t, resolver.entryCache.Get(parent.Pid))
+	assert.Equal(t, 1, resolver.entryCache.Len())
 	assert.Equal(t, parent, child.Ancestor)
 
 	// [parent]
 	//     \ [child] -> exec1
 	resolver.AddExecEntry(exec1, 0)
-	assert.Equal(t, exec1, resolver.entryCache[exec1.Pid])
-	assert.Equal(t, 1, len(resolver.entryCache))
+	assert.Equal(t, exec1, resolver.entryCache.Get(exec1.Pid))
+	assert.Equal(t, 1, resolver.entryCache.Len())
 	assert.Equal(t, child, exec1.Ancestor)
 	assert.Equal(t, parent, exec1.Ancestor.Ancestor)
 	assert.EqualValues(t, 3, resolver.cacheSize.Load())
@@ -524,14 +531,14 @@ func TestExecBomb(t *testing.T) {
 	// [parent]
 	//     \ [child] -> [exec1] -> exec2
 	resolver.AddExecEntry(exec2, 0)
-	assert.Equal(t, exec1, resolver.entryCache[exec2.Pid])
-	assert.Equal(t, 1, len(resolver.entryCache))
+	assert.Equal(t, exec1, resolver.entryCache.Get(exec2.Pid))
+	assert.Equal(t, 1, resolver.entryCache.Len())
 	assert.Equal(t, exec1.ExecTime, exec2.ExecTime)
 	assert.EqualValues(t, 3, resolver.cacheSize.Load())
 
 	// nothing
 	resolver.DeleteEntry(exec1.Pid, time.Now())
-	assert.Zero(t, len(resolver.entryCache))
+	assert.Zero(t, resolver.entryCache.Len())
 
 	testCacheSize(t, resolver)
 }
@@ -693,7 +700,7 @@ func TestIsExecExecSnapshot(t *testing.T) {
 	parent.FileEvent.Inode = 1
 
 	// parent
-	resolver.insertEntry(parent, nil, model.ProcessCacheEntryFromSnapshot)
+	resolver.insertEntry(parent, nil, model.ProcessCacheEntryFromSnapshot, ProcessTreeInsert, time.Now())
 
 	child := resolver.NewProcessCacheEntry(model.PIDContext{Pid: 2, Tid: 2})
 	child.PPid = parent.Pid
@@ -703,7 +710,7 @@ func TestIsExecExecSnapshot(t *testing.T) {
 	//     \ child
 
 	resolver.setAncestor(child)
-	resolver.insertEntry(child, nil, model.ProcessCacheEntryFromSnapshot)
+	resolver.insertEntry(child, nil, model.ProcessCacheEntryFromSnapshot, ProcessTreeInsert, time.Now())
 
 	assert.False(t, parent.IsExecExec)
 	assert.False(t, parent.IsExec)
@@ -731,3 +738,980 @@ func TestIsExecExecSnapshot(t *testing.T) {
 	assert.True(t, child3.IsExecExec)
 	assert.True(t, child3.IsExec)
 }
+
+func TestSweep(t *testing.T) {
+	resolver, err := NewEBPFResolver(nil, nil, &statsd.NoOpClient{}, nil, nil, nil, nil, nil, nil, nil, nil, NewResolverOpts())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for pid := uint32(1); pid <= 3; pid++ {
+		entry := resolver.NewProcessCacheEntry(model.PIDContext{Pid: pid, Tid: pid})
+		resolver.insertEntry(entry, nil, model.ProcessCacheEntryFromSnapshot, ProcessTreeInsert, time.Now())
+	}
+
+	procRoot := t.TempDir()
+	for _, name := range []string{"1", "3", "self", "sys"} {
+		assert.NoError(t, os.Mkdir(filepath.Join(procRoot, name), 0755))
+	}
+
+	assert.NoError(t, resolver.sweep(procRoot))
+	assert.Equal(t, int64(1), resolver.exitedQueue.depth.Load())
+
+	// entries without fork nor exec time are flushed right away
+	resolver.DequeueExited()
+	assert.Nil(t, resolver.entryCache.Get(2))
+	assert.Equal(t, 2, resolver.entryCache.Len())
+}
+
+func TestExitedRetention(t *testing.T) {
+	resolver, err := NewEBPFResolver(nil, nil, &statsd.NoOpClient{}, nil, nil, nil, nil, nil, nil, nil, nil, NewResolverOpts().WithExitedRetention(time.Hour, 10*time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now()
+	for pid, execTime := range map[uint32]time.Time{1: now.Add(-2 * time.Hour), 2: now.Add(-10 * time.Minute), 3: now.Add(-2 * time.Hour)} {
+		entry := resolver.NewProcessCacheEntry(model.PIDContext{Pid: pid, Tid: pid})
+		entry.ExecTime = execTime
+		resolver.insertEntry(entry, nil, model.ProcessCacheEntryFromSnapshot, ProcessTreeInsert, time.Now())
+	}
+
+	// pid 3 is referenced by an event
+	assert.NotNil(t, resolver.ResolveFromCache(3, 3, 0))
+	assert.False(t, resolver.entryCache.Get(3).LastReferenced.IsZero())
+
+	for pid := uint32(1); pid <= 3; pid++ {
+		resolver.exitedQueue.push(pid)
+	}
+	resolver.DequeueExited()
+
+	assert.Nil(t, resolver.entryCache.Get(1))
+	assert.NotNil(t, resolver.entryCache.Get(2))
+	assert.NotNil(t, resolver.entryCache.Get(3))
+}
+
+func TestExitedRetentionOnExitEvent(t *testing.T) {
+	resolver, err := NewEBPFResolver(nil, nil, &statsd.NoOpClient{}, nil, nil, nil, nil, nil, nil, nil, nil, NewResolverOpts().WithExitedRetention(time.Hour, 10*time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for pid := uint32(1); pid <= 3; pid++ {
+		entry := resolver.NewProcessCacheEntry(model.PIDContext{Pid: pid, Tid: pid})
+		entry.ExecTime = time.Now()
+		resolver.insertEntry(entry, nil, model.ProcessCacheEntryFromSnapshot, ProcessTreeInsert, time.Now())
+	}
+
+	// the exited entries are kept for the late events
+	exitTime := time.Now()
+	for pid := uint32(1); pid <= 3; pid++ {
+		resolver.DeleteEntry(pid, exitTime)
+	}
+	assert.NotNil(t, resolver.entryCache.Get(1))
+	assert.Equal(t, exitTime, resolver.entryCache.Get(1).ExitTime)
+
+	// a late event references pid 2 after its exit, and pid 3 is reused by a new process
+	resolver.entryCache.Get(2).LastReferenced = time.Now().Add(5 * time.Minute)
+	reused := resolver.NewProcessCacheEntry(model.PIDContext{Pid: 3, Tid: 3})
+	reused.ForkTime = time.Now()
+	resolver.AddForkEntry(reused, 0, nil)
+	assert.Equal(t, reused, resolver.entryCache.Get(3))
+
+	resolver.flushRetainedExits(time.Now().Add(11 * time.Minute))
+	assert.Nil(t, resolver.entryCache.Get(1))
+	assert.NotNil(t, resolver.entryCache.Get(2))
+	assert.Equal(t, reused, resolver.entryCache.Get(3))
+
+	resolver.flushRetainedExits(time.Now().Add(16 * time.Minute))
+	assert.Nil(t, resolver.entryCache.Get(2))
+	assert.Equal(t, reused, resolver.entryCache.Get(3))
+
+	// without referenced retention, the entries are deleted on exit
+	resolver.opts.referencedRetention = 0
+	resolver.DeleteEntry(3, time.Now())
+	assert.Nil(t, resolver.entryCache.Get(3))
+}
+
+func TestEviction(t *testing.T) {
+	resolver, err := NewEBPFResolver(nil, nil, &statsd.NoOpClient{}, nil, nil, nil, nil, nil, nil, nil, nil, NewResolverOpts().WithMaxEntries(10))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now()
+
+	parent := resolver.NewProcessCacheEntry(model.PIDContext{Pid: 1, Tid: 1})
+	parent.ForkTime = now
+	resolver.AddForkEntry(parent, 0, nil)
+
+	for pid := uint32(2); pid <= 11; pid++ {
+		child := resolver.NewProcessCacheEntry(model.PIDContext{Pid: pid, Tid: pid})
+		child.PPid = parent.Pid
+		child.ForkTime = now.Add(time.Duration(pid) * time.Second)
+		resolver.AddForkEntry(child, 0, nil)
+	}
+
+	// the cache is shrunk to 90% of its maximum size, the oldest children being evicted while their parent is kept
+	assert.Equal(t, 9, resolver.entryCache.Len())
+	assert.Equal(t, int64(2), resolver.evictedLeaves.Load())
+	assert.NotNil(t, resolver.entryCache.Get(1))
+	assert.Nil(t, resolver.entryCache.Get(2))
+	assert.Nil(t, resolver.entryCache.Get(3))
+	assert.NotNil(t, resolver.entryCache.Get(11))
+}
+
+func TestEvictionLeastRecentlyUsed(t *testing.T) {
+	resolver, err := NewEBPFResolver(nil, nil, &statsd.NoOpClient{}, nil, nil, nil, nil, nil, nil, nil, nil, NewResolverOpts().WithMaxEntries(10))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	past := time.Now().Add(-time.Hour)
+
+	parent := resolver.NewProcessCacheEntry(model.PIDContext{Pid: 1, Tid: 1})
+	parent.ForkTime = past
+	resolver.AddForkEntry(parent, 0, nil)
+
+	for pid := uint32(2); pid <= 10; pid++ {
+		child := resolver.NewProcessCacheEntry(model.PIDContext{Pid: pid, Tid: pid})
+		child.PPid = parent.Pid
+		child.ForkTime = past.Add(time.Duration(pid) * time.Second)
+		resolver.AddForkEntry(child, 0, nil)
+	}
+
+	// the oldest child is referenced by an event
+	assert.NotNil(t, resolver.resolveFromCache(2, 2, 0))
+
+	child := resolver.NewProcessCacheEntry(model.PIDContext{Pid: 11, Tid: 11})
+	child.PPid = parent.Pid
+	child.ForkTime = past.Add(11 * time.Second)
+	resolver.AddForkEntry(child, 0, nil)
+
+	// the least recently used children are evicted, not the oldest one
+	assert.Equal(t, 9, resolver.entryCache.Len())
+	assert.NotNil(t, resolver.entryCache.Get(2))
+	assert.Nil(t, resolver.entryCache.Get(3))
+	assert.Nil(t, resolver.entryCache.Get(4))
+	assert.NotNil(t, resolver.entryCache.Get(11))
+}
+
+func TestMemoryBudget(t *testing.T) {
+	budget := 10*processCacheEntrySize + processCacheEntrySize/2
+	resolver, err := NewEBPFResolver(nil, nil, &statsd.NoOpClient{}, nil, nil, nil, nil, nil, nil, nil, nil, NewResolverOpts().WithMemoryBudget(budget))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now()
+
+	parent := resolver.NewProcessCacheEntry(model.PIDContext{Pid: 1, Tid: 1})
+	parent.ForkTime = now
+	resolver.AddForkEntry(parent, 0, nil)
+
+	for pid := uint32(2); pid <= 11; pid++ {
+		child := resolver.NewProcessCacheEntry(model.PIDContext{Pid: pid, Tid: pid})
+		child.PPid = parent.Pid
+		child.ForkTime = now.Add(time.Duration(pid) * time.Second)
+		if pid == 10 {
+			child.ExitTime = now.Add(time.Minute)
+		}
+		resolver.AddForkEntry(child, 0, nil)
+	}
+
+	// the memory is shrunk under 90% of the budget, the exited process being shed before the oldest child
+	assert.Equal(t, 9, resolver.entryCache.Len())
+	assert.Equal(t, int64(1), resolver.shedExited.Load())
+	assert.Equal(t, int64(1), resolver.shedLeaves.Load())
+	assert.Zero(t, resolver.evictedExited.Load()+resolver.evictedLeaves.Load())
+	assert.Nil(t, resolver.entryCache.Get(10))
+	assert.Nil(t, resolver.entryCache.Get(2))
+	assert.NotNil(t, resolver.entryCache.Get(1))
+	assert.NotNil(t, resolver.entryCache.Get(11))
+
+	// the usage tracked on insertion and release matches the one computed from the cache content
+	assert.Equal(t, 9*processCacheEntrySize, resolver.memoryUsage.Load())
+	assert.Equal(t, computeMemoryUsage(resolver), resolver.memoryUsage.Load())
+	assert.False(t, resolver.isOverMemoryBudget())
+
+	var exceeded []MemoryBudgetExceeded
+	resolver.SetMemoryBudgetExceededHandler(func(report MemoryBudgetExceeded) {
+		exceeded = append(exceeded, report)
+	})
+	assert.NoError(t, resolver.SendStats())
+	assert.Equal(t, []MemoryBudgetExceeded{{Budget: budget, Usage: 9 * processCacheEntrySize, ShedExited: 1, ShedLeaves: 1}}, exceeded)
+
+	// the handler is only called for the intervals during which entries were shed
+	assert.NoError(t, resolver.SendStats())
+	assert.Len(t, exceeded, 1)
+}
+
+func TestMemoryUsageArgsEnvs(t *testing.T) {
+	resolver, err := NewEBPFResolver(nil, nil, &statsd.NoOpClient{}, nil, nil, nil, nil, nil, nil, nil, nil, NewResolverOpts().WithArgsEnvsCache(1, true))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	newArgsEnvsEvent := func(id uint64, values ...string) *model.ArgsEnvsEvent {
+		event := &model.ArgsEnvsEvent{ArgsEnvs: model.ArgsEnvs{ID: id}}
+		for _, value := range values {
+			event.Size += uint32(copy(event.ValuesRaw[event.Size:], value)) + 1
+		}
+		return event
+	}
+
+	// the pending args are accounted until they are attached to their process, or evicted
+	resolver.UpdateArgsEnvs(newArgsEnvsEvent(1, "ls"))
+	resolver.UpdateArgsEnvs(newArgsEnvsEvent(1, "-l"))
+	assert.Equal(t, computeMemoryUsage(resolver), resolver.memoryUsage.Load())
+
+	resolver.UpdateArgsEnvs(newArgsEnvsEvent(2, "cat", "/etc/passwd"))
+	assert.Equal(t, int64(1), resolver.argsEnvsEvicted.Load())
+	assert.Equal(t, computeMemoryUsage(resolver), resolver.memoryUsage.Load())
+
+	entry := resolver.NewProcessCacheEntry(model.PIDContext{Pid: 1, Tid: 1})
+	entry.ArgsID = 2
+	resolver.SetProcessArgs(entry)
+	resolver.insertEntry(entry, nil, model.ProcessCacheEntryFromEvent, ProcessTreeInsert, time.Now())
+	assert.Equal(t, 0, resolver.argsEnvsCache.Len())
+	assert.Equal(t, computeMemoryUsage(resolver), resolver.memoryUsage.Load())
+
+	// the args replaced after the insertion are accounted, and released with the entry
+	entry.ArgsEntry = &model.ArgsEntry{Values: []string{"cat", "/etc/passwd", "/etc/group"}}
+	resolver.accountEntryMemory(entry)
+	assert.Equal(t, computeMemoryUsage(resolver), resolver.memoryUsage.Load())
+
+	// the args shared by the forks are accounted once, until the last of them is released
+	child := resolver.NewProcessCacheEntry(model.PIDContext{Pid: 2, Tid: 2})
+	child.PPid = 1
+	resolver.insertForkEntry(child, 0, model.ProcessCacheEntryFromEvent, nil)
+	assert.Same(t, entry.ArgsEntry, child.ArgsEntry)
+	assert.Equal(t, computeMemoryUsage(resolver), resolver.memoryUsage.Load())
+
+	resolver.deleteEntry(1, time.Now())
+	assert.Equal(t, computeMemoryUsage(resolver), resolver.memoryUsage.Load())
+	resolver.deleteEntry(2, time.Now())
+	assert.Zero(t, resolver.memoryUsage.Load())
+}
+
+// computeMemoryUsage returns the estimated memory used by the entries of the cache, their exited ancestors, and the
+// args and envs waiting for their exec event, computed from the cache content
+func computeMemoryUsage(p *EBPFResolver) int64 {
+	var usage int64
+
+	seen := make(map[*model.ProcessCacheEntry]struct{}, p.entryCache.Len())
+	seenArgsEnvs := make(map[any]struct{})
+	p.entryCache.Range(func(_ uint32, entry *model.ProcessCacheEntry) bool {
+		for ; entry != nil; entry = entry.Ancestor {
+			if _, found := seen[entry]; found {
+				break
+			}
+			seen[entry] = struct{}{}
+			usage += entryMemorySize(entry)
+
+			// the args and envs shared by several entries are accounted once
+			if args := entry.ArgsEntry; args != nil {
+				if _, found := seenArgsEnvs[args]; !found {
+					seenArgsEnvs[args] = struct{}{}
+					usage += stringsMemorySize(args.Values)
+				}
+			}
+			if envs := entry.EnvsEntry; envs != nil {
+				if _, found := seenArgsEnvs[envs]; !found {
+					seenArgsEnvs[envs] = struct{}{}
+					usage += stringsMemorySize(envs.Values)
+				}
+			}
+		}
+		return true
+	})
+
+	for _, id := range p.argsEnvsCache.Keys() {
+		if entry, found := p.argsEnvsCache.Peek(id); found {
+			usage += argsEnvsMemorySize(entry)
+		}
+	}
+
+	for _, snapshot := range p.envsSnapshots {
+		usage += stringsMemorySize(snapshot.values)
+	}
+
+	return usage
+}
+
+func TestReconcile(t *testing.T) {
+	resolver, err := NewEBPFResolver(nil, nil, &statsd.NoOpClient{}, nil, nil, nil, nil, nil, nil, nil, nil, NewResolverOpts())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for pid := uint32(1); pid <= 4; pid++ {
+		entry := resolver.NewProcessCacheEntry(model.PIDContext{Pid: pid, Tid: pid})
+		entry.Cookie = model.ProcessCookie{Lo: uint64(pid * 10)}
+		resolver.insertEntry(entry, nil, model.ProcessCacheEntryFromSnapshot, ProcessTreeInsert, time.Now())
+	}
+
+	// pid 4 isn't running anymore, pid 5 was never inserted in the user space cache
+	running := map[uint32]struct{}{1: {}, 2: {}, 3: {}, 5: {}}
+	kernelCookies := map[uint32]model.ProcessCookie{1: {Lo: 10}, 2: {Lo: 21}, 5: {Lo: 50}}
+
+	assert.Equal(t, map[uint32]cacheDrift{
+		2: {kind: driftCookieMismatch, cookie: model.ProcessCookie{Lo: 21}},
+		3: {kind: driftMissingInKernel, cookie: model.ProcessCookie{Lo: 30}},
+		5: {kind: driftMissingInCache, cookie: model.ProcessCookie{Lo: 50}},
+	}, resolver.findDrifts(running, kernelCookies))
+
+	resolver.reconcileWith(running, kernelCookies)
+	for _, kind := range allDriftKinds {
+		assert.Equal(t, int64(1), resolver.reconciliationStats.drifts[kind].Load(), kind)
+	}
+	assert.Len(t, resolver.reconciliationSuspects, 3)
+
+	// the kernel maps caught up with the exec of pid 2, the repairs of the other drifts fail without kernel maps
+	kernelCookies[2] = model.ProcessCookie{Lo: 20}
+	resolver.reconcileWith(running, kernelCookies)
+	assert.Equal(t, int64(1), resolver.reconciliationStats.drifts[driftCookieMismatch].Load())
+	assert.Equal(t, int64(2), resolver.reconciliationStats.drifts[driftMissingInKernel].Load())
+	assert.Equal(t, int64(0), resolver.reconciliationStats.repaired[driftMissingInKernel].Load())
+	assert.Len(t, resolver.reconciliationSuspects, 2)
+	assert.NotContains(t, resolver.reconciliationSuspects, uint32(2))
+
+	// kworkers aren't tracked by the kernel maps
+	resolver.entryCache.Get(3).IsKworker = true
+	assert.NotContains(t, resolver.findDrifts(running, kernelCookies), uint32(3))
+}
+
+func TestCookieCollisions(t *testing.T) {
+	resolver, err := NewEBPFResolver(nil, nil, &statsd.NoOpClient{}, nil, nil, nil, nil, nil, nil, nil, nil, NewResolverOpts())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cookie := model.ProcessCookie{Lo: 1, Hi: 2}
+	now := time.Now()
+
+	parent := resolver.NewProcessCacheEntry(model.PIDContext{Pid: 1, Tid: 1})
+	parent.Retain()
+	parent.Cookie = cookie
+	parent.ExecTime = now
+	parent.FileEvent.Inode = 10
+	resolver.indexCookie(parent, false)
+
+	// a fork resolved from the kernel maps shares the cookie of the execution of its parent
+	fork := resolver.NewProcessCacheEntry(model.PIDContext{Pid: 2, Tid: 2})
+	fork.Retain()
+	parent.Fork(fork)
+	resolver.indexCookie(fork, false)
+	assert.Zero(t, resolver.cookieCollisions.Load())
+
+	// another execution got the same cookie from the kernel
+	exec := resolver.NewProcessCacheEntry(model.PIDContext{Pid: 3, Tid: 3})
+	exec.Retain()
+	exec.Cookie = cookie
+	exec.ExecTime = now.Add(time.Second)
+	exec.FileEvent.Inode = 20
+	resolver.indexCookie(exec, false)
+	assert.Equal(t, int64(1), resolver.cookieCollisions.Load())
+	assert.Equal(t, cookie, exec.Cookie)
+
+	// the cookies generated in user space are replaced
+	snapshot := resolver.NewProcessCacheEntry(model.PIDContext{Pid: 4, Tid: 4})
+	snapshot.Retain()
+	snapshot.Cookie = cookie
+	snapshot.FileEvent.Inode = 30
+	resolver.indexCookie(snapshot, true)
+	assert.Equal(t, int64(2), resolver.cookieCollisions.Load())
+	assert.NotEqual(t, cookie, snapshot.Cookie)
+
+	// the cookie remains indexed by the first execution, and leaves the index with it
+	assert.Same(t, parent, resolver.cookies.entries[cookie])
+	snapshotCookie := snapshot.Cookie
+	snapshot.Release()
+	exec.Release()
+	assert.Contains(t, resolver.cookies.entries, cookie)
+	assert.NotContains(t, resolver.cookies.entries, snapshotCookie)
+	fork.Release()
+	parent.Release()
+	assert.NotContains(t, resolver.cookies.entries, cookie)
+}
+
+func TestProcessTreeSubscription(t *testing.T) {
+	resolver, err := NewEBPFResolver(nil, nil, &statsd.NoOpClient{}, nil, nil, nil, nil, nil, nil, nil, nil, NewResolverOpts())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	subscription := resolver.SubscribeProcessTree(3)
+
+	now := time.Now()
+
+	parent := resolver.NewProcessCacheEntry(model.PIDContext{Pid: 1, Tid: 1})
+	parent.ForkTime = now
+	resolver.AddForkEntry(parent, 0, nil)
+
+	child := resolver.NewProcessCacheEntry(model.PIDContext{Pid: 2, Tid: 2})
+	child.PPid = parent.Pid
+	child.ForkTime = now.Add(time.Second)
+	resolver.AddForkEntry(child, 0, nil)
+
+	exec := resolver.NewProcessCacheEntry(model.PIDContext{Pid: 2, Tid: 2})
+	exec.PPid = parent.Pid
+	exec.FileEvent.Inode = 42
+	exec.FileEvent.PathnameStr = "/usr/bin/ls"
+	exec.ExecTime = now.Add(2 * time.Second)
+	resolver.AddExecEntry(exec, 0)
+
+	// the subscriber doesn't keep up, the exit is dropped
+	resolver.DeleteEntry(2, now.Add(3*time.Second))
+	assert.Equal(t, int64(1), subscription.Dropped())
+	assert.Equal(t, int64(1), resolver.subscribers.dropped.Load())
+
+	var updates []ProcessTreeUpdate
+	for i := 0; i < 3; i++ {
+		updates = append(updates, <-subscription.Updates())
+	}
+	assert.Equal(t, ProcessTreeFork, updates[0].Type)
+	assert.Equal(t, uint32(1), updates[0].Pid)
+	assert.Equal(t, ProcessTreeFork, updates[1].Type)
+	assert.Equal(t, uint32(1), updates[1].PPid)
+	assert.Equal(t, ProcessTreeExec, updates[2].Type)
+	assert.Equal(t, "/usr/bin/ls", updates[2].Pathname)
+	assert.Equal(t, now.Add(2*time.Second), updates[2].Time)
+
+	resolver.DeleteEntry(1, now.Add(4*time.Second))
+	update := <-subscription.Updates()
+	assert.Equal(t, ProcessTreeExit, update.Type)
+	assert.Equal(t, uint32(1), update.Pid)
+
+	// the entries inserted from procfs and the evicted ones are notified too
+	snapshotted := resolver.NewProcessCacheEntry(model.PIDContext{Pid: 3, Tid: 3})
+	snapshotted.PPid = 1
+	resolver.InsertSnapshotEntry(snapshotted)
+	update = <-subscription.Updates()
+	assert.Equal(t, ProcessTreeInsert, update.Type)
+	assert.Equal(t, uint32(3), update.Pid)
+	assert.Equal(t, uint64(model.ProcessCacheEntryFromSnapshot), update.Source)
+
+	resolver.Lock()
+	resolver.evictEntry(snapshotted)
+	resolver.Unlock()
+	update = <-subscription.Updates()
+	assert.Equal(t, ProcessTreeEvict, update.Type)
+	assert.Equal(t, uint32(3), update.Pid)
+
+	// the channel is closed once the subscription is cancelled
+	resolver.UnsubscribeProcessTree(subscription)
+	_, open := <-subscription.Updates()
+	assert.False(t, open)
+	resolver.UnsubscribeProcessTree(subscription)
+
+	// a new subscription starts with the processes of the cache
+	running := resolver.NewProcessCacheEntry(model.PIDContext{Pid: 4, Tid: 4})
+	running.ForkTime = now
+	resolver.AddForkEntry(running, 0, nil)
+
+	subscription = resolver.SubscribeProcessTree(0)
+	defer resolver.UnsubscribeProcessTree(subscription)
+	if assert.Len(t, subscription.Updates(), 1) {
+		update = <-subscription.Updates()
+		assert.Equal(t, ProcessTreeInsert, update.Type)
+		assert.Equal(t, uint32(4), update.Pid)
+	}
+}
+
+func TestExitedQueue(t *testing.T) {
+	queue := newExitedQueue(4)
+
+	for pid := uint32(1); pid <= 5; pid++ {
+		queue.push(pid)
+	}
+	assert.Equal(t, int64(4), queue.depth.Load())
+	assert.Equal(t, int64(1), queue.dropped.Load())
+
+	// the queue is drained incrementally, oldest first
+	assert.Equal(t, []uint32{1, 2, 3}, queue.pop(3))
+	assert.True(t, queue.push(6))
+	assert.Equal(t, []uint32{4, 6}, queue.pop(3))
+	assert.Nil(t, queue.pop(3))
+	assert.Equal(t, int64(0), queue.depth.Load())
+}
+
+func TestProcfsWorkersQueue(t *testing.T) {
+	resolver, err := NewEBPFResolver(nil, nil, &statsd.NoOpClient{}, nil, nil, nil, nil, nil, nil, nil, nil, NewResolverOpts().WithProcfsWorkers(1, 2))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the workers aren't started, resolutions past the size of the queue are dropped without blocking
+	resolver.Lock()
+	for pid := uint32(1); pid <= 3; pid++ {
+		resolver.queueProcfsResolution(pid)
+	}
+	// a pid already queued isn't queued twice
+	resolver.queueProcfsResolution(1)
+	resolver.Unlock()
+	assert.Equal(t, 2, len(resolver.procfsRequests))
+	assert.Equal(t, int64(1), resolver.procfsDropped.Load())
+	assert.True(t, resolver.IsProcfsResolutionPending(1))
+	assert.True(t, resolver.IsProcfsResolutionPending(2))
+	assert.False(t, resolver.IsProcfsResolutionPending(3))
+
+	// a pid already in the cache isn't resolved again
+	entry := resolver.NewProcessCacheEntry(model.PIDContext{Pid: 1, Tid: 1})
+	resolver.insertEntry(entry, nil, model.ProcessCacheEntryFromSnapshot, ProcessTreeInsert, time.Now())
+
+	resolver.resolveFromProcfsInWorker(<-resolver.procfsRequests, 0)
+	assert.Same(t, entry, resolver.entryCache.Get(1))
+	assert.Equal(t, 1, resolver.entryCache.Len())
+}
+
+func TestLineageRepair(t *testing.T) {
+	resolver, err := NewEBPFResolver(nil, nil, &statsd.NoOpClient{}, nil, nil, nil, nil, nil, nil, nil, nil, NewResolverOpts().WithLineageRepair())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pid1 := resolver.NewProcessCacheEntry(model.PIDContext{Pid: 1, Tid: 1})
+	resolver.insertEntry(pid1, nil, model.ProcessCacheEntryFromSnapshot, ProcessTreeInsert, time.Now())
+
+	parent := resolver.NewProcessCacheEntry(model.PIDContext{Pid: 10, Tid: 10})
+	parent.PPid = 1
+	parent.SetForkParent(pid1)
+	resolver.insertEntry(parent, nil, model.ProcessCacheEntryFromSnapshot, ProcessTreeInsert, time.Now())
+
+	// the parent of the child was missing when its event was received
+	child := resolver.NewProcessCacheEntry(model.PIDContext{Pid: 11, Tid: 11})
+	child.PPid = 10
+	child.IsParentMissing = true
+	resolver.insertEntry(child, nil, model.ProcessCacheEntryFromEvent, ProcessTreeInsert, time.Now())
+
+	valid, _ := child.HasValidLineage()
+	assert.False(t, valid)
+
+	// a lineage is queued once until its repair is attempted
+	resolver.state.Store(Snapshotted)
+	resolver.QueueLineageRepair(child)
+	resolver.QueueLineageRepair(child)
+	assert.Equal(t, 1, len(resolver.lineageRepairs.requests))
+
+	pid := <-resolver.lineageRepairs.requests
+	repair := resolver.findLineageRepair(pid)
+	assert.NotNil(t, repair)
+	resolver.lineageRepairs.done(pid, repair)
+
+	// the entries are only updated once the repair is applied from the event path
+	assert.Nil(t, child.Ancestor)
+	assert.True(t, child.IsParentMissing)
+	valid, _ = child.HasValidLineage()
+	assert.False(t, valid)
+
+	resolver.ApplyLineageRepairs()
+	assert.Same(t, parent, child.Ancestor)
+	assert.False(t, child.IsParentMissing)
+	assert.Equal(t, int64(1), resolver.lineageRepaired.Load())
+
+	valid, err = child.HasValidLineage()
+	assert.True(t, valid)
+	assert.NoError(t, err)
+
+	// a valid lineage isn't repaired again
+	assert.Nil(t, resolver.findLineageRepair(child.Pid))
+
+	// the parent pid was reused after the fork of the orphan, the process using it isn't its parent
+	reused := resolver.NewProcessCacheEntry(model.PIDContext{Pid: 20, Tid: 20})
+	reused.PPid = 1
+	reused.StartBootTime = 5 * uint64(time.Second)
+	reused.SetForkParent(pid1)
+	resolver.insertEntry(reused, nil, model.ProcessCacheEntryFromSnapshot, ProcessTreeInsert, time.Now())
+
+	orphan := resolver.NewProcessCacheEntry(model.PIDContext{Pid: 21, Tid: 21})
+	orphan.PPid = 20
+	orphan.StartBootTime = 4 * uint64(time.Second)
+	orphan.IsParentMissing = true
+	resolver.insertEntry(orphan, nil, model.ProcessCacheEntryFromEvent, ProcessTreeInsert, time.Now())
+
+	assert.Nil(t, resolver.findLineageRepair(orphan.Pid))
+	assert.Nil(t, orphan.Ancestor)
+}
+
+func TestLookupInfo(t *testing.T) {
+	resolver, err := NewEBPFResolver(nil, nil, &statsd.NoOpClient{}, procutil.NewDefaultDataScrubber(), nil, nil, nil, nil, nil, nil, nil, NewResolverOpts())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, found := resolver.LookupInfo(1)
+	assert.False(t, found)
+
+	entry := resolver.NewProcessCacheEntry(model.PIDContext{Pid: 1, Tid: 1})
+	entry.ContainerID = "0123456789abcdef"
+	entry.ArgsEntry = &model.ArgsEntry{Values: []string{"mysql", "-u", "root", "--password=secret"}}
+	entry.EnvsEntry = &model.EnvsEntry{Values: []string{"DD_SERVICE=billing", "PATH=/bin"}}
+	entry.ForkTime = time.Now().Add(-time.Minute)
+	resolver.insertEntry(entry, nil, model.ProcessCacheEntryFromSnapshot, ProcessTreeInsert, time.Now())
+
+	info, found := resolver.LookupInfo(1)
+	assert.True(t, found)
+	assert.Equal(t, uint32(1), info.Pid)
+	assert.Equal(t, "0123456789abcdef", info.ContainerID)
+	assert.Equal(t, "billing", info.Service)
+	assert.NotZero(t, info.CmdLineHash)
+	// the processes that didn't execute a binary since the snapshot are dated from their fork
+	assert.Equal(t, entry.ForkTime, info.ExecTime)
+	assert.True(t, info.ExitTime.IsZero())
+
+	// the lookup only reads the attributes snapshotted at the insertion, the args of the entry aren't scrubbed
+	assert.Equal(t, []string{"mysql", "-u", "root", "--password=secret"}, entry.ArgsEntry.Values)
+
+	// the hash is computed on the scrubbed command line, it doesn't change once the args are scrubbed, the args of
+	// the entry being left untouched
+	argv, _ := resolver.GetProcessArgvScrubbed(&entry.Process)
+	assert.Equal(t, []string{"-u", "root", "--password=********"}, argv)
+	assert.Equal(t, []string{"mysql", "-u", "root", "--password=secret"}, entry.ArgsEntry.Values)
+
+	scrubbed, _ := resolver.LookupInfo(1)
+	assert.Equal(t, info.CmdLineHash, scrubbed.CmdLineHash)
+}
+
+func TestQueryCache(t *testing.T) {
+	resolver, err := NewEBPFResolver(nil, nil, &statsd.NoOpClient{}, procutil.NewDefaultDataScrubber(), nil, nil, nil, nil, nil, nil, nil, NewResolverOpts())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parent := resolver.NewProcessCacheEntry(model.PIDContext{Pid: 1, Tid: 1})
+	parent.FileEvent.PathnameStr = "/usr/bin/containerd-shim"
+	parent.ForkTime = time.Now()
+	resolver.AddForkEntry(parent, 0, nil)
+
+	for pid := uint32(2); pid <= 4; pid++ {
+		child := resolver.NewProcessCacheEntry(model.PIDContext{Pid: pid, Tid: pid})
+		child.PPid = parent.Pid
+		child.ForkTime = time.Now()
+		resolver.AddForkEntry(child, 0, nil)
+
+		exec := resolver.NewProcessCacheEntry(model.PIDContext{Pid: pid, Tid: pid})
+		exec.PPid = parent.Pid
+		exec.ContainerID = "0123456789abcdef"
+		exec.FileEvent.PathnameStr = "/usr/sbin/mysqld"
+		exec.ArgsEntry = &model.ArgsEntry{Values: []string{"mysql", "-u", "root", "--password=secret"}}
+		exec.EnvsEntry = &model.EnvsEntry{Values: []string{"DD_SERVICE=billing", "PATH=/bin"}}
+		exec.Credentials.UID = 999
+		exec.Credentials.User = "mysql"
+		exec.ExecTime = time.Now()
+		resolver.AddExecEntry(exec, 0)
+	}
+
+	t.Run("pid", func(t *testing.T) {
+		msg := resolver.QueryCache(&api.ProcessCacheQueryParams{Pid: 2})
+		assert.Empty(t, msg.Error)
+		if assert.Len(t, msg.Entries, 1) {
+			entry := msg.Entries[0]
+			assert.Equal(t, uint32(2), entry.Pid)
+			assert.Equal(t, "/usr/sbin/mysqld", entry.Path)
+			assert.Equal(t, []string{"mysql", "-u", "root", "--password=********"}, entry.Args)
+			assert.Equal(t, []string{"DD_SERVICE", "PATH"}, entry.EnvKeys)
+			assert.Equal(t, "mysql", entry.Credentials.User)
+			assert.Equal(t, uint32(999), entry.Credentials.UID)
+			assert.NotZero(t, entry.ExecTime)
+			// the forked process, then its parent
+			if assert.Len(t, entry.Lineage, 2) {
+				assert.Equal(t, uint32(2), entry.Lineage[0].Pid)
+				assert.Equal(t, uint32(1), entry.Lineage[1].Pid)
+				assert.Equal(t, "/usr/bin/containerd-shim", entry.Lineage[1].Path)
+			}
+		}
+
+		// the query doesn't scrub the cached args
+		assert.Equal(t, "--password=secret", resolver.entryCache.Get(2).ArgsEntry.Values[3])
+	})
+
+	t.Run("container", func(t *testing.T) {
+		msg := resolver.QueryCache(&api.ProcessCacheQueryParams{ContainerID: "0123456789abcdef"})
+		assert.Len(t, msg.Entries, 3)
+		assert.False(t, msg.Truncated)
+
+		msg = resolver.QueryCache(&api.ProcessCacheQueryParams{ContainerID: "0123456789abcdef", Pid: 1})
+		assert.Empty(t, msg.Entries)
+	})
+
+	t.Run("path", func(t *testing.T) {
+		msg := resolver.QueryCache(&api.ProcessCacheQueryParams{Path: "/usr/bin/*"})
+		if assert.Len(t, msg.Entries, 1) {
+			assert.Equal(t, uint32(1), msg.Entries[0].Pid)
+		}
+
+		msg = resolver.QueryCache(&api.ProcessCacheQueryParams{Path: "/usr/[bin"})
+		assert.NotEmpty(t, msg.Error)
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		msg := resolver.QueryCache(&api.ProcessCacheQueryParams{Limit: 2})
+		assert.Len(t, msg.Entries, 2)
+		assert.True(t, msg.Truncated)
+	})
+}
+
+func TestEnvsWithValuePolicies(t *testing.T) {
+	envs := newEnvsWithValueSet(map[string]bool{"LD_PRELOAD": true})
+
+	assert.True(t, envs.setPolicyEnvs([]string{"PATH", "SHELL"}))
+	assert.Equal(t, []string{"LD_PRELOAD", "PATH", "SHELL"}, envs.list())
+
+	// loading the same policies doesn't change the set
+	assert.False(t, envs.setPolicyEnvs([]string{"SHELL", "PATH"}))
+
+	// the variables of the unloaded policies are dropped, the configured ones are kept
+	assert.True(t, envs.setPolicyEnvs(nil))
+	assert.Equal(t, []string{"LD_PRELOAD"}, envs.list())
+}
+
+func TestEnvsWithValuePatterns(t *testing.T) {
+	filter := newEnvsWithValueFilter([]string{"PATH", "DD_*", "!*_TOKEN", "/^AWS_[A-Z]+_REGION$/", "!HOME", "/[invalid/"})
+	assert.Equal(t, []string{"!*_TOKEN", "!HOME", "/^AWS_[A-Z]+_REGION$/", "DD_*", "PATH"}, filter.patterns)
+
+	for name, expected := range map[string]bool{
+		"PATH":               true,
+		"PATHEXT":            false,
+		"DD_SERVICE":         true,
+		"DD_API_TOKEN":       false,
+		"GITHUB_TOKEN":       false,
+		"AWS_DEFAULT_REGION": true,
+		"AWS_REGION":         false,
+		"HOME":               false,
+	} {
+		assert.Equal(t, expected, filter.keepValue(name), name)
+	}
+
+	entry := &model.EnvsEntry{Values: []string{"PATH=/bin", "DD_ENV=prod", "DD_API_TOKEN=secret", "NOVALUE"}}
+	envs, _ := entry.FilterEnvsFunc(filter.keepValue)
+	assert.Equal(t, []string{"PATH=/bin", "DD_ENV=prod", "DD_API_TOKEN", "NOVALUE"}, envs)
+}
+
+func TestArgsScrubbingPolicies(t *testing.T) {
+	resolver, err := NewEBPFResolver(nil, nil, &statsd.NoOpClient{}, procutil.NewDefaultDataScrubber(), nil, nil, nil, nil, nil, nil, nil, NewResolverOpts())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resolver.SetPolicyArgsScrubbing([]rules.ArgsScrubbingDefinition{
+		{Path: "/usr/bin/mysql*", DropArgs: true},
+		{Path: "/usr/**/curl", DropArgs: true},
+		{Args: []string{"--api-key=*", "-k"}},
+	})
+
+	newProcess := func(path string, args ...string) *model.Process {
+		pr := &model.Process{}
+		pr.FileEvent.PathnameStr = path
+		pr.ArgsEntry = &model.ArgsEntry{Values: args}
+		return pr
+	}
+
+	argv, _ := resolver.GetProcessArgvScrubbed(newProcess("/usr/bin/mysqldump", "mysqldump", "-u", "root", "db"))
+	assert.Empty(t, argv)
+
+	values := []string{"curl", "--api-key=abcd", "-k", "efgh", "--password=secret", "https://example.com"}
+	pr := newProcess("/usr/bin/curl", values...)
+
+	// the cache queries and lookups scrub the args the same way, without updating the entry
+	queried, _ := resolver.queryArgs(pr)
+	assert.Equal(t, []string{"curl", "--api-key=********", "-k", "********", "--password=********", "https://example.com"}, queried)
+	hash := resolver.cmdLineHash(pr)
+	assert.False(t, pr.ScrubbedArgvResolved)
+
+	argv, _ = resolver.GetProcessArgvScrubbed(pr)
+	assert.Equal(t, []string{"--api-key=********", "-k", "********", "--password=********", "https://example.com"}, argv)
+	assert.Equal(t, hash, resolver.cmdLineHash(pr))
+
+	// the rules of the unloaded policies don't apply anymore
+	resolver.SetPolicyArgsScrubbing(nil)
+	argv, _ = resolver.GetProcessArgvScrubbed(newProcess("/usr/bin/curl", values...))
+	assert.Equal(t, []string{"--api-key=abcd", "-k", "efgh", "--password=********", "https://example.com"}, argv)
+}
+
+func TestArgsLostProcfsFallback(t *testing.T) {
+	opts := NewResolverOpts()
+	opts.WithArgsEnvsCache(1, true)
+
+	resolver, err := NewEBPFResolver(nil, nil, &statsd.NoOpClient{}, nil, nil, nil, nil, nil, nil, nil, nil, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the args of the first exec are evicted by the ones of the second
+	resolver.argsEnvsCache.Add(1, &argsEnvsCacheEntry{values: []string{"lost"}})
+	resolver.argsEnvsCache.Add(2, &argsEnvsCacheEntry{values: []string{"kept"}})
+
+	kept := resolver.NewProcessCacheEntry(model.PIDContext{Pid: 2, Tid: 2})
+	kept.ArgsID = 2
+	resolver.SetProcessArgs(kept)
+	assert.Equal(t, []string{"kept"}, kept.ArgsEntry.Values)
+	assert.Zero(t, resolver.argsLost.Load())
+
+	lost := resolver.NewProcessCacheEntry(model.PIDContext{Pid: uint32(os.Getpid()), Tid: uint32(os.Getpid())})
+	lost.ArgsID = 1
+	resolver.SetProcessArgs(lost)
+	assert.Equal(t, int64(1), resolver.argsLost.Load())
+	assert.Equal(t, int64(1), resolver.argsProcfsFallback.Load())
+	if assert.NotNil(t, lost.ArgsEntry) {
+		assert.Equal(t, os.Args, lost.ArgsEntry.Values)
+	}
+
+	// the execs without args aren't losses
+	noArgs := resolver.NewProcessCacheEntry(model.PIDContext{Pid: 3, Tid: 3})
+	resolver.SetProcessArgs(noArgs)
+	assert.Equal(t, int64(1), resolver.argsLost.Load())
+
+	// the command line of a reused pid isn't used
+	reused := resolver.NewProcessCacheEntry(model.PIDContext{Pid: uint32(os.Getpid()), Tid: uint32(os.Getpid())})
+	reused.ArgsID = 3
+	reused.StartBootTime = 1
+	resolver.SetProcessArgs(reused)
+	assert.Equal(t, int64(2), resolver.argsLost.Load())
+	assert.Equal(t, int64(1), resolver.argsProcfsFallback.Load())
+	assert.Nil(t, reused.ArgsEntry)
+}
+
+func TestArgsEnvsCacheEvictions(t *testing.T) {
+	opts := NewResolverOpts()
+	opts.WithArgsEnvsCache(1, false)
+	opts.WithArgsEnvsValueCache(1)
+
+	resolver, err := NewEBPFResolver(nil, nil, &statsd.NoOpClient{}, nil, nil, nil, nil, nil, nil, nil, nil, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	newArgsEnvsEvent := func(id uint64, value string) *model.ArgsEnvsEvent {
+		event := &model.ArgsEnvsEvent{}
+		event.ID = id
+		event.Size = uint32(4 + len(value))
+		binary.NativeEndian.PutUint32(event.ValuesRaw[:], uint32(len(value)))
+		copy(event.ValuesRaw[4:], value)
+		return event
+	}
+
+	resolver.UpdateArgsEnvs(newArgsEnvsEvent(1, "first"))
+	assert.Zero(t, resolver.argsEnvsEvicted.Load())
+	assert.Zero(t, resolver.argsEnvsValuesEvicted.Load())
+
+	// the args of the first exec and their value are evicted by the ones of the second
+	resolver.UpdateArgsEnvs(newArgsEnvsEvent(2, "second"))
+	assert.Equal(t, int64(1), resolver.argsEnvsEvicted.Load())
+	assert.Equal(t, int64(1), resolver.argsEnvsValuesEvicted.Load())
+
+	// consuming the args of an exec isn't an eviction
+	entry := resolver.NewProcessCacheEntry(model.PIDContext{Pid: 2, Tid: 2})
+	entry.ArgsID = 2
+	resolver.SetProcessArgs(entry)
+	assert.Equal(t, []string{"second"}, entry.ArgsEntry.Values)
+	assert.Equal(t, int64(1), resolver.argsEnvsEvicted.Load())
+}
+
+func TestIsShebangInterpreter(t *testing.T) {
+	exe, err := os.Executable()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var stat syscall.Stat_t
+	if err := syscall.Stat(exe, &stat); err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	script := filepath.Join(dir, "script")
+	if err := os.WriteFile(script, []byte("#!"+exe+"\nsleep 10\n"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	other := filepath.Join(dir, "other")
+	if err := os.WriteFile(other, []byte("#!/usr/bin/env perl\nsleep 10;\n"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	pr := &model.Process{PIDContext: model.PIDContext{Pid: uint32(os.Getpid())}}
+	pr.FileEvent.PathnameStr = exe
+	pr.FileEvent.Inode = stat.Ino
+
+	assert.True(t, IsShebangInterpreter(pr, []string{exe, "-x", script}))
+	assert.False(t, IsShebangInterpreter(pr, []string{exe, other}))
+	assert.False(t, IsShebangInterpreter(pr, []string{exe}))
+
+	pr.FileEvent.PathnameStr = "/usr/bin/perl5.36"
+	pr.FileEvent.Inode = 0
+	assert.True(t, IsShebangInterpreter(pr, []string{"perl", other}))
+}
+
+func TestArgsLostProcfsFallbackUnderLock(t *testing.T) {
+	opts := NewResolverOpts()
+	opts.WithArgsEnvsCache(1, true)
+
+	resolver, err := NewEBPFResolver(nil, nil, &statsd.NoOpClient{}, nil, nil, nil, nil, nil, nil, nil, nil, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the entries resolved from the kernel maps, under the resolver lock, don't read procfs and their args being
+	// already consumed isn't a loss
+	lost := resolver.NewProcessCacheEntry(model.PIDContext{Pid: uint32(os.Getpid()), Tid: uint32(os.Getpid())})
+	lost.ArgsID = 1
+	resolver.setProcessArgs(lost, false)
+	assert.Zero(t, resolver.argsLost.Load())
+	assert.Zero(t, resolver.argsProcfsFallback.Load())
+	assert.Nil(t, lost.ArgsEntry)
+
+	// their args are completed once the lock is released
+	resolver.completeArgsFromProcfs(lost)
+	assert.Equal(t, int64(1), resolver.argsProcfsFallback.Load())
+	if assert.NotNil(t, lost.ArgsEntry) {
+		assert.Equal(t, os.Args, lost.ArgsEntry.Values)
+	}
+}
+
+func TestCloudCredentialsExpiration(t *testing.T) {
+	now := time.Now()
+	isExpired := func(creds model.CloudCredentials) bool {
+		return creds.IsExpired(now)
+	}
+
+	assert.False(t, isExpired(model.CloudCredentials{CloudProvider: model.IMDSAWSCloudProvider, Expiration: now.Add(time.Hour)}))
+	assert.True(t, isExpired(model.CloudCredentials{CloudProvider: model.IMDSAWSCloudProvider, Expiration: now.Add(-time.Hour)}))
+	// the expiration of the AWS security credentials couldn't be parsed
+	assert.True(t, isExpired(model.CloudCredentials{CloudProvider: model.IMDSAWSCloudProvider, AcquiredAt: now}))
+
+	assert.True(t, isExpired(model.CloudCredentials{CloudProvider: model.IMDSAzureCloudProvider, Expiration: now.Add(-time.Hour)}))
+	// the access tokens without expiration are kept for their maximum lifetime
+	assert.False(t, isExpired(model.CloudCredentials{CloudProvider: model.IMDSGCPCloudProvider, AcquiredAt: now.Add(-time.Hour)}))
+	assert.True(t, isExpired(model.CloudCredentials{CloudProvider: model.IMDSGCPCloudProvider, AcquiredAt: now.Add(-model.MaxCloudAccessTokenLifetime - time.Minute)}))
+
+	process := &model.Process{
+		CloudCredentials: []model.CloudCredentials{
+			{CloudProvider: model.IMDSGCPCloudProvider, ID: "valid", AcquiredAt: now},
+			{CloudProvider: model.IMDSAzureCloudProvider, ID: "expired", Expiration: now.Add(-time.Hour)},
+		},
+	}
+	valid := process.GetValidCloudCredentials(now)
+	if assert.Len(t, valid, 1) {
+		assert.Equal(t, "valid", valid[0].ID)
+	}
+}
+
+func TestResolveWhileSnapshotting(t *testing.T) {
+	resolver, err := NewEBPFResolver(nil, nil, &statsd.NoOpClient{}, nil, nil, nil, nil, nil, nil, nil, nil, NewResolverOpts())
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.False(t, resolver.IsSnapshotted())
+
+	entry := resolver.NewProcessCacheEntry(model.PIDContext{Pid: 1, Tid: 1})
+	resolver.insertEntry(entry, nil, model.ProcessCacheEntryFromSnapshot, ProcessTreeInsert, time.Now())
+
+	// the entries already inserted by the snapshot are resolved
+	assert.Same(t, entry, resolver.Resolve(1, 1, 0, "", true, nil))
+
+	// the other ones are left to the snapshot walking /proc, the kernel maps being unavailable before the start
+	assert.Nil(t, resolver.Resolve(uint32(os.Getpid()), uint32(os.Getpid()), 0, "", true, nil))
+	assert.Equal(t, int64(1), resolver.missStats.Load())
+
+	resolver.SetState(Snapshotted)
+	assert.True(t, resolver.IsSnapshotted())
+}
@@ -56,7 +56,7 @@ func NewResolver(_ *config.Config, statsdClient statsd.ClientInterface, scrubber
 		statsdClient: statsdClient,
 	}
 
-	p.processCacheEntryPool = NewProcessCacheEntryPool(func() { p.cacheSize.Dec() })
+	p.processCacheEntryPool = NewProcessCacheEntryPool(func(_ *model.ProcessCacheEntry) { p.cacheSize.Dec() })
 
 	return p, nil
 }
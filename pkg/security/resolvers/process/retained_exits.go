@@ -0,0 +1,76 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+package process
+
+import (
+	"time"
+
+	"go.uber.org/atomic"
+
+	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
+)
+
+// retainedExit is an entry whose exit event was received, kept in the cache until its deadline
+type retainedExit struct {
+	pid      uint32
+	cookie   model.ProcessCookie
+	deadline time.Time
+}
+
+// retainedExits is a bounded FIFO of the entries kept in the cache after their exit event so that the late events can
+// still resolve their lineage. It is protected by the resolver lock, the deadline of its head being readable without it.
+type retainedExits struct {
+	exits []retainedExit
+	size  int
+
+	// next holds the deadline of the head of the queue in nanoseconds, 0 when the queue is empty
+	next *atomic.Int64
+}
+
+func newRetainedExits(size int) *retainedExits {
+	return &retainedExits{
+		size: size,
+		next: atomic.NewInt64(0),
+	}
+}
+
+// push queues an exited entry, it returns false when the queue is full
+func (q *retainedExits) push(exit retainedExit) bool {
+	if len(q.exits) >= q.size {
+		return false
+	}
+
+	q.exits = append(q.exits, exit)
+	if len(q.exits) == 1 {
+		q.next.Store(exit.deadline.UnixNano())
+	}
+	return true
+}
+
+// expired returns whether the head of the queue reached its deadline
+func (q *retainedExits) expired(now time.Time) bool {
+	next := q.next.Load()
+	return next != 0 && next <= now.UnixNano()
+}
+
+// pop dequeues the head of the queue if it reached its deadline
+func (q *retainedExits) pop(now time.Time) (retainedExit, bool) {
+	if len(q.exits) == 0 || q.exits[0].deadline.After(now) {
+		return retainedExit{}, false
+	}
+
+	exit := q.exits[0]
+	q.exits = q.exits[1:]
+	if len(q.exits) == 0 {
+		q.exits = nil
+		q.next.Store(0)
+	} else {
+		q.next.Store(q.exits[0].deadline.UnixNano())
+	}
+	return exit, true
+}
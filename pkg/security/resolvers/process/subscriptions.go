@@ -0,0 +1,199 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+// Package process holds process related files
+package process
+
+import (
+	"sync"
+	"time"
+
+	"go.uber.org/atomic"
+
+	"github.com/DataDog/datadog-agent/pkg/security/secl/containerutils"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
+)
+
+// ProcessTreeUpdateType is the type of a mutation of the process cache
+type ProcessTreeUpdateType int
+
+const (
+	// ProcessTreeFork is sent when a process is forked
+	ProcessTreeFork ProcessTreeUpdateType = iota + 1
+	// ProcessTreeExec is sent when a process executes a new program
+	ProcessTreeExec
+	// ProcessTreeExit is sent when a process exits
+	ProcessTreeExit
+	// ProcessTreeEnvs is sent when the environment of a running process changed
+	ProcessTreeEnvs
+	// ProcessTreeInsert is sent when a running process is inserted from the snapshot or from procfs, and for each
+	// process of the cache when subscribing
+	ProcessTreeInsert
+	// ProcessTreeEvict is sent when a running process is evicted from the cache
+	ProcessTreeEvict
+)
+
+// String returns the name of the update type
+func (t ProcessTreeUpdateType) String() string {
+	switch t {
+	case ProcessTreeFork:
+		return "fork"
+	case ProcessTreeExec:
+		return "exec"
+	case ProcessTreeExit:
+		return "exit"
+	case ProcessTreeEnvs:
+		return "envs"
+	case ProcessTreeInsert:
+		return "insert"
+	case ProcessTreeEvict:
+		return "evict"
+	default:
+		return "unknown"
+	}
+}
+
+// ProcessTreeUpdate describes a mutation of the process cache. It is a copy of the entry at the time of the mutation,
+// the entries of the cache being reused once released.
+type ProcessTreeUpdate struct {
+	Type        ProcessTreeUpdateType
+	Pid         uint32
+	PPid        uint32
+	Cookie      model.ProcessCookie
+	ContainerID containerutils.ContainerID
+	Pathname    string
+	Comm        string
+	Source      uint64
+	Time        time.Time
+	// Envs describes the change of the environment of the process, for the ProcessTreeEnvs updates only
+	Envs *EnvsChange
+}
+
+// newProcessTreeUpdate returns the update of the provided entry
+func newProcessTreeUpdate(updateType ProcessTreeUpdateType, entry *model.ProcessCacheEntry, tm time.Time) ProcessTreeUpdate {
+	return ProcessTreeUpdate{
+		Type:        updateType,
+		Pid:         entry.Pid,
+		PPid:        entry.PPid,
+		Cookie:      entry.Cookie,
+		ContainerID: entry.ContainerID,
+		Pathname:    entry.FileEvent.PathnameStr,
+		Comm:        entry.Comm,
+		Source:      entry.Source,
+		Time:        tm,
+	}
+}
+
+// ProcessTreeSubscription receives the mutations of the process cache. The updates are never waited for, they are
+// dropped when the subscriber doesn't keep up with them.
+type ProcessTreeSubscription struct {
+	updates chan ProcessTreeUpdate
+	dropped *atomic.Int64
+}
+
+// Updates returns the channel of the updates, it is closed once the subscription is cancelled
+func (s *ProcessTreeSubscription) Updates() <-chan ProcessTreeUpdate {
+	return s.updates
+}
+
+// Dropped returns the number of updates dropped because the channel was full
+func (s *ProcessTreeSubscription) Dropped() int64 {
+	return s.dropped.Load()
+}
+
+// processTreeSubscribers holds the subscriptions to the mutations of the process cache
+type processTreeSubscribers struct {
+	sync.RWMutex
+	subscriptions map[*ProcessTreeSubscription]struct{}
+	count         *atomic.Int64
+	dropped       *atomic.Int64
+}
+
+func newProcessTreeSubscribers() *processTreeSubscribers {
+	return &processTreeSubscribers{
+		subscriptions: make(map[*ProcessTreeSubscription]struct{}),
+		count:         atomic.NewInt64(0),
+		dropped:       atomic.NewInt64(0),
+	}
+}
+
+// notify sends the update built by the provided function to all the subscriptions, the update being only built when
+// there is at least one subscription
+func (s *processTreeSubscribers) notify(build func() ProcessTreeUpdate) {
+	if s.count.Load() == 0 {
+		return
+	}
+
+	s.RLock()
+	defer s.RUnlock()
+
+	if len(s.subscriptions) == 0 {
+		return
+	}
+
+	update := build()
+	for subscription := range s.subscriptions {
+		select {
+		case subscription.updates <- update:
+		default:
+			subscription.dropped.Inc()
+			s.dropped.Inc()
+		}
+	}
+}
+
+// SubscribeProcessTree returns a subscription to the insertions and removals of the process cache, so that the
+// process tree can be followed without polling the resolver. The subscription starts with a ProcessTreeInsert update
+// for each process of the cache, then up to bufferSize updates are queued.
+func (p *EBPFResolver) SubscribeProcessTree(bufferSize int) *ProcessTreeSubscription {
+	// the cache is only mutated under the resolver lock, no update is missed nor sent twice between the snapshot and
+	// the registration of the subscription
+	p.Lock()
+	defer p.Unlock()
+
+	now := time.Now()
+	subscription := &ProcessTreeSubscription{
+		updates: make(chan ProcessTreeUpdate, bufferSize+p.entryCache.Len()),
+		dropped: atomic.NewInt64(0),
+	}
+	p.entryCache.Range(func(_ uint32, entry *model.ProcessCacheEntry) bool {
+		select {
+		case subscription.updates <- newProcessTreeUpdate(ProcessTreeInsert, entry, now):
+			return true
+		default:
+			subscription.dropped.Inc()
+			return false
+		}
+	})
+
+	p.subscribers.Lock()
+	p.subscribers.subscriptions[subscription] = struct{}{}
+	p.subscribers.count.Inc()
+	p.subscribers.Unlock()
+
+	return subscription
+}
+
+// UnsubscribeProcessTree cancels the subscription and closes its channel
+func (p *EBPFResolver) UnsubscribeProcessTree(subscription *ProcessTreeSubscription) {
+	p.subscribers.Lock()
+	defer p.subscribers.Unlock()
+
+	if _, found := p.subscribers.subscriptions[subscription]; !found {
+		return
+	}
+	delete(p.subscribers.subscriptions, subscription)
+	p.subscribers.count.Dec()
+	close(subscription.updates)
+}
+
+// notifyProcessTree notifies the subscribers of a mutation of the entry. The caller must hold the resolver lock.
+func (p *EBPFResolver) notifyProcessTree(updateType ProcessTreeUpdateType, entry *model.ProcessCacheEntry, tm time.Time) {
+	p.subscribers.notify(func() ProcessTreeUpdate {
+		return newProcessTreeUpdate(updateType, entry, tm)
+	})
+}
@@ -0,0 +1,92 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+// Package process holds process related files
+package process
+
+import (
+	"strings"
+	"time"
+
+	"github.com/DataDog/datadog-agent/comp/core/telemetry"
+	"github.com/DataDog/datadog-agent/pkg/security/metrics"
+)
+
+const (
+	telemetrySubsystem = "cws_process_resolver"
+	missSource         = "miss"
+)
+
+// resolutionLatencyBuckets are the buckets, in microseconds, of the resolution latency histogram
+var resolutionLatencyBuckets = []float64{1, 5, 10, 50, 100, 500, 1000, 5000, 10000, 50000}
+
+// resolverTelemetry holds the metrics of the resolver published on the agent telemetry endpoint, so that they can be
+// scraped without the Datadog backend. They mirror the statsd metrics sent by SendStats.
+type resolverTelemetry struct {
+	cacheSize         telemetry.Gauge
+	referenceCount    telemetry.Gauge
+	hits              telemetry.Counter
+	misses            telemetry.Counter
+	hitRatio          telemetry.Gauge
+	resolutionLatency telemetry.Histogram
+}
+
+func newResolverTelemetry(tm telemetry.Component) *resolverTelemetry {
+	return &resolverTelemetry{
+		cacheSize: tm.NewGauge(telemetrySubsystem, "cache_size",
+			nil, "Number of processes in the cache"),
+		referenceCount: tm.NewGauge(telemetrySubsystem, "reference_count",
+			nil, "Number of entries in the cache, including the exited ancestors of the running processes"),
+		hits: tm.NewCounter(telemetrySubsystem, "hits",
+			[]string{"source"}, "Number of processes resolved, by source"),
+		misses: tm.NewCounter(telemetrySubsystem, "misses",
+			nil, "Number of processes that couldn't be resolved"),
+		hitRatio: tm.NewGauge(telemetrySubsystem, "hit_ratio",
+			[]string{"source"}, "Share of the resolutions of the last stats interval resolved by each source"),
+		resolutionLatency: tm.NewHistogram(telemetrySubsystem, "resolution_latency",
+			[]string{"source"}, "Time in microseconds to resolve a process, by source", resolutionLatencyBuckets),
+	}
+}
+
+// sourceLabel returns the label value of the provided resolution type tag
+func sourceLabel(resolutionType string) string {
+	if _, source, found := strings.Cut(resolutionType, ":"); found {
+		return source
+	}
+	return resolutionType
+}
+
+// observeResolution records the latency of a resolution started at the provided time
+func (t *resolverTelemetry) observeResolution(source string, start time.Time) {
+	t.resolutionLatency.Observe(float64(time.Since(start).Microseconds()), source)
+}
+
+// setStats publishes the stats of the last interval, the hits being indexed by resolution type tag. The hit ratio is
+// set for every source, the sources without hit during the interval getting a zero ratio.
+func (t *resolverTelemetry) setStats(cacheSize, referenceCount float64, hits map[string]int64, misses int64) {
+	t.cacheSize.Set(cacheSize)
+	t.referenceCount.Set(referenceCount)
+
+	total := misses
+	for _, count := range hits {
+		total += count
+	}
+
+	for _, resolutionType := range metrics.AllTypesTags {
+		count := hits[resolutionType]
+		if count > 0 {
+			t.hits.Add(float64(count), sourceLabel(resolutionType))
+		}
+
+		var ratio float64
+		if total > 0 {
+			ratio = float64(count) / float64(total)
+		}
+		t.hitRatio.Set(ratio, sourceLabel(resolutionType))
+	}
+	t.misses.Add(float64(misses))
+}
@@ -0,0 +1,99 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux && test
+
+// Package process holds process related files
+package process
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DataDog/datadog-go/v5/statsd"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/DataDog/datadog-agent/comp/core/telemetry"
+	"github.com/DataDog/datadog-agent/comp/core/telemetry/telemetryimpl"
+	"github.com/DataDog/datadog-agent/pkg/security/metrics"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
+	"github.com/DataDog/datadog-agent/pkg/util/fxutil"
+)
+
+func TestResolverTelemetry(t *testing.T) {
+	tm := fxutil.Test[telemetry.Mock](t, telemetryimpl.MockModule())
+	resolver, err := NewEBPFResolver(nil, nil, &statsd.NoOpClient{}, nil, nil, nil, nil, nil, nil, nil, nil, NewResolverOpts().WithTelemetry(tm))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entry := resolver.NewProcessCacheEntry(model.PIDContext{Pid: 1, Tid: 1})
+	resolver.insertEntry(entry, nil, model.ProcessCacheEntryFromSnapshot, ProcessTreeInsert, time.Now())
+
+	for i := 0; i < 3; i++ {
+		assert.Same(t, entry, resolver.Resolve(1, 1, 0, "", false, nil))
+	}
+	assert.Nil(t, resolver.Resolve(2, 2, 0, "", false, nil))
+
+	if err := resolver.SendStats(); err != nil {
+		t.Fatal(err)
+	}
+
+	cacheSize, err := tm.GetGaugeMetric(telemetrySubsystem, "cache_size")
+	if assert.NoError(t, err) && assert.Len(t, cacheSize, 1) {
+		assert.Equal(t, float64(1), cacheSize[0].Value())
+	}
+
+	// the ratio is set for every source
+	hitRatio, err := tm.GetGaugeMetric(telemetrySubsystem, "hit_ratio")
+	if assert.NoError(t, err) && assert.Len(t, hitRatio, len(metrics.AllTypesTags)) {
+		ratios := make(map[string]float64)
+		for _, metric := range hitRatio {
+			ratios[metric.Tags()["source"]] = metric.Value()
+		}
+		assert.Equal(t, map[string]float64{"cache": 0.75, "kernel_maps": 0, "procfs": 0, "erpc": 0}, ratios)
+	}
+
+	misses, err := tm.GetCountMetric(telemetrySubsystem, "misses")
+	if assert.NoError(t, err) && assert.Len(t, misses, 1) {
+		assert.Equal(t, float64(1), misses[0].Value())
+	}
+
+	latency, err := tm.GetHistogramMetric(telemetrySubsystem, "resolution_latency")
+	if assert.NoError(t, err) {
+		var sources []string
+		for _, metric := range latency {
+			sources = append(sources, metric.Tags()["source"])
+		}
+		assert.ElementsMatch(t, []string{"cache", "miss"}, sources)
+	}
+}
+
+// failingStatsdClient fails to send any gauge
+type failingStatsdClient struct {
+	statsd.NoOpClient
+}
+
+func (failingStatsdClient) Gauge(_ string, _ float64, _ []string, _ float64) error {
+	return errors.New("statsd unavailable")
+}
+
+func TestResolverTelemetryStatsdFailure(t *testing.T) {
+	tm := fxutil.Test[telemetry.Mock](t, telemetryimpl.MockModule())
+	resolver, err := NewEBPFResolver(nil, nil, &failingStatsdClient{}, nil, nil, nil, nil, nil, nil, nil, nil, NewResolverOpts().WithTelemetry(tm))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Nil(t, resolver.Resolve(2, 2, 0, "", false, nil))
+	assert.Error(t, resolver.SendStats())
+
+	// the telemetry is updated even though the statsd metrics couldn't be sent
+	misses, err := tm.GetCountMetric(telemetrySubsystem, "misses")
+	if assert.NoError(t, err) && assert.Len(t, misses, 1) {
+		assert.Equal(t, float64(1), misses[0].Value())
+	}
+}
@@ -16,6 +16,7 @@ import (
 
 	"github.com/DataDog/datadog-go/v5/statsd"
 	manager "github.com/DataDog/ebpf-manager"
+	gopsutilProcess "github.com/shirou/gopsutil/v3/process"
 
 	"github.com/DataDog/datadog-agent/comp/core/telemetry"
 	"github.com/DataDog/datadog-agent/pkg/process/procutil"
@@ -25,6 +26,7 @@ import (
 	"github.com/DataDog/datadog-agent/pkg/security/resolvers/cgroup"
 	"github.com/DataDog/datadog-agent/pkg/security/resolvers/container"
 	"github.com/DataDog/datadog-agent/pkg/security/resolvers/dentry"
+	"github.com/DataDog/datadog-agent/pkg/security/resolvers/dns"
 	"github.com/DataDog/datadog-agent/pkg/security/resolvers/envvars"
 	"github.com/DataDog/datadog-agent/pkg/security/resolvers/hash"
 	"github.com/DataDog/datadog-agent/pkg/security/resolvers/mount"
@@ -38,6 +40,7 @@ import (
 	"github.com/DataDog/datadog-agent/pkg/security/resolvers/tc"
 	"github.com/DataDog/datadog-agent/pkg/security/resolvers/usergroup"
 	"github.com/DataDog/datadog-agent/pkg/security/resolvers/usersessions"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
 	"github.com/DataDog/datadog-agent/pkg/security/utils"
 	"github.com/DataDog/datadog-agent/pkg/util/ktime"
 	"github.com/DataDog/datadog-agent/pkg/util/log"
@@ -61,6 +64,10 @@ type EBPFResolvers struct {
 	HashResolver         *hash.Resolver
 	UserSessionsResolver *usersessions.Resolver
 	SyscallCtxResolver   *syscallctx.Resolver
+	DNSResolver          *dns.Resolver
+	PathAnonymizer       *path.Anonymizer
+
+	snapshotWorkers int
 }
 
 // NewEBPFResolvers creates a new instance of EBPFResolvers
@@ -98,7 +105,7 @@ func NewEBPFResolvers(config *config.Config, manager *manager.Manager, statsdCli
 
 	tagsResolver := tags.NewResolver(telemetry, opts.Tagger, cgroupsResolver)
 
-	userGroupResolver, err := usergroup.NewResolver(cgroupsResolver)
+	userGroupResolver, err := usergroup.NewResolver(cgroupsResolver, config.RuntimeSecurity.UserGroupNSSEnabled)
 	if err != nil {
 		return nil, err
 	}
@@ -126,18 +133,59 @@ func NewEBPFResolvers(config *config.Config, manager *manager.Manager, statsdCli
 		if err != nil {
 			return nil, err
 		}
-		pathResolver = path.NewResolver(dentryResolver, mountResolver)
+		pathResolver, err = path.NewResolver(dentryResolver, mountResolver, statsdClient, path.ResolverOpts{
+			CacheSize:        config.Probe.PathCacheSize,
+			CacheNegativeTTL: config.Probe.PathCacheNegativeTTL,
+		})
+		if err != nil {
+			return nil, err
+		}
 	} else {
 		mountResolver = &mount.NoOpResolver{}
 		pathResolver = &path.NoOpResolver{}
 	}
-	containerResolver := &container.Resolver{}
+
+	containerResolver, err := container.NewResolver(config.RuntimeSecurity.ContainerMetadataEnabled)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cgroupsResolver.RegisterListener(cgroup.CGroupCreated, containerResolver.OnCGroupCreatedEvent); err != nil {
+		return nil, err
+	}
+
+	var pathAnonymizer *path.Anonymizer
+	if config.RuntimeSecurity.PathAnonymizationEnabled {
+		if pathAnonymizer, err = path.NewAnonymizer(config.RuntimeSecurity.PathAnonymizationPatterns); err != nil {
+			return nil, err
+		}
+	}
 
 	processOpts := process.NewResolverOpts()
 	processOpts.WithEnvsValue(config.Probe.EnvsWithValue)
+	processOpts.WithEntryCache(config.Probe.ProcessResolverEntryCache, config.Probe.ProcessResolverEntryCacheShards)
+	processOpts.WithMaxEntries(config.Probe.ProcessResolverMaxEntries)
+	processOpts.WithMemoryBudget(config.Probe.ProcessResolverMemoryBudget)
+	processOpts.WithSweepInterval(config.Probe.ProcessResolverSweepInterval)
+	processOpts.WithReconciliationInterval(config.Probe.ProcessResolverReconcileInterval)
+	processOpts.WithEnvsRefreshInterval(config.Probe.ProcessResolverEnvsRefreshInterval)
+	processOpts.WithExitedRetention(config.Probe.ProcessResolverExitedRetention, config.Probe.ProcessResolverRefExitedRetention)
+	processOpts.WithProcfsWorkers(config.Probe.ProcessResolverProcfsWorkers, config.Probe.ProcessResolverProcfsQueueSize)
+	processOpts.WithArgsEnvsCache(config.Probe.ProcessResolverArgsEnvsCacheSize, config.Probe.ProcessResolverArgsProcfsFallback)
+	processOpts.WithArgsEnvsValueCache(config.Probe.ProcessResolverArgsEnvsValueCacheSize)
+	processOpts.WithProcfsFallbackLimits(config.RuntimeSecurity.ProcfsFallbackMaxResolutions, config.RuntimeSecurity.ProcfsFallbackPeriod, config.RuntimeSecurity.ProcfsFallbackOverrides)
 	if opts.TTYFallbackEnabled {
 		processOpts.WithTTYFallbackEnabled()
 	}
+	if config.Probe.ProcessResolverShebangDetection {
+		processOpts.WithShebangDetection()
+	}
+	if config.Probe.ProcessResolverLineageRepair {
+		processOpts.WithLineageRepair()
+	}
+	if telemetry != nil {
+		processOpts.WithTelemetry(telemetry)
+	}
 	if opts.EnvVarsResolutionEnabled {
 		processOpts.WithEnvsResolutionEnabled()
 	}
@@ -162,6 +210,11 @@ func NewEBPFResolvers(config *config.Config, manager *manager.Manager, statsdCli
 		return nil, err
 	}
 
+	dnsResolver, err := dns.NewResolver()
+	if err != nil {
+		return nil, err
+	}
+
 	resolvers := &EBPFResolvers{
 		manager:              manager,
 		MountResolver:        mountResolver,
@@ -179,6 +232,9 @@ func NewEBPFResolvers(config *config.Config, manager *manager.Manager, statsdCli
 		HashResolver:         hashResolver,
 		UserSessionsResolver: userSessionsResolver,
 		SyscallCtxResolver:   syscallctx.NewResolver(),
+		DNSResolver:          dnsResolver,
+		PathAnonymizer:       pathAnonymizer,
+		snapshotWorkers:      config.Probe.ProcessResolverSnapshotWorkers,
 	}
 
 	return resolvers, nil
@@ -202,7 +258,11 @@ func (r *EBPFResolvers) Start(ctx context.Context) error {
 		return err
 	}
 
+	r.TimeResolver.Start(ctx)
+	r.MountResolver.Start(ctx)
 	r.CGroupResolver.Start(ctx)
+	r.ContainerResolver.Start(ctx)
+	r.UserGroupResolver.Start(ctx)
 	if r.SBOMResolver != nil {
 		if err := r.SBOMResolver.Start(ctx); err != nil {
 			return err
@@ -266,36 +326,87 @@ func (r *EBPFResolvers) snapshot() error {
 		return createA < createB
 	})
 
-	for _, proc := range processes {
-		ppid, err := proc.Ppid()
-		if err != nil {
-			continue
-		}
+	workers := r.snapshotWorkers
+	if workers < 1 {
+		workers = 1
+	}
 
-		pid := uint32(proc.Pid)
+	// /proc is read concurrently by the workers, while the entries are inserted in the creation time order so that
+	// the parents are inserted before their children
+	results := make([]snapshotResult, len(processes))
+	for i := range results {
+		results[i].done = make(chan struct{})
+	}
 
-		if process.IsKThread(uint32(ppid), pid) {
-			continue
+	jobs := make(chan int)
+	go func() {
+		for i := range processes {
+			jobs <- i
 		}
-
-		// Start with the mount resolver because the process resolver might need it to resolve paths
-		if err = r.MountResolver.SyncCache(pid); err != nil {
-			if !os.IsNotExist(err) {
-				log.Debugf("snapshot failed for %d: couldn't sync mount points: %s", proc.Pid, err)
+		close(jobs)
+	}()
+
+	for w := 0; w < workers; w++ {
+		go func() {
+			for i := range jobs {
+				results[i].pid, results[i].entry, results[i].synced = r.snapshotProcess(processes[i])
+				close(results[i].done)
 			}
+		}()
+	}
+
+	for i := range results {
+		<-results[i].done
+
+		if !results[i].synced {
 			continue
 		}
 
 		// Sync the process cache
-		r.ProcessResolver.SyncCache(proc)
+		if results[i].entry != nil {
+			r.ProcessResolver.InsertSnapshotEntry(results[i].entry)
+		}
 
 		// Sync the namespace cache
-		r.NamespaceResolver.SyncCache(pid)
+		r.NamespaceResolver.SyncCache(results[i].pid)
 	}
 
 	return nil
 }
 
+// snapshotResult holds the snapshot of a process, until the ones of the processes created before it are inserted
+type snapshotResult struct {
+	pid    uint32
+	entry  *model.ProcessCacheEntry
+	synced bool
+	done   chan struct{}
+}
+
+// snapshotProcess syncs the mount points of the process and reads its process cache entry from /proc. It returns
+// false if the process has to be skipped.
+func (r *EBPFResolvers) snapshotProcess(proc *gopsutilProcess.Process) (uint32, *model.ProcessCacheEntry, bool) {
+	ppid, err := proc.Ppid()
+	if err != nil {
+		return 0, nil, false
+	}
+
+	pid := uint32(proc.Pid)
+
+	if process.IsKThread(uint32(ppid), pid) {
+		return 0, nil, false
+	}
+
+	// Start with the mount resolver because the process resolver might need it to resolve paths
+	if err = r.MountResolver.SyncCache(pid); err != nil {
+		if !os.IsNotExist(err) {
+			log.Debugf("snapshot failed for %d: couldn't sync mount points: %s", proc.Pid, err)
+		}
+		return 0, nil, false
+	}
+
+	return pid, r.ProcessResolver.NewSnapshotEntry(proc), true
+}
+
 // Close cleans up any underlying resolver that requires a cleanup
 func (r *EBPFResolvers) Close() error {
 	// clean up the handles in netns resolver
@@ -19,6 +19,7 @@ import (
 	"github.com/DataDog/datadog-agent/pkg/security/resolvers/cgroup"
 	"github.com/DataDog/datadog-agent/pkg/security/resolvers/container"
 	"github.com/DataDog/datadog-agent/pkg/security/resolvers/hash"
+	"github.com/DataDog/datadog-agent/pkg/security/resolvers/path"
 	"github.com/DataDog/datadog-agent/pkg/security/resolvers/process"
 	"github.com/DataDog/datadog-agent/pkg/security/resolvers/tags"
 )
@@ -29,6 +30,7 @@ type EBPFLessResolvers struct {
 	TagsResolver      *tags.LinuxResolver
 	ProcessResolver   *process.EBPFLessResolver
 	HashResolver      *hash.Resolver
+	PathAnonymizer    *path.Anonymizer
 }
 
 // NewEBPFLessResolvers creates a new instance of EBPFLessResolvers
@@ -39,6 +41,14 @@ func NewEBPFLessResolvers(config *config.Config, statsdClient statsd.ClientInter
 	}
 
 	tagsResolver := tags.NewResolver(telemetry, opts.Tagger, cgroupsResolver)
+
+	var pathAnonymizer *path.Anonymizer
+	if config.RuntimeSecurity.PathAnonymizationEnabled {
+		if pathAnonymizer, err = path.NewAnonymizer(config.RuntimeSecurity.PathAnonymizationPatterns); err != nil {
+			return nil, err
+		}
+	}
+
 	processOpts := process.NewResolverOpts()
 	processOpts.WithEnvsValue(config.Probe.EnvsWithValue)
 
@@ -56,6 +66,7 @@ func NewEBPFLessResolvers(config *config.Config, statsdClient statsd.ClientInter
 		TagsResolver:    tagsResolver,
 		ProcessResolver: processResolver,
 		HashResolver:    hashResolver,
+		PathAnonymizer:  pathAnonymizer,
 	}
 
 	return resolvers, nil
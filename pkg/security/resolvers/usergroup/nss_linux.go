@@ -0,0 +1,181 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package usergroup holds usergroup related files
+package usergroup
+
+import (
+	"context"
+	"os/user"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/golang-lru/v2/simplelru"
+	"go.uber.org/atomic"
+)
+
+const (
+	nssCacheSize = 1024
+	// period after which a resolved name is looked up again
+	nssEntryTTL = 10 * time.Minute
+	// delays before looking up again an id that couldn't be resolved, doubled after each failure
+	nssMinBackoff = 30 * time.Second
+	nssMaxBackoff = 30 * time.Minute
+	// maximum number of ids waiting to be looked up
+	nssQueueSize = 256
+)
+
+type nssEntry struct {
+	name      string
+	expiresAt time.Time
+	failures  int
+	// set while the id is waiting to be looked up
+	pending bool
+}
+
+// nssCache caches the names resolved through the name service switch, which queries the directories like LDAP through
+// SSSD for the ids unknown to the local files. The lookups, which may block for as long as the directory takes to
+// answer, are performed by a worker so that the event path never waits for them.
+type nssCache struct {
+	sync.Mutex
+	lookup  func(id int) (string, error)
+	entries *simplelru.LRU[int, *nssEntry]
+	queue   chan int
+	now     func() time.Time
+	// ids resolved for the first time since the previous call to popResolved, so that the names missing from the
+	// processes resolved before them can be set
+	resolved map[int]struct{}
+	// set when resolved isn't empty, checked without the lock from the event path
+	hasResolved atomic.Bool
+}
+
+func newNSSCache(lookup func(id int) (string, error)) (*nssCache, error) {
+	entries, err := simplelru.NewLRU[int, *nssEntry](nssCacheSize, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &nssCache{
+		lookup:   lookup,
+		entries:  entries,
+		queue:    make(chan int, nssQueueSize),
+		now:      time.Now,
+		resolved: make(map[int]struct{}),
+	}, nil
+}
+
+// start the worker looking up the queued ids
+func (c *nssCache) start(ctx context.Context) {
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case id := <-c.queue:
+				c.handleLookup(id)
+			}
+		}
+	}()
+}
+
+// backoff returns the delay before looking up again an id after the provided number of consecutive failures
+func backoff(failures int) time.Duration {
+	delay := nssMinBackoff
+	for i := 1; i < failures && delay < nssMaxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, nssMaxBackoff)
+}
+
+// resolve returns the cached name of an id, queuing its lookup once its cached entry expired. The ids not resolved
+// yet are reported as not found.
+func (c *nssCache) resolve(id int, notFoundErr error) (string, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	entry, found := c.entries.Get(id)
+	if !found {
+		entry = &nssEntry{}
+		c.entries.Add(id, entry)
+	}
+
+	if !entry.pending && !c.now().Before(entry.expiresAt) {
+		select {
+		case c.queue <- id:
+			entry.pending = true
+		default:
+			// the queue is full, the lookup will be queued by a later resolution
+		}
+	}
+
+	// keep serving the previously resolved name, if any, while the id is looked up again
+	if entry.name == "" {
+		return "", notFoundErr
+	}
+	return entry.name, nil
+}
+
+// handleLookup looks up the name of an id and updates its cached entry
+func (c *nssCache) handleLookup(id int) {
+	name, err := c.lookup(id)
+
+	c.Lock()
+	defer c.Unlock()
+
+	entry, found := c.entries.Peek(id)
+	if !found {
+		entry = &nssEntry{}
+		c.entries.Add(id, entry)
+	}
+	entry.pending = false
+
+	now := c.now()
+	if err != nil || name == "" {
+		// keep serving the previously resolved name, if any, while the directory is unreachable
+		entry.failures++
+		entry.expiresAt = now.Add(backoff(entry.failures))
+		return
+	}
+
+	if entry.name == "" && len(c.resolved) < nssCacheSize {
+		c.resolved[id] = struct{}{}
+		c.hasResolved.Store(true)
+	}
+	entry.name = name
+	entry.failures = 0
+	entry.expiresAt = now.Add(nssEntryTTL)
+}
+
+// popResolved returns the ids resolved for the first time since the previous call
+func (c *nssCache) popResolved() map[int]struct{} {
+	if !c.hasResolved.Load() {
+		return nil
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	resolved := c.resolved
+	c.resolved = make(map[int]struct{})
+	c.hasResolved.Store(false)
+	return resolved
+}
+
+func lookupUserName(uid int) (string, error) {
+	u, err := user.LookupId(strconv.Itoa(uid))
+	if err != nil {
+		return "", err
+	}
+	return u.Username, nil
+}
+
+func lookupGroupName(gid int) (string, error) {
+	g, err := user.LookupGroupId(strconv.Itoa(gid))
+	if err != nil {
+		return "", err
+	}
+	return g.Name, nil
+}
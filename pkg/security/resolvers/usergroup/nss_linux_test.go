@@ -0,0 +1,147 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package usergroup holds usergroup related files
+package usergroup
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNSSCache(t *testing.T) {
+	var (
+		lookups int
+		names   = map[int]string{1000001: "jdoe"}
+		lookErr error
+	)
+
+	cache, err := newNSSCache(func(id int) (string, error) {
+		lookups++
+		if lookErr != nil {
+			return "", lookErr
+		}
+		name, found := names[id]
+		if !found {
+			return "", errors.New("unknown id")
+		}
+		return name, nil
+	})
+	assert.NoError(t, err)
+
+	now := time.Now()
+	cache.now = func() time.Time { return now }
+
+	// drain performs the queued lookups, as the worker would
+	drain := func() {
+		for len(cache.queue) > 0 {
+			cache.handleLookup(<-cache.queue)
+		}
+	}
+
+	t.Run("async", func(t *testing.T) {
+		// the id isn't resolved until the worker looked it up
+		_, err := cache.resolve(1000001, errUserNotFound)
+		assert.ErrorIs(t, err, errUserNotFound)
+		_, err = cache.resolve(1000001, errUserNotFound)
+		assert.ErrorIs(t, err, errUserNotFound)
+		assert.Equal(t, 0, lookups)
+		assert.Len(t, cache.queue, 1)
+
+		drain()
+		name, err := cache.resolve(1000001, errUserNotFound)
+		assert.NoError(t, err)
+		assert.Equal(t, "jdoe", name)
+		assert.Equal(t, 1, lookups)
+
+		// the id is reported once so that the processes missing its name are updated
+		assert.Equal(t, map[int]struct{}{1000001: {}}, cache.popResolved())
+		assert.Empty(t, cache.popResolved())
+	})
+
+	t.Run("ttl", func(t *testing.T) {
+		lookups = 0
+
+		name, _ := cache.resolve(1000001, errUserNotFound)
+		assert.Equal(t, "jdoe", name)
+		drain()
+		assert.Equal(t, 0, lookups)
+
+		// the previous name is served while the expired entry is looked up again
+		now = now.Add(nssEntryTTL)
+		names[1000001] = "john.doe"
+		name, _ = cache.resolve(1000001, errUserNotFound)
+		assert.Equal(t, "jdoe", name)
+		drain()
+		name, _ = cache.resolve(1000001, errUserNotFound)
+		assert.Equal(t, "john.doe", name)
+		assert.Equal(t, 1, lookups)
+		assert.Empty(t, cache.popResolved())
+	})
+
+	t.Run("backoff", func(t *testing.T) {
+		lookups = 0
+
+		_, err := cache.resolve(1000002, errUserNotFound)
+		assert.ErrorIs(t, err, errUserNotFound)
+		drain()
+		_, err = cache.resolve(1000002, errUserNotFound)
+		assert.ErrorIs(t, err, errUserNotFound)
+		drain()
+		assert.Equal(t, 1, lookups)
+
+		now = now.Add(nssMinBackoff)
+		_, _ = cache.resolve(1000002, errUserNotFound)
+		drain()
+		assert.Equal(t, 2, lookups)
+
+		// the delay doubled after the second failure
+		now = now.Add(nssMinBackoff)
+		_, _ = cache.resolve(1000002, errUserNotFound)
+		drain()
+		assert.Equal(t, 2, lookups)
+
+		now = now.Add(nssMinBackoff)
+		names[1000002] = "asmith"
+		_, _ = cache.resolve(1000002, errUserNotFound)
+		drain()
+		name, err := cache.resolve(1000002, errUserNotFound)
+		assert.NoError(t, err)
+		assert.Equal(t, "asmith", name)
+		assert.Equal(t, 3, lookups)
+	})
+
+	t.Run("unreachable", func(t *testing.T) {
+		now = now.Add(nssEntryTTL)
+		lookErr = errors.New("sssd unreachable")
+		defer func() { lookErr = nil }()
+
+		// the previously resolved name is kept
+		_, _ = cache.resolve(1000001, errUserNotFound)
+		drain()
+		name, err := cache.resolve(1000001, errUserNotFound)
+		assert.NoError(t, err)
+		assert.Equal(t, "john.doe", name)
+	})
+
+	t.Run("queue-full", func(t *testing.T) {
+		for id := 0; id < nssQueueSize; id++ {
+			_, _ = cache.resolve(2000000+id, errUserNotFound)
+		}
+
+		// the lookup is queued again by a later resolution once the queue has room
+		_, err := cache.resolve(3000000, errUserNotFound)
+		assert.ErrorIs(t, err, errUserNotFound)
+		drain()
+		_, _ = cache.resolve(3000000, errUserNotFound)
+		assert.Len(t, cache.queue, 1)
+		drain()
+	})
+
+	assert.Equal(t, nssMaxBackoff, backoff(100))
+}
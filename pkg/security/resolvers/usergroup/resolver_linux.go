@@ -7,6 +7,7 @@
 package usergroup
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io/fs"
@@ -40,21 +41,26 @@ type Resolver struct {
 	cgroupResolver *cgroup.Resolver
 	nsUserCache    *lru.Cache[string, *EntryCache]
 	nsGroupCache   *lru.Cache[string, *EntryCache]
+	// users and groups of the host resolved through the name service switch, nil when disabled
+	nssUsers  *nssCache
+	nssGroups *nssCache
 }
 
+// containerFS gives access to the filesystem of a container through the root of its processes
 type containerFS struct {
-	cgroup *cgroupModel.CacheEntry
+	containerID    string
+	rootCandidates []uint32
 }
 
 // Open implements the fs.FS interface for containers
 func (fs *containerFS) Open(filename string) (fs.File, error) {
-	for _, rootCandidatePID := range fs.cgroup.GetPIDs() {
+	for _, rootCandidatePID := range fs.rootCandidates {
 		file, err := os.Open(filepath.Join(utils.ProcRootPath(rootCandidatePID), filename))
 		if err != nil {
 			if os.IsNotExist(err) {
-				seclog.Tracef("failed to read %s for pid %d of container %s: %s", filename, rootCandidatePID, fs.cgroup.ContainerID, err)
+				seclog.Tracef("failed to read %s for pid %d of container %s: %s", filename, rootCandidatePID, fs.containerID, err)
 			} else {
-				seclog.Debugf("failed to read %s for pid %d of container %s: %s", filename, rootCandidatePID, fs.cgroup.ContainerID, err)
+				seclog.Debugf("failed to read %s for pid %d of container %s: %s", filename, rootCandidatePID, fs.containerID, err)
 			}
 			continue
 		}
@@ -62,7 +68,7 @@ func (fs *containerFS) Open(filename string) (fs.File, error) {
 		return file, nil
 	}
 
-	return nil, fmt.Errorf("failed to resolve root filesystem for %s", fs.cgroup.ContainerID)
+	return nil, fmt.Errorf("failed to resolve root filesystem for %s", fs.containerID)
 }
 
 type hostFS struct{}
@@ -75,17 +81,23 @@ func (fs *hostFS) Open(path string) (fs.File, error) {
 	return os.Open(path)
 }
 
-func (r *Resolver) getFilesystem(containerID string) (fs.FS, error) {
-	var fsys fs.FS
+// getFilesystem returns the filesystem of the container, accessed through the root of the provided process first, as
+// the first processes of a container are resolved before being added to its workload
+func (r *Resolver) getFilesystem(containerID string, pid uint32) (fs.FS, error) {
+	if containerID == "" {
+		return &hostFS{}, nil
+	}
 
-	if containerID != "" {
-		cgroupEntry, found := r.cgroupResolver.GetWorkload(containerID)
-		if !found {
-			return nil, fmt.Errorf("failed to resolve container %s", containerID)
-		}
-		fsys = &containerFS{cgroup: cgroupEntry}
-	} else {
-		fsys = &hostFS{}
+	fsys := &containerFS{containerID: containerID}
+	if pid != 0 {
+		fsys.rootCandidates = append(fsys.rootCandidates, pid)
+	}
+	if cgroupEntry, found := r.cgroupResolver.GetWorkload(containerID); found {
+		fsys.rootCandidates = append(fsys.rootCandidates, cgroupEntry.GetPIDs()...)
+	}
+
+	if len(fsys.rootCandidates) == 0 {
+		return nil, fmt.Errorf("failed to resolve container %s", containerID)
 	}
 
 	return fsys, nil
@@ -93,7 +105,7 @@ func (r *Resolver) getFilesystem(containerID string) (fs.FS, error) {
 
 // RefreshCache refresh the user and group caches with data from files
 func (r *Resolver) RefreshCache(containerID string) error {
-	fsys, err := r.getFilesystem(containerID)
+	fsys, err := r.getFilesystem(containerID, 0)
 	if err != nil {
 		return err
 	}
@@ -151,8 +163,17 @@ func (r *Resolver) refreshGroupCache(containerID string, fsys fs.FS) (map[int]st
 	return entries, nil
 }
 
-// ResolveUser resolves a user id to a username
-func (r *Resolver) ResolveUser(uid int, containerID string) (string, error) {
+// ResolveUser resolves a user id to a username, from the files of the container of the provided process
+func (r *Resolver) ResolveUser(uid int, containerID string, pid uint32) (string, error) {
+	userName, err := r.resolveLocalUser(uid, containerID, pid)
+	if errors.Is(err, errUserNotFound) && containerID == "" && r.nssUsers != nil {
+		return r.nssUsers.resolve(uid, errUserNotFound)
+	}
+	return userName, err
+}
+
+// resolveLocalUser resolves a user id to a username from the passwd file of the host or of the container
+func (r *Resolver) resolveLocalUser(uid int, containerID string, pid uint32) (string, error) {
 	userCache, found := r.nsUserCache.Get(containerID)
 	if found {
 		cachedEntry, found := userCache.entries[uid]
@@ -162,7 +183,7 @@ func (r *Resolver) ResolveUser(uid int, containerID string) (string, error) {
 		return cachedEntry, nil
 	}
 
-	fsys, err := r.getFilesystem(containerID)
+	fsys, err := r.getFilesystem(containerID, pid)
 	if err != nil {
 		return "", err
 	}
@@ -180,8 +201,17 @@ func (r *Resolver) ResolveUser(uid int, containerID string) (string, error) {
 	return userName, nil
 }
 
-// ResolveGroup resolves a group id to a group name
-func (r *Resolver) ResolveGroup(gid int, containerID string) (string, error) {
+// ResolveGroup resolves a group id to a group name, from the files of the container of the provided process
+func (r *Resolver) ResolveGroup(gid int, containerID string, pid uint32) (string, error) {
+	groupName, err := r.resolveLocalGroup(gid, containerID, pid)
+	if errors.Is(err, errGroupNotFound) && containerID == "" && r.nssGroups != nil {
+		return r.nssGroups.resolve(gid, errGroupNotFound)
+	}
+	return groupName, err
+}
+
+// resolveLocalGroup resolves a group id to a group name from the group file of the host or of the container
+func (r *Resolver) resolveLocalGroup(gid int, containerID string, pid uint32) (string, error) {
 	groupCache, found := r.nsGroupCache.Get(containerID)
 	if found {
 		cachedEntry, found := groupCache.entries[gid]
@@ -191,7 +221,7 @@ func (r *Resolver) ResolveGroup(gid int, containerID string) (string, error) {
 		return cachedEntry, nil
 	}
 
-	fsys, err := r.getFilesystem(containerID)
+	fsys, err := r.getFilesystem(containerID, pid)
 	if err != nil {
 		return "", err
 	}
@@ -209,14 +239,35 @@ func (r *Resolver) ResolveGroup(gid int, containerID string) (string, error) {
 	return groupName, nil
 }
 
+// PopNSSResolved returns the uids and gids of the host resolved for the first time through the name service switch
+// since the previous call. The names of these ids were reported as not found until then.
+func (r *Resolver) PopNSSResolved() (map[int]struct{}, map[int]struct{}) {
+	if r.nssUsers == nil || r.nssGroups == nil {
+		return nil, nil
+	}
+	return r.nssUsers.popResolved(), r.nssGroups.popResolved()
+}
+
 // OnCGroupDeletedEvent is used to handle a CGroupDeleted event
-func (r *Resolver) OnCGroupDeletedEvent(sbom *cgroupModel.CacheEntry) {
-	r.nsGroupCache.Remove(string(sbom.CGroupID))
-	r.nsUserCache.Remove(string(sbom.CGroupID))
+func (r *Resolver) OnCGroupDeletedEvent(workload *cgroupModel.CacheEntry) {
+	// the caches are keyed by container ID
+	r.nsGroupCache.Remove(string(workload.ContainerID))
+	r.nsUserCache.Remove(string(workload.ContainerID))
 }
 
-// NewResolver instantiates a new user and group resolver
-func NewResolver(cgroupResolver *cgroup.Resolver) (*Resolver, error) {
+// Start the resolver
+func (r *Resolver) Start(ctx context.Context) {
+	if r.nssUsers != nil {
+		r.nssUsers.start(ctx)
+	}
+	if r.nssGroups != nil {
+		r.nssGroups.start(ctx)
+	}
+}
+
+// NewResolver instantiates a new user and group resolver. When nssEnabled is set, the ids of the host unknown to its
+// local files are resolved through the name service switch.
+func NewResolver(cgroupResolver *cgroup.Resolver, nssEnabled bool) (*Resolver, error) {
 	nsUserCache, err := lru.New[string, *EntryCache](64)
 	if err != nil {
 		return nil, err
@@ -227,9 +278,26 @@ func NewResolver(cgroupResolver *cgroup.Resolver) (*Resolver, error) {
 		return nil, err
 	}
 
-	return &Resolver{
+	r := &Resolver{
 		cgroupResolver: cgroupResolver,
 		nsUserCache:    nsUserCache,
 		nsGroupCache:   nsGroupCache,
-	}, nil
+	}
+
+	if nssEnabled {
+		// the name service switch of a containerized agent is the one of its image, not the one of the host
+		if os.Getenv("HOST_ROOT") != "" {
+			seclog.Warnf("the users and groups can't be resolved through the name service switch of the host from a container")
+			return r, nil
+		}
+
+		if r.nssUsers, err = newNSSCache(lookupUserName); err != nil {
+			return nil, err
+		}
+		if r.nssGroups, err = newNSSCache(lookupGroupName); err != nil {
+			return nil, err
+		}
+	}
+
+	return r, nil
 }
@@ -0,0 +1,54 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package usergroup holds usergroup related files
+package usergroup
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/DataDog/datadog-agent/pkg/security/resolvers/cgroup"
+	cgroupModel "github.com/DataDog/datadog-agent/pkg/security/resolvers/cgroup/model"
+)
+
+func TestResolveContainerUser(t *testing.T) {
+	if _, err := os.Stat("/etc/passwd"); err != nil {
+		t.Skip("no passwd file")
+	}
+
+	cgroupResolver, err := cgroup.NewResolver()
+	assert.NoError(t, err)
+
+	r, err := NewResolver(cgroupResolver, false)
+	assert.NoError(t, err)
+
+	// the container isn't known yet, its files are read through the root of the process
+	const containerID = "aAbBcCdDeEfF2345678901234567890123456789012345678901234567890123"
+	pid := uint32(os.Getpid())
+
+	name, err := r.ResolveUser(0, containerID, pid)
+	assert.NoError(t, err)
+	assert.Equal(t, "root", name)
+
+	name, err = r.ResolveGroup(0, containerID, pid)
+	assert.NoError(t, err)
+	assert.Equal(t, "root", name)
+
+	_, found := r.nsUserCache.Get(containerID)
+	assert.True(t, found)
+
+	// the resolution fails without any process of the container
+	_, err = r.ResolveUser(0, "other", 0)
+	assert.Error(t, err)
+
+	workload, err := cgroupModel.NewCacheEntry(containerID, 0)
+	assert.NoError(t, err)
+	r.OnCGroupDeletedEvent(workload)
+	_, found = r.nsUserCache.Get(containerID)
+	assert.False(t, found)
+}
@@ -8,9 +8,11 @@ package bundled
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/DataDog/datadog-agent/pkg/security/config"
 	"github.com/DataDog/datadog-agent/pkg/security/secl/rules"
+	"github.com/DataDog/datadog-agent/pkg/security/utils"
 )
 
 func newBundledPolicyRules(cfg *config.RuntimeSecurityConfig) []*rules.RuleDefinition {
@@ -43,5 +45,63 @@ func newBundledPolicyRules(cfg *config.RuntimeSecurityConfig) []*rules.RuleDefin
 		})
 	}
 
+	if cfg.CanaryFilesEnabled && len(cfg.CanaryFilesPaths) > 0 {
+		ruleDefinitions = append(ruleDefinitions, newCanaryFilesRules(cfg.CanaryFilesPaths)...)
+	}
+
+	if cfg.GPUAccessDetectionEnabled {
+		ruleDefinitions = append(ruleDefinitions, newGPUDeviceAccessRule())
+	}
+
+	if cfg.ContainerEscapeEnabled {
+		ruleDefinitions = append(ruleDefinitions, newContainerEscapeRules(cfg)...)
+	}
+
+	return ruleDefinitions
+}
+
+// canaryFilesEventTypes lists the event types triggering a canary file alert
+var canaryFilesEventTypes = []string{"open", "unlink", "rename", "chmod", "chown", "utimes", "link"}
+
+// gpuDevicePaths lists the device files used to access the NVIDIA and AMD GPUs
+var gpuDevicePaths = []string{`~"/dev/nvidia*"`, `"/dev/kfd"`, `~"/dev/dri/renderD*"`}
+
+// newGPUDeviceAccessRule returns the rule matching the accesses of the containers to the GPU devices. The allowed
+// images are not part of the expression as the container tags are usually not resolved yet when the first accesses
+// are evaluated, they are filtered once the event is sent, see IsGPUAccessAllowed.
+func newGPUDeviceAccessRule() *rules.RuleDefinition {
+	return &rules.RuleDefinition{
+		ID:          GPUDeviceAccessRuleID,
+		Expression:  fmt.Sprintf(`open.file.path in [%s] && container.id != ""`, strings.Join(gpuDevicePaths, ", ")),
+		Description: "A container accessed a GPU device",
+		Tags: map[string]string{
+			"severity": "medium",
+		},
+	}
+}
+
+// newCanaryFilesRules returns the rules matching the accesses to the canary files. The only legitimate accesses are the
+// ones of system-probe deploying and removing the files, they are excluded by pid as a path based exclusion could be
+// spoofed by running any binary from the agent install directory.
+func newCanaryFilesRules(paths []string) []*rules.RuleDefinition {
+	quoted := make([]string, 0, len(paths))
+	for _, path := range paths {
+		quoted = append(quoted, fmt.Sprintf("%q", path))
+	}
+	pathList := strings.Join(quoted, ", ")
+	pid := utils.Getpid()
+
+	ruleDefinitions := make([]*rules.RuleDefinition, 0, len(canaryFilesEventTypes))
+	for _, eventType := range canaryFilesEventTypes {
+		ruleDefinitions = append(ruleDefinitions, &rules.RuleDefinition{
+			ID:          CanaryFileRuleIDPrefix + "_" + eventType,
+			Expression:  fmt.Sprintf(`%s.file.path in [%s] && process.pid != %d`, eventType, pathList, pid),
+			Description: "A canary file was accessed",
+			Tags: map[string]string{
+				"severity": "high",
+			},
+		})
+	}
+
 	return ruleDefinitions
 }
@@ -0,0 +1,140 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package bundled contains bundled rules
+package bundled
+
+import (
+	"path/filepath"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/DataDog/datadog-agent/pkg/security/config"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/ast"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/eval"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/containerutils"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/rules"
+)
+
+func TestGPUDeviceAccessRule(t *testing.T) {
+	ruleOpts, evalOpts := rules.NewBothOpts(map[eval.EventType]bool{"*": true})
+	rs := rules.NewRuleSet(&model.Model{}, func() eval.Event { return model.NewFakeEvent() }, ruleOpts, evalOpts)
+
+	policy := &rules.Policy{Name: "bundled_policy", Def: &rules.PolicyDef{}}
+	pRule := &rules.PolicyRule{Def: newGPUDeviceAccessRule(), Policy: policy}
+	assert.Nil(t, rs.AddRules(ast.NewParsingContext(false), []*rules.PolicyRule{pRule}).ErrorOrNil())
+
+	newOpenEvent := func(path string, containerID string) eval.Event {
+		event := model.NewFakeEvent()
+		event.Type = uint32(model.FileOpenEventType)
+		event.Open.File.PathnameStr = path
+		event.ContainerContext.ContainerID = containerutils.ContainerID(containerID)
+		return event
+	}
+
+	assert.True(t, rs.Evaluate(newOpenEvent("/dev/nvidia0", "abc")))
+	assert.True(t, rs.Evaluate(newOpenEvent("/dev/kfd", "abc")))
+	assert.True(t, rs.Evaluate(newOpenEvent("/dev/dri/renderD128", "abc")))
+	assert.False(t, rs.Evaluate(newOpenEvent("/dev/null", "abc")))
+	assert.False(t, rs.Evaluate(newOpenEvent("/dev/nvidia0", "")))
+
+	// the allowed images are filtered once the container tags are resolved
+	allowedImages := []string{"cuda-training", `weird"image`}
+	assert.True(t, IsGPUAccessAllowed(allowedImages, []string{"image_name:cuda-training", "image_tag:v1"}))
+	assert.True(t, IsGPUAccessAllowed(allowedImages, []string{`image_name:weird"image`}))
+	assert.False(t, IsGPUAccessAllowed(allowedImages, []string{"image_name:nginx"}))
+	assert.False(t, IsGPUAccessAllowed(allowedImages, nil))
+}
+
+func TestContainerEscapeRules(t *testing.T) {
+	cfg := &config.RuntimeSecurityConfig{
+		ContainerEscapeEnabled:       true,
+		ContainerEscapeNsenter:       true,
+		ContainerEscapeCorePattern:   true,
+		ContainerEscapeReleaseAgent:  false,
+		ContainerEscapeProcSysKernel: true,
+	}
+
+	ruleDefs := newContainerEscapeRules(cfg)
+	assert.Len(t, ruleDefs, 3)
+
+	ruleOpts, evalOpts := rules.NewBothOpts(map[eval.EventType]bool{"*": true})
+	rs := rules.NewRuleSet(&model.Model{}, func() eval.Event { return model.NewFakeEvent() }, ruleOpts, evalOpts)
+
+	policy := &rules.Policy{Name: "bundled_policy", Def: &rules.PolicyDef{}, IsInternal: true}
+	var pRules []*rules.PolicyRule
+	for _, ruleDef := range ruleDefs {
+		assert.Equal(t, ContainerEscapeBundleVersion, ruleDef.Version)
+		pRules = append(pRules, &rules.PolicyRule{Def: ruleDef, Policy: policy})
+	}
+	assert.Nil(t, rs.AddRules(ast.NewParsingContext(false), pRules).ErrorOrNil())
+
+	newOpenEvent := func(path string, filesystem string, flags int, containerID string) eval.Event {
+		event := model.NewFakeEvent()
+		event.Type = uint32(model.FileOpenEventType)
+		event.Open.File.PathnameStr = path
+		event.Open.File.BasenameStr = filepath.Base(path)
+		event.Open.File.Filesystem = filesystem
+		event.Open.Flags = uint32(flags)
+		event.ContainerContext.ContainerID = containerutils.ContainerID(containerID)
+		return event
+	}
+
+	newExecEvent := func(name string, argv []string, containerID string) eval.Event {
+		event := model.NewFakeEvent()
+		event.Type = uint32(model.ExecEventType)
+		event.ProcessContext = &model.ProcessContext{}
+		event.Exec.Process = &event.ProcessContext.Process
+		event.Exec.FileEvent.BasenameStr = name
+		event.Exec.Argv = argv
+		event.ContainerContext.ContainerID = containerutils.ContainerID(containerID)
+		return event
+	}
+
+	assert.True(t, rs.Evaluate(newOpenEvent("/proc/sys/kernel/core_pattern", "proc", syscall.O_WRONLY, "abc")))
+	assert.False(t, rs.Evaluate(newOpenEvent("/proc/sys/kernel/core_pattern", "proc", syscall.O_RDONLY, "abc")))
+	assert.False(t, rs.Evaluate(newOpenEvent("/proc/sys/kernel/core_pattern", "proc", syscall.O_WRONLY, "")))
+	assert.True(t, rs.Evaluate(newOpenEvent("/proc/sys/kernel/modprobe", "proc", syscall.O_RDWR, "abc")))
+	assert.False(t, rs.Evaluate(newOpenEvent("/proc/sys/net/ipv4/ip_forward", "proc", syscall.O_WRONLY, "abc")))
+	// disabled technique
+	assert.False(t, rs.Evaluate(newOpenEvent("/sys/fs/cgroup/rdma/release_agent", "cgroup", syscall.O_WRONLY, "abc")))
+
+	// the fake field handlers return the arguments as the parsed options
+	newNsenterEvent := func(argv []string, pidNamespace uint32, containerID string) eval.Event {
+		event := newExecEvent("nsenter", argv, containerID).(*model.Event)
+		event.ProcessContext.PIDNamespace = pidNamespace
+		event.ProcessContext.Parent = &model.Process{PIDNamespace: pidNamespace}
+		return event
+	}
+	assert.True(t, rs.Evaluate(newNsenterEvent([]string{"target=1", "mount", "pid"}, 4026531836, "abc")))
+	assert.True(t, rs.Evaluate(newNsenterEvent([]string{"t=1", "m"}, 4026531836, "abc")))
+	assert.False(t, rs.Evaluate(newNsenterEvent([]string{"t=1234", "m"}, 4026531836, "abc")))
+	assert.False(t, rs.Evaluate(newNsenterEvent([]string{"t=1", "m"}, 4026531836, "")))
+	// without the host PID namespace, the pid 1 is the init process of the container
+	assert.False(t, rs.Evaluate(newNsenterEvent([]string{"t=1", "m"}, 4026532500, "abc")))
+
+	// a process of a container entering the host PID namespace, whatever the tool calling setns
+	newSetnsExecEvent := func(pidNamespace, parentPIDNamespace uint32, containerID string) eval.Event {
+		event := newExecEvent("sh", nil, containerID).(*model.Event)
+		event.ProcessContext.PIDNamespace = pidNamespace
+		event.ProcessContext.Parent = &model.Process{PIDNamespace: parentPIDNamespace}
+		return event
+	}
+	assert.True(t, rs.Evaluate(newSetnsExecEvent(4026531836, 4026532500, "abc")))
+	// the containers sharing the PID namespace of the host
+	assert.False(t, rs.Evaluate(newSetnsExecEvent(4026531836, 4026531836, "abc")))
+	// a new PID namespace created in a container
+	assert.False(t, rs.Evaluate(newSetnsExecEvent(4026532600, 4026532500, "abc")))
+	assert.False(t, rs.Evaluate(newSetnsExecEvent(4026531836, 4026532500, "")))
+
+	technique, ok := ContainerEscapeTechnique(ruleDefs[0].ID)
+	assert.True(t, ok)
+	assert.Equal(t, ContainerEscapeNsenter, technique)
+	_, ok = ContainerEscapeTechnique(GPUDeviceAccessRuleID)
+	assert.False(t, ok)
+}
@@ -0,0 +1,81 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package bundled contains bundled rules
+package bundled
+
+import (
+	"github.com/DataDog/datadog-agent/pkg/security/config"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/rules"
+)
+
+const (
+	writeFlags = `(open.flags & (O_RDWR | O_WRONLY)) > 0`
+	// hostPIDNamespace is the inode number of the initial PID namespace, fixed by the kernel
+	hostPIDNamespace = "4026531836"
+)
+
+type containerEscapeRule struct {
+	technique   string
+	expression  string
+	description string
+}
+
+// containerEscapeRules lists the rules of the container escape techniques
+var containerEscapeRules = []containerEscapeRule{
+	{
+		technique: ContainerEscapeNsenter,
+		// the pid 1 targeted by nsenter is the host init process only when the container shares the host PID
+		// namespace, otherwise it's the init process of the container itself. The processes entering the host PID
+		// namespace with setns are matched whatever the tool used.
+		expression: `container.id != "" && exec.ns.pid == ` + hostPIDNamespace + ` && ` +
+			`((exec.file.name == "nsenter" && exec.args_options in ["t=1", "target=1"]) || exec.ns.pid != process.parent.ns.pid)`,
+		description: "A container entered the namespaces of the host init process",
+	},
+	{
+		technique:   ContainerEscapeCorePattern,
+		expression:  `open.file.name == "core_pattern" && open.file.filesystem == "proc" && ` + writeFlags + ` && container.id != ""`,
+		description: "A container modified the core dump handler of the host",
+	},
+	{
+		technique:   ContainerEscapeReleaseAgent,
+		expression:  `open.file.name == "release_agent" && open.file.filesystem == "cgroup" && ` + writeFlags + ` && container.id != ""`,
+		description: "A container modified the release agent of a cgroup hierarchy",
+	},
+	{
+		technique:   ContainerEscapeProcSysKernel,
+		expression:  `open.file.path =~ "/proc/sys/kernel/**" && open.file.filesystem == "proc" && open.file.name != "core_pattern" && ` + writeFlags + ` && container.id != ""`,
+		description: "A container modified a kernel parameter",
+	},
+}
+
+func newContainerEscapeRules(cfg *config.RuntimeSecurityConfig) []*rules.RuleDefinition {
+	enabled := map[string]bool{
+		ContainerEscapeNsenter:       cfg.ContainerEscapeNsenter,
+		ContainerEscapeCorePattern:   cfg.ContainerEscapeCorePattern,
+		ContainerEscapeReleaseAgent:  cfg.ContainerEscapeReleaseAgent,
+		ContainerEscapeProcSysKernel: cfg.ContainerEscapeProcSysKernel,
+	}
+
+	var ruleDefinitions []*rules.RuleDefinition
+	for _, rule := range containerEscapeRules {
+		if !enabled[rule.technique] {
+			continue
+		}
+
+		ruleDefinitions = append(ruleDefinitions, &rules.RuleDefinition{
+			ID:          ContainerEscapeRuleIDPrefix + "_" + rule.technique,
+			Version:     ContainerEscapeBundleVersion,
+			Expression:  rule.expression,
+			Description: rule.description,
+			Tags: map[string]string{
+				"severity":  "critical",
+				"technique": rule.technique,
+			},
+		})
+	}
+
+	return ruleDefinitions
+}
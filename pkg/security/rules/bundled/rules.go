@@ -6,6 +6,13 @@
 // Package bundled contains bundled rules
 package bundled
 
+import (
+	"slices"
+	"strings"
+
+	"github.com/DataDog/datadog-agent/pkg/security/utils"
+)
+
 const (
 	// RefreshUserCacheRuleID is the rule ID used to refresh users and groups cache
 	RefreshUserCacheRuleID = "refresh_user_cache"
@@ -15,4 +22,40 @@ const (
 
 	// NeedRefreshSBOMRuleID is the rule ID used to request a SBOM refresh
 	NeedRefreshSBOMRuleID = "need_refresh_sbom"
+
+	// CanaryFileRuleIDPrefix is the prefix of the rule IDs used to detect accesses to canary files
+	CanaryFileRuleIDPrefix = "canary_file"
+
+	// GPUDeviceAccessRuleID is the rule ID used to detect the accesses of containers to the GPU devices
+	GPUDeviceAccessRuleID = "gpu_device_access"
+
+	// ContainerEscapeRuleIDPrefix is the prefix of the rule IDs used to detect the container escape techniques
+	ContainerEscapeRuleIDPrefix = "container_escape"
+
+	// ContainerEscapeBundleVersion is the version of the container escape rules, bumped whenever they change
+	ContainerEscapeBundleVersion = "1.0.0"
+)
+
+// Container escape techniques
+const (
+	// ContainerEscapeNsenter is the technique of a container entering the namespaces of the host with nsenter
+	ContainerEscapeNsenter = "nsenter"
+	// ContainerEscapeCorePattern is the technique of a container setting the core dump handler of the host
+	ContainerEscapeCorePattern = "core_pattern"
+	// ContainerEscapeReleaseAgent is the technique of a container setting the release agent of a cgroup v1 hierarchy
+	ContainerEscapeReleaseAgent = "release_agent"
+	// ContainerEscapeProcSysKernel is the technique of a container modifying the kernel parameters
+	ContainerEscapeProcSysKernel = "proc_sys_kernel"
 )
+
+// ContainerEscapeTechnique returns the container escape technique detected by the provided bundled rule
+func ContainerEscapeTechnique(ruleID string) (string, bool) {
+	return strings.CutPrefix(ruleID, ContainerEscapeRuleIDPrefix+"_")
+}
+
+// IsGPUAccessAllowed returns whether the container with the provided tags runs one of the images allowed to access
+// the GPU devices
+func IsGPUAccessAllowed(allowedImages []string, tags []string) bool {
+	image := utils.GetTagValue("image_name", tags)
+	return image != "" && slices.Contains(allowedImages, image)
+}
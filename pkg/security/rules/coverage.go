@@ -0,0 +1,69 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package rules holds rules related files
+package rules
+
+import (
+	"errors"
+
+	"github.com/DataDog/datadog-agent/pkg/security/probe"
+	"github.com/DataDog/datadog-agent/pkg/security/rules/monitor"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/eval"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/rules"
+)
+
+const (
+	// RuleStatusMissingProbes is the status of the loaded rules for which none of the probes could be attached
+	RuleStatusMissingProbes = "missing_probes"
+	// RuleStatusUnsupportedKernel is the status of the loaded rules whose hook points don't exist on the running kernel
+	RuleStatusUnsupportedKernel = "unsupported_kernel"
+)
+
+// coverageStatus returns the rule status matching the reason why an event type is inactive
+func coverageStatus(err error) string {
+	switch {
+	case errors.Is(err, probe.ErrEventTypeDisabled):
+		return string(rules.EventTypeNotEnabledErrType)
+	case errors.Is(err, probe.ErrUnsupportedKernel):
+		return RuleStatusUnsupportedKernel
+	default:
+		return RuleStatusMissingProbes
+	}
+}
+
+// applyEventTypeCoverage returns a copy of the policy states where the loaded rules of the inactive event types are
+// reported with the reason why they can't fire
+func applyEventTypeCoverage(policies []*monitor.PolicyState, rs *rules.RuleSet, inactive map[eval.EventType]error) []*monitor.PolicyState {
+	if len(inactive) == 0 {
+		return policies
+	}
+
+	ruleSetRules := rs.GetRules()
+
+	covered := make([]*monitor.PolicyState, 0, len(policies))
+	for _, policy := range policies {
+		policyCopy := *policy
+		policyCopy.Rules = make([]*monitor.RuleState, 0, len(policy.Rules))
+
+		for _, ruleState := range policy.Rules {
+			if rule, exists := ruleSetRules[ruleState.ID]; exists && ruleState.Status == "loaded" {
+				if eventType, err := rule.GetEventType(); err == nil {
+					if reason, isInactive := inactive[eventType]; isInactive {
+						ruleStateCopy := *ruleState
+						ruleStateCopy.Status = coverageStatus(reason)
+						ruleStateCopy.Message = reason.Error()
+						ruleState = &ruleStateCopy
+					}
+				}
+			}
+			policyCopy.Rules = append(policyCopy.Rules, ruleState)
+		}
+
+		covered = append(covered, &policyCopy)
+	}
+
+	return covered
+}
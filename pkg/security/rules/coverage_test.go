@@ -0,0 +1,62 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package rules holds rules related files
+package rules
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/DataDog/datadog-agent/pkg/security/probe"
+	"github.com/DataDog/datadog-agent/pkg/security/rules/monitor"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/ast"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/eval"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/rules"
+)
+
+func TestApplyEventTypeCoverage(t *testing.T) {
+	ruleOpts, evalOpts := rules.NewBothOpts(map[eval.EventType]bool{"*": true})
+	rs := rules.NewRuleSet(&model.Model{}, func() eval.Event { return model.NewFakeEvent() }, ruleOpts, evalOpts)
+
+	policy := &rules.Policy{Name: "default.policy"}
+	pRules := []*rules.PolicyRule{
+		{Def: &rules.RuleDefinition{ID: "exec_shell", Expression: `exec.file.name == "sh"`}, Policy: policy},
+		{Def: &rules.RuleDefinition{ID: "bpf_load", Expression: `bpf.cmd == BPF_PROG_LOAD`}, Policy: policy},
+		{Def: &rules.RuleDefinition{ID: "imds_creds", Expression: `imds.cloud_provider == "aws"`}, Policy: policy},
+	}
+	assert.Nil(t, rs.AddRules(ast.NewParsingContext(false), pRules).ErrorOrNil())
+
+	policies := []*monitor.PolicyState{{
+		Name: "default.policy",
+		Rules: []*monitor.RuleState{
+			{ID: "exec_shell", Status: "loaded"},
+			{ID: "bpf_load", Status: "loaded"},
+			{ID: "imds_creds", Status: "loaded"},
+			{ID: "unknown_field", Status: string(rules.SyntaxErrType), Message: "syntax error"},
+		},
+	}}
+
+	covered := applyEventTypeCoverage(policies, rs, map[eval.EventType]error{
+		"bpf":  probe.ErrUnsupportedKernel,
+		"imds": probe.ErrEventTypeDisabled,
+	})
+
+	statuses := make(map[string]string)
+	for _, rule := range covered[0].Rules {
+		statuses[rule.ID] = rule.Status
+	}
+	assert.Equal(t, map[string]string{
+		"exec_shell":    "loaded",
+		"bpf_load":      RuleStatusUnsupportedKernel,
+		"imds_creds":    string(rules.EventTypeNotEnabledErrType),
+		"unknown_field": string(rules.SyntaxErrType),
+	}, statuses)
+
+	// the policy states reported to the backend are left untouched
+	assert.Equal(t, "loaded", policies[0].Rules[1].Status)
+}
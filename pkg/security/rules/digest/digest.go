@@ -0,0 +1,150 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package digest holds digest related files
+package digest
+
+import (
+	json "encoding/json"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/DataDog/datadog-agent/pkg/security/events"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/rules"
+)
+
+// Entry counts the matches of a rule for a container and a process
+type Entry struct {
+	ContainerID string `json:"container_id,omitempty"`
+	ProcessPath string `json:"process_path,omitempty"`
+	Count       uint64 `json:"count"`
+}
+
+// RuleDigest summarizes the matches of a rule in digest mode
+type RuleDigest struct {
+	RuleID  rules.RuleID `json:"rule_id"`
+	Count   uint64       `json:"count"`
+	Entries []*Entry     `json:"entries"`
+	// Dropped is the number of matches that weren't accounted in an entry because the maximum number of entries was reached
+	Dropped uint64 `json:"dropped,omitempty"`
+}
+
+// Event is used to report the matches of the rules in digest mode
+type Event struct {
+	events.CustomEventCommonFields
+	Start time.Time     `json:"start"`
+	End   time.Time     `json:"end"`
+	Rules []*RuleDigest `json:"rules"`
+}
+
+// ToJSON marshal using json format
+func (e Event) ToJSON() ([]byte, error) {
+	return json.Marshal(e)
+}
+
+type entryKey struct {
+	containerID string
+	processPath string
+}
+
+type ruleDigest struct {
+	count   uint64
+	dropped uint64
+	entries map[entryKey]uint64
+}
+
+// Digest accumulates the matches of the rules in digest mode, so that they are reported periodically in a single
+// event instead of individually
+type Digest struct {
+	sync.Mutex
+	maxEntries int
+	start      time.Time
+	rules      map[rules.RuleID]*ruleDigest
+}
+
+// NewDigest returns a new digest reporting at most maxEntries entries per rule
+func NewDigest(maxEntries int) *Digest {
+	return &Digest{
+		maxEntries: maxEntries,
+		start:      time.Now(),
+		rules:      make(map[rules.RuleID]*ruleDigest),
+	}
+}
+
+// Add accounts a match of the provided rule
+func (d *Digest) Add(rule *rules.Rule, event *model.Event) {
+	key := entryKey{
+		containerID: event.FieldHandlers.ResolveContainerID(event, event.ContainerContext),
+	}
+	if event.ProcessContext != nil {
+		key.processPath = event.FieldHandlers.ResolveFilePath(event, &event.ProcessContext.Process.FileEvent)
+	}
+
+	d.Lock()
+	defer d.Unlock()
+
+	rd, exists := d.rules[rule.ID]
+	if !exists {
+		rd = &ruleDigest{
+			entries: make(map[entryKey]uint64),
+		}
+		d.rules[rule.ID] = rd
+	}
+	rd.count++
+
+	if _, exists := rd.entries[key]; !exists && len(rd.entries) >= d.maxEntries {
+		rd.dropped++
+		return
+	}
+	rd.entries[key]++
+}
+
+// Flush returns the digest event of the matches accounted since the previous flush, or nil if there was none
+func (d *Digest) Flush(acc *events.AgentContainerContext) (*rules.Rule, *events.CustomEvent) {
+	d.Lock()
+	start, digests := d.start, d.rules
+	d.start = time.Now()
+	d.rules = make(map[rules.RuleID]*ruleDigest)
+	d.Unlock()
+
+	if len(digests) == 0 {
+		return nil, nil
+	}
+
+	evt := Event{
+		Start: start,
+		End:   time.Now(),
+		Rules: make([]*RuleDigest, 0, len(digests)),
+	}
+	evt.FillCustomEventCommonFields(acc)
+
+	for ruleID, rd := range digests {
+		digest := &RuleDigest{
+			RuleID:  ruleID,
+			Count:   rd.count,
+			Dropped: rd.dropped,
+			Entries: make([]*Entry, 0, len(rd.entries)),
+		}
+		for key, count := range rd.entries {
+			digest.Entries = append(digest.Entries, &Entry{
+				ContainerID: key.containerID,
+				ProcessPath: key.processPath,
+				Count:       count,
+			})
+		}
+		sort.Slice(digest.Entries, func(i, j int) bool {
+			return digest.Entries[i].Count > digest.Entries[j].Count
+		})
+		evt.Rules = append(evt.Rules, digest)
+	}
+	sort.Slice(evt.Rules, func(i, j int) bool {
+		return evt.Rules[i].RuleID < evt.Rules[j].RuleID
+	})
+
+	return events.NewCustomRule(events.RuleDigestRuleID, events.RuleDigestRuleDesc),
+		events.NewCustomEvent(model.CustomEventType, evt)
+}
@@ -0,0 +1,66 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package digest holds digest related files
+package digest
+
+import (
+	json "encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/DataDog/datadog-agent/pkg/security/events"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/eval"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/containerutils"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/rules"
+)
+
+func newEvent(containerID string, processPath string) *model.Event {
+	event := model.NewFakeEvent()
+	event.ContainerContext.ContainerID = containerutils.ContainerID(containerID)
+	event.ProcessContext = &model.ProcessContext{}
+	event.ProcessContext.Process.FileEvent.PathnameStr = processPath
+	return event
+}
+
+func TestDigest(t *testing.T) {
+	d := NewDigest(2)
+
+	world := &rules.Rule{Rule: &eval.Rule{ID: "world_writable"}}
+	setuid := &rules.Rule{Rule: &eval.Rule{ID: "setuid_file"}}
+
+	rule, event := d.Flush(nil)
+	assert.Nil(t, rule)
+	assert.Nil(t, event)
+
+	for i := 0; i < 3; i++ {
+		d.Add(world, newEvent("abc", "/usr/bin/chmod"))
+	}
+	d.Add(world, newEvent("", "/usr/bin/install"))
+	d.Add(world, newEvent("def", "/usr/bin/chmod"))
+	d.Add(setuid, newEvent("abc", "/usr/bin/chmod"))
+
+	rule, event = d.Flush(nil)
+	assert.Equal(t, events.RuleDigestRuleID, rule.ID)
+
+	assert.Empty(t, d.rules)
+
+	data, err := event.MarshalJSON()
+	assert.NoError(t, err)
+
+	var digest struct {
+		Rules json.RawMessage `json:"rules"`
+	}
+	assert.NoError(t, json.Unmarshal(data, &digest))
+	assert.JSONEq(t, `[
+		{"rule_id": "setuid_file", "count": 1, "entries": [{"container_id": "abc", "process_path": "/usr/bin/chmod", "count": 1}]},
+		{"rule_id": "world_writable", "count": 5, "dropped": 1, "entries": [
+			{"container_id": "abc", "process_path": "/usr/bin/chmod", "count": 3},
+			{"process_path": "/usr/bin/install", "count": 1}
+		]}
+	]`, string(digest.Rules))
+}
@@ -0,0 +1,53 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package rules holds rules related files
+package rules
+
+import (
+	"sort"
+
+	"github.com/DataDog/datadog-agent/pkg/security/rules/monitor"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/rules"
+)
+
+// EffectiveRule describes a loaded rule once the definitions sharing its ID, coming from the policies of all the
+// sources, were merged according to the precedence of their provider and to their override options
+type EffectiveRule struct {
+	*rules.RuleDefinition
+	// Policy is the policy the rule was loaded from, the one of the provider with the highest precedence
+	Policy *monitor.PolicyState `json:"policy"`
+	// ModifiedBy lists, in the order they were applied, the policies that overrode, disabled or enabled the rule
+	ModifiedBy []*monitor.PolicyState `json:"modified_by,omitempty"`
+}
+
+func newEffectiveRules(rs *rules.RuleSet) []*EffectiveRule {
+	effectiveRules := make([]*EffectiveRule, 0, len(rs.GetRules()))
+	for _, rule := range rs.GetRules() {
+		effectiveRule := &EffectiveRule{
+			RuleDefinition: rule.Def,
+			Policy:         monitor.PolicyStateFromRule(rule.PolicyRule),
+		}
+		for _, modRule := range rule.ModifiedBy {
+			effectiveRule.ModifiedBy = append(effectiveRule.ModifiedBy, monitor.PolicyStateFromRule(modRule))
+		}
+		effectiveRules = append(effectiveRules, effectiveRule)
+	}
+
+	sort.Slice(effectiveRules, func(i, j int) bool {
+		return effectiveRules[i].ID < effectiveRules[j].ID
+	})
+
+	return effectiveRules
+}
+
+// GetEffectiveRules returns the rules of the current rule set once merged
+func (e *RuleEngine) GetEffectiveRules() []*EffectiveRule {
+	rs := e.GetRuleSet()
+	if rs == nil {
+		return nil
+	}
+	return newEffectiveRules(rs)
+}
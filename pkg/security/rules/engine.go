@@ -13,6 +13,7 @@ import (
 	"fmt"
 	"os"
 	"runtime"
+	"strings"
 	"sync"
 	"time"
 
@@ -29,8 +30,10 @@ import (
 	"github.com/DataDog/datadog-agent/pkg/security/rconfig"
 	"github.com/DataDog/datadog-agent/pkg/security/rules/autosuppression"
 	"github.com/DataDog/datadog-agent/pkg/security/rules/bundled"
+	"github.com/DataDog/datadog-agent/pkg/security/rules/digest"
 	"github.com/DataDog/datadog-agent/pkg/security/rules/filtermodel"
 	"github.com/DataDog/datadog-agent/pkg/security/rules/monitor"
+	"github.com/DataDog/datadog-agent/pkg/security/rules/runaway"
 	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/eval"
 	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
 	"github.com/DataDog/datadog-agent/pkg/security/secl/rules"
@@ -64,6 +67,8 @@ type RuleEngine struct {
 	eventSender      events.EventSender
 	rulesetListeners []rules.RuleSetListener
 	AutoSuppression  autosuppression.AutoSuppression
+	digest           *digest.Digest
+	runaway          *runaway.Guard
 	pid              uint32
 }
 
@@ -87,9 +92,14 @@ func NewRuleEngine(evm *eventmonitor.EventMonitor, config *config.RuntimeSecurit
 		policyLoader:     rules.NewPolicyLoader(),
 		statsdClient:     statsdClient,
 		rulesetListeners: rulesetListeners,
+		digest:           digest.NewDigest(config.RuleDigestMaxEntries),
 		pid:              utils.Getpid(),
 	}
 
+	if config.RunawayRulesEnabled {
+		engine.runaway = runaway.NewGuard(uint64(config.RunawayRulesMaxRate), config.RunawayRulesPeriod, config.RunawayRulesCooldown)
+	}
+
 	engine.AutoSuppression.Init(autosuppression.Opts{
 		SecurityProfileEnabled:                config.SecurityProfileEnabled,
 		SecurityProfileAutoSuppressionEnabled: config.SecurityProfileAutoSuppressionEnabled,
@@ -110,6 +120,12 @@ func NewRuleEngine(evm *eventmonitor.EventMonitor, config *config.RuntimeSecurit
 
 // Start the rule engine
 func (e *RuleEngine) Start(ctx context.Context, reloadChan <-chan struct{}, wg *sync.WaitGroup) error {
+	if disabled := e.config.GetDisabledEventTypes(); len(disabled) > 0 {
+		seclog.Infof("event types matrix %v, disabled event types: %s", e.config.EventTypesMatrix, strings.Join(disabled, ", "))
+	} else {
+		seclog.Infof("event types matrix: all the event types are enabled")
+	}
+
 	// monitor policies
 	if e.config.PolicyMonitorEnabled {
 		e.policyMonitor.Start(ctx)
@@ -265,9 +281,38 @@ func (e *RuleEngine) Start(ctx context.Context, reloadChan <-chan struct{}, wg *
 			}
 		}
 	}()
+
+	// Sending the digest of the rules in digest mode
+	if e.config.RuleDigestPeriod > 0 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			digestTicker := time.NewTicker(e.config.RuleDigestPeriod)
+			defer digestTicker.Stop()
+
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-digestTicker.C:
+					e.FlushDigest()
+				}
+			}
+		}()
+	} else {
+		seclog.Warnf("the digest period isn't positive, the matches of the rules in digest mode are reported individually")
+	}
 	return nil
 }
 
+// FlushDigest sends the digest of the matches of the rules in digest mode accounted since the previous flush
+func (e *RuleEngine) FlushDigest() {
+	if rule, event := e.digest.Flush(e.probe.GetAgentContainerContext()); event != nil {
+		e.eventSender.SendEvent(rule, event, nil, "")
+	}
+}
+
 // ReloadPolicies reloads the policies
 func (e *RuleEngine) ReloadPolicies() error {
 	seclog.Infof("reload policies")
@@ -345,8 +390,16 @@ func (e *RuleEngine) LoadPolicies(providers []rules.PolicyProvider, sendLoadedRe
 	// update the stats of auto-suppression rules
 	e.AutoSuppression.Apply(rs)
 
+	// the rules disabled because of their match rate get a new chance with the new policies
+	if e.runaway != nil {
+		e.runaway.Reset()
+	}
+
 	policies := monitor.NewPoliciesState(rs, loadErrs, e.config.PolicyMonitorReportInternalPolicies)
-	e.notifyAPIServer(ruleIDs, policies)
+
+	// report the loaded rules that can't fire on this host through the API server
+	inactiveEventTypes := e.probe.GetInactiveEventTypes(rs.GetEventTypes())
+	e.notifyAPIServer(ruleIDs, applyEventTypeCoverage(policies, rs, inactiveEventTypes))
 
 	if sendLoadedReport {
 		monitor.ReportRuleSetLoaded(e.probe.GetAgentContainerContext(), e.eventSender, e.statsdClient, policies)
@@ -417,12 +470,28 @@ func (e *RuleEngine) RuleMatch(rule *rules.Rule, event eval.Event) bool {
 		return false
 	}
 
+	if technique, ok := bundled.ContainerEscapeTechnique(rule.ID); ok && rule.Policy.IsInternal {
+		_ = e.statsdClient.Count(metrics.MetricContainerEscapeMatches, 1, []string{"technique:" + technique, "bundle_version:" + bundled.ContainerEscapeBundleVersion}, 1.0)
+	}
+
 	e.probe.HandleActions(rule, event)
 
+	// the actions of the matches disabling a runaway rule are still applied, only their reporting is dropped
+	if e.runaway != nil && !e.allowRunaway(rule) {
+		return false
+	}
+
 	if rule.Def.Silent {
 		return false
 	}
 
+	// the matches of the rules in digest mode are only reported periodically, in the digest event, or individually
+	// when there is no digest period
+	if rule.Def.Digest && e.config.RuleDigestPeriod > 0 {
+		e.digest.Add(rule, ev)
+		return false
+	}
+
 	// ensure that all the fields are resolved before sending
 	ev.FieldHandlers.ResolveContainerID(ev, ev.ContainerContext)
 	ev.FieldHandlers.ResolveContainerTags(ev, ev.ContainerContext)
@@ -456,6 +525,23 @@ func (e *RuleEngine) RuleMatch(rule *rules.Rule, event eval.Event) bool {
 	return true
 }
 
+// allowRunaway returns whether the match of the rule can be reported, the rule being disabled for a while when it
+// matches too often. A disabled rule isn't evaluated until the end of its cooldown.
+func (e *RuleEngine) allowRunaway(rule *rules.Rule) bool {
+	allowed, disablement := e.runaway.Allow(rule.ID, time.Now())
+	if disablement != nil {
+		rule.DisableUntil(disablement.DisabledUntil)
+
+		seclog.Warnf("rule `%s` matched more than %d times per second for %s, disabling it until %s", rule.ID, e.config.RunawayRulesMaxRate, e.config.RunawayRulesPeriod, disablement.DisabledUntil.Format(time.RFC3339))
+
+		_ = e.statsdClient.Count(metrics.MetricRulesRunawayDisabled, 1, []string{"rule_id:" + rule.ID}, 1.0)
+
+		customRule, customEvent := e.runaway.NewEvent(e.probe.GetAgentContainerContext(), disablement)
+		e.eventSender.SendEvent(customRule, customEvent, nil, "")
+	}
+	return allowed
+}
+
 // Stop stops the rule engine
 func (e *RuleEngine) Stop() {
 	for _, provider := range e.policyProviders {
@@ -509,6 +595,10 @@ func (e *RuleEngine) getEventTypeEnabled() map[eval.EventType]bool {
 		}
 	}
 
+	for eventType := range enabled {
+		enabled[eventType] = enabled[eventType] && e.config.IsEventTypeEnabled(eventType)
+	}
+
 	return enabled
 }
 
@@ -9,6 +9,8 @@ package filtermodel
 import (
 	"reflect"
 
+	pkgconfigsetup "github.com/DataDog/datadog-agent/pkg/config/setup"
+	configUtils "github.com/DataDog/datadog-agent/pkg/config/utils"
 	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/eval"
 	"github.com/DataDog/datadog-agent/pkg/security/utils"
 )
@@ -32,7 +34,7 @@ func (e *RuleFilterEvent) GetFieldType(field eval.Field) (reflect.Kind, error) {
 	case "kernel.version.major", "kernel.version.minor", "kernel.version.patch", "kernel.version.abi":
 		return reflect.Int, nil
 	case "kernel.version.flavor",
-		"os", "os.id", "os.platform_id", "os.version_id", "envs", "origin", "hostname":
+		"os", "os.id", "os.platform_id", "os.version_id", "envs", "origin", "hostname", "host.tags":
 		return reflect.String, nil
 	case "os.is_amazon_linux", "os.is_cos", "os.is_debian", "os.is_oracle", "os.is_rhel", "os.is_rhel7",
 		"os.is_rhel8", "os.is_sles", "os.is_sles12", "os.is_sles15", "kernel.core.enabled":
@@ -69,3 +71,8 @@ func getHostname() string {
 	}
 	return hostname
 }
+
+// getHostTags returns the tags configured for the host
+func getHostTags() []string {
+	return configUtils.GetConfiguredTags(pkgconfigsetup.Datadog(), false)
+}
@@ -20,15 +20,17 @@ import (
 // RuleFilterEvent defines a rule filter event
 type RuleFilterEvent struct {
 	*kernel.Version
-	origin string
-	cfg    *config.Config
+	origin   string
+	cfg      *config.Config
+	hostTags []string
 }
 
 // RuleFilterModel defines a filter model
 type RuleFilterModel struct {
 	*kernel.Version
-	origin string
-	cfg    *config.Config
+	origin   string
+	cfg      *config.Config
+	hostTags []string
 }
 
 // NewRuleFilterModel returns a new rule filter model
@@ -38,18 +40,20 @@ func NewRuleFilterModel(cfg *config.Config, origin string) (*RuleFilterModel, er
 		return nil, err
 	}
 	return &RuleFilterModel{
-		Version: kv,
-		origin:  origin,
-		cfg:     cfg,
+		Version:  kv,
+		origin:   origin,
+		cfg:      cfg,
+		hostTags: getHostTags(),
 	}, nil
 }
 
 // NewEvent returns a new event
 func (m *RuleFilterModel) NewEvent() eval.Event {
 	return &RuleFilterEvent{
-		Version: m.Version,
-		origin:  m.origin,
-		cfg:     m.cfg,
+		Version:  m.Version,
+		origin:   m.origin,
+		cfg:      m.cfg,
+		hostTags: m.hostTags,
 	}
 }
 
@@ -199,6 +203,11 @@ func (m *RuleFilterModel) GetEvaluator(field eval.Field, _ eval.RegisterID) (eva
 			Value: getHostname(),
 			Field: field,
 		}, nil
+	case "host.tags":
+		return &eval.StringArrayEvaluator{
+			Values: m.hostTags,
+			Field:  field,
+		}, nil
 	case "kernel.core.enabled":
 		return &eval.BoolEvaluator{
 			EvalFnc: func(ctx *eval.Context) bool {
@@ -276,6 +285,8 @@ func (e *RuleFilterEvent) GetFieldValue(field eval.Field) (interface{}, error) {
 		return e.origin, nil
 	case "hostname":
 		return getHostname(), nil
+	case "host.tags":
+		return e.hostTags, nil
 	case "kernel.core.enabled":
 		return e.cfg != nil && e.cfg.Probe.EnableCORE && e.SupportCORE(), nil
 	}
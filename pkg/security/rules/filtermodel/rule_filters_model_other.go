@@ -18,25 +18,29 @@ import (
 
 // RuleFilterEvent represents a rule filtering event
 type RuleFilterEvent struct {
-	origin string
+	origin   string
+	hostTags []string
 }
 
 // RuleFilterModel represents a rule fitlering model
 type RuleFilterModel struct {
-	origin string
+	origin   string
+	hostTags []string
 }
 
 // NewRuleFilterModel returns a new rule filtering model
 func NewRuleFilterModel(_ *config.Config, origin string) (*RuleFilterModel, error) {
 	return &RuleFilterModel{
-		origin: origin,
+		origin:   origin,
+		hostTags: getHostTags(),
 	}, nil
 }
 
 // NewEvent returns a new rule filtering event
 func (m *RuleFilterModel) NewEvent() eval.Event {
 	return &RuleFilterEvent{
-		origin: m.origin,
+		origin:   m.origin,
+		hostTags: m.hostTags,
 	}
 }
 
@@ -82,6 +86,11 @@ func (m *RuleFilterModel) GetEvaluator(field eval.Field, _ eval.RegisterID) (eva
 			Value: getHostname(),
 			Field: field,
 		}, nil
+	case "host.tags":
+		return &eval.StringArrayEvaluator{
+			Values: m.hostTags,
+			Field:  field,
+		}, nil
 	}
 
 	return nil, &eval.ErrFieldNotFound{Field: field}
@@ -109,6 +118,8 @@ func (e *RuleFilterEvent) GetFieldValue(field eval.Field) (interface{}, error) {
 		return e.origin, nil
 	case "hostname":
 		return getHostname(), nil
+	case "host.tags":
+		return e.hostTags, nil
 	}
 
 	return nil, &eval.ErrFieldNotFound{Field: field}
@@ -0,0 +1,39 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package filtermodel holds rules related files
+package filtermodel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/DataDog/datadog-agent/pkg/security/secl/rules"
+)
+
+func TestHostFilters(t *testing.T) {
+	m, err := NewRuleFilterModel(nil, "")
+	if err != nil {
+		t.Skipf("rule filter model not available: %v", err)
+	}
+	m.hostTags = []string{"env:prod", "team:cws"}
+	seclRuleFilter := rules.NewSECLRuleFilter(m)
+
+	for filter, expected := range map[string]bool{
+		`"env:prod" in host.tags`:                                 true,
+		`"env:staging" in host.tags`:                              false,
+		`host.tags =~ "team:*"`:                                   true,
+		`host.tags =~ "region:*"`:                                 false,
+		`hostname != "" && "env:prod" in host.tags`:               true,
+		`hostname =~ "*" && host.tags in ["env:prod", "env:dev"]`: true,
+	} {
+		t.Run(filter, func(t *testing.T) {
+			accepted, err := seclRuleFilter.IsRuleAccepted(&rules.RuleDefinition{Filters: []string{filter}})
+			assert.NoError(t, err)
+			assert.Equal(t, expected, accepted)
+		})
+	}
+}
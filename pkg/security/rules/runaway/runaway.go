@@ -0,0 +1,144 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package runaway holds runaway related files
+package runaway
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/DataDog/datadog-agent/pkg/security/events"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/rules"
+)
+
+// Event is used to report a rule disabled because of its match rate
+type Event struct {
+	events.CustomEventCommonFields
+	RuleID        rules.RuleID `json:"rule_id"`
+	MaxRate       uint64       `json:"max_rate"`
+	Period        string       `json:"period"`
+	DisabledUntil time.Time    `json:"disabled_until"`
+}
+
+// ToJSON marshal using json format
+func (e Event) ToJSON() ([]byte, error) {
+	return json.Marshal(e)
+}
+
+// Disablement describes a rule that was just disabled
+type Disablement struct {
+	RuleID        rules.RuleID
+	DisabledUntil time.Time
+}
+
+type ruleState struct {
+	// number of matches during the current second
+	second int64
+	count  uint64
+
+	// first and last seconds of the current streak of seconds over the maximum rate
+	exceedingSince int64
+	exceedingLast  int64
+
+	disabledUntil time.Time
+}
+
+// Guard disables temporarily the rules matching more than a maximum rate, every second, for a sustained period, so
+// that a badly written rule can't overwhelm the event pipeline
+type Guard struct {
+	sync.Mutex
+	maxRate  uint64
+	period   int64
+	cooldown time.Duration
+	rules    map[rules.RuleID]*ruleState
+}
+
+// NewGuard returns a new guard disabling for cooldown the rules matching more than maxRate times per second during period
+func NewGuard(maxRate uint64, period time.Duration, cooldown time.Duration) *Guard {
+	seconds := int64(period / time.Second)
+	if seconds < 1 {
+		seconds = 1
+	}
+
+	return &Guard{
+		maxRate:  maxRate,
+		period:   seconds,
+		cooldown: cooldown,
+		rules:    make(map[rules.RuleID]*ruleState),
+	}
+}
+
+// Allow accounts a match of the provided rule and returns whether the match can be handled. The returned
+// disablement isn't nil when this match disabled the rule.
+func (g *Guard) Allow(ruleID rules.RuleID, now time.Time) (bool, *Disablement) {
+	g.Lock()
+	defer g.Unlock()
+
+	state, exists := g.rules[ruleID]
+	if !exists {
+		state = &ruleState{}
+		g.rules[ruleID] = state
+	}
+
+	if !state.disabledUntil.IsZero() {
+		if now.Before(state.disabledUntil) {
+			return false, nil
+		}
+		// the cooldown expired, the rule is enabled again
+		*state = ruleState{}
+	}
+
+	second := now.Unix()
+	if second != state.second {
+		state.second = second
+		state.count = 0
+	}
+	state.count++
+
+	if state.count != g.maxRate+1 {
+		return true, nil
+	}
+
+	// first match over the maximum rate during this second
+	if state.exceedingLast != second-1 {
+		state.exceedingSince = second
+	}
+	state.exceedingLast = second
+
+	if second-state.exceedingSince+1 < g.period {
+		return true, nil
+	}
+
+	state.disabledUntil = now.Add(g.cooldown)
+	return false, &Disablement{
+		RuleID:        ruleID,
+		DisabledUntil: state.disabledUntil,
+	}
+}
+
+// Reset enables all the rules again, after a reload of the policies for instance
+func (g *Guard) Reset() {
+	g.Lock()
+	defer g.Unlock()
+
+	g.rules = make(map[rules.RuleID]*ruleState)
+}
+
+// NewEvent returns the event reporting the provided disablement
+func (g *Guard) NewEvent(acc *events.AgentContainerContext, d *Disablement) (*rules.Rule, *events.CustomEvent) {
+	evt := Event{
+		RuleID:        d.RuleID,
+		MaxRate:       g.maxRate,
+		Period:        (time.Duration(g.period) * time.Second).String(),
+		DisabledUntil: d.DisabledUntil,
+	}
+	evt.FillCustomEventCommonFields(acc)
+
+	return events.NewCustomRule(events.RunawayRuleRuleID, events.RunawayRuleRuleDesc),
+		events.NewCustomEvent(model.CustomEventType, evt)
+}
@@ -0,0 +1,100 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package runaway holds runaway related files
+package runaway
+
+import (
+	json "encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/DataDog/datadog-agent/pkg/security/events"
+)
+
+// match accounts count matches of the rule during the provided second, and returns the number of allowed matches and
+// the disablement if any
+func match(g *Guard, ruleID string, second time.Time, count int) (int, *Disablement) {
+	var (
+		allowed     int
+		disablement *Disablement
+	)
+	for i := 0; i < count; i++ {
+		ok, d := g.Allow(ruleID, second.Add(time.Duration(i)*time.Millisecond))
+		if ok {
+			allowed++
+		}
+		if d != nil {
+			disablement = d
+		}
+	}
+	return allowed, disablement
+}
+
+func TestGuard(t *testing.T) {
+	g := NewGuard(10, 3*time.Second, time.Minute)
+	start := time.Unix(1000, 0)
+
+	// two seconds over the rate, then a quiet second: the streak is broken
+	for i := 0; i < 2; i++ {
+		allowed, d := match(g, "noisy", start.Add(time.Duration(i)*time.Second), 20)
+		assert.Equal(t, 20, allowed)
+		assert.Nil(t, d)
+	}
+	allowed, d := match(g, "noisy", start.Add(2*time.Second), 5)
+	assert.Equal(t, 5, allowed)
+	assert.Nil(t, d)
+
+	// three consecutive seconds over the rate
+	for i := 3; i < 5; i++ {
+		allowed, d = match(g, "noisy", start.Add(time.Duration(i)*time.Second), 20)
+		assert.Equal(t, 20, allowed)
+		assert.Nil(t, d)
+	}
+	allowed, d = match(g, "noisy", start.Add(5*time.Second), 20)
+	assert.Equal(t, 10, allowed)
+	require.NotNil(t, d)
+	assert.Equal(t, "noisy", d.RuleID)
+	assert.Equal(t, start.Add(5*time.Second+10*time.Millisecond+time.Minute), d.DisabledUntil)
+
+	// the other rules aren't affected
+	allowed, d = match(g, "quiet", start.Add(5*time.Second), 5)
+	assert.Equal(t, 5, allowed)
+	assert.Nil(t, d)
+
+	// disabled during the cooldown, reported only once
+	allowed, d = match(g, "noisy", start.Add(30*time.Second), 5)
+	assert.Equal(t, 0, allowed)
+	assert.Nil(t, d)
+
+	// enabled again after the cooldown
+	allowed, d = match(g, "noisy", start.Add(2*time.Minute), 5)
+	assert.Equal(t, 5, allowed)
+	assert.Nil(t, d)
+
+	// and by a reset
+	for i := 0; i < 3; i++ {
+		_, d = match(g, "noisy", start.Add(3*time.Minute+time.Duration(i)*time.Second), 20)
+	}
+	require.NotNil(t, d)
+	g.Reset()
+	allowed, _ = match(g, "noisy", start.Add(3*time.Minute+3*time.Second), 5)
+	assert.Equal(t, 5, allowed)
+
+	rule, event := g.NewEvent(nil, &Disablement{RuleID: "noisy", DisabledUntil: start})
+	assert.Equal(t, events.RunawayRuleRuleID, rule.ID)
+
+	data, err := event.MarshalJSON()
+	require.NoError(t, err)
+
+	var decoded map[string]interface{}
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, "noisy", decoded["rule_id"])
+	assert.Equal(t, float64(10), decoded["max_rate"])
+	assert.Equal(t, "3s", decoded["period"])
+}
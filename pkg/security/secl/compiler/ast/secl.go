@@ -227,7 +227,8 @@ type Unary struct {
 type Primary struct {
 	Pos lexer.Position
 
-	Ident         *string     `parser:"@Ident"`
+	Call          *Call       `parser:"@@"`
+	Ident         *string     `parser:"| @Ident"`
 	CIDR          *string     `parser:"| @CIDR"`
 	IP            *string     `parser:"| @IP"`
 	Number        *int        `parser:"| @Int"`
@@ -239,6 +240,14 @@ type Primary struct {
 	SubExpression *Expression `parser:"| \"(\" @@ \")\""`
 }
 
+// Call describes a call to a builtin function, taking string arguments
+type Call struct {
+	Pos lexer.Position
+
+	Name string         `parser:"@Ident \"(\""`
+	Args []StringMember `parser:"[ @@ { \",\" @@ } ] \")\""`
+}
+
 // StringMember describes a String based array member
 type StringMember struct {
 	Pos lexer.Position
@@ -348,3 +348,20 @@ func TestCIDRArrayMatches(t *testing.T) {
 
 	printJSON(t, rule)
 }
+
+func TestCall(t *testing.T) {
+	rule, err := parseRule(`exec.file.name == "sh" && descends_from("nginx", ~"/usr/sbin/*")`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	printJSON(t, rule)
+
+	if _, err := parseRule(`descends_from()`); err != nil {
+		t.Error(err)
+	}
+
+	if _, err := parseRule(`descends_from("nginx"`); err == nil {
+		t.Error("unterminated call should not be valid")
+	}
+}
@@ -1204,6 +1204,8 @@ func nodeToEvaluator(obj interface{}, opts *Opts, state *State) (interface{}, le
 		return nodeToEvaluator(obj.Primary, opts, state)
 	case *ast.Primary:
 		switch {
+		case obj.Call != nil:
+			return callToEvaluator(obj.Call, opts, state)
 		case obj.Ident != nil:
 			return identToEvaluator(&ident{Pos: obj.Pos, Ident: obj.Ident}, opts, state)
 		case obj.Number != nil:
@@ -0,0 +1,84 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package eval holds eval related files
+package eval
+
+import (
+	"regexp"
+	"sync"
+
+	"github.com/alecthomas/participle/lexer"
+
+	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/ast"
+)
+
+// FunctionArg defines an argument of a builtin function call
+type FunctionArg struct {
+	Value     string
+	ValueType FieldValueType
+}
+
+// Literal returns the SECL literal of the argument, prefixed by the provided value. The value is emitted as written in
+// the rule, the SECL literals being used as is, without unescaping, and the prefix must not contain any quote.
+func (a FunctionArg) Literal(prefix string) string {
+	switch a.ValueType {
+	case PatternValueType:
+		return `~"` + prefix + a.Value + `"`
+	case RegexpValueType:
+		return `r"` + regexp.QuoteMeta(prefix) + "(?:" + a.Value + `)"`
+	default:
+		return `"` + prefix + a.Value + `"`
+	}
+}
+
+// Function defines a builtin function. Calls are expanded, when the rule is compiled, into the SECL expression
+// returned by the function, so that they are evaluated as efficiently as the equivalent expression written by hand.
+type Function func(args []FunctionArg) (string, error)
+
+var (
+	functionParsingContext     *ast.ParsingContext
+	functionParsingContextOnce sync.Once
+)
+
+func callToEvaluator(call *ast.Call, opts *Opts, state *State) (interface{}, lexer.Position, error) {
+	function, ok := opts.Functions[call.Name]
+	if !ok {
+		return nil, call.Pos, NewError(call.Pos, "unknown function '%s'", call.Name)
+	}
+
+	args := make([]FunctionArg, 0, len(call.Args))
+	for _, member := range call.Args {
+		switch {
+		case member.String != nil:
+			args = append(args, FunctionArg{Value: *member.String, ValueType: ScalarValueType})
+		case member.Pattern != nil:
+			args = append(args, FunctionArg{Value: *member.Pattern, ValueType: PatternValueType})
+		case member.Regexp != nil:
+			args = append(args, FunctionArg{Value: *member.Regexp, ValueType: RegexpValueType})
+		}
+	}
+
+	expr, err := function(args)
+	if err != nil {
+		return nil, call.Pos, NewError(call.Pos, "invalid call to '%s': %s", call.Name, err)
+	}
+
+	functionParsingContextOnce.Do(func() {
+		functionParsingContext = ast.NewParsingContext(false)
+	})
+
+	rule, err := functionParsingContext.ParseRule(expr)
+	if err != nil {
+		return nil, call.Pos, NewError(call.Pos, "invalid expansion of '%s': %s", call.Name, err)
+	}
+
+	evaluator, _, err := nodeToEvaluator(rule.BooleanExpression, opts, state)
+	if err != nil {
+		return nil, call.Pos, NewError(call.Pos, "invalid call to '%s', expanded to `%s`: %s", call.Name, expr, err)
+	}
+
+	return evaluator, call.Pos, nil
+}
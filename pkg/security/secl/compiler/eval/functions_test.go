@@ -0,0 +1,68 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package eval holds eval related files
+package eval
+
+import (
+	"container/list"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFunctions(t *testing.T) {
+	model := &testModel{}
+	opts := newOptsWithParams(make(map[string]interface{}), nil).WithFunctions(map[string]Function{
+		"named": func(args []FunctionArg) (string, error) {
+			if len(args) != 1 {
+				return "", errors.New("one argument expected")
+			}
+			return "process.name == " + args[0].Literal(""), nil
+		},
+		"in_list": func(args []FunctionArg) (string, error) {
+			return `process.list.value in [` + args[0].Literal("prefix_") + `]`, nil
+		},
+	})
+
+	event := &testEvent{
+		process: testProcess{
+			name: "httpd",
+			list: list.New(),
+		},
+	}
+	event.process.list.PushBack(&testItem{key: 10, value: "prefix_value"})
+
+	tests := []struct {
+		expr     string
+		expected bool
+	}{
+		{expr: `named("httpd")`, expected: true},
+		{expr: `named(~"http*")`, expected: true},
+		{expr: `named(r"^ht+pd$")`, expected: true},
+		{expr: `named(r"^ht\w+d$")`, expected: true},
+		{expr: `!named("nginx") && process.name == "httpd"`, expected: true},
+		{expr: `in_list("value")`, expected: true},
+		{expr: `in_list(~"val*")`, expected: true},
+		{expr: `in_list(r"v.lue")`, expected: true},
+		{expr: `in_list(r"v\w+e")`, expected: true},
+		{expr: `in_list("other")`, expected: false},
+	}
+
+	for _, test := range tests {
+		rule, err := parseRule(test.expr, model, opts)
+		if err != nil {
+			t.Fatalf("error while evaluating `%s`: %s", test.expr, err)
+		}
+
+		assert.Equal(t, test.expected, rule.Eval(NewContext(event)), test.expr)
+	}
+
+	for _, expr := range []string{`unknown("httpd")`, `named()`} {
+		_, err := parseRule(expr, model, opts)
+		assert.Error(t, err, expr)
+	}
+}
@@ -62,6 +62,7 @@ func NewStringValuesMacro(id string, values []string, opts *Opts) (*Macro, error
 
 		evaluator.Values.AppendFieldValue(fieldValue)
 	}
+	evaluator.Values.indexDisabled = opts.IndexesDisabled
 
 	if err := evaluator.Compile(DefaultStringCmpOpts); err != nil {
 		return nil, err
@@ -100,6 +101,7 @@ func macroToEvaluator(macro *ast.Macro, model Model, opts *Opts, field Field) (*
 		macros[macro.ID] = macro.evaluator
 	}
 	state := NewState(model, field, macros)
+	state.indexDisabled = opts.IndexesDisabled
 
 	var eval interface{}
 	var err error
@@ -8,6 +8,10 @@ package eval
 var (
 	// GlobCmp replaces a pattern matcher with a glob matcher for *file.path fields.
 	GlobCmp = &OpOverrides{
+		// a pattern value matches a superset of the values matched by the glob replacing it
+		Indexable: func(_ Field) bool {
+			return true
+		},
 		StringEquals: func(a *StringEvaluator, b *StringEvaluator, state *State) (*BoolEvaluator, error) {
 			if a.ValueType == PatternValueType {
 				a.ValueType = GlobValueType
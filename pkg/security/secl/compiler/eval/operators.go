@@ -17,6 +17,9 @@ type OpOverrides struct {
 	StringValuesContains func(a *StringEvaluator, b *StringValuesEvaluator, state *State) (*BoolEvaluator, error)
 	StringArrayContains  func(a *StringEvaluator, b *StringArrayEvaluator, state *State) (*BoolEvaluator, error)
 	StringArrayMatches   func(a *StringArrayEvaluator, b *StringValuesEvaluator, state *State) (*BoolEvaluator, error)
+	// Indexable returns whether the values compared to the field only match its own value, so that the rules can be
+	// indexed by them
+	Indexable func(field Field) bool
 }
 
 // return whether a arithmetic operation is deterministic
@@ -551,6 +554,7 @@ func StringValuesContains(a *StringEvaluator, b *StringValuesEvaluator, state *S
 		}
 	}
 
+	b.Values.indexDisabled = state.indexDisabled
 	if err := b.Compile(a.StringCmpOpts); err != nil {
 		return nil, err
 	}
@@ -620,6 +624,7 @@ func StringArrayMatches(a *StringArrayEvaluator, b *StringValuesEvaluator, state
 		}
 	}
 
+	b.Values.indexDisabled = state.indexDisabled
 	if err := b.Compile(a.StringCmpOpts); err != nil {
 		return nil, err
 	}
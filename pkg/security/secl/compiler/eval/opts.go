@@ -73,6 +73,10 @@ type Opts struct {
 	Constants     map[string]interface{}
 	VariableStore *VariableStore
 	MacroStore    *MacroStore
+	Functions     map[string]Function
+
+	// IndexesDisabled disables the indexes of the values of the `in` comparisons and of the rules
+	IndexesDisabled bool
 }
 
 // WithConstants set constants
@@ -81,6 +85,12 @@ func (o *Opts) WithConstants(constants map[string]interface{}) *Opts {
 	return o
 }
 
+// WithFunctions set builtin functions
+func (o *Opts) WithFunctions(functions map[string]Function) *Opts {
+	o.Functions = functions
+	return o
+}
+
 // WithVariables set variables
 func (o *Opts) WithVariables(variables map[string]VariableValue) *Opts {
 	if o.VariableStore == nil {
@@ -105,6 +115,12 @@ func (o *Opts) WithLegacyFields(fields map[Field]Field) *Opts {
 	return o
 }
 
+// WithIndexesDisabled disables the indexes of the values and of the rules, they are all evaluated one by one
+func (o *Opts) WithIndexesDisabled(disabled bool) *Opts {
+	o.IndexesDisabled = disabled
+	return o
+}
+
 // WithMacroStore set the macro store
 func (o *Opts) WithMacroStore(store *MacroStore) *Opts {
 	o.MacroStore = store
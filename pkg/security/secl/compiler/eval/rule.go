@@ -201,6 +201,7 @@ func NewRuleEvaluator(rule *ast.Rule, model Model, opts *Opts) (*RuleEvaluator,
 		macros[macro.ID] = macro.evaluator
 	}
 	state := NewState(model, "", macros)
+	state.indexDisabled = opts.IndexesDisabled
 
 	eval, _, err := nodeToEvaluator(rule.BooleanExpression, opts, state)
 	if err != nil {
@@ -332,6 +333,7 @@ func (r *Rule) genPartials(field Field) error {
 	}
 
 	state := NewState(r.Model, field, macroPartial)
+	state.indexDisabled = r.Opts.IndexesDisabled
 	pEval, _, err := nodeToEvaluator(r.ast.BooleanExpression, r.Opts, state)
 	if err != nil {
 		return fmt.Errorf("couldn't generate partial for field %s and rule %s: %w", field, r.ID, err)
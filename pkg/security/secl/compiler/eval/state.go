@@ -24,6 +24,9 @@ type State struct {
 	macros      map[MacroID]*MacroEvaluator
 	regexpCache StateRegexpCache
 	registers   []Register
+
+	// indexDisabled disables the indexes of the values compiled while evaluating the rule
+	indexDisabled bool
 }
 
 // UpdateFields updates the fields used in the rule
@@ -28,6 +28,12 @@ type StringValues struct {
 	scalars        []string
 	stringMatchers []StringMatcher
 
+	// indexes of the values, built when they are numerous and not disabled
+	indexDisabled     bool
+	scalarSet         map[string]struct{}
+	matcherTrie       *prefixTrie
+	unindexedMatchers []StringMatcher
+
 	fieldValues []FieldValue
 }
 
@@ -66,6 +72,8 @@ func (s *StringValues) Compile(opts StringCmpOpts) error {
 		}
 	}
 
+	s.buildIndex(opts)
+
 	return nil
 }
 
@@ -84,6 +92,9 @@ func (s *StringValues) SetFieldValues(values ...FieldValue) error {
 	// reset internal caches
 	s.scalars = nil
 	s.stringMatchers = nil
+	s.scalarSet = nil
+	s.matcherTrie = nil
+	s.unindexedMatchers = nil
 
 	for _, value := range values {
 		s.AppendFieldValue(value)
@@ -99,9 +110,26 @@ func (s *StringValues) AppendScalarValue(value string) {
 
 // Matches returns whether the value matches the string values
 func (s *StringValues) Matches(value string) bool {
-	if slices.Contains(s.scalars, value) {
+	if s.scalarSet != nil {
+		if _, found := s.scalarSet[value]; found {
+			return true
+		}
+	} else if slices.Contains(s.scalars, value) {
 		return true
 	}
+
+	if s.matcherTrie != nil {
+		if s.matcherTrie.matches(value) {
+			return true
+		}
+		for _, pm := range s.unindexedMatchers {
+			if pm.Matches(value) {
+				return true
+			}
+		}
+		return false
+	}
+
 	for _, pm := range s.stringMatchers {
 		if pm.Matches(value) {
 			return true
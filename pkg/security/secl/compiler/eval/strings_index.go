@@ -0,0 +1,186 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package eval
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+const (
+	// minIndexedScalars is the number of scalar values from which they are looked up in a set
+	minIndexedScalars = 16
+	// minIndexedMatchers is the number of glob and pattern matchers from which they are indexed in a prefix trie
+	minIndexedMatchers = 8
+)
+
+// prefixTrie indexes string matchers by the literal prefix of their pattern, so that only the matchers whose prefix
+// is a prefix of the value are evaluated. The cost of a lookup depends on the length of the value and on the number
+// of candidate matchers, not on the total number of matchers.
+type prefixTrie struct {
+	children map[byte]*prefixTrie
+	entries  []trieEntry
+}
+
+// trieEntry is a matcher of the trie along with the identifier of the set of values it belongs to
+type trieEntry struct {
+	matcher StringMatcher
+	id      int
+}
+
+func (t *prefixTrie) insert(prefix string, matcher StringMatcher, id int) {
+	node := t
+	for i := 0; i < len(prefix); i++ {
+		child := node.children[prefix[i]]
+		if child == nil {
+			if node.children == nil {
+				node.children = make(map[byte]*prefixTrie)
+			}
+			child = &prefixTrie{}
+			node.children[prefix[i]] = child
+		}
+		node = child
+	}
+	node.entries = append(node.entries, trieEntry{matcher: matcher, id: id})
+}
+
+// walk calls the callback for the entries whose prefix is a prefix of the value and whose matcher matches the value,
+// until the callback returns false
+func (t *prefixTrie) walk(value string, fn func(entry trieEntry) bool) {
+	node := t
+	for i := 0; ; i++ {
+		for _, entry := range node.entries {
+			if entry.matcher.Matches(value) && !fn(entry) {
+				return
+			}
+		}
+
+		if i == len(value) {
+			return
+		}
+
+		if node = node.children[value[i]]; node == nil {
+			return
+		}
+	}
+}
+
+// matches returns whether one of the matchers whose prefix is a prefix of the value matches the value
+func (t *prefixTrie) matches(value string) bool {
+	var found bool
+	t.walk(value, func(_ trieEntry) bool {
+		found = true
+		return false
+	})
+	return found
+}
+
+// literalPrefix returns the part of a glob or a pattern before its first wildcard, that all the matching values
+// start with
+func literalPrefix(pattern string) string {
+	if star := strings.IndexByte(pattern, '*'); star != -1 {
+		return pattern[:star]
+	}
+	return pattern
+}
+
+// buildIndex indexes the compiled values when they are numerous enough for the linear evaluation to be costly
+func (s *StringValues) buildIndex(opts StringCmpOpts) {
+	s.scalarSet, s.matcherTrie, s.unindexedMatchers = nil, nil, nil
+
+	if s.indexDisabled {
+		return
+	}
+
+	if len(s.scalars) >= minIndexedScalars {
+		s.scalarSet = make(map[string]struct{}, len(s.scalars))
+		for _, scalar := range s.scalars {
+			s.scalarSet[scalar] = struct{}{}
+		}
+	}
+
+	// the prefixes are compared byte per byte, they can't be used with the comparisons altering the values
+	if opts.CaseInsensitive || opts.PathSeparatorNormalize {
+		return
+	}
+
+	var indexable int
+	for _, matcher := range s.stringMatchers {
+		switch matcher.(type) {
+		case *GlobStringMatcher, *PatternStringMatcher:
+			indexable++
+		}
+	}
+	if indexable < minIndexedMatchers {
+		return
+	}
+
+	s.matcherTrie = &prefixTrie{}
+	for _, matcher := range s.stringMatchers {
+		switch m := matcher.(type) {
+		case *GlobStringMatcher:
+			s.matcherTrie.insert(literalPrefix(m.glob.pattern), m, 0)
+		case *PatternStringMatcher:
+			s.matcherTrie.insert(literalPrefix(m.pattern.pattern), m, 0)
+		default:
+			s.unindexedMatchers = append(s.unindexedMatchers, m)
+		}
+	}
+}
+
+// StringValuesIndex indexes several sets of scalar, glob and pattern values, each one identified by an integer, so
+// that the sets matching a value are looked up at once instead of being evaluated one by one
+type StringValuesIndex struct {
+	scalars map[string][]int
+	trie    prefixTrie
+}
+
+// NewStringValuesIndex returns a new empty index
+func NewStringValuesIndex() *StringValuesIndex {
+	return &StringValuesIndex{
+		scalars: make(map[string][]int),
+	}
+}
+
+// Add adds a value to the set identified by the given id. Only the default comparison options are supported, the
+// prefixes being compared byte per byte.
+func (i *StringValuesIndex) Add(id int, value FieldValue) error {
+	str, ok := value.Value.(string)
+	if !ok {
+		return fmt.Errorf("invalid field value `%v`", value.Value)
+	}
+
+	switch value.Type {
+	case ScalarValueType:
+		if !slices.Contains(i.scalars[str], id) {
+			i.scalars[str] = append(i.scalars[str], id)
+		}
+	case GlobValueType, PatternValueType:
+		matcher, err := NewStringMatcher(value.Type, str, DefaultStringCmpOpts)
+		if err != nil {
+			return err
+		}
+		i.trie.insert(literalPrefix(str), matcher, id)
+	default:
+		return fmt.Errorf("unsupported value type `%d` for `%v`", value.Type, value.Value)
+	}
+
+	return nil
+}
+
+// Lookup calls the callback with the id of every set having a value matching the given one. The same id can be
+// reported several times.
+func (i *StringValuesIndex) Lookup(value string, fn func(id int)) {
+	for _, id := range i.scalars[value] {
+		fn(id)
+	}
+
+	i.trie.walk(value, func(entry trieEntry) bool {
+		fn(entry.id)
+		return true
+	})
+}
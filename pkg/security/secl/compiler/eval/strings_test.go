@@ -7,7 +7,9 @@
 package eval
 
 import (
+	"fmt"
 	"slices"
+	"strings"
 	"testing"
 )
 
@@ -274,3 +276,66 @@ func BenchmarkRegexpEvaluator(b *testing.B) {
 		}
 	}
 }
+
+func TestStringValuesIndex(t *testing.T) {
+	globs := []string{
+		"/etc/**", "/etc/*/conf", "/usr/bin/*", "/usr/*/python*", "*/shadow", "/tmp/*.sh", "/var/log/**",
+		"/root/.ssh/*", "/home/*/.ssh/authorized_keys", "/proc/*/mem", "/", "/opt/datadog-agent/bin/*",
+	}
+	values := []string{
+		"", "/", "/etc", "/etc/", "/etc/passwd", "/etc/ssh/conf", "/usr/bin/ls", "/usr/local/python3", "/usr/lib",
+		"/etc/shadow", "shadow", "/tmp/a.sh", "/tmp/a.py", "/var/log/syslog", "/var/log", "/root/.ssh/id_rsa",
+		"/home/user/.ssh/authorized_keys", "/proc/1/mem", "/proc/1/maps", "/opt/datadog-agent/bin/agent", "/x",
+	}
+
+	for _, valueType := range []FieldValueType{GlobValueType, PatternValueType} {
+		var indexed, linear StringValues
+		for _, glob := range globs {
+			if valueType == PatternValueType && strings.Contains(glob, "**") {
+				continue
+			}
+			indexed.AppendFieldValue(FieldValue{Value: glob, Type: valueType})
+			linear.AppendFieldValue(FieldValue{Value: glob, Type: valueType})
+		}
+		for i := 0; i < minIndexedScalars; i++ {
+			indexed.AppendScalarValue(fmt.Sprintf("/bin/%d", i))
+			linear.AppendScalarValue(fmt.Sprintf("/bin/%d", i))
+		}
+
+		if err := indexed.Compile(DefaultStringCmpOpts); err != nil {
+			t.Fatal(err)
+		}
+		if indexed.matcherTrie == nil || indexed.scalarSet == nil {
+			t.Fatal("expected the values to be indexed")
+		}
+
+		linear.indexDisabled = true
+		if err := linear.Compile(DefaultStringCmpOpts); err != nil {
+			t.Fatal(err)
+		}
+		if linear.matcherTrie != nil || linear.scalarSet != nil {
+			t.Fatal("expected the values not to be indexed")
+		}
+
+		for _, value := range append(values, "/bin/3", "/bin/42") {
+			if indexed.Matches(value) != linear.Matches(value) {
+				t.Errorf("unexpected result for `%s` with value type %d: %v", value, valueType, indexed.Matches(value))
+			}
+		}
+	}
+}
+
+func BenchmarkStringValuesIndex(b *testing.B) {
+	var values StringValues
+	for i := 0; i < 1000; i++ {
+		values.AppendFieldValue(FieldValue{Value: fmt.Sprintf("/opt/app%d/bin/*", i), Type: GlobValueType})
+	}
+	if err := values.Compile(DefaultStringCmpOpts); err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		values.Matches("/opt/app999/bin/server")
+	}
+}
@@ -22,6 +22,7 @@ const (
 	CGroupManagerPodman                           // podman
 	CGroupManagerCRI                              // containerd
 	CGroupManagerSystemd                          // systemd
+	CGroupManagerLXC                              // lxc
 )
 
 const (
@@ -33,6 +34,8 @@ const (
 	ContainerRuntimeCRIO = "cri-o"
 	// ContainerRuntimePodman is used to specify that a container is managed by Podman
 	ContainerRuntimePodman = "podman"
+	// ContainerRuntimeLXC is used to specify that a container is managed by LXC or LXD
+	ContainerRuntimeLXC = "lxc"
 )
 
 // RuntimePrefixes holds the cgroup prefixed used by the different runtimes
@@ -42,6 +45,7 @@ var RuntimePrefixes = map[string]CGroupManager{
 	"cri-containerd-": CGroupManagerCRI,
 	"crio-":           CGroupManagerCRIO,
 	"libpod-":         CGroupManagerPodman,
+	"lxc.payload.":    CGroupManagerLXC,
 }
 
 // GetCGroupManager extracts the cgroup manager from a cgroup name
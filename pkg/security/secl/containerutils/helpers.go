@@ -20,10 +20,22 @@ var containerIDPattern *regexp.Regexp
 
 var containerIDCoreChars = "0123456789abcdefABCDEF"
 
+// podmanConmonPrefix is the prefix of the scopes of the conmon processes monitoring the podman containers from the host
+const podmanConmonPrefix = "libpod-conmon-"
+
+// podmanContainerIDPattern matches the cgroup of a podman container, rootful or rootless, which may be nested in the
+// slice of its pod, e.g. machine-libpod_pod_<pod ID>.slice/libpod-<container ID>.scope
+var podmanContainerIDPattern = regexp.MustCompile(`(?:^|/)libpod-([0-9a-fA-F]{64})(?:\.scope)?(?:/|$)`)
+
+// lxcContainerIDPattern matches the cgroup of a LXC or LXD container, created at the root of the hierarchy as
+// lxc.payload.<name>, or as lxc/<name> by the legacy releases. The monitor processes, running in lxc.monitor.<name>,
+// aren't part of the container.
+var lxcContainerIDPattern = regexp.MustCompile(`^/?(?:lxc\.payload\.|lxc/)([^/]+)`)
+
 func init() {
 	var prefixes []string
 	for prefix := range RuntimePrefixes {
-		prefixes = append(prefixes, prefix)
+		prefixes = append(prefixes, regexp.QuoteMeta(prefix))
 	}
 	ContainerIDPatternStr = "(?:" + strings.Join(prefixes[:], "|") + ")?([0-9a-fA-F]{64})|([0-9a-fA-F]{32}-\\d+)|([0-9a-fA-F]{8}(-[0-9a-fA-F]{4}){4})"
 	containerIDPattern = regexp.MustCompile(ContainerIDPatternStr)
@@ -35,6 +47,34 @@ func isSystemdCgroup(cgroup string) bool {
 
 // FindContainerID extracts the first sub string that matches the pattern of a container ID along with the container flags induced from the container runtime prefix
 func FindContainerID(s string) (string, uint64) {
+	// the ID of the container monitored by conmon doesn't make conmon part of the container
+	if strings.Contains(s, podmanConmonPrefix) {
+		if isSystemdCgroup(s) {
+			return "", uint64(CGroupManagerSystemd)
+		}
+		return "", 0
+	}
+
+	// look for the podman container first so that the ID of its pod isn't mistaken for the container ID
+	if match := podmanContainerIDPattern.FindStringSubmatch(s); match != nil {
+		return match[1], uint64(CGroupManagerPodman)
+	}
+
+	containerID, flags := findContainerIDFromPattern(s)
+	if CGroupFlags(flags).IsContainer() {
+		return containerID, flags
+	}
+
+	// the names of the LXC containers don't follow the pattern of the container IDs
+	if match := lxcContainerIDPattern.FindStringSubmatch(s); match != nil {
+		return match[1], uint64(CGroupManagerLXC)
+	}
+
+	return containerID, flags
+}
+
+// findContainerIDFromPattern extracts the first sub string that matches the pattern of a container ID
+func findContainerIDFromPattern(s string) (string, uint64) {
 	match := containerIDPattern.FindIndex([]byte(s))
 	if match == nil {
 		if isSystemdCgroup(s) {
@@ -85,3 +125,51 @@ func GetCGroupContext(containerID ContainerID, cgroupFlags CGroupFlags) (CGroupI
 	}
 	return CGroupID(cgroupID), ContainerID(containerID)
 }
+
+// GetSystemdUnitFromCgroup returns the systemd unit owning the cgroup, the deepest ".service" or ".scope" component
+// of its path, and the slice the unit is nested in
+func GetSystemdUnitFromCgroup(cgroup string) (string, string) {
+	var unit, slice string
+
+	parts := strings.Split(strings.Trim(cgroup, "/"), "/")
+	for i := len(parts) - 1; i >= 0; i-- {
+		if unit == "" {
+			if isSystemdCgroup(parts[i]) {
+				unit = parts[i]
+			}
+		} else if strings.HasSuffix(parts[i], ".slice") {
+			slice = parts[i]
+			break
+		}
+	}
+
+	return unit, slice
+}
+
+// GetCGroupAncestors returns the paths of the ancestors of a cgroup in the cgroup v2 hierarchy, from its parent to the
+// root of the hierarchy. The cgroups whose path isn't absolute, like the ones inferred from a container ID, have no
+// known ancestor.
+func GetCGroupAncestors(cgroup CGroupID) []CGroupID {
+	path := strings.TrimRight(string(cgroup), "/")
+	if !strings.HasPrefix(path, "/") {
+		return nil
+	}
+
+	var ancestors []CGroupID
+	for i := strings.LastIndexByte(path, '/'); i > 0; i = strings.LastIndexByte(path, '/') {
+		path = path[:i]
+		ancestors = append(ancestors, CGroupID(path))
+	}
+	return append(ancestors, "/")
+}
+
+// IsCGroupUnder returns whether a cgroup is the provided cgroup or one of its descendants in the cgroup v2 hierarchy
+func IsCGroupUnder(cgroup CGroupID, ancestor CGroupID) bool {
+	parent := strings.TrimRight(string(ancestor), "/")
+	if parent == "" {
+		return strings.HasPrefix(string(cgroup), "/")
+	}
+
+	path := strings.TrimRight(string(cgroup), "/")
+	return path == parent || strings.HasPrefix(path, parent+"/")
+}
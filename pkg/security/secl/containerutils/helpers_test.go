@@ -89,6 +89,55 @@ func TestFindContainerID(t *testing.T) {
 			input:  "/ecs/0123456789aAbBcCdDeEfF0123456789/0123456789aAbBcCdDeEfF0123456789-012345678",
 			output: "0123456789aAbBcCdDeEfF0123456789-012345678",
 		},
+		{ // podman rootful
+			input:  "/machine.slice/libpod-aAbBcCdDeEfF2345678901234567890123456789012345678901234567890123.scope",
+			output: "aAbBcCdDeEfF2345678901234567890123456789012345678901234567890123",
+			flags:  CGroupManagerPodman,
+		},
+		{ // podman rootless
+			input:  "/user.slice/user-1000.slice/user@1000.service/user.slice/libpod-aAbBcCdDeEfF2345678901234567890123456789012345678901234567890123.scope/container",
+			output: "aAbBcCdDeEfF2345678901234567890123456789012345678901234567890123",
+			flags:  CGroupManagerPodman,
+		},
+		{ // podman pod
+			input:  "/machine.slice/machine-libpod_pod_0123456789012345678901234567890123456789012345678901234567890123.slice/libpod-aAbBcCdDeEfF2345678901234567890123456789012345678901234567890123.scope",
+			output: "aAbBcCdDeEfF2345678901234567890123456789012345678901234567890123",
+			flags:  CGroupManagerPodman,
+		},
+		{ // podman cgroupfs manager
+			input:  "/libpod_parent/libpod-aAbBcCdDeEfF2345678901234567890123456789012345678901234567890123",
+			output: "aAbBcCdDeEfF2345678901234567890123456789012345678901234567890123",
+			flags:  CGroupManagerPodman,
+		},
+		{ // podman conmon
+			input:  "/machine.slice/libpod-conmon-aAbBcCdDeEfF2345678901234567890123456789012345678901234567890123.scope",
+			output: "",
+			flags:  CGroupManagerSystemd,
+		},
+		{ // LXC
+			input:  "/lxc.payload.web-01",
+			output: "web-01",
+			flags:  CGroupManagerLXC,
+		},
+		{ // LXC with systemd inside the container
+			input:  "/lxc.payload.web-01/system.slice/nginx.service",
+			output: "web-01",
+			flags:  CGroupManagerLXC,
+		},
+		{ // LXC legacy
+			input:  "/lxc/web-01",
+			output: "web-01",
+			flags:  CGroupManagerLXC,
+		},
+		{ // LXC monitor
+			input:  "/lxc.monitor.web-01",
+			output: "",
+		},
+		{ // docker nested in LXC
+			input:  "/lxc.payload.web-01/docker/aAbBcCdDeEfF2345678901234567890123456789012345678901234567890123",
+			output: "aAbBcCdDeEfF2345678901234567890123456789012345678901234567890123",
+			flags:  CGroupManagerDocker,
+		},
 	}
 
 	for _, test := range testCases {
@@ -97,3 +146,73 @@ func TestFindContainerID(t *testing.T) {
 		assert.Equal(t, uint64(test.flags), containerFlags, "wrong flags for container %s", containerID)
 	}
 }
+
+func TestGetCgroupFromContainer(t *testing.T) {
+	assert.Equal(t, CGroupID("lxc.payload.web-01"), GetCgroupFromContainer("web-01", CGroupFlags(CGroupManagerLXC)))
+
+	containerID, flags := GetContainerFromCgroup("lxc.payload.web-01")
+	assert.Equal(t, "web-01", containerID)
+	assert.Equal(t, CGroupFlags(CGroupManagerLXC), flags)
+}
+
+func TestGetSystemdUnitFromCgroup(t *testing.T) {
+	testCases := []struct {
+		cgroup string
+		unit   string
+		slice  string
+	}{
+		{
+			cgroup: "/system.slice/nginx.service",
+			unit:   "nginx.service",
+			slice:  "system.slice",
+		},
+		{ // nested in the user manager
+			cgroup: "/user.slice/user-1000.slice/user@1000.service/app.slice/app-org.gnome.Terminal.slice/vte-spawn-f9176c6a-2a34-4ce2-86af-60d16888ed8e.scope",
+			unit:   "vte-spawn-f9176c6a-2a34-4ce2-86af-60d16888ed8e.scope",
+			slice:  "app-org.gnome.Terminal.slice",
+		},
+		{ // sub cgroup delegated by the unit
+			cgroup: "/system.slice/containerd.service/payload",
+			unit:   "containerd.service",
+			slice:  "system.slice",
+		},
+		{ // unit at the root
+			cgroup: "/init.scope",
+			unit:   "init.scope",
+		},
+		{ // slice only
+			cgroup: "/system.slice",
+		},
+		{ // cgroupfs driver
+			cgroup: "/docker/aAbBcCdDeEfF2345678901234567890123456789012345678901234567890123",
+		},
+		{
+			cgroup: "",
+		},
+	}
+
+	for _, test := range testCases {
+		unit, slice := GetSystemdUnitFromCgroup(test.cgroup)
+		assert.Equal(t, test.unit, unit, "wrong unit for %s", test.cgroup)
+		assert.Equal(t, test.slice, slice, "wrong slice for %s", test.cgroup)
+	}
+}
+
+func TestGetCGroupAncestors(t *testing.T) {
+	assert.Equal(t, []CGroupID{"/system.slice", "/"}, GetCGroupAncestors("/system.slice/nginx.service"))
+	assert.Equal(t, []CGroupID{"/kubepods.slice/kubepods-burstable.slice", "/kubepods.slice", "/"}, GetCGroupAncestors("/kubepods.slice/kubepods-burstable.slice/cri-containerd-1234.scope/"))
+	assert.Equal(t, []CGroupID{"/"}, GetCGroupAncestors("/init.scope"))
+	assert.Empty(t, GetCGroupAncestors("/"))
+	assert.Empty(t, GetCGroupAncestors("docker-1234"))
+	assert.Empty(t, GetCGroupAncestors(""))
+}
+
+func TestIsCGroupUnder(t *testing.T) {
+	assert.True(t, IsCGroupUnder("/system.slice/nginx.service", "/system.slice"))
+	assert.True(t, IsCGroupUnder("/system.slice/nginx.service", "/system.slice/"))
+	assert.True(t, IsCGroupUnder("/system.slice", "/system.slice"))
+	assert.True(t, IsCGroupUnder("/system.slice/nginx.service", "/"))
+	assert.False(t, IsCGroupUnder("/system.slice-other/nginx.service", "/system.slice"))
+	assert.False(t, IsCGroupUnder("/user.slice", "/system.slice"))
+	assert.False(t, IsCGroupUnder("docker-1234", "/"))
+}
@@ -137,6 +137,24 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "bpf.kernel_security.lockdown":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveKernelLockdown(ev, &ev.BPF.KernelSecurity)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "bpf.kernel_security.secure_boot":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveKernelSecureBoot(ev, &ev.BPF.KernelSecurity)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "bpf.map.name":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
@@ -231,6 +249,33 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "cgroup.ancestors":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveCGroupAncestors(ev, &ev.CGroupContext)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "cgroup.controllers":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveCGroupControllers(ev, &ev.CGroupContext)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "cgroup.cpu.usage":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return int(ev.FieldHandlers.ResolveCGroupCPUUsage(ev, &ev.CGroupContext))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "cgroup.file.inode":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -267,6 +312,33 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
+	case "cgroup.memory.current":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return int(ev.FieldHandlers.ResolveCGroupMemoryCurrent(ev, &ev.CGroupContext))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "cgroup.memory.high_exceeded":
+		return &eval.BoolEvaluator{
+			EvalFnc: func(ctx *eval.Context) bool {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveCGroupMemoryHighExceeded(ev, &ev.CGroupContext)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "cgroup.pids.current":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return int(ev.FieldHandlers.ResolveCGroupPidsCurrent(ev, &ev.CGroupContext))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "chdir.file.change_time":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -330,6 +402,24 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "chdir.file.layer.digest":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Chdir.File)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "chdir.file.layer.path":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Chdir.File)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "chdir.file.mode":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -550,6 +640,24 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "chmod.file.layer.digest":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Chmod.File)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "chmod.file.layer.path":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Chmod.File)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "chmod.file.mode":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -644,6 +752,24 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
+	case "chmod.file.previous_mode":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return int(ev.Chmod.PreviousMode)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+	case "chmod.file.previous_rights":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return int(ev.Chmod.PreviousMode)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
 	case "chmod.file.rights":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -797,6 +923,24 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "chown.file.layer.digest":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Chown.File)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "chown.file.layer.path":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Chown.File)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "chown.file.mode":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -891,6 +1035,24 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
+	case "chown.file.previous_gid":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return int(ev.Chown.PreviousGID)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+	case "chown.file.previous_uid":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return int(ev.Chown.PreviousUID)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
 	case "chown.file.rights":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -1098,6 +1260,24 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "dns.question.domain_query_rate":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return ev.DNS.DomainQueryRate
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+	case "dns.question.label_entropy":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return ev.DNS.LabelEntropy
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
 	case "dns.question.length":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -1136,6 +1316,15 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "dns.question.unique_subdomains":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return ev.DNS.UniqueSubdomains
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
 	case "event.async":
 		return &eval.BoolEvaluator{
 			EvalFnc: func(ctx *eval.Context) bool {
@@ -1271,6 +1460,33 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "exec.cgroup.ancestors":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveCGroupAncestors(ev, &ev.Exec.Process.CGroup)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "exec.cgroup.controllers":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveCGroupControllers(ev, &ev.Exec.Process.CGroup)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "exec.cgroup.cpu.usage":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return int(ev.FieldHandlers.ResolveCGroupCPUUsage(ev, &ev.Exec.Process.CGroup))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "exec.cgroup.file.inode":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -1307,6 +1523,69 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
+	case "exec.cgroup.memory.current":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return int(ev.FieldHandlers.ResolveCGroupMemoryCurrent(ev, &ev.Exec.Process.CGroup))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "exec.cgroup.memory.high_exceeded":
+		return &eval.BoolEvaluator{
+			EvalFnc: func(ctx *eval.Context) bool {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveCGroupMemoryHighExceeded(ev, &ev.Exec.Process.CGroup)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "exec.cgroup.pids.current":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return int(ev.FieldHandlers.ResolveCGroupPidsCurrent(ev, &ev.Exec.Process.CGroup))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "exec.cloud_credentials.providers":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveProcessCloudCredentialsProviders(ev, ev.Exec.Process)
+			},
+			Field:  field,
+			Weight: 100 * eval.HandlerWeight,
+		}, nil
+	case "exec.cloud_credentials.resources":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveProcessCloudCredentialsResources(ev, ev.Exec.Process)
+			},
+			Field:  field,
+			Weight: 100 * eval.HandlerWeight,
+		}, nil
+	case "exec.cloud_credentials.types":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveProcessCloudCredentialsTypes(ev, ev.Exec.Process)
+			},
+			Field:  field,
+			Weight: 100 * eval.HandlerWeight,
+		}, nil
+	case "exec.cmdline_obfuscation_score":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveProcessCmdLineObfuscationScore(ev, ev.Exec.Process)
+			},
+			Field:  field,
+			Weight: 500 * eval.HandlerWeight,
+		}, nil
 	case "exec.comm":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
@@ -1352,6 +1631,42 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "exec.elf.build_id":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveProcessELFBuildID(ev, ev.Exec.Process)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "exec.elf.interpreter":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveProcessELFInterpreter(ev, ev.Exec.Process)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "exec.elf.is_static":
+		return &eval.BoolEvaluator{
+			EvalFnc: func(ctx *eval.Context) bool {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveProcessELFIsStatic(ev, ev.Exec.Process)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "exec.elf.is_stripped":
+		return &eval.BoolEvaluator{
+			EvalFnc: func(ctx *eval.Context) bool {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveProcessELFIsStripped(ev, ev.Exec.Process)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "exec.envp":
 		return &eval.StringArrayEvaluator{
 			EvalFnc: func(ctx *eval.Context) []string {
@@ -1481,6 +1796,30 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "exec.file.layer.digest":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				if !ev.Exec.Process.IsNotKworker() {
+					return ""
+				}
+				return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Exec.Process.FileEvent)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "exec.file.layer.path":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				if !ev.Exec.Process.IsNotKworker() {
+					return ""
+				}
+				return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Exec.Process.FileEvent)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "exec.file.mode":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -1773,6 +2112,30 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "exec.interpreter.file.layer.digest":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				if !ev.Exec.Process.HasInterpreter() {
+					return ""
+				}
+				return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Exec.Process.LinuxBinprm.FileEvent)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "exec.interpreter.file.layer.path":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				if !ev.Exec.Process.HasInterpreter() {
+					return ""
+				}
+				return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Exec.Process.LinuxBinprm.FileEvent)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "exec.interpreter.file.mode":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -1954,6 +2317,42 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
+	case "exec.ns.mnt":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return int(ev.FieldHandlers.ResolveProcessMountNamespace(ev, ev.Exec.Process))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "exec.ns.pid":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return int(ev.FieldHandlers.ResolveProcessPIDNamespace(ev, ev.Exec.Process))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "exec.ns.user":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return int(ev.FieldHandlers.ResolveProcessUserNamespace(ev, ev.Exec.Process))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "exec.ns.uts":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return int(ev.FieldHandlers.ResolveProcessUTSNamespace(ev, ev.Exec.Process))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "exec.pid":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -1981,6 +2380,24 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: 900 * eval.HandlerWeight,
 		}, nil
+	case "exec.systemd.slice":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveProcessSystemdSlice(ev, ev.Exec.Process)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "exec.systemd.unit":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveProcessSystemdUnit(ev, ev.Exec.Process)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "exec.tid":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -2134,6 +2551,33 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "exit.cgroup.ancestors":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveCGroupAncestors(ev, &ev.Exit.Process.CGroup)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "exit.cgroup.controllers":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveCGroupControllers(ev, &ev.Exit.Process.CGroup)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "exit.cgroup.cpu.usage":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return int(ev.FieldHandlers.ResolveCGroupCPUUsage(ev, &ev.Exit.Process.CGroup))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "exit.cgroup.file.inode":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -2170,6 +2614,69 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
+	case "exit.cgroup.memory.current":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return int(ev.FieldHandlers.ResolveCGroupMemoryCurrent(ev, &ev.Exit.Process.CGroup))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "exit.cgroup.memory.high_exceeded":
+		return &eval.BoolEvaluator{
+			EvalFnc: func(ctx *eval.Context) bool {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveCGroupMemoryHighExceeded(ev, &ev.Exit.Process.CGroup)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "exit.cgroup.pids.current":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return int(ev.FieldHandlers.ResolveCGroupPidsCurrent(ev, &ev.Exit.Process.CGroup))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "exit.cloud_credentials.providers":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveProcessCloudCredentialsProviders(ev, ev.Exit.Process)
+			},
+			Field:  field,
+			Weight: 100 * eval.HandlerWeight,
+		}, nil
+	case "exit.cloud_credentials.resources":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveProcessCloudCredentialsResources(ev, ev.Exit.Process)
+			},
+			Field:  field,
+			Weight: 100 * eval.HandlerWeight,
+		}, nil
+	case "exit.cloud_credentials.types":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveProcessCloudCredentialsTypes(ev, ev.Exit.Process)
+			},
+			Field:  field,
+			Weight: 100 * eval.HandlerWeight,
+		}, nil
+	case "exit.cmdline_obfuscation_score":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveProcessCmdLineObfuscationScore(ev, ev.Exit.Process)
+			},
+			Field:  field,
+			Weight: 500 * eval.HandlerWeight,
+		}, nil
 	case "exit.code":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -2224,6 +2731,42 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "exit.elf.build_id":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveProcessELFBuildID(ev, ev.Exit.Process)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "exit.elf.interpreter":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveProcessELFInterpreter(ev, ev.Exit.Process)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "exit.elf.is_static":
+		return &eval.BoolEvaluator{
+			EvalFnc: func(ctx *eval.Context) bool {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveProcessELFIsStatic(ev, ev.Exit.Process)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "exit.elf.is_stripped":
+		return &eval.BoolEvaluator{
+			EvalFnc: func(ctx *eval.Context) bool {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveProcessELFIsStripped(ev, ev.Exit.Process)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "exit.envp":
 		return &eval.StringArrayEvaluator{
 			EvalFnc: func(ctx *eval.Context) []string {
@@ -2353,6 +2896,30 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "exit.file.layer.digest":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				if !ev.Exit.Process.IsNotKworker() {
+					return ""
+				}
+				return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Exit.Process.FileEvent)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "exit.file.layer.path":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				if !ev.Exit.Process.IsNotKworker() {
+					return ""
+				}
+				return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Exit.Process.FileEvent)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "exit.file.mode":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -2645,6 +3212,30 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "exit.interpreter.file.layer.digest":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				if !ev.Exit.Process.HasInterpreter() {
+					return ""
+				}
+				return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Exit.Process.LinuxBinprm.FileEvent)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "exit.interpreter.file.layer.path":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				if !ev.Exit.Process.HasInterpreter() {
+					return ""
+				}
+				return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Exit.Process.LinuxBinprm.FileEvent)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "exit.interpreter.file.mode":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -2826,6 +3417,42 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
+	case "exit.ns.mnt":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return int(ev.FieldHandlers.ResolveProcessMountNamespace(ev, ev.Exit.Process))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "exit.ns.pid":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return int(ev.FieldHandlers.ResolveProcessPIDNamespace(ev, ev.Exit.Process))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "exit.ns.user":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return int(ev.FieldHandlers.ResolveProcessUserNamespace(ev, ev.Exit.Process))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "exit.ns.uts":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return int(ev.FieldHandlers.ResolveProcessUTSNamespace(ev, ev.Exit.Process))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "exit.pid":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -2844,6 +3471,24 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "exit.systemd.slice":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveProcessSystemdSlice(ev, ev.Exit.Process)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "exit.systemd.unit":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveProcessSystemdUnit(ev, ev.Exit.Process)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "exit.tid":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -2907,6 +3552,33 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
+	case "imds.access_token.expires_in":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return ev.IMDS.AccessToken.ExpiresIn
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+	case "imds.access_token.resource":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.IMDS.AccessToken.Resource
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+	case "imds.access_token.type":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.IMDS.AccessToken.Type
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
 	case "imds.aws.is_imds_v2":
 		return &eval.BoolEvaluator{
 			EvalFnc: func(ctx *eval.Context) bool {
@@ -3051,6 +3723,24 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "link.file.destination.layer.digest":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Link.Target)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "link.file.destination.layer.path":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Link.Target)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "link.file.destination.mode":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -3226,6 +3916,24 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "link.file.layer.digest":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Link.Source)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "link.file.layer.path":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Link.Source)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "link.file.mode":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -3464,6 +4172,24 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "load_module.file.layer.digest":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.LoadModule.File)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "load_module.file.layer.path":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.LoadModule.File)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "load_module.file.mode":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -3585,6 +4311,24 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
+	case "load_module.kernel_security.lockdown":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveKernelLockdown(ev, &ev.LoadModule.KernelSecurity)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "load_module.kernel_security.secure_boot":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveKernelSecureBoot(ev, &ev.LoadModule.KernelSecurity)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "load_module.loaded_from_memory":
 		return &eval.BoolEvaluator{
 			EvalFnc: func(ctx *eval.Context) bool {
@@ -3693,6 +4437,24 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "mkdir.file.layer.digest":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Mkdir.File)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "mkdir.file.layer.path":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Mkdir.File)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "mkdir.file.mode":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -3886,6 +4648,24 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "mmap.file.layer.digest":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.MMap.File)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "mmap.file.layer.path":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.MMap.File)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "mmap.file.mode":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -4016,6 +4796,15 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "mmap.length":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return int(ev.MMap.Len)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
 	case "mmap.protection":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -4106,6 +4895,15 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: 900 * eval.HandlerWeight,
 		}, nil
+	case "mprotect.is_anonymous":
+		return &eval.BoolEvaluator{
+			EvalFnc: func(ctx *eval.Context) bool {
+				ev := ctx.Event.(*Event)
+				return ev.MProtect.IsAnonymous
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
 	case "mprotect.req_protection":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -4124,6 +4922,15 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "mprotect.size":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return int(ev.MProtect.Size)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
 	case "mprotect.vm_protection":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -4376,6 +5183,24 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "open.file.layer.digest":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Open.File)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "open.file.layer.path":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Open.File)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "open.file.mode":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -4915,6 +5740,95 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			}, Field: field,
 			Weight: eval.IteratorWeight,
 		}, nil
+	case "process.ancestors.cgroup.ancestors":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.StringCache[field]; ok {
+					return result
+				}
+				var results []string
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := ev.FieldHandlers.ResolveCGroupAncestors(ev, &element.ProcessContext.Process.CGroup)
+					results = append(results, result...)
+					return results
+				}
+				value := iterator.Front(ctx)
+				for value != nil {
+					element := value
+					result := ev.FieldHandlers.ResolveCGroupAncestors(ev, &element.ProcessContext.Process.CGroup)
+					results = append(results, result...)
+					value = iterator.Next()
+				}
+				ctx.StringCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
+	case "process.ancestors.cgroup.controllers":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.StringCache[field]; ok {
+					return result
+				}
+				var results []string
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := ev.FieldHandlers.ResolveCGroupControllers(ev, &element.ProcessContext.Process.CGroup)
+					results = append(results, result...)
+					return results
+				}
+				value := iterator.Front(ctx)
+				for value != nil {
+					element := value
+					result := ev.FieldHandlers.ResolveCGroupControllers(ev, &element.ProcessContext.Process.CGroup)
+					results = append(results, result...)
+					value = iterator.Next()
+				}
+				ctx.StringCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
+	case "process.ancestors.cgroup.cpu.usage":
+		return &eval.IntArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []int {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.IntCache[field]; ok {
+					return result
+				}
+				var results []int
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := int(ev.FieldHandlers.ResolveCGroupCPUUsage(ev, &element.ProcessContext.Process.CGroup))
+					results = append(results, result)
+					return results
+				}
+				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) int {
+					return int(ev.FieldHandlers.ResolveCGroupCPUUsage(ev, &pce.ProcessContext.Process.CGroup))
+				})
+				ctx.IntCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
 	case "process.ancestors.cgroup.file.inode":
 		return &eval.IntArrayEvaluator{
 			EvalFnc: func(ctx *eval.Context) []int {
@@ -5029,6 +5943,207 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			}, Field: field,
 			Weight: eval.IteratorWeight,
 		}, nil
+	case "process.ancestors.cgroup.memory.current":
+		return &eval.IntArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []int {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.IntCache[field]; ok {
+					return result
+				}
+				var results []int
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := int(ev.FieldHandlers.ResolveCGroupMemoryCurrent(ev, &element.ProcessContext.Process.CGroup))
+					results = append(results, result)
+					return results
+				}
+				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) int {
+					return int(ev.FieldHandlers.ResolveCGroupMemoryCurrent(ev, &pce.ProcessContext.Process.CGroup))
+				})
+				ctx.IntCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
+	case "process.ancestors.cgroup.memory.high_exceeded":
+		return &eval.BoolArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []bool {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.BoolCache[field]; ok {
+					return result
+				}
+				var results []bool
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := ev.FieldHandlers.ResolveCGroupMemoryHighExceeded(ev, &element.ProcessContext.Process.CGroup)
+					results = append(results, result)
+					return results
+				}
+				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) bool {
+					return ev.FieldHandlers.ResolveCGroupMemoryHighExceeded(ev, &pce.ProcessContext.Process.CGroup)
+				})
+				ctx.BoolCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
+	case "process.ancestors.cgroup.pids.current":
+		return &eval.IntArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []int {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.IntCache[field]; ok {
+					return result
+				}
+				var results []int
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := int(ev.FieldHandlers.ResolveCGroupPidsCurrent(ev, &element.ProcessContext.Process.CGroup))
+					results = append(results, result)
+					return results
+				}
+				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) int {
+					return int(ev.FieldHandlers.ResolveCGroupPidsCurrent(ev, &pce.ProcessContext.Process.CGroup))
+				})
+				ctx.IntCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
+	case "process.ancestors.cloud_credentials.providers":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.StringCache[field]; ok {
+					return result
+				}
+				var results []string
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := ev.FieldHandlers.ResolveProcessCloudCredentialsProviders(ev, &element.ProcessContext.Process)
+					results = append(results, result...)
+					return results
+				}
+				value := iterator.Front(ctx)
+				for value != nil {
+					element := value
+					result := ev.FieldHandlers.ResolveProcessCloudCredentialsProviders(ev, &element.ProcessContext.Process)
+					results = append(results, result...)
+					value = iterator.Next()
+				}
+				ctx.StringCache[field] = results
+				return results
+			}, Field: field,
+			Weight: 100 * eval.IteratorWeight,
+		}, nil
+	case "process.ancestors.cloud_credentials.resources":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.StringCache[field]; ok {
+					return result
+				}
+				var results []string
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := ev.FieldHandlers.ResolveProcessCloudCredentialsResources(ev, &element.ProcessContext.Process)
+					results = append(results, result...)
+					return results
+				}
+				value := iterator.Front(ctx)
+				for value != nil {
+					element := value
+					result := ev.FieldHandlers.ResolveProcessCloudCredentialsResources(ev, &element.ProcessContext.Process)
+					results = append(results, result...)
+					value = iterator.Next()
+				}
+				ctx.StringCache[field] = results
+				return results
+			}, Field: field,
+			Weight: 100 * eval.IteratorWeight,
+		}, nil
+	case "process.ancestors.cloud_credentials.types":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.StringCache[field]; ok {
+					return result
+				}
+				var results []string
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := ev.FieldHandlers.ResolveProcessCloudCredentialsTypes(ev, &element.ProcessContext.Process)
+					results = append(results, result...)
+					return results
+				}
+				value := iterator.Front(ctx)
+				for value != nil {
+					element := value
+					result := ev.FieldHandlers.ResolveProcessCloudCredentialsTypes(ev, &element.ProcessContext.Process)
+					results = append(results, result...)
+					value = iterator.Next()
+				}
+				ctx.StringCache[field] = results
+				return results
+			}, Field: field,
+			Weight: 100 * eval.IteratorWeight,
+		}, nil
+	case "process.ancestors.cmdline_obfuscation_score":
+		return &eval.IntArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []int {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.IntCache[field]; ok {
+					return result
+				}
+				var results []int
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := int(ev.FieldHandlers.ResolveProcessCmdLineObfuscationScore(ev, &element.ProcessContext.Process))
+					results = append(results, result)
+					return results
+				}
+				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) int {
+					return int(ev.FieldHandlers.ResolveProcessCmdLineObfuscationScore(ev, &pce.ProcessContext.Process))
+				})
+				ctx.IntCache[field] = results
+				return results
+			}, Field: field,
+			Weight: 500 * eval.IteratorWeight,
+		}, nil
 	case "process.ancestors.comm":
 		return &eval.StringArrayEvaluator{
 			EvalFnc: func(ctx *eval.Context) []string {
@@ -5173,6 +6288,114 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			}, Field: field,
 			Weight: eval.IteratorWeight,
 		}, nil
+	case "process.ancestors.elf.build_id":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.StringCache[field]; ok {
+					return result
+				}
+				var results []string
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := ev.FieldHandlers.ResolveProcessELFBuildID(ev, &element.ProcessContext.Process)
+					results = append(results, result)
+					return results
+				}
+				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) string {
+					return ev.FieldHandlers.ResolveProcessELFBuildID(ev, &pce.ProcessContext.Process)
+				})
+				ctx.StringCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
+	case "process.ancestors.elf.interpreter":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.StringCache[field]; ok {
+					return result
+				}
+				var results []string
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := ev.FieldHandlers.ResolveProcessELFInterpreter(ev, &element.ProcessContext.Process)
+					results = append(results, result)
+					return results
+				}
+				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) string {
+					return ev.FieldHandlers.ResolveProcessELFInterpreter(ev, &pce.ProcessContext.Process)
+				})
+				ctx.StringCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
+	case "process.ancestors.elf.is_static":
+		return &eval.BoolArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []bool {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.BoolCache[field]; ok {
+					return result
+				}
+				var results []bool
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := ev.FieldHandlers.ResolveProcessELFIsStatic(ev, &element.ProcessContext.Process)
+					results = append(results, result)
+					return results
+				}
+				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) bool {
+					return ev.FieldHandlers.ResolveProcessELFIsStatic(ev, &pce.ProcessContext.Process)
+				})
+				ctx.BoolCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
+	case "process.ancestors.elf.is_stripped":
+		return &eval.BoolArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []bool {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.BoolCache[field]; ok {
+					return result
+				}
+				var results []bool
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := ev.FieldHandlers.ResolveProcessELFIsStripped(ev, &element.ProcessContext.Process)
+					results = append(results, result)
+					return results
+				}
+				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) bool {
+					return ev.FieldHandlers.ResolveProcessELFIsStripped(ev, &pce.ProcessContext.Process)
+				})
+				ctx.BoolCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
 	case "process.ancestors.envp":
 		return &eval.StringArrayEvaluator{
 			EvalFnc: func(ctx *eval.Context) []string {
@@ -5574,6 +6797,72 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			}, Field: field,
 			Weight: eval.IteratorWeight,
 		}, nil
+	case "process.ancestors.file.layer.digest":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.StringCache[field]; ok {
+					return result
+				}
+				var results []string
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					if !element.ProcessContext.Process.IsNotKworker() {
+						return append(results, "")
+					}
+					result := ev.FieldHandlers.ResolveFileLayerDigest(ev, &element.ProcessContext.Process.FileEvent)
+					results = append(results, result)
+					return results
+				}
+				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) string {
+					if !pce.ProcessContext.Process.IsNotKworker() {
+						return ""
+					}
+					return ev.FieldHandlers.ResolveFileLayerDigest(ev, &pce.ProcessContext.Process.FileEvent)
+				})
+				ctx.StringCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
+	case "process.ancestors.file.layer.path":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.StringCache[field]; ok {
+					return result
+				}
+				var results []string
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					if !element.ProcessContext.Process.IsNotKworker() {
+						return append(results, "")
+					}
+					result := ev.FieldHandlers.ResolveFileLayerPath(ev, &element.ProcessContext.Process.FileEvent)
+					results = append(results, result)
+					return results
+				}
+				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) string {
+					if !pce.ProcessContext.Process.IsNotKworker() {
+						return ""
+					}
+					return ev.FieldHandlers.ResolveFileLayerPath(ev, &pce.ProcessContext.Process.FileEvent)
+				})
+				ctx.StringCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
 	case "process.ancestors.file.mode":
 		return &eval.IntArrayEvaluator{
 			EvalFnc: func(ctx *eval.Context) []int {
@@ -6447,6 +7736,72 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			}, Field: field,
 			Weight: eval.IteratorWeight,
 		}, nil
+	case "process.ancestors.interpreter.file.layer.digest":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.StringCache[field]; ok {
+					return result
+				}
+				var results []string
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					if !element.ProcessContext.Process.HasInterpreter() {
+						return append(results, "")
+					}
+					result := ev.FieldHandlers.ResolveFileLayerDigest(ev, &element.ProcessContext.Process.LinuxBinprm.FileEvent)
+					results = append(results, result)
+					return results
+				}
+				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) string {
+					if !pce.ProcessContext.Process.HasInterpreter() {
+						return ""
+					}
+					return ev.FieldHandlers.ResolveFileLayerDigest(ev, &pce.ProcessContext.Process.LinuxBinprm.FileEvent)
+				})
+				ctx.StringCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
+	case "process.ancestors.interpreter.file.layer.path":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.StringCache[field]; ok {
+					return result
+				}
+				var results []string
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					if !element.ProcessContext.Process.HasInterpreter() {
+						return append(results, "")
+					}
+					result := ev.FieldHandlers.ResolveFileLayerPath(ev, &element.ProcessContext.Process.LinuxBinprm.FileEvent)
+					results = append(results, result)
+					return results
+				}
+				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) string {
+					if !pce.ProcessContext.Process.HasInterpreter() {
+						return ""
+					}
+					return ev.FieldHandlers.ResolveFileLayerPath(ev, &pce.ProcessContext.Process.LinuxBinprm.FileEvent)
+				})
+				ctx.StringCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
 	case "process.ancestors.interpreter.file.mode":
 		return &eval.IntArrayEvaluator{
 			EvalFnc: func(ctx *eval.Context) []int {
@@ -6984,6 +8339,114 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.IteratorWeight,
 		}, nil
+	case "process.ancestors.ns.mnt":
+		return &eval.IntArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []int {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.IntCache[field]; ok {
+					return result
+				}
+				var results []int
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := int(ev.FieldHandlers.ResolveProcessMountNamespace(ev, &element.ProcessContext.Process))
+					results = append(results, result)
+					return results
+				}
+				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) int {
+					return int(ev.FieldHandlers.ResolveProcessMountNamespace(ev, &pce.ProcessContext.Process))
+				})
+				ctx.IntCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
+	case "process.ancestors.ns.pid":
+		return &eval.IntArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []int {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.IntCache[field]; ok {
+					return result
+				}
+				var results []int
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := int(ev.FieldHandlers.ResolveProcessPIDNamespace(ev, &element.ProcessContext.Process))
+					results = append(results, result)
+					return results
+				}
+				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) int {
+					return int(ev.FieldHandlers.ResolveProcessPIDNamespace(ev, &pce.ProcessContext.Process))
+				})
+				ctx.IntCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
+	case "process.ancestors.ns.user":
+		return &eval.IntArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []int {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.IntCache[field]; ok {
+					return result
+				}
+				var results []int
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := int(ev.FieldHandlers.ResolveProcessUserNamespace(ev, &element.ProcessContext.Process))
+					results = append(results, result)
+					return results
+				}
+				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) int {
+					return int(ev.FieldHandlers.ResolveProcessUserNamespace(ev, &pce.ProcessContext.Process))
+				})
+				ctx.IntCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
+	case "process.ancestors.ns.uts":
+		return &eval.IntArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []int {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.IntCache[field]; ok {
+					return result
+				}
+				var results []int
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := int(ev.FieldHandlers.ResolveProcessUTSNamespace(ev, &element.ProcessContext.Process))
+					results = append(results, result)
+					return results
+				}
+				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) int {
+					return int(ev.FieldHandlers.ResolveProcessUTSNamespace(ev, &pce.ProcessContext.Process))
+				})
+				ctx.IntCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
 	case "process.ancestors.pid":
 		return &eval.IntArrayEvaluator{
 			EvalFnc: func(ctx *eval.Context) []int {
@@ -7044,6 +8507,60 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			}, Field: field,
 			Weight: eval.IteratorWeight,
 		}, nil
+	case "process.ancestors.systemd.slice":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.StringCache[field]; ok {
+					return result
+				}
+				var results []string
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := ev.FieldHandlers.ResolveProcessSystemdSlice(ev, &element.ProcessContext.Process)
+					results = append(results, result)
+					return results
+				}
+				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) string {
+					return ev.FieldHandlers.ResolveProcessSystemdSlice(ev, &pce.ProcessContext.Process)
+				})
+				ctx.StringCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
+	case "process.ancestors.systemd.unit":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.StringCache[field]; ok {
+					return result
+				}
+				var results []string
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := ev.FieldHandlers.ResolveProcessSystemdUnit(ev, &element.ProcessContext.Process)
+					results = append(results, result)
+					return results
+				}
+				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) string {
+					return ev.FieldHandlers.ResolveProcessSystemdUnit(ev, &pce.ProcessContext.Process)
+				})
+				ctx.StringCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
 	case "process.ancestors.tid":
 		return &eval.IntArrayEvaluator{
 			EvalFnc: func(ctx *eval.Context) []int {
@@ -7330,6 +8847,33 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "process.cgroup.ancestors":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveCGroupAncestors(ev, &ev.BaseEvent.ProcessContext.Process.CGroup)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "process.cgroup.controllers":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveCGroupControllers(ev, &ev.BaseEvent.ProcessContext.Process.CGroup)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "process.cgroup.cpu.usage":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return int(ev.FieldHandlers.ResolveCGroupCPUUsage(ev, &ev.BaseEvent.ProcessContext.Process.CGroup))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "process.cgroup.file.inode":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -7366,6 +8910,69 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
+	case "process.cgroup.memory.current":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return int(ev.FieldHandlers.ResolveCGroupMemoryCurrent(ev, &ev.BaseEvent.ProcessContext.Process.CGroup))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "process.cgroup.memory.high_exceeded":
+		return &eval.BoolEvaluator{
+			EvalFnc: func(ctx *eval.Context) bool {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveCGroupMemoryHighExceeded(ev, &ev.BaseEvent.ProcessContext.Process.CGroup)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "process.cgroup.pids.current":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return int(ev.FieldHandlers.ResolveCGroupPidsCurrent(ev, &ev.BaseEvent.ProcessContext.Process.CGroup))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "process.cloud_credentials.providers":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveProcessCloudCredentialsProviders(ev, &ev.BaseEvent.ProcessContext.Process)
+			},
+			Field:  field,
+			Weight: 100 * eval.HandlerWeight,
+		}, nil
+	case "process.cloud_credentials.resources":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveProcessCloudCredentialsResources(ev, &ev.BaseEvent.ProcessContext.Process)
+			},
+			Field:  field,
+			Weight: 100 * eval.HandlerWeight,
+		}, nil
+	case "process.cloud_credentials.types":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveProcessCloudCredentialsTypes(ev, &ev.BaseEvent.ProcessContext.Process)
+			},
+			Field:  field,
+			Weight: 100 * eval.HandlerWeight,
+		}, nil
+	case "process.cmdline_obfuscation_score":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveProcessCmdLineObfuscationScore(ev, &ev.BaseEvent.ProcessContext.Process)
+			},
+			Field:  field,
+			Weight: 500 * eval.HandlerWeight,
+		}, nil
 	case "process.comm":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
@@ -7411,6 +9018,42 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "process.elf.build_id":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveProcessELFBuildID(ev, &ev.BaseEvent.ProcessContext.Process)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "process.elf.interpreter":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveProcessELFInterpreter(ev, &ev.BaseEvent.ProcessContext.Process)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "process.elf.is_static":
+		return &eval.BoolEvaluator{
+			EvalFnc: func(ctx *eval.Context) bool {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveProcessELFIsStatic(ev, &ev.BaseEvent.ProcessContext.Process)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "process.elf.is_stripped":
+		return &eval.BoolEvaluator{
+			EvalFnc: func(ctx *eval.Context) bool {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveProcessELFIsStripped(ev, &ev.BaseEvent.ProcessContext.Process)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "process.envp":
 		return &eval.StringArrayEvaluator{
 			EvalFnc: func(ctx *eval.Context) []string {
@@ -7540,6 +9183,30 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "process.file.layer.digest":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				if !ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
+					return ""
+				}
+				return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.BaseEvent.ProcessContext.Process.FileEvent)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "process.file.layer.path":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				if !ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
+					return ""
+				}
+				return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.BaseEvent.ProcessContext.Process.FileEvent)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "process.file.mode":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -7832,6 +9499,30 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "process.interpreter.file.layer.digest":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				if !ev.BaseEvent.ProcessContext.Process.HasInterpreter() {
+					return ""
+				}
+				return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "process.interpreter.file.layer.path":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				if !ev.BaseEvent.ProcessContext.Process.HasInterpreter() {
+					return ""
+				}
+				return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "process.interpreter.file.mode":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -8013,6 +9704,42 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
+	case "process.ns.mnt":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return int(ev.FieldHandlers.ResolveProcessMountNamespace(ev, &ev.BaseEvent.ProcessContext.Process))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "process.ns.pid":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return int(ev.FieldHandlers.ResolveProcessPIDNamespace(ev, &ev.BaseEvent.ProcessContext.Process))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "process.ns.user":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return int(ev.FieldHandlers.ResolveProcessUserNamespace(ev, &ev.BaseEvent.ProcessContext.Process))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "process.ns.uts":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return int(ev.FieldHandlers.ResolveProcessUTSNamespace(ev, &ev.BaseEvent.ProcessContext.Process))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "process.parent.args":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
@@ -8121,6 +9848,42 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "process.parent.cgroup.ancestors":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				if !ev.BaseEvent.ProcessContext.HasParent() {
+					return []string{}
+				}
+				return ev.FieldHandlers.ResolveCGroupAncestors(ev, &ev.BaseEvent.ProcessContext.Parent.CGroup)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "process.parent.cgroup.controllers":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				if !ev.BaseEvent.ProcessContext.HasParent() {
+					return []string{}
+				}
+				return ev.FieldHandlers.ResolveCGroupControllers(ev, &ev.BaseEvent.ProcessContext.Parent.CGroup)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "process.parent.cgroup.cpu.usage":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				if !ev.BaseEvent.ProcessContext.HasParent() {
+					return 0
+				}
+				return int(ev.FieldHandlers.ResolveCGroupCPUUsage(ev, &ev.BaseEvent.ProcessContext.Parent.CGroup))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "process.parent.cgroup.file.inode":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -8169,6 +9932,90 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
+	case "process.parent.cgroup.memory.current":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				if !ev.BaseEvent.ProcessContext.HasParent() {
+					return 0
+				}
+				return int(ev.FieldHandlers.ResolveCGroupMemoryCurrent(ev, &ev.BaseEvent.ProcessContext.Parent.CGroup))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "process.parent.cgroup.memory.high_exceeded":
+		return &eval.BoolEvaluator{
+			EvalFnc: func(ctx *eval.Context) bool {
+				ev := ctx.Event.(*Event)
+				if !ev.BaseEvent.ProcessContext.HasParent() {
+					return false
+				}
+				return ev.FieldHandlers.ResolveCGroupMemoryHighExceeded(ev, &ev.BaseEvent.ProcessContext.Parent.CGroup)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "process.parent.cgroup.pids.current":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				if !ev.BaseEvent.ProcessContext.HasParent() {
+					return 0
+				}
+				return int(ev.FieldHandlers.ResolveCGroupPidsCurrent(ev, &ev.BaseEvent.ProcessContext.Parent.CGroup))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "process.parent.cloud_credentials.providers":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				if !ev.BaseEvent.ProcessContext.HasParent() {
+					return []string{}
+				}
+				return ev.FieldHandlers.ResolveProcessCloudCredentialsProviders(ev, ev.BaseEvent.ProcessContext.Parent)
+			},
+			Field:  field,
+			Weight: 100 * eval.HandlerWeight,
+		}, nil
+	case "process.parent.cloud_credentials.resources":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				if !ev.BaseEvent.ProcessContext.HasParent() {
+					return []string{}
+				}
+				return ev.FieldHandlers.ResolveProcessCloudCredentialsResources(ev, ev.BaseEvent.ProcessContext.Parent)
+			},
+			Field:  field,
+			Weight: 100 * eval.HandlerWeight,
+		}, nil
+	case "process.parent.cloud_credentials.types":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				if !ev.BaseEvent.ProcessContext.HasParent() {
+					return []string{}
+				}
+				return ev.FieldHandlers.ResolveProcessCloudCredentialsTypes(ev, ev.BaseEvent.ProcessContext.Parent)
+			},
+			Field:  field,
+			Weight: 100 * eval.HandlerWeight,
+		}, nil
+	case "process.parent.cmdline_obfuscation_score":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				if !ev.BaseEvent.ProcessContext.HasParent() {
+					return 0
+				}
+				return ev.FieldHandlers.ResolveProcessCmdLineObfuscationScore(ev, ev.BaseEvent.ProcessContext.Parent)
+			},
+			Field:  field,
+			Weight: 500 * eval.HandlerWeight,
+		}, nil
 	case "process.parent.comm":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
@@ -8229,6 +10076,54 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "process.parent.elf.build_id":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				if !ev.BaseEvent.ProcessContext.HasParent() {
+					return ""
+				}
+				return ev.FieldHandlers.ResolveProcessELFBuildID(ev, ev.BaseEvent.ProcessContext.Parent)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "process.parent.elf.interpreter":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				if !ev.BaseEvent.ProcessContext.HasParent() {
+					return ""
+				}
+				return ev.FieldHandlers.ResolveProcessELFInterpreter(ev, ev.BaseEvent.ProcessContext.Parent)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "process.parent.elf.is_static":
+		return &eval.BoolEvaluator{
+			EvalFnc: func(ctx *eval.Context) bool {
+				ev := ctx.Event.(*Event)
+				if !ev.BaseEvent.ProcessContext.HasParent() {
+					return false
+				}
+				return ev.FieldHandlers.ResolveProcessELFIsStatic(ev, ev.BaseEvent.ProcessContext.Parent)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "process.parent.elf.is_stripped":
+		return &eval.BoolEvaluator{
+			EvalFnc: func(ctx *eval.Context) bool {
+				ev := ctx.Event.(*Event)
+				if !ev.BaseEvent.ProcessContext.HasParent() {
+					return false
+				}
+				return ev.FieldHandlers.ResolveProcessELFIsStripped(ev, ev.BaseEvent.ProcessContext.Parent)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "process.parent.envp":
 		return &eval.StringArrayEvaluator{
 			EvalFnc: func(ctx *eval.Context) []string {
@@ -8394,6 +10289,36 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "process.parent.file.layer.digest":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				if !ev.BaseEvent.ProcessContext.HasParent() {
+					return ""
+				}
+				if !ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
+					return ""
+				}
+				return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "process.parent.file.layer.path":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				if !ev.BaseEvent.ProcessContext.HasParent() {
+					return ""
+				}
+				if !ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
+					return ""
+				}
+				return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "process.parent.file.mode":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -8758,6 +10683,36 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "process.parent.interpreter.file.layer.digest":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				if !ev.BaseEvent.ProcessContext.HasParent() {
+					return ""
+				}
+				if !ev.BaseEvent.ProcessContext.Parent.HasInterpreter() {
+					return ""
+				}
+				return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "process.parent.interpreter.file.layer.path":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				if !ev.BaseEvent.ProcessContext.HasParent() {
+					return ""
+				}
+				if !ev.BaseEvent.ProcessContext.Parent.HasInterpreter() {
+					return ""
+				}
+				return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "process.parent.interpreter.file.mode":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -8981,6 +10936,54 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
+	case "process.parent.ns.mnt":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				if !ev.BaseEvent.ProcessContext.HasParent() {
+					return 0
+				}
+				return int(ev.FieldHandlers.ResolveProcessMountNamespace(ev, ev.BaseEvent.ProcessContext.Parent))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "process.parent.ns.pid":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				if !ev.BaseEvent.ProcessContext.HasParent() {
+					return 0
+				}
+				return int(ev.FieldHandlers.ResolveProcessPIDNamespace(ev, ev.BaseEvent.ProcessContext.Parent))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "process.parent.ns.user":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				if !ev.BaseEvent.ProcessContext.HasParent() {
+					return 0
+				}
+				return int(ev.FieldHandlers.ResolveProcessUserNamespace(ev, ev.BaseEvent.ProcessContext.Parent))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "process.parent.ns.uts":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				if !ev.BaseEvent.ProcessContext.HasParent() {
+					return 0
+				}
+				return int(ev.FieldHandlers.ResolveProcessUTSNamespace(ev, ev.BaseEvent.ProcessContext.Parent))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "process.parent.pid":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -9005,6 +11008,30 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "process.parent.systemd.slice":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				if !ev.BaseEvent.ProcessContext.HasParent() {
+					return ""
+				}
+				return ev.FieldHandlers.ResolveProcessSystemdSlice(ev, ev.BaseEvent.ProcessContext.Parent)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "process.parent.systemd.unit":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				if !ev.BaseEvent.ProcessContext.HasParent() {
+					return ""
+				}
+				return ev.FieldHandlers.ResolveProcessSystemdUnit(ev, ev.BaseEvent.ProcessContext.Parent)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "process.parent.tid":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -9107,6 +11134,24 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "process.systemd.slice":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveProcessSystemdSlice(ev, &ev.BaseEvent.ProcessContext.Process)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "process.systemd.unit":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveProcessSystemdUnit(ev, &ev.BaseEvent.ProcessContext.Process)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "process.tid":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -9452,6 +11497,95 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			}, Field: field,
 			Weight: eval.IteratorWeight,
 		}, nil
+	case "ptrace.tracee.ancestors.cgroup.ancestors":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.StringCache[field]; ok {
+					return result
+				}
+				var results []string
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := ev.FieldHandlers.ResolveCGroupAncestors(ev, &element.ProcessContext.Process.CGroup)
+					results = append(results, result...)
+					return results
+				}
+				value := iterator.Front(ctx)
+				for value != nil {
+					element := value
+					result := ev.FieldHandlers.ResolveCGroupAncestors(ev, &element.ProcessContext.Process.CGroup)
+					results = append(results, result...)
+					value = iterator.Next()
+				}
+				ctx.StringCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
+	case "ptrace.tracee.ancestors.cgroup.controllers":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.StringCache[field]; ok {
+					return result
+				}
+				var results []string
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := ev.FieldHandlers.ResolveCGroupControllers(ev, &element.ProcessContext.Process.CGroup)
+					results = append(results, result...)
+					return results
+				}
+				value := iterator.Front(ctx)
+				for value != nil {
+					element := value
+					result := ev.FieldHandlers.ResolveCGroupControllers(ev, &element.ProcessContext.Process.CGroup)
+					results = append(results, result...)
+					value = iterator.Next()
+				}
+				ctx.StringCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
+	case "ptrace.tracee.ancestors.cgroup.cpu.usage":
+		return &eval.IntArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []int {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.IntCache[field]; ok {
+					return result
+				}
+				var results []int
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := int(ev.FieldHandlers.ResolveCGroupCPUUsage(ev, &element.ProcessContext.Process.CGroup))
+					results = append(results, result)
+					return results
+				}
+				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) int {
+					return int(ev.FieldHandlers.ResolveCGroupCPUUsage(ev, &pce.ProcessContext.Process.CGroup))
+				})
+				ctx.IntCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
 	case "ptrace.tracee.ancestors.cgroup.file.inode":
 		return &eval.IntArrayEvaluator{
 			EvalFnc: func(ctx *eval.Context) []int {
@@ -9566,6 +11700,207 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			}, Field: field,
 			Weight: eval.IteratorWeight,
 		}, nil
+	case "ptrace.tracee.ancestors.cgroup.memory.current":
+		return &eval.IntArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []int {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.IntCache[field]; ok {
+					return result
+				}
+				var results []int
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := int(ev.FieldHandlers.ResolveCGroupMemoryCurrent(ev, &element.ProcessContext.Process.CGroup))
+					results = append(results, result)
+					return results
+				}
+				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) int {
+					return int(ev.FieldHandlers.ResolveCGroupMemoryCurrent(ev, &pce.ProcessContext.Process.CGroup))
+				})
+				ctx.IntCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
+	case "ptrace.tracee.ancestors.cgroup.memory.high_exceeded":
+		return &eval.BoolArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []bool {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.BoolCache[field]; ok {
+					return result
+				}
+				var results []bool
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := ev.FieldHandlers.ResolveCGroupMemoryHighExceeded(ev, &element.ProcessContext.Process.CGroup)
+					results = append(results, result)
+					return results
+				}
+				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) bool {
+					return ev.FieldHandlers.ResolveCGroupMemoryHighExceeded(ev, &pce.ProcessContext.Process.CGroup)
+				})
+				ctx.BoolCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
+	case "ptrace.tracee.ancestors.cgroup.pids.current":
+		return &eval.IntArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []int {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.IntCache[field]; ok {
+					return result
+				}
+				var results []int
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := int(ev.FieldHandlers.ResolveCGroupPidsCurrent(ev, &element.ProcessContext.Process.CGroup))
+					results = append(results, result)
+					return results
+				}
+				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) int {
+					return int(ev.FieldHandlers.ResolveCGroupPidsCurrent(ev, &pce.ProcessContext.Process.CGroup))
+				})
+				ctx.IntCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
+	case "ptrace.tracee.ancestors.cloud_credentials.providers":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.StringCache[field]; ok {
+					return result
+				}
+				var results []string
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := ev.FieldHandlers.ResolveProcessCloudCredentialsProviders(ev, &element.ProcessContext.Process)
+					results = append(results, result...)
+					return results
+				}
+				value := iterator.Front(ctx)
+				for value != nil {
+					element := value
+					result := ev.FieldHandlers.ResolveProcessCloudCredentialsProviders(ev, &element.ProcessContext.Process)
+					results = append(results, result...)
+					value = iterator.Next()
+				}
+				ctx.StringCache[field] = results
+				return results
+			}, Field: field,
+			Weight: 100 * eval.IteratorWeight,
+		}, nil
+	case "ptrace.tracee.ancestors.cloud_credentials.resources":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.StringCache[field]; ok {
+					return result
+				}
+				var results []string
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := ev.FieldHandlers.ResolveProcessCloudCredentialsResources(ev, &element.ProcessContext.Process)
+					results = append(results, result...)
+					return results
+				}
+				value := iterator.Front(ctx)
+				for value != nil {
+					element := value
+					result := ev.FieldHandlers.ResolveProcessCloudCredentialsResources(ev, &element.ProcessContext.Process)
+					results = append(results, result...)
+					value = iterator.Next()
+				}
+				ctx.StringCache[field] = results
+				return results
+			}, Field: field,
+			Weight: 100 * eval.IteratorWeight,
+		}, nil
+	case "ptrace.tracee.ancestors.cloud_credentials.types":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.StringCache[field]; ok {
+					return result
+				}
+				var results []string
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := ev.FieldHandlers.ResolveProcessCloudCredentialsTypes(ev, &element.ProcessContext.Process)
+					results = append(results, result...)
+					return results
+				}
+				value := iterator.Front(ctx)
+				for value != nil {
+					element := value
+					result := ev.FieldHandlers.ResolveProcessCloudCredentialsTypes(ev, &element.ProcessContext.Process)
+					results = append(results, result...)
+					value = iterator.Next()
+				}
+				ctx.StringCache[field] = results
+				return results
+			}, Field: field,
+			Weight: 100 * eval.IteratorWeight,
+		}, nil
+	case "ptrace.tracee.ancestors.cmdline_obfuscation_score":
+		return &eval.IntArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []int {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.IntCache[field]; ok {
+					return result
+				}
+				var results []int
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := int(ev.FieldHandlers.ResolveProcessCmdLineObfuscationScore(ev, &element.ProcessContext.Process))
+					results = append(results, result)
+					return results
+				}
+				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) int {
+					return int(ev.FieldHandlers.ResolveProcessCmdLineObfuscationScore(ev, &pce.ProcessContext.Process))
+				})
+				ctx.IntCache[field] = results
+				return results
+			}, Field: field,
+			Weight: 500 * eval.IteratorWeight,
+		}, nil
 	case "ptrace.tracee.ancestors.comm":
 		return &eval.StringArrayEvaluator{
 			EvalFnc: func(ctx *eval.Context) []string {
@@ -9710,6 +12045,114 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			}, Field: field,
 			Weight: eval.IteratorWeight,
 		}, nil
+	case "ptrace.tracee.ancestors.elf.build_id":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.StringCache[field]; ok {
+					return result
+				}
+				var results []string
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := ev.FieldHandlers.ResolveProcessELFBuildID(ev, &element.ProcessContext.Process)
+					results = append(results, result)
+					return results
+				}
+				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) string {
+					return ev.FieldHandlers.ResolveProcessELFBuildID(ev, &pce.ProcessContext.Process)
+				})
+				ctx.StringCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
+	case "ptrace.tracee.ancestors.elf.interpreter":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.StringCache[field]; ok {
+					return result
+				}
+				var results []string
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := ev.FieldHandlers.ResolveProcessELFInterpreter(ev, &element.ProcessContext.Process)
+					results = append(results, result)
+					return results
+				}
+				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) string {
+					return ev.FieldHandlers.ResolveProcessELFInterpreter(ev, &pce.ProcessContext.Process)
+				})
+				ctx.StringCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
+	case "ptrace.tracee.ancestors.elf.is_static":
+		return &eval.BoolArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []bool {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.BoolCache[field]; ok {
+					return result
+				}
+				var results []bool
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := ev.FieldHandlers.ResolveProcessELFIsStatic(ev, &element.ProcessContext.Process)
+					results = append(results, result)
+					return results
+				}
+				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) bool {
+					return ev.FieldHandlers.ResolveProcessELFIsStatic(ev, &pce.ProcessContext.Process)
+				})
+				ctx.BoolCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
+	case "ptrace.tracee.ancestors.elf.is_stripped":
+		return &eval.BoolArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []bool {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.BoolCache[field]; ok {
+					return result
+				}
+				var results []bool
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := ev.FieldHandlers.ResolveProcessELFIsStripped(ev, &element.ProcessContext.Process)
+					results = append(results, result)
+					return results
+				}
+				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) bool {
+					return ev.FieldHandlers.ResolveProcessELFIsStripped(ev, &pce.ProcessContext.Process)
+				})
+				ctx.BoolCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
 	case "ptrace.tracee.ancestors.envp":
 		return &eval.StringArrayEvaluator{
 			EvalFnc: func(ctx *eval.Context) []string {
@@ -10111,6 +12554,72 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			}, Field: field,
 			Weight: eval.IteratorWeight,
 		}, nil
+	case "ptrace.tracee.ancestors.file.layer.digest":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.StringCache[field]; ok {
+					return result
+				}
+				var results []string
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					if !element.ProcessContext.Process.IsNotKworker() {
+						return append(results, "")
+					}
+					result := ev.FieldHandlers.ResolveFileLayerDigest(ev, &element.ProcessContext.Process.FileEvent)
+					results = append(results, result)
+					return results
+				}
+				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) string {
+					if !pce.ProcessContext.Process.IsNotKworker() {
+						return ""
+					}
+					return ev.FieldHandlers.ResolveFileLayerDigest(ev, &pce.ProcessContext.Process.FileEvent)
+				})
+				ctx.StringCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
+	case "ptrace.tracee.ancestors.file.layer.path":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.StringCache[field]; ok {
+					return result
+				}
+				var results []string
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					if !element.ProcessContext.Process.IsNotKworker() {
+						return append(results, "")
+					}
+					result := ev.FieldHandlers.ResolveFileLayerPath(ev, &element.ProcessContext.Process.FileEvent)
+					results = append(results, result)
+					return results
+				}
+				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) string {
+					if !pce.ProcessContext.Process.IsNotKworker() {
+						return ""
+					}
+					return ev.FieldHandlers.ResolveFileLayerPath(ev, &pce.ProcessContext.Process.FileEvent)
+				})
+				ctx.StringCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
 	case "ptrace.tracee.ancestors.file.mode":
 		return &eval.IntArrayEvaluator{
 			EvalFnc: func(ctx *eval.Context) []int {
@@ -10984,6 +13493,72 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			}, Field: field,
 			Weight: eval.IteratorWeight,
 		}, nil
+	case "ptrace.tracee.ancestors.interpreter.file.layer.digest":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.StringCache[field]; ok {
+					return result
+				}
+				var results []string
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					if !element.ProcessContext.Process.HasInterpreter() {
+						return append(results, "")
+					}
+					result := ev.FieldHandlers.ResolveFileLayerDigest(ev, &element.ProcessContext.Process.LinuxBinprm.FileEvent)
+					results = append(results, result)
+					return results
+				}
+				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) string {
+					if !pce.ProcessContext.Process.HasInterpreter() {
+						return ""
+					}
+					return ev.FieldHandlers.ResolveFileLayerDigest(ev, &pce.ProcessContext.Process.LinuxBinprm.FileEvent)
+				})
+				ctx.StringCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
+	case "ptrace.tracee.ancestors.interpreter.file.layer.path":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.StringCache[field]; ok {
+					return result
+				}
+				var results []string
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					if !element.ProcessContext.Process.HasInterpreter() {
+						return append(results, "")
+					}
+					result := ev.FieldHandlers.ResolveFileLayerPath(ev, &element.ProcessContext.Process.LinuxBinprm.FileEvent)
+					results = append(results, result)
+					return results
+				}
+				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) string {
+					if !pce.ProcessContext.Process.HasInterpreter() {
+						return ""
+					}
+					return ev.FieldHandlers.ResolveFileLayerPath(ev, &pce.ProcessContext.Process.LinuxBinprm.FileEvent)
+				})
+				ctx.StringCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
 	case "ptrace.tracee.ancestors.interpreter.file.mode":
 		return &eval.IntArrayEvaluator{
 			EvalFnc: func(ctx *eval.Context) []int {
@@ -11521,6 +14096,114 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.IteratorWeight,
 		}, nil
+	case "ptrace.tracee.ancestors.ns.mnt":
+		return &eval.IntArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []int {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.IntCache[field]; ok {
+					return result
+				}
+				var results []int
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := int(ev.FieldHandlers.ResolveProcessMountNamespace(ev, &element.ProcessContext.Process))
+					results = append(results, result)
+					return results
+				}
+				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) int {
+					return int(ev.FieldHandlers.ResolveProcessMountNamespace(ev, &pce.ProcessContext.Process))
+				})
+				ctx.IntCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
+	case "ptrace.tracee.ancestors.ns.pid":
+		return &eval.IntArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []int {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.IntCache[field]; ok {
+					return result
+				}
+				var results []int
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := int(ev.FieldHandlers.ResolveProcessPIDNamespace(ev, &element.ProcessContext.Process))
+					results = append(results, result)
+					return results
+				}
+				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) int {
+					return int(ev.FieldHandlers.ResolveProcessPIDNamespace(ev, &pce.ProcessContext.Process))
+				})
+				ctx.IntCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
+	case "ptrace.tracee.ancestors.ns.user":
+		return &eval.IntArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []int {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.IntCache[field]; ok {
+					return result
+				}
+				var results []int
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := int(ev.FieldHandlers.ResolveProcessUserNamespace(ev, &element.ProcessContext.Process))
+					results = append(results, result)
+					return results
+				}
+				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) int {
+					return int(ev.FieldHandlers.ResolveProcessUserNamespace(ev, &pce.ProcessContext.Process))
+				})
+				ctx.IntCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
+	case "ptrace.tracee.ancestors.ns.uts":
+		return &eval.IntArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []int {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.IntCache[field]; ok {
+					return result
+				}
+				var results []int
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := int(ev.FieldHandlers.ResolveProcessUTSNamespace(ev, &element.ProcessContext.Process))
+					results = append(results, result)
+					return results
+				}
+				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) int {
+					return int(ev.FieldHandlers.ResolveProcessUTSNamespace(ev, &pce.ProcessContext.Process))
+				})
+				ctx.IntCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
 	case "ptrace.tracee.ancestors.pid":
 		return &eval.IntArrayEvaluator{
 			EvalFnc: func(ctx *eval.Context) []int {
@@ -11581,6 +14264,60 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			}, Field: field,
 			Weight: eval.IteratorWeight,
 		}, nil
+	case "ptrace.tracee.ancestors.systemd.slice":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.StringCache[field]; ok {
+					return result
+				}
+				var results []string
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := ev.FieldHandlers.ResolveProcessSystemdSlice(ev, &element.ProcessContext.Process)
+					results = append(results, result)
+					return results
+				}
+				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) string {
+					return ev.FieldHandlers.ResolveProcessSystemdSlice(ev, &pce.ProcessContext.Process)
+				})
+				ctx.StringCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
+	case "ptrace.tracee.ancestors.systemd.unit":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.StringCache[field]; ok {
+					return result
+				}
+				var results []string
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := ev.FieldHandlers.ResolveProcessSystemdUnit(ev, &element.ProcessContext.Process)
+					results = append(results, result)
+					return results
+				}
+				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) string {
+					return ev.FieldHandlers.ResolveProcessSystemdUnit(ev, &pce.ProcessContext.Process)
+				})
+				ctx.StringCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
 	case "ptrace.tracee.ancestors.tid":
 		return &eval.IntArrayEvaluator{
 			EvalFnc: func(ctx *eval.Context) []int {
@@ -11867,6 +14604,33 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "ptrace.tracee.cgroup.ancestors":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveCGroupAncestors(ev, &ev.PTrace.Tracee.Process.CGroup)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "ptrace.tracee.cgroup.controllers":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveCGroupControllers(ev, &ev.PTrace.Tracee.Process.CGroup)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "ptrace.tracee.cgroup.cpu.usage":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return int(ev.FieldHandlers.ResolveCGroupCPUUsage(ev, &ev.PTrace.Tracee.Process.CGroup))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "ptrace.tracee.cgroup.file.inode":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -11903,6 +14667,69 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
+	case "ptrace.tracee.cgroup.memory.current":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return int(ev.FieldHandlers.ResolveCGroupMemoryCurrent(ev, &ev.PTrace.Tracee.Process.CGroup))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "ptrace.tracee.cgroup.memory.high_exceeded":
+		return &eval.BoolEvaluator{
+			EvalFnc: func(ctx *eval.Context) bool {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveCGroupMemoryHighExceeded(ev, &ev.PTrace.Tracee.Process.CGroup)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "ptrace.tracee.cgroup.pids.current":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return int(ev.FieldHandlers.ResolveCGroupPidsCurrent(ev, &ev.PTrace.Tracee.Process.CGroup))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "ptrace.tracee.cloud_credentials.providers":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveProcessCloudCredentialsProviders(ev, &ev.PTrace.Tracee.Process)
+			},
+			Field:  field,
+			Weight: 100 * eval.HandlerWeight,
+		}, nil
+	case "ptrace.tracee.cloud_credentials.resources":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveProcessCloudCredentialsResources(ev, &ev.PTrace.Tracee.Process)
+			},
+			Field:  field,
+			Weight: 100 * eval.HandlerWeight,
+		}, nil
+	case "ptrace.tracee.cloud_credentials.types":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveProcessCloudCredentialsTypes(ev, &ev.PTrace.Tracee.Process)
+			},
+			Field:  field,
+			Weight: 100 * eval.HandlerWeight,
+		}, nil
+	case "ptrace.tracee.cmdline_obfuscation_score":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveProcessCmdLineObfuscationScore(ev, &ev.PTrace.Tracee.Process)
+			},
+			Field:  field,
+			Weight: 500 * eval.HandlerWeight,
+		}, nil
 	case "ptrace.tracee.comm":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
@@ -11948,6 +14775,42 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "ptrace.tracee.elf.build_id":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveProcessELFBuildID(ev, &ev.PTrace.Tracee.Process)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "ptrace.tracee.elf.interpreter":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveProcessELFInterpreter(ev, &ev.PTrace.Tracee.Process)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "ptrace.tracee.elf.is_static":
+		return &eval.BoolEvaluator{
+			EvalFnc: func(ctx *eval.Context) bool {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveProcessELFIsStatic(ev, &ev.PTrace.Tracee.Process)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "ptrace.tracee.elf.is_stripped":
+		return &eval.BoolEvaluator{
+			EvalFnc: func(ctx *eval.Context) bool {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveProcessELFIsStripped(ev, &ev.PTrace.Tracee.Process)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "ptrace.tracee.envp":
 		return &eval.StringArrayEvaluator{
 			EvalFnc: func(ctx *eval.Context) []string {
@@ -12077,6 +14940,30 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "ptrace.tracee.file.layer.digest":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				if !ev.PTrace.Tracee.Process.IsNotKworker() {
+					return ""
+				}
+				return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.PTrace.Tracee.Process.FileEvent)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "ptrace.tracee.file.layer.path":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				if !ev.PTrace.Tracee.Process.IsNotKworker() {
+					return ""
+				}
+				return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.PTrace.Tracee.Process.FileEvent)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "ptrace.tracee.file.mode":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -12369,6 +15256,30 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "ptrace.tracee.interpreter.file.layer.digest":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				if !ev.PTrace.Tracee.Process.HasInterpreter() {
+					return ""
+				}
+				return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.PTrace.Tracee.Process.LinuxBinprm.FileEvent)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "ptrace.tracee.interpreter.file.layer.path":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				if !ev.PTrace.Tracee.Process.HasInterpreter() {
+					return ""
+				}
+				return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.PTrace.Tracee.Process.LinuxBinprm.FileEvent)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "ptrace.tracee.interpreter.file.mode":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -12550,6 +15461,42 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
+	case "ptrace.tracee.ns.mnt":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return int(ev.FieldHandlers.ResolveProcessMountNamespace(ev, &ev.PTrace.Tracee.Process))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "ptrace.tracee.ns.pid":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return int(ev.FieldHandlers.ResolveProcessPIDNamespace(ev, &ev.PTrace.Tracee.Process))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "ptrace.tracee.ns.user":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return int(ev.FieldHandlers.ResolveProcessUserNamespace(ev, &ev.PTrace.Tracee.Process))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "ptrace.tracee.ns.uts":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return int(ev.FieldHandlers.ResolveProcessUTSNamespace(ev, &ev.PTrace.Tracee.Process))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "ptrace.tracee.parent.args":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
@@ -12658,6 +15605,42 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "ptrace.tracee.parent.cgroup.ancestors":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				if !ev.PTrace.Tracee.HasParent() {
+					return []string{}
+				}
+				return ev.FieldHandlers.ResolveCGroupAncestors(ev, &ev.PTrace.Tracee.Parent.CGroup)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "ptrace.tracee.parent.cgroup.controllers":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				if !ev.PTrace.Tracee.HasParent() {
+					return []string{}
+				}
+				return ev.FieldHandlers.ResolveCGroupControllers(ev, &ev.PTrace.Tracee.Parent.CGroup)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "ptrace.tracee.parent.cgroup.cpu.usage":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				if !ev.PTrace.Tracee.HasParent() {
+					return 0
+				}
+				return int(ev.FieldHandlers.ResolveCGroupCPUUsage(ev, &ev.PTrace.Tracee.Parent.CGroup))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "ptrace.tracee.parent.cgroup.file.inode":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -12706,6 +15689,90 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
+	case "ptrace.tracee.parent.cgroup.memory.current":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				if !ev.PTrace.Tracee.HasParent() {
+					return 0
+				}
+				return int(ev.FieldHandlers.ResolveCGroupMemoryCurrent(ev, &ev.PTrace.Tracee.Parent.CGroup))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "ptrace.tracee.parent.cgroup.memory.high_exceeded":
+		return &eval.BoolEvaluator{
+			EvalFnc: func(ctx *eval.Context) bool {
+				ev := ctx.Event.(*Event)
+				if !ev.PTrace.Tracee.HasParent() {
+					return false
+				}
+				return ev.FieldHandlers.ResolveCGroupMemoryHighExceeded(ev, &ev.PTrace.Tracee.Parent.CGroup)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "ptrace.tracee.parent.cgroup.pids.current":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				if !ev.PTrace.Tracee.HasParent() {
+					return 0
+				}
+				return int(ev.FieldHandlers.ResolveCGroupPidsCurrent(ev, &ev.PTrace.Tracee.Parent.CGroup))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "ptrace.tracee.parent.cloud_credentials.providers":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				if !ev.PTrace.Tracee.HasParent() {
+					return []string{}
+				}
+				return ev.FieldHandlers.ResolveProcessCloudCredentialsProviders(ev, ev.PTrace.Tracee.Parent)
+			},
+			Field:  field,
+			Weight: 100 * eval.HandlerWeight,
+		}, nil
+	case "ptrace.tracee.parent.cloud_credentials.resources":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				if !ev.PTrace.Tracee.HasParent() {
+					return []string{}
+				}
+				return ev.FieldHandlers.ResolveProcessCloudCredentialsResources(ev, ev.PTrace.Tracee.Parent)
+			},
+			Field:  field,
+			Weight: 100 * eval.HandlerWeight,
+		}, nil
+	case "ptrace.tracee.parent.cloud_credentials.types":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				if !ev.PTrace.Tracee.HasParent() {
+					return []string{}
+				}
+				return ev.FieldHandlers.ResolveProcessCloudCredentialsTypes(ev, ev.PTrace.Tracee.Parent)
+			},
+			Field:  field,
+			Weight: 100 * eval.HandlerWeight,
+		}, nil
+	case "ptrace.tracee.parent.cmdline_obfuscation_score":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				if !ev.PTrace.Tracee.HasParent() {
+					return 0
+				}
+				return ev.FieldHandlers.ResolveProcessCmdLineObfuscationScore(ev, ev.PTrace.Tracee.Parent)
+			},
+			Field:  field,
+			Weight: 500 * eval.HandlerWeight,
+		}, nil
 	case "ptrace.tracee.parent.comm":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
@@ -12766,6 +15833,54 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "ptrace.tracee.parent.elf.build_id":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				if !ev.PTrace.Tracee.HasParent() {
+					return ""
+				}
+				return ev.FieldHandlers.ResolveProcessELFBuildID(ev, ev.PTrace.Tracee.Parent)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "ptrace.tracee.parent.elf.interpreter":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				if !ev.PTrace.Tracee.HasParent() {
+					return ""
+				}
+				return ev.FieldHandlers.ResolveProcessELFInterpreter(ev, ev.PTrace.Tracee.Parent)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "ptrace.tracee.parent.elf.is_static":
+		return &eval.BoolEvaluator{
+			EvalFnc: func(ctx *eval.Context) bool {
+				ev := ctx.Event.(*Event)
+				if !ev.PTrace.Tracee.HasParent() {
+					return false
+				}
+				return ev.FieldHandlers.ResolveProcessELFIsStatic(ev, ev.PTrace.Tracee.Parent)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "ptrace.tracee.parent.elf.is_stripped":
+		return &eval.BoolEvaluator{
+			EvalFnc: func(ctx *eval.Context) bool {
+				ev := ctx.Event.(*Event)
+				if !ev.PTrace.Tracee.HasParent() {
+					return false
+				}
+				return ev.FieldHandlers.ResolveProcessELFIsStripped(ev, ev.PTrace.Tracee.Parent)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "ptrace.tracee.parent.envp":
 		return &eval.StringArrayEvaluator{
 			EvalFnc: func(ctx *eval.Context) []string {
@@ -12931,6 +16046,36 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "ptrace.tracee.parent.file.layer.digest":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				if !ev.PTrace.Tracee.HasParent() {
+					return ""
+				}
+				if !ev.PTrace.Tracee.Parent.IsNotKworker() {
+					return ""
+				}
+				return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.PTrace.Tracee.Parent.FileEvent)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "ptrace.tracee.parent.file.layer.path":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				if !ev.PTrace.Tracee.HasParent() {
+					return ""
+				}
+				if !ev.PTrace.Tracee.Parent.IsNotKworker() {
+					return ""
+				}
+				return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.PTrace.Tracee.Parent.FileEvent)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "ptrace.tracee.parent.file.mode":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -13295,6 +16440,36 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "ptrace.tracee.parent.interpreter.file.layer.digest":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				if !ev.PTrace.Tracee.HasParent() {
+					return ""
+				}
+				if !ev.PTrace.Tracee.Parent.HasInterpreter() {
+					return ""
+				}
+				return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.PTrace.Tracee.Parent.LinuxBinprm.FileEvent)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "ptrace.tracee.parent.interpreter.file.layer.path":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				if !ev.PTrace.Tracee.HasParent() {
+					return ""
+				}
+				if !ev.PTrace.Tracee.Parent.HasInterpreter() {
+					return ""
+				}
+				return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.PTrace.Tracee.Parent.LinuxBinprm.FileEvent)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "ptrace.tracee.parent.interpreter.file.mode":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -13518,6 +16693,54 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
+	case "ptrace.tracee.parent.ns.mnt":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				if !ev.PTrace.Tracee.HasParent() {
+					return 0
+				}
+				return int(ev.FieldHandlers.ResolveProcessMountNamespace(ev, ev.PTrace.Tracee.Parent))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "ptrace.tracee.parent.ns.pid":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				if !ev.PTrace.Tracee.HasParent() {
+					return 0
+				}
+				return int(ev.FieldHandlers.ResolveProcessPIDNamespace(ev, ev.PTrace.Tracee.Parent))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "ptrace.tracee.parent.ns.user":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				if !ev.PTrace.Tracee.HasParent() {
+					return 0
+				}
+				return int(ev.FieldHandlers.ResolveProcessUserNamespace(ev, ev.PTrace.Tracee.Parent))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "ptrace.tracee.parent.ns.uts":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				if !ev.PTrace.Tracee.HasParent() {
+					return 0
+				}
+				return int(ev.FieldHandlers.ResolveProcessUTSNamespace(ev, ev.PTrace.Tracee.Parent))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "ptrace.tracee.parent.pid":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -13542,6 +16765,30 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "ptrace.tracee.parent.systemd.slice":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				if !ev.PTrace.Tracee.HasParent() {
+					return ""
+				}
+				return ev.FieldHandlers.ResolveProcessSystemdSlice(ev, ev.PTrace.Tracee.Parent)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "ptrace.tracee.parent.systemd.unit":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				if !ev.PTrace.Tracee.HasParent() {
+					return ""
+				}
+				return ev.FieldHandlers.ResolveProcessSystemdUnit(ev, ev.PTrace.Tracee.Parent)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "ptrace.tracee.parent.tid":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -13644,6 +16891,24 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "ptrace.tracee.systemd.slice":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveProcessSystemdSlice(ev, &ev.PTrace.Tracee.Process)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "ptrace.tracee.systemd.unit":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveProcessSystemdUnit(ev, &ev.PTrace.Tracee.Process)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "ptrace.tracee.tid":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -13788,6 +17053,24 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "removexattr.file.layer.digest":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.RemoveXAttr.File)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "removexattr.file.layer.path":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.RemoveXAttr.File)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "removexattr.file.mode":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -13990,6 +17273,24 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "rename.file.destination.layer.digest":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Rename.New)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "rename.file.destination.layer.path":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Rename.New)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "rename.file.destination.mode":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -14165,6 +17466,24 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "rename.file.layer.digest":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Rename.Old)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "rename.file.layer.path":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Rename.Old)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "rename.file.mode":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -14376,6 +17695,24 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "rmdir.file.layer.digest":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Rmdir.File)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "rmdir.file.layer.path":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Rmdir.File)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "rmdir.file.mode":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -14731,6 +18068,24 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "setxattr.file.layer.digest":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.SetXAttr.File)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "setxattr.file.layer.path":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.SetXAttr.File)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "setxattr.file.mode":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -15143,6 +18498,95 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			}, Field: field,
 			Weight: eval.IteratorWeight,
 		}, nil
+	case "signal.target.ancestors.cgroup.ancestors":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.StringCache[field]; ok {
+					return result
+				}
+				var results []string
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := ev.FieldHandlers.ResolveCGroupAncestors(ev, &element.ProcessContext.Process.CGroup)
+					results = append(results, result...)
+					return results
+				}
+				value := iterator.Front(ctx)
+				for value != nil {
+					element := value
+					result := ev.FieldHandlers.ResolveCGroupAncestors(ev, &element.ProcessContext.Process.CGroup)
+					results = append(results, result...)
+					value = iterator.Next()
+				}
+				ctx.StringCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
+	case "signal.target.ancestors.cgroup.controllers":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.StringCache[field]; ok {
+					return result
+				}
+				var results []string
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := ev.FieldHandlers.ResolveCGroupControllers(ev, &element.ProcessContext.Process.CGroup)
+					results = append(results, result...)
+					return results
+				}
+				value := iterator.Front(ctx)
+				for value != nil {
+					element := value
+					result := ev.FieldHandlers.ResolveCGroupControllers(ev, &element.ProcessContext.Process.CGroup)
+					results = append(results, result...)
+					value = iterator.Next()
+				}
+				ctx.StringCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
+	case "signal.target.ancestors.cgroup.cpu.usage":
+		return &eval.IntArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []int {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.IntCache[field]; ok {
+					return result
+				}
+				var results []int
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := int(ev.FieldHandlers.ResolveCGroupCPUUsage(ev, &element.ProcessContext.Process.CGroup))
+					results = append(results, result)
+					return results
+				}
+				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) int {
+					return int(ev.FieldHandlers.ResolveCGroupCPUUsage(ev, &pce.ProcessContext.Process.CGroup))
+				})
+				ctx.IntCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
 	case "signal.target.ancestors.cgroup.file.inode":
 		return &eval.IntArrayEvaluator{
 			EvalFnc: func(ctx *eval.Context) []int {
@@ -15257,6 +18701,207 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			}, Field: field,
 			Weight: eval.IteratorWeight,
 		}, nil
+	case "signal.target.ancestors.cgroup.memory.current":
+		return &eval.IntArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []int {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.IntCache[field]; ok {
+					return result
+				}
+				var results []int
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := int(ev.FieldHandlers.ResolveCGroupMemoryCurrent(ev, &element.ProcessContext.Process.CGroup))
+					results = append(results, result)
+					return results
+				}
+				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) int {
+					return int(ev.FieldHandlers.ResolveCGroupMemoryCurrent(ev, &pce.ProcessContext.Process.CGroup))
+				})
+				ctx.IntCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
+	case "signal.target.ancestors.cgroup.memory.high_exceeded":
+		return &eval.BoolArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []bool {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.BoolCache[field]; ok {
+					return result
+				}
+				var results []bool
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := ev.FieldHandlers.ResolveCGroupMemoryHighExceeded(ev, &element.ProcessContext.Process.CGroup)
+					results = append(results, result)
+					return results
+				}
+				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) bool {
+					return ev.FieldHandlers.ResolveCGroupMemoryHighExceeded(ev, &pce.ProcessContext.Process.CGroup)
+				})
+				ctx.BoolCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
+	case "signal.target.ancestors.cgroup.pids.current":
+		return &eval.IntArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []int {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.IntCache[field]; ok {
+					return result
+				}
+				var results []int
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := int(ev.FieldHandlers.ResolveCGroupPidsCurrent(ev, &element.ProcessContext.Process.CGroup))
+					results = append(results, result)
+					return results
+				}
+				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) int {
+					return int(ev.FieldHandlers.ResolveCGroupPidsCurrent(ev, &pce.ProcessContext.Process.CGroup))
+				})
+				ctx.IntCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
+	case "signal.target.ancestors.cloud_credentials.providers":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.StringCache[field]; ok {
+					return result
+				}
+				var results []string
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := ev.FieldHandlers.ResolveProcessCloudCredentialsProviders(ev, &element.ProcessContext.Process)
+					results = append(results, result...)
+					return results
+				}
+				value := iterator.Front(ctx)
+				for value != nil {
+					element := value
+					result := ev.FieldHandlers.ResolveProcessCloudCredentialsProviders(ev, &element.ProcessContext.Process)
+					results = append(results, result...)
+					value = iterator.Next()
+				}
+				ctx.StringCache[field] = results
+				return results
+			}, Field: field,
+			Weight: 100 * eval.IteratorWeight,
+		}, nil
+	case "signal.target.ancestors.cloud_credentials.resources":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.StringCache[field]; ok {
+					return result
+				}
+				var results []string
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := ev.FieldHandlers.ResolveProcessCloudCredentialsResources(ev, &element.ProcessContext.Process)
+					results = append(results, result...)
+					return results
+				}
+				value := iterator.Front(ctx)
+				for value != nil {
+					element := value
+					result := ev.FieldHandlers.ResolveProcessCloudCredentialsResources(ev, &element.ProcessContext.Process)
+					results = append(results, result...)
+					value = iterator.Next()
+				}
+				ctx.StringCache[field] = results
+				return results
+			}, Field: field,
+			Weight: 100 * eval.IteratorWeight,
+		}, nil
+	case "signal.target.ancestors.cloud_credentials.types":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.StringCache[field]; ok {
+					return result
+				}
+				var results []string
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := ev.FieldHandlers.ResolveProcessCloudCredentialsTypes(ev, &element.ProcessContext.Process)
+					results = append(results, result...)
+					return results
+				}
+				value := iterator.Front(ctx)
+				for value != nil {
+					element := value
+					result := ev.FieldHandlers.ResolveProcessCloudCredentialsTypes(ev, &element.ProcessContext.Process)
+					results = append(results, result...)
+					value = iterator.Next()
+				}
+				ctx.StringCache[field] = results
+				return results
+			}, Field: field,
+			Weight: 100 * eval.IteratorWeight,
+		}, nil
+	case "signal.target.ancestors.cmdline_obfuscation_score":
+		return &eval.IntArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []int {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.IntCache[field]; ok {
+					return result
+				}
+				var results []int
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := int(ev.FieldHandlers.ResolveProcessCmdLineObfuscationScore(ev, &element.ProcessContext.Process))
+					results = append(results, result)
+					return results
+				}
+				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) int {
+					return int(ev.FieldHandlers.ResolveProcessCmdLineObfuscationScore(ev, &pce.ProcessContext.Process))
+				})
+				ctx.IntCache[field] = results
+				return results
+			}, Field: field,
+			Weight: 500 * eval.IteratorWeight,
+		}, nil
 	case "signal.target.ancestors.comm":
 		return &eval.StringArrayEvaluator{
 			EvalFnc: func(ctx *eval.Context) []string {
@@ -15401,6 +19046,114 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			}, Field: field,
 			Weight: eval.IteratorWeight,
 		}, nil
+	case "signal.target.ancestors.elf.build_id":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.StringCache[field]; ok {
+					return result
+				}
+				var results []string
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := ev.FieldHandlers.ResolveProcessELFBuildID(ev, &element.ProcessContext.Process)
+					results = append(results, result)
+					return results
+				}
+				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) string {
+					return ev.FieldHandlers.ResolveProcessELFBuildID(ev, &pce.ProcessContext.Process)
+				})
+				ctx.StringCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
+	case "signal.target.ancestors.elf.interpreter":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.StringCache[field]; ok {
+					return result
+				}
+				var results []string
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := ev.FieldHandlers.ResolveProcessELFInterpreter(ev, &element.ProcessContext.Process)
+					results = append(results, result)
+					return results
+				}
+				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) string {
+					return ev.FieldHandlers.ResolveProcessELFInterpreter(ev, &pce.ProcessContext.Process)
+				})
+				ctx.StringCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
+	case "signal.target.ancestors.elf.is_static":
+		return &eval.BoolArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []bool {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.BoolCache[field]; ok {
+					return result
+				}
+				var results []bool
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := ev.FieldHandlers.ResolveProcessELFIsStatic(ev, &element.ProcessContext.Process)
+					results = append(results, result)
+					return results
+				}
+				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) bool {
+					return ev.FieldHandlers.ResolveProcessELFIsStatic(ev, &pce.ProcessContext.Process)
+				})
+				ctx.BoolCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
+	case "signal.target.ancestors.elf.is_stripped":
+		return &eval.BoolArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []bool {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.BoolCache[field]; ok {
+					return result
+				}
+				var results []bool
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := ev.FieldHandlers.ResolveProcessELFIsStripped(ev, &element.ProcessContext.Process)
+					results = append(results, result)
+					return results
+				}
+				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) bool {
+					return ev.FieldHandlers.ResolveProcessELFIsStripped(ev, &pce.ProcessContext.Process)
+				})
+				ctx.BoolCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
 	case "signal.target.ancestors.envp":
 		return &eval.StringArrayEvaluator{
 			EvalFnc: func(ctx *eval.Context) []string {
@@ -15802,6 +19555,72 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			}, Field: field,
 			Weight: eval.IteratorWeight,
 		}, nil
+	case "signal.target.ancestors.file.layer.digest":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.StringCache[field]; ok {
+					return result
+				}
+				var results []string
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					if !element.ProcessContext.Process.IsNotKworker() {
+						return append(results, "")
+					}
+					result := ev.FieldHandlers.ResolveFileLayerDigest(ev, &element.ProcessContext.Process.FileEvent)
+					results = append(results, result)
+					return results
+				}
+				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) string {
+					if !pce.ProcessContext.Process.IsNotKworker() {
+						return ""
+					}
+					return ev.FieldHandlers.ResolveFileLayerDigest(ev, &pce.ProcessContext.Process.FileEvent)
+				})
+				ctx.StringCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
+	case "signal.target.ancestors.file.layer.path":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.StringCache[field]; ok {
+					return result
+				}
+				var results []string
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					if !element.ProcessContext.Process.IsNotKworker() {
+						return append(results, "")
+					}
+					result := ev.FieldHandlers.ResolveFileLayerPath(ev, &element.ProcessContext.Process.FileEvent)
+					results = append(results, result)
+					return results
+				}
+				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) string {
+					if !pce.ProcessContext.Process.IsNotKworker() {
+						return ""
+					}
+					return ev.FieldHandlers.ResolveFileLayerPath(ev, &pce.ProcessContext.Process.FileEvent)
+				})
+				ctx.StringCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
 	case "signal.target.ancestors.file.mode":
 		return &eval.IntArrayEvaluator{
 			EvalFnc: func(ctx *eval.Context) []int {
@@ -16675,6 +20494,72 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			}, Field: field,
 			Weight: eval.IteratorWeight,
 		}, nil
+	case "signal.target.ancestors.interpreter.file.layer.digest":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.StringCache[field]; ok {
+					return result
+				}
+				var results []string
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					if !element.ProcessContext.Process.HasInterpreter() {
+						return append(results, "")
+					}
+					result := ev.FieldHandlers.ResolveFileLayerDigest(ev, &element.ProcessContext.Process.LinuxBinprm.FileEvent)
+					results = append(results, result)
+					return results
+				}
+				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) string {
+					if !pce.ProcessContext.Process.HasInterpreter() {
+						return ""
+					}
+					return ev.FieldHandlers.ResolveFileLayerDigest(ev, &pce.ProcessContext.Process.LinuxBinprm.FileEvent)
+				})
+				ctx.StringCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
+	case "signal.target.ancestors.interpreter.file.layer.path":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.StringCache[field]; ok {
+					return result
+				}
+				var results []string
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					if !element.ProcessContext.Process.HasInterpreter() {
+						return append(results, "")
+					}
+					result := ev.FieldHandlers.ResolveFileLayerPath(ev, &element.ProcessContext.Process.LinuxBinprm.FileEvent)
+					results = append(results, result)
+					return results
+				}
+				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) string {
+					if !pce.ProcessContext.Process.HasInterpreter() {
+						return ""
+					}
+					return ev.FieldHandlers.ResolveFileLayerPath(ev, &pce.ProcessContext.Process.LinuxBinprm.FileEvent)
+				})
+				ctx.StringCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
 	case "signal.target.ancestors.interpreter.file.mode":
 		return &eval.IntArrayEvaluator{
 			EvalFnc: func(ctx *eval.Context) []int {
@@ -17212,6 +21097,114 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.IteratorWeight,
 		}, nil
+	case "signal.target.ancestors.ns.mnt":
+		return &eval.IntArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []int {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.IntCache[field]; ok {
+					return result
+				}
+				var results []int
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := int(ev.FieldHandlers.ResolveProcessMountNamespace(ev, &element.ProcessContext.Process))
+					results = append(results, result)
+					return results
+				}
+				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) int {
+					return int(ev.FieldHandlers.ResolveProcessMountNamespace(ev, &pce.ProcessContext.Process))
+				})
+				ctx.IntCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
+	case "signal.target.ancestors.ns.pid":
+		return &eval.IntArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []int {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.IntCache[field]; ok {
+					return result
+				}
+				var results []int
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := int(ev.FieldHandlers.ResolveProcessPIDNamespace(ev, &element.ProcessContext.Process))
+					results = append(results, result)
+					return results
+				}
+				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) int {
+					return int(ev.FieldHandlers.ResolveProcessPIDNamespace(ev, &pce.ProcessContext.Process))
+				})
+				ctx.IntCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
+	case "signal.target.ancestors.ns.user":
+		return &eval.IntArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []int {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.IntCache[field]; ok {
+					return result
+				}
+				var results []int
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := int(ev.FieldHandlers.ResolveProcessUserNamespace(ev, &element.ProcessContext.Process))
+					results = append(results, result)
+					return results
+				}
+				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) int {
+					return int(ev.FieldHandlers.ResolveProcessUserNamespace(ev, &pce.ProcessContext.Process))
+				})
+				ctx.IntCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
+	case "signal.target.ancestors.ns.uts":
+		return &eval.IntArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []int {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.IntCache[field]; ok {
+					return result
+				}
+				var results []int
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := int(ev.FieldHandlers.ResolveProcessUTSNamespace(ev, &element.ProcessContext.Process))
+					results = append(results, result)
+					return results
+				}
+				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) int {
+					return int(ev.FieldHandlers.ResolveProcessUTSNamespace(ev, &pce.ProcessContext.Process))
+				})
+				ctx.IntCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
 	case "signal.target.ancestors.pid":
 		return &eval.IntArrayEvaluator{
 			EvalFnc: func(ctx *eval.Context) []int {
@@ -17272,6 +21265,60 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			}, Field: field,
 			Weight: eval.IteratorWeight,
 		}, nil
+	case "signal.target.ancestors.systemd.slice":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.StringCache[field]; ok {
+					return result
+				}
+				var results []string
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := ev.FieldHandlers.ResolveProcessSystemdSlice(ev, &element.ProcessContext.Process)
+					results = append(results, result)
+					return results
+				}
+				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) string {
+					return ev.FieldHandlers.ResolveProcessSystemdSlice(ev, &pce.ProcessContext.Process)
+				})
+				ctx.StringCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
+	case "signal.target.ancestors.systemd.unit":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				if result, ok := ctx.StringCache[field]; ok {
+					return result
+				}
+				var results []string
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := ev.FieldHandlers.ResolveProcessSystemdUnit(ev, &element.ProcessContext.Process)
+					results = append(results, result)
+					return results
+				}
+				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) string {
+					return ev.FieldHandlers.ResolveProcessSystemdUnit(ev, &pce.ProcessContext.Process)
+				})
+				ctx.StringCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
 	case "signal.target.ancestors.tid":
 		return &eval.IntArrayEvaluator{
 			EvalFnc: func(ctx *eval.Context) []int {
@@ -17558,6 +21605,33 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "signal.target.cgroup.ancestors":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveCGroupAncestors(ev, &ev.Signal.Target.Process.CGroup)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "signal.target.cgroup.controllers":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveCGroupControllers(ev, &ev.Signal.Target.Process.CGroup)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "signal.target.cgroup.cpu.usage":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return int(ev.FieldHandlers.ResolveCGroupCPUUsage(ev, &ev.Signal.Target.Process.CGroup))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "signal.target.cgroup.file.inode":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -17594,6 +21668,69 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
+	case "signal.target.cgroup.memory.current":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return int(ev.FieldHandlers.ResolveCGroupMemoryCurrent(ev, &ev.Signal.Target.Process.CGroup))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "signal.target.cgroup.memory.high_exceeded":
+		return &eval.BoolEvaluator{
+			EvalFnc: func(ctx *eval.Context) bool {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveCGroupMemoryHighExceeded(ev, &ev.Signal.Target.Process.CGroup)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "signal.target.cgroup.pids.current":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return int(ev.FieldHandlers.ResolveCGroupPidsCurrent(ev, &ev.Signal.Target.Process.CGroup))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "signal.target.cloud_credentials.providers":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveProcessCloudCredentialsProviders(ev, &ev.Signal.Target.Process)
+			},
+			Field:  field,
+			Weight: 100 * eval.HandlerWeight,
+		}, nil
+	case "signal.target.cloud_credentials.resources":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveProcessCloudCredentialsResources(ev, &ev.Signal.Target.Process)
+			},
+			Field:  field,
+			Weight: 100 * eval.HandlerWeight,
+		}, nil
+	case "signal.target.cloud_credentials.types":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveProcessCloudCredentialsTypes(ev, &ev.Signal.Target.Process)
+			},
+			Field:  field,
+			Weight: 100 * eval.HandlerWeight,
+		}, nil
+	case "signal.target.cmdline_obfuscation_score":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveProcessCmdLineObfuscationScore(ev, &ev.Signal.Target.Process)
+			},
+			Field:  field,
+			Weight: 500 * eval.HandlerWeight,
+		}, nil
 	case "signal.target.comm":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
@@ -17639,6 +21776,42 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "signal.target.elf.build_id":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveProcessELFBuildID(ev, &ev.Signal.Target.Process)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "signal.target.elf.interpreter":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveProcessELFInterpreter(ev, &ev.Signal.Target.Process)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "signal.target.elf.is_static":
+		return &eval.BoolEvaluator{
+			EvalFnc: func(ctx *eval.Context) bool {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveProcessELFIsStatic(ev, &ev.Signal.Target.Process)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "signal.target.elf.is_stripped":
+		return &eval.BoolEvaluator{
+			EvalFnc: func(ctx *eval.Context) bool {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveProcessELFIsStripped(ev, &ev.Signal.Target.Process)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "signal.target.envp":
 		return &eval.StringArrayEvaluator{
 			EvalFnc: func(ctx *eval.Context) []string {
@@ -17768,6 +21941,30 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "signal.target.file.layer.digest":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				if !ev.Signal.Target.Process.IsNotKworker() {
+					return ""
+				}
+				return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Signal.Target.Process.FileEvent)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "signal.target.file.layer.path":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				if !ev.Signal.Target.Process.IsNotKworker() {
+					return ""
+				}
+				return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Signal.Target.Process.FileEvent)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "signal.target.file.mode":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -18060,6 +22257,30 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "signal.target.interpreter.file.layer.digest":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				if !ev.Signal.Target.Process.HasInterpreter() {
+					return ""
+				}
+				return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Signal.Target.Process.LinuxBinprm.FileEvent)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "signal.target.interpreter.file.layer.path":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				if !ev.Signal.Target.Process.HasInterpreter() {
+					return ""
+				}
+				return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Signal.Target.Process.LinuxBinprm.FileEvent)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "signal.target.interpreter.file.mode":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -18241,6 +22462,42 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
+	case "signal.target.ns.mnt":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return int(ev.FieldHandlers.ResolveProcessMountNamespace(ev, &ev.Signal.Target.Process))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "signal.target.ns.pid":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return int(ev.FieldHandlers.ResolveProcessPIDNamespace(ev, &ev.Signal.Target.Process))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "signal.target.ns.user":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return int(ev.FieldHandlers.ResolveProcessUserNamespace(ev, &ev.Signal.Target.Process))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "signal.target.ns.uts":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return int(ev.FieldHandlers.ResolveProcessUTSNamespace(ev, &ev.Signal.Target.Process))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "signal.target.parent.args":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
@@ -18349,6 +22606,42 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "signal.target.parent.cgroup.ancestors":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				if !ev.Signal.Target.HasParent() {
+					return []string{}
+				}
+				return ev.FieldHandlers.ResolveCGroupAncestors(ev, &ev.Signal.Target.Parent.CGroup)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "signal.target.parent.cgroup.controllers":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				if !ev.Signal.Target.HasParent() {
+					return []string{}
+				}
+				return ev.FieldHandlers.ResolveCGroupControllers(ev, &ev.Signal.Target.Parent.CGroup)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "signal.target.parent.cgroup.cpu.usage":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				if !ev.Signal.Target.HasParent() {
+					return 0
+				}
+				return int(ev.FieldHandlers.ResolveCGroupCPUUsage(ev, &ev.Signal.Target.Parent.CGroup))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "signal.target.parent.cgroup.file.inode":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -18397,6 +22690,90 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
+	case "signal.target.parent.cgroup.memory.current":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				if !ev.Signal.Target.HasParent() {
+					return 0
+				}
+				return int(ev.FieldHandlers.ResolveCGroupMemoryCurrent(ev, &ev.Signal.Target.Parent.CGroup))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "signal.target.parent.cgroup.memory.high_exceeded":
+		return &eval.BoolEvaluator{
+			EvalFnc: func(ctx *eval.Context) bool {
+				ev := ctx.Event.(*Event)
+				if !ev.Signal.Target.HasParent() {
+					return false
+				}
+				return ev.FieldHandlers.ResolveCGroupMemoryHighExceeded(ev, &ev.Signal.Target.Parent.CGroup)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "signal.target.parent.cgroup.pids.current":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				if !ev.Signal.Target.HasParent() {
+					return 0
+				}
+				return int(ev.FieldHandlers.ResolveCGroupPidsCurrent(ev, &ev.Signal.Target.Parent.CGroup))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "signal.target.parent.cloud_credentials.providers":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				if !ev.Signal.Target.HasParent() {
+					return []string{}
+				}
+				return ev.FieldHandlers.ResolveProcessCloudCredentialsProviders(ev, ev.Signal.Target.Parent)
+			},
+			Field:  field,
+			Weight: 100 * eval.HandlerWeight,
+		}, nil
+	case "signal.target.parent.cloud_credentials.resources":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				if !ev.Signal.Target.HasParent() {
+					return []string{}
+				}
+				return ev.FieldHandlers.ResolveProcessCloudCredentialsResources(ev, ev.Signal.Target.Parent)
+			},
+			Field:  field,
+			Weight: 100 * eval.HandlerWeight,
+		}, nil
+	case "signal.target.parent.cloud_credentials.types":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				ev := ctx.Event.(*Event)
+				if !ev.Signal.Target.HasParent() {
+					return []string{}
+				}
+				return ev.FieldHandlers.ResolveProcessCloudCredentialsTypes(ev, ev.Signal.Target.Parent)
+			},
+			Field:  field,
+			Weight: 100 * eval.HandlerWeight,
+		}, nil
+	case "signal.target.parent.cmdline_obfuscation_score":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				if !ev.Signal.Target.HasParent() {
+					return 0
+				}
+				return ev.FieldHandlers.ResolveProcessCmdLineObfuscationScore(ev, ev.Signal.Target.Parent)
+			},
+			Field:  field,
+			Weight: 500 * eval.HandlerWeight,
+		}, nil
 	case "signal.target.parent.comm":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
@@ -18457,6 +22834,54 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "signal.target.parent.elf.build_id":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				if !ev.Signal.Target.HasParent() {
+					return ""
+				}
+				return ev.FieldHandlers.ResolveProcessELFBuildID(ev, ev.Signal.Target.Parent)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "signal.target.parent.elf.interpreter":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				if !ev.Signal.Target.HasParent() {
+					return ""
+				}
+				return ev.FieldHandlers.ResolveProcessELFInterpreter(ev, ev.Signal.Target.Parent)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "signal.target.parent.elf.is_static":
+		return &eval.BoolEvaluator{
+			EvalFnc: func(ctx *eval.Context) bool {
+				ev := ctx.Event.(*Event)
+				if !ev.Signal.Target.HasParent() {
+					return false
+				}
+				return ev.FieldHandlers.ResolveProcessELFIsStatic(ev, ev.Signal.Target.Parent)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "signal.target.parent.elf.is_stripped":
+		return &eval.BoolEvaluator{
+			EvalFnc: func(ctx *eval.Context) bool {
+				ev := ctx.Event.(*Event)
+				if !ev.Signal.Target.HasParent() {
+					return false
+				}
+				return ev.FieldHandlers.ResolveProcessELFIsStripped(ev, ev.Signal.Target.Parent)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "signal.target.parent.envp":
 		return &eval.StringArrayEvaluator{
 			EvalFnc: func(ctx *eval.Context) []string {
@@ -18622,6 +23047,36 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "signal.target.parent.file.layer.digest":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				if !ev.Signal.Target.HasParent() {
+					return ""
+				}
+				if !ev.Signal.Target.Parent.IsNotKworker() {
+					return ""
+				}
+				return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Signal.Target.Parent.FileEvent)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "signal.target.parent.file.layer.path":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				if !ev.Signal.Target.HasParent() {
+					return ""
+				}
+				if !ev.Signal.Target.Parent.IsNotKworker() {
+					return ""
+				}
+				return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Signal.Target.Parent.FileEvent)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "signal.target.parent.file.mode":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -18986,6 +23441,36 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "signal.target.parent.interpreter.file.layer.digest":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				if !ev.Signal.Target.HasParent() {
+					return ""
+				}
+				if !ev.Signal.Target.Parent.HasInterpreter() {
+					return ""
+				}
+				return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Signal.Target.Parent.LinuxBinprm.FileEvent)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "signal.target.parent.interpreter.file.layer.path":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				if !ev.Signal.Target.HasParent() {
+					return ""
+				}
+				if !ev.Signal.Target.Parent.HasInterpreter() {
+					return ""
+				}
+				return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Signal.Target.Parent.LinuxBinprm.FileEvent)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "signal.target.parent.interpreter.file.mode":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -19209,6 +23694,54 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
+	case "signal.target.parent.ns.mnt":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				if !ev.Signal.Target.HasParent() {
+					return 0
+				}
+				return int(ev.FieldHandlers.ResolveProcessMountNamespace(ev, ev.Signal.Target.Parent))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "signal.target.parent.ns.pid":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				if !ev.Signal.Target.HasParent() {
+					return 0
+				}
+				return int(ev.FieldHandlers.ResolveProcessPIDNamespace(ev, ev.Signal.Target.Parent))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "signal.target.parent.ns.user":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				if !ev.Signal.Target.HasParent() {
+					return 0
+				}
+				return int(ev.FieldHandlers.ResolveProcessUserNamespace(ev, ev.Signal.Target.Parent))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "signal.target.parent.ns.uts":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				if !ev.Signal.Target.HasParent() {
+					return 0
+				}
+				return int(ev.FieldHandlers.ResolveProcessUTSNamespace(ev, ev.Signal.Target.Parent))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "signal.target.parent.pid":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -19233,6 +23766,30 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "signal.target.parent.systemd.slice":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				if !ev.Signal.Target.HasParent() {
+					return ""
+				}
+				return ev.FieldHandlers.ResolveProcessSystemdSlice(ev, ev.Signal.Target.Parent)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "signal.target.parent.systemd.unit":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				if !ev.Signal.Target.HasParent() {
+					return ""
+				}
+				return ev.FieldHandlers.ResolveProcessSystemdUnit(ev, ev.Signal.Target.Parent)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "signal.target.parent.tid":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -19335,6 +23892,24 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "signal.target.systemd.slice":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveProcessSystemdSlice(ev, &ev.Signal.Target.Process)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "signal.target.systemd.unit":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveProcessSystemdUnit(ev, &ev.Signal.Target.Process)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "signal.target.tid":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -19470,6 +24045,24 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "splice.file.layer.digest":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Splice.File)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "splice.file.layer.path":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Splice.File)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "splice.file.mode":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -19681,6 +24274,24 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "unlink.file.layer.digest":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Unlink.File)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "unlink.file.layer.path":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Unlink.File)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "unlink.file.mode":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -19928,6 +24539,24 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "utimes.file.layer.digest":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Utimes.File)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "utimes.file.layer.path":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Utimes.File)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "utimes.file.mode":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -20078,6 +24707,8 @@ func (ev *Event) GetFields() []eval.Field {
 		"bind.addr.port",
 		"bind.retval",
 		"bpf.cmd",
+		"bpf.kernel_security.lockdown",
+		"bpf.kernel_security.secure_boot",
 		"bpf.map.name",
 		"bpf.map.type",
 		"bpf.prog.attach_type",
@@ -20088,10 +24719,16 @@ func (ev *Event) GetFields() []eval.Field {
 		"bpf.retval",
 		"capset.cap_effective",
 		"capset.cap_permitted",
+		"cgroup.ancestors",
+		"cgroup.controllers",
+		"cgroup.cpu.usage",
 		"cgroup.file.inode",
 		"cgroup.file.mount_id",
 		"cgroup.id",
 		"cgroup.manager",
+		"cgroup.memory.current",
+		"cgroup.memory.high_exceeded",
+		"cgroup.pids.current",
 		"chdir.file.change_time",
 		"chdir.file.filesystem",
 		"chdir.file.gid",
@@ -20099,6 +24736,8 @@ func (ev *Event) GetFields() []eval.Field {
 		"chdir.file.hashes",
 		"chdir.file.in_upper_layer",
 		"chdir.file.inode",
+		"chdir.file.layer.digest",
+		"chdir.file.layer.path",
 		"chdir.file.mode",
 		"chdir.file.modification_time",
 		"chdir.file.mount_id",
@@ -20123,6 +24762,8 @@ func (ev *Event) GetFields() []eval.Field {
 		"chmod.file.hashes",
 		"chmod.file.in_upper_layer",
 		"chmod.file.inode",
+		"chmod.file.layer.digest",
+		"chmod.file.layer.path",
 		"chmod.file.mode",
 		"chmod.file.modification_time",
 		"chmod.file.mount_id",
@@ -20133,6 +24774,8 @@ func (ev *Event) GetFields() []eval.Field {
 		"chmod.file.package.version",
 		"chmod.file.path",
 		"chmod.file.path.length",
+		"chmod.file.previous_mode",
+		"chmod.file.previous_rights",
 		"chmod.file.rights",
 		"chmod.file.uid",
 		"chmod.file.user",
@@ -20150,6 +24793,8 @@ func (ev *Event) GetFields() []eval.Field {
 		"chown.file.hashes",
 		"chown.file.in_upper_layer",
 		"chown.file.inode",
+		"chown.file.layer.digest",
+		"chown.file.layer.path",
 		"chown.file.mode",
 		"chown.file.modification_time",
 		"chown.file.mount_id",
@@ -20160,6 +24805,8 @@ func (ev *Event) GetFields() []eval.Field {
 		"chown.file.package.version",
 		"chown.file.path",
 		"chown.file.path.length",
+		"chown.file.previous_gid",
+		"chown.file.previous_uid",
 		"chown.file.rights",
 		"chown.file.uid",
 		"chown.file.user",
@@ -20183,10 +24830,13 @@ func (ev *Event) GetFields() []eval.Field {
 		"dns.id",
 		"dns.question.class",
 		"dns.question.count",
+		"dns.question.domain_query_rate",
+		"dns.question.label_entropy",
 		"dns.question.length",
 		"dns.question.name",
 		"dns.question.name.length",
 		"dns.question.type",
+		"dns.question.unique_subdomains",
 		"event.async",
 		"event.hostname",
 		"event.origin",
@@ -20202,15 +24852,29 @@ func (ev *Event) GetFields() []eval.Field {
 		"exec.auid",
 		"exec.cap_effective",
 		"exec.cap_permitted",
+		"exec.cgroup.ancestors",
+		"exec.cgroup.controllers",
+		"exec.cgroup.cpu.usage",
 		"exec.cgroup.file.inode",
 		"exec.cgroup.file.mount_id",
 		"exec.cgroup.id",
 		"exec.cgroup.manager",
+		"exec.cgroup.memory.current",
+		"exec.cgroup.memory.high_exceeded",
+		"exec.cgroup.pids.current",
+		"exec.cloud_credentials.providers",
+		"exec.cloud_credentials.resources",
+		"exec.cloud_credentials.types",
+		"exec.cmdline_obfuscation_score",
 		"exec.comm",
 		"exec.container.id",
 		"exec.created_at",
 		"exec.egid",
 		"exec.egroup",
+		"exec.elf.build_id",
+		"exec.elf.interpreter",
+		"exec.elf.is_static",
+		"exec.elf.is_stripped",
 		"exec.envp",
 		"exec.envs",
 		"exec.envs_truncated",
@@ -20223,6 +24887,8 @@ func (ev *Event) GetFields() []eval.Field {
 		"exec.file.hashes",
 		"exec.file.in_upper_layer",
 		"exec.file.inode",
+		"exec.file.layer.digest",
+		"exec.file.layer.path",
 		"exec.file.mode",
 		"exec.file.modification_time",
 		"exec.file.mount_id",
@@ -20249,6 +24915,8 @@ func (ev *Event) GetFields() []eval.Field {
 		"exec.interpreter.file.hashes",
 		"exec.interpreter.file.in_upper_layer",
 		"exec.interpreter.file.inode",
+		"exec.interpreter.file.layer.digest",
+		"exec.interpreter.file.layer.path",
 		"exec.interpreter.file.mode",
 		"exec.interpreter.file.modification_time",
 		"exec.interpreter.file.mount_id",
@@ -20265,9 +24933,15 @@ func (ev *Event) GetFields() []eval.Field {
 		"exec.is_exec",
 		"exec.is_kworker",
 		"exec.is_thread",
+		"exec.ns.mnt",
+		"exec.ns.pid",
+		"exec.ns.user",
+		"exec.ns.uts",
 		"exec.pid",
 		"exec.ppid",
 		"exec.syscall.path",
+		"exec.systemd.slice",
+		"exec.systemd.unit",
 		"exec.tid",
 		"exec.tty_name",
 		"exec.uid",
@@ -20285,16 +24959,30 @@ func (ev *Event) GetFields() []eval.Field {
 		"exit.cap_effective",
 		"exit.cap_permitted",
 		"exit.cause",
+		"exit.cgroup.ancestors",
+		"exit.cgroup.controllers",
+		"exit.cgroup.cpu.usage",
 		"exit.cgroup.file.inode",
 		"exit.cgroup.file.mount_id",
 		"exit.cgroup.id",
 		"exit.cgroup.manager",
+		"exit.cgroup.memory.current",
+		"exit.cgroup.memory.high_exceeded",
+		"exit.cgroup.pids.current",
+		"exit.cloud_credentials.providers",
+		"exit.cloud_credentials.resources",
+		"exit.cloud_credentials.types",
+		"exit.cmdline_obfuscation_score",
 		"exit.code",
 		"exit.comm",
 		"exit.container.id",
 		"exit.created_at",
 		"exit.egid",
 		"exit.egroup",
+		"exit.elf.build_id",
+		"exit.elf.interpreter",
+		"exit.elf.is_static",
+		"exit.elf.is_stripped",
 		"exit.envp",
 		"exit.envs",
 		"exit.envs_truncated",
@@ -20307,6 +24995,8 @@ func (ev *Event) GetFields() []eval.Field {
 		"exit.file.hashes",
 		"exit.file.in_upper_layer",
 		"exit.file.inode",
+		"exit.file.layer.digest",
+		"exit.file.layer.path",
 		"exit.file.mode",
 		"exit.file.modification_time",
 		"exit.file.mount_id",
@@ -20333,6 +25023,8 @@ func (ev *Event) GetFields() []eval.Field {
 		"exit.interpreter.file.hashes",
 		"exit.interpreter.file.in_upper_layer",
 		"exit.interpreter.file.inode",
+		"exit.interpreter.file.layer.digest",
+		"exit.interpreter.file.layer.path",
 		"exit.interpreter.file.mode",
 		"exit.interpreter.file.modification_time",
 		"exit.interpreter.file.mount_id",
@@ -20349,8 +25041,14 @@ func (ev *Event) GetFields() []eval.Field {
 		"exit.is_exec",
 		"exit.is_kworker",
 		"exit.is_thread",
+		"exit.ns.mnt",
+		"exit.ns.pid",
+		"exit.ns.user",
+		"exit.ns.uts",
 		"exit.pid",
 		"exit.ppid",
+		"exit.systemd.slice",
+		"exit.systemd.unit",
 		"exit.tid",
 		"exit.tty_name",
 		"exit.uid",
@@ -20358,6 +25056,9 @@ func (ev *Event) GetFields() []eval.Field {
 		"exit.user_session.k8s_groups",
 		"exit.user_session.k8s_uid",
 		"exit.user_session.k8s_username",
+		"imds.access_token.expires_in",
+		"imds.access_token.resource",
+		"imds.access_token.type",
 		"imds.aws.is_imds_v2",
 		"imds.aws.security_credentials.type",
 		"imds.cloud_provider",
@@ -20374,6 +25075,8 @@ func (ev *Event) GetFields() []eval.Field {
 		"link.file.destination.hashes",
 		"link.file.destination.in_upper_layer",
 		"link.file.destination.inode",
+		"link.file.destination.layer.digest",
+		"link.file.destination.layer.path",
 		"link.file.destination.mode",
 		"link.file.destination.modification_time",
 		"link.file.destination.mount_id",
@@ -20393,6 +25096,8 @@ func (ev *Event) GetFields() []eval.Field {
 		"link.file.hashes",
 		"link.file.in_upper_layer",
 		"link.file.inode",
+		"link.file.layer.digest",
+		"link.file.layer.path",
 		"link.file.mode",
 		"link.file.modification_time",
 		"link.file.mount_id",
@@ -20419,6 +25124,8 @@ func (ev *Event) GetFields() []eval.Field {
 		"load_module.file.hashes",
 		"load_module.file.in_upper_layer",
 		"load_module.file.inode",
+		"load_module.file.layer.digest",
+		"load_module.file.layer.path",
 		"load_module.file.mode",
 		"load_module.file.modification_time",
 		"load_module.file.mount_id",
@@ -20432,6 +25139,8 @@ func (ev *Event) GetFields() []eval.Field {
 		"load_module.file.rights",
 		"load_module.file.uid",
 		"load_module.file.user",
+		"load_module.kernel_security.lockdown",
+		"load_module.kernel_security.secure_boot",
 		"load_module.loaded_from_memory",
 		"load_module.name",
 		"load_module.retval",
@@ -20444,6 +25153,8 @@ func (ev *Event) GetFields() []eval.Field {
 		"mkdir.file.hashes",
 		"mkdir.file.in_upper_layer",
 		"mkdir.file.inode",
+		"mkdir.file.layer.digest",
+		"mkdir.file.layer.path",
 		"mkdir.file.mode",
 		"mkdir.file.modification_time",
 		"mkdir.file.mount_id",
@@ -20465,6 +25176,8 @@ func (ev *Event) GetFields() []eval.Field {
 		"mmap.file.hashes",
 		"mmap.file.in_upper_layer",
 		"mmap.file.inode",
+		"mmap.file.layer.digest",
+		"mmap.file.layer.path",
 		"mmap.file.mode",
 		"mmap.file.modification_time",
 		"mmap.file.mount_id",
@@ -20479,6 +25192,7 @@ func (ev *Event) GetFields() []eval.Field {
 		"mmap.file.uid",
 		"mmap.file.user",
 		"mmap.flags",
+		"mmap.length",
 		"mmap.protection",
 		"mmap.retval",
 		"mount.fs_type",
@@ -20489,8 +25203,10 @@ func (ev *Event) GetFields() []eval.Field {
 		"mount.syscall.fs_type",
 		"mount.syscall.mountpoint.path",
 		"mount.syscall.source.path",
+		"mprotect.is_anonymous",
 		"mprotect.req_protection",
 		"mprotect.retval",
+		"mprotect.size",
 		"mprotect.vm_protection",
 		"network.destination.ip",
 		"network.destination.is_public",
@@ -20519,6 +25235,8 @@ func (ev *Event) GetFields() []eval.Field {
 		"open.file.hashes",
 		"open.file.in_upper_layer",
 		"open.file.inode",
+		"open.file.layer.digest",
+		"open.file.layer.path",
 		"open.file.mode",
 		"open.file.modification_time",
 		"open.file.mount_id",
@@ -20558,15 +25276,29 @@ func (ev *Event) GetFields() []eval.Field {
 		"process.ancestors.auid",
 		"process.ancestors.cap_effective",
 		"process.ancestors.cap_permitted",
+		"process.ancestors.cgroup.ancestors",
+		"process.ancestors.cgroup.controllers",
+		"process.ancestors.cgroup.cpu.usage",
 		"process.ancestors.cgroup.file.inode",
 		"process.ancestors.cgroup.file.mount_id",
 		"process.ancestors.cgroup.id",
 		"process.ancestors.cgroup.manager",
+		"process.ancestors.cgroup.memory.current",
+		"process.ancestors.cgroup.memory.high_exceeded",
+		"process.ancestors.cgroup.pids.current",
+		"process.ancestors.cloud_credentials.providers",
+		"process.ancestors.cloud_credentials.resources",
+		"process.ancestors.cloud_credentials.types",
+		"process.ancestors.cmdline_obfuscation_score",
 		"process.ancestors.comm",
 		"process.ancestors.container.id",
 		"process.ancestors.created_at",
 		"process.ancestors.egid",
 		"process.ancestors.egroup",
+		"process.ancestors.elf.build_id",
+		"process.ancestors.elf.interpreter",
+		"process.ancestors.elf.is_static",
+		"process.ancestors.elf.is_stripped",
 		"process.ancestors.envp",
 		"process.ancestors.envs",
 		"process.ancestors.envs_truncated",
@@ -20579,6 +25311,8 @@ func (ev *Event) GetFields() []eval.Field {
 		"process.ancestors.file.hashes",
 		"process.ancestors.file.in_upper_layer",
 		"process.ancestors.file.inode",
+		"process.ancestors.file.layer.digest",
+		"process.ancestors.file.layer.path",
 		"process.ancestors.file.mode",
 		"process.ancestors.file.modification_time",
 		"process.ancestors.file.mount_id",
@@ -20605,6 +25339,8 @@ func (ev *Event) GetFields() []eval.Field {
 		"process.ancestors.interpreter.file.hashes",
 		"process.ancestors.interpreter.file.in_upper_layer",
 		"process.ancestors.interpreter.file.inode",
+		"process.ancestors.interpreter.file.layer.digest",
+		"process.ancestors.interpreter.file.layer.path",
 		"process.ancestors.interpreter.file.mode",
 		"process.ancestors.interpreter.file.modification_time",
 		"process.ancestors.interpreter.file.mount_id",
@@ -20622,8 +25358,14 @@ func (ev *Event) GetFields() []eval.Field {
 		"process.ancestors.is_kworker",
 		"process.ancestors.is_thread",
 		"process.ancestors.length",
+		"process.ancestors.ns.mnt",
+		"process.ancestors.ns.pid",
+		"process.ancestors.ns.user",
+		"process.ancestors.ns.uts",
 		"process.ancestors.pid",
 		"process.ancestors.ppid",
+		"process.ancestors.systemd.slice",
+		"process.ancestors.systemd.unit",
 		"process.ancestors.tid",
 		"process.ancestors.tty_name",
 		"process.ancestors.uid",
@@ -20640,15 +25382,29 @@ func (ev *Event) GetFields() []eval.Field {
 		"process.auid",
 		"process.cap_effective",
 		"process.cap_permitted",
+		"process.cgroup.ancestors",
+		"process.cgroup.controllers",
+		"process.cgroup.cpu.usage",
 		"process.cgroup.file.inode",
 		"process.cgroup.file.mount_id",
 		"process.cgroup.id",
 		"process.cgroup.manager",
+		"process.cgroup.memory.current",
+		"process.cgroup.memory.high_exceeded",
+		"process.cgroup.pids.current",
+		"process.cloud_credentials.providers",
+		"process.cloud_credentials.resources",
+		"process.cloud_credentials.types",
+		"process.cmdline_obfuscation_score",
 		"process.comm",
 		"process.container.id",
 		"process.created_at",
 		"process.egid",
 		"process.egroup",
+		"process.elf.build_id",
+		"process.elf.interpreter",
+		"process.elf.is_static",
+		"process.elf.is_stripped",
 		"process.envp",
 		"process.envs",
 		"process.envs_truncated",
@@ -20661,6 +25417,8 @@ func (ev *Event) GetFields() []eval.Field {
 		"process.file.hashes",
 		"process.file.in_upper_layer",
 		"process.file.inode",
+		"process.file.layer.digest",
+		"process.file.layer.path",
 		"process.file.mode",
 		"process.file.modification_time",
 		"process.file.mount_id",
@@ -20687,6 +25445,8 @@ func (ev *Event) GetFields() []eval.Field {
 		"process.interpreter.file.hashes",
 		"process.interpreter.file.in_upper_layer",
 		"process.interpreter.file.inode",
+		"process.interpreter.file.layer.digest",
+		"process.interpreter.file.layer.path",
 		"process.interpreter.file.mode",
 		"process.interpreter.file.modification_time",
 		"process.interpreter.file.mount_id",
@@ -20703,6 +25463,10 @@ func (ev *Event) GetFields() []eval.Field {
 		"process.is_exec",
 		"process.is_kworker",
 		"process.is_thread",
+		"process.ns.mnt",
+		"process.ns.pid",
+		"process.ns.user",
+		"process.ns.uts",
 		"process.parent.args",
 		"process.parent.args_flags",
 		"process.parent.args_options",
@@ -20712,15 +25476,29 @@ func (ev *Event) GetFields() []eval.Field {
 		"process.parent.auid",
 		"process.parent.cap_effective",
 		"process.parent.cap_permitted",
+		"process.parent.cgroup.ancestors",
+		"process.parent.cgroup.controllers",
+		"process.parent.cgroup.cpu.usage",
 		"process.parent.cgroup.file.inode",
 		"process.parent.cgroup.file.mount_id",
 		"process.parent.cgroup.id",
 		"process.parent.cgroup.manager",
+		"process.parent.cgroup.memory.current",
+		"process.parent.cgroup.memory.high_exceeded",
+		"process.parent.cgroup.pids.current",
+		"process.parent.cloud_credentials.providers",
+		"process.parent.cloud_credentials.resources",
+		"process.parent.cloud_credentials.types",
+		"process.parent.cmdline_obfuscation_score",
 		"process.parent.comm",
 		"process.parent.container.id",
 		"process.parent.created_at",
 		"process.parent.egid",
 		"process.parent.egroup",
+		"process.parent.elf.build_id",
+		"process.parent.elf.interpreter",
+		"process.parent.elf.is_static",
+		"process.parent.elf.is_stripped",
 		"process.parent.envp",
 		"process.parent.envs",
 		"process.parent.envs_truncated",
@@ -20733,6 +25511,8 @@ func (ev *Event) GetFields() []eval.Field {
 		"process.parent.file.hashes",
 		"process.parent.file.in_upper_layer",
 		"process.parent.file.inode",
+		"process.parent.file.layer.digest",
+		"process.parent.file.layer.path",
 		"process.parent.file.mode",
 		"process.parent.file.modification_time",
 		"process.parent.file.mount_id",
@@ -20759,6 +25539,8 @@ func (ev *Event) GetFields() []eval.Field {
 		"process.parent.interpreter.file.hashes",
 		"process.parent.interpreter.file.in_upper_layer",
 		"process.parent.interpreter.file.inode",
+		"process.parent.interpreter.file.layer.digest",
+		"process.parent.interpreter.file.layer.path",
 		"process.parent.interpreter.file.mode",
 		"process.parent.interpreter.file.modification_time",
 		"process.parent.interpreter.file.mount_id",
@@ -20775,8 +25557,14 @@ func (ev *Event) GetFields() []eval.Field {
 		"process.parent.is_exec",
 		"process.parent.is_kworker",
 		"process.parent.is_thread",
+		"process.parent.ns.mnt",
+		"process.parent.ns.pid",
+		"process.parent.ns.user",
+		"process.parent.ns.uts",
 		"process.parent.pid",
 		"process.parent.ppid",
+		"process.parent.systemd.slice",
+		"process.parent.systemd.unit",
 		"process.parent.tid",
 		"process.parent.tty_name",
 		"process.parent.uid",
@@ -20786,6 +25574,8 @@ func (ev *Event) GetFields() []eval.Field {
 		"process.parent.user_session.k8s_username",
 		"process.pid",
 		"process.ppid",
+		"process.systemd.slice",
+		"process.systemd.unit",
 		"process.tid",
 		"process.tty_name",
 		"process.uid",
@@ -20804,15 +25594,29 @@ func (ev *Event) GetFields() []eval.Field {
 		"ptrace.tracee.ancestors.auid",
 		"ptrace.tracee.ancestors.cap_effective",
 		"ptrace.tracee.ancestors.cap_permitted",
+		"ptrace.tracee.ancestors.cgroup.ancestors",
+		"ptrace.tracee.ancestors.cgroup.controllers",
+		"ptrace.tracee.ancestors.cgroup.cpu.usage",
 		"ptrace.tracee.ancestors.cgroup.file.inode",
 		"ptrace.tracee.ancestors.cgroup.file.mount_id",
 		"ptrace.tracee.ancestors.cgroup.id",
 		"ptrace.tracee.ancestors.cgroup.manager",
+		"ptrace.tracee.ancestors.cgroup.memory.current",
+		"ptrace.tracee.ancestors.cgroup.memory.high_exceeded",
+		"ptrace.tracee.ancestors.cgroup.pids.current",
+		"ptrace.tracee.ancestors.cloud_credentials.providers",
+		"ptrace.tracee.ancestors.cloud_credentials.resources",
+		"ptrace.tracee.ancestors.cloud_credentials.types",
+		"ptrace.tracee.ancestors.cmdline_obfuscation_score",
 		"ptrace.tracee.ancestors.comm",
 		"ptrace.tracee.ancestors.container.id",
 		"ptrace.tracee.ancestors.created_at",
 		"ptrace.tracee.ancestors.egid",
 		"ptrace.tracee.ancestors.egroup",
+		"ptrace.tracee.ancestors.elf.build_id",
+		"ptrace.tracee.ancestors.elf.interpreter",
+		"ptrace.tracee.ancestors.elf.is_static",
+		"ptrace.tracee.ancestors.elf.is_stripped",
 		"ptrace.tracee.ancestors.envp",
 		"ptrace.tracee.ancestors.envs",
 		"ptrace.tracee.ancestors.envs_truncated",
@@ -20825,6 +25629,8 @@ func (ev *Event) GetFields() []eval.Field {
 		"ptrace.tracee.ancestors.file.hashes",
 		"ptrace.tracee.ancestors.file.in_upper_layer",
 		"ptrace.tracee.ancestors.file.inode",
+		"ptrace.tracee.ancestors.file.layer.digest",
+		"ptrace.tracee.ancestors.file.layer.path",
 		"ptrace.tracee.ancestors.file.mode",
 		"ptrace.tracee.ancestors.file.modification_time",
 		"ptrace.tracee.ancestors.file.mount_id",
@@ -20851,6 +25657,8 @@ func (ev *Event) GetFields() []eval.Field {
 		"ptrace.tracee.ancestors.interpreter.file.hashes",
 		"ptrace.tracee.ancestors.interpreter.file.in_upper_layer",
 		"ptrace.tracee.ancestors.interpreter.file.inode",
+		"ptrace.tracee.ancestors.interpreter.file.layer.digest",
+		"ptrace.tracee.ancestors.interpreter.file.layer.path",
 		"ptrace.tracee.ancestors.interpreter.file.mode",
 		"ptrace.tracee.ancestors.interpreter.file.modification_time",
 		"ptrace.tracee.ancestors.interpreter.file.mount_id",
@@ -20868,8 +25676,14 @@ func (ev *Event) GetFields() []eval.Field {
 		"ptrace.tracee.ancestors.is_kworker",
 		"ptrace.tracee.ancestors.is_thread",
 		"ptrace.tracee.ancestors.length",
+		"ptrace.tracee.ancestors.ns.mnt",
+		"ptrace.tracee.ancestors.ns.pid",
+		"ptrace.tracee.ancestors.ns.user",
+		"ptrace.tracee.ancestors.ns.uts",
 		"ptrace.tracee.ancestors.pid",
 		"ptrace.tracee.ancestors.ppid",
+		"ptrace.tracee.ancestors.systemd.slice",
+		"ptrace.tracee.ancestors.systemd.unit",
 		"ptrace.tracee.ancestors.tid",
 		"ptrace.tracee.ancestors.tty_name",
 		"ptrace.tracee.ancestors.uid",
@@ -20886,15 +25700,29 @@ func (ev *Event) GetFields() []eval.Field {
 		"ptrace.tracee.auid",
 		"ptrace.tracee.cap_effective",
 		"ptrace.tracee.cap_permitted",
+		"ptrace.tracee.cgroup.ancestors",
+		"ptrace.tracee.cgroup.controllers",
+		"ptrace.tracee.cgroup.cpu.usage",
 		"ptrace.tracee.cgroup.file.inode",
 		"ptrace.tracee.cgroup.file.mount_id",
 		"ptrace.tracee.cgroup.id",
 		"ptrace.tracee.cgroup.manager",
+		"ptrace.tracee.cgroup.memory.current",
+		"ptrace.tracee.cgroup.memory.high_exceeded",
+		"ptrace.tracee.cgroup.pids.current",
+		"ptrace.tracee.cloud_credentials.providers",
+		"ptrace.tracee.cloud_credentials.resources",
+		"ptrace.tracee.cloud_credentials.types",
+		"ptrace.tracee.cmdline_obfuscation_score",
 		"ptrace.tracee.comm",
 		"ptrace.tracee.container.id",
 		"ptrace.tracee.created_at",
 		"ptrace.tracee.egid",
 		"ptrace.tracee.egroup",
+		"ptrace.tracee.elf.build_id",
+		"ptrace.tracee.elf.interpreter",
+		"ptrace.tracee.elf.is_static",
+		"ptrace.tracee.elf.is_stripped",
 		"ptrace.tracee.envp",
 		"ptrace.tracee.envs",
 		"ptrace.tracee.envs_truncated",
@@ -20907,6 +25735,8 @@ func (ev *Event) GetFields() []eval.Field {
 		"ptrace.tracee.file.hashes",
 		"ptrace.tracee.file.in_upper_layer",
 		"ptrace.tracee.file.inode",
+		"ptrace.tracee.file.layer.digest",
+		"ptrace.tracee.file.layer.path",
 		"ptrace.tracee.file.mode",
 		"ptrace.tracee.file.modification_time",
 		"ptrace.tracee.file.mount_id",
@@ -20933,6 +25763,8 @@ func (ev *Event) GetFields() []eval.Field {
 		"ptrace.tracee.interpreter.file.hashes",
 		"ptrace.tracee.interpreter.file.in_upper_layer",
 		"ptrace.tracee.interpreter.file.inode",
+		"ptrace.tracee.interpreter.file.layer.digest",
+		"ptrace.tracee.interpreter.file.layer.path",
 		"ptrace.tracee.interpreter.file.mode",
 		"ptrace.tracee.interpreter.file.modification_time",
 		"ptrace.tracee.interpreter.file.mount_id",
@@ -20949,6 +25781,10 @@ func (ev *Event) GetFields() []eval.Field {
 		"ptrace.tracee.is_exec",
 		"ptrace.tracee.is_kworker",
 		"ptrace.tracee.is_thread",
+		"ptrace.tracee.ns.mnt",
+		"ptrace.tracee.ns.pid",
+		"ptrace.tracee.ns.user",
+		"ptrace.tracee.ns.uts",
 		"ptrace.tracee.parent.args",
 		"ptrace.tracee.parent.args_flags",
 		"ptrace.tracee.parent.args_options",
@@ -20958,15 +25794,29 @@ func (ev *Event) GetFields() []eval.Field {
 		"ptrace.tracee.parent.auid",
 		"ptrace.tracee.parent.cap_effective",
 		"ptrace.tracee.parent.cap_permitted",
+		"ptrace.tracee.parent.cgroup.ancestors",
+		"ptrace.tracee.parent.cgroup.controllers",
+		"ptrace.tracee.parent.cgroup.cpu.usage",
 		"ptrace.tracee.parent.cgroup.file.inode",
 		"ptrace.tracee.parent.cgroup.file.mount_id",
 		"ptrace.tracee.parent.cgroup.id",
 		"ptrace.tracee.parent.cgroup.manager",
+		"ptrace.tracee.parent.cgroup.memory.current",
+		"ptrace.tracee.parent.cgroup.memory.high_exceeded",
+		"ptrace.tracee.parent.cgroup.pids.current",
+		"ptrace.tracee.parent.cloud_credentials.providers",
+		"ptrace.tracee.parent.cloud_credentials.resources",
+		"ptrace.tracee.parent.cloud_credentials.types",
+		"ptrace.tracee.parent.cmdline_obfuscation_score",
 		"ptrace.tracee.parent.comm",
 		"ptrace.tracee.parent.container.id",
 		"ptrace.tracee.parent.created_at",
 		"ptrace.tracee.parent.egid",
 		"ptrace.tracee.parent.egroup",
+		"ptrace.tracee.parent.elf.build_id",
+		"ptrace.tracee.parent.elf.interpreter",
+		"ptrace.tracee.parent.elf.is_static",
+		"ptrace.tracee.parent.elf.is_stripped",
 		"ptrace.tracee.parent.envp",
 		"ptrace.tracee.parent.envs",
 		"ptrace.tracee.parent.envs_truncated",
@@ -20979,6 +25829,8 @@ func (ev *Event) GetFields() []eval.Field {
 		"ptrace.tracee.parent.file.hashes",
 		"ptrace.tracee.parent.file.in_upper_layer",
 		"ptrace.tracee.parent.file.inode",
+		"ptrace.tracee.parent.file.layer.digest",
+		"ptrace.tracee.parent.file.layer.path",
 		"ptrace.tracee.parent.file.mode",
 		"ptrace.tracee.parent.file.modification_time",
 		"ptrace.tracee.parent.file.mount_id",
@@ -21005,6 +25857,8 @@ func (ev *Event) GetFields() []eval.Field {
 		"ptrace.tracee.parent.interpreter.file.hashes",
 		"ptrace.tracee.parent.interpreter.file.in_upper_layer",
 		"ptrace.tracee.parent.interpreter.file.inode",
+		"ptrace.tracee.parent.interpreter.file.layer.digest",
+		"ptrace.tracee.parent.interpreter.file.layer.path",
 		"ptrace.tracee.parent.interpreter.file.mode",
 		"ptrace.tracee.parent.interpreter.file.modification_time",
 		"ptrace.tracee.parent.interpreter.file.mount_id",
@@ -21021,8 +25875,14 @@ func (ev *Event) GetFields() []eval.Field {
 		"ptrace.tracee.parent.is_exec",
 		"ptrace.tracee.parent.is_kworker",
 		"ptrace.tracee.parent.is_thread",
+		"ptrace.tracee.parent.ns.mnt",
+		"ptrace.tracee.parent.ns.pid",
+		"ptrace.tracee.parent.ns.user",
+		"ptrace.tracee.parent.ns.uts",
 		"ptrace.tracee.parent.pid",
 		"ptrace.tracee.parent.ppid",
+		"ptrace.tracee.parent.systemd.slice",
+		"ptrace.tracee.parent.systemd.unit",
 		"ptrace.tracee.parent.tid",
 		"ptrace.tracee.parent.tty_name",
 		"ptrace.tracee.parent.uid",
@@ -21032,6 +25892,8 @@ func (ev *Event) GetFields() []eval.Field {
 		"ptrace.tracee.parent.user_session.k8s_username",
 		"ptrace.tracee.pid",
 		"ptrace.tracee.ppid",
+		"ptrace.tracee.systemd.slice",
+		"ptrace.tracee.systemd.unit",
 		"ptrace.tracee.tid",
 		"ptrace.tracee.tty_name",
 		"ptrace.tracee.uid",
@@ -21048,6 +25910,8 @@ func (ev *Event) GetFields() []eval.Field {
 		"removexattr.file.hashes",
 		"removexattr.file.in_upper_layer",
 		"removexattr.file.inode",
+		"removexattr.file.layer.digest",
+		"removexattr.file.layer.path",
 		"removexattr.file.mode",
 		"removexattr.file.modification_time",
 		"removexattr.file.mount_id",
@@ -21070,6 +25934,8 @@ func (ev *Event) GetFields() []eval.Field {
 		"rename.file.destination.hashes",
 		"rename.file.destination.in_upper_layer",
 		"rename.file.destination.inode",
+		"rename.file.destination.layer.digest",
+		"rename.file.destination.layer.path",
 		"rename.file.destination.mode",
 		"rename.file.destination.modification_time",
 		"rename.file.destination.mount_id",
@@ -21089,6 +25955,8 @@ func (ev *Event) GetFields() []eval.Field {
 		"rename.file.hashes",
 		"rename.file.in_upper_layer",
 		"rename.file.inode",
+		"rename.file.layer.digest",
+		"rename.file.layer.path",
 		"rename.file.mode",
 		"rename.file.modification_time",
 		"rename.file.mount_id",
@@ -21112,6 +25980,8 @@ func (ev *Event) GetFields() []eval.Field {
 		"rmdir.file.hashes",
 		"rmdir.file.in_upper_layer",
 		"rmdir.file.inode",
+		"rmdir.file.layer.digest",
+		"rmdir.file.layer.path",
 		"rmdir.file.mode",
 		"rmdir.file.modification_time",
 		"rmdir.file.mount_id",
@@ -21151,6 +26021,8 @@ func (ev *Event) GetFields() []eval.Field {
 		"setxattr.file.hashes",
 		"setxattr.file.in_upper_layer",
 		"setxattr.file.inode",
+		"setxattr.file.layer.digest",
+		"setxattr.file.layer.path",
 		"setxattr.file.mode",
 		"setxattr.file.modification_time",
 		"setxattr.file.mount_id",
@@ -21176,15 +26048,29 @@ func (ev *Event) GetFields() []eval.Field {
 		"signal.target.ancestors.auid",
 		"signal.target.ancestors.cap_effective",
 		"signal.target.ancestors.cap_permitted",
+		"signal.target.ancestors.cgroup.ancestors",
+		"signal.target.ancestors.cgroup.controllers",
+		"signal.target.ancestors.cgroup.cpu.usage",
 		"signal.target.ancestors.cgroup.file.inode",
 		"signal.target.ancestors.cgroup.file.mount_id",
 		"signal.target.ancestors.cgroup.id",
 		"signal.target.ancestors.cgroup.manager",
+		"signal.target.ancestors.cgroup.memory.current",
+		"signal.target.ancestors.cgroup.memory.high_exceeded",
+		"signal.target.ancestors.cgroup.pids.current",
+		"signal.target.ancestors.cloud_credentials.providers",
+		"signal.target.ancestors.cloud_credentials.resources",
+		"signal.target.ancestors.cloud_credentials.types",
+		"signal.target.ancestors.cmdline_obfuscation_score",
 		"signal.target.ancestors.comm",
 		"signal.target.ancestors.container.id",
 		"signal.target.ancestors.created_at",
 		"signal.target.ancestors.egid",
 		"signal.target.ancestors.egroup",
+		"signal.target.ancestors.elf.build_id",
+		"signal.target.ancestors.elf.interpreter",
+		"signal.target.ancestors.elf.is_static",
+		"signal.target.ancestors.elf.is_stripped",
 		"signal.target.ancestors.envp",
 		"signal.target.ancestors.envs",
 		"signal.target.ancestors.envs_truncated",
@@ -21197,6 +26083,8 @@ func (ev *Event) GetFields() []eval.Field {
 		"signal.target.ancestors.file.hashes",
 		"signal.target.ancestors.file.in_upper_layer",
 		"signal.target.ancestors.file.inode",
+		"signal.target.ancestors.file.layer.digest",
+		"signal.target.ancestors.file.layer.path",
 		"signal.target.ancestors.file.mode",
 		"signal.target.ancestors.file.modification_time",
 		"signal.target.ancestors.file.mount_id",
@@ -21223,6 +26111,8 @@ func (ev *Event) GetFields() []eval.Field {
 		"signal.target.ancestors.interpreter.file.hashes",
 		"signal.target.ancestors.interpreter.file.in_upper_layer",
 		"signal.target.ancestors.interpreter.file.inode",
+		"signal.target.ancestors.interpreter.file.layer.digest",
+		"signal.target.ancestors.interpreter.file.layer.path",
 		"signal.target.ancestors.interpreter.file.mode",
 		"signal.target.ancestors.interpreter.file.modification_time",
 		"signal.target.ancestors.interpreter.file.mount_id",
@@ -21240,8 +26130,14 @@ func (ev *Event) GetFields() []eval.Field {
 		"signal.target.ancestors.is_kworker",
 		"signal.target.ancestors.is_thread",
 		"signal.target.ancestors.length",
+		"signal.target.ancestors.ns.mnt",
+		"signal.target.ancestors.ns.pid",
+		"signal.target.ancestors.ns.user",
+		"signal.target.ancestors.ns.uts",
 		"signal.target.ancestors.pid",
 		"signal.target.ancestors.ppid",
+		"signal.target.ancestors.systemd.slice",
+		"signal.target.ancestors.systemd.unit",
 		"signal.target.ancestors.tid",
 		"signal.target.ancestors.tty_name",
 		"signal.target.ancestors.uid",
@@ -21258,15 +26154,29 @@ func (ev *Event) GetFields() []eval.Field {
 		"signal.target.auid",
 		"signal.target.cap_effective",
 		"signal.target.cap_permitted",
+		"signal.target.cgroup.ancestors",
+		"signal.target.cgroup.controllers",
+		"signal.target.cgroup.cpu.usage",
 		"signal.target.cgroup.file.inode",
 		"signal.target.cgroup.file.mount_id",
 		"signal.target.cgroup.id",
 		"signal.target.cgroup.manager",
+		"signal.target.cgroup.memory.current",
+		"signal.target.cgroup.memory.high_exceeded",
+		"signal.target.cgroup.pids.current",
+		"signal.target.cloud_credentials.providers",
+		"signal.target.cloud_credentials.resources",
+		"signal.target.cloud_credentials.types",
+		"signal.target.cmdline_obfuscation_score",
 		"signal.target.comm",
 		"signal.target.container.id",
 		"signal.target.created_at",
 		"signal.target.egid",
 		"signal.target.egroup",
+		"signal.target.elf.build_id",
+		"signal.target.elf.interpreter",
+		"signal.target.elf.is_static",
+		"signal.target.elf.is_stripped",
 		"signal.target.envp",
 		"signal.target.envs",
 		"signal.target.envs_truncated",
@@ -21279,6 +26189,8 @@ func (ev *Event) GetFields() []eval.Field {
 		"signal.target.file.hashes",
 		"signal.target.file.in_upper_layer",
 		"signal.target.file.inode",
+		"signal.target.file.layer.digest",
+		"signal.target.file.layer.path",
 		"signal.target.file.mode",
 		"signal.target.file.modification_time",
 		"signal.target.file.mount_id",
@@ -21305,6 +26217,8 @@ func (ev *Event) GetFields() []eval.Field {
 		"signal.target.interpreter.file.hashes",
 		"signal.target.interpreter.file.in_upper_layer",
 		"signal.target.interpreter.file.inode",
+		"signal.target.interpreter.file.layer.digest",
+		"signal.target.interpreter.file.layer.path",
 		"signal.target.interpreter.file.mode",
 		"signal.target.interpreter.file.modification_time",
 		"signal.target.interpreter.file.mount_id",
@@ -21321,6 +26235,10 @@ func (ev *Event) GetFields() []eval.Field {
 		"signal.target.is_exec",
 		"signal.target.is_kworker",
 		"signal.target.is_thread",
+		"signal.target.ns.mnt",
+		"signal.target.ns.pid",
+		"signal.target.ns.user",
+		"signal.target.ns.uts",
 		"signal.target.parent.args",
 		"signal.target.parent.args_flags",
 		"signal.target.parent.args_options",
@@ -21330,15 +26248,29 @@ func (ev *Event) GetFields() []eval.Field {
 		"signal.target.parent.auid",
 		"signal.target.parent.cap_effective",
 		"signal.target.parent.cap_permitted",
+		"signal.target.parent.cgroup.ancestors",
+		"signal.target.parent.cgroup.controllers",
+		"signal.target.parent.cgroup.cpu.usage",
 		"signal.target.parent.cgroup.file.inode",
 		"signal.target.parent.cgroup.file.mount_id",
 		"signal.target.parent.cgroup.id",
 		"signal.target.parent.cgroup.manager",
+		"signal.target.parent.cgroup.memory.current",
+		"signal.target.parent.cgroup.memory.high_exceeded",
+		"signal.target.parent.cgroup.pids.current",
+		"signal.target.parent.cloud_credentials.providers",
+		"signal.target.parent.cloud_credentials.resources",
+		"signal.target.parent.cloud_credentials.types",
+		"signal.target.parent.cmdline_obfuscation_score",
 		"signal.target.parent.comm",
 		"signal.target.parent.container.id",
 		"signal.target.parent.created_at",
 		"signal.target.parent.egid",
 		"signal.target.parent.egroup",
+		"signal.target.parent.elf.build_id",
+		"signal.target.parent.elf.interpreter",
+		"signal.target.parent.elf.is_static",
+		"signal.target.parent.elf.is_stripped",
 		"signal.target.parent.envp",
 		"signal.target.parent.envs",
 		"signal.target.parent.envs_truncated",
@@ -21351,6 +26283,8 @@ func (ev *Event) GetFields() []eval.Field {
 		"signal.target.parent.file.hashes",
 		"signal.target.parent.file.in_upper_layer",
 		"signal.target.parent.file.inode",
+		"signal.target.parent.file.layer.digest",
+		"signal.target.parent.file.layer.path",
 		"signal.target.parent.file.mode",
 		"signal.target.parent.file.modification_time",
 		"signal.target.parent.file.mount_id",
@@ -21377,6 +26311,8 @@ func (ev *Event) GetFields() []eval.Field {
 		"signal.target.parent.interpreter.file.hashes",
 		"signal.target.parent.interpreter.file.in_upper_layer",
 		"signal.target.parent.interpreter.file.inode",
+		"signal.target.parent.interpreter.file.layer.digest",
+		"signal.target.parent.interpreter.file.layer.path",
 		"signal.target.parent.interpreter.file.mode",
 		"signal.target.parent.interpreter.file.modification_time",
 		"signal.target.parent.interpreter.file.mount_id",
@@ -21393,8 +26329,14 @@ func (ev *Event) GetFields() []eval.Field {
 		"signal.target.parent.is_exec",
 		"signal.target.parent.is_kworker",
 		"signal.target.parent.is_thread",
+		"signal.target.parent.ns.mnt",
+		"signal.target.parent.ns.pid",
+		"signal.target.parent.ns.user",
+		"signal.target.parent.ns.uts",
 		"signal.target.parent.pid",
 		"signal.target.parent.ppid",
+		"signal.target.parent.systemd.slice",
+		"signal.target.parent.systemd.unit",
 		"signal.target.parent.tid",
 		"signal.target.parent.tty_name",
 		"signal.target.parent.uid",
@@ -21404,6 +26346,8 @@ func (ev *Event) GetFields() []eval.Field {
 		"signal.target.parent.user_session.k8s_username",
 		"signal.target.pid",
 		"signal.target.ppid",
+		"signal.target.systemd.slice",
+		"signal.target.systemd.unit",
 		"signal.target.tid",
 		"signal.target.tty_name",
 		"signal.target.uid",
@@ -21419,6 +26363,8 @@ func (ev *Event) GetFields() []eval.Field {
 		"splice.file.hashes",
 		"splice.file.in_upper_layer",
 		"splice.file.inode",
+		"splice.file.layer.digest",
+		"splice.file.layer.path",
 		"splice.file.mode",
 		"splice.file.modification_time",
 		"splice.file.mount_id",
@@ -21442,6 +26388,8 @@ func (ev *Event) GetFields() []eval.Field {
 		"unlink.file.hashes",
 		"unlink.file.in_upper_layer",
 		"unlink.file.inode",
+		"unlink.file.layer.digest",
+		"unlink.file.layer.path",
 		"unlink.file.mode",
 		"unlink.file.modification_time",
 		"unlink.file.mount_id",
@@ -21469,6 +26417,8 @@ func (ev *Event) GetFields() []eval.Field {
 		"utimes.file.hashes",
 		"utimes.file.in_upper_layer",
 		"utimes.file.inode",
+		"utimes.file.layer.digest",
+		"utimes.file.layer.path",
 		"utimes.file.mode",
 		"utimes.file.modification_time",
 		"utimes.file.mount_id",
@@ -21500,6 +26450,10 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		return int(ev.Bind.SyscallEvent.Retval), nil
 	case "bpf.cmd":
 		return int(ev.BPF.Cmd), nil
+	case "bpf.kernel_security.lockdown":
+		return ev.FieldHandlers.ResolveKernelLockdown(ev, &ev.BPF.KernelSecurity), nil
+	case "bpf.kernel_security.secure_boot":
+		return ev.FieldHandlers.ResolveKernelSecureBoot(ev, &ev.BPF.KernelSecurity), nil
 	case "bpf.map.name":
 		return ev.BPF.Map.Name, nil
 	case "bpf.map.type":
@@ -21524,6 +26478,12 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		return int(ev.Capset.CapEffective), nil
 	case "capset.cap_permitted":
 		return int(ev.Capset.CapPermitted), nil
+	case "cgroup.ancestors":
+		return ev.FieldHandlers.ResolveCGroupAncestors(ev, &ev.CGroupContext), nil
+	case "cgroup.controllers":
+		return ev.FieldHandlers.ResolveCGroupControllers(ev, &ev.CGroupContext), nil
+	case "cgroup.cpu.usage":
+		return int(ev.FieldHandlers.ResolveCGroupCPUUsage(ev, &ev.CGroupContext)), nil
 	case "cgroup.file.inode":
 		return int(ev.CGroupContext.CGroupFile.Inode), nil
 	case "cgroup.file.mount_id":
@@ -21532,6 +26492,12 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		return ev.FieldHandlers.ResolveCGroupID(ev, &ev.CGroupContext), nil
 	case "cgroup.manager":
 		return ev.FieldHandlers.ResolveCGroupManager(ev, &ev.CGroupContext), nil
+	case "cgroup.memory.current":
+		return int(ev.FieldHandlers.ResolveCGroupMemoryCurrent(ev, &ev.CGroupContext)), nil
+	case "cgroup.memory.high_exceeded":
+		return ev.FieldHandlers.ResolveCGroupMemoryHighExceeded(ev, &ev.CGroupContext), nil
+	case "cgroup.pids.current":
+		return int(ev.FieldHandlers.ResolveCGroupPidsCurrent(ev, &ev.CGroupContext)), nil
 	case "chdir.file.change_time":
 		return int(ev.Chdir.File.FileFields.CTime), nil
 	case "chdir.file.filesystem":
@@ -21546,6 +26512,10 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		return ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.Chdir.File.FileFields), nil
 	case "chdir.file.inode":
 		return int(ev.Chdir.File.FileFields.PathKey.Inode), nil
+	case "chdir.file.layer.digest":
+		return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Chdir.File), nil
+	case "chdir.file.layer.path":
+		return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Chdir.File), nil
 	case "chdir.file.mode":
 		return int(ev.Chdir.File.FileFields.Mode), nil
 	case "chdir.file.modification_time":
@@ -21594,6 +26564,10 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		return ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.Chmod.File.FileFields), nil
 	case "chmod.file.inode":
 		return int(ev.Chmod.File.FileFields.PathKey.Inode), nil
+	case "chmod.file.layer.digest":
+		return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Chmod.File), nil
+	case "chmod.file.layer.path":
+		return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Chmod.File), nil
 	case "chmod.file.mode":
 		return int(ev.Chmod.File.FileFields.Mode), nil
 	case "chmod.file.modification_time":
@@ -21614,6 +26588,10 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		return ev.FieldHandlers.ResolveFilePath(ev, &ev.Chmod.File), nil
 	case "chmod.file.path.length":
 		return ev.FieldHandlers.ResolveFilePath(ev, &ev.Chmod.File), nil
+	case "chmod.file.previous_mode":
+		return int(ev.Chmod.PreviousMode), nil
+	case "chmod.file.previous_rights":
+		return int(ev.Chmod.PreviousMode), nil
 	case "chmod.file.rights":
 		return int(ev.FieldHandlers.ResolveRights(ev, &ev.Chmod.File.FileFields)), nil
 	case "chmod.file.uid":
@@ -21648,6 +26626,10 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		return ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.Chown.File.FileFields), nil
 	case "chown.file.inode":
 		return int(ev.Chown.File.FileFields.PathKey.Inode), nil
+	case "chown.file.layer.digest":
+		return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Chown.File), nil
+	case "chown.file.layer.path":
+		return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Chown.File), nil
 	case "chown.file.mode":
 		return int(ev.Chown.File.FileFields.Mode), nil
 	case "chown.file.modification_time":
@@ -21668,6 +26650,10 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		return ev.FieldHandlers.ResolveFilePath(ev, &ev.Chown.File), nil
 	case "chown.file.path.length":
 		return ev.FieldHandlers.ResolveFilePath(ev, &ev.Chown.File), nil
+	case "chown.file.previous_gid":
+		return int(ev.Chown.PreviousGID), nil
+	case "chown.file.previous_uid":
+		return int(ev.Chown.PreviousUID), nil
 	case "chown.file.rights":
 		return int(ev.FieldHandlers.ResolveRights(ev, &ev.Chown.File.FileFields)), nil
 	case "chown.file.uid":
@@ -21714,6 +26700,10 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		return int(ev.DNS.Class), nil
 	case "dns.question.count":
 		return int(ev.DNS.Count), nil
+	case "dns.question.domain_query_rate":
+		return ev.DNS.DomainQueryRate, nil
+	case "dns.question.label_entropy":
+		return ev.DNS.LabelEntropy, nil
 	case "dns.question.length":
 		return int(ev.DNS.Size), nil
 	case "dns.question.name":
@@ -21722,6 +26712,8 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		return len(ev.DNS.Name), nil
 	case "dns.question.type":
 		return int(ev.DNS.Type), nil
+	case "dns.question.unique_subdomains":
+		return ev.DNS.UniqueSubdomains, nil
 	case "event.async":
 		return ev.FieldHandlers.ResolveAsync(ev), nil
 	case "event.hostname":
@@ -21752,6 +26744,12 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		return int(ev.Exec.Process.Credentials.CapEffective), nil
 	case "exec.cap_permitted":
 		return int(ev.Exec.Process.Credentials.CapPermitted), nil
+	case "exec.cgroup.ancestors":
+		return ev.FieldHandlers.ResolveCGroupAncestors(ev, &ev.Exec.Process.CGroup), nil
+	case "exec.cgroup.controllers":
+		return ev.FieldHandlers.ResolveCGroupControllers(ev, &ev.Exec.Process.CGroup), nil
+	case "exec.cgroup.cpu.usage":
+		return int(ev.FieldHandlers.ResolveCGroupCPUUsage(ev, &ev.Exec.Process.CGroup)), nil
 	case "exec.cgroup.file.inode":
 		return int(ev.Exec.Process.CGroup.CGroupFile.Inode), nil
 	case "exec.cgroup.file.mount_id":
@@ -21760,6 +26758,20 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		return ev.FieldHandlers.ResolveCGroupID(ev, &ev.Exec.Process.CGroup), nil
 	case "exec.cgroup.manager":
 		return ev.FieldHandlers.ResolveCGroupManager(ev, &ev.Exec.Process.CGroup), nil
+	case "exec.cgroup.memory.current":
+		return int(ev.FieldHandlers.ResolveCGroupMemoryCurrent(ev, &ev.Exec.Process.CGroup)), nil
+	case "exec.cgroup.memory.high_exceeded":
+		return ev.FieldHandlers.ResolveCGroupMemoryHighExceeded(ev, &ev.Exec.Process.CGroup), nil
+	case "exec.cgroup.pids.current":
+		return int(ev.FieldHandlers.ResolveCGroupPidsCurrent(ev, &ev.Exec.Process.CGroup)), nil
+	case "exec.cloud_credentials.providers":
+		return ev.FieldHandlers.ResolveProcessCloudCredentialsProviders(ev, ev.Exec.Process), nil
+	case "exec.cloud_credentials.resources":
+		return ev.FieldHandlers.ResolveProcessCloudCredentialsResources(ev, ev.Exec.Process), nil
+	case "exec.cloud_credentials.types":
+		return ev.FieldHandlers.ResolveProcessCloudCredentialsTypes(ev, ev.Exec.Process), nil
+	case "exec.cmdline_obfuscation_score":
+		return ev.FieldHandlers.ResolveProcessCmdLineObfuscationScore(ev, ev.Exec.Process), nil
 	case "exec.comm":
 		return ev.Exec.Process.Comm, nil
 	case "exec.container.id":
@@ -21770,6 +26782,14 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		return int(ev.Exec.Process.Credentials.EGID), nil
 	case "exec.egroup":
 		return ev.Exec.Process.Credentials.EGroup, nil
+	case "exec.elf.build_id":
+		return ev.FieldHandlers.ResolveProcessELFBuildID(ev, ev.Exec.Process), nil
+	case "exec.elf.interpreter":
+		return ev.FieldHandlers.ResolveProcessELFInterpreter(ev, ev.Exec.Process), nil
+	case "exec.elf.is_static":
+		return ev.FieldHandlers.ResolveProcessELFIsStatic(ev, ev.Exec.Process), nil
+	case "exec.elf.is_stripped":
+		return ev.FieldHandlers.ResolveProcessELFIsStripped(ev, ev.Exec.Process), nil
 	case "exec.envp":
 		return ev.FieldHandlers.ResolveProcessEnvp(ev, ev.Exec.Process), nil
 	case "exec.envs":
@@ -21815,6 +26835,16 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 			return 0, &eval.ErrNotSupported{Field: field}
 		}
 		return int(ev.Exec.Process.FileEvent.FileFields.PathKey.Inode), nil
+	case "exec.file.layer.digest":
+		if !ev.Exec.Process.IsNotKworker() {
+			return "", &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Exec.Process.FileEvent), nil
+	case "exec.file.layer.path":
+		if !ev.Exec.Process.IsNotKworker() {
+			return "", &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Exec.Process.FileEvent), nil
 	case "exec.file.mode":
 		if !ev.Exec.Process.IsNotKworker() {
 			return 0, &eval.ErrNotSupported{Field: field}
@@ -21921,6 +26951,16 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 			return 0, &eval.ErrNotSupported{Field: field}
 		}
 		return int(ev.Exec.Process.LinuxBinprm.FileEvent.FileFields.PathKey.Inode), nil
+	case "exec.interpreter.file.layer.digest":
+		if !ev.Exec.Process.HasInterpreter() {
+			return "", &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Exec.Process.LinuxBinprm.FileEvent), nil
+	case "exec.interpreter.file.layer.path":
+		if !ev.Exec.Process.HasInterpreter() {
+			return "", &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Exec.Process.LinuxBinprm.FileEvent), nil
 	case "exec.interpreter.file.mode":
 		if !ev.Exec.Process.HasInterpreter() {
 			return 0, &eval.ErrNotSupported{Field: field}
@@ -21986,12 +27026,24 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		return ev.Exec.Process.PIDContext.IsKworker, nil
 	case "exec.is_thread":
 		return ev.FieldHandlers.ResolveProcessIsThread(ev, ev.Exec.Process), nil
+	case "exec.ns.mnt":
+		return int(ev.FieldHandlers.ResolveProcessMountNamespace(ev, ev.Exec.Process)), nil
+	case "exec.ns.pid":
+		return int(ev.FieldHandlers.ResolveProcessPIDNamespace(ev, ev.Exec.Process)), nil
+	case "exec.ns.user":
+		return int(ev.FieldHandlers.ResolveProcessUserNamespace(ev, ev.Exec.Process)), nil
+	case "exec.ns.uts":
+		return int(ev.FieldHandlers.ResolveProcessUTSNamespace(ev, ev.Exec.Process)), nil
 	case "exec.pid":
 		return int(ev.Exec.Process.PIDContext.Pid), nil
 	case "exec.ppid":
 		return int(ev.Exec.Process.PPid), nil
 	case "exec.syscall.path":
 		return ev.FieldHandlers.ResolveSyscallCtxArgsStr1(ev, &ev.Exec.SyscallContext), nil
+	case "exec.systemd.slice":
+		return ev.FieldHandlers.ResolveProcessSystemdSlice(ev, ev.Exec.Process), nil
+	case "exec.systemd.unit":
+		return ev.FieldHandlers.ResolveProcessSystemdUnit(ev, ev.Exec.Process), nil
 	case "exec.tid":
 		return int(ev.Exec.Process.PIDContext.Tid), nil
 	case "exec.tty_name":
@@ -22026,6 +27078,12 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		return int(ev.Exit.Process.Credentials.CapPermitted), nil
 	case "exit.cause":
 		return int(ev.Exit.Cause), nil
+	case "exit.cgroup.ancestors":
+		return ev.FieldHandlers.ResolveCGroupAncestors(ev, &ev.Exit.Process.CGroup), nil
+	case "exit.cgroup.controllers":
+		return ev.FieldHandlers.ResolveCGroupControllers(ev, &ev.Exit.Process.CGroup), nil
+	case "exit.cgroup.cpu.usage":
+		return int(ev.FieldHandlers.ResolveCGroupCPUUsage(ev, &ev.Exit.Process.CGroup)), nil
 	case "exit.cgroup.file.inode":
 		return int(ev.Exit.Process.CGroup.CGroupFile.Inode), nil
 	case "exit.cgroup.file.mount_id":
@@ -22034,6 +27092,20 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		return ev.FieldHandlers.ResolveCGroupID(ev, &ev.Exit.Process.CGroup), nil
 	case "exit.cgroup.manager":
 		return ev.FieldHandlers.ResolveCGroupManager(ev, &ev.Exit.Process.CGroup), nil
+	case "exit.cgroup.memory.current":
+		return int(ev.FieldHandlers.ResolveCGroupMemoryCurrent(ev, &ev.Exit.Process.CGroup)), nil
+	case "exit.cgroup.memory.high_exceeded":
+		return ev.FieldHandlers.ResolveCGroupMemoryHighExceeded(ev, &ev.Exit.Process.CGroup), nil
+	case "exit.cgroup.pids.current":
+		return int(ev.FieldHandlers.ResolveCGroupPidsCurrent(ev, &ev.Exit.Process.CGroup)), nil
+	case "exit.cloud_credentials.providers":
+		return ev.FieldHandlers.ResolveProcessCloudCredentialsProviders(ev, ev.Exit.Process), nil
+	case "exit.cloud_credentials.resources":
+		return ev.FieldHandlers.ResolveProcessCloudCredentialsResources(ev, ev.Exit.Process), nil
+	case "exit.cloud_credentials.types":
+		return ev.FieldHandlers.ResolveProcessCloudCredentialsTypes(ev, ev.Exit.Process), nil
+	case "exit.cmdline_obfuscation_score":
+		return ev.FieldHandlers.ResolveProcessCmdLineObfuscationScore(ev, ev.Exit.Process), nil
 	case "exit.code":
 		return int(ev.Exit.Code), nil
 	case "exit.comm":
@@ -22046,6 +27118,14 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		return int(ev.Exit.Process.Credentials.EGID), nil
 	case "exit.egroup":
 		return ev.Exit.Process.Credentials.EGroup, nil
+	case "exit.elf.build_id":
+		return ev.FieldHandlers.ResolveProcessELFBuildID(ev, ev.Exit.Process), nil
+	case "exit.elf.interpreter":
+		return ev.FieldHandlers.ResolveProcessELFInterpreter(ev, ev.Exit.Process), nil
+	case "exit.elf.is_static":
+		return ev.FieldHandlers.ResolveProcessELFIsStatic(ev, ev.Exit.Process), nil
+	case "exit.elf.is_stripped":
+		return ev.FieldHandlers.ResolveProcessELFIsStripped(ev, ev.Exit.Process), nil
 	case "exit.envp":
 		return ev.FieldHandlers.ResolveProcessEnvp(ev, ev.Exit.Process), nil
 	case "exit.envs":
@@ -22091,6 +27171,16 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 			return 0, &eval.ErrNotSupported{Field: field}
 		}
 		return int(ev.Exit.Process.FileEvent.FileFields.PathKey.Inode), nil
+	case "exit.file.layer.digest":
+		if !ev.Exit.Process.IsNotKworker() {
+			return "", &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Exit.Process.FileEvent), nil
+	case "exit.file.layer.path":
+		if !ev.Exit.Process.IsNotKworker() {
+			return "", &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Exit.Process.FileEvent), nil
 	case "exit.file.mode":
 		if !ev.Exit.Process.IsNotKworker() {
 			return 0, &eval.ErrNotSupported{Field: field}
@@ -22197,6 +27287,16 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 			return 0, &eval.ErrNotSupported{Field: field}
 		}
 		return int(ev.Exit.Process.LinuxBinprm.FileEvent.FileFields.PathKey.Inode), nil
+	case "exit.interpreter.file.layer.digest":
+		if !ev.Exit.Process.HasInterpreter() {
+			return "", &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Exit.Process.LinuxBinprm.FileEvent), nil
+	case "exit.interpreter.file.layer.path":
+		if !ev.Exit.Process.HasInterpreter() {
+			return "", &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Exit.Process.LinuxBinprm.FileEvent), nil
 	case "exit.interpreter.file.mode":
 		if !ev.Exit.Process.HasInterpreter() {
 			return 0, &eval.ErrNotSupported{Field: field}
@@ -22262,10 +27362,22 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		return ev.Exit.Process.PIDContext.IsKworker, nil
 	case "exit.is_thread":
 		return ev.FieldHandlers.ResolveProcessIsThread(ev, ev.Exit.Process), nil
+	case "exit.ns.mnt":
+		return int(ev.FieldHandlers.ResolveProcessMountNamespace(ev, ev.Exit.Process)), nil
+	case "exit.ns.pid":
+		return int(ev.FieldHandlers.ResolveProcessPIDNamespace(ev, ev.Exit.Process)), nil
+	case "exit.ns.user":
+		return int(ev.FieldHandlers.ResolveProcessUserNamespace(ev, ev.Exit.Process)), nil
+	case "exit.ns.uts":
+		return int(ev.FieldHandlers.ResolveProcessUTSNamespace(ev, ev.Exit.Process)), nil
 	case "exit.pid":
 		return int(ev.Exit.Process.PIDContext.Pid), nil
 	case "exit.ppid":
 		return int(ev.Exit.Process.PPid), nil
+	case "exit.systemd.slice":
+		return ev.FieldHandlers.ResolveProcessSystemdSlice(ev, ev.Exit.Process), nil
+	case "exit.systemd.unit":
+		return ev.FieldHandlers.ResolveProcessSystemdUnit(ev, ev.Exit.Process), nil
 	case "exit.tid":
 		return int(ev.Exit.Process.PIDContext.Tid), nil
 	case "exit.tty_name":
@@ -22280,6 +27392,12 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		return ev.FieldHandlers.ResolveK8SUID(ev, &ev.Exit.Process.UserSession), nil
 	case "exit.user_session.k8s_username":
 		return ev.FieldHandlers.ResolveK8SUsername(ev, &ev.Exit.Process.UserSession), nil
+	case "imds.access_token.expires_in":
+		return ev.IMDS.AccessToken.ExpiresIn, nil
+	case "imds.access_token.resource":
+		return ev.IMDS.AccessToken.Resource, nil
+	case "imds.access_token.type":
+		return ev.IMDS.AccessToken.Type, nil
 	case "imds.aws.is_imds_v2":
 		return ev.IMDS.AWS.IsIMDSv2, nil
 	case "imds.aws.security_credentials.type":
@@ -22312,6 +27430,10 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		return ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.Link.Target.FileFields), nil
 	case "link.file.destination.inode":
 		return int(ev.Link.Target.FileFields.PathKey.Inode), nil
+	case "link.file.destination.layer.digest":
+		return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Link.Target), nil
+	case "link.file.destination.layer.path":
+		return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Link.Target), nil
 	case "link.file.destination.mode":
 		return int(ev.Link.Target.FileFields.Mode), nil
 	case "link.file.destination.modification_time":
@@ -22350,6 +27472,10 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		return ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.Link.Source.FileFields), nil
 	case "link.file.inode":
 		return int(ev.Link.Source.FileFields.PathKey.Inode), nil
+	case "link.file.layer.digest":
+		return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Link.Source), nil
+	case "link.file.layer.path":
+		return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Link.Source), nil
 	case "link.file.mode":
 		return int(ev.Link.Source.FileFields.Mode), nil
 	case "link.file.modification_time":
@@ -22402,6 +27528,10 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		return ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.LoadModule.File.FileFields), nil
 	case "load_module.file.inode":
 		return int(ev.LoadModule.File.FileFields.PathKey.Inode), nil
+	case "load_module.file.layer.digest":
+		return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.LoadModule.File), nil
+	case "load_module.file.layer.path":
+		return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.LoadModule.File), nil
 	case "load_module.file.mode":
 		return int(ev.LoadModule.File.FileFields.Mode), nil
 	case "load_module.file.modification_time":
@@ -22428,6 +27558,10 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		return int(ev.LoadModule.File.FileFields.UID), nil
 	case "load_module.file.user":
 		return ev.FieldHandlers.ResolveFileFieldsUser(ev, &ev.LoadModule.File.FileFields), nil
+	case "load_module.kernel_security.lockdown":
+		return ev.FieldHandlers.ResolveKernelLockdown(ev, &ev.LoadModule.KernelSecurity), nil
+	case "load_module.kernel_security.secure_boot":
+		return ev.FieldHandlers.ResolveKernelSecureBoot(ev, &ev.LoadModule.KernelSecurity), nil
 	case "load_module.loaded_from_memory":
 		return ev.LoadModule.LoadedFromMemory, nil
 	case "load_module.name":
@@ -22452,6 +27586,10 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		return ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.Mkdir.File.FileFields), nil
 	case "mkdir.file.inode":
 		return int(ev.Mkdir.File.FileFields.PathKey.Inode), nil
+	case "mkdir.file.layer.digest":
+		return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Mkdir.File), nil
+	case "mkdir.file.layer.path":
+		return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Mkdir.File), nil
 	case "mkdir.file.mode":
 		return int(ev.Mkdir.File.FileFields.Mode), nil
 	case "mkdir.file.modification_time":
@@ -22494,6 +27632,10 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		return ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.MMap.File.FileFields), nil
 	case "mmap.file.inode":
 		return int(ev.MMap.File.FileFields.PathKey.Inode), nil
+	case "mmap.file.layer.digest":
+		return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.MMap.File), nil
+	case "mmap.file.layer.path":
+		return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.MMap.File), nil
 	case "mmap.file.mode":
 		return int(ev.MMap.File.FileFields.Mode), nil
 	case "mmap.file.modification_time":
@@ -22522,6 +27664,8 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		return ev.FieldHandlers.ResolveFileFieldsUser(ev, &ev.MMap.File.FileFields), nil
 	case "mmap.flags":
 		return int(ev.MMap.Flags), nil
+	case "mmap.length":
+		return int(ev.MMap.Len), nil
 	case "mmap.protection":
 		return int(ev.MMap.Protection), nil
 	case "mmap.retval":
@@ -22542,10 +27686,14 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		return ev.FieldHandlers.ResolveSyscallCtxArgsStr2(ev, &ev.Mount.SyscallContext), nil
 	case "mount.syscall.source.path":
 		return ev.FieldHandlers.ResolveSyscallCtxArgsStr1(ev, &ev.Mount.SyscallContext), nil
+	case "mprotect.is_anonymous":
+		return ev.MProtect.IsAnonymous, nil
 	case "mprotect.req_protection":
 		return ev.MProtect.ReqProtection, nil
 	case "mprotect.retval":
 		return int(ev.MProtect.SyscallEvent.Retval), nil
+	case "mprotect.size":
+		return int(ev.MProtect.Size), nil
 	case "mprotect.vm_protection":
 		return ev.MProtect.VMProtection, nil
 	case "network.destination.ip":
@@ -22602,6 +27750,10 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		return ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.Open.File.FileFields), nil
 	case "open.file.inode":
 		return int(ev.Open.File.FileFields.PathKey.Inode), nil
+	case "open.file.layer.digest":
+		return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Open.File), nil
+	case "open.file.layer.path":
+		return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Open.File), nil
 	case "open.file.mode":
 		return int(ev.Open.File.FileFields.Mode), nil
 	case "open.file.modification_time":
@@ -22770,6 +27922,42 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 			ptr = iterator.Next()
 		}
 		return values, nil
+	case "process.ancestors.cgroup.ancestors":
+		var values []string
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := ev.FieldHandlers.ResolveCGroupAncestors(ev, &element.ProcessContext.Process.CGroup)
+			values = append(values, result...)
+			ptr = iterator.Next()
+		}
+		return values, nil
+	case "process.ancestors.cgroup.controllers":
+		var values []string
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := ev.FieldHandlers.ResolveCGroupControllers(ev, &element.ProcessContext.Process.CGroup)
+			values = append(values, result...)
+			ptr = iterator.Next()
+		}
+		return values, nil
+	case "process.ancestors.cgroup.cpu.usage":
+		var values []int
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := int(ev.FieldHandlers.ResolveCGroupCPUUsage(ev, &element.ProcessContext.Process.CGroup))
+			values = append(values, result)
+			ptr = iterator.Next()
+		}
+		return values, nil
 	case "process.ancestors.cgroup.file.inode":
 		var values []int
 		ctx := eval.NewContext(ev)
@@ -22818,6 +28006,90 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 			ptr = iterator.Next()
 		}
 		return values, nil
+	case "process.ancestors.cgroup.memory.current":
+		var values []int
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := int(ev.FieldHandlers.ResolveCGroupMemoryCurrent(ev, &element.ProcessContext.Process.CGroup))
+			values = append(values, result)
+			ptr = iterator.Next()
+		}
+		return values, nil
+	case "process.ancestors.cgroup.memory.high_exceeded":
+		var values []bool
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := ev.FieldHandlers.ResolveCGroupMemoryHighExceeded(ev, &element.ProcessContext.Process.CGroup)
+			values = append(values, result)
+			ptr = iterator.Next()
+		}
+		return values, nil
+	case "process.ancestors.cgroup.pids.current":
+		var values []int
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := int(ev.FieldHandlers.ResolveCGroupPidsCurrent(ev, &element.ProcessContext.Process.CGroup))
+			values = append(values, result)
+			ptr = iterator.Next()
+		}
+		return values, nil
+	case "process.ancestors.cloud_credentials.providers":
+		var values []string
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := ev.FieldHandlers.ResolveProcessCloudCredentialsProviders(ev, &element.ProcessContext.Process)
+			values = append(values, result...)
+			ptr = iterator.Next()
+		}
+		return values, nil
+	case "process.ancestors.cloud_credentials.resources":
+		var values []string
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := ev.FieldHandlers.ResolveProcessCloudCredentialsResources(ev, &element.ProcessContext.Process)
+			values = append(values, result...)
+			ptr = iterator.Next()
+		}
+		return values, nil
+	case "process.ancestors.cloud_credentials.types":
+		var values []string
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := ev.FieldHandlers.ResolveProcessCloudCredentialsTypes(ev, &element.ProcessContext.Process)
+			values = append(values, result...)
+			ptr = iterator.Next()
+		}
+		return values, nil
+	case "process.ancestors.cmdline_obfuscation_score":
+		var values []int
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := ev.FieldHandlers.ResolveProcessCmdLineObfuscationScore(ev, &element.ProcessContext.Process)
+			values = append(values, result)
+			ptr = iterator.Next()
+		}
+		return values, nil
 	case "process.ancestors.comm":
 		var values []string
 		ctx := eval.NewContext(ev)
@@ -22878,6 +28150,54 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 			ptr = iterator.Next()
 		}
 		return values, nil
+	case "process.ancestors.elf.build_id":
+		var values []string
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := ev.FieldHandlers.ResolveProcessELFBuildID(ev, &element.ProcessContext.Process)
+			values = append(values, result)
+			ptr = iterator.Next()
+		}
+		return values, nil
+	case "process.ancestors.elf.interpreter":
+		var values []string
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := ev.FieldHandlers.ResolveProcessELFInterpreter(ev, &element.ProcessContext.Process)
+			values = append(values, result)
+			ptr = iterator.Next()
+		}
+		return values, nil
+	case "process.ancestors.elf.is_static":
+		var values []bool
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := ev.FieldHandlers.ResolveProcessELFIsStatic(ev, &element.ProcessContext.Process)
+			values = append(values, result)
+			ptr = iterator.Next()
+		}
+		return values, nil
+	case "process.ancestors.elf.is_stripped":
+		var values []bool
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := ev.FieldHandlers.ResolveProcessELFIsStripped(ev, &element.ProcessContext.Process)
+			values = append(values, result)
+			ptr = iterator.Next()
+		}
+		return values, nil
 	case "process.ancestors.envp":
 		var values []string
 		ctx := eval.NewContext(ev)
@@ -23022,6 +28342,30 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 			ptr = iterator.Next()
 		}
 		return values, nil
+	case "process.ancestors.file.layer.digest":
+		var values []string
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := ev.FieldHandlers.ResolveFileLayerDigest(ev, &element.ProcessContext.Process.FileEvent)
+			values = append(values, result)
+			ptr = iterator.Next()
+		}
+		return values, nil
+	case "process.ancestors.file.layer.path":
+		var values []string
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := ev.FieldHandlers.ResolveFileLayerPath(ev, &element.ProcessContext.Process.FileEvent)
+			values = append(values, result)
+			ptr = iterator.Next()
+		}
+		return values, nil
 	case "process.ancestors.file.mode":
 		var values []int
 		ctx := eval.NewContext(ev)
@@ -23314,6 +28658,30 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 			ptr = iterator.Next()
 		}
 		return values, nil
+	case "process.ancestors.interpreter.file.layer.digest":
+		var values []string
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := ev.FieldHandlers.ResolveFileLayerDigest(ev, &element.ProcessContext.Process.LinuxBinprm.FileEvent)
+			values = append(values, result)
+			ptr = iterator.Next()
+		}
+		return values, nil
+	case "process.ancestors.interpreter.file.layer.path":
+		var values []string
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := ev.FieldHandlers.ResolveFileLayerPath(ev, &element.ProcessContext.Process.LinuxBinprm.FileEvent)
+			values = append(values, result)
+			ptr = iterator.Next()
+		}
+		return values, nil
 	case "process.ancestors.interpreter.file.mode":
 		var values []int
 		ctx := eval.NewContext(ev)
@@ -23490,6 +28858,54 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		ctx := eval.NewContext(ev)
 		iterator := &ProcessAncestorsIterator{}
 		return iterator.Len(ctx), nil
+	case "process.ancestors.ns.mnt":
+		var values []int
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := int(ev.FieldHandlers.ResolveProcessMountNamespace(ev, &element.ProcessContext.Process))
+			values = append(values, result)
+			ptr = iterator.Next()
+		}
+		return values, nil
+	case "process.ancestors.ns.pid":
+		var values []int
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := int(ev.FieldHandlers.ResolveProcessPIDNamespace(ev, &element.ProcessContext.Process))
+			values = append(values, result)
+			ptr = iterator.Next()
+		}
+		return values, nil
+	case "process.ancestors.ns.user":
+		var values []int
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := int(ev.FieldHandlers.ResolveProcessUserNamespace(ev, &element.ProcessContext.Process))
+			values = append(values, result)
+			ptr = iterator.Next()
+		}
+		return values, nil
+	case "process.ancestors.ns.uts":
+		var values []int
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := int(ev.FieldHandlers.ResolveProcessUTSNamespace(ev, &element.ProcessContext.Process))
+			values = append(values, result)
+			ptr = iterator.Next()
+		}
+		return values, nil
 	case "process.ancestors.pid":
 		var values []int
 		ctx := eval.NewContext(ev)
@@ -23514,6 +28930,30 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 			ptr = iterator.Next()
 		}
 		return values, nil
+	case "process.ancestors.systemd.slice":
+		var values []string
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := ev.FieldHandlers.ResolveProcessSystemdSlice(ev, &element.ProcessContext.Process)
+			values = append(values, result)
+			ptr = iterator.Next()
+		}
+		return values, nil
+	case "process.ancestors.systemd.unit":
+		var values []string
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := ev.FieldHandlers.ResolveProcessSystemdUnit(ev, &element.ProcessContext.Process)
+			values = append(values, result)
+			ptr = iterator.Next()
+		}
+		return values, nil
 	case "process.ancestors.tid":
 		var values []int
 		ctx := eval.NewContext(ev)
@@ -23616,6 +29056,12 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		return int(ev.BaseEvent.ProcessContext.Process.Credentials.CapEffective), nil
 	case "process.cap_permitted":
 		return int(ev.BaseEvent.ProcessContext.Process.Credentials.CapPermitted), nil
+	case "process.cgroup.ancestors":
+		return ev.FieldHandlers.ResolveCGroupAncestors(ev, &ev.BaseEvent.ProcessContext.Process.CGroup), nil
+	case "process.cgroup.controllers":
+		return ev.FieldHandlers.ResolveCGroupControllers(ev, &ev.BaseEvent.ProcessContext.Process.CGroup), nil
+	case "process.cgroup.cpu.usage":
+		return int(ev.FieldHandlers.ResolveCGroupCPUUsage(ev, &ev.BaseEvent.ProcessContext.Process.CGroup)), nil
 	case "process.cgroup.file.inode":
 		return int(ev.BaseEvent.ProcessContext.Process.CGroup.CGroupFile.Inode), nil
 	case "process.cgroup.file.mount_id":
@@ -23624,6 +29070,20 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		return ev.FieldHandlers.ResolveCGroupID(ev, &ev.BaseEvent.ProcessContext.Process.CGroup), nil
 	case "process.cgroup.manager":
 		return ev.FieldHandlers.ResolveCGroupManager(ev, &ev.BaseEvent.ProcessContext.Process.CGroup), nil
+	case "process.cgroup.memory.current":
+		return int(ev.FieldHandlers.ResolveCGroupMemoryCurrent(ev, &ev.BaseEvent.ProcessContext.Process.CGroup)), nil
+	case "process.cgroup.memory.high_exceeded":
+		return ev.FieldHandlers.ResolveCGroupMemoryHighExceeded(ev, &ev.BaseEvent.ProcessContext.Process.CGroup), nil
+	case "process.cgroup.pids.current":
+		return int(ev.FieldHandlers.ResolveCGroupPidsCurrent(ev, &ev.BaseEvent.ProcessContext.Process.CGroup)), nil
+	case "process.cloud_credentials.providers":
+		return ev.FieldHandlers.ResolveProcessCloudCredentialsProviders(ev, &ev.BaseEvent.ProcessContext.Process), nil
+	case "process.cloud_credentials.resources":
+		return ev.FieldHandlers.ResolveProcessCloudCredentialsResources(ev, &ev.BaseEvent.ProcessContext.Process), nil
+	case "process.cloud_credentials.types":
+		return ev.FieldHandlers.ResolveProcessCloudCredentialsTypes(ev, &ev.BaseEvent.ProcessContext.Process), nil
+	case "process.cmdline_obfuscation_score":
+		return ev.FieldHandlers.ResolveProcessCmdLineObfuscationScore(ev, &ev.BaseEvent.ProcessContext.Process), nil
 	case "process.comm":
 		return ev.BaseEvent.ProcessContext.Process.Comm, nil
 	case "process.container.id":
@@ -23634,6 +29094,14 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		return int(ev.BaseEvent.ProcessContext.Process.Credentials.EGID), nil
 	case "process.egroup":
 		return ev.BaseEvent.ProcessContext.Process.Credentials.EGroup, nil
+	case "process.elf.build_id":
+		return ev.FieldHandlers.ResolveProcessELFBuildID(ev, &ev.BaseEvent.ProcessContext.Process), nil
+	case "process.elf.interpreter":
+		return ev.FieldHandlers.ResolveProcessELFInterpreter(ev, &ev.BaseEvent.ProcessContext.Process), nil
+	case "process.elf.is_static":
+		return ev.FieldHandlers.ResolveProcessELFIsStatic(ev, &ev.BaseEvent.ProcessContext.Process), nil
+	case "process.elf.is_stripped":
+		return ev.FieldHandlers.ResolveProcessELFIsStripped(ev, &ev.BaseEvent.ProcessContext.Process), nil
 	case "process.envp":
 		return ev.FieldHandlers.ResolveProcessEnvp(ev, &ev.BaseEvent.ProcessContext.Process), nil
 	case "process.envs":
@@ -23679,6 +29147,16 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 			return 0, &eval.ErrNotSupported{Field: field}
 		}
 		return int(ev.BaseEvent.ProcessContext.Process.FileEvent.FileFields.PathKey.Inode), nil
+	case "process.file.layer.digest":
+		if !ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
+			return "", &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.BaseEvent.ProcessContext.Process.FileEvent), nil
+	case "process.file.layer.path":
+		if !ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
+			return "", &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.BaseEvent.ProcessContext.Process.FileEvent), nil
 	case "process.file.mode":
 		if !ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
 			return 0, &eval.ErrNotSupported{Field: field}
@@ -23785,6 +29263,16 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 			return 0, &eval.ErrNotSupported{Field: field}
 		}
 		return int(ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent.FileFields.PathKey.Inode), nil
+	case "process.interpreter.file.layer.digest":
+		if !ev.BaseEvent.ProcessContext.Process.HasInterpreter() {
+			return "", &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent), nil
+	case "process.interpreter.file.layer.path":
+		if !ev.BaseEvent.ProcessContext.Process.HasInterpreter() {
+			return "", &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent), nil
 	case "process.interpreter.file.mode":
 		if !ev.BaseEvent.ProcessContext.Process.HasInterpreter() {
 			return 0, &eval.ErrNotSupported{Field: field}
@@ -23850,6 +29338,14 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		return ev.BaseEvent.ProcessContext.Process.PIDContext.IsKworker, nil
 	case "process.is_thread":
 		return ev.FieldHandlers.ResolveProcessIsThread(ev, &ev.BaseEvent.ProcessContext.Process), nil
+	case "process.ns.mnt":
+		return int(ev.FieldHandlers.ResolveProcessMountNamespace(ev, &ev.BaseEvent.ProcessContext.Process)), nil
+	case "process.ns.pid":
+		return int(ev.FieldHandlers.ResolveProcessPIDNamespace(ev, &ev.BaseEvent.ProcessContext.Process)), nil
+	case "process.ns.user":
+		return int(ev.FieldHandlers.ResolveProcessUserNamespace(ev, &ev.BaseEvent.ProcessContext.Process)), nil
+	case "process.ns.uts":
+		return int(ev.FieldHandlers.ResolveProcessUTSNamespace(ev, &ev.BaseEvent.ProcessContext.Process)), nil
 	case "process.parent.args":
 		if !ev.BaseEvent.ProcessContext.HasParent() {
 			return "", &eval.ErrNotSupported{Field: field}
@@ -23895,6 +29391,21 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 			return 0, &eval.ErrNotSupported{Field: field}
 		}
 		return int(ev.BaseEvent.ProcessContext.Parent.Credentials.CapPermitted), nil
+	case "process.parent.cgroup.ancestors":
+		if !ev.BaseEvent.ProcessContext.HasParent() {
+			return []string{}, &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveCGroupAncestors(ev, &ev.BaseEvent.ProcessContext.Parent.CGroup), nil
+	case "process.parent.cgroup.controllers":
+		if !ev.BaseEvent.ProcessContext.HasParent() {
+			return []string{}, &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveCGroupControllers(ev, &ev.BaseEvent.ProcessContext.Parent.CGroup), nil
+	case "process.parent.cgroup.cpu.usage":
+		if !ev.BaseEvent.ProcessContext.HasParent() {
+			return 0, &eval.ErrNotSupported{Field: field}
+		}
+		return int(ev.FieldHandlers.ResolveCGroupCPUUsage(ev, &ev.BaseEvent.ProcessContext.Parent.CGroup)), nil
 	case "process.parent.cgroup.file.inode":
 		if !ev.BaseEvent.ProcessContext.HasParent() {
 			return 0, &eval.ErrNotSupported{Field: field}
@@ -23915,6 +29426,41 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 			return "", &eval.ErrNotSupported{Field: field}
 		}
 		return ev.FieldHandlers.ResolveCGroupManager(ev, &ev.BaseEvent.ProcessContext.Parent.CGroup), nil
+	case "process.parent.cgroup.memory.current":
+		if !ev.BaseEvent.ProcessContext.HasParent() {
+			return 0, &eval.ErrNotSupported{Field: field}
+		}
+		return int(ev.FieldHandlers.ResolveCGroupMemoryCurrent(ev, &ev.BaseEvent.ProcessContext.Parent.CGroup)), nil
+	case "process.parent.cgroup.memory.high_exceeded":
+		if !ev.BaseEvent.ProcessContext.HasParent() {
+			return false, &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveCGroupMemoryHighExceeded(ev, &ev.BaseEvent.ProcessContext.Parent.CGroup), nil
+	case "process.parent.cgroup.pids.current":
+		if !ev.BaseEvent.ProcessContext.HasParent() {
+			return 0, &eval.ErrNotSupported{Field: field}
+		}
+		return int(ev.FieldHandlers.ResolveCGroupPidsCurrent(ev, &ev.BaseEvent.ProcessContext.Parent.CGroup)), nil
+	case "process.parent.cloud_credentials.providers":
+		if !ev.BaseEvent.ProcessContext.HasParent() {
+			return []string{}, &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveProcessCloudCredentialsProviders(ev, ev.BaseEvent.ProcessContext.Parent), nil
+	case "process.parent.cloud_credentials.resources":
+		if !ev.BaseEvent.ProcessContext.HasParent() {
+			return []string{}, &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveProcessCloudCredentialsResources(ev, ev.BaseEvent.ProcessContext.Parent), nil
+	case "process.parent.cloud_credentials.types":
+		if !ev.BaseEvent.ProcessContext.HasParent() {
+			return []string{}, &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveProcessCloudCredentialsTypes(ev, ev.BaseEvent.ProcessContext.Parent), nil
+	case "process.parent.cmdline_obfuscation_score":
+		if !ev.BaseEvent.ProcessContext.HasParent() {
+			return 0, &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveProcessCmdLineObfuscationScore(ev, ev.BaseEvent.ProcessContext.Parent), nil
 	case "process.parent.comm":
 		if !ev.BaseEvent.ProcessContext.HasParent() {
 			return "", &eval.ErrNotSupported{Field: field}
@@ -23940,6 +29486,26 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 			return "", &eval.ErrNotSupported{Field: field}
 		}
 		return ev.BaseEvent.ProcessContext.Parent.Credentials.EGroup, nil
+	case "process.parent.elf.build_id":
+		if !ev.BaseEvent.ProcessContext.HasParent() {
+			return "", &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveProcessELFBuildID(ev, ev.BaseEvent.ProcessContext.Parent), nil
+	case "process.parent.elf.interpreter":
+		if !ev.BaseEvent.ProcessContext.HasParent() {
+			return "", &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveProcessELFInterpreter(ev, ev.BaseEvent.ProcessContext.Parent), nil
+	case "process.parent.elf.is_static":
+		if !ev.BaseEvent.ProcessContext.HasParent() {
+			return false, &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveProcessELFIsStatic(ev, ev.BaseEvent.ProcessContext.Parent), nil
+	case "process.parent.elf.is_stripped":
+		if !ev.BaseEvent.ProcessContext.HasParent() {
+			return false, &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveProcessELFIsStripped(ev, ev.BaseEvent.ProcessContext.Parent), nil
 	case "process.parent.envp":
 		if !ev.BaseEvent.ProcessContext.HasParent() {
 			return []string{}, &eval.ErrNotSupported{Field: field}
@@ -24021,6 +29587,22 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 			return 0, &eval.ErrNotSupported{Field: field}
 		}
 		return int(ev.BaseEvent.ProcessContext.Parent.FileEvent.FileFields.PathKey.Inode), nil
+	case "process.parent.file.layer.digest":
+		if !ev.BaseEvent.ProcessContext.HasParent() {
+			return "", &eval.ErrNotSupported{Field: field}
+		}
+		if !ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
+			return "", &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent), nil
+	case "process.parent.file.layer.path":
+		if !ev.BaseEvent.ProcessContext.HasParent() {
+			return "", &eval.ErrNotSupported{Field: field}
+		}
+		if !ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
+			return "", &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent), nil
 	case "process.parent.file.mode":
 		if !ev.BaseEvent.ProcessContext.HasParent() {
 			return 0, &eval.ErrNotSupported{Field: field}
@@ -24199,6 +29781,22 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 			return 0, &eval.ErrNotSupported{Field: field}
 		}
 		return int(ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent.FileFields.PathKey.Inode), nil
+	case "process.parent.interpreter.file.layer.digest":
+		if !ev.BaseEvent.ProcessContext.HasParent() {
+			return "", &eval.ErrNotSupported{Field: field}
+		}
+		if !ev.BaseEvent.ProcessContext.Parent.HasInterpreter() {
+			return "", &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent), nil
+	case "process.parent.interpreter.file.layer.path":
+		if !ev.BaseEvent.ProcessContext.HasParent() {
+			return "", &eval.ErrNotSupported{Field: field}
+		}
+		if !ev.BaseEvent.ProcessContext.Parent.HasInterpreter() {
+			return "", &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent), nil
 	case "process.parent.interpreter.file.mode":
 		if !ev.BaseEvent.ProcessContext.HasParent() {
 			return 0, &eval.ErrNotSupported{Field: field}
@@ -24306,6 +29904,26 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 			return false, &eval.ErrNotSupported{Field: field}
 		}
 		return ev.FieldHandlers.ResolveProcessIsThread(ev, ev.BaseEvent.ProcessContext.Parent), nil
+	case "process.parent.ns.mnt":
+		if !ev.BaseEvent.ProcessContext.HasParent() {
+			return 0, &eval.ErrNotSupported{Field: field}
+		}
+		return int(ev.FieldHandlers.ResolveProcessMountNamespace(ev, ev.BaseEvent.ProcessContext.Parent)), nil
+	case "process.parent.ns.pid":
+		if !ev.BaseEvent.ProcessContext.HasParent() {
+			return 0, &eval.ErrNotSupported{Field: field}
+		}
+		return int(ev.FieldHandlers.ResolveProcessPIDNamespace(ev, ev.BaseEvent.ProcessContext.Parent)), nil
+	case "process.parent.ns.user":
+		if !ev.BaseEvent.ProcessContext.HasParent() {
+			return 0, &eval.ErrNotSupported{Field: field}
+		}
+		return int(ev.FieldHandlers.ResolveProcessUserNamespace(ev, ev.BaseEvent.ProcessContext.Parent)), nil
+	case "process.parent.ns.uts":
+		if !ev.BaseEvent.ProcessContext.HasParent() {
+			return 0, &eval.ErrNotSupported{Field: field}
+		}
+		return int(ev.FieldHandlers.ResolveProcessUTSNamespace(ev, ev.BaseEvent.ProcessContext.Parent)), nil
 	case "process.parent.pid":
 		if !ev.BaseEvent.ProcessContext.HasParent() {
 			return 0, &eval.ErrNotSupported{Field: field}
@@ -24316,6 +29934,16 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 			return 0, &eval.ErrNotSupported{Field: field}
 		}
 		return int(ev.BaseEvent.ProcessContext.Parent.PPid), nil
+	case "process.parent.systemd.slice":
+		if !ev.BaseEvent.ProcessContext.HasParent() {
+			return "", &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveProcessSystemdSlice(ev, ev.BaseEvent.ProcessContext.Parent), nil
+	case "process.parent.systemd.unit":
+		if !ev.BaseEvent.ProcessContext.HasParent() {
+			return "", &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveProcessSystemdUnit(ev, ev.BaseEvent.ProcessContext.Parent), nil
 	case "process.parent.tid":
 		if !ev.BaseEvent.ProcessContext.HasParent() {
 			return 0, &eval.ErrNotSupported{Field: field}
@@ -24355,6 +29983,10 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		return int(ev.BaseEvent.ProcessContext.Process.PIDContext.Pid), nil
 	case "process.ppid":
 		return int(ev.BaseEvent.ProcessContext.Process.PPid), nil
+	case "process.systemd.slice":
+		return ev.FieldHandlers.ResolveProcessSystemdSlice(ev, &ev.BaseEvent.ProcessContext.Process), nil
+	case "process.systemd.unit":
+		return ev.FieldHandlers.ResolveProcessSystemdUnit(ev, &ev.BaseEvent.ProcessContext.Process), nil
 	case "process.tid":
 		return int(ev.BaseEvent.ProcessContext.Process.PIDContext.Tid), nil
 	case "process.tty_name":
@@ -24481,6 +30113,42 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 			ptr = iterator.Next()
 		}
 		return values, nil
+	case "ptrace.tracee.ancestors.cgroup.ancestors":
+		var values []string
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := ev.FieldHandlers.ResolveCGroupAncestors(ev, &element.ProcessContext.Process.CGroup)
+			values = append(values, result...)
+			ptr = iterator.Next()
+		}
+		return values, nil
+	case "ptrace.tracee.ancestors.cgroup.controllers":
+		var values []string
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := ev.FieldHandlers.ResolveCGroupControllers(ev, &element.ProcessContext.Process.CGroup)
+			values = append(values, result...)
+			ptr = iterator.Next()
+		}
+		return values, nil
+	case "ptrace.tracee.ancestors.cgroup.cpu.usage":
+		var values []int
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := int(ev.FieldHandlers.ResolveCGroupCPUUsage(ev, &element.ProcessContext.Process.CGroup))
+			values = append(values, result)
+			ptr = iterator.Next()
+		}
+		return values, nil
 	case "ptrace.tracee.ancestors.cgroup.file.inode":
 		var values []int
 		ctx := eval.NewContext(ev)
@@ -24529,6 +30197,90 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 			ptr = iterator.Next()
 		}
 		return values, nil
+	case "ptrace.tracee.ancestors.cgroup.memory.current":
+		var values []int
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := int(ev.FieldHandlers.ResolveCGroupMemoryCurrent(ev, &element.ProcessContext.Process.CGroup))
+			values = append(values, result)
+			ptr = iterator.Next()
+		}
+		return values, nil
+	case "ptrace.tracee.ancestors.cgroup.memory.high_exceeded":
+		var values []bool
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := ev.FieldHandlers.ResolveCGroupMemoryHighExceeded(ev, &element.ProcessContext.Process.CGroup)
+			values = append(values, result)
+			ptr = iterator.Next()
+		}
+		return values, nil
+	case "ptrace.tracee.ancestors.cgroup.pids.current":
+		var values []int
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := int(ev.FieldHandlers.ResolveCGroupPidsCurrent(ev, &element.ProcessContext.Process.CGroup))
+			values = append(values, result)
+			ptr = iterator.Next()
+		}
+		return values, nil
+	case "ptrace.tracee.ancestors.cloud_credentials.providers":
+		var values []string
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := ev.FieldHandlers.ResolveProcessCloudCredentialsProviders(ev, &element.ProcessContext.Process)
+			values = append(values, result...)
+			ptr = iterator.Next()
+		}
+		return values, nil
+	case "ptrace.tracee.ancestors.cloud_credentials.resources":
+		var values []string
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := ev.FieldHandlers.ResolveProcessCloudCredentialsResources(ev, &element.ProcessContext.Process)
+			values = append(values, result...)
+			ptr = iterator.Next()
+		}
+		return values, nil
+	case "ptrace.tracee.ancestors.cloud_credentials.types":
+		var values []string
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := ev.FieldHandlers.ResolveProcessCloudCredentialsTypes(ev, &element.ProcessContext.Process)
+			values = append(values, result...)
+			ptr = iterator.Next()
+		}
+		return values, nil
+	case "ptrace.tracee.ancestors.cmdline_obfuscation_score":
+		var values []int
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := ev.FieldHandlers.ResolveProcessCmdLineObfuscationScore(ev, &element.ProcessContext.Process)
+			values = append(values, result)
+			ptr = iterator.Next()
+		}
+		return values, nil
 	case "ptrace.tracee.ancestors.comm":
 		var values []string
 		ctx := eval.NewContext(ev)
@@ -24589,6 +30341,54 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 			ptr = iterator.Next()
 		}
 		return values, nil
+	case "ptrace.tracee.ancestors.elf.build_id":
+		var values []string
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := ev.FieldHandlers.ResolveProcessELFBuildID(ev, &element.ProcessContext.Process)
+			values = append(values, result)
+			ptr = iterator.Next()
+		}
+		return values, nil
+	case "ptrace.tracee.ancestors.elf.interpreter":
+		var values []string
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := ev.FieldHandlers.ResolveProcessELFInterpreter(ev, &element.ProcessContext.Process)
+			values = append(values, result)
+			ptr = iterator.Next()
+		}
+		return values, nil
+	case "ptrace.tracee.ancestors.elf.is_static":
+		var values []bool
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := ev.FieldHandlers.ResolveProcessELFIsStatic(ev, &element.ProcessContext.Process)
+			values = append(values, result)
+			ptr = iterator.Next()
+		}
+		return values, nil
+	case "ptrace.tracee.ancestors.elf.is_stripped":
+		var values []bool
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := ev.FieldHandlers.ResolveProcessELFIsStripped(ev, &element.ProcessContext.Process)
+			values = append(values, result)
+			ptr = iterator.Next()
+		}
+		return values, nil
 	case "ptrace.tracee.ancestors.envp":
 		var values []string
 		ctx := eval.NewContext(ev)
@@ -24733,6 +30533,30 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 			ptr = iterator.Next()
 		}
 		return values, nil
+	case "ptrace.tracee.ancestors.file.layer.digest":
+		var values []string
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := ev.FieldHandlers.ResolveFileLayerDigest(ev, &element.ProcessContext.Process.FileEvent)
+			values = append(values, result)
+			ptr = iterator.Next()
+		}
+		return values, nil
+	case "ptrace.tracee.ancestors.file.layer.path":
+		var values []string
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := ev.FieldHandlers.ResolveFileLayerPath(ev, &element.ProcessContext.Process.FileEvent)
+			values = append(values, result)
+			ptr = iterator.Next()
+		}
+		return values, nil
 	case "ptrace.tracee.ancestors.file.mode":
 		var values []int
 		ctx := eval.NewContext(ev)
@@ -25025,6 +30849,30 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 			ptr = iterator.Next()
 		}
 		return values, nil
+	case "ptrace.tracee.ancestors.interpreter.file.layer.digest":
+		var values []string
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := ev.FieldHandlers.ResolveFileLayerDigest(ev, &element.ProcessContext.Process.LinuxBinprm.FileEvent)
+			values = append(values, result)
+			ptr = iterator.Next()
+		}
+		return values, nil
+	case "ptrace.tracee.ancestors.interpreter.file.layer.path":
+		var values []string
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := ev.FieldHandlers.ResolveFileLayerPath(ev, &element.ProcessContext.Process.LinuxBinprm.FileEvent)
+			values = append(values, result)
+			ptr = iterator.Next()
+		}
+		return values, nil
 	case "ptrace.tracee.ancestors.interpreter.file.mode":
 		var values []int
 		ctx := eval.NewContext(ev)
@@ -25201,6 +31049,54 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		ctx := eval.NewContext(ev)
 		iterator := &ProcessAncestorsIterator{}
 		return iterator.Len(ctx), nil
+	case "ptrace.tracee.ancestors.ns.mnt":
+		var values []int
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := int(ev.FieldHandlers.ResolveProcessMountNamespace(ev, &element.ProcessContext.Process))
+			values = append(values, result)
+			ptr = iterator.Next()
+		}
+		return values, nil
+	case "ptrace.tracee.ancestors.ns.pid":
+		var values []int
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := int(ev.FieldHandlers.ResolveProcessPIDNamespace(ev, &element.ProcessContext.Process))
+			values = append(values, result)
+			ptr = iterator.Next()
+		}
+		return values, nil
+	case "ptrace.tracee.ancestors.ns.user":
+		var values []int
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := int(ev.FieldHandlers.ResolveProcessUserNamespace(ev, &element.ProcessContext.Process))
+			values = append(values, result)
+			ptr = iterator.Next()
+		}
+		return values, nil
+	case "ptrace.tracee.ancestors.ns.uts":
+		var values []int
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := int(ev.FieldHandlers.ResolveProcessUTSNamespace(ev, &element.ProcessContext.Process))
+			values = append(values, result)
+			ptr = iterator.Next()
+		}
+		return values, nil
 	case "ptrace.tracee.ancestors.pid":
 		var values []int
 		ctx := eval.NewContext(ev)
@@ -25225,6 +31121,30 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 			ptr = iterator.Next()
 		}
 		return values, nil
+	case "ptrace.tracee.ancestors.systemd.slice":
+		var values []string
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := ev.FieldHandlers.ResolveProcessSystemdSlice(ev, &element.ProcessContext.Process)
+			values = append(values, result)
+			ptr = iterator.Next()
+		}
+		return values, nil
+	case "ptrace.tracee.ancestors.systemd.unit":
+		var values []string
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := ev.FieldHandlers.ResolveProcessSystemdUnit(ev, &element.ProcessContext.Process)
+			values = append(values, result)
+			ptr = iterator.Next()
+		}
+		return values, nil
 	case "ptrace.tracee.ancestors.tid":
 		var values []int
 		ctx := eval.NewContext(ev)
@@ -25327,6 +31247,12 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		return int(ev.PTrace.Tracee.Process.Credentials.CapEffective), nil
 	case "ptrace.tracee.cap_permitted":
 		return int(ev.PTrace.Tracee.Process.Credentials.CapPermitted), nil
+	case "ptrace.tracee.cgroup.ancestors":
+		return ev.FieldHandlers.ResolveCGroupAncestors(ev, &ev.PTrace.Tracee.Process.CGroup), nil
+	case "ptrace.tracee.cgroup.controllers":
+		return ev.FieldHandlers.ResolveCGroupControllers(ev, &ev.PTrace.Tracee.Process.CGroup), nil
+	case "ptrace.tracee.cgroup.cpu.usage":
+		return int(ev.FieldHandlers.ResolveCGroupCPUUsage(ev, &ev.PTrace.Tracee.Process.CGroup)), nil
 	case "ptrace.tracee.cgroup.file.inode":
 		return int(ev.PTrace.Tracee.Process.CGroup.CGroupFile.Inode), nil
 	case "ptrace.tracee.cgroup.file.mount_id":
@@ -25335,6 +31261,20 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		return ev.FieldHandlers.ResolveCGroupID(ev, &ev.PTrace.Tracee.Process.CGroup), nil
 	case "ptrace.tracee.cgroup.manager":
 		return ev.FieldHandlers.ResolveCGroupManager(ev, &ev.PTrace.Tracee.Process.CGroup), nil
+	case "ptrace.tracee.cgroup.memory.current":
+		return int(ev.FieldHandlers.ResolveCGroupMemoryCurrent(ev, &ev.PTrace.Tracee.Process.CGroup)), nil
+	case "ptrace.tracee.cgroup.memory.high_exceeded":
+		return ev.FieldHandlers.ResolveCGroupMemoryHighExceeded(ev, &ev.PTrace.Tracee.Process.CGroup), nil
+	case "ptrace.tracee.cgroup.pids.current":
+		return int(ev.FieldHandlers.ResolveCGroupPidsCurrent(ev, &ev.PTrace.Tracee.Process.CGroup)), nil
+	case "ptrace.tracee.cloud_credentials.providers":
+		return ev.FieldHandlers.ResolveProcessCloudCredentialsProviders(ev, &ev.PTrace.Tracee.Process), nil
+	case "ptrace.tracee.cloud_credentials.resources":
+		return ev.FieldHandlers.ResolveProcessCloudCredentialsResources(ev, &ev.PTrace.Tracee.Process), nil
+	case "ptrace.tracee.cloud_credentials.types":
+		return ev.FieldHandlers.ResolveProcessCloudCredentialsTypes(ev, &ev.PTrace.Tracee.Process), nil
+	case "ptrace.tracee.cmdline_obfuscation_score":
+		return ev.FieldHandlers.ResolveProcessCmdLineObfuscationScore(ev, &ev.PTrace.Tracee.Process), nil
 	case "ptrace.tracee.comm":
 		return ev.PTrace.Tracee.Process.Comm, nil
 	case "ptrace.tracee.container.id":
@@ -25345,6 +31285,14 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		return int(ev.PTrace.Tracee.Process.Credentials.EGID), nil
 	case "ptrace.tracee.egroup":
 		return ev.PTrace.Tracee.Process.Credentials.EGroup, nil
+	case "ptrace.tracee.elf.build_id":
+		return ev.FieldHandlers.ResolveProcessELFBuildID(ev, &ev.PTrace.Tracee.Process), nil
+	case "ptrace.tracee.elf.interpreter":
+		return ev.FieldHandlers.ResolveProcessELFInterpreter(ev, &ev.PTrace.Tracee.Process), nil
+	case "ptrace.tracee.elf.is_static":
+		return ev.FieldHandlers.ResolveProcessELFIsStatic(ev, &ev.PTrace.Tracee.Process), nil
+	case "ptrace.tracee.elf.is_stripped":
+		return ev.FieldHandlers.ResolveProcessELFIsStripped(ev, &ev.PTrace.Tracee.Process), nil
 	case "ptrace.tracee.envp":
 		return ev.FieldHandlers.ResolveProcessEnvp(ev, &ev.PTrace.Tracee.Process), nil
 	case "ptrace.tracee.envs":
@@ -25390,6 +31338,16 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 			return 0, &eval.ErrNotSupported{Field: field}
 		}
 		return int(ev.PTrace.Tracee.Process.FileEvent.FileFields.PathKey.Inode), nil
+	case "ptrace.tracee.file.layer.digest":
+		if !ev.PTrace.Tracee.Process.IsNotKworker() {
+			return "", &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.PTrace.Tracee.Process.FileEvent), nil
+	case "ptrace.tracee.file.layer.path":
+		if !ev.PTrace.Tracee.Process.IsNotKworker() {
+			return "", &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.PTrace.Tracee.Process.FileEvent), nil
 	case "ptrace.tracee.file.mode":
 		if !ev.PTrace.Tracee.Process.IsNotKworker() {
 			return 0, &eval.ErrNotSupported{Field: field}
@@ -25496,6 +31454,16 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 			return 0, &eval.ErrNotSupported{Field: field}
 		}
 		return int(ev.PTrace.Tracee.Process.LinuxBinprm.FileEvent.FileFields.PathKey.Inode), nil
+	case "ptrace.tracee.interpreter.file.layer.digest":
+		if !ev.PTrace.Tracee.Process.HasInterpreter() {
+			return "", &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.PTrace.Tracee.Process.LinuxBinprm.FileEvent), nil
+	case "ptrace.tracee.interpreter.file.layer.path":
+		if !ev.PTrace.Tracee.Process.HasInterpreter() {
+			return "", &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.PTrace.Tracee.Process.LinuxBinprm.FileEvent), nil
 	case "ptrace.tracee.interpreter.file.mode":
 		if !ev.PTrace.Tracee.Process.HasInterpreter() {
 			return 0, &eval.ErrNotSupported{Field: field}
@@ -25561,6 +31529,14 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		return ev.PTrace.Tracee.Process.PIDContext.IsKworker, nil
 	case "ptrace.tracee.is_thread":
 		return ev.FieldHandlers.ResolveProcessIsThread(ev, &ev.PTrace.Tracee.Process), nil
+	case "ptrace.tracee.ns.mnt":
+		return int(ev.FieldHandlers.ResolveProcessMountNamespace(ev, &ev.PTrace.Tracee.Process)), nil
+	case "ptrace.tracee.ns.pid":
+		return int(ev.FieldHandlers.ResolveProcessPIDNamespace(ev, &ev.PTrace.Tracee.Process)), nil
+	case "ptrace.tracee.ns.user":
+		return int(ev.FieldHandlers.ResolveProcessUserNamespace(ev, &ev.PTrace.Tracee.Process)), nil
+	case "ptrace.tracee.ns.uts":
+		return int(ev.FieldHandlers.ResolveProcessUTSNamespace(ev, &ev.PTrace.Tracee.Process)), nil
 	case "ptrace.tracee.parent.args":
 		if !ev.PTrace.Tracee.HasParent() {
 			return "", &eval.ErrNotSupported{Field: field}
@@ -25606,6 +31582,21 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 			return 0, &eval.ErrNotSupported{Field: field}
 		}
 		return int(ev.PTrace.Tracee.Parent.Credentials.CapPermitted), nil
+	case "ptrace.tracee.parent.cgroup.ancestors":
+		if !ev.PTrace.Tracee.HasParent() {
+			return []string{}, &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveCGroupAncestors(ev, &ev.PTrace.Tracee.Parent.CGroup), nil
+	case "ptrace.tracee.parent.cgroup.controllers":
+		if !ev.PTrace.Tracee.HasParent() {
+			return []string{}, &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveCGroupControllers(ev, &ev.PTrace.Tracee.Parent.CGroup), nil
+	case "ptrace.tracee.parent.cgroup.cpu.usage":
+		if !ev.PTrace.Tracee.HasParent() {
+			return 0, &eval.ErrNotSupported{Field: field}
+		}
+		return int(ev.FieldHandlers.ResolveCGroupCPUUsage(ev, &ev.PTrace.Tracee.Parent.CGroup)), nil
 	case "ptrace.tracee.parent.cgroup.file.inode":
 		if !ev.PTrace.Tracee.HasParent() {
 			return 0, &eval.ErrNotSupported{Field: field}
@@ -25626,6 +31617,41 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 			return "", &eval.ErrNotSupported{Field: field}
 		}
 		return ev.FieldHandlers.ResolveCGroupManager(ev, &ev.PTrace.Tracee.Parent.CGroup), nil
+	case "ptrace.tracee.parent.cgroup.memory.current":
+		if !ev.PTrace.Tracee.HasParent() {
+			return 0, &eval.ErrNotSupported{Field: field}
+		}
+		return int(ev.FieldHandlers.ResolveCGroupMemoryCurrent(ev, &ev.PTrace.Tracee.Parent.CGroup)), nil
+	case "ptrace.tracee.parent.cgroup.memory.high_exceeded":
+		if !ev.PTrace.Tracee.HasParent() {
+			return false, &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveCGroupMemoryHighExceeded(ev, &ev.PTrace.Tracee.Parent.CGroup), nil
+	case "ptrace.tracee.parent.cgroup.pids.current":
+		if !ev.PTrace.Tracee.HasParent() {
+			return 0, &eval.ErrNotSupported{Field: field}
+		}
+		return int(ev.FieldHandlers.ResolveCGroupPidsCurrent(ev, &ev.PTrace.Tracee.Parent.CGroup)), nil
+	case "ptrace.tracee.parent.cloud_credentials.providers":
+		if !ev.PTrace.Tracee.HasParent() {
+			return []string{}, &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveProcessCloudCredentialsProviders(ev, ev.PTrace.Tracee.Parent), nil
+	case "ptrace.tracee.parent.cloud_credentials.resources":
+		if !ev.PTrace.Tracee.HasParent() {
+			return []string{}, &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveProcessCloudCredentialsResources(ev, ev.PTrace.Tracee.Parent), nil
+	case "ptrace.tracee.parent.cloud_credentials.types":
+		if !ev.PTrace.Tracee.HasParent() {
+			return []string{}, &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveProcessCloudCredentialsTypes(ev, ev.PTrace.Tracee.Parent), nil
+	case "ptrace.tracee.parent.cmdline_obfuscation_score":
+		if !ev.PTrace.Tracee.HasParent() {
+			return 0, &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveProcessCmdLineObfuscationScore(ev, ev.PTrace.Tracee.Parent), nil
 	case "ptrace.tracee.parent.comm":
 		if !ev.PTrace.Tracee.HasParent() {
 			return "", &eval.ErrNotSupported{Field: field}
@@ -25651,6 +31677,26 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 			return "", &eval.ErrNotSupported{Field: field}
 		}
 		return ev.PTrace.Tracee.Parent.Credentials.EGroup, nil
+	case "ptrace.tracee.parent.elf.build_id":
+		if !ev.PTrace.Tracee.HasParent() {
+			return "", &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveProcessELFBuildID(ev, ev.PTrace.Tracee.Parent), nil
+	case "ptrace.tracee.parent.elf.interpreter":
+		if !ev.PTrace.Tracee.HasParent() {
+			return "", &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveProcessELFInterpreter(ev, ev.PTrace.Tracee.Parent), nil
+	case "ptrace.tracee.parent.elf.is_static":
+		if !ev.PTrace.Tracee.HasParent() {
+			return false, &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveProcessELFIsStatic(ev, ev.PTrace.Tracee.Parent), nil
+	case "ptrace.tracee.parent.elf.is_stripped":
+		if !ev.PTrace.Tracee.HasParent() {
+			return false, &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveProcessELFIsStripped(ev, ev.PTrace.Tracee.Parent), nil
 	case "ptrace.tracee.parent.envp":
 		if !ev.PTrace.Tracee.HasParent() {
 			return []string{}, &eval.ErrNotSupported{Field: field}
@@ -25732,6 +31778,22 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 			return 0, &eval.ErrNotSupported{Field: field}
 		}
 		return int(ev.PTrace.Tracee.Parent.FileEvent.FileFields.PathKey.Inode), nil
+	case "ptrace.tracee.parent.file.layer.digest":
+		if !ev.PTrace.Tracee.HasParent() {
+			return "", &eval.ErrNotSupported{Field: field}
+		}
+		if !ev.PTrace.Tracee.Parent.IsNotKworker() {
+			return "", &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.PTrace.Tracee.Parent.FileEvent), nil
+	case "ptrace.tracee.parent.file.layer.path":
+		if !ev.PTrace.Tracee.HasParent() {
+			return "", &eval.ErrNotSupported{Field: field}
+		}
+		if !ev.PTrace.Tracee.Parent.IsNotKworker() {
+			return "", &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.PTrace.Tracee.Parent.FileEvent), nil
 	case "ptrace.tracee.parent.file.mode":
 		if !ev.PTrace.Tracee.HasParent() {
 			return 0, &eval.ErrNotSupported{Field: field}
@@ -25910,6 +31972,22 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 			return 0, &eval.ErrNotSupported{Field: field}
 		}
 		return int(ev.PTrace.Tracee.Parent.LinuxBinprm.FileEvent.FileFields.PathKey.Inode), nil
+	case "ptrace.tracee.parent.interpreter.file.layer.digest":
+		if !ev.PTrace.Tracee.HasParent() {
+			return "", &eval.ErrNotSupported{Field: field}
+		}
+		if !ev.PTrace.Tracee.Parent.HasInterpreter() {
+			return "", &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.PTrace.Tracee.Parent.LinuxBinprm.FileEvent), nil
+	case "ptrace.tracee.parent.interpreter.file.layer.path":
+		if !ev.PTrace.Tracee.HasParent() {
+			return "", &eval.ErrNotSupported{Field: field}
+		}
+		if !ev.PTrace.Tracee.Parent.HasInterpreter() {
+			return "", &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.PTrace.Tracee.Parent.LinuxBinprm.FileEvent), nil
 	case "ptrace.tracee.parent.interpreter.file.mode":
 		if !ev.PTrace.Tracee.HasParent() {
 			return 0, &eval.ErrNotSupported{Field: field}
@@ -26017,6 +32095,26 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 			return false, &eval.ErrNotSupported{Field: field}
 		}
 		return ev.FieldHandlers.ResolveProcessIsThread(ev, ev.PTrace.Tracee.Parent), nil
+	case "ptrace.tracee.parent.ns.mnt":
+		if !ev.PTrace.Tracee.HasParent() {
+			return 0, &eval.ErrNotSupported{Field: field}
+		}
+		return int(ev.FieldHandlers.ResolveProcessMountNamespace(ev, ev.PTrace.Tracee.Parent)), nil
+	case "ptrace.tracee.parent.ns.pid":
+		if !ev.PTrace.Tracee.HasParent() {
+			return 0, &eval.ErrNotSupported{Field: field}
+		}
+		return int(ev.FieldHandlers.ResolveProcessPIDNamespace(ev, ev.PTrace.Tracee.Parent)), nil
+	case "ptrace.tracee.parent.ns.user":
+		if !ev.PTrace.Tracee.HasParent() {
+			return 0, &eval.ErrNotSupported{Field: field}
+		}
+		return int(ev.FieldHandlers.ResolveProcessUserNamespace(ev, ev.PTrace.Tracee.Parent)), nil
+	case "ptrace.tracee.parent.ns.uts":
+		if !ev.PTrace.Tracee.HasParent() {
+			return 0, &eval.ErrNotSupported{Field: field}
+		}
+		return int(ev.FieldHandlers.ResolveProcessUTSNamespace(ev, ev.PTrace.Tracee.Parent)), nil
 	case "ptrace.tracee.parent.pid":
 		if !ev.PTrace.Tracee.HasParent() {
 			return 0, &eval.ErrNotSupported{Field: field}
@@ -26027,6 +32125,16 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 			return 0, &eval.ErrNotSupported{Field: field}
 		}
 		return int(ev.PTrace.Tracee.Parent.PPid), nil
+	case "ptrace.tracee.parent.systemd.slice":
+		if !ev.PTrace.Tracee.HasParent() {
+			return "", &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveProcessSystemdSlice(ev, ev.PTrace.Tracee.Parent), nil
+	case "ptrace.tracee.parent.systemd.unit":
+		if !ev.PTrace.Tracee.HasParent() {
+			return "", &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveProcessSystemdUnit(ev, ev.PTrace.Tracee.Parent), nil
 	case "ptrace.tracee.parent.tid":
 		if !ev.PTrace.Tracee.HasParent() {
 			return 0, &eval.ErrNotSupported{Field: field}
@@ -26066,6 +32174,10 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		return int(ev.PTrace.Tracee.Process.PIDContext.Pid), nil
 	case "ptrace.tracee.ppid":
 		return int(ev.PTrace.Tracee.Process.PPid), nil
+	case "ptrace.tracee.systemd.slice":
+		return ev.FieldHandlers.ResolveProcessSystemdSlice(ev, &ev.PTrace.Tracee.Process), nil
+	case "ptrace.tracee.systemd.unit":
+		return ev.FieldHandlers.ResolveProcessSystemdUnit(ev, &ev.PTrace.Tracee.Process), nil
 	case "ptrace.tracee.tid":
 		return int(ev.PTrace.Tracee.Process.PIDContext.Tid), nil
 	case "ptrace.tracee.tty_name":
@@ -26098,6 +32210,10 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		return ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.RemoveXAttr.File.FileFields), nil
 	case "removexattr.file.inode":
 		return int(ev.RemoveXAttr.File.FileFields.PathKey.Inode), nil
+	case "removexattr.file.layer.digest":
+		return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.RemoveXAttr.File), nil
+	case "removexattr.file.layer.path":
+		return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.RemoveXAttr.File), nil
 	case "removexattr.file.mode":
 		return int(ev.RemoveXAttr.File.FileFields.Mode), nil
 	case "removexattr.file.modification_time":
@@ -26142,6 +32258,10 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		return ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.Rename.New.FileFields), nil
 	case "rename.file.destination.inode":
 		return int(ev.Rename.New.FileFields.PathKey.Inode), nil
+	case "rename.file.destination.layer.digest":
+		return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Rename.New), nil
+	case "rename.file.destination.layer.path":
+		return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Rename.New), nil
 	case "rename.file.destination.mode":
 		return int(ev.Rename.New.FileFields.Mode), nil
 	case "rename.file.destination.modification_time":
@@ -26180,6 +32300,10 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		return ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.Rename.Old.FileFields), nil
 	case "rename.file.inode":
 		return int(ev.Rename.Old.FileFields.PathKey.Inode), nil
+	case "rename.file.layer.digest":
+		return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Rename.Old), nil
+	case "rename.file.layer.path":
+		return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Rename.Old), nil
 	case "rename.file.mode":
 		return int(ev.Rename.Old.FileFields.Mode), nil
 	case "rename.file.modification_time":
@@ -26226,6 +32350,10 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		return ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.Rmdir.File.FileFields), nil
 	case "rmdir.file.inode":
 		return int(ev.Rmdir.File.FileFields.PathKey.Inode), nil
+	case "rmdir.file.layer.digest":
+		return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Rmdir.File), nil
+	case "rmdir.file.layer.path":
+		return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Rmdir.File), nil
 	case "rmdir.file.mode":
 		return int(ev.Rmdir.File.FileFields.Mode), nil
 	case "rmdir.file.modification_time":
@@ -26304,6 +32432,10 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		return ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.SetXAttr.File.FileFields), nil
 	case "setxattr.file.inode":
 		return int(ev.SetXAttr.File.FileFields.PathKey.Inode), nil
+	case "setxattr.file.layer.digest":
+		return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.SetXAttr.File), nil
+	case "setxattr.file.layer.path":
+		return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.SetXAttr.File), nil
 	case "setxattr.file.mode":
 		return int(ev.SetXAttr.File.FileFields.Mode), nil
 	case "setxattr.file.modification_time":
@@ -26444,6 +32576,42 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 			ptr = iterator.Next()
 		}
 		return values, nil
+	case "signal.target.ancestors.cgroup.ancestors":
+		var values []string
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := ev.FieldHandlers.ResolveCGroupAncestors(ev, &element.ProcessContext.Process.CGroup)
+			values = append(values, result...)
+			ptr = iterator.Next()
+		}
+		return values, nil
+	case "signal.target.ancestors.cgroup.controllers":
+		var values []string
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := ev.FieldHandlers.ResolveCGroupControllers(ev, &element.ProcessContext.Process.CGroup)
+			values = append(values, result...)
+			ptr = iterator.Next()
+		}
+		return values, nil
+	case "signal.target.ancestors.cgroup.cpu.usage":
+		var values []int
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := int(ev.FieldHandlers.ResolveCGroupCPUUsage(ev, &element.ProcessContext.Process.CGroup))
+			values = append(values, result)
+			ptr = iterator.Next()
+		}
+		return values, nil
 	case "signal.target.ancestors.cgroup.file.inode":
 		var values []int
 		ctx := eval.NewContext(ev)
@@ -26492,6 +32660,90 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 			ptr = iterator.Next()
 		}
 		return values, nil
+	case "signal.target.ancestors.cgroup.memory.current":
+		var values []int
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := int(ev.FieldHandlers.ResolveCGroupMemoryCurrent(ev, &element.ProcessContext.Process.CGroup))
+			values = append(values, result)
+			ptr = iterator.Next()
+		}
+		return values, nil
+	case "signal.target.ancestors.cgroup.memory.high_exceeded":
+		var values []bool
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := ev.FieldHandlers.ResolveCGroupMemoryHighExceeded(ev, &element.ProcessContext.Process.CGroup)
+			values = append(values, result)
+			ptr = iterator.Next()
+		}
+		return values, nil
+	case "signal.target.ancestors.cgroup.pids.current":
+		var values []int
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := int(ev.FieldHandlers.ResolveCGroupPidsCurrent(ev, &element.ProcessContext.Process.CGroup))
+			values = append(values, result)
+			ptr = iterator.Next()
+		}
+		return values, nil
+	case "signal.target.ancestors.cloud_credentials.providers":
+		var values []string
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := ev.FieldHandlers.ResolveProcessCloudCredentialsProviders(ev, &element.ProcessContext.Process)
+			values = append(values, result...)
+			ptr = iterator.Next()
+		}
+		return values, nil
+	case "signal.target.ancestors.cloud_credentials.resources":
+		var values []string
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := ev.FieldHandlers.ResolveProcessCloudCredentialsResources(ev, &element.ProcessContext.Process)
+			values = append(values, result...)
+			ptr = iterator.Next()
+		}
+		return values, nil
+	case "signal.target.ancestors.cloud_credentials.types":
+		var values []string
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := ev.FieldHandlers.ResolveProcessCloudCredentialsTypes(ev, &element.ProcessContext.Process)
+			values = append(values, result...)
+			ptr = iterator.Next()
+		}
+		return values, nil
+	case "signal.target.ancestors.cmdline_obfuscation_score":
+		var values []int
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := ev.FieldHandlers.ResolveProcessCmdLineObfuscationScore(ev, &element.ProcessContext.Process)
+			values = append(values, result)
+			ptr = iterator.Next()
+		}
+		return values, nil
 	case "signal.target.ancestors.comm":
 		var values []string
 		ctx := eval.NewContext(ev)
@@ -26552,6 +32804,54 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 			ptr = iterator.Next()
 		}
 		return values, nil
+	case "signal.target.ancestors.elf.build_id":
+		var values []string
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := ev.FieldHandlers.ResolveProcessELFBuildID(ev, &element.ProcessContext.Process)
+			values = append(values, result)
+			ptr = iterator.Next()
+		}
+		return values, nil
+	case "signal.target.ancestors.elf.interpreter":
+		var values []string
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := ev.FieldHandlers.ResolveProcessELFInterpreter(ev, &element.ProcessContext.Process)
+			values = append(values, result)
+			ptr = iterator.Next()
+		}
+		return values, nil
+	case "signal.target.ancestors.elf.is_static":
+		var values []bool
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := ev.FieldHandlers.ResolveProcessELFIsStatic(ev, &element.ProcessContext.Process)
+			values = append(values, result)
+			ptr = iterator.Next()
+		}
+		return values, nil
+	case "signal.target.ancestors.elf.is_stripped":
+		var values []bool
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := ev.FieldHandlers.ResolveProcessELFIsStripped(ev, &element.ProcessContext.Process)
+			values = append(values, result)
+			ptr = iterator.Next()
+		}
+		return values, nil
 	case "signal.target.ancestors.envp":
 		var values []string
 		ctx := eval.NewContext(ev)
@@ -26696,6 +32996,30 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 			ptr = iterator.Next()
 		}
 		return values, nil
+	case "signal.target.ancestors.file.layer.digest":
+		var values []string
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := ev.FieldHandlers.ResolveFileLayerDigest(ev, &element.ProcessContext.Process.FileEvent)
+			values = append(values, result)
+			ptr = iterator.Next()
+		}
+		return values, nil
+	case "signal.target.ancestors.file.layer.path":
+		var values []string
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := ev.FieldHandlers.ResolveFileLayerPath(ev, &element.ProcessContext.Process.FileEvent)
+			values = append(values, result)
+			ptr = iterator.Next()
+		}
+		return values, nil
 	case "signal.target.ancestors.file.mode":
 		var values []int
 		ctx := eval.NewContext(ev)
@@ -26988,6 +33312,30 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 			ptr = iterator.Next()
 		}
 		return values, nil
+	case "signal.target.ancestors.interpreter.file.layer.digest":
+		var values []string
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := ev.FieldHandlers.ResolveFileLayerDigest(ev, &element.ProcessContext.Process.LinuxBinprm.FileEvent)
+			values = append(values, result)
+			ptr = iterator.Next()
+		}
+		return values, nil
+	case "signal.target.ancestors.interpreter.file.layer.path":
+		var values []string
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := ev.FieldHandlers.ResolveFileLayerPath(ev, &element.ProcessContext.Process.LinuxBinprm.FileEvent)
+			values = append(values, result)
+			ptr = iterator.Next()
+		}
+		return values, nil
 	case "signal.target.ancestors.interpreter.file.mode":
 		var values []int
 		ctx := eval.NewContext(ev)
@@ -27164,6 +33512,54 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		ctx := eval.NewContext(ev)
 		iterator := &ProcessAncestorsIterator{}
 		return iterator.Len(ctx), nil
+	case "signal.target.ancestors.ns.mnt":
+		var values []int
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := int(ev.FieldHandlers.ResolveProcessMountNamespace(ev, &element.ProcessContext.Process))
+			values = append(values, result)
+			ptr = iterator.Next()
+		}
+		return values, nil
+	case "signal.target.ancestors.ns.pid":
+		var values []int
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := int(ev.FieldHandlers.ResolveProcessPIDNamespace(ev, &element.ProcessContext.Process))
+			values = append(values, result)
+			ptr = iterator.Next()
+		}
+		return values, nil
+	case "signal.target.ancestors.ns.user":
+		var values []int
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := int(ev.FieldHandlers.ResolveProcessUserNamespace(ev, &element.ProcessContext.Process))
+			values = append(values, result)
+			ptr = iterator.Next()
+		}
+		return values, nil
+	case "signal.target.ancestors.ns.uts":
+		var values []int
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := int(ev.FieldHandlers.ResolveProcessUTSNamespace(ev, &element.ProcessContext.Process))
+			values = append(values, result)
+			ptr = iterator.Next()
+		}
+		return values, nil
 	case "signal.target.ancestors.pid":
 		var values []int
 		ctx := eval.NewContext(ev)
@@ -27188,6 +33584,30 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 			ptr = iterator.Next()
 		}
 		return values, nil
+	case "signal.target.ancestors.systemd.slice":
+		var values []string
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := ev.FieldHandlers.ResolveProcessSystemdSlice(ev, &element.ProcessContext.Process)
+			values = append(values, result)
+			ptr = iterator.Next()
+		}
+		return values, nil
+	case "signal.target.ancestors.systemd.unit":
+		var values []string
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := ev.FieldHandlers.ResolveProcessSystemdUnit(ev, &element.ProcessContext.Process)
+			values = append(values, result)
+			ptr = iterator.Next()
+		}
+		return values, nil
 	case "signal.target.ancestors.tid":
 		var values []int
 		ctx := eval.NewContext(ev)
@@ -27290,6 +33710,12 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		return int(ev.Signal.Target.Process.Credentials.CapEffective), nil
 	case "signal.target.cap_permitted":
 		return int(ev.Signal.Target.Process.Credentials.CapPermitted), nil
+	case "signal.target.cgroup.ancestors":
+		return ev.FieldHandlers.ResolveCGroupAncestors(ev, &ev.Signal.Target.Process.CGroup), nil
+	case "signal.target.cgroup.controllers":
+		return ev.FieldHandlers.ResolveCGroupControllers(ev, &ev.Signal.Target.Process.CGroup), nil
+	case "signal.target.cgroup.cpu.usage":
+		return int(ev.FieldHandlers.ResolveCGroupCPUUsage(ev, &ev.Signal.Target.Process.CGroup)), nil
 	case "signal.target.cgroup.file.inode":
 		return int(ev.Signal.Target.Process.CGroup.CGroupFile.Inode), nil
 	case "signal.target.cgroup.file.mount_id":
@@ -27298,6 +33724,20 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		return ev.FieldHandlers.ResolveCGroupID(ev, &ev.Signal.Target.Process.CGroup), nil
 	case "signal.target.cgroup.manager":
 		return ev.FieldHandlers.ResolveCGroupManager(ev, &ev.Signal.Target.Process.CGroup), nil
+	case "signal.target.cgroup.memory.current":
+		return int(ev.FieldHandlers.ResolveCGroupMemoryCurrent(ev, &ev.Signal.Target.Process.CGroup)), nil
+	case "signal.target.cgroup.memory.high_exceeded":
+		return ev.FieldHandlers.ResolveCGroupMemoryHighExceeded(ev, &ev.Signal.Target.Process.CGroup), nil
+	case "signal.target.cgroup.pids.current":
+		return int(ev.FieldHandlers.ResolveCGroupPidsCurrent(ev, &ev.Signal.Target.Process.CGroup)), nil
+	case "signal.target.cloud_credentials.providers":
+		return ev.FieldHandlers.ResolveProcessCloudCredentialsProviders(ev, &ev.Signal.Target.Process), nil
+	case "signal.target.cloud_credentials.resources":
+		return ev.FieldHandlers.ResolveProcessCloudCredentialsResources(ev, &ev.Signal.Target.Process), nil
+	case "signal.target.cloud_credentials.types":
+		return ev.FieldHandlers.ResolveProcessCloudCredentialsTypes(ev, &ev.Signal.Target.Process), nil
+	case "signal.target.cmdline_obfuscation_score":
+		return ev.FieldHandlers.ResolveProcessCmdLineObfuscationScore(ev, &ev.Signal.Target.Process), nil
 	case "signal.target.comm":
 		return ev.Signal.Target.Process.Comm, nil
 	case "signal.target.container.id":
@@ -27308,6 +33748,14 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		return int(ev.Signal.Target.Process.Credentials.EGID), nil
 	case "signal.target.egroup":
 		return ev.Signal.Target.Process.Credentials.EGroup, nil
+	case "signal.target.elf.build_id":
+		return ev.FieldHandlers.ResolveProcessELFBuildID(ev, &ev.Signal.Target.Process), nil
+	case "signal.target.elf.interpreter":
+		return ev.FieldHandlers.ResolveProcessELFInterpreter(ev, &ev.Signal.Target.Process), nil
+	case "signal.target.elf.is_static":
+		return ev.FieldHandlers.ResolveProcessELFIsStatic(ev, &ev.Signal.Target.Process), nil
+	case "signal.target.elf.is_stripped":
+		return ev.FieldHandlers.ResolveProcessELFIsStripped(ev, &ev.Signal.Target.Process), nil
 	case "signal.target.envp":
 		return ev.FieldHandlers.ResolveProcessEnvp(ev, &ev.Signal.Target.Process), nil
 	case "signal.target.envs":
@@ -27353,6 +33801,16 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 			return 0, &eval.ErrNotSupported{Field: field}
 		}
 		return int(ev.Signal.Target.Process.FileEvent.FileFields.PathKey.Inode), nil
+	case "signal.target.file.layer.digest":
+		if !ev.Signal.Target.Process.IsNotKworker() {
+			return "", &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Signal.Target.Process.FileEvent), nil
+	case "signal.target.file.layer.path":
+		if !ev.Signal.Target.Process.IsNotKworker() {
+			return "", &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Signal.Target.Process.FileEvent), nil
 	case "signal.target.file.mode":
 		if !ev.Signal.Target.Process.IsNotKworker() {
 			return 0, &eval.ErrNotSupported{Field: field}
@@ -27459,6 +33917,16 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 			return 0, &eval.ErrNotSupported{Field: field}
 		}
 		return int(ev.Signal.Target.Process.LinuxBinprm.FileEvent.FileFields.PathKey.Inode), nil
+	case "signal.target.interpreter.file.layer.digest":
+		if !ev.Signal.Target.Process.HasInterpreter() {
+			return "", &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Signal.Target.Process.LinuxBinprm.FileEvent), nil
+	case "signal.target.interpreter.file.layer.path":
+		if !ev.Signal.Target.Process.HasInterpreter() {
+			return "", &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Signal.Target.Process.LinuxBinprm.FileEvent), nil
 	case "signal.target.interpreter.file.mode":
 		if !ev.Signal.Target.Process.HasInterpreter() {
 			return 0, &eval.ErrNotSupported{Field: field}
@@ -27524,6 +33992,14 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		return ev.Signal.Target.Process.PIDContext.IsKworker, nil
 	case "signal.target.is_thread":
 		return ev.FieldHandlers.ResolveProcessIsThread(ev, &ev.Signal.Target.Process), nil
+	case "signal.target.ns.mnt":
+		return int(ev.FieldHandlers.ResolveProcessMountNamespace(ev, &ev.Signal.Target.Process)), nil
+	case "signal.target.ns.pid":
+		return int(ev.FieldHandlers.ResolveProcessPIDNamespace(ev, &ev.Signal.Target.Process)), nil
+	case "signal.target.ns.user":
+		return int(ev.FieldHandlers.ResolveProcessUserNamespace(ev, &ev.Signal.Target.Process)), nil
+	case "signal.target.ns.uts":
+		return int(ev.FieldHandlers.ResolveProcessUTSNamespace(ev, &ev.Signal.Target.Process)), nil
 	case "signal.target.parent.args":
 		if !ev.Signal.Target.HasParent() {
 			return "", &eval.ErrNotSupported{Field: field}
@@ -27569,6 +34045,21 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 			return 0, &eval.ErrNotSupported{Field: field}
 		}
 		return int(ev.Signal.Target.Parent.Credentials.CapPermitted), nil
+	case "signal.target.parent.cgroup.ancestors":
+		if !ev.Signal.Target.HasParent() {
+			return []string{}, &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveCGroupAncestors(ev, &ev.Signal.Target.Parent.CGroup), nil
+	case "signal.target.parent.cgroup.controllers":
+		if !ev.Signal.Target.HasParent() {
+			return []string{}, &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveCGroupControllers(ev, &ev.Signal.Target.Parent.CGroup), nil
+	case "signal.target.parent.cgroup.cpu.usage":
+		if !ev.Signal.Target.HasParent() {
+			return 0, &eval.ErrNotSupported{Field: field}
+		}
+		return int(ev.FieldHandlers.ResolveCGroupCPUUsage(ev, &ev.Signal.Target.Parent.CGroup)), nil
 	case "signal.target.parent.cgroup.file.inode":
 		if !ev.Signal.Target.HasParent() {
 			return 0, &eval.ErrNotSupported{Field: field}
@@ -27589,6 +34080,41 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 			return "", &eval.ErrNotSupported{Field: field}
 		}
 		return ev.FieldHandlers.ResolveCGroupManager(ev, &ev.Signal.Target.Parent.CGroup), nil
+	case "signal.target.parent.cgroup.memory.current":
+		if !ev.Signal.Target.HasParent() {
+			return 0, &eval.ErrNotSupported{Field: field}
+		}
+		return int(ev.FieldHandlers.ResolveCGroupMemoryCurrent(ev, &ev.Signal.Target.Parent.CGroup)), nil
+	case "signal.target.parent.cgroup.memory.high_exceeded":
+		if !ev.Signal.Target.HasParent() {
+			return false, &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveCGroupMemoryHighExceeded(ev, &ev.Signal.Target.Parent.CGroup), nil
+	case "signal.target.parent.cgroup.pids.current":
+		if !ev.Signal.Target.HasParent() {
+			return 0, &eval.ErrNotSupported{Field: field}
+		}
+		return int(ev.FieldHandlers.ResolveCGroupPidsCurrent(ev, &ev.Signal.Target.Parent.CGroup)), nil
+	case "signal.target.parent.cloud_credentials.providers":
+		if !ev.Signal.Target.HasParent() {
+			return []string{}, &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveProcessCloudCredentialsProviders(ev, ev.Signal.Target.Parent), nil
+	case "signal.target.parent.cloud_credentials.resources":
+		if !ev.Signal.Target.HasParent() {
+			return []string{}, &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveProcessCloudCredentialsResources(ev, ev.Signal.Target.Parent), nil
+	case "signal.target.parent.cloud_credentials.types":
+		if !ev.Signal.Target.HasParent() {
+			return []string{}, &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveProcessCloudCredentialsTypes(ev, ev.Signal.Target.Parent), nil
+	case "signal.target.parent.cmdline_obfuscation_score":
+		if !ev.Signal.Target.HasParent() {
+			return 0, &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveProcessCmdLineObfuscationScore(ev, ev.Signal.Target.Parent), nil
 	case "signal.target.parent.comm":
 		if !ev.Signal.Target.HasParent() {
 			return "", &eval.ErrNotSupported{Field: field}
@@ -27614,6 +34140,26 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 			return "", &eval.ErrNotSupported{Field: field}
 		}
 		return ev.Signal.Target.Parent.Credentials.EGroup, nil
+	case "signal.target.parent.elf.build_id":
+		if !ev.Signal.Target.HasParent() {
+			return "", &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveProcessELFBuildID(ev, ev.Signal.Target.Parent), nil
+	case "signal.target.parent.elf.interpreter":
+		if !ev.Signal.Target.HasParent() {
+			return "", &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveProcessELFInterpreter(ev, ev.Signal.Target.Parent), nil
+	case "signal.target.parent.elf.is_static":
+		if !ev.Signal.Target.HasParent() {
+			return false, &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveProcessELFIsStatic(ev, ev.Signal.Target.Parent), nil
+	case "signal.target.parent.elf.is_stripped":
+		if !ev.Signal.Target.HasParent() {
+			return false, &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveProcessELFIsStripped(ev, ev.Signal.Target.Parent), nil
 	case "signal.target.parent.envp":
 		if !ev.Signal.Target.HasParent() {
 			return []string{}, &eval.ErrNotSupported{Field: field}
@@ -27695,6 +34241,22 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 			return 0, &eval.ErrNotSupported{Field: field}
 		}
 		return int(ev.Signal.Target.Parent.FileEvent.FileFields.PathKey.Inode), nil
+	case "signal.target.parent.file.layer.digest":
+		if !ev.Signal.Target.HasParent() {
+			return "", &eval.ErrNotSupported{Field: field}
+		}
+		if !ev.Signal.Target.Parent.IsNotKworker() {
+			return "", &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Signal.Target.Parent.FileEvent), nil
+	case "signal.target.parent.file.layer.path":
+		if !ev.Signal.Target.HasParent() {
+			return "", &eval.ErrNotSupported{Field: field}
+		}
+		if !ev.Signal.Target.Parent.IsNotKworker() {
+			return "", &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Signal.Target.Parent.FileEvent), nil
 	case "signal.target.parent.file.mode":
 		if !ev.Signal.Target.HasParent() {
 			return 0, &eval.ErrNotSupported{Field: field}
@@ -27873,6 +34435,22 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 			return 0, &eval.ErrNotSupported{Field: field}
 		}
 		return int(ev.Signal.Target.Parent.LinuxBinprm.FileEvent.FileFields.PathKey.Inode), nil
+	case "signal.target.parent.interpreter.file.layer.digest":
+		if !ev.Signal.Target.HasParent() {
+			return "", &eval.ErrNotSupported{Field: field}
+		}
+		if !ev.Signal.Target.Parent.HasInterpreter() {
+			return "", &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Signal.Target.Parent.LinuxBinprm.FileEvent), nil
+	case "signal.target.parent.interpreter.file.layer.path":
+		if !ev.Signal.Target.HasParent() {
+			return "", &eval.ErrNotSupported{Field: field}
+		}
+		if !ev.Signal.Target.Parent.HasInterpreter() {
+			return "", &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Signal.Target.Parent.LinuxBinprm.FileEvent), nil
 	case "signal.target.parent.interpreter.file.mode":
 		if !ev.Signal.Target.HasParent() {
 			return 0, &eval.ErrNotSupported{Field: field}
@@ -27980,6 +34558,26 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 			return false, &eval.ErrNotSupported{Field: field}
 		}
 		return ev.FieldHandlers.ResolveProcessIsThread(ev, ev.Signal.Target.Parent), nil
+	case "signal.target.parent.ns.mnt":
+		if !ev.Signal.Target.HasParent() {
+			return 0, &eval.ErrNotSupported{Field: field}
+		}
+		return int(ev.FieldHandlers.ResolveProcessMountNamespace(ev, ev.Signal.Target.Parent)), nil
+	case "signal.target.parent.ns.pid":
+		if !ev.Signal.Target.HasParent() {
+			return 0, &eval.ErrNotSupported{Field: field}
+		}
+		return int(ev.FieldHandlers.ResolveProcessPIDNamespace(ev, ev.Signal.Target.Parent)), nil
+	case "signal.target.parent.ns.user":
+		if !ev.Signal.Target.HasParent() {
+			return 0, &eval.ErrNotSupported{Field: field}
+		}
+		return int(ev.FieldHandlers.ResolveProcessUserNamespace(ev, ev.Signal.Target.Parent)), nil
+	case "signal.target.parent.ns.uts":
+		if !ev.Signal.Target.HasParent() {
+			return 0, &eval.ErrNotSupported{Field: field}
+		}
+		return int(ev.FieldHandlers.ResolveProcessUTSNamespace(ev, ev.Signal.Target.Parent)), nil
 	case "signal.target.parent.pid":
 		if !ev.Signal.Target.HasParent() {
 			return 0, &eval.ErrNotSupported{Field: field}
@@ -27990,6 +34588,16 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 			return 0, &eval.ErrNotSupported{Field: field}
 		}
 		return int(ev.Signal.Target.Parent.PPid), nil
+	case "signal.target.parent.systemd.slice":
+		if !ev.Signal.Target.HasParent() {
+			return "", &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveProcessSystemdSlice(ev, ev.Signal.Target.Parent), nil
+	case "signal.target.parent.systemd.unit":
+		if !ev.Signal.Target.HasParent() {
+			return "", &eval.ErrNotSupported{Field: field}
+		}
+		return ev.FieldHandlers.ResolveProcessSystemdUnit(ev, ev.Signal.Target.Parent), nil
 	case "signal.target.parent.tid":
 		if !ev.Signal.Target.HasParent() {
 			return 0, &eval.ErrNotSupported{Field: field}
@@ -28029,6 +34637,10 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		return int(ev.Signal.Target.Process.PIDContext.Pid), nil
 	case "signal.target.ppid":
 		return int(ev.Signal.Target.Process.PPid), nil
+	case "signal.target.systemd.slice":
+		return ev.FieldHandlers.ResolveProcessSystemdSlice(ev, &ev.Signal.Target.Process), nil
+	case "signal.target.systemd.unit":
+		return ev.FieldHandlers.ResolveProcessSystemdUnit(ev, &ev.Signal.Target.Process), nil
 	case "signal.target.tid":
 		return int(ev.Signal.Target.Process.PIDContext.Tid), nil
 	case "signal.target.tty_name":
@@ -28059,6 +34671,10 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		return ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.Splice.File.FileFields), nil
 	case "splice.file.inode":
 		return int(ev.Splice.File.FileFields.PathKey.Inode), nil
+	case "splice.file.layer.digest":
+		return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Splice.File), nil
+	case "splice.file.layer.path":
+		return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Splice.File), nil
 	case "splice.file.mode":
 		return int(ev.Splice.File.FileFields.Mode), nil
 	case "splice.file.modification_time":
@@ -28105,6 +34721,10 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		return ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.Unlink.File.FileFields), nil
 	case "unlink.file.inode":
 		return int(ev.Unlink.File.FileFields.PathKey.Inode), nil
+	case "unlink.file.layer.digest":
+		return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Unlink.File), nil
+	case "unlink.file.layer.path":
+		return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Unlink.File), nil
 	case "unlink.file.mode":
 		return int(ev.Unlink.File.FileFields.Mode), nil
 	case "unlink.file.modification_time":
@@ -28159,6 +34779,10 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		return ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.Utimes.File.FileFields), nil
 	case "utimes.file.inode":
 		return int(ev.Utimes.File.FileFields.PathKey.Inode), nil
+	case "utimes.file.layer.digest":
+		return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Utimes.File), nil
+	case "utimes.file.layer.path":
+		return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Utimes.File), nil
 	case "utimes.file.mode":
 		return int(ev.Utimes.File.FileFields.Mode), nil
 	case "utimes.file.modification_time":
@@ -28206,6 +34830,10 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "bind", nil
 	case "bpf.cmd":
 		return "bpf", nil
+	case "bpf.kernel_security.lockdown":
+		return "bpf", nil
+	case "bpf.kernel_security.secure_boot":
+		return "bpf", nil
 	case "bpf.map.name":
 		return "bpf", nil
 	case "bpf.map.type":
@@ -28226,6 +34854,12 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "capset", nil
 	case "capset.cap_permitted":
 		return "capset", nil
+	case "cgroup.ancestors":
+		return "", nil
+	case "cgroup.controllers":
+		return "", nil
+	case "cgroup.cpu.usage":
+		return "", nil
 	case "cgroup.file.inode":
 		return "", nil
 	case "cgroup.file.mount_id":
@@ -28234,6 +34868,12 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "", nil
 	case "cgroup.manager":
 		return "", nil
+	case "cgroup.memory.current":
+		return "", nil
+	case "cgroup.memory.high_exceeded":
+		return "", nil
+	case "cgroup.pids.current":
+		return "", nil
 	case "chdir.file.change_time":
 		return "chdir", nil
 	case "chdir.file.filesystem":
@@ -28248,6 +34888,10 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "chdir", nil
 	case "chdir.file.inode":
 		return "chdir", nil
+	case "chdir.file.layer.digest":
+		return "chdir", nil
+	case "chdir.file.layer.path":
+		return "chdir", nil
 	case "chdir.file.mode":
 		return "chdir", nil
 	case "chdir.file.modification_time":
@@ -28296,6 +34940,10 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "chmod", nil
 	case "chmod.file.inode":
 		return "chmod", nil
+	case "chmod.file.layer.digest":
+		return "chmod", nil
+	case "chmod.file.layer.path":
+		return "chmod", nil
 	case "chmod.file.mode":
 		return "chmod", nil
 	case "chmod.file.modification_time":
@@ -28316,6 +34964,10 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "chmod", nil
 	case "chmod.file.path.length":
 		return "chmod", nil
+	case "chmod.file.previous_mode":
+		return "chmod", nil
+	case "chmod.file.previous_rights":
+		return "chmod", nil
 	case "chmod.file.rights":
 		return "chmod", nil
 	case "chmod.file.uid":
@@ -28350,6 +35002,10 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "chown", nil
 	case "chown.file.inode":
 		return "chown", nil
+	case "chown.file.layer.digest":
+		return "chown", nil
+	case "chown.file.layer.path":
+		return "chown", nil
 	case "chown.file.mode":
 		return "chown", nil
 	case "chown.file.modification_time":
@@ -28370,6 +35026,10 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "chown", nil
 	case "chown.file.path.length":
 		return "chown", nil
+	case "chown.file.previous_gid":
+		return "chown", nil
+	case "chown.file.previous_uid":
+		return "chown", nil
 	case "chown.file.rights":
 		return "chown", nil
 	case "chown.file.uid":
@@ -28416,6 +35076,10 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "dns", nil
 	case "dns.question.count":
 		return "dns", nil
+	case "dns.question.domain_query_rate":
+		return "dns", nil
+	case "dns.question.label_entropy":
+		return "dns", nil
 	case "dns.question.length":
 		return "dns", nil
 	case "dns.question.name":
@@ -28424,6 +35088,8 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "dns", nil
 	case "dns.question.type":
 		return "dns", nil
+	case "dns.question.unique_subdomains":
+		return "dns", nil
 	case "event.async":
 		return "", nil
 	case "event.hostname":
@@ -28454,6 +35120,12 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "exec", nil
 	case "exec.cap_permitted":
 		return "exec", nil
+	case "exec.cgroup.ancestors":
+		return "exec", nil
+	case "exec.cgroup.controllers":
+		return "exec", nil
+	case "exec.cgroup.cpu.usage":
+		return "exec", nil
 	case "exec.cgroup.file.inode":
 		return "exec", nil
 	case "exec.cgroup.file.mount_id":
@@ -28462,6 +35134,20 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "exec", nil
 	case "exec.cgroup.manager":
 		return "exec", nil
+	case "exec.cgroup.memory.current":
+		return "exec", nil
+	case "exec.cgroup.memory.high_exceeded":
+		return "exec", nil
+	case "exec.cgroup.pids.current":
+		return "exec", nil
+	case "exec.cloud_credentials.providers":
+		return "exec", nil
+	case "exec.cloud_credentials.resources":
+		return "exec", nil
+	case "exec.cloud_credentials.types":
+		return "exec", nil
+	case "exec.cmdline_obfuscation_score":
+		return "exec", nil
 	case "exec.comm":
 		return "exec", nil
 	case "exec.container.id":
@@ -28472,6 +35158,14 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "exec", nil
 	case "exec.egroup":
 		return "exec", nil
+	case "exec.elf.build_id":
+		return "exec", nil
+	case "exec.elf.interpreter":
+		return "exec", nil
+	case "exec.elf.is_static":
+		return "exec", nil
+	case "exec.elf.is_stripped":
+		return "exec", nil
 	case "exec.envp":
 		return "exec", nil
 	case "exec.envs":
@@ -28496,6 +35190,10 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "exec", nil
 	case "exec.file.inode":
 		return "exec", nil
+	case "exec.file.layer.digest":
+		return "exec", nil
+	case "exec.file.layer.path":
+		return "exec", nil
 	case "exec.file.mode":
 		return "exec", nil
 	case "exec.file.modification_time":
@@ -28548,6 +35246,10 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "exec", nil
 	case "exec.interpreter.file.inode":
 		return "exec", nil
+	case "exec.interpreter.file.layer.digest":
+		return "exec", nil
+	case "exec.interpreter.file.layer.path":
+		return "exec", nil
 	case "exec.interpreter.file.mode":
 		return "exec", nil
 	case "exec.interpreter.file.modification_time":
@@ -28580,12 +35282,24 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "exec", nil
 	case "exec.is_thread":
 		return "exec", nil
+	case "exec.ns.mnt":
+		return "exec", nil
+	case "exec.ns.pid":
+		return "exec", nil
+	case "exec.ns.user":
+		return "exec", nil
+	case "exec.ns.uts":
+		return "exec", nil
 	case "exec.pid":
 		return "exec", nil
 	case "exec.ppid":
 		return "exec", nil
 	case "exec.syscall.path":
 		return "exec", nil
+	case "exec.systemd.slice":
+		return "exec", nil
+	case "exec.systemd.unit":
+		return "exec", nil
 	case "exec.tid":
 		return "exec", nil
 	case "exec.tty_name":
@@ -28620,6 +35334,12 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "exit", nil
 	case "exit.cause":
 		return "exit", nil
+	case "exit.cgroup.ancestors":
+		return "exit", nil
+	case "exit.cgroup.controllers":
+		return "exit", nil
+	case "exit.cgroup.cpu.usage":
+		return "exit", nil
 	case "exit.cgroup.file.inode":
 		return "exit", nil
 	case "exit.cgroup.file.mount_id":
@@ -28628,6 +35348,20 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "exit", nil
 	case "exit.cgroup.manager":
 		return "exit", nil
+	case "exit.cgroup.memory.current":
+		return "exit", nil
+	case "exit.cgroup.memory.high_exceeded":
+		return "exit", nil
+	case "exit.cgroup.pids.current":
+		return "exit", nil
+	case "exit.cloud_credentials.providers":
+		return "exit", nil
+	case "exit.cloud_credentials.resources":
+		return "exit", nil
+	case "exit.cloud_credentials.types":
+		return "exit", nil
+	case "exit.cmdline_obfuscation_score":
+		return "exit", nil
 	case "exit.code":
 		return "exit", nil
 	case "exit.comm":
@@ -28640,6 +35374,14 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "exit", nil
 	case "exit.egroup":
 		return "exit", nil
+	case "exit.elf.build_id":
+		return "exit", nil
+	case "exit.elf.interpreter":
+		return "exit", nil
+	case "exit.elf.is_static":
+		return "exit", nil
+	case "exit.elf.is_stripped":
+		return "exit", nil
 	case "exit.envp":
 		return "exit", nil
 	case "exit.envs":
@@ -28664,6 +35406,10 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "exit", nil
 	case "exit.file.inode":
 		return "exit", nil
+	case "exit.file.layer.digest":
+		return "exit", nil
+	case "exit.file.layer.path":
+		return "exit", nil
 	case "exit.file.mode":
 		return "exit", nil
 	case "exit.file.modification_time":
@@ -28716,6 +35462,10 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "exit", nil
 	case "exit.interpreter.file.inode":
 		return "exit", nil
+	case "exit.interpreter.file.layer.digest":
+		return "exit", nil
+	case "exit.interpreter.file.layer.path":
+		return "exit", nil
 	case "exit.interpreter.file.mode":
 		return "exit", nil
 	case "exit.interpreter.file.modification_time":
@@ -28748,10 +35498,22 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "exit", nil
 	case "exit.is_thread":
 		return "exit", nil
+	case "exit.ns.mnt":
+		return "exit", nil
+	case "exit.ns.pid":
+		return "exit", nil
+	case "exit.ns.user":
+		return "exit", nil
+	case "exit.ns.uts":
+		return "exit", nil
 	case "exit.pid":
 		return "exit", nil
 	case "exit.ppid":
 		return "exit", nil
+	case "exit.systemd.slice":
+		return "exit", nil
+	case "exit.systemd.unit":
+		return "exit", nil
 	case "exit.tid":
 		return "exit", nil
 	case "exit.tty_name":
@@ -28766,6 +35528,12 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "exit", nil
 	case "exit.user_session.k8s_username":
 		return "exit", nil
+	case "imds.access_token.expires_in":
+		return "imds", nil
+	case "imds.access_token.resource":
+		return "imds", nil
+	case "imds.access_token.type":
+		return "imds", nil
 	case "imds.aws.is_imds_v2":
 		return "imds", nil
 	case "imds.aws.security_credentials.type":
@@ -28798,6 +35566,10 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "link", nil
 	case "link.file.destination.inode":
 		return "link", nil
+	case "link.file.destination.layer.digest":
+		return "link", nil
+	case "link.file.destination.layer.path":
+		return "link", nil
 	case "link.file.destination.mode":
 		return "link", nil
 	case "link.file.destination.modification_time":
@@ -28836,6 +35608,10 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "link", nil
 	case "link.file.inode":
 		return "link", nil
+	case "link.file.layer.digest":
+		return "link", nil
+	case "link.file.layer.path":
+		return "link", nil
 	case "link.file.mode":
 		return "link", nil
 	case "link.file.modification_time":
@@ -28888,6 +35664,10 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "load_module", nil
 	case "load_module.file.inode":
 		return "load_module", nil
+	case "load_module.file.layer.digest":
+		return "load_module", nil
+	case "load_module.file.layer.path":
+		return "load_module", nil
 	case "load_module.file.mode":
 		return "load_module", nil
 	case "load_module.file.modification_time":
@@ -28914,6 +35694,10 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "load_module", nil
 	case "load_module.file.user":
 		return "load_module", nil
+	case "load_module.kernel_security.lockdown":
+		return "load_module", nil
+	case "load_module.kernel_security.secure_boot":
+		return "load_module", nil
 	case "load_module.loaded_from_memory":
 		return "load_module", nil
 	case "load_module.name":
@@ -28938,6 +35722,10 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "mkdir", nil
 	case "mkdir.file.inode":
 		return "mkdir", nil
+	case "mkdir.file.layer.digest":
+		return "mkdir", nil
+	case "mkdir.file.layer.path":
+		return "mkdir", nil
 	case "mkdir.file.mode":
 		return "mkdir", nil
 	case "mkdir.file.modification_time":
@@ -28980,6 +35768,10 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "mmap", nil
 	case "mmap.file.inode":
 		return "mmap", nil
+	case "mmap.file.layer.digest":
+		return "mmap", nil
+	case "mmap.file.layer.path":
+		return "mmap", nil
 	case "mmap.file.mode":
 		return "mmap", nil
 	case "mmap.file.modification_time":
@@ -29008,6 +35800,8 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "mmap", nil
 	case "mmap.flags":
 		return "mmap", nil
+	case "mmap.length":
+		return "mmap", nil
 	case "mmap.protection":
 		return "mmap", nil
 	case "mmap.retval":
@@ -29028,10 +35822,14 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "mount", nil
 	case "mount.syscall.source.path":
 		return "mount", nil
+	case "mprotect.is_anonymous":
+		return "mprotect", nil
 	case "mprotect.req_protection":
 		return "mprotect", nil
 	case "mprotect.retval":
 		return "mprotect", nil
+	case "mprotect.size":
+		return "mprotect", nil
 	case "mprotect.vm_protection":
 		return "mprotect", nil
 	case "network.destination.ip":
@@ -29088,6 +35886,10 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "open", nil
 	case "open.file.inode":
 		return "open", nil
+	case "open.file.layer.digest":
+		return "open", nil
+	case "open.file.layer.path":
+		return "open", nil
 	case "open.file.mode":
 		return "open", nil
 	case "open.file.modification_time":
@@ -29166,6 +35968,12 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "", nil
 	case "process.ancestors.cap_permitted":
 		return "", nil
+	case "process.ancestors.cgroup.ancestors":
+		return "", nil
+	case "process.ancestors.cgroup.controllers":
+		return "", nil
+	case "process.ancestors.cgroup.cpu.usage":
+		return "", nil
 	case "process.ancestors.cgroup.file.inode":
 		return "", nil
 	case "process.ancestors.cgroup.file.mount_id":
@@ -29174,6 +35982,20 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "", nil
 	case "process.ancestors.cgroup.manager":
 		return "", nil
+	case "process.ancestors.cgroup.memory.current":
+		return "", nil
+	case "process.ancestors.cgroup.memory.high_exceeded":
+		return "", nil
+	case "process.ancestors.cgroup.pids.current":
+		return "", nil
+	case "process.ancestors.cloud_credentials.providers":
+		return "", nil
+	case "process.ancestors.cloud_credentials.resources":
+		return "", nil
+	case "process.ancestors.cloud_credentials.types":
+		return "", nil
+	case "process.ancestors.cmdline_obfuscation_score":
+		return "", nil
 	case "process.ancestors.comm":
 		return "", nil
 	case "process.ancestors.container.id":
@@ -29184,6 +36006,14 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "", nil
 	case "process.ancestors.egroup":
 		return "", nil
+	case "process.ancestors.elf.build_id":
+		return "", nil
+	case "process.ancestors.elf.interpreter":
+		return "", nil
+	case "process.ancestors.elf.is_static":
+		return "", nil
+	case "process.ancestors.elf.is_stripped":
+		return "", nil
 	case "process.ancestors.envp":
 		return "", nil
 	case "process.ancestors.envs":
@@ -29208,6 +36038,10 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "", nil
 	case "process.ancestors.file.inode":
 		return "", nil
+	case "process.ancestors.file.layer.digest":
+		return "", nil
+	case "process.ancestors.file.layer.path":
+		return "", nil
 	case "process.ancestors.file.mode":
 		return "", nil
 	case "process.ancestors.file.modification_time":
@@ -29260,6 +36094,10 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "", nil
 	case "process.ancestors.interpreter.file.inode":
 		return "", nil
+	case "process.ancestors.interpreter.file.layer.digest":
+		return "", nil
+	case "process.ancestors.interpreter.file.layer.path":
+		return "", nil
 	case "process.ancestors.interpreter.file.mode":
 		return "", nil
 	case "process.ancestors.interpreter.file.modification_time":
@@ -29294,10 +36132,22 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "", nil
 	case "process.ancestors.length":
 		return "", nil
+	case "process.ancestors.ns.mnt":
+		return "", nil
+	case "process.ancestors.ns.pid":
+		return "", nil
+	case "process.ancestors.ns.user":
+		return "", nil
+	case "process.ancestors.ns.uts":
+		return "", nil
 	case "process.ancestors.pid":
 		return "", nil
 	case "process.ancestors.ppid":
 		return "", nil
+	case "process.ancestors.systemd.slice":
+		return "", nil
+	case "process.ancestors.systemd.unit":
+		return "", nil
 	case "process.ancestors.tid":
 		return "", nil
 	case "process.ancestors.tty_name":
@@ -29330,6 +36180,12 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "", nil
 	case "process.cap_permitted":
 		return "", nil
+	case "process.cgroup.ancestors":
+		return "", nil
+	case "process.cgroup.controllers":
+		return "", nil
+	case "process.cgroup.cpu.usage":
+		return "", nil
 	case "process.cgroup.file.inode":
 		return "", nil
 	case "process.cgroup.file.mount_id":
@@ -29338,6 +36194,20 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "", nil
 	case "process.cgroup.manager":
 		return "", nil
+	case "process.cgroup.memory.current":
+		return "", nil
+	case "process.cgroup.memory.high_exceeded":
+		return "", nil
+	case "process.cgroup.pids.current":
+		return "", nil
+	case "process.cloud_credentials.providers":
+		return "", nil
+	case "process.cloud_credentials.resources":
+		return "", nil
+	case "process.cloud_credentials.types":
+		return "", nil
+	case "process.cmdline_obfuscation_score":
+		return "", nil
 	case "process.comm":
 		return "", nil
 	case "process.container.id":
@@ -29348,6 +36218,14 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "", nil
 	case "process.egroup":
 		return "", nil
+	case "process.elf.build_id":
+		return "", nil
+	case "process.elf.interpreter":
+		return "", nil
+	case "process.elf.is_static":
+		return "", nil
+	case "process.elf.is_stripped":
+		return "", nil
 	case "process.envp":
 		return "", nil
 	case "process.envs":
@@ -29372,6 +36250,10 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "", nil
 	case "process.file.inode":
 		return "", nil
+	case "process.file.layer.digest":
+		return "", nil
+	case "process.file.layer.path":
+		return "", nil
 	case "process.file.mode":
 		return "", nil
 	case "process.file.modification_time":
@@ -29424,6 +36306,10 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "", nil
 	case "process.interpreter.file.inode":
 		return "", nil
+	case "process.interpreter.file.layer.digest":
+		return "", nil
+	case "process.interpreter.file.layer.path":
+		return "", nil
 	case "process.interpreter.file.mode":
 		return "", nil
 	case "process.interpreter.file.modification_time":
@@ -29456,6 +36342,14 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "", nil
 	case "process.is_thread":
 		return "", nil
+	case "process.ns.mnt":
+		return "", nil
+	case "process.ns.pid":
+		return "", nil
+	case "process.ns.user":
+		return "", nil
+	case "process.ns.uts":
+		return "", nil
 	case "process.parent.args":
 		return "", nil
 	case "process.parent.args_flags":
@@ -29474,6 +36368,12 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "", nil
 	case "process.parent.cap_permitted":
 		return "", nil
+	case "process.parent.cgroup.ancestors":
+		return "", nil
+	case "process.parent.cgroup.controllers":
+		return "", nil
+	case "process.parent.cgroup.cpu.usage":
+		return "", nil
 	case "process.parent.cgroup.file.inode":
 		return "", nil
 	case "process.parent.cgroup.file.mount_id":
@@ -29482,6 +36382,20 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "", nil
 	case "process.parent.cgroup.manager":
 		return "", nil
+	case "process.parent.cgroup.memory.current":
+		return "", nil
+	case "process.parent.cgroup.memory.high_exceeded":
+		return "", nil
+	case "process.parent.cgroup.pids.current":
+		return "", nil
+	case "process.parent.cloud_credentials.providers":
+		return "", nil
+	case "process.parent.cloud_credentials.resources":
+		return "", nil
+	case "process.parent.cloud_credentials.types":
+		return "", nil
+	case "process.parent.cmdline_obfuscation_score":
+		return "", nil
 	case "process.parent.comm":
 		return "", nil
 	case "process.parent.container.id":
@@ -29492,6 +36406,14 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "", nil
 	case "process.parent.egroup":
 		return "", nil
+	case "process.parent.elf.build_id":
+		return "", nil
+	case "process.parent.elf.interpreter":
+		return "", nil
+	case "process.parent.elf.is_static":
+		return "", nil
+	case "process.parent.elf.is_stripped":
+		return "", nil
 	case "process.parent.envp":
 		return "", nil
 	case "process.parent.envs":
@@ -29516,6 +36438,10 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "", nil
 	case "process.parent.file.inode":
 		return "", nil
+	case "process.parent.file.layer.digest":
+		return "", nil
+	case "process.parent.file.layer.path":
+		return "", nil
 	case "process.parent.file.mode":
 		return "", nil
 	case "process.parent.file.modification_time":
@@ -29568,6 +36494,10 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "", nil
 	case "process.parent.interpreter.file.inode":
 		return "", nil
+	case "process.parent.interpreter.file.layer.digest":
+		return "", nil
+	case "process.parent.interpreter.file.layer.path":
+		return "", nil
 	case "process.parent.interpreter.file.mode":
 		return "", nil
 	case "process.parent.interpreter.file.modification_time":
@@ -29600,10 +36530,22 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "", nil
 	case "process.parent.is_thread":
 		return "", nil
+	case "process.parent.ns.mnt":
+		return "", nil
+	case "process.parent.ns.pid":
+		return "", nil
+	case "process.parent.ns.user":
+		return "", nil
+	case "process.parent.ns.uts":
+		return "", nil
 	case "process.parent.pid":
 		return "", nil
 	case "process.parent.ppid":
 		return "", nil
+	case "process.parent.systemd.slice":
+		return "", nil
+	case "process.parent.systemd.unit":
+		return "", nil
 	case "process.parent.tid":
 		return "", nil
 	case "process.parent.tty_name":
@@ -29622,6 +36564,10 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "", nil
 	case "process.ppid":
 		return "", nil
+	case "process.systemd.slice":
+		return "", nil
+	case "process.systemd.unit":
+		return "", nil
 	case "process.tid":
 		return "", nil
 	case "process.tty_name":
@@ -29658,6 +36604,12 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "ptrace", nil
 	case "ptrace.tracee.ancestors.cap_permitted":
 		return "ptrace", nil
+	case "ptrace.tracee.ancestors.cgroup.ancestors":
+		return "ptrace", nil
+	case "ptrace.tracee.ancestors.cgroup.controllers":
+		return "ptrace", nil
+	case "ptrace.tracee.ancestors.cgroup.cpu.usage":
+		return "ptrace", nil
 	case "ptrace.tracee.ancestors.cgroup.file.inode":
 		return "ptrace", nil
 	case "ptrace.tracee.ancestors.cgroup.file.mount_id":
@@ -29666,6 +36618,20 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "ptrace", nil
 	case "ptrace.tracee.ancestors.cgroup.manager":
 		return "ptrace", nil
+	case "ptrace.tracee.ancestors.cgroup.memory.current":
+		return "ptrace", nil
+	case "ptrace.tracee.ancestors.cgroup.memory.high_exceeded":
+		return "ptrace", nil
+	case "ptrace.tracee.ancestors.cgroup.pids.current":
+		return "ptrace", nil
+	case "ptrace.tracee.ancestors.cloud_credentials.providers":
+		return "ptrace", nil
+	case "ptrace.tracee.ancestors.cloud_credentials.resources":
+		return "ptrace", nil
+	case "ptrace.tracee.ancestors.cloud_credentials.types":
+		return "ptrace", nil
+	case "ptrace.tracee.ancestors.cmdline_obfuscation_score":
+		return "ptrace", nil
 	case "ptrace.tracee.ancestors.comm":
 		return "ptrace", nil
 	case "ptrace.tracee.ancestors.container.id":
@@ -29676,6 +36642,14 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "ptrace", nil
 	case "ptrace.tracee.ancestors.egroup":
 		return "ptrace", nil
+	case "ptrace.tracee.ancestors.elf.build_id":
+		return "ptrace", nil
+	case "ptrace.tracee.ancestors.elf.interpreter":
+		return "ptrace", nil
+	case "ptrace.tracee.ancestors.elf.is_static":
+		return "ptrace", nil
+	case "ptrace.tracee.ancestors.elf.is_stripped":
+		return "ptrace", nil
 	case "ptrace.tracee.ancestors.envp":
 		return "ptrace", nil
 	case "ptrace.tracee.ancestors.envs":
@@ -29700,6 +36674,10 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "ptrace", nil
 	case "ptrace.tracee.ancestors.file.inode":
 		return "ptrace", nil
+	case "ptrace.tracee.ancestors.file.layer.digest":
+		return "ptrace", nil
+	case "ptrace.tracee.ancestors.file.layer.path":
+		return "ptrace", nil
 	case "ptrace.tracee.ancestors.file.mode":
 		return "ptrace", nil
 	case "ptrace.tracee.ancestors.file.modification_time":
@@ -29752,6 +36730,10 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "ptrace", nil
 	case "ptrace.tracee.ancestors.interpreter.file.inode":
 		return "ptrace", nil
+	case "ptrace.tracee.ancestors.interpreter.file.layer.digest":
+		return "ptrace", nil
+	case "ptrace.tracee.ancestors.interpreter.file.layer.path":
+		return "ptrace", nil
 	case "ptrace.tracee.ancestors.interpreter.file.mode":
 		return "ptrace", nil
 	case "ptrace.tracee.ancestors.interpreter.file.modification_time":
@@ -29786,10 +36768,22 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "ptrace", nil
 	case "ptrace.tracee.ancestors.length":
 		return "ptrace", nil
+	case "ptrace.tracee.ancestors.ns.mnt":
+		return "ptrace", nil
+	case "ptrace.tracee.ancestors.ns.pid":
+		return "ptrace", nil
+	case "ptrace.tracee.ancestors.ns.user":
+		return "ptrace", nil
+	case "ptrace.tracee.ancestors.ns.uts":
+		return "ptrace", nil
 	case "ptrace.tracee.ancestors.pid":
 		return "ptrace", nil
 	case "ptrace.tracee.ancestors.ppid":
 		return "ptrace", nil
+	case "ptrace.tracee.ancestors.systemd.slice":
+		return "ptrace", nil
+	case "ptrace.tracee.ancestors.systemd.unit":
+		return "ptrace", nil
 	case "ptrace.tracee.ancestors.tid":
 		return "ptrace", nil
 	case "ptrace.tracee.ancestors.tty_name":
@@ -29822,6 +36816,12 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "ptrace", nil
 	case "ptrace.tracee.cap_permitted":
 		return "ptrace", nil
+	case "ptrace.tracee.cgroup.ancestors":
+		return "ptrace", nil
+	case "ptrace.tracee.cgroup.controllers":
+		return "ptrace", nil
+	case "ptrace.tracee.cgroup.cpu.usage":
+		return "ptrace", nil
 	case "ptrace.tracee.cgroup.file.inode":
 		return "ptrace", nil
 	case "ptrace.tracee.cgroup.file.mount_id":
@@ -29830,6 +36830,20 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "ptrace", nil
 	case "ptrace.tracee.cgroup.manager":
 		return "ptrace", nil
+	case "ptrace.tracee.cgroup.memory.current":
+		return "ptrace", nil
+	case "ptrace.tracee.cgroup.memory.high_exceeded":
+		return "ptrace", nil
+	case "ptrace.tracee.cgroup.pids.current":
+		return "ptrace", nil
+	case "ptrace.tracee.cloud_credentials.providers":
+		return "ptrace", nil
+	case "ptrace.tracee.cloud_credentials.resources":
+		return "ptrace", nil
+	case "ptrace.tracee.cloud_credentials.types":
+		return "ptrace", nil
+	case "ptrace.tracee.cmdline_obfuscation_score":
+		return "ptrace", nil
 	case "ptrace.tracee.comm":
 		return "ptrace", nil
 	case "ptrace.tracee.container.id":
@@ -29840,6 +36854,14 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "ptrace", nil
 	case "ptrace.tracee.egroup":
 		return "ptrace", nil
+	case "ptrace.tracee.elf.build_id":
+		return "ptrace", nil
+	case "ptrace.tracee.elf.interpreter":
+		return "ptrace", nil
+	case "ptrace.tracee.elf.is_static":
+		return "ptrace", nil
+	case "ptrace.tracee.elf.is_stripped":
+		return "ptrace", nil
 	case "ptrace.tracee.envp":
 		return "ptrace", nil
 	case "ptrace.tracee.envs":
@@ -29864,6 +36886,10 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "ptrace", nil
 	case "ptrace.tracee.file.inode":
 		return "ptrace", nil
+	case "ptrace.tracee.file.layer.digest":
+		return "ptrace", nil
+	case "ptrace.tracee.file.layer.path":
+		return "ptrace", nil
 	case "ptrace.tracee.file.mode":
 		return "ptrace", nil
 	case "ptrace.tracee.file.modification_time":
@@ -29916,6 +36942,10 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "ptrace", nil
 	case "ptrace.tracee.interpreter.file.inode":
 		return "ptrace", nil
+	case "ptrace.tracee.interpreter.file.layer.digest":
+		return "ptrace", nil
+	case "ptrace.tracee.interpreter.file.layer.path":
+		return "ptrace", nil
 	case "ptrace.tracee.interpreter.file.mode":
 		return "ptrace", nil
 	case "ptrace.tracee.interpreter.file.modification_time":
@@ -29948,6 +36978,14 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "ptrace", nil
 	case "ptrace.tracee.is_thread":
 		return "ptrace", nil
+	case "ptrace.tracee.ns.mnt":
+		return "ptrace", nil
+	case "ptrace.tracee.ns.pid":
+		return "ptrace", nil
+	case "ptrace.tracee.ns.user":
+		return "ptrace", nil
+	case "ptrace.tracee.ns.uts":
+		return "ptrace", nil
 	case "ptrace.tracee.parent.args":
 		return "ptrace", nil
 	case "ptrace.tracee.parent.args_flags":
@@ -29966,6 +37004,12 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "ptrace", nil
 	case "ptrace.tracee.parent.cap_permitted":
 		return "ptrace", nil
+	case "ptrace.tracee.parent.cgroup.ancestors":
+		return "ptrace", nil
+	case "ptrace.tracee.parent.cgroup.controllers":
+		return "ptrace", nil
+	case "ptrace.tracee.parent.cgroup.cpu.usage":
+		return "ptrace", nil
 	case "ptrace.tracee.parent.cgroup.file.inode":
 		return "ptrace", nil
 	case "ptrace.tracee.parent.cgroup.file.mount_id":
@@ -29974,6 +37018,20 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "ptrace", nil
 	case "ptrace.tracee.parent.cgroup.manager":
 		return "ptrace", nil
+	case "ptrace.tracee.parent.cgroup.memory.current":
+		return "ptrace", nil
+	case "ptrace.tracee.parent.cgroup.memory.high_exceeded":
+		return "ptrace", nil
+	case "ptrace.tracee.parent.cgroup.pids.current":
+		return "ptrace", nil
+	case "ptrace.tracee.parent.cloud_credentials.providers":
+		return "ptrace", nil
+	case "ptrace.tracee.parent.cloud_credentials.resources":
+		return "ptrace", nil
+	case "ptrace.tracee.parent.cloud_credentials.types":
+		return "ptrace", nil
+	case "ptrace.tracee.parent.cmdline_obfuscation_score":
+		return "ptrace", nil
 	case "ptrace.tracee.parent.comm":
 		return "ptrace", nil
 	case "ptrace.tracee.parent.container.id":
@@ -29984,6 +37042,14 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "ptrace", nil
 	case "ptrace.tracee.parent.egroup":
 		return "ptrace", nil
+	case "ptrace.tracee.parent.elf.build_id":
+		return "ptrace", nil
+	case "ptrace.tracee.parent.elf.interpreter":
+		return "ptrace", nil
+	case "ptrace.tracee.parent.elf.is_static":
+		return "ptrace", nil
+	case "ptrace.tracee.parent.elf.is_stripped":
+		return "ptrace", nil
 	case "ptrace.tracee.parent.envp":
 		return "ptrace", nil
 	case "ptrace.tracee.parent.envs":
@@ -30008,6 +37074,10 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "ptrace", nil
 	case "ptrace.tracee.parent.file.inode":
 		return "ptrace", nil
+	case "ptrace.tracee.parent.file.layer.digest":
+		return "ptrace", nil
+	case "ptrace.tracee.parent.file.layer.path":
+		return "ptrace", nil
 	case "ptrace.tracee.parent.file.mode":
 		return "ptrace", nil
 	case "ptrace.tracee.parent.file.modification_time":
@@ -30060,6 +37130,10 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "ptrace", nil
 	case "ptrace.tracee.parent.interpreter.file.inode":
 		return "ptrace", nil
+	case "ptrace.tracee.parent.interpreter.file.layer.digest":
+		return "ptrace", nil
+	case "ptrace.tracee.parent.interpreter.file.layer.path":
+		return "ptrace", nil
 	case "ptrace.tracee.parent.interpreter.file.mode":
 		return "ptrace", nil
 	case "ptrace.tracee.parent.interpreter.file.modification_time":
@@ -30092,10 +37166,22 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "ptrace", nil
 	case "ptrace.tracee.parent.is_thread":
 		return "ptrace", nil
+	case "ptrace.tracee.parent.ns.mnt":
+		return "ptrace", nil
+	case "ptrace.tracee.parent.ns.pid":
+		return "ptrace", nil
+	case "ptrace.tracee.parent.ns.user":
+		return "ptrace", nil
+	case "ptrace.tracee.parent.ns.uts":
+		return "ptrace", nil
 	case "ptrace.tracee.parent.pid":
 		return "ptrace", nil
 	case "ptrace.tracee.parent.ppid":
 		return "ptrace", nil
+	case "ptrace.tracee.parent.systemd.slice":
+		return "ptrace", nil
+	case "ptrace.tracee.parent.systemd.unit":
+		return "ptrace", nil
 	case "ptrace.tracee.parent.tid":
 		return "ptrace", nil
 	case "ptrace.tracee.parent.tty_name":
@@ -30114,6 +37200,10 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "ptrace", nil
 	case "ptrace.tracee.ppid":
 		return "ptrace", nil
+	case "ptrace.tracee.systemd.slice":
+		return "ptrace", nil
+	case "ptrace.tracee.systemd.unit":
+		return "ptrace", nil
 	case "ptrace.tracee.tid":
 		return "ptrace", nil
 	case "ptrace.tracee.tty_name":
@@ -30146,6 +37236,10 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "removexattr", nil
 	case "removexattr.file.inode":
 		return "removexattr", nil
+	case "removexattr.file.layer.digest":
+		return "removexattr", nil
+	case "removexattr.file.layer.path":
+		return "removexattr", nil
 	case "removexattr.file.mode":
 		return "removexattr", nil
 	case "removexattr.file.modification_time":
@@ -30190,6 +37284,10 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "rename", nil
 	case "rename.file.destination.inode":
 		return "rename", nil
+	case "rename.file.destination.layer.digest":
+		return "rename", nil
+	case "rename.file.destination.layer.path":
+		return "rename", nil
 	case "rename.file.destination.mode":
 		return "rename", nil
 	case "rename.file.destination.modification_time":
@@ -30228,6 +37326,10 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "rename", nil
 	case "rename.file.inode":
 		return "rename", nil
+	case "rename.file.layer.digest":
+		return "rename", nil
+	case "rename.file.layer.path":
+		return "rename", nil
 	case "rename.file.mode":
 		return "rename", nil
 	case "rename.file.modification_time":
@@ -30274,6 +37376,10 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "rmdir", nil
 	case "rmdir.file.inode":
 		return "rmdir", nil
+	case "rmdir.file.layer.digest":
+		return "rmdir", nil
+	case "rmdir.file.layer.path":
+		return "rmdir", nil
 	case "rmdir.file.mode":
 		return "rmdir", nil
 	case "rmdir.file.modification_time":
@@ -30352,6 +37458,10 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "setxattr", nil
 	case "setxattr.file.inode":
 		return "setxattr", nil
+	case "setxattr.file.layer.digest":
+		return "setxattr", nil
+	case "setxattr.file.layer.path":
+		return "setxattr", nil
 	case "setxattr.file.mode":
 		return "setxattr", nil
 	case "setxattr.file.modification_time":
@@ -30402,6 +37512,12 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "signal", nil
 	case "signal.target.ancestors.cap_permitted":
 		return "signal", nil
+	case "signal.target.ancestors.cgroup.ancestors":
+		return "signal", nil
+	case "signal.target.ancestors.cgroup.controllers":
+		return "signal", nil
+	case "signal.target.ancestors.cgroup.cpu.usage":
+		return "signal", nil
 	case "signal.target.ancestors.cgroup.file.inode":
 		return "signal", nil
 	case "signal.target.ancestors.cgroup.file.mount_id":
@@ -30410,6 +37526,20 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "signal", nil
 	case "signal.target.ancestors.cgroup.manager":
 		return "signal", nil
+	case "signal.target.ancestors.cgroup.memory.current":
+		return "signal", nil
+	case "signal.target.ancestors.cgroup.memory.high_exceeded":
+		return "signal", nil
+	case "signal.target.ancestors.cgroup.pids.current":
+		return "signal", nil
+	case "signal.target.ancestors.cloud_credentials.providers":
+		return "signal", nil
+	case "signal.target.ancestors.cloud_credentials.resources":
+		return "signal", nil
+	case "signal.target.ancestors.cloud_credentials.types":
+		return "signal", nil
+	case "signal.target.ancestors.cmdline_obfuscation_score":
+		return "signal", nil
 	case "signal.target.ancestors.comm":
 		return "signal", nil
 	case "signal.target.ancestors.container.id":
@@ -30420,6 +37550,14 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "signal", nil
 	case "signal.target.ancestors.egroup":
 		return "signal", nil
+	case "signal.target.ancestors.elf.build_id":
+		return "signal", nil
+	case "signal.target.ancestors.elf.interpreter":
+		return "signal", nil
+	case "signal.target.ancestors.elf.is_static":
+		return "signal", nil
+	case "signal.target.ancestors.elf.is_stripped":
+		return "signal", nil
 	case "signal.target.ancestors.envp":
 		return "signal", nil
 	case "signal.target.ancestors.envs":
@@ -30444,6 +37582,10 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "signal", nil
 	case "signal.target.ancestors.file.inode":
 		return "signal", nil
+	case "signal.target.ancestors.file.layer.digest":
+		return "signal", nil
+	case "signal.target.ancestors.file.layer.path":
+		return "signal", nil
 	case "signal.target.ancestors.file.mode":
 		return "signal", nil
 	case "signal.target.ancestors.file.modification_time":
@@ -30496,6 +37638,10 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "signal", nil
 	case "signal.target.ancestors.interpreter.file.inode":
 		return "signal", nil
+	case "signal.target.ancestors.interpreter.file.layer.digest":
+		return "signal", nil
+	case "signal.target.ancestors.interpreter.file.layer.path":
+		return "signal", nil
 	case "signal.target.ancestors.interpreter.file.mode":
 		return "signal", nil
 	case "signal.target.ancestors.interpreter.file.modification_time":
@@ -30530,10 +37676,22 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "signal", nil
 	case "signal.target.ancestors.length":
 		return "signal", nil
+	case "signal.target.ancestors.ns.mnt":
+		return "signal", nil
+	case "signal.target.ancestors.ns.pid":
+		return "signal", nil
+	case "signal.target.ancestors.ns.user":
+		return "signal", nil
+	case "signal.target.ancestors.ns.uts":
+		return "signal", nil
 	case "signal.target.ancestors.pid":
 		return "signal", nil
 	case "signal.target.ancestors.ppid":
 		return "signal", nil
+	case "signal.target.ancestors.systemd.slice":
+		return "signal", nil
+	case "signal.target.ancestors.systemd.unit":
+		return "signal", nil
 	case "signal.target.ancestors.tid":
 		return "signal", nil
 	case "signal.target.ancestors.tty_name":
@@ -30566,6 +37724,12 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "signal", nil
 	case "signal.target.cap_permitted":
 		return "signal", nil
+	case "signal.target.cgroup.ancestors":
+		return "signal", nil
+	case "signal.target.cgroup.controllers":
+		return "signal", nil
+	case "signal.target.cgroup.cpu.usage":
+		return "signal", nil
 	case "signal.target.cgroup.file.inode":
 		return "signal", nil
 	case "signal.target.cgroup.file.mount_id":
@@ -30574,6 +37738,20 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "signal", nil
 	case "signal.target.cgroup.manager":
 		return "signal", nil
+	case "signal.target.cgroup.memory.current":
+		return "signal", nil
+	case "signal.target.cgroup.memory.high_exceeded":
+		return "signal", nil
+	case "signal.target.cgroup.pids.current":
+		return "signal", nil
+	case "signal.target.cloud_credentials.providers":
+		return "signal", nil
+	case "signal.target.cloud_credentials.resources":
+		return "signal", nil
+	case "signal.target.cloud_credentials.types":
+		return "signal", nil
+	case "signal.target.cmdline_obfuscation_score":
+		return "signal", nil
 	case "signal.target.comm":
 		return "signal", nil
 	case "signal.target.container.id":
@@ -30584,6 +37762,14 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "signal", nil
 	case "signal.target.egroup":
 		return "signal", nil
+	case "signal.target.elf.build_id":
+		return "signal", nil
+	case "signal.target.elf.interpreter":
+		return "signal", nil
+	case "signal.target.elf.is_static":
+		return "signal", nil
+	case "signal.target.elf.is_stripped":
+		return "signal", nil
 	case "signal.target.envp":
 		return "signal", nil
 	case "signal.target.envs":
@@ -30608,6 +37794,10 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "signal", nil
 	case "signal.target.file.inode":
 		return "signal", nil
+	case "signal.target.file.layer.digest":
+		return "signal", nil
+	case "signal.target.file.layer.path":
+		return "signal", nil
 	case "signal.target.file.mode":
 		return "signal", nil
 	case "signal.target.file.modification_time":
@@ -30660,6 +37850,10 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "signal", nil
 	case "signal.target.interpreter.file.inode":
 		return "signal", nil
+	case "signal.target.interpreter.file.layer.digest":
+		return "signal", nil
+	case "signal.target.interpreter.file.layer.path":
+		return "signal", nil
 	case "signal.target.interpreter.file.mode":
 		return "signal", nil
 	case "signal.target.interpreter.file.modification_time":
@@ -30692,6 +37886,14 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "signal", nil
 	case "signal.target.is_thread":
 		return "signal", nil
+	case "signal.target.ns.mnt":
+		return "signal", nil
+	case "signal.target.ns.pid":
+		return "signal", nil
+	case "signal.target.ns.user":
+		return "signal", nil
+	case "signal.target.ns.uts":
+		return "signal", nil
 	case "signal.target.parent.args":
 		return "signal", nil
 	case "signal.target.parent.args_flags":
@@ -30710,6 +37912,12 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "signal", nil
 	case "signal.target.parent.cap_permitted":
 		return "signal", nil
+	case "signal.target.parent.cgroup.ancestors":
+		return "signal", nil
+	case "signal.target.parent.cgroup.controllers":
+		return "signal", nil
+	case "signal.target.parent.cgroup.cpu.usage":
+		return "signal", nil
 	case "signal.target.parent.cgroup.file.inode":
 		return "signal", nil
 	case "signal.target.parent.cgroup.file.mount_id":
@@ -30718,6 +37926,20 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "signal", nil
 	case "signal.target.parent.cgroup.manager":
 		return "signal", nil
+	case "signal.target.parent.cgroup.memory.current":
+		return "signal", nil
+	case "signal.target.parent.cgroup.memory.high_exceeded":
+		return "signal", nil
+	case "signal.target.parent.cgroup.pids.current":
+		return "signal", nil
+	case "signal.target.parent.cloud_credentials.providers":
+		return "signal", nil
+	case "signal.target.parent.cloud_credentials.resources":
+		return "signal", nil
+	case "signal.target.parent.cloud_credentials.types":
+		return "signal", nil
+	case "signal.target.parent.cmdline_obfuscation_score":
+		return "signal", nil
 	case "signal.target.parent.comm":
 		return "signal", nil
 	case "signal.target.parent.container.id":
@@ -30728,6 +37950,14 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "signal", nil
 	case "signal.target.parent.egroup":
 		return "signal", nil
+	case "signal.target.parent.elf.build_id":
+		return "signal", nil
+	case "signal.target.parent.elf.interpreter":
+		return "signal", nil
+	case "signal.target.parent.elf.is_static":
+		return "signal", nil
+	case "signal.target.parent.elf.is_stripped":
+		return "signal", nil
 	case "signal.target.parent.envp":
 		return "signal", nil
 	case "signal.target.parent.envs":
@@ -30752,6 +37982,10 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "signal", nil
 	case "signal.target.parent.file.inode":
 		return "signal", nil
+	case "signal.target.parent.file.layer.digest":
+		return "signal", nil
+	case "signal.target.parent.file.layer.path":
+		return "signal", nil
 	case "signal.target.parent.file.mode":
 		return "signal", nil
 	case "signal.target.parent.file.modification_time":
@@ -30804,6 +38038,10 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "signal", nil
 	case "signal.target.parent.interpreter.file.inode":
 		return "signal", nil
+	case "signal.target.parent.interpreter.file.layer.digest":
+		return "signal", nil
+	case "signal.target.parent.interpreter.file.layer.path":
+		return "signal", nil
 	case "signal.target.parent.interpreter.file.mode":
 		return "signal", nil
 	case "signal.target.parent.interpreter.file.modification_time":
@@ -30836,10 +38074,22 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "signal", nil
 	case "signal.target.parent.is_thread":
 		return "signal", nil
+	case "signal.target.parent.ns.mnt":
+		return "signal", nil
+	case "signal.target.parent.ns.pid":
+		return "signal", nil
+	case "signal.target.parent.ns.user":
+		return "signal", nil
+	case "signal.target.parent.ns.uts":
+		return "signal", nil
 	case "signal.target.parent.pid":
 		return "signal", nil
 	case "signal.target.parent.ppid":
 		return "signal", nil
+	case "signal.target.parent.systemd.slice":
+		return "signal", nil
+	case "signal.target.parent.systemd.unit":
+		return "signal", nil
 	case "signal.target.parent.tid":
 		return "signal", nil
 	case "signal.target.parent.tty_name":
@@ -30858,6 +38108,10 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "signal", nil
 	case "signal.target.ppid":
 		return "signal", nil
+	case "signal.target.systemd.slice":
+		return "signal", nil
+	case "signal.target.systemd.unit":
+		return "signal", nil
 	case "signal.target.tid":
 		return "signal", nil
 	case "signal.target.tty_name":
@@ -30888,6 +38142,10 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "splice", nil
 	case "splice.file.inode":
 		return "splice", nil
+	case "splice.file.layer.digest":
+		return "splice", nil
+	case "splice.file.layer.path":
+		return "splice", nil
 	case "splice.file.mode":
 		return "splice", nil
 	case "splice.file.modification_time":
@@ -30934,6 +38192,10 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "unlink", nil
 	case "unlink.file.inode":
 		return "unlink", nil
+	case "unlink.file.layer.digest":
+		return "unlink", nil
+	case "unlink.file.layer.path":
+		return "unlink", nil
 	case "unlink.file.mode":
 		return "unlink", nil
 	case "unlink.file.modification_time":
@@ -30988,6 +38250,10 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "utimes", nil
 	case "utimes.file.inode":
 		return "utimes", nil
+	case "utimes.file.layer.digest":
+		return "utimes", nil
+	case "utimes.file.layer.path":
+		return "utimes", nil
 	case "utimes.file.mode":
 		return "utimes", nil
 	case "utimes.file.modification_time":
@@ -31035,6 +38301,10 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Int, nil
 	case "bpf.cmd":
 		return reflect.Int, nil
+	case "bpf.kernel_security.lockdown":
+		return reflect.String, nil
+	case "bpf.kernel_security.secure_boot":
+		return reflect.String, nil
 	case "bpf.map.name":
 		return reflect.String, nil
 	case "bpf.map.type":
@@ -31055,6 +38325,12 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Int, nil
 	case "capset.cap_permitted":
 		return reflect.Int, nil
+	case "cgroup.ancestors":
+		return reflect.String, nil
+	case "cgroup.controllers":
+		return reflect.String, nil
+	case "cgroup.cpu.usage":
+		return reflect.Int, nil
 	case "cgroup.file.inode":
 		return reflect.Int, nil
 	case "cgroup.file.mount_id":
@@ -31063,6 +38339,12 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.String, nil
 	case "cgroup.manager":
 		return reflect.String, nil
+	case "cgroup.memory.current":
+		return reflect.Int, nil
+	case "cgroup.memory.high_exceeded":
+		return reflect.Bool, nil
+	case "cgroup.pids.current":
+		return reflect.Int, nil
 	case "chdir.file.change_time":
 		return reflect.Int, nil
 	case "chdir.file.filesystem":
@@ -31077,6 +38359,10 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Bool, nil
 	case "chdir.file.inode":
 		return reflect.Int, nil
+	case "chdir.file.layer.digest":
+		return reflect.String, nil
+	case "chdir.file.layer.path":
+		return reflect.String, nil
 	case "chdir.file.mode":
 		return reflect.Int, nil
 	case "chdir.file.modification_time":
@@ -31125,6 +38411,10 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Bool, nil
 	case "chmod.file.inode":
 		return reflect.Int, nil
+	case "chmod.file.layer.digest":
+		return reflect.String, nil
+	case "chmod.file.layer.path":
+		return reflect.String, nil
 	case "chmod.file.mode":
 		return reflect.Int, nil
 	case "chmod.file.modification_time":
@@ -31145,6 +38435,10 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.String, nil
 	case "chmod.file.path.length":
 		return reflect.Int, nil
+	case "chmod.file.previous_mode":
+		return reflect.Int, nil
+	case "chmod.file.previous_rights":
+		return reflect.Int, nil
 	case "chmod.file.rights":
 		return reflect.Int, nil
 	case "chmod.file.uid":
@@ -31179,6 +38473,10 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Bool, nil
 	case "chown.file.inode":
 		return reflect.Int, nil
+	case "chown.file.layer.digest":
+		return reflect.String, nil
+	case "chown.file.layer.path":
+		return reflect.String, nil
 	case "chown.file.mode":
 		return reflect.Int, nil
 	case "chown.file.modification_time":
@@ -31199,6 +38497,10 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.String, nil
 	case "chown.file.path.length":
 		return reflect.Int, nil
+	case "chown.file.previous_gid":
+		return reflect.Int, nil
+	case "chown.file.previous_uid":
+		return reflect.Int, nil
 	case "chown.file.rights":
 		return reflect.Int, nil
 	case "chown.file.uid":
@@ -31245,6 +38547,10 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Int, nil
 	case "dns.question.count":
 		return reflect.Int, nil
+	case "dns.question.domain_query_rate":
+		return reflect.Int, nil
+	case "dns.question.label_entropy":
+		return reflect.Int, nil
 	case "dns.question.length":
 		return reflect.Int, nil
 	case "dns.question.name":
@@ -31253,6 +38559,8 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Int, nil
 	case "dns.question.type":
 		return reflect.Int, nil
+	case "dns.question.unique_subdomains":
+		return reflect.Int, nil
 	case "event.async":
 		return reflect.Bool, nil
 	case "event.hostname":
@@ -31283,6 +38591,12 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Int, nil
 	case "exec.cap_permitted":
 		return reflect.Int, nil
+	case "exec.cgroup.ancestors":
+		return reflect.String, nil
+	case "exec.cgroup.controllers":
+		return reflect.String, nil
+	case "exec.cgroup.cpu.usage":
+		return reflect.Int, nil
 	case "exec.cgroup.file.inode":
 		return reflect.Int, nil
 	case "exec.cgroup.file.mount_id":
@@ -31291,6 +38605,20 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.String, nil
 	case "exec.cgroup.manager":
 		return reflect.String, nil
+	case "exec.cgroup.memory.current":
+		return reflect.Int, nil
+	case "exec.cgroup.memory.high_exceeded":
+		return reflect.Bool, nil
+	case "exec.cgroup.pids.current":
+		return reflect.Int, nil
+	case "exec.cloud_credentials.providers":
+		return reflect.String, nil
+	case "exec.cloud_credentials.resources":
+		return reflect.String, nil
+	case "exec.cloud_credentials.types":
+		return reflect.String, nil
+	case "exec.cmdline_obfuscation_score":
+		return reflect.Int, nil
 	case "exec.comm":
 		return reflect.String, nil
 	case "exec.container.id":
@@ -31301,6 +38629,14 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Int, nil
 	case "exec.egroup":
 		return reflect.String, nil
+	case "exec.elf.build_id":
+		return reflect.String, nil
+	case "exec.elf.interpreter":
+		return reflect.String, nil
+	case "exec.elf.is_static":
+		return reflect.Bool, nil
+	case "exec.elf.is_stripped":
+		return reflect.Bool, nil
 	case "exec.envp":
 		return reflect.String, nil
 	case "exec.envs":
@@ -31325,6 +38661,10 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Bool, nil
 	case "exec.file.inode":
 		return reflect.Int, nil
+	case "exec.file.layer.digest":
+		return reflect.String, nil
+	case "exec.file.layer.path":
+		return reflect.String, nil
 	case "exec.file.mode":
 		return reflect.Int, nil
 	case "exec.file.modification_time":
@@ -31377,6 +38717,10 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Bool, nil
 	case "exec.interpreter.file.inode":
 		return reflect.Int, nil
+	case "exec.interpreter.file.layer.digest":
+		return reflect.String, nil
+	case "exec.interpreter.file.layer.path":
+		return reflect.String, nil
 	case "exec.interpreter.file.mode":
 		return reflect.Int, nil
 	case "exec.interpreter.file.modification_time":
@@ -31409,12 +38753,24 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Bool, nil
 	case "exec.is_thread":
 		return reflect.Bool, nil
+	case "exec.ns.mnt":
+		return reflect.Int, nil
+	case "exec.ns.pid":
+		return reflect.Int, nil
+	case "exec.ns.user":
+		return reflect.Int, nil
+	case "exec.ns.uts":
+		return reflect.Int, nil
 	case "exec.pid":
 		return reflect.Int, nil
 	case "exec.ppid":
 		return reflect.Int, nil
 	case "exec.syscall.path":
 		return reflect.String, nil
+	case "exec.systemd.slice":
+		return reflect.String, nil
+	case "exec.systemd.unit":
+		return reflect.String, nil
 	case "exec.tid":
 		return reflect.Int, nil
 	case "exec.tty_name":
@@ -31449,6 +38805,12 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Int, nil
 	case "exit.cause":
 		return reflect.Int, nil
+	case "exit.cgroup.ancestors":
+		return reflect.String, nil
+	case "exit.cgroup.controllers":
+		return reflect.String, nil
+	case "exit.cgroup.cpu.usage":
+		return reflect.Int, nil
 	case "exit.cgroup.file.inode":
 		return reflect.Int, nil
 	case "exit.cgroup.file.mount_id":
@@ -31457,6 +38819,20 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.String, nil
 	case "exit.cgroup.manager":
 		return reflect.String, nil
+	case "exit.cgroup.memory.current":
+		return reflect.Int, nil
+	case "exit.cgroup.memory.high_exceeded":
+		return reflect.Bool, nil
+	case "exit.cgroup.pids.current":
+		return reflect.Int, nil
+	case "exit.cloud_credentials.providers":
+		return reflect.String, nil
+	case "exit.cloud_credentials.resources":
+		return reflect.String, nil
+	case "exit.cloud_credentials.types":
+		return reflect.String, nil
+	case "exit.cmdline_obfuscation_score":
+		return reflect.Int, nil
 	case "exit.code":
 		return reflect.Int, nil
 	case "exit.comm":
@@ -31469,6 +38845,14 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Int, nil
 	case "exit.egroup":
 		return reflect.String, nil
+	case "exit.elf.build_id":
+		return reflect.String, nil
+	case "exit.elf.interpreter":
+		return reflect.String, nil
+	case "exit.elf.is_static":
+		return reflect.Bool, nil
+	case "exit.elf.is_stripped":
+		return reflect.Bool, nil
 	case "exit.envp":
 		return reflect.String, nil
 	case "exit.envs":
@@ -31493,6 +38877,10 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Bool, nil
 	case "exit.file.inode":
 		return reflect.Int, nil
+	case "exit.file.layer.digest":
+		return reflect.String, nil
+	case "exit.file.layer.path":
+		return reflect.String, nil
 	case "exit.file.mode":
 		return reflect.Int, nil
 	case "exit.file.modification_time":
@@ -31545,6 +38933,10 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Bool, nil
 	case "exit.interpreter.file.inode":
 		return reflect.Int, nil
+	case "exit.interpreter.file.layer.digest":
+		return reflect.String, nil
+	case "exit.interpreter.file.layer.path":
+		return reflect.String, nil
 	case "exit.interpreter.file.mode":
 		return reflect.Int, nil
 	case "exit.interpreter.file.modification_time":
@@ -31577,10 +38969,22 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Bool, nil
 	case "exit.is_thread":
 		return reflect.Bool, nil
+	case "exit.ns.mnt":
+		return reflect.Int, nil
+	case "exit.ns.pid":
+		return reflect.Int, nil
+	case "exit.ns.user":
+		return reflect.Int, nil
+	case "exit.ns.uts":
+		return reflect.Int, nil
 	case "exit.pid":
 		return reflect.Int, nil
 	case "exit.ppid":
 		return reflect.Int, nil
+	case "exit.systemd.slice":
+		return reflect.String, nil
+	case "exit.systemd.unit":
+		return reflect.String, nil
 	case "exit.tid":
 		return reflect.Int, nil
 	case "exit.tty_name":
@@ -31595,6 +38999,12 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.String, nil
 	case "exit.user_session.k8s_username":
 		return reflect.String, nil
+	case "imds.access_token.expires_in":
+		return reflect.Int, nil
+	case "imds.access_token.resource":
+		return reflect.String, nil
+	case "imds.access_token.type":
+		return reflect.String, nil
 	case "imds.aws.is_imds_v2":
 		return reflect.Bool, nil
 	case "imds.aws.security_credentials.type":
@@ -31627,6 +39037,10 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Bool, nil
 	case "link.file.destination.inode":
 		return reflect.Int, nil
+	case "link.file.destination.layer.digest":
+		return reflect.String, nil
+	case "link.file.destination.layer.path":
+		return reflect.String, nil
 	case "link.file.destination.mode":
 		return reflect.Int, nil
 	case "link.file.destination.modification_time":
@@ -31665,6 +39079,10 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Bool, nil
 	case "link.file.inode":
 		return reflect.Int, nil
+	case "link.file.layer.digest":
+		return reflect.String, nil
+	case "link.file.layer.path":
+		return reflect.String, nil
 	case "link.file.mode":
 		return reflect.Int, nil
 	case "link.file.modification_time":
@@ -31717,6 +39135,10 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Bool, nil
 	case "load_module.file.inode":
 		return reflect.Int, nil
+	case "load_module.file.layer.digest":
+		return reflect.String, nil
+	case "load_module.file.layer.path":
+		return reflect.String, nil
 	case "load_module.file.mode":
 		return reflect.Int, nil
 	case "load_module.file.modification_time":
@@ -31743,6 +39165,10 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Int, nil
 	case "load_module.file.user":
 		return reflect.String, nil
+	case "load_module.kernel_security.lockdown":
+		return reflect.String, nil
+	case "load_module.kernel_security.secure_boot":
+		return reflect.String, nil
 	case "load_module.loaded_from_memory":
 		return reflect.Bool, nil
 	case "load_module.name":
@@ -31767,6 +39193,10 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Bool, nil
 	case "mkdir.file.inode":
 		return reflect.Int, nil
+	case "mkdir.file.layer.digest":
+		return reflect.String, nil
+	case "mkdir.file.layer.path":
+		return reflect.String, nil
 	case "mkdir.file.mode":
 		return reflect.Int, nil
 	case "mkdir.file.modification_time":
@@ -31809,6 +39239,10 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Bool, nil
 	case "mmap.file.inode":
 		return reflect.Int, nil
+	case "mmap.file.layer.digest":
+		return reflect.String, nil
+	case "mmap.file.layer.path":
+		return reflect.String, nil
 	case "mmap.file.mode":
 		return reflect.Int, nil
 	case "mmap.file.modification_time":
@@ -31837,6 +39271,8 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.String, nil
 	case "mmap.flags":
 		return reflect.Int, nil
+	case "mmap.length":
+		return reflect.Int, nil
 	case "mmap.protection":
 		return reflect.Int, nil
 	case "mmap.retval":
@@ -31857,10 +39293,14 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.String, nil
 	case "mount.syscall.source.path":
 		return reflect.String, nil
+	case "mprotect.is_anonymous":
+		return reflect.Bool, nil
 	case "mprotect.req_protection":
 		return reflect.Int, nil
 	case "mprotect.retval":
 		return reflect.Int, nil
+	case "mprotect.size":
+		return reflect.Int, nil
 	case "mprotect.vm_protection":
 		return reflect.Int, nil
 	case "network.destination.ip":
@@ -31917,6 +39357,10 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Bool, nil
 	case "open.file.inode":
 		return reflect.Int, nil
+	case "open.file.layer.digest":
+		return reflect.String, nil
+	case "open.file.layer.path":
+		return reflect.String, nil
 	case "open.file.mode":
 		return reflect.Int, nil
 	case "open.file.modification_time":
@@ -31995,6 +39439,12 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Int, nil
 	case "process.ancestors.cap_permitted":
 		return reflect.Int, nil
+	case "process.ancestors.cgroup.ancestors":
+		return reflect.String, nil
+	case "process.ancestors.cgroup.controllers":
+		return reflect.String, nil
+	case "process.ancestors.cgroup.cpu.usage":
+		return reflect.Int, nil
 	case "process.ancestors.cgroup.file.inode":
 		return reflect.Int, nil
 	case "process.ancestors.cgroup.file.mount_id":
@@ -32003,6 +39453,20 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.String, nil
 	case "process.ancestors.cgroup.manager":
 		return reflect.String, nil
+	case "process.ancestors.cgroup.memory.current":
+		return reflect.Int, nil
+	case "process.ancestors.cgroup.memory.high_exceeded":
+		return reflect.Bool, nil
+	case "process.ancestors.cgroup.pids.current":
+		return reflect.Int, nil
+	case "process.ancestors.cloud_credentials.providers":
+		return reflect.String, nil
+	case "process.ancestors.cloud_credentials.resources":
+		return reflect.String, nil
+	case "process.ancestors.cloud_credentials.types":
+		return reflect.String, nil
+	case "process.ancestors.cmdline_obfuscation_score":
+		return reflect.Int, nil
 	case "process.ancestors.comm":
 		return reflect.String, nil
 	case "process.ancestors.container.id":
@@ -32013,6 +39477,14 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Int, nil
 	case "process.ancestors.egroup":
 		return reflect.String, nil
+	case "process.ancestors.elf.build_id":
+		return reflect.String, nil
+	case "process.ancestors.elf.interpreter":
+		return reflect.String, nil
+	case "process.ancestors.elf.is_static":
+		return reflect.Bool, nil
+	case "process.ancestors.elf.is_stripped":
+		return reflect.Bool, nil
 	case "process.ancestors.envp":
 		return reflect.String, nil
 	case "process.ancestors.envs":
@@ -32037,6 +39509,10 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Bool, nil
 	case "process.ancestors.file.inode":
 		return reflect.Int, nil
+	case "process.ancestors.file.layer.digest":
+		return reflect.String, nil
+	case "process.ancestors.file.layer.path":
+		return reflect.String, nil
 	case "process.ancestors.file.mode":
 		return reflect.Int, nil
 	case "process.ancestors.file.modification_time":
@@ -32089,6 +39565,10 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Bool, nil
 	case "process.ancestors.interpreter.file.inode":
 		return reflect.Int, nil
+	case "process.ancestors.interpreter.file.layer.digest":
+		return reflect.String, nil
+	case "process.ancestors.interpreter.file.layer.path":
+		return reflect.String, nil
 	case "process.ancestors.interpreter.file.mode":
 		return reflect.Int, nil
 	case "process.ancestors.interpreter.file.modification_time":
@@ -32123,10 +39603,22 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Bool, nil
 	case "process.ancestors.length":
 		return reflect.Int, nil
+	case "process.ancestors.ns.mnt":
+		return reflect.Int, nil
+	case "process.ancestors.ns.pid":
+		return reflect.Int, nil
+	case "process.ancestors.ns.user":
+		return reflect.Int, nil
+	case "process.ancestors.ns.uts":
+		return reflect.Int, nil
 	case "process.ancestors.pid":
 		return reflect.Int, nil
 	case "process.ancestors.ppid":
 		return reflect.Int, nil
+	case "process.ancestors.systemd.slice":
+		return reflect.String, nil
+	case "process.ancestors.systemd.unit":
+		return reflect.String, nil
 	case "process.ancestors.tid":
 		return reflect.Int, nil
 	case "process.ancestors.tty_name":
@@ -32159,6 +39651,12 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Int, nil
 	case "process.cap_permitted":
 		return reflect.Int, nil
+	case "process.cgroup.ancestors":
+		return reflect.String, nil
+	case "process.cgroup.controllers":
+		return reflect.String, nil
+	case "process.cgroup.cpu.usage":
+		return reflect.Int, nil
 	case "process.cgroup.file.inode":
 		return reflect.Int, nil
 	case "process.cgroup.file.mount_id":
@@ -32167,6 +39665,20 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.String, nil
 	case "process.cgroup.manager":
 		return reflect.String, nil
+	case "process.cgroup.memory.current":
+		return reflect.Int, nil
+	case "process.cgroup.memory.high_exceeded":
+		return reflect.Bool, nil
+	case "process.cgroup.pids.current":
+		return reflect.Int, nil
+	case "process.cloud_credentials.providers":
+		return reflect.String, nil
+	case "process.cloud_credentials.resources":
+		return reflect.String, nil
+	case "process.cloud_credentials.types":
+		return reflect.String, nil
+	case "process.cmdline_obfuscation_score":
+		return reflect.Int, nil
 	case "process.comm":
 		return reflect.String, nil
 	case "process.container.id":
@@ -32177,6 +39689,14 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Int, nil
 	case "process.egroup":
 		return reflect.String, nil
+	case "process.elf.build_id":
+		return reflect.String, nil
+	case "process.elf.interpreter":
+		return reflect.String, nil
+	case "process.elf.is_static":
+		return reflect.Bool, nil
+	case "process.elf.is_stripped":
+		return reflect.Bool, nil
 	case "process.envp":
 		return reflect.String, nil
 	case "process.envs":
@@ -32201,6 +39721,10 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Bool, nil
 	case "process.file.inode":
 		return reflect.Int, nil
+	case "process.file.layer.digest":
+		return reflect.String, nil
+	case "process.file.layer.path":
+		return reflect.String, nil
 	case "process.file.mode":
 		return reflect.Int, nil
 	case "process.file.modification_time":
@@ -32253,6 +39777,10 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Bool, nil
 	case "process.interpreter.file.inode":
 		return reflect.Int, nil
+	case "process.interpreter.file.layer.digest":
+		return reflect.String, nil
+	case "process.interpreter.file.layer.path":
+		return reflect.String, nil
 	case "process.interpreter.file.mode":
 		return reflect.Int, nil
 	case "process.interpreter.file.modification_time":
@@ -32285,6 +39813,14 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Bool, nil
 	case "process.is_thread":
 		return reflect.Bool, nil
+	case "process.ns.mnt":
+		return reflect.Int, nil
+	case "process.ns.pid":
+		return reflect.Int, nil
+	case "process.ns.user":
+		return reflect.Int, nil
+	case "process.ns.uts":
+		return reflect.Int, nil
 	case "process.parent.args":
 		return reflect.String, nil
 	case "process.parent.args_flags":
@@ -32303,6 +39839,12 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Int, nil
 	case "process.parent.cap_permitted":
 		return reflect.Int, nil
+	case "process.parent.cgroup.ancestors":
+		return reflect.String, nil
+	case "process.parent.cgroup.controllers":
+		return reflect.String, nil
+	case "process.parent.cgroup.cpu.usage":
+		return reflect.Int, nil
 	case "process.parent.cgroup.file.inode":
 		return reflect.Int, nil
 	case "process.parent.cgroup.file.mount_id":
@@ -32311,6 +39853,20 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.String, nil
 	case "process.parent.cgroup.manager":
 		return reflect.String, nil
+	case "process.parent.cgroup.memory.current":
+		return reflect.Int, nil
+	case "process.parent.cgroup.memory.high_exceeded":
+		return reflect.Bool, nil
+	case "process.parent.cgroup.pids.current":
+		return reflect.Int, nil
+	case "process.parent.cloud_credentials.providers":
+		return reflect.String, nil
+	case "process.parent.cloud_credentials.resources":
+		return reflect.String, nil
+	case "process.parent.cloud_credentials.types":
+		return reflect.String, nil
+	case "process.parent.cmdline_obfuscation_score":
+		return reflect.Int, nil
 	case "process.parent.comm":
 		return reflect.String, nil
 	case "process.parent.container.id":
@@ -32321,6 +39877,14 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Int, nil
 	case "process.parent.egroup":
 		return reflect.String, nil
+	case "process.parent.elf.build_id":
+		return reflect.String, nil
+	case "process.parent.elf.interpreter":
+		return reflect.String, nil
+	case "process.parent.elf.is_static":
+		return reflect.Bool, nil
+	case "process.parent.elf.is_stripped":
+		return reflect.Bool, nil
 	case "process.parent.envp":
 		return reflect.String, nil
 	case "process.parent.envs":
@@ -32345,6 +39909,10 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Bool, nil
 	case "process.parent.file.inode":
 		return reflect.Int, nil
+	case "process.parent.file.layer.digest":
+		return reflect.String, nil
+	case "process.parent.file.layer.path":
+		return reflect.String, nil
 	case "process.parent.file.mode":
 		return reflect.Int, nil
 	case "process.parent.file.modification_time":
@@ -32397,6 +39965,10 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Bool, nil
 	case "process.parent.interpreter.file.inode":
 		return reflect.Int, nil
+	case "process.parent.interpreter.file.layer.digest":
+		return reflect.String, nil
+	case "process.parent.interpreter.file.layer.path":
+		return reflect.String, nil
 	case "process.parent.interpreter.file.mode":
 		return reflect.Int, nil
 	case "process.parent.interpreter.file.modification_time":
@@ -32429,10 +40001,22 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Bool, nil
 	case "process.parent.is_thread":
 		return reflect.Bool, nil
+	case "process.parent.ns.mnt":
+		return reflect.Int, nil
+	case "process.parent.ns.pid":
+		return reflect.Int, nil
+	case "process.parent.ns.user":
+		return reflect.Int, nil
+	case "process.parent.ns.uts":
+		return reflect.Int, nil
 	case "process.parent.pid":
 		return reflect.Int, nil
 	case "process.parent.ppid":
 		return reflect.Int, nil
+	case "process.parent.systemd.slice":
+		return reflect.String, nil
+	case "process.parent.systemd.unit":
+		return reflect.String, nil
 	case "process.parent.tid":
 		return reflect.Int, nil
 	case "process.parent.tty_name":
@@ -32451,6 +40035,10 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Int, nil
 	case "process.ppid":
 		return reflect.Int, nil
+	case "process.systemd.slice":
+		return reflect.String, nil
+	case "process.systemd.unit":
+		return reflect.String, nil
 	case "process.tid":
 		return reflect.Int, nil
 	case "process.tty_name":
@@ -32487,6 +40075,12 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Int, nil
 	case "ptrace.tracee.ancestors.cap_permitted":
 		return reflect.Int, nil
+	case "ptrace.tracee.ancestors.cgroup.ancestors":
+		return reflect.String, nil
+	case "ptrace.tracee.ancestors.cgroup.controllers":
+		return reflect.String, nil
+	case "ptrace.tracee.ancestors.cgroup.cpu.usage":
+		return reflect.Int, nil
 	case "ptrace.tracee.ancestors.cgroup.file.inode":
 		return reflect.Int, nil
 	case "ptrace.tracee.ancestors.cgroup.file.mount_id":
@@ -32495,6 +40089,20 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.String, nil
 	case "ptrace.tracee.ancestors.cgroup.manager":
 		return reflect.String, nil
+	case "ptrace.tracee.ancestors.cgroup.memory.current":
+		return reflect.Int, nil
+	case "ptrace.tracee.ancestors.cgroup.memory.high_exceeded":
+		return reflect.Bool, nil
+	case "ptrace.tracee.ancestors.cgroup.pids.current":
+		return reflect.Int, nil
+	case "ptrace.tracee.ancestors.cloud_credentials.providers":
+		return reflect.String, nil
+	case "ptrace.tracee.ancestors.cloud_credentials.resources":
+		return reflect.String, nil
+	case "ptrace.tracee.ancestors.cloud_credentials.types":
+		return reflect.String, nil
+	case "ptrace.tracee.ancestors.cmdline_obfuscation_score":
+		return reflect.Int, nil
 	case "ptrace.tracee.ancestors.comm":
 		return reflect.String, nil
 	case "ptrace.tracee.ancestors.container.id":
@@ -32505,6 +40113,14 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Int, nil
 	case "ptrace.tracee.ancestors.egroup":
 		return reflect.String, nil
+	case "ptrace.tracee.ancestors.elf.build_id":
+		return reflect.String, nil
+	case "ptrace.tracee.ancestors.elf.interpreter":
+		return reflect.String, nil
+	case "ptrace.tracee.ancestors.elf.is_static":
+		return reflect.Bool, nil
+	case "ptrace.tracee.ancestors.elf.is_stripped":
+		return reflect.Bool, nil
 	case "ptrace.tracee.ancestors.envp":
 		return reflect.String, nil
 	case "ptrace.tracee.ancestors.envs":
@@ -32529,6 +40145,10 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Bool, nil
 	case "ptrace.tracee.ancestors.file.inode":
 		return reflect.Int, nil
+	case "ptrace.tracee.ancestors.file.layer.digest":
+		return reflect.String, nil
+	case "ptrace.tracee.ancestors.file.layer.path":
+		return reflect.String, nil
 	case "ptrace.tracee.ancestors.file.mode":
 		return reflect.Int, nil
 	case "ptrace.tracee.ancestors.file.modification_time":
@@ -32581,6 +40201,10 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Bool, nil
 	case "ptrace.tracee.ancestors.interpreter.file.inode":
 		return reflect.Int, nil
+	case "ptrace.tracee.ancestors.interpreter.file.layer.digest":
+		return reflect.String, nil
+	case "ptrace.tracee.ancestors.interpreter.file.layer.path":
+		return reflect.String, nil
 	case "ptrace.tracee.ancestors.interpreter.file.mode":
 		return reflect.Int, nil
 	case "ptrace.tracee.ancestors.interpreter.file.modification_time":
@@ -32615,10 +40239,22 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Bool, nil
 	case "ptrace.tracee.ancestors.length":
 		return reflect.Int, nil
+	case "ptrace.tracee.ancestors.ns.mnt":
+		return reflect.Int, nil
+	case "ptrace.tracee.ancestors.ns.pid":
+		return reflect.Int, nil
+	case "ptrace.tracee.ancestors.ns.user":
+		return reflect.Int, nil
+	case "ptrace.tracee.ancestors.ns.uts":
+		return reflect.Int, nil
 	case "ptrace.tracee.ancestors.pid":
 		return reflect.Int, nil
 	case "ptrace.tracee.ancestors.ppid":
 		return reflect.Int, nil
+	case "ptrace.tracee.ancestors.systemd.slice":
+		return reflect.String, nil
+	case "ptrace.tracee.ancestors.systemd.unit":
+		return reflect.String, nil
 	case "ptrace.tracee.ancestors.tid":
 		return reflect.Int, nil
 	case "ptrace.tracee.ancestors.tty_name":
@@ -32651,6 +40287,12 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Int, nil
 	case "ptrace.tracee.cap_permitted":
 		return reflect.Int, nil
+	case "ptrace.tracee.cgroup.ancestors":
+		return reflect.String, nil
+	case "ptrace.tracee.cgroup.controllers":
+		return reflect.String, nil
+	case "ptrace.tracee.cgroup.cpu.usage":
+		return reflect.Int, nil
 	case "ptrace.tracee.cgroup.file.inode":
 		return reflect.Int, nil
 	case "ptrace.tracee.cgroup.file.mount_id":
@@ -32659,6 +40301,20 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.String, nil
 	case "ptrace.tracee.cgroup.manager":
 		return reflect.String, nil
+	case "ptrace.tracee.cgroup.memory.current":
+		return reflect.Int, nil
+	case "ptrace.tracee.cgroup.memory.high_exceeded":
+		return reflect.Bool, nil
+	case "ptrace.tracee.cgroup.pids.current":
+		return reflect.Int, nil
+	case "ptrace.tracee.cloud_credentials.providers":
+		return reflect.String, nil
+	case "ptrace.tracee.cloud_credentials.resources":
+		return reflect.String, nil
+	case "ptrace.tracee.cloud_credentials.types":
+		return reflect.String, nil
+	case "ptrace.tracee.cmdline_obfuscation_score":
+		return reflect.Int, nil
 	case "ptrace.tracee.comm":
 		return reflect.String, nil
 	case "ptrace.tracee.container.id":
@@ -32669,6 +40325,14 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Int, nil
 	case "ptrace.tracee.egroup":
 		return reflect.String, nil
+	case "ptrace.tracee.elf.build_id":
+		return reflect.String, nil
+	case "ptrace.tracee.elf.interpreter":
+		return reflect.String, nil
+	case "ptrace.tracee.elf.is_static":
+		return reflect.Bool, nil
+	case "ptrace.tracee.elf.is_stripped":
+		return reflect.Bool, nil
 	case "ptrace.tracee.envp":
 		return reflect.String, nil
 	case "ptrace.tracee.envs":
@@ -32693,6 +40357,10 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Bool, nil
 	case "ptrace.tracee.file.inode":
 		return reflect.Int, nil
+	case "ptrace.tracee.file.layer.digest":
+		return reflect.String, nil
+	case "ptrace.tracee.file.layer.path":
+		return reflect.String, nil
 	case "ptrace.tracee.file.mode":
 		return reflect.Int, nil
 	case "ptrace.tracee.file.modification_time":
@@ -32745,6 +40413,10 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Bool, nil
 	case "ptrace.tracee.interpreter.file.inode":
 		return reflect.Int, nil
+	case "ptrace.tracee.interpreter.file.layer.digest":
+		return reflect.String, nil
+	case "ptrace.tracee.interpreter.file.layer.path":
+		return reflect.String, nil
 	case "ptrace.tracee.interpreter.file.mode":
 		return reflect.Int, nil
 	case "ptrace.tracee.interpreter.file.modification_time":
@@ -32777,6 +40449,14 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Bool, nil
 	case "ptrace.tracee.is_thread":
 		return reflect.Bool, nil
+	case "ptrace.tracee.ns.mnt":
+		return reflect.Int, nil
+	case "ptrace.tracee.ns.pid":
+		return reflect.Int, nil
+	case "ptrace.tracee.ns.user":
+		return reflect.Int, nil
+	case "ptrace.tracee.ns.uts":
+		return reflect.Int, nil
 	case "ptrace.tracee.parent.args":
 		return reflect.String, nil
 	case "ptrace.tracee.parent.args_flags":
@@ -32795,6 +40475,12 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Int, nil
 	case "ptrace.tracee.parent.cap_permitted":
 		return reflect.Int, nil
+	case "ptrace.tracee.parent.cgroup.ancestors":
+		return reflect.String, nil
+	case "ptrace.tracee.parent.cgroup.controllers":
+		return reflect.String, nil
+	case "ptrace.tracee.parent.cgroup.cpu.usage":
+		return reflect.Int, nil
 	case "ptrace.tracee.parent.cgroup.file.inode":
 		return reflect.Int, nil
 	case "ptrace.tracee.parent.cgroup.file.mount_id":
@@ -32803,6 +40489,20 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.String, nil
 	case "ptrace.tracee.parent.cgroup.manager":
 		return reflect.String, nil
+	case "ptrace.tracee.parent.cgroup.memory.current":
+		return reflect.Int, nil
+	case "ptrace.tracee.parent.cgroup.memory.high_exceeded":
+		return reflect.Bool, nil
+	case "ptrace.tracee.parent.cgroup.pids.current":
+		return reflect.Int, nil
+	case "ptrace.tracee.parent.cloud_credentials.providers":
+		return reflect.String, nil
+	case "ptrace.tracee.parent.cloud_credentials.resources":
+		return reflect.String, nil
+	case "ptrace.tracee.parent.cloud_credentials.types":
+		return reflect.String, nil
+	case "ptrace.tracee.parent.cmdline_obfuscation_score":
+		return reflect.Int, nil
 	case "ptrace.tracee.parent.comm":
 		return reflect.String, nil
 	case "ptrace.tracee.parent.container.id":
@@ -32813,6 +40513,14 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Int, nil
 	case "ptrace.tracee.parent.egroup":
 		return reflect.String, nil
+	case "ptrace.tracee.parent.elf.build_id":
+		return reflect.String, nil
+	case "ptrace.tracee.parent.elf.interpreter":
+		return reflect.String, nil
+	case "ptrace.tracee.parent.elf.is_static":
+		return reflect.Bool, nil
+	case "ptrace.tracee.parent.elf.is_stripped":
+		return reflect.Bool, nil
 	case "ptrace.tracee.parent.envp":
 		return reflect.String, nil
 	case "ptrace.tracee.parent.envs":
@@ -32837,6 +40545,10 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Bool, nil
 	case "ptrace.tracee.parent.file.inode":
 		return reflect.Int, nil
+	case "ptrace.tracee.parent.file.layer.digest":
+		return reflect.String, nil
+	case "ptrace.tracee.parent.file.layer.path":
+		return reflect.String, nil
 	case "ptrace.tracee.parent.file.mode":
 		return reflect.Int, nil
 	case "ptrace.tracee.parent.file.modification_time":
@@ -32889,6 +40601,10 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Bool, nil
 	case "ptrace.tracee.parent.interpreter.file.inode":
 		return reflect.Int, nil
+	case "ptrace.tracee.parent.interpreter.file.layer.digest":
+		return reflect.String, nil
+	case "ptrace.tracee.parent.interpreter.file.layer.path":
+		return reflect.String, nil
 	case "ptrace.tracee.parent.interpreter.file.mode":
 		return reflect.Int, nil
 	case "ptrace.tracee.parent.interpreter.file.modification_time":
@@ -32921,10 +40637,22 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Bool, nil
 	case "ptrace.tracee.parent.is_thread":
 		return reflect.Bool, nil
+	case "ptrace.tracee.parent.ns.mnt":
+		return reflect.Int, nil
+	case "ptrace.tracee.parent.ns.pid":
+		return reflect.Int, nil
+	case "ptrace.tracee.parent.ns.user":
+		return reflect.Int, nil
+	case "ptrace.tracee.parent.ns.uts":
+		return reflect.Int, nil
 	case "ptrace.tracee.parent.pid":
 		return reflect.Int, nil
 	case "ptrace.tracee.parent.ppid":
 		return reflect.Int, nil
+	case "ptrace.tracee.parent.systemd.slice":
+		return reflect.String, nil
+	case "ptrace.tracee.parent.systemd.unit":
+		return reflect.String, nil
 	case "ptrace.tracee.parent.tid":
 		return reflect.Int, nil
 	case "ptrace.tracee.parent.tty_name":
@@ -32943,6 +40671,10 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Int, nil
 	case "ptrace.tracee.ppid":
 		return reflect.Int, nil
+	case "ptrace.tracee.systemd.slice":
+		return reflect.String, nil
+	case "ptrace.tracee.systemd.unit":
+		return reflect.String, nil
 	case "ptrace.tracee.tid":
 		return reflect.Int, nil
 	case "ptrace.tracee.tty_name":
@@ -32975,6 +40707,10 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Bool, nil
 	case "removexattr.file.inode":
 		return reflect.Int, nil
+	case "removexattr.file.layer.digest":
+		return reflect.String, nil
+	case "removexattr.file.layer.path":
+		return reflect.String, nil
 	case "removexattr.file.mode":
 		return reflect.Int, nil
 	case "removexattr.file.modification_time":
@@ -33019,6 +40755,10 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Bool, nil
 	case "rename.file.destination.inode":
 		return reflect.Int, nil
+	case "rename.file.destination.layer.digest":
+		return reflect.String, nil
+	case "rename.file.destination.layer.path":
+		return reflect.String, nil
 	case "rename.file.destination.mode":
 		return reflect.Int, nil
 	case "rename.file.destination.modification_time":
@@ -33057,6 +40797,10 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Bool, nil
 	case "rename.file.inode":
 		return reflect.Int, nil
+	case "rename.file.layer.digest":
+		return reflect.String, nil
+	case "rename.file.layer.path":
+		return reflect.String, nil
 	case "rename.file.mode":
 		return reflect.Int, nil
 	case "rename.file.modification_time":
@@ -33103,6 +40847,10 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Bool, nil
 	case "rmdir.file.inode":
 		return reflect.Int, nil
+	case "rmdir.file.layer.digest":
+		return reflect.String, nil
+	case "rmdir.file.layer.path":
+		return reflect.String, nil
 	case "rmdir.file.mode":
 		return reflect.Int, nil
 	case "rmdir.file.modification_time":
@@ -33181,6 +40929,10 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Bool, nil
 	case "setxattr.file.inode":
 		return reflect.Int, nil
+	case "setxattr.file.layer.digest":
+		return reflect.String, nil
+	case "setxattr.file.layer.path":
+		return reflect.String, nil
 	case "setxattr.file.mode":
 		return reflect.Int, nil
 	case "setxattr.file.modification_time":
@@ -33231,6 +40983,12 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Int, nil
 	case "signal.target.ancestors.cap_permitted":
 		return reflect.Int, nil
+	case "signal.target.ancestors.cgroup.ancestors":
+		return reflect.String, nil
+	case "signal.target.ancestors.cgroup.controllers":
+		return reflect.String, nil
+	case "signal.target.ancestors.cgroup.cpu.usage":
+		return reflect.Int, nil
 	case "signal.target.ancestors.cgroup.file.inode":
 		return reflect.Int, nil
 	case "signal.target.ancestors.cgroup.file.mount_id":
@@ -33239,6 +40997,20 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.String, nil
 	case "signal.target.ancestors.cgroup.manager":
 		return reflect.String, nil
+	case "signal.target.ancestors.cgroup.memory.current":
+		return reflect.Int, nil
+	case "signal.target.ancestors.cgroup.memory.high_exceeded":
+		return reflect.Bool, nil
+	case "signal.target.ancestors.cgroup.pids.current":
+		return reflect.Int, nil
+	case "signal.target.ancestors.cloud_credentials.providers":
+		return reflect.String, nil
+	case "signal.target.ancestors.cloud_credentials.resources":
+		return reflect.String, nil
+	case "signal.target.ancestors.cloud_credentials.types":
+		return reflect.String, nil
+	case "signal.target.ancestors.cmdline_obfuscation_score":
+		return reflect.Int, nil
 	case "signal.target.ancestors.comm":
 		return reflect.String, nil
 	case "signal.target.ancestors.container.id":
@@ -33249,6 +41021,14 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Int, nil
 	case "signal.target.ancestors.egroup":
 		return reflect.String, nil
+	case "signal.target.ancestors.elf.build_id":
+		return reflect.String, nil
+	case "signal.target.ancestors.elf.interpreter":
+		return reflect.String, nil
+	case "signal.target.ancestors.elf.is_static":
+		return reflect.Bool, nil
+	case "signal.target.ancestors.elf.is_stripped":
+		return reflect.Bool, nil
 	case "signal.target.ancestors.envp":
 		return reflect.String, nil
 	case "signal.target.ancestors.envs":
@@ -33273,6 +41053,10 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Bool, nil
 	case "signal.target.ancestors.file.inode":
 		return reflect.Int, nil
+	case "signal.target.ancestors.file.layer.digest":
+		return reflect.String, nil
+	case "signal.target.ancestors.file.layer.path":
+		return reflect.String, nil
 	case "signal.target.ancestors.file.mode":
 		return reflect.Int, nil
 	case "signal.target.ancestors.file.modification_time":
@@ -33325,6 +41109,10 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Bool, nil
 	case "signal.target.ancestors.interpreter.file.inode":
 		return reflect.Int, nil
+	case "signal.target.ancestors.interpreter.file.layer.digest":
+		return reflect.String, nil
+	case "signal.target.ancestors.interpreter.file.layer.path":
+		return reflect.String, nil
 	case "signal.target.ancestors.interpreter.file.mode":
 		return reflect.Int, nil
 	case "signal.target.ancestors.interpreter.file.modification_time":
@@ -33359,10 +41147,22 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Bool, nil
 	case "signal.target.ancestors.length":
 		return reflect.Int, nil
+	case "signal.target.ancestors.ns.mnt":
+		return reflect.Int, nil
+	case "signal.target.ancestors.ns.pid":
+		return reflect.Int, nil
+	case "signal.target.ancestors.ns.user":
+		return reflect.Int, nil
+	case "signal.target.ancestors.ns.uts":
+		return reflect.Int, nil
 	case "signal.target.ancestors.pid":
 		return reflect.Int, nil
 	case "signal.target.ancestors.ppid":
 		return reflect.Int, nil
+	case "signal.target.ancestors.systemd.slice":
+		return reflect.String, nil
+	case "signal.target.ancestors.systemd.unit":
+		return reflect.String, nil
 	case "signal.target.ancestors.tid":
 		return reflect.Int, nil
 	case "signal.target.ancestors.tty_name":
@@ -33395,6 +41195,12 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Int, nil
 	case "signal.target.cap_permitted":
 		return reflect.Int, nil
+	case "signal.target.cgroup.ancestors":
+		return reflect.String, nil
+	case "signal.target.cgroup.controllers":
+		return reflect.String, nil
+	case "signal.target.cgroup.cpu.usage":
+		return reflect.Int, nil
 	case "signal.target.cgroup.file.inode":
 		return reflect.Int, nil
 	case "signal.target.cgroup.file.mount_id":
@@ -33403,6 +41209,20 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.String, nil
 	case "signal.target.cgroup.manager":
 		return reflect.String, nil
+	case "signal.target.cgroup.memory.current":
+		return reflect.Int, nil
+	case "signal.target.cgroup.memory.high_exceeded":
+		return reflect.Bool, nil
+	case "signal.target.cgroup.pids.current":
+		return reflect.Int, nil
+	case "signal.target.cloud_credentials.providers":
+		return reflect.String, nil
+	case "signal.target.cloud_credentials.resources":
+		return reflect.String, nil
+	case "signal.target.cloud_credentials.types":
+		return reflect.String, nil
+	case "signal.target.cmdline_obfuscation_score":
+		return reflect.Int, nil
 	case "signal.target.comm":
 		return reflect.String, nil
 	case "signal.target.container.id":
@@ -33413,6 +41233,14 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Int, nil
 	case "signal.target.egroup":
 		return reflect.String, nil
+	case "signal.target.elf.build_id":
+		return reflect.String, nil
+	case "signal.target.elf.interpreter":
+		return reflect.String, nil
+	case "signal.target.elf.is_static":
+		return reflect.Bool, nil
+	case "signal.target.elf.is_stripped":
+		return reflect.Bool, nil
 	case "signal.target.envp":
 		return reflect.String, nil
 	case "signal.target.envs":
@@ -33437,6 +41265,10 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Bool, nil
 	case "signal.target.file.inode":
 		return reflect.Int, nil
+	case "signal.target.file.layer.digest":
+		return reflect.String, nil
+	case "signal.target.file.layer.path":
+		return reflect.String, nil
 	case "signal.target.file.mode":
 		return reflect.Int, nil
 	case "signal.target.file.modification_time":
@@ -33489,6 +41321,10 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Bool, nil
 	case "signal.target.interpreter.file.inode":
 		return reflect.Int, nil
+	case "signal.target.interpreter.file.layer.digest":
+		return reflect.String, nil
+	case "signal.target.interpreter.file.layer.path":
+		return reflect.String, nil
 	case "signal.target.interpreter.file.mode":
 		return reflect.Int, nil
 	case "signal.target.interpreter.file.modification_time":
@@ -33521,6 +41357,14 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Bool, nil
 	case "signal.target.is_thread":
 		return reflect.Bool, nil
+	case "signal.target.ns.mnt":
+		return reflect.Int, nil
+	case "signal.target.ns.pid":
+		return reflect.Int, nil
+	case "signal.target.ns.user":
+		return reflect.Int, nil
+	case "signal.target.ns.uts":
+		return reflect.Int, nil
 	case "signal.target.parent.args":
 		return reflect.String, nil
 	case "signal.target.parent.args_flags":
@@ -33539,6 +41383,12 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Int, nil
 	case "signal.target.parent.cap_permitted":
 		return reflect.Int, nil
+	case "signal.target.parent.cgroup.ancestors":
+		return reflect.String, nil
+	case "signal.target.parent.cgroup.controllers":
+		return reflect.String, nil
+	case "signal.target.parent.cgroup.cpu.usage":
+		return reflect.Int, nil
 	case "signal.target.parent.cgroup.file.inode":
 		return reflect.Int, nil
 	case "signal.target.parent.cgroup.file.mount_id":
@@ -33547,6 +41397,20 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.String, nil
 	case "signal.target.parent.cgroup.manager":
 		return reflect.String, nil
+	case "signal.target.parent.cgroup.memory.current":
+		return reflect.Int, nil
+	case "signal.target.parent.cgroup.memory.high_exceeded":
+		return reflect.Bool, nil
+	case "signal.target.parent.cgroup.pids.current":
+		return reflect.Int, nil
+	case "signal.target.parent.cloud_credentials.providers":
+		return reflect.String, nil
+	case "signal.target.parent.cloud_credentials.resources":
+		return reflect.String, nil
+	case "signal.target.parent.cloud_credentials.types":
+		return reflect.String, nil
+	case "signal.target.parent.cmdline_obfuscation_score":
+		return reflect.Int, nil
 	case "signal.target.parent.comm":
 		return reflect.String, nil
 	case "signal.target.parent.container.id":
@@ -33557,6 +41421,14 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Int, nil
 	case "signal.target.parent.egroup":
 		return reflect.String, nil
+	case "signal.target.parent.elf.build_id":
+		return reflect.String, nil
+	case "signal.target.parent.elf.interpreter":
+		return reflect.String, nil
+	case "signal.target.parent.elf.is_static":
+		return reflect.Bool, nil
+	case "signal.target.parent.elf.is_stripped":
+		return reflect.Bool, nil
 	case "signal.target.parent.envp":
 		return reflect.String, nil
 	case "signal.target.parent.envs":
@@ -33581,6 +41453,10 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Bool, nil
 	case "signal.target.parent.file.inode":
 		return reflect.Int, nil
+	case "signal.target.parent.file.layer.digest":
+		return reflect.String, nil
+	case "signal.target.parent.file.layer.path":
+		return reflect.String, nil
 	case "signal.target.parent.file.mode":
 		return reflect.Int, nil
 	case "signal.target.parent.file.modification_time":
@@ -33633,6 +41509,10 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Bool, nil
 	case "signal.target.parent.interpreter.file.inode":
 		return reflect.Int, nil
+	case "signal.target.parent.interpreter.file.layer.digest":
+		return reflect.String, nil
+	case "signal.target.parent.interpreter.file.layer.path":
+		return reflect.String, nil
 	case "signal.target.parent.interpreter.file.mode":
 		return reflect.Int, nil
 	case "signal.target.parent.interpreter.file.modification_time":
@@ -33665,10 +41545,22 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Bool, nil
 	case "signal.target.parent.is_thread":
 		return reflect.Bool, nil
+	case "signal.target.parent.ns.mnt":
+		return reflect.Int, nil
+	case "signal.target.parent.ns.pid":
+		return reflect.Int, nil
+	case "signal.target.parent.ns.user":
+		return reflect.Int, nil
+	case "signal.target.parent.ns.uts":
+		return reflect.Int, nil
 	case "signal.target.parent.pid":
 		return reflect.Int, nil
 	case "signal.target.parent.ppid":
 		return reflect.Int, nil
+	case "signal.target.parent.systemd.slice":
+		return reflect.String, nil
+	case "signal.target.parent.systemd.unit":
+		return reflect.String, nil
 	case "signal.target.parent.tid":
 		return reflect.Int, nil
 	case "signal.target.parent.tty_name":
@@ -33687,6 +41579,10 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Int, nil
 	case "signal.target.ppid":
 		return reflect.Int, nil
+	case "signal.target.systemd.slice":
+		return reflect.String, nil
+	case "signal.target.systemd.unit":
+		return reflect.String, nil
 	case "signal.target.tid":
 		return reflect.Int, nil
 	case "signal.target.tty_name":
@@ -33717,6 +41613,10 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Bool, nil
 	case "splice.file.inode":
 		return reflect.Int, nil
+	case "splice.file.layer.digest":
+		return reflect.String, nil
+	case "splice.file.layer.path":
+		return reflect.String, nil
 	case "splice.file.mode":
 		return reflect.Int, nil
 	case "splice.file.modification_time":
@@ -33763,6 +41663,10 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Bool, nil
 	case "unlink.file.inode":
 		return reflect.Int, nil
+	case "unlink.file.layer.digest":
+		return reflect.String, nil
+	case "unlink.file.layer.path":
+		return reflect.String, nil
 	case "unlink.file.mode":
 		return reflect.Int, nil
 	case "unlink.file.modification_time":
@@ -33817,6 +41721,10 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.Bool, nil
 	case "utimes.file.inode":
 		return reflect.Int, nil
+	case "utimes.file.layer.digest":
+		return reflect.String, nil
+	case "utimes.file.layer.path":
+		return reflect.String, nil
 	case "utimes.file.mode":
 		return reflect.Int, nil
 	case "utimes.file.modification_time":
@@ -33900,6 +41808,20 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.BPF.Cmd = uint32(rv)
 		return nil
+	case "bpf.kernel_security.lockdown":
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BPF.KernelSecurity.Lockdown"}
+		}
+		ev.BPF.KernelSecurity.Lockdown = rv
+		return nil
+	case "bpf.kernel_security.secure_boot":
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BPF.KernelSecurity.SecureBoot"}
+		}
+		ev.BPF.KernelSecurity.SecureBoot = rv
+		return nil
 	case "bpf.map.name":
 		rv, ok := value.(string)
 		if !ok {
@@ -33975,6 +41897,33 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.Capset.CapPermitted = uint64(rv)
 		return nil
+	case "cgroup.ancestors":
+		switch rv := value.(type) {
+		case string:
+			ev.CGroupContext.CGroupAncestors = append(ev.CGroupContext.CGroupAncestors, rv)
+		case []string:
+			ev.CGroupContext.CGroupAncestors = append(ev.CGroupContext.CGroupAncestors, rv...)
+		default:
+			return &eval.ErrValueTypeMismatch{Field: "CGroupContext.CGroupAncestors"}
+		}
+		return nil
+	case "cgroup.controllers":
+		switch rv := value.(type) {
+		case string:
+			ev.CGroupContext.CGroupControllers = append(ev.CGroupContext.CGroupControllers, rv)
+		case []string:
+			ev.CGroupContext.CGroupControllers = append(ev.CGroupContext.CGroupControllers, rv...)
+		default:
+			return &eval.ErrValueTypeMismatch{Field: "CGroupContext.CGroupControllers"}
+		}
+		return nil
+	case "cgroup.cpu.usage":
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "CGroupContext.CGroupCPUUsage"}
+		}
+		ev.CGroupContext.CGroupCPUUsage = uint64(rv)
+		return nil
 	case "cgroup.file.inode":
 		rv, ok := value.(int)
 		if !ok {
@@ -34003,6 +41952,27 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.CGroupContext.CGroupManager = rv
 		return nil
+	case "cgroup.memory.current":
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "CGroupContext.CGroupMemoryCurrent"}
+		}
+		ev.CGroupContext.CGroupMemoryCurrent = uint64(rv)
+		return nil
+	case "cgroup.memory.high_exceeded":
+		rv, ok := value.(bool)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "CGroupContext.CGroupMemoryHighExceeded"}
+		}
+		ev.CGroupContext.CGroupMemoryHighExceeded = rv
+		return nil
+	case "cgroup.pids.current":
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "CGroupContext.CGroupPidsCurrent"}
+		}
+		ev.CGroupContext.CGroupPidsCurrent = uint64(rv)
+		return nil
 	case "chdir.file.change_time":
 		rv, ok := value.(int)
 		if !ok {
@@ -34055,6 +42025,20 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.Chdir.File.FileFields.PathKey.Inode = uint64(rv)
 		return nil
+	case "chdir.file.layer.digest":
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chdir.File.LayerDigest"}
+		}
+		ev.Chdir.File.LayerDigest = rv
+		return nil
+	case "chdir.file.layer.path":
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chdir.File.LayerPath"}
+		}
+		ev.Chdir.File.LayerPath = rv
+		return nil
 	case "chdir.file.mode":
 		rv, ok := value.(int)
 		if !ok {
@@ -34222,6 +42206,20 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.Chmod.File.FileFields.PathKey.Inode = uint64(rv)
 		return nil
+	case "chmod.file.layer.digest":
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chmod.File.LayerDigest"}
+		}
+		ev.Chmod.File.LayerDigest = rv
+		return nil
+	case "chmod.file.layer.path":
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chmod.File.LayerPath"}
+		}
+		ev.Chmod.File.LayerPath = rv
+		return nil
 	case "chmod.file.mode":
 		rv, ok := value.(int)
 		if !ok {
@@ -34285,6 +42283,20 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		return nil
 	case "chmod.file.path.length":
 		return &eval.ErrFieldReadOnly{Field: "chmod.file.path.length"}
+	case "chmod.file.previous_mode":
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chmod.PreviousMode"}
+		}
+		ev.Chmod.PreviousMode = uint32(rv)
+		return nil
+	case "chmod.file.previous_rights":
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chmod.PreviousMode"}
+		}
+		ev.Chmod.PreviousMode = uint32(rv)
+		return nil
 	case "chmod.file.rights":
 		rv, ok := value.(int)
 		if !ok {
@@ -34410,6 +42422,20 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.Chown.File.FileFields.PathKey.Inode = uint64(rv)
 		return nil
+	case "chown.file.layer.digest":
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chown.File.LayerDigest"}
+		}
+		ev.Chown.File.LayerDigest = rv
+		return nil
+	case "chown.file.layer.path":
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chown.File.LayerPath"}
+		}
+		ev.Chown.File.LayerPath = rv
+		return nil
 	case "chown.file.mode":
 		rv, ok := value.(int)
 		if !ok {
@@ -34473,6 +42499,20 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		return nil
 	case "chown.file.path.length":
 		return &eval.ErrFieldReadOnly{Field: "chown.file.path.length"}
+	case "chown.file.previous_gid":
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chown.PreviousGID"}
+		}
+		ev.Chown.PreviousGID = int64(rv)
+		return nil
+	case "chown.file.previous_uid":
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chown.PreviousUID"}
+		}
+		ev.Chown.PreviousUID = int64(rv)
+		return nil
 	case "chown.file.rights":
 		rv, ok := value.(int)
 		if !ok {
@@ -34673,6 +42713,20 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.DNS.Count = uint16(rv)
 		return nil
+	case "dns.question.domain_query_rate":
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "DNS.DomainQueryRate"}
+		}
+		ev.DNS.DomainQueryRate = int(rv)
+		return nil
+	case "dns.question.label_entropy":
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "DNS.LabelEntropy"}
+		}
+		ev.DNS.LabelEntropy = int(rv)
+		return nil
 	case "dns.question.length":
 		rv, ok := value.(int)
 		if !ok {
@@ -34702,6 +42756,13 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.DNS.Type = uint16(rv)
 		return nil
+	case "dns.question.unique_subdomains":
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "DNS.UniqueSubdomains"}
+		}
+		ev.DNS.UniqueSubdomains = int(rv)
+		return nil
 	case "event.async":
 		rv, ok := value.(bool)
 		if !ok {
@@ -34843,6 +42904,42 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.Exec.Process.Credentials.CapPermitted = uint64(rv)
 		return nil
+	case "exec.cgroup.ancestors":
+		if ev.Exec.Process == nil {
+			ev.Exec.Process = &Process{}
+		}
+		switch rv := value.(type) {
+		case string:
+			ev.Exec.Process.CGroup.CGroupAncestors = append(ev.Exec.Process.CGroup.CGroupAncestors, rv)
+		case []string:
+			ev.Exec.Process.CGroup.CGroupAncestors = append(ev.Exec.Process.CGroup.CGroupAncestors, rv...)
+		default:
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.CGroup.CGroupAncestors"}
+		}
+		return nil
+	case "exec.cgroup.controllers":
+		if ev.Exec.Process == nil {
+			ev.Exec.Process = &Process{}
+		}
+		switch rv := value.(type) {
+		case string:
+			ev.Exec.Process.CGroup.CGroupControllers = append(ev.Exec.Process.CGroup.CGroupControllers, rv)
+		case []string:
+			ev.Exec.Process.CGroup.CGroupControllers = append(ev.Exec.Process.CGroup.CGroupControllers, rv...)
+		default:
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.CGroup.CGroupControllers"}
+		}
+		return nil
+	case "exec.cgroup.cpu.usage":
+		if ev.Exec.Process == nil {
+			ev.Exec.Process = &Process{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.CGroup.CGroupCPUUsage"}
+		}
+		ev.Exec.Process.CGroup.CGroupCPUUsage = uint64(rv)
+		return nil
 	case "exec.cgroup.file.inode":
 		if ev.Exec.Process == nil {
 			ev.Exec.Process = &Process{}
@@ -34883,6 +42980,85 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.Exec.Process.CGroup.CGroupManager = rv
 		return nil
+	case "exec.cgroup.memory.current":
+		if ev.Exec.Process == nil {
+			ev.Exec.Process = &Process{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.CGroup.CGroupMemoryCurrent"}
+		}
+		ev.Exec.Process.CGroup.CGroupMemoryCurrent = uint64(rv)
+		return nil
+	case "exec.cgroup.memory.high_exceeded":
+		if ev.Exec.Process == nil {
+			ev.Exec.Process = &Process{}
+		}
+		rv, ok := value.(bool)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.CGroup.CGroupMemoryHighExceeded"}
+		}
+		ev.Exec.Process.CGroup.CGroupMemoryHighExceeded = rv
+		return nil
+	case "exec.cgroup.pids.current":
+		if ev.Exec.Process == nil {
+			ev.Exec.Process = &Process{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.CGroup.CGroupPidsCurrent"}
+		}
+		ev.Exec.Process.CGroup.CGroupPidsCurrent = uint64(rv)
+		return nil
+	case "exec.cloud_credentials.providers":
+		if ev.Exec.Process == nil {
+			ev.Exec.Process = &Process{}
+		}
+		switch rv := value.(type) {
+		case string:
+			ev.Exec.Process.CloudCredentialsProviders = append(ev.Exec.Process.CloudCredentialsProviders, rv)
+		case []string:
+			ev.Exec.Process.CloudCredentialsProviders = append(ev.Exec.Process.CloudCredentialsProviders, rv...)
+		default:
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.CloudCredentialsProviders"}
+		}
+		return nil
+	case "exec.cloud_credentials.resources":
+		if ev.Exec.Process == nil {
+			ev.Exec.Process = &Process{}
+		}
+		switch rv := value.(type) {
+		case string:
+			ev.Exec.Process.CloudCredentialsResources = append(ev.Exec.Process.CloudCredentialsResources, rv)
+		case []string:
+			ev.Exec.Process.CloudCredentialsResources = append(ev.Exec.Process.CloudCredentialsResources, rv...)
+		default:
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.CloudCredentialsResources"}
+		}
+		return nil
+	case "exec.cloud_credentials.types":
+		if ev.Exec.Process == nil {
+			ev.Exec.Process = &Process{}
+		}
+		switch rv := value.(type) {
+		case string:
+			ev.Exec.Process.CloudCredentialsTypes = append(ev.Exec.Process.CloudCredentialsTypes, rv)
+		case []string:
+			ev.Exec.Process.CloudCredentialsTypes = append(ev.Exec.Process.CloudCredentialsTypes, rv...)
+		default:
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.CloudCredentialsTypes"}
+		}
+		return nil
+	case "exec.cmdline_obfuscation_score":
+		if ev.Exec.Process == nil {
+			ev.Exec.Process = &Process{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.CmdLineObfuscationScore"}
+		}
+		ev.Exec.Process.CmdLineObfuscationScore = int(rv)
+		return nil
 	case "exec.comm":
 		if ev.Exec.Process == nil {
 			ev.Exec.Process = &Process{}
@@ -34933,6 +43109,46 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.Exec.Process.Credentials.EGroup = rv
 		return nil
+	case "exec.elf.build_id":
+		if ev.Exec.Process == nil {
+			ev.Exec.Process = &Process{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.ELFBuildID"}
+		}
+		ev.Exec.Process.ELFBuildID = rv
+		return nil
+	case "exec.elf.interpreter":
+		if ev.Exec.Process == nil {
+			ev.Exec.Process = &Process{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.ELFInterpreter"}
+		}
+		ev.Exec.Process.ELFInterpreter = rv
+		return nil
+	case "exec.elf.is_static":
+		if ev.Exec.Process == nil {
+			ev.Exec.Process = &Process{}
+		}
+		rv, ok := value.(bool)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.ELFIsStatic"}
+		}
+		ev.Exec.Process.ELFIsStatic = rv
+		return nil
+	case "exec.elf.is_stripped":
+		if ev.Exec.Process == nil {
+			ev.Exec.Process = &Process{}
+		}
+		rv, ok := value.(bool)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.ELFIsStripped"}
+		}
+		ev.Exec.Process.ELFIsStripped = rv
+		return nil
 	case "exec.envp":
 		if ev.Exec.Process == nil {
 			ev.Exec.Process = &Process{}
@@ -35062,6 +43278,26 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.Exec.Process.FileEvent.FileFields.PathKey.Inode = uint64(rv)
 		return nil
+	case "exec.file.layer.digest":
+		if ev.Exec.Process == nil {
+			ev.Exec.Process = &Process{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.FileEvent.LayerDigest"}
+		}
+		ev.Exec.Process.FileEvent.LayerDigest = rv
+		return nil
+	case "exec.file.layer.path":
+		if ev.Exec.Process == nil {
+			ev.Exec.Process = &Process{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.FileEvent.LayerPath"}
+		}
+		ev.Exec.Process.FileEvent.LayerPath = rv
+		return nil
 	case "exec.file.mode":
 		if ev.Exec.Process == nil {
 			ev.Exec.Process = &Process{}
@@ -35321,6 +43557,26 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.Exec.Process.LinuxBinprm.FileEvent.FileFields.PathKey.Inode = uint64(rv)
 		return nil
+	case "exec.interpreter.file.layer.digest":
+		if ev.Exec.Process == nil {
+			ev.Exec.Process = &Process{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.LinuxBinprm.FileEvent.LayerDigest"}
+		}
+		ev.Exec.Process.LinuxBinprm.FileEvent.LayerDigest = rv
+		return nil
+	case "exec.interpreter.file.layer.path":
+		if ev.Exec.Process == nil {
+			ev.Exec.Process = &Process{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.LinuxBinprm.FileEvent.LayerPath"}
+		}
+		ev.Exec.Process.LinuxBinprm.FileEvent.LayerPath = rv
+		return nil
 	case "exec.interpreter.file.mode":
 		if ev.Exec.Process == nil {
 			ev.Exec.Process = &Process{}
@@ -35477,6 +43733,46 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.Exec.Process.IsThread = rv
 		return nil
+	case "exec.ns.mnt":
+		if ev.Exec.Process == nil {
+			ev.Exec.Process = &Process{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.MountNamespace"}
+		}
+		ev.Exec.Process.MountNamespace = uint32(rv)
+		return nil
+	case "exec.ns.pid":
+		if ev.Exec.Process == nil {
+			ev.Exec.Process = &Process{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.PIDNamespace"}
+		}
+		ev.Exec.Process.PIDNamespace = uint32(rv)
+		return nil
+	case "exec.ns.user":
+		if ev.Exec.Process == nil {
+			ev.Exec.Process = &Process{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.UserNamespace"}
+		}
+		ev.Exec.Process.UserNamespace = uint32(rv)
+		return nil
+	case "exec.ns.uts":
+		if ev.Exec.Process == nil {
+			ev.Exec.Process = &Process{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.UTSNamespace"}
+		}
+		ev.Exec.Process.UTSNamespace = uint32(rv)
+		return nil
 	case "exec.pid":
 		if ev.Exec.Process == nil {
 			ev.Exec.Process = &Process{}
@@ -35504,6 +43800,26 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.Exec.SyscallContext.StrArg1 = rv
 		return nil
+	case "exec.systemd.slice":
+		if ev.Exec.Process == nil {
+			ev.Exec.Process = &Process{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.SystemdSlice"}
+		}
+		ev.Exec.Process.SystemdSlice = rv
+		return nil
+	case "exec.systemd.unit":
+		if ev.Exec.Process == nil {
+			ev.Exec.Process = &Process{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.SystemdUnit"}
+		}
+		ev.Exec.Process.SystemdUnit = rv
+		return nil
 	case "exec.tid":
 		if ev.Exec.Process == nil {
 			ev.Exec.Process = &Process{}
@@ -35683,6 +43999,42 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.Exit.Cause = uint32(rv)
 		return nil
+	case "exit.cgroup.ancestors":
+		if ev.Exit.Process == nil {
+			ev.Exit.Process = &Process{}
+		}
+		switch rv := value.(type) {
+		case string:
+			ev.Exit.Process.CGroup.CGroupAncestors = append(ev.Exit.Process.CGroup.CGroupAncestors, rv)
+		case []string:
+			ev.Exit.Process.CGroup.CGroupAncestors = append(ev.Exit.Process.CGroup.CGroupAncestors, rv...)
+		default:
+			return &eval.ErrValueTypeMismatch{Field: "Exit.Process.CGroup.CGroupAncestors"}
+		}
+		return nil
+	case "exit.cgroup.controllers":
+		if ev.Exit.Process == nil {
+			ev.Exit.Process = &Process{}
+		}
+		switch rv := value.(type) {
+		case string:
+			ev.Exit.Process.CGroup.CGroupControllers = append(ev.Exit.Process.CGroup.CGroupControllers, rv)
+		case []string:
+			ev.Exit.Process.CGroup.CGroupControllers = append(ev.Exit.Process.CGroup.CGroupControllers, rv...)
+		default:
+			return &eval.ErrValueTypeMismatch{Field: "Exit.Process.CGroup.CGroupControllers"}
+		}
+		return nil
+	case "exit.cgroup.cpu.usage":
+		if ev.Exit.Process == nil {
+			ev.Exit.Process = &Process{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exit.Process.CGroup.CGroupCPUUsage"}
+		}
+		ev.Exit.Process.CGroup.CGroupCPUUsage = uint64(rv)
+		return nil
 	case "exit.cgroup.file.inode":
 		if ev.Exit.Process == nil {
 			ev.Exit.Process = &Process{}
@@ -35723,6 +44075,85 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.Exit.Process.CGroup.CGroupManager = rv
 		return nil
+	case "exit.cgroup.memory.current":
+		if ev.Exit.Process == nil {
+			ev.Exit.Process = &Process{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exit.Process.CGroup.CGroupMemoryCurrent"}
+		}
+		ev.Exit.Process.CGroup.CGroupMemoryCurrent = uint64(rv)
+		return nil
+	case "exit.cgroup.memory.high_exceeded":
+		if ev.Exit.Process == nil {
+			ev.Exit.Process = &Process{}
+		}
+		rv, ok := value.(bool)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exit.Process.CGroup.CGroupMemoryHighExceeded"}
+		}
+		ev.Exit.Process.CGroup.CGroupMemoryHighExceeded = rv
+		return nil
+	case "exit.cgroup.pids.current":
+		if ev.Exit.Process == nil {
+			ev.Exit.Process = &Process{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exit.Process.CGroup.CGroupPidsCurrent"}
+		}
+		ev.Exit.Process.CGroup.CGroupPidsCurrent = uint64(rv)
+		return nil
+	case "exit.cloud_credentials.providers":
+		if ev.Exit.Process == nil {
+			ev.Exit.Process = &Process{}
+		}
+		switch rv := value.(type) {
+		case string:
+			ev.Exit.Process.CloudCredentialsProviders = append(ev.Exit.Process.CloudCredentialsProviders, rv)
+		case []string:
+			ev.Exit.Process.CloudCredentialsProviders = append(ev.Exit.Process.CloudCredentialsProviders, rv...)
+		default:
+			return &eval.ErrValueTypeMismatch{Field: "Exit.Process.CloudCredentialsProviders"}
+		}
+		return nil
+	case "exit.cloud_credentials.resources":
+		if ev.Exit.Process == nil {
+			ev.Exit.Process = &Process{}
+		}
+		switch rv := value.(type) {
+		case string:
+			ev.Exit.Process.CloudCredentialsResources = append(ev.Exit.Process.CloudCredentialsResources, rv)
+		case []string:
+			ev.Exit.Process.CloudCredentialsResources = append(ev.Exit.Process.CloudCredentialsResources, rv...)
+		default:
+			return &eval.ErrValueTypeMismatch{Field: "Exit.Process.CloudCredentialsResources"}
+		}
+		return nil
+	case "exit.cloud_credentials.types":
+		if ev.Exit.Process == nil {
+			ev.Exit.Process = &Process{}
+		}
+		switch rv := value.(type) {
+		case string:
+			ev.Exit.Process.CloudCredentialsTypes = append(ev.Exit.Process.CloudCredentialsTypes, rv)
+		case []string:
+			ev.Exit.Process.CloudCredentialsTypes = append(ev.Exit.Process.CloudCredentialsTypes, rv...)
+		default:
+			return &eval.ErrValueTypeMismatch{Field: "Exit.Process.CloudCredentialsTypes"}
+		}
+		return nil
+	case "exit.cmdline_obfuscation_score":
+		if ev.Exit.Process == nil {
+			ev.Exit.Process = &Process{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exit.Process.CmdLineObfuscationScore"}
+		}
+		ev.Exit.Process.CmdLineObfuscationScore = int(rv)
+		return nil
 	case "exit.code":
 		rv, ok := value.(int)
 		if !ok {
@@ -35780,6 +44211,46 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.Exit.Process.Credentials.EGroup = rv
 		return nil
+	case "exit.elf.build_id":
+		if ev.Exit.Process == nil {
+			ev.Exit.Process = &Process{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exit.Process.ELFBuildID"}
+		}
+		ev.Exit.Process.ELFBuildID = rv
+		return nil
+	case "exit.elf.interpreter":
+		if ev.Exit.Process == nil {
+			ev.Exit.Process = &Process{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exit.Process.ELFInterpreter"}
+		}
+		ev.Exit.Process.ELFInterpreter = rv
+		return nil
+	case "exit.elf.is_static":
+		if ev.Exit.Process == nil {
+			ev.Exit.Process = &Process{}
+		}
+		rv, ok := value.(bool)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exit.Process.ELFIsStatic"}
+		}
+		ev.Exit.Process.ELFIsStatic = rv
+		return nil
+	case "exit.elf.is_stripped":
+		if ev.Exit.Process == nil {
+			ev.Exit.Process = &Process{}
+		}
+		rv, ok := value.(bool)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exit.Process.ELFIsStripped"}
+		}
+		ev.Exit.Process.ELFIsStripped = rv
+		return nil
 	case "exit.envp":
 		if ev.Exit.Process == nil {
 			ev.Exit.Process = &Process{}
@@ -35909,6 +44380,26 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.Exit.Process.FileEvent.FileFields.PathKey.Inode = uint64(rv)
 		return nil
+	case "exit.file.layer.digest":
+		if ev.Exit.Process == nil {
+			ev.Exit.Process = &Process{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exit.Process.FileEvent.LayerDigest"}
+		}
+		ev.Exit.Process.FileEvent.LayerDigest = rv
+		return nil
+	case "exit.file.layer.path":
+		if ev.Exit.Process == nil {
+			ev.Exit.Process = &Process{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exit.Process.FileEvent.LayerPath"}
+		}
+		ev.Exit.Process.FileEvent.LayerPath = rv
+		return nil
 	case "exit.file.mode":
 		if ev.Exit.Process == nil {
 			ev.Exit.Process = &Process{}
@@ -36168,6 +44659,26 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.Exit.Process.LinuxBinprm.FileEvent.FileFields.PathKey.Inode = uint64(rv)
 		return nil
+	case "exit.interpreter.file.layer.digest":
+		if ev.Exit.Process == nil {
+			ev.Exit.Process = &Process{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exit.Process.LinuxBinprm.FileEvent.LayerDigest"}
+		}
+		ev.Exit.Process.LinuxBinprm.FileEvent.LayerDigest = rv
+		return nil
+	case "exit.interpreter.file.layer.path":
+		if ev.Exit.Process == nil {
+			ev.Exit.Process = &Process{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exit.Process.LinuxBinprm.FileEvent.LayerPath"}
+		}
+		ev.Exit.Process.LinuxBinprm.FileEvent.LayerPath = rv
+		return nil
 	case "exit.interpreter.file.mode":
 		if ev.Exit.Process == nil {
 			ev.Exit.Process = &Process{}
@@ -36324,6 +44835,46 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.Exit.Process.IsThread = rv
 		return nil
+	case "exit.ns.mnt":
+		if ev.Exit.Process == nil {
+			ev.Exit.Process = &Process{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exit.Process.MountNamespace"}
+		}
+		ev.Exit.Process.MountNamespace = uint32(rv)
+		return nil
+	case "exit.ns.pid":
+		if ev.Exit.Process == nil {
+			ev.Exit.Process = &Process{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exit.Process.PIDNamespace"}
+		}
+		ev.Exit.Process.PIDNamespace = uint32(rv)
+		return nil
+	case "exit.ns.user":
+		if ev.Exit.Process == nil {
+			ev.Exit.Process = &Process{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exit.Process.UserNamespace"}
+		}
+		ev.Exit.Process.UserNamespace = uint32(rv)
+		return nil
+	case "exit.ns.uts":
+		if ev.Exit.Process == nil {
+			ev.Exit.Process = &Process{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exit.Process.UTSNamespace"}
+		}
+		ev.Exit.Process.UTSNamespace = uint32(rv)
+		return nil
 	case "exit.pid":
 		if ev.Exit.Process == nil {
 			ev.Exit.Process = &Process{}
@@ -36344,6 +44895,26 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.Exit.Process.PPid = uint32(rv)
 		return nil
+	case "exit.systemd.slice":
+		if ev.Exit.Process == nil {
+			ev.Exit.Process = &Process{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exit.Process.SystemdSlice"}
+		}
+		ev.Exit.Process.SystemdSlice = rv
+		return nil
+	case "exit.systemd.unit":
+		if ev.Exit.Process == nil {
+			ev.Exit.Process = &Process{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exit.Process.SystemdUnit"}
+		}
+		ev.Exit.Process.SystemdUnit = rv
+		return nil
 	case "exit.tid":
 		if ev.Exit.Process == nil {
 			ev.Exit.Process = &Process{}
@@ -36417,6 +44988,27 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.Exit.Process.UserSession.K8SUsername = rv
 		return nil
+	case "imds.access_token.expires_in":
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "IMDS.AccessToken.ExpiresIn"}
+		}
+		ev.IMDS.AccessToken.ExpiresIn = int(rv)
+		return nil
+	case "imds.access_token.resource":
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "IMDS.AccessToken.Resource"}
+		}
+		ev.IMDS.AccessToken.Resource = rv
+		return nil
+	case "imds.access_token.type":
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "IMDS.AccessToken.Type"}
+		}
+		ev.IMDS.AccessToken.Type = rv
+		return nil
 	case "imds.aws.is_imds_v2":
 		rv, ok := value.(bool)
 		if !ok {
@@ -36532,6 +45124,20 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.Link.Target.FileFields.PathKey.Inode = uint64(rv)
 		return nil
+	case "link.file.destination.layer.digest":
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Link.Target.LayerDigest"}
+		}
+		ev.Link.Target.LayerDigest = rv
+		return nil
+	case "link.file.destination.layer.path":
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Link.Target.LayerPath"}
+		}
+		ev.Link.Target.LayerPath = rv
+		return nil
 	case "link.file.destination.mode":
 		rv, ok := value.(int)
 		if !ok {
@@ -36664,6 +45270,20 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.Link.Source.FileFields.PathKey.Inode = uint64(rv)
 		return nil
+	case "link.file.layer.digest":
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Link.Source.LayerDigest"}
+		}
+		ev.Link.Source.LayerDigest = rv
+		return nil
+	case "link.file.layer.path":
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Link.Source.LayerPath"}
+		}
+		ev.Link.Source.LayerPath = rv
+		return nil
 	case "link.file.mode":
 		rv, ok := value.(int)
 		if !ok {
@@ -36848,6 +45468,20 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.LoadModule.File.FileFields.PathKey.Inode = uint64(rv)
 		return nil
+	case "load_module.file.layer.digest":
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "LoadModule.File.LayerDigest"}
+		}
+		ev.LoadModule.File.LayerDigest = rv
+		return nil
+	case "load_module.file.layer.path":
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "LoadModule.File.LayerPath"}
+		}
+		ev.LoadModule.File.LayerPath = rv
+		return nil
 	case "load_module.file.mode":
 		rv, ok := value.(int)
 		if !ok {
@@ -36935,6 +45569,20 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.LoadModule.File.FileFields.User = rv
 		return nil
+	case "load_module.kernel_security.lockdown":
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "LoadModule.KernelSecurity.Lockdown"}
+		}
+		ev.LoadModule.KernelSecurity.Lockdown = rv
+		return nil
+	case "load_module.kernel_security.secure_boot":
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "LoadModule.KernelSecurity.SecureBoot"}
+		}
+		ev.LoadModule.KernelSecurity.SecureBoot = rv
+		return nil
 	case "load_module.loaded_from_memory":
 		rv, ok := value.(bool)
 		if !ok {
@@ -37022,6 +45670,20 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.Mkdir.File.FileFields.PathKey.Inode = uint64(rv)
 		return nil
+	case "mkdir.file.layer.digest":
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Mkdir.File.LayerDigest"}
+		}
+		ev.Mkdir.File.LayerDigest = rv
+		return nil
+	case "mkdir.file.layer.path":
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Mkdir.File.LayerPath"}
+		}
+		ev.Mkdir.File.LayerPath = rv
+		return nil
 	case "mkdir.file.mode":
 		rv, ok := value.(int)
 		if !ok {
@@ -37168,6 +45830,20 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.MMap.File.FileFields.PathKey.Inode = uint64(rv)
 		return nil
+	case "mmap.file.layer.digest":
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "MMap.File.LayerDigest"}
+		}
+		ev.MMap.File.LayerDigest = rv
+		return nil
+	case "mmap.file.layer.path":
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "MMap.File.LayerPath"}
+		}
+		ev.MMap.File.LayerPath = rv
+		return nil
 	case "mmap.file.mode":
 		rv, ok := value.(int)
 		if !ok {
@@ -37262,6 +45938,13 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.MMap.Flags = uint64(rv)
 		return nil
+	case "mmap.length":
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "MMap.Len"}
+		}
+		ev.MMap.Len = uint64(rv)
+		return nil
 	case "mmap.protection":
 		rv, ok := value.(int)
 		if !ok {
@@ -37332,6 +46015,13 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.Mount.SyscallContext.StrArg1 = rv
 		return nil
+	case "mprotect.is_anonymous":
+		rv, ok := value.(bool)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "MProtect.IsAnonymous"}
+		}
+		ev.MProtect.IsAnonymous = rv
+		return nil
 	case "mprotect.req_protection":
 		rv, ok := value.(int)
 		if !ok {
@@ -37346,6 +46036,13 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.MProtect.SyscallEvent.Retval = int64(rv)
 		return nil
+	case "mprotect.size":
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "MProtect.Size"}
+		}
+		ev.MProtect.Size = uint64(rv)
+		return nil
 	case "mprotect.vm_protection":
 		rv, ok := value.(int)
 		if !ok {
@@ -37557,6 +46254,20 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.Open.File.FileFields.PathKey.Inode = uint64(rv)
 		return nil
+	case "open.file.layer.digest":
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Open.File.LayerDigest"}
+		}
+		ev.Open.File.LayerDigest = rv
+		return nil
+	case "open.file.layer.path":
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Open.File.LayerPath"}
+		}
+		ev.Open.File.LayerPath = rv
+		return nil
 	case "open.file.mode":
 		rv, ok := value.(int)
 		if !ok {
@@ -37904,6 +46615,51 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.Credentials.CapPermitted = uint64(rv)
 		return nil
+	case "process.ancestors.cgroup.ancestors":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		if ev.BaseEvent.ProcessContext.Ancestor == nil {
+			ev.BaseEvent.ProcessContext.Ancestor = &ProcessCacheEntry{}
+		}
+		switch rv := value.(type) {
+		case string:
+			ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.CGroup.CGroupAncestors = append(ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.CGroup.CGroupAncestors, rv)
+		case []string:
+			ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.CGroup.CGroupAncestors = append(ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.CGroup.CGroupAncestors, rv...)
+		default:
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.CGroup.CGroupAncestors"}
+		}
+		return nil
+	case "process.ancestors.cgroup.controllers":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		if ev.BaseEvent.ProcessContext.Ancestor == nil {
+			ev.BaseEvent.ProcessContext.Ancestor = &ProcessCacheEntry{}
+		}
+		switch rv := value.(type) {
+		case string:
+			ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.CGroup.CGroupControllers = append(ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.CGroup.CGroupControllers, rv)
+		case []string:
+			ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.CGroup.CGroupControllers = append(ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.CGroup.CGroupControllers, rv...)
+		default:
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.CGroup.CGroupControllers"}
+		}
+		return nil
+	case "process.ancestors.cgroup.cpu.usage":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		if ev.BaseEvent.ProcessContext.Ancestor == nil {
+			ev.BaseEvent.ProcessContext.Ancestor = &ProcessCacheEntry{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.CGroup.CGroupCPUUsage"}
+		}
+		ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.CGroup.CGroupCPUUsage = uint64(rv)
+		return nil
 	case "process.ancestors.cgroup.file.inode":
 		if ev.BaseEvent.ProcessContext == nil {
 			ev.BaseEvent.ProcessContext = &ProcessContext{}
@@ -37956,6 +46712,106 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.CGroup.CGroupManager = rv
 		return nil
+	case "process.ancestors.cgroup.memory.current":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		if ev.BaseEvent.ProcessContext.Ancestor == nil {
+			ev.BaseEvent.ProcessContext.Ancestor = &ProcessCacheEntry{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.CGroup.CGroupMemoryCurrent"}
+		}
+		ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.CGroup.CGroupMemoryCurrent = uint64(rv)
+		return nil
+	case "process.ancestors.cgroup.memory.high_exceeded":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		if ev.BaseEvent.ProcessContext.Ancestor == nil {
+			ev.BaseEvent.ProcessContext.Ancestor = &ProcessCacheEntry{}
+		}
+		rv, ok := value.(bool)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.CGroup.CGroupMemoryHighExceeded"}
+		}
+		ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.CGroup.CGroupMemoryHighExceeded = rv
+		return nil
+	case "process.ancestors.cgroup.pids.current":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		if ev.BaseEvent.ProcessContext.Ancestor == nil {
+			ev.BaseEvent.ProcessContext.Ancestor = &ProcessCacheEntry{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.CGroup.CGroupPidsCurrent"}
+		}
+		ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.CGroup.CGroupPidsCurrent = uint64(rv)
+		return nil
+	case "process.ancestors.cloud_credentials.providers":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		if ev.BaseEvent.ProcessContext.Ancestor == nil {
+			ev.BaseEvent.ProcessContext.Ancestor = &ProcessCacheEntry{}
+		}
+		switch rv := value.(type) {
+		case string:
+			ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.CloudCredentialsProviders = append(ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.CloudCredentialsProviders, rv)
+		case []string:
+			ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.CloudCredentialsProviders = append(ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.CloudCredentialsProviders, rv...)
+		default:
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.CloudCredentialsProviders"}
+		}
+		return nil
+	case "process.ancestors.cloud_credentials.resources":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		if ev.BaseEvent.ProcessContext.Ancestor == nil {
+			ev.BaseEvent.ProcessContext.Ancestor = &ProcessCacheEntry{}
+		}
+		switch rv := value.(type) {
+		case string:
+			ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.CloudCredentialsResources = append(ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.CloudCredentialsResources, rv)
+		case []string:
+			ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.CloudCredentialsResources = append(ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.CloudCredentialsResources, rv...)
+		default:
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.CloudCredentialsResources"}
+		}
+		return nil
+	case "process.ancestors.cloud_credentials.types":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		if ev.BaseEvent.ProcessContext.Ancestor == nil {
+			ev.BaseEvent.ProcessContext.Ancestor = &ProcessCacheEntry{}
+		}
+		switch rv := value.(type) {
+		case string:
+			ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.CloudCredentialsTypes = append(ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.CloudCredentialsTypes, rv)
+		case []string:
+			ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.CloudCredentialsTypes = append(ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.CloudCredentialsTypes, rv...)
+		default:
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.CloudCredentialsTypes"}
+		}
+		return nil
+	case "process.ancestors.cmdline_obfuscation_score":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		if ev.BaseEvent.ProcessContext.Ancestor == nil {
+			ev.BaseEvent.ProcessContext.Ancestor = &ProcessCacheEntry{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.CmdLineObfuscationScore"}
+		}
+		ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.CmdLineObfuscationScore = int(rv)
+		return nil
 	case "process.ancestors.comm":
 		if ev.BaseEvent.ProcessContext == nil {
 			ev.BaseEvent.ProcessContext = &ProcessContext{}
@@ -38021,6 +46877,58 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.Credentials.EGroup = rv
 		return nil
+	case "process.ancestors.elf.build_id":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		if ev.BaseEvent.ProcessContext.Ancestor == nil {
+			ev.BaseEvent.ProcessContext.Ancestor = &ProcessCacheEntry{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.ELFBuildID"}
+		}
+		ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.ELFBuildID = rv
+		return nil
+	case "process.ancestors.elf.interpreter":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		if ev.BaseEvent.ProcessContext.Ancestor == nil {
+			ev.BaseEvent.ProcessContext.Ancestor = &ProcessCacheEntry{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.ELFInterpreter"}
+		}
+		ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.ELFInterpreter = rv
+		return nil
+	case "process.ancestors.elf.is_static":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		if ev.BaseEvent.ProcessContext.Ancestor == nil {
+			ev.BaseEvent.ProcessContext.Ancestor = &ProcessCacheEntry{}
+		}
+		rv, ok := value.(bool)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.ELFIsStatic"}
+		}
+		ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.ELFIsStatic = rv
+		return nil
+	case "process.ancestors.elf.is_stripped":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		if ev.BaseEvent.ProcessContext.Ancestor == nil {
+			ev.BaseEvent.ProcessContext.Ancestor = &ProcessCacheEntry{}
+		}
+		rv, ok := value.(bool)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.ELFIsStripped"}
+		}
+		ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.ELFIsStripped = rv
+		return nil
 	case "process.ancestors.envp":
 		if ev.BaseEvent.ProcessContext == nil {
 			ev.BaseEvent.ProcessContext = &ProcessContext{}
@@ -38186,6 +47094,32 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.FileEvent.FileFields.PathKey.Inode = uint64(rv)
 		return nil
+	case "process.ancestors.file.layer.digest":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		if ev.BaseEvent.ProcessContext.Ancestor == nil {
+			ev.BaseEvent.ProcessContext.Ancestor = &ProcessCacheEntry{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.FileEvent.LayerDigest"}
+		}
+		ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.FileEvent.LayerDigest = rv
+		return nil
+	case "process.ancestors.file.layer.path":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		if ev.BaseEvent.ProcessContext.Ancestor == nil {
+			ev.BaseEvent.ProcessContext.Ancestor = &ProcessCacheEntry{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.FileEvent.LayerPath"}
+		}
+		ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.FileEvent.LayerPath = rv
+		return nil
 	case "process.ancestors.file.mode":
 		if ev.BaseEvent.ProcessContext == nil {
 			ev.BaseEvent.ProcessContext = &ProcessContext{}
@@ -38523,6 +47457,32 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.LinuxBinprm.FileEvent.FileFields.PathKey.Inode = uint64(rv)
 		return nil
+	case "process.ancestors.interpreter.file.layer.digest":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		if ev.BaseEvent.ProcessContext.Ancestor == nil {
+			ev.BaseEvent.ProcessContext.Ancestor = &ProcessCacheEntry{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.LinuxBinprm.FileEvent.LayerDigest"}
+		}
+		ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.LinuxBinprm.FileEvent.LayerDigest = rv
+		return nil
+	case "process.ancestors.interpreter.file.layer.path":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		if ev.BaseEvent.ProcessContext.Ancestor == nil {
+			ev.BaseEvent.ProcessContext.Ancestor = &ProcessCacheEntry{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.LinuxBinprm.FileEvent.LayerPath"}
+		}
+		ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.LinuxBinprm.FileEvent.LayerPath = rv
+		return nil
 	case "process.ancestors.interpreter.file.mode":
 		if ev.BaseEvent.ProcessContext == nil {
 			ev.BaseEvent.ProcessContext = &ProcessContext{}
@@ -38735,6 +47695,58 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 			ev.BaseEvent.ProcessContext.Ancestor = &ProcessCacheEntry{}
 		}
 		return &eval.ErrFieldReadOnly{Field: "process.ancestors.length"}
+	case "process.ancestors.ns.mnt":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		if ev.BaseEvent.ProcessContext.Ancestor == nil {
+			ev.BaseEvent.ProcessContext.Ancestor = &ProcessCacheEntry{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.MountNamespace"}
+		}
+		ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.MountNamespace = uint32(rv)
+		return nil
+	case "process.ancestors.ns.pid":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		if ev.BaseEvent.ProcessContext.Ancestor == nil {
+			ev.BaseEvent.ProcessContext.Ancestor = &ProcessCacheEntry{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.PIDNamespace"}
+		}
+		ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.PIDNamespace = uint32(rv)
+		return nil
+	case "process.ancestors.ns.user":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		if ev.BaseEvent.ProcessContext.Ancestor == nil {
+			ev.BaseEvent.ProcessContext.Ancestor = &ProcessCacheEntry{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.UserNamespace"}
+		}
+		ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.UserNamespace = uint32(rv)
+		return nil
+	case "process.ancestors.ns.uts":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		if ev.BaseEvent.ProcessContext.Ancestor == nil {
+			ev.BaseEvent.ProcessContext.Ancestor = &ProcessCacheEntry{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.UTSNamespace"}
+		}
+		ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.UTSNamespace = uint32(rv)
+		return nil
 	case "process.ancestors.pid":
 		if ev.BaseEvent.ProcessContext == nil {
 			ev.BaseEvent.ProcessContext = &ProcessContext{}
@@ -38761,6 +47773,32 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.PPid = uint32(rv)
 		return nil
+	case "process.ancestors.systemd.slice":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		if ev.BaseEvent.ProcessContext.Ancestor == nil {
+			ev.BaseEvent.ProcessContext.Ancestor = &ProcessCacheEntry{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.SystemdSlice"}
+		}
+		ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.SystemdSlice = rv
+		return nil
+	case "process.ancestors.systemd.unit":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		if ev.BaseEvent.ProcessContext.Ancestor == nil {
+			ev.BaseEvent.ProcessContext.Ancestor = &ProcessCacheEntry{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.SystemdUnit"}
+		}
+		ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.SystemdUnit = rv
+		return nil
 	case "process.ancestors.tid":
 		if ev.BaseEvent.ProcessContext == nil {
 			ev.BaseEvent.ProcessContext = &ProcessContext{}
@@ -38954,6 +47992,42 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.BaseEvent.ProcessContext.Process.Credentials.CapPermitted = uint64(rv)
 		return nil
+	case "process.cgroup.ancestors":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		switch rv := value.(type) {
+		case string:
+			ev.BaseEvent.ProcessContext.Process.CGroup.CGroupAncestors = append(ev.BaseEvent.ProcessContext.Process.CGroup.CGroupAncestors, rv)
+		case []string:
+			ev.BaseEvent.ProcessContext.Process.CGroup.CGroupAncestors = append(ev.BaseEvent.ProcessContext.Process.CGroup.CGroupAncestors, rv...)
+		default:
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Process.CGroup.CGroupAncestors"}
+		}
+		return nil
+	case "process.cgroup.controllers":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		switch rv := value.(type) {
+		case string:
+			ev.BaseEvent.ProcessContext.Process.CGroup.CGroupControllers = append(ev.BaseEvent.ProcessContext.Process.CGroup.CGroupControllers, rv)
+		case []string:
+			ev.BaseEvent.ProcessContext.Process.CGroup.CGroupControllers = append(ev.BaseEvent.ProcessContext.Process.CGroup.CGroupControllers, rv...)
+		default:
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Process.CGroup.CGroupControllers"}
+		}
+		return nil
+	case "process.cgroup.cpu.usage":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Process.CGroup.CGroupCPUUsage"}
+		}
+		ev.BaseEvent.ProcessContext.Process.CGroup.CGroupCPUUsage = uint64(rv)
+		return nil
 	case "process.cgroup.file.inode":
 		if ev.BaseEvent.ProcessContext == nil {
 			ev.BaseEvent.ProcessContext = &ProcessContext{}
@@ -38994,6 +48068,85 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.BaseEvent.ProcessContext.Process.CGroup.CGroupManager = rv
 		return nil
+	case "process.cgroup.memory.current":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Process.CGroup.CGroupMemoryCurrent"}
+		}
+		ev.BaseEvent.ProcessContext.Process.CGroup.CGroupMemoryCurrent = uint64(rv)
+		return nil
+	case "process.cgroup.memory.high_exceeded":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		rv, ok := value.(bool)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Process.CGroup.CGroupMemoryHighExceeded"}
+		}
+		ev.BaseEvent.ProcessContext.Process.CGroup.CGroupMemoryHighExceeded = rv
+		return nil
+	case "process.cgroup.pids.current":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Process.CGroup.CGroupPidsCurrent"}
+		}
+		ev.BaseEvent.ProcessContext.Process.CGroup.CGroupPidsCurrent = uint64(rv)
+		return nil
+	case "process.cloud_credentials.providers":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		switch rv := value.(type) {
+		case string:
+			ev.BaseEvent.ProcessContext.Process.CloudCredentialsProviders = append(ev.BaseEvent.ProcessContext.Process.CloudCredentialsProviders, rv)
+		case []string:
+			ev.BaseEvent.ProcessContext.Process.CloudCredentialsProviders = append(ev.BaseEvent.ProcessContext.Process.CloudCredentialsProviders, rv...)
+		default:
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Process.CloudCredentialsProviders"}
+		}
+		return nil
+	case "process.cloud_credentials.resources":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		switch rv := value.(type) {
+		case string:
+			ev.BaseEvent.ProcessContext.Process.CloudCredentialsResources = append(ev.BaseEvent.ProcessContext.Process.CloudCredentialsResources, rv)
+		case []string:
+			ev.BaseEvent.ProcessContext.Process.CloudCredentialsResources = append(ev.BaseEvent.ProcessContext.Process.CloudCredentialsResources, rv...)
+		default:
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Process.CloudCredentialsResources"}
+		}
+		return nil
+	case "process.cloud_credentials.types":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		switch rv := value.(type) {
+		case string:
+			ev.BaseEvent.ProcessContext.Process.CloudCredentialsTypes = append(ev.BaseEvent.ProcessContext.Process.CloudCredentialsTypes, rv)
+		case []string:
+			ev.BaseEvent.ProcessContext.Process.CloudCredentialsTypes = append(ev.BaseEvent.ProcessContext.Process.CloudCredentialsTypes, rv...)
+		default:
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Process.CloudCredentialsTypes"}
+		}
+		return nil
+	case "process.cmdline_obfuscation_score":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Process.CmdLineObfuscationScore"}
+		}
+		ev.BaseEvent.ProcessContext.Process.CmdLineObfuscationScore = int(rv)
+		return nil
 	case "process.comm":
 		if ev.BaseEvent.ProcessContext == nil {
 			ev.BaseEvent.ProcessContext = &ProcessContext{}
@@ -39044,6 +48197,46 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.BaseEvent.ProcessContext.Process.Credentials.EGroup = rv
 		return nil
+	case "process.elf.build_id":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Process.ELFBuildID"}
+		}
+		ev.BaseEvent.ProcessContext.Process.ELFBuildID = rv
+		return nil
+	case "process.elf.interpreter":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Process.ELFInterpreter"}
+		}
+		ev.BaseEvent.ProcessContext.Process.ELFInterpreter = rv
+		return nil
+	case "process.elf.is_static":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		rv, ok := value.(bool)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Process.ELFIsStatic"}
+		}
+		ev.BaseEvent.ProcessContext.Process.ELFIsStatic = rv
+		return nil
+	case "process.elf.is_stripped":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		rv, ok := value.(bool)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Process.ELFIsStripped"}
+		}
+		ev.BaseEvent.ProcessContext.Process.ELFIsStripped = rv
+		return nil
 	case "process.envp":
 		if ev.BaseEvent.ProcessContext == nil {
 			ev.BaseEvent.ProcessContext = &ProcessContext{}
@@ -39173,6 +48366,26 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.BaseEvent.ProcessContext.Process.FileEvent.FileFields.PathKey.Inode = uint64(rv)
 		return nil
+	case "process.file.layer.digest":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Process.FileEvent.LayerDigest"}
+		}
+		ev.BaseEvent.ProcessContext.Process.FileEvent.LayerDigest = rv
+		return nil
+	case "process.file.layer.path":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Process.FileEvent.LayerPath"}
+		}
+		ev.BaseEvent.ProcessContext.Process.FileEvent.LayerPath = rv
+		return nil
 	case "process.file.mode":
 		if ev.BaseEvent.ProcessContext == nil {
 			ev.BaseEvent.ProcessContext = &ProcessContext{}
@@ -39432,6 +48645,26 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent.FileFields.PathKey.Inode = uint64(rv)
 		return nil
+	case "process.interpreter.file.layer.digest":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent.LayerDigest"}
+		}
+		ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent.LayerDigest = rv
+		return nil
+	case "process.interpreter.file.layer.path":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent.LayerPath"}
+		}
+		ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent.LayerPath = rv
+		return nil
 	case "process.interpreter.file.mode":
 		if ev.BaseEvent.ProcessContext == nil {
 			ev.BaseEvent.ProcessContext = &ProcessContext{}
@@ -39588,6 +48821,46 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.BaseEvent.ProcessContext.Process.IsThread = rv
 		return nil
+	case "process.ns.mnt":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Process.MountNamespace"}
+		}
+		ev.BaseEvent.ProcessContext.Process.MountNamespace = uint32(rv)
+		return nil
+	case "process.ns.pid":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Process.PIDNamespace"}
+		}
+		ev.BaseEvent.ProcessContext.Process.PIDNamespace = uint32(rv)
+		return nil
+	case "process.ns.user":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Process.UserNamespace"}
+		}
+		ev.BaseEvent.ProcessContext.Process.UserNamespace = uint32(rv)
+		return nil
+	case "process.ns.uts":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Process.UTSNamespace"}
+		}
+		ev.BaseEvent.ProcessContext.Process.UTSNamespace = uint32(rv)
+		return nil
 	case "process.parent.args":
 		if ev.BaseEvent.ProcessContext == nil {
 			ev.BaseEvent.ProcessContext = &ProcessContext{}
@@ -39714,6 +48987,51 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.BaseEvent.ProcessContext.Parent.Credentials.CapPermitted = uint64(rv)
 		return nil
+	case "process.parent.cgroup.ancestors":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		if ev.BaseEvent.ProcessContext.Parent == nil {
+			ev.BaseEvent.ProcessContext.Parent = &Process{}
+		}
+		switch rv := value.(type) {
+		case string:
+			ev.BaseEvent.ProcessContext.Parent.CGroup.CGroupAncestors = append(ev.BaseEvent.ProcessContext.Parent.CGroup.CGroupAncestors, rv)
+		case []string:
+			ev.BaseEvent.ProcessContext.Parent.CGroup.CGroupAncestors = append(ev.BaseEvent.ProcessContext.Parent.CGroup.CGroupAncestors, rv...)
+		default:
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Parent.CGroup.CGroupAncestors"}
+		}
+		return nil
+	case "process.parent.cgroup.controllers":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		if ev.BaseEvent.ProcessContext.Parent == nil {
+			ev.BaseEvent.ProcessContext.Parent = &Process{}
+		}
+		switch rv := value.(type) {
+		case string:
+			ev.BaseEvent.ProcessContext.Parent.CGroup.CGroupControllers = append(ev.BaseEvent.ProcessContext.Parent.CGroup.CGroupControllers, rv)
+		case []string:
+			ev.BaseEvent.ProcessContext.Parent.CGroup.CGroupControllers = append(ev.BaseEvent.ProcessContext.Parent.CGroup.CGroupControllers, rv...)
+		default:
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Parent.CGroup.CGroupControllers"}
+		}
+		return nil
+	case "process.parent.cgroup.cpu.usage":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		if ev.BaseEvent.ProcessContext.Parent == nil {
+			ev.BaseEvent.ProcessContext.Parent = &Process{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Parent.CGroup.CGroupCPUUsage"}
+		}
+		ev.BaseEvent.ProcessContext.Parent.CGroup.CGroupCPUUsage = uint64(rv)
+		return nil
 	case "process.parent.cgroup.file.inode":
 		if ev.BaseEvent.ProcessContext == nil {
 			ev.BaseEvent.ProcessContext = &ProcessContext{}
@@ -39766,6 +49084,106 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.BaseEvent.ProcessContext.Parent.CGroup.CGroupManager = rv
 		return nil
+	case "process.parent.cgroup.memory.current":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		if ev.BaseEvent.ProcessContext.Parent == nil {
+			ev.BaseEvent.ProcessContext.Parent = &Process{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Parent.CGroup.CGroupMemoryCurrent"}
+		}
+		ev.BaseEvent.ProcessContext.Parent.CGroup.CGroupMemoryCurrent = uint64(rv)
+		return nil
+	case "process.parent.cgroup.memory.high_exceeded":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		if ev.BaseEvent.ProcessContext.Parent == nil {
+			ev.BaseEvent.ProcessContext.Parent = &Process{}
+		}
+		rv, ok := value.(bool)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Parent.CGroup.CGroupMemoryHighExceeded"}
+		}
+		ev.BaseEvent.ProcessContext.Parent.CGroup.CGroupMemoryHighExceeded = rv
+		return nil
+	case "process.parent.cgroup.pids.current":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		if ev.BaseEvent.ProcessContext.Parent == nil {
+			ev.BaseEvent.ProcessContext.Parent = &Process{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Parent.CGroup.CGroupPidsCurrent"}
+		}
+		ev.BaseEvent.ProcessContext.Parent.CGroup.CGroupPidsCurrent = uint64(rv)
+		return nil
+	case "process.parent.cloud_credentials.providers":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		if ev.BaseEvent.ProcessContext.Parent == nil {
+			ev.BaseEvent.ProcessContext.Parent = &Process{}
+		}
+		switch rv := value.(type) {
+		case string:
+			ev.BaseEvent.ProcessContext.Parent.CloudCredentialsProviders = append(ev.BaseEvent.ProcessContext.Parent.CloudCredentialsProviders, rv)
+		case []string:
+			ev.BaseEvent.ProcessContext.Parent.CloudCredentialsProviders = append(ev.BaseEvent.ProcessContext.Parent.CloudCredentialsProviders, rv...)
+		default:
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Parent.CloudCredentialsProviders"}
+		}
+		return nil
+	case "process.parent.cloud_credentials.resources":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		if ev.BaseEvent.ProcessContext.Parent == nil {
+			ev.BaseEvent.ProcessContext.Parent = &Process{}
+		}
+		switch rv := value.(type) {
+		case string:
+			ev.BaseEvent.ProcessContext.Parent.CloudCredentialsResources = append(ev.BaseEvent.ProcessContext.Parent.CloudCredentialsResources, rv)
+		case []string:
+			ev.BaseEvent.ProcessContext.Parent.CloudCredentialsResources = append(ev.BaseEvent.ProcessContext.Parent.CloudCredentialsResources, rv...)
+		default:
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Parent.CloudCredentialsResources"}
+		}
+		return nil
+	case "process.parent.cloud_credentials.types":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		if ev.BaseEvent.ProcessContext.Parent == nil {
+			ev.BaseEvent.ProcessContext.Parent = &Process{}
+		}
+		switch rv := value.(type) {
+		case string:
+			ev.BaseEvent.ProcessContext.Parent.CloudCredentialsTypes = append(ev.BaseEvent.ProcessContext.Parent.CloudCredentialsTypes, rv)
+		case []string:
+			ev.BaseEvent.ProcessContext.Parent.CloudCredentialsTypes = append(ev.BaseEvent.ProcessContext.Parent.CloudCredentialsTypes, rv...)
+		default:
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Parent.CloudCredentialsTypes"}
+		}
+		return nil
+	case "process.parent.cmdline_obfuscation_score":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		if ev.BaseEvent.ProcessContext.Parent == nil {
+			ev.BaseEvent.ProcessContext.Parent = &Process{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Parent.CmdLineObfuscationScore"}
+		}
+		ev.BaseEvent.ProcessContext.Parent.CmdLineObfuscationScore = int(rv)
+		return nil
 	case "process.parent.comm":
 		if ev.BaseEvent.ProcessContext == nil {
 			ev.BaseEvent.ProcessContext = &ProcessContext{}
@@ -39831,6 +49249,58 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.BaseEvent.ProcessContext.Parent.Credentials.EGroup = rv
 		return nil
+	case "process.parent.elf.build_id":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		if ev.BaseEvent.ProcessContext.Parent == nil {
+			ev.BaseEvent.ProcessContext.Parent = &Process{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Parent.ELFBuildID"}
+		}
+		ev.BaseEvent.ProcessContext.Parent.ELFBuildID = rv
+		return nil
+	case "process.parent.elf.interpreter":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		if ev.BaseEvent.ProcessContext.Parent == nil {
+			ev.BaseEvent.ProcessContext.Parent = &Process{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Parent.ELFInterpreter"}
+		}
+		ev.BaseEvent.ProcessContext.Parent.ELFInterpreter = rv
+		return nil
+	case "process.parent.elf.is_static":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		if ev.BaseEvent.ProcessContext.Parent == nil {
+			ev.BaseEvent.ProcessContext.Parent = &Process{}
+		}
+		rv, ok := value.(bool)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Parent.ELFIsStatic"}
+		}
+		ev.BaseEvent.ProcessContext.Parent.ELFIsStatic = rv
+		return nil
+	case "process.parent.elf.is_stripped":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		if ev.BaseEvent.ProcessContext.Parent == nil {
+			ev.BaseEvent.ProcessContext.Parent = &Process{}
+		}
+		rv, ok := value.(bool)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Parent.ELFIsStripped"}
+		}
+		ev.BaseEvent.ProcessContext.Parent.ELFIsStripped = rv
+		return nil
 	case "process.parent.envp":
 		if ev.BaseEvent.ProcessContext == nil {
 			ev.BaseEvent.ProcessContext = &ProcessContext{}
@@ -39996,6 +49466,32 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.BaseEvent.ProcessContext.Parent.FileEvent.FileFields.PathKey.Inode = uint64(rv)
 		return nil
+	case "process.parent.file.layer.digest":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		if ev.BaseEvent.ProcessContext.Parent == nil {
+			ev.BaseEvent.ProcessContext.Parent = &Process{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Parent.FileEvent.LayerDigest"}
+		}
+		ev.BaseEvent.ProcessContext.Parent.FileEvent.LayerDigest = rv
+		return nil
+	case "process.parent.file.layer.path":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		if ev.BaseEvent.ProcessContext.Parent == nil {
+			ev.BaseEvent.ProcessContext.Parent = &Process{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Parent.FileEvent.LayerPath"}
+		}
+		ev.BaseEvent.ProcessContext.Parent.FileEvent.LayerPath = rv
+		return nil
 	case "process.parent.file.mode":
 		if ev.BaseEvent.ProcessContext == nil {
 			ev.BaseEvent.ProcessContext = &ProcessContext{}
@@ -40333,6 +49829,32 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent.FileFields.PathKey.Inode = uint64(rv)
 		return nil
+	case "process.parent.interpreter.file.layer.digest":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		if ev.BaseEvent.ProcessContext.Parent == nil {
+			ev.BaseEvent.ProcessContext.Parent = &Process{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent.LayerDigest"}
+		}
+		ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent.LayerDigest = rv
+		return nil
+	case "process.parent.interpreter.file.layer.path":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		if ev.BaseEvent.ProcessContext.Parent == nil {
+			ev.BaseEvent.ProcessContext.Parent = &Process{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent.LayerPath"}
+		}
+		ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent.LayerPath = rv
+		return nil
 	case "process.parent.interpreter.file.mode":
 		if ev.BaseEvent.ProcessContext == nil {
 			ev.BaseEvent.ProcessContext = &ProcessContext{}
@@ -40537,6 +50059,58 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.BaseEvent.ProcessContext.Parent.IsThread = rv
 		return nil
+	case "process.parent.ns.mnt":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		if ev.BaseEvent.ProcessContext.Parent == nil {
+			ev.BaseEvent.ProcessContext.Parent = &Process{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Parent.MountNamespace"}
+		}
+		ev.BaseEvent.ProcessContext.Parent.MountNamespace = uint32(rv)
+		return nil
+	case "process.parent.ns.pid":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		if ev.BaseEvent.ProcessContext.Parent == nil {
+			ev.BaseEvent.ProcessContext.Parent = &Process{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Parent.PIDNamespace"}
+		}
+		ev.BaseEvent.ProcessContext.Parent.PIDNamespace = uint32(rv)
+		return nil
+	case "process.parent.ns.user":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		if ev.BaseEvent.ProcessContext.Parent == nil {
+			ev.BaseEvent.ProcessContext.Parent = &Process{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Parent.UserNamespace"}
+		}
+		ev.BaseEvent.ProcessContext.Parent.UserNamespace = uint32(rv)
+		return nil
+	case "process.parent.ns.uts":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		if ev.BaseEvent.ProcessContext.Parent == nil {
+			ev.BaseEvent.ProcessContext.Parent = &Process{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Parent.UTSNamespace"}
+		}
+		ev.BaseEvent.ProcessContext.Parent.UTSNamespace = uint32(rv)
+		return nil
 	case "process.parent.pid":
 		if ev.BaseEvent.ProcessContext == nil {
 			ev.BaseEvent.ProcessContext = &ProcessContext{}
@@ -40563,6 +50137,32 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.BaseEvent.ProcessContext.Parent.PPid = uint32(rv)
 		return nil
+	case "process.parent.systemd.slice":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		if ev.BaseEvent.ProcessContext.Parent == nil {
+			ev.BaseEvent.ProcessContext.Parent = &Process{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Parent.SystemdSlice"}
+		}
+		ev.BaseEvent.ProcessContext.Parent.SystemdSlice = rv
+		return nil
+	case "process.parent.systemd.unit":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		if ev.BaseEvent.ProcessContext.Parent == nil {
+			ev.BaseEvent.ProcessContext.Parent = &Process{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Parent.SystemdUnit"}
+		}
+		ev.BaseEvent.ProcessContext.Parent.SystemdUnit = rv
+		return nil
 	case "process.parent.tid":
 		if ev.BaseEvent.ProcessContext == nil {
 			ev.BaseEvent.ProcessContext = &ProcessContext{}
@@ -40677,6 +50277,26 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.BaseEvent.ProcessContext.Process.PPid = uint32(rv)
 		return nil
+	case "process.systemd.slice":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Process.SystemdSlice"}
+		}
+		ev.BaseEvent.ProcessContext.Process.SystemdSlice = rv
+		return nil
+	case "process.systemd.unit":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BaseEvent.ProcessContext.Process.SystemdUnit"}
+		}
+		ev.BaseEvent.ProcessContext.Process.SystemdUnit = rv
+		return nil
 	case "process.tid":
 		if ev.BaseEvent.ProcessContext == nil {
 			ev.BaseEvent.ProcessContext = &ProcessContext{}
@@ -40890,6 +50510,51 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.PTrace.Tracee.Ancestor.ProcessContext.Process.Credentials.CapPermitted = uint64(rv)
 		return nil
+	case "ptrace.tracee.ancestors.cgroup.ancestors":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		if ev.PTrace.Tracee.Ancestor == nil {
+			ev.PTrace.Tracee.Ancestor = &ProcessCacheEntry{}
+		}
+		switch rv := value.(type) {
+		case string:
+			ev.PTrace.Tracee.Ancestor.ProcessContext.Process.CGroup.CGroupAncestors = append(ev.PTrace.Tracee.Ancestor.ProcessContext.Process.CGroup.CGroupAncestors, rv)
+		case []string:
+			ev.PTrace.Tracee.Ancestor.ProcessContext.Process.CGroup.CGroupAncestors = append(ev.PTrace.Tracee.Ancestor.ProcessContext.Process.CGroup.CGroupAncestors, rv...)
+		default:
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Ancestor.ProcessContext.Process.CGroup.CGroupAncestors"}
+		}
+		return nil
+	case "ptrace.tracee.ancestors.cgroup.controllers":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		if ev.PTrace.Tracee.Ancestor == nil {
+			ev.PTrace.Tracee.Ancestor = &ProcessCacheEntry{}
+		}
+		switch rv := value.(type) {
+		case string:
+			ev.PTrace.Tracee.Ancestor.ProcessContext.Process.CGroup.CGroupControllers = append(ev.PTrace.Tracee.Ancestor.ProcessContext.Process.CGroup.CGroupControllers, rv)
+		case []string:
+			ev.PTrace.Tracee.Ancestor.ProcessContext.Process.CGroup.CGroupControllers = append(ev.PTrace.Tracee.Ancestor.ProcessContext.Process.CGroup.CGroupControllers, rv...)
+		default:
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Ancestor.ProcessContext.Process.CGroup.CGroupControllers"}
+		}
+		return nil
+	case "ptrace.tracee.ancestors.cgroup.cpu.usage":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		if ev.PTrace.Tracee.Ancestor == nil {
+			ev.PTrace.Tracee.Ancestor = &ProcessCacheEntry{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Ancestor.ProcessContext.Process.CGroup.CGroupCPUUsage"}
+		}
+		ev.PTrace.Tracee.Ancestor.ProcessContext.Process.CGroup.CGroupCPUUsage = uint64(rv)
+		return nil
 	case "ptrace.tracee.ancestors.cgroup.file.inode":
 		if ev.PTrace.Tracee == nil {
 			ev.PTrace.Tracee = &ProcessContext{}
@@ -40942,6 +50607,106 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.PTrace.Tracee.Ancestor.ProcessContext.Process.CGroup.CGroupManager = rv
 		return nil
+	case "ptrace.tracee.ancestors.cgroup.memory.current":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		if ev.PTrace.Tracee.Ancestor == nil {
+			ev.PTrace.Tracee.Ancestor = &ProcessCacheEntry{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Ancestor.ProcessContext.Process.CGroup.CGroupMemoryCurrent"}
+		}
+		ev.PTrace.Tracee.Ancestor.ProcessContext.Process.CGroup.CGroupMemoryCurrent = uint64(rv)
+		return nil
+	case "ptrace.tracee.ancestors.cgroup.memory.high_exceeded":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		if ev.PTrace.Tracee.Ancestor == nil {
+			ev.PTrace.Tracee.Ancestor = &ProcessCacheEntry{}
+		}
+		rv, ok := value.(bool)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Ancestor.ProcessContext.Process.CGroup.CGroupMemoryHighExceeded"}
+		}
+		ev.PTrace.Tracee.Ancestor.ProcessContext.Process.CGroup.CGroupMemoryHighExceeded = rv
+		return nil
+	case "ptrace.tracee.ancestors.cgroup.pids.current":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		if ev.PTrace.Tracee.Ancestor == nil {
+			ev.PTrace.Tracee.Ancestor = &ProcessCacheEntry{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Ancestor.ProcessContext.Process.CGroup.CGroupPidsCurrent"}
+		}
+		ev.PTrace.Tracee.Ancestor.ProcessContext.Process.CGroup.CGroupPidsCurrent = uint64(rv)
+		return nil
+	case "ptrace.tracee.ancestors.cloud_credentials.providers":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		if ev.PTrace.Tracee.Ancestor == nil {
+			ev.PTrace.Tracee.Ancestor = &ProcessCacheEntry{}
+		}
+		switch rv := value.(type) {
+		case string:
+			ev.PTrace.Tracee.Ancestor.ProcessContext.Process.CloudCredentialsProviders = append(ev.PTrace.Tracee.Ancestor.ProcessContext.Process.CloudCredentialsProviders, rv)
+		case []string:
+			ev.PTrace.Tracee.Ancestor.ProcessContext.Process.CloudCredentialsProviders = append(ev.PTrace.Tracee.Ancestor.ProcessContext.Process.CloudCredentialsProviders, rv...)
+		default:
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Ancestor.ProcessContext.Process.CloudCredentialsProviders"}
+		}
+		return nil
+	case "ptrace.tracee.ancestors.cloud_credentials.resources":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		if ev.PTrace.Tracee.Ancestor == nil {
+			ev.PTrace.Tracee.Ancestor = &ProcessCacheEntry{}
+		}
+		switch rv := value.(type) {
+		case string:
+			ev.PTrace.Tracee.Ancestor.ProcessContext.Process.CloudCredentialsResources = append(ev.PTrace.Tracee.Ancestor.ProcessContext.Process.CloudCredentialsResources, rv)
+		case []string:
+			ev.PTrace.Tracee.Ancestor.ProcessContext.Process.CloudCredentialsResources = append(ev.PTrace.Tracee.Ancestor.ProcessContext.Process.CloudCredentialsResources, rv...)
+		default:
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Ancestor.ProcessContext.Process.CloudCredentialsResources"}
+		}
+		return nil
+	case "ptrace.tracee.ancestors.cloud_credentials.types":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		if ev.PTrace.Tracee.Ancestor == nil {
+			ev.PTrace.Tracee.Ancestor = &ProcessCacheEntry{}
+		}
+		switch rv := value.(type) {
+		case string:
+			ev.PTrace.Tracee.Ancestor.ProcessContext.Process.CloudCredentialsTypes = append(ev.PTrace.Tracee.Ancestor.ProcessContext.Process.CloudCredentialsTypes, rv)
+		case []string:
+			ev.PTrace.Tracee.Ancestor.ProcessContext.Process.CloudCredentialsTypes = append(ev.PTrace.Tracee.Ancestor.ProcessContext.Process.CloudCredentialsTypes, rv...)
+		default:
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Ancestor.ProcessContext.Process.CloudCredentialsTypes"}
+		}
+		return nil
+	case "ptrace.tracee.ancestors.cmdline_obfuscation_score":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		if ev.PTrace.Tracee.Ancestor == nil {
+			ev.PTrace.Tracee.Ancestor = &ProcessCacheEntry{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Ancestor.ProcessContext.Process.CmdLineObfuscationScore"}
+		}
+		ev.PTrace.Tracee.Ancestor.ProcessContext.Process.CmdLineObfuscationScore = int(rv)
+		return nil
 	case "ptrace.tracee.ancestors.comm":
 		if ev.PTrace.Tracee == nil {
 			ev.PTrace.Tracee = &ProcessContext{}
@@ -41007,6 +50772,58 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.PTrace.Tracee.Ancestor.ProcessContext.Process.Credentials.EGroup = rv
 		return nil
+	case "ptrace.tracee.ancestors.elf.build_id":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		if ev.PTrace.Tracee.Ancestor == nil {
+			ev.PTrace.Tracee.Ancestor = &ProcessCacheEntry{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Ancestor.ProcessContext.Process.ELFBuildID"}
+		}
+		ev.PTrace.Tracee.Ancestor.ProcessContext.Process.ELFBuildID = rv
+		return nil
+	case "ptrace.tracee.ancestors.elf.interpreter":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		if ev.PTrace.Tracee.Ancestor == nil {
+			ev.PTrace.Tracee.Ancestor = &ProcessCacheEntry{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Ancestor.ProcessContext.Process.ELFInterpreter"}
+		}
+		ev.PTrace.Tracee.Ancestor.ProcessContext.Process.ELFInterpreter = rv
+		return nil
+	case "ptrace.tracee.ancestors.elf.is_static":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		if ev.PTrace.Tracee.Ancestor == nil {
+			ev.PTrace.Tracee.Ancestor = &ProcessCacheEntry{}
+		}
+		rv, ok := value.(bool)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Ancestor.ProcessContext.Process.ELFIsStatic"}
+		}
+		ev.PTrace.Tracee.Ancestor.ProcessContext.Process.ELFIsStatic = rv
+		return nil
+	case "ptrace.tracee.ancestors.elf.is_stripped":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		if ev.PTrace.Tracee.Ancestor == nil {
+			ev.PTrace.Tracee.Ancestor = &ProcessCacheEntry{}
+		}
+		rv, ok := value.(bool)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Ancestor.ProcessContext.Process.ELFIsStripped"}
+		}
+		ev.PTrace.Tracee.Ancestor.ProcessContext.Process.ELFIsStripped = rv
+		return nil
 	case "ptrace.tracee.ancestors.envp":
 		if ev.PTrace.Tracee == nil {
 			ev.PTrace.Tracee = &ProcessContext{}
@@ -41172,6 +50989,32 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.PTrace.Tracee.Ancestor.ProcessContext.Process.FileEvent.FileFields.PathKey.Inode = uint64(rv)
 		return nil
+	case "ptrace.tracee.ancestors.file.layer.digest":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		if ev.PTrace.Tracee.Ancestor == nil {
+			ev.PTrace.Tracee.Ancestor = &ProcessCacheEntry{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Ancestor.ProcessContext.Process.FileEvent.LayerDigest"}
+		}
+		ev.PTrace.Tracee.Ancestor.ProcessContext.Process.FileEvent.LayerDigest = rv
+		return nil
+	case "ptrace.tracee.ancestors.file.layer.path":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		if ev.PTrace.Tracee.Ancestor == nil {
+			ev.PTrace.Tracee.Ancestor = &ProcessCacheEntry{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Ancestor.ProcessContext.Process.FileEvent.LayerPath"}
+		}
+		ev.PTrace.Tracee.Ancestor.ProcessContext.Process.FileEvent.LayerPath = rv
+		return nil
 	case "ptrace.tracee.ancestors.file.mode":
 		if ev.PTrace.Tracee == nil {
 			ev.PTrace.Tracee = &ProcessContext{}
@@ -41509,6 +51352,32 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.PTrace.Tracee.Ancestor.ProcessContext.Process.LinuxBinprm.FileEvent.FileFields.PathKey.Inode = uint64(rv)
 		return nil
+	case "ptrace.tracee.ancestors.interpreter.file.layer.digest":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		if ev.PTrace.Tracee.Ancestor == nil {
+			ev.PTrace.Tracee.Ancestor = &ProcessCacheEntry{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Ancestor.ProcessContext.Process.LinuxBinprm.FileEvent.LayerDigest"}
+		}
+		ev.PTrace.Tracee.Ancestor.ProcessContext.Process.LinuxBinprm.FileEvent.LayerDigest = rv
+		return nil
+	case "ptrace.tracee.ancestors.interpreter.file.layer.path":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		if ev.PTrace.Tracee.Ancestor == nil {
+			ev.PTrace.Tracee.Ancestor = &ProcessCacheEntry{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Ancestor.ProcessContext.Process.LinuxBinprm.FileEvent.LayerPath"}
+		}
+		ev.PTrace.Tracee.Ancestor.ProcessContext.Process.LinuxBinprm.FileEvent.LayerPath = rv
+		return nil
 	case "ptrace.tracee.ancestors.interpreter.file.mode":
 		if ev.PTrace.Tracee == nil {
 			ev.PTrace.Tracee = &ProcessContext{}
@@ -41721,6 +51590,58 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 			ev.PTrace.Tracee.Ancestor = &ProcessCacheEntry{}
 		}
 		return &eval.ErrFieldReadOnly{Field: "ptrace.tracee.ancestors.length"}
+	case "ptrace.tracee.ancestors.ns.mnt":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		if ev.PTrace.Tracee.Ancestor == nil {
+			ev.PTrace.Tracee.Ancestor = &ProcessCacheEntry{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Ancestor.ProcessContext.Process.MountNamespace"}
+		}
+		ev.PTrace.Tracee.Ancestor.ProcessContext.Process.MountNamespace = uint32(rv)
+		return nil
+	case "ptrace.tracee.ancestors.ns.pid":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		if ev.PTrace.Tracee.Ancestor == nil {
+			ev.PTrace.Tracee.Ancestor = &ProcessCacheEntry{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Ancestor.ProcessContext.Process.PIDNamespace"}
+		}
+		ev.PTrace.Tracee.Ancestor.ProcessContext.Process.PIDNamespace = uint32(rv)
+		return nil
+	case "ptrace.tracee.ancestors.ns.user":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		if ev.PTrace.Tracee.Ancestor == nil {
+			ev.PTrace.Tracee.Ancestor = &ProcessCacheEntry{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Ancestor.ProcessContext.Process.UserNamespace"}
+		}
+		ev.PTrace.Tracee.Ancestor.ProcessContext.Process.UserNamespace = uint32(rv)
+		return nil
+	case "ptrace.tracee.ancestors.ns.uts":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		if ev.PTrace.Tracee.Ancestor == nil {
+			ev.PTrace.Tracee.Ancestor = &ProcessCacheEntry{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Ancestor.ProcessContext.Process.UTSNamespace"}
+		}
+		ev.PTrace.Tracee.Ancestor.ProcessContext.Process.UTSNamespace = uint32(rv)
+		return nil
 	case "ptrace.tracee.ancestors.pid":
 		if ev.PTrace.Tracee == nil {
 			ev.PTrace.Tracee = &ProcessContext{}
@@ -41747,6 +51668,32 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.PTrace.Tracee.Ancestor.ProcessContext.Process.PPid = uint32(rv)
 		return nil
+	case "ptrace.tracee.ancestors.systemd.slice":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		if ev.PTrace.Tracee.Ancestor == nil {
+			ev.PTrace.Tracee.Ancestor = &ProcessCacheEntry{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Ancestor.ProcessContext.Process.SystemdSlice"}
+		}
+		ev.PTrace.Tracee.Ancestor.ProcessContext.Process.SystemdSlice = rv
+		return nil
+	case "ptrace.tracee.ancestors.systemd.unit":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		if ev.PTrace.Tracee.Ancestor == nil {
+			ev.PTrace.Tracee.Ancestor = &ProcessCacheEntry{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Ancestor.ProcessContext.Process.SystemdUnit"}
+		}
+		ev.PTrace.Tracee.Ancestor.ProcessContext.Process.SystemdUnit = rv
+		return nil
 	case "ptrace.tracee.ancestors.tid":
 		if ev.PTrace.Tracee == nil {
 			ev.PTrace.Tracee = &ProcessContext{}
@@ -41940,6 +51887,42 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.PTrace.Tracee.Process.Credentials.CapPermitted = uint64(rv)
 		return nil
+	case "ptrace.tracee.cgroup.ancestors":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		switch rv := value.(type) {
+		case string:
+			ev.PTrace.Tracee.Process.CGroup.CGroupAncestors = append(ev.PTrace.Tracee.Process.CGroup.CGroupAncestors, rv)
+		case []string:
+			ev.PTrace.Tracee.Process.CGroup.CGroupAncestors = append(ev.PTrace.Tracee.Process.CGroup.CGroupAncestors, rv...)
+		default:
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Process.CGroup.CGroupAncestors"}
+		}
+		return nil
+	case "ptrace.tracee.cgroup.controllers":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		switch rv := value.(type) {
+		case string:
+			ev.PTrace.Tracee.Process.CGroup.CGroupControllers = append(ev.PTrace.Tracee.Process.CGroup.CGroupControllers, rv)
+		case []string:
+			ev.PTrace.Tracee.Process.CGroup.CGroupControllers = append(ev.PTrace.Tracee.Process.CGroup.CGroupControllers, rv...)
+		default:
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Process.CGroup.CGroupControllers"}
+		}
+		return nil
+	case "ptrace.tracee.cgroup.cpu.usage":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Process.CGroup.CGroupCPUUsage"}
+		}
+		ev.PTrace.Tracee.Process.CGroup.CGroupCPUUsage = uint64(rv)
+		return nil
 	case "ptrace.tracee.cgroup.file.inode":
 		if ev.PTrace.Tracee == nil {
 			ev.PTrace.Tracee = &ProcessContext{}
@@ -41980,6 +51963,85 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.PTrace.Tracee.Process.CGroup.CGroupManager = rv
 		return nil
+	case "ptrace.tracee.cgroup.memory.current":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Process.CGroup.CGroupMemoryCurrent"}
+		}
+		ev.PTrace.Tracee.Process.CGroup.CGroupMemoryCurrent = uint64(rv)
+		return nil
+	case "ptrace.tracee.cgroup.memory.high_exceeded":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		rv, ok := value.(bool)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Process.CGroup.CGroupMemoryHighExceeded"}
+		}
+		ev.PTrace.Tracee.Process.CGroup.CGroupMemoryHighExceeded = rv
+		return nil
+	case "ptrace.tracee.cgroup.pids.current":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Process.CGroup.CGroupPidsCurrent"}
+		}
+		ev.PTrace.Tracee.Process.CGroup.CGroupPidsCurrent = uint64(rv)
+		return nil
+	case "ptrace.tracee.cloud_credentials.providers":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		switch rv := value.(type) {
+		case string:
+			ev.PTrace.Tracee.Process.CloudCredentialsProviders = append(ev.PTrace.Tracee.Process.CloudCredentialsProviders, rv)
+		case []string:
+			ev.PTrace.Tracee.Process.CloudCredentialsProviders = append(ev.PTrace.Tracee.Process.CloudCredentialsProviders, rv...)
+		default:
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Process.CloudCredentialsProviders"}
+		}
+		return nil
+	case "ptrace.tracee.cloud_credentials.resources":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		switch rv := value.(type) {
+		case string:
+			ev.PTrace.Tracee.Process.CloudCredentialsResources = append(ev.PTrace.Tracee.Process.CloudCredentialsResources, rv)
+		case []string:
+			ev.PTrace.Tracee.Process.CloudCredentialsResources = append(ev.PTrace.Tracee.Process.CloudCredentialsResources, rv...)
+		default:
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Process.CloudCredentialsResources"}
+		}
+		return nil
+	case "ptrace.tracee.cloud_credentials.types":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		switch rv := value.(type) {
+		case string:
+			ev.PTrace.Tracee.Process.CloudCredentialsTypes = append(ev.PTrace.Tracee.Process.CloudCredentialsTypes, rv)
+		case []string:
+			ev.PTrace.Tracee.Process.CloudCredentialsTypes = append(ev.PTrace.Tracee.Process.CloudCredentialsTypes, rv...)
+		default:
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Process.CloudCredentialsTypes"}
+		}
+		return nil
+	case "ptrace.tracee.cmdline_obfuscation_score":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Process.CmdLineObfuscationScore"}
+		}
+		ev.PTrace.Tracee.Process.CmdLineObfuscationScore = int(rv)
+		return nil
 	case "ptrace.tracee.comm":
 		if ev.PTrace.Tracee == nil {
 			ev.PTrace.Tracee = &ProcessContext{}
@@ -42030,6 +52092,46 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.PTrace.Tracee.Process.Credentials.EGroup = rv
 		return nil
+	case "ptrace.tracee.elf.build_id":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Process.ELFBuildID"}
+		}
+		ev.PTrace.Tracee.Process.ELFBuildID = rv
+		return nil
+	case "ptrace.tracee.elf.interpreter":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Process.ELFInterpreter"}
+		}
+		ev.PTrace.Tracee.Process.ELFInterpreter = rv
+		return nil
+	case "ptrace.tracee.elf.is_static":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		rv, ok := value.(bool)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Process.ELFIsStatic"}
+		}
+		ev.PTrace.Tracee.Process.ELFIsStatic = rv
+		return nil
+	case "ptrace.tracee.elf.is_stripped":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		rv, ok := value.(bool)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Process.ELFIsStripped"}
+		}
+		ev.PTrace.Tracee.Process.ELFIsStripped = rv
+		return nil
 	case "ptrace.tracee.envp":
 		if ev.PTrace.Tracee == nil {
 			ev.PTrace.Tracee = &ProcessContext{}
@@ -42159,6 +52261,26 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.PTrace.Tracee.Process.FileEvent.FileFields.PathKey.Inode = uint64(rv)
 		return nil
+	case "ptrace.tracee.file.layer.digest":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Process.FileEvent.LayerDigest"}
+		}
+		ev.PTrace.Tracee.Process.FileEvent.LayerDigest = rv
+		return nil
+	case "ptrace.tracee.file.layer.path":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Process.FileEvent.LayerPath"}
+		}
+		ev.PTrace.Tracee.Process.FileEvent.LayerPath = rv
+		return nil
 	case "ptrace.tracee.file.mode":
 		if ev.PTrace.Tracee == nil {
 			ev.PTrace.Tracee = &ProcessContext{}
@@ -42418,6 +52540,26 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.PTrace.Tracee.Process.LinuxBinprm.FileEvent.FileFields.PathKey.Inode = uint64(rv)
 		return nil
+	case "ptrace.tracee.interpreter.file.layer.digest":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Process.LinuxBinprm.FileEvent.LayerDigest"}
+		}
+		ev.PTrace.Tracee.Process.LinuxBinprm.FileEvent.LayerDigest = rv
+		return nil
+	case "ptrace.tracee.interpreter.file.layer.path":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Process.LinuxBinprm.FileEvent.LayerPath"}
+		}
+		ev.PTrace.Tracee.Process.LinuxBinprm.FileEvent.LayerPath = rv
+		return nil
 	case "ptrace.tracee.interpreter.file.mode":
 		if ev.PTrace.Tracee == nil {
 			ev.PTrace.Tracee = &ProcessContext{}
@@ -42574,6 +52716,46 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.PTrace.Tracee.Process.IsThread = rv
 		return nil
+	case "ptrace.tracee.ns.mnt":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Process.MountNamespace"}
+		}
+		ev.PTrace.Tracee.Process.MountNamespace = uint32(rv)
+		return nil
+	case "ptrace.tracee.ns.pid":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Process.PIDNamespace"}
+		}
+		ev.PTrace.Tracee.Process.PIDNamespace = uint32(rv)
+		return nil
+	case "ptrace.tracee.ns.user":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Process.UserNamespace"}
+		}
+		ev.PTrace.Tracee.Process.UserNamespace = uint32(rv)
+		return nil
+	case "ptrace.tracee.ns.uts":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Process.UTSNamespace"}
+		}
+		ev.PTrace.Tracee.Process.UTSNamespace = uint32(rv)
+		return nil
 	case "ptrace.tracee.parent.args":
 		if ev.PTrace.Tracee == nil {
 			ev.PTrace.Tracee = &ProcessContext{}
@@ -42700,6 +52882,51 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.PTrace.Tracee.Parent.Credentials.CapPermitted = uint64(rv)
 		return nil
+	case "ptrace.tracee.parent.cgroup.ancestors":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		if ev.PTrace.Tracee.Parent == nil {
+			ev.PTrace.Tracee.Parent = &Process{}
+		}
+		switch rv := value.(type) {
+		case string:
+			ev.PTrace.Tracee.Parent.CGroup.CGroupAncestors = append(ev.PTrace.Tracee.Parent.CGroup.CGroupAncestors, rv)
+		case []string:
+			ev.PTrace.Tracee.Parent.CGroup.CGroupAncestors = append(ev.PTrace.Tracee.Parent.CGroup.CGroupAncestors, rv...)
+		default:
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Parent.CGroup.CGroupAncestors"}
+		}
+		return nil
+	case "ptrace.tracee.parent.cgroup.controllers":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		if ev.PTrace.Tracee.Parent == nil {
+			ev.PTrace.Tracee.Parent = &Process{}
+		}
+		switch rv := value.(type) {
+		case string:
+			ev.PTrace.Tracee.Parent.CGroup.CGroupControllers = append(ev.PTrace.Tracee.Parent.CGroup.CGroupControllers, rv)
+		case []string:
+			ev.PTrace.Tracee.Parent.CGroup.CGroupControllers = append(ev.PTrace.Tracee.Parent.CGroup.CGroupControllers, rv...)
+		default:
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Parent.CGroup.CGroupControllers"}
+		}
+		return nil
+	case "ptrace.tracee.parent.cgroup.cpu.usage":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		if ev.PTrace.Tracee.Parent == nil {
+			ev.PTrace.Tracee.Parent = &Process{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Parent.CGroup.CGroupCPUUsage"}
+		}
+		ev.PTrace.Tracee.Parent.CGroup.CGroupCPUUsage = uint64(rv)
+		return nil
 	case "ptrace.tracee.parent.cgroup.file.inode":
 		if ev.PTrace.Tracee == nil {
 			ev.PTrace.Tracee = &ProcessContext{}
@@ -42752,6 +52979,106 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.PTrace.Tracee.Parent.CGroup.CGroupManager = rv
 		return nil
+	case "ptrace.tracee.parent.cgroup.memory.current":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		if ev.PTrace.Tracee.Parent == nil {
+			ev.PTrace.Tracee.Parent = &Process{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Parent.CGroup.CGroupMemoryCurrent"}
+		}
+		ev.PTrace.Tracee.Parent.CGroup.CGroupMemoryCurrent = uint64(rv)
+		return nil
+	case "ptrace.tracee.parent.cgroup.memory.high_exceeded":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		if ev.PTrace.Tracee.Parent == nil {
+			ev.PTrace.Tracee.Parent = &Process{}
+		}
+		rv, ok := value.(bool)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Parent.CGroup.CGroupMemoryHighExceeded"}
+		}
+		ev.PTrace.Tracee.Parent.CGroup.CGroupMemoryHighExceeded = rv
+		return nil
+	case "ptrace.tracee.parent.cgroup.pids.current":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		if ev.PTrace.Tracee.Parent == nil {
+			ev.PTrace.Tracee.Parent = &Process{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Parent.CGroup.CGroupPidsCurrent"}
+		}
+		ev.PTrace.Tracee.Parent.CGroup.CGroupPidsCurrent = uint64(rv)
+		return nil
+	case "ptrace.tracee.parent.cloud_credentials.providers":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		if ev.PTrace.Tracee.Parent == nil {
+			ev.PTrace.Tracee.Parent = &Process{}
+		}
+		switch rv := value.(type) {
+		case string:
+			ev.PTrace.Tracee.Parent.CloudCredentialsProviders = append(ev.PTrace.Tracee.Parent.CloudCredentialsProviders, rv)
+		case []string:
+			ev.PTrace.Tracee.Parent.CloudCredentialsProviders = append(ev.PTrace.Tracee.Parent.CloudCredentialsProviders, rv...)
+		default:
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Parent.CloudCredentialsProviders"}
+		}
+		return nil
+	case "ptrace.tracee.parent.cloud_credentials.resources":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		if ev.PTrace.Tracee.Parent == nil {
+			ev.PTrace.Tracee.Parent = &Process{}
+		}
+		switch rv := value.(type) {
+		case string:
+			ev.PTrace.Tracee.Parent.CloudCredentialsResources = append(ev.PTrace.Tracee.Parent.CloudCredentialsResources, rv)
+		case []string:
+			ev.PTrace.Tracee.Parent.CloudCredentialsResources = append(ev.PTrace.Tracee.Parent.CloudCredentialsResources, rv...)
+		default:
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Parent.CloudCredentialsResources"}
+		}
+		return nil
+	case "ptrace.tracee.parent.cloud_credentials.types":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		if ev.PTrace.Tracee.Parent == nil {
+			ev.PTrace.Tracee.Parent = &Process{}
+		}
+		switch rv := value.(type) {
+		case string:
+			ev.PTrace.Tracee.Parent.CloudCredentialsTypes = append(ev.PTrace.Tracee.Parent.CloudCredentialsTypes, rv)
+		case []string:
+			ev.PTrace.Tracee.Parent.CloudCredentialsTypes = append(ev.PTrace.Tracee.Parent.CloudCredentialsTypes, rv...)
+		default:
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Parent.CloudCredentialsTypes"}
+		}
+		return nil
+	case "ptrace.tracee.parent.cmdline_obfuscation_score":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		if ev.PTrace.Tracee.Parent == nil {
+			ev.PTrace.Tracee.Parent = &Process{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Parent.CmdLineObfuscationScore"}
+		}
+		ev.PTrace.Tracee.Parent.CmdLineObfuscationScore = int(rv)
+		return nil
 	case "ptrace.tracee.parent.comm":
 		if ev.PTrace.Tracee == nil {
 			ev.PTrace.Tracee = &ProcessContext{}
@@ -42817,6 +53144,58 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.PTrace.Tracee.Parent.Credentials.EGroup = rv
 		return nil
+	case "ptrace.tracee.parent.elf.build_id":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		if ev.PTrace.Tracee.Parent == nil {
+			ev.PTrace.Tracee.Parent = &Process{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Parent.ELFBuildID"}
+		}
+		ev.PTrace.Tracee.Parent.ELFBuildID = rv
+		return nil
+	case "ptrace.tracee.parent.elf.interpreter":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		if ev.PTrace.Tracee.Parent == nil {
+			ev.PTrace.Tracee.Parent = &Process{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Parent.ELFInterpreter"}
+		}
+		ev.PTrace.Tracee.Parent.ELFInterpreter = rv
+		return nil
+	case "ptrace.tracee.parent.elf.is_static":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		if ev.PTrace.Tracee.Parent == nil {
+			ev.PTrace.Tracee.Parent = &Process{}
+		}
+		rv, ok := value.(bool)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Parent.ELFIsStatic"}
+		}
+		ev.PTrace.Tracee.Parent.ELFIsStatic = rv
+		return nil
+	case "ptrace.tracee.parent.elf.is_stripped":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		if ev.PTrace.Tracee.Parent == nil {
+			ev.PTrace.Tracee.Parent = &Process{}
+		}
+		rv, ok := value.(bool)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Parent.ELFIsStripped"}
+		}
+		ev.PTrace.Tracee.Parent.ELFIsStripped = rv
+		return nil
 	case "ptrace.tracee.parent.envp":
 		if ev.PTrace.Tracee == nil {
 			ev.PTrace.Tracee = &ProcessContext{}
@@ -42982,6 +53361,32 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.PTrace.Tracee.Parent.FileEvent.FileFields.PathKey.Inode = uint64(rv)
 		return nil
+	case "ptrace.tracee.parent.file.layer.digest":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		if ev.PTrace.Tracee.Parent == nil {
+			ev.PTrace.Tracee.Parent = &Process{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Parent.FileEvent.LayerDigest"}
+		}
+		ev.PTrace.Tracee.Parent.FileEvent.LayerDigest = rv
+		return nil
+	case "ptrace.tracee.parent.file.layer.path":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		if ev.PTrace.Tracee.Parent == nil {
+			ev.PTrace.Tracee.Parent = &Process{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Parent.FileEvent.LayerPath"}
+		}
+		ev.PTrace.Tracee.Parent.FileEvent.LayerPath = rv
+		return nil
 	case "ptrace.tracee.parent.file.mode":
 		if ev.PTrace.Tracee == nil {
 			ev.PTrace.Tracee = &ProcessContext{}
@@ -43319,6 +53724,32 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.PTrace.Tracee.Parent.LinuxBinprm.FileEvent.FileFields.PathKey.Inode = uint64(rv)
 		return nil
+	case "ptrace.tracee.parent.interpreter.file.layer.digest":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		if ev.PTrace.Tracee.Parent == nil {
+			ev.PTrace.Tracee.Parent = &Process{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Parent.LinuxBinprm.FileEvent.LayerDigest"}
+		}
+		ev.PTrace.Tracee.Parent.LinuxBinprm.FileEvent.LayerDigest = rv
+		return nil
+	case "ptrace.tracee.parent.interpreter.file.layer.path":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		if ev.PTrace.Tracee.Parent == nil {
+			ev.PTrace.Tracee.Parent = &Process{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Parent.LinuxBinprm.FileEvent.LayerPath"}
+		}
+		ev.PTrace.Tracee.Parent.LinuxBinprm.FileEvent.LayerPath = rv
+		return nil
 	case "ptrace.tracee.parent.interpreter.file.mode":
 		if ev.PTrace.Tracee == nil {
 			ev.PTrace.Tracee = &ProcessContext{}
@@ -43523,6 +53954,58 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.PTrace.Tracee.Parent.IsThread = rv
 		return nil
+	case "ptrace.tracee.parent.ns.mnt":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		if ev.PTrace.Tracee.Parent == nil {
+			ev.PTrace.Tracee.Parent = &Process{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Parent.MountNamespace"}
+		}
+		ev.PTrace.Tracee.Parent.MountNamespace = uint32(rv)
+		return nil
+	case "ptrace.tracee.parent.ns.pid":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		if ev.PTrace.Tracee.Parent == nil {
+			ev.PTrace.Tracee.Parent = &Process{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Parent.PIDNamespace"}
+		}
+		ev.PTrace.Tracee.Parent.PIDNamespace = uint32(rv)
+		return nil
+	case "ptrace.tracee.parent.ns.user":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		if ev.PTrace.Tracee.Parent == nil {
+			ev.PTrace.Tracee.Parent = &Process{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Parent.UserNamespace"}
+		}
+		ev.PTrace.Tracee.Parent.UserNamespace = uint32(rv)
+		return nil
+	case "ptrace.tracee.parent.ns.uts":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		if ev.PTrace.Tracee.Parent == nil {
+			ev.PTrace.Tracee.Parent = &Process{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Parent.UTSNamespace"}
+		}
+		ev.PTrace.Tracee.Parent.UTSNamespace = uint32(rv)
+		return nil
 	case "ptrace.tracee.parent.pid":
 		if ev.PTrace.Tracee == nil {
 			ev.PTrace.Tracee = &ProcessContext{}
@@ -43549,6 +54032,32 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.PTrace.Tracee.Parent.PPid = uint32(rv)
 		return nil
+	case "ptrace.tracee.parent.systemd.slice":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		if ev.PTrace.Tracee.Parent == nil {
+			ev.PTrace.Tracee.Parent = &Process{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Parent.SystemdSlice"}
+		}
+		ev.PTrace.Tracee.Parent.SystemdSlice = rv
+		return nil
+	case "ptrace.tracee.parent.systemd.unit":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		if ev.PTrace.Tracee.Parent == nil {
+			ev.PTrace.Tracee.Parent = &Process{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Parent.SystemdUnit"}
+		}
+		ev.PTrace.Tracee.Parent.SystemdUnit = rv
+		return nil
 	case "ptrace.tracee.parent.tid":
 		if ev.PTrace.Tracee == nil {
 			ev.PTrace.Tracee = &ProcessContext{}
@@ -43663,6 +54172,26 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.PTrace.Tracee.Process.PPid = uint32(rv)
 		return nil
+	case "ptrace.tracee.systemd.slice":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Process.SystemdSlice"}
+		}
+		ev.PTrace.Tracee.Process.SystemdSlice = rv
+		return nil
+	case "ptrace.tracee.systemd.unit":
+		if ev.PTrace.Tracee == nil {
+			ev.PTrace.Tracee = &ProcessContext{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Process.SystemdUnit"}
+		}
+		ev.PTrace.Tracee.Process.SystemdUnit = rv
+		return nil
 	case "ptrace.tracee.tid":
 		if ev.PTrace.Tracee == nil {
 			ev.PTrace.Tracee = &ProcessContext{}
@@ -43802,6 +54331,20 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.RemoveXAttr.File.FileFields.PathKey.Inode = uint64(rv)
 		return nil
+	case "removexattr.file.layer.digest":
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "RemoveXAttr.File.LayerDigest"}
+		}
+		ev.RemoveXAttr.File.LayerDigest = rv
+		return nil
+	case "removexattr.file.layer.path":
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "RemoveXAttr.File.LayerPath"}
+		}
+		ev.RemoveXAttr.File.LayerPath = rv
+		return nil
 	case "removexattr.file.mode":
 		rv, ok := value.(int)
 		if !ok {
@@ -43955,6 +54498,20 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.Rename.New.FileFields.PathKey.Inode = uint64(rv)
 		return nil
+	case "rename.file.destination.layer.digest":
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Rename.New.LayerDigest"}
+		}
+		ev.Rename.New.LayerDigest = rv
+		return nil
+	case "rename.file.destination.layer.path":
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Rename.New.LayerPath"}
+		}
+		ev.Rename.New.LayerPath = rv
+		return nil
 	case "rename.file.destination.mode":
 		rv, ok := value.(int)
 		if !ok {
@@ -44087,6 +54644,20 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.Rename.Old.FileFields.PathKey.Inode = uint64(rv)
 		return nil
+	case "rename.file.layer.digest":
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Rename.Old.LayerDigest"}
+		}
+		ev.Rename.Old.LayerDigest = rv
+		return nil
+	case "rename.file.layer.path":
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Rename.Old.LayerPath"}
+		}
+		ev.Rename.Old.LayerPath = rv
+		return nil
 	case "rename.file.mode":
 		rv, ok := value.(int)
 		if !ok {
@@ -44247,6 +54818,20 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.Rmdir.File.FileFields.PathKey.Inode = uint64(rv)
 		return nil
+	case "rmdir.file.layer.digest":
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Rmdir.File.LayerDigest"}
+		}
+		ev.Rmdir.File.LayerDigest = rv
+		return nil
+	case "rmdir.file.layer.path":
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Rmdir.File.LayerPath"}
+		}
+		ev.Rmdir.File.LayerPath = rv
+		return nil
 	case "rmdir.file.mode":
 		rv, ok := value.(int)
 		if !ok {
@@ -44519,6 +55104,20 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.SetXAttr.File.FileFields.PathKey.Inode = uint64(rv)
 		return nil
+	case "setxattr.file.layer.digest":
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "SetXAttr.File.LayerDigest"}
+		}
+		ev.SetXAttr.File.LayerDigest = rv
+		return nil
+	case "setxattr.file.layer.path":
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "SetXAttr.File.LayerPath"}
+		}
+		ev.SetXAttr.File.LayerPath = rv
+		return nil
 	case "setxattr.file.mode":
 		rv, ok := value.(int)
 		if !ok {
@@ -44753,6 +55352,51 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.Signal.Target.Ancestor.ProcessContext.Process.Credentials.CapPermitted = uint64(rv)
 		return nil
+	case "signal.target.ancestors.cgroup.ancestors":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		if ev.Signal.Target.Ancestor == nil {
+			ev.Signal.Target.Ancestor = &ProcessCacheEntry{}
+		}
+		switch rv := value.(type) {
+		case string:
+			ev.Signal.Target.Ancestor.ProcessContext.Process.CGroup.CGroupAncestors = append(ev.Signal.Target.Ancestor.ProcessContext.Process.CGroup.CGroupAncestors, rv)
+		case []string:
+			ev.Signal.Target.Ancestor.ProcessContext.Process.CGroup.CGroupAncestors = append(ev.Signal.Target.Ancestor.ProcessContext.Process.CGroup.CGroupAncestors, rv...)
+		default:
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Ancestor.ProcessContext.Process.CGroup.CGroupAncestors"}
+		}
+		return nil
+	case "signal.target.ancestors.cgroup.controllers":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		if ev.Signal.Target.Ancestor == nil {
+			ev.Signal.Target.Ancestor = &ProcessCacheEntry{}
+		}
+		switch rv := value.(type) {
+		case string:
+			ev.Signal.Target.Ancestor.ProcessContext.Process.CGroup.CGroupControllers = append(ev.Signal.Target.Ancestor.ProcessContext.Process.CGroup.CGroupControllers, rv)
+		case []string:
+			ev.Signal.Target.Ancestor.ProcessContext.Process.CGroup.CGroupControllers = append(ev.Signal.Target.Ancestor.ProcessContext.Process.CGroup.CGroupControllers, rv...)
+		default:
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Ancestor.ProcessContext.Process.CGroup.CGroupControllers"}
+		}
+		return nil
+	case "signal.target.ancestors.cgroup.cpu.usage":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		if ev.Signal.Target.Ancestor == nil {
+			ev.Signal.Target.Ancestor = &ProcessCacheEntry{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Ancestor.ProcessContext.Process.CGroup.CGroupCPUUsage"}
+		}
+		ev.Signal.Target.Ancestor.ProcessContext.Process.CGroup.CGroupCPUUsage = uint64(rv)
+		return nil
 	case "signal.target.ancestors.cgroup.file.inode":
 		if ev.Signal.Target == nil {
 			ev.Signal.Target = &ProcessContext{}
@@ -44805,6 +55449,106 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.Signal.Target.Ancestor.ProcessContext.Process.CGroup.CGroupManager = rv
 		return nil
+	case "signal.target.ancestors.cgroup.memory.current":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		if ev.Signal.Target.Ancestor == nil {
+			ev.Signal.Target.Ancestor = &ProcessCacheEntry{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Ancestor.ProcessContext.Process.CGroup.CGroupMemoryCurrent"}
+		}
+		ev.Signal.Target.Ancestor.ProcessContext.Process.CGroup.CGroupMemoryCurrent = uint64(rv)
+		return nil
+	case "signal.target.ancestors.cgroup.memory.high_exceeded":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		if ev.Signal.Target.Ancestor == nil {
+			ev.Signal.Target.Ancestor = &ProcessCacheEntry{}
+		}
+		rv, ok := value.(bool)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Ancestor.ProcessContext.Process.CGroup.CGroupMemoryHighExceeded"}
+		}
+		ev.Signal.Target.Ancestor.ProcessContext.Process.CGroup.CGroupMemoryHighExceeded = rv
+		return nil
+	case "signal.target.ancestors.cgroup.pids.current":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		if ev.Signal.Target.Ancestor == nil {
+			ev.Signal.Target.Ancestor = &ProcessCacheEntry{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Ancestor.ProcessContext.Process.CGroup.CGroupPidsCurrent"}
+		}
+		ev.Signal.Target.Ancestor.ProcessContext.Process.CGroup.CGroupPidsCurrent = uint64(rv)
+		return nil
+	case "signal.target.ancestors.cloud_credentials.providers":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		if ev.Signal.Target.Ancestor == nil {
+			ev.Signal.Target.Ancestor = &ProcessCacheEntry{}
+		}
+		switch rv := value.(type) {
+		case string:
+			ev.Signal.Target.Ancestor.ProcessContext.Process.CloudCredentialsProviders = append(ev.Signal.Target.Ancestor.ProcessContext.Process.CloudCredentialsProviders, rv)
+		case []string:
+			ev.Signal.Target.Ancestor.ProcessContext.Process.CloudCredentialsProviders = append(ev.Signal.Target.Ancestor.ProcessContext.Process.CloudCredentialsProviders, rv...)
+		default:
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Ancestor.ProcessContext.Process.CloudCredentialsProviders"}
+		}
+		return nil
+	case "signal.target.ancestors.cloud_credentials.resources":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		if ev.Signal.Target.Ancestor == nil {
+			ev.Signal.Target.Ancestor = &ProcessCacheEntry{}
+		}
+		switch rv := value.(type) {
+		case string:
+			ev.Signal.Target.Ancestor.ProcessContext.Process.CloudCredentialsResources = append(ev.Signal.Target.Ancestor.ProcessContext.Process.CloudCredentialsResources, rv)
+		case []string:
+			ev.Signal.Target.Ancestor.ProcessContext.Process.CloudCredentialsResources = append(ev.Signal.Target.Ancestor.ProcessContext.Process.CloudCredentialsResources, rv...)
+		default:
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Ancestor.ProcessContext.Process.CloudCredentialsResources"}
+		}
+		return nil
+	case "signal.target.ancestors.cloud_credentials.types":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		if ev.Signal.Target.Ancestor == nil {
+			ev.Signal.Target.Ancestor = &ProcessCacheEntry{}
+		}
+		switch rv := value.(type) {
+		case string:
+			ev.Signal.Target.Ancestor.ProcessContext.Process.CloudCredentialsTypes = append(ev.Signal.Target.Ancestor.ProcessContext.Process.CloudCredentialsTypes, rv)
+		case []string:
+			ev.Signal.Target.Ancestor.ProcessContext.Process.CloudCredentialsTypes = append(ev.Signal.Target.Ancestor.ProcessContext.Process.CloudCredentialsTypes, rv...)
+		default:
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Ancestor.ProcessContext.Process.CloudCredentialsTypes"}
+		}
+		return nil
+	case "signal.target.ancestors.cmdline_obfuscation_score":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		if ev.Signal.Target.Ancestor == nil {
+			ev.Signal.Target.Ancestor = &ProcessCacheEntry{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Ancestor.ProcessContext.Process.CmdLineObfuscationScore"}
+		}
+		ev.Signal.Target.Ancestor.ProcessContext.Process.CmdLineObfuscationScore = int(rv)
+		return nil
 	case "signal.target.ancestors.comm":
 		if ev.Signal.Target == nil {
 			ev.Signal.Target = &ProcessContext{}
@@ -44870,6 +55614,58 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.Signal.Target.Ancestor.ProcessContext.Process.Credentials.EGroup = rv
 		return nil
+	case "signal.target.ancestors.elf.build_id":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		if ev.Signal.Target.Ancestor == nil {
+			ev.Signal.Target.Ancestor = &ProcessCacheEntry{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Ancestor.ProcessContext.Process.ELFBuildID"}
+		}
+		ev.Signal.Target.Ancestor.ProcessContext.Process.ELFBuildID = rv
+		return nil
+	case "signal.target.ancestors.elf.interpreter":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		if ev.Signal.Target.Ancestor == nil {
+			ev.Signal.Target.Ancestor = &ProcessCacheEntry{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Ancestor.ProcessContext.Process.ELFInterpreter"}
+		}
+		ev.Signal.Target.Ancestor.ProcessContext.Process.ELFInterpreter = rv
+		return nil
+	case "signal.target.ancestors.elf.is_static":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		if ev.Signal.Target.Ancestor == nil {
+			ev.Signal.Target.Ancestor = &ProcessCacheEntry{}
+		}
+		rv, ok := value.(bool)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Ancestor.ProcessContext.Process.ELFIsStatic"}
+		}
+		ev.Signal.Target.Ancestor.ProcessContext.Process.ELFIsStatic = rv
+		return nil
+	case "signal.target.ancestors.elf.is_stripped":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		if ev.Signal.Target.Ancestor == nil {
+			ev.Signal.Target.Ancestor = &ProcessCacheEntry{}
+		}
+		rv, ok := value.(bool)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Ancestor.ProcessContext.Process.ELFIsStripped"}
+		}
+		ev.Signal.Target.Ancestor.ProcessContext.Process.ELFIsStripped = rv
+		return nil
 	case "signal.target.ancestors.envp":
 		if ev.Signal.Target == nil {
 			ev.Signal.Target = &ProcessContext{}
@@ -45035,6 +55831,32 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.Signal.Target.Ancestor.ProcessContext.Process.FileEvent.FileFields.PathKey.Inode = uint64(rv)
 		return nil
+	case "signal.target.ancestors.file.layer.digest":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		if ev.Signal.Target.Ancestor == nil {
+			ev.Signal.Target.Ancestor = &ProcessCacheEntry{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Ancestor.ProcessContext.Process.FileEvent.LayerDigest"}
+		}
+		ev.Signal.Target.Ancestor.ProcessContext.Process.FileEvent.LayerDigest = rv
+		return nil
+	case "signal.target.ancestors.file.layer.path":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		if ev.Signal.Target.Ancestor == nil {
+			ev.Signal.Target.Ancestor = &ProcessCacheEntry{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Ancestor.ProcessContext.Process.FileEvent.LayerPath"}
+		}
+		ev.Signal.Target.Ancestor.ProcessContext.Process.FileEvent.LayerPath = rv
+		return nil
 	case "signal.target.ancestors.file.mode":
 		if ev.Signal.Target == nil {
 			ev.Signal.Target = &ProcessContext{}
@@ -45372,6 +56194,32 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.Signal.Target.Ancestor.ProcessContext.Process.LinuxBinprm.FileEvent.FileFields.PathKey.Inode = uint64(rv)
 		return nil
+	case "signal.target.ancestors.interpreter.file.layer.digest":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		if ev.Signal.Target.Ancestor == nil {
+			ev.Signal.Target.Ancestor = &ProcessCacheEntry{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Ancestor.ProcessContext.Process.LinuxBinprm.FileEvent.LayerDigest"}
+		}
+		ev.Signal.Target.Ancestor.ProcessContext.Process.LinuxBinprm.FileEvent.LayerDigest = rv
+		return nil
+	case "signal.target.ancestors.interpreter.file.layer.path":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		if ev.Signal.Target.Ancestor == nil {
+			ev.Signal.Target.Ancestor = &ProcessCacheEntry{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Ancestor.ProcessContext.Process.LinuxBinprm.FileEvent.LayerPath"}
+		}
+		ev.Signal.Target.Ancestor.ProcessContext.Process.LinuxBinprm.FileEvent.LayerPath = rv
+		return nil
 	case "signal.target.ancestors.interpreter.file.mode":
 		if ev.Signal.Target == nil {
 			ev.Signal.Target = &ProcessContext{}
@@ -45584,6 +56432,58 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 			ev.Signal.Target.Ancestor = &ProcessCacheEntry{}
 		}
 		return &eval.ErrFieldReadOnly{Field: "signal.target.ancestors.length"}
+	case "signal.target.ancestors.ns.mnt":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		if ev.Signal.Target.Ancestor == nil {
+			ev.Signal.Target.Ancestor = &ProcessCacheEntry{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Ancestor.ProcessContext.Process.MountNamespace"}
+		}
+		ev.Signal.Target.Ancestor.ProcessContext.Process.MountNamespace = uint32(rv)
+		return nil
+	case "signal.target.ancestors.ns.pid":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		if ev.Signal.Target.Ancestor == nil {
+			ev.Signal.Target.Ancestor = &ProcessCacheEntry{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Ancestor.ProcessContext.Process.PIDNamespace"}
+		}
+		ev.Signal.Target.Ancestor.ProcessContext.Process.PIDNamespace = uint32(rv)
+		return nil
+	case "signal.target.ancestors.ns.user":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		if ev.Signal.Target.Ancestor == nil {
+			ev.Signal.Target.Ancestor = &ProcessCacheEntry{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Ancestor.ProcessContext.Process.UserNamespace"}
+		}
+		ev.Signal.Target.Ancestor.ProcessContext.Process.UserNamespace = uint32(rv)
+		return nil
+	case "signal.target.ancestors.ns.uts":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		if ev.Signal.Target.Ancestor == nil {
+			ev.Signal.Target.Ancestor = &ProcessCacheEntry{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Ancestor.ProcessContext.Process.UTSNamespace"}
+		}
+		ev.Signal.Target.Ancestor.ProcessContext.Process.UTSNamespace = uint32(rv)
+		return nil
 	case "signal.target.ancestors.pid":
 		if ev.Signal.Target == nil {
 			ev.Signal.Target = &ProcessContext{}
@@ -45610,6 +56510,32 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.Signal.Target.Ancestor.ProcessContext.Process.PPid = uint32(rv)
 		return nil
+	case "signal.target.ancestors.systemd.slice":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		if ev.Signal.Target.Ancestor == nil {
+			ev.Signal.Target.Ancestor = &ProcessCacheEntry{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Ancestor.ProcessContext.Process.SystemdSlice"}
+		}
+		ev.Signal.Target.Ancestor.ProcessContext.Process.SystemdSlice = rv
+		return nil
+	case "signal.target.ancestors.systemd.unit":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		if ev.Signal.Target.Ancestor == nil {
+			ev.Signal.Target.Ancestor = &ProcessCacheEntry{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Ancestor.ProcessContext.Process.SystemdUnit"}
+		}
+		ev.Signal.Target.Ancestor.ProcessContext.Process.SystemdUnit = rv
+		return nil
 	case "signal.target.ancestors.tid":
 		if ev.Signal.Target == nil {
 			ev.Signal.Target = &ProcessContext{}
@@ -45803,6 +56729,42 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.Signal.Target.Process.Credentials.CapPermitted = uint64(rv)
 		return nil
+	case "signal.target.cgroup.ancestors":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		switch rv := value.(type) {
+		case string:
+			ev.Signal.Target.Process.CGroup.CGroupAncestors = append(ev.Signal.Target.Process.CGroup.CGroupAncestors, rv)
+		case []string:
+			ev.Signal.Target.Process.CGroup.CGroupAncestors = append(ev.Signal.Target.Process.CGroup.CGroupAncestors, rv...)
+		default:
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Process.CGroup.CGroupAncestors"}
+		}
+		return nil
+	case "signal.target.cgroup.controllers":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		switch rv := value.(type) {
+		case string:
+			ev.Signal.Target.Process.CGroup.CGroupControllers = append(ev.Signal.Target.Process.CGroup.CGroupControllers, rv)
+		case []string:
+			ev.Signal.Target.Process.CGroup.CGroupControllers = append(ev.Signal.Target.Process.CGroup.CGroupControllers, rv...)
+		default:
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Process.CGroup.CGroupControllers"}
+		}
+		return nil
+	case "signal.target.cgroup.cpu.usage":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Process.CGroup.CGroupCPUUsage"}
+		}
+		ev.Signal.Target.Process.CGroup.CGroupCPUUsage = uint64(rv)
+		return nil
 	case "signal.target.cgroup.file.inode":
 		if ev.Signal.Target == nil {
 			ev.Signal.Target = &ProcessContext{}
@@ -45843,6 +56805,85 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.Signal.Target.Process.CGroup.CGroupManager = rv
 		return nil
+	case "signal.target.cgroup.memory.current":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Process.CGroup.CGroupMemoryCurrent"}
+		}
+		ev.Signal.Target.Process.CGroup.CGroupMemoryCurrent = uint64(rv)
+		return nil
+	case "signal.target.cgroup.memory.high_exceeded":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		rv, ok := value.(bool)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Process.CGroup.CGroupMemoryHighExceeded"}
+		}
+		ev.Signal.Target.Process.CGroup.CGroupMemoryHighExceeded = rv
+		return nil
+	case "signal.target.cgroup.pids.current":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Process.CGroup.CGroupPidsCurrent"}
+		}
+		ev.Signal.Target.Process.CGroup.CGroupPidsCurrent = uint64(rv)
+		return nil
+	case "signal.target.cloud_credentials.providers":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		switch rv := value.(type) {
+		case string:
+			ev.Signal.Target.Process.CloudCredentialsProviders = append(ev.Signal.Target.Process.CloudCredentialsProviders, rv)
+		case []string:
+			ev.Signal.Target.Process.CloudCredentialsProviders = append(ev.Signal.Target.Process.CloudCredentialsProviders, rv...)
+		default:
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Process.CloudCredentialsProviders"}
+		}
+		return nil
+	case "signal.target.cloud_credentials.resources":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		switch rv := value.(type) {
+		case string:
+			ev.Signal.Target.Process.CloudCredentialsResources = append(ev.Signal.Target.Process.CloudCredentialsResources, rv)
+		case []string:
+			ev.Signal.Target.Process.CloudCredentialsResources = append(ev.Signal.Target.Process.CloudCredentialsResources, rv...)
+		default:
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Process.CloudCredentialsResources"}
+		}
+		return nil
+	case "signal.target.cloud_credentials.types":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		switch rv := value.(type) {
+		case string:
+			ev.Signal.Target.Process.CloudCredentialsTypes = append(ev.Signal.Target.Process.CloudCredentialsTypes, rv)
+		case []string:
+			ev.Signal.Target.Process.CloudCredentialsTypes = append(ev.Signal.Target.Process.CloudCredentialsTypes, rv...)
+		default:
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Process.CloudCredentialsTypes"}
+		}
+		return nil
+	case "signal.target.cmdline_obfuscation_score":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Process.CmdLineObfuscationScore"}
+		}
+		ev.Signal.Target.Process.CmdLineObfuscationScore = int(rv)
+		return nil
 	case "signal.target.comm":
 		if ev.Signal.Target == nil {
 			ev.Signal.Target = &ProcessContext{}
@@ -45893,6 +56934,46 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.Signal.Target.Process.Credentials.EGroup = rv
 		return nil
+	case "signal.target.elf.build_id":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Process.ELFBuildID"}
+		}
+		ev.Signal.Target.Process.ELFBuildID = rv
+		return nil
+	case "signal.target.elf.interpreter":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Process.ELFInterpreter"}
+		}
+		ev.Signal.Target.Process.ELFInterpreter = rv
+		return nil
+	case "signal.target.elf.is_static":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		rv, ok := value.(bool)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Process.ELFIsStatic"}
+		}
+		ev.Signal.Target.Process.ELFIsStatic = rv
+		return nil
+	case "signal.target.elf.is_stripped":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		rv, ok := value.(bool)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Process.ELFIsStripped"}
+		}
+		ev.Signal.Target.Process.ELFIsStripped = rv
+		return nil
 	case "signal.target.envp":
 		if ev.Signal.Target == nil {
 			ev.Signal.Target = &ProcessContext{}
@@ -46022,6 +57103,26 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.Signal.Target.Process.FileEvent.FileFields.PathKey.Inode = uint64(rv)
 		return nil
+	case "signal.target.file.layer.digest":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Process.FileEvent.LayerDigest"}
+		}
+		ev.Signal.Target.Process.FileEvent.LayerDigest = rv
+		return nil
+	case "signal.target.file.layer.path":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Process.FileEvent.LayerPath"}
+		}
+		ev.Signal.Target.Process.FileEvent.LayerPath = rv
+		return nil
 	case "signal.target.file.mode":
 		if ev.Signal.Target == nil {
 			ev.Signal.Target = &ProcessContext{}
@@ -46281,6 +57382,26 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.Signal.Target.Process.LinuxBinprm.FileEvent.FileFields.PathKey.Inode = uint64(rv)
 		return nil
+	case "signal.target.interpreter.file.layer.digest":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Process.LinuxBinprm.FileEvent.LayerDigest"}
+		}
+		ev.Signal.Target.Process.LinuxBinprm.FileEvent.LayerDigest = rv
+		return nil
+	case "signal.target.interpreter.file.layer.path":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Process.LinuxBinprm.FileEvent.LayerPath"}
+		}
+		ev.Signal.Target.Process.LinuxBinprm.FileEvent.LayerPath = rv
+		return nil
 	case "signal.target.interpreter.file.mode":
 		if ev.Signal.Target == nil {
 			ev.Signal.Target = &ProcessContext{}
@@ -46437,6 +57558,46 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.Signal.Target.Process.IsThread = rv
 		return nil
+	case "signal.target.ns.mnt":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Process.MountNamespace"}
+		}
+		ev.Signal.Target.Process.MountNamespace = uint32(rv)
+		return nil
+	case "signal.target.ns.pid":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Process.PIDNamespace"}
+		}
+		ev.Signal.Target.Process.PIDNamespace = uint32(rv)
+		return nil
+	case "signal.target.ns.user":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Process.UserNamespace"}
+		}
+		ev.Signal.Target.Process.UserNamespace = uint32(rv)
+		return nil
+	case "signal.target.ns.uts":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Process.UTSNamespace"}
+		}
+		ev.Signal.Target.Process.UTSNamespace = uint32(rv)
+		return nil
 	case "signal.target.parent.args":
 		if ev.Signal.Target == nil {
 			ev.Signal.Target = &ProcessContext{}
@@ -46563,6 +57724,51 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.Signal.Target.Parent.Credentials.CapPermitted = uint64(rv)
 		return nil
+	case "signal.target.parent.cgroup.ancestors":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		if ev.Signal.Target.Parent == nil {
+			ev.Signal.Target.Parent = &Process{}
+		}
+		switch rv := value.(type) {
+		case string:
+			ev.Signal.Target.Parent.CGroup.CGroupAncestors = append(ev.Signal.Target.Parent.CGroup.CGroupAncestors, rv)
+		case []string:
+			ev.Signal.Target.Parent.CGroup.CGroupAncestors = append(ev.Signal.Target.Parent.CGroup.CGroupAncestors, rv...)
+		default:
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Parent.CGroup.CGroupAncestors"}
+		}
+		return nil
+	case "signal.target.parent.cgroup.controllers":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		if ev.Signal.Target.Parent == nil {
+			ev.Signal.Target.Parent = &Process{}
+		}
+		switch rv := value.(type) {
+		case string:
+			ev.Signal.Target.Parent.CGroup.CGroupControllers = append(ev.Signal.Target.Parent.CGroup.CGroupControllers, rv)
+		case []string:
+			ev.Signal.Target.Parent.CGroup.CGroupControllers = append(ev.Signal.Target.Parent.CGroup.CGroupControllers, rv...)
+		default:
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Parent.CGroup.CGroupControllers"}
+		}
+		return nil
+	case "signal.target.parent.cgroup.cpu.usage":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		if ev.Signal.Target.Parent == nil {
+			ev.Signal.Target.Parent = &Process{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Parent.CGroup.CGroupCPUUsage"}
+		}
+		ev.Signal.Target.Parent.CGroup.CGroupCPUUsage = uint64(rv)
+		return nil
 	case "signal.target.parent.cgroup.file.inode":
 		if ev.Signal.Target == nil {
 			ev.Signal.Target = &ProcessContext{}
@@ -46615,6 +57821,106 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.Signal.Target.Parent.CGroup.CGroupManager = rv
 		return nil
+	case "signal.target.parent.cgroup.memory.current":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		if ev.Signal.Target.Parent == nil {
+			ev.Signal.Target.Parent = &Process{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Parent.CGroup.CGroupMemoryCurrent"}
+		}
+		ev.Signal.Target.Parent.CGroup.CGroupMemoryCurrent = uint64(rv)
+		return nil
+	case "signal.target.parent.cgroup.memory.high_exceeded":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		if ev.Signal.Target.Parent == nil {
+			ev.Signal.Target.Parent = &Process{}
+		}
+		rv, ok := value.(bool)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Parent.CGroup.CGroupMemoryHighExceeded"}
+		}
+		ev.Signal.Target.Parent.CGroup.CGroupMemoryHighExceeded = rv
+		return nil
+	case "signal.target.parent.cgroup.pids.current":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		if ev.Signal.Target.Parent == nil {
+			ev.Signal.Target.Parent = &Process{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Parent.CGroup.CGroupPidsCurrent"}
+		}
+		ev.Signal.Target.Parent.CGroup.CGroupPidsCurrent = uint64(rv)
+		return nil
+	case "signal.target.parent.cloud_credentials.providers":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		if ev.Signal.Target.Parent == nil {
+			ev.Signal.Target.Parent = &Process{}
+		}
+		switch rv := value.(type) {
+		case string:
+			ev.Signal.Target.Parent.CloudCredentialsProviders = append(ev.Signal.Target.Parent.CloudCredentialsProviders, rv)
+		case []string:
+			ev.Signal.Target.Parent.CloudCredentialsProviders = append(ev.Signal.Target.Parent.CloudCredentialsProviders, rv...)
+		default:
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Parent.CloudCredentialsProviders"}
+		}
+		return nil
+	case "signal.target.parent.cloud_credentials.resources":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		if ev.Signal.Target.Parent == nil {
+			ev.Signal.Target.Parent = &Process{}
+		}
+		switch rv := value.(type) {
+		case string:
+			ev.Signal.Target.Parent.CloudCredentialsResources = append(ev.Signal.Target.Parent.CloudCredentialsResources, rv)
+		case []string:
+			ev.Signal.Target.Parent.CloudCredentialsResources = append(ev.Signal.Target.Parent.CloudCredentialsResources, rv...)
+		default:
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Parent.CloudCredentialsResources"}
+		}
+		return nil
+	case "signal.target.parent.cloud_credentials.types":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		if ev.Signal.Target.Parent == nil {
+			ev.Signal.Target.Parent = &Process{}
+		}
+		switch rv := value.(type) {
+		case string:
+			ev.Signal.Target.Parent.CloudCredentialsTypes = append(ev.Signal.Target.Parent.CloudCredentialsTypes, rv)
+		case []string:
+			ev.Signal.Target.Parent.CloudCredentialsTypes = append(ev.Signal.Target.Parent.CloudCredentialsTypes, rv...)
+		default:
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Parent.CloudCredentialsTypes"}
+		}
+		return nil
+	case "signal.target.parent.cmdline_obfuscation_score":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		if ev.Signal.Target.Parent == nil {
+			ev.Signal.Target.Parent = &Process{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Parent.CmdLineObfuscationScore"}
+		}
+		ev.Signal.Target.Parent.CmdLineObfuscationScore = int(rv)
+		return nil
 	case "signal.target.parent.comm":
 		if ev.Signal.Target == nil {
 			ev.Signal.Target = &ProcessContext{}
@@ -46680,6 +57986,58 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.Signal.Target.Parent.Credentials.EGroup = rv
 		return nil
+	case "signal.target.parent.elf.build_id":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		if ev.Signal.Target.Parent == nil {
+			ev.Signal.Target.Parent = &Process{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Parent.ELFBuildID"}
+		}
+		ev.Signal.Target.Parent.ELFBuildID = rv
+		return nil
+	case "signal.target.parent.elf.interpreter":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		if ev.Signal.Target.Parent == nil {
+			ev.Signal.Target.Parent = &Process{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Parent.ELFInterpreter"}
+		}
+		ev.Signal.Target.Parent.ELFInterpreter = rv
+		return nil
+	case "signal.target.parent.elf.is_static":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		if ev.Signal.Target.Parent == nil {
+			ev.Signal.Target.Parent = &Process{}
+		}
+		rv, ok := value.(bool)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Parent.ELFIsStatic"}
+		}
+		ev.Signal.Target.Parent.ELFIsStatic = rv
+		return nil
+	case "signal.target.parent.elf.is_stripped":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		if ev.Signal.Target.Parent == nil {
+			ev.Signal.Target.Parent = &Process{}
+		}
+		rv, ok := value.(bool)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Parent.ELFIsStripped"}
+		}
+		ev.Signal.Target.Parent.ELFIsStripped = rv
+		return nil
 	case "signal.target.parent.envp":
 		if ev.Signal.Target == nil {
 			ev.Signal.Target = &ProcessContext{}
@@ -46845,6 +58203,32 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.Signal.Target.Parent.FileEvent.FileFields.PathKey.Inode = uint64(rv)
 		return nil
+	case "signal.target.parent.file.layer.digest":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		if ev.Signal.Target.Parent == nil {
+			ev.Signal.Target.Parent = &Process{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Parent.FileEvent.LayerDigest"}
+		}
+		ev.Signal.Target.Parent.FileEvent.LayerDigest = rv
+		return nil
+	case "signal.target.parent.file.layer.path":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		if ev.Signal.Target.Parent == nil {
+			ev.Signal.Target.Parent = &Process{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Parent.FileEvent.LayerPath"}
+		}
+		ev.Signal.Target.Parent.FileEvent.LayerPath = rv
+		return nil
 	case "signal.target.parent.file.mode":
 		if ev.Signal.Target == nil {
 			ev.Signal.Target = &ProcessContext{}
@@ -47182,6 +58566,32 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.Signal.Target.Parent.LinuxBinprm.FileEvent.FileFields.PathKey.Inode = uint64(rv)
 		return nil
+	case "signal.target.parent.interpreter.file.layer.digest":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		if ev.Signal.Target.Parent == nil {
+			ev.Signal.Target.Parent = &Process{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Parent.LinuxBinprm.FileEvent.LayerDigest"}
+		}
+		ev.Signal.Target.Parent.LinuxBinprm.FileEvent.LayerDigest = rv
+		return nil
+	case "signal.target.parent.interpreter.file.layer.path":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		if ev.Signal.Target.Parent == nil {
+			ev.Signal.Target.Parent = &Process{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Parent.LinuxBinprm.FileEvent.LayerPath"}
+		}
+		ev.Signal.Target.Parent.LinuxBinprm.FileEvent.LayerPath = rv
+		return nil
 	case "signal.target.parent.interpreter.file.mode":
 		if ev.Signal.Target == nil {
 			ev.Signal.Target = &ProcessContext{}
@@ -47386,6 +58796,58 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.Signal.Target.Parent.IsThread = rv
 		return nil
+	case "signal.target.parent.ns.mnt":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		if ev.Signal.Target.Parent == nil {
+			ev.Signal.Target.Parent = &Process{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Parent.MountNamespace"}
+		}
+		ev.Signal.Target.Parent.MountNamespace = uint32(rv)
+		return nil
+	case "signal.target.parent.ns.pid":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		if ev.Signal.Target.Parent == nil {
+			ev.Signal.Target.Parent = &Process{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Parent.PIDNamespace"}
+		}
+		ev.Signal.Target.Parent.PIDNamespace = uint32(rv)
+		return nil
+	case "signal.target.parent.ns.user":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		if ev.Signal.Target.Parent == nil {
+			ev.Signal.Target.Parent = &Process{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Parent.UserNamespace"}
+		}
+		ev.Signal.Target.Parent.UserNamespace = uint32(rv)
+		return nil
+	case "signal.target.parent.ns.uts":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		if ev.Signal.Target.Parent == nil {
+			ev.Signal.Target.Parent = &Process{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Parent.UTSNamespace"}
+		}
+		ev.Signal.Target.Parent.UTSNamespace = uint32(rv)
+		return nil
 	case "signal.target.parent.pid":
 		if ev.Signal.Target == nil {
 			ev.Signal.Target = &ProcessContext{}
@@ -47412,6 +58874,32 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.Signal.Target.Parent.PPid = uint32(rv)
 		return nil
+	case "signal.target.parent.systemd.slice":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		if ev.Signal.Target.Parent == nil {
+			ev.Signal.Target.Parent = &Process{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Parent.SystemdSlice"}
+		}
+		ev.Signal.Target.Parent.SystemdSlice = rv
+		return nil
+	case "signal.target.parent.systemd.unit":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		if ev.Signal.Target.Parent == nil {
+			ev.Signal.Target.Parent = &Process{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Parent.SystemdUnit"}
+		}
+		ev.Signal.Target.Parent.SystemdUnit = rv
+		return nil
 	case "signal.target.parent.tid":
 		if ev.Signal.Target == nil {
 			ev.Signal.Target = &ProcessContext{}
@@ -47526,6 +59014,26 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.Signal.Target.Process.PPid = uint32(rv)
 		return nil
+	case "signal.target.systemd.slice":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Process.SystemdSlice"}
+		}
+		ev.Signal.Target.Process.SystemdSlice = rv
+		return nil
+	case "signal.target.systemd.unit":
+		if ev.Signal.Target == nil {
+			ev.Signal.Target = &ProcessContext{}
+		}
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Process.SystemdUnit"}
+		}
+		ev.Signal.Target.Process.SystemdUnit = rv
+		return nil
 	case "signal.target.tid":
 		if ev.Signal.Target == nil {
 			ev.Signal.Target = &ProcessContext{}
@@ -47658,6 +59166,20 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.Splice.File.FileFields.PathKey.Inode = uint64(rv)
 		return nil
+	case "splice.file.layer.digest":
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Splice.File.LayerDigest"}
+		}
+		ev.Splice.File.LayerDigest = rv
+		return nil
+	case "splice.file.layer.path":
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Splice.File.LayerPath"}
+		}
+		ev.Splice.File.LayerPath = rv
+		return nil
 	case "splice.file.mode":
 		rv, ok := value.(int)
 		if !ok {
@@ -47818,6 +59340,20 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.Unlink.File.FileFields.PathKey.Inode = uint64(rv)
 		return nil
+	case "unlink.file.layer.digest":
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Unlink.File.LayerDigest"}
+		}
+		ev.Unlink.File.LayerDigest = rv
+		return nil
+	case "unlink.file.layer.path":
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Unlink.File.LayerPath"}
+		}
+		ev.Unlink.File.LayerPath = rv
+		return nil
 	case "unlink.file.mode":
 		rv, ok := value.(int)
 		if !ok {
@@ -48006,6 +59542,20 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.Utimes.File.FileFields.PathKey.Inode = uint64(rv)
 		return nil
+	case "utimes.file.layer.digest":
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Utimes.File.LayerDigest"}
+		}
+		ev.Utimes.File.LayerDigest = rv
+		return nil
+	case "utimes.file.layer.path":
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Utimes.File.LayerPath"}
+		}
+		ev.Utimes.File.LayerPath = rv
+		return nil
 	case "utimes.file.mode":
 		rv, ok := value.(int)
 		if !ok {
@@ -21,12 +21,17 @@ var _ = math.MaxUint16
 func (m *Model) GetEventTypes() []eval.EventType {
 	return []eval.EventType{
 		eval.EventType("change_permission"),
+		eval.EventType("connect_named_pipe"),
 		eval.EventType("create"),
 		eval.EventType("create_key"),
+		eval.EventType("create_named_pipe"),
+		eval.EventType("create_service"),
 		eval.EventType("delete"),
 		eval.EventType("delete_key"),
+		eval.EventType("delete_key_value"),
 		eval.EventType("exec"),
 		eval.EventType("exit"),
+		eval.EventType("modify_service"),
 		eval.EventType("open_key"),
 		eval.EventType("rename"),
 		eval.EventType("set_key_value"),
@@ -94,6 +99,75 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "connect_named_pipe.pipe.name":
+		return &eval.StringEvaluator{
+			OpOverrides: eval.CaseInsensitiveCmp,
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.ConnectNamedPipe.Pipe.Name
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+	case "connect_named_pipe.pipe.name.length":
+		return &eval.IntEvaluator{
+			OpOverrides: eval.CaseInsensitiveCmp,
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return len(ev.ConnectNamedPipe.Pipe.Name)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+	case "connect_named_pipe.pipe.path":
+		return &eval.StringEvaluator{
+			OpOverrides: eval.CaseInsensitiveCmp,
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.ConnectNamedPipe.Pipe.Path
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+	case "connect_named_pipe.pipe.path.length":
+		return &eval.IntEvaluator{
+			OpOverrides: eval.CaseInsensitiveCmp,
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return len(ev.ConnectNamedPipe.Pipe.Path)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+	case "connect_named_pipe.server.path":
+		return &eval.StringEvaluator{
+			OpOverrides: eval.WindowsPathCmp,
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.ConnectNamedPipe.ServerPath
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+	case "connect_named_pipe.server.path.length":
+		return &eval.IntEvaluator{
+			OpOverrides: eval.WindowsPathCmp,
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return len(ev.ConnectNamedPipe.ServerPath)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+	case "connect_named_pipe.server.pid":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return int(ev.ConnectNamedPipe.ServerPid)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
 	case "container.created_at":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -266,6 +340,95 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "create_named_pipe.pipe.name":
+		return &eval.StringEvaluator{
+			OpOverrides: eval.CaseInsensitiveCmp,
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.CreateNamedPipe.Pipe.Name
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+	case "create_named_pipe.pipe.name.length":
+		return &eval.IntEvaluator{
+			OpOverrides: eval.CaseInsensitiveCmp,
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return len(ev.CreateNamedPipe.Pipe.Name)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+	case "create_named_pipe.pipe.path":
+		return &eval.StringEvaluator{
+			OpOverrides: eval.CaseInsensitiveCmp,
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.CreateNamedPipe.Pipe.Path
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+	case "create_named_pipe.pipe.path.length":
+		return &eval.IntEvaluator{
+			OpOverrides: eval.CaseInsensitiveCmp,
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return len(ev.CreateNamedPipe.Pipe.Path)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+	case "create_service.image_path":
+		return &eval.StringEvaluator{
+			OpOverrides: eval.CaseInsensitiveCmp,
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.CreateService.ImagePath
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+	case "create_service.image_path.length":
+		return &eval.IntEvaluator{
+			OpOverrides: eval.CaseInsensitiveCmp,
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return len(ev.CreateService.ImagePath)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+	case "create_service.name":
+		return &eval.StringEvaluator{
+			OpOverrides: eval.CaseInsensitiveCmp,
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.CreateService.Name
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+	case "create_service.name.length":
+		return &eval.IntEvaluator{
+			OpOverrides: eval.CaseInsensitiveCmp,
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return len(ev.CreateService.Name)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+	case "create_service.start_type":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.CreateService.StartType
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
 	case "delete.file.device_path":
 		return &eval.StringEvaluator{
 			OpOverrides: eval.WindowsPathCmp,
@@ -402,6 +565,71 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "delete_key_value.registry.key_name":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.DeleteRegistryKeyValue.Registry.KeyName
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+	case "delete_key_value.registry.key_name.length":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return len(ev.DeleteRegistryKeyValue.Registry.KeyName)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+	case "delete_key_value.registry.key_path":
+		return &eval.StringEvaluator{
+			OpOverrides: eval.CaseInsensitiveCmp,
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.DeleteRegistryKeyValue.Registry.KeyPath
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+	case "delete_key_value.registry.key_path.length":
+		return &eval.IntEvaluator{
+			OpOverrides: eval.CaseInsensitiveCmp,
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return len(ev.DeleteRegistryKeyValue.Registry.KeyPath)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+	case "delete_key_value.registry.value_name":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.DeleteRegistryKeyValue.ValueName
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+	case "delete_key_value.registry.value_name.length":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return len(ev.DeleteRegistryKeyValue.ValueName)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+	case "delete_key_value.value_name":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.DeleteRegistryKeyValue.ValueName
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
 	case "event.hostname":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
@@ -709,6 +937,84 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "modify_service.image_path":
+		return &eval.StringEvaluator{
+			OpOverrides: eval.CaseInsensitiveCmp,
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.ModifyService.ImagePath
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+	case "modify_service.image_path.length":
+		return &eval.IntEvaluator{
+			OpOverrides: eval.CaseInsensitiveCmp,
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return len(ev.ModifyService.ImagePath)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+	case "modify_service.name":
+		return &eval.StringEvaluator{
+			OpOverrides: eval.CaseInsensitiveCmp,
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.ModifyService.Name
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+	case "modify_service.name.length":
+		return &eval.IntEvaluator{
+			OpOverrides: eval.CaseInsensitiveCmp,
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return len(ev.ModifyService.Name)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+	case "modify_service.previous_image_path":
+		return &eval.StringEvaluator{
+			OpOverrides: eval.CaseInsensitiveCmp,
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.ModifyService.PreviousImagePath
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+	case "modify_service.previous_image_path.length":
+		return &eval.IntEvaluator{
+			OpOverrides: eval.CaseInsensitiveCmp,
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return len(ev.ModifyService.PreviousImagePath)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+	case "modify_service.previous_start_type":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.ModifyService.PreviousStartType
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+	case "modify_service.start_type":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.ModifyService.StartType
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
 	case "open.registry.key_name":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
@@ -1605,6 +1911,24 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "set.registry.value_data":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.SetRegistryKeyValue.ValueData
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+	case "set.registry.value_data.length":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return len(ev.SetRegistryKeyValue.ValueData)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
 	case "set.registry.value_name":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
@@ -1623,6 +1947,15 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "set.value_data":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.SetRegistryKeyValue.ValueData
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
 	case "set.value_name":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
@@ -1670,6 +2003,24 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "set_key_value.registry.value_data":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.SetRegistryKeyValue.ValueData
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+	case "set_key_value.registry.value_data.length":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ev := ctx.Event.(*Event)
+				return len(ev.SetRegistryKeyValue.ValueData)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
 	case "set_key_value.registry.value_name":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
@@ -1688,6 +2039,15 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "set_key_value.value_data":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+				ev := ctx.Event.(*Event)
+				return ev.SetRegistryKeyValue.ValueData
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
 	case "set_key_value.value_name":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
@@ -1768,6 +2128,13 @@ func (ev *Event) GetFields() []eval.Field {
 		"change_permission.type",
 		"change_permission.user_domain",
 		"change_permission.username",
+		"connect_named_pipe.pipe.name",
+		"connect_named_pipe.pipe.name.length",
+		"connect_named_pipe.pipe.path",
+		"connect_named_pipe.pipe.path.length",
+		"connect_named_pipe.server.path",
+		"connect_named_pipe.server.path.length",
+		"connect_named_pipe.server.pid",
 		"container.created_at",
 		"container.id",
 		"container.runtime",
@@ -1786,6 +2153,15 @@ func (ev *Event) GetFields() []eval.Field {
 		"create_key.registry.key_name.length",
 		"create_key.registry.key_path",
 		"create_key.registry.key_path.length",
+		"create_named_pipe.pipe.name",
+		"create_named_pipe.pipe.name.length",
+		"create_named_pipe.pipe.path",
+		"create_named_pipe.pipe.path.length",
+		"create_service.image_path",
+		"create_service.image_path.length",
+		"create_service.name",
+		"create_service.name.length",
+		"create_service.start_type",
 		"delete.file.device_path",
 		"delete.file.device_path.length",
 		"delete.file.name",
@@ -1800,6 +2176,13 @@ func (ev *Event) GetFields() []eval.Field {
 		"delete_key.registry.key_name.length",
 		"delete_key.registry.key_path",
 		"delete_key.registry.key_path.length",
+		"delete_key_value.registry.key_name",
+		"delete_key_value.registry.key_name.length",
+		"delete_key_value.registry.key_path",
+		"delete_key_value.registry.key_path.length",
+		"delete_key_value.registry.value_name",
+		"delete_key_value.registry.value_name.length",
+		"delete_key_value.value_name",
 		"event.hostname",
 		"event.origin",
 		"event.os",
@@ -1833,6 +2216,14 @@ func (ev *Event) GetFields() []eval.Field {
 		"exit.ppid",
 		"exit.user",
 		"exit.user_sid",
+		"modify_service.image_path",
+		"modify_service.image_path.length",
+		"modify_service.name",
+		"modify_service.name.length",
+		"modify_service.previous_image_path",
+		"modify_service.previous_image_path.length",
+		"modify_service.previous_start_type",
+		"modify_service.start_type",
 		"open.registry.key_name",
 		"open.registry.key_name.length",
 		"open.registry.key_path",
@@ -1897,15 +2288,21 @@ func (ev *Event) GetFields() []eval.Field {
 		"set.registry.key_name.length",
 		"set.registry.key_path",
 		"set.registry.key_path.length",
+		"set.registry.value_data",
+		"set.registry.value_data.length",
 		"set.registry.value_name",
 		"set.registry.value_name.length",
+		"set.value_data",
 		"set.value_name",
 		"set_key_value.registry.key_name",
 		"set_key_value.registry.key_name.length",
 		"set_key_value.registry.key_path",
 		"set_key_value.registry.key_path.length",
+		"set_key_value.registry.value_data",
+		"set_key_value.registry.value_data.length",
 		"set_key_value.registry.value_name",
 		"set_key_value.registry.value_name.length",
+		"set_key_value.value_data",
 		"set_key_value.value_name",
 		"write.file.device_path",
 		"write.file.device_path.length",
@@ -1929,6 +2326,20 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		return ev.ChangePermission.UserDomain, nil
 	case "change_permission.username":
 		return ev.ChangePermission.UserName, nil
+	case "connect_named_pipe.pipe.name":
+		return ev.ConnectNamedPipe.Pipe.Name, nil
+	case "connect_named_pipe.pipe.name.length":
+		return len(ev.ConnectNamedPipe.Pipe.Name), nil
+	case "connect_named_pipe.pipe.path":
+		return ev.ConnectNamedPipe.Pipe.Path, nil
+	case "connect_named_pipe.pipe.path.length":
+		return len(ev.ConnectNamedPipe.Pipe.Path), nil
+	case "connect_named_pipe.server.path":
+		return ev.ConnectNamedPipe.ServerPath, nil
+	case "connect_named_pipe.server.path.length":
+		return len(ev.ConnectNamedPipe.ServerPath), nil
+	case "connect_named_pipe.server.pid":
+		return int(ev.ConnectNamedPipe.ServerPid), nil
 	case "container.created_at":
 		return int(ev.FieldHandlers.ResolveContainerCreatedAt(ev, ev.BaseEvent.ContainerContext)), nil
 	case "container.id":
@@ -1965,6 +2376,24 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		return ev.CreateRegistryKey.Registry.KeyPath, nil
 	case "create_key.registry.key_path.length":
 		return len(ev.CreateRegistryKey.Registry.KeyPath), nil
+	case "create_named_pipe.pipe.name":
+		return ev.CreateNamedPipe.Pipe.Name, nil
+	case "create_named_pipe.pipe.name.length":
+		return len(ev.CreateNamedPipe.Pipe.Name), nil
+	case "create_named_pipe.pipe.path":
+		return ev.CreateNamedPipe.Pipe.Path, nil
+	case "create_named_pipe.pipe.path.length":
+		return len(ev.CreateNamedPipe.Pipe.Path), nil
+	case "create_service.image_path":
+		return ev.CreateService.ImagePath, nil
+	case "create_service.image_path.length":
+		return len(ev.CreateService.ImagePath), nil
+	case "create_service.name":
+		return ev.CreateService.Name, nil
+	case "create_service.name.length":
+		return len(ev.CreateService.Name), nil
+	case "create_service.start_type":
+		return ev.CreateService.StartType, nil
 	case "delete.file.device_path":
 		return ev.FieldHandlers.ResolveFimFilePath(ev, &ev.DeleteFile.File), nil
 	case "delete.file.device_path.length":
@@ -1993,6 +2422,20 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		return ev.DeleteRegistryKey.Registry.KeyPath, nil
 	case "delete_key.registry.key_path.length":
 		return len(ev.DeleteRegistryKey.Registry.KeyPath), nil
+	case "delete_key_value.registry.key_name":
+		return ev.DeleteRegistryKeyValue.Registry.KeyName, nil
+	case "delete_key_value.registry.key_name.length":
+		return len(ev.DeleteRegistryKeyValue.Registry.KeyName), nil
+	case "delete_key_value.registry.key_path":
+		return ev.DeleteRegistryKeyValue.Registry.KeyPath, nil
+	case "delete_key_value.registry.key_path.length":
+		return len(ev.DeleteRegistryKeyValue.Registry.KeyPath), nil
+	case "delete_key_value.registry.value_name":
+		return ev.DeleteRegistryKeyValue.ValueName, nil
+	case "delete_key_value.registry.value_name.length":
+		return len(ev.DeleteRegistryKeyValue.ValueName), nil
+	case "delete_key_value.value_name":
+		return ev.DeleteRegistryKeyValue.ValueName, nil
 	case "event.hostname":
 		return ev.FieldHandlers.ResolveHostname(ev, &ev.BaseEvent), nil
 	case "event.origin":
@@ -2059,6 +2502,22 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		return ev.FieldHandlers.ResolveUser(ev, ev.Exit.Process), nil
 	case "exit.user_sid":
 		return ev.Exit.Process.OwnerSidString, nil
+	case "modify_service.image_path":
+		return ev.ModifyService.ImagePath, nil
+	case "modify_service.image_path.length":
+		return len(ev.ModifyService.ImagePath), nil
+	case "modify_service.name":
+		return ev.ModifyService.Name, nil
+	case "modify_service.name.length":
+		return len(ev.ModifyService.Name), nil
+	case "modify_service.previous_image_path":
+		return ev.ModifyService.PreviousImagePath, nil
+	case "modify_service.previous_image_path.length":
+		return len(ev.ModifyService.PreviousImagePath), nil
+	case "modify_service.previous_start_type":
+		return ev.ModifyService.PreviousStartType, nil
+	case "modify_service.start_type":
+		return ev.ModifyService.StartType, nil
 	case "open.registry.key_name":
 		return ev.OpenRegistryKey.Registry.KeyName, nil
 	case "open.registry.key_name.length":
@@ -2332,10 +2791,16 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		return ev.SetRegistryKeyValue.Registry.KeyPath, nil
 	case "set.registry.key_path.length":
 		return len(ev.SetRegistryKeyValue.Registry.KeyPath), nil
+	case "set.registry.value_data":
+		return ev.SetRegistryKeyValue.ValueData, nil
+	case "set.registry.value_data.length":
+		return len(ev.SetRegistryKeyValue.ValueData), nil
 	case "set.registry.value_name":
 		return ev.SetRegistryKeyValue.ValueName, nil
 	case "set.registry.value_name.length":
 		return len(ev.SetRegistryKeyValue.ValueName), nil
+	case "set.value_data":
+		return ev.SetRegistryKeyValue.ValueData, nil
 	case "set.value_name":
 		return ev.SetRegistryKeyValue.ValueName, nil
 	case "set_key_value.registry.key_name":
@@ -2346,10 +2811,16 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		return ev.SetRegistryKeyValue.Registry.KeyPath, nil
 	case "set_key_value.registry.key_path.length":
 		return len(ev.SetRegistryKeyValue.Registry.KeyPath), nil
+	case "set_key_value.registry.value_data":
+		return ev.SetRegistryKeyValue.ValueData, nil
+	case "set_key_value.registry.value_data.length":
+		return len(ev.SetRegistryKeyValue.ValueData), nil
 	case "set_key_value.registry.value_name":
 		return ev.SetRegistryKeyValue.ValueName, nil
 	case "set_key_value.registry.value_name.length":
 		return len(ev.SetRegistryKeyValue.ValueName), nil
+	case "set_key_value.value_data":
+		return ev.SetRegistryKeyValue.ValueData, nil
 	case "set_key_value.value_name":
 		return ev.SetRegistryKeyValue.ValueName, nil
 	case "write.file.device_path":
@@ -2381,6 +2852,20 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "change_permission", nil
 	case "change_permission.username":
 		return "change_permission", nil
+	case "connect_named_pipe.pipe.name":
+		return "connect_named_pipe", nil
+	case "connect_named_pipe.pipe.name.length":
+		return "connect_named_pipe", nil
+	case "connect_named_pipe.pipe.path":
+		return "connect_named_pipe", nil
+	case "connect_named_pipe.pipe.path.length":
+		return "connect_named_pipe", nil
+	case "connect_named_pipe.server.path":
+		return "connect_named_pipe", nil
+	case "connect_named_pipe.server.path.length":
+		return "connect_named_pipe", nil
+	case "connect_named_pipe.server.pid":
+		return "connect_named_pipe", nil
 	case "container.created_at":
 		return "", nil
 	case "container.id":
@@ -2417,6 +2902,24 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "create_key", nil
 	case "create_key.registry.key_path.length":
 		return "create_key", nil
+	case "create_named_pipe.pipe.name":
+		return "create_named_pipe", nil
+	case "create_named_pipe.pipe.name.length":
+		return "create_named_pipe", nil
+	case "create_named_pipe.pipe.path":
+		return "create_named_pipe", nil
+	case "create_named_pipe.pipe.path.length":
+		return "create_named_pipe", nil
+	case "create_service.image_path":
+		return "create_service", nil
+	case "create_service.image_path.length":
+		return "create_service", nil
+	case "create_service.name":
+		return "create_service", nil
+	case "create_service.name.length":
+		return "create_service", nil
+	case "create_service.start_type":
+		return "create_service", nil
 	case "delete.file.device_path":
 		return "delete", nil
 	case "delete.file.device_path.length":
@@ -2445,6 +2948,20 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "delete_key", nil
 	case "delete_key.registry.key_path.length":
 		return "delete_key", nil
+	case "delete_key_value.registry.key_name":
+		return "delete_key_value", nil
+	case "delete_key_value.registry.key_name.length":
+		return "delete_key_value", nil
+	case "delete_key_value.registry.key_path":
+		return "delete_key_value", nil
+	case "delete_key_value.registry.key_path.length":
+		return "delete_key_value", nil
+	case "delete_key_value.registry.value_name":
+		return "delete_key_value", nil
+	case "delete_key_value.registry.value_name.length":
+		return "delete_key_value", nil
+	case "delete_key_value.value_name":
+		return "delete_key_value", nil
 	case "event.hostname":
 		return "", nil
 	case "event.origin":
@@ -2511,6 +3028,22 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "exit", nil
 	case "exit.user_sid":
 		return "exit", nil
+	case "modify_service.image_path":
+		return "modify_service", nil
+	case "modify_service.image_path.length":
+		return "modify_service", nil
+	case "modify_service.name":
+		return "modify_service", nil
+	case "modify_service.name.length":
+		return "modify_service", nil
+	case "modify_service.previous_image_path":
+		return "modify_service", nil
+	case "modify_service.previous_image_path.length":
+		return "modify_service", nil
+	case "modify_service.previous_start_type":
+		return "modify_service", nil
+	case "modify_service.start_type":
+		return "modify_service", nil
 	case "open.registry.key_name":
 		return "open_key", nil
 	case "open.registry.key_name.length":
@@ -2639,10 +3172,16 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "set_key_value", nil
 	case "set.registry.key_path.length":
 		return "set_key_value", nil
+	case "set.registry.value_data":
+		return "set_key_value", nil
+	case "set.registry.value_data.length":
+		return "set_key_value", nil
 	case "set.registry.value_name":
 		return "set_key_value", nil
 	case "set.registry.value_name.length":
 		return "set_key_value", nil
+	case "set.value_data":
+		return "set_key_value", nil
 	case "set.value_name":
 		return "set_key_value", nil
 	case "set_key_value.registry.key_name":
@@ -2653,10 +3192,16 @@ func (ev *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
 		return "set_key_value", nil
 	case "set_key_value.registry.key_path.length":
 		return "set_key_value", nil
+	case "set_key_value.registry.value_data":
+		return "set_key_value", nil
+	case "set_key_value.registry.value_data.length":
+		return "set_key_value", nil
 	case "set_key_value.registry.value_name":
 		return "set_key_value", nil
 	case "set_key_value.registry.value_name.length":
 		return "set_key_value", nil
+	case "set_key_value.value_data":
+		return "set_key_value", nil
 	case "set_key_value.value_name":
 		return "set_key_value", nil
 	case "write.file.device_path":
@@ -2688,6 +3233,20 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.String, nil
 	case "change_permission.username":
 		return reflect.String, nil
+	case "connect_named_pipe.pipe.name":
+		return reflect.String, nil
+	case "connect_named_pipe.pipe.name.length":
+		return reflect.Int, nil
+	case "connect_named_pipe.pipe.path":
+		return reflect.String, nil
+	case "connect_named_pipe.pipe.path.length":
+		return reflect.Int, nil
+	case "connect_named_pipe.server.path":
+		return reflect.String, nil
+	case "connect_named_pipe.server.path.length":
+		return reflect.Int, nil
+	case "connect_named_pipe.server.pid":
+		return reflect.Int, nil
 	case "container.created_at":
 		return reflect.Int, nil
 	case "container.id":
@@ -2724,6 +3283,24 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.String, nil
 	case "create_key.registry.key_path.length":
 		return reflect.Int, nil
+	case "create_named_pipe.pipe.name":
+		return reflect.String, nil
+	case "create_named_pipe.pipe.name.length":
+		return reflect.Int, nil
+	case "create_named_pipe.pipe.path":
+		return reflect.String, nil
+	case "create_named_pipe.pipe.path.length":
+		return reflect.Int, nil
+	case "create_service.image_path":
+		return reflect.String, nil
+	case "create_service.image_path.length":
+		return reflect.Int, nil
+	case "create_service.name":
+		return reflect.String, nil
+	case "create_service.name.length":
+		return reflect.Int, nil
+	case "create_service.start_type":
+		return reflect.String, nil
 	case "delete.file.device_path":
 		return reflect.String, nil
 	case "delete.file.device_path.length":
@@ -2752,6 +3329,20 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.String, nil
 	case "delete_key.registry.key_path.length":
 		return reflect.Int, nil
+	case "delete_key_value.registry.key_name":
+		return reflect.String, nil
+	case "delete_key_value.registry.key_name.length":
+		return reflect.Int, nil
+	case "delete_key_value.registry.key_path":
+		return reflect.String, nil
+	case "delete_key_value.registry.key_path.length":
+		return reflect.Int, nil
+	case "delete_key_value.registry.value_name":
+		return reflect.String, nil
+	case "delete_key_value.registry.value_name.length":
+		return reflect.Int, nil
+	case "delete_key_value.value_name":
+		return reflect.String, nil
 	case "event.hostname":
 		return reflect.String, nil
 	case "event.origin":
@@ -2818,6 +3409,22 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.String, nil
 	case "exit.user_sid":
 		return reflect.String, nil
+	case "modify_service.image_path":
+		return reflect.String, nil
+	case "modify_service.image_path.length":
+		return reflect.Int, nil
+	case "modify_service.name":
+		return reflect.String, nil
+	case "modify_service.name.length":
+		return reflect.Int, nil
+	case "modify_service.previous_image_path":
+		return reflect.String, nil
+	case "modify_service.previous_image_path.length":
+		return reflect.Int, nil
+	case "modify_service.previous_start_type":
+		return reflect.String, nil
+	case "modify_service.start_type":
+		return reflect.String, nil
 	case "open.registry.key_name":
 		return reflect.String, nil
 	case "open.registry.key_name.length":
@@ -2946,10 +3553,16 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.String, nil
 	case "set.registry.key_path.length":
 		return reflect.Int, nil
+	case "set.registry.value_data":
+		return reflect.String, nil
+	case "set.registry.value_data.length":
+		return reflect.Int, nil
 	case "set.registry.value_name":
 		return reflect.String, nil
 	case "set.registry.value_name.length":
 		return reflect.Int, nil
+	case "set.value_data":
+		return reflect.String, nil
 	case "set.value_name":
 		return reflect.String, nil
 	case "set_key_value.registry.key_name":
@@ -2960,10 +3573,16 @@ func (ev *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 		return reflect.String, nil
 	case "set_key_value.registry.key_path.length":
 		return reflect.Int, nil
+	case "set_key_value.registry.value_data":
+		return reflect.String, nil
+	case "set_key_value.registry.value_data.length":
+		return reflect.Int, nil
 	case "set_key_value.registry.value_name":
 		return reflect.String, nil
 	case "set_key_value.registry.value_name.length":
 		return reflect.Int, nil
+	case "set_key_value.value_data":
+		return reflect.String, nil
 	case "set_key_value.value_name":
 		return reflect.String, nil
 	case "write.file.device_path":
@@ -3025,6 +3644,40 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.ChangePermission.UserName = rv
 		return nil
+	case "connect_named_pipe.pipe.name":
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "ConnectNamedPipe.Pipe.Name"}
+		}
+		ev.ConnectNamedPipe.Pipe.Name = rv
+		return nil
+	case "connect_named_pipe.pipe.name.length":
+		return &eval.ErrFieldReadOnly{Field: "connect_named_pipe.pipe.name.length"}
+	case "connect_named_pipe.pipe.path":
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "ConnectNamedPipe.Pipe.Path"}
+		}
+		ev.ConnectNamedPipe.Pipe.Path = rv
+		return nil
+	case "connect_named_pipe.pipe.path.length":
+		return &eval.ErrFieldReadOnly{Field: "connect_named_pipe.pipe.path.length"}
+	case "connect_named_pipe.server.path":
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "ConnectNamedPipe.ServerPath"}
+		}
+		ev.ConnectNamedPipe.ServerPath = rv
+		return nil
+	case "connect_named_pipe.server.path.length":
+		return &eval.ErrFieldReadOnly{Field: "connect_named_pipe.server.path.length"}
+	case "connect_named_pipe.server.pid":
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "ConnectNamedPipe.ServerPid"}
+		}
+		ev.ConnectNamedPipe.ServerPid = uint32(rv)
+		return nil
 	case "container.created_at":
 		if ev.BaseEvent.ContainerContext == nil {
 			ev.BaseEvent.ContainerContext = &ContainerContext{}
@@ -3131,6 +3784,49 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		return nil
 	case "create_key.registry.key_path.length":
 		return &eval.ErrFieldReadOnly{Field: "create_key.registry.key_path.length"}
+	case "create_named_pipe.pipe.name":
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "CreateNamedPipe.Pipe.Name"}
+		}
+		ev.CreateNamedPipe.Pipe.Name = rv
+		return nil
+	case "create_named_pipe.pipe.name.length":
+		return &eval.ErrFieldReadOnly{Field: "create_named_pipe.pipe.name.length"}
+	case "create_named_pipe.pipe.path":
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "CreateNamedPipe.Pipe.Path"}
+		}
+		ev.CreateNamedPipe.Pipe.Path = rv
+		return nil
+	case "create_named_pipe.pipe.path.length":
+		return &eval.ErrFieldReadOnly{Field: "create_named_pipe.pipe.path.length"}
+	case "create_service.image_path":
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "CreateService.ImagePath"}
+		}
+		ev.CreateService.ImagePath = rv
+		return nil
+	case "create_service.image_path.length":
+		return &eval.ErrFieldReadOnly{Field: "create_service.image_path.length"}
+	case "create_service.name":
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "CreateService.Name"}
+		}
+		ev.CreateService.Name = rv
+		return nil
+	case "create_service.name.length":
+		return &eval.ErrFieldReadOnly{Field: "create_service.name.length"}
+	case "create_service.start_type":
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "CreateService.StartType"}
+		}
+		ev.CreateService.StartType = rv
+		return nil
 	case "delete.file.device_path":
 		rv, ok := value.(string)
 		if !ok {
@@ -3194,6 +3890,40 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		return nil
 	case "delete_key.registry.key_path.length":
 		return &eval.ErrFieldReadOnly{Field: "delete_key.registry.key_path.length"}
+	case "delete_key_value.registry.key_name":
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "DeleteRegistryKeyValue.Registry.KeyName"}
+		}
+		ev.DeleteRegistryKeyValue.Registry.KeyName = rv
+		return nil
+	case "delete_key_value.registry.key_name.length":
+		return &eval.ErrFieldReadOnly{Field: "delete_key_value.registry.key_name.length"}
+	case "delete_key_value.registry.key_path":
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "DeleteRegistryKeyValue.Registry.KeyPath"}
+		}
+		ev.DeleteRegistryKeyValue.Registry.KeyPath = rv
+		return nil
+	case "delete_key_value.registry.key_path.length":
+		return &eval.ErrFieldReadOnly{Field: "delete_key_value.registry.key_path.length"}
+	case "delete_key_value.registry.value_name":
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "DeleteRegistryKeyValue.ValueName"}
+		}
+		ev.DeleteRegistryKeyValue.ValueName = rv
+		return nil
+	case "delete_key_value.registry.value_name.length":
+		return &eval.ErrFieldReadOnly{Field: "delete_key_value.registry.value_name.length"}
+	case "delete_key_value.value_name":
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "DeleteRegistryKeyValue.ValueName"}
+		}
+		ev.DeleteRegistryKeyValue.ValueName = rv
+		return nil
 	case "event.hostname":
 		rv, ok := value.(string)
 		if !ok {
@@ -3495,6 +4225,47 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.Exit.Process.OwnerSidString = rv
 		return nil
+	case "modify_service.image_path":
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "ModifyService.ImagePath"}
+		}
+		ev.ModifyService.ImagePath = rv
+		return nil
+	case "modify_service.image_path.length":
+		return &eval.ErrFieldReadOnly{Field: "modify_service.image_path.length"}
+	case "modify_service.name":
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "ModifyService.Name"}
+		}
+		ev.ModifyService.Name = rv
+		return nil
+	case "modify_service.name.length":
+		return &eval.ErrFieldReadOnly{Field: "modify_service.name.length"}
+	case "modify_service.previous_image_path":
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "ModifyService.PreviousImagePath"}
+		}
+		ev.ModifyService.PreviousImagePath = rv
+		return nil
+	case "modify_service.previous_image_path.length":
+		return &eval.ErrFieldReadOnly{Field: "modify_service.previous_image_path.length"}
+	case "modify_service.previous_start_type":
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "ModifyService.PreviousStartType"}
+		}
+		ev.ModifyService.PreviousStartType = rv
+		return nil
+	case "modify_service.start_type":
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "ModifyService.StartType"}
+		}
+		ev.ModifyService.StartType = rv
+		return nil
 	case "open.registry.key_name":
 		rv, ok := value.(string)
 		if !ok {
@@ -4067,6 +4838,15 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		return nil
 	case "set.registry.key_path.length":
 		return &eval.ErrFieldReadOnly{Field: "set.registry.key_path.length"}
+	case "set.registry.value_data":
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "SetRegistryKeyValue.ValueData"}
+		}
+		ev.SetRegistryKeyValue.ValueData = rv
+		return nil
+	case "set.registry.value_data.length":
+		return &eval.ErrFieldReadOnly{Field: "set.registry.value_data.length"}
 	case "set.registry.value_name":
 		rv, ok := value.(string)
 		if !ok {
@@ -4076,6 +4856,13 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		return nil
 	case "set.registry.value_name.length":
 		return &eval.ErrFieldReadOnly{Field: "set.registry.value_name.length"}
+	case "set.value_data":
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "SetRegistryKeyValue.ValueData"}
+		}
+		ev.SetRegistryKeyValue.ValueData = rv
+		return nil
 	case "set.value_name":
 		rv, ok := value.(string)
 		if !ok {
@@ -4101,6 +4888,15 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		return nil
 	case "set_key_value.registry.key_path.length":
 		return &eval.ErrFieldReadOnly{Field: "set_key_value.registry.key_path.length"}
+	case "set_key_value.registry.value_data":
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "SetRegistryKeyValue.ValueData"}
+		}
+		ev.SetRegistryKeyValue.ValueData = rv
+		return nil
+	case "set_key_value.registry.value_data.length":
+		return &eval.ErrFieldReadOnly{Field: "set_key_value.registry.value_data.length"}
 	case "set_key_value.registry.value_name":
 		rv, ok := value.(string)
 		if !ok {
@@ -4110,6 +4906,13 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		return nil
 	case "set_key_value.registry.value_name.length":
 		return &eval.ErrFieldReadOnly{Field: "set_key_value.registry.value_name.length"}
+	case "set_key_value.value_data":
+		rv, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "SetRegistryKeyValue.ValueData"}
+		}
+		ev.SetRegistryKeyValue.ValueData = rv
+		return nil
 	case "set_key_value.value_name":
 		rv, ok := value.(string)
 		if !ok {
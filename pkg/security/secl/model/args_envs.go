@@ -29,6 +29,27 @@ type ArgsEnvs struct {
 type ArgsEntry struct {
 	Values    []string
 	Truncated bool
+
+	// ObfuscationScore is computed from the unscrubbed values, once ObfuscationScoreResolved is set
+	ObfuscationScore         int
+	ObfuscationScoreResolved bool
+
+	memory argsEnvsMemory
+}
+
+// RetainMemory references the args from a cache entry, see argsEnvsMemory
+func (p *ArgsEntry) RetainMemory(size func() int64) int64 {
+	return p.memory.retain(size)
+}
+
+// ReleaseMemory drops a reference of a cache entry to the args, see argsEnvsMemory
+func (p *ArgsEntry) ReleaseMemory() int64 {
+	return p.memory.release()
+}
+
+// MemoryRefs returns the number of cache entries referencing the args
+func (p *ArgsEntry) MemoryRefs() int {
+	return p.memory.refs
 }
 
 // Equals compares two ArgsEntry
@@ -49,10 +70,65 @@ type EnvsEntry struct {
 
 	filteredEnvs []string
 	kv           map[string]string
+
+	memory argsEnvsMemory
+}
+
+// RetainMemory references the envs from a cache entry, see argsEnvsMemory
+func (p *EnvsEntry) RetainMemory(size func() int64) int64 {
+	return p.memory.retain(size)
+}
+
+// ReleaseMemory drops a reference of a cache entry to the envs, see argsEnvsMemory
+func (p *EnvsEntry) ReleaseMemory() int64 {
+	return p.memory.release()
+}
+
+// MemoryRefs returns the number of cache entries referencing the envs
+func (p *EnvsEntry) MemoryRefs() int {
+	return p.memory.refs
+}
+
+// argsEnvsMemory accounts once the memory of args or envs shared by several cache entries, the forks sharing the args
+// and envs of their parent. The size is computed on the first reference and kept until the last one is dropped, so
+// that the memory usage gets back to its previous value even if the values were changed in between.
+type argsEnvsMemory struct {
+	refs int
+	size int64
+}
+
+// retain returns the memory to add to the usage, only the first reference adds the size of the values
+func (m *argsEnvsMemory) retain(size func() int64) int64 {
+	m.refs++
+	if m.refs > 1 {
+		return 0
+	}
+	m.size = size()
+	return m.size
+}
+
+// release returns the memory to remove from the usage, only the last reference removes the size of the values
+func (m *argsEnvsMemory) release() int64 {
+	if m.refs == 0 {
+		return 0
+	}
+	m.refs--
+	if m.refs > 0 {
+		return 0
+	}
+	return m.size
 }
 
 // FilterEnvs returns an array of envs, only the name of each variable is returned unless the variable name is part of the provided filter
 func (p *EnvsEntry) FilterEnvs(envsWithValue map[string]bool) ([]string, bool) {
+	return p.FilterEnvsFunc(func(name string) bool {
+		return envsWithValue[name]
+	})
+}
+
+// FilterEnvsFunc returns an array of envs, only the name of each variable is returned unless keepValue returns true
+// for the variable name
+func (p *EnvsEntry) FilterEnvsFunc(keepValue func(name string) bool) ([]string, bool) {
 	if p.filteredEnvs != nil {
 		return p.filteredEnvs, p.Truncated
 	}
@@ -66,7 +142,7 @@ func (p *EnvsEntry) FilterEnvs(envsWithValue map[string]bool) ([]string, bool) {
 	for _, value := range p.Values {
 		k, _, found := strings.Cut(value, "=")
 		if found {
-			if envsWithValue[k] {
+			if keepValue(k) {
 				p.filteredEnvs = append(p.filteredEnvs, value)
 			} else {
 				p.filteredEnvs = append(p.filteredEnvs, k)
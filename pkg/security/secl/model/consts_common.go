@@ -61,6 +61,10 @@ const (
 
 	// EventFlagsHasActiveActivityDump true if the event has an active activity dump associated to it
 	EventFlagsHasActiveActivityDump
+
+	// EventFlagsPreSnapshot true if the event was handled before the end of the snapshot of the processes, its lineage
+	// may be incomplete
+	EventFlagsPreSnapshot
 )
 
 const (
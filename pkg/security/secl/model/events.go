@@ -138,6 +138,16 @@ const (
 	DeleteRegistryKeyEventType
 	// ChangePermissionEventType event
 	ChangePermissionEventType
+	// DeleteRegistryKeyValueEventType event
+	DeleteRegistryKeyValueEventType
+	// CreateNamedPipeEventType event
+	CreateNamedPipeEventType
+	// ConnectNamedPipeEventType event
+	ConnectNamedPipeEventType
+	// CreateServiceEventType event
+	CreateServiceEventType
+	// ModifyServiceEventType event
+	ModifyServiceEventType
 
 	// MaxAllEventType is used internally to get the maximum number of events.
 	MaxAllEventType
@@ -249,6 +259,16 @@ func (t EventType) String() string {
 		return "delete_key"
 	case ChangePermissionEventType:
 		return "change_permission"
+	case DeleteRegistryKeyValueEventType:
+		return "delete_key_value"
+	case CreateNamedPipeEventType:
+		return "create_named_pipe"
+	case ConnectNamedPipeEventType:
+		return "connect_named_pipe"
+	case CreateServiceEventType:
+		return "create_service"
+	case ModifyServiceEventType:
+		return "modify_service"
 	case LoginUIDWriteEventType:
 		return "login_uid_write"
 	case CgroupWriteEventType:
@@ -62,6 +62,22 @@ func (ev *Event) GetBpfCmd() uint32 {
 	return ev.BPF.Cmd
 }
 
+// GetBpfKernelSecurityLockdown returns the value of the field, resolving if necessary
+func (ev *Event) GetBpfKernelSecurityLockdown() string {
+	if ev.GetEventType().String() != "bpf" {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveKernelLockdown(ev, &ev.BPF.KernelSecurity)
+}
+
+// GetBpfKernelSecuritySecureBoot returns the value of the field, resolving if necessary
+func (ev *Event) GetBpfKernelSecuritySecureBoot() string {
+	if ev.GetEventType().String() != "bpf" {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveKernelSecureBoot(ev, &ev.BPF.KernelSecurity)
+}
+
 // GetBpfMapName returns the value of the field, resolving if necessary
 func (ev *Event) GetBpfMapName() string {
 	if ev.GetEventType().String() != "bpf" {
@@ -142,6 +158,21 @@ func (ev *Event) GetCapsetCapPermitted() uint64 {
 	return ev.Capset.CapPermitted
 }
 
+// GetCgroupAncestors returns the value of the field, resolving if necessary
+func (ev *Event) GetCgroupAncestors() []string {
+	return ev.FieldHandlers.ResolveCGroupAncestors(ev, &ev.CGroupContext)
+}
+
+// GetCgroupControllers returns the value of the field, resolving if necessary
+func (ev *Event) GetCgroupControllers() []string {
+	return ev.FieldHandlers.ResolveCGroupControllers(ev, &ev.CGroupContext)
+}
+
+// GetCgroupCpuUsage returns the value of the field, resolving if necessary
+func (ev *Event) GetCgroupCpuUsage() int {
+	return ev.FieldHandlers.ResolveCGroupCPUUsage(ev, &ev.CGroupContext)
+}
+
 // GetCgroupFileInode returns the value of the field, resolving if necessary
 func (ev *Event) GetCgroupFileInode() uint64 {
 	return ev.CGroupContext.CGroupFile.Inode
@@ -162,6 +193,21 @@ func (ev *Event) GetCgroupManager() string {
 	return ev.FieldHandlers.ResolveCGroupManager(ev, &ev.CGroupContext)
 }
 
+// GetCgroupMemoryCurrent returns the value of the field, resolving if necessary
+func (ev *Event) GetCgroupMemoryCurrent() int {
+	return ev.FieldHandlers.ResolveCGroupMemoryCurrent(ev, &ev.CGroupContext)
+}
+
+// GetCgroupMemoryHighExceeded returns the value of the field, resolving if necessary
+func (ev *Event) GetCgroupMemoryHighExceeded() bool {
+	return ev.FieldHandlers.ResolveCGroupMemoryHighExceeded(ev, &ev.CGroupContext)
+}
+
+// GetCgroupPidsCurrent returns the value of the field, resolving if necessary
+func (ev *Event) GetCgroupPidsCurrent() int {
+	return ev.FieldHandlers.ResolveCGroupPidsCurrent(ev, &ev.CGroupContext)
+}
+
 // GetChdirFileChangeTime returns the value of the field, resolving if necessary
 func (ev *Event) GetChdirFileChangeTime() uint64 {
 	if ev.GetEventType().String() != "chdir" {
@@ -218,6 +264,22 @@ func (ev *Event) GetChdirFileInode() uint64 {
 	return ev.Chdir.File.FileFields.PathKey.Inode
 }
 
+// GetChdirFileLayerDigest returns the value of the field, resolving if necessary
+func (ev *Event) GetChdirFileLayerDigest() string {
+	if ev.GetEventType().String() != "chdir" {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Chdir.File)
+}
+
+// GetChdirFileLayerPath returns the value of the field, resolving if necessary
+func (ev *Event) GetChdirFileLayerPath() string {
+	if ev.GetEventType().String() != "chdir" {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Chdir.File)
+}
+
 // GetChdirFileMode returns the value of the field, resolving if necessary
 func (ev *Event) GetChdirFileMode() uint16 {
 	if ev.GetEventType().String() != "chdir" {
@@ -458,6 +520,22 @@ func (ev *Event) GetChmodFileInode() uint64 {
 	return ev.Chmod.File.FileFields.PathKey.Inode
 }
 
+// GetChmodFileLayerDigest returns the value of the field, resolving if necessary
+func (ev *Event) GetChmodFileLayerDigest() string {
+	if ev.GetEventType().String() != "chmod" {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Chmod.File)
+}
+
+// GetChmodFileLayerPath returns the value of the field, resolving if necessary
+func (ev *Event) GetChmodFileLayerPath() string {
+	if ev.GetEventType().String() != "chmod" {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Chmod.File)
+}
+
 // GetChmodFileMode returns the value of the field, resolving if necessary
 func (ev *Event) GetChmodFileMode() uint16 {
 	if ev.GetEventType().String() != "chmod" {
@@ -538,6 +616,22 @@ func (ev *Event) GetChmodFilePathLength() int {
 	return len(ev.FieldHandlers.ResolveFilePath(ev, &ev.Chmod.File))
 }
 
+// GetChmodFilePreviousMode returns the value of the field, resolving if necessary
+func (ev *Event) GetChmodFilePreviousMode() uint32 {
+	if ev.GetEventType().String() != "chmod" {
+		return uint32(0)
+	}
+	return ev.Chmod.PreviousMode
+}
+
+// GetChmodFilePreviousRights returns the value of the field, resolving if necessary
+func (ev *Event) GetChmodFilePreviousRights() uint32 {
+	if ev.GetEventType().String() != "chmod" {
+		return uint32(0)
+	}
+	return ev.Chmod.PreviousMode
+}
+
 // GetChmodFileRights returns the value of the field, resolving if necessary
 func (ev *Event) GetChmodFileRights() int {
 	if ev.GetEventType().String() != "chmod" {
@@ -722,6 +816,22 @@ func (ev *Event) GetChownFileInode() uint64 {
 	return ev.Chown.File.FileFields.PathKey.Inode
 }
 
+// GetChownFileLayerDigest returns the value of the field, resolving if necessary
+func (ev *Event) GetChownFileLayerDigest() string {
+	if ev.GetEventType().String() != "chown" {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Chown.File)
+}
+
+// GetChownFileLayerPath returns the value of the field, resolving if necessary
+func (ev *Event) GetChownFileLayerPath() string {
+	if ev.GetEventType().String() != "chown" {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Chown.File)
+}
+
 // GetChownFileMode returns the value of the field, resolving if necessary
 func (ev *Event) GetChownFileMode() uint16 {
 	if ev.GetEventType().String() != "chown" {
@@ -802,6 +912,22 @@ func (ev *Event) GetChownFilePathLength() int {
 	return len(ev.FieldHandlers.ResolveFilePath(ev, &ev.Chown.File))
 }
 
+// GetChownFilePreviousGid returns the value of the field, resolving if necessary
+func (ev *Event) GetChownFilePreviousGid() int64 {
+	if ev.GetEventType().String() != "chown" {
+		return int64(0)
+	}
+	return ev.Chown.PreviousGID
+}
+
+// GetChownFilePreviousUid returns the value of the field, resolving if necessary
+func (ev *Event) GetChownFilePreviousUid() int64 {
+	if ev.GetEventType().String() != "chown" {
+		return int64(0)
+	}
+	return ev.Chown.PreviousUID
+}
+
 // GetChownFileRights returns the value of the field, resolving if necessary
 func (ev *Event) GetChownFileRights() int {
 	if ev.GetEventType().String() != "chown" {
@@ -1034,6 +1160,22 @@ func (ev *Event) GetDnsQuestionCount() uint16 {
 	return ev.DNS.Count
 }
 
+// GetDnsQuestionDomainQueryRate returns the value of the field, resolving if necessary
+func (ev *Event) GetDnsQuestionDomainQueryRate() int {
+	if ev.GetEventType().String() != "dns" {
+		return 0
+	}
+	return ev.DNS.DomainQueryRate
+}
+
+// GetDnsQuestionLabelEntropy returns the value of the field, resolving if necessary
+func (ev *Event) GetDnsQuestionLabelEntropy() int {
+	if ev.GetEventType().String() != "dns" {
+		return 0
+	}
+	return ev.DNS.LabelEntropy
+}
+
 // GetDnsQuestionLength returns the value of the field, resolving if necessary
 func (ev *Event) GetDnsQuestionLength() uint16 {
 	if ev.GetEventType().String() != "dns" {
@@ -1066,6 +1208,14 @@ func (ev *Event) GetDnsQuestionType() uint16 {
 	return ev.DNS.Type
 }
 
+// GetDnsQuestionUniqueSubdomains returns the value of the field, resolving if necessary
+func (ev *Event) GetDnsQuestionUniqueSubdomains() int {
+	if ev.GetEventType().String() != "dns" {
+		return 0
+	}
+	return ev.DNS.UniqueSubdomains
+}
+
 // GetEventAsync returns the value of the field, resolving if necessary
 func (ev *Event) GetEventAsync() bool {
 	return ev.FieldHandlers.ResolveAsync(ev)
@@ -1217,6 +1367,39 @@ func (ev *Event) GetExecCapPermitted() uint64 {
 	return ev.Exec.Process.Credentials.CapPermitted
 }
 
+// GetExecCgroupAncestors returns the value of the field, resolving if necessary
+func (ev *Event) GetExecCgroupAncestors() []string {
+	if ev.GetEventType().String() != "exec" {
+		return []string{}
+	}
+	if ev.Exec.Process == nil {
+		return []string{}
+	}
+	return ev.FieldHandlers.ResolveCGroupAncestors(ev, &ev.Exec.Process.CGroup)
+}
+
+// GetExecCgroupControllers returns the value of the field, resolving if necessary
+func (ev *Event) GetExecCgroupControllers() []string {
+	if ev.GetEventType().String() != "exec" {
+		return []string{}
+	}
+	if ev.Exec.Process == nil {
+		return []string{}
+	}
+	return ev.FieldHandlers.ResolveCGroupControllers(ev, &ev.Exec.Process.CGroup)
+}
+
+// GetExecCgroupCpuUsage returns the value of the field, resolving if necessary
+func (ev *Event) GetExecCgroupCpuUsage() int {
+	if ev.GetEventType().String() != "exec" {
+		return 0
+	}
+	if ev.Exec.Process == nil {
+		return 0
+	}
+	return ev.FieldHandlers.ResolveCGroupCPUUsage(ev, &ev.Exec.Process.CGroup)
+}
+
 // GetExecCgroupFileInode returns the value of the field, resolving if necessary
 func (ev *Event) GetExecCgroupFileInode() uint64 {
 	if ev.GetEventType().String() != "exec" {
@@ -1261,6 +1444,72 @@ func (ev *Event) GetExecCgroupManager() string {
 	return ev.FieldHandlers.ResolveCGroupManager(ev, &ev.Exec.Process.CGroup)
 }
 
+// GetExecCgroupMemoryCurrent returns the value of the field, resolving if necessary
+func (ev *Event) GetExecCgroupMemoryCurrent() int {
+	if ev.GetEventType().String() != "exec" {
+		return 0
+	}
+	if ev.Exec.Process == nil {
+		return 0
+	}
+	return ev.FieldHandlers.ResolveCGroupMemoryCurrent(ev, &ev.Exec.Process.CGroup)
+}
+
+// GetExecCgroupMemoryHighExceeded returns the value of the field, resolving if necessary
+func (ev *Event) GetExecCgroupMemoryHighExceeded() bool {
+	if ev.GetEventType().String() != "exec" {
+		return false
+	}
+	if ev.Exec.Process == nil {
+		return false
+	}
+	return ev.FieldHandlers.ResolveCGroupMemoryHighExceeded(ev, &ev.Exec.Process.CGroup)
+}
+
+// GetExecCgroupPidsCurrent returns the value of the field, resolving if necessary
+func (ev *Event) GetExecCgroupPidsCurrent() int {
+	if ev.GetEventType().String() != "exec" {
+		return 0
+	}
+	if ev.Exec.Process == nil {
+		return 0
+	}
+	return ev.FieldHandlers.ResolveCGroupPidsCurrent(ev, &ev.Exec.Process.CGroup)
+}
+
+// GetExecCloudCredentialsProviders returns the value of the field, resolving if necessary
+func (ev *Event) GetExecCloudCredentialsProviders() []string {
+	if ev.GetEventType().String() != "exec" {
+		return []string{}
+	}
+	if ev.Exec.Process == nil {
+		return []string{}
+	}
+	return ev.FieldHandlers.ResolveProcessCloudCredentialsProviders(ev, ev.Exec.Process)
+}
+
+// GetExecCloudCredentialsResources returns the value of the field, resolving if necessary
+func (ev *Event) GetExecCloudCredentialsResources() []string {
+	if ev.GetEventType().String() != "exec" {
+		return []string{}
+	}
+	if ev.Exec.Process == nil {
+		return []string{}
+	}
+	return ev.FieldHandlers.ResolveProcessCloudCredentialsResources(ev, ev.Exec.Process)
+}
+
+// GetExecCloudCredentialsTypes returns the value of the field, resolving if necessary
+func (ev *Event) GetExecCloudCredentialsTypes() []string {
+	if ev.GetEventType().String() != "exec" {
+		return []string{}
+	}
+	if ev.Exec.Process == nil {
+		return []string{}
+	}
+	return ev.FieldHandlers.ResolveProcessCloudCredentialsTypes(ev, ev.Exec.Process)
+}
+
 // GetExecCmdargv returns the value of the field, resolving if necessary
 func (ev *Event) GetExecCmdargv() []string {
 	if ev.GetEventType().String() != "exec" {
@@ -1272,6 +1521,17 @@ func (ev *Event) GetExecCmdargv() []string {
 	return ev.FieldHandlers.ResolveProcessCmdArgv(ev, ev.Exec.Process)
 }
 
+// GetExecCmdlineObfuscationScore returns the value of the field, resolving if necessary
+func (ev *Event) GetExecCmdlineObfuscationScore() int {
+	if ev.GetEventType().String() != "exec" {
+		return 0
+	}
+	if ev.Exec.Process == nil {
+		return 0
+	}
+	return ev.FieldHandlers.ResolveProcessCmdLineObfuscationScore(ev, ev.Exec.Process)
+}
+
 // GetExecComm returns the value of the field, resolving if necessary
 func (ev *Event) GetExecComm() string {
 	if ev.GetEventType().String() != "exec" {
@@ -1327,6 +1587,50 @@ func (ev *Event) GetExecEgroup() string {
 	return ev.Exec.Process.Credentials.EGroup
 }
 
+// GetExecElfBuildId returns the value of the field, resolving if necessary
+func (ev *Event) GetExecElfBuildId() string {
+	if ev.GetEventType().String() != "exec" {
+		return ""
+	}
+	if ev.Exec.Process == nil {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveProcessELFBuildID(ev, ev.Exec.Process)
+}
+
+// GetExecElfInterpreter returns the value of the field, resolving if necessary
+func (ev *Event) GetExecElfInterpreter() string {
+	if ev.GetEventType().String() != "exec" {
+		return ""
+	}
+	if ev.Exec.Process == nil {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveProcessELFInterpreter(ev, ev.Exec.Process)
+}
+
+// GetExecElfIsStatic returns the value of the field, resolving if necessary
+func (ev *Event) GetExecElfIsStatic() bool {
+	if ev.GetEventType().String() != "exec" {
+		return false
+	}
+	if ev.Exec.Process == nil {
+		return false
+	}
+	return ev.FieldHandlers.ResolveProcessELFIsStatic(ev, ev.Exec.Process)
+}
+
+// GetExecElfIsStripped returns the value of the field, resolving if necessary
+func (ev *Event) GetExecElfIsStripped() bool {
+	if ev.GetEventType().String() != "exec" {
+		return false
+	}
+	if ev.Exec.Process == nil {
+		return false
+	}
+	return ev.FieldHandlers.ResolveProcessELFIsStripped(ev, ev.Exec.Process)
+}
+
 // GetExecEnvp returns the value of the field, resolving if necessary
 func (ev *Event) GetExecEnvp() []string {
 	if ev.GetEventType().String() != "exec" {
@@ -1502,6 +1806,34 @@ func (ev *Event) GetExecFileInode() uint64 {
 	return ev.Exec.Process.FileEvent.FileFields.PathKey.Inode
 }
 
+// GetExecFileLayerDigest returns the value of the field, resolving if necessary
+func (ev *Event) GetExecFileLayerDigest() string {
+	if ev.GetEventType().String() != "exec" {
+		return ""
+	}
+	if ev.Exec.Process == nil {
+		return ""
+	}
+	if !ev.Exec.Process.IsNotKworker() {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Exec.Process.FileEvent)
+}
+
+// GetExecFileLayerPath returns the value of the field, resolving if necessary
+func (ev *Event) GetExecFileLayerPath() string {
+	if ev.GetEventType().String() != "exec" {
+		return ""
+	}
+	if ev.Exec.Process == nil {
+		return ""
+	}
+	if !ev.Exec.Process.IsNotKworker() {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Exec.Process.FileEvent)
+}
+
 // GetExecFileMode returns the value of the field, resolving if necessary
 func (ev *Event) GetExecFileMode() uint16 {
 	if ev.GetEventType().String() != "exec" {
@@ -1755,6 +2087,17 @@ func (ev *Event) GetExecGroup() string {
 	return ev.Exec.Process.Credentials.Group
 }
 
+// GetExecIdentity returns the value of the field, resolving if necessary
+func (ev *Event) GetExecIdentity() string {
+	if ev.GetEventType().String() != "exec" {
+		return ""
+	}
+	if ev.Exec.Process == nil {
+		return ""
+	}
+	return ev.Exec.Process.Identity
+}
+
 // GetExecInterpreterFileChangeTime returns the value of the field, resolving if necessary
 func (ev *Event) GetExecInterpreterFileChangeTime() uint64 {
 	if ev.GetEventType().String() != "exec" {
@@ -1853,6 +2196,34 @@ func (ev *Event) GetExecInterpreterFileInode() uint64 {
 	return ev.Exec.Process.LinuxBinprm.FileEvent.FileFields.PathKey.Inode
 }
 
+// GetExecInterpreterFileLayerDigest returns the value of the field, resolving if necessary
+func (ev *Event) GetExecInterpreterFileLayerDigest() string {
+	if ev.GetEventType().String() != "exec" {
+		return ""
+	}
+	if ev.Exec.Process == nil {
+		return ""
+	}
+	if !ev.Exec.Process.HasInterpreter() {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Exec.Process.LinuxBinprm.FileEvent)
+}
+
+// GetExecInterpreterFileLayerPath returns the value of the field, resolving if necessary
+func (ev *Event) GetExecInterpreterFileLayerPath() string {
+	if ev.GetEventType().String() != "exec" {
+		return ""
+	}
+	if ev.Exec.Process == nil {
+		return ""
+	}
+	if !ev.Exec.Process.HasInterpreter() {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Exec.Process.LinuxBinprm.FileEvent)
+}
+
 // GetExecInterpreterFileMode returns the value of the field, resolving if necessary
 func (ev *Event) GetExecInterpreterFileMode() uint16 {
 	if ev.GetEventType().String() != "exec" {
@@ -2062,13 +2433,57 @@ func (ev *Event) GetExecIsThread() bool {
 	return ev.FieldHandlers.ResolveProcessIsThread(ev, ev.Exec.Process)
 }
 
-// GetExecPid returns the value of the field, resolving if necessary
-func (ev *Event) GetExecPid() uint32 {
+// GetExecNsMnt returns the value of the field, resolving if necessary
+func (ev *Event) GetExecNsMnt() int {
 	if ev.GetEventType().String() != "exec" {
-		return uint32(0)
+		return 0
 	}
 	if ev.Exec.Process == nil {
-		return uint32(0)
+		return 0
+	}
+	return ev.FieldHandlers.ResolveProcessMountNamespace(ev, ev.Exec.Process)
+}
+
+// GetExecNsPid returns the value of the field, resolving if necessary
+func (ev *Event) GetExecNsPid() int {
+	if ev.GetEventType().String() != "exec" {
+		return 0
+	}
+	if ev.Exec.Process == nil {
+		return 0
+	}
+	return ev.FieldHandlers.ResolveProcessPIDNamespace(ev, ev.Exec.Process)
+}
+
+// GetExecNsUser returns the value of the field, resolving if necessary
+func (ev *Event) GetExecNsUser() int {
+	if ev.GetEventType().String() != "exec" {
+		return 0
+	}
+	if ev.Exec.Process == nil {
+		return 0
+	}
+	return ev.FieldHandlers.ResolveProcessUserNamespace(ev, ev.Exec.Process)
+}
+
+// GetExecNsUts returns the value of the field, resolving if necessary
+func (ev *Event) GetExecNsUts() int {
+	if ev.GetEventType().String() != "exec" {
+		return 0
+	}
+	if ev.Exec.Process == nil {
+		return 0
+	}
+	return ev.FieldHandlers.ResolveProcessUTSNamespace(ev, ev.Exec.Process)
+}
+
+// GetExecPid returns the value of the field, resolving if necessary
+func (ev *Event) GetExecPid() uint32 {
+	if ev.GetEventType().String() != "exec" {
+		return uint32(0)
+	}
+	if ev.Exec.Process == nil {
+		return uint32(0)
 	}
 	return ev.Exec.Process.PIDContext.Pid
 }
@@ -2140,6 +2555,28 @@ func (ev *Event) GetExecSyscallStr3() string {
 	return ev.FieldHandlers.ResolveSyscallCtxArgsStr3(ev, &ev.Exec.SyscallContext)
 }
 
+// GetExecSystemdSlice returns the value of the field, resolving if necessary
+func (ev *Event) GetExecSystemdSlice() string {
+	if ev.GetEventType().String() != "exec" {
+		return ""
+	}
+	if ev.Exec.Process == nil {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveProcessSystemdSlice(ev, ev.Exec.Process)
+}
+
+// GetExecSystemdUnit returns the value of the field, resolving if necessary
+func (ev *Event) GetExecSystemdUnit() string {
+	if ev.GetEventType().String() != "exec" {
+		return ""
+	}
+	if ev.Exec.Process == nil {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveProcessSystemdUnit(ev, ev.Exec.Process)
+}
+
 // GetExecTid returns the value of the field, resolving if necessary
 func (ev *Event) GetExecTid() uint32 {
 	if ev.GetEventType().String() != "exec" {
@@ -2346,6 +2783,39 @@ func (ev *Event) GetExitCause() uint32 {
 	return ev.Exit.Cause
 }
 
+// GetExitCgroupAncestors returns the value of the field, resolving if necessary
+func (ev *Event) GetExitCgroupAncestors() []string {
+	if ev.GetEventType().String() != "exit" {
+		return []string{}
+	}
+	if ev.Exit.Process == nil {
+		return []string{}
+	}
+	return ev.FieldHandlers.ResolveCGroupAncestors(ev, &ev.Exit.Process.CGroup)
+}
+
+// GetExitCgroupControllers returns the value of the field, resolving if necessary
+func (ev *Event) GetExitCgroupControllers() []string {
+	if ev.GetEventType().String() != "exit" {
+		return []string{}
+	}
+	if ev.Exit.Process == nil {
+		return []string{}
+	}
+	return ev.FieldHandlers.ResolveCGroupControllers(ev, &ev.Exit.Process.CGroup)
+}
+
+// GetExitCgroupCpuUsage returns the value of the field, resolving if necessary
+func (ev *Event) GetExitCgroupCpuUsage() int {
+	if ev.GetEventType().String() != "exit" {
+		return 0
+	}
+	if ev.Exit.Process == nil {
+		return 0
+	}
+	return ev.FieldHandlers.ResolveCGroupCPUUsage(ev, &ev.Exit.Process.CGroup)
+}
+
 // GetExitCgroupFileInode returns the value of the field, resolving if necessary
 func (ev *Event) GetExitCgroupFileInode() uint64 {
 	if ev.GetEventType().String() != "exit" {
@@ -2390,6 +2860,72 @@ func (ev *Event) GetExitCgroupManager() string {
 	return ev.FieldHandlers.ResolveCGroupManager(ev, &ev.Exit.Process.CGroup)
 }
 
+// GetExitCgroupMemoryCurrent returns the value of the field, resolving if necessary
+func (ev *Event) GetExitCgroupMemoryCurrent() int {
+	if ev.GetEventType().String() != "exit" {
+		return 0
+	}
+	if ev.Exit.Process == nil {
+		return 0
+	}
+	return ev.FieldHandlers.ResolveCGroupMemoryCurrent(ev, &ev.Exit.Process.CGroup)
+}
+
+// GetExitCgroupMemoryHighExceeded returns the value of the field, resolving if necessary
+func (ev *Event) GetExitCgroupMemoryHighExceeded() bool {
+	if ev.GetEventType().String() != "exit" {
+		return false
+	}
+	if ev.Exit.Process == nil {
+		return false
+	}
+	return ev.FieldHandlers.ResolveCGroupMemoryHighExceeded(ev, &ev.Exit.Process.CGroup)
+}
+
+// GetExitCgroupPidsCurrent returns the value of the field, resolving if necessary
+func (ev *Event) GetExitCgroupPidsCurrent() int {
+	if ev.GetEventType().String() != "exit" {
+		return 0
+	}
+	if ev.Exit.Process == nil {
+		return 0
+	}
+	return ev.FieldHandlers.ResolveCGroupPidsCurrent(ev, &ev.Exit.Process.CGroup)
+}
+
+// GetExitCloudCredentialsProviders returns the value of the field, resolving if necessary
+func (ev *Event) GetExitCloudCredentialsProviders() []string {
+	if ev.GetEventType().String() != "exit" {
+		return []string{}
+	}
+	if ev.Exit.Process == nil {
+		return []string{}
+	}
+	return ev.FieldHandlers.ResolveProcessCloudCredentialsProviders(ev, ev.Exit.Process)
+}
+
+// GetExitCloudCredentialsResources returns the value of the field, resolving if necessary
+func (ev *Event) GetExitCloudCredentialsResources() []string {
+	if ev.GetEventType().String() != "exit" {
+		return []string{}
+	}
+	if ev.Exit.Process == nil {
+		return []string{}
+	}
+	return ev.FieldHandlers.ResolveProcessCloudCredentialsResources(ev, ev.Exit.Process)
+}
+
+// GetExitCloudCredentialsTypes returns the value of the field, resolving if necessary
+func (ev *Event) GetExitCloudCredentialsTypes() []string {
+	if ev.GetEventType().String() != "exit" {
+		return []string{}
+	}
+	if ev.Exit.Process == nil {
+		return []string{}
+	}
+	return ev.FieldHandlers.ResolveProcessCloudCredentialsTypes(ev, ev.Exit.Process)
+}
+
 // GetExitCmdargv returns the value of the field, resolving if necessary
 func (ev *Event) GetExitCmdargv() []string {
 	if ev.GetEventType().String() != "exit" {
@@ -2401,6 +2937,17 @@ func (ev *Event) GetExitCmdargv() []string {
 	return ev.FieldHandlers.ResolveProcessCmdArgv(ev, ev.Exit.Process)
 }
 
+// GetExitCmdlineObfuscationScore returns the value of the field, resolving if necessary
+func (ev *Event) GetExitCmdlineObfuscationScore() int {
+	if ev.GetEventType().String() != "exit" {
+		return 0
+	}
+	if ev.Exit.Process == nil {
+		return 0
+	}
+	return ev.FieldHandlers.ResolveProcessCmdLineObfuscationScore(ev, ev.Exit.Process)
+}
+
 // GetExitCode returns the value of the field, resolving if necessary
 func (ev *Event) GetExitCode() uint32 {
 	if ev.GetEventType().String() != "exit" {
@@ -2464,6 +3011,50 @@ func (ev *Event) GetExitEgroup() string {
 	return ev.Exit.Process.Credentials.EGroup
 }
 
+// GetExitElfBuildId returns the value of the field, resolving if necessary
+func (ev *Event) GetExitElfBuildId() string {
+	if ev.GetEventType().String() != "exit" {
+		return ""
+	}
+	if ev.Exit.Process == nil {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveProcessELFBuildID(ev, ev.Exit.Process)
+}
+
+// GetExitElfInterpreter returns the value of the field, resolving if necessary
+func (ev *Event) GetExitElfInterpreter() string {
+	if ev.GetEventType().String() != "exit" {
+		return ""
+	}
+	if ev.Exit.Process == nil {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveProcessELFInterpreter(ev, ev.Exit.Process)
+}
+
+// GetExitElfIsStatic returns the value of the field, resolving if necessary
+func (ev *Event) GetExitElfIsStatic() bool {
+	if ev.GetEventType().String() != "exit" {
+		return false
+	}
+	if ev.Exit.Process == nil {
+		return false
+	}
+	return ev.FieldHandlers.ResolveProcessELFIsStatic(ev, ev.Exit.Process)
+}
+
+// GetExitElfIsStripped returns the value of the field, resolving if necessary
+func (ev *Event) GetExitElfIsStripped() bool {
+	if ev.GetEventType().String() != "exit" {
+		return false
+	}
+	if ev.Exit.Process == nil {
+		return false
+	}
+	return ev.FieldHandlers.ResolveProcessELFIsStripped(ev, ev.Exit.Process)
+}
+
 // GetExitEnvp returns the value of the field, resolving if necessary
 func (ev *Event) GetExitEnvp() []string {
 	if ev.GetEventType().String() != "exit" {
@@ -2639,6 +3230,34 @@ func (ev *Event) GetExitFileInode() uint64 {
 	return ev.Exit.Process.FileEvent.FileFields.PathKey.Inode
 }
 
+// GetExitFileLayerDigest returns the value of the field, resolving if necessary
+func (ev *Event) GetExitFileLayerDigest() string {
+	if ev.GetEventType().String() != "exit" {
+		return ""
+	}
+	if ev.Exit.Process == nil {
+		return ""
+	}
+	if !ev.Exit.Process.IsNotKworker() {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Exit.Process.FileEvent)
+}
+
+// GetExitFileLayerPath returns the value of the field, resolving if necessary
+func (ev *Event) GetExitFileLayerPath() string {
+	if ev.GetEventType().String() != "exit" {
+		return ""
+	}
+	if ev.Exit.Process == nil {
+		return ""
+	}
+	if !ev.Exit.Process.IsNotKworker() {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Exit.Process.FileEvent)
+}
+
 // GetExitFileMode returns the value of the field, resolving if necessary
 func (ev *Event) GetExitFileMode() uint16 {
 	if ev.GetEventType().String() != "exit" {
@@ -2892,6 +3511,17 @@ func (ev *Event) GetExitGroup() string {
 	return ev.Exit.Process.Credentials.Group
 }
 
+// GetExitIdentity returns the value of the field, resolving if necessary
+func (ev *Event) GetExitIdentity() string {
+	if ev.GetEventType().String() != "exit" {
+		return ""
+	}
+	if ev.Exit.Process == nil {
+		return ""
+	}
+	return ev.Exit.Process.Identity
+}
+
 // GetExitInterpreterFileChangeTime returns the value of the field, resolving if necessary
 func (ev *Event) GetExitInterpreterFileChangeTime() uint64 {
 	if ev.GetEventType().String() != "exit" {
@@ -2990,6 +3620,34 @@ func (ev *Event) GetExitInterpreterFileInode() uint64 {
 	return ev.Exit.Process.LinuxBinprm.FileEvent.FileFields.PathKey.Inode
 }
 
+// GetExitInterpreterFileLayerDigest returns the value of the field, resolving if necessary
+func (ev *Event) GetExitInterpreterFileLayerDigest() string {
+	if ev.GetEventType().String() != "exit" {
+		return ""
+	}
+	if ev.Exit.Process == nil {
+		return ""
+	}
+	if !ev.Exit.Process.HasInterpreter() {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Exit.Process.LinuxBinprm.FileEvent)
+}
+
+// GetExitInterpreterFileLayerPath returns the value of the field, resolving if necessary
+func (ev *Event) GetExitInterpreterFileLayerPath() string {
+	if ev.GetEventType().String() != "exit" {
+		return ""
+	}
+	if ev.Exit.Process == nil {
+		return ""
+	}
+	if !ev.Exit.Process.HasInterpreter() {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Exit.Process.LinuxBinprm.FileEvent)
+}
+
 // GetExitInterpreterFileMode returns the value of the field, resolving if necessary
 func (ev *Event) GetExitInterpreterFileMode() uint16 {
 	if ev.GetEventType().String() != "exit" {
@@ -3199,68 +3857,134 @@ func (ev *Event) GetExitIsThread() bool {
 	return ev.FieldHandlers.ResolveProcessIsThread(ev, ev.Exit.Process)
 }
 
-// GetExitPid returns the value of the field, resolving if necessary
-func (ev *Event) GetExitPid() uint32 {
+// GetExitNsMnt returns the value of the field, resolving if necessary
+func (ev *Event) GetExitNsMnt() int {
 	if ev.GetEventType().String() != "exit" {
-		return uint32(0)
+		return 0
 	}
 	if ev.Exit.Process == nil {
-		return uint32(0)
+		return 0
 	}
-	return ev.Exit.Process.PIDContext.Pid
+	return ev.FieldHandlers.ResolveProcessMountNamespace(ev, ev.Exit.Process)
 }
 
-// GetExitPpid returns the value of the field, resolving if necessary
-func (ev *Event) GetExitPpid() uint32 {
+// GetExitNsPid returns the value of the field, resolving if necessary
+func (ev *Event) GetExitNsPid() int {
 	if ev.GetEventType().String() != "exit" {
-		return uint32(0)
+		return 0
 	}
 	if ev.Exit.Process == nil {
-		return uint32(0)
+		return 0
 	}
-	return ev.Exit.Process.PPid
+	return ev.FieldHandlers.ResolveProcessPIDNamespace(ev, ev.Exit.Process)
 }
 
-// GetExitTid returns the value of the field, resolving if necessary
-func (ev *Event) GetExitTid() uint32 {
+// GetExitNsUser returns the value of the field, resolving if necessary
+func (ev *Event) GetExitNsUser() int {
 	if ev.GetEventType().String() != "exit" {
-		return uint32(0)
+		return 0
 	}
 	if ev.Exit.Process == nil {
-		return uint32(0)
+		return 0
 	}
-	return ev.Exit.Process.PIDContext.Tid
+	return ev.FieldHandlers.ResolveProcessUserNamespace(ev, ev.Exit.Process)
 }
 
-// GetExitTtyName returns the value of the field, resolving if necessary
-func (ev *Event) GetExitTtyName() string {
+// GetExitNsUts returns the value of the field, resolving if necessary
+func (ev *Event) GetExitNsUts() int {
 	if ev.GetEventType().String() != "exit" {
-		return ""
+		return 0
 	}
 	if ev.Exit.Process == nil {
-		return ""
+		return 0
 	}
-	return ev.Exit.Process.TTYName
+	return ev.FieldHandlers.ResolveProcessUTSNamespace(ev, ev.Exit.Process)
 }
 
-// GetExitUid returns the value of the field, resolving if necessary
-func (ev *Event) GetExitUid() uint32 {
+// GetExitPid returns the value of the field, resolving if necessary
+func (ev *Event) GetExitPid() uint32 {
 	if ev.GetEventType().String() != "exit" {
 		return uint32(0)
 	}
 	if ev.Exit.Process == nil {
 		return uint32(0)
 	}
-	return ev.Exit.Process.Credentials.UID
+	return ev.Exit.Process.PIDContext.Pid
 }
 
-// GetExitUser returns the value of the field, resolving if necessary
-func (ev *Event) GetExitUser() string {
+// GetExitPpid returns the value of the field, resolving if necessary
+func (ev *Event) GetExitPpid() uint32 {
 	if ev.GetEventType().String() != "exit" {
-		return ""
+		return uint32(0)
 	}
 	if ev.Exit.Process == nil {
-		return ""
+		return uint32(0)
+	}
+	return ev.Exit.Process.PPid
+}
+
+// GetExitSystemdSlice returns the value of the field, resolving if necessary
+func (ev *Event) GetExitSystemdSlice() string {
+	if ev.GetEventType().String() != "exit" {
+		return ""
+	}
+	if ev.Exit.Process == nil {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveProcessSystemdSlice(ev, ev.Exit.Process)
+}
+
+// GetExitSystemdUnit returns the value of the field, resolving if necessary
+func (ev *Event) GetExitSystemdUnit() string {
+	if ev.GetEventType().String() != "exit" {
+		return ""
+	}
+	if ev.Exit.Process == nil {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveProcessSystemdUnit(ev, ev.Exit.Process)
+}
+
+// GetExitTid returns the value of the field, resolving if necessary
+func (ev *Event) GetExitTid() uint32 {
+	if ev.GetEventType().String() != "exit" {
+		return uint32(0)
+	}
+	if ev.Exit.Process == nil {
+		return uint32(0)
+	}
+	return ev.Exit.Process.PIDContext.Tid
+}
+
+// GetExitTtyName returns the value of the field, resolving if necessary
+func (ev *Event) GetExitTtyName() string {
+	if ev.GetEventType().String() != "exit" {
+		return ""
+	}
+	if ev.Exit.Process == nil {
+		return ""
+	}
+	return ev.Exit.Process.TTYName
+}
+
+// GetExitUid returns the value of the field, resolving if necessary
+func (ev *Event) GetExitUid() uint32 {
+	if ev.GetEventType().String() != "exit" {
+		return uint32(0)
+	}
+	if ev.Exit.Process == nil {
+		return uint32(0)
+	}
+	return ev.Exit.Process.Credentials.UID
+}
+
+// GetExitUser returns the value of the field, resolving if necessary
+func (ev *Event) GetExitUser() string {
+	if ev.GetEventType().String() != "exit" {
+		return ""
+	}
+	if ev.Exit.Process == nil {
+		return ""
 	}
 	return ev.Exit.Process.Credentials.User
 }
@@ -3298,6 +4022,30 @@ func (ev *Event) GetExitUserSessionK8sUsername() string {
 	return ev.FieldHandlers.ResolveK8SUsername(ev, &ev.Exit.Process.UserSession)
 }
 
+// GetImdsAccessTokenExpiresIn returns the value of the field, resolving if necessary
+func (ev *Event) GetImdsAccessTokenExpiresIn() int {
+	if ev.GetEventType().String() != "imds" {
+		return 0
+	}
+	return ev.IMDS.AccessToken.ExpiresIn
+}
+
+// GetImdsAccessTokenResource returns the value of the field, resolving if necessary
+func (ev *Event) GetImdsAccessTokenResource() string {
+	if ev.GetEventType().String() != "imds" {
+		return ""
+	}
+	return ev.IMDS.AccessToken.Resource
+}
+
+// GetImdsAccessTokenType returns the value of the field, resolving if necessary
+func (ev *Event) GetImdsAccessTokenType() string {
+	if ev.GetEventType().String() != "imds" {
+		return ""
+	}
+	return ev.IMDS.AccessToken.Type
+}
+
 // GetImdsAwsIsImdsV2 returns the value of the field, resolving if necessary
 func (ev *Event) GetImdsAwsIsImdsV2() bool {
 	if ev.GetEventType().String() != "imds" {
@@ -3426,6 +4174,22 @@ func (ev *Event) GetLinkFileDestinationInode() uint64 {
 	return ev.Link.Target.FileFields.PathKey.Inode
 }
 
+// GetLinkFileDestinationLayerDigest returns the value of the field, resolving if necessary
+func (ev *Event) GetLinkFileDestinationLayerDigest() string {
+	if ev.GetEventType().String() != "link" {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Link.Target)
+}
+
+// GetLinkFileDestinationLayerPath returns the value of the field, resolving if necessary
+func (ev *Event) GetLinkFileDestinationLayerPath() string {
+	if ev.GetEventType().String() != "link" {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Link.Target)
+}
+
 // GetLinkFileDestinationMode returns the value of the field, resolving if necessary
 func (ev *Event) GetLinkFileDestinationMode() uint16 {
 	if ev.GetEventType().String() != "link" {
@@ -3578,6 +4342,22 @@ func (ev *Event) GetLinkFileInode() uint64 {
 	return ev.Link.Source.FileFields.PathKey.Inode
 }
 
+// GetLinkFileLayerDigest returns the value of the field, resolving if necessary
+func (ev *Event) GetLinkFileLayerDigest() string {
+	if ev.GetEventType().String() != "link" {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Link.Source)
+}
+
+// GetLinkFileLayerPath returns the value of the field, resolving if necessary
+func (ev *Event) GetLinkFileLayerPath() string {
+	if ev.GetEventType().String() != "link" {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Link.Source)
+}
+
 // GetLinkFileMode returns the value of the field, resolving if necessary
 func (ev *Event) GetLinkFileMode() uint16 {
 	if ev.GetEventType().String() != "link" {
@@ -3834,6 +4614,22 @@ func (ev *Event) GetLoadModuleFileInode() uint64 {
 	return ev.LoadModule.File.FileFields.PathKey.Inode
 }
 
+// GetLoadModuleFileLayerDigest returns the value of the field, resolving if necessary
+func (ev *Event) GetLoadModuleFileLayerDigest() string {
+	if ev.GetEventType().String() != "load_module" {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.LoadModule.File)
+}
+
+// GetLoadModuleFileLayerPath returns the value of the field, resolving if necessary
+func (ev *Event) GetLoadModuleFileLayerPath() string {
+	if ev.GetEventType().String() != "load_module" {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.LoadModule.File)
+}
+
 // GetLoadModuleFileMode returns the value of the field, resolving if necessary
 func (ev *Event) GetLoadModuleFileMode() uint16 {
 	if ev.GetEventType().String() != "load_module" {
@@ -3938,6 +4734,22 @@ func (ev *Event) GetLoadModuleFileUser() string {
 	return ev.FieldHandlers.ResolveFileFieldsUser(ev, &ev.LoadModule.File.FileFields)
 }
 
+// GetLoadModuleKernelSecurityLockdown returns the value of the field, resolving if necessary
+func (ev *Event) GetLoadModuleKernelSecurityLockdown() string {
+	if ev.GetEventType().String() != "load_module" {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveKernelLockdown(ev, &ev.LoadModule.KernelSecurity)
+}
+
+// GetLoadModuleKernelSecuritySecureBoot returns the value of the field, resolving if necessary
+func (ev *Event) GetLoadModuleKernelSecuritySecureBoot() string {
+	if ev.GetEventType().String() != "load_module" {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveKernelSecureBoot(ev, &ev.LoadModule.KernelSecurity)
+}
+
 // GetLoadModuleLoadedFromMemory returns the value of the field, resolving if necessary
 func (ev *Event) GetLoadModuleLoadedFromMemory() bool {
 	if ev.GetEventType().String() != "load_module" {
@@ -4034,6 +4846,22 @@ func (ev *Event) GetMkdirFileInode() uint64 {
 	return ev.Mkdir.File.FileFields.PathKey.Inode
 }
 
+// GetMkdirFileLayerDigest returns the value of the field, resolving if necessary
+func (ev *Event) GetMkdirFileLayerDigest() string {
+	if ev.GetEventType().String() != "mkdir" {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Mkdir.File)
+}
+
+// GetMkdirFileLayerPath returns the value of the field, resolving if necessary
+func (ev *Event) GetMkdirFileLayerPath() string {
+	if ev.GetEventType().String() != "mkdir" {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Mkdir.File)
+}
+
 // GetMkdirFileMode returns the value of the field, resolving if necessary
 func (ev *Event) GetMkdirFileMode() uint16 {
 	if ev.GetEventType().String() != "mkdir" {
@@ -4202,6 +5030,22 @@ func (ev *Event) GetMmapFileInode() uint64 {
 	return ev.MMap.File.FileFields.PathKey.Inode
 }
 
+// GetMmapFileLayerDigest returns the value of the field, resolving if necessary
+func (ev *Event) GetMmapFileLayerDigest() string {
+	if ev.GetEventType().String() != "mmap" {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.MMap.File)
+}
+
+// GetMmapFileLayerPath returns the value of the field, resolving if necessary
+func (ev *Event) GetMmapFileLayerPath() string {
+	if ev.GetEventType().String() != "mmap" {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.MMap.File)
+}
+
 // GetMmapFileMode returns the value of the field, resolving if necessary
 func (ev *Event) GetMmapFileMode() uint16 {
 	if ev.GetEventType().String() != "mmap" {
@@ -4314,6 +5158,14 @@ func (ev *Event) GetMmapFlags() uint64 {
 	return ev.MMap.Flags
 }
 
+// GetMmapLength returns the value of the field, resolving if necessary
+func (ev *Event) GetMmapLength() uint64 {
+	if ev.GetEventType().String() != "mmap" {
+		return uint64(0)
+	}
+	return ev.MMap.Len
+}
+
 // GetMmapProtection returns the value of the field, resolving if necessary
 func (ev *Event) GetMmapProtection() uint64 {
 	if ev.GetEventType().String() != "mmap" {
@@ -4442,6 +5294,14 @@ func (ev *Event) GetMountSyscallStr3() string {
 	return ev.FieldHandlers.ResolveSyscallCtxArgsStr3(ev, &ev.Mount.SyscallContext)
 }
 
+// GetMprotectIsAnonymous returns the value of the field, resolving if necessary
+func (ev *Event) GetMprotectIsAnonymous() bool {
+	if ev.GetEventType().String() != "mprotect" {
+		return false
+	}
+	return ev.MProtect.IsAnonymous
+}
+
 // GetMprotectReqProtection returns the value of the field, resolving if necessary
 func (ev *Event) GetMprotectReqProtection() int {
 	if ev.GetEventType().String() != "mprotect" {
@@ -4458,6 +5318,14 @@ func (ev *Event) GetMprotectRetval() int64 {
 	return ev.MProtect.SyscallEvent.Retval
 }
 
+// GetMprotectSize returns the value of the field, resolving if necessary
+func (ev *Event) GetMprotectSize() uint64 {
+	if ev.GetEventType().String() != "mprotect" {
+		return uint64(0)
+	}
+	return ev.MProtect.Size
+}
+
 // GetMprotectVmProtection returns the value of the field, resolving if necessary
 func (ev *Event) GetMprotectVmProtection() int {
 	if ev.GetEventType().String() != "mprotect" {
@@ -4652,6 +5520,22 @@ func (ev *Event) GetOpenFileInode() uint64 {
 	return ev.Open.File.FileFields.PathKey.Inode
 }
 
+// GetOpenFileLayerDigest returns the value of the field, resolving if necessary
+func (ev *Event) GetOpenFileLayerDigest() string {
+	if ev.GetEventType().String() != "open" {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Open.File)
+}
+
+// GetOpenFileLayerPath returns the value of the field, resolving if necessary
+func (ev *Event) GetOpenFileLayerPath() string {
+	if ev.GetEventType().String() != "open" {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Open.File)
+}
+
 // GetOpenFileMode returns the value of the field, resolving if necessary
 func (ev *Event) GetOpenFileMode() uint16 {
 	if ev.GetEventType().String() != "open" {
@@ -5171,113 +6055,113 @@ func (ev *Event) GetProcessAncestorsCapPermitted() []uint64 {
 	return values
 }
 
-// GetProcessAncestorsCgroupFileInode returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsCgroupFileInode() []uint64 {
+// GetProcessAncestorsCgroupAncestors returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsCgroupAncestors() []string {
 	if ev.BaseEvent.ProcessContext == nil {
-		return []uint64{}
+		return []string{}
 	}
 	if ev.BaseEvent.ProcessContext.Ancestor == nil {
-		return []uint64{}
+		return []string{}
 	}
-	var values []uint64
+	var values []string
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := element.ProcessContext.Process.CGroup.CGroupFile.Inode
-		values = append(values, result)
+		result := ev.FieldHandlers.ResolveCGroupAncestors(ev, &element.ProcessContext.Process.CGroup)
+		values = append(values, result...)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsCgroupFileMountId returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsCgroupFileMountId() []uint32 {
+// GetProcessAncestorsCgroupControllers returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsCgroupControllers() []string {
 	if ev.BaseEvent.ProcessContext == nil {
-		return []uint32{}
+		return []string{}
 	}
 	if ev.BaseEvent.ProcessContext.Ancestor == nil {
-		return []uint32{}
+		return []string{}
 	}
-	var values []uint32
+	var values []string
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := element.ProcessContext.Process.CGroup.CGroupFile.MountID
-		values = append(values, result)
+		result := ev.FieldHandlers.ResolveCGroupControllers(ev, &element.ProcessContext.Process.CGroup)
+		values = append(values, result...)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsCgroupId returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsCgroupId() []string {
+// GetProcessAncestorsCgroupCpuUsage returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsCgroupCpuUsage() []int {
 	if ev.BaseEvent.ProcessContext == nil {
-		return []string{}
+		return []int{}
 	}
 	if ev.BaseEvent.ProcessContext.Ancestor == nil {
-		return []string{}
+		return []int{}
 	}
-	var values []string
+	var values []int
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := ev.FieldHandlers.ResolveCGroupID(ev, &element.ProcessContext.Process.CGroup)
+		result := int(ev.FieldHandlers.ResolveCGroupCPUUsage(ev, &element.ProcessContext.Process.CGroup))
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsCgroupManager returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsCgroupManager() []string {
+// GetProcessAncestorsCgroupFileInode returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsCgroupFileInode() []uint64 {
 	if ev.BaseEvent.ProcessContext == nil {
-		return []string{}
+		return []uint64{}
 	}
 	if ev.BaseEvent.ProcessContext.Ancestor == nil {
-		return []string{}
+		return []uint64{}
 	}
-	var values []string
+	var values []uint64
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := ev.FieldHandlers.ResolveCGroupManager(ev, &element.ProcessContext.Process.CGroup)
+		result := element.ProcessContext.Process.CGroup.CGroupFile.Inode
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsCmdargv returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsCmdargv() []string {
+// GetProcessAncestorsCgroupFileMountId returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsCgroupFileMountId() []uint32 {
 	if ev.BaseEvent.ProcessContext == nil {
-		return []string{}
+		return []uint32{}
 	}
 	if ev.BaseEvent.ProcessContext.Ancestor == nil {
-		return []string{}
+		return []uint32{}
 	}
-	var values []string
+	var values []uint32
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := ev.FieldHandlers.ResolveProcessCmdArgv(ev, &element.ProcessContext.Process)
-		values = append(values, result...)
+		result := element.ProcessContext.Process.CGroup.CGroupFile.MountID
+		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsComm returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsComm() []string {
+// GetProcessAncestorsCgroupId returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsCgroupId() []string {
 	if ev.BaseEvent.ProcessContext == nil {
 		return []string{}
 	}
@@ -5290,15 +6174,15 @@ func (ev *Event) GetProcessAncestorsComm() []string {
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := element.ProcessContext.Process.Comm
+		result := ev.FieldHandlers.ResolveCGroupID(ev, &element.ProcessContext.Process.CGroup)
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsContainerId returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsContainerId() []string {
+// GetProcessAncestorsCgroupManager returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsCgroupManager() []string {
 	if ev.BaseEvent.ProcessContext == nil {
 		return []string{}
 	}
@@ -5311,15 +6195,15 @@ func (ev *Event) GetProcessAncestorsContainerId() []string {
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := ev.FieldHandlers.ResolveProcessContainerID(ev, &element.ProcessContext.Process)
+		result := ev.FieldHandlers.ResolveCGroupManager(ev, &element.ProcessContext.Process.CGroup)
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsCreatedAt returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsCreatedAt() []int {
+// GetProcessAncestorsCgroupMemoryCurrent returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsCgroupMemoryCurrent() []int {
 	if ev.BaseEvent.ProcessContext == nil {
 		return []int{}
 	}
@@ -5332,57 +6216,57 @@ func (ev *Event) GetProcessAncestorsCreatedAt() []int {
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := int(ev.FieldHandlers.ResolveProcessCreatedAt(ev, &element.ProcessContext.Process))
+		result := int(ev.FieldHandlers.ResolveCGroupMemoryCurrent(ev, &element.ProcessContext.Process.CGroup))
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsEgid returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsEgid() []uint32 {
+// GetProcessAncestorsCgroupMemoryHighExceeded returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsCgroupMemoryHighExceeded() []bool {
 	if ev.BaseEvent.ProcessContext == nil {
-		return []uint32{}
+		return []bool{}
 	}
 	if ev.BaseEvent.ProcessContext.Ancestor == nil {
-		return []uint32{}
+		return []bool{}
 	}
-	var values []uint32
+	var values []bool
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := element.ProcessContext.Process.Credentials.EGID
+		result := ev.FieldHandlers.ResolveCGroupMemoryHighExceeded(ev, &element.ProcessContext.Process.CGroup)
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsEgroup returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsEgroup() []string {
+// GetProcessAncestorsCgroupPidsCurrent returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsCgroupPidsCurrent() []int {
 	if ev.BaseEvent.ProcessContext == nil {
-		return []string{}
+		return []int{}
 	}
 	if ev.BaseEvent.ProcessContext.Ancestor == nil {
-		return []string{}
+		return []int{}
 	}
-	var values []string
+	var values []int
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := element.ProcessContext.Process.Credentials.EGroup
+		result := int(ev.FieldHandlers.ResolveCGroupPidsCurrent(ev, &element.ProcessContext.Process.CGroup))
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsEnvp returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsEnvp() []string {
+// GetProcessAncestorsCloudCredentialsProviders returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsCloudCredentialsProviders() []string {
 	if ev.BaseEvent.ProcessContext == nil {
 		return []string{}
 	}
@@ -5395,15 +6279,15 @@ func (ev *Event) GetProcessAncestorsEnvp() []string {
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := ev.FieldHandlers.ResolveProcessEnvp(ev, &element.ProcessContext.Process)
+		result := ev.FieldHandlers.ResolveProcessCloudCredentialsProviders(ev, &element.ProcessContext.Process)
 		values = append(values, result...)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsEnvs returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsEnvs() []string {
+// GetProcessAncestorsCloudCredentialsResources returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsCloudCredentialsResources() []string {
 	if ev.BaseEvent.ProcessContext == nil {
 		return []string{}
 	}
@@ -5416,99 +6300,99 @@ func (ev *Event) GetProcessAncestorsEnvs() []string {
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := ev.FieldHandlers.ResolveProcessEnvs(ev, &element.ProcessContext.Process)
+		result := ev.FieldHandlers.ResolveProcessCloudCredentialsResources(ev, &element.ProcessContext.Process)
 		values = append(values, result...)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsEnvsTruncated returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsEnvsTruncated() []bool {
+// GetProcessAncestorsCloudCredentialsTypes returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsCloudCredentialsTypes() []string {
 	if ev.BaseEvent.ProcessContext == nil {
-		return []bool{}
+		return []string{}
 	}
 	if ev.BaseEvent.ProcessContext.Ancestor == nil {
-		return []bool{}
+		return []string{}
 	}
-	var values []bool
+	var values []string
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := ev.FieldHandlers.ResolveProcessEnvsTruncated(ev, &element.ProcessContext.Process)
-		values = append(values, result)
+		result := ev.FieldHandlers.ResolveProcessCloudCredentialsTypes(ev, &element.ProcessContext.Process)
+		values = append(values, result...)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsEuid returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsEuid() []uint32 {
+// GetProcessAncestorsCmdargv returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsCmdargv() []string {
 	if ev.BaseEvent.ProcessContext == nil {
-		return []uint32{}
+		return []string{}
 	}
 	if ev.BaseEvent.ProcessContext.Ancestor == nil {
-		return []uint32{}
+		return []string{}
 	}
-	var values []uint32
+	var values []string
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := element.ProcessContext.Process.Credentials.EUID
-		values = append(values, result)
+		result := ev.FieldHandlers.ResolveProcessCmdArgv(ev, &element.ProcessContext.Process)
+		values = append(values, result...)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsEuser returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsEuser() []string {
+// GetProcessAncestorsCmdlineObfuscationScore returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsCmdlineObfuscationScore() []int {
 	if ev.BaseEvent.ProcessContext == nil {
-		return []string{}
+		return []int{}
 	}
 	if ev.BaseEvent.ProcessContext.Ancestor == nil {
-		return []string{}
+		return []int{}
 	}
-	var values []string
+	var values []int
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := element.ProcessContext.Process.Credentials.EUser
+		result := ev.FieldHandlers.ResolveProcessCmdLineObfuscationScore(ev, &element.ProcessContext.Process)
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsFileChangeTime returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsFileChangeTime() []uint64 {
+// GetProcessAncestorsComm returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsComm() []string {
 	if ev.BaseEvent.ProcessContext == nil {
-		return []uint64{}
+		return []string{}
 	}
 	if ev.BaseEvent.ProcessContext.Ancestor == nil {
-		return []uint64{}
+		return []string{}
 	}
-	var values []uint64
+	var values []string
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := element.ProcessContext.Process.FileEvent.FileFields.CTime
+		result := element.ProcessContext.Process.Comm
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsFileFilesystem returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsFileFilesystem() []string {
+// GetProcessAncestorsContainerId returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsContainerId() []string {
 	if ev.BaseEvent.ProcessContext == nil {
 		return []string{}
 	}
@@ -5521,57 +6405,57 @@ func (ev *Event) GetProcessAncestorsFileFilesystem() []string {
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := ev.FieldHandlers.ResolveFileFilesystem(ev, &element.ProcessContext.Process.FileEvent)
+		result := ev.FieldHandlers.ResolveProcessContainerID(ev, &element.ProcessContext.Process)
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsFileGid returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsFileGid() []uint32 {
+// GetProcessAncestorsCreatedAt returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsCreatedAt() []int {
 	if ev.BaseEvent.ProcessContext == nil {
-		return []uint32{}
+		return []int{}
 	}
 	if ev.BaseEvent.ProcessContext.Ancestor == nil {
-		return []uint32{}
+		return []int{}
 	}
-	var values []uint32
+	var values []int
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := element.ProcessContext.Process.FileEvent.FileFields.GID
+		result := int(ev.FieldHandlers.ResolveProcessCreatedAt(ev, &element.ProcessContext.Process))
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsFileGroup returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsFileGroup() []string {
+// GetProcessAncestorsEgid returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsEgid() []uint32 {
 	if ev.BaseEvent.ProcessContext == nil {
-		return []string{}
+		return []uint32{}
 	}
 	if ev.BaseEvent.ProcessContext.Ancestor == nil {
-		return []string{}
+		return []uint32{}
 	}
-	var values []string
+	var values []uint32
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := ev.FieldHandlers.ResolveFileFieldsGroup(ev, &element.ProcessContext.Process.FileEvent.FileFields)
+		result := element.ProcessContext.Process.Credentials.EGID
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsFileHashes returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsFileHashes() []string {
+// GetProcessAncestorsEgroup returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsEgroup() []string {
 	if ev.BaseEvent.ProcessContext == nil {
 		return []string{}
 	}
@@ -5584,120 +6468,120 @@ func (ev *Event) GetProcessAncestorsFileHashes() []string {
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := ev.FieldHandlers.ResolveHashesFromEvent(ev, &element.ProcessContext.Process.FileEvent)
-		values = append(values, result...)
+		result := element.ProcessContext.Process.Credentials.EGroup
+		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsFileInUpperLayer returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsFileInUpperLayer() []bool {
+// GetProcessAncestorsElfBuildId returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsElfBuildId() []string {
 	if ev.BaseEvent.ProcessContext == nil {
-		return []bool{}
+		return []string{}
 	}
 	if ev.BaseEvent.ProcessContext.Ancestor == nil {
-		return []bool{}
+		return []string{}
 	}
-	var values []bool
+	var values []string
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &element.ProcessContext.Process.FileEvent.FileFields)
+		result := ev.FieldHandlers.ResolveProcessELFBuildID(ev, &element.ProcessContext.Process)
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsFileInode returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsFileInode() []uint64 {
+// GetProcessAncestorsElfInterpreter returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsElfInterpreter() []string {
 	if ev.BaseEvent.ProcessContext == nil {
-		return []uint64{}
+		return []string{}
 	}
 	if ev.BaseEvent.ProcessContext.Ancestor == nil {
-		return []uint64{}
+		return []string{}
 	}
-	var values []uint64
+	var values []string
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := element.ProcessContext.Process.FileEvent.FileFields.PathKey.Inode
+		result := ev.FieldHandlers.ResolveProcessELFInterpreter(ev, &element.ProcessContext.Process)
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsFileMode returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsFileMode() []uint16 {
+// GetProcessAncestorsElfIsStatic returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsElfIsStatic() []bool {
 	if ev.BaseEvent.ProcessContext == nil {
-		return []uint16{}
+		return []bool{}
 	}
 	if ev.BaseEvent.ProcessContext.Ancestor == nil {
-		return []uint16{}
+		return []bool{}
 	}
-	var values []uint16
+	var values []bool
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := element.ProcessContext.Process.FileEvent.FileFields.Mode
+		result := ev.FieldHandlers.ResolveProcessELFIsStatic(ev, &element.ProcessContext.Process)
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsFileModificationTime returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsFileModificationTime() []uint64 {
+// GetProcessAncestorsElfIsStripped returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsElfIsStripped() []bool {
 	if ev.BaseEvent.ProcessContext == nil {
-		return []uint64{}
+		return []bool{}
 	}
 	if ev.BaseEvent.ProcessContext.Ancestor == nil {
-		return []uint64{}
+		return []bool{}
 	}
-	var values []uint64
+	var values []bool
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := element.ProcessContext.Process.FileEvent.FileFields.MTime
+		result := ev.FieldHandlers.ResolveProcessELFIsStripped(ev, &element.ProcessContext.Process)
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsFileMountId returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsFileMountId() []uint32 {
+// GetProcessAncestorsEnvp returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsEnvp() []string {
 	if ev.BaseEvent.ProcessContext == nil {
-		return []uint32{}
+		return []string{}
 	}
 	if ev.BaseEvent.ProcessContext.Ancestor == nil {
-		return []uint32{}
+		return []string{}
 	}
-	var values []uint32
+	var values []string
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := element.ProcessContext.Process.FileEvent.FileFields.PathKey.MountID
-		values = append(values, result)
+		result := ev.FieldHandlers.ResolveProcessEnvp(ev, &element.ProcessContext.Process)
+		values = append(values, result...)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsFileName returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsFileName() []string {
+// GetProcessAncestorsEnvs returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsEnvs() []string {
 	if ev.BaseEvent.ProcessContext == nil {
 		return []string{}
 	}
@@ -5710,57 +6594,57 @@ func (ev *Event) GetProcessAncestorsFileName() []string {
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := ev.FieldHandlers.ResolveFileBasename(ev, &element.ProcessContext.Process.FileEvent)
-		values = append(values, result)
+		result := ev.FieldHandlers.ResolveProcessEnvs(ev, &element.ProcessContext.Process)
+		values = append(values, result...)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsFileNameLength returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsFileNameLength() []int {
+// GetProcessAncestorsEnvsTruncated returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsEnvsTruncated() []bool {
 	if ev.BaseEvent.ProcessContext == nil {
-		return []int{}
+		return []bool{}
 	}
 	if ev.BaseEvent.ProcessContext.Ancestor == nil {
-		return []int{}
+		return []bool{}
 	}
-	var values []int
+	var values []bool
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := len(ev.FieldHandlers.ResolveFileBasename(ev, &element.ProcessContext.Process.FileEvent))
+		result := ev.FieldHandlers.ResolveProcessEnvsTruncated(ev, &element.ProcessContext.Process)
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsFilePackageName returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsFilePackageName() []string {
+// GetProcessAncestorsEuid returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsEuid() []uint32 {
 	if ev.BaseEvent.ProcessContext == nil {
-		return []string{}
+		return []uint32{}
 	}
 	if ev.BaseEvent.ProcessContext.Ancestor == nil {
-		return []string{}
+		return []uint32{}
 	}
-	var values []string
+	var values []uint32
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := ev.FieldHandlers.ResolvePackageName(ev, &element.ProcessContext.Process.FileEvent)
+		result := element.ProcessContext.Process.Credentials.EUID
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsFilePackageSourceVersion returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsFilePackageSourceVersion() []string {
+// GetProcessAncestorsEuser returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsEuser() []string {
 	if ev.BaseEvent.ProcessContext == nil {
 		return []string{}
 	}
@@ -5773,36 +6657,36 @@ func (ev *Event) GetProcessAncestorsFilePackageSourceVersion() []string {
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := ev.FieldHandlers.ResolvePackageSourceVersion(ev, &element.ProcessContext.Process.FileEvent)
+		result := element.ProcessContext.Process.Credentials.EUser
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsFilePackageVersion returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsFilePackageVersion() []string {
+// GetProcessAncestorsFileChangeTime returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsFileChangeTime() []uint64 {
 	if ev.BaseEvent.ProcessContext == nil {
-		return []string{}
+		return []uint64{}
 	}
 	if ev.BaseEvent.ProcessContext.Ancestor == nil {
-		return []string{}
+		return []uint64{}
 	}
-	var values []string
+	var values []uint64
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := ev.FieldHandlers.ResolvePackageVersion(ev, &element.ProcessContext.Process.FileEvent)
+		result := element.ProcessContext.Process.FileEvent.FileFields.CTime
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsFilePath returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsFilePath() []string {
+// GetProcessAncestorsFileFilesystem returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsFileFilesystem() []string {
 	if ev.BaseEvent.ProcessContext == nil {
 		return []string{}
 	}
@@ -5815,120 +6699,120 @@ func (ev *Event) GetProcessAncestorsFilePath() []string {
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := ev.FieldHandlers.ResolveFilePath(ev, &element.ProcessContext.Process.FileEvent)
+		result := ev.FieldHandlers.ResolveFileFilesystem(ev, &element.ProcessContext.Process.FileEvent)
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsFilePathLength returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsFilePathLength() []int {
+// GetProcessAncestorsFileGid returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsFileGid() []uint32 {
 	if ev.BaseEvent.ProcessContext == nil {
-		return []int{}
+		return []uint32{}
 	}
 	if ev.BaseEvent.ProcessContext.Ancestor == nil {
-		return []int{}
+		return []uint32{}
 	}
-	var values []int
+	var values []uint32
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := len(ev.FieldHandlers.ResolveFilePath(ev, &element.ProcessContext.Process.FileEvent))
+		result := element.ProcessContext.Process.FileEvent.FileFields.GID
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsFileRights returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsFileRights() []int {
+// GetProcessAncestorsFileGroup returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsFileGroup() []string {
 	if ev.BaseEvent.ProcessContext == nil {
-		return []int{}
+		return []string{}
 	}
 	if ev.BaseEvent.ProcessContext.Ancestor == nil {
-		return []int{}
+		return []string{}
 	}
-	var values []int
+	var values []string
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := int(ev.FieldHandlers.ResolveRights(ev, &element.ProcessContext.Process.FileEvent.FileFields))
+		result := ev.FieldHandlers.ResolveFileFieldsGroup(ev, &element.ProcessContext.Process.FileEvent.FileFields)
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsFileUid returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsFileUid() []uint32 {
+// GetProcessAncestorsFileHashes returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsFileHashes() []string {
 	if ev.BaseEvent.ProcessContext == nil {
-		return []uint32{}
+		return []string{}
 	}
 	if ev.BaseEvent.ProcessContext.Ancestor == nil {
-		return []uint32{}
+		return []string{}
 	}
-	var values []uint32
+	var values []string
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := element.ProcessContext.Process.FileEvent.FileFields.UID
-		values = append(values, result)
+		result := ev.FieldHandlers.ResolveHashesFromEvent(ev, &element.ProcessContext.Process.FileEvent)
+		values = append(values, result...)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsFileUser returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsFileUser() []string {
+// GetProcessAncestorsFileInUpperLayer returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsFileInUpperLayer() []bool {
 	if ev.BaseEvent.ProcessContext == nil {
-		return []string{}
+		return []bool{}
 	}
 	if ev.BaseEvent.ProcessContext.Ancestor == nil {
-		return []string{}
+		return []bool{}
 	}
-	var values []string
+	var values []bool
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := ev.FieldHandlers.ResolveFileFieldsUser(ev, &element.ProcessContext.Process.FileEvent.FileFields)
+		result := ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &element.ProcessContext.Process.FileEvent.FileFields)
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsFsgid returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsFsgid() []uint32 {
+// GetProcessAncestorsFileInode returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsFileInode() []uint64 {
 	if ev.BaseEvent.ProcessContext == nil {
-		return []uint32{}
+		return []uint64{}
 	}
 	if ev.BaseEvent.ProcessContext.Ancestor == nil {
-		return []uint32{}
+		return []uint64{}
 	}
-	var values []uint32
+	var values []uint64
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := element.ProcessContext.Process.Credentials.FSGID
+		result := element.ProcessContext.Process.FileEvent.FileFields.PathKey.Inode
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsFsgroup returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsFsgroup() []string {
+// GetProcessAncestorsFileLayerDigest returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsFileLayerDigest() []string {
 	if ev.BaseEvent.ProcessContext == nil {
 		return []string{}
 	}
@@ -5941,57 +6825,78 @@ func (ev *Event) GetProcessAncestorsFsgroup() []string {
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := element.ProcessContext.Process.Credentials.FSGroup
+		result := ev.FieldHandlers.ResolveFileLayerDigest(ev, &element.ProcessContext.Process.FileEvent)
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsFsuid returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsFsuid() []uint32 {
+// GetProcessAncestorsFileLayerPath returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsFileLayerPath() []string {
 	if ev.BaseEvent.ProcessContext == nil {
-		return []uint32{}
+		return []string{}
 	}
 	if ev.BaseEvent.ProcessContext.Ancestor == nil {
-		return []uint32{}
+		return []string{}
 	}
-	var values []uint32
+	var values []string
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := element.ProcessContext.Process.Credentials.FSUID
+		result := ev.FieldHandlers.ResolveFileLayerPath(ev, &element.ProcessContext.Process.FileEvent)
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsFsuser returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsFsuser() []string {
+// GetProcessAncestorsFileMode returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsFileMode() []uint16 {
 	if ev.BaseEvent.ProcessContext == nil {
-		return []string{}
+		return []uint16{}
 	}
 	if ev.BaseEvent.ProcessContext.Ancestor == nil {
-		return []string{}
+		return []uint16{}
 	}
-	var values []string
+	var values []uint16
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := element.ProcessContext.Process.Credentials.FSUser
+		result := element.ProcessContext.Process.FileEvent.FileFields.Mode
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsGid returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsGid() []uint32 {
+// GetProcessAncestorsFileModificationTime returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsFileModificationTime() []uint64 {
+	if ev.BaseEvent.ProcessContext == nil {
+		return []uint64{}
+	}
+	if ev.BaseEvent.ProcessContext.Ancestor == nil {
+		return []uint64{}
+	}
+	var values []uint64
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := element.ProcessContext.Process.FileEvent.FileFields.MTime
+		values = append(values, result)
+		ptr = iterator.Next()
+	}
+	return values
+}
+
+// GetProcessAncestorsFileMountId returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsFileMountId() []uint32 {
 	if ev.BaseEvent.ProcessContext == nil {
 		return []uint32{}
 	}
@@ -6004,15 +6909,15 @@ func (ev *Event) GetProcessAncestorsGid() []uint32 {
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := element.ProcessContext.Process.Credentials.GID
+		result := element.ProcessContext.Process.FileEvent.FileFields.PathKey.MountID
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsGroup returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsGroup() []string {
+// GetProcessAncestorsFileName returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsFileName() []string {
 	if ev.BaseEvent.ProcessContext == nil {
 		return []string{}
 	}
@@ -6025,36 +6930,36 @@ func (ev *Event) GetProcessAncestorsGroup() []string {
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := element.ProcessContext.Process.Credentials.Group
+		result := ev.FieldHandlers.ResolveFileBasename(ev, &element.ProcessContext.Process.FileEvent)
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsInterpreterFileChangeTime returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsInterpreterFileChangeTime() []uint64 {
+// GetProcessAncestorsFileNameLength returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsFileNameLength() []int {
 	if ev.BaseEvent.ProcessContext == nil {
-		return []uint64{}
+		return []int{}
 	}
 	if ev.BaseEvent.ProcessContext.Ancestor == nil {
-		return []uint64{}
+		return []int{}
 	}
-	var values []uint64
+	var values []int
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := element.ProcessContext.Process.LinuxBinprm.FileEvent.FileFields.CTime
+		result := len(ev.FieldHandlers.ResolveFileBasename(ev, &element.ProcessContext.Process.FileEvent))
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsInterpreterFileFilesystem returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsInterpreterFileFilesystem() []string {
+// GetProcessAncestorsFilePackageName returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsFilePackageName() []string {
 	if ev.BaseEvent.ProcessContext == nil {
 		return []string{}
 	}
@@ -6067,36 +6972,36 @@ func (ev *Event) GetProcessAncestorsInterpreterFileFilesystem() []string {
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := ev.FieldHandlers.ResolveFileFilesystem(ev, &element.ProcessContext.Process.LinuxBinprm.FileEvent)
+		result := ev.FieldHandlers.ResolvePackageName(ev, &element.ProcessContext.Process.FileEvent)
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsInterpreterFileGid returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsInterpreterFileGid() []uint32 {
+// GetProcessAncestorsFilePackageSourceVersion returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsFilePackageSourceVersion() []string {
 	if ev.BaseEvent.ProcessContext == nil {
-		return []uint32{}
+		return []string{}
 	}
 	if ev.BaseEvent.ProcessContext.Ancestor == nil {
-		return []uint32{}
+		return []string{}
 	}
-	var values []uint32
+	var values []string
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := element.ProcessContext.Process.LinuxBinprm.FileEvent.FileFields.GID
+		result := ev.FieldHandlers.ResolvePackageSourceVersion(ev, &element.ProcessContext.Process.FileEvent)
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsInterpreterFileGroup returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsInterpreterFileGroup() []string {
+// GetProcessAncestorsFilePackageVersion returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsFilePackageVersion() []string {
 	if ev.BaseEvent.ProcessContext == nil {
 		return []string{}
 	}
@@ -6109,15 +7014,15 @@ func (ev *Event) GetProcessAncestorsInterpreterFileGroup() []string {
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := ev.FieldHandlers.ResolveFileFieldsGroup(ev, &element.ProcessContext.Process.LinuxBinprm.FileEvent.FileFields)
+		result := ev.FieldHandlers.ResolvePackageVersion(ev, &element.ProcessContext.Process.FileEvent)
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsInterpreterFileHashes returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsInterpreterFileHashes() []string {
+// GetProcessAncestorsFilePath returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsFilePath() []string {
 	if ev.BaseEvent.ProcessContext == nil {
 		return []string{}
 	}
@@ -6130,99 +7035,99 @@ func (ev *Event) GetProcessAncestorsInterpreterFileHashes() []string {
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := ev.FieldHandlers.ResolveHashesFromEvent(ev, &element.ProcessContext.Process.LinuxBinprm.FileEvent)
-		values = append(values, result...)
+		result := ev.FieldHandlers.ResolveFilePath(ev, &element.ProcessContext.Process.FileEvent)
+		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsInterpreterFileInUpperLayer returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsInterpreterFileInUpperLayer() []bool {
+// GetProcessAncestorsFilePathLength returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsFilePathLength() []int {
 	if ev.BaseEvent.ProcessContext == nil {
-		return []bool{}
+		return []int{}
 	}
 	if ev.BaseEvent.ProcessContext.Ancestor == nil {
-		return []bool{}
+		return []int{}
 	}
-	var values []bool
+	var values []int
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &element.ProcessContext.Process.LinuxBinprm.FileEvent.FileFields)
+		result := len(ev.FieldHandlers.ResolveFilePath(ev, &element.ProcessContext.Process.FileEvent))
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsInterpreterFileInode returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsInterpreterFileInode() []uint64 {
+// GetProcessAncestorsFileRights returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsFileRights() []int {
 	if ev.BaseEvent.ProcessContext == nil {
-		return []uint64{}
+		return []int{}
 	}
 	if ev.BaseEvent.ProcessContext.Ancestor == nil {
-		return []uint64{}
+		return []int{}
 	}
-	var values []uint64
+	var values []int
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := element.ProcessContext.Process.LinuxBinprm.FileEvent.FileFields.PathKey.Inode
+		result := int(ev.FieldHandlers.ResolveRights(ev, &element.ProcessContext.Process.FileEvent.FileFields))
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsInterpreterFileMode returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsInterpreterFileMode() []uint16 {
+// GetProcessAncestorsFileUid returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsFileUid() []uint32 {
 	if ev.BaseEvent.ProcessContext == nil {
-		return []uint16{}
+		return []uint32{}
 	}
 	if ev.BaseEvent.ProcessContext.Ancestor == nil {
-		return []uint16{}
+		return []uint32{}
 	}
-	var values []uint16
+	var values []uint32
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := element.ProcessContext.Process.LinuxBinprm.FileEvent.FileFields.Mode
+		result := element.ProcessContext.Process.FileEvent.FileFields.UID
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsInterpreterFileModificationTime returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsInterpreterFileModificationTime() []uint64 {
+// GetProcessAncestorsFileUser returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsFileUser() []string {
 	if ev.BaseEvent.ProcessContext == nil {
-		return []uint64{}
+		return []string{}
 	}
 	if ev.BaseEvent.ProcessContext.Ancestor == nil {
-		return []uint64{}
+		return []string{}
 	}
-	var values []uint64
+	var values []string
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := element.ProcessContext.Process.LinuxBinprm.FileEvent.FileFields.MTime
+		result := ev.FieldHandlers.ResolveFileFieldsUser(ev, &element.ProcessContext.Process.FileEvent.FileFields)
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsInterpreterFileMountId returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsInterpreterFileMountId() []uint32 {
+// GetProcessAncestorsFsgid returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsFsgid() []uint32 {
 	if ev.BaseEvent.ProcessContext == nil {
 		return []uint32{}
 	}
@@ -6235,15 +7140,15 @@ func (ev *Event) GetProcessAncestorsInterpreterFileMountId() []uint32 {
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := element.ProcessContext.Process.LinuxBinprm.FileEvent.FileFields.PathKey.MountID
+		result := element.ProcessContext.Process.Credentials.FSGID
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsInterpreterFileName returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsInterpreterFileName() []string {
+// GetProcessAncestorsFsgroup returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsFsgroup() []string {
 	if ev.BaseEvent.ProcessContext == nil {
 		return []string{}
 	}
@@ -6256,36 +7161,36 @@ func (ev *Event) GetProcessAncestorsInterpreterFileName() []string {
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := ev.FieldHandlers.ResolveFileBasename(ev, &element.ProcessContext.Process.LinuxBinprm.FileEvent)
+		result := element.ProcessContext.Process.Credentials.FSGroup
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsInterpreterFileNameLength returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsInterpreterFileNameLength() []int {
+// GetProcessAncestorsFsuid returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsFsuid() []uint32 {
 	if ev.BaseEvent.ProcessContext == nil {
-		return []int{}
+		return []uint32{}
 	}
 	if ev.BaseEvent.ProcessContext.Ancestor == nil {
-		return []int{}
+		return []uint32{}
 	}
-	var values []int
+	var values []uint32
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := len(ev.FieldHandlers.ResolveFileBasename(ev, &element.ProcessContext.Process.LinuxBinprm.FileEvent))
+		result := element.ProcessContext.Process.Credentials.FSUID
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsInterpreterFilePackageName returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsInterpreterFilePackageName() []string {
+// GetProcessAncestorsFsuser returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsFsuser() []string {
 	if ev.BaseEvent.ProcessContext == nil {
 		return []string{}
 	}
@@ -6298,36 +7203,36 @@ func (ev *Event) GetProcessAncestorsInterpreterFilePackageName() []string {
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := ev.FieldHandlers.ResolvePackageName(ev, &element.ProcessContext.Process.LinuxBinprm.FileEvent)
+		result := element.ProcessContext.Process.Credentials.FSUser
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsInterpreterFilePackageSourceVersion returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsInterpreterFilePackageSourceVersion() []string {
+// GetProcessAncestorsGid returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsGid() []uint32 {
 	if ev.BaseEvent.ProcessContext == nil {
-		return []string{}
+		return []uint32{}
 	}
 	if ev.BaseEvent.ProcessContext.Ancestor == nil {
-		return []string{}
+		return []uint32{}
 	}
-	var values []string
+	var values []uint32
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := ev.FieldHandlers.ResolvePackageSourceVersion(ev, &element.ProcessContext.Process.LinuxBinprm.FileEvent)
+		result := element.ProcessContext.Process.Credentials.GID
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsInterpreterFilePackageVersion returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsInterpreterFilePackageVersion() []string {
+// GetProcessAncestorsGroup returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsGroup() []string {
 	if ev.BaseEvent.ProcessContext == nil {
 		return []string{}
 	}
@@ -6340,99 +7245,99 @@ func (ev *Event) GetProcessAncestorsInterpreterFilePackageVersion() []string {
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := ev.FieldHandlers.ResolvePackageVersion(ev, &element.ProcessContext.Process.LinuxBinprm.FileEvent)
+		result := element.ProcessContext.Process.Credentials.Group
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsInterpreterFilePath returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsInterpreterFilePath() []string {
+// GetProcessAncestorsInterpreterFileChangeTime returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsInterpreterFileChangeTime() []uint64 {
 	if ev.BaseEvent.ProcessContext == nil {
-		return []string{}
+		return []uint64{}
 	}
 	if ev.BaseEvent.ProcessContext.Ancestor == nil {
-		return []string{}
+		return []uint64{}
 	}
-	var values []string
+	var values []uint64
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := ev.FieldHandlers.ResolveFilePath(ev, &element.ProcessContext.Process.LinuxBinprm.FileEvent)
+		result := element.ProcessContext.Process.LinuxBinprm.FileEvent.FileFields.CTime
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsInterpreterFilePathLength returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsInterpreterFilePathLength() []int {
+// GetProcessAncestorsInterpreterFileFilesystem returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsInterpreterFileFilesystem() []string {
 	if ev.BaseEvent.ProcessContext == nil {
-		return []int{}
+		return []string{}
 	}
 	if ev.BaseEvent.ProcessContext.Ancestor == nil {
-		return []int{}
+		return []string{}
 	}
-	var values []int
+	var values []string
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := len(ev.FieldHandlers.ResolveFilePath(ev, &element.ProcessContext.Process.LinuxBinprm.FileEvent))
+		result := ev.FieldHandlers.ResolveFileFilesystem(ev, &element.ProcessContext.Process.LinuxBinprm.FileEvent)
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsInterpreterFileRights returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsInterpreterFileRights() []int {
+// GetProcessAncestorsInterpreterFileGid returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsInterpreterFileGid() []uint32 {
 	if ev.BaseEvent.ProcessContext == nil {
-		return []int{}
+		return []uint32{}
 	}
 	if ev.BaseEvent.ProcessContext.Ancestor == nil {
-		return []int{}
+		return []uint32{}
 	}
-	var values []int
+	var values []uint32
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := int(ev.FieldHandlers.ResolveRights(ev, &element.ProcessContext.Process.LinuxBinprm.FileEvent.FileFields))
+		result := element.ProcessContext.Process.LinuxBinprm.FileEvent.FileFields.GID
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsInterpreterFileUid returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsInterpreterFileUid() []uint32 {
+// GetProcessAncestorsInterpreterFileGroup returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsInterpreterFileGroup() []string {
 	if ev.BaseEvent.ProcessContext == nil {
-		return []uint32{}
+		return []string{}
 	}
 	if ev.BaseEvent.ProcessContext.Ancestor == nil {
-		return []uint32{}
+		return []string{}
 	}
-	var values []uint32
+	var values []string
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := element.ProcessContext.Process.LinuxBinprm.FileEvent.FileFields.UID
+		result := ev.FieldHandlers.ResolveFileFieldsGroup(ev, &element.ProcessContext.Process.LinuxBinprm.FileEvent.FileFields)
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsInterpreterFileUser returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsInterpreterFileUser() []string {
+// GetProcessAncestorsInterpreterFileHashes returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsInterpreterFileHashes() []string {
 	if ev.BaseEvent.ProcessContext == nil {
 		return []string{}
 	}
@@ -6445,15 +7350,15 @@ func (ev *Event) GetProcessAncestorsInterpreterFileUser() []string {
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := ev.FieldHandlers.ResolveFileFieldsUser(ev, &element.ProcessContext.Process.LinuxBinprm.FileEvent.FileFields)
-		values = append(values, result)
+		result := ev.FieldHandlers.ResolveHashesFromEvent(ev, &element.ProcessContext.Process.LinuxBinprm.FileEvent)
+		values = append(values, result...)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsIsExec returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsIsExec() []bool {
+// GetProcessAncestorsInterpreterFileInUpperLayer returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsInterpreterFileInUpperLayer() []bool {
 	if ev.BaseEvent.ProcessContext == nil {
 		return []bool{}
 	}
@@ -6466,112 +7371,120 @@ func (ev *Event) GetProcessAncestorsIsExec() []bool {
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := element.ProcessContext.Process.IsExec
+		result := ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &element.ProcessContext.Process.LinuxBinprm.FileEvent.FileFields)
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsIsKworker returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsIsKworker() []bool {
+// GetProcessAncestorsInterpreterFileInode returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsInterpreterFileInode() []uint64 {
 	if ev.BaseEvent.ProcessContext == nil {
-		return []bool{}
+		return []uint64{}
 	}
 	if ev.BaseEvent.ProcessContext.Ancestor == nil {
-		return []bool{}
+		return []uint64{}
 	}
-	var values []bool
+	var values []uint64
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := element.ProcessContext.Process.PIDContext.IsKworker
+		result := element.ProcessContext.Process.LinuxBinprm.FileEvent.FileFields.PathKey.Inode
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsIsThread returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsIsThread() []bool {
+// GetProcessAncestorsInterpreterFileLayerDigest returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsInterpreterFileLayerDigest() []string {
 	if ev.BaseEvent.ProcessContext == nil {
-		return []bool{}
+		return []string{}
 	}
 	if ev.BaseEvent.ProcessContext.Ancestor == nil {
-		return []bool{}
+		return []string{}
 	}
-	var values []bool
+	var values []string
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := ev.FieldHandlers.ResolveProcessIsThread(ev, &element.ProcessContext.Process)
+		result := ev.FieldHandlers.ResolveFileLayerDigest(ev, &element.ProcessContext.Process.LinuxBinprm.FileEvent)
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsLength returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsLength() int {
+// GetProcessAncestorsInterpreterFileLayerPath returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsInterpreterFileLayerPath() []string {
 	if ev.BaseEvent.ProcessContext == nil {
-		return 0
+		return []string{}
 	}
 	if ev.BaseEvent.ProcessContext.Ancestor == nil {
-		return 0
+		return []string{}
 	}
+	var values []string
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
-	return iterator.Len(ctx)
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := ev.FieldHandlers.ResolveFileLayerPath(ev, &element.ProcessContext.Process.LinuxBinprm.FileEvent)
+		values = append(values, result)
+		ptr = iterator.Next()
+	}
+	return values
 }
 
-// GetProcessAncestorsPid returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsPid() []uint32 {
+// GetProcessAncestorsInterpreterFileMode returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsInterpreterFileMode() []uint16 {
 	if ev.BaseEvent.ProcessContext == nil {
-		return []uint32{}
+		return []uint16{}
 	}
 	if ev.BaseEvent.ProcessContext.Ancestor == nil {
-		return []uint32{}
+		return []uint16{}
 	}
-	var values []uint32
+	var values []uint16
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := element.ProcessContext.Process.PIDContext.Pid
+		result := element.ProcessContext.Process.LinuxBinprm.FileEvent.FileFields.Mode
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsPpid returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsPpid() []uint32 {
+// GetProcessAncestorsInterpreterFileModificationTime returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsInterpreterFileModificationTime() []uint64 {
 	if ev.BaseEvent.ProcessContext == nil {
-		return []uint32{}
+		return []uint64{}
 	}
 	if ev.BaseEvent.ProcessContext.Ancestor == nil {
-		return []uint32{}
+		return []uint64{}
 	}
-	var values []uint32
+	var values []uint64
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := element.ProcessContext.Process.PPid
+		result := element.ProcessContext.Process.LinuxBinprm.FileEvent.FileFields.MTime
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsTid returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsTid() []uint32 {
+// GetProcessAncestorsInterpreterFileMountId returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsInterpreterFileMountId() []uint32 {
 	if ev.BaseEvent.ProcessContext == nil {
 		return []uint32{}
 	}
@@ -6584,15 +7497,15 @@ func (ev *Event) GetProcessAncestorsTid() []uint32 {
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := element.ProcessContext.Process.PIDContext.Tid
+		result := element.ProcessContext.Process.LinuxBinprm.FileEvent.FileFields.PathKey.MountID
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsTtyName returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsTtyName() []string {
+// GetProcessAncestorsInterpreterFileName returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsInterpreterFileName() []string {
 	if ev.BaseEvent.ProcessContext == nil {
 		return []string{}
 	}
@@ -6605,36 +7518,36 @@ func (ev *Event) GetProcessAncestorsTtyName() []string {
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := element.ProcessContext.Process.TTYName
+		result := ev.FieldHandlers.ResolveFileBasename(ev, &element.ProcessContext.Process.LinuxBinprm.FileEvent)
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsUid returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsUid() []uint32 {
+// GetProcessAncestorsInterpreterFileNameLength returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsInterpreterFileNameLength() []int {
 	if ev.BaseEvent.ProcessContext == nil {
-		return []uint32{}
+		return []int{}
 	}
 	if ev.BaseEvent.ProcessContext.Ancestor == nil {
-		return []uint32{}
+		return []int{}
 	}
-	var values []uint32
+	var values []int
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := element.ProcessContext.Process.Credentials.UID
+		result := len(ev.FieldHandlers.ResolveFileBasename(ev, &element.ProcessContext.Process.LinuxBinprm.FileEvent))
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsUser returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsUser() []string {
+// GetProcessAncestorsInterpreterFilePackageName returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsInterpreterFilePackageName() []string {
 	if ev.BaseEvent.ProcessContext == nil {
 		return []string{}
 	}
@@ -6647,15 +7560,15 @@ func (ev *Event) GetProcessAncestorsUser() []string {
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := element.ProcessContext.Process.Credentials.User
+		result := ev.FieldHandlers.ResolvePackageName(ev, &element.ProcessContext.Process.LinuxBinprm.FileEvent)
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsUserSessionK8sGroups returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsUserSessionK8sGroups() []string {
+// GetProcessAncestorsInterpreterFilePackageSourceVersion returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsInterpreterFilePackageSourceVersion() []string {
 	if ev.BaseEvent.ProcessContext == nil {
 		return []string{}
 	}
@@ -6668,15 +7581,15 @@ func (ev *Event) GetProcessAncestorsUserSessionK8sGroups() []string {
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := ev.FieldHandlers.ResolveK8SGroups(ev, &element.ProcessContext.Process.UserSession)
-		values = append(values, result...)
+		result := ev.FieldHandlers.ResolvePackageSourceVersion(ev, &element.ProcessContext.Process.LinuxBinprm.FileEvent)
+		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsUserSessionK8sUid returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsUserSessionK8sUid() []string {
+// GetProcessAncestorsInterpreterFilePackageVersion returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsInterpreterFilePackageVersion() []string {
 	if ev.BaseEvent.ProcessContext == nil {
 		return []string{}
 	}
@@ -6689,15 +7602,15 @@ func (ev *Event) GetProcessAncestorsUserSessionK8sUid() []string {
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := ev.FieldHandlers.ResolveK8SUID(ev, &element.ProcessContext.Process.UserSession)
+		result := ev.FieldHandlers.ResolvePackageVersion(ev, &element.ProcessContext.Process.LinuxBinprm.FileEvent)
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessAncestorsUserSessionK8sUsername returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAncestorsUserSessionK8sUsername() []string {
+// GetProcessAncestorsInterpreterFilePath returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsInterpreterFilePath() []string {
 	if ev.BaseEvent.ProcessContext == nil {
 		return []string{}
 	}
@@ -6710,1453 +7623,1460 @@ func (ev *Event) GetProcessAncestorsUserSessionK8sUsername() []string {
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := ev.FieldHandlers.ResolveK8SUsername(ev, &element.ProcessContext.Process.UserSession)
+		result := ev.FieldHandlers.ResolveFilePath(ev, &element.ProcessContext.Process.LinuxBinprm.FileEvent)
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetProcessArgs returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessArgs() string {
+// GetProcessAncestorsInterpreterFilePathLength returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsInterpreterFilePathLength() []int {
 	if ev.BaseEvent.ProcessContext == nil {
-		return ""
+		return []int{}
 	}
-	return ev.FieldHandlers.ResolveProcessArgs(ev, &ev.BaseEvent.ProcessContext.Process)
-}
-
-// GetProcessArgsFlags returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessArgsFlags() []string {
-	if ev.BaseEvent.ProcessContext == nil {
-		return []string{}
+	if ev.BaseEvent.ProcessContext.Ancestor == nil {
+		return []int{}
 	}
-	return ev.FieldHandlers.ResolveProcessArgsFlags(ev, &ev.BaseEvent.ProcessContext.Process)
-}
-
-// GetProcessArgsOptions returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessArgsOptions() []string {
-	if ev.BaseEvent.ProcessContext == nil {
-		return []string{}
+	var values []int
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := len(ev.FieldHandlers.ResolveFilePath(ev, &element.ProcessContext.Process.LinuxBinprm.FileEvent))
+		values = append(values, result)
+		ptr = iterator.Next()
 	}
-	return ev.FieldHandlers.ResolveProcessArgsOptions(ev, &ev.BaseEvent.ProcessContext.Process)
+	return values
 }
 
-// GetProcessArgsScrubbed returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessArgsScrubbed() string {
+// GetProcessAncestorsInterpreterFileRights returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsInterpreterFileRights() []int {
 	if ev.BaseEvent.ProcessContext == nil {
-		return ""
+		return []int{}
 	}
-	return ev.FieldHandlers.ResolveProcessArgsScrubbed(ev, &ev.BaseEvent.ProcessContext.Process)
-}
-
-// GetProcessArgsTruncated returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessArgsTruncated() bool {
-	if ev.BaseEvent.ProcessContext == nil {
-		return false
+	if ev.BaseEvent.ProcessContext.Ancestor == nil {
+		return []int{}
 	}
-	return ev.FieldHandlers.ResolveProcessArgsTruncated(ev, &ev.BaseEvent.ProcessContext.Process)
-}
-
-// GetProcessArgv returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessArgv() []string {
-	if ev.BaseEvent.ProcessContext == nil {
-		return []string{}
+	var values []int
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := int(ev.FieldHandlers.ResolveRights(ev, &element.ProcessContext.Process.LinuxBinprm.FileEvent.FileFields))
+		values = append(values, result)
+		ptr = iterator.Next()
 	}
-	return ev.FieldHandlers.ResolveProcessArgv(ev, &ev.BaseEvent.ProcessContext.Process)
+	return values
 }
 
-// GetProcessArgv0 returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessArgv0() string {
+// GetProcessAncestorsInterpreterFileUid returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsInterpreterFileUid() []uint32 {
 	if ev.BaseEvent.ProcessContext == nil {
-		return ""
+		return []uint32{}
 	}
-	return ev.FieldHandlers.ResolveProcessArgv0(ev, &ev.BaseEvent.ProcessContext.Process)
+	if ev.BaseEvent.ProcessContext.Ancestor == nil {
+		return []uint32{}
+	}
+	var values []uint32
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := element.ProcessContext.Process.LinuxBinprm.FileEvent.FileFields.UID
+		values = append(values, result)
+		ptr = iterator.Next()
+	}
+	return values
 }
 
-// GetProcessArgvScrubbed returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessArgvScrubbed() []string {
+// GetProcessAncestorsInterpreterFileUser returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsInterpreterFileUser() []string {
 	if ev.BaseEvent.ProcessContext == nil {
 		return []string{}
 	}
-	return ev.FieldHandlers.ResolveProcessArgvScrubbed(ev, &ev.BaseEvent.ProcessContext.Process)
-}
-
-// GetProcessAuid returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessAuid() uint32 {
-	if ev.BaseEvent.ProcessContext == nil {
-		return uint32(0)
+	if ev.BaseEvent.ProcessContext.Ancestor == nil {
+		return []string{}
 	}
-	return ev.BaseEvent.ProcessContext.Process.Credentials.AUID
-}
-
-// GetProcessCapEffective returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessCapEffective() uint64 {
-	if ev.BaseEvent.ProcessContext == nil {
-		return uint64(0)
+	var values []string
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := ev.FieldHandlers.ResolveFileFieldsUser(ev, &element.ProcessContext.Process.LinuxBinprm.FileEvent.FileFields)
+		values = append(values, result)
+		ptr = iterator.Next()
 	}
-	return ev.BaseEvent.ProcessContext.Process.Credentials.CapEffective
+	return values
 }
 
-// GetProcessCapPermitted returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessCapPermitted() uint64 {
+// GetProcessAncestorsIsExec returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsIsExec() []bool {
 	if ev.BaseEvent.ProcessContext == nil {
-		return uint64(0)
+		return []bool{}
 	}
-	return ev.BaseEvent.ProcessContext.Process.Credentials.CapPermitted
+	if ev.BaseEvent.ProcessContext.Ancestor == nil {
+		return []bool{}
+	}
+	var values []bool
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := element.ProcessContext.Process.IsExec
+		values = append(values, result)
+		ptr = iterator.Next()
+	}
+	return values
 }
 
-// GetProcessCgroupFileInode returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessCgroupFileInode() uint64 {
+// GetProcessAncestorsIsKworker returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsIsKworker() []bool {
 	if ev.BaseEvent.ProcessContext == nil {
-		return uint64(0)
+		return []bool{}
 	}
-	return ev.BaseEvent.ProcessContext.Process.CGroup.CGroupFile.Inode
-}
-
-// GetProcessCgroupFileMountId returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessCgroupFileMountId() uint32 {
-	if ev.BaseEvent.ProcessContext == nil {
-		return uint32(0)
+	if ev.BaseEvent.ProcessContext.Ancestor == nil {
+		return []bool{}
 	}
-	return ev.BaseEvent.ProcessContext.Process.CGroup.CGroupFile.MountID
-}
-
-// GetProcessCgroupId returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessCgroupId() string {
-	if ev.BaseEvent.ProcessContext == nil {
-		return ""
+	var values []bool
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := element.ProcessContext.Process.PIDContext.IsKworker
+		values = append(values, result)
+		ptr = iterator.Next()
 	}
-	return ev.FieldHandlers.ResolveCGroupID(ev, &ev.BaseEvent.ProcessContext.Process.CGroup)
+	return values
 }
 
-// GetProcessCgroupManager returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessCgroupManager() string {
+// GetProcessAncestorsIsThread returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsIsThread() []bool {
 	if ev.BaseEvent.ProcessContext == nil {
-		return ""
+		return []bool{}
 	}
-	return ev.FieldHandlers.ResolveCGroupManager(ev, &ev.BaseEvent.ProcessContext.Process.CGroup)
-}
-
-// GetProcessCmdargv returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessCmdargv() []string {
-	if ev.BaseEvent.ProcessContext == nil {
-		return []string{}
+	if ev.BaseEvent.ProcessContext.Ancestor == nil {
+		return []bool{}
 	}
-	return ev.FieldHandlers.ResolveProcessCmdArgv(ev, &ev.BaseEvent.ProcessContext.Process)
-}
-
-// GetProcessComm returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessComm() string {
-	if ev.BaseEvent.ProcessContext == nil {
-		return ""
+	var values []bool
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := ev.FieldHandlers.ResolveProcessIsThread(ev, &element.ProcessContext.Process)
+		values = append(values, result)
+		ptr = iterator.Next()
 	}
-	return ev.BaseEvent.ProcessContext.Process.Comm
+	return values
 }
 
-// GetProcessContainerId returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessContainerId() string {
+// GetProcessAncestorsLength returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsLength() int {
 	if ev.BaseEvent.ProcessContext == nil {
-		return ""
+		return 0
 	}
-	return ev.FieldHandlers.ResolveProcessContainerID(ev, &ev.BaseEvent.ProcessContext.Process)
-}
-
-// GetProcessCreatedAt returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessCreatedAt() int {
-	if ev.BaseEvent.ProcessContext == nil {
+	if ev.BaseEvent.ProcessContext.Ancestor == nil {
 		return 0
 	}
-	return ev.FieldHandlers.ResolveProcessCreatedAt(ev, &ev.BaseEvent.ProcessContext.Process)
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	return iterator.Len(ctx)
 }
 
-// GetProcessEgid returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessEgid() uint32 {
+// GetProcessAncestorsNsMnt returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsNsMnt() []int {
 	if ev.BaseEvent.ProcessContext == nil {
-		return uint32(0)
+		return []int{}
 	}
-	return ev.BaseEvent.ProcessContext.Process.Credentials.EGID
-}
-
-// GetProcessEgroup returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessEgroup() string {
-	if ev.BaseEvent.ProcessContext == nil {
-		return ""
+	if ev.BaseEvent.ProcessContext.Ancestor == nil {
+		return []int{}
 	}
-	return ev.BaseEvent.ProcessContext.Process.Credentials.EGroup
-}
-
-// GetProcessEnvp returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessEnvp() []string {
-	if ev.BaseEvent.ProcessContext == nil {
-		return []string{}
+	var values []int
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := int(ev.FieldHandlers.ResolveProcessMountNamespace(ev, &element.ProcessContext.Process))
+		values = append(values, result)
+		ptr = iterator.Next()
 	}
-	return ev.FieldHandlers.ResolveProcessEnvp(ev, &ev.BaseEvent.ProcessContext.Process)
+	return values
 }
 
-// GetProcessEnvs returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessEnvs() []string {
+// GetProcessAncestorsNsPid returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsNsPid() []int {
 	if ev.BaseEvent.ProcessContext == nil {
-		return []string{}
+		return []int{}
 	}
-	return ev.FieldHandlers.ResolveProcessEnvs(ev, &ev.BaseEvent.ProcessContext.Process)
-}
-
-// GetProcessEnvsTruncated returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessEnvsTruncated() bool {
-	if ev.BaseEvent.ProcessContext == nil {
-		return false
+	if ev.BaseEvent.ProcessContext.Ancestor == nil {
+		return []int{}
 	}
-	return ev.FieldHandlers.ResolveProcessEnvsTruncated(ev, &ev.BaseEvent.ProcessContext.Process)
-}
-
-// GetProcessEuid returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessEuid() uint32 {
-	if ev.BaseEvent.ProcessContext == nil {
-		return uint32(0)
+	var values []int
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := int(ev.FieldHandlers.ResolveProcessPIDNamespace(ev, &element.ProcessContext.Process))
+		values = append(values, result)
+		ptr = iterator.Next()
 	}
-	return ev.BaseEvent.ProcessContext.Process.Credentials.EUID
+	return values
 }
 
-// GetProcessEuser returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessEuser() string {
+// GetProcessAncestorsNsUser returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsNsUser() []int {
 	if ev.BaseEvent.ProcessContext == nil {
-		return ""
+		return []int{}
 	}
-	return ev.BaseEvent.ProcessContext.Process.Credentials.EUser
-}
-
-// GetProcessExecTime returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessExecTime() time.Time {
-	if ev.BaseEvent.ProcessContext == nil {
-		return time.Time{}
+	if ev.BaseEvent.ProcessContext.Ancestor == nil {
+		return []int{}
 	}
-	return ev.BaseEvent.ProcessContext.Process.ExecTime
-}
-
-// GetProcessExitTime returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessExitTime() time.Time {
-	if ev.BaseEvent.ProcessContext == nil {
-		return time.Time{}
+	var values []int
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := int(ev.FieldHandlers.ResolveProcessUserNamespace(ev, &element.ProcessContext.Process))
+		values = append(values, result)
+		ptr = iterator.Next()
 	}
-	return ev.BaseEvent.ProcessContext.Process.ExitTime
+	return values
 }
 
-// GetProcessFileChangeTime returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessFileChangeTime() uint64 {
+// GetProcessAncestorsNsUts returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsNsUts() []int {
 	if ev.BaseEvent.ProcessContext == nil {
-		return uint64(0)
+		return []int{}
 	}
-	if !ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
-		return uint64(0)
+	if ev.BaseEvent.ProcessContext.Ancestor == nil {
+		return []int{}
 	}
-	return ev.BaseEvent.ProcessContext.Process.FileEvent.FileFields.CTime
+	var values []int
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := int(ev.FieldHandlers.ResolveProcessUTSNamespace(ev, &element.ProcessContext.Process))
+		values = append(values, result)
+		ptr = iterator.Next()
+	}
+	return values
 }
 
-// GetProcessFileFilesystem returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessFileFilesystem() string {
+// GetProcessAncestorsPid returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsPid() []uint32 {
 	if ev.BaseEvent.ProcessContext == nil {
-		return ""
+		return []uint32{}
 	}
-	if !ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
-		return ""
+	if ev.BaseEvent.ProcessContext.Ancestor == nil {
+		return []uint32{}
 	}
-	return ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.BaseEvent.ProcessContext.Process.FileEvent)
+	var values []uint32
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := element.ProcessContext.Process.PIDContext.Pid
+		values = append(values, result)
+		ptr = iterator.Next()
+	}
+	return values
 }
 
-// GetProcessFileGid returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessFileGid() uint32 {
+// GetProcessAncestorsPpid returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsPpid() []uint32 {
 	if ev.BaseEvent.ProcessContext == nil {
-		return uint32(0)
+		return []uint32{}
 	}
-	if !ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
-		return uint32(0)
+	if ev.BaseEvent.ProcessContext.Ancestor == nil {
+		return []uint32{}
 	}
-	return ev.BaseEvent.ProcessContext.Process.FileEvent.FileFields.GID
+	var values []uint32
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := element.ProcessContext.Process.PPid
+		values = append(values, result)
+		ptr = iterator.Next()
+	}
+	return values
 }
 
-// GetProcessFileGroup returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessFileGroup() string {
+// GetProcessAncestorsSystemdSlice returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsSystemdSlice() []string {
 	if ev.BaseEvent.ProcessContext == nil {
-		return ""
+		return []string{}
 	}
-	if !ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
-		return ""
+	if ev.BaseEvent.ProcessContext.Ancestor == nil {
+		return []string{}
 	}
-	return ev.FieldHandlers.ResolveFileFieldsGroup(ev, &ev.BaseEvent.ProcessContext.Process.FileEvent.FileFields)
+	var values []string
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := ev.FieldHandlers.ResolveProcessSystemdSlice(ev, &element.ProcessContext.Process)
+		values = append(values, result)
+		ptr = iterator.Next()
+	}
+	return values
 }
 
-// GetProcessFileHashes returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessFileHashes() []string {
+// GetProcessAncestorsSystemdUnit returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsSystemdUnit() []string {
 	if ev.BaseEvent.ProcessContext == nil {
 		return []string{}
 	}
-	if !ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
+	if ev.BaseEvent.ProcessContext.Ancestor == nil {
 		return []string{}
 	}
-	return ev.FieldHandlers.ResolveHashesFromEvent(ev, &ev.BaseEvent.ProcessContext.Process.FileEvent)
-}
-
-// GetProcessFileInUpperLayer returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessFileInUpperLayer() bool {
-	if ev.BaseEvent.ProcessContext == nil {
-		return false
-	}
-	if !ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
-		return false
+	var values []string
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := ev.FieldHandlers.ResolveProcessSystemdUnit(ev, &element.ProcessContext.Process)
+		values = append(values, result)
+		ptr = iterator.Next()
 	}
-	return ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.BaseEvent.ProcessContext.Process.FileEvent.FileFields)
+	return values
 }
 
-// GetProcessFileInode returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessFileInode() uint64 {
+// GetProcessAncestorsTid returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsTid() []uint32 {
 	if ev.BaseEvent.ProcessContext == nil {
-		return uint64(0)
+		return []uint32{}
 	}
-	if !ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
-		return uint64(0)
+	if ev.BaseEvent.ProcessContext.Ancestor == nil {
+		return []uint32{}
 	}
-	return ev.BaseEvent.ProcessContext.Process.FileEvent.FileFields.PathKey.Inode
+	var values []uint32
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := element.ProcessContext.Process.PIDContext.Tid
+		values = append(values, result)
+		ptr = iterator.Next()
+	}
+	return values
 }
 
-// GetProcessFileMode returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessFileMode() uint16 {
+// GetProcessAncestorsTtyName returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsTtyName() []string {
 	if ev.BaseEvent.ProcessContext == nil {
-		return uint16(0)
+		return []string{}
 	}
-	if !ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
-		return uint16(0)
+	if ev.BaseEvent.ProcessContext.Ancestor == nil {
+		return []string{}
 	}
-	return ev.BaseEvent.ProcessContext.Process.FileEvent.FileFields.Mode
+	var values []string
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := element.ProcessContext.Process.TTYName
+		values = append(values, result)
+		ptr = iterator.Next()
+	}
+	return values
 }
 
-// GetProcessFileModificationTime returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessFileModificationTime() uint64 {
+// GetProcessAncestorsUid returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsUid() []uint32 {
 	if ev.BaseEvent.ProcessContext == nil {
-		return uint64(0)
+		return []uint32{}
 	}
-	if !ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
-		return uint64(0)
+	if ev.BaseEvent.ProcessContext.Ancestor == nil {
+		return []uint32{}
 	}
-	return ev.BaseEvent.ProcessContext.Process.FileEvent.FileFields.MTime
+	var values []uint32
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := element.ProcessContext.Process.Credentials.UID
+		values = append(values, result)
+		ptr = iterator.Next()
+	}
+	return values
 }
 
-// GetProcessFileMountId returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessFileMountId() uint32 {
+// GetProcessAncestorsUser returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsUser() []string {
 	if ev.BaseEvent.ProcessContext == nil {
-		return uint32(0)
+		return []string{}
 	}
-	if !ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
-		return uint32(0)
+	if ev.BaseEvent.ProcessContext.Ancestor == nil {
+		return []string{}
 	}
-	return ev.BaseEvent.ProcessContext.Process.FileEvent.FileFields.PathKey.MountID
+	var values []string
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := element.ProcessContext.Process.Credentials.User
+		values = append(values, result)
+		ptr = iterator.Next()
+	}
+	return values
 }
 
-// GetProcessFileName returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessFileName() string {
+// GetProcessAncestorsUserSessionK8sGroups returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsUserSessionK8sGroups() []string {
 	if ev.BaseEvent.ProcessContext == nil {
-		return ""
+		return []string{}
 	}
-	if !ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
-		return ""
+	if ev.BaseEvent.ProcessContext.Ancestor == nil {
+		return []string{}
 	}
-	return ev.FieldHandlers.ResolveFileBasename(ev, &ev.BaseEvent.ProcessContext.Process.FileEvent)
+	var values []string
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := ev.FieldHandlers.ResolveK8SGroups(ev, &element.ProcessContext.Process.UserSession)
+		values = append(values, result...)
+		ptr = iterator.Next()
+	}
+	return values
 }
 
-// GetProcessFileNameLength returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessFileNameLength() int {
+// GetProcessAncestorsUserSessionK8sUid returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsUserSessionK8sUid() []string {
 	if ev.BaseEvent.ProcessContext == nil {
-		return 0
+		return []string{}
 	}
-	return len(ev.FieldHandlers.ResolveFileBasename(ev, &ev.BaseEvent.ProcessContext.Process.FileEvent))
+	if ev.BaseEvent.ProcessContext.Ancestor == nil {
+		return []string{}
+	}
+	var values []string
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := ev.FieldHandlers.ResolveK8SUID(ev, &element.ProcessContext.Process.UserSession)
+		values = append(values, result)
+		ptr = iterator.Next()
+	}
+	return values
 }
 
-// GetProcessFilePackageName returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessFilePackageName() string {
+// GetProcessAncestorsUserSessionK8sUsername returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsUserSessionK8sUsername() []string {
 	if ev.BaseEvent.ProcessContext == nil {
-		return ""
+		return []string{}
 	}
-	if !ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
-		return ""
+	if ev.BaseEvent.ProcessContext.Ancestor == nil {
+		return []string{}
 	}
-	return ev.FieldHandlers.ResolvePackageName(ev, &ev.BaseEvent.ProcessContext.Process.FileEvent)
+	var values []string
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := ev.FieldHandlers.ResolveK8SUsername(ev, &element.ProcessContext.Process.UserSession)
+		values = append(values, result)
+		ptr = iterator.Next()
+	}
+	return values
 }
 
-// GetProcessFilePackageSourceVersion returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessFilePackageSourceVersion() string {
+// GetProcessArgs returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessArgs() string {
 	if ev.BaseEvent.ProcessContext == nil {
 		return ""
 	}
-	if !ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
-		return ""
-	}
-	return ev.FieldHandlers.ResolvePackageSourceVersion(ev, &ev.BaseEvent.ProcessContext.Process.FileEvent)
+	return ev.FieldHandlers.ResolveProcessArgs(ev, &ev.BaseEvent.ProcessContext.Process)
 }
 
-// GetProcessFilePackageVersion returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessFilePackageVersion() string {
+// GetProcessArgsFlags returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessArgsFlags() []string {
 	if ev.BaseEvent.ProcessContext == nil {
-		return ""
-	}
-	if !ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
-		return ""
+		return []string{}
 	}
-	return ev.FieldHandlers.ResolvePackageVersion(ev, &ev.BaseEvent.ProcessContext.Process.FileEvent)
+	return ev.FieldHandlers.ResolveProcessArgsFlags(ev, &ev.BaseEvent.ProcessContext.Process)
 }
 
-// GetProcessFilePath returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessFilePath() string {
+// GetProcessArgsOptions returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessArgsOptions() []string {
 	if ev.BaseEvent.ProcessContext == nil {
-		return ""
-	}
-	if !ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
-		return ""
+		return []string{}
 	}
-	return ev.FieldHandlers.ResolveFilePath(ev, &ev.BaseEvent.ProcessContext.Process.FileEvent)
+	return ev.FieldHandlers.ResolveProcessArgsOptions(ev, &ev.BaseEvent.ProcessContext.Process)
 }
 
-// GetProcessFilePathLength returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessFilePathLength() int {
+// GetProcessArgsScrubbed returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessArgsScrubbed() string {
 	if ev.BaseEvent.ProcessContext == nil {
-		return 0
+		return ""
 	}
-	return len(ev.FieldHandlers.ResolveFilePath(ev, &ev.BaseEvent.ProcessContext.Process.FileEvent))
+	return ev.FieldHandlers.ResolveProcessArgsScrubbed(ev, &ev.BaseEvent.ProcessContext.Process)
 }
 
-// GetProcessFileRights returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessFileRights() int {
+// GetProcessArgsTruncated returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessArgsTruncated() bool {
 	if ev.BaseEvent.ProcessContext == nil {
-		return 0
-	}
-	if !ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
-		return 0
+		return false
 	}
-	return ev.FieldHandlers.ResolveRights(ev, &ev.BaseEvent.ProcessContext.Process.FileEvent.FileFields)
+	return ev.FieldHandlers.ResolveProcessArgsTruncated(ev, &ev.BaseEvent.ProcessContext.Process)
 }
 
-// GetProcessFileUid returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessFileUid() uint32 {
+// GetProcessArgv returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessArgv() []string {
 	if ev.BaseEvent.ProcessContext == nil {
-		return uint32(0)
-	}
-	if !ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
-		return uint32(0)
+		return []string{}
 	}
-	return ev.BaseEvent.ProcessContext.Process.FileEvent.FileFields.UID
+	return ev.FieldHandlers.ResolveProcessArgv(ev, &ev.BaseEvent.ProcessContext.Process)
 }
 
-// GetProcessFileUser returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessFileUser() string {
+// GetProcessArgv0 returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessArgv0() string {
 	if ev.BaseEvent.ProcessContext == nil {
 		return ""
 	}
-	if !ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
-		return ""
-	}
-	return ev.FieldHandlers.ResolveFileFieldsUser(ev, &ev.BaseEvent.ProcessContext.Process.FileEvent.FileFields)
+	return ev.FieldHandlers.ResolveProcessArgv0(ev, &ev.BaseEvent.ProcessContext.Process)
 }
 
-// GetProcessForkTime returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessForkTime() time.Time {
+// GetProcessArgvScrubbed returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessArgvScrubbed() []string {
 	if ev.BaseEvent.ProcessContext == nil {
-		return time.Time{}
+		return []string{}
 	}
-	return ev.BaseEvent.ProcessContext.Process.ForkTime
+	return ev.FieldHandlers.ResolveProcessArgvScrubbed(ev, &ev.BaseEvent.ProcessContext.Process)
 }
 
-// GetProcessFsgid returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessFsgid() uint32 {
+// GetProcessAuid returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAuid() uint32 {
 	if ev.BaseEvent.ProcessContext == nil {
 		return uint32(0)
 	}
-	return ev.BaseEvent.ProcessContext.Process.Credentials.FSGID
+	return ev.BaseEvent.ProcessContext.Process.Credentials.AUID
 }
 
-// GetProcessFsgroup returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessFsgroup() string {
-	if ev.BaseEvent.ProcessContext == nil {
-		return ""
+// GetProcessCapEffective returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessCapEffective() uint64 {
+	if ev.BaseEvent.ProcessContext == nil {
+		return uint64(0)
 	}
-	return ev.BaseEvent.ProcessContext.Process.Credentials.FSGroup
+	return ev.BaseEvent.ProcessContext.Process.Credentials.CapEffective
 }
 
-// GetProcessFsuid returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessFsuid() uint32 {
+// GetProcessCapPermitted returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessCapPermitted() uint64 {
 	if ev.BaseEvent.ProcessContext == nil {
-		return uint32(0)
+		return uint64(0)
 	}
-	return ev.BaseEvent.ProcessContext.Process.Credentials.FSUID
+	return ev.BaseEvent.ProcessContext.Process.Credentials.CapPermitted
 }
 
-// GetProcessFsuser returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessFsuser() string {
+// GetProcessCgroupAncestors returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessCgroupAncestors() []string {
 	if ev.BaseEvent.ProcessContext == nil {
-		return ""
+		return []string{}
 	}
-	return ev.BaseEvent.ProcessContext.Process.Credentials.FSUser
+	return ev.FieldHandlers.ResolveCGroupAncestors(ev, &ev.BaseEvent.ProcessContext.Process.CGroup)
 }
 
-// GetProcessGid returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessGid() uint32 {
+// GetProcessCgroupControllers returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessCgroupControllers() []string {
 	if ev.BaseEvent.ProcessContext == nil {
-		return uint32(0)
+		return []string{}
 	}
-	return ev.BaseEvent.ProcessContext.Process.Credentials.GID
+	return ev.FieldHandlers.ResolveCGroupControllers(ev, &ev.BaseEvent.ProcessContext.Process.CGroup)
 }
 
-// GetProcessGroup returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessGroup() string {
+// GetProcessCgroupCpuUsage returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessCgroupCpuUsage() int {
 	if ev.BaseEvent.ProcessContext == nil {
-		return ""
+		return 0
 	}
-	return ev.BaseEvent.ProcessContext.Process.Credentials.Group
+	return ev.FieldHandlers.ResolveCGroupCPUUsage(ev, &ev.BaseEvent.ProcessContext.Process.CGroup)
 }
 
-// GetProcessInterpreterFileChangeTime returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessInterpreterFileChangeTime() uint64 {
+// GetProcessCgroupFileInode returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessCgroupFileInode() uint64 {
 	if ev.BaseEvent.ProcessContext == nil {
 		return uint64(0)
 	}
-	if !ev.BaseEvent.ProcessContext.Process.HasInterpreter() {
-		return uint64(0)
-	}
-	return ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent.FileFields.CTime
+	return ev.BaseEvent.ProcessContext.Process.CGroup.CGroupFile.Inode
 }
 
-// GetProcessInterpreterFileFilesystem returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessInterpreterFileFilesystem() string {
+// GetProcessCgroupFileMountId returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessCgroupFileMountId() uint32 {
 	if ev.BaseEvent.ProcessContext == nil {
-		return ""
-	}
-	if !ev.BaseEvent.ProcessContext.Process.HasInterpreter() {
-		return ""
+		return uint32(0)
 	}
-	return ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent)
+	return ev.BaseEvent.ProcessContext.Process.CGroup.CGroupFile.MountID
 }
 
-// GetProcessInterpreterFileGid returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessInterpreterFileGid() uint32 {
+// GetProcessCgroupId returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessCgroupId() string {
 	if ev.BaseEvent.ProcessContext == nil {
-		return uint32(0)
-	}
-	if !ev.BaseEvent.ProcessContext.Process.HasInterpreter() {
-		return uint32(0)
+		return ""
 	}
-	return ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent.FileFields.GID
+	return ev.FieldHandlers.ResolveCGroupID(ev, &ev.BaseEvent.ProcessContext.Process.CGroup)
 }
 
-// GetProcessInterpreterFileGroup returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessInterpreterFileGroup() string {
+// GetProcessCgroupManager returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessCgroupManager() string {
 	if ev.BaseEvent.ProcessContext == nil {
 		return ""
 	}
-	if !ev.BaseEvent.ProcessContext.Process.HasInterpreter() {
-		return ""
-	}
-	return ev.FieldHandlers.ResolveFileFieldsGroup(ev, &ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent.FileFields)
+	return ev.FieldHandlers.ResolveCGroupManager(ev, &ev.BaseEvent.ProcessContext.Process.CGroup)
 }
 
-// GetProcessInterpreterFileHashes returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessInterpreterFileHashes() []string {
+// GetProcessCgroupMemoryCurrent returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessCgroupMemoryCurrent() int {
 	if ev.BaseEvent.ProcessContext == nil {
-		return []string{}
-	}
-	if !ev.BaseEvent.ProcessContext.Process.HasInterpreter() {
-		return []string{}
+		return 0
 	}
-	return ev.FieldHandlers.ResolveHashesFromEvent(ev, &ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent)
+	return ev.FieldHandlers.ResolveCGroupMemoryCurrent(ev, &ev.BaseEvent.ProcessContext.Process.CGroup)
 }
 
-// GetProcessInterpreterFileInUpperLayer returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessInterpreterFileInUpperLayer() bool {
+// GetProcessCgroupMemoryHighExceeded returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessCgroupMemoryHighExceeded() bool {
 	if ev.BaseEvent.ProcessContext == nil {
 		return false
 	}
-	if !ev.BaseEvent.ProcessContext.Process.HasInterpreter() {
-		return false
-	}
-	return ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent.FileFields)
+	return ev.FieldHandlers.ResolveCGroupMemoryHighExceeded(ev, &ev.BaseEvent.ProcessContext.Process.CGroup)
 }
 
-// GetProcessInterpreterFileInode returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessInterpreterFileInode() uint64 {
+// GetProcessCgroupPidsCurrent returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessCgroupPidsCurrent() int {
 	if ev.BaseEvent.ProcessContext == nil {
-		return uint64(0)
-	}
-	if !ev.BaseEvent.ProcessContext.Process.HasInterpreter() {
-		return uint64(0)
+		return 0
 	}
-	return ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent.FileFields.PathKey.Inode
+	return ev.FieldHandlers.ResolveCGroupPidsCurrent(ev, &ev.BaseEvent.ProcessContext.Process.CGroup)
 }
 
-// GetProcessInterpreterFileMode returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessInterpreterFileMode() uint16 {
+// GetProcessCloudCredentialsProviders returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessCloudCredentialsProviders() []string {
 	if ev.BaseEvent.ProcessContext == nil {
-		return uint16(0)
-	}
-	if !ev.BaseEvent.ProcessContext.Process.HasInterpreter() {
-		return uint16(0)
+		return []string{}
 	}
-	return ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent.FileFields.Mode
+	return ev.FieldHandlers.ResolveProcessCloudCredentialsProviders(ev, &ev.BaseEvent.ProcessContext.Process)
 }
 
-// GetProcessInterpreterFileModificationTime returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessInterpreterFileModificationTime() uint64 {
+// GetProcessCloudCredentialsResources returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessCloudCredentialsResources() []string {
 	if ev.BaseEvent.ProcessContext == nil {
-		return uint64(0)
-	}
-	if !ev.BaseEvent.ProcessContext.Process.HasInterpreter() {
-		return uint64(0)
+		return []string{}
 	}
-	return ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent.FileFields.MTime
+	return ev.FieldHandlers.ResolveProcessCloudCredentialsResources(ev, &ev.BaseEvent.ProcessContext.Process)
 }
 
-// GetProcessInterpreterFileMountId returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessInterpreterFileMountId() uint32 {
+// GetProcessCloudCredentialsTypes returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessCloudCredentialsTypes() []string {
 	if ev.BaseEvent.ProcessContext == nil {
-		return uint32(0)
-	}
-	if !ev.BaseEvent.ProcessContext.Process.HasInterpreter() {
-		return uint32(0)
+		return []string{}
 	}
-	return ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent.FileFields.PathKey.MountID
+	return ev.FieldHandlers.ResolveProcessCloudCredentialsTypes(ev, &ev.BaseEvent.ProcessContext.Process)
 }
 
-// GetProcessInterpreterFileName returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessInterpreterFileName() string {
+// GetProcessCmdargv returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessCmdargv() []string {
 	if ev.BaseEvent.ProcessContext == nil {
-		return ""
-	}
-	if !ev.BaseEvent.ProcessContext.Process.HasInterpreter() {
-		return ""
+		return []string{}
 	}
-	return ev.FieldHandlers.ResolveFileBasename(ev, &ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent)
+	return ev.FieldHandlers.ResolveProcessCmdArgv(ev, &ev.BaseEvent.ProcessContext.Process)
 }
 
-// GetProcessInterpreterFileNameLength returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessInterpreterFileNameLength() int {
+// GetProcessCmdlineObfuscationScore returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessCmdlineObfuscationScore() int {
 	if ev.BaseEvent.ProcessContext == nil {
 		return 0
 	}
-	return len(ev.FieldHandlers.ResolveFileBasename(ev, &ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent))
+	return ev.FieldHandlers.ResolveProcessCmdLineObfuscationScore(ev, &ev.BaseEvent.ProcessContext.Process)
 }
 
-// GetProcessInterpreterFilePackageName returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessInterpreterFilePackageName() string {
+// GetProcessComm returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessComm() string {
 	if ev.BaseEvent.ProcessContext == nil {
 		return ""
 	}
-	if !ev.BaseEvent.ProcessContext.Process.HasInterpreter() {
-		return ""
-	}
-	return ev.FieldHandlers.ResolvePackageName(ev, &ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent)
+	return ev.BaseEvent.ProcessContext.Process.Comm
 }
 
-// GetProcessInterpreterFilePackageSourceVersion returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessInterpreterFilePackageSourceVersion() string {
+// GetProcessContainerId returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessContainerId() string {
 	if ev.BaseEvent.ProcessContext == nil {
 		return ""
 	}
-	if !ev.BaseEvent.ProcessContext.Process.HasInterpreter() {
-		return ""
-	}
-	return ev.FieldHandlers.ResolvePackageSourceVersion(ev, &ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent)
+	return ev.FieldHandlers.ResolveProcessContainerID(ev, &ev.BaseEvent.ProcessContext.Process)
 }
 
-// GetProcessInterpreterFilePackageVersion returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessInterpreterFilePackageVersion() string {
+// GetProcessCreatedAt returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessCreatedAt() int {
 	if ev.BaseEvent.ProcessContext == nil {
-		return ""
+		return 0
 	}
-	if !ev.BaseEvent.ProcessContext.Process.HasInterpreter() {
-		return ""
+	return ev.FieldHandlers.ResolveProcessCreatedAt(ev, &ev.BaseEvent.ProcessContext.Process)
+}
+
+// GetProcessEgid returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessEgid() uint32 {
+	if ev.BaseEvent.ProcessContext == nil {
+		return uint32(0)
 	}
-	return ev.FieldHandlers.ResolvePackageVersion(ev, &ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent)
+	return ev.BaseEvent.ProcessContext.Process.Credentials.EGID
 }
 
-// GetProcessInterpreterFilePath returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessInterpreterFilePath() string {
+// GetProcessEgroup returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessEgroup() string {
 	if ev.BaseEvent.ProcessContext == nil {
 		return ""
 	}
-	if !ev.BaseEvent.ProcessContext.Process.HasInterpreter() {
+	return ev.BaseEvent.ProcessContext.Process.Credentials.EGroup
+}
+
+// GetProcessElfBuildId returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessElfBuildId() string {
+	if ev.BaseEvent.ProcessContext == nil {
 		return ""
 	}
-	return ev.FieldHandlers.ResolveFilePath(ev, &ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent)
+	return ev.FieldHandlers.ResolveProcessELFBuildID(ev, &ev.BaseEvent.ProcessContext.Process)
 }
 
-// GetProcessInterpreterFilePathLength returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessInterpreterFilePathLength() int {
+// GetProcessElfInterpreter returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessElfInterpreter() string {
 	if ev.BaseEvent.ProcessContext == nil {
-		return 0
+		return ""
 	}
-	return len(ev.FieldHandlers.ResolveFilePath(ev, &ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent))
+	return ev.FieldHandlers.ResolveProcessELFInterpreter(ev, &ev.BaseEvent.ProcessContext.Process)
 }
 
-// GetProcessInterpreterFileRights returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessInterpreterFileRights() int {
+// GetProcessElfIsStatic returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessElfIsStatic() bool {
 	if ev.BaseEvent.ProcessContext == nil {
-		return 0
-	}
-	if !ev.BaseEvent.ProcessContext.Process.HasInterpreter() {
-		return 0
+		return false
 	}
-	return ev.FieldHandlers.ResolveRights(ev, &ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent.FileFields)
+	return ev.FieldHandlers.ResolveProcessELFIsStatic(ev, &ev.BaseEvent.ProcessContext.Process)
 }
 
-// GetProcessInterpreterFileUid returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessInterpreterFileUid() uint32 {
+// GetProcessElfIsStripped returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessElfIsStripped() bool {
 	if ev.BaseEvent.ProcessContext == nil {
-		return uint32(0)
-	}
-	if !ev.BaseEvent.ProcessContext.Process.HasInterpreter() {
-		return uint32(0)
+		return false
 	}
-	return ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent.FileFields.UID
+	return ev.FieldHandlers.ResolveProcessELFIsStripped(ev, &ev.BaseEvent.ProcessContext.Process)
 }
 
-// GetProcessInterpreterFileUser returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessInterpreterFileUser() string {
+// GetProcessEnvp returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessEnvp() []string {
 	if ev.BaseEvent.ProcessContext == nil {
-		return ""
-	}
-	if !ev.BaseEvent.ProcessContext.Process.HasInterpreter() {
-		return ""
+		return []string{}
 	}
-	return ev.FieldHandlers.ResolveFileFieldsUser(ev, &ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent.FileFields)
+	return ev.FieldHandlers.ResolveProcessEnvp(ev, &ev.BaseEvent.ProcessContext.Process)
 }
 
-// GetProcessIsExec returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessIsExec() bool {
+// GetProcessEnvs returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessEnvs() []string {
 	if ev.BaseEvent.ProcessContext == nil {
-		return false
+		return []string{}
 	}
-	return ev.BaseEvent.ProcessContext.Process.IsExec
+	return ev.FieldHandlers.ResolveProcessEnvs(ev, &ev.BaseEvent.ProcessContext.Process)
 }
 
-// GetProcessIsKworker returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessIsKworker() bool {
+// GetProcessEnvsTruncated returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessEnvsTruncated() bool {
 	if ev.BaseEvent.ProcessContext == nil {
 		return false
 	}
-	return ev.BaseEvent.ProcessContext.Process.PIDContext.IsKworker
+	return ev.FieldHandlers.ResolveProcessEnvsTruncated(ev, &ev.BaseEvent.ProcessContext.Process)
 }
 
-// GetProcessIsThread returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessIsThread() bool {
+// GetProcessEuid returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessEuid() uint32 {
 	if ev.BaseEvent.ProcessContext == nil {
-		return false
+		return uint32(0)
 	}
-	return ev.FieldHandlers.ResolveProcessIsThread(ev, &ev.BaseEvent.ProcessContext.Process)
+	return ev.BaseEvent.ProcessContext.Process.Credentials.EUID
 }
 
-// GetProcessParentArgs returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentArgs() string {
+// GetProcessEuser returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessEuser() string {
 	if ev.BaseEvent.ProcessContext == nil {
 		return ""
 	}
-	if ev.BaseEvent.ProcessContext.Parent == nil {
-		return ""
-	}
-	if !ev.BaseEvent.ProcessContext.HasParent() {
-		return ""
-	}
-	return ev.FieldHandlers.ResolveProcessArgs(ev, ev.BaseEvent.ProcessContext.Parent)
+	return ev.BaseEvent.ProcessContext.Process.Credentials.EUser
 }
 
-// GetProcessParentArgsFlags returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentArgsFlags() []string {
+// GetProcessExecTime returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessExecTime() time.Time {
 	if ev.BaseEvent.ProcessContext == nil {
-		return []string{}
-	}
-	if ev.BaseEvent.ProcessContext.Parent == nil {
-		return []string{}
-	}
-	if !ev.BaseEvent.ProcessContext.HasParent() {
-		return []string{}
+		return time.Time{}
 	}
-	return ev.FieldHandlers.ResolveProcessArgsFlags(ev, ev.BaseEvent.ProcessContext.Parent)
+	return ev.BaseEvent.ProcessContext.Process.ExecTime
 }
 
-// GetProcessParentArgsOptions returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentArgsOptions() []string {
+// GetProcessExitTime returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessExitTime() time.Time {
 	if ev.BaseEvent.ProcessContext == nil {
-		return []string{}
-	}
-	if ev.BaseEvent.ProcessContext.Parent == nil {
-		return []string{}
-	}
-	if !ev.BaseEvent.ProcessContext.HasParent() {
-		return []string{}
+		return time.Time{}
 	}
-	return ev.FieldHandlers.ResolveProcessArgsOptions(ev, ev.BaseEvent.ProcessContext.Parent)
+	return ev.BaseEvent.ProcessContext.Process.ExitTime
 }
 
-// GetProcessParentArgsScrubbed returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentArgsScrubbed() string {
+// GetProcessFileChangeTime returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessFileChangeTime() uint64 {
 	if ev.BaseEvent.ProcessContext == nil {
-		return ""
-	}
-	if ev.BaseEvent.ProcessContext.Parent == nil {
-		return ""
+		return uint64(0)
 	}
-	if !ev.BaseEvent.ProcessContext.HasParent() {
-		return ""
+	if !ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
+		return uint64(0)
 	}
-	return ev.FieldHandlers.ResolveProcessArgsScrubbed(ev, ev.BaseEvent.ProcessContext.Parent)
+	return ev.BaseEvent.ProcessContext.Process.FileEvent.FileFields.CTime
 }
 
-// GetProcessParentArgsTruncated returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentArgsTruncated() bool {
+// GetProcessFileFilesystem returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessFileFilesystem() string {
 	if ev.BaseEvent.ProcessContext == nil {
-		return false
-	}
-	if ev.BaseEvent.ProcessContext.Parent == nil {
-		return false
+		return ""
 	}
-	if !ev.BaseEvent.ProcessContext.HasParent() {
-		return false
+	if !ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
+		return ""
 	}
-	return ev.FieldHandlers.ResolveProcessArgsTruncated(ev, ev.BaseEvent.ProcessContext.Parent)
+	return ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.BaseEvent.ProcessContext.Process.FileEvent)
 }
 
-// GetProcessParentArgv returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentArgv() []string {
+// GetProcessFileGid returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessFileGid() uint32 {
 	if ev.BaseEvent.ProcessContext == nil {
-		return []string{}
-	}
-	if ev.BaseEvent.ProcessContext.Parent == nil {
-		return []string{}
+		return uint32(0)
 	}
-	if !ev.BaseEvent.ProcessContext.HasParent() {
-		return []string{}
+	if !ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
+		return uint32(0)
 	}
-	return ev.FieldHandlers.ResolveProcessArgv(ev, ev.BaseEvent.ProcessContext.Parent)
+	return ev.BaseEvent.ProcessContext.Process.FileEvent.FileFields.GID
 }
 
-// GetProcessParentArgv0 returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentArgv0() string {
+// GetProcessFileGroup returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessFileGroup() string {
 	if ev.BaseEvent.ProcessContext == nil {
 		return ""
 	}
-	if ev.BaseEvent.ProcessContext.Parent == nil {
-		return ""
-	}
-	if !ev.BaseEvent.ProcessContext.HasParent() {
+	if !ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
 		return ""
 	}
-	return ev.FieldHandlers.ResolveProcessArgv0(ev, ev.BaseEvent.ProcessContext.Parent)
+	return ev.FieldHandlers.ResolveFileFieldsGroup(ev, &ev.BaseEvent.ProcessContext.Process.FileEvent.FileFields)
 }
 
-// GetProcessParentArgvScrubbed returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentArgvScrubbed() []string {
+// GetProcessFileHashes returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessFileHashes() []string {
 	if ev.BaseEvent.ProcessContext == nil {
 		return []string{}
 	}
-	if ev.BaseEvent.ProcessContext.Parent == nil {
-		return []string{}
-	}
-	if !ev.BaseEvent.ProcessContext.HasParent() {
+	if !ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
 		return []string{}
 	}
-	return ev.FieldHandlers.ResolveProcessArgvScrubbed(ev, ev.BaseEvent.ProcessContext.Parent)
+	return ev.FieldHandlers.ResolveHashesFromEvent(ev, &ev.BaseEvent.ProcessContext.Process.FileEvent)
 }
 
-// GetProcessParentAuid returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentAuid() uint32 {
+// GetProcessFileInUpperLayer returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessFileInUpperLayer() bool {
 	if ev.BaseEvent.ProcessContext == nil {
-		return uint32(0)
-	}
-	if ev.BaseEvent.ProcessContext.Parent == nil {
-		return uint32(0)
+		return false
 	}
-	if !ev.BaseEvent.ProcessContext.HasParent() {
-		return uint32(0)
+	if !ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
+		return false
 	}
-	return ev.BaseEvent.ProcessContext.Parent.Credentials.AUID
+	return ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.BaseEvent.ProcessContext.Process.FileEvent.FileFields)
 }
 
-// GetProcessParentCapEffective returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentCapEffective() uint64 {
+// GetProcessFileInode returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessFileInode() uint64 {
 	if ev.BaseEvent.ProcessContext == nil {
 		return uint64(0)
 	}
-	if ev.BaseEvent.ProcessContext.Parent == nil {
-		return uint64(0)
-	}
-	if !ev.BaseEvent.ProcessContext.HasParent() {
+	if !ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
 		return uint64(0)
 	}
-	return ev.BaseEvent.ProcessContext.Parent.Credentials.CapEffective
+	return ev.BaseEvent.ProcessContext.Process.FileEvent.FileFields.PathKey.Inode
 }
 
-// GetProcessParentCapPermitted returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentCapPermitted() uint64 {
+// GetProcessFileLayerDigest returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessFileLayerDigest() string {
 	if ev.BaseEvent.ProcessContext == nil {
-		return uint64(0)
+		return ""
 	}
-	if ev.BaseEvent.ProcessContext.Parent == nil {
-		return uint64(0)
+	if !ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
+		return ""
 	}
-	if !ev.BaseEvent.ProcessContext.HasParent() {
-		return uint64(0)
+	return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.BaseEvent.ProcessContext.Process.FileEvent)
+}
+
+// GetProcessFileLayerPath returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessFileLayerPath() string {
+	if ev.BaseEvent.ProcessContext == nil {
+		return ""
 	}
-	return ev.BaseEvent.ProcessContext.Parent.Credentials.CapPermitted
+	if !ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.BaseEvent.ProcessContext.Process.FileEvent)
 }
 
-// GetProcessParentCgroupFileInode returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentCgroupFileInode() uint64 {
+// GetProcessFileMode returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessFileMode() uint16 {
 	if ev.BaseEvent.ProcessContext == nil {
-		return uint64(0)
+		return uint16(0)
 	}
-	if ev.BaseEvent.ProcessContext.Parent == nil {
+	if !ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
+		return uint16(0)
+	}
+	return ev.BaseEvent.ProcessContext.Process.FileEvent.FileFields.Mode
+}
+
+// GetProcessFileModificationTime returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessFileModificationTime() uint64 {
+	if ev.BaseEvent.ProcessContext == nil {
 		return uint64(0)
 	}
-	if !ev.BaseEvent.ProcessContext.HasParent() {
+	if !ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
 		return uint64(0)
 	}
-	return ev.BaseEvent.ProcessContext.Parent.CGroup.CGroupFile.Inode
+	return ev.BaseEvent.ProcessContext.Process.FileEvent.FileFields.MTime
 }
 
-// GetProcessParentCgroupFileMountId returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentCgroupFileMountId() uint32 {
+// GetProcessFileMountId returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessFileMountId() uint32 {
 	if ev.BaseEvent.ProcessContext == nil {
 		return uint32(0)
 	}
-	if ev.BaseEvent.ProcessContext.Parent == nil {
-		return uint32(0)
-	}
-	if !ev.BaseEvent.ProcessContext.HasParent() {
+	if !ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
 		return uint32(0)
 	}
-	return ev.BaseEvent.ProcessContext.Parent.CGroup.CGroupFile.MountID
+	return ev.BaseEvent.ProcessContext.Process.FileEvent.FileFields.PathKey.MountID
 }
 
-// GetProcessParentCgroupId returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentCgroupId() string {
+// GetProcessFileName returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessFileName() string {
 	if ev.BaseEvent.ProcessContext == nil {
 		return ""
 	}
-	if ev.BaseEvent.ProcessContext.Parent == nil {
-		return ""
-	}
-	if !ev.BaseEvent.ProcessContext.HasParent() {
+	if !ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
 		return ""
 	}
-	return ev.FieldHandlers.ResolveCGroupID(ev, &ev.BaseEvent.ProcessContext.Parent.CGroup)
+	return ev.FieldHandlers.ResolveFileBasename(ev, &ev.BaseEvent.ProcessContext.Process.FileEvent)
 }
 
-// GetProcessParentCgroupManager returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentCgroupManager() string {
+// GetProcessFileNameLength returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessFileNameLength() int {
 	if ev.BaseEvent.ProcessContext == nil {
-		return ""
+		return 0
 	}
-	if ev.BaseEvent.ProcessContext.Parent == nil {
+	return len(ev.FieldHandlers.ResolveFileBasename(ev, &ev.BaseEvent.ProcessContext.Process.FileEvent))
+}
+
+// GetProcessFilePackageName returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessFilePackageName() string {
+	if ev.BaseEvent.ProcessContext == nil {
 		return ""
 	}
-	if !ev.BaseEvent.ProcessContext.HasParent() {
+	if !ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
 		return ""
 	}
-	return ev.FieldHandlers.ResolveCGroupManager(ev, &ev.BaseEvent.ProcessContext.Parent.CGroup)
+	return ev.FieldHandlers.ResolvePackageName(ev, &ev.BaseEvent.ProcessContext.Process.FileEvent)
 }
 
-// GetProcessParentCmdargv returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentCmdargv() []string {
+// GetProcessFilePackageSourceVersion returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessFilePackageSourceVersion() string {
 	if ev.BaseEvent.ProcessContext == nil {
-		return []string{}
-	}
-	if ev.BaseEvent.ProcessContext.Parent == nil {
-		return []string{}
+		return ""
 	}
-	if !ev.BaseEvent.ProcessContext.HasParent() {
-		return []string{}
+	if !ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
+		return ""
 	}
-	return ev.FieldHandlers.ResolveProcessCmdArgv(ev, ev.BaseEvent.ProcessContext.Parent)
+	return ev.FieldHandlers.ResolvePackageSourceVersion(ev, &ev.BaseEvent.ProcessContext.Process.FileEvent)
 }
 
-// GetProcessParentComm returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentComm() string {
+// GetProcessFilePackageVersion returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessFilePackageVersion() string {
 	if ev.BaseEvent.ProcessContext == nil {
 		return ""
 	}
-	if ev.BaseEvent.ProcessContext.Parent == nil {
-		return ""
-	}
-	if !ev.BaseEvent.ProcessContext.HasParent() {
+	if !ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
 		return ""
 	}
-	return ev.BaseEvent.ProcessContext.Parent.Comm
+	return ev.FieldHandlers.ResolvePackageVersion(ev, &ev.BaseEvent.ProcessContext.Process.FileEvent)
 }
 
-// GetProcessParentContainerId returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentContainerId() string {
+// GetProcessFilePath returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessFilePath() string {
 	if ev.BaseEvent.ProcessContext == nil {
 		return ""
 	}
-	if ev.BaseEvent.ProcessContext.Parent == nil {
-		return ""
-	}
-	if !ev.BaseEvent.ProcessContext.HasParent() {
+	if !ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
 		return ""
 	}
-	return ev.FieldHandlers.ResolveProcessContainerID(ev, ev.BaseEvent.ProcessContext.Parent)
+	return ev.FieldHandlers.ResolveFilePath(ev, &ev.BaseEvent.ProcessContext.Process.FileEvent)
 }
 
-// GetProcessParentCreatedAt returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentCreatedAt() int {
+// GetProcessFilePathLength returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessFilePathLength() int {
 	if ev.BaseEvent.ProcessContext == nil {
 		return 0
 	}
-	if ev.BaseEvent.ProcessContext.Parent == nil {
+	return len(ev.FieldHandlers.ResolveFilePath(ev, &ev.BaseEvent.ProcessContext.Process.FileEvent))
+}
+
+// GetProcessFileRights returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessFileRights() int {
+	if ev.BaseEvent.ProcessContext == nil {
 		return 0
 	}
-	if !ev.BaseEvent.ProcessContext.HasParent() {
+	if !ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
 		return 0
 	}
-	return ev.FieldHandlers.ResolveProcessCreatedAt(ev, ev.BaseEvent.ProcessContext.Parent)
+	return ev.FieldHandlers.ResolveRights(ev, &ev.BaseEvent.ProcessContext.Process.FileEvent.FileFields)
 }
 
-// GetProcessParentEgid returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentEgid() uint32 {
+// GetProcessFileUid returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessFileUid() uint32 {
 	if ev.BaseEvent.ProcessContext == nil {
 		return uint32(0)
 	}
-	if ev.BaseEvent.ProcessContext.Parent == nil {
-		return uint32(0)
-	}
-	if !ev.BaseEvent.ProcessContext.HasParent() {
+	if !ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
 		return uint32(0)
 	}
-	return ev.BaseEvent.ProcessContext.Parent.Credentials.EGID
+	return ev.BaseEvent.ProcessContext.Process.FileEvent.FileFields.UID
 }
 
-// GetProcessParentEgroup returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentEgroup() string {
+// GetProcessFileUser returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessFileUser() string {
 	if ev.BaseEvent.ProcessContext == nil {
 		return ""
 	}
-	if ev.BaseEvent.ProcessContext.Parent == nil {
-		return ""
-	}
-	if !ev.BaseEvent.ProcessContext.HasParent() {
+	if !ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
 		return ""
 	}
-	return ev.BaseEvent.ProcessContext.Parent.Credentials.EGroup
+	return ev.FieldHandlers.ResolveFileFieldsUser(ev, &ev.BaseEvent.ProcessContext.Process.FileEvent.FileFields)
 }
 
-// GetProcessParentEnvp returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentEnvp() []string {
+// GetProcessForkTime returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessForkTime() time.Time {
 	if ev.BaseEvent.ProcessContext == nil {
-		return []string{}
-	}
-	if ev.BaseEvent.ProcessContext.Parent == nil {
-		return []string{}
-	}
-	if !ev.BaseEvent.ProcessContext.HasParent() {
-		return []string{}
+		return time.Time{}
 	}
-	return ev.FieldHandlers.ResolveProcessEnvp(ev, ev.BaseEvent.ProcessContext.Parent)
+	return ev.BaseEvent.ProcessContext.Process.ForkTime
 }
 
-// GetProcessParentEnvs returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentEnvs() []string {
+// GetProcessFsgid returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessFsgid() uint32 {
 	if ev.BaseEvent.ProcessContext == nil {
-		return []string{}
-	}
-	if ev.BaseEvent.ProcessContext.Parent == nil {
-		return []string{}
-	}
-	if !ev.BaseEvent.ProcessContext.HasParent() {
-		return []string{}
+		return uint32(0)
 	}
-	return ev.FieldHandlers.ResolveProcessEnvs(ev, ev.BaseEvent.ProcessContext.Parent)
+	return ev.BaseEvent.ProcessContext.Process.Credentials.FSGID
 }
 
-// GetProcessParentEnvsTruncated returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentEnvsTruncated() bool {
+// GetProcessFsgroup returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessFsgroup() string {
 	if ev.BaseEvent.ProcessContext == nil {
-		return false
-	}
-	if ev.BaseEvent.ProcessContext.Parent == nil {
-		return false
-	}
-	if !ev.BaseEvent.ProcessContext.HasParent() {
-		return false
+		return ""
 	}
-	return ev.FieldHandlers.ResolveProcessEnvsTruncated(ev, ev.BaseEvent.ProcessContext.Parent)
+	return ev.BaseEvent.ProcessContext.Process.Credentials.FSGroup
 }
 
-// GetProcessParentEuid returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentEuid() uint32 {
+// GetProcessFsuid returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessFsuid() uint32 {
 	if ev.BaseEvent.ProcessContext == nil {
 		return uint32(0)
 	}
-	if ev.BaseEvent.ProcessContext.Parent == nil {
-		return uint32(0)
+	return ev.BaseEvent.ProcessContext.Process.Credentials.FSUID
+}
+
+// GetProcessFsuser returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessFsuser() string {
+	if ev.BaseEvent.ProcessContext == nil {
+		return ""
 	}
-	if !ev.BaseEvent.ProcessContext.HasParent() {
+	return ev.BaseEvent.ProcessContext.Process.Credentials.FSUser
+}
+
+// GetProcessGid returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessGid() uint32 {
+	if ev.BaseEvent.ProcessContext == nil {
 		return uint32(0)
 	}
-	return ev.BaseEvent.ProcessContext.Parent.Credentials.EUID
+	return ev.BaseEvent.ProcessContext.Process.Credentials.GID
 }
 
-// GetProcessParentEuser returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentEuser() string {
+// GetProcessGroup returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessGroup() string {
 	if ev.BaseEvent.ProcessContext == nil {
 		return ""
 	}
-	if ev.BaseEvent.ProcessContext.Parent == nil {
-		return ""
-	}
-	if !ev.BaseEvent.ProcessContext.HasParent() {
+	return ev.BaseEvent.ProcessContext.Process.Credentials.Group
+}
+
+// GetProcessIdentity returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessIdentity() string {
+	if ev.BaseEvent.ProcessContext == nil {
 		return ""
 	}
-	return ev.BaseEvent.ProcessContext.Parent.Credentials.EUser
+	return ev.BaseEvent.ProcessContext.Process.Identity
 }
 
-// GetProcessParentFileChangeTime returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentFileChangeTime() uint64 {
+// GetProcessInterpreterFileChangeTime returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessInterpreterFileChangeTime() uint64 {
 	if ev.BaseEvent.ProcessContext == nil {
 		return uint64(0)
 	}
-	if ev.BaseEvent.ProcessContext.Parent == nil {
-		return uint64(0)
-	}
-	if !ev.BaseEvent.ProcessContext.HasParent() {
-		return uint64(0)
-	}
-	if !ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
+	if !ev.BaseEvent.ProcessContext.Process.HasInterpreter() {
 		return uint64(0)
 	}
-	return ev.BaseEvent.ProcessContext.Parent.FileEvent.FileFields.CTime
+	return ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent.FileFields.CTime
 }
 
-// GetProcessParentFileFilesystem returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentFileFilesystem() string {
+// GetProcessInterpreterFileFilesystem returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessInterpreterFileFilesystem() string {
 	if ev.BaseEvent.ProcessContext == nil {
 		return ""
 	}
-	if ev.BaseEvent.ProcessContext.Parent == nil {
-		return ""
-	}
-	if !ev.BaseEvent.ProcessContext.HasParent() {
-		return ""
-	}
-	if !ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
+	if !ev.BaseEvent.ProcessContext.Process.HasInterpreter() {
 		return ""
 	}
-	return ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent)
+	return ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent)
 }
 
-// GetProcessParentFileGid returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentFileGid() uint32 {
+// GetProcessInterpreterFileGid returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessInterpreterFileGid() uint32 {
 	if ev.BaseEvent.ProcessContext == nil {
 		return uint32(0)
 	}
-	if ev.BaseEvent.ProcessContext.Parent == nil {
-		return uint32(0)
-	}
-	if !ev.BaseEvent.ProcessContext.HasParent() {
-		return uint32(0)
-	}
-	if !ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
+	if !ev.BaseEvent.ProcessContext.Process.HasInterpreter() {
 		return uint32(0)
 	}
-	return ev.BaseEvent.ProcessContext.Parent.FileEvent.FileFields.GID
+	return ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent.FileFields.GID
 }
 
-// GetProcessParentFileGroup returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentFileGroup() string {
+// GetProcessInterpreterFileGroup returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessInterpreterFileGroup() string {
 	if ev.BaseEvent.ProcessContext == nil {
 		return ""
 	}
-	if ev.BaseEvent.ProcessContext.Parent == nil {
-		return ""
-	}
-	if !ev.BaseEvent.ProcessContext.HasParent() {
-		return ""
-	}
-	if !ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
+	if !ev.BaseEvent.ProcessContext.Process.HasInterpreter() {
 		return ""
 	}
-	return ev.FieldHandlers.ResolveFileFieldsGroup(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent.FileFields)
+	return ev.FieldHandlers.ResolveFileFieldsGroup(ev, &ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent.FileFields)
 }
 
-// GetProcessParentFileHashes returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentFileHashes() []string {
+// GetProcessInterpreterFileHashes returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessInterpreterFileHashes() []string {
 	if ev.BaseEvent.ProcessContext == nil {
 		return []string{}
 	}
-	if ev.BaseEvent.ProcessContext.Parent == nil {
-		return []string{}
-	}
-	if !ev.BaseEvent.ProcessContext.HasParent() {
-		return []string{}
-	}
-	if !ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
+	if !ev.BaseEvent.ProcessContext.Process.HasInterpreter() {
 		return []string{}
 	}
-	return ev.FieldHandlers.ResolveHashesFromEvent(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent)
+	return ev.FieldHandlers.ResolveHashesFromEvent(ev, &ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent)
 }
 
-// GetProcessParentFileInUpperLayer returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentFileInUpperLayer() bool {
+// GetProcessInterpreterFileInUpperLayer returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessInterpreterFileInUpperLayer() bool {
 	if ev.BaseEvent.ProcessContext == nil {
 		return false
 	}
-	if ev.BaseEvent.ProcessContext.Parent == nil {
-		return false
-	}
-	if !ev.BaseEvent.ProcessContext.HasParent() {
-		return false
-	}
-	if !ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
+	if !ev.BaseEvent.ProcessContext.Process.HasInterpreter() {
 		return false
 	}
-	return ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent.FileFields)
+	return ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent.FileFields)
 }
 
-// GetProcessParentFileInode returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentFileInode() uint64 {
+// GetProcessInterpreterFileInode returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessInterpreterFileInode() uint64 {
 	if ev.BaseEvent.ProcessContext == nil {
 		return uint64(0)
 	}
-	if ev.BaseEvent.ProcessContext.Parent == nil {
+	if !ev.BaseEvent.ProcessContext.Process.HasInterpreter() {
 		return uint64(0)
 	}
-	if !ev.BaseEvent.ProcessContext.HasParent() {
-		return uint64(0)
+	return ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent.FileFields.PathKey.Inode
+}
+
+// GetProcessInterpreterFileLayerDigest returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessInterpreterFileLayerDigest() string {
+	if ev.BaseEvent.ProcessContext == nil {
+		return ""
 	}
-	if !ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
-		return uint64(0)
+	if !ev.BaseEvent.ProcessContext.Process.HasInterpreter() {
+		return ""
 	}
-	return ev.BaseEvent.ProcessContext.Parent.FileEvent.FileFields.PathKey.Inode
+	return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent)
 }
 
-// GetProcessParentFileMode returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentFileMode() uint16 {
+// GetProcessInterpreterFileLayerPath returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessInterpreterFileLayerPath() string {
 	if ev.BaseEvent.ProcessContext == nil {
-		return uint16(0)
+		return ""
 	}
-	if ev.BaseEvent.ProcessContext.Parent == nil {
-		return uint16(0)
+	if !ev.BaseEvent.ProcessContext.Process.HasInterpreter() {
+		return ""
 	}
-	if !ev.BaseEvent.ProcessContext.HasParent() {
+	return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent)
+}
+
+// GetProcessInterpreterFileMode returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessInterpreterFileMode() uint16 {
+	if ev.BaseEvent.ProcessContext == nil {
 		return uint16(0)
 	}
-	if !ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
+	if !ev.BaseEvent.ProcessContext.Process.HasInterpreter() {
 		return uint16(0)
 	}
-	return ev.BaseEvent.ProcessContext.Parent.FileEvent.FileFields.Mode
+	return ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent.FileFields.Mode
 }
 
-// GetProcessParentFileModificationTime returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentFileModificationTime() uint64 {
+// GetProcessInterpreterFileModificationTime returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessInterpreterFileModificationTime() uint64 {
 	if ev.BaseEvent.ProcessContext == nil {
 		return uint64(0)
 	}
-	if ev.BaseEvent.ProcessContext.Parent == nil {
-		return uint64(0)
-	}
-	if !ev.BaseEvent.ProcessContext.HasParent() {
-		return uint64(0)
-	}
-	if !ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
+	if !ev.BaseEvent.ProcessContext.Process.HasInterpreter() {
 		return uint64(0)
 	}
-	return ev.BaseEvent.ProcessContext.Parent.FileEvent.FileFields.MTime
+	return ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent.FileFields.MTime
 }
 
-// GetProcessParentFileMountId returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentFileMountId() uint32 {
+// GetProcessInterpreterFileMountId returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessInterpreterFileMountId() uint32 {
 	if ev.BaseEvent.ProcessContext == nil {
 		return uint32(0)
 	}
-	if ev.BaseEvent.ProcessContext.Parent == nil {
-		return uint32(0)
-	}
-	if !ev.BaseEvent.ProcessContext.HasParent() {
-		return uint32(0)
-	}
-	if !ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
+	if !ev.BaseEvent.ProcessContext.Process.HasInterpreter() {
 		return uint32(0)
 	}
-	return ev.BaseEvent.ProcessContext.Parent.FileEvent.FileFields.PathKey.MountID
+	return ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent.FileFields.PathKey.MountID
 }
 
-// GetProcessParentFileName returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentFileName() string {
+// GetProcessInterpreterFileName returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessInterpreterFileName() string {
 	if ev.BaseEvent.ProcessContext == nil {
 		return ""
 	}
-	if ev.BaseEvent.ProcessContext.Parent == nil {
-		return ""
-	}
-	if !ev.BaseEvent.ProcessContext.HasParent() {
-		return ""
-	}
-	if !ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
+	if !ev.BaseEvent.ProcessContext.Process.HasInterpreter() {
 		return ""
 	}
-	return ev.FieldHandlers.ResolveFileBasename(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent)
+	return ev.FieldHandlers.ResolveFileBasename(ev, &ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent)
 }
 
-// GetProcessParentFileNameLength returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentFileNameLength() int {
+// GetProcessInterpreterFileNameLength returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessInterpreterFileNameLength() int {
 	if ev.BaseEvent.ProcessContext == nil {
 		return 0
 	}
-	if ev.BaseEvent.ProcessContext.Parent == nil {
-		return 0
-	}
-	return len(ev.FieldHandlers.ResolveFileBasename(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent))
+	return len(ev.FieldHandlers.ResolveFileBasename(ev, &ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent))
 }
 
-// GetProcessParentFilePackageName returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentFilePackageName() string {
+// GetProcessInterpreterFilePackageName returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessInterpreterFilePackageName() string {
 	if ev.BaseEvent.ProcessContext == nil {
 		return ""
 	}
-	if ev.BaseEvent.ProcessContext.Parent == nil {
+	if !ev.BaseEvent.ProcessContext.Process.HasInterpreter() {
 		return ""
 	}
-	if !ev.BaseEvent.ProcessContext.HasParent() {
+	return ev.FieldHandlers.ResolvePackageName(ev, &ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent)
+}
+
+// GetProcessInterpreterFilePackageSourceVersion returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessInterpreterFilePackageSourceVersion() string {
+	if ev.BaseEvent.ProcessContext == nil {
 		return ""
 	}
-	if !ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
+	if !ev.BaseEvent.ProcessContext.Process.HasInterpreter() {
 		return ""
 	}
-	return ev.FieldHandlers.ResolvePackageName(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent)
+	return ev.FieldHandlers.ResolvePackageSourceVersion(ev, &ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent)
 }
 
-// GetProcessParentFilePackageSourceVersion returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentFilePackageSourceVersion() string {
+// GetProcessInterpreterFilePackageVersion returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessInterpreterFilePackageVersion() string {
 	if ev.BaseEvent.ProcessContext == nil {
 		return ""
 	}
-	if ev.BaseEvent.ProcessContext.Parent == nil {
+	if !ev.BaseEvent.ProcessContext.Process.HasInterpreter() {
 		return ""
 	}
-	if !ev.BaseEvent.ProcessContext.HasParent() {
+	return ev.FieldHandlers.ResolvePackageVersion(ev, &ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent)
+}
+
+// GetProcessInterpreterFilePath returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessInterpreterFilePath() string {
+	if ev.BaseEvent.ProcessContext == nil {
 		return ""
 	}
-	if !ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
+	if !ev.BaseEvent.ProcessContext.Process.HasInterpreter() {
 		return ""
 	}
-	return ev.FieldHandlers.ResolvePackageSourceVersion(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent)
+	return ev.FieldHandlers.ResolveFilePath(ev, &ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent)
 }
 
-// GetProcessParentFilePackageVersion returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentFilePackageVersion() string {
+// GetProcessInterpreterFilePathLength returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessInterpreterFilePathLength() int {
 	if ev.BaseEvent.ProcessContext == nil {
-		return ""
-	}
-	if ev.BaseEvent.ProcessContext.Parent == nil {
-		return ""
+		return 0
 	}
-	if !ev.BaseEvent.ProcessContext.HasParent() {
-		return ""
+	return len(ev.FieldHandlers.ResolveFilePath(ev, &ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent))
+}
+
+// GetProcessInterpreterFileRights returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessInterpreterFileRights() int {
+	if ev.BaseEvent.ProcessContext == nil {
+		return 0
 	}
-	if !ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
-		return ""
+	if !ev.BaseEvent.ProcessContext.Process.HasInterpreter() {
+		return 0
 	}
-	return ev.FieldHandlers.ResolvePackageVersion(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent)
+	return ev.FieldHandlers.ResolveRights(ev, &ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent.FileFields)
 }
 
-// GetProcessParentFilePath returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentFilePath() string {
+// GetProcessInterpreterFileUid returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessInterpreterFileUid() uint32 {
 	if ev.BaseEvent.ProcessContext == nil {
-		return ""
+		return uint32(0)
 	}
-	if ev.BaseEvent.ProcessContext.Parent == nil {
-		return ""
+	if !ev.BaseEvent.ProcessContext.Process.HasInterpreter() {
+		return uint32(0)
 	}
-	if !ev.BaseEvent.ProcessContext.HasParent() {
+	return ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent.FileFields.UID
+}
+
+// GetProcessInterpreterFileUser returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessInterpreterFileUser() string {
+	if ev.BaseEvent.ProcessContext == nil {
 		return ""
 	}
-	if !ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
+	if !ev.BaseEvent.ProcessContext.Process.HasInterpreter() {
 		return ""
 	}
-	return ev.FieldHandlers.ResolveFilePath(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent)
+	return ev.FieldHandlers.ResolveFileFieldsUser(ev, &ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent.FileFields)
 }
 
-// GetProcessParentFilePathLength returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentFilePathLength() int {
+// GetProcessIsExec returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessIsExec() bool {
 	if ev.BaseEvent.ProcessContext == nil {
-		return 0
+		return false
 	}
-	if ev.BaseEvent.ProcessContext.Parent == nil {
-		return 0
+	return ev.BaseEvent.ProcessContext.Process.IsExec
+}
+
+// GetProcessIsKworker returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessIsKworker() bool {
+	if ev.BaseEvent.ProcessContext == nil {
+		return false
 	}
-	return len(ev.FieldHandlers.ResolveFilePath(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent))
+	return ev.BaseEvent.ProcessContext.Process.PIDContext.IsKworker
 }
 
-// GetProcessParentFileRights returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentFileRights() int {
+// GetProcessIsThread returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessIsThread() bool {
+	if ev.BaseEvent.ProcessContext == nil {
+		return false
+	}
+	return ev.FieldHandlers.ResolveProcessIsThread(ev, &ev.BaseEvent.ProcessContext.Process)
+}
+
+// GetProcessNsMnt returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessNsMnt() int {
 	if ev.BaseEvent.ProcessContext == nil {
 		return 0
 	}
-	if ev.BaseEvent.ProcessContext.Parent == nil {
+	return ev.FieldHandlers.ResolveProcessMountNamespace(ev, &ev.BaseEvent.ProcessContext.Process)
+}
+
+// GetProcessNsPid returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessNsPid() int {
+	if ev.BaseEvent.ProcessContext == nil {
 		return 0
 	}
-	if !ev.BaseEvent.ProcessContext.HasParent() {
+	return ev.FieldHandlers.ResolveProcessPIDNamespace(ev, &ev.BaseEvent.ProcessContext.Process)
+}
+
+// GetProcessNsUser returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessNsUser() int {
+	if ev.BaseEvent.ProcessContext == nil {
 		return 0
 	}
-	if !ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
+	return ev.FieldHandlers.ResolveProcessUserNamespace(ev, &ev.BaseEvent.ProcessContext.Process)
+}
+
+// GetProcessNsUts returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessNsUts() int {
+	if ev.BaseEvent.ProcessContext == nil {
 		return 0
 	}
-	return ev.FieldHandlers.ResolveRights(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent.FileFields)
+	return ev.FieldHandlers.ResolveProcessUTSNamespace(ev, &ev.BaseEvent.ProcessContext.Process)
 }
 
-// GetProcessParentFileUid returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentFileUid() uint32 {
+// GetProcessParentArgs returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentArgs() string {
 	if ev.BaseEvent.ProcessContext == nil {
-		return uint32(0)
+		return ""
 	}
 	if ev.BaseEvent.ProcessContext.Parent == nil {
-		return uint32(0)
+		return ""
 	}
 	if !ev.BaseEvent.ProcessContext.HasParent() {
-		return uint32(0)
-	}
-	if !ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
-		return uint32(0)
+		return ""
 	}
-	return ev.BaseEvent.ProcessContext.Parent.FileEvent.FileFields.UID
+	return ev.FieldHandlers.ResolveProcessArgs(ev, ev.BaseEvent.ProcessContext.Parent)
 }
 
-// GetProcessParentFileUser returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentFileUser() string {
+// GetProcessParentArgsFlags returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentArgsFlags() []string {
 	if ev.BaseEvent.ProcessContext == nil {
-		return ""
+		return []string{}
 	}
 	if ev.BaseEvent.ProcessContext.Parent == nil {
-		return ""
+		return []string{}
 	}
 	if !ev.BaseEvent.ProcessContext.HasParent() {
-		return ""
-	}
-	if !ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
-		return ""
+		return []string{}
 	}
-	return ev.FieldHandlers.ResolveFileFieldsUser(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent.FileFields)
+	return ev.FieldHandlers.ResolveProcessArgsFlags(ev, ev.BaseEvent.ProcessContext.Parent)
 }
 
-// GetProcessParentFsgid returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentFsgid() uint32 {
+// GetProcessParentArgsOptions returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentArgsOptions() []string {
 	if ev.BaseEvent.ProcessContext == nil {
-		return uint32(0)
+		return []string{}
 	}
 	if ev.BaseEvent.ProcessContext.Parent == nil {
-		return uint32(0)
+		return []string{}
 	}
 	if !ev.BaseEvent.ProcessContext.HasParent() {
-		return uint32(0)
+		return []string{}
 	}
-	return ev.BaseEvent.ProcessContext.Parent.Credentials.FSGID
+	return ev.FieldHandlers.ResolveProcessArgsOptions(ev, ev.BaseEvent.ProcessContext.Parent)
 }
 
-// GetProcessParentFsgroup returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentFsgroup() string {
+// GetProcessParentArgsScrubbed returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentArgsScrubbed() string {
 	if ev.BaseEvent.ProcessContext == nil {
 		return ""
 	}
@@ -8166,25 +9086,39 @@ func (ev *Event) GetProcessParentFsgroup() string {
 	if !ev.BaseEvent.ProcessContext.HasParent() {
 		return ""
 	}
-	return ev.BaseEvent.ProcessContext.Parent.Credentials.FSGroup
+	return ev.FieldHandlers.ResolveProcessArgsScrubbed(ev, ev.BaseEvent.ProcessContext.Parent)
 }
 
-// GetProcessParentFsuid returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentFsuid() uint32 {
+// GetProcessParentArgsTruncated returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentArgsTruncated() bool {
 	if ev.BaseEvent.ProcessContext == nil {
-		return uint32(0)
+		return false
 	}
 	if ev.BaseEvent.ProcessContext.Parent == nil {
-		return uint32(0)
+		return false
 	}
 	if !ev.BaseEvent.ProcessContext.HasParent() {
-		return uint32(0)
+		return false
 	}
-	return ev.BaseEvent.ProcessContext.Parent.Credentials.FSUID
+	return ev.FieldHandlers.ResolveProcessArgsTruncated(ev, ev.BaseEvent.ProcessContext.Parent)
 }
 
-// GetProcessParentFsuser returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentFsuser() string {
+// GetProcessParentArgv returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentArgv() []string {
+	if ev.BaseEvent.ProcessContext == nil {
+		return []string{}
+	}
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return []string{}
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return []string{}
+	}
+	return ev.FieldHandlers.ResolveProcessArgv(ev, ev.BaseEvent.ProcessContext.Parent)
+}
+
+// GetProcessParentArgv0 returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentArgv0() string {
 	if ev.BaseEvent.ProcessContext == nil {
 		return ""
 	}
@@ -8194,39 +9128,39 @@ func (ev *Event) GetProcessParentFsuser() string {
 	if !ev.BaseEvent.ProcessContext.HasParent() {
 		return ""
 	}
-	return ev.BaseEvent.ProcessContext.Parent.Credentials.FSUser
+	return ev.FieldHandlers.ResolveProcessArgv0(ev, ev.BaseEvent.ProcessContext.Parent)
 }
 
-// GetProcessParentGid returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentGid() uint32 {
+// GetProcessParentArgvScrubbed returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentArgvScrubbed() []string {
 	if ev.BaseEvent.ProcessContext == nil {
-		return uint32(0)
+		return []string{}
 	}
 	if ev.BaseEvent.ProcessContext.Parent == nil {
-		return uint32(0)
+		return []string{}
 	}
 	if !ev.BaseEvent.ProcessContext.HasParent() {
-		return uint32(0)
+		return []string{}
 	}
-	return ev.BaseEvent.ProcessContext.Parent.Credentials.GID
+	return ev.FieldHandlers.ResolveProcessArgvScrubbed(ev, ev.BaseEvent.ProcessContext.Parent)
 }
 
-// GetProcessParentGroup returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentGroup() string {
+// GetProcessParentAuid returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentAuid() uint32 {
 	if ev.BaseEvent.ProcessContext == nil {
-		return ""
+		return uint32(0)
 	}
 	if ev.BaseEvent.ProcessContext.Parent == nil {
-		return ""
+		return uint32(0)
 	}
 	if !ev.BaseEvent.ProcessContext.HasParent() {
-		return ""
+		return uint32(0)
 	}
-	return ev.BaseEvent.ProcessContext.Parent.Credentials.Group
+	return ev.BaseEvent.ProcessContext.Parent.Credentials.AUID
 }
 
-// GetProcessParentInterpreterFileChangeTime returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentInterpreterFileChangeTime() uint64 {
+// GetProcessParentCapEffective returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentCapEffective() uint64 {
 	if ev.BaseEvent.ProcessContext == nil {
 		return uint64(0)
 	}
@@ -8236,246 +9170,235 @@ func (ev *Event) GetProcessParentInterpreterFileChangeTime() uint64 {
 	if !ev.BaseEvent.ProcessContext.HasParent() {
 		return uint64(0)
 	}
-	if !ev.BaseEvent.ProcessContext.Parent.HasInterpreter() {
-		return uint64(0)
-	}
-	return ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent.FileFields.CTime
+	return ev.BaseEvent.ProcessContext.Parent.Credentials.CapEffective
 }
 
-// GetProcessParentInterpreterFileFilesystem returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentInterpreterFileFilesystem() string {
+// GetProcessParentCapPermitted returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentCapPermitted() uint64 {
 	if ev.BaseEvent.ProcessContext == nil {
-		return ""
+		return uint64(0)
 	}
 	if ev.BaseEvent.ProcessContext.Parent == nil {
-		return ""
+		return uint64(0)
 	}
 	if !ev.BaseEvent.ProcessContext.HasParent() {
-		return ""
-	}
-	if !ev.BaseEvent.ProcessContext.Parent.HasInterpreter() {
-		return ""
+		return uint64(0)
 	}
-	return ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent)
+	return ev.BaseEvent.ProcessContext.Parent.Credentials.CapPermitted
 }
 
-// GetProcessParentInterpreterFileGid returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentInterpreterFileGid() uint32 {
+// GetProcessParentCgroupAncestors returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentCgroupAncestors() []string {
 	if ev.BaseEvent.ProcessContext == nil {
-		return uint32(0)
+		return []string{}
 	}
 	if ev.BaseEvent.ProcessContext.Parent == nil {
-		return uint32(0)
+		return []string{}
 	}
 	if !ev.BaseEvent.ProcessContext.HasParent() {
-		return uint32(0)
-	}
-	if !ev.BaseEvent.ProcessContext.Parent.HasInterpreter() {
-		return uint32(0)
+		return []string{}
 	}
-	return ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent.FileFields.GID
+	return ev.FieldHandlers.ResolveCGroupAncestors(ev, &ev.BaseEvent.ProcessContext.Parent.CGroup)
 }
 
-// GetProcessParentInterpreterFileGroup returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentInterpreterFileGroup() string {
+// GetProcessParentCgroupControllers returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentCgroupControllers() []string {
 	if ev.BaseEvent.ProcessContext == nil {
-		return ""
+		return []string{}
 	}
 	if ev.BaseEvent.ProcessContext.Parent == nil {
-		return ""
+		return []string{}
 	}
 	if !ev.BaseEvent.ProcessContext.HasParent() {
-		return ""
-	}
-	if !ev.BaseEvent.ProcessContext.Parent.HasInterpreter() {
-		return ""
+		return []string{}
 	}
-	return ev.FieldHandlers.ResolveFileFieldsGroup(ev, &ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent.FileFields)
+	return ev.FieldHandlers.ResolveCGroupControllers(ev, &ev.BaseEvent.ProcessContext.Parent.CGroup)
 }
 
-// GetProcessParentInterpreterFileHashes returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentInterpreterFileHashes() []string {
+// GetProcessParentCgroupCpuUsage returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentCgroupCpuUsage() int {
 	if ev.BaseEvent.ProcessContext == nil {
-		return []string{}
+		return 0
 	}
 	if ev.BaseEvent.ProcessContext.Parent == nil {
-		return []string{}
+		return 0
 	}
 	if !ev.BaseEvent.ProcessContext.HasParent() {
-		return []string{}
-	}
-	if !ev.BaseEvent.ProcessContext.Parent.HasInterpreter() {
-		return []string{}
+		return 0
 	}
-	return ev.FieldHandlers.ResolveHashesFromEvent(ev, &ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent)
+	return ev.FieldHandlers.ResolveCGroupCPUUsage(ev, &ev.BaseEvent.ProcessContext.Parent.CGroup)
 }
 
-// GetProcessParentInterpreterFileInUpperLayer returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentInterpreterFileInUpperLayer() bool {
+// GetProcessParentCgroupFileInode returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentCgroupFileInode() uint64 {
 	if ev.BaseEvent.ProcessContext == nil {
-		return false
+		return uint64(0)
 	}
 	if ev.BaseEvent.ProcessContext.Parent == nil {
-		return false
+		return uint64(0)
 	}
 	if !ev.BaseEvent.ProcessContext.HasParent() {
-		return false
-	}
-	if !ev.BaseEvent.ProcessContext.Parent.HasInterpreter() {
-		return false
+		return uint64(0)
 	}
-	return ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent.FileFields)
+	return ev.BaseEvent.ProcessContext.Parent.CGroup.CGroupFile.Inode
 }
 
-// GetProcessParentInterpreterFileInode returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentInterpreterFileInode() uint64 {
+// GetProcessParentCgroupFileMountId returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentCgroupFileMountId() uint32 {
 	if ev.BaseEvent.ProcessContext == nil {
-		return uint64(0)
+		return uint32(0)
 	}
 	if ev.BaseEvent.ProcessContext.Parent == nil {
-		return uint64(0)
+		return uint32(0)
 	}
 	if !ev.BaseEvent.ProcessContext.HasParent() {
-		return uint64(0)
-	}
-	if !ev.BaseEvent.ProcessContext.Parent.HasInterpreter() {
-		return uint64(0)
+		return uint32(0)
 	}
-	return ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent.FileFields.PathKey.Inode
+	return ev.BaseEvent.ProcessContext.Parent.CGroup.CGroupFile.MountID
 }
 
-// GetProcessParentInterpreterFileMode returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentInterpreterFileMode() uint16 {
+// GetProcessParentCgroupId returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentCgroupId() string {
 	if ev.BaseEvent.ProcessContext == nil {
-		return uint16(0)
+		return ""
 	}
 	if ev.BaseEvent.ProcessContext.Parent == nil {
-		return uint16(0)
+		return ""
 	}
 	if !ev.BaseEvent.ProcessContext.HasParent() {
-		return uint16(0)
-	}
-	if !ev.BaseEvent.ProcessContext.Parent.HasInterpreter() {
-		return uint16(0)
+		return ""
 	}
-	return ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent.FileFields.Mode
+	return ev.FieldHandlers.ResolveCGroupID(ev, &ev.BaseEvent.ProcessContext.Parent.CGroup)
 }
 
-// GetProcessParentInterpreterFileModificationTime returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentInterpreterFileModificationTime() uint64 {
+// GetProcessParentCgroupManager returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentCgroupManager() string {
 	if ev.BaseEvent.ProcessContext == nil {
-		return uint64(0)
+		return ""
 	}
 	if ev.BaseEvent.ProcessContext.Parent == nil {
-		return uint64(0)
+		return ""
 	}
 	if !ev.BaseEvent.ProcessContext.HasParent() {
-		return uint64(0)
-	}
-	if !ev.BaseEvent.ProcessContext.Parent.HasInterpreter() {
-		return uint64(0)
+		return ""
 	}
-	return ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent.FileFields.MTime
+	return ev.FieldHandlers.ResolveCGroupManager(ev, &ev.BaseEvent.ProcessContext.Parent.CGroup)
 }
 
-// GetProcessParentInterpreterFileMountId returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentInterpreterFileMountId() uint32 {
+// GetProcessParentCgroupMemoryCurrent returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentCgroupMemoryCurrent() int {
 	if ev.BaseEvent.ProcessContext == nil {
-		return uint32(0)
+		return 0
 	}
 	if ev.BaseEvent.ProcessContext.Parent == nil {
-		return uint32(0)
+		return 0
 	}
 	if !ev.BaseEvent.ProcessContext.HasParent() {
-		return uint32(0)
-	}
-	if !ev.BaseEvent.ProcessContext.Parent.HasInterpreter() {
-		return uint32(0)
+		return 0
 	}
-	return ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent.FileFields.PathKey.MountID
+	return ev.FieldHandlers.ResolveCGroupMemoryCurrent(ev, &ev.BaseEvent.ProcessContext.Parent.CGroup)
 }
 
-// GetProcessParentInterpreterFileName returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentInterpreterFileName() string {
+// GetProcessParentCgroupMemoryHighExceeded returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentCgroupMemoryHighExceeded() bool {
 	if ev.BaseEvent.ProcessContext == nil {
-		return ""
+		return false
 	}
 	if ev.BaseEvent.ProcessContext.Parent == nil {
-		return ""
+		return false
 	}
 	if !ev.BaseEvent.ProcessContext.HasParent() {
-		return ""
-	}
-	if !ev.BaseEvent.ProcessContext.Parent.HasInterpreter() {
-		return ""
+		return false
 	}
-	return ev.FieldHandlers.ResolveFileBasename(ev, &ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent)
+	return ev.FieldHandlers.ResolveCGroupMemoryHighExceeded(ev, &ev.BaseEvent.ProcessContext.Parent.CGroup)
 }
 
-// GetProcessParentInterpreterFileNameLength returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentInterpreterFileNameLength() int {
+// GetProcessParentCgroupPidsCurrent returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentCgroupPidsCurrent() int {
 	if ev.BaseEvent.ProcessContext == nil {
 		return 0
 	}
 	if ev.BaseEvent.ProcessContext.Parent == nil {
 		return 0
 	}
-	return len(ev.FieldHandlers.ResolveFileBasename(ev, &ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent))
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return 0
+	}
+	return ev.FieldHandlers.ResolveCGroupPidsCurrent(ev, &ev.BaseEvent.ProcessContext.Parent.CGroup)
 }
 
-// GetProcessParentInterpreterFilePackageName returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentInterpreterFilePackageName() string {
+// GetProcessParentCloudCredentialsProviders returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentCloudCredentialsProviders() []string {
 	if ev.BaseEvent.ProcessContext == nil {
-		return ""
+		return []string{}
 	}
 	if ev.BaseEvent.ProcessContext.Parent == nil {
-		return ""
+		return []string{}
 	}
 	if !ev.BaseEvent.ProcessContext.HasParent() {
-		return ""
-	}
-	if !ev.BaseEvent.ProcessContext.Parent.HasInterpreter() {
-		return ""
+		return []string{}
 	}
-	return ev.FieldHandlers.ResolvePackageName(ev, &ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent)
+	return ev.FieldHandlers.ResolveProcessCloudCredentialsProviders(ev, ev.BaseEvent.ProcessContext.Parent)
 }
 
-// GetProcessParentInterpreterFilePackageSourceVersion returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentInterpreterFilePackageSourceVersion() string {
+// GetProcessParentCloudCredentialsResources returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentCloudCredentialsResources() []string {
 	if ev.BaseEvent.ProcessContext == nil {
-		return ""
+		return []string{}
 	}
 	if ev.BaseEvent.ProcessContext.Parent == nil {
-		return ""
+		return []string{}
 	}
 	if !ev.BaseEvent.ProcessContext.HasParent() {
-		return ""
+		return []string{}
 	}
-	if !ev.BaseEvent.ProcessContext.Parent.HasInterpreter() {
-		return ""
+	return ev.FieldHandlers.ResolveProcessCloudCredentialsResources(ev, ev.BaseEvent.ProcessContext.Parent)
+}
+
+// GetProcessParentCloudCredentialsTypes returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentCloudCredentialsTypes() []string {
+	if ev.BaseEvent.ProcessContext == nil {
+		return []string{}
 	}
-	return ev.FieldHandlers.ResolvePackageSourceVersion(ev, &ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent)
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return []string{}
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return []string{}
+	}
+	return ev.FieldHandlers.ResolveProcessCloudCredentialsTypes(ev, ev.BaseEvent.ProcessContext.Parent)
 }
 
-// GetProcessParentInterpreterFilePackageVersion returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentInterpreterFilePackageVersion() string {
+// GetProcessParentCmdargv returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentCmdargv() []string {
 	if ev.BaseEvent.ProcessContext == nil {
-		return ""
+		return []string{}
 	}
 	if ev.BaseEvent.ProcessContext.Parent == nil {
-		return ""
+		return []string{}
 	}
 	if !ev.BaseEvent.ProcessContext.HasParent() {
-		return ""
+		return []string{}
 	}
-	if !ev.BaseEvent.ProcessContext.Parent.HasInterpreter() {
-		return ""
+	return ev.FieldHandlers.ResolveProcessCmdArgv(ev, ev.BaseEvent.ProcessContext.Parent)
+}
+
+// GetProcessParentCmdlineObfuscationScore returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentCmdlineObfuscationScore() int {
+	if ev.BaseEvent.ProcessContext == nil {
+		return 0
 	}
-	return ev.FieldHandlers.ResolvePackageVersion(ev, &ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent)
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return 0
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return 0
+	}
+	return ev.FieldHandlers.ResolveProcessCmdLineObfuscationScore(ev, ev.BaseEvent.ProcessContext.Parent)
 }
 
-// GetProcessParentInterpreterFilePath returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentInterpreterFilePath() string {
+// GetProcessParentComm returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentComm() string {
 	if ev.BaseEvent.ProcessContext == nil {
 		return ""
 	}
@@ -8485,25 +9408,25 @@ func (ev *Event) GetProcessParentInterpreterFilePath() string {
 	if !ev.BaseEvent.ProcessContext.HasParent() {
 		return ""
 	}
-	if !ev.BaseEvent.ProcessContext.Parent.HasInterpreter() {
-		return ""
-	}
-	return ev.FieldHandlers.ResolveFilePath(ev, &ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent)
+	return ev.BaseEvent.ProcessContext.Parent.Comm
 }
 
-// GetProcessParentInterpreterFilePathLength returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentInterpreterFilePathLength() int {
+// GetProcessParentContainerId returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentContainerId() string {
 	if ev.BaseEvent.ProcessContext == nil {
-		return 0
+		return ""
 	}
 	if ev.BaseEvent.ProcessContext.Parent == nil {
-		return 0
+		return ""
 	}
-	return len(ev.FieldHandlers.ResolveFilePath(ev, &ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent))
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveProcessContainerID(ev, ev.BaseEvent.ProcessContext.Parent)
 }
 
-// GetProcessParentInterpreterFileRights returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentInterpreterFileRights() int {
+// GetProcessParentCreatedAt returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentCreatedAt() int {
 	if ev.BaseEvent.ProcessContext == nil {
 		return 0
 	}
@@ -8513,14 +9436,11 @@ func (ev *Event) GetProcessParentInterpreterFileRights() int {
 	if !ev.BaseEvent.ProcessContext.HasParent() {
 		return 0
 	}
-	if !ev.BaseEvent.ProcessContext.Parent.HasInterpreter() {
-		return 0
-	}
-	return ev.FieldHandlers.ResolveRights(ev, &ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent.FileFields)
+	return ev.FieldHandlers.ResolveProcessCreatedAt(ev, ev.BaseEvent.ProcessContext.Parent)
 }
 
-// GetProcessParentInterpreterFileUid returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentInterpreterFileUid() uint32 {
+// GetProcessParentEgid returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentEgid() uint32 {
 	if ev.BaseEvent.ProcessContext == nil {
 		return uint32(0)
 	}
@@ -8530,14 +9450,11 @@ func (ev *Event) GetProcessParentInterpreterFileUid() uint32 {
 	if !ev.BaseEvent.ProcessContext.HasParent() {
 		return uint32(0)
 	}
-	if !ev.BaseEvent.ProcessContext.Parent.HasInterpreter() {
-		return uint32(0)
-	}
-	return ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent.FileFields.UID
+	return ev.BaseEvent.ProcessContext.Parent.Credentials.EGID
 }
 
-// GetProcessParentInterpreterFileUser returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentInterpreterFileUser() string {
+// GetProcessParentEgroup returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentEgroup() string {
 	if ev.BaseEvent.ProcessContext == nil {
 		return ""
 	}
@@ -8547,28 +9464,39 @@ func (ev *Event) GetProcessParentInterpreterFileUser() string {
 	if !ev.BaseEvent.ProcessContext.HasParent() {
 		return ""
 	}
-	if !ev.BaseEvent.ProcessContext.Parent.HasInterpreter() {
+	return ev.BaseEvent.ProcessContext.Parent.Credentials.EGroup
+}
+
+// GetProcessParentElfBuildId returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentElfBuildId() string {
+	if ev.BaseEvent.ProcessContext == nil {
 		return ""
 	}
-	return ev.FieldHandlers.ResolveFileFieldsUser(ev, &ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent.FileFields)
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return ""
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveProcessELFBuildID(ev, ev.BaseEvent.ProcessContext.Parent)
 }
 
-// GetProcessParentIsExec returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentIsExec() bool {
+// GetProcessParentElfInterpreter returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentElfInterpreter() string {
 	if ev.BaseEvent.ProcessContext == nil {
-		return false
+		return ""
 	}
 	if ev.BaseEvent.ProcessContext.Parent == nil {
-		return false
+		return ""
 	}
 	if !ev.BaseEvent.ProcessContext.HasParent() {
-		return false
+		return ""
 	}
-	return ev.BaseEvent.ProcessContext.Parent.IsExec
+	return ev.FieldHandlers.ResolveProcessELFInterpreter(ev, ev.BaseEvent.ProcessContext.Parent)
 }
 
-// GetProcessParentIsKworker returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentIsKworker() bool {
+// GetProcessParentElfIsStatic returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentElfIsStatic() bool {
 	if ev.BaseEvent.ProcessContext == nil {
 		return false
 	}
@@ -8578,11 +9506,11 @@ func (ev *Event) GetProcessParentIsKworker() bool {
 	if !ev.BaseEvent.ProcessContext.HasParent() {
 		return false
 	}
-	return ev.BaseEvent.ProcessContext.Parent.PIDContext.IsKworker
+	return ev.FieldHandlers.ResolveProcessELFIsStatic(ev, ev.BaseEvent.ProcessContext.Parent)
 }
 
-// GetProcessParentIsThread returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentIsThread() bool {
+// GetProcessParentElfIsStripped returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentElfIsStripped() bool {
 	if ev.BaseEvent.ProcessContext == nil {
 		return false
 	}
@@ -8592,39 +9520,53 @@ func (ev *Event) GetProcessParentIsThread() bool {
 	if !ev.BaseEvent.ProcessContext.HasParent() {
 		return false
 	}
-	return ev.FieldHandlers.ResolveProcessIsThread(ev, ev.BaseEvent.ProcessContext.Parent)
+	return ev.FieldHandlers.ResolveProcessELFIsStripped(ev, ev.BaseEvent.ProcessContext.Parent)
 }
 
-// GetProcessParentPid returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentPid() uint32 {
+// GetProcessParentEnvp returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentEnvp() []string {
 	if ev.BaseEvent.ProcessContext == nil {
-		return uint32(0)
+		return []string{}
 	}
 	if ev.BaseEvent.ProcessContext.Parent == nil {
-		return uint32(0)
+		return []string{}
 	}
 	if !ev.BaseEvent.ProcessContext.HasParent() {
-		return uint32(0)
+		return []string{}
 	}
-	return ev.BaseEvent.ProcessContext.Parent.PIDContext.Pid
+	return ev.FieldHandlers.ResolveProcessEnvp(ev, ev.BaseEvent.ProcessContext.Parent)
 }
 
-// GetProcessParentPpid returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentPpid() uint32 {
+// GetProcessParentEnvs returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentEnvs() []string {
 	if ev.BaseEvent.ProcessContext == nil {
-		return uint32(0)
+		return []string{}
 	}
 	if ev.BaseEvent.ProcessContext.Parent == nil {
-		return uint32(0)
+		return []string{}
 	}
 	if !ev.BaseEvent.ProcessContext.HasParent() {
-		return uint32(0)
+		return []string{}
 	}
-	return ev.BaseEvent.ProcessContext.Parent.PPid
+	return ev.FieldHandlers.ResolveProcessEnvs(ev, ev.BaseEvent.ProcessContext.Parent)
 }
 
-// GetProcessParentTid returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentTid() uint32 {
+// GetProcessParentEnvsTruncated returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentEnvsTruncated() bool {
+	if ev.BaseEvent.ProcessContext == nil {
+		return false
+	}
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return false
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return false
+	}
+	return ev.FieldHandlers.ResolveProcessEnvsTruncated(ev, ev.BaseEvent.ProcessContext.Parent)
+}
+
+// GetProcessParentEuid returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentEuid() uint32 {
 	if ev.BaseEvent.ProcessContext == nil {
 		return uint32(0)
 	}
@@ -8634,11 +9576,11 @@ func (ev *Event) GetProcessParentTid() uint32 {
 	if !ev.BaseEvent.ProcessContext.HasParent() {
 		return uint32(0)
 	}
-	return ev.BaseEvent.ProcessContext.Parent.PIDContext.Tid
+	return ev.BaseEvent.ProcessContext.Parent.Credentials.EUID
 }
 
-// GetProcessParentTtyName returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentTtyName() string {
+// GetProcessParentEuser returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentEuser() string {
 	if ev.BaseEvent.ProcessContext == nil {
 		return ""
 	}
@@ -8648,11 +9590,45 @@ func (ev *Event) GetProcessParentTtyName() string {
 	if !ev.BaseEvent.ProcessContext.HasParent() {
 		return ""
 	}
-	return ev.BaseEvent.ProcessContext.Parent.TTYName
+	return ev.BaseEvent.ProcessContext.Parent.Credentials.EUser
 }
 
-// GetProcessParentUid returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentUid() uint32 {
+// GetProcessParentFileChangeTime returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentFileChangeTime() uint64 {
+	if ev.BaseEvent.ProcessContext == nil {
+		return uint64(0)
+	}
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return uint64(0)
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return uint64(0)
+	}
+	if !ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
+		return uint64(0)
+	}
+	return ev.BaseEvent.ProcessContext.Parent.FileEvent.FileFields.CTime
+}
+
+// GetProcessParentFileFilesystem returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentFileFilesystem() string {
+	if ev.BaseEvent.ProcessContext == nil {
+		return ""
+	}
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return ""
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return ""
+	}
+	if !ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent)
+}
+
+// GetProcessParentFileGid returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentFileGid() uint32 {
 	if ev.BaseEvent.ProcessContext == nil {
 		return uint32(0)
 	}
@@ -8662,11 +9638,14 @@ func (ev *Event) GetProcessParentUid() uint32 {
 	if !ev.BaseEvent.ProcessContext.HasParent() {
 		return uint32(0)
 	}
-	return ev.BaseEvent.ProcessContext.Parent.Credentials.UID
+	if !ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
+		return uint32(0)
+	}
+	return ev.BaseEvent.ProcessContext.Parent.FileEvent.FileFields.GID
 }
 
-// GetProcessParentUser returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentUser() string {
+// GetProcessParentFileGroup returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentFileGroup() string {
 	if ev.BaseEvent.ProcessContext == nil {
 		return ""
 	}
@@ -8676,11 +9655,14 @@ func (ev *Event) GetProcessParentUser() string {
 	if !ev.BaseEvent.ProcessContext.HasParent() {
 		return ""
 	}
-	return ev.BaseEvent.ProcessContext.Parent.Credentials.User
+	if !ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileFieldsGroup(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent.FileFields)
 }
 
-// GetProcessParentUserSessionK8sGroups returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentUserSessionK8sGroups() []string {
+// GetProcessParentFileHashes returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentFileHashes() []string {
 	if ev.BaseEvent.ProcessContext == nil {
 		return []string{}
 	}
@@ -8690,11 +9672,48 @@ func (ev *Event) GetProcessParentUserSessionK8sGroups() []string {
 	if !ev.BaseEvent.ProcessContext.HasParent() {
 		return []string{}
 	}
-	return ev.FieldHandlers.ResolveK8SGroups(ev, &ev.BaseEvent.ProcessContext.Parent.UserSession)
+	if !ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
+		return []string{}
+	}
+	return ev.FieldHandlers.ResolveHashesFromEvent(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent)
 }
 
-// GetProcessParentUserSessionK8sUid returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentUserSessionK8sUid() string {
+// GetProcessParentFileInUpperLayer returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentFileInUpperLayer() bool {
+	if ev.BaseEvent.ProcessContext == nil {
+		return false
+	}
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return false
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return false
+	}
+	if !ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
+		return false
+	}
+	return ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent.FileFields)
+}
+
+// GetProcessParentFileInode returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentFileInode() uint64 {
+	if ev.BaseEvent.ProcessContext == nil {
+		return uint64(0)
+	}
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return uint64(0)
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return uint64(0)
+	}
+	if !ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
+		return uint64(0)
+	}
+	return ev.BaseEvent.ProcessContext.Parent.FileEvent.FileFields.PathKey.Inode
+}
+
+// GetProcessParentFileLayerDigest returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentFileLayerDigest() string {
 	if ev.BaseEvent.ProcessContext == nil {
 		return ""
 	}
@@ -8704,11 +9723,14 @@ func (ev *Event) GetProcessParentUserSessionK8sUid() string {
 	if !ev.BaseEvent.ProcessContext.HasParent() {
 		return ""
 	}
-	return ev.FieldHandlers.ResolveK8SUID(ev, &ev.BaseEvent.ProcessContext.Parent.UserSession)
+	if !ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent)
 }
 
-// GetProcessParentUserSessionK8sUsername returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessParentUserSessionK8sUsername() string {
+// GetProcessParentFileLayerPath returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentFileLayerPath() string {
 	if ev.BaseEvent.ProcessContext == nil {
 		return ""
 	}
@@ -8718,99 +9740,1313 @@ func (ev *Event) GetProcessParentUserSessionK8sUsername() string {
 	if !ev.BaseEvent.ProcessContext.HasParent() {
 		return ""
 	}
-	return ev.FieldHandlers.ResolveK8SUsername(ev, &ev.BaseEvent.ProcessContext.Parent.UserSession)
+	if !ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent)
 }
 
-// GetProcessPid returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessPid() uint32 {
+// GetProcessParentFileMode returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentFileMode() uint16 {
 	if ev.BaseEvent.ProcessContext == nil {
-		return uint32(0)
+		return uint16(0)
 	}
-	return ev.BaseEvent.ProcessContext.Process.PIDContext.Pid
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return uint16(0)
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return uint16(0)
+	}
+	if !ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
+		return uint16(0)
+	}
+	return ev.BaseEvent.ProcessContext.Parent.FileEvent.FileFields.Mode
 }
 
-// GetProcessPpid returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessPpid() uint32 {
+// GetProcessParentFileModificationTime returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentFileModificationTime() uint64 {
 	if ev.BaseEvent.ProcessContext == nil {
-		return uint32(0)
+		return uint64(0)
 	}
-	return ev.BaseEvent.ProcessContext.Process.PPid
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return uint64(0)
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return uint64(0)
+	}
+	if !ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
+		return uint64(0)
+	}
+	return ev.BaseEvent.ProcessContext.Parent.FileEvent.FileFields.MTime
 }
 
-// GetProcessTid returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessTid() uint32 {
+// GetProcessParentFileMountId returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentFileMountId() uint32 {
 	if ev.BaseEvent.ProcessContext == nil {
 		return uint32(0)
 	}
-	return ev.BaseEvent.ProcessContext.Process.PIDContext.Tid
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return uint32(0)
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return uint32(0)
+	}
+	if !ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
+		return uint32(0)
+	}
+	return ev.BaseEvent.ProcessContext.Parent.FileEvent.FileFields.PathKey.MountID
 }
 
-// GetProcessTtyName returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessTtyName() string {
+// GetProcessParentFileName returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentFileName() string {
 	if ev.BaseEvent.ProcessContext == nil {
 		return ""
 	}
-	return ev.BaseEvent.ProcessContext.Process.TTYName
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return ""
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return ""
+	}
+	if !ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileBasename(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent)
 }
 
-// GetProcessUid returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessUid() uint32 {
+// GetProcessParentFileNameLength returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentFileNameLength() int {
 	if ev.BaseEvent.ProcessContext == nil {
-		return uint32(0)
+		return 0
 	}
-	return ev.BaseEvent.ProcessContext.Process.Credentials.UID
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return 0
+	}
+	return len(ev.FieldHandlers.ResolveFileBasename(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent))
 }
 
-// GetProcessUser returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessUser() string {
+// GetProcessParentFilePackageName returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentFilePackageName() string {
 	if ev.BaseEvent.ProcessContext == nil {
 		return ""
 	}
-	return ev.BaseEvent.ProcessContext.Process.Credentials.User
-}
-
-// GetProcessUserSessionK8sGroups returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessUserSessionK8sGroups() []string {
-	if ev.BaseEvent.ProcessContext == nil {
-		return []string{}
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return ""
 	}
-	return ev.FieldHandlers.ResolveK8SGroups(ev, &ev.BaseEvent.ProcessContext.Process.UserSession)
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return ""
+	}
+	if !ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
+		return ""
+	}
+	return ev.FieldHandlers.ResolvePackageName(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent)
 }
 
-// GetProcessUserSessionK8sUid returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessUserSessionK8sUid() string {
+// GetProcessParentFilePackageSourceVersion returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentFilePackageSourceVersion() string {
 	if ev.BaseEvent.ProcessContext == nil {
 		return ""
 	}
-	return ev.FieldHandlers.ResolveK8SUID(ev, &ev.BaseEvent.ProcessContext.Process.UserSession)
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return ""
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return ""
+	}
+	if !ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
+		return ""
+	}
+	return ev.FieldHandlers.ResolvePackageSourceVersion(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent)
 }
 
-// GetProcessUserSessionK8sUsername returns the value of the field, resolving if necessary
-func (ev *Event) GetProcessUserSessionK8sUsername() string {
+// GetProcessParentFilePackageVersion returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentFilePackageVersion() string {
 	if ev.BaseEvent.ProcessContext == nil {
 		return ""
 	}
-	return ev.FieldHandlers.ResolveK8SUsername(ev, &ev.BaseEvent.ProcessContext.Process.UserSession)
-}
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return ""
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return ""
+	}
+	if !ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
+		return ""
+	}
+	return ev.FieldHandlers.ResolvePackageVersion(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent)
+}
+
+// GetProcessParentFilePath returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentFilePath() string {
+	if ev.BaseEvent.ProcessContext == nil {
+		return ""
+	}
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return ""
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return ""
+	}
+	if !ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFilePath(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent)
+}
+
+// GetProcessParentFilePathLength returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentFilePathLength() int {
+	if ev.BaseEvent.ProcessContext == nil {
+		return 0
+	}
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return 0
+	}
+	return len(ev.FieldHandlers.ResolveFilePath(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent))
+}
+
+// GetProcessParentFileRights returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentFileRights() int {
+	if ev.BaseEvent.ProcessContext == nil {
+		return 0
+	}
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return 0
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return 0
+	}
+	if !ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
+		return 0
+	}
+	return ev.FieldHandlers.ResolveRights(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent.FileFields)
+}
+
+// GetProcessParentFileUid returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentFileUid() uint32 {
+	if ev.BaseEvent.ProcessContext == nil {
+		return uint32(0)
+	}
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return uint32(0)
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return uint32(0)
+	}
+	if !ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
+		return uint32(0)
+	}
+	return ev.BaseEvent.ProcessContext.Parent.FileEvent.FileFields.UID
+}
+
+// GetProcessParentFileUser returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentFileUser() string {
+	if ev.BaseEvent.ProcessContext == nil {
+		return ""
+	}
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return ""
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return ""
+	}
+	if !ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileFieldsUser(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent.FileFields)
+}
+
+// GetProcessParentFsgid returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentFsgid() uint32 {
+	if ev.BaseEvent.ProcessContext == nil {
+		return uint32(0)
+	}
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return uint32(0)
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return uint32(0)
+	}
+	return ev.BaseEvent.ProcessContext.Parent.Credentials.FSGID
+}
+
+// GetProcessParentFsgroup returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentFsgroup() string {
+	if ev.BaseEvent.ProcessContext == nil {
+		return ""
+	}
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return ""
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return ""
+	}
+	return ev.BaseEvent.ProcessContext.Parent.Credentials.FSGroup
+}
+
+// GetProcessParentFsuid returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentFsuid() uint32 {
+	if ev.BaseEvent.ProcessContext == nil {
+		return uint32(0)
+	}
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return uint32(0)
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return uint32(0)
+	}
+	return ev.BaseEvent.ProcessContext.Parent.Credentials.FSUID
+}
+
+// GetProcessParentFsuser returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentFsuser() string {
+	if ev.BaseEvent.ProcessContext == nil {
+		return ""
+	}
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return ""
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return ""
+	}
+	return ev.BaseEvent.ProcessContext.Parent.Credentials.FSUser
+}
+
+// GetProcessParentGid returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentGid() uint32 {
+	if ev.BaseEvent.ProcessContext == nil {
+		return uint32(0)
+	}
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return uint32(0)
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return uint32(0)
+	}
+	return ev.BaseEvent.ProcessContext.Parent.Credentials.GID
+}
+
+// GetProcessParentGroup returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentGroup() string {
+	if ev.BaseEvent.ProcessContext == nil {
+		return ""
+	}
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return ""
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return ""
+	}
+	return ev.BaseEvent.ProcessContext.Parent.Credentials.Group
+}
+
+// GetProcessParentInterpreterFileChangeTime returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentInterpreterFileChangeTime() uint64 {
+	if ev.BaseEvent.ProcessContext == nil {
+		return uint64(0)
+	}
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return uint64(0)
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return uint64(0)
+	}
+	if !ev.BaseEvent.ProcessContext.Parent.HasInterpreter() {
+		return uint64(0)
+	}
+	return ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent.FileFields.CTime
+}
+
+// GetProcessParentInterpreterFileFilesystem returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentInterpreterFileFilesystem() string {
+	if ev.BaseEvent.ProcessContext == nil {
+		return ""
+	}
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return ""
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return ""
+	}
+	if !ev.BaseEvent.ProcessContext.Parent.HasInterpreter() {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent)
+}
+
+// GetProcessParentInterpreterFileGid returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentInterpreterFileGid() uint32 {
+	if ev.BaseEvent.ProcessContext == nil {
+		return uint32(0)
+	}
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return uint32(0)
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return uint32(0)
+	}
+	if !ev.BaseEvent.ProcessContext.Parent.HasInterpreter() {
+		return uint32(0)
+	}
+	return ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent.FileFields.GID
+}
+
+// GetProcessParentInterpreterFileGroup returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentInterpreterFileGroup() string {
+	if ev.BaseEvent.ProcessContext == nil {
+		return ""
+	}
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return ""
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return ""
+	}
+	if !ev.BaseEvent.ProcessContext.Parent.HasInterpreter() {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileFieldsGroup(ev, &ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent.FileFields)
+}
+
+// GetProcessParentInterpreterFileHashes returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentInterpreterFileHashes() []string {
+	if ev.BaseEvent.ProcessContext == nil {
+		return []string{}
+	}
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return []string{}
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return []string{}
+	}
+	if !ev.BaseEvent.ProcessContext.Parent.HasInterpreter() {
+		return []string{}
+	}
+	return ev.FieldHandlers.ResolveHashesFromEvent(ev, &ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent)
+}
+
+// GetProcessParentInterpreterFileInUpperLayer returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentInterpreterFileInUpperLayer() bool {
+	if ev.BaseEvent.ProcessContext == nil {
+		return false
+	}
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return false
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return false
+	}
+	if !ev.BaseEvent.ProcessContext.Parent.HasInterpreter() {
+		return false
+	}
+	return ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent.FileFields)
+}
+
+// GetProcessParentInterpreterFileInode returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentInterpreterFileInode() uint64 {
+	if ev.BaseEvent.ProcessContext == nil {
+		return uint64(0)
+	}
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return uint64(0)
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return uint64(0)
+	}
+	if !ev.BaseEvent.ProcessContext.Parent.HasInterpreter() {
+		return uint64(0)
+	}
+	return ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent.FileFields.PathKey.Inode
+}
+
+// GetProcessParentInterpreterFileLayerDigest returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentInterpreterFileLayerDigest() string {
+	if ev.BaseEvent.ProcessContext == nil {
+		return ""
+	}
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return ""
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return ""
+	}
+	if !ev.BaseEvent.ProcessContext.Parent.HasInterpreter() {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent)
+}
+
+// GetProcessParentInterpreterFileLayerPath returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentInterpreterFileLayerPath() string {
+	if ev.BaseEvent.ProcessContext == nil {
+		return ""
+	}
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return ""
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return ""
+	}
+	if !ev.BaseEvent.ProcessContext.Parent.HasInterpreter() {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent)
+}
+
+// GetProcessParentInterpreterFileMode returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentInterpreterFileMode() uint16 {
+	if ev.BaseEvent.ProcessContext == nil {
+		return uint16(0)
+	}
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return uint16(0)
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return uint16(0)
+	}
+	if !ev.BaseEvent.ProcessContext.Parent.HasInterpreter() {
+		return uint16(0)
+	}
+	return ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent.FileFields.Mode
+}
+
+// GetProcessParentInterpreterFileModificationTime returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentInterpreterFileModificationTime() uint64 {
+	if ev.BaseEvent.ProcessContext == nil {
+		return uint64(0)
+	}
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return uint64(0)
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return uint64(0)
+	}
+	if !ev.BaseEvent.ProcessContext.Parent.HasInterpreter() {
+		return uint64(0)
+	}
+	return ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent.FileFields.MTime
+}
+
+// GetProcessParentInterpreterFileMountId returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentInterpreterFileMountId() uint32 {
+	if ev.BaseEvent.ProcessContext == nil {
+		return uint32(0)
+	}
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return uint32(0)
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return uint32(0)
+	}
+	if !ev.BaseEvent.ProcessContext.Parent.HasInterpreter() {
+		return uint32(0)
+	}
+	return ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent.FileFields.PathKey.MountID
+}
+
+// GetProcessParentInterpreterFileName returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentInterpreterFileName() string {
+	if ev.BaseEvent.ProcessContext == nil {
+		return ""
+	}
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return ""
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return ""
+	}
+	if !ev.BaseEvent.ProcessContext.Parent.HasInterpreter() {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileBasename(ev, &ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent)
+}
+
+// GetProcessParentInterpreterFileNameLength returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentInterpreterFileNameLength() int {
+	if ev.BaseEvent.ProcessContext == nil {
+		return 0
+	}
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return 0
+	}
+	return len(ev.FieldHandlers.ResolveFileBasename(ev, &ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent))
+}
+
+// GetProcessParentInterpreterFilePackageName returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentInterpreterFilePackageName() string {
+	if ev.BaseEvent.ProcessContext == nil {
+		return ""
+	}
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return ""
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return ""
+	}
+	if !ev.BaseEvent.ProcessContext.Parent.HasInterpreter() {
+		return ""
+	}
+	return ev.FieldHandlers.ResolvePackageName(ev, &ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent)
+}
+
+// GetProcessParentInterpreterFilePackageSourceVersion returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentInterpreterFilePackageSourceVersion() string {
+	if ev.BaseEvent.ProcessContext == nil {
+		return ""
+	}
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return ""
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return ""
+	}
+	if !ev.BaseEvent.ProcessContext.Parent.HasInterpreter() {
+		return ""
+	}
+	return ev.FieldHandlers.ResolvePackageSourceVersion(ev, &ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent)
+}
+
+// GetProcessParentInterpreterFilePackageVersion returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentInterpreterFilePackageVersion() string {
+	if ev.BaseEvent.ProcessContext == nil {
+		return ""
+	}
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return ""
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return ""
+	}
+	if !ev.BaseEvent.ProcessContext.Parent.HasInterpreter() {
+		return ""
+	}
+	return ev.FieldHandlers.ResolvePackageVersion(ev, &ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent)
+}
+
+// GetProcessParentInterpreterFilePath returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentInterpreterFilePath() string {
+	if ev.BaseEvent.ProcessContext == nil {
+		return ""
+	}
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return ""
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return ""
+	}
+	if !ev.BaseEvent.ProcessContext.Parent.HasInterpreter() {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFilePath(ev, &ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent)
+}
+
+// GetProcessParentInterpreterFilePathLength returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentInterpreterFilePathLength() int {
+	if ev.BaseEvent.ProcessContext == nil {
+		return 0
+	}
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return 0
+	}
+	return len(ev.FieldHandlers.ResolveFilePath(ev, &ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent))
+}
+
+// GetProcessParentInterpreterFileRights returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentInterpreterFileRights() int {
+	if ev.BaseEvent.ProcessContext == nil {
+		return 0
+	}
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return 0
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return 0
+	}
+	if !ev.BaseEvent.ProcessContext.Parent.HasInterpreter() {
+		return 0
+	}
+	return ev.FieldHandlers.ResolveRights(ev, &ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent.FileFields)
+}
+
+// GetProcessParentInterpreterFileUid returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentInterpreterFileUid() uint32 {
+	if ev.BaseEvent.ProcessContext == nil {
+		return uint32(0)
+	}
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return uint32(0)
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return uint32(0)
+	}
+	if !ev.BaseEvent.ProcessContext.Parent.HasInterpreter() {
+		return uint32(0)
+	}
+	return ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent.FileFields.UID
+}
+
+// GetProcessParentInterpreterFileUser returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentInterpreterFileUser() string {
+	if ev.BaseEvent.ProcessContext == nil {
+		return ""
+	}
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return ""
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return ""
+	}
+	if !ev.BaseEvent.ProcessContext.Parent.HasInterpreter() {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileFieldsUser(ev, &ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent.FileFields)
+}
+
+// GetProcessParentIsExec returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentIsExec() bool {
+	if ev.BaseEvent.ProcessContext == nil {
+		return false
+	}
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return false
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return false
+	}
+	return ev.BaseEvent.ProcessContext.Parent.IsExec
+}
+
+// GetProcessParentIsKworker returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentIsKworker() bool {
+	if ev.BaseEvent.ProcessContext == nil {
+		return false
+	}
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return false
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return false
+	}
+	return ev.BaseEvent.ProcessContext.Parent.PIDContext.IsKworker
+}
+
+// GetProcessParentIsThread returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentIsThread() bool {
+	if ev.BaseEvent.ProcessContext == nil {
+		return false
+	}
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return false
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return false
+	}
+	return ev.FieldHandlers.ResolveProcessIsThread(ev, ev.BaseEvent.ProcessContext.Parent)
+}
+
+// GetProcessParentNsMnt returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentNsMnt() int {
+	if ev.BaseEvent.ProcessContext == nil {
+		return 0
+	}
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return 0
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return 0
+	}
+	return ev.FieldHandlers.ResolveProcessMountNamespace(ev, ev.BaseEvent.ProcessContext.Parent)
+}
+
+// GetProcessParentNsPid returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentNsPid() int {
+	if ev.BaseEvent.ProcessContext == nil {
+		return 0
+	}
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return 0
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return 0
+	}
+	return ev.FieldHandlers.ResolveProcessPIDNamespace(ev, ev.BaseEvent.ProcessContext.Parent)
+}
+
+// GetProcessParentNsUser returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentNsUser() int {
+	if ev.BaseEvent.ProcessContext == nil {
+		return 0
+	}
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return 0
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return 0
+	}
+	return ev.FieldHandlers.ResolveProcessUserNamespace(ev, ev.BaseEvent.ProcessContext.Parent)
+}
+
+// GetProcessParentNsUts returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentNsUts() int {
+	if ev.BaseEvent.ProcessContext == nil {
+		return 0
+	}
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return 0
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return 0
+	}
+	return ev.FieldHandlers.ResolveProcessUTSNamespace(ev, ev.BaseEvent.ProcessContext.Parent)
+}
+
+// GetProcessParentPid returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentPid() uint32 {
+	if ev.BaseEvent.ProcessContext == nil {
+		return uint32(0)
+	}
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return uint32(0)
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return uint32(0)
+	}
+	return ev.BaseEvent.ProcessContext.Parent.PIDContext.Pid
+}
+
+// GetProcessParentPpid returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentPpid() uint32 {
+	if ev.BaseEvent.ProcessContext == nil {
+		return uint32(0)
+	}
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return uint32(0)
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return uint32(0)
+	}
+	return ev.BaseEvent.ProcessContext.Parent.PPid
+}
+
+// GetProcessParentSystemdSlice returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentSystemdSlice() string {
+	if ev.BaseEvent.ProcessContext == nil {
+		return ""
+	}
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return ""
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveProcessSystemdSlice(ev, ev.BaseEvent.ProcessContext.Parent)
+}
+
+// GetProcessParentSystemdUnit returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentSystemdUnit() string {
+	if ev.BaseEvent.ProcessContext == nil {
+		return ""
+	}
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return ""
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveProcessSystemdUnit(ev, ev.BaseEvent.ProcessContext.Parent)
+}
+
+// GetProcessParentTid returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentTid() uint32 {
+	if ev.BaseEvent.ProcessContext == nil {
+		return uint32(0)
+	}
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return uint32(0)
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return uint32(0)
+	}
+	return ev.BaseEvent.ProcessContext.Parent.PIDContext.Tid
+}
+
+// GetProcessParentTtyName returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentTtyName() string {
+	if ev.BaseEvent.ProcessContext == nil {
+		return ""
+	}
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return ""
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return ""
+	}
+	return ev.BaseEvent.ProcessContext.Parent.TTYName
+}
+
+// GetProcessParentUid returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentUid() uint32 {
+	if ev.BaseEvent.ProcessContext == nil {
+		return uint32(0)
+	}
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return uint32(0)
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return uint32(0)
+	}
+	return ev.BaseEvent.ProcessContext.Parent.Credentials.UID
+}
+
+// GetProcessParentUser returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentUser() string {
+	if ev.BaseEvent.ProcessContext == nil {
+		return ""
+	}
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return ""
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return ""
+	}
+	return ev.BaseEvent.ProcessContext.Parent.Credentials.User
+}
+
+// GetProcessParentUserSessionK8sGroups returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentUserSessionK8sGroups() []string {
+	if ev.BaseEvent.ProcessContext == nil {
+		return []string{}
+	}
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return []string{}
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return []string{}
+	}
+	return ev.FieldHandlers.ResolveK8SGroups(ev, &ev.BaseEvent.ProcessContext.Parent.UserSession)
+}
+
+// GetProcessParentUserSessionK8sUid returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentUserSessionK8sUid() string {
+	if ev.BaseEvent.ProcessContext == nil {
+		return ""
+	}
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return ""
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveK8SUID(ev, &ev.BaseEvent.ProcessContext.Parent.UserSession)
+}
+
+// GetProcessParentUserSessionK8sUsername returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessParentUserSessionK8sUsername() string {
+	if ev.BaseEvent.ProcessContext == nil {
+		return ""
+	}
+	if ev.BaseEvent.ProcessContext.Parent == nil {
+		return ""
+	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveK8SUsername(ev, &ev.BaseEvent.ProcessContext.Parent.UserSession)
+}
+
+// GetProcessPid returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessPid() uint32 {
+	if ev.BaseEvent.ProcessContext == nil {
+		return uint32(0)
+	}
+	return ev.BaseEvent.ProcessContext.Process.PIDContext.Pid
+}
+
+// GetProcessPpid returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessPpid() uint32 {
+	if ev.BaseEvent.ProcessContext == nil {
+		return uint32(0)
+	}
+	return ev.BaseEvent.ProcessContext.Process.PPid
+}
+
+// GetProcessSystemdSlice returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessSystemdSlice() string {
+	if ev.BaseEvent.ProcessContext == nil {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveProcessSystemdSlice(ev, &ev.BaseEvent.ProcessContext.Process)
+}
+
+// GetProcessSystemdUnit returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessSystemdUnit() string {
+	if ev.BaseEvent.ProcessContext == nil {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveProcessSystemdUnit(ev, &ev.BaseEvent.ProcessContext.Process)
+}
+
+// GetProcessTid returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessTid() uint32 {
+	if ev.BaseEvent.ProcessContext == nil {
+		return uint32(0)
+	}
+	return ev.BaseEvent.ProcessContext.Process.PIDContext.Tid
+}
+
+// GetProcessTtyName returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessTtyName() string {
+	if ev.BaseEvent.ProcessContext == nil {
+		return ""
+	}
+	return ev.BaseEvent.ProcessContext.Process.TTYName
+}
+
+// GetProcessUid returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessUid() uint32 {
+	if ev.BaseEvent.ProcessContext == nil {
+		return uint32(0)
+	}
+	return ev.BaseEvent.ProcessContext.Process.Credentials.UID
+}
+
+// GetProcessUser returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessUser() string {
+	if ev.BaseEvent.ProcessContext == nil {
+		return ""
+	}
+	return ev.BaseEvent.ProcessContext.Process.Credentials.User
+}
+
+// GetProcessUserSessionK8sGroups returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessUserSessionK8sGroups() []string {
+	if ev.BaseEvent.ProcessContext == nil {
+		return []string{}
+	}
+	return ev.FieldHandlers.ResolveK8SGroups(ev, &ev.BaseEvent.ProcessContext.Process.UserSession)
+}
+
+// GetProcessUserSessionK8sUid returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessUserSessionK8sUid() string {
+	if ev.BaseEvent.ProcessContext == nil {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveK8SUID(ev, &ev.BaseEvent.ProcessContext.Process.UserSession)
+}
+
+// GetProcessUserSessionK8sUsername returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessUserSessionK8sUsername() string {
+	if ev.BaseEvent.ProcessContext == nil {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveK8SUsername(ev, &ev.BaseEvent.ProcessContext.Process.UserSession)
+}
+
+// GetPtraceRequest returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceRequest() uint32 {
+	if ev.GetEventType().String() != "ptrace" {
+		return uint32(0)
+	}
+	return ev.PTrace.Request
+}
+
+// GetPtraceRetval returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceRetval() int64 {
+	if ev.GetEventType().String() != "ptrace" {
+		return int64(0)
+	}
+	return ev.PTrace.SyscallEvent.Retval
+}
+
+// GetPtraceTraceeAncestorsArgs returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeAncestorsArgs() []string {
+	if ev.GetEventType().String() != "ptrace" {
+		return []string{}
+	}
+	if ev.PTrace.Tracee == nil {
+		return []string{}
+	}
+	if ev.PTrace.Tracee.Ancestor == nil {
+		return []string{}
+	}
+	var values []string
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := ev.FieldHandlers.ResolveProcessArgs(ev, &element.ProcessContext.Process)
+		values = append(values, result)
+		ptr = iterator.Next()
+	}
+	return values
+}
+
+// GetPtraceTraceeAncestorsArgsFlags returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeAncestorsArgsFlags() []string {
+	if ev.GetEventType().String() != "ptrace" {
+		return []string{}
+	}
+	if ev.PTrace.Tracee == nil {
+		return []string{}
+	}
+	if ev.PTrace.Tracee.Ancestor == nil {
+		return []string{}
+	}
+	var values []string
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := ev.FieldHandlers.ResolveProcessArgsFlags(ev, &element.ProcessContext.Process)
+		values = append(values, result...)
+		ptr = iterator.Next()
+	}
+	return values
+}
+
+// GetPtraceTraceeAncestorsArgsOptions returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeAncestorsArgsOptions() []string {
+	if ev.GetEventType().String() != "ptrace" {
+		return []string{}
+	}
+	if ev.PTrace.Tracee == nil {
+		return []string{}
+	}
+	if ev.PTrace.Tracee.Ancestor == nil {
+		return []string{}
+	}
+	var values []string
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := ev.FieldHandlers.ResolveProcessArgsOptions(ev, &element.ProcessContext.Process)
+		values = append(values, result...)
+		ptr = iterator.Next()
+	}
+	return values
+}
+
+// GetPtraceTraceeAncestorsArgsScrubbed returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeAncestorsArgsScrubbed() []string {
+	if ev.GetEventType().String() != "ptrace" {
+		return []string{}
+	}
+	if ev.PTrace.Tracee == nil {
+		return []string{}
+	}
+	if ev.PTrace.Tracee.Ancestor == nil {
+		return []string{}
+	}
+	var values []string
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := ev.FieldHandlers.ResolveProcessArgsScrubbed(ev, &element.ProcessContext.Process)
+		values = append(values, result)
+		ptr = iterator.Next()
+	}
+	return values
+}
+
+// GetPtraceTraceeAncestorsArgsTruncated returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeAncestorsArgsTruncated() []bool {
+	if ev.GetEventType().String() != "ptrace" {
+		return []bool{}
+	}
+	if ev.PTrace.Tracee == nil {
+		return []bool{}
+	}
+	if ev.PTrace.Tracee.Ancestor == nil {
+		return []bool{}
+	}
+	var values []bool
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := ev.FieldHandlers.ResolveProcessArgsTruncated(ev, &element.ProcessContext.Process)
+		values = append(values, result)
+		ptr = iterator.Next()
+	}
+	return values
+}
 
-// GetPtraceRequest returns the value of the field, resolving if necessary
-func (ev *Event) GetPtraceRequest() uint32 {
+// GetPtraceTraceeAncestorsArgv returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeAncestorsArgv() []string {
 	if ev.GetEventType().String() != "ptrace" {
-		return uint32(0)
+		return []string{}
 	}
-	return ev.PTrace.Request
+	if ev.PTrace.Tracee == nil {
+		return []string{}
+	}
+	if ev.PTrace.Tracee.Ancestor == nil {
+		return []string{}
+	}
+	var values []string
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := ev.FieldHandlers.ResolveProcessArgv(ev, &element.ProcessContext.Process)
+		values = append(values, result...)
+		ptr = iterator.Next()
+	}
+	return values
 }
 
-// GetPtraceRetval returns the value of the field, resolving if necessary
-func (ev *Event) GetPtraceRetval() int64 {
+// GetPtraceTraceeAncestorsArgv0 returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeAncestorsArgv0() []string {
 	if ev.GetEventType().String() != "ptrace" {
-		return int64(0)
+		return []string{}
 	}
-	return ev.PTrace.SyscallEvent.Retval
+	if ev.PTrace.Tracee == nil {
+		return []string{}
+	}
+	if ev.PTrace.Tracee.Ancestor == nil {
+		return []string{}
+	}
+	var values []string
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := ev.FieldHandlers.ResolveProcessArgv0(ev, &element.ProcessContext.Process)
+		values = append(values, result)
+		ptr = iterator.Next()
+	}
+	return values
 }
 
-// GetPtraceTraceeAncestorsArgs returns the value of the field, resolving if necessary
-func (ev *Event) GetPtraceTraceeAncestorsArgs() []string {
+// GetPtraceTraceeAncestorsArgvScrubbed returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeAncestorsArgvScrubbed() []string {
+	if ev.GetEventType().String() != "ptrace" {
+		return []string{}
+	}
+	if ev.PTrace.Tracee == nil {
+		return []string{}
+	}
+	if ev.PTrace.Tracee.Ancestor == nil {
+		return []string{}
+	}
+	var values []string
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := ev.FieldHandlers.ResolveProcessArgvScrubbed(ev, &element.ProcessContext.Process)
+		values = append(values, result...)
+		ptr = iterator.Next()
+	}
+	return values
+}
+
+// GetPtraceTraceeAncestorsAuid returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeAncestorsAuid() []uint32 {
+	if ev.GetEventType().String() != "ptrace" {
+		return []uint32{}
+	}
+	if ev.PTrace.Tracee == nil {
+		return []uint32{}
+	}
+	if ev.PTrace.Tracee.Ancestor == nil {
+		return []uint32{}
+	}
+	var values []uint32
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := element.ProcessContext.Process.Credentials.AUID
+		values = append(values, result)
+		ptr = iterator.Next()
+	}
+	return values
+}
+
+// GetPtraceTraceeAncestorsCapEffective returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeAncestorsCapEffective() []uint64 {
+	if ev.GetEventType().String() != "ptrace" {
+		return []uint64{}
+	}
+	if ev.PTrace.Tracee == nil {
+		return []uint64{}
+	}
+	if ev.PTrace.Tracee.Ancestor == nil {
+		return []uint64{}
+	}
+	var values []uint64
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := element.ProcessContext.Process.Credentials.CapEffective
+		values = append(values, result)
+		ptr = iterator.Next()
+	}
+	return values
+}
+
+// GetPtraceTraceeAncestorsCapPermitted returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeAncestorsCapPermitted() []uint64 {
+	if ev.GetEventType().String() != "ptrace" {
+		return []uint64{}
+	}
+	if ev.PTrace.Tracee == nil {
+		return []uint64{}
+	}
+	if ev.PTrace.Tracee.Ancestor == nil {
+		return []uint64{}
+	}
+	var values []uint64
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := element.ProcessContext.Process.Credentials.CapPermitted
+		values = append(values, result)
+		ptr = iterator.Next()
+	}
+	return values
+}
+
+// GetPtraceTraceeAncestorsCgroupAncestors returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeAncestorsCgroupAncestors() []string {
+	if ev.GetEventType().String() != "ptrace" {
+		return []string{}
+	}
+	if ev.PTrace.Tracee == nil {
+		return []string{}
+	}
+	if ev.PTrace.Tracee.Ancestor == nil {
+		return []string{}
+	}
+	var values []string
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := ev.FieldHandlers.ResolveCGroupAncestors(ev, &element.ProcessContext.Process.CGroup)
+		values = append(values, result...)
+		ptr = iterator.Next()
+	}
+	return values
+}
+
+// GetPtraceTraceeAncestorsCgroupControllers returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeAncestorsCgroupControllers() []string {
 	if ev.GetEventType().String() != "ptrace" {
 		return []string{}
 	}
@@ -8826,15 +11062,87 @@ func (ev *Event) GetPtraceTraceeAncestorsArgs() []string {
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := ev.FieldHandlers.ResolveProcessArgs(ev, &element.ProcessContext.Process)
+		result := ev.FieldHandlers.ResolveCGroupControllers(ev, &element.ProcessContext.Process.CGroup)
+		values = append(values, result...)
+		ptr = iterator.Next()
+	}
+	return values
+}
+
+// GetPtraceTraceeAncestorsCgroupCpuUsage returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeAncestorsCgroupCpuUsage() []int {
+	if ev.GetEventType().String() != "ptrace" {
+		return []int{}
+	}
+	if ev.PTrace.Tracee == nil {
+		return []int{}
+	}
+	if ev.PTrace.Tracee.Ancestor == nil {
+		return []int{}
+	}
+	var values []int
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := int(ev.FieldHandlers.ResolveCGroupCPUUsage(ev, &element.ProcessContext.Process.CGroup))
+		values = append(values, result)
+		ptr = iterator.Next()
+	}
+	return values
+}
+
+// GetPtraceTraceeAncestorsCgroupFileInode returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeAncestorsCgroupFileInode() []uint64 {
+	if ev.GetEventType().String() != "ptrace" {
+		return []uint64{}
+	}
+	if ev.PTrace.Tracee == nil {
+		return []uint64{}
+	}
+	if ev.PTrace.Tracee.Ancestor == nil {
+		return []uint64{}
+	}
+	var values []uint64
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := element.ProcessContext.Process.CGroup.CGroupFile.Inode
+		values = append(values, result)
+		ptr = iterator.Next()
+	}
+	return values
+}
+
+// GetPtraceTraceeAncestorsCgroupFileMountId returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeAncestorsCgroupFileMountId() []uint32 {
+	if ev.GetEventType().String() != "ptrace" {
+		return []uint32{}
+	}
+	if ev.PTrace.Tracee == nil {
+		return []uint32{}
+	}
+	if ev.PTrace.Tracee.Ancestor == nil {
+		return []uint32{}
+	}
+	var values []uint32
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := element.ProcessContext.Process.CGroup.CGroupFile.MountID
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetPtraceTraceeAncestorsArgsFlags returns the value of the field, resolving if necessary
-func (ev *Event) GetPtraceTraceeAncestorsArgsFlags() []string {
+// GetPtraceTraceeAncestorsCgroupId returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeAncestorsCgroupId() []string {
 	if ev.GetEventType().String() != "ptrace" {
 		return []string{}
 	}
@@ -8850,15 +11158,15 @@ func (ev *Event) GetPtraceTraceeAncestorsArgsFlags() []string {
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := ev.FieldHandlers.ResolveProcessArgsFlags(ev, &element.ProcessContext.Process)
-		values = append(values, result...)
+		result := ev.FieldHandlers.ResolveCGroupID(ev, &element.ProcessContext.Process.CGroup)
+		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetPtraceTraceeAncestorsArgsOptions returns the value of the field, resolving if necessary
-func (ev *Event) GetPtraceTraceeAncestorsArgsOptions() []string {
+// GetPtraceTraceeAncestorsCgroupManager returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeAncestorsCgroupManager() []string {
 	if ev.GetEventType().String() != "ptrace" {
 		return []string{}
 	}
@@ -8874,39 +11182,39 @@ func (ev *Event) GetPtraceTraceeAncestorsArgsOptions() []string {
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := ev.FieldHandlers.ResolveProcessArgsOptions(ev, &element.ProcessContext.Process)
-		values = append(values, result...)
+		result := ev.FieldHandlers.ResolveCGroupManager(ev, &element.ProcessContext.Process.CGroup)
+		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetPtraceTraceeAncestorsArgsScrubbed returns the value of the field, resolving if necessary
-func (ev *Event) GetPtraceTraceeAncestorsArgsScrubbed() []string {
+// GetPtraceTraceeAncestorsCgroupMemoryCurrent returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeAncestorsCgroupMemoryCurrent() []int {
 	if ev.GetEventType().String() != "ptrace" {
-		return []string{}
+		return []int{}
 	}
 	if ev.PTrace.Tracee == nil {
-		return []string{}
+		return []int{}
 	}
 	if ev.PTrace.Tracee.Ancestor == nil {
-		return []string{}
+		return []int{}
 	}
-	var values []string
+	var values []int
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := ev.FieldHandlers.ResolveProcessArgsScrubbed(ev, &element.ProcessContext.Process)
+		result := int(ev.FieldHandlers.ResolveCGroupMemoryCurrent(ev, &element.ProcessContext.Process.CGroup))
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetPtraceTraceeAncestorsArgsTruncated returns the value of the field, resolving if necessary
-func (ev *Event) GetPtraceTraceeAncestorsArgsTruncated() []bool {
+// GetPtraceTraceeAncestorsCgroupMemoryHighExceeded returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeAncestorsCgroupMemoryHighExceeded() []bool {
 	if ev.GetEventType().String() != "ptrace" {
 		return []bool{}
 	}
@@ -8922,39 +11230,39 @@ func (ev *Event) GetPtraceTraceeAncestorsArgsTruncated() []bool {
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := ev.FieldHandlers.ResolveProcessArgsTruncated(ev, &element.ProcessContext.Process)
+		result := ev.FieldHandlers.ResolveCGroupMemoryHighExceeded(ev, &element.ProcessContext.Process.CGroup)
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetPtraceTraceeAncestorsArgv returns the value of the field, resolving if necessary
-func (ev *Event) GetPtraceTraceeAncestorsArgv() []string {
+// GetPtraceTraceeAncestorsCgroupPidsCurrent returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeAncestorsCgroupPidsCurrent() []int {
 	if ev.GetEventType().String() != "ptrace" {
-		return []string{}
+		return []int{}
 	}
 	if ev.PTrace.Tracee == nil {
-		return []string{}
+		return []int{}
 	}
 	if ev.PTrace.Tracee.Ancestor == nil {
-		return []string{}
+		return []int{}
 	}
-	var values []string
+	var values []int
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := ev.FieldHandlers.ResolveProcessArgv(ev, &element.ProcessContext.Process)
-		values = append(values, result...)
+		result := int(ev.FieldHandlers.ResolveCGroupPidsCurrent(ev, &element.ProcessContext.Process.CGroup))
+		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetPtraceTraceeAncestorsArgv0 returns the value of the field, resolving if necessary
-func (ev *Event) GetPtraceTraceeAncestorsArgv0() []string {
+// GetPtraceTraceeAncestorsCloudCredentialsProviders returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeAncestorsCloudCredentialsProviders() []string {
 	if ev.GetEventType().String() != "ptrace" {
 		return []string{}
 	}
@@ -8970,15 +11278,15 @@ func (ev *Event) GetPtraceTraceeAncestorsArgv0() []string {
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := ev.FieldHandlers.ResolveProcessArgv0(ev, &element.ProcessContext.Process)
-		values = append(values, result)
+		result := ev.FieldHandlers.ResolveProcessCloudCredentialsProviders(ev, &element.ProcessContext.Process)
+		values = append(values, result...)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetPtraceTraceeAncestorsArgvScrubbed returns the value of the field, resolving if necessary
-func (ev *Event) GetPtraceTraceeAncestorsArgvScrubbed() []string {
+// GetPtraceTraceeAncestorsCloudCredentialsResources returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeAncestorsCloudCredentialsResources() []string {
 	if ev.GetEventType().String() != "ptrace" {
 		return []string{}
 	}
@@ -8994,135 +11302,111 @@ func (ev *Event) GetPtraceTraceeAncestorsArgvScrubbed() []string {
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := ev.FieldHandlers.ResolveProcessArgvScrubbed(ev, &element.ProcessContext.Process)
+		result := ev.FieldHandlers.ResolveProcessCloudCredentialsResources(ev, &element.ProcessContext.Process)
 		values = append(values, result...)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetPtraceTraceeAncestorsAuid returns the value of the field, resolving if necessary
-func (ev *Event) GetPtraceTraceeAncestorsAuid() []uint32 {
-	if ev.GetEventType().String() != "ptrace" {
-		return []uint32{}
-	}
-	if ev.PTrace.Tracee == nil {
-		return []uint32{}
-	}
-	if ev.PTrace.Tracee.Ancestor == nil {
-		return []uint32{}
-	}
-	var values []uint32
-	ctx := eval.NewContext(ev)
-	iterator := &ProcessAncestorsIterator{}
-	ptr := iterator.Front(ctx)
-	for ptr != nil {
-		element := (*ProcessCacheEntry)(ptr)
-		result := element.ProcessContext.Process.Credentials.AUID
-		values = append(values, result)
-		ptr = iterator.Next()
-	}
-	return values
-}
-
-// GetPtraceTraceeAncestorsCapEffective returns the value of the field, resolving if necessary
-func (ev *Event) GetPtraceTraceeAncestorsCapEffective() []uint64 {
+// GetPtraceTraceeAncestorsCloudCredentialsTypes returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeAncestorsCloudCredentialsTypes() []string {
 	if ev.GetEventType().String() != "ptrace" {
-		return []uint64{}
+		return []string{}
 	}
 	if ev.PTrace.Tracee == nil {
-		return []uint64{}
+		return []string{}
 	}
 	if ev.PTrace.Tracee.Ancestor == nil {
-		return []uint64{}
+		return []string{}
 	}
-	var values []uint64
+	var values []string
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := element.ProcessContext.Process.Credentials.CapEffective
-		values = append(values, result)
+		result := ev.FieldHandlers.ResolveProcessCloudCredentialsTypes(ev, &element.ProcessContext.Process)
+		values = append(values, result...)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetPtraceTraceeAncestorsCapPermitted returns the value of the field, resolving if necessary
-func (ev *Event) GetPtraceTraceeAncestorsCapPermitted() []uint64 {
+// GetPtraceTraceeAncestorsCmdargv returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeAncestorsCmdargv() []string {
 	if ev.GetEventType().String() != "ptrace" {
-		return []uint64{}
+		return []string{}
 	}
 	if ev.PTrace.Tracee == nil {
-		return []uint64{}
+		return []string{}
 	}
 	if ev.PTrace.Tracee.Ancestor == nil {
-		return []uint64{}
+		return []string{}
 	}
-	var values []uint64
+	var values []string
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := element.ProcessContext.Process.Credentials.CapPermitted
-		values = append(values, result)
+		result := ev.FieldHandlers.ResolveProcessCmdArgv(ev, &element.ProcessContext.Process)
+		values = append(values, result...)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetPtraceTraceeAncestorsCgroupFileInode returns the value of the field, resolving if necessary
-func (ev *Event) GetPtraceTraceeAncestorsCgroupFileInode() []uint64 {
+// GetPtraceTraceeAncestorsCmdlineObfuscationScore returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeAncestorsCmdlineObfuscationScore() []int {
 	if ev.GetEventType().String() != "ptrace" {
-		return []uint64{}
+		return []int{}
 	}
 	if ev.PTrace.Tracee == nil {
-		return []uint64{}
+		return []int{}
 	}
 	if ev.PTrace.Tracee.Ancestor == nil {
-		return []uint64{}
+		return []int{}
 	}
-	var values []uint64
+	var values []int
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := element.ProcessContext.Process.CGroup.CGroupFile.Inode
+		result := ev.FieldHandlers.ResolveProcessCmdLineObfuscationScore(ev, &element.ProcessContext.Process)
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetPtraceTraceeAncestorsCgroupFileMountId returns the value of the field, resolving if necessary
-func (ev *Event) GetPtraceTraceeAncestorsCgroupFileMountId() []uint32 {
+// GetPtraceTraceeAncestorsComm returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeAncestorsComm() []string {
 	if ev.GetEventType().String() != "ptrace" {
-		return []uint32{}
+		return []string{}
 	}
 	if ev.PTrace.Tracee == nil {
-		return []uint32{}
+		return []string{}
 	}
 	if ev.PTrace.Tracee.Ancestor == nil {
-		return []uint32{}
+		return []string{}
 	}
-	var values []uint32
+	var values []string
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := element.ProcessContext.Process.CGroup.CGroupFile.MountID
+		result := element.ProcessContext.Process.Comm
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetPtraceTraceeAncestorsCgroupId returns the value of the field, resolving if necessary
-func (ev *Event) GetPtraceTraceeAncestorsCgroupId() []string {
+// GetPtraceTraceeAncestorsContainerId returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeAncestorsContainerId() []string {
 	if ev.GetEventType().String() != "ptrace" {
 		return []string{}
 	}
@@ -9138,63 +11422,63 @@ func (ev *Event) GetPtraceTraceeAncestorsCgroupId() []string {
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := ev.FieldHandlers.ResolveCGroupID(ev, &element.ProcessContext.Process.CGroup)
+		result := ev.FieldHandlers.ResolveProcessContainerID(ev, &element.ProcessContext.Process)
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetPtraceTraceeAncestorsCgroupManager returns the value of the field, resolving if necessary
-func (ev *Event) GetPtraceTraceeAncestorsCgroupManager() []string {
+// GetPtraceTraceeAncestorsCreatedAt returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeAncestorsCreatedAt() []int {
 	if ev.GetEventType().String() != "ptrace" {
-		return []string{}
+		return []int{}
 	}
 	if ev.PTrace.Tracee == nil {
-		return []string{}
+		return []int{}
 	}
 	if ev.PTrace.Tracee.Ancestor == nil {
-		return []string{}
+		return []int{}
 	}
-	var values []string
+	var values []int
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := ev.FieldHandlers.ResolveCGroupManager(ev, &element.ProcessContext.Process.CGroup)
+		result := int(ev.FieldHandlers.ResolveProcessCreatedAt(ev, &element.ProcessContext.Process))
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetPtraceTraceeAncestorsCmdargv returns the value of the field, resolving if necessary
-func (ev *Event) GetPtraceTraceeAncestorsCmdargv() []string {
+// GetPtraceTraceeAncestorsEgid returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeAncestorsEgid() []uint32 {
 	if ev.GetEventType().String() != "ptrace" {
-		return []string{}
+		return []uint32{}
 	}
 	if ev.PTrace.Tracee == nil {
-		return []string{}
+		return []uint32{}
 	}
 	if ev.PTrace.Tracee.Ancestor == nil {
-		return []string{}
+		return []uint32{}
 	}
-	var values []string
+	var values []uint32
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := ev.FieldHandlers.ResolveProcessCmdArgv(ev, &element.ProcessContext.Process)
-		values = append(values, result...)
+		result := element.ProcessContext.Process.Credentials.EGID
+		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetPtraceTraceeAncestorsComm returns the value of the field, resolving if necessary
-func (ev *Event) GetPtraceTraceeAncestorsComm() []string {
+// GetPtraceTraceeAncestorsEgroup returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeAncestorsEgroup() []string {
 	if ev.GetEventType().String() != "ptrace" {
 		return []string{}
 	}
@@ -9210,15 +11494,15 @@ func (ev *Event) GetPtraceTraceeAncestorsComm() []string {
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := element.ProcessContext.Process.Comm
+		result := element.ProcessContext.Process.Credentials.EGroup
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetPtraceTraceeAncestorsContainerId returns the value of the field, resolving if necessary
-func (ev *Event) GetPtraceTraceeAncestorsContainerId() []string {
+// GetPtraceTraceeAncestorsElfBuildId returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeAncestorsElfBuildId() []string {
 	if ev.GetEventType().String() != "ptrace" {
 		return []string{}
 	}
@@ -9234,79 +11518,79 @@ func (ev *Event) GetPtraceTraceeAncestorsContainerId() []string {
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := ev.FieldHandlers.ResolveProcessContainerID(ev, &element.ProcessContext.Process)
+		result := ev.FieldHandlers.ResolveProcessELFBuildID(ev, &element.ProcessContext.Process)
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetPtraceTraceeAncestorsCreatedAt returns the value of the field, resolving if necessary
-func (ev *Event) GetPtraceTraceeAncestorsCreatedAt() []int {
+// GetPtraceTraceeAncestorsElfInterpreter returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeAncestorsElfInterpreter() []string {
 	if ev.GetEventType().String() != "ptrace" {
-		return []int{}
+		return []string{}
 	}
 	if ev.PTrace.Tracee == nil {
-		return []int{}
+		return []string{}
 	}
 	if ev.PTrace.Tracee.Ancestor == nil {
-		return []int{}
+		return []string{}
 	}
-	var values []int
+	var values []string
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := int(ev.FieldHandlers.ResolveProcessCreatedAt(ev, &element.ProcessContext.Process))
+		result := ev.FieldHandlers.ResolveProcessELFInterpreter(ev, &element.ProcessContext.Process)
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetPtraceTraceeAncestorsEgid returns the value of the field, resolving if necessary
-func (ev *Event) GetPtraceTraceeAncestorsEgid() []uint32 {
+// GetPtraceTraceeAncestorsElfIsStatic returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeAncestorsElfIsStatic() []bool {
 	if ev.GetEventType().String() != "ptrace" {
-		return []uint32{}
+		return []bool{}
 	}
 	if ev.PTrace.Tracee == nil {
-		return []uint32{}
+		return []bool{}
 	}
 	if ev.PTrace.Tracee.Ancestor == nil {
-		return []uint32{}
+		return []bool{}
 	}
-	var values []uint32
+	var values []bool
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := element.ProcessContext.Process.Credentials.EGID
+		result := ev.FieldHandlers.ResolveProcessELFIsStatic(ev, &element.ProcessContext.Process)
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetPtraceTraceeAncestorsEgroup returns the value of the field, resolving if necessary
-func (ev *Event) GetPtraceTraceeAncestorsEgroup() []string {
+// GetPtraceTraceeAncestorsElfIsStripped returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeAncestorsElfIsStripped() []bool {
 	if ev.GetEventType().String() != "ptrace" {
-		return []string{}
+		return []bool{}
 	}
 	if ev.PTrace.Tracee == nil {
-		return []string{}
+		return []bool{}
 	}
 	if ev.PTrace.Tracee.Ancestor == nil {
-		return []string{}
+		return []bool{}
 	}
-	var values []string
+	var values []bool
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := element.ProcessContext.Process.Credentials.EGroup
+		result := ev.FieldHandlers.ResolveProcessELFIsStripped(ev, &element.ProcessContext.Process)
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
@@ -9562,39 +11846,87 @@ func (ev *Event) GetPtraceTraceeAncestorsFileInUpperLayer() []bool {
 		return []bool{}
 	}
 	if ev.PTrace.Tracee.Ancestor == nil {
-		return []bool{}
+		return []bool{}
+	}
+	var values []bool
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &element.ProcessContext.Process.FileEvent.FileFields)
+		values = append(values, result)
+		ptr = iterator.Next()
+	}
+	return values
+}
+
+// GetPtraceTraceeAncestorsFileInode returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeAncestorsFileInode() []uint64 {
+	if ev.GetEventType().String() != "ptrace" {
+		return []uint64{}
+	}
+	if ev.PTrace.Tracee == nil {
+		return []uint64{}
+	}
+	if ev.PTrace.Tracee.Ancestor == nil {
+		return []uint64{}
+	}
+	var values []uint64
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := element.ProcessContext.Process.FileEvent.FileFields.PathKey.Inode
+		values = append(values, result)
+		ptr = iterator.Next()
+	}
+	return values
+}
+
+// GetPtraceTraceeAncestorsFileLayerDigest returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeAncestorsFileLayerDigest() []string {
+	if ev.GetEventType().String() != "ptrace" {
+		return []string{}
+	}
+	if ev.PTrace.Tracee == nil {
+		return []string{}
+	}
+	if ev.PTrace.Tracee.Ancestor == nil {
+		return []string{}
 	}
-	var values []bool
+	var values []string
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &element.ProcessContext.Process.FileEvent.FileFields)
+		result := ev.FieldHandlers.ResolveFileLayerDigest(ev, &element.ProcessContext.Process.FileEvent)
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetPtraceTraceeAncestorsFileInode returns the value of the field, resolving if necessary
-func (ev *Event) GetPtraceTraceeAncestorsFileInode() []uint64 {
+// GetPtraceTraceeAncestorsFileLayerPath returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeAncestorsFileLayerPath() []string {
 	if ev.GetEventType().String() != "ptrace" {
-		return []uint64{}
+		return []string{}
 	}
 	if ev.PTrace.Tracee == nil {
-		return []uint64{}
+		return []string{}
 	}
 	if ev.PTrace.Tracee.Ancestor == nil {
-		return []uint64{}
+		return []string{}
 	}
-	var values []uint64
+	var values []string
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := element.ProcessContext.Process.FileEvent.FileFields.PathKey.Inode
+		result := ev.FieldHandlers.ResolveFileLayerPath(ev, &element.ProcessContext.Process.FileEvent)
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
@@ -10225,6 +12557,54 @@ func (ev *Event) GetPtraceTraceeAncestorsInterpreterFileInode() []uint64 {
 	return values
 }
 
+// GetPtraceTraceeAncestorsInterpreterFileLayerDigest returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeAncestorsInterpreterFileLayerDigest() []string {
+	if ev.GetEventType().String() != "ptrace" {
+		return []string{}
+	}
+	if ev.PTrace.Tracee == nil {
+		return []string{}
+	}
+	if ev.PTrace.Tracee.Ancestor == nil {
+		return []string{}
+	}
+	var values []string
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := ev.FieldHandlers.ResolveFileLayerDigest(ev, &element.ProcessContext.Process.LinuxBinprm.FileEvent)
+		values = append(values, result)
+		ptr = iterator.Next()
+	}
+	return values
+}
+
+// GetPtraceTraceeAncestorsInterpreterFileLayerPath returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeAncestorsInterpreterFileLayerPath() []string {
+	if ev.GetEventType().String() != "ptrace" {
+		return []string{}
+	}
+	if ev.PTrace.Tracee == nil {
+		return []string{}
+	}
+	if ev.PTrace.Tracee.Ancestor == nil {
+		return []string{}
+	}
+	var values []string
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := ev.FieldHandlers.ResolveFileLayerPath(ev, &element.ProcessContext.Process.LinuxBinprm.FileEvent)
+		values = append(values, result)
+		ptr = iterator.Next()
+	}
+	return values
+}
+
 // GetPtraceTraceeAncestorsInterpreterFileMode returns the value of the field, resolving if necessary
 func (ev *Event) GetPtraceTraceeAncestorsInterpreterFileMode() []uint16 {
 	if ev.GetEventType().String() != "ptrace" {
@@ -10625,6 +13005,102 @@ func (ev *Event) GetPtraceTraceeAncestorsLength() int {
 	return iterator.Len(ctx)
 }
 
+// GetPtraceTraceeAncestorsNsMnt returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeAncestorsNsMnt() []int {
+	if ev.GetEventType().String() != "ptrace" {
+		return []int{}
+	}
+	if ev.PTrace.Tracee == nil {
+		return []int{}
+	}
+	if ev.PTrace.Tracee.Ancestor == nil {
+		return []int{}
+	}
+	var values []int
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := int(ev.FieldHandlers.ResolveProcessMountNamespace(ev, &element.ProcessContext.Process))
+		values = append(values, result)
+		ptr = iterator.Next()
+	}
+	return values
+}
+
+// GetPtraceTraceeAncestorsNsPid returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeAncestorsNsPid() []int {
+	if ev.GetEventType().String() != "ptrace" {
+		return []int{}
+	}
+	if ev.PTrace.Tracee == nil {
+		return []int{}
+	}
+	if ev.PTrace.Tracee.Ancestor == nil {
+		return []int{}
+	}
+	var values []int
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := int(ev.FieldHandlers.ResolveProcessPIDNamespace(ev, &element.ProcessContext.Process))
+		values = append(values, result)
+		ptr = iterator.Next()
+	}
+	return values
+}
+
+// GetPtraceTraceeAncestorsNsUser returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeAncestorsNsUser() []int {
+	if ev.GetEventType().String() != "ptrace" {
+		return []int{}
+	}
+	if ev.PTrace.Tracee == nil {
+		return []int{}
+	}
+	if ev.PTrace.Tracee.Ancestor == nil {
+		return []int{}
+	}
+	var values []int
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := int(ev.FieldHandlers.ResolveProcessUserNamespace(ev, &element.ProcessContext.Process))
+		values = append(values, result)
+		ptr = iterator.Next()
+	}
+	return values
+}
+
+// GetPtraceTraceeAncestorsNsUts returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeAncestorsNsUts() []int {
+	if ev.GetEventType().String() != "ptrace" {
+		return []int{}
+	}
+	if ev.PTrace.Tracee == nil {
+		return []int{}
+	}
+	if ev.PTrace.Tracee.Ancestor == nil {
+		return []int{}
+	}
+	var values []int
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := int(ev.FieldHandlers.ResolveProcessUTSNamespace(ev, &element.ProcessContext.Process))
+		values = append(values, result)
+		ptr = iterator.Next()
+	}
+	return values
+}
+
 // GetPtraceTraceeAncestorsPid returns the value of the field, resolving if necessary
 func (ev *Event) GetPtraceTraceeAncestorsPid() []uint32 {
 	if ev.GetEventType().String() != "ptrace" {
@@ -10673,6 +13149,54 @@ func (ev *Event) GetPtraceTraceeAncestorsPpid() []uint32 {
 	return values
 }
 
+// GetPtraceTraceeAncestorsSystemdSlice returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeAncestorsSystemdSlice() []string {
+	if ev.GetEventType().String() != "ptrace" {
+		return []string{}
+	}
+	if ev.PTrace.Tracee == nil {
+		return []string{}
+	}
+	if ev.PTrace.Tracee.Ancestor == nil {
+		return []string{}
+	}
+	var values []string
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := ev.FieldHandlers.ResolveProcessSystemdSlice(ev, &element.ProcessContext.Process)
+		values = append(values, result)
+		ptr = iterator.Next()
+	}
+	return values
+}
+
+// GetPtraceTraceeAncestorsSystemdUnit returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeAncestorsSystemdUnit() []string {
+	if ev.GetEventType().String() != "ptrace" {
+		return []string{}
+	}
+	if ev.PTrace.Tracee == nil {
+		return []string{}
+	}
+	if ev.PTrace.Tracee.Ancestor == nil {
+		return []string{}
+	}
+	var values []string
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := ev.FieldHandlers.ResolveProcessSystemdUnit(ev, &element.ProcessContext.Process)
+		values = append(values, result)
+		ptr = iterator.Next()
+	}
+	return values
+}
+
 // GetPtraceTraceeAncestorsTid returns the value of the field, resolving if necessary
 func (ev *Event) GetPtraceTraceeAncestorsTid() []uint32 {
 	if ev.GetEventType().String() != "ptrace" {
@@ -10962,6 +13486,39 @@ func (ev *Event) GetPtraceTraceeCapPermitted() uint64 {
 	return ev.PTrace.Tracee.Process.Credentials.CapPermitted
 }
 
+// GetPtraceTraceeCgroupAncestors returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeCgroupAncestors() []string {
+	if ev.GetEventType().String() != "ptrace" {
+		return []string{}
+	}
+	if ev.PTrace.Tracee == nil {
+		return []string{}
+	}
+	return ev.FieldHandlers.ResolveCGroupAncestors(ev, &ev.PTrace.Tracee.Process.CGroup)
+}
+
+// GetPtraceTraceeCgroupControllers returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeCgroupControllers() []string {
+	if ev.GetEventType().String() != "ptrace" {
+		return []string{}
+	}
+	if ev.PTrace.Tracee == nil {
+		return []string{}
+	}
+	return ev.FieldHandlers.ResolveCGroupControllers(ev, &ev.PTrace.Tracee.Process.CGroup)
+}
+
+// GetPtraceTraceeCgroupCpuUsage returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeCgroupCpuUsage() int {
+	if ev.GetEventType().String() != "ptrace" {
+		return 0
+	}
+	if ev.PTrace.Tracee == nil {
+		return 0
+	}
+	return ev.FieldHandlers.ResolveCGroupCPUUsage(ev, &ev.PTrace.Tracee.Process.CGroup)
+}
+
 // GetPtraceTraceeCgroupFileInode returns the value of the field, resolving if necessary
 func (ev *Event) GetPtraceTraceeCgroupFileInode() uint64 {
 	if ev.GetEventType().String() != "ptrace" {
@@ -11003,7 +13560,73 @@ func (ev *Event) GetPtraceTraceeCgroupManager() string {
 	if ev.PTrace.Tracee == nil {
 		return ""
 	}
-	return ev.FieldHandlers.ResolveCGroupManager(ev, &ev.PTrace.Tracee.Process.CGroup)
+	return ev.FieldHandlers.ResolveCGroupManager(ev, &ev.PTrace.Tracee.Process.CGroup)
+}
+
+// GetPtraceTraceeCgroupMemoryCurrent returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeCgroupMemoryCurrent() int {
+	if ev.GetEventType().String() != "ptrace" {
+		return 0
+	}
+	if ev.PTrace.Tracee == nil {
+		return 0
+	}
+	return ev.FieldHandlers.ResolveCGroupMemoryCurrent(ev, &ev.PTrace.Tracee.Process.CGroup)
+}
+
+// GetPtraceTraceeCgroupMemoryHighExceeded returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeCgroupMemoryHighExceeded() bool {
+	if ev.GetEventType().String() != "ptrace" {
+		return false
+	}
+	if ev.PTrace.Tracee == nil {
+		return false
+	}
+	return ev.FieldHandlers.ResolveCGroupMemoryHighExceeded(ev, &ev.PTrace.Tracee.Process.CGroup)
+}
+
+// GetPtraceTraceeCgroupPidsCurrent returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeCgroupPidsCurrent() int {
+	if ev.GetEventType().String() != "ptrace" {
+		return 0
+	}
+	if ev.PTrace.Tracee == nil {
+		return 0
+	}
+	return ev.FieldHandlers.ResolveCGroupPidsCurrent(ev, &ev.PTrace.Tracee.Process.CGroup)
+}
+
+// GetPtraceTraceeCloudCredentialsProviders returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeCloudCredentialsProviders() []string {
+	if ev.GetEventType().String() != "ptrace" {
+		return []string{}
+	}
+	if ev.PTrace.Tracee == nil {
+		return []string{}
+	}
+	return ev.FieldHandlers.ResolveProcessCloudCredentialsProviders(ev, &ev.PTrace.Tracee.Process)
+}
+
+// GetPtraceTraceeCloudCredentialsResources returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeCloudCredentialsResources() []string {
+	if ev.GetEventType().String() != "ptrace" {
+		return []string{}
+	}
+	if ev.PTrace.Tracee == nil {
+		return []string{}
+	}
+	return ev.FieldHandlers.ResolveProcessCloudCredentialsResources(ev, &ev.PTrace.Tracee.Process)
+}
+
+// GetPtraceTraceeCloudCredentialsTypes returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeCloudCredentialsTypes() []string {
+	if ev.GetEventType().String() != "ptrace" {
+		return []string{}
+	}
+	if ev.PTrace.Tracee == nil {
+		return []string{}
+	}
+	return ev.FieldHandlers.ResolveProcessCloudCredentialsTypes(ev, &ev.PTrace.Tracee.Process)
 }
 
 // GetPtraceTraceeCmdargv returns the value of the field, resolving if necessary
@@ -11017,6 +13640,17 @@ func (ev *Event) GetPtraceTraceeCmdargv() []string {
 	return ev.FieldHandlers.ResolveProcessCmdArgv(ev, &ev.PTrace.Tracee.Process)
 }
 
+// GetPtraceTraceeCmdlineObfuscationScore returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeCmdlineObfuscationScore() int {
+	if ev.GetEventType().String() != "ptrace" {
+		return 0
+	}
+	if ev.PTrace.Tracee == nil {
+		return 0
+	}
+	return ev.FieldHandlers.ResolveProcessCmdLineObfuscationScore(ev, &ev.PTrace.Tracee.Process)
+}
+
 // GetPtraceTraceeComm returns the value of the field, resolving if necessary
 func (ev *Event) GetPtraceTraceeComm() string {
 	if ev.GetEventType().String() != "ptrace" {
@@ -11072,6 +13706,50 @@ func (ev *Event) GetPtraceTraceeEgroup() string {
 	return ev.PTrace.Tracee.Process.Credentials.EGroup
 }
 
+// GetPtraceTraceeElfBuildId returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeElfBuildId() string {
+	if ev.GetEventType().String() != "ptrace" {
+		return ""
+	}
+	if ev.PTrace.Tracee == nil {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveProcessELFBuildID(ev, &ev.PTrace.Tracee.Process)
+}
+
+// GetPtraceTraceeElfInterpreter returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeElfInterpreter() string {
+	if ev.GetEventType().String() != "ptrace" {
+		return ""
+	}
+	if ev.PTrace.Tracee == nil {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveProcessELFInterpreter(ev, &ev.PTrace.Tracee.Process)
+}
+
+// GetPtraceTraceeElfIsStatic returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeElfIsStatic() bool {
+	if ev.GetEventType().String() != "ptrace" {
+		return false
+	}
+	if ev.PTrace.Tracee == nil {
+		return false
+	}
+	return ev.FieldHandlers.ResolveProcessELFIsStatic(ev, &ev.PTrace.Tracee.Process)
+}
+
+// GetPtraceTraceeElfIsStripped returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeElfIsStripped() bool {
+	if ev.GetEventType().String() != "ptrace" {
+		return false
+	}
+	if ev.PTrace.Tracee == nil {
+		return false
+	}
+	return ev.FieldHandlers.ResolveProcessELFIsStripped(ev, &ev.PTrace.Tracee.Process)
+}
+
 // GetPtraceTraceeEnvp returns the value of the field, resolving if necessary
 func (ev *Event) GetPtraceTraceeEnvp() []string {
 	if ev.GetEventType().String() != "ptrace" {
@@ -11247,6 +13925,34 @@ func (ev *Event) GetPtraceTraceeFileInode() uint64 {
 	return ev.PTrace.Tracee.Process.FileEvent.FileFields.PathKey.Inode
 }
 
+// GetPtraceTraceeFileLayerDigest returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeFileLayerDigest() string {
+	if ev.GetEventType().String() != "ptrace" {
+		return ""
+	}
+	if ev.PTrace.Tracee == nil {
+		return ""
+	}
+	if !ev.PTrace.Tracee.Process.IsNotKworker() {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.PTrace.Tracee.Process.FileEvent)
+}
+
+// GetPtraceTraceeFileLayerPath returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeFileLayerPath() string {
+	if ev.GetEventType().String() != "ptrace" {
+		return ""
+	}
+	if ev.PTrace.Tracee == nil {
+		return ""
+	}
+	if !ev.PTrace.Tracee.Process.IsNotKworker() {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.PTrace.Tracee.Process.FileEvent)
+}
+
 // GetPtraceTraceeFileMode returns the value of the field, resolving if necessary
 func (ev *Event) GetPtraceTraceeFileMode() uint16 {
 	if ev.GetEventType().String() != "ptrace" {
@@ -11500,6 +14206,17 @@ func (ev *Event) GetPtraceTraceeGroup() string {
 	return ev.PTrace.Tracee.Process.Credentials.Group
 }
 
+// GetPtraceTraceeIdentity returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeIdentity() string {
+	if ev.GetEventType().String() != "ptrace" {
+		return ""
+	}
+	if ev.PTrace.Tracee == nil {
+		return ""
+	}
+	return ev.PTrace.Tracee.Process.Identity
+}
+
 // GetPtraceTraceeInterpreterFileChangeTime returns the value of the field, resolving if necessary
 func (ev *Event) GetPtraceTraceeInterpreterFileChangeTime() uint64 {
 	if ev.GetEventType().String() != "ptrace" {
@@ -11598,6 +14315,34 @@ func (ev *Event) GetPtraceTraceeInterpreterFileInode() uint64 {
 	return ev.PTrace.Tracee.Process.LinuxBinprm.FileEvent.FileFields.PathKey.Inode
 }
 
+// GetPtraceTraceeInterpreterFileLayerDigest returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeInterpreterFileLayerDigest() string {
+	if ev.GetEventType().String() != "ptrace" {
+		return ""
+	}
+	if ev.PTrace.Tracee == nil {
+		return ""
+	}
+	if !ev.PTrace.Tracee.Process.HasInterpreter() {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.PTrace.Tracee.Process.LinuxBinprm.FileEvent)
+}
+
+// GetPtraceTraceeInterpreterFileLayerPath returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeInterpreterFileLayerPath() string {
+	if ev.GetEventType().String() != "ptrace" {
+		return ""
+	}
+	if ev.PTrace.Tracee == nil {
+		return ""
+	}
+	if !ev.PTrace.Tracee.Process.HasInterpreter() {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.PTrace.Tracee.Process.LinuxBinprm.FileEvent)
+}
+
 // GetPtraceTraceeInterpreterFileMode returns the value of the field, resolving if necessary
 func (ev *Event) GetPtraceTraceeInterpreterFileMode() uint16 {
 	if ev.GetEventType().String() != "ptrace" {
@@ -11807,6 +14552,50 @@ func (ev *Event) GetPtraceTraceeIsThread() bool {
 	return ev.FieldHandlers.ResolveProcessIsThread(ev, &ev.PTrace.Tracee.Process)
 }
 
+// GetPtraceTraceeNsMnt returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeNsMnt() int {
+	if ev.GetEventType().String() != "ptrace" {
+		return 0
+	}
+	if ev.PTrace.Tracee == nil {
+		return 0
+	}
+	return ev.FieldHandlers.ResolveProcessMountNamespace(ev, &ev.PTrace.Tracee.Process)
+}
+
+// GetPtraceTraceeNsPid returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeNsPid() int {
+	if ev.GetEventType().String() != "ptrace" {
+		return 0
+	}
+	if ev.PTrace.Tracee == nil {
+		return 0
+	}
+	return ev.FieldHandlers.ResolveProcessPIDNamespace(ev, &ev.PTrace.Tracee.Process)
+}
+
+// GetPtraceTraceeNsUser returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeNsUser() int {
+	if ev.GetEventType().String() != "ptrace" {
+		return 0
+	}
+	if ev.PTrace.Tracee == nil {
+		return 0
+	}
+	return ev.FieldHandlers.ResolveProcessUserNamespace(ev, &ev.PTrace.Tracee.Process)
+}
+
+// GetPtraceTraceeNsUts returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeNsUts() int {
+	if ev.GetEventType().String() != "ptrace" {
+		return 0
+	}
+	if ev.PTrace.Tracee == nil {
+		return 0
+	}
+	return ev.FieldHandlers.ResolveProcessUTSNamespace(ev, &ev.PTrace.Tracee.Process)
+}
+
 // GetPtraceTraceeParentArgs returns the value of the field, resolving if necessary
 func (ev *Event) GetPtraceTraceeParentArgs() string {
 	if ev.GetEventType().String() != "ptrace" {
@@ -11994,6 +14783,57 @@ func (ev *Event) GetPtraceTraceeParentCapPermitted() uint64 {
 	return ev.PTrace.Tracee.Parent.Credentials.CapPermitted
 }
 
+// GetPtraceTraceeParentCgroupAncestors returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeParentCgroupAncestors() []string {
+	if ev.GetEventType().String() != "ptrace" {
+		return []string{}
+	}
+	if ev.PTrace.Tracee == nil {
+		return []string{}
+	}
+	if ev.PTrace.Tracee.Parent == nil {
+		return []string{}
+	}
+	if !ev.PTrace.Tracee.HasParent() {
+		return []string{}
+	}
+	return ev.FieldHandlers.ResolveCGroupAncestors(ev, &ev.PTrace.Tracee.Parent.CGroup)
+}
+
+// GetPtraceTraceeParentCgroupControllers returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeParentCgroupControllers() []string {
+	if ev.GetEventType().String() != "ptrace" {
+		return []string{}
+	}
+	if ev.PTrace.Tracee == nil {
+		return []string{}
+	}
+	if ev.PTrace.Tracee.Parent == nil {
+		return []string{}
+	}
+	if !ev.PTrace.Tracee.HasParent() {
+		return []string{}
+	}
+	return ev.FieldHandlers.ResolveCGroupControllers(ev, &ev.PTrace.Tracee.Parent.CGroup)
+}
+
+// GetPtraceTraceeParentCgroupCpuUsage returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeParentCgroupCpuUsage() int {
+	if ev.GetEventType().String() != "ptrace" {
+		return 0
+	}
+	if ev.PTrace.Tracee == nil {
+		return 0
+	}
+	if ev.PTrace.Tracee.Parent == nil {
+		return 0
+	}
+	if !ev.PTrace.Tracee.HasParent() {
+		return 0
+	}
+	return ev.FieldHandlers.ResolveCGroupCPUUsage(ev, &ev.PTrace.Tracee.Parent.CGroup)
+}
+
 // GetPtraceTraceeParentCgroupFileInode returns the value of the field, resolving if necessary
 func (ev *Event) GetPtraceTraceeParentCgroupFileInode() uint64 {
 	if ev.GetEventType().String() != "ptrace" {
@@ -12034,32 +14874,134 @@ func (ev *Event) GetPtraceTraceeParentCgroupId() string {
 		return ""
 	}
 	if ev.PTrace.Tracee == nil {
-		return ""
+		return ""
+	}
+	if ev.PTrace.Tracee.Parent == nil {
+		return ""
+	}
+	if !ev.PTrace.Tracee.HasParent() {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveCGroupID(ev, &ev.PTrace.Tracee.Parent.CGroup)
+}
+
+// GetPtraceTraceeParentCgroupManager returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeParentCgroupManager() string {
+	if ev.GetEventType().String() != "ptrace" {
+		return ""
+	}
+	if ev.PTrace.Tracee == nil {
+		return ""
+	}
+	if ev.PTrace.Tracee.Parent == nil {
+		return ""
+	}
+	if !ev.PTrace.Tracee.HasParent() {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveCGroupManager(ev, &ev.PTrace.Tracee.Parent.CGroup)
+}
+
+// GetPtraceTraceeParentCgroupMemoryCurrent returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeParentCgroupMemoryCurrent() int {
+	if ev.GetEventType().String() != "ptrace" {
+		return 0
+	}
+	if ev.PTrace.Tracee == nil {
+		return 0
+	}
+	if ev.PTrace.Tracee.Parent == nil {
+		return 0
+	}
+	if !ev.PTrace.Tracee.HasParent() {
+		return 0
+	}
+	return ev.FieldHandlers.ResolveCGroupMemoryCurrent(ev, &ev.PTrace.Tracee.Parent.CGroup)
+}
+
+// GetPtraceTraceeParentCgroupMemoryHighExceeded returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeParentCgroupMemoryHighExceeded() bool {
+	if ev.GetEventType().String() != "ptrace" {
+		return false
+	}
+	if ev.PTrace.Tracee == nil {
+		return false
+	}
+	if ev.PTrace.Tracee.Parent == nil {
+		return false
+	}
+	if !ev.PTrace.Tracee.HasParent() {
+		return false
+	}
+	return ev.FieldHandlers.ResolveCGroupMemoryHighExceeded(ev, &ev.PTrace.Tracee.Parent.CGroup)
+}
+
+// GetPtraceTraceeParentCgroupPidsCurrent returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeParentCgroupPidsCurrent() int {
+	if ev.GetEventType().String() != "ptrace" {
+		return 0
+	}
+	if ev.PTrace.Tracee == nil {
+		return 0
+	}
+	if ev.PTrace.Tracee.Parent == nil {
+		return 0
+	}
+	if !ev.PTrace.Tracee.HasParent() {
+		return 0
+	}
+	return ev.FieldHandlers.ResolveCGroupPidsCurrent(ev, &ev.PTrace.Tracee.Parent.CGroup)
+}
+
+// GetPtraceTraceeParentCloudCredentialsProviders returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeParentCloudCredentialsProviders() []string {
+	if ev.GetEventType().String() != "ptrace" {
+		return []string{}
+	}
+	if ev.PTrace.Tracee == nil {
+		return []string{}
+	}
+	if ev.PTrace.Tracee.Parent == nil {
+		return []string{}
+	}
+	if !ev.PTrace.Tracee.HasParent() {
+		return []string{}
+	}
+	return ev.FieldHandlers.ResolveProcessCloudCredentialsProviders(ev, ev.PTrace.Tracee.Parent)
+}
+
+// GetPtraceTraceeParentCloudCredentialsResources returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeParentCloudCredentialsResources() []string {
+	if ev.GetEventType().String() != "ptrace" {
+		return []string{}
+	}
+	if ev.PTrace.Tracee == nil {
+		return []string{}
 	}
 	if ev.PTrace.Tracee.Parent == nil {
-		return ""
+		return []string{}
 	}
 	if !ev.PTrace.Tracee.HasParent() {
-		return ""
+		return []string{}
 	}
-	return ev.FieldHandlers.ResolveCGroupID(ev, &ev.PTrace.Tracee.Parent.CGroup)
+	return ev.FieldHandlers.ResolveProcessCloudCredentialsResources(ev, ev.PTrace.Tracee.Parent)
 }
 
-// GetPtraceTraceeParentCgroupManager returns the value of the field, resolving if necessary
-func (ev *Event) GetPtraceTraceeParentCgroupManager() string {
+// GetPtraceTraceeParentCloudCredentialsTypes returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeParentCloudCredentialsTypes() []string {
 	if ev.GetEventType().String() != "ptrace" {
-		return ""
+		return []string{}
 	}
 	if ev.PTrace.Tracee == nil {
-		return ""
+		return []string{}
 	}
 	if ev.PTrace.Tracee.Parent == nil {
-		return ""
+		return []string{}
 	}
 	if !ev.PTrace.Tracee.HasParent() {
-		return ""
+		return []string{}
 	}
-	return ev.FieldHandlers.ResolveCGroupManager(ev, &ev.PTrace.Tracee.Parent.CGroup)
+	return ev.FieldHandlers.ResolveProcessCloudCredentialsTypes(ev, ev.PTrace.Tracee.Parent)
 }
 
 // GetPtraceTraceeParentCmdargv returns the value of the field, resolving if necessary
@@ -12079,6 +15021,23 @@ func (ev *Event) GetPtraceTraceeParentCmdargv() []string {
 	return ev.FieldHandlers.ResolveProcessCmdArgv(ev, ev.PTrace.Tracee.Parent)
 }
 
+// GetPtraceTraceeParentCmdlineObfuscationScore returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeParentCmdlineObfuscationScore() int {
+	if ev.GetEventType().String() != "ptrace" {
+		return 0
+	}
+	if ev.PTrace.Tracee == nil {
+		return 0
+	}
+	if ev.PTrace.Tracee.Parent == nil {
+		return 0
+	}
+	if !ev.PTrace.Tracee.HasParent() {
+		return 0
+	}
+	return ev.FieldHandlers.ResolveProcessCmdLineObfuscationScore(ev, ev.PTrace.Tracee.Parent)
+}
+
 // GetPtraceTraceeParentComm returns the value of the field, resolving if necessary
 func (ev *Event) GetPtraceTraceeParentComm() string {
 	if ev.GetEventType().String() != "ptrace" {
@@ -12164,6 +15123,74 @@ func (ev *Event) GetPtraceTraceeParentEgroup() string {
 	return ev.PTrace.Tracee.Parent.Credentials.EGroup
 }
 
+// GetPtraceTraceeParentElfBuildId returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeParentElfBuildId() string {
+	if ev.GetEventType().String() != "ptrace" {
+		return ""
+	}
+	if ev.PTrace.Tracee == nil {
+		return ""
+	}
+	if ev.PTrace.Tracee.Parent == nil {
+		return ""
+	}
+	if !ev.PTrace.Tracee.HasParent() {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveProcessELFBuildID(ev, ev.PTrace.Tracee.Parent)
+}
+
+// GetPtraceTraceeParentElfInterpreter returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeParentElfInterpreter() string {
+	if ev.GetEventType().String() != "ptrace" {
+		return ""
+	}
+	if ev.PTrace.Tracee == nil {
+		return ""
+	}
+	if ev.PTrace.Tracee.Parent == nil {
+		return ""
+	}
+	if !ev.PTrace.Tracee.HasParent() {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveProcessELFInterpreter(ev, ev.PTrace.Tracee.Parent)
+}
+
+// GetPtraceTraceeParentElfIsStatic returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeParentElfIsStatic() bool {
+	if ev.GetEventType().String() != "ptrace" {
+		return false
+	}
+	if ev.PTrace.Tracee == nil {
+		return false
+	}
+	if ev.PTrace.Tracee.Parent == nil {
+		return false
+	}
+	if !ev.PTrace.Tracee.HasParent() {
+		return false
+	}
+	return ev.FieldHandlers.ResolveProcessELFIsStatic(ev, ev.PTrace.Tracee.Parent)
+}
+
+// GetPtraceTraceeParentElfIsStripped returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeParentElfIsStripped() bool {
+	if ev.GetEventType().String() != "ptrace" {
+		return false
+	}
+	if ev.PTrace.Tracee == nil {
+		return false
+	}
+	if ev.PTrace.Tracee.Parent == nil {
+		return false
+	}
+	if !ev.PTrace.Tracee.HasParent() {
+		return false
+	}
+	return ev.FieldHandlers.ResolveProcessELFIsStripped(ev, ev.PTrace.Tracee.Parent)
+}
+
 // GetPtraceTraceeParentEnvp returns the value of the field, resolving if necessary
 func (ev *Event) GetPtraceTraceeParentEnvp() []string {
 	if ev.GetEventType().String() != "ptrace" {
@@ -12389,6 +15416,46 @@ func (ev *Event) GetPtraceTraceeParentFileInode() uint64 {
 	return ev.PTrace.Tracee.Parent.FileEvent.FileFields.PathKey.Inode
 }
 
+// GetPtraceTraceeParentFileLayerDigest returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeParentFileLayerDigest() string {
+	if ev.GetEventType().String() != "ptrace" {
+		return ""
+	}
+	if ev.PTrace.Tracee == nil {
+		return ""
+	}
+	if ev.PTrace.Tracee.Parent == nil {
+		return ""
+	}
+	if !ev.PTrace.Tracee.HasParent() {
+		return ""
+	}
+	if !ev.PTrace.Tracee.Parent.IsNotKworker() {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.PTrace.Tracee.Parent.FileEvent)
+}
+
+// GetPtraceTraceeParentFileLayerPath returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeParentFileLayerPath() string {
+	if ev.GetEventType().String() != "ptrace" {
+		return ""
+	}
+	if ev.PTrace.Tracee == nil {
+		return ""
+	}
+	if ev.PTrace.Tracee.Parent == nil {
+		return ""
+	}
+	if !ev.PTrace.Tracee.HasParent() {
+		return ""
+	}
+	if !ev.PTrace.Tracee.Parent.IsNotKworker() {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.PTrace.Tracee.Parent.FileEvent)
+}
+
 // GetPtraceTraceeParentFileMode returns the value of the field, resolving if necessary
 func (ev *Event) GetPtraceTraceeParentFileMode() uint16 {
 	if ev.GetEventType().String() != "ptrace" {
@@ -12879,6 +15946,46 @@ func (ev *Event) GetPtraceTraceeParentInterpreterFileInode() uint64 {
 	return ev.PTrace.Tracee.Parent.LinuxBinprm.FileEvent.FileFields.PathKey.Inode
 }
 
+// GetPtraceTraceeParentInterpreterFileLayerDigest returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeParentInterpreterFileLayerDigest() string {
+	if ev.GetEventType().String() != "ptrace" {
+		return ""
+	}
+	if ev.PTrace.Tracee == nil {
+		return ""
+	}
+	if ev.PTrace.Tracee.Parent == nil {
+		return ""
+	}
+	if !ev.PTrace.Tracee.HasParent() {
+		return ""
+	}
+	if !ev.PTrace.Tracee.Parent.HasInterpreter() {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.PTrace.Tracee.Parent.LinuxBinprm.FileEvent)
+}
+
+// GetPtraceTraceeParentInterpreterFileLayerPath returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeParentInterpreterFileLayerPath() string {
+	if ev.GetEventType().String() != "ptrace" {
+		return ""
+	}
+	if ev.PTrace.Tracee == nil {
+		return ""
+	}
+	if ev.PTrace.Tracee.Parent == nil {
+		return ""
+	}
+	if !ev.PTrace.Tracee.HasParent() {
+		return ""
+	}
+	if !ev.PTrace.Tracee.Parent.HasInterpreter() {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.PTrace.Tracee.Parent.LinuxBinprm.FileEvent)
+}
+
 // GetPtraceTraceeParentInterpreterFileMode returns the value of the field, resolving if necessary
 func (ev *Event) GetPtraceTraceeParentInterpreterFileMode() uint16 {
 	if ev.GetEventType().String() != "ptrace" {
@@ -13178,6 +16285,74 @@ func (ev *Event) GetPtraceTraceeParentIsThread() bool {
 	return ev.FieldHandlers.ResolveProcessIsThread(ev, ev.PTrace.Tracee.Parent)
 }
 
+// GetPtraceTraceeParentNsMnt returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeParentNsMnt() int {
+	if ev.GetEventType().String() != "ptrace" {
+		return 0
+	}
+	if ev.PTrace.Tracee == nil {
+		return 0
+	}
+	if ev.PTrace.Tracee.Parent == nil {
+		return 0
+	}
+	if !ev.PTrace.Tracee.HasParent() {
+		return 0
+	}
+	return ev.FieldHandlers.ResolveProcessMountNamespace(ev, ev.PTrace.Tracee.Parent)
+}
+
+// GetPtraceTraceeParentNsPid returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeParentNsPid() int {
+	if ev.GetEventType().String() != "ptrace" {
+		return 0
+	}
+	if ev.PTrace.Tracee == nil {
+		return 0
+	}
+	if ev.PTrace.Tracee.Parent == nil {
+		return 0
+	}
+	if !ev.PTrace.Tracee.HasParent() {
+		return 0
+	}
+	return ev.FieldHandlers.ResolveProcessPIDNamespace(ev, ev.PTrace.Tracee.Parent)
+}
+
+// GetPtraceTraceeParentNsUser returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeParentNsUser() int {
+	if ev.GetEventType().String() != "ptrace" {
+		return 0
+	}
+	if ev.PTrace.Tracee == nil {
+		return 0
+	}
+	if ev.PTrace.Tracee.Parent == nil {
+		return 0
+	}
+	if !ev.PTrace.Tracee.HasParent() {
+		return 0
+	}
+	return ev.FieldHandlers.ResolveProcessUserNamespace(ev, ev.PTrace.Tracee.Parent)
+}
+
+// GetPtraceTraceeParentNsUts returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeParentNsUts() int {
+	if ev.GetEventType().String() != "ptrace" {
+		return 0
+	}
+	if ev.PTrace.Tracee == nil {
+		return 0
+	}
+	if ev.PTrace.Tracee.Parent == nil {
+		return 0
+	}
+	if !ev.PTrace.Tracee.HasParent() {
+		return 0
+	}
+	return ev.FieldHandlers.ResolveProcessUTSNamespace(ev, ev.PTrace.Tracee.Parent)
+}
+
 // GetPtraceTraceeParentPid returns the value of the field, resolving if necessary
 func (ev *Event) GetPtraceTraceeParentPid() uint32 {
 	if ev.GetEventType().String() != "ptrace" {
@@ -13212,6 +16387,40 @@ func (ev *Event) GetPtraceTraceeParentPpid() uint32 {
 	return ev.PTrace.Tracee.Parent.PPid
 }
 
+// GetPtraceTraceeParentSystemdSlice returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeParentSystemdSlice() string {
+	if ev.GetEventType().String() != "ptrace" {
+		return ""
+	}
+	if ev.PTrace.Tracee == nil {
+		return ""
+	}
+	if ev.PTrace.Tracee.Parent == nil {
+		return ""
+	}
+	if !ev.PTrace.Tracee.HasParent() {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveProcessSystemdSlice(ev, ev.PTrace.Tracee.Parent)
+}
+
+// GetPtraceTraceeParentSystemdUnit returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeParentSystemdUnit() string {
+	if ev.GetEventType().String() != "ptrace" {
+		return ""
+	}
+	if ev.PTrace.Tracee == nil {
+		return ""
+	}
+	if ev.PTrace.Tracee.Parent == nil {
+		return ""
+	}
+	if !ev.PTrace.Tracee.HasParent() {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveProcessSystemdUnit(ev, ev.PTrace.Tracee.Parent)
+}
+
 // GetPtraceTraceeParentTid returns the value of the field, resolving if necessary
 func (ev *Event) GetPtraceTraceeParentTid() uint32 {
 	if ev.GetEventType().String() != "ptrace" {
@@ -13353,6 +16562,28 @@ func (ev *Event) GetPtraceTraceePpid() uint32 {
 	return ev.PTrace.Tracee.Process.PPid
 }
 
+// GetPtraceTraceeSystemdSlice returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeSystemdSlice() string {
+	if ev.GetEventType().String() != "ptrace" {
+		return ""
+	}
+	if ev.PTrace.Tracee == nil {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveProcessSystemdSlice(ev, &ev.PTrace.Tracee.Process)
+}
+
+// GetPtraceTraceeSystemdUnit returns the value of the field, resolving if necessary
+func (ev *Event) GetPtraceTraceeSystemdUnit() string {
+	if ev.GetEventType().String() != "ptrace" {
+		return ""
+	}
+	if ev.PTrace.Tracee == nil {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveProcessSystemdUnit(ev, &ev.PTrace.Tracee.Process)
+}
+
 // GetPtraceTraceeTid returns the value of the field, resolving if necessary
 func (ev *Event) GetPtraceTraceeTid() uint32 {
 	if ev.GetEventType().String() != "ptrace" {
@@ -13502,6 +16733,22 @@ func (ev *Event) GetRemovexattrFileInode() uint64 {
 	return ev.RemoveXAttr.File.FileFields.PathKey.Inode
 }
 
+// GetRemovexattrFileLayerDigest returns the value of the field, resolving if necessary
+func (ev *Event) GetRemovexattrFileLayerDigest() string {
+	if ev.GetEventType().String() != "removexattr" {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.RemoveXAttr.File)
+}
+
+// GetRemovexattrFileLayerPath returns the value of the field, resolving if necessary
+func (ev *Event) GetRemovexattrFileLayerPath() string {
+	if ev.GetEventType().String() != "removexattr" {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.RemoveXAttr.File)
+}
+
 // GetRemovexattrFileMode returns the value of the field, resolving if necessary
 func (ev *Event) GetRemovexattrFileMode() uint16 {
 	if ev.GetEventType().String() != "removexattr" {
@@ -13678,6 +16925,22 @@ func (ev *Event) GetRenameFileDestinationInode() uint64 {
 	return ev.Rename.New.FileFields.PathKey.Inode
 }
 
+// GetRenameFileDestinationLayerDigest returns the value of the field, resolving if necessary
+func (ev *Event) GetRenameFileDestinationLayerDigest() string {
+	if ev.GetEventType().String() != "rename" {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Rename.New)
+}
+
+// GetRenameFileDestinationLayerPath returns the value of the field, resolving if necessary
+func (ev *Event) GetRenameFileDestinationLayerPath() string {
+	if ev.GetEventType().String() != "rename" {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Rename.New)
+}
+
 // GetRenameFileDestinationMode returns the value of the field, resolving if necessary
 func (ev *Event) GetRenameFileDestinationMode() uint16 {
 	if ev.GetEventType().String() != "rename" {
@@ -13830,6 +17093,22 @@ func (ev *Event) GetRenameFileInode() uint64 {
 	return ev.Rename.Old.FileFields.PathKey.Inode
 }
 
+// GetRenameFileLayerDigest returns the value of the field, resolving if necessary
+func (ev *Event) GetRenameFileLayerDigest() string {
+	if ev.GetEventType().String() != "rename" {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Rename.Old)
+}
+
+// GetRenameFileLayerPath returns the value of the field, resolving if necessary
+func (ev *Event) GetRenameFileLayerPath() string {
+	if ev.GetEventType().String() != "rename" {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Rename.Old)
+}
+
 // GetRenameFileMode returns the value of the field, resolving if necessary
 func (ev *Event) GetRenameFileMode() uint16 {
 	if ev.GetEventType().String() != "rename" {
@@ -14062,6 +17341,22 @@ func (ev *Event) GetRmdirFileInode() uint64 {
 	return ev.Rmdir.File.FileFields.PathKey.Inode
 }
 
+// GetRmdirFileLayerDigest returns the value of the field, resolving if necessary
+func (ev *Event) GetRmdirFileLayerDigest() string {
+	if ev.GetEventType().String() != "rmdir" {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Rmdir.File)
+}
+
+// GetRmdirFileLayerPath returns the value of the field, resolving if necessary
+func (ev *Event) GetRmdirFileLayerPath() string {
+	if ev.GetEventType().String() != "rmdir" {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Rmdir.File)
+}
+
 // GetRmdirFileMode returns the value of the field, resolving if necessary
 func (ev *Event) GetRmdirFileMode() uint16 {
 	if ev.GetEventType().String() != "rmdir" {
@@ -14227,331 +17522,683 @@ func (ev *Event) GetSetgidFsgid() uint32 {
 	if ev.GetEventType().String() != "setgid" {
 		return uint32(0)
 	}
-	return ev.SetGID.FSGID
+	return ev.SetGID.FSGID
+}
+
+// GetSetgidFsgroup returns the value of the field, resolving if necessary
+func (ev *Event) GetSetgidFsgroup() string {
+	if ev.GetEventType().String() != "setgid" {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveSetgidFSGroup(ev, &ev.SetGID)
+}
+
+// GetSetgidGid returns the value of the field, resolving if necessary
+func (ev *Event) GetSetgidGid() uint32 {
+	if ev.GetEventType().String() != "setgid" {
+		return uint32(0)
+	}
+	return ev.SetGID.GID
+}
+
+// GetSetgidGroup returns the value of the field, resolving if necessary
+func (ev *Event) GetSetgidGroup() string {
+	if ev.GetEventType().String() != "setgid" {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveSetgidGroup(ev, &ev.SetGID)
+}
+
+// GetSetuidEuid returns the value of the field, resolving if necessary
+func (ev *Event) GetSetuidEuid() uint32 {
+	if ev.GetEventType().String() != "setuid" {
+		return uint32(0)
+	}
+	return ev.SetUID.EUID
+}
+
+// GetSetuidEuser returns the value of the field, resolving if necessary
+func (ev *Event) GetSetuidEuser() string {
+	if ev.GetEventType().String() != "setuid" {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveSetuidEUser(ev, &ev.SetUID)
+}
+
+// GetSetuidFsuid returns the value of the field, resolving if necessary
+func (ev *Event) GetSetuidFsuid() uint32 {
+	if ev.GetEventType().String() != "setuid" {
+		return uint32(0)
+	}
+	return ev.SetUID.FSUID
+}
+
+// GetSetuidFsuser returns the value of the field, resolving if necessary
+func (ev *Event) GetSetuidFsuser() string {
+	if ev.GetEventType().String() != "setuid" {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveSetuidFSUser(ev, &ev.SetUID)
+}
+
+// GetSetuidUid returns the value of the field, resolving if necessary
+func (ev *Event) GetSetuidUid() uint32 {
+	if ev.GetEventType().String() != "setuid" {
+		return uint32(0)
+	}
+	return ev.SetUID.UID
+}
+
+// GetSetuidUser returns the value of the field, resolving if necessary
+func (ev *Event) GetSetuidUser() string {
+	if ev.GetEventType().String() != "setuid" {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveSetuidUser(ev, &ev.SetUID)
+}
+
+// GetSetxattrFileChangeTime returns the value of the field, resolving if necessary
+func (ev *Event) GetSetxattrFileChangeTime() uint64 {
+	if ev.GetEventType().String() != "setxattr" {
+		return uint64(0)
+	}
+	return ev.SetXAttr.File.FileFields.CTime
+}
+
+// GetSetxattrFileDestinationName returns the value of the field, resolving if necessary
+func (ev *Event) GetSetxattrFileDestinationName() string {
+	if ev.GetEventType().String() != "setxattr" {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveXAttrName(ev, &ev.SetXAttr)
+}
+
+// GetSetxattrFileDestinationNamespace returns the value of the field, resolving if necessary
+func (ev *Event) GetSetxattrFileDestinationNamespace() string {
+	if ev.GetEventType().String() != "setxattr" {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveXAttrNamespace(ev, &ev.SetXAttr)
+}
+
+// GetSetxattrFileFilesystem returns the value of the field, resolving if necessary
+func (ev *Event) GetSetxattrFileFilesystem() string {
+	if ev.GetEventType().String() != "setxattr" {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.SetXAttr.File)
+}
+
+// GetSetxattrFileGid returns the value of the field, resolving if necessary
+func (ev *Event) GetSetxattrFileGid() uint32 {
+	if ev.GetEventType().String() != "setxattr" {
+		return uint32(0)
+	}
+	return ev.SetXAttr.File.FileFields.GID
+}
+
+// GetSetxattrFileGroup returns the value of the field, resolving if necessary
+func (ev *Event) GetSetxattrFileGroup() string {
+	if ev.GetEventType().String() != "setxattr" {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileFieldsGroup(ev, &ev.SetXAttr.File.FileFields)
+}
+
+// GetSetxattrFileHashes returns the value of the field, resolving if necessary
+func (ev *Event) GetSetxattrFileHashes() []string {
+	if ev.GetEventType().String() != "setxattr" {
+		return []string{}
+	}
+	return ev.FieldHandlers.ResolveHashesFromEvent(ev, &ev.SetXAttr.File)
+}
+
+// GetSetxattrFileInUpperLayer returns the value of the field, resolving if necessary
+func (ev *Event) GetSetxattrFileInUpperLayer() bool {
+	if ev.GetEventType().String() != "setxattr" {
+		return false
+	}
+	return ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.SetXAttr.File.FileFields)
+}
+
+// GetSetxattrFileInode returns the value of the field, resolving if necessary
+func (ev *Event) GetSetxattrFileInode() uint64 {
+	if ev.GetEventType().String() != "setxattr" {
+		return uint64(0)
+	}
+	return ev.SetXAttr.File.FileFields.PathKey.Inode
+}
+
+// GetSetxattrFileLayerDigest returns the value of the field, resolving if necessary
+func (ev *Event) GetSetxattrFileLayerDigest() string {
+	if ev.GetEventType().String() != "setxattr" {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.SetXAttr.File)
+}
+
+// GetSetxattrFileLayerPath returns the value of the field, resolving if necessary
+func (ev *Event) GetSetxattrFileLayerPath() string {
+	if ev.GetEventType().String() != "setxattr" {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.SetXAttr.File)
+}
+
+// GetSetxattrFileMode returns the value of the field, resolving if necessary
+func (ev *Event) GetSetxattrFileMode() uint16 {
+	if ev.GetEventType().String() != "setxattr" {
+		return uint16(0)
+	}
+	return ev.SetXAttr.File.FileFields.Mode
+}
+
+// GetSetxattrFileModificationTime returns the value of the field, resolving if necessary
+func (ev *Event) GetSetxattrFileModificationTime() uint64 {
+	if ev.GetEventType().String() != "setxattr" {
+		return uint64(0)
+	}
+	return ev.SetXAttr.File.FileFields.MTime
+}
+
+// GetSetxattrFileMountId returns the value of the field, resolving if necessary
+func (ev *Event) GetSetxattrFileMountId() uint32 {
+	if ev.GetEventType().String() != "setxattr" {
+		return uint32(0)
+	}
+	return ev.SetXAttr.File.FileFields.PathKey.MountID
+}
+
+// GetSetxattrFileName returns the value of the field, resolving if necessary
+func (ev *Event) GetSetxattrFileName() string {
+	if ev.GetEventType().String() != "setxattr" {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileBasename(ev, &ev.SetXAttr.File)
+}
+
+// GetSetxattrFileNameLength returns the value of the field, resolving if necessary
+func (ev *Event) GetSetxattrFileNameLength() int {
+	if ev.GetEventType().String() != "setxattr" {
+		return 0
+	}
+	return len(ev.FieldHandlers.ResolveFileBasename(ev, &ev.SetXAttr.File))
+}
+
+// GetSetxattrFilePackageName returns the value of the field, resolving if necessary
+func (ev *Event) GetSetxattrFilePackageName() string {
+	if ev.GetEventType().String() != "setxattr" {
+		return ""
+	}
+	return ev.FieldHandlers.ResolvePackageName(ev, &ev.SetXAttr.File)
+}
+
+// GetSetxattrFilePackageSourceVersion returns the value of the field, resolving if necessary
+func (ev *Event) GetSetxattrFilePackageSourceVersion() string {
+	if ev.GetEventType().String() != "setxattr" {
+		return ""
+	}
+	return ev.FieldHandlers.ResolvePackageSourceVersion(ev, &ev.SetXAttr.File)
+}
+
+// GetSetxattrFilePackageVersion returns the value of the field, resolving if necessary
+func (ev *Event) GetSetxattrFilePackageVersion() string {
+	if ev.GetEventType().String() != "setxattr" {
+		return ""
+	}
+	return ev.FieldHandlers.ResolvePackageVersion(ev, &ev.SetXAttr.File)
 }
 
-// GetSetgidFsgroup returns the value of the field, resolving if necessary
-func (ev *Event) GetSetgidFsgroup() string {
-	if ev.GetEventType().String() != "setgid" {
+// GetSetxattrFilePath returns the value of the field, resolving if necessary
+func (ev *Event) GetSetxattrFilePath() string {
+	if ev.GetEventType().String() != "setxattr" {
 		return ""
 	}
-	return ev.FieldHandlers.ResolveSetgidFSGroup(ev, &ev.SetGID)
+	return ev.FieldHandlers.ResolveFilePath(ev, &ev.SetXAttr.File)
 }
 
-// GetSetgidGid returns the value of the field, resolving if necessary
-func (ev *Event) GetSetgidGid() uint32 {
-	if ev.GetEventType().String() != "setgid" {
-		return uint32(0)
+// GetSetxattrFilePathLength returns the value of the field, resolving if necessary
+func (ev *Event) GetSetxattrFilePathLength() int {
+	if ev.GetEventType().String() != "setxattr" {
+		return 0
 	}
-	return ev.SetGID.GID
+	return len(ev.FieldHandlers.ResolveFilePath(ev, &ev.SetXAttr.File))
 }
 
-// GetSetgidGroup returns the value of the field, resolving if necessary
-func (ev *Event) GetSetgidGroup() string {
-	if ev.GetEventType().String() != "setgid" {
-		return ""
+// GetSetxattrFileRights returns the value of the field, resolving if necessary
+func (ev *Event) GetSetxattrFileRights() int {
+	if ev.GetEventType().String() != "setxattr" {
+		return 0
 	}
-	return ev.FieldHandlers.ResolveSetgidGroup(ev, &ev.SetGID)
+	return ev.FieldHandlers.ResolveRights(ev, &ev.SetXAttr.File.FileFields)
 }
 
-// GetSetuidEuid returns the value of the field, resolving if necessary
-func (ev *Event) GetSetuidEuid() uint32 {
-	if ev.GetEventType().String() != "setuid" {
+// GetSetxattrFileUid returns the value of the field, resolving if necessary
+func (ev *Event) GetSetxattrFileUid() uint32 {
+	if ev.GetEventType().String() != "setxattr" {
 		return uint32(0)
 	}
-	return ev.SetUID.EUID
+	return ev.SetXAttr.File.FileFields.UID
 }
 
-// GetSetuidEuser returns the value of the field, resolving if necessary
-func (ev *Event) GetSetuidEuser() string {
-	if ev.GetEventType().String() != "setuid" {
+// GetSetxattrFileUser returns the value of the field, resolving if necessary
+func (ev *Event) GetSetxattrFileUser() string {
+	if ev.GetEventType().String() != "setxattr" {
 		return ""
 	}
-	return ev.FieldHandlers.ResolveSetuidEUser(ev, &ev.SetUID)
+	return ev.FieldHandlers.ResolveFileFieldsUser(ev, &ev.SetXAttr.File.FileFields)
 }
 
-// GetSetuidFsuid returns the value of the field, resolving if necessary
-func (ev *Event) GetSetuidFsuid() uint32 {
-	if ev.GetEventType().String() != "setuid" {
-		return uint32(0)
+// GetSetxattrRetval returns the value of the field, resolving if necessary
+func (ev *Event) GetSetxattrRetval() int64 {
+	if ev.GetEventType().String() != "setxattr" {
+		return int64(0)
 	}
-	return ev.SetUID.FSUID
+	return ev.SetXAttr.SyscallEvent.Retval
 }
 
-// GetSetuidFsuser returns the value of the field, resolving if necessary
-func (ev *Event) GetSetuidFsuser() string {
-	if ev.GetEventType().String() != "setuid" {
-		return ""
+// GetSignalPid returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalPid() uint32 {
+	if ev.GetEventType().String() != "signal" {
+		return uint32(0)
 	}
-	return ev.FieldHandlers.ResolveSetuidFSUser(ev, &ev.SetUID)
+	return ev.Signal.PID
 }
 
-// GetSetuidUid returns the value of the field, resolving if necessary
-func (ev *Event) GetSetuidUid() uint32 {
-	if ev.GetEventType().String() != "setuid" {
-		return uint32(0)
+// GetSignalRetval returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalRetval() int64 {
+	if ev.GetEventType().String() != "signal" {
+		return int64(0)
 	}
-	return ev.SetUID.UID
+	return ev.Signal.SyscallEvent.Retval
 }
 
-// GetSetuidUser returns the value of the field, resolving if necessary
-func (ev *Event) GetSetuidUser() string {
-	if ev.GetEventType().String() != "setuid" {
-		return ""
+// GetSignalTargetAncestorsArgs returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetAncestorsArgs() []string {
+	if ev.GetEventType().String() != "signal" {
+		return []string{}
 	}
-	return ev.FieldHandlers.ResolveSetuidUser(ev, &ev.SetUID)
+	if ev.Signal.Target == nil {
+		return []string{}
+	}
+	if ev.Signal.Target.Ancestor == nil {
+		return []string{}
+	}
+	var values []string
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := ev.FieldHandlers.ResolveProcessArgs(ev, &element.ProcessContext.Process)
+		values = append(values, result)
+		ptr = iterator.Next()
+	}
+	return values
 }
 
-// GetSetxattrFileChangeTime returns the value of the field, resolving if necessary
-func (ev *Event) GetSetxattrFileChangeTime() uint64 {
-	if ev.GetEventType().String() != "setxattr" {
-		return uint64(0)
+// GetSignalTargetAncestorsArgsFlags returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetAncestorsArgsFlags() []string {
+	if ev.GetEventType().String() != "signal" {
+		return []string{}
 	}
-	return ev.SetXAttr.File.FileFields.CTime
+	if ev.Signal.Target == nil {
+		return []string{}
+	}
+	if ev.Signal.Target.Ancestor == nil {
+		return []string{}
+	}
+	var values []string
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := ev.FieldHandlers.ResolveProcessArgsFlags(ev, &element.ProcessContext.Process)
+		values = append(values, result...)
+		ptr = iterator.Next()
+	}
+	return values
 }
 
-// GetSetxattrFileDestinationName returns the value of the field, resolving if necessary
-func (ev *Event) GetSetxattrFileDestinationName() string {
-	if ev.GetEventType().String() != "setxattr" {
-		return ""
+// GetSignalTargetAncestorsArgsOptions returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetAncestorsArgsOptions() []string {
+	if ev.GetEventType().String() != "signal" {
+		return []string{}
 	}
-	return ev.FieldHandlers.ResolveXAttrName(ev, &ev.SetXAttr)
+	if ev.Signal.Target == nil {
+		return []string{}
+	}
+	if ev.Signal.Target.Ancestor == nil {
+		return []string{}
+	}
+	var values []string
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := ev.FieldHandlers.ResolveProcessArgsOptions(ev, &element.ProcessContext.Process)
+		values = append(values, result...)
+		ptr = iterator.Next()
+	}
+	return values
 }
 
-// GetSetxattrFileDestinationNamespace returns the value of the field, resolving if necessary
-func (ev *Event) GetSetxattrFileDestinationNamespace() string {
-	if ev.GetEventType().String() != "setxattr" {
-		return ""
+// GetSignalTargetAncestorsArgsScrubbed returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetAncestorsArgsScrubbed() []string {
+	if ev.GetEventType().String() != "signal" {
+		return []string{}
 	}
-	return ev.FieldHandlers.ResolveXAttrNamespace(ev, &ev.SetXAttr)
+	if ev.Signal.Target == nil {
+		return []string{}
+	}
+	if ev.Signal.Target.Ancestor == nil {
+		return []string{}
+	}
+	var values []string
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := ev.FieldHandlers.ResolveProcessArgsScrubbed(ev, &element.ProcessContext.Process)
+		values = append(values, result)
+		ptr = iterator.Next()
+	}
+	return values
 }
 
-// GetSetxattrFileFilesystem returns the value of the field, resolving if necessary
-func (ev *Event) GetSetxattrFileFilesystem() string {
-	if ev.GetEventType().String() != "setxattr" {
-		return ""
+// GetSignalTargetAncestorsArgsTruncated returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetAncestorsArgsTruncated() []bool {
+	if ev.GetEventType().String() != "signal" {
+		return []bool{}
 	}
-	return ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.SetXAttr.File)
+	if ev.Signal.Target == nil {
+		return []bool{}
+	}
+	if ev.Signal.Target.Ancestor == nil {
+		return []bool{}
+	}
+	var values []bool
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := ev.FieldHandlers.ResolveProcessArgsTruncated(ev, &element.ProcessContext.Process)
+		values = append(values, result)
+		ptr = iterator.Next()
+	}
+	return values
 }
 
-// GetSetxattrFileGid returns the value of the field, resolving if necessary
-func (ev *Event) GetSetxattrFileGid() uint32 {
-	if ev.GetEventType().String() != "setxattr" {
-		return uint32(0)
+// GetSignalTargetAncestorsArgv returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetAncestorsArgv() []string {
+	if ev.GetEventType().String() != "signal" {
+		return []string{}
 	}
-	return ev.SetXAttr.File.FileFields.GID
+	if ev.Signal.Target == nil {
+		return []string{}
+	}
+	if ev.Signal.Target.Ancestor == nil {
+		return []string{}
+	}
+	var values []string
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := ev.FieldHandlers.ResolveProcessArgv(ev, &element.ProcessContext.Process)
+		values = append(values, result...)
+		ptr = iterator.Next()
+	}
+	return values
 }
 
-// GetSetxattrFileGroup returns the value of the field, resolving if necessary
-func (ev *Event) GetSetxattrFileGroup() string {
-	if ev.GetEventType().String() != "setxattr" {
-		return ""
+// GetSignalTargetAncestorsArgv0 returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetAncestorsArgv0() []string {
+	if ev.GetEventType().String() != "signal" {
+		return []string{}
+	}
+	if ev.Signal.Target == nil {
+		return []string{}
+	}
+	if ev.Signal.Target.Ancestor == nil {
+		return []string{}
+	}
+	var values []string
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := ev.FieldHandlers.ResolveProcessArgv0(ev, &element.ProcessContext.Process)
+		values = append(values, result)
+		ptr = iterator.Next()
 	}
-	return ev.FieldHandlers.ResolveFileFieldsGroup(ev, &ev.SetXAttr.File.FileFields)
+	return values
 }
 
-// GetSetxattrFileHashes returns the value of the field, resolving if necessary
-func (ev *Event) GetSetxattrFileHashes() []string {
-	if ev.GetEventType().String() != "setxattr" {
+// GetSignalTargetAncestorsArgvScrubbed returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetAncestorsArgvScrubbed() []string {
+	if ev.GetEventType().String() != "signal" {
 		return []string{}
 	}
-	return ev.FieldHandlers.ResolveHashesFromEvent(ev, &ev.SetXAttr.File)
-}
-
-// GetSetxattrFileInUpperLayer returns the value of the field, resolving if necessary
-func (ev *Event) GetSetxattrFileInUpperLayer() bool {
-	if ev.GetEventType().String() != "setxattr" {
-		return false
+	if ev.Signal.Target == nil {
+		return []string{}
 	}
-	return ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.SetXAttr.File.FileFields)
-}
-
-// GetSetxattrFileInode returns the value of the field, resolving if necessary
-func (ev *Event) GetSetxattrFileInode() uint64 {
-	if ev.GetEventType().String() != "setxattr" {
-		return uint64(0)
+	if ev.Signal.Target.Ancestor == nil {
+		return []string{}
 	}
-	return ev.SetXAttr.File.FileFields.PathKey.Inode
-}
-
-// GetSetxattrFileMode returns the value of the field, resolving if necessary
-func (ev *Event) GetSetxattrFileMode() uint16 {
-	if ev.GetEventType().String() != "setxattr" {
-		return uint16(0)
+	var values []string
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := ev.FieldHandlers.ResolveProcessArgvScrubbed(ev, &element.ProcessContext.Process)
+		values = append(values, result...)
+		ptr = iterator.Next()
 	}
-	return ev.SetXAttr.File.FileFields.Mode
+	return values
 }
 
-// GetSetxattrFileModificationTime returns the value of the field, resolving if necessary
-func (ev *Event) GetSetxattrFileModificationTime() uint64 {
-	if ev.GetEventType().String() != "setxattr" {
-		return uint64(0)
+// GetSignalTargetAncestorsAuid returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetAncestorsAuid() []uint32 {
+	if ev.GetEventType().String() != "signal" {
+		return []uint32{}
 	}
-	return ev.SetXAttr.File.FileFields.MTime
-}
-
-// GetSetxattrFileMountId returns the value of the field, resolving if necessary
-func (ev *Event) GetSetxattrFileMountId() uint32 {
-	if ev.GetEventType().String() != "setxattr" {
-		return uint32(0)
+	if ev.Signal.Target == nil {
+		return []uint32{}
 	}
-	return ev.SetXAttr.File.FileFields.PathKey.MountID
-}
-
-// GetSetxattrFileName returns the value of the field, resolving if necessary
-func (ev *Event) GetSetxattrFileName() string {
-	if ev.GetEventType().String() != "setxattr" {
-		return ""
+	if ev.Signal.Target.Ancestor == nil {
+		return []uint32{}
 	}
-	return ev.FieldHandlers.ResolveFileBasename(ev, &ev.SetXAttr.File)
-}
-
-// GetSetxattrFileNameLength returns the value of the field, resolving if necessary
-func (ev *Event) GetSetxattrFileNameLength() int {
-	if ev.GetEventType().String() != "setxattr" {
-		return 0
+	var values []uint32
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := element.ProcessContext.Process.Credentials.AUID
+		values = append(values, result)
+		ptr = iterator.Next()
 	}
-	return len(ev.FieldHandlers.ResolveFileBasename(ev, &ev.SetXAttr.File))
+	return values
 }
 
-// GetSetxattrFilePackageName returns the value of the field, resolving if necessary
-func (ev *Event) GetSetxattrFilePackageName() string {
-	if ev.GetEventType().String() != "setxattr" {
-		return ""
+// GetSignalTargetAncestorsCapEffective returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetAncestorsCapEffective() []uint64 {
+	if ev.GetEventType().String() != "signal" {
+		return []uint64{}
 	}
-	return ev.FieldHandlers.ResolvePackageName(ev, &ev.SetXAttr.File)
-}
-
-// GetSetxattrFilePackageSourceVersion returns the value of the field, resolving if necessary
-func (ev *Event) GetSetxattrFilePackageSourceVersion() string {
-	if ev.GetEventType().String() != "setxattr" {
-		return ""
+	if ev.Signal.Target == nil {
+		return []uint64{}
 	}
-	return ev.FieldHandlers.ResolvePackageSourceVersion(ev, &ev.SetXAttr.File)
-}
-
-// GetSetxattrFilePackageVersion returns the value of the field, resolving if necessary
-func (ev *Event) GetSetxattrFilePackageVersion() string {
-	if ev.GetEventType().String() != "setxattr" {
-		return ""
+	if ev.Signal.Target.Ancestor == nil {
+		return []uint64{}
 	}
-	return ev.FieldHandlers.ResolvePackageVersion(ev, &ev.SetXAttr.File)
-}
-
-// GetSetxattrFilePath returns the value of the field, resolving if necessary
-func (ev *Event) GetSetxattrFilePath() string {
-	if ev.GetEventType().String() != "setxattr" {
-		return ""
+	var values []uint64
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := element.ProcessContext.Process.Credentials.CapEffective
+		values = append(values, result)
+		ptr = iterator.Next()
 	}
-	return ev.FieldHandlers.ResolveFilePath(ev, &ev.SetXAttr.File)
+	return values
 }
 
-// GetSetxattrFilePathLength returns the value of the field, resolving if necessary
-func (ev *Event) GetSetxattrFilePathLength() int {
-	if ev.GetEventType().String() != "setxattr" {
-		return 0
+// GetSignalTargetAncestorsCapPermitted returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetAncestorsCapPermitted() []uint64 {
+	if ev.GetEventType().String() != "signal" {
+		return []uint64{}
 	}
-	return len(ev.FieldHandlers.ResolveFilePath(ev, &ev.SetXAttr.File))
-}
-
-// GetSetxattrFileRights returns the value of the field, resolving if necessary
-func (ev *Event) GetSetxattrFileRights() int {
-	if ev.GetEventType().String() != "setxattr" {
-		return 0
+	if ev.Signal.Target == nil {
+		return []uint64{}
 	}
-	return ev.FieldHandlers.ResolveRights(ev, &ev.SetXAttr.File.FileFields)
-}
-
-// GetSetxattrFileUid returns the value of the field, resolving if necessary
-func (ev *Event) GetSetxattrFileUid() uint32 {
-	if ev.GetEventType().String() != "setxattr" {
-		return uint32(0)
+	if ev.Signal.Target.Ancestor == nil {
+		return []uint64{}
 	}
-	return ev.SetXAttr.File.FileFields.UID
-}
-
-// GetSetxattrFileUser returns the value of the field, resolving if necessary
-func (ev *Event) GetSetxattrFileUser() string {
-	if ev.GetEventType().String() != "setxattr" {
-		return ""
+	var values []uint64
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := element.ProcessContext.Process.Credentials.CapPermitted
+		values = append(values, result)
+		ptr = iterator.Next()
 	}
-	return ev.FieldHandlers.ResolveFileFieldsUser(ev, &ev.SetXAttr.File.FileFields)
+	return values
 }
 
-// GetSetxattrRetval returns the value of the field, resolving if necessary
-func (ev *Event) GetSetxattrRetval() int64 {
-	if ev.GetEventType().String() != "setxattr" {
-		return int64(0)
+// GetSignalTargetAncestorsCgroupAncestors returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetAncestorsCgroupAncestors() []string {
+	if ev.GetEventType().String() != "signal" {
+		return []string{}
 	}
-	return ev.SetXAttr.SyscallEvent.Retval
+	if ev.Signal.Target == nil {
+		return []string{}
+	}
+	if ev.Signal.Target.Ancestor == nil {
+		return []string{}
+	}
+	var values []string
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := ev.FieldHandlers.ResolveCGroupAncestors(ev, &element.ProcessContext.Process.CGroup)
+		values = append(values, result...)
+		ptr = iterator.Next()
+	}
+	return values
 }
 
-// GetSignalPid returns the value of the field, resolving if necessary
-func (ev *Event) GetSignalPid() uint32 {
+// GetSignalTargetAncestorsCgroupControllers returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetAncestorsCgroupControllers() []string {
 	if ev.GetEventType().String() != "signal" {
-		return uint32(0)
+		return []string{}
 	}
-	return ev.Signal.PID
+	if ev.Signal.Target == nil {
+		return []string{}
+	}
+	if ev.Signal.Target.Ancestor == nil {
+		return []string{}
+	}
+	var values []string
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := ev.FieldHandlers.ResolveCGroupControllers(ev, &element.ProcessContext.Process.CGroup)
+		values = append(values, result...)
+		ptr = iterator.Next()
+	}
+	return values
 }
 
-// GetSignalRetval returns the value of the field, resolving if necessary
-func (ev *Event) GetSignalRetval() int64 {
+// GetSignalTargetAncestorsCgroupCpuUsage returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetAncestorsCgroupCpuUsage() []int {
 	if ev.GetEventType().String() != "signal" {
-		return int64(0)
+		return []int{}
 	}
-	return ev.Signal.SyscallEvent.Retval
+	if ev.Signal.Target == nil {
+		return []int{}
+	}
+	if ev.Signal.Target.Ancestor == nil {
+		return []int{}
+	}
+	var values []int
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := int(ev.FieldHandlers.ResolveCGroupCPUUsage(ev, &element.ProcessContext.Process.CGroup))
+		values = append(values, result)
+		ptr = iterator.Next()
+	}
+	return values
 }
 
-// GetSignalTargetAncestorsArgs returns the value of the field, resolving if necessary
-func (ev *Event) GetSignalTargetAncestorsArgs() []string {
+// GetSignalTargetAncestorsCgroupFileInode returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetAncestorsCgroupFileInode() []uint64 {
 	if ev.GetEventType().String() != "signal" {
-		return []string{}
+		return []uint64{}
 	}
 	if ev.Signal.Target == nil {
-		return []string{}
+		return []uint64{}
 	}
 	if ev.Signal.Target.Ancestor == nil {
-		return []string{}
+		return []uint64{}
 	}
-	var values []string
+	var values []uint64
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := ev.FieldHandlers.ResolveProcessArgs(ev, &element.ProcessContext.Process)
+		result := element.ProcessContext.Process.CGroup.CGroupFile.Inode
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetSignalTargetAncestorsArgsFlags returns the value of the field, resolving if necessary
-func (ev *Event) GetSignalTargetAncestorsArgsFlags() []string {
+// GetSignalTargetAncestorsCgroupFileMountId returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetAncestorsCgroupFileMountId() []uint32 {
 	if ev.GetEventType().String() != "signal" {
-		return []string{}
+		return []uint32{}
 	}
 	if ev.Signal.Target == nil {
-		return []string{}
+		return []uint32{}
 	}
 	if ev.Signal.Target.Ancestor == nil {
-		return []string{}
+		return []uint32{}
 	}
-	var values []string
+	var values []uint32
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := ev.FieldHandlers.ResolveProcessArgsFlags(ev, &element.ProcessContext.Process)
-		values = append(values, result...)
+		result := element.ProcessContext.Process.CGroup.CGroupFile.MountID
+		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetSignalTargetAncestorsArgsOptions returns the value of the field, resolving if necessary
-func (ev *Event) GetSignalTargetAncestorsArgsOptions() []string {
+// GetSignalTargetAncestorsCgroupId returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetAncestorsCgroupId() []string {
 	if ev.GetEventType().String() != "signal" {
 		return []string{}
 	}
@@ -14567,15 +18214,15 @@ func (ev *Event) GetSignalTargetAncestorsArgsOptions() []string {
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := ev.FieldHandlers.ResolveProcessArgsOptions(ev, &element.ProcessContext.Process)
-		values = append(values, result...)
+		result := ev.FieldHandlers.ResolveCGroupID(ev, &element.ProcessContext.Process.CGroup)
+		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetSignalTargetAncestorsArgsScrubbed returns the value of the field, resolving if necessary
-func (ev *Event) GetSignalTargetAncestorsArgsScrubbed() []string {
+// GetSignalTargetAncestorsCgroupManager returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetAncestorsCgroupManager() []string {
 	if ev.GetEventType().String() != "signal" {
 		return []string{}
 	}
@@ -14591,87 +18238,87 @@ func (ev *Event) GetSignalTargetAncestorsArgsScrubbed() []string {
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := ev.FieldHandlers.ResolveProcessArgsScrubbed(ev, &element.ProcessContext.Process)
+		result := ev.FieldHandlers.ResolveCGroupManager(ev, &element.ProcessContext.Process.CGroup)
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetSignalTargetAncestorsArgsTruncated returns the value of the field, resolving if necessary
-func (ev *Event) GetSignalTargetAncestorsArgsTruncated() []bool {
+// GetSignalTargetAncestorsCgroupMemoryCurrent returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetAncestorsCgroupMemoryCurrent() []int {
 	if ev.GetEventType().String() != "signal" {
-		return []bool{}
+		return []int{}
 	}
 	if ev.Signal.Target == nil {
-		return []bool{}
+		return []int{}
 	}
 	if ev.Signal.Target.Ancestor == nil {
-		return []bool{}
+		return []int{}
 	}
-	var values []bool
+	var values []int
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := ev.FieldHandlers.ResolveProcessArgsTruncated(ev, &element.ProcessContext.Process)
+		result := int(ev.FieldHandlers.ResolveCGroupMemoryCurrent(ev, &element.ProcessContext.Process.CGroup))
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetSignalTargetAncestorsArgv returns the value of the field, resolving if necessary
-func (ev *Event) GetSignalTargetAncestorsArgv() []string {
+// GetSignalTargetAncestorsCgroupMemoryHighExceeded returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetAncestorsCgroupMemoryHighExceeded() []bool {
 	if ev.GetEventType().String() != "signal" {
-		return []string{}
+		return []bool{}
 	}
 	if ev.Signal.Target == nil {
-		return []string{}
+		return []bool{}
 	}
 	if ev.Signal.Target.Ancestor == nil {
-		return []string{}
+		return []bool{}
 	}
-	var values []string
+	var values []bool
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := ev.FieldHandlers.ResolveProcessArgv(ev, &element.ProcessContext.Process)
-		values = append(values, result...)
+		result := ev.FieldHandlers.ResolveCGroupMemoryHighExceeded(ev, &element.ProcessContext.Process.CGroup)
+		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetSignalTargetAncestorsArgv0 returns the value of the field, resolving if necessary
-func (ev *Event) GetSignalTargetAncestorsArgv0() []string {
+// GetSignalTargetAncestorsCgroupPidsCurrent returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetAncestorsCgroupPidsCurrent() []int {
 	if ev.GetEventType().String() != "signal" {
-		return []string{}
+		return []int{}
 	}
 	if ev.Signal.Target == nil {
-		return []string{}
+		return []int{}
 	}
 	if ev.Signal.Target.Ancestor == nil {
-		return []string{}
+		return []int{}
 	}
-	var values []string
+	var values []int
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := ev.FieldHandlers.ResolveProcessArgv0(ev, &element.ProcessContext.Process)
+		result := int(ev.FieldHandlers.ResolveCGroupPidsCurrent(ev, &element.ProcessContext.Process.CGroup))
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetSignalTargetAncestorsArgvScrubbed returns the value of the field, resolving if necessary
-func (ev *Event) GetSignalTargetAncestorsArgvScrubbed() []string {
+// GetSignalTargetAncestorsCloudCredentialsProviders returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetAncestorsCloudCredentialsProviders() []string {
 	if ev.GetEventType().String() != "signal" {
 		return []string{}
 	}
@@ -14687,135 +18334,135 @@ func (ev *Event) GetSignalTargetAncestorsArgvScrubbed() []string {
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := ev.FieldHandlers.ResolveProcessArgvScrubbed(ev, &element.ProcessContext.Process)
+		result := ev.FieldHandlers.ResolveProcessCloudCredentialsProviders(ev, &element.ProcessContext.Process)
 		values = append(values, result...)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetSignalTargetAncestorsAuid returns the value of the field, resolving if necessary
-func (ev *Event) GetSignalTargetAncestorsAuid() []uint32 {
+// GetSignalTargetAncestorsCloudCredentialsResources returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetAncestorsCloudCredentialsResources() []string {
 	if ev.GetEventType().String() != "signal" {
-		return []uint32{}
+		return []string{}
 	}
 	if ev.Signal.Target == nil {
-		return []uint32{}
+		return []string{}
 	}
 	if ev.Signal.Target.Ancestor == nil {
-		return []uint32{}
+		return []string{}
 	}
-	var values []uint32
+	var values []string
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := element.ProcessContext.Process.Credentials.AUID
-		values = append(values, result)
+		result := ev.FieldHandlers.ResolveProcessCloudCredentialsResources(ev, &element.ProcessContext.Process)
+		values = append(values, result...)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetSignalTargetAncestorsCapEffective returns the value of the field, resolving if necessary
-func (ev *Event) GetSignalTargetAncestorsCapEffective() []uint64 {
+// GetSignalTargetAncestorsCloudCredentialsTypes returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetAncestorsCloudCredentialsTypes() []string {
 	if ev.GetEventType().String() != "signal" {
-		return []uint64{}
+		return []string{}
 	}
 	if ev.Signal.Target == nil {
-		return []uint64{}
+		return []string{}
 	}
 	if ev.Signal.Target.Ancestor == nil {
-		return []uint64{}
+		return []string{}
 	}
-	var values []uint64
+	var values []string
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := element.ProcessContext.Process.Credentials.CapEffective
-		values = append(values, result)
+		result := ev.FieldHandlers.ResolveProcessCloudCredentialsTypes(ev, &element.ProcessContext.Process)
+		values = append(values, result...)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetSignalTargetAncestorsCapPermitted returns the value of the field, resolving if necessary
-func (ev *Event) GetSignalTargetAncestorsCapPermitted() []uint64 {
+// GetSignalTargetAncestorsCmdargv returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetAncestorsCmdargv() []string {
 	if ev.GetEventType().String() != "signal" {
-		return []uint64{}
+		return []string{}
 	}
 	if ev.Signal.Target == nil {
-		return []uint64{}
+		return []string{}
 	}
 	if ev.Signal.Target.Ancestor == nil {
-		return []uint64{}
+		return []string{}
 	}
-	var values []uint64
+	var values []string
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := element.ProcessContext.Process.Credentials.CapPermitted
-		values = append(values, result)
+		result := ev.FieldHandlers.ResolveProcessCmdArgv(ev, &element.ProcessContext.Process)
+		values = append(values, result...)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetSignalTargetAncestorsCgroupFileInode returns the value of the field, resolving if necessary
-func (ev *Event) GetSignalTargetAncestorsCgroupFileInode() []uint64 {
+// GetSignalTargetAncestorsCmdlineObfuscationScore returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetAncestorsCmdlineObfuscationScore() []int {
 	if ev.GetEventType().String() != "signal" {
-		return []uint64{}
+		return []int{}
 	}
 	if ev.Signal.Target == nil {
-		return []uint64{}
+		return []int{}
 	}
 	if ev.Signal.Target.Ancestor == nil {
-		return []uint64{}
+		return []int{}
 	}
-	var values []uint64
+	var values []int
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := element.ProcessContext.Process.CGroup.CGroupFile.Inode
+		result := ev.FieldHandlers.ResolveProcessCmdLineObfuscationScore(ev, &element.ProcessContext.Process)
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetSignalTargetAncestorsCgroupFileMountId returns the value of the field, resolving if necessary
-func (ev *Event) GetSignalTargetAncestorsCgroupFileMountId() []uint32 {
+// GetSignalTargetAncestorsComm returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetAncestorsComm() []string {
 	if ev.GetEventType().String() != "signal" {
-		return []uint32{}
+		return []string{}
 	}
 	if ev.Signal.Target == nil {
-		return []uint32{}
+		return []string{}
 	}
 	if ev.Signal.Target.Ancestor == nil {
-		return []uint32{}
+		return []string{}
 	}
-	var values []uint32
+	var values []string
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := element.ProcessContext.Process.CGroup.CGroupFile.MountID
+		result := element.ProcessContext.Process.Comm
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetSignalTargetAncestorsCgroupId returns the value of the field, resolving if necessary
-func (ev *Event) GetSignalTargetAncestorsCgroupId() []string {
+// GetSignalTargetAncestorsContainerId returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetAncestorsContainerId() []string {
 	if ev.GetEventType().String() != "signal" {
 		return []string{}
 	}
@@ -14831,63 +18478,63 @@ func (ev *Event) GetSignalTargetAncestorsCgroupId() []string {
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := ev.FieldHandlers.ResolveCGroupID(ev, &element.ProcessContext.Process.CGroup)
+		result := ev.FieldHandlers.ResolveProcessContainerID(ev, &element.ProcessContext.Process)
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetSignalTargetAncestorsCgroupManager returns the value of the field, resolving if necessary
-func (ev *Event) GetSignalTargetAncestorsCgroupManager() []string {
+// GetSignalTargetAncestorsCreatedAt returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetAncestorsCreatedAt() []int {
 	if ev.GetEventType().String() != "signal" {
-		return []string{}
+		return []int{}
 	}
 	if ev.Signal.Target == nil {
-		return []string{}
+		return []int{}
 	}
 	if ev.Signal.Target.Ancestor == nil {
-		return []string{}
+		return []int{}
 	}
-	var values []string
+	var values []int
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := ev.FieldHandlers.ResolveCGroupManager(ev, &element.ProcessContext.Process.CGroup)
+		result := int(ev.FieldHandlers.ResolveProcessCreatedAt(ev, &element.ProcessContext.Process))
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetSignalTargetAncestorsCmdargv returns the value of the field, resolving if necessary
-func (ev *Event) GetSignalTargetAncestorsCmdargv() []string {
+// GetSignalTargetAncestorsEgid returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetAncestorsEgid() []uint32 {
 	if ev.GetEventType().String() != "signal" {
-		return []string{}
+		return []uint32{}
 	}
 	if ev.Signal.Target == nil {
-		return []string{}
+		return []uint32{}
 	}
 	if ev.Signal.Target.Ancestor == nil {
-		return []string{}
+		return []uint32{}
 	}
-	var values []string
+	var values []uint32
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := ev.FieldHandlers.ResolveProcessCmdArgv(ev, &element.ProcessContext.Process)
-		values = append(values, result...)
+		result := element.ProcessContext.Process.Credentials.EGID
+		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetSignalTargetAncestorsComm returns the value of the field, resolving if necessary
-func (ev *Event) GetSignalTargetAncestorsComm() []string {
+// GetSignalTargetAncestorsEgroup returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetAncestorsEgroup() []string {
 	if ev.GetEventType().String() != "signal" {
 		return []string{}
 	}
@@ -14903,15 +18550,15 @@ func (ev *Event) GetSignalTargetAncestorsComm() []string {
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := element.ProcessContext.Process.Comm
+		result := element.ProcessContext.Process.Credentials.EGroup
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetSignalTargetAncestorsContainerId returns the value of the field, resolving if necessary
-func (ev *Event) GetSignalTargetAncestorsContainerId() []string {
+// GetSignalTargetAncestorsElfBuildId returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetAncestorsElfBuildId() []string {
 	if ev.GetEventType().String() != "signal" {
 		return []string{}
 	}
@@ -14927,79 +18574,79 @@ func (ev *Event) GetSignalTargetAncestorsContainerId() []string {
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := ev.FieldHandlers.ResolveProcessContainerID(ev, &element.ProcessContext.Process)
+		result := ev.FieldHandlers.ResolveProcessELFBuildID(ev, &element.ProcessContext.Process)
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetSignalTargetAncestorsCreatedAt returns the value of the field, resolving if necessary
-func (ev *Event) GetSignalTargetAncestorsCreatedAt() []int {
+// GetSignalTargetAncestorsElfInterpreter returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetAncestorsElfInterpreter() []string {
 	if ev.GetEventType().String() != "signal" {
-		return []int{}
+		return []string{}
 	}
 	if ev.Signal.Target == nil {
-		return []int{}
+		return []string{}
 	}
 	if ev.Signal.Target.Ancestor == nil {
-		return []int{}
+		return []string{}
 	}
-	var values []int
+	var values []string
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := int(ev.FieldHandlers.ResolveProcessCreatedAt(ev, &element.ProcessContext.Process))
+		result := ev.FieldHandlers.ResolveProcessELFInterpreter(ev, &element.ProcessContext.Process)
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetSignalTargetAncestorsEgid returns the value of the field, resolving if necessary
-func (ev *Event) GetSignalTargetAncestorsEgid() []uint32 {
+// GetSignalTargetAncestorsElfIsStatic returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetAncestorsElfIsStatic() []bool {
 	if ev.GetEventType().String() != "signal" {
-		return []uint32{}
+		return []bool{}
 	}
 	if ev.Signal.Target == nil {
-		return []uint32{}
+		return []bool{}
 	}
 	if ev.Signal.Target.Ancestor == nil {
-		return []uint32{}
+		return []bool{}
 	}
-	var values []uint32
+	var values []bool
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := element.ProcessContext.Process.Credentials.EGID
+		result := ev.FieldHandlers.ResolveProcessELFIsStatic(ev, &element.ProcessContext.Process)
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
 	return values
 }
 
-// GetSignalTargetAncestorsEgroup returns the value of the field, resolving if necessary
-func (ev *Event) GetSignalTargetAncestorsEgroup() []string {
+// GetSignalTargetAncestorsElfIsStripped returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetAncestorsElfIsStripped() []bool {
 	if ev.GetEventType().String() != "signal" {
-		return []string{}
+		return []bool{}
 	}
 	if ev.Signal.Target == nil {
-		return []string{}
+		return []bool{}
 	}
 	if ev.Signal.Target.Ancestor == nil {
-		return []string{}
+		return []bool{}
 	}
-	var values []string
+	var values []bool
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := element.ProcessContext.Process.Credentials.EGroup
+		result := ev.FieldHandlers.ResolveProcessELFIsStripped(ev, &element.ProcessContext.Process)
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
@@ -15276,18 +18923,66 @@ func (ev *Event) GetSignalTargetAncestorsFileInode() []uint64 {
 		return []uint64{}
 	}
 	if ev.Signal.Target == nil {
-		return []uint64{}
+		return []uint64{}
+	}
+	if ev.Signal.Target.Ancestor == nil {
+		return []uint64{}
+	}
+	var values []uint64
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := element.ProcessContext.Process.FileEvent.FileFields.PathKey.Inode
+		values = append(values, result)
+		ptr = iterator.Next()
+	}
+	return values
+}
+
+// GetSignalTargetAncestorsFileLayerDigest returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetAncestorsFileLayerDigest() []string {
+	if ev.GetEventType().String() != "signal" {
+		return []string{}
+	}
+	if ev.Signal.Target == nil {
+		return []string{}
+	}
+	if ev.Signal.Target.Ancestor == nil {
+		return []string{}
+	}
+	var values []string
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := ev.FieldHandlers.ResolveFileLayerDigest(ev, &element.ProcessContext.Process.FileEvent)
+		values = append(values, result)
+		ptr = iterator.Next()
+	}
+	return values
+}
+
+// GetSignalTargetAncestorsFileLayerPath returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetAncestorsFileLayerPath() []string {
+	if ev.GetEventType().String() != "signal" {
+		return []string{}
+	}
+	if ev.Signal.Target == nil {
+		return []string{}
 	}
 	if ev.Signal.Target.Ancestor == nil {
-		return []uint64{}
+		return []string{}
 	}
-	var values []uint64
+	var values []string
 	ctx := eval.NewContext(ev)
 	iterator := &ProcessAncestorsIterator{}
 	ptr := iterator.Front(ctx)
 	for ptr != nil {
 		element := (*ProcessCacheEntry)(ptr)
-		result := element.ProcessContext.Process.FileEvent.FileFields.PathKey.Inode
+		result := ev.FieldHandlers.ResolveFileLayerPath(ev, &element.ProcessContext.Process.FileEvent)
 		values = append(values, result)
 		ptr = iterator.Next()
 	}
@@ -15918,6 +19613,54 @@ func (ev *Event) GetSignalTargetAncestorsInterpreterFileInode() []uint64 {
 	return values
 }
 
+// GetSignalTargetAncestorsInterpreterFileLayerDigest returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetAncestorsInterpreterFileLayerDigest() []string {
+	if ev.GetEventType().String() != "signal" {
+		return []string{}
+	}
+	if ev.Signal.Target == nil {
+		return []string{}
+	}
+	if ev.Signal.Target.Ancestor == nil {
+		return []string{}
+	}
+	var values []string
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := ev.FieldHandlers.ResolveFileLayerDigest(ev, &element.ProcessContext.Process.LinuxBinprm.FileEvent)
+		values = append(values, result)
+		ptr = iterator.Next()
+	}
+	return values
+}
+
+// GetSignalTargetAncestorsInterpreterFileLayerPath returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetAncestorsInterpreterFileLayerPath() []string {
+	if ev.GetEventType().String() != "signal" {
+		return []string{}
+	}
+	if ev.Signal.Target == nil {
+		return []string{}
+	}
+	if ev.Signal.Target.Ancestor == nil {
+		return []string{}
+	}
+	var values []string
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := ev.FieldHandlers.ResolveFileLayerPath(ev, &element.ProcessContext.Process.LinuxBinprm.FileEvent)
+		values = append(values, result)
+		ptr = iterator.Next()
+	}
+	return values
+}
+
 // GetSignalTargetAncestorsInterpreterFileMode returns the value of the field, resolving if necessary
 func (ev *Event) GetSignalTargetAncestorsInterpreterFileMode() []uint16 {
 	if ev.GetEventType().String() != "signal" {
@@ -16318,6 +20061,102 @@ func (ev *Event) GetSignalTargetAncestorsLength() int {
 	return iterator.Len(ctx)
 }
 
+// GetSignalTargetAncestorsNsMnt returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetAncestorsNsMnt() []int {
+	if ev.GetEventType().String() != "signal" {
+		return []int{}
+	}
+	if ev.Signal.Target == nil {
+		return []int{}
+	}
+	if ev.Signal.Target.Ancestor == nil {
+		return []int{}
+	}
+	var values []int
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := int(ev.FieldHandlers.ResolveProcessMountNamespace(ev, &element.ProcessContext.Process))
+		values = append(values, result)
+		ptr = iterator.Next()
+	}
+	return values
+}
+
+// GetSignalTargetAncestorsNsPid returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetAncestorsNsPid() []int {
+	if ev.GetEventType().String() != "signal" {
+		return []int{}
+	}
+	if ev.Signal.Target == nil {
+		return []int{}
+	}
+	if ev.Signal.Target.Ancestor == nil {
+		return []int{}
+	}
+	var values []int
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := int(ev.FieldHandlers.ResolveProcessPIDNamespace(ev, &element.ProcessContext.Process))
+		values = append(values, result)
+		ptr = iterator.Next()
+	}
+	return values
+}
+
+// GetSignalTargetAncestorsNsUser returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetAncestorsNsUser() []int {
+	if ev.GetEventType().String() != "signal" {
+		return []int{}
+	}
+	if ev.Signal.Target == nil {
+		return []int{}
+	}
+	if ev.Signal.Target.Ancestor == nil {
+		return []int{}
+	}
+	var values []int
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := int(ev.FieldHandlers.ResolveProcessUserNamespace(ev, &element.ProcessContext.Process))
+		values = append(values, result)
+		ptr = iterator.Next()
+	}
+	return values
+}
+
+// GetSignalTargetAncestorsNsUts returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetAncestorsNsUts() []int {
+	if ev.GetEventType().String() != "signal" {
+		return []int{}
+	}
+	if ev.Signal.Target == nil {
+		return []int{}
+	}
+	if ev.Signal.Target.Ancestor == nil {
+		return []int{}
+	}
+	var values []int
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := int(ev.FieldHandlers.ResolveProcessUTSNamespace(ev, &element.ProcessContext.Process))
+		values = append(values, result)
+		ptr = iterator.Next()
+	}
+	return values
+}
+
 // GetSignalTargetAncestorsPid returns the value of the field, resolving if necessary
 func (ev *Event) GetSignalTargetAncestorsPid() []uint32 {
 	if ev.GetEventType().String() != "signal" {
@@ -16366,6 +20205,54 @@ func (ev *Event) GetSignalTargetAncestorsPpid() []uint32 {
 	return values
 }
 
+// GetSignalTargetAncestorsSystemdSlice returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetAncestorsSystemdSlice() []string {
+	if ev.GetEventType().String() != "signal" {
+		return []string{}
+	}
+	if ev.Signal.Target == nil {
+		return []string{}
+	}
+	if ev.Signal.Target.Ancestor == nil {
+		return []string{}
+	}
+	var values []string
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := ev.FieldHandlers.ResolveProcessSystemdSlice(ev, &element.ProcessContext.Process)
+		values = append(values, result)
+		ptr = iterator.Next()
+	}
+	return values
+}
+
+// GetSignalTargetAncestorsSystemdUnit returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetAncestorsSystemdUnit() []string {
+	if ev.GetEventType().String() != "signal" {
+		return []string{}
+	}
+	if ev.Signal.Target == nil {
+		return []string{}
+	}
+	if ev.Signal.Target.Ancestor == nil {
+		return []string{}
+	}
+	var values []string
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := ev.FieldHandlers.ResolveProcessSystemdUnit(ev, &element.ProcessContext.Process)
+		values = append(values, result)
+		ptr = iterator.Next()
+	}
+	return values
+}
+
 // GetSignalTargetAncestorsTid returns the value of the field, resolving if necessary
 func (ev *Event) GetSignalTargetAncestorsTid() []uint32 {
 	if ev.GetEventType().String() != "signal" {
@@ -16655,6 +20542,39 @@ func (ev *Event) GetSignalTargetCapPermitted() uint64 {
 	return ev.Signal.Target.Process.Credentials.CapPermitted
 }
 
+// GetSignalTargetCgroupAncestors returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetCgroupAncestors() []string {
+	if ev.GetEventType().String() != "signal" {
+		return []string{}
+	}
+	if ev.Signal.Target == nil {
+		return []string{}
+	}
+	return ev.FieldHandlers.ResolveCGroupAncestors(ev, &ev.Signal.Target.Process.CGroup)
+}
+
+// GetSignalTargetCgroupControllers returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetCgroupControllers() []string {
+	if ev.GetEventType().String() != "signal" {
+		return []string{}
+	}
+	if ev.Signal.Target == nil {
+		return []string{}
+	}
+	return ev.FieldHandlers.ResolveCGroupControllers(ev, &ev.Signal.Target.Process.CGroup)
+}
+
+// GetSignalTargetCgroupCpuUsage returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetCgroupCpuUsage() int {
+	if ev.GetEventType().String() != "signal" {
+		return 0
+	}
+	if ev.Signal.Target == nil {
+		return 0
+	}
+	return ev.FieldHandlers.ResolveCGroupCPUUsage(ev, &ev.Signal.Target.Process.CGroup)
+}
+
 // GetSignalTargetCgroupFileInode returns the value of the field, resolving if necessary
 func (ev *Event) GetSignalTargetCgroupFileInode() uint64 {
 	if ev.GetEventType().String() != "signal" {
@@ -16699,6 +20619,72 @@ func (ev *Event) GetSignalTargetCgroupManager() string {
 	return ev.FieldHandlers.ResolveCGroupManager(ev, &ev.Signal.Target.Process.CGroup)
 }
 
+// GetSignalTargetCgroupMemoryCurrent returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetCgroupMemoryCurrent() int {
+	if ev.GetEventType().String() != "signal" {
+		return 0
+	}
+	if ev.Signal.Target == nil {
+		return 0
+	}
+	return ev.FieldHandlers.ResolveCGroupMemoryCurrent(ev, &ev.Signal.Target.Process.CGroup)
+}
+
+// GetSignalTargetCgroupMemoryHighExceeded returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetCgroupMemoryHighExceeded() bool {
+	if ev.GetEventType().String() != "signal" {
+		return false
+	}
+	if ev.Signal.Target == nil {
+		return false
+	}
+	return ev.FieldHandlers.ResolveCGroupMemoryHighExceeded(ev, &ev.Signal.Target.Process.CGroup)
+}
+
+// GetSignalTargetCgroupPidsCurrent returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetCgroupPidsCurrent() int {
+	if ev.GetEventType().String() != "signal" {
+		return 0
+	}
+	if ev.Signal.Target == nil {
+		return 0
+	}
+	return ev.FieldHandlers.ResolveCGroupPidsCurrent(ev, &ev.Signal.Target.Process.CGroup)
+}
+
+// GetSignalTargetCloudCredentialsProviders returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetCloudCredentialsProviders() []string {
+	if ev.GetEventType().String() != "signal" {
+		return []string{}
+	}
+	if ev.Signal.Target == nil {
+		return []string{}
+	}
+	return ev.FieldHandlers.ResolveProcessCloudCredentialsProviders(ev, &ev.Signal.Target.Process)
+}
+
+// GetSignalTargetCloudCredentialsResources returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetCloudCredentialsResources() []string {
+	if ev.GetEventType().String() != "signal" {
+		return []string{}
+	}
+	if ev.Signal.Target == nil {
+		return []string{}
+	}
+	return ev.FieldHandlers.ResolveProcessCloudCredentialsResources(ev, &ev.Signal.Target.Process)
+}
+
+// GetSignalTargetCloudCredentialsTypes returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetCloudCredentialsTypes() []string {
+	if ev.GetEventType().String() != "signal" {
+		return []string{}
+	}
+	if ev.Signal.Target == nil {
+		return []string{}
+	}
+	return ev.FieldHandlers.ResolveProcessCloudCredentialsTypes(ev, &ev.Signal.Target.Process)
+}
+
 // GetSignalTargetCmdargv returns the value of the field, resolving if necessary
 func (ev *Event) GetSignalTargetCmdargv() []string {
 	if ev.GetEventType().String() != "signal" {
@@ -16710,6 +20696,17 @@ func (ev *Event) GetSignalTargetCmdargv() []string {
 	return ev.FieldHandlers.ResolveProcessCmdArgv(ev, &ev.Signal.Target.Process)
 }
 
+// GetSignalTargetCmdlineObfuscationScore returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetCmdlineObfuscationScore() int {
+	if ev.GetEventType().String() != "signal" {
+		return 0
+	}
+	if ev.Signal.Target == nil {
+		return 0
+	}
+	return ev.FieldHandlers.ResolveProcessCmdLineObfuscationScore(ev, &ev.Signal.Target.Process)
+}
+
 // GetSignalTargetComm returns the value of the field, resolving if necessary
 func (ev *Event) GetSignalTargetComm() string {
 	if ev.GetEventType().String() != "signal" {
@@ -16765,6 +20762,50 @@ func (ev *Event) GetSignalTargetEgroup() string {
 	return ev.Signal.Target.Process.Credentials.EGroup
 }
 
+// GetSignalTargetElfBuildId returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetElfBuildId() string {
+	if ev.GetEventType().String() != "signal" {
+		return ""
+	}
+	if ev.Signal.Target == nil {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveProcessELFBuildID(ev, &ev.Signal.Target.Process)
+}
+
+// GetSignalTargetElfInterpreter returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetElfInterpreter() string {
+	if ev.GetEventType().String() != "signal" {
+		return ""
+	}
+	if ev.Signal.Target == nil {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveProcessELFInterpreter(ev, &ev.Signal.Target.Process)
+}
+
+// GetSignalTargetElfIsStatic returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetElfIsStatic() bool {
+	if ev.GetEventType().String() != "signal" {
+		return false
+	}
+	if ev.Signal.Target == nil {
+		return false
+	}
+	return ev.FieldHandlers.ResolveProcessELFIsStatic(ev, &ev.Signal.Target.Process)
+}
+
+// GetSignalTargetElfIsStripped returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetElfIsStripped() bool {
+	if ev.GetEventType().String() != "signal" {
+		return false
+	}
+	if ev.Signal.Target == nil {
+		return false
+	}
+	return ev.FieldHandlers.ResolveProcessELFIsStripped(ev, &ev.Signal.Target.Process)
+}
+
 // GetSignalTargetEnvp returns the value of the field, resolving if necessary
 func (ev *Event) GetSignalTargetEnvp() []string {
 	if ev.GetEventType().String() != "signal" {
@@ -16940,6 +20981,34 @@ func (ev *Event) GetSignalTargetFileInode() uint64 {
 	return ev.Signal.Target.Process.FileEvent.FileFields.PathKey.Inode
 }
 
+// GetSignalTargetFileLayerDigest returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetFileLayerDigest() string {
+	if ev.GetEventType().String() != "signal" {
+		return ""
+	}
+	if ev.Signal.Target == nil {
+		return ""
+	}
+	if !ev.Signal.Target.Process.IsNotKworker() {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Signal.Target.Process.FileEvent)
+}
+
+// GetSignalTargetFileLayerPath returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetFileLayerPath() string {
+	if ev.GetEventType().String() != "signal" {
+		return ""
+	}
+	if ev.Signal.Target == nil {
+		return ""
+	}
+	if !ev.Signal.Target.Process.IsNotKworker() {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Signal.Target.Process.FileEvent)
+}
+
 // GetSignalTargetFileMode returns the value of the field, resolving if necessary
 func (ev *Event) GetSignalTargetFileMode() uint16 {
 	if ev.GetEventType().String() != "signal" {
@@ -17193,6 +21262,17 @@ func (ev *Event) GetSignalTargetGroup() string {
 	return ev.Signal.Target.Process.Credentials.Group
 }
 
+// GetSignalTargetIdentity returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetIdentity() string {
+	if ev.GetEventType().String() != "signal" {
+		return ""
+	}
+	if ev.Signal.Target == nil {
+		return ""
+	}
+	return ev.Signal.Target.Process.Identity
+}
+
 // GetSignalTargetInterpreterFileChangeTime returns the value of the field, resolving if necessary
 func (ev *Event) GetSignalTargetInterpreterFileChangeTime() uint64 {
 	if ev.GetEventType().String() != "signal" {
@@ -17291,6 +21371,34 @@ func (ev *Event) GetSignalTargetInterpreterFileInode() uint64 {
 	return ev.Signal.Target.Process.LinuxBinprm.FileEvent.FileFields.PathKey.Inode
 }
 
+// GetSignalTargetInterpreterFileLayerDigest returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetInterpreterFileLayerDigest() string {
+	if ev.GetEventType().String() != "signal" {
+		return ""
+	}
+	if ev.Signal.Target == nil {
+		return ""
+	}
+	if !ev.Signal.Target.Process.HasInterpreter() {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Signal.Target.Process.LinuxBinprm.FileEvent)
+}
+
+// GetSignalTargetInterpreterFileLayerPath returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetInterpreterFileLayerPath() string {
+	if ev.GetEventType().String() != "signal" {
+		return ""
+	}
+	if ev.Signal.Target == nil {
+		return ""
+	}
+	if !ev.Signal.Target.Process.HasInterpreter() {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Signal.Target.Process.LinuxBinprm.FileEvent)
+}
+
 // GetSignalTargetInterpreterFileMode returns the value of the field, resolving if necessary
 func (ev *Event) GetSignalTargetInterpreterFileMode() uint16 {
 	if ev.GetEventType().String() != "signal" {
@@ -17500,6 +21608,50 @@ func (ev *Event) GetSignalTargetIsThread() bool {
 	return ev.FieldHandlers.ResolveProcessIsThread(ev, &ev.Signal.Target.Process)
 }
 
+// GetSignalTargetNsMnt returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetNsMnt() int {
+	if ev.GetEventType().String() != "signal" {
+		return 0
+	}
+	if ev.Signal.Target == nil {
+		return 0
+	}
+	return ev.FieldHandlers.ResolveProcessMountNamespace(ev, &ev.Signal.Target.Process)
+}
+
+// GetSignalTargetNsPid returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetNsPid() int {
+	if ev.GetEventType().String() != "signal" {
+		return 0
+	}
+	if ev.Signal.Target == nil {
+		return 0
+	}
+	return ev.FieldHandlers.ResolveProcessPIDNamespace(ev, &ev.Signal.Target.Process)
+}
+
+// GetSignalTargetNsUser returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetNsUser() int {
+	if ev.GetEventType().String() != "signal" {
+		return 0
+	}
+	if ev.Signal.Target == nil {
+		return 0
+	}
+	return ev.FieldHandlers.ResolveProcessUserNamespace(ev, &ev.Signal.Target.Process)
+}
+
+// GetSignalTargetNsUts returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetNsUts() int {
+	if ev.GetEventType().String() != "signal" {
+		return 0
+	}
+	if ev.Signal.Target == nil {
+		return 0
+	}
+	return ev.FieldHandlers.ResolveProcessUTSNamespace(ev, &ev.Signal.Target.Process)
+}
+
 // GetSignalTargetParentArgs returns the value of the field, resolving if necessary
 func (ev *Event) GetSignalTargetParentArgs() string {
 	if ev.GetEventType().String() != "signal" {
@@ -17687,6 +21839,57 @@ func (ev *Event) GetSignalTargetParentCapPermitted() uint64 {
 	return ev.Signal.Target.Parent.Credentials.CapPermitted
 }
 
+// GetSignalTargetParentCgroupAncestors returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetParentCgroupAncestors() []string {
+	if ev.GetEventType().String() != "signal" {
+		return []string{}
+	}
+	if ev.Signal.Target == nil {
+		return []string{}
+	}
+	if ev.Signal.Target.Parent == nil {
+		return []string{}
+	}
+	if !ev.Signal.Target.HasParent() {
+		return []string{}
+	}
+	return ev.FieldHandlers.ResolveCGroupAncestors(ev, &ev.Signal.Target.Parent.CGroup)
+}
+
+// GetSignalTargetParentCgroupControllers returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetParentCgroupControllers() []string {
+	if ev.GetEventType().String() != "signal" {
+		return []string{}
+	}
+	if ev.Signal.Target == nil {
+		return []string{}
+	}
+	if ev.Signal.Target.Parent == nil {
+		return []string{}
+	}
+	if !ev.Signal.Target.HasParent() {
+		return []string{}
+	}
+	return ev.FieldHandlers.ResolveCGroupControllers(ev, &ev.Signal.Target.Parent.CGroup)
+}
+
+// GetSignalTargetParentCgroupCpuUsage returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetParentCgroupCpuUsage() int {
+	if ev.GetEventType().String() != "signal" {
+		return 0
+	}
+	if ev.Signal.Target == nil {
+		return 0
+	}
+	if ev.Signal.Target.Parent == nil {
+		return 0
+	}
+	if !ev.Signal.Target.HasParent() {
+		return 0
+	}
+	return ev.FieldHandlers.ResolveCGroupCPUUsage(ev, &ev.Signal.Target.Parent.CGroup)
+}
+
 // GetSignalTargetParentCgroupFileInode returns the value of the field, resolving if necessary
 func (ev *Event) GetSignalTargetParentCgroupFileInode() uint64 {
 	if ev.GetEventType().String() != "signal" {
@@ -17755,6 +21958,108 @@ func (ev *Event) GetSignalTargetParentCgroupManager() string {
 	return ev.FieldHandlers.ResolveCGroupManager(ev, &ev.Signal.Target.Parent.CGroup)
 }
 
+// GetSignalTargetParentCgroupMemoryCurrent returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetParentCgroupMemoryCurrent() int {
+	if ev.GetEventType().String() != "signal" {
+		return 0
+	}
+	if ev.Signal.Target == nil {
+		return 0
+	}
+	if ev.Signal.Target.Parent == nil {
+		return 0
+	}
+	if !ev.Signal.Target.HasParent() {
+		return 0
+	}
+	return ev.FieldHandlers.ResolveCGroupMemoryCurrent(ev, &ev.Signal.Target.Parent.CGroup)
+}
+
+// GetSignalTargetParentCgroupMemoryHighExceeded returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetParentCgroupMemoryHighExceeded() bool {
+	if ev.GetEventType().String() != "signal" {
+		return false
+	}
+	if ev.Signal.Target == nil {
+		return false
+	}
+	if ev.Signal.Target.Parent == nil {
+		return false
+	}
+	if !ev.Signal.Target.HasParent() {
+		return false
+	}
+	return ev.FieldHandlers.ResolveCGroupMemoryHighExceeded(ev, &ev.Signal.Target.Parent.CGroup)
+}
+
+// GetSignalTargetParentCgroupPidsCurrent returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetParentCgroupPidsCurrent() int {
+	if ev.GetEventType().String() != "signal" {
+		return 0
+	}
+	if ev.Signal.Target == nil {
+		return 0
+	}
+	if ev.Signal.Target.Parent == nil {
+		return 0
+	}
+	if !ev.Signal.Target.HasParent() {
+		return 0
+	}
+	return ev.FieldHandlers.ResolveCGroupPidsCurrent(ev, &ev.Signal.Target.Parent.CGroup)
+}
+
+// GetSignalTargetParentCloudCredentialsProviders returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetParentCloudCredentialsProviders() []string {
+	if ev.GetEventType().String() != "signal" {
+		return []string{}
+	}
+	if ev.Signal.Target == nil {
+		return []string{}
+	}
+	if ev.Signal.Target.Parent == nil {
+		return []string{}
+	}
+	if !ev.Signal.Target.HasParent() {
+		return []string{}
+	}
+	return ev.FieldHandlers.ResolveProcessCloudCredentialsProviders(ev, ev.Signal.Target.Parent)
+}
+
+// GetSignalTargetParentCloudCredentialsResources returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetParentCloudCredentialsResources() []string {
+	if ev.GetEventType().String() != "signal" {
+		return []string{}
+	}
+	if ev.Signal.Target == nil {
+		return []string{}
+	}
+	if ev.Signal.Target.Parent == nil {
+		return []string{}
+	}
+	if !ev.Signal.Target.HasParent() {
+		return []string{}
+	}
+	return ev.FieldHandlers.ResolveProcessCloudCredentialsResources(ev, ev.Signal.Target.Parent)
+}
+
+// GetSignalTargetParentCloudCredentialsTypes returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetParentCloudCredentialsTypes() []string {
+	if ev.GetEventType().String() != "signal" {
+		return []string{}
+	}
+	if ev.Signal.Target == nil {
+		return []string{}
+	}
+	if ev.Signal.Target.Parent == nil {
+		return []string{}
+	}
+	if !ev.Signal.Target.HasParent() {
+		return []string{}
+	}
+	return ev.FieldHandlers.ResolveProcessCloudCredentialsTypes(ev, ev.Signal.Target.Parent)
+}
+
 // GetSignalTargetParentCmdargv returns the value of the field, resolving if necessary
 func (ev *Event) GetSignalTargetParentCmdargv() []string {
 	if ev.GetEventType().String() != "signal" {
@@ -17769,7 +22074,24 @@ func (ev *Event) GetSignalTargetParentCmdargv() []string {
 	if !ev.Signal.Target.HasParent() {
 		return []string{}
 	}
-	return ev.FieldHandlers.ResolveProcessCmdArgv(ev, ev.Signal.Target.Parent)
+	return ev.FieldHandlers.ResolveProcessCmdArgv(ev, ev.Signal.Target.Parent)
+}
+
+// GetSignalTargetParentCmdlineObfuscationScore returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetParentCmdlineObfuscationScore() int {
+	if ev.GetEventType().String() != "signal" {
+		return 0
+	}
+	if ev.Signal.Target == nil {
+		return 0
+	}
+	if ev.Signal.Target.Parent == nil {
+		return 0
+	}
+	if !ev.Signal.Target.HasParent() {
+		return 0
+	}
+	return ev.FieldHandlers.ResolveProcessCmdLineObfuscationScore(ev, ev.Signal.Target.Parent)
 }
 
 // GetSignalTargetParentComm returns the value of the field, resolving if necessary
@@ -17857,6 +22179,74 @@ func (ev *Event) GetSignalTargetParentEgroup() string {
 	return ev.Signal.Target.Parent.Credentials.EGroup
 }
 
+// GetSignalTargetParentElfBuildId returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetParentElfBuildId() string {
+	if ev.GetEventType().String() != "signal" {
+		return ""
+	}
+	if ev.Signal.Target == nil {
+		return ""
+	}
+	if ev.Signal.Target.Parent == nil {
+		return ""
+	}
+	if !ev.Signal.Target.HasParent() {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveProcessELFBuildID(ev, ev.Signal.Target.Parent)
+}
+
+// GetSignalTargetParentElfInterpreter returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetParentElfInterpreter() string {
+	if ev.GetEventType().String() != "signal" {
+		return ""
+	}
+	if ev.Signal.Target == nil {
+		return ""
+	}
+	if ev.Signal.Target.Parent == nil {
+		return ""
+	}
+	if !ev.Signal.Target.HasParent() {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveProcessELFInterpreter(ev, ev.Signal.Target.Parent)
+}
+
+// GetSignalTargetParentElfIsStatic returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetParentElfIsStatic() bool {
+	if ev.GetEventType().String() != "signal" {
+		return false
+	}
+	if ev.Signal.Target == nil {
+		return false
+	}
+	if ev.Signal.Target.Parent == nil {
+		return false
+	}
+	if !ev.Signal.Target.HasParent() {
+		return false
+	}
+	return ev.FieldHandlers.ResolveProcessELFIsStatic(ev, ev.Signal.Target.Parent)
+}
+
+// GetSignalTargetParentElfIsStripped returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetParentElfIsStripped() bool {
+	if ev.GetEventType().String() != "signal" {
+		return false
+	}
+	if ev.Signal.Target == nil {
+		return false
+	}
+	if ev.Signal.Target.Parent == nil {
+		return false
+	}
+	if !ev.Signal.Target.HasParent() {
+		return false
+	}
+	return ev.FieldHandlers.ResolveProcessELFIsStripped(ev, ev.Signal.Target.Parent)
+}
+
 // GetSignalTargetParentEnvp returns the value of the field, resolving if necessary
 func (ev *Event) GetSignalTargetParentEnvp() []string {
 	if ev.GetEventType().String() != "signal" {
@@ -18082,6 +22472,46 @@ func (ev *Event) GetSignalTargetParentFileInode() uint64 {
 	return ev.Signal.Target.Parent.FileEvent.FileFields.PathKey.Inode
 }
 
+// GetSignalTargetParentFileLayerDigest returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetParentFileLayerDigest() string {
+	if ev.GetEventType().String() != "signal" {
+		return ""
+	}
+	if ev.Signal.Target == nil {
+		return ""
+	}
+	if ev.Signal.Target.Parent == nil {
+		return ""
+	}
+	if !ev.Signal.Target.HasParent() {
+		return ""
+	}
+	if !ev.Signal.Target.Parent.IsNotKworker() {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Signal.Target.Parent.FileEvent)
+}
+
+// GetSignalTargetParentFileLayerPath returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetParentFileLayerPath() string {
+	if ev.GetEventType().String() != "signal" {
+		return ""
+	}
+	if ev.Signal.Target == nil {
+		return ""
+	}
+	if ev.Signal.Target.Parent == nil {
+		return ""
+	}
+	if !ev.Signal.Target.HasParent() {
+		return ""
+	}
+	if !ev.Signal.Target.Parent.IsNotKworker() {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Signal.Target.Parent.FileEvent)
+}
+
 // GetSignalTargetParentFileMode returns the value of the field, resolving if necessary
 func (ev *Event) GetSignalTargetParentFileMode() uint16 {
 	if ev.GetEventType().String() != "signal" {
@@ -18572,6 +23002,46 @@ func (ev *Event) GetSignalTargetParentInterpreterFileInode() uint64 {
 	return ev.Signal.Target.Parent.LinuxBinprm.FileEvent.FileFields.PathKey.Inode
 }
 
+// GetSignalTargetParentInterpreterFileLayerDigest returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetParentInterpreterFileLayerDigest() string {
+	if ev.GetEventType().String() != "signal" {
+		return ""
+	}
+	if ev.Signal.Target == nil {
+		return ""
+	}
+	if ev.Signal.Target.Parent == nil {
+		return ""
+	}
+	if !ev.Signal.Target.HasParent() {
+		return ""
+	}
+	if !ev.Signal.Target.Parent.HasInterpreter() {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Signal.Target.Parent.LinuxBinprm.FileEvent)
+}
+
+// GetSignalTargetParentInterpreterFileLayerPath returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetParentInterpreterFileLayerPath() string {
+	if ev.GetEventType().String() != "signal" {
+		return ""
+	}
+	if ev.Signal.Target == nil {
+		return ""
+	}
+	if ev.Signal.Target.Parent == nil {
+		return ""
+	}
+	if !ev.Signal.Target.HasParent() {
+		return ""
+	}
+	if !ev.Signal.Target.Parent.HasInterpreter() {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Signal.Target.Parent.LinuxBinprm.FileEvent)
+}
+
 // GetSignalTargetParentInterpreterFileMode returns the value of the field, resolving if necessary
 func (ev *Event) GetSignalTargetParentInterpreterFileMode() uint16 {
 	if ev.GetEventType().String() != "signal" {
@@ -18871,6 +23341,74 @@ func (ev *Event) GetSignalTargetParentIsThread() bool {
 	return ev.FieldHandlers.ResolveProcessIsThread(ev, ev.Signal.Target.Parent)
 }
 
+// GetSignalTargetParentNsMnt returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetParentNsMnt() int {
+	if ev.GetEventType().String() != "signal" {
+		return 0
+	}
+	if ev.Signal.Target == nil {
+		return 0
+	}
+	if ev.Signal.Target.Parent == nil {
+		return 0
+	}
+	if !ev.Signal.Target.HasParent() {
+		return 0
+	}
+	return ev.FieldHandlers.ResolveProcessMountNamespace(ev, ev.Signal.Target.Parent)
+}
+
+// GetSignalTargetParentNsPid returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetParentNsPid() int {
+	if ev.GetEventType().String() != "signal" {
+		return 0
+	}
+	if ev.Signal.Target == nil {
+		return 0
+	}
+	if ev.Signal.Target.Parent == nil {
+		return 0
+	}
+	if !ev.Signal.Target.HasParent() {
+		return 0
+	}
+	return ev.FieldHandlers.ResolveProcessPIDNamespace(ev, ev.Signal.Target.Parent)
+}
+
+// GetSignalTargetParentNsUser returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetParentNsUser() int {
+	if ev.GetEventType().String() != "signal" {
+		return 0
+	}
+	if ev.Signal.Target == nil {
+		return 0
+	}
+	if ev.Signal.Target.Parent == nil {
+		return 0
+	}
+	if !ev.Signal.Target.HasParent() {
+		return 0
+	}
+	return ev.FieldHandlers.ResolveProcessUserNamespace(ev, ev.Signal.Target.Parent)
+}
+
+// GetSignalTargetParentNsUts returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetParentNsUts() int {
+	if ev.GetEventType().String() != "signal" {
+		return 0
+	}
+	if ev.Signal.Target == nil {
+		return 0
+	}
+	if ev.Signal.Target.Parent == nil {
+		return 0
+	}
+	if !ev.Signal.Target.HasParent() {
+		return 0
+	}
+	return ev.FieldHandlers.ResolveProcessUTSNamespace(ev, ev.Signal.Target.Parent)
+}
+
 // GetSignalTargetParentPid returns the value of the field, resolving if necessary
 func (ev *Event) GetSignalTargetParentPid() uint32 {
 	if ev.GetEventType().String() != "signal" {
@@ -18905,6 +23443,40 @@ func (ev *Event) GetSignalTargetParentPpid() uint32 {
 	return ev.Signal.Target.Parent.PPid
 }
 
+// GetSignalTargetParentSystemdSlice returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetParentSystemdSlice() string {
+	if ev.GetEventType().String() != "signal" {
+		return ""
+	}
+	if ev.Signal.Target == nil {
+		return ""
+	}
+	if ev.Signal.Target.Parent == nil {
+		return ""
+	}
+	if !ev.Signal.Target.HasParent() {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveProcessSystemdSlice(ev, ev.Signal.Target.Parent)
+}
+
+// GetSignalTargetParentSystemdUnit returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetParentSystemdUnit() string {
+	if ev.GetEventType().String() != "signal" {
+		return ""
+	}
+	if ev.Signal.Target == nil {
+		return ""
+	}
+	if ev.Signal.Target.Parent == nil {
+		return ""
+	}
+	if !ev.Signal.Target.HasParent() {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveProcessSystemdUnit(ev, ev.Signal.Target.Parent)
+}
+
 // GetSignalTargetParentTid returns the value of the field, resolving if necessary
 func (ev *Event) GetSignalTargetParentTid() uint32 {
 	if ev.GetEventType().String() != "signal" {
@@ -19046,6 +23618,28 @@ func (ev *Event) GetSignalTargetPpid() uint32 {
 	return ev.Signal.Target.Process.PPid
 }
 
+// GetSignalTargetSystemdSlice returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetSystemdSlice() string {
+	if ev.GetEventType().String() != "signal" {
+		return ""
+	}
+	if ev.Signal.Target == nil {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveProcessSystemdSlice(ev, &ev.Signal.Target.Process)
+}
+
+// GetSignalTargetSystemdUnit returns the value of the field, resolving if necessary
+func (ev *Event) GetSignalTargetSystemdUnit() string {
+	if ev.GetEventType().String() != "signal" {
+		return ""
+	}
+	if ev.Signal.Target == nil {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveProcessSystemdUnit(ev, &ev.Signal.Target.Process)
+}
+
 // GetSignalTargetTid returns the value of the field, resolving if necessary
 func (ev *Event) GetSignalTargetTid() uint32 {
 	if ev.GetEventType().String() != "signal" {
@@ -19187,6 +23781,22 @@ func (ev *Event) GetSpliceFileInode() uint64 {
 	return ev.Splice.File.FileFields.PathKey.Inode
 }
 
+// GetSpliceFileLayerDigest returns the value of the field, resolving if necessary
+func (ev *Event) GetSpliceFileLayerDigest() string {
+	if ev.GetEventType().String() != "splice" {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Splice.File)
+}
+
+// GetSpliceFileLayerPath returns the value of the field, resolving if necessary
+func (ev *Event) GetSpliceFileLayerPath() string {
+	if ev.GetEventType().String() != "splice" {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Splice.File)
+}
+
 // GetSpliceFileMode returns the value of the field, resolving if necessary
 func (ev *Event) GetSpliceFileMode() uint16 {
 	if ev.GetEventType().String() != "splice" {
@@ -19376,6 +23986,22 @@ func (ev *Event) GetUnlinkFileInode() uint64 {
 	return ev.Unlink.File.FileFields.PathKey.Inode
 }
 
+// GetUnlinkFileLayerDigest returns the value of the field, resolving if necessary
+func (ev *Event) GetUnlinkFileLayerDigest() string {
+	if ev.GetEventType().String() != "unlink" {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Unlink.File)
+}
+
+// GetUnlinkFileLayerPath returns the value of the field, resolving if necessary
+func (ev *Event) GetUnlinkFileLayerPath() string {
+	if ev.GetEventType().String() != "unlink" {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Unlink.File)
+}
+
 // GetUnlinkFileMode returns the value of the field, resolving if necessary
 func (ev *Event) GetUnlinkFileMode() uint16 {
 	if ev.GetEventType().String() != "unlink" {
@@ -19640,6 +24266,22 @@ func (ev *Event) GetUtimesFileInode() uint64 {
 	return ev.Utimes.File.FileFields.PathKey.Inode
 }
 
+// GetUtimesFileLayerDigest returns the value of the field, resolving if necessary
+func (ev *Event) GetUtimesFileLayerDigest() string {
+	if ev.GetEventType().String() != "utimes" {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Utimes.File)
+}
+
+// GetUtimesFileLayerPath returns the value of the field, resolving if necessary
+func (ev *Event) GetUtimesFileLayerPath() string {
+	if ev.GetEventType().String() != "utimes" {
+		return ""
+	}
+	return ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Utimes.File)
+}
+
 // GetUtimesFileMode returns the value of the field, resolving if necessary
 func (ev *Event) GetUtimesFileMode() uint16 {
 	if ev.GetEventType().String() != "utimes" {
@@ -61,6 +61,62 @@ func (ev *Event) GetChangePermissionUsername() string {
 	return ev.ChangePermission.UserName
 }
 
+// GetConnectNamedPipePipeName returns the value of the field, resolving if necessary
+func (ev *Event) GetConnectNamedPipePipeName() string {
+	if ev.GetEventType().String() != "connect_named_pipe" {
+		return ""
+	}
+	return ev.ConnectNamedPipe.Pipe.Name
+}
+
+// GetConnectNamedPipePipeNameLength returns the value of the field, resolving if necessary
+func (ev *Event) GetConnectNamedPipePipeNameLength() int {
+	if ev.GetEventType().String() != "connect_named_pipe" {
+		return 0
+	}
+	return len(ev.ConnectNamedPipe.Pipe.Name)
+}
+
+// GetConnectNamedPipePipePath returns the value of the field, resolving if necessary
+func (ev *Event) GetConnectNamedPipePipePath() string {
+	if ev.GetEventType().String() != "connect_named_pipe" {
+		return ""
+	}
+	return ev.ConnectNamedPipe.Pipe.Path
+}
+
+// GetConnectNamedPipePipePathLength returns the value of the field, resolving if necessary
+func (ev *Event) GetConnectNamedPipePipePathLength() int {
+	if ev.GetEventType().String() != "connect_named_pipe" {
+		return 0
+	}
+	return len(ev.ConnectNamedPipe.Pipe.Path)
+}
+
+// GetConnectNamedPipeServerPath returns the value of the field, resolving if necessary
+func (ev *Event) GetConnectNamedPipeServerPath() string {
+	if ev.GetEventType().String() != "connect_named_pipe" {
+		return ""
+	}
+	return ev.ConnectNamedPipe.ServerPath
+}
+
+// GetConnectNamedPipeServerPathLength returns the value of the field, resolving if necessary
+func (ev *Event) GetConnectNamedPipeServerPathLength() int {
+	if ev.GetEventType().String() != "connect_named_pipe" {
+		return 0
+	}
+	return len(ev.ConnectNamedPipe.ServerPath)
+}
+
+// GetConnectNamedPipeServerPid returns the value of the field, resolving if necessary
+func (ev *Event) GetConnectNamedPipeServerPid() uint32 {
+	if ev.GetEventType().String() != "connect_named_pipe" {
+		return uint32(0)
+	}
+	return ev.ConnectNamedPipe.ServerPid
+}
+
 // GetContainerCreatedAt returns the value of the field, resolving if necessary
 func (ev *Event) GetContainerCreatedAt() int {
 	if ev.BaseEvent.ContainerContext == nil {
@@ -205,6 +261,78 @@ func (ev *Event) GetCreateKeyRegistryKeyPathLength() int {
 	return len(ev.CreateRegistryKey.Registry.KeyPath)
 }
 
+// GetCreateNamedPipePipeName returns the value of the field, resolving if necessary
+func (ev *Event) GetCreateNamedPipePipeName() string {
+	if ev.GetEventType().String() != "create_named_pipe" {
+		return ""
+	}
+	return ev.CreateNamedPipe.Pipe.Name
+}
+
+// GetCreateNamedPipePipeNameLength returns the value of the field, resolving if necessary
+func (ev *Event) GetCreateNamedPipePipeNameLength() int {
+	if ev.GetEventType().String() != "create_named_pipe" {
+		return 0
+	}
+	return len(ev.CreateNamedPipe.Pipe.Name)
+}
+
+// GetCreateNamedPipePipePath returns the value of the field, resolving if necessary
+func (ev *Event) GetCreateNamedPipePipePath() string {
+	if ev.GetEventType().String() != "create_named_pipe" {
+		return ""
+	}
+	return ev.CreateNamedPipe.Pipe.Path
+}
+
+// GetCreateNamedPipePipePathLength returns the value of the field, resolving if necessary
+func (ev *Event) GetCreateNamedPipePipePathLength() int {
+	if ev.GetEventType().String() != "create_named_pipe" {
+		return 0
+	}
+	return len(ev.CreateNamedPipe.Pipe.Path)
+}
+
+// GetCreateServiceImagePath returns the value of the field, resolving if necessary
+func (ev *Event) GetCreateServiceImagePath() string {
+	if ev.GetEventType().String() != "create_service" {
+		return ""
+	}
+	return ev.CreateService.ImagePath
+}
+
+// GetCreateServiceImagePathLength returns the value of the field, resolving if necessary
+func (ev *Event) GetCreateServiceImagePathLength() int {
+	if ev.GetEventType().String() != "create_service" {
+		return 0
+	}
+	return len(ev.CreateService.ImagePath)
+}
+
+// GetCreateServiceName returns the value of the field, resolving if necessary
+func (ev *Event) GetCreateServiceName() string {
+	if ev.GetEventType().String() != "create_service" {
+		return ""
+	}
+	return ev.CreateService.Name
+}
+
+// GetCreateServiceNameLength returns the value of the field, resolving if necessary
+func (ev *Event) GetCreateServiceNameLength() int {
+	if ev.GetEventType().String() != "create_service" {
+		return 0
+	}
+	return len(ev.CreateService.Name)
+}
+
+// GetCreateServiceStartType returns the value of the field, resolving if necessary
+func (ev *Event) GetCreateServiceStartType() string {
+	if ev.GetEventType().String() != "create_service" {
+		return ""
+	}
+	return ev.CreateService.StartType
+}
+
 // GetDeleteFileDevicePath returns the value of the field, resolving if necessary
 func (ev *Event) GetDeleteFileDevicePath() string {
 	if ev.GetEventType().String() != "delete" {
@@ -317,6 +445,62 @@ func (ev *Event) GetDeleteKeyRegistryKeyPathLength() int {
 	return len(ev.DeleteRegistryKey.Registry.KeyPath)
 }
 
+// GetDeleteKeyValueRegistryKeyName returns the value of the field, resolving if necessary
+func (ev *Event) GetDeleteKeyValueRegistryKeyName() string {
+	if ev.GetEventType().String() != "delete_key_value" {
+		return ""
+	}
+	return ev.DeleteRegistryKeyValue.Registry.KeyName
+}
+
+// GetDeleteKeyValueRegistryKeyNameLength returns the value of the field, resolving if necessary
+func (ev *Event) GetDeleteKeyValueRegistryKeyNameLength() int {
+	if ev.GetEventType().String() != "delete_key_value" {
+		return 0
+	}
+	return len(ev.DeleteRegistryKeyValue.Registry.KeyName)
+}
+
+// GetDeleteKeyValueRegistryKeyPath returns the value of the field, resolving if necessary
+func (ev *Event) GetDeleteKeyValueRegistryKeyPath() string {
+	if ev.GetEventType().String() != "delete_key_value" {
+		return ""
+	}
+	return ev.DeleteRegistryKeyValue.Registry.KeyPath
+}
+
+// GetDeleteKeyValueRegistryKeyPathLength returns the value of the field, resolving if necessary
+func (ev *Event) GetDeleteKeyValueRegistryKeyPathLength() int {
+	if ev.GetEventType().String() != "delete_key_value" {
+		return 0
+	}
+	return len(ev.DeleteRegistryKeyValue.Registry.KeyPath)
+}
+
+// GetDeleteKeyValueRegistryValueName returns the value of the field, resolving if necessary
+func (ev *Event) GetDeleteKeyValueRegistryValueName() string {
+	if ev.GetEventType().String() != "delete_key_value" {
+		return ""
+	}
+	return ev.DeleteRegistryKeyValue.ValueName
+}
+
+// GetDeleteKeyValueRegistryValueNameLength returns the value of the field, resolving if necessary
+func (ev *Event) GetDeleteKeyValueRegistryValueNameLength() int {
+	if ev.GetEventType().String() != "delete_key_value" {
+		return 0
+	}
+	return len(ev.DeleteRegistryKeyValue.ValueName)
+}
+
+// GetDeleteKeyValueValueName returns the value of the field, resolving if necessary
+func (ev *Event) GetDeleteKeyValueValueName() string {
+	if ev.GetEventType().String() != "delete_key_value" {
+		return ""
+	}
+	return ev.DeleteRegistryKeyValue.ValueName
+}
+
 // GetEventHostname returns the value of the field, resolving if necessary
 func (ev *Event) GetEventHostname() string {
 	return ev.FieldHandlers.ResolveHostname(ev, &ev.BaseEvent)
@@ -710,6 +894,70 @@ func (ev *Event) GetExitUserSid() string {
 	return ev.Exit.Process.OwnerSidString
 }
 
+// GetModifyServiceImagePath returns the value of the field, resolving if necessary
+func (ev *Event) GetModifyServiceImagePath() string {
+	if ev.GetEventType().String() != "modify_service" {
+		return ""
+	}
+	return ev.ModifyService.ImagePath
+}
+
+// GetModifyServiceImagePathLength returns the value of the field, resolving if necessary
+func (ev *Event) GetModifyServiceImagePathLength() int {
+	if ev.GetEventType().String() != "modify_service" {
+		return 0
+	}
+	return len(ev.ModifyService.ImagePath)
+}
+
+// GetModifyServiceName returns the value of the field, resolving if necessary
+func (ev *Event) GetModifyServiceName() string {
+	if ev.GetEventType().String() != "modify_service" {
+		return ""
+	}
+	return ev.ModifyService.Name
+}
+
+// GetModifyServiceNameLength returns the value of the field, resolving if necessary
+func (ev *Event) GetModifyServiceNameLength() int {
+	if ev.GetEventType().String() != "modify_service" {
+		return 0
+	}
+	return len(ev.ModifyService.Name)
+}
+
+// GetModifyServicePreviousImagePath returns the value of the field, resolving if necessary
+func (ev *Event) GetModifyServicePreviousImagePath() string {
+	if ev.GetEventType().String() != "modify_service" {
+		return ""
+	}
+	return ev.ModifyService.PreviousImagePath
+}
+
+// GetModifyServicePreviousImagePathLength returns the value of the field, resolving if necessary
+func (ev *Event) GetModifyServicePreviousImagePathLength() int {
+	if ev.GetEventType().String() != "modify_service" {
+		return 0
+	}
+	return len(ev.ModifyService.PreviousImagePath)
+}
+
+// GetModifyServicePreviousStartType returns the value of the field, resolving if necessary
+func (ev *Event) GetModifyServicePreviousStartType() string {
+	if ev.GetEventType().String() != "modify_service" {
+		return ""
+	}
+	return ev.ModifyService.PreviousStartType
+}
+
+// GetModifyServiceStartType returns the value of the field, resolving if necessary
+func (ev *Event) GetModifyServiceStartType() string {
+	if ev.GetEventType().String() != "modify_service" {
+		return ""
+	}
+	return ev.ModifyService.StartType
+}
+
 // GetOpenRegistryKeyName returns the value of the field, resolving if necessary
 func (ev *Event) GetOpenRegistryKeyName() string {
 	if ev.GetEventType().String() != "open_key" {
@@ -1527,6 +1775,22 @@ func (ev *Event) GetSetRegistryKeyPathLength() int {
 	return len(ev.SetRegistryKeyValue.Registry.KeyPath)
 }
 
+// GetSetRegistryValueData returns the value of the field, resolving if necessary
+func (ev *Event) GetSetRegistryValueData() string {
+	if ev.GetEventType().String() != "set_key_value" {
+		return ""
+	}
+	return ev.SetRegistryKeyValue.ValueData
+}
+
+// GetSetRegistryValueDataLength returns the value of the field, resolving if necessary
+func (ev *Event) GetSetRegistryValueDataLength() int {
+	if ev.GetEventType().String() != "set_key_value" {
+		return 0
+	}
+	return len(ev.SetRegistryKeyValue.ValueData)
+}
+
 // GetSetRegistryValueName returns the value of the field, resolving if necessary
 func (ev *Event) GetSetRegistryValueName() string {
 	if ev.GetEventType().String() != "set_key_value" {
@@ -1543,6 +1807,14 @@ func (ev *Event) GetSetRegistryValueNameLength() int {
 	return len(ev.SetRegistryKeyValue.ValueName)
 }
 
+// GetSetValueData returns the value of the field, resolving if necessary
+func (ev *Event) GetSetValueData() string {
+	if ev.GetEventType().String() != "set_key_value" {
+		return ""
+	}
+	return ev.SetRegistryKeyValue.ValueData
+}
+
 // GetSetValueName returns the value of the field, resolving if necessary
 func (ev *Event) GetSetValueName() string {
 	if ev.GetEventType().String() != "set_key_value" {
@@ -1583,6 +1855,22 @@ func (ev *Event) GetSetKeyValueRegistryKeyPathLength() int {
 	return len(ev.SetRegistryKeyValue.Registry.KeyPath)
 }
 
+// GetSetKeyValueRegistryValueData returns the value of the field, resolving if necessary
+func (ev *Event) GetSetKeyValueRegistryValueData() string {
+	if ev.GetEventType().String() != "set_key_value" {
+		return ""
+	}
+	return ev.SetRegistryKeyValue.ValueData
+}
+
+// GetSetKeyValueRegistryValueDataLength returns the value of the field, resolving if necessary
+func (ev *Event) GetSetKeyValueRegistryValueDataLength() int {
+	if ev.GetEventType().String() != "set_key_value" {
+		return 0
+	}
+	return len(ev.SetRegistryKeyValue.ValueData)
+}
+
 // GetSetKeyValueRegistryValueName returns the value of the field, resolving if necessary
 func (ev *Event) GetSetKeyValueRegistryValueName() string {
 	if ev.GetEventType().String() != "set_key_value" {
@@ -1599,6 +1887,14 @@ func (ev *Event) GetSetKeyValueRegistryValueNameLength() int {
 	return len(ev.SetRegistryKeyValue.ValueName)
 }
 
+// GetSetKeyValueValueData returns the value of the field, resolving if necessary
+func (ev *Event) GetSetKeyValueValueData() string {
+	if ev.GetEventType().String() != "set_key_value" {
+		return ""
+	}
+	return ev.SetRegistryKeyValue.ValueData
+}
+
 // GetSetKeyValueValueName returns the value of the field, resolving if necessary
 func (ev *Event) GetSetKeyValueValueName() string {
 	if ev.GetEventType().String() != "set_key_value" {
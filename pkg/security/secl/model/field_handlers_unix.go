@@ -23,8 +23,14 @@ func (ev *Event) ResolveFieldsForAD() {
 }
 func (ev *Event) resolveFields(forADs bool) {
 	// resolve context fields that are not related to any event type
+	_ = ev.FieldHandlers.ResolveCGroupAncestors(ev, &ev.CGroupContext)
+	_ = ev.FieldHandlers.ResolveCGroupControllers(ev, &ev.CGroupContext)
+	_ = ev.FieldHandlers.ResolveCGroupCPUUsage(ev, &ev.CGroupContext)
 	_ = ev.FieldHandlers.ResolveCGroupID(ev, &ev.CGroupContext)
 	_ = ev.FieldHandlers.ResolveCGroupManager(ev, &ev.CGroupContext)
+	_ = ev.FieldHandlers.ResolveCGroupMemoryCurrent(ev, &ev.CGroupContext)
+	_ = ev.FieldHandlers.ResolveCGroupMemoryHighExceeded(ev, &ev.CGroupContext)
+	_ = ev.FieldHandlers.ResolveCGroupPidsCurrent(ev, &ev.CGroupContext)
 	_ = ev.FieldHandlers.ResolveContainerCreatedAt(ev, ev.BaseEvent.ContainerContext)
 	_ = ev.FieldHandlers.ResolveContainerID(ev, ev.BaseEvent.ContainerContext)
 	_ = ev.FieldHandlers.ResolveContainerRuntime(ev, ev.BaseEvent.ContainerContext)
@@ -46,10 +52,24 @@ func (ev *Event) resolveFields(forADs bool) {
 	_ = ev.FieldHandlers.ResolveProcessArgsTruncated(ev, &ev.BaseEvent.ProcessContext.Process)
 	_ = ev.FieldHandlers.ResolveProcessArgv(ev, &ev.BaseEvent.ProcessContext.Process)
 	_ = ev.FieldHandlers.ResolveProcessArgv0(ev, &ev.BaseEvent.ProcessContext.Process)
+	_ = ev.FieldHandlers.ResolveCGroupAncestors(ev, &ev.BaseEvent.ProcessContext.Process.CGroup)
+	_ = ev.FieldHandlers.ResolveCGroupControllers(ev, &ev.BaseEvent.ProcessContext.Process.CGroup)
+	_ = ev.FieldHandlers.ResolveCGroupCPUUsage(ev, &ev.BaseEvent.ProcessContext.Process.CGroup)
 	_ = ev.FieldHandlers.ResolveCGroupID(ev, &ev.BaseEvent.ProcessContext.Process.CGroup)
 	_ = ev.FieldHandlers.ResolveCGroupManager(ev, &ev.BaseEvent.ProcessContext.Process.CGroup)
+	_ = ev.FieldHandlers.ResolveCGroupMemoryCurrent(ev, &ev.BaseEvent.ProcessContext.Process.CGroup)
+	_ = ev.FieldHandlers.ResolveCGroupMemoryHighExceeded(ev, &ev.BaseEvent.ProcessContext.Process.CGroup)
+	_ = ev.FieldHandlers.ResolveCGroupPidsCurrent(ev, &ev.BaseEvent.ProcessContext.Process.CGroup)
+	_ = ev.FieldHandlers.ResolveProcessCloudCredentialsProviders(ev, &ev.BaseEvent.ProcessContext.Process)
+	_ = ev.FieldHandlers.ResolveProcessCloudCredentialsResources(ev, &ev.BaseEvent.ProcessContext.Process)
+	_ = ev.FieldHandlers.ResolveProcessCloudCredentialsTypes(ev, &ev.BaseEvent.ProcessContext.Process)
+	_ = ev.FieldHandlers.ResolveProcessCmdLineObfuscationScore(ev, &ev.BaseEvent.ProcessContext.Process)
 	_ = ev.FieldHandlers.ResolveProcessContainerID(ev, &ev.BaseEvent.ProcessContext.Process)
 	_ = ev.FieldHandlers.ResolveProcessCreatedAt(ev, &ev.BaseEvent.ProcessContext.Process)
+	_ = ev.FieldHandlers.ResolveProcessELFBuildID(ev, &ev.BaseEvent.ProcessContext.Process)
+	_ = ev.FieldHandlers.ResolveProcessELFInterpreter(ev, &ev.BaseEvent.ProcessContext.Process)
+	_ = ev.FieldHandlers.ResolveProcessELFIsStatic(ev, &ev.BaseEvent.ProcessContext.Process)
+	_ = ev.FieldHandlers.ResolveProcessELFIsStripped(ev, &ev.BaseEvent.ProcessContext.Process)
 	_ = ev.FieldHandlers.ResolveProcessEnvp(ev, &ev.BaseEvent.ProcessContext.Process)
 	_ = ev.FieldHandlers.ResolveProcessEnvs(ev, &ev.BaseEvent.ProcessContext.Process)
 	_ = ev.FieldHandlers.ResolveProcessEnvsTruncated(ev, &ev.BaseEvent.ProcessContext.Process)
@@ -67,6 +87,12 @@ func (ev *Event) resolveFields(forADs bool) {
 	if ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
 		_ = ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.BaseEvent.ProcessContext.Process.FileEvent.FileFields)
 	}
+	if ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
+		_ = ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.BaseEvent.ProcessContext.Process.FileEvent)
+	}
+	if ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
+		_ = ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.BaseEvent.ProcessContext.Process.FileEvent)
+	}
 	if ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
 		_ = ev.FieldHandlers.ResolveFileBasename(ev, &ev.BaseEvent.ProcessContext.Process.FileEvent)
 	}
@@ -99,6 +125,12 @@ func (ev *Event) resolveFields(forADs bool) {
 	if ev.BaseEvent.ProcessContext.Process.HasInterpreter() {
 		_ = ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent.FileFields)
 	}
+	if ev.BaseEvent.ProcessContext.Process.HasInterpreter() {
+		_ = ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent)
+	}
+	if ev.BaseEvent.ProcessContext.Process.HasInterpreter() {
+		_ = ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent)
+	}
 	if ev.BaseEvent.ProcessContext.Process.HasInterpreter() {
 		_ = ev.FieldHandlers.ResolveFileBasename(ev, &ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent)
 	}
@@ -118,6 +150,10 @@ func (ev *Event) resolveFields(forADs bool) {
 		_ = ev.FieldHandlers.ResolveFileFieldsUser(ev, &ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent.FileFields)
 	}
 	_ = ev.FieldHandlers.ResolveProcessIsThread(ev, &ev.BaseEvent.ProcessContext.Process)
+	_ = ev.FieldHandlers.ResolveProcessMountNamespace(ev, &ev.BaseEvent.ProcessContext.Process)
+	_ = ev.FieldHandlers.ResolveProcessPIDNamespace(ev, &ev.BaseEvent.ProcessContext.Process)
+	_ = ev.FieldHandlers.ResolveProcessUserNamespace(ev, &ev.BaseEvent.ProcessContext.Process)
+	_ = ev.FieldHandlers.ResolveProcessUTSNamespace(ev, &ev.BaseEvent.ProcessContext.Process)
 	if ev.BaseEvent.ProcessContext.HasParent() {
 		if !forADs {
 			_ = ev.FieldHandlers.ResolveProcessArgs(ev, ev.BaseEvent.ProcessContext.Parent)
@@ -132,18 +168,60 @@ func (ev *Event) resolveFields(forADs bool) {
 	if ev.BaseEvent.ProcessContext.HasParent() {
 		_ = ev.FieldHandlers.ResolveProcessArgv0(ev, ev.BaseEvent.ProcessContext.Parent)
 	}
+	if ev.BaseEvent.ProcessContext.HasParent() {
+		_ = ev.FieldHandlers.ResolveCGroupAncestors(ev, &ev.BaseEvent.ProcessContext.Parent.CGroup)
+	}
+	if ev.BaseEvent.ProcessContext.HasParent() {
+		_ = ev.FieldHandlers.ResolveCGroupControllers(ev, &ev.BaseEvent.ProcessContext.Parent.CGroup)
+	}
+	if ev.BaseEvent.ProcessContext.HasParent() {
+		_ = ev.FieldHandlers.ResolveCGroupCPUUsage(ev, &ev.BaseEvent.ProcessContext.Parent.CGroup)
+	}
 	if ev.BaseEvent.ProcessContext.HasParent() {
 		_ = ev.FieldHandlers.ResolveCGroupID(ev, &ev.BaseEvent.ProcessContext.Parent.CGroup)
 	}
 	if ev.BaseEvent.ProcessContext.HasParent() {
 		_ = ev.FieldHandlers.ResolveCGroupManager(ev, &ev.BaseEvent.ProcessContext.Parent.CGroup)
 	}
+	if ev.BaseEvent.ProcessContext.HasParent() {
+		_ = ev.FieldHandlers.ResolveCGroupMemoryCurrent(ev, &ev.BaseEvent.ProcessContext.Parent.CGroup)
+	}
+	if ev.BaseEvent.ProcessContext.HasParent() {
+		_ = ev.FieldHandlers.ResolveCGroupMemoryHighExceeded(ev, &ev.BaseEvent.ProcessContext.Parent.CGroup)
+	}
+	if ev.BaseEvent.ProcessContext.HasParent() {
+		_ = ev.FieldHandlers.ResolveCGroupPidsCurrent(ev, &ev.BaseEvent.ProcessContext.Parent.CGroup)
+	}
+	if ev.BaseEvent.ProcessContext.HasParent() {
+		_ = ev.FieldHandlers.ResolveProcessCloudCredentialsProviders(ev, ev.BaseEvent.ProcessContext.Parent)
+	}
+	if ev.BaseEvent.ProcessContext.HasParent() {
+		_ = ev.FieldHandlers.ResolveProcessCloudCredentialsResources(ev, ev.BaseEvent.ProcessContext.Parent)
+	}
+	if ev.BaseEvent.ProcessContext.HasParent() {
+		_ = ev.FieldHandlers.ResolveProcessCloudCredentialsTypes(ev, ev.BaseEvent.ProcessContext.Parent)
+	}
+	if ev.BaseEvent.ProcessContext.HasParent() {
+		_ = ev.FieldHandlers.ResolveProcessCmdLineObfuscationScore(ev, ev.BaseEvent.ProcessContext.Parent)
+	}
 	if ev.BaseEvent.ProcessContext.HasParent() {
 		_ = ev.FieldHandlers.ResolveProcessContainerID(ev, ev.BaseEvent.ProcessContext.Parent)
 	}
 	if ev.BaseEvent.ProcessContext.HasParent() {
 		_ = ev.FieldHandlers.ResolveProcessCreatedAt(ev, ev.BaseEvent.ProcessContext.Parent)
 	}
+	if ev.BaseEvent.ProcessContext.HasParent() {
+		_ = ev.FieldHandlers.ResolveProcessELFBuildID(ev, ev.BaseEvent.ProcessContext.Parent)
+	}
+	if ev.BaseEvent.ProcessContext.HasParent() {
+		_ = ev.FieldHandlers.ResolveProcessELFInterpreter(ev, ev.BaseEvent.ProcessContext.Parent)
+	}
+	if ev.BaseEvent.ProcessContext.HasParent() {
+		_ = ev.FieldHandlers.ResolveProcessELFIsStatic(ev, ev.BaseEvent.ProcessContext.Parent)
+	}
+	if ev.BaseEvent.ProcessContext.HasParent() {
+		_ = ev.FieldHandlers.ResolveProcessELFIsStripped(ev, ev.BaseEvent.ProcessContext.Parent)
+	}
 	if ev.BaseEvent.ProcessContext.HasParent() {
 		_ = ev.FieldHandlers.ResolveProcessEnvp(ev, ev.BaseEvent.ProcessContext.Parent)
 	}
@@ -167,6 +245,12 @@ func (ev *Event) resolveFields(forADs bool) {
 	if ev.BaseEvent.ProcessContext.HasParent() && ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
 		_ = ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent.FileFields)
 	}
+	if ev.BaseEvent.ProcessContext.HasParent() && ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
+		_ = ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent)
+	}
+	if ev.BaseEvent.ProcessContext.HasParent() && ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
+		_ = ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent)
+	}
 	if ev.BaseEvent.ProcessContext.HasParent() && ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
 		_ = ev.FieldHandlers.ResolveFileBasename(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent)
 	}
@@ -199,6 +283,12 @@ func (ev *Event) resolveFields(forADs bool) {
 	if ev.BaseEvent.ProcessContext.HasParent() && ev.BaseEvent.ProcessContext.Parent.HasInterpreter() {
 		_ = ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent.FileFields)
 	}
+	if ev.BaseEvent.ProcessContext.HasParent() && ev.BaseEvent.ProcessContext.Parent.HasInterpreter() {
+		_ = ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent)
+	}
+	if ev.BaseEvent.ProcessContext.HasParent() && ev.BaseEvent.ProcessContext.Parent.HasInterpreter() {
+		_ = ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent)
+	}
 	if ev.BaseEvent.ProcessContext.HasParent() && ev.BaseEvent.ProcessContext.Parent.HasInterpreter() {
 		_ = ev.FieldHandlers.ResolveFileBasename(ev, &ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent)
 	}
@@ -220,6 +310,24 @@ func (ev *Event) resolveFields(forADs bool) {
 	if ev.BaseEvent.ProcessContext.HasParent() {
 		_ = ev.FieldHandlers.ResolveProcessIsThread(ev, ev.BaseEvent.ProcessContext.Parent)
 	}
+	if ev.BaseEvent.ProcessContext.HasParent() {
+		_ = ev.FieldHandlers.ResolveProcessMountNamespace(ev, ev.BaseEvent.ProcessContext.Parent)
+	}
+	if ev.BaseEvent.ProcessContext.HasParent() {
+		_ = ev.FieldHandlers.ResolveProcessPIDNamespace(ev, ev.BaseEvent.ProcessContext.Parent)
+	}
+	if ev.BaseEvent.ProcessContext.HasParent() {
+		_ = ev.FieldHandlers.ResolveProcessUserNamespace(ev, ev.BaseEvent.ProcessContext.Parent)
+	}
+	if ev.BaseEvent.ProcessContext.HasParent() {
+		_ = ev.FieldHandlers.ResolveProcessUTSNamespace(ev, ev.BaseEvent.ProcessContext.Parent)
+	}
+	if ev.BaseEvent.ProcessContext.HasParent() {
+		_ = ev.FieldHandlers.ResolveProcessSystemdSlice(ev, ev.BaseEvent.ProcessContext.Parent)
+	}
+	if ev.BaseEvent.ProcessContext.HasParent() {
+		_ = ev.FieldHandlers.ResolveProcessSystemdUnit(ev, ev.BaseEvent.ProcessContext.Parent)
+	}
 	if ev.BaseEvent.ProcessContext.HasParent() {
 		_ = ev.FieldHandlers.ResolveK8SGroups(ev, &ev.BaseEvent.ProcessContext.Parent.UserSession)
 	}
@@ -229,6 +337,8 @@ func (ev *Event) resolveFields(forADs bool) {
 	if ev.BaseEvent.ProcessContext.HasParent() {
 		_ = ev.FieldHandlers.ResolveK8SUsername(ev, &ev.BaseEvent.ProcessContext.Parent.UserSession)
 	}
+	_ = ev.FieldHandlers.ResolveProcessSystemdSlice(ev, &ev.BaseEvent.ProcessContext.Process)
+	_ = ev.FieldHandlers.ResolveProcessSystemdUnit(ev, &ev.BaseEvent.ProcessContext.Process)
 	_ = ev.FieldHandlers.ResolveK8SGroups(ev, &ev.BaseEvent.ProcessContext.Process.UserSession)
 	_ = ev.FieldHandlers.ResolveK8SUID(ev, &ev.BaseEvent.ProcessContext.Process.UserSession)
 	_ = ev.FieldHandlers.ResolveK8SUsername(ev, &ev.BaseEvent.ProcessContext.Process.UserSession)
@@ -237,6 +347,8 @@ func (ev *Event) resolveFields(forADs bool) {
 	case "bind":
 		_ = ev.FieldHandlers.ResolveIsIPPublic(ev, &ev.Bind.Addr)
 	case "bpf":
+		_ = ev.FieldHandlers.ResolveKernelLockdown(ev, &ev.BPF.KernelSecurity)
+		_ = ev.FieldHandlers.ResolveKernelSecureBoot(ev, &ev.BPF.KernelSecurity)
 	case "capset":
 	case "chdir":
 		_ = ev.FieldHandlers.ResolveFileFieldsUser(ev, &ev.Chdir.File.FileFields)
@@ -245,6 +357,8 @@ func (ev *Event) resolveFields(forADs bool) {
 		_ = ev.FieldHandlers.ResolveFilePath(ev, &ev.Chdir.File)
 		_ = ev.FieldHandlers.ResolveFileBasename(ev, &ev.Chdir.File)
 		_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.Chdir.File)
+		_ = ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Chdir.File)
+		_ = ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Chdir.File)
 		_ = ev.FieldHandlers.ResolvePackageName(ev, &ev.Chdir.File)
 		_ = ev.FieldHandlers.ResolvePackageVersion(ev, &ev.Chdir.File)
 		_ = ev.FieldHandlers.ResolvePackageSourceVersion(ev, &ev.Chdir.File)
@@ -261,6 +375,8 @@ func (ev *Event) resolveFields(forADs bool) {
 		_ = ev.FieldHandlers.ResolveFilePath(ev, &ev.Chmod.File)
 		_ = ev.FieldHandlers.ResolveFileBasename(ev, &ev.Chmod.File)
 		_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.Chmod.File)
+		_ = ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Chmod.File)
+		_ = ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Chmod.File)
 		_ = ev.FieldHandlers.ResolvePackageName(ev, &ev.Chmod.File)
 		_ = ev.FieldHandlers.ResolvePackageVersion(ev, &ev.Chmod.File)
 		_ = ev.FieldHandlers.ResolvePackageSourceVersion(ev, &ev.Chmod.File)
@@ -280,6 +396,8 @@ func (ev *Event) resolveFields(forADs bool) {
 		_ = ev.FieldHandlers.ResolveFilePath(ev, &ev.Chown.File)
 		_ = ev.FieldHandlers.ResolveFileBasename(ev, &ev.Chown.File)
 		_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.Chown.File)
+		_ = ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Chown.File)
+		_ = ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Chown.File)
 		_ = ev.FieldHandlers.ResolvePackageName(ev, &ev.Chown.File)
 		_ = ev.FieldHandlers.ResolvePackageVersion(ev, &ev.Chown.File)
 		_ = ev.FieldHandlers.ResolvePackageSourceVersion(ev, &ev.Chown.File)
@@ -320,6 +438,12 @@ func (ev *Event) resolveFields(forADs bool) {
 		if ev.Exec.Process.IsNotKworker() {
 			_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.Exec.Process.FileEvent)
 		}
+		if ev.Exec.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Exec.Process.FileEvent)
+		}
+		if ev.Exec.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Exec.Process.FileEvent)
+		}
 		if ev.Exec.Process.IsNotKworker() {
 			_ = ev.FieldHandlers.ResolvePackageName(ev, &ev.Exec.Process.FileEvent)
 		}
@@ -336,7 +460,19 @@ func (ev *Event) resolveFields(forADs bool) {
 		}
 		_ = ev.FieldHandlers.ResolveCGroupID(ev, &ev.Exec.Process.CGroup)
 		_ = ev.FieldHandlers.ResolveCGroupManager(ev, &ev.Exec.Process.CGroup)
+		_ = ev.FieldHandlers.ResolveCGroupAncestors(ev, &ev.Exec.Process.CGroup)
+		_ = ev.FieldHandlers.ResolveCGroupControllers(ev, &ev.Exec.Process.CGroup)
+		_ = ev.FieldHandlers.ResolveCGroupMemoryCurrent(ev, &ev.Exec.Process.CGroup)
+		_ = ev.FieldHandlers.ResolveCGroupMemoryHighExceeded(ev, &ev.Exec.Process.CGroup)
+		_ = ev.FieldHandlers.ResolveCGroupPidsCurrent(ev, &ev.Exec.Process.CGroup)
+		_ = ev.FieldHandlers.ResolveCGroupCPUUsage(ev, &ev.Exec.Process.CGroup)
 		_ = ev.FieldHandlers.ResolveProcessContainerID(ev, ev.Exec.Process)
+		_ = ev.FieldHandlers.ResolveProcessSystemdUnit(ev, ev.Exec.Process)
+		_ = ev.FieldHandlers.ResolveProcessSystemdSlice(ev, ev.Exec.Process)
+		_ = ev.FieldHandlers.ResolveProcessPIDNamespace(ev, ev.Exec.Process)
+		_ = ev.FieldHandlers.ResolveProcessUserNamespace(ev, ev.Exec.Process)
+		_ = ev.FieldHandlers.ResolveProcessMountNamespace(ev, ev.Exec.Process)
+		_ = ev.FieldHandlers.ResolveProcessUTSNamespace(ev, ev.Exec.Process)
 		if ev.Exec.Process.HasInterpreter() {
 			_ = ev.FieldHandlers.ResolveFileFieldsUser(ev, &ev.Exec.Process.LinuxBinprm.FileEvent.FileFields)
 		}
@@ -355,6 +491,12 @@ func (ev *Event) resolveFields(forADs bool) {
 		if ev.Exec.Process.HasInterpreter() {
 			_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.Exec.Process.LinuxBinprm.FileEvent)
 		}
+		if ev.Exec.Process.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Exec.Process.LinuxBinprm.FileEvent)
+		}
+		if ev.Exec.Process.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Exec.Process.LinuxBinprm.FileEvent)
+		}
 		if ev.Exec.Process.HasInterpreter() {
 			_ = ev.FieldHandlers.ResolvePackageName(ev, &ev.Exec.Process.LinuxBinprm.FileEvent)
 		}
@@ -373,6 +515,9 @@ func (ev *Event) resolveFields(forADs bool) {
 		_ = ev.FieldHandlers.ResolveK8SUsername(ev, &ev.Exec.Process.UserSession)
 		_ = ev.FieldHandlers.ResolveK8SUID(ev, &ev.Exec.Process.UserSession)
 		_ = ev.FieldHandlers.ResolveK8SGroups(ev, &ev.Exec.Process.UserSession)
+		_ = ev.FieldHandlers.ResolveProcessCloudCredentialsProviders(ev, ev.Exec.Process)
+		_ = ev.FieldHandlers.ResolveProcessCloudCredentialsTypes(ev, ev.Exec.Process)
+		_ = ev.FieldHandlers.ResolveProcessCloudCredentialsResources(ev, ev.Exec.Process)
 		_ = ev.FieldHandlers.ResolveProcessArgv0(ev, ev.Exec.Process)
 		if !forADs {
 			_ = ev.FieldHandlers.ResolveProcessArgs(ev, ev.Exec.Process)
@@ -382,6 +527,11 @@ func (ev *Event) resolveFields(forADs bool) {
 		_ = ev.FieldHandlers.ResolveProcessEnvs(ev, ev.Exec.Process)
 		_ = ev.FieldHandlers.ResolveProcessEnvp(ev, ev.Exec.Process)
 		_ = ev.FieldHandlers.ResolveProcessEnvsTruncated(ev, ev.Exec.Process)
+		_ = ev.FieldHandlers.ResolveProcessCmdLineObfuscationScore(ev, ev.Exec.Process)
+		_ = ev.FieldHandlers.ResolveProcessELFBuildID(ev, ev.Exec.Process)
+		_ = ev.FieldHandlers.ResolveProcessELFInterpreter(ev, ev.Exec.Process)
+		_ = ev.FieldHandlers.ResolveProcessELFIsStatic(ev, ev.Exec.Process)
+		_ = ev.FieldHandlers.ResolveProcessELFIsStripped(ev, ev.Exec.Process)
 		_ = ev.FieldHandlers.ResolveProcessIsThread(ev, ev.Exec.Process)
 		if !forADs {
 			_ = ev.FieldHandlers.ResolveSyscallCtxArgsStr1(ev, &ev.Exec.SyscallContext)
@@ -405,6 +555,12 @@ func (ev *Event) resolveFields(forADs bool) {
 		if ev.Exit.Process.IsNotKworker() {
 			_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.Exit.Process.FileEvent)
 		}
+		if ev.Exit.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Exit.Process.FileEvent)
+		}
+		if ev.Exit.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Exit.Process.FileEvent)
+		}
 		if ev.Exit.Process.IsNotKworker() {
 			_ = ev.FieldHandlers.ResolvePackageName(ev, &ev.Exit.Process.FileEvent)
 		}
@@ -421,7 +577,19 @@ func (ev *Event) resolveFields(forADs bool) {
 		}
 		_ = ev.FieldHandlers.ResolveCGroupID(ev, &ev.Exit.Process.CGroup)
 		_ = ev.FieldHandlers.ResolveCGroupManager(ev, &ev.Exit.Process.CGroup)
+		_ = ev.FieldHandlers.ResolveCGroupAncestors(ev, &ev.Exit.Process.CGroup)
+		_ = ev.FieldHandlers.ResolveCGroupControllers(ev, &ev.Exit.Process.CGroup)
+		_ = ev.FieldHandlers.ResolveCGroupMemoryCurrent(ev, &ev.Exit.Process.CGroup)
+		_ = ev.FieldHandlers.ResolveCGroupMemoryHighExceeded(ev, &ev.Exit.Process.CGroup)
+		_ = ev.FieldHandlers.ResolveCGroupPidsCurrent(ev, &ev.Exit.Process.CGroup)
+		_ = ev.FieldHandlers.ResolveCGroupCPUUsage(ev, &ev.Exit.Process.CGroup)
 		_ = ev.FieldHandlers.ResolveProcessContainerID(ev, ev.Exit.Process)
+		_ = ev.FieldHandlers.ResolveProcessSystemdUnit(ev, ev.Exit.Process)
+		_ = ev.FieldHandlers.ResolveProcessSystemdSlice(ev, ev.Exit.Process)
+		_ = ev.FieldHandlers.ResolveProcessPIDNamespace(ev, ev.Exit.Process)
+		_ = ev.FieldHandlers.ResolveProcessUserNamespace(ev, ev.Exit.Process)
+		_ = ev.FieldHandlers.ResolveProcessMountNamespace(ev, ev.Exit.Process)
+		_ = ev.FieldHandlers.ResolveProcessUTSNamespace(ev, ev.Exit.Process)
 		if ev.Exit.Process.HasInterpreter() {
 			_ = ev.FieldHandlers.ResolveFileFieldsUser(ev, &ev.Exit.Process.LinuxBinprm.FileEvent.FileFields)
 		}
@@ -440,6 +608,12 @@ func (ev *Event) resolveFields(forADs bool) {
 		if ev.Exit.Process.HasInterpreter() {
 			_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.Exit.Process.LinuxBinprm.FileEvent)
 		}
+		if ev.Exit.Process.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Exit.Process.LinuxBinprm.FileEvent)
+		}
+		if ev.Exit.Process.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Exit.Process.LinuxBinprm.FileEvent)
+		}
 		if ev.Exit.Process.HasInterpreter() {
 			_ = ev.FieldHandlers.ResolvePackageName(ev, &ev.Exit.Process.LinuxBinprm.FileEvent)
 		}
@@ -458,6 +632,9 @@ func (ev *Event) resolveFields(forADs bool) {
 		_ = ev.FieldHandlers.ResolveK8SUsername(ev, &ev.Exit.Process.UserSession)
 		_ = ev.FieldHandlers.ResolveK8SUID(ev, &ev.Exit.Process.UserSession)
 		_ = ev.FieldHandlers.ResolveK8SGroups(ev, &ev.Exit.Process.UserSession)
+		_ = ev.FieldHandlers.ResolveProcessCloudCredentialsProviders(ev, ev.Exit.Process)
+		_ = ev.FieldHandlers.ResolveProcessCloudCredentialsTypes(ev, ev.Exit.Process)
+		_ = ev.FieldHandlers.ResolveProcessCloudCredentialsResources(ev, ev.Exit.Process)
 		_ = ev.FieldHandlers.ResolveProcessArgv0(ev, ev.Exit.Process)
 		if !forADs {
 			_ = ev.FieldHandlers.ResolveProcessArgs(ev, ev.Exit.Process)
@@ -467,6 +644,11 @@ func (ev *Event) resolveFields(forADs bool) {
 		_ = ev.FieldHandlers.ResolveProcessEnvs(ev, ev.Exit.Process)
 		_ = ev.FieldHandlers.ResolveProcessEnvp(ev, ev.Exit.Process)
 		_ = ev.FieldHandlers.ResolveProcessEnvsTruncated(ev, ev.Exit.Process)
+		_ = ev.FieldHandlers.ResolveProcessCmdLineObfuscationScore(ev, ev.Exit.Process)
+		_ = ev.FieldHandlers.ResolveProcessELFBuildID(ev, ev.Exit.Process)
+		_ = ev.FieldHandlers.ResolveProcessELFInterpreter(ev, ev.Exit.Process)
+		_ = ev.FieldHandlers.ResolveProcessELFIsStatic(ev, ev.Exit.Process)
+		_ = ev.FieldHandlers.ResolveProcessELFIsStripped(ev, ev.Exit.Process)
 		_ = ev.FieldHandlers.ResolveProcessIsThread(ev, ev.Exit.Process)
 	case "imds":
 	case "link":
@@ -476,6 +658,8 @@ func (ev *Event) resolveFields(forADs bool) {
 		_ = ev.FieldHandlers.ResolveFilePath(ev, &ev.Link.Source)
 		_ = ev.FieldHandlers.ResolveFileBasename(ev, &ev.Link.Source)
 		_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.Link.Source)
+		_ = ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Link.Source)
+		_ = ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Link.Source)
 		_ = ev.FieldHandlers.ResolvePackageName(ev, &ev.Link.Source)
 		_ = ev.FieldHandlers.ResolvePackageVersion(ev, &ev.Link.Source)
 		_ = ev.FieldHandlers.ResolvePackageSourceVersion(ev, &ev.Link.Source)
@@ -488,6 +672,8 @@ func (ev *Event) resolveFields(forADs bool) {
 		_ = ev.FieldHandlers.ResolveFilePath(ev, &ev.Link.Target)
 		_ = ev.FieldHandlers.ResolveFileBasename(ev, &ev.Link.Target)
 		_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.Link.Target)
+		_ = ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Link.Target)
+		_ = ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Link.Target)
 		_ = ev.FieldHandlers.ResolvePackageName(ev, &ev.Link.Target)
 		_ = ev.FieldHandlers.ResolvePackageVersion(ev, &ev.Link.Target)
 		_ = ev.FieldHandlers.ResolvePackageSourceVersion(ev, &ev.Link.Target)
@@ -507,6 +693,8 @@ func (ev *Event) resolveFields(forADs bool) {
 		_ = ev.FieldHandlers.ResolveFilePath(ev, &ev.LoadModule.File)
 		_ = ev.FieldHandlers.ResolveFileBasename(ev, &ev.LoadModule.File)
 		_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.LoadModule.File)
+		_ = ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.LoadModule.File)
+		_ = ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.LoadModule.File)
 		_ = ev.FieldHandlers.ResolvePackageName(ev, &ev.LoadModule.File)
 		_ = ev.FieldHandlers.ResolvePackageVersion(ev, &ev.LoadModule.File)
 		_ = ev.FieldHandlers.ResolvePackageSourceVersion(ev, &ev.LoadModule.File)
@@ -515,6 +703,8 @@ func (ev *Event) resolveFields(forADs bool) {
 		}
 		_ = ev.FieldHandlers.ResolveModuleArgs(ev, &ev.LoadModule)
 		_ = ev.FieldHandlers.ResolveModuleArgv(ev, &ev.LoadModule)
+		_ = ev.FieldHandlers.ResolveKernelLockdown(ev, &ev.LoadModule.KernelSecurity)
+		_ = ev.FieldHandlers.ResolveKernelSecureBoot(ev, &ev.LoadModule.KernelSecurity)
 	case "mkdir":
 		_ = ev.FieldHandlers.ResolveFileFieldsUser(ev, &ev.Mkdir.File.FileFields)
 		_ = ev.FieldHandlers.ResolveFileFieldsGroup(ev, &ev.Mkdir.File.FileFields)
@@ -522,6 +712,8 @@ func (ev *Event) resolveFields(forADs bool) {
 		_ = ev.FieldHandlers.ResolveFilePath(ev, &ev.Mkdir.File)
 		_ = ev.FieldHandlers.ResolveFileBasename(ev, &ev.Mkdir.File)
 		_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.Mkdir.File)
+		_ = ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Mkdir.File)
+		_ = ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Mkdir.File)
 		_ = ev.FieldHandlers.ResolvePackageName(ev, &ev.Mkdir.File)
 		_ = ev.FieldHandlers.ResolvePackageVersion(ev, &ev.Mkdir.File)
 		_ = ev.FieldHandlers.ResolvePackageSourceVersion(ev, &ev.Mkdir.File)
@@ -535,6 +727,8 @@ func (ev *Event) resolveFields(forADs bool) {
 		_ = ev.FieldHandlers.ResolveFilePath(ev, &ev.MMap.File)
 		_ = ev.FieldHandlers.ResolveFileBasename(ev, &ev.MMap.File)
 		_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.MMap.File)
+		_ = ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.MMap.File)
+		_ = ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.MMap.File)
 		_ = ev.FieldHandlers.ResolvePackageName(ev, &ev.MMap.File)
 		_ = ev.FieldHandlers.ResolvePackageVersion(ev, &ev.MMap.File)
 		_ = ev.FieldHandlers.ResolvePackageSourceVersion(ev, &ev.MMap.File)
@@ -572,6 +766,8 @@ func (ev *Event) resolveFields(forADs bool) {
 		_ = ev.FieldHandlers.ResolveFilePath(ev, &ev.Open.File)
 		_ = ev.FieldHandlers.ResolveFileBasename(ev, &ev.Open.File)
 		_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.Open.File)
+		_ = ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Open.File)
+		_ = ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Open.File)
 		_ = ev.FieldHandlers.ResolvePackageName(ev, &ev.Open.File)
 		_ = ev.FieldHandlers.ResolvePackageVersion(ev, &ev.Open.File)
 		_ = ev.FieldHandlers.ResolvePackageSourceVersion(ev, &ev.Open.File)
@@ -610,6 +806,12 @@ func (ev *Event) resolveFields(forADs bool) {
 		if ev.PTrace.Tracee.Process.IsNotKworker() {
 			_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.PTrace.Tracee.Process.FileEvent)
 		}
+		if ev.PTrace.Tracee.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.PTrace.Tracee.Process.FileEvent)
+		}
+		if ev.PTrace.Tracee.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.PTrace.Tracee.Process.FileEvent)
+		}
 		if ev.PTrace.Tracee.Process.IsNotKworker() {
 			_ = ev.FieldHandlers.ResolvePackageName(ev, &ev.PTrace.Tracee.Process.FileEvent)
 		}
@@ -626,7 +828,19 @@ func (ev *Event) resolveFields(forADs bool) {
 		}
 		_ = ev.FieldHandlers.ResolveCGroupID(ev, &ev.PTrace.Tracee.Process.CGroup)
 		_ = ev.FieldHandlers.ResolveCGroupManager(ev, &ev.PTrace.Tracee.Process.CGroup)
+		_ = ev.FieldHandlers.ResolveCGroupAncestors(ev, &ev.PTrace.Tracee.Process.CGroup)
+		_ = ev.FieldHandlers.ResolveCGroupControllers(ev, &ev.PTrace.Tracee.Process.CGroup)
+		_ = ev.FieldHandlers.ResolveCGroupMemoryCurrent(ev, &ev.PTrace.Tracee.Process.CGroup)
+		_ = ev.FieldHandlers.ResolveCGroupMemoryHighExceeded(ev, &ev.PTrace.Tracee.Process.CGroup)
+		_ = ev.FieldHandlers.ResolveCGroupPidsCurrent(ev, &ev.PTrace.Tracee.Process.CGroup)
+		_ = ev.FieldHandlers.ResolveCGroupCPUUsage(ev, &ev.PTrace.Tracee.Process.CGroup)
 		_ = ev.FieldHandlers.ResolveProcessContainerID(ev, &ev.PTrace.Tracee.Process)
+		_ = ev.FieldHandlers.ResolveProcessSystemdUnit(ev, &ev.PTrace.Tracee.Process)
+		_ = ev.FieldHandlers.ResolveProcessSystemdSlice(ev, &ev.PTrace.Tracee.Process)
+		_ = ev.FieldHandlers.ResolveProcessPIDNamespace(ev, &ev.PTrace.Tracee.Process)
+		_ = ev.FieldHandlers.ResolveProcessUserNamespace(ev, &ev.PTrace.Tracee.Process)
+		_ = ev.FieldHandlers.ResolveProcessMountNamespace(ev, &ev.PTrace.Tracee.Process)
+		_ = ev.FieldHandlers.ResolveProcessUTSNamespace(ev, &ev.PTrace.Tracee.Process)
 		if ev.PTrace.Tracee.Process.HasInterpreter() {
 			_ = ev.FieldHandlers.ResolveFileFieldsUser(ev, &ev.PTrace.Tracee.Process.LinuxBinprm.FileEvent.FileFields)
 		}
@@ -645,6 +859,12 @@ func (ev *Event) resolveFields(forADs bool) {
 		if ev.PTrace.Tracee.Process.HasInterpreter() {
 			_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.PTrace.Tracee.Process.LinuxBinprm.FileEvent)
 		}
+		if ev.PTrace.Tracee.Process.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.PTrace.Tracee.Process.LinuxBinprm.FileEvent)
+		}
+		if ev.PTrace.Tracee.Process.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.PTrace.Tracee.Process.LinuxBinprm.FileEvent)
+		}
 		if ev.PTrace.Tracee.Process.HasInterpreter() {
 			_ = ev.FieldHandlers.ResolvePackageName(ev, &ev.PTrace.Tracee.Process.LinuxBinprm.FileEvent)
 		}
@@ -663,6 +883,9 @@ func (ev *Event) resolveFields(forADs bool) {
 		_ = ev.FieldHandlers.ResolveK8SUsername(ev, &ev.PTrace.Tracee.Process.UserSession)
 		_ = ev.FieldHandlers.ResolveK8SUID(ev, &ev.PTrace.Tracee.Process.UserSession)
 		_ = ev.FieldHandlers.ResolveK8SGroups(ev, &ev.PTrace.Tracee.Process.UserSession)
+		_ = ev.FieldHandlers.ResolveProcessCloudCredentialsProviders(ev, &ev.PTrace.Tracee.Process)
+		_ = ev.FieldHandlers.ResolveProcessCloudCredentialsTypes(ev, &ev.PTrace.Tracee.Process)
+		_ = ev.FieldHandlers.ResolveProcessCloudCredentialsResources(ev, &ev.PTrace.Tracee.Process)
 		_ = ev.FieldHandlers.ResolveProcessArgv0(ev, &ev.PTrace.Tracee.Process)
 		if !forADs {
 			_ = ev.FieldHandlers.ResolveProcessArgs(ev, &ev.PTrace.Tracee.Process)
@@ -672,6 +895,11 @@ func (ev *Event) resolveFields(forADs bool) {
 		_ = ev.FieldHandlers.ResolveProcessEnvs(ev, &ev.PTrace.Tracee.Process)
 		_ = ev.FieldHandlers.ResolveProcessEnvp(ev, &ev.PTrace.Tracee.Process)
 		_ = ev.FieldHandlers.ResolveProcessEnvsTruncated(ev, &ev.PTrace.Tracee.Process)
+		_ = ev.FieldHandlers.ResolveProcessCmdLineObfuscationScore(ev, &ev.PTrace.Tracee.Process)
+		_ = ev.FieldHandlers.ResolveProcessELFBuildID(ev, &ev.PTrace.Tracee.Process)
+		_ = ev.FieldHandlers.ResolveProcessELFInterpreter(ev, &ev.PTrace.Tracee.Process)
+		_ = ev.FieldHandlers.ResolveProcessELFIsStatic(ev, &ev.PTrace.Tracee.Process)
+		_ = ev.FieldHandlers.ResolveProcessELFIsStripped(ev, &ev.PTrace.Tracee.Process)
 		_ = ev.FieldHandlers.ResolveProcessIsThread(ev, &ev.PTrace.Tracee.Process)
 		if ev.PTrace.Tracee.HasParent() && ev.PTrace.Tracee.Parent.IsNotKworker() {
 			_ = ev.FieldHandlers.ResolveFileFieldsUser(ev, &ev.PTrace.Tracee.Parent.FileEvent.FileFields)
@@ -691,6 +919,12 @@ func (ev *Event) resolveFields(forADs bool) {
 		if ev.PTrace.Tracee.HasParent() && ev.PTrace.Tracee.Parent.IsNotKworker() {
 			_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.PTrace.Tracee.Parent.FileEvent)
 		}
+		if ev.PTrace.Tracee.HasParent() && ev.PTrace.Tracee.Parent.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.PTrace.Tracee.Parent.FileEvent)
+		}
+		if ev.PTrace.Tracee.HasParent() && ev.PTrace.Tracee.Parent.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.PTrace.Tracee.Parent.FileEvent)
+		}
 		if ev.PTrace.Tracee.HasParent() && ev.PTrace.Tracee.Parent.IsNotKworker() {
 			_ = ev.FieldHandlers.ResolvePackageName(ev, &ev.PTrace.Tracee.Parent.FileEvent)
 		}
@@ -711,9 +945,45 @@ func (ev *Event) resolveFields(forADs bool) {
 		if ev.PTrace.Tracee.HasParent() {
 			_ = ev.FieldHandlers.ResolveCGroupManager(ev, &ev.PTrace.Tracee.Parent.CGroup)
 		}
+		if ev.PTrace.Tracee.HasParent() {
+			_ = ev.FieldHandlers.ResolveCGroupAncestors(ev, &ev.PTrace.Tracee.Parent.CGroup)
+		}
+		if ev.PTrace.Tracee.HasParent() {
+			_ = ev.FieldHandlers.ResolveCGroupControllers(ev, &ev.PTrace.Tracee.Parent.CGroup)
+		}
+		if ev.PTrace.Tracee.HasParent() {
+			_ = ev.FieldHandlers.ResolveCGroupMemoryCurrent(ev, &ev.PTrace.Tracee.Parent.CGroup)
+		}
+		if ev.PTrace.Tracee.HasParent() {
+			_ = ev.FieldHandlers.ResolveCGroupMemoryHighExceeded(ev, &ev.PTrace.Tracee.Parent.CGroup)
+		}
+		if ev.PTrace.Tracee.HasParent() {
+			_ = ev.FieldHandlers.ResolveCGroupPidsCurrent(ev, &ev.PTrace.Tracee.Parent.CGroup)
+		}
+		if ev.PTrace.Tracee.HasParent() {
+			_ = ev.FieldHandlers.ResolveCGroupCPUUsage(ev, &ev.PTrace.Tracee.Parent.CGroup)
+		}
 		if ev.PTrace.Tracee.HasParent() {
 			_ = ev.FieldHandlers.ResolveProcessContainerID(ev, ev.PTrace.Tracee.Parent)
 		}
+		if ev.PTrace.Tracee.HasParent() {
+			_ = ev.FieldHandlers.ResolveProcessSystemdUnit(ev, ev.PTrace.Tracee.Parent)
+		}
+		if ev.PTrace.Tracee.HasParent() {
+			_ = ev.FieldHandlers.ResolveProcessSystemdSlice(ev, ev.PTrace.Tracee.Parent)
+		}
+		if ev.PTrace.Tracee.HasParent() {
+			_ = ev.FieldHandlers.ResolveProcessPIDNamespace(ev, ev.PTrace.Tracee.Parent)
+		}
+		if ev.PTrace.Tracee.HasParent() {
+			_ = ev.FieldHandlers.ResolveProcessUserNamespace(ev, ev.PTrace.Tracee.Parent)
+		}
+		if ev.PTrace.Tracee.HasParent() {
+			_ = ev.FieldHandlers.ResolveProcessMountNamespace(ev, ev.PTrace.Tracee.Parent)
+		}
+		if ev.PTrace.Tracee.HasParent() {
+			_ = ev.FieldHandlers.ResolveProcessUTSNamespace(ev, ev.PTrace.Tracee.Parent)
+		}
 		if ev.PTrace.Tracee.HasParent() && ev.PTrace.Tracee.Parent.HasInterpreter() {
 			_ = ev.FieldHandlers.ResolveFileFieldsUser(ev, &ev.PTrace.Tracee.Parent.LinuxBinprm.FileEvent.FileFields)
 		}
@@ -732,6 +1002,12 @@ func (ev *Event) resolveFields(forADs bool) {
 		if ev.PTrace.Tracee.HasParent() && ev.PTrace.Tracee.Parent.HasInterpreter() {
 			_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.PTrace.Tracee.Parent.LinuxBinprm.FileEvent)
 		}
+		if ev.PTrace.Tracee.HasParent() && ev.PTrace.Tracee.Parent.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.PTrace.Tracee.Parent.LinuxBinprm.FileEvent)
+		}
+		if ev.PTrace.Tracee.HasParent() && ev.PTrace.Tracee.Parent.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.PTrace.Tracee.Parent.LinuxBinprm.FileEvent)
+		}
 		if ev.PTrace.Tracee.HasParent() && ev.PTrace.Tracee.Parent.HasInterpreter() {
 			_ = ev.FieldHandlers.ResolvePackageName(ev, &ev.PTrace.Tracee.Parent.LinuxBinprm.FileEvent)
 		}
@@ -758,6 +1034,15 @@ func (ev *Event) resolveFields(forADs bool) {
 		if ev.PTrace.Tracee.HasParent() {
 			_ = ev.FieldHandlers.ResolveK8SGroups(ev, &ev.PTrace.Tracee.Parent.UserSession)
 		}
+		if ev.PTrace.Tracee.HasParent() {
+			_ = ev.FieldHandlers.ResolveProcessCloudCredentialsProviders(ev, ev.PTrace.Tracee.Parent)
+		}
+		if ev.PTrace.Tracee.HasParent() {
+			_ = ev.FieldHandlers.ResolveProcessCloudCredentialsTypes(ev, ev.PTrace.Tracee.Parent)
+		}
+		if ev.PTrace.Tracee.HasParent() {
+			_ = ev.FieldHandlers.ResolveProcessCloudCredentialsResources(ev, ev.PTrace.Tracee.Parent)
+		}
 		if ev.PTrace.Tracee.HasParent() {
 			_ = ev.FieldHandlers.ResolveProcessArgv0(ev, ev.PTrace.Tracee.Parent)
 		}
@@ -781,6 +1066,21 @@ func (ev *Event) resolveFields(forADs bool) {
 		if ev.PTrace.Tracee.HasParent() {
 			_ = ev.FieldHandlers.ResolveProcessEnvsTruncated(ev, ev.PTrace.Tracee.Parent)
 		}
+		if ev.PTrace.Tracee.HasParent() {
+			_ = ev.FieldHandlers.ResolveProcessCmdLineObfuscationScore(ev, ev.PTrace.Tracee.Parent)
+		}
+		if ev.PTrace.Tracee.HasParent() {
+			_ = ev.FieldHandlers.ResolveProcessELFBuildID(ev, ev.PTrace.Tracee.Parent)
+		}
+		if ev.PTrace.Tracee.HasParent() {
+			_ = ev.FieldHandlers.ResolveProcessELFInterpreter(ev, ev.PTrace.Tracee.Parent)
+		}
+		if ev.PTrace.Tracee.HasParent() {
+			_ = ev.FieldHandlers.ResolveProcessELFIsStatic(ev, ev.PTrace.Tracee.Parent)
+		}
+		if ev.PTrace.Tracee.HasParent() {
+			_ = ev.FieldHandlers.ResolveProcessELFIsStripped(ev, ev.PTrace.Tracee.Parent)
+		}
 		if ev.PTrace.Tracee.HasParent() {
 			_ = ev.FieldHandlers.ResolveProcessIsThread(ev, ev.PTrace.Tracee.Parent)
 		}
@@ -791,6 +1091,8 @@ func (ev *Event) resolveFields(forADs bool) {
 		_ = ev.FieldHandlers.ResolveFilePath(ev, &ev.RemoveXAttr.File)
 		_ = ev.FieldHandlers.ResolveFileBasename(ev, &ev.RemoveXAttr.File)
 		_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.RemoveXAttr.File)
+		_ = ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.RemoveXAttr.File)
+		_ = ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.RemoveXAttr.File)
 		_ = ev.FieldHandlers.ResolvePackageName(ev, &ev.RemoveXAttr.File)
 		_ = ev.FieldHandlers.ResolvePackageVersion(ev, &ev.RemoveXAttr.File)
 		_ = ev.FieldHandlers.ResolvePackageSourceVersion(ev, &ev.RemoveXAttr.File)
@@ -806,6 +1108,8 @@ func (ev *Event) resolveFields(forADs bool) {
 		_ = ev.FieldHandlers.ResolveFilePath(ev, &ev.Rename.Old)
 		_ = ev.FieldHandlers.ResolveFileBasename(ev, &ev.Rename.Old)
 		_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.Rename.Old)
+		_ = ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Rename.Old)
+		_ = ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Rename.Old)
 		_ = ev.FieldHandlers.ResolvePackageName(ev, &ev.Rename.Old)
 		_ = ev.FieldHandlers.ResolvePackageVersion(ev, &ev.Rename.Old)
 		_ = ev.FieldHandlers.ResolvePackageSourceVersion(ev, &ev.Rename.Old)
@@ -818,6 +1122,8 @@ func (ev *Event) resolveFields(forADs bool) {
 		_ = ev.FieldHandlers.ResolveFilePath(ev, &ev.Rename.New)
 		_ = ev.FieldHandlers.ResolveFileBasename(ev, &ev.Rename.New)
 		_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.Rename.New)
+		_ = ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Rename.New)
+		_ = ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Rename.New)
 		_ = ev.FieldHandlers.ResolvePackageName(ev, &ev.Rename.New)
 		_ = ev.FieldHandlers.ResolvePackageVersion(ev, &ev.Rename.New)
 		_ = ev.FieldHandlers.ResolvePackageSourceVersion(ev, &ev.Rename.New)
@@ -837,6 +1143,8 @@ func (ev *Event) resolveFields(forADs bool) {
 		_ = ev.FieldHandlers.ResolveFilePath(ev, &ev.Rmdir.File)
 		_ = ev.FieldHandlers.ResolveFileBasename(ev, &ev.Rmdir.File)
 		_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.Rmdir.File)
+		_ = ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Rmdir.File)
+		_ = ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Rmdir.File)
 		_ = ev.FieldHandlers.ResolvePackageName(ev, &ev.Rmdir.File)
 		_ = ev.FieldHandlers.ResolvePackageVersion(ev, &ev.Rmdir.File)
 		_ = ev.FieldHandlers.ResolvePackageSourceVersion(ev, &ev.Rmdir.File)
@@ -860,6 +1168,8 @@ func (ev *Event) resolveFields(forADs bool) {
 		_ = ev.FieldHandlers.ResolveFilePath(ev, &ev.SetXAttr.File)
 		_ = ev.FieldHandlers.ResolveFileBasename(ev, &ev.SetXAttr.File)
 		_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.SetXAttr.File)
+		_ = ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.SetXAttr.File)
+		_ = ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.SetXAttr.File)
 		_ = ev.FieldHandlers.ResolvePackageName(ev, &ev.SetXAttr.File)
 		_ = ev.FieldHandlers.ResolvePackageVersion(ev, &ev.SetXAttr.File)
 		_ = ev.FieldHandlers.ResolvePackageSourceVersion(ev, &ev.SetXAttr.File)
@@ -887,6 +1197,12 @@ func (ev *Event) resolveFields(forADs bool) {
 		if ev.Signal.Target.Process.IsNotKworker() {
 			_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.Signal.Target.Process.FileEvent)
 		}
+		if ev.Signal.Target.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Signal.Target.Process.FileEvent)
+		}
+		if ev.Signal.Target.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Signal.Target.Process.FileEvent)
+		}
 		if ev.Signal.Target.Process.IsNotKworker() {
 			_ = ev.FieldHandlers.ResolvePackageName(ev, &ev.Signal.Target.Process.FileEvent)
 		}
@@ -903,7 +1219,19 @@ func (ev *Event) resolveFields(forADs bool) {
 		}
 		_ = ev.FieldHandlers.ResolveCGroupID(ev, &ev.Signal.Target.Process.CGroup)
 		_ = ev.FieldHandlers.ResolveCGroupManager(ev, &ev.Signal.Target.Process.CGroup)
+		_ = ev.FieldHandlers.ResolveCGroupAncestors(ev, &ev.Signal.Target.Process.CGroup)
+		_ = ev.FieldHandlers.ResolveCGroupControllers(ev, &ev.Signal.Target.Process.CGroup)
+		_ = ev.FieldHandlers.ResolveCGroupMemoryCurrent(ev, &ev.Signal.Target.Process.CGroup)
+		_ = ev.FieldHandlers.ResolveCGroupMemoryHighExceeded(ev, &ev.Signal.Target.Process.CGroup)
+		_ = ev.FieldHandlers.ResolveCGroupPidsCurrent(ev, &ev.Signal.Target.Process.CGroup)
+		_ = ev.FieldHandlers.ResolveCGroupCPUUsage(ev, &ev.Signal.Target.Process.CGroup)
 		_ = ev.FieldHandlers.ResolveProcessContainerID(ev, &ev.Signal.Target.Process)
+		_ = ev.FieldHandlers.ResolveProcessSystemdUnit(ev, &ev.Signal.Target.Process)
+		_ = ev.FieldHandlers.ResolveProcessSystemdSlice(ev, &ev.Signal.Target.Process)
+		_ = ev.FieldHandlers.ResolveProcessPIDNamespace(ev, &ev.Signal.Target.Process)
+		_ = ev.FieldHandlers.ResolveProcessUserNamespace(ev, &ev.Signal.Target.Process)
+		_ = ev.FieldHandlers.ResolveProcessMountNamespace(ev, &ev.Signal.Target.Process)
+		_ = ev.FieldHandlers.ResolveProcessUTSNamespace(ev, &ev.Signal.Target.Process)
 		if ev.Signal.Target.Process.HasInterpreter() {
 			_ = ev.FieldHandlers.ResolveFileFieldsUser(ev, &ev.Signal.Target.Process.LinuxBinprm.FileEvent.FileFields)
 		}
@@ -922,6 +1250,12 @@ func (ev *Event) resolveFields(forADs bool) {
 		if ev.Signal.Target.Process.HasInterpreter() {
 			_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.Signal.Target.Process.LinuxBinprm.FileEvent)
 		}
+		if ev.Signal.Target.Process.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Signal.Target.Process.LinuxBinprm.FileEvent)
+		}
+		if ev.Signal.Target.Process.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Signal.Target.Process.LinuxBinprm.FileEvent)
+		}
 		if ev.Signal.Target.Process.HasInterpreter() {
 			_ = ev.FieldHandlers.ResolvePackageName(ev, &ev.Signal.Target.Process.LinuxBinprm.FileEvent)
 		}
@@ -940,6 +1274,9 @@ func (ev *Event) resolveFields(forADs bool) {
 		_ = ev.FieldHandlers.ResolveK8SUsername(ev, &ev.Signal.Target.Process.UserSession)
 		_ = ev.FieldHandlers.ResolveK8SUID(ev, &ev.Signal.Target.Process.UserSession)
 		_ = ev.FieldHandlers.ResolveK8SGroups(ev, &ev.Signal.Target.Process.UserSession)
+		_ = ev.FieldHandlers.ResolveProcessCloudCredentialsProviders(ev, &ev.Signal.Target.Process)
+		_ = ev.FieldHandlers.ResolveProcessCloudCredentialsTypes(ev, &ev.Signal.Target.Process)
+		_ = ev.FieldHandlers.ResolveProcessCloudCredentialsResources(ev, &ev.Signal.Target.Process)
 		_ = ev.FieldHandlers.ResolveProcessArgv0(ev, &ev.Signal.Target.Process)
 		if !forADs {
 			_ = ev.FieldHandlers.ResolveProcessArgs(ev, &ev.Signal.Target.Process)
@@ -949,6 +1286,11 @@ func (ev *Event) resolveFields(forADs bool) {
 		_ = ev.FieldHandlers.ResolveProcessEnvs(ev, &ev.Signal.Target.Process)
 		_ = ev.FieldHandlers.ResolveProcessEnvp(ev, &ev.Signal.Target.Process)
 		_ = ev.FieldHandlers.ResolveProcessEnvsTruncated(ev, &ev.Signal.Target.Process)
+		_ = ev.FieldHandlers.ResolveProcessCmdLineObfuscationScore(ev, &ev.Signal.Target.Process)
+		_ = ev.FieldHandlers.ResolveProcessELFBuildID(ev, &ev.Signal.Target.Process)
+		_ = ev.FieldHandlers.ResolveProcessELFInterpreter(ev, &ev.Signal.Target.Process)
+		_ = ev.FieldHandlers.ResolveProcessELFIsStatic(ev, &ev.Signal.Target.Process)
+		_ = ev.FieldHandlers.ResolveProcessELFIsStripped(ev, &ev.Signal.Target.Process)
 		_ = ev.FieldHandlers.ResolveProcessIsThread(ev, &ev.Signal.Target.Process)
 		if ev.Signal.Target.HasParent() && ev.Signal.Target.Parent.IsNotKworker() {
 			_ = ev.FieldHandlers.ResolveFileFieldsUser(ev, &ev.Signal.Target.Parent.FileEvent.FileFields)
@@ -968,6 +1310,12 @@ func (ev *Event) resolveFields(forADs bool) {
 		if ev.Signal.Target.HasParent() && ev.Signal.Target.Parent.IsNotKworker() {
 			_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.Signal.Target.Parent.FileEvent)
 		}
+		if ev.Signal.Target.HasParent() && ev.Signal.Target.Parent.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Signal.Target.Parent.FileEvent)
+		}
+		if ev.Signal.Target.HasParent() && ev.Signal.Target.Parent.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Signal.Target.Parent.FileEvent)
+		}
 		if ev.Signal.Target.HasParent() && ev.Signal.Target.Parent.IsNotKworker() {
 			_ = ev.FieldHandlers.ResolvePackageName(ev, &ev.Signal.Target.Parent.FileEvent)
 		}
@@ -988,9 +1336,45 @@ func (ev *Event) resolveFields(forADs bool) {
 		if ev.Signal.Target.HasParent() {
 			_ = ev.FieldHandlers.ResolveCGroupManager(ev, &ev.Signal.Target.Parent.CGroup)
 		}
+		if ev.Signal.Target.HasParent() {
+			_ = ev.FieldHandlers.ResolveCGroupAncestors(ev, &ev.Signal.Target.Parent.CGroup)
+		}
+		if ev.Signal.Target.HasParent() {
+			_ = ev.FieldHandlers.ResolveCGroupControllers(ev, &ev.Signal.Target.Parent.CGroup)
+		}
+		if ev.Signal.Target.HasParent() {
+			_ = ev.FieldHandlers.ResolveCGroupMemoryCurrent(ev, &ev.Signal.Target.Parent.CGroup)
+		}
+		if ev.Signal.Target.HasParent() {
+			_ = ev.FieldHandlers.ResolveCGroupMemoryHighExceeded(ev, &ev.Signal.Target.Parent.CGroup)
+		}
+		if ev.Signal.Target.HasParent() {
+			_ = ev.FieldHandlers.ResolveCGroupPidsCurrent(ev, &ev.Signal.Target.Parent.CGroup)
+		}
+		if ev.Signal.Target.HasParent() {
+			_ = ev.FieldHandlers.ResolveCGroupCPUUsage(ev, &ev.Signal.Target.Parent.CGroup)
+		}
 		if ev.Signal.Target.HasParent() {
 			_ = ev.FieldHandlers.ResolveProcessContainerID(ev, ev.Signal.Target.Parent)
 		}
+		if ev.Signal.Target.HasParent() {
+			_ = ev.FieldHandlers.ResolveProcessSystemdUnit(ev, ev.Signal.Target.Parent)
+		}
+		if ev.Signal.Target.HasParent() {
+			_ = ev.FieldHandlers.ResolveProcessSystemdSlice(ev, ev.Signal.Target.Parent)
+		}
+		if ev.Signal.Target.HasParent() {
+			_ = ev.FieldHandlers.ResolveProcessPIDNamespace(ev, ev.Signal.Target.Parent)
+		}
+		if ev.Signal.Target.HasParent() {
+			_ = ev.FieldHandlers.ResolveProcessUserNamespace(ev, ev.Signal.Target.Parent)
+		}
+		if ev.Signal.Target.HasParent() {
+			_ = ev.FieldHandlers.ResolveProcessMountNamespace(ev, ev.Signal.Target.Parent)
+		}
+		if ev.Signal.Target.HasParent() {
+			_ = ev.FieldHandlers.ResolveProcessUTSNamespace(ev, ev.Signal.Target.Parent)
+		}
 		if ev.Signal.Target.HasParent() && ev.Signal.Target.Parent.HasInterpreter() {
 			_ = ev.FieldHandlers.ResolveFileFieldsUser(ev, &ev.Signal.Target.Parent.LinuxBinprm.FileEvent.FileFields)
 		}
@@ -1009,6 +1393,12 @@ func (ev *Event) resolveFields(forADs bool) {
 		if ev.Signal.Target.HasParent() && ev.Signal.Target.Parent.HasInterpreter() {
 			_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.Signal.Target.Parent.LinuxBinprm.FileEvent)
 		}
+		if ev.Signal.Target.HasParent() && ev.Signal.Target.Parent.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Signal.Target.Parent.LinuxBinprm.FileEvent)
+		}
+		if ev.Signal.Target.HasParent() && ev.Signal.Target.Parent.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Signal.Target.Parent.LinuxBinprm.FileEvent)
+		}
 		if ev.Signal.Target.HasParent() && ev.Signal.Target.Parent.HasInterpreter() {
 			_ = ev.FieldHandlers.ResolvePackageName(ev, &ev.Signal.Target.Parent.LinuxBinprm.FileEvent)
 		}
@@ -1035,6 +1425,15 @@ func (ev *Event) resolveFields(forADs bool) {
 		if ev.Signal.Target.HasParent() {
 			_ = ev.FieldHandlers.ResolveK8SGroups(ev, &ev.Signal.Target.Parent.UserSession)
 		}
+		if ev.Signal.Target.HasParent() {
+			_ = ev.FieldHandlers.ResolveProcessCloudCredentialsProviders(ev, ev.Signal.Target.Parent)
+		}
+		if ev.Signal.Target.HasParent() {
+			_ = ev.FieldHandlers.ResolveProcessCloudCredentialsTypes(ev, ev.Signal.Target.Parent)
+		}
+		if ev.Signal.Target.HasParent() {
+			_ = ev.FieldHandlers.ResolveProcessCloudCredentialsResources(ev, ev.Signal.Target.Parent)
+		}
 		if ev.Signal.Target.HasParent() {
 			_ = ev.FieldHandlers.ResolveProcessArgv0(ev, ev.Signal.Target.Parent)
 		}
@@ -1058,6 +1457,21 @@ func (ev *Event) resolveFields(forADs bool) {
 		if ev.Signal.Target.HasParent() {
 			_ = ev.FieldHandlers.ResolveProcessEnvsTruncated(ev, ev.Signal.Target.Parent)
 		}
+		if ev.Signal.Target.HasParent() {
+			_ = ev.FieldHandlers.ResolveProcessCmdLineObfuscationScore(ev, ev.Signal.Target.Parent)
+		}
+		if ev.Signal.Target.HasParent() {
+			_ = ev.FieldHandlers.ResolveProcessELFBuildID(ev, ev.Signal.Target.Parent)
+		}
+		if ev.Signal.Target.HasParent() {
+			_ = ev.FieldHandlers.ResolveProcessELFInterpreter(ev, ev.Signal.Target.Parent)
+		}
+		if ev.Signal.Target.HasParent() {
+			_ = ev.FieldHandlers.ResolveProcessELFIsStatic(ev, ev.Signal.Target.Parent)
+		}
+		if ev.Signal.Target.HasParent() {
+			_ = ev.FieldHandlers.ResolveProcessELFIsStripped(ev, ev.Signal.Target.Parent)
+		}
 		if ev.Signal.Target.HasParent() {
 			_ = ev.FieldHandlers.ResolveProcessIsThread(ev, ev.Signal.Target.Parent)
 		}
@@ -1068,6 +1482,8 @@ func (ev *Event) resolveFields(forADs bool) {
 		_ = ev.FieldHandlers.ResolveFilePath(ev, &ev.Splice.File)
 		_ = ev.FieldHandlers.ResolveFileBasename(ev, &ev.Splice.File)
 		_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.Splice.File)
+		_ = ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Splice.File)
+		_ = ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Splice.File)
 		_ = ev.FieldHandlers.ResolvePackageName(ev, &ev.Splice.File)
 		_ = ev.FieldHandlers.ResolvePackageVersion(ev, &ev.Splice.File)
 		_ = ev.FieldHandlers.ResolvePackageSourceVersion(ev, &ev.Splice.File)
@@ -1081,6 +1497,8 @@ func (ev *Event) resolveFields(forADs bool) {
 		_ = ev.FieldHandlers.ResolveFilePath(ev, &ev.Unlink.File)
 		_ = ev.FieldHandlers.ResolveFileBasename(ev, &ev.Unlink.File)
 		_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.Unlink.File)
+		_ = ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Unlink.File)
+		_ = ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Unlink.File)
 		_ = ev.FieldHandlers.ResolvePackageName(ev, &ev.Unlink.File)
 		_ = ev.FieldHandlers.ResolvePackageVersion(ev, &ev.Unlink.File)
 		_ = ev.FieldHandlers.ResolvePackageSourceVersion(ev, &ev.Unlink.File)
@@ -1104,6 +1522,8 @@ func (ev *Event) resolveFields(forADs bool) {
 		_ = ev.FieldHandlers.ResolveFilePath(ev, &ev.Utimes.File)
 		_ = ev.FieldHandlers.ResolveFileBasename(ev, &ev.Utimes.File)
 		_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.Utimes.File)
+		_ = ev.FieldHandlers.ResolveFileLayerPath(ev, &ev.Utimes.File)
+		_ = ev.FieldHandlers.ResolveFileLayerDigest(ev, &ev.Utimes.File)
 		_ = ev.FieldHandlers.ResolvePackageName(ev, &ev.Utimes.File)
 		_ = ev.FieldHandlers.ResolvePackageVersion(ev, &ev.Utimes.File)
 		_ = ev.FieldHandlers.ResolvePackageSourceVersion(ev, &ev.Utimes.File)
@@ -1118,8 +1538,14 @@ func (ev *Event) resolveFields(forADs bool) {
 
 type FieldHandlers interface {
 	ResolveAsync(ev *Event) bool
+	ResolveCGroupAncestors(ev *Event, e *CGroupContext) []string
+	ResolveCGroupCPUUsage(ev *Event, e *CGroupContext) int
+	ResolveCGroupControllers(ev *Event, e *CGroupContext) []string
 	ResolveCGroupID(ev *Event, e *CGroupContext) string
 	ResolveCGroupManager(ev *Event, e *CGroupContext) string
+	ResolveCGroupMemoryCurrent(ev *Event, e *CGroupContext) int
+	ResolveCGroupMemoryHighExceeded(ev *Event, e *CGroupContext) bool
+	ResolveCGroupPidsCurrent(ev *Event, e *CGroupContext) int
 	ResolveChownGID(ev *Event, e *ChownEvent) string
 	ResolveChownUID(ev *Event, e *ChownEvent) string
 	ResolveContainerCreatedAt(ev *Event, e *ContainerContext) int
@@ -1133,6 +1559,8 @@ type FieldHandlers interface {
 	ResolveFileFieldsInUpperLayer(ev *Event, e *FileFields) bool
 	ResolveFileFieldsUser(ev *Event, e *FileFields) string
 	ResolveFileFilesystem(ev *Event, e *FileEvent) string
+	ResolveFileLayerDigest(ev *Event, e *FileEvent) string
+	ResolveFileLayerPath(ev *Event, e *FileEvent) string
 	ResolveFilePath(ev *Event, e *FileEvent) string
 	ResolveHashesFromEvent(ev *Event, e *FileEvent) []string
 	ResolveHostname(ev *Event, e *BaseEvent) string
@@ -1140,6 +1568,8 @@ type FieldHandlers interface {
 	ResolveK8SGroups(ev *Event, e *UserSessionContext) []string
 	ResolveK8SUID(ev *Event, e *UserSessionContext) string
 	ResolveK8SUsername(ev *Event, e *UserSessionContext) string
+	ResolveKernelLockdown(ev *Event, e *KernelSecurityContext) string
+	ResolveKernelSecureBoot(ev *Event, e *KernelSecurityContext) string
 	ResolveModuleArgs(ev *Event, e *LoadModuleEvent) string
 	ResolveModuleArgv(ev *Event, e *LoadModuleEvent) []string
 	ResolveMountPointPath(ev *Event, e *MountEvent) string
@@ -1166,13 +1596,27 @@ type FieldHandlers interface {
 	ResolveProcessArgv(ev *Event, e *Process) []string
 	ResolveProcessArgv0(ev *Event, e *Process) string
 	ResolveProcessArgvScrubbed(ev *Event, e *Process) []string
+	ResolveProcessCloudCredentialsProviders(ev *Event, e *Process) []string
+	ResolveProcessCloudCredentialsResources(ev *Event, e *Process) []string
+	ResolveProcessCloudCredentialsTypes(ev *Event, e *Process) []string
 	ResolveProcessCmdArgv(ev *Event, e *Process) []string
+	ResolveProcessCmdLineObfuscationScore(ev *Event, e *Process) int
 	ResolveProcessContainerID(ev *Event, e *Process) string
 	ResolveProcessCreatedAt(ev *Event, e *Process) int
+	ResolveProcessELFBuildID(ev *Event, e *Process) string
+	ResolveProcessELFInterpreter(ev *Event, e *Process) string
+	ResolveProcessELFIsStatic(ev *Event, e *Process) bool
+	ResolveProcessELFIsStripped(ev *Event, e *Process) bool
 	ResolveProcessEnvp(ev *Event, e *Process) []string
 	ResolveProcessEnvs(ev *Event, e *Process) []string
 	ResolveProcessEnvsTruncated(ev *Event, e *Process) bool
 	ResolveProcessIsThread(ev *Event, e *Process) bool
+	ResolveProcessMountNamespace(ev *Event, e *Process) int
+	ResolveProcessPIDNamespace(ev *Event, e *Process) int
+	ResolveProcessSystemdSlice(ev *Event, e *Process) string
+	ResolveProcessSystemdUnit(ev *Event, e *Process) string
+	ResolveProcessUTSNamespace(ev *Event, e *Process) int
+	ResolveProcessUserNamespace(ev *Event, e *Process) int
 	ResolveRights(ev *Event, e *FileFields) int
 	ResolveSELinuxBoolName(ev *Event, e *SELinuxEvent) string
 	ResolveService(ev *Event, e *BaseEvent) string
@@ -1196,12 +1640,30 @@ type FieldHandlers interface {
 type FakeFieldHandlers struct{}
 
 func (dfh *FakeFieldHandlers) ResolveAsync(ev *Event) bool { return bool(ev.Async) }
+func (dfh *FakeFieldHandlers) ResolveCGroupAncestors(ev *Event, e *CGroupContext) []string {
+	return []string(e.CGroupAncestors)
+}
+func (dfh *FakeFieldHandlers) ResolveCGroupCPUUsage(ev *Event, e *CGroupContext) int {
+	return int(e.CGroupCPUUsage)
+}
+func (dfh *FakeFieldHandlers) ResolveCGroupControllers(ev *Event, e *CGroupContext) []string {
+	return []string(e.CGroupControllers)
+}
 func (dfh *FakeFieldHandlers) ResolveCGroupID(ev *Event, e *CGroupContext) string {
 	return string(e.CGroupID)
 }
 func (dfh *FakeFieldHandlers) ResolveCGroupManager(ev *Event, e *CGroupContext) string {
 	return string(e.CGroupManager)
 }
+func (dfh *FakeFieldHandlers) ResolveCGroupMemoryCurrent(ev *Event, e *CGroupContext) int {
+	return int(e.CGroupMemoryCurrent)
+}
+func (dfh *FakeFieldHandlers) ResolveCGroupMemoryHighExceeded(ev *Event, e *CGroupContext) bool {
+	return bool(e.CGroupMemoryHighExceeded)
+}
+func (dfh *FakeFieldHandlers) ResolveCGroupPidsCurrent(ev *Event, e *CGroupContext) int {
+	return int(e.CGroupPidsCurrent)
+}
 func (dfh *FakeFieldHandlers) ResolveChownGID(ev *Event, e *ChownEvent) string {
 	return string(e.Group)
 }
@@ -1239,6 +1701,12 @@ func (dfh *FakeFieldHandlers) ResolveFileFieldsUser(ev *Event, e *FileFields) st
 func (dfh *FakeFieldHandlers) ResolveFileFilesystem(ev *Event, e *FileEvent) string {
 	return string(e.Filesystem)
 }
+func (dfh *FakeFieldHandlers) ResolveFileLayerDigest(ev *Event, e *FileEvent) string {
+	return string(e.LayerDigest)
+}
+func (dfh *FakeFieldHandlers) ResolveFileLayerPath(ev *Event, e *FileEvent) string {
+	return string(e.LayerPath)
+}
 func (dfh *FakeFieldHandlers) ResolveFilePath(ev *Event, e *FileEvent) string {
 	return string(e.PathnameStr)
 }
@@ -1260,6 +1728,12 @@ func (dfh *FakeFieldHandlers) ResolveK8SUID(ev *Event, e *UserSessionContext) st
 func (dfh *FakeFieldHandlers) ResolveK8SUsername(ev *Event, e *UserSessionContext) string {
 	return string(e.K8SUsername)
 }
+func (dfh *FakeFieldHandlers) ResolveKernelLockdown(ev *Event, e *KernelSecurityContext) string {
+	return string(e.Lockdown)
+}
+func (dfh *FakeFieldHandlers) ResolveKernelSecureBoot(ev *Event, e *KernelSecurityContext) string {
+	return string(e.SecureBoot)
+}
 func (dfh *FakeFieldHandlers) ResolveModuleArgs(ev *Event, e *LoadModuleEvent) string {
 	return string(e.Args)
 }
@@ -1336,15 +1810,39 @@ func (dfh *FakeFieldHandlers) ResolveProcessArgv0(ev *Event, e *Process) string
 func (dfh *FakeFieldHandlers) ResolveProcessArgvScrubbed(ev *Event, e *Process) []string {
 	return []string(e.ArgvScrubbed)
 }
+func (dfh *FakeFieldHandlers) ResolveProcessCloudCredentialsProviders(ev *Event, e *Process) []string {
+	return []string(e.CloudCredentialsProviders)
+}
+func (dfh *FakeFieldHandlers) ResolveProcessCloudCredentialsResources(ev *Event, e *Process) []string {
+	return []string(e.CloudCredentialsResources)
+}
+func (dfh *FakeFieldHandlers) ResolveProcessCloudCredentialsTypes(ev *Event, e *Process) []string {
+	return []string(e.CloudCredentialsTypes)
+}
 func (dfh *FakeFieldHandlers) ResolveProcessCmdArgv(ev *Event, e *Process) []string {
 	return []string(e.Argv)
 }
+func (dfh *FakeFieldHandlers) ResolveProcessCmdLineObfuscationScore(ev *Event, e *Process) int {
+	return int(e.CmdLineObfuscationScore)
+}
 func (dfh *FakeFieldHandlers) ResolveProcessContainerID(ev *Event, e *Process) string {
 	return string(e.ContainerID)
 }
 func (dfh *FakeFieldHandlers) ResolveProcessCreatedAt(ev *Event, e *Process) int {
 	return int(e.CreatedAt)
 }
+func (dfh *FakeFieldHandlers) ResolveProcessELFBuildID(ev *Event, e *Process) string {
+	return string(e.ELFBuildID)
+}
+func (dfh *FakeFieldHandlers) ResolveProcessELFInterpreter(ev *Event, e *Process) string {
+	return string(e.ELFInterpreter)
+}
+func (dfh *FakeFieldHandlers) ResolveProcessELFIsStatic(ev *Event, e *Process) bool {
+	return bool(e.ELFIsStatic)
+}
+func (dfh *FakeFieldHandlers) ResolveProcessELFIsStripped(ev *Event, e *Process) bool {
+	return bool(e.ELFIsStripped)
+}
 func (dfh *FakeFieldHandlers) ResolveProcessEnvp(ev *Event, e *Process) []string {
 	return []string(e.Envp)
 }
@@ -1357,6 +1855,24 @@ func (dfh *FakeFieldHandlers) ResolveProcessEnvsTruncated(ev *Event, e *Process)
 func (dfh *FakeFieldHandlers) ResolveProcessIsThread(ev *Event, e *Process) bool {
 	return bool(e.IsThread)
 }
+func (dfh *FakeFieldHandlers) ResolveProcessMountNamespace(ev *Event, e *Process) int {
+	return int(e.MountNamespace)
+}
+func (dfh *FakeFieldHandlers) ResolveProcessPIDNamespace(ev *Event, e *Process) int {
+	return int(e.PIDNamespace)
+}
+func (dfh *FakeFieldHandlers) ResolveProcessSystemdSlice(ev *Event, e *Process) string {
+	return string(e.SystemdSlice)
+}
+func (dfh *FakeFieldHandlers) ResolveProcessSystemdUnit(ev *Event, e *Process) string {
+	return string(e.SystemdUnit)
+}
+func (dfh *FakeFieldHandlers) ResolveProcessUTSNamespace(ev *Event, e *Process) int {
+	return int(e.UTSNamespace)
+}
+func (dfh *FakeFieldHandlers) ResolveProcessUserNamespace(ev *Event, e *Process) int {
+	return int(e.UserNamespace)
+}
 func (dfh *FakeFieldHandlers) ResolveRights(ev *Event, e *FileFields) int { return int(e.Mode) }
 func (dfh *FakeFieldHandlers) ResolveSELinuxBoolName(ev *Event, e *SELinuxEvent) string {
 	return string(e.BoolName)
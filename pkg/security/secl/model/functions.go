@@ -0,0 +1,42 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package model holds model related files
+package model
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/eval"
+)
+
+// SECLFunctions lists the builtin lineage predicates, expanded into expressions evaluated against the ancestors of
+// the process
+var SECLFunctions = map[string]eval.Function{
+	// descends_from(binary) or descends_from(image, binary): the process descends from the binary, the process
+	// running in a container of the image when specified
+	"descends_from": descendsFrom,
+	// spawned_by(binary): the parent of the process is the binary
+	"spawned_by": spawnedBy,
+}
+
+func descendsFrom(args []eval.FunctionArg) (string, error) {
+	switch len(args) {
+	case 1:
+		return fmt.Sprintf("process.ancestors.file.path in [%s]", args[0].Literal("")), nil
+	case 2:
+		return fmt.Sprintf(`(container.tags in [%s] && process.ancestors.file.path in [%s])`, args[0].Literal("image_name:"), args[1].Literal("")), nil
+	default:
+		return "", errors.New("a binary and an optional image are expected")
+	}
+}
+
+func spawnedBy(args []eval.FunctionArg) (string, error) {
+	if len(args) != 1 {
+		return "", errors.New("a binary is expected")
+	}
+	return fmt.Sprintf("process.parent.file.path in [%s]", args[0].Literal("")), nil
+}
@@ -0,0 +1,45 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build unix
+
+// Package model holds model related files
+package model
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/eval"
+)
+
+func init() {
+	// spawned_by_service(unit): the process runs in the cgroup of the systemd unit, and so descends from its main
+	// process. The ".service" suffix of the unit is optional.
+	SECLFunctions["spawned_by_service"] = spawnedByService
+}
+
+func spawnedByService(args []eval.FunctionArg) (string, error) {
+	if len(args) != 1 {
+		return "", errors.New("a systemd unit is expected")
+	}
+
+	unit := args[0]
+	switch unit.ValueType {
+	case eval.RegexpValueType:
+		return "", errors.New("regular expressions aren't supported for systemd units")
+	case eval.ScalarValueType:
+		if !strings.Contains(unit.Value, ".") {
+			unit.Value += ".service"
+		}
+	}
+
+	// the cgroup of a unit is nested in its slice, /system.slice/nginx.service for example
+	cgroup := unit
+	cgroup.ValueType = eval.PatternValueType
+
+	return fmt.Sprintf("process.cgroup.id in [%s, %s]", cgroup.Literal("*/"), unit.Literal("")), nil
+}
@@ -0,0 +1,81 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build unix
+
+// Package model holds model related files
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/ast"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/eval"
+)
+
+func TestLineageFunctions(t *testing.T) {
+	grandParent := &ProcessCacheEntry{}
+	grandParent.FileEvent.PathnameStr = "/usr/sbin/nginx"
+
+	parent := &ProcessCacheEntry{}
+	parent.Ancestor = grandParent
+	parent.FileEvent.PathnameStr = "/usr/sbin/nginx"
+
+	event := NewFakeEvent()
+	event.Type = uint32(ExecEventType)
+	event.ContainerContext.Tags = []string{"image_name:nginx", "image_tag:1.27"}
+	event.ProcessContext = &ProcessContext{
+		Process: Process{
+			FileEvent: FileEvent{PathnameStr: "/bin/sh"},
+			CGroup:    CGroupContext{CGroupID: "/system.slice/nginx.service"},
+		},
+		Parent:   &parent.Process,
+		Ancestor: parent,
+	}
+	event.Exec.Process = &event.ProcessContext.Process
+
+	opts := &eval.Opts{}
+	opts.WithConstants(SECLConstants()).WithFunctions(SECLFunctions)
+
+	tests := []struct {
+		expr     string
+		expected bool
+	}{
+		{expr: `descends_from("/usr/sbin/nginx")`, expected: true},
+		{expr: `descends_from(~"/usr/sbin/*")`, expected: true},
+		{expr: `descends_from("/usr/sbin/apache2")`, expected: false},
+		{expr: `descends_from("nginx", "/usr/sbin/nginx")`, expected: true},
+		{expr: `descends_from(r"ngi.x", ~"/usr/s*/nginx")`, expected: true},
+		{expr: `descends_from("httpd", "/usr/sbin/nginx")`, expected: false},
+		{expr: `spawned_by("/usr/sbin/nginx") && exec.file.path == "/bin/sh"`, expected: true},
+		{expr: `spawned_by("/bin/bash")`, expected: false},
+		{expr: `spawned_by_service("nginx")`, expected: true},
+		{expr: `spawned_by_service("nginx.service")`, expected: true},
+		{expr: `spawned_by_service(~"ngin*")`, expected: true},
+		{expr: `spawned_by_service("sshd")`, expected: false},
+	}
+
+	for _, test := range tests {
+		rule := eval.NewRule("test", test.expr, opts)
+		pc := ast.NewParsingContext(false)
+		if err := rule.Parse(pc); err != nil {
+			t.Fatalf("failed to parse `%s`: %s", test.expr, err)
+		}
+		if err := rule.GenEvaluator(&Model{}, pc); err != nil {
+			t.Fatalf("failed to compile `%s`: %s", test.expr, err)
+		}
+
+		assert.Equal(t, test.expected, rule.Eval(eval.NewContext(event)), test.expr)
+	}
+
+	for _, expr := range []string{`descends_from()`, `spawned_by_service(r"ngin.")`} {
+		rule := eval.NewRule("test", expr, opts)
+		pc := ast.NewParsingContext(false)
+		assert.NoError(t, rule.Parse(pc))
+		assert.Error(t, rule.GenEvaluator(&Model{}, pc), expr)
+	}
+}
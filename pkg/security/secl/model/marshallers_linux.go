@@ -136,18 +136,25 @@ func (e *Credentials) MarshalBinary(data []byte) (int, error) {
 // MarshalPidCache marshals a binary representation of itself
 func (e *Process) MarshalPidCache(data []byte, bootTime time.Time) (int, error) {
 	// Marshal pid_cache_t
-	if len(data) < 88 {
+	if len(data) < 120 {
 		return 0, ErrNotEnoughSpace
 	}
-	binary.NativeEndian.PutUint64(data[0:8], e.Cookie)
-	binary.NativeEndian.PutUint32(data[8:12], e.PPid)
+	if _, err := e.Cookie.MarshalBinary(data[0:SizeOfCookie]); err != nil {
+		return 0, err
+	}
+	binary.NativeEndian.PutUint32(data[16:20], e.PPid)
 
 	// padding
 
-	marshalTime(data[16:24], e.ForkTime.Sub(bootTime))
-	marshalTime(data[24:32], e.ExitTime.Sub(bootTime))
-	binary.NativeEndian.PutUint64(data[32:40], e.UserSession.ID)
-	written := 40
+	marshalTime(data[24:32], e.ForkTime.Sub(bootTime))
+	marshalTime(data[32:40], e.ExitTime.Sub(bootTime))
+	binary.NativeEndian.PutUint64(data[40:48], e.UserSession.ID)
+	binary.NativeEndian.PutUint64(data[48:56], e.StartBootTime)
+	binary.NativeEndian.PutUint32(data[56:60], e.PIDNamespace)
+	binary.NativeEndian.PutUint32(data[60:64], e.UserNamespace)
+	binary.NativeEndian.PutUint32(data[64:68], e.MountNamespace)
+	binary.NativeEndian.PutUint32(data[68:72], e.UTSNamespace)
+	written := 72
 
 	n, err := MarshalBinary(data[written:], &e.Credentials)
 	if err != nil {
@@ -12,6 +12,7 @@ import (
 	"net"
 	"reflect"
 	"runtime"
+	"sync/atomic"
 	"time"
 
 	"modernc.org/mathutil"
@@ -77,6 +78,36 @@ type ContainerContext struct {
 	Tags        []string                   `field:"tags,handler:ResolveContainerTags,opts:skip_ad,weight:9999"` // SECLDoc[tags] Definition:`Tags of the container`
 	Resolved    bool                       `field:"-"`
 	Runtime     string                     `field:"runtime,handler:ResolveContainerRuntime"` // SECLDoc[runtime] Definition:`Runtime managing the container`
+
+	// metadata reported by the container runtime, replaced as a whole as it is read without the lock of the workload.
+	// The pointer is shared by the copies of the context.
+	Metadata *atomic.Pointer[ContainerMetadata] `field:"-"`
+}
+
+// NewContainerContext returns the container context of a workload, able to hold the metadata of its runtime
+func NewContainerContext(containerID containerutils.ContainerID) ContainerContext {
+	return ContainerContext{
+		ContainerID: containerID,
+		Metadata:    &atomic.Pointer[ContainerMetadata]{},
+	}
+}
+
+// GetMetadata returns the metadata reported by the container runtime, or nil if it wasn't queried yet
+func (cc *ContainerContext) GetMetadata() *ContainerMetadata {
+	if cc.Metadata == nil {
+		return nil
+	}
+	return cc.Metadata.Load()
+}
+
+// ContainerMetadata holds the metadata of a container reported by its runtime
+type ContainerMetadata struct {
+	ImageName    string
+	ImageDigest  string
+	PodName      string
+	PodNamespace string
+	PodUID       string
+	SandboxID    string
 }
 
 // SecurityProfileContext holds the security context of the profile
@@ -86,6 +117,10 @@ type SecurityProfileContext struct {
 	Tags           []string                   `field:"tags"`        // SECLDoc[tags] Definition:`Tags of the security profile`
 	EventTypes     []EventType                `field:"event_types"` // SECLDoc[event_types] Definition:`Event types enabled for the security profile`
 	EventTypeState EventFilteringProfileState `field:"-"`           // State of the event type in this profile
+
+	// activity dump capturing the workload, set on the anomaly detection events
+	ActivityDumpName  string   `field:"-"`
+	ActivityDumpFiles []string `field:"-"`
 }
 
 // IPPortContext is used to hold an IP and Port
@@ -220,6 +255,11 @@ func (e *Event) HasActiveActivityDump() bool {
 	return e.Flags&EventFlagsHasActiveActivityDump > 0
 }
 
+// IsPreSnapshot returns true if the event was handled before the end of the snapshot of the processes
+func (e *Event) IsPreSnapshot() bool {
+	return e.Flags&EventFlagsPreSnapshot > 0
+}
+
 // IsAnomalyDetectionEvent returns true if the current event is an anomaly detection event (kernel or user space)
 func (e *Event) IsAnomalyDetectionEvent() bool {
 	return e.Flags&EventFlagsAnomalyDetectionEvent > 0
@@ -437,6 +477,22 @@ var zeroProcessContext ProcessContext
 type ProcessCacheEntry struct {
 	ProcessContext
 
+	// LastReferenced is the last time the entry was resolved for an event, only tracked when the retention of the
+	// referenced exited processes or the eviction of the entries is enabled
+	LastReferenced time.Time `field:"-"`
+
+	// MemorySize is the estimated memory used by the entry, as accounted by the process resolver. The args and envs
+	// are accounted separately, once for all the entries sharing them, MemoryArgsEntry and MemoryEnvsEntry being the
+	// ones the entry references in the accounting.
+	MemorySize      int64      `field:"-"`
+	MemoryArgsEntry *ArgsEntry `field:"-"`
+	MemoryEnvsEntry *EnvsEntry `field:"-"`
+
+	// Service is the unified service tag of the process, read from its environment when the entry is inserted
+	Service string `field:"-"`
+	// CmdLineHash is the hash of the scrubbed command line of the process, computed when the entry is inserted
+	CmdLineHash uint64 `field:"-"`
+
 	refCount    uint64                     `field:"-"`
 	coreRelease func(_ *ProcessCacheEntry) `field:"-"`
 	onRelease   []func()                   `field:"-"`
@@ -450,6 +506,12 @@ func (pc *ProcessCacheEntry) IsContainerRoot() bool {
 // Reset the entry
 func (pc *ProcessCacheEntry) Reset() {
 	pc.ProcessContext = zeroProcessContext
+	pc.LastReferenced = time.Time{}
+	pc.MemorySize = 0
+	pc.MemoryArgsEntry = nil
+	pc.MemoryEnvsEntry = nil
+	pc.Service = ""
+	pc.CmdLineHash = 0
 	pc.refCount = 0
 	// `coreRelease` function should not be cleared on reset
 	// it's used for pool and cache size management
@@ -582,6 +644,11 @@ type DNSEvent struct {
 	Class uint16 `field:"question.class"`                                                  // SECLDoc[question.class] Definition:`the class looked up by the DNS question` Constants:`DNS qclasses`
 	Size  uint16 `field:"question.length"`                                                 // SECLDoc[question.length] Definition:`the total DNS request size in bytes`
 	Count uint16 `field:"question.count"`                                                  // SECLDoc[question.count] Definition:`the total count of questions in the DNS request`
+
+	// heuristics computed per process and queried domain, to detect DNS tunneling
+	UniqueSubdomains int `field:"question.unique_subdomains"` // SECLDoc[question.unique_subdomains] Definition:`[Experimental] the number of distinct subdomains of the queried domain resolved by the process during the current minute`
+	DomainQueryRate  int `field:"question.domain_query_rate"` // SECLDoc[question.domain_query_rate] Definition:`[Experimental] the number of queries to the queried domain sent by the process during the current minute`
+	LabelEntropy     int `field:"question.label_entropy"`     // SECLDoc[question.label_entropy] Definition:`[Experimental] the Shannon entropy of the subdomain of the queried name, in hundredths of bits per character`
 }
 
 // Matches returns true if the two DNS events matches
@@ -600,6 +667,9 @@ type IMDSEvent struct {
 
 	// The fields below are optional and cloud specific fields
 	AWS AWSIMDSEvent `field:"aws"` // SECLDoc[aws] Definition:`the AWS specific data parsed from the IMDS event`
+
+	// AccessToken holds the OAuth access token delivered by the GCP and Azure IMDS
+	AccessToken OAuthAccessToken `field:"access_token"` // SECLDoc[access_token] Definition:`the OAuth access token in the IMDS answer`
 }
 
 // AWSIMDSEvent holds data from an AWS IMDS event
@@ -619,6 +689,17 @@ type AWSSecurityCredentials struct {
 	ExpirationRaw string `field:"-" json:"Expiration"`
 }
 
+// OAuthAccessToken is used to parse the fields of an OAuth access token response that are free of secrets. The token
+// itself is only kept as a fingerprint.
+type OAuthAccessToken struct {
+	Type        string `field:"type"`       // SECLDoc[type] Definition:`the access token type`
+	ExpiresIn   int    `field:"expires_in"` // SECLDoc[expires_in] Definition:`the lifetime in seconds of the access token`
+	ExpiresOn   int64  `field:"-"`
+	Resource    string `field:"resource"` // SECLDoc[resource] Definition:`the resource the access token grants access to (Azure only)`
+	ClientID    string `field:"-"`
+	Fingerprint string `field:"-"`
+}
+
 // BaseExtraFieldHandlers handlers not hold by any field
 type BaseExtraFieldHandlers interface {
 	ResolveProcessCacheEntry(ev *Event, newEntryCb func(*ProcessCacheEntry, error)) (*ProcessCacheEntry, bool)
@@ -18,6 +18,7 @@ import (
 	"time"
 
 	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/eval"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/containerutils"
 	"modernc.org/mathutil"
 )
 
@@ -31,9 +32,71 @@ const (
 	ErrPathSegmentLimit   = "each segment of a path must be shorter than" // ErrPathSegmentLimit tells when a patch reached the segment limit
 
 	// SizeOfCookie size of cookie
-	SizeOfCookie = 8
+	SizeOfCookie = 16
 )
 
+// ProcessCookie identifies an execution of a process in the kernel and user space caches, it is shared by the forks
+// of the process until their next exec. It matches the process_cookie_t kernel struct.
+type ProcessCookie struct {
+	Lo uint64
+	Hi uint64
+}
+
+// IsZero returns whether the cookie is unset
+func (c ProcessCookie) IsZero() bool {
+	return c.Lo == 0 && c.Hi == 0
+}
+
+// String returns the hexadecimal representation of the cookie
+func (c ProcessCookie) String() string {
+	return fmt.Sprintf("%016x%016x", c.Hi, c.Lo)
+}
+
+// MaxCloudAccessTokenLifetime bounds the retention of the access tokens whose IMDS response didn't provide an
+// expiration, the access tokens delivered by the GCP and Azure IMDS being valid for 24 hours at most
+const MaxCloudAccessTokenLifetime = 24 * time.Hour
+
+// IsExpired returns whether the credentials expired at the given time. The AWS security credentials always come with an
+// expiration, they are expired when it couldn't be parsed. The access tokens without expiration are expired after
+// their maximum lifetime.
+func (c *CloudCredentials) IsExpired(now time.Time) bool {
+	if c.Expiration.IsZero() {
+		return c.CloudProvider == IMDSAWSCloudProvider || c.AcquiredAt.Add(MaxCloudAccessTokenLifetime).Before(now)
+	}
+	return c.Expiration.Before(now)
+}
+
+// GetValidCloudCredentials returns the cloud credentials acquired by the process that aren't expired at the given time
+func (p *Process) GetValidCloudCredentials(now time.Time) []CloudCredentials {
+	var valid []CloudCredentials
+	for _, creds := range p.CloudCredentials {
+		if !creds.IsExpired(now) {
+			valid = append(valid, creds)
+		}
+	}
+	return valid
+}
+
+// UnmarshalBinary unmarshalls a binary representation of itself
+func (c *ProcessCookie) UnmarshalBinary(data []byte) (int, error) {
+	if len(data) < SizeOfCookie {
+		return 0, ErrNotEnoughData
+	}
+	c.Lo = binary.NativeEndian.Uint64(data[0:8])
+	c.Hi = binary.NativeEndian.Uint64(data[8:16])
+	return SizeOfCookie, nil
+}
+
+// MarshalBinary marshals a binary representation of itself
+func (c ProcessCookie) MarshalBinary(data []byte) (int, error) {
+	if len(data) < SizeOfCookie {
+		return 0, ErrNotEnoughSpace
+	}
+	binary.NativeEndian.PutUint64(data[0:8], c.Lo)
+	binary.NativeEndian.PutUint64(data[8:16], c.Hi)
+	return SizeOfCookie, nil
+}
+
 // check that all path are absolute
 func validatePath(field eval.Field, fieldValue eval.FieldValue) error {
 	// do not support regular expression on path, currently unable to support discarder for regex value
@@ -204,6 +267,12 @@ func (f *FileFields) GetInLowerLayer() bool {
 	return f.Flags&LowerLayer != 0
 }
 
+// IsUnder returns whether the cgroup is the provided cgroup or one of its descendants in the cgroup v2 hierarchy, like
+// a process under a slice
+func (cg *CGroupContext) IsUnder(ancestor containerutils.CGroupID) bool {
+	return containerutils.IsCGroupUnder(cg.CGroupID, ancestor)
+}
+
 // GetInUpperLayer returns whether a file is in the upper layer
 func (f *FileFields) GetInUpperLayer() bool {
 	return f.Flags&UpperLayer != 0
@@ -401,8 +470,8 @@ func (dfh *FakeFieldHandlers) ResolveHashes(_ EventType, _ *Process, _ *FileEven
 // ResolveUserSessionContext resolves and updates the provided user session context
 func (dfh *FakeFieldHandlers) ResolveUserSessionContext(_ *UserSessionContext) {}
 
-// ResolveAWSSecurityCredentials resolves and updates the AWS security credentials of the input process entry
-func (dfh *FakeFieldHandlers) ResolveAWSSecurityCredentials(_ *Event) []AWSSecurityCredentials {
+// ResolveCloudCredentials resolves and updates the cloud credentials of the input process entry
+func (dfh *FakeFieldHandlers) ResolveCloudCredentials(_ *Event) []CloudCredentials {
 	return nil
 }
 
@@ -426,6 +495,6 @@ type ExtraFieldHandlers interface {
 	BaseExtraFieldHandlers
 	ResolveHashes(eventType EventType, process *Process, file *FileEvent) []string
 	ResolveUserSessionContext(evtCtx *UserSessionContext)
-	ResolveAWSSecurityCredentials(event *Event) []AWSSecurityCredentials
+	ResolveCloudCredentials(event *Event) []CloudCredentials
 	ResolveSyscallCtxArgs(ev *Event, e *SyscallContext)
 }
@@ -34,6 +34,9 @@ type Event struct {
 	NetworkContext NetworkContext `field:"network" restricted_to:"dns,imds"` // [7.36] [Network] Network context
 	CGroupContext  CGroupContext  `field:"cgroup"`
 
+	// resource usage of the cgroups of the event, read once per event
+	CGroupUsages []CGroupUsage `field:"-"`
+
 	// fim events
 	Chmod       ChmodEvent    `field:"chmod" event:"chmod"`             // [7.27] [File] A file’s permissions were changed
 	Chown       ChownEvent    `field:"chown" event:"chown"`             // [7.27] [File] A file’s owner was changed
@@ -99,6 +102,25 @@ type CGroupContext struct {
 	CGroupFlags   containerutils.CGroupFlags `field:"-"`
 	CGroupManager string                     `field:"manager,handler:ResolveCGroupManager"` // SECLDoc[manager] Definition:`Lifecycle manager of the cgroup`
 	CGroupFile    PathKey                    `field:"file"`
+
+	CGroupAncestors   []string `field:"ancestors,handler:ResolveCGroupAncestors"`     // SECLDoc[ancestors] Definition:`Paths of the ancestors of the cgroup in the cgroup v2 hierarchy, from its parent to the root` Example:`exec.cgroup.ancestors == "/system.slice"` Description:`Matches the executions in the cgroup subtree of the system slice.`
+	CGroupControllers []string `field:"controllers,handler:ResolveCGroupControllers"` // SECLDoc[controllers] Definition:`Controllers available to the cgroup in the cgroup v2 hierarchy, from its cgroup.controllers file`
+
+	// the usage is resolved once per event in Event.CGroupUsages, these fields are never set as the context of a process
+	// is shared by its events
+	CGroupMemoryCurrent      uint64 `field:"memory.current,handler:ResolveCGroupMemoryCurrent"`            // SECLDoc[memory.current] Definition:`Memory used by the cgroup and its descendants, in bytes, when the cgroup usage enrichment is enabled`
+	CGroupMemoryHighExceeded bool   `field:"memory.high_exceeded,handler:ResolveCGroupMemoryHighExceeded"` // SECLDoc[memory.high_exceeded] Definition:`Indicator of a memory usage of the cgroup, or of one of its ancestors, above its memory.high throttle limit, when the cgroup usage enrichment is enabled` Example:`exec.cgroup.memory.high_exceeded` Description:`Matches the executions in a cgroup whose memory usage is being throttled.`
+	CGroupPidsCurrent        uint64 `field:"pids.current,handler:ResolveCGroupPidsCurrent"`                // SECLDoc[pids.current] Definition:`Number of processes of the cgroup and its descendants, when the cgroup usage enrichment is enabled`
+	CGroupCPUUsage           uint64 `field:"cpu.usage,handler:ResolveCGroupCPUUsage"`                      // SECLDoc[cpu.usage] Definition:`CPU time consumed by the cgroup and its descendants, in microseconds, when the cgroup usage enrichment is enabled`
+}
+
+// CGroupUsage holds the resource usage of a cgroup read for an event
+type CGroupUsage struct {
+	CGroupID           containerutils.CGroupID
+	MemoryCurrent      uint64
+	MemoryHighExceeded bool
+	PidsCurrent        uint64
+	CPUUsage           uint64
 }
 
 // SyscallEvent contains common fields for all the event
@@ -127,6 +149,8 @@ type ChmodEvent struct {
 	SyscallContext
 	File FileEvent `field:"file"`
 	Mode uint32    `field:"file.destination.mode; file.destination.rights"` // SECLDoc[file.destination.mode] Definition:`New mode of the chmod-ed file` Constants:`File mode constants` SECLDoc[file.destination.rights] Definition:`New rights of the chmod-ed file` Constants:`File mode constants`
+	// PreviousMode holds the mode of the file before the change, captured before it is applied
+	PreviousMode uint32 `field:"file.previous_mode; file.previous_rights"` // SECLDoc[file.previous_mode] Definition:`Mode of the chmod-ed file before the change` Constants:`File mode constants` SECLDoc[file.previous_rights] Definition:`Rights of the chmod-ed file before the change` Constants:`File mode constants`
 
 	// Syscall context aliases
 	SyscallPath string `field:"syscall.path,ref:chmod.syscall.str1"` // SECLDoc[syscall.path] Definition:`path argument of the syscall`
@@ -142,6 +166,9 @@ type ChownEvent struct {
 	User  string    `field:"file.destination.user,handler:ResolveChownUID"`  // SECLDoc[file.destination.user] Definition:`New user of the chown-ed file's owner`
 	GID   int64     `field:"file.destination.gid"`                           // SECLDoc[file.destination.gid] Definition:`New GID of the chown-ed file's owner`
 	Group string    `field:"file.destination.group,handler:ResolveChownGID"` // SECLDoc[file.destination.group] Definition:`New group of the chown-ed file's owner`
+	// PreviousUID and PreviousGID hold the owner of the file before the change, captured before it is applied
+	PreviousUID int64 `field:"file.previous_uid"` // SECLDoc[file.previous_uid] Definition:`UID of the chown-ed file's owner before the change`
+	PreviousGID int64 `field:"file.previous_gid"` // SECLDoc[file.previous_gid] Definition:`GID of the chown-ed file's owner before the change`
 
 	// Syscall context aliases
 	SyscallPath string `field:"syscall.path,ref:chown.syscall.str1"` // SECLDoc[syscall.path] Definition:`Path argument of the syscall`
@@ -212,6 +239,17 @@ type Process struct {
 	CGroup      CGroupContext              `field:"cgroup"`                                         // SECLDoc[cgroup] Definition:`CGroup`
 	ContainerID containerutils.ContainerID `field:"container.id,handler:ResolveProcessContainerID"` // SECLDoc[container.id] Definition:`Container ID`
 
+	// systemd unit owning the cgroup of the process, and the slice it is nested in
+	SystemdUnit  string `field:"systemd.unit,handler:ResolveProcessSystemdUnit"`   // SECLDoc[systemd.unit] Definition:`Systemd unit (service or scope) owning the cgroup of the process` Example:`exec.systemd.unit == "nginx.service"` Description:`Matches the processes started by the nginx service.`
+	SystemdSlice string `field:"systemd.slice,handler:ResolveProcessSystemdSlice"` // SECLDoc[systemd.slice] Definition:`Systemd slice the unit of the process is nested in`
+
+	// namespaces of the process, identified by the inode number of their /proc/[pid]/ns entry
+	PIDNamespace       uint32 `field:"ns.pid,handler:ResolveProcessPIDNamespace"`   // SECLDoc[ns.pid] Definition:`Inode number of the PID namespace of the process` Example:`exec.ns.pid != process.parent.ns.pid` Description:`Matches the executions of the processes living in another PID namespace than their parent.`
+	UserNamespace      uint32 `field:"ns.user,handler:ResolveProcessUserNamespace"` // SECLDoc[ns.user] Definition:`Inode number of the user namespace of the process`
+	MountNamespace     uint32 `field:"ns.mnt,handler:ResolveProcessMountNamespace"` // SECLDoc[ns.mnt] Definition:`Inode number of the mount namespace of the process`
+	UTSNamespace       uint32 `field:"ns.uts,handler:ResolveProcessUTSNamespace"`   // SECLDoc[ns.uts] Definition:`Inode number of the UTS namespace of the process`
+	NamespacesResolved bool   `field:"-"`
+
 	SpanID  uint64          `field:"-"`
 	TraceID mathutil.Int128 `field:"-"`
 
@@ -224,18 +262,27 @@ type Process struct {
 	ExitTime time.Time `field:"exit_time,opts:getters_only"`
 	ExecTime time.Time `field:"exec_time,opts:getters_only"`
 
+	// start time of the process in nanoseconds since boot, kept across the executions of the process
+	StartBootTime uint64 `field:"-"`
+
 	// TODO: merge with ExecTime
 	CreatedAt uint64 `field:"created_at,handler:ResolveProcessCreatedAt"` // SECLDoc[created_at] Definition:`Timestamp of the creation of the process`
 
-	Cookie uint64 `field:"-"`
-	PPid   uint32 `field:"ppid"` // SECLDoc[ppid] Definition:`Parent process ID`
+	// token identifying the process across the agent products, computed from the boot ID, the pid and the start time
+	Identity string `field:"identity,opts:getters_only"`
+
+	Cookie ProcessCookie `field:"-"`
+	PPid   uint32        `field:"ppid"` // SECLDoc[ppid] Definition:`Parent process ID`
 
 	// credentials_t section of pid_cache_t
 	Credentials
 
 	UserSession UserSessionContext `field:"user_session"` // SECLDoc[user_session] Definition:`User Session context of this process`
 
-	AWSSecurityCredentials []AWSSecurityCredentials `field:"-"`
+	CloudCredentials          []CloudCredentials `field:"-"`
+	CloudCredentialsProviders []string           `field:"cloud_credentials.providers,handler:ResolveProcessCloudCredentialsProviders,weight:100"` // SECLDoc[cloud_credentials.providers] Definition:`Cloud providers of the unexpired credentials acquired by the process from the IMDS` Example:`process.cloud_credentials.providers in ["gcp", "azure"]` Description:`Matches any process holding GCP or Azure credentials acquired from the IMDS.`
+	CloudCredentialsTypes     []string           `field:"cloud_credentials.types,handler:ResolveProcessCloudCredentialsTypes,weight:100"`         // SECLDoc[cloud_credentials.types] Definition:`Types of the unexpired credentials acquired by the process from the IMDS`
+	CloudCredentialsResources []string           `field:"cloud_credentials.resources,handler:ResolveProcessCloudCredentialsResources,weight:100"` // SECLDoc[cloud_credentials.resources] Definition:`Resources, or audiences, of the unexpired access tokens acquired by the process from the IMDS`
 
 	ArgsID uint64 `field:"-"`
 	EnvsID uint64 `field:"-"`
@@ -252,6 +299,15 @@ type Process struct {
 	Envp          []string `field:"envp,handler:ResolveProcessEnvp,weight:100"`                                                                                                                                                                              // SECLDoc[envp] Definition:`Environment variables of the process`
 	EnvsTruncated bool     `field:"envs_truncated,handler:ResolveProcessEnvsTruncated"`                                                                                                                                                                      // SECLDoc[envs_truncated] Definition:`Indicator of environment variables truncation`
 
+	CmdLineObfuscationScore int `field:"cmdline_obfuscation_score,handler:ResolveProcessCmdLineObfuscationScore,weight:500"` // SECLDoc[cmdline_obfuscation_score] Definition:`Score, from 0 to 100, of how likely the command line of the process hides an encoded payload (base64 or hex blobs, high entropy tokens, inline decoders, excessive length)` Example:`exec.cmdline_obfuscation_score >= 60` Description:`Matches any process whose command line is likely to contain an encoded payload.`
+
+	// metadata parsed from the ELF headers of the executable, on demand
+	ELFBuildID     string `field:"elf.build_id,handler:ResolveProcessELFBuildID"`        // SECLDoc[elf.build_id] Definition:`GNU build ID of the ELF executable of the process`
+	ELFInterpreter string `field:"elf.interpreter,handler:ResolveProcessELFInterpreter"` // SECLDoc[elf.interpreter] Definition:`Path of the program interpreter (PT_INTERP) of the ELF executable of the process`
+	ELFIsStatic    bool   `field:"elf.is_static,handler:ResolveProcessELFIsStatic"`      // SECLDoc[elf.is_static] Definition:`Indicates whether the ELF executable of the process is statically linked`
+	ELFIsStripped  bool   `field:"elf.is_stripped,handler:ResolveProcessELFIsStripped"`  // SECLDoc[elf.is_stripped] Definition:`Indicates whether the ELF executable of the process has no symbol table`
+	ELFResolved    bool   `field:"-"`
+
 	ArgsScrubbed string   `field:"args_scrubbed,handler:ResolveProcessArgsScrubbed,opts:getters_only"`
 	ArgvScrubbed []string `field:"argv_scrubbed,handler:ResolveProcessArgvScrubbed,opts:getters_only"`
 
@@ -272,8 +328,25 @@ type Process struct {
 	Source uint64 `field:"-"`
 
 	// lineage
-	hasValidLineage *bool `field:"-"`
-	lineageError    error `field:"-"`
+	hasValidLineage   *bool  `field:"-"`
+	lineageError      error  `field:"-"`
+	lineageGeneration uint64 `field:"-"`
+}
+
+// CloudCredentials holds the metadata, free of secrets, of the credentials acquired by a process from the IMDS of a
+// cloud provider
+type CloudCredentials struct {
+	CloudProvider string
+	// ID identifies the credentials: the access key ID of the AWS credentials, the fingerprint of the access tokens
+	ID         string
+	Type       string
+	Resource   string
+	Expiration time.Time
+	// AcquiredAt is the time of the IMDS response, bounding the retention of the access tokens without expiration
+	AcquiredAt time.Time
+
+	// AWS holds the AWS security credentials, when the credentials were delivered by the AWS IMDS
+	AWS AWSSecurityCredentials
 }
 
 // ExecEvent represents a exec event
@@ -318,6 +391,10 @@ type FileEvent struct {
 
 	PathResolutionError error `field:"-"`
 
+	LayerPath       string `field:"layer.path,handler:ResolveFileLayerPath"`     // SECLDoc[layer.path] Definition:`Path of the file in the OverlayFS layer backing it`
+	LayerDigest     string `field:"layer.digest,handler:ResolveFileLayerDigest"` // SECLDoc[layer.digest] Definition:`Digest of the container image layer backing the file, when it can be found`
+	IsLayerResolved bool   `field:"-"`
+
 	PkgName       string `field:"package.name,handler:ResolvePackageName"`                    // SECLDoc[package.name] Definition:`[Experimental] Name of the package that provided this file`
 	PkgVersion    string `field:"package.version,handler:ResolvePackageVersion"`              // SECLDoc[package.version] Definition:`[Experimental] Full version of the package that provided this file`
 	PkgSrcVersion string `field:"package.source_version,handler:ResolvePackageSourceVersion"` // SECLDoc[package.source_version] Definition:`[Experimental] Full version of the source package of the package that provided this file`
@@ -367,16 +444,18 @@ type ArgsEnvsEvent struct {
 
 // Mount represents a mountpoint (used by MountEvent and UnshareMountNSEvent)
 type Mount struct {
-	MountID        uint32  `field:"-"`
-	Device         uint32  `field:"-"`
-	ParentPathKey  PathKey `field:"-"`
-	RootPathKey    PathKey `field:"-"`
-	BindSrcMountID uint32  `field:"-"`
-	FSType         string  `field:"fs_type"` // SECLDoc[fs_type] Definition:`Type of the mounted file system`
-	MountPointStr  string  `field:"-"`
-	RootStr        string  `field:"-"`
-	Path           string  `field:"-"`
-	Origin         uint32  `field:"-"`
+	MountID        uint32   `field:"-"`
+	Device         uint32   `field:"-"`
+	ParentPathKey  PathKey  `field:"-"`
+	RootPathKey    PathKey  `field:"-"`
+	BindSrcMountID uint32   `field:"-"`
+	FSType         string   `field:"fs_type"` // SECLDoc[fs_type] Definition:`Type of the mounted file system`
+	MountPointStr  string   `field:"-"`
+	RootStr        string   `field:"-"`
+	Path           string   `field:"-"`
+	Origin         uint32   `field:"-"`
+	UpperDir       string   `field:"-"`
+	LowerDirs      []string `field:"-"`
 }
 
 // MountEvent represents a mount event
@@ -510,9 +589,10 @@ type UtimesEvent struct {
 type BPFEvent struct {
 	SyscallEvent
 
-	Map     BPFMap     `field:"map"`  // eBPF map involved in the BPF command
-	Program BPFProgram `field:"prog"` // eBPF program involved in the BPF command
-	Cmd     uint32     `field:"cmd"`  // SECLDoc[cmd] Definition:`BPF command name` Constants:`BPF commands`
+	Map            BPFMap                `field:"map"`             // eBPF map involved in the BPF command
+	Program        BPFProgram            `field:"prog"`            // eBPF program involved in the BPF command
+	Cmd            uint32                `field:"cmd"`             // SECLDoc[cmd] Definition:`BPF command name` Constants:`BPF commands`
+	KernelSecurity KernelSecurityContext `field:"kernel_security"` // Security state of the kernel of the host
 }
 
 // BPFMap represents a BPF map
@@ -549,7 +629,7 @@ type MMapEvent struct {
 	File       FileEvent `field:"file"`
 	Addr       uint64    `field:"-"`
 	Offset     uint64    `field:"-"`
-	Len        uint64    `field:"-"`
+	Len        uint64    `field:"length"`     // SECLDoc[length] Definition:`memory segment length`
 	Protection uint64    `field:"protection"` // SECLDoc[protection] Definition:`memory segment protection` Constants:`Protection constants`
 	Flags      uint64    `field:"flags"`      // SECLDoc[flags] Definition:`memory segment flags` Constants:`MMap flags`
 }
@@ -562,6 +642,8 @@ type MProtectEvent struct {
 	VMEnd         uint64 `field:"-"`
 	VMProtection  int    `field:"vm_protection"`  // SECLDoc[vm_protection] Definition:`initial memory segment protection` Constants:`Virtual Memory flags`
 	ReqProtection int    `field:"req_protection"` // SECLDoc[req_protection] Definition:`new memory segment protection` Constants:`Virtual Memory flags`
+	Size          uint64 `field:"size"`           // SECLDoc[size] Definition:`memory segment size`
+	IsAnonymous   bool   `field:"is_anonymous"`   // SECLDoc[is_anonymous] Definition:`indicates if the memory segment is anonymous, not backed by a file`
 }
 
 // LoadModuleEvent represents a load_module event
@@ -574,6 +656,15 @@ type LoadModuleEvent struct {
 	Args             string    `field:"args,handler:ResolveModuleArgs"` // SECLDoc[args] Definition:`Parameters (as a string) of the new kernel module`
 	Argv             []string  `field:"argv,handler:ResolveModuleArgv"` // SECLDoc[argv] Definition:`Parameters (as an array) of the new kernel module`
 	ArgsTruncated    bool      `field:"args_truncated"`                 // SECLDoc[args_truncated] Definition:`Indicates if the arguments were truncated or not`
+
+	KernelSecurity KernelSecurityContext `field:"kernel_security"` // Security state of the kernel of the host
+}
+
+// KernelSecurityContext represents the security state of the kernel of the host, on which the severity of the module
+// load and bpf events depends
+type KernelSecurityContext struct {
+	Lockdown   string `field:"lockdown,handler:ResolveKernelLockdown"`      // SECLDoc[lockdown] Definition:`Kernel lockdown mode of the host: none, integrity or confidentiality`
+	SecureBoot string `field:"secure_boot,handler:ResolveKernelSecureBoot"` // SECLDoc[secure_boot] Definition:`Secure boot status of the host: enabled, disabled or unknown`
 }
 
 // UnloadModuleEvent represents an unload_module event
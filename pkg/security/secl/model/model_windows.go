@@ -44,7 +44,17 @@ type Event struct {
 	SetRegistryKeyValue SetRegistryKeyValueEvent `field:"set_key_value;set" event:"set_key_value"` // [7.52] [Registry] A registry key value was set
 	DeleteRegistryKey   DeleteRegistryKeyEvent   `field:"delete_key;delete" event:"delete_key"`    // [7.52] [Registry] A registry key was deleted
 
+	DeleteRegistryKeyValue DeleteRegistryKeyValueEvent `field:"delete_key_value" event:"delete_key_value"` // [7.61] [Registry] A registry key value was deleted
+
 	ChangePermission ChangePermissionEvent `field:"change_permission" event:"change_permission" ` // [7.55] [Registry] A permission change was made
+
+	// Named pipes
+	CreateNamedPipe  CreateNamedPipeEvent  `field:"create_named_pipe" event:"create_named_pipe"`   // [7.61] [File] A named pipe was created
+	ConnectNamedPipe ConnectNamedPipeEvent `field:"connect_named_pipe" event:"connect_named_pipe"` // [7.61] [File] A client connected to a named pipe
+
+	// Services
+	CreateService CreateServiceEvent `field:"create_service" event:"create_service"` // [7.61] [Registry] A service was installed
+	ModifyService ModifyServiceEvent `field:"modify_service" event:"modify_service"` // [7.61] [Registry] The image path or the start type of a service was changed
 }
 
 // FileEvent is the common file event type
@@ -68,6 +78,12 @@ type RegistryEvent struct {
 	KeyPath string `field:"key_path,opts:length" op_override:"eval.CaseInsensitiveCmp"` // SECLDoc[key_path] Definition:`Registry's path`
 }
 
+// NamedPipeEvent is the common named pipe event type
+type NamedPipeEvent struct {
+	Name string `field:"name,opts:length" op_override:"eval.CaseInsensitiveCmp"` // SECLDoc[name] Definition:`Named pipe's name` Example:`create_named_pipe.pipe.name =~ "msagent_*"` Description:`Matches the creation of named pipes whose name starts with msagent_.`
+	Path string `field:"path,opts:length" op_override:"eval.CaseInsensitiveCmp"` // SECLDoc[path] Definition:`Named pipe's path` Example:`connect_named_pipe.pipe.path == "\\.\pipe\lsarpc"` Description:`Matches the connections to the lsarpc named pipe.`
+}
+
 // Process represents a process
 type Process struct {
 	PIDContext
@@ -157,6 +173,7 @@ type OpenRegistryKeyEvent struct {
 type SetRegistryKeyValueEvent struct {
 	Registry  RegistryEvent `field:"registry"`                                   // SECLDoc[registry] Definition:`Registry Event`
 	ValueName string        `field:"value_name;registry.value_name,opts:length"` // SECLDoc[value_name] Definition:`Registry's value name` SECLDoc[registry.value_name] Definition:`Registry's value name`
+	ValueData string        `field:"value_data;registry.value_data,opts:length"` // SECLDoc[value_data] Definition:`Registry's value data` Example:`set_key_value.value_data =~ "*powershell*"` Description:`Matches the registry values set to a command involving powershell.` SECLDoc[registry.value_data] Definition:`Registry's value data`
 }
 
 // DeleteRegistryKeyEvent defines registry key deletion
@@ -164,6 +181,12 @@ type DeleteRegistryKeyEvent struct {
 	Registry RegistryEvent `field:"registry"` // SECLDoc[registry] Definition:`Registry Event`
 }
 
+// DeleteRegistryKeyValueEvent defines the event of deleting a value of a registry key
+type DeleteRegistryKeyValueEvent struct {
+	Registry  RegistryEvent `field:"registry"`                                   // SECLDoc[registry] Definition:`Registry Event`
+	ValueName string        `field:"value_name;registry.value_name,opts:length"` // SECLDoc[value_name] Definition:`Registry's value name` SECLDoc[registry.value_name] Definition:`Registry's value name`
+}
+
 // ChangePermissionEvent defines object permission change
 type ChangePermissionEvent struct {
 	UserName   string `field:"username"`                                    // SECLDoc[username] Definition:`Username of the permission change author`
@@ -173,3 +196,35 @@ type ChangePermissionEvent struct {
 	OldSd      string `field:"old_sd,handler:ResolveOldSecurityDescriptor"` // SECLDoc[old_sd] Definition:`Original Security Descriptor of the object of which permission was changed`
 	NewSd      string `field:"new_sd,handler:ResolveNewSecurityDescriptor"` // SECLDoc[new_sd] Definition:`New Security Descriptor of the object of which permission was changed`
 }
+
+// Named pipes
+
+// CreateNamedPipeEvent defines named pipe creation
+type CreateNamedPipeEvent struct {
+	Pipe NamedPipeEvent `field:"pipe"` // SECLDoc[pipe] Definition:`Named Pipe Event`
+}
+
+// ConnectNamedPipeEvent defines the connection of a client to a named pipe
+type ConnectNamedPipeEvent struct {
+	Pipe       NamedPipeEvent `field:"pipe"`                                                      // SECLDoc[pipe] Definition:`Named Pipe Event`
+	ServerPid  uint32         `field:"server.pid"`                                                // SECLDoc[server.pid] Definition:`Process ID of the named pipe server`
+	ServerPath string         `field:"server.path,opts:length" op_override:"eval.WindowsPathCmp"` // SECLDoc[server.path] Definition:`Executable path of the named pipe server` Example:`connect_named_pipe.server.path == "c:\windows\system32\lsass.exe"` Description:`Matches the connections to named pipes served by lsass.`
+}
+
+// Services
+
+// CreateServiceEvent defines service installation
+type CreateServiceEvent struct {
+	Name      string `field:"name,opts:length" op_override:"eval.CaseInsensitiveCmp"`       // SECLDoc[name] Definition:`Name of the service`
+	ImagePath string `field:"image_path,opts:length" op_override:"eval.CaseInsensitiveCmp"` // SECLDoc[image_path] Definition:`Image path of the service` Example:`create_service.image_path =~ "*\temp\*"` Description:`Matches the installation of services running a binary located in a temp directory.`
+	StartType string `field:"start_type"`                                                   // SECLDoc[start_type] Definition:`Start type of the service (boot, system, automatic, manual or disabled)`
+}
+
+// ModifyServiceEvent defines the change of the image path or of the start type of a service
+type ModifyServiceEvent struct {
+	Name              string `field:"name,opts:length" op_override:"eval.CaseInsensitiveCmp"`                // SECLDoc[name] Definition:`Name of the service`
+	ImagePath         string `field:"image_path,opts:length" op_override:"eval.CaseInsensitiveCmp"`          // SECLDoc[image_path] Definition:`Image path of the service`
+	PreviousImagePath string `field:"previous_image_path,opts:length" op_override:"eval.CaseInsensitiveCmp"` // SECLDoc[previous_image_path] Definition:`Image path of the service before the change`
+	StartType         string `field:"start_type"`                                                            // SECLDoc[start_type] Definition:`Start type of the service (boot, system, automatic, manual or disabled)` Example:`modify_service.start_type == "disabled" && modify_service.name == "WinDefend"` Description:`Matches the deactivation of the Windows Defender service.`
+	PreviousStartType string `field:"previous_start_type"`                                                   // SECLDoc[previous_start_type] Definition:`Start type of the service before the change`
+}
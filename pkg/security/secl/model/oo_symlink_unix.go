@@ -52,6 +52,9 @@ var (
 
 	// ProcessSymlinkPathname handles symlink for process enrtries
 	ProcessSymlinkPathname = &eval.OpOverrides{
+		Indexable: func(field eval.Field) bool {
+			return field != "exec.file.path" && field != "process.file.path"
+		},
 		StringEquals: func(a *eval.StringEvaluator, b *eval.StringEvaluator, state *eval.State) (*eval.BoolEvaluator, error) {
 			path, err := eval.GlobCmp.StringEquals(a, b, state)
 			if err != nil {
@@ -156,6 +159,9 @@ var (
 
 	// ProcessSymlinkBasename handles symlink for process enrtries
 	ProcessSymlinkBasename = &eval.OpOverrides{
+		Indexable: func(field eval.Field) bool {
+			return field != "exec.file.name" && field != "process.file.name"
+		},
 		StringEquals: func(a *eval.StringEvaluator, b *eval.StringEvaluator, state *eval.State) (*eval.BoolEvaluator, error) {
 			path, err := eval.StringEquals(a, b, state)
 			if err != nil {
@@ -9,6 +9,7 @@
 package model
 
 import (
+	"sync/atomic"
 	"time"
 
 	"github.com/DataDog/datadog-agent/pkg/security/secl/containerutils"
@@ -37,8 +38,10 @@ func hasValidLineage(pc *ProcessCacheEntry) (bool, error) {
 		err       error
 	)
 
+	generation := lineageGeneration.Load()
 	for pc != nil {
-		if pc.hasValidLineage != nil {
+		// a broken lineage is only valid until the next repair of a lineage
+		if pc.hasValidLineage != nil && (*pc.hasValidLineage || pc.lineageGeneration == generation) {
 			return *pc.hasValidLineage, pc.lineageError
 		}
 
@@ -60,13 +63,25 @@ func hasValidLineage(pc *ProcessCacheEntry) (bool, error) {
 	return false, &ErrProcessIncompleteLineage{PID: pid, PPID: ppid, ContainerID: string(ctrID)}
 }
 
+// lineageGeneration is incremented on each repair of a lineage, invalidating the broken lineages cached by the entries
+// that may descend from the repaired one
+var lineageGeneration atomic.Uint64
+
 // HasValidLineage returns false if, from the entry, we cannot ascend the ancestors list to PID 1 or if a new is having a missing parent
 func (pc *ProcessCacheEntry) HasValidLineage() (bool, error) {
+	generation := lineageGeneration.Load()
 	res, err := hasValidLineage(pc)
-	pc.hasValidLineage, pc.lineageError = &res, err
+	pc.hasValidLineage, pc.lineageError, pc.lineageGeneration = &res, err, generation
 	return res, err
 }
 
+// RepairLineage links the entry to its parent that was missing
+func (pc *ProcessCacheEntry) RepairLineage(parent *ProcessCacheEntry) {
+	pc.SetAncestor(parent)
+	pc.IsParentMissing = false
+	lineageGeneration.Add(1)
+}
+
 // Exit a process
 func (pc *ProcessCacheEntry) Exit(exitTime time.Time) {
 	pc.ExitTime = exitTime
@@ -86,6 +101,26 @@ func copyProcessContext(parent, child *ProcessCacheEntry) {
 
 	// AUIDs should be inherited just like container IDs
 	child.Credentials.AUID = parent.Credentials.AUID
+
+	// the namespaces are kept across executions, unless the process entered other ones right before. They are used
+	// until the ones of the child are resolved.
+	copyNamespaces(parent, child)
+}
+
+// copyNamespaces copies the namespaces of the parent the child doesn't have yet
+func copyNamespaces(parent, child *ProcessCacheEntry) {
+	if child.PIDNamespace == 0 {
+		child.PIDNamespace = parent.PIDNamespace
+	}
+	if child.UserNamespace == 0 {
+		child.UserNamespace = parent.UserNamespace
+	}
+	if child.MountNamespace == 0 {
+		child.MountNamespace = parent.MountNamespace
+	}
+	if child.UTSNamespace == 0 {
+		child.UTSNamespace = parent.UTSNamespace
+	}
 }
 
 // ApplyExecTimeOf replace previous entry values by the given one
@@ -157,6 +192,7 @@ func (pc *ProcessCacheEntry) Fork(childEntry *ProcessCacheEntry) {
 	childEntry.Credentials = pc.Credentials
 	childEntry.LinuxBinprm = pc.LinuxBinprm
 	childEntry.Cookie = pc.Cookie
+	copyNamespaces(pc, childEntry)
 
 	childEntry.SetForkParent(pc)
 }
@@ -12,6 +12,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/ast"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/eval"
 )
 
 func TestHasValidLineage(t *testing.T) {
@@ -82,6 +85,27 @@ func TestHasValidLineage(t *testing.T) {
 		var mn *ErrProcessMissingParentNode
 		assert.ErrorAs(t, err, &mn)
 	})
+
+	t.Run("repaired", func(t *testing.T) {
+		pid1 := newPCE(1, nil, false)
+		child1 := newPCE(2, nil, true)
+		child2 := newPCE(3, child1, false)
+
+		isValid, _ := child2.HasValidLineage()
+		assert.False(t, isValid)
+
+		// the broken lineage is cached until a repair
+		child1.Ancestor, child1.IsParentMissing = pid1, false
+		isValid, _ = child2.HasValidLineage()
+		assert.False(t, isValid)
+
+		child1.Ancestor, child1.IsParentMissing = nil, true
+		child1.RepairLineage(pid1)
+
+		isValid, err := child2.HasValidLineage()
+		assert.True(t, isValid)
+		assert.NoError(t, err)
+	})
 }
 
 func TestEntryEquals(t *testing.T) {
@@ -108,3 +132,50 @@ func TestEntryEquals(t *testing.T) {
 	e1.ArgsEntry = &ArgsEntry{Values: []string{"aaa"}}
 	assert.True(t, e1.Equals(e2))
 }
+
+func TestForkExecNamespaces(t *testing.T) {
+	parent := NewProcessCacheEntry(nil)
+	parent.Pid = 1
+	parent.PIDNamespace, parent.UserNamespace, parent.MountNamespace, parent.UTSNamespace = 4026531836, 4026531837, 4026531841, 4026531838
+
+	// the child inherits the namespaces of its parent
+	child := NewProcessCacheEntry(nil)
+	child.Pid = 2
+	parent.Fork(child)
+	assert.Equal(t, parent.PIDNamespace, child.PIDNamespace)
+	assert.Equal(t, parent.UserNamespace, child.UserNamespace)
+	assert.Equal(t, parent.MountNamespace, child.MountNamespace)
+	assert.Equal(t, parent.UTSNamespace, child.UTSNamespace)
+
+	newExecEvent := func(pidNamespace uint32) *Event {
+		exec := NewProcessCacheEntry(nil)
+		exec.Pid = 2
+		exec.PIDNamespace = pidNamespace
+		child.Exec(exec)
+
+		event := NewFakeEvent()
+		event.Type = uint32(ExecEventType)
+		event.ProcessContext = &exec.ProcessContext
+		event.Exec.Process = &exec.Process
+		return event
+	}
+
+	rule := eval.NewRule("test", `exec.ns.pid != process.parent.ns.pid`, &eval.Opts{})
+	pc := ast.NewParsingContext(false)
+	if err := rule.Parse(pc); err != nil {
+		t.Fatal(err)
+	}
+	if err := rule.GenEvaluator(&Model{}, pc); err != nil {
+		t.Fatal(err)
+	}
+
+	// the execution keeps the namespaces of the process
+	event := newExecEvent(0)
+	assert.Equal(t, parent.PIDNamespace, event.Exec.Process.PIDNamespace)
+	assert.False(t, rule.Eval(eval.NewContext(event)))
+
+	// the process entered another PID namespace right before the execution
+	event = newExecEvent(4026532500)
+	assert.Equal(t, parent.MountNamespace, event.Exec.Process.MountNamespace)
+	assert.True(t, rule.Eval(eval.NewContext(event)))
+}
@@ -9,8 +9,12 @@ package model
 import (
 	"bufio"
 	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
 	"encoding/binary"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"math/bits"
@@ -27,6 +31,9 @@ import (
 	"github.com/DataDog/datadog-agent/pkg/security/secl/containerutils"
 )
 
+// mprotectFlagAnonymous is set by the kernel on the mprotect events of anonymous memory segments
+const mprotectFlagAnonymous = 1 << 0
+
 func validateReadSize(size, read int) (int, error) {
 	if size != read {
 		return 0, fmt.Errorf("expected %d, read %d: %w", size, read, ErrIncorrectDataSize)
@@ -80,6 +87,8 @@ func (e *ChmodEvent) UnmarshalBinary(data []byte) (int, error) {
 	}
 
 	e.Mode = binary.NativeEndian.Uint32(data[0:4])
+	// the file metadata are collected before the change is applied
+	e.PreviousMode = uint32(e.File.Mode) &^ unix.S_IFMT
 	return n + 4, nil
 }
 
@@ -98,6 +107,9 @@ func (e *ChownEvent) UnmarshalBinary(data []byte) (int, error) {
 	// First convert to int32 to sign extend, then convert to int64
 	e.UID = int64(int32(binary.NativeEndian.Uint32(data[0:4])))
 	e.GID = int64(int32(binary.NativeEndian.Uint32(data[4:8])))
+	// the file metadata are collected before the change is applied
+	e.PreviousUID = int64(e.File.UID)
+	e.PreviousGID = int64(e.File.GID)
 	return n + 8, nil
 }
 
@@ -228,7 +240,7 @@ func (e *Process) UnmarshalProcEntryBinary(data []byte) (int, error) {
 
 // UnmarshalPidCacheBinary unmarshalls Unmarshal pid_cache_t
 func (e *Process) UnmarshalPidCacheBinary(data []byte) (int, error) {
-	const size = 88
+	const size = 120
 	if len(data) < size {
 		return 0, ErrNotEnoughData
 	}
@@ -236,31 +248,41 @@ func (e *Process) UnmarshalPidCacheBinary(data []byte) (int, error) {
 	var read int
 
 	// Unmarshal pid_cache_t
-	cookie := binary.NativeEndian.Uint64(data[0:SizeOfCookie])
-	if cookie > 0 {
+	var cookie ProcessCookie
+	if _, err := cookie.UnmarshalBinary(data[0:SizeOfCookie]); err != nil {
+		return 0, err
+	}
+	if !cookie.IsZero() {
 		e.Cookie = cookie
 	}
-	e.PPid = binary.NativeEndian.Uint32(data[8:12])
+	e.PPid = binary.NativeEndian.Uint32(data[16:20])
 
 	// padding
 
-	e.ForkTime = unmarshalTime(data[16:24])
-	e.ExitTime = unmarshalTime(data[24:32])
-	e.UserSession.ID = binary.NativeEndian.Uint64(data[32:40])
+	e.ForkTime = unmarshalTime(data[24:32])
+	e.ExitTime = unmarshalTime(data[32:40])
+	e.UserSession.ID = binary.NativeEndian.Uint64(data[40:48])
+	e.StartBootTime = binary.NativeEndian.Uint64(data[48:56])
+
+	// the namespaces are read from the task by the kernel, 0 when the offsets are unavailable
+	e.PIDNamespace = binary.NativeEndian.Uint32(data[56:60])
+	e.UserNamespace = binary.NativeEndian.Uint32(data[60:64])
+	e.MountNamespace = binary.NativeEndian.Uint32(data[64:68])
+	e.UTSNamespace = binary.NativeEndian.Uint32(data[68:72])
 
 	// Unmarshal the credentials contained in pid_cache_t
-	read, err := UnmarshalBinary(data[40:], &e.Credentials)
+	read, err := UnmarshalBinary(data[72:], &e.Credentials)
 	if err != nil {
 		return 0, err
 	}
-	read += 40
+	read += 72
 
 	return validateReadSize(size, read)
 }
 
 // UnmarshalBinary unmarshalls a binary representation of itself
 func (e *Process) UnmarshalBinary(data []byte) (int, error) {
-	const size = 288 // size of struct exec_event_t starting from process_entry_t, inclusive
+	const size = 320 // size of struct exec_event_t starting from process_entry_t, inclusive
 	if len(data) < size {
 		return 0, ErrNotEnoughData
 	}
@@ -869,7 +891,7 @@ func (e *MProtectEvent) UnmarshalBinary(data []byte) (int, error) {
 		return 0, err
 	}
 
-	if len(data)-read < 32 {
+	if len(data)-read < 40 {
 		return 0, ErrNotEnoughData
 	}
 
@@ -877,7 +899,11 @@ func (e *MProtectEvent) UnmarshalBinary(data []byte) (int, error) {
 	e.VMEnd = binary.NativeEndian.Uint64(data[read+8 : read+16])
 	e.VMProtection = int(binary.NativeEndian.Uint32(data[read+16 : read+24]))
 	e.ReqProtection = int(binary.NativeEndian.Uint32(data[read+24 : read+32]))
-	return read + 32, nil
+	e.IsAnonymous = binary.NativeEndian.Uint64(data[read+32:read+40])&mprotectFlagAnonymous != 0
+	if e.VMEnd > e.VMStart {
+		e.Size = e.VMEnd - e.VMStart
+	}
+	return read + 40, nil
 }
 
 // UnmarshalBinary unmarshals a binary representation of itself
@@ -1122,16 +1148,13 @@ func (e *IMDSEvent) UnmarshalBinary(data []byte) (int, error) {
 		e.fillFromIMDSHeader(resp.Header, "")
 
 		// try to parse cloud provider specific data
-		if e.CloudProvider == IMDSAWSCloudProvider {
+		if e.CloudProvider == IMDSAWSCloudProvider || e.CloudProvider == IMDSGCPCloudProvider {
+			// the captured responses are truncated to IMDS_MAX_LENGTH, the partial bodies are parsed too
 			b := new(bytes.Buffer)
 			_, err = io.Copy(b, resp.Body)
-			if err == nil {
+			if err == nil || errors.Is(err, io.ErrUnexpectedEOF) {
 				_ = resp.Body.Close()
-				// we don't care about errors, this unmarshalling will only work for token responses
-				_ = e.AWS.SecurityCredentials.UnmarshalBinary(b.Bytes())
-				if len(e.AWS.SecurityCredentials.ExpirationRaw) > 0 {
-					e.AWS.SecurityCredentials.Expiration, _ = time.Parse(time.RFC3339, e.AWS.SecurityCredentials.ExpirationRaw)
-				}
+				e.fillFromIMDSBody(b.Bytes())
 			}
 		}
 	case slices.Contains([]string{
@@ -1186,11 +1209,152 @@ func (e *IMDSEvent) fillFromIMDSHeader(header http.Header, url string) {
 	}
 }
 
+// fillFromIMDSBody parses the credentials of an IMDS response body. The responses of the Azure IMDS don't have any
+// particular header and are first guessed as AWS responses, they are told apart by their OAuth access token.
+func (e *IMDSEvent) fillFromIMDSBody(body []byte) {
+	// we don't care about errors, this unmarshalling will only work for token responses
+	if e.CloudProvider == IMDSAWSCloudProvider {
+		_ = e.AWS.SecurityCredentials.UnmarshalBinary(body)
+		if len(e.AWS.SecurityCredentials.ExpirationRaw) > 0 {
+			e.AWS.SecurityCredentials.Expiration, _ = time.Parse(time.RFC3339, e.AWS.SecurityCredentials.ExpirationRaw)
+		}
+		if len(e.AWS.SecurityCredentials.AccessKeyID) > 0 {
+			return
+		}
+	}
+
+	if err := e.AccessToken.UnmarshalBinary(body); err != nil {
+		return
+	}
+	if e.CloudProvider == IMDSAWSCloudProvider {
+		e.CloudProvider = IMDSAzureCloudProvider
+	}
+}
+
 // UnmarshalBinary extract scrubbed data from an AWS IMDS security credentials response body
 func (creds *AWSSecurityCredentials) UnmarshalBinary(body []byte) error {
 	return json.Unmarshal(body, creds)
 }
 
+// UnmarshalBinary extract scrubbed data from a GCP or Azure IMDS access token response body, the expiration fields
+// being numbers in the GCP responses and strings in the Azure ones
+func (token *OAuthAccessToken) UnmarshalBinary(body []byte) error {
+	var raw struct {
+		AccessToken string      `json:"access_token"`
+		TokenType   string      `json:"token_type"`
+		ExpiresIn   json.Number `json:"expires_in"`
+		ExpiresOn   json.Number `json:"expires_on"`
+		Resource    string      `json:"resource"`
+		ClientID    string      `json:"client_id"`
+	}
+	if err := json.Unmarshal(body, &raw); err != nil {
+		// the Azure access tokens are JWTs of a few kilobytes, their responses are truncated by the capture
+		return token.unmarshalTruncated(body)
+	}
+	if len(raw.AccessToken) == 0 {
+		return errors.New("no access token")
+	}
+
+	token.Type = raw.TokenType
+	token.Resource = raw.Resource
+	token.ClientID = raw.ClientID
+	if expiresIn, err := raw.ExpiresIn.Int64(); err == nil {
+		token.ExpiresIn = int(expiresIn)
+	}
+	if expiresOn, err := raw.ExpiresOn.Int64(); err == nil {
+		token.ExpiresOn = expiresOn
+	}
+
+	// the claims of a JWT are only used when the response doesn't provide the fields
+	if claims, ok := parseJWTClaims(raw.AccessToken); ok {
+		token.fillFromJWTClaims(claims)
+	} else {
+		token.Fingerprint = accessTokenFingerprint(raw.AccessToken)
+	}
+	return nil
+}
+
+// unmarshalTruncated extracts the fields of a truncated access token response body. The access token comes first in
+// the Azure responses, the other fields of the response are lost with the end of its signature, they are read from the
+// header and the payload of the JWT instead, which fit in the capture.
+func (token *OAuthAccessToken) unmarshalTruncated(body []byte) error {
+	const accessTokenKey = `"access_token":"`
+
+	_, value, found := bytes.Cut(body, []byte(accessTokenKey))
+	if !found {
+		return errors.New("no access token")
+	}
+	accessToken, _, _ := bytes.Cut(value, []byte(`"`))
+
+	claims, ok := parseJWTClaims(string(accessToken))
+	if !ok {
+		return errors.New("truncated access token")
+	}
+	token.fillFromJWTClaims(claims)
+	return nil
+}
+
+// jwtClaims holds the claims, free of secrets, of the payload of a JWT access token
+type jwtClaims struct {
+	signingInput string
+	Audience     json.RawMessage `json:"aud"`
+	ExpiresAt    json.Number     `json:"exp"`
+	AppID        string          `json:"appid"`
+	AuthorizedBy string          `json:"azp"`
+}
+
+// parseJWTClaims decodes the claims of a JWT access token, its signature being possibly truncated
+func parseJWTClaims(accessToken string) (jwtClaims, bool) {
+	header, rest, found := strings.Cut(accessToken, ".")
+	if !found {
+		return jwtClaims{}, false
+	}
+	payload, _, found := strings.Cut(rest, ".")
+	if !found {
+		return jwtClaims{}, false
+	}
+
+	decoded, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return jwtClaims{}, false
+	}
+
+	var claims jwtClaims
+	if err := json.Unmarshal(decoded, &claims); err != nil {
+		return jwtClaims{}, false
+	}
+	claims.signingInput = header + "." + payload
+	return claims, true
+}
+
+// fillFromJWTClaims completes the token with the claims of its JWT. The fingerprint is computed from the header and the
+// payload of the JWT, which are unique to a token, so that the truncated and complete captures of a token match.
+func (token *OAuthAccessToken) fillFromJWTClaims(claims jwtClaims) {
+	token.Fingerprint = accessTokenFingerprint(claims.signingInput)
+
+	if token.ExpiresOn == 0 {
+		if expiresAt, err := claims.ExpiresAt.Int64(); err == nil {
+			token.ExpiresOn = expiresAt
+		}
+	}
+	if len(token.Resource) == 0 {
+		// the audience may also be a list of resources, it is only used when it is a single one
+		_ = json.Unmarshal(claims.Audience, &token.Resource)
+	}
+	if len(token.ClientID) == 0 {
+		token.ClientID = claims.AppID
+		if len(token.ClientID) == 0 {
+			token.ClientID = claims.AuthorizedBy
+		}
+	}
+}
+
+// accessTokenFingerprint returns a fingerprint identifying an access token without leaking it
+func accessTokenFingerprint(accessToken string) string {
+	sum := sha256.Sum256([]byte(accessToken))
+	return hex.EncodeToString(sum[:8])
+}
+
 // UnmarshalBinary unmarshalls a binary representation of itself
 func (d *NetDevice) UnmarshalBinary(data []byte) (int, error) {
 	if len(data[:]) < 32 {
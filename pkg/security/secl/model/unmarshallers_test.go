@@ -9,7 +9,11 @@
 package model
 
 import (
+	"encoding/base64"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -102,3 +106,112 @@ func TestSyscallsEvent_UnmarshalBinary(t *testing.T) {
 		})
 	}
 }
+
+func TestIMDSEvent_UnmarshalAccessToken(t *testing.T) {
+	// IMDS_MAX_LENGTH
+	const imdsMaxLength = 2048
+
+	response := func(headers string, body string) []byte {
+		return []byte("HTTP/1.1 200 OK\r\n" + headers + "Content-Type: application/json\r\nContent-Length: " + strconv.Itoa(len(body)) + "\r\n\r\n" + body)
+	}
+
+	t.Run("gcp", func(t *testing.T) {
+		var e IMDSEvent
+		_, err := e.UnmarshalBinary(response("Metadata-Flavor: Google\r\n", `{"access_token":"ya29.secret","expires_in":3599,"token_type":"Bearer"}`))
+		assert.NoError(t, err)
+		assert.Equal(t, IMDSGCPCloudProvider, e.CloudProvider)
+		assert.Equal(t, "Bearer", e.AccessToken.Type)
+		assert.Equal(t, 3599, e.AccessToken.ExpiresIn)
+		assert.Len(t, e.AccessToken.Fingerprint, 16)
+		assert.NotContains(t, e.AccessToken.Fingerprint, "secret")
+	})
+
+	t.Run("azure", func(t *testing.T) {
+		var e IMDSEvent
+		_, err := e.UnmarshalBinary(response("", `{"access_token":"eyJ0eXAi.secret","client_id":"1234","expires_in":"86399","expires_on":"1506484173","resource":"https://management.azure.com/","token_type":"Bearer"}`))
+		assert.NoError(t, err)
+		assert.Equal(t, IMDSAzureCloudProvider, e.CloudProvider)
+		assert.Equal(t, 86399, e.AccessToken.ExpiresIn)
+		assert.Equal(t, int64(1506484173), e.AccessToken.ExpiresOn)
+		assert.Equal(t, "https://management.azure.com/", e.AccessToken.Resource)
+		assert.Equal(t, "1234", e.AccessToken.ClientID)
+		assert.NotEmpty(t, e.AccessToken.Fingerprint)
+	})
+
+	t.Run("azure-truncated", func(t *testing.T) {
+		// a managed identity token of a realistic size, the capture keeping IMDS_MAX_LENGTH bytes of the response
+		resourceID := "/subscriptions/" + strings.Repeat("s", 36) + "/resourcegroups/production-workloads/providers/Microsoft.Compute/virtualMachines/production-vm-01"
+		header := base64.RawURLEncoding.EncodeToString([]byte(`{"typ":"JWT","alg":"RS256","x5t":"` + strings.Repeat("x", 27) + `","kid":"` + strings.Repeat("k", 27) + `"}`))
+		payload := base64.RawURLEncoding.EncodeToString([]byte(`{"aud":"https://management.azure.com/","iss":"https://sts.windows.net/` + strings.Repeat("t", 36) +
+			`/","iat":1506397773,"nbf":1506397773,"exp":1506484173,"aio":"` + strings.Repeat("a", 56) + `","appid":"1234","appidacr":"2","idp":"https://sts.windows.net/` +
+			strings.Repeat("t", 36) + `/","oid":"` + strings.Repeat("o", 36) + `","rh":"` + strings.Repeat("r", 48) + `","sub":"` + strings.Repeat("o", 36) +
+			`","tid":"` + strings.Repeat("t", 36) + `","uti":"` + strings.Repeat("u", 22) + `","ver":"1.0","xms_az_rid":"` + resourceID + `","xms_mirid":"` + resourceID +
+			`","xms_tcdt":1506397773}`))
+		accessToken := header + "." + payload + "." + strings.Repeat("s", 342)
+		body := `{"access_token":"` + accessToken + `","client_id":"1234","expires_in":"86399","expires_on":"1506484173","ext_expires_in":"86399","not_before":"1506397773","resource":"https://management.azure.com/","token_type":"Bearer"}`
+
+		data := response("Server: IMDS/150.870.65.1\r\nx-ms-request-id: "+strings.Repeat("0", 36)+"\r\nDate: Tue, 26 Sep 2017 03:49:33 GMT\r\n", body)
+		assert.Greater(t, len(accessToken), 1500)
+		assert.Greater(t, len(data), imdsMaxLength)
+
+		var complete IMDSEvent
+		_, err := complete.UnmarshalBinary(data)
+		assert.NoError(t, err)
+
+		var e IMDSEvent
+		_, err = e.UnmarshalBinary(data[:imdsMaxLength])
+		assert.NoError(t, err)
+		assert.Equal(t, IMDSAzureCloudProvider, e.CloudProvider)
+		assert.Equal(t, int64(1506484173), e.AccessToken.ExpiresOn)
+		assert.Equal(t, "https://management.azure.com/", e.AccessToken.Resource)
+		assert.Equal(t, "1234", e.AccessToken.ClientID)
+		// the truncated and complete captures of the token share the same fingerprint
+		assert.Len(t, e.AccessToken.Fingerprint, 16)
+		assert.Equal(t, complete.AccessToken.Fingerprint, e.AccessToken.Fingerprint)
+
+		// the payload of the JWT is lost too
+		var lost IMDSEvent
+		_, err = lost.UnmarshalBinary(data[:len(data)-len(body)+len(header)+32])
+		assert.NoError(t, err)
+		assert.Empty(t, lost.AccessToken.Fingerprint)
+	})
+
+	t.Run("aws", func(t *testing.T) {
+		var e IMDSEvent
+		_, err := e.UnmarshalBinary(response("", `{"Code":"Success","Type":"AWS-HMAC","AccessKeyId":"ASIA","Expiration":"2024-01-01T00:00:00Z"}`))
+		assert.NoError(t, err)
+		assert.Equal(t, IMDSAWSCloudProvider, e.CloudProvider)
+		assert.Equal(t, "ASIA", e.AWS.SecurityCredentials.AccessKeyID)
+		assert.Empty(t, e.AccessToken.Fingerprint)
+	})
+}
+
+func TestProcess_PidCacheBinary(t *testing.T) {
+	bootTime := time.Unix(1000, 0)
+
+	var e Process
+	e.Cookie = ProcessCookie{Lo: 0x1122334455667788, Hi: 0x99aabbccddeeff00}
+	e.PPid = 42
+	e.UserSession.ID = 7
+	e.StartBootTime = 123450000000
+	e.PIDNamespace = 4026531836
+	e.MountNamespace = 4026531841
+	e.Credentials.UID = 1000
+
+	data := make([]byte, 120)
+	written, err := e.MarshalPidCache(data, bootTime)
+	assert.NoError(t, err)
+	assert.Equal(t, 120, written)
+
+	var decoded Process
+	read, err := decoded.UnmarshalPidCacheBinary(data)
+	assert.NoError(t, err)
+	assert.Equal(t, 120, read)
+	assert.Equal(t, e.Cookie, decoded.Cookie)
+	assert.Equal(t, e.PPid, decoded.PPid)
+	assert.Equal(t, e.UserSession.ID, decoded.UserSession.ID)
+	assert.Equal(t, e.StartBootTime, decoded.StartBootTime)
+	assert.Equal(t, e.PIDNamespace, decoded.PIDNamespace)
+	assert.Equal(t, e.MountNamespace, decoded.MountNamespace)
+	assert.Equal(t, e.Credentials.UID, decoded.Credentials.UID)
+}
@@ -8,14 +8,31 @@ package rules
 
 import (
 	"sort"
+	"sync"
 
 	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/eval"
 )
 
+// minIndexedRules is the number of rules of a bucket, covered by an index, from which the indexes are used
+const minIndexedRules = 16
+
 // RuleBucket groups rules with the same event type
 type RuleBucket struct {
 	rules  []*Rule
 	fields []eval.Field
+
+	// indexes of the rules by the approver values of their string fields, one per field across the rules of the
+	// bucket. The rules not covered by any of them are always evaluated.
+	indexes    []*ruleFieldIndex
+	unindexed  []bool
+	candidates sync.Pool
+}
+
+// ruleFieldIndex indexes the rules of a bucket by the approver values of one of their fields
+type ruleFieldIndex struct {
+	field     eval.Field
+	evaluator *eval.StringEvaluator
+	values    *eval.StringValuesIndex
 }
 
 // AddRule adds a rule to the bucket
@@ -37,6 +54,10 @@ func (rb *RuleBucket) AddRule(rule *Rule) error {
 	}
 
 	rb.rules = append(rb.rules, rule)
+
+	// the indexes don't cover the new rule anymore
+	rb.indexes, rb.unindexed = nil, nil
+
 	return nil
 }
 
@@ -44,3 +65,184 @@ func (rb *RuleBucket) AddRule(rule *Rule) error {
 func (rb *RuleBucket) GetRules() []*Rule {
 	return rb.rules
 }
+
+// indexableEvaluator returns the evaluator of a field when its values can be indexed, that is when it's a string
+// field compared without specific comparison options nor operator overrides matching other values
+func indexableEvaluator(model eval.Model, field eval.Field) *eval.StringEvaluator {
+	evaluator, err := model.GetEvaluator(field, "")
+	if err != nil {
+		return nil
+	}
+
+	stringEvaluator, ok := evaluator.(*eval.StringEvaluator)
+	if !ok || stringEvaluator.StringCmpOpts != eval.DefaultStringCmpOpts {
+		return nil
+	}
+
+	if overrides := stringEvaluator.OpOverrides; overrides != nil && (overrides.Indexable == nil || !overrides.Indexable(field)) {
+		return nil
+	}
+	return stringEvaluator
+}
+
+// ruleApproverValues returns the approver values of a rule for a field, the event matching the rule only if the value
+// of the field matches one of them
+func ruleApproverValues(event eval.Event, ctx *eval.Context, rule *Rule, field eval.Field) ([]eval.FieldValue, error) {
+	fieldCap := FieldCapability{
+		Field:       field,
+		TypeBitmask: eval.ScalarValueType | eval.PatternValueType | eval.GlobValueType,
+	}
+
+	var approvers []eval.FieldValue
+	for _, value := range rule.GetFieldValues(field) {
+		if !fieldCap.TypeMatches(value.Type) {
+			return nil, nil
+		}
+
+		isAnApprover, approverValueType, approverValue, err := isAnApprover(event, ctx, rule, fieldCap, value.Type, value.Value)
+		if err != nil {
+			return nil, err
+		}
+		if isAnApprover {
+			approvers = append(approvers, eval.FieldValue{Value: approverValue, Type: approverValueType})
+		}
+	}
+
+	return approvers, nil
+}
+
+// buildIndexes indexes the rules of the bucket by the approver values of their string fields. Each rule is indexed
+// on the field covering the most rules of the bucket, so that an event is looked up in as few indexes as possible.
+func (rb *RuleBucket) buildIndexes(model eval.Model, event eval.Event) error {
+	rb.indexes, rb.unindexed = nil, nil
+
+	if len(rb.rules) < minIndexedRules {
+		return nil
+	}
+
+	evaluators := make(map[eval.Field]*eval.StringEvaluator)
+	for _, field := range rb.fields {
+		if evaluator := indexableEvaluator(model, field); evaluator != nil {
+			evaluators[field] = evaluator
+		}
+	}
+	if len(evaluators) == 0 {
+		return nil
+	}
+
+	ctx := eval.NewContext(event)
+
+	approvers := make([]map[eval.Field][]eval.FieldValue, len(rb.rules))
+	coverage := make(map[eval.Field]int)
+	for i, rule := range rb.rules {
+		for _, field := range rule.GetEvaluator().GetFields() {
+			if evaluators[field] == nil {
+				continue
+			}
+
+			values, err := ruleApproverValues(event, ctx, rule, field)
+			if err != nil {
+				return err
+			}
+			if len(values) == 0 {
+				continue
+			}
+
+			if approvers[i] == nil {
+				approvers[i] = make(map[eval.Field][]eval.FieldValue)
+			}
+			approvers[i][field] = values
+			coverage[field]++
+		}
+	}
+
+	var (
+		indexes   = make(map[eval.Field]*ruleFieldIndex)
+		unindexed = make([]bool, len(rb.rules))
+		indexed   int
+	)
+
+	for i, ruleApprovers := range approvers {
+		var best eval.Field
+		for field := range ruleApprovers {
+			if best == "" || coverage[field] > coverage[best] || (coverage[field] == coverage[best] && field < best) {
+				best = field
+			}
+		}
+
+		if best == "" {
+			unindexed[i] = true
+			continue
+		}
+
+		index := indexes[best]
+		if index == nil {
+			index = &ruleFieldIndex{
+				field:     best,
+				evaluator: evaluators[best],
+				values:    eval.NewStringValuesIndex(),
+			}
+			indexes[best] = index
+		}
+
+		for _, value := range ruleApprovers[best] {
+			if err := index.values.Add(i, value); err != nil {
+				return err
+			}
+		}
+		indexed++
+	}
+
+	if indexed < minIndexedRules {
+		return nil
+	}
+
+	for _, field := range rb.fields {
+		if index := indexes[field]; index != nil {
+			rb.indexes = append(rb.indexes, index)
+		}
+	}
+	rb.unindexed = unindexed
+
+	return nil
+}
+
+// forEachCandidate calls the callback, in the order of the bucket, for the rules that can match the event of the
+// context, that is the rules not covered by an index and the ones having an approver matching the event
+func (rb *RuleBucket) forEachCandidate(ctx *eval.Context, fn func(rule *Rule)) {
+	if rb.indexes == nil {
+		for _, rule := range rb.rules {
+			fn(rule)
+		}
+		return
+	}
+
+	buffer, _ := rb.candidates.Get().(*[]bool)
+	if buffer == nil || len(*buffer) != len(rb.rules) {
+		candidates := make([]bool, len(rb.rules))
+		buffer = &candidates
+	}
+	candidates := *buffer
+	copy(candidates, rb.unindexed)
+
+	for _, index := range rb.indexes {
+		var value string
+		if index.evaluator.EvalFnc != nil {
+			value = index.evaluator.EvalFnc(ctx)
+		} else {
+			value = index.evaluator.Value
+		}
+
+		index.values.Lookup(value, func(id int) {
+			candidates[id] = true
+		})
+	}
+
+	for i, rule := range rb.rules {
+		if candidates[i] {
+			fn(rule)
+		}
+	}
+
+	rb.candidates.Put(buffer)
+}
@@ -0,0 +1,170 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+// Package rules holds rules related files
+package rules
+
+import (
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"syscall"
+	"testing"
+
+	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/eval"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
+)
+
+type matchHandler struct {
+	matches []eval.RuleID
+}
+
+func (h *matchHandler) RuleMatch(rule *Rule, _ eval.Event) bool {
+	h.matches = append(h.matches, rule.ID)
+	return true
+}
+
+func (h *matchHandler) EventDiscarderFound(_ *RuleSet, _ eval.Event, _ eval.Field, _ eval.EventType) {
+}
+
+func TestRuleBucketIndexes(t *testing.T) {
+	var exprs []string
+	for i := 0; i < minIndexedRules; i++ {
+		exprs = append(exprs, fmt.Sprintf(`open.file.path == "/etc/conf%d" && process.uid != 0`, i))
+	}
+	exprs = append(exprs,
+		`open.file.path in [~"/tmp/*.sh", "/etc/shadow"]`,
+		`open.file.path =~ "/var/**" && open.flags & O_CREAT > 0`,
+		`open.file.path =~ "*/authorized_keys"`,
+		`open.file.name == "passwd" && open.file.path != "/tmp/passwd"`,
+		`open.flags & O_CREAT > 0`,
+		`open.file.path == "/etc/conf1" || process.uid == 0`,
+		`open.file.name =~ r"app.*"`,
+	)
+
+	rs := newRuleSet()
+	handler := &matchHandler{}
+	rs.AddListener(handler)
+	AddTestRuleExpr(t, rs, exprs...)
+
+	bucket := rs.eventRuleBuckets["open"]
+	if len(bucket.indexes) == 0 {
+		t.Fatal("expected the rules to be indexed")
+	}
+
+	// the symlinks of the executed files are compared along with their path
+	if indexableEvaluator(rs.model, "exec.file.path") != nil {
+		t.Error("expected `exec.file.path` not to be indexable")
+	}
+
+	var matched int
+
+	paths := []string{
+		"/etc/conf1", "/etc/conf15", "/etc/conf42", "/tmp/a.sh", "/tmp/a.py", "/etc/shadow", "/var/log/syslog",
+		"/home/user/.ssh/authorized_keys", "/etc/passwd", "/tmp/passwd", "/opt/app", "/",
+	}
+
+	for _, path := range paths {
+		for _, uid := range []int{0, 1000} {
+			for _, flags := range []int{syscall.O_RDONLY, syscall.O_CREAT} {
+				event := model.NewFakeEvent()
+				event.Type = uint32(model.FileOpenEventType)
+				event.SetFieldValue("open.file.path", path)
+				event.SetFieldValue("open.file.name", filepath.Base(path))
+				event.SetFieldValue("open.flags", flags)
+				event.SetFieldValue("process.uid", uid)
+
+				indexes, unindexed := bucket.indexes, bucket.unindexed
+
+				handler.matches = nil
+				rs.Evaluate(event)
+				indexed := handler.matches
+
+				bucket.indexes, bucket.unindexed = nil, nil
+				handler.matches = nil
+				rs.Evaluate(event)
+				bucket.indexes, bucket.unindexed = indexes, unindexed
+
+				matched += len(indexed)
+				if !reflect.DeepEqual(indexed, handler.matches) {
+					t.Errorf("unexpected matches for `%s` (uid %d, flags %d): %v instead of %v", path, uid, flags, indexed, handler.matches)
+				}
+			}
+		}
+	}
+
+	if matched == 0 {
+		t.Error("expected some rules to match")
+	}
+}
+
+func TestRuleBucketIndexesDisabled(t *testing.T) {
+	var exprs []string
+	for i := 0; i < minIndexedRules; i++ {
+		exprs = append(exprs, fmt.Sprintf(`open.file.path == "/etc/conf%d"`, i))
+	}
+	var values []string
+	for i := 0; i < minIndexedRules; i++ {
+		values = append(values, fmt.Sprintf(`~"/opt/app%d/*"`, i))
+	}
+	exprs = append(exprs, fmt.Sprintf(`open.file.path in [%s]`, strings.Join(values, ", ")))
+
+	ruleOpts, evalOpts := NewBothOpts(map[eval.EventType]bool{"*": true})
+	evalOpts.WithIndexesDisabled(true)
+	rs := NewRuleSet(&model.Model{}, newFakeEvent, ruleOpts, evalOpts)
+	handler := &matchHandler{}
+	rs.AddListener(handler)
+	AddTestRuleExpr(t, rs, exprs...)
+
+	if bucket := rs.eventRuleBuckets["open"]; bucket.indexes != nil {
+		t.Fatal("expected the rules not to be indexed")
+	}
+
+	event := model.NewFakeEvent()
+	event.Type = uint32(model.FileOpenEventType)
+	event.SetFieldValue("open.file.path", "/opt/app7/server")
+
+	if !rs.Evaluate(event) || len(handler.matches) != 1 {
+		t.Errorf("expected the glob values to match: %v", handler.matches)
+	}
+}
+
+func BenchmarkRuleBucketIndexes(b *testing.B) {
+	var exprs []string
+	for i := 0; i < 1000; i++ {
+		exprs = append(exprs, fmt.Sprintf(`open.file.path =~ "/opt/app%d/bin/*" && process.uid != 0`, i))
+	}
+
+	rs := newRuleSet()
+	AddTestRuleExpr(b, rs, exprs...)
+
+	event := model.NewFakeEvent()
+	event.Type = uint32(model.FileOpenEventType)
+	event.SetFieldValue("open.file.path", "/opt/app999/bin/server")
+	event.SetFieldValue("process.uid", 1000)
+
+	bucket := rs.eventRuleBuckets["open"]
+
+	b.Run("indexed", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			rs.Evaluate(event)
+		}
+	})
+
+	b.Run("linear", func(b *testing.B) {
+		indexes, unindexed := bucket.indexes, bucket.unindexed
+		bucket.indexes, bucket.unindexed = nil, nil
+		defer func() {
+			bucket.indexes, bucket.unindexed = indexes, unindexed
+		}()
+
+		for i := 0; i < b.N; i++ {
+			rs.Evaluate(event)
+		}
+	})
+}
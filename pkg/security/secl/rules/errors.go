@@ -44,6 +44,9 @@ var (
 
 	// ErrRuleAgentFilter is returned when an agent rule was filtered
 	ErrRuleAgentFilter = errors.New("agent rule filtered")
+
+	// ErrOverrideFieldConflict is returned when a field is both overridden and merged
+	ErrOverrideFieldConflict = errors.New("field both overridden and merged")
 )
 
 // ErrFieldTypeUnknown is returned when a field has an unknown type
@@ -42,6 +42,8 @@ const (
 // OverrideOptions defines combine options
 type OverrideOptions struct {
 	Fields []OverrideField `yaml:"fields" json:"fields" jsonschema:"enum=all,enum=expression,enum=actions,enum=every,enum=tags"`
+	// MergeFields lists the fields merged with, instead of replacing, the ones of the overridden rule
+	MergeFields []OverrideField `yaml:"merge_fields" json:"merge_fields,omitempty" jsonschema:"enum=actions,enum=tags"`
 }
 
 // MacroDefinition holds the definition of a macro
@@ -75,6 +77,7 @@ type RuleDefinition struct {
 	RateLimiterToken       []string            `yaml:"limiter_token,omitempty" json:"limiter_token,omitempty"`
 	Silent                 bool                `yaml:"silent,omitempty" json:"silent,omitempty"`
 	GroupID                string              `yaml:"group_id,omitempty" json:"group_id,omitempty"`
+	Digest                 bool                `yaml:"digest,omitempty" json:"digest,omitempty"`
 }
 
 // GetTag returns the tag value associated with a tag key
@@ -182,10 +185,19 @@ type HookPointArg struct {
 	Kind string `yaml:"kind" json:"kind" jsonschema:"enum=uint,enum=null-terminated-string"`
 }
 
+// ArgsScrubbingDefinition describes a scrubbing rule of the arguments of the processes
+type ArgsScrubbingDefinition struct {
+	Path     string   `yaml:"path,omitempty" json:"path,omitempty" jsonschema:"description=Glob of the paths of the executables the rule applies to. The rule applies to all the executables if empty,example=/usr/bin/mysql*"`
+	DropArgs bool     `yaml:"drop_args,omitempty" json:"drop_args,omitempty" jsonschema:"description=Drop all the arguments"`
+	Args     []string `yaml:"args,omitempty" json:"args,omitempty" jsonschema:"description=Globs of the arguments whose value is redacted. The value follows the '=' of the argument or is the next argument"`
+}
+
 // PolicyDef represents a policy file definition
 type PolicyDef struct {
-	Version            string              `yaml:"version,omitempty" json:"version"`
-	Macros             []*MacroDefinition  `yaml:"macros,omitempty" json:"macros,omitempty"`
-	Rules              []*RuleDefinition   `yaml:"rules" json:"rules"`
-	OnDemandHookPoints []OnDemandHookPoint `yaml:"hooks,omitempty" json:"hooks,omitempty"`
+	Version            string                    `yaml:"version,omitempty" json:"version"`
+	Macros             []*MacroDefinition        `yaml:"macros,omitempty" json:"macros,omitempty"`
+	Rules              []*RuleDefinition         `yaml:"rules" json:"rules"`
+	OnDemandHookPoints []OnDemandHookPoint       `yaml:"hooks,omitempty" json:"hooks,omitempty"`
+	EnvsWithValue      []string                  `yaml:"envs_with_value,omitempty" json:"envs_with_value,omitempty"`
+	ArgsScrubbing      []ArgsScrubbingDefinition `yaml:"args_scrubbing,omitempty" json:"args_scrubbing,omitempty"`
 }
@@ -103,7 +103,8 @@ func NewEvalOpts() *eval.Opts {
 	evalOpts.
 		WithConstants(model.SECLConstants()).
 		WithLegacyFields(model.SECLLegacyFields).
-		WithVariables(model.SECLVariables)
+		WithVariables(model.SECLVariables).
+		WithFunctions(model.SECLFunctions)
 
 	return &evalOpts
 }
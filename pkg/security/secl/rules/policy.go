@@ -9,6 +9,7 @@ package rules
 import (
 	"fmt"
 	"io"
+	"maps"
 	"slices"
 
 	"github.com/hashicorp/go-multierror"
@@ -63,31 +64,58 @@ func applyOverride(rd1, rd2 *PolicyRule) {
 	// keep track of the combine
 	rd1.Def.Combine = rd2.Def.Combine
 
+	options := rd2.Def.OverrideOptions
+
 	// for backward compatibility, by default only the expression is copied if no options
-	if len(rd2.Def.OverrideOptions.Fields) == 0 {
+	if len(options.Fields) == 0 && len(options.MergeFields) == 0 {
 		rd1.Def.Expression = rd2.Def.Expression
-	} else if slices.Contains(rd2.Def.OverrideOptions.Fields, OverrideAllFields) {
+	} else if slices.Contains(options.Fields, OverrideAllFields) {
 		*rd1.Def = *rd2.Def
 	} else {
-		if slices.Contains(rd2.Def.OverrideOptions.Fields, OverrideExpressionField) {
+		if slices.Contains(options.Fields, OverrideExpressionField) {
 			rd1.Def.Expression = rd2.Def.Expression
 		}
-		if slices.Contains(rd2.Def.OverrideOptions.Fields, OverrideActionFields) {
+		if slices.Contains(options.Fields, OverrideActionFields) {
 			rd1.Def.Actions = rd2.Def.Actions
 		}
-		if slices.Contains(rd2.Def.OverrideOptions.Fields, OverrideEveryField) {
+		if slices.Contains(options.Fields, OverrideEveryField) {
 			rd1.Def.Every = rd2.Def.Every
 		}
-		if slices.Contains(rd2.Def.OverrideOptions.Fields, OverrideTagsField) {
+		if slices.Contains(options.Fields, OverrideTagsField) {
 			rd1.Def.Tags = rd2.Def.Tags
 		}
+
+		// the merged fields, never overridden, are combined with the ones of the overridden rule, the overriding
+		// values taking precedence
+		if slices.Contains(options.MergeFields, OverrideActionFields) {
+			rd1.Def.Actions = append(slices.Clone(rd1.Def.Actions), rd2.Def.Actions...)
+		}
+		if slices.Contains(options.MergeFields, OverrideTagsField) {
+			tags := make(map[string]string, len(rd1.Def.Tags)+len(rd2.Def.Tags))
+			maps.Copy(tags, rd1.Def.Tags)
+			maps.Copy(tags, rd2.Def.Tags)
+			rd1.Def.Tags = tags
+		}
+	}
+}
+
+// checkOverrideOptions checks that the overridden and the merged fields are mutually exclusive
+func checkOverrideOptions(options OverrideOptions) error {
+	for _, field := range options.MergeFields {
+		if slices.Contains(options.Fields, field) || slices.Contains(options.Fields, OverrideAllFields) {
+			return fmt.Errorf("%w: %s", ErrOverrideFieldConflict, field)
+		}
 	}
+	return nil
 }
 
 // MergeWith merges rule r2 into r
 func (r *PolicyRule) MergeWith(r2 *PolicyRule) error {
 	switch r2.Def.Combine {
 	case OverridePolicy:
+		if err := checkOverrideOptions(r2.Def.OverrideOptions); err != nil {
+			return &ErrRuleLoad{Rule: r2, Err: err}
+		}
 		applyOverride(r, r2)
 	default:
 		if r.Def.Disabled == r2.Def.Disabled {
@@ -110,6 +138,8 @@ type Policy struct {
 	// multiple rules can have the same ID but different filters (e.g. agent version)
 	rules              map[RuleID][]*PolicyRule
 	onDemandHookPoints []OnDemandHookPoint
+	envsWithValue      []string
+	argsScrubbing      []ArgsScrubbingDefinition
 }
 
 // GetAcceptedMacros returns the list of accepted macros that are part of the policy
@@ -232,6 +262,8 @@ RULES:
 	}
 
 	p.onDemandHookPoints = p.Def.OnDemandHookPoints
+	p.envsWithValue = p.Def.EnvsWithValue
+	p.argsScrubbing = p.Def.ArgsScrubbing
 
 	return errs.ErrorOrNil()
 }
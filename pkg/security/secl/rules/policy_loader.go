@@ -7,6 +7,7 @@
 package rules
 
 import (
+	"slices"
 	"sync"
 	"time"
 
@@ -23,6 +24,17 @@ const (
 
 var (
 	debounceDelay = 5 * time.Second
+
+	// PolicyProviderPrecedence defines the precedence of the policy providers, the lower the value the higher the
+	// precedence. When rules of different policies share the same ID, the rule of the provider with the highest
+	// precedence is kept, the other ones being only applied when they override it explicitly. The providers of the
+	// other types are loaded last, in the order they were registered.
+	PolicyProviderPrecedence = map[string]int{
+		PolicyProviderTypeRC:       0,
+		PolicyProviderTypeBundled:  1,
+		PolicyProviderTypeDir:      2,
+		PolicyProviderTypeWorkload: 3,
+	}
 )
 
 // PolicyLoaderOpts options used during the loading
@@ -54,7 +66,7 @@ func (p *PolicyLoader) LoadPolicies(opts PolicyLoaderOpts) ([]*Policy, *multierr
 		defaultPolicy *Policy
 	)
 
-	p.remoteConfigProvidersFirst()
+	p.sortProvidersByPrecedence()
 	for _, provider := range p.Providers {
 		policies, err := provider.LoadPolicies(opts.MacroFilters, opts.RuleFilters)
 		if err.ErrorOrNil() != nil {
@@ -146,18 +158,20 @@ func NewPolicyLoader(providers ...PolicyProvider) *PolicyLoader {
 	return p
 }
 
-// Rules from RC override local rules if they share the same ID, so the RC policy provider has to be first
-func (p *PolicyLoader) remoteConfigProvidersFirst() {
-	var remoteConfigProviders []PolicyProvider
-	var otherProviders []PolicyProvider
-
-	for _, provider := range p.Providers {
-		if provider.Type() == PolicyProviderTypeRC {
-			remoteConfigProviders = append(remoteConfigProviders, provider)
-		} else {
-			otherProviders = append(otherProviders, provider)
-		}
+// GetProviderPrecedence returns the precedence of a policy provider type, see PolicyProviderPrecedence
+func GetProviderPrecedence(providerType string) int {
+	if precedence, exists := PolicyProviderPrecedence[providerType]; exists {
+		return precedence
 	}
+	return len(PolicyProviderPrecedence)
+}
 
-	p.Providers = append(remoteConfigProviders, otherProviders...)
+// Rules from RC override local rules if they share the same ID, so the providers are sorted according to their
+// precedence, RC first
+func (p *PolicyLoader) sortProvidersByPrecedence() {
+	providers := slices.Clone(p.Providers)
+	slices.SortStableFunc(providers, func(a, b PolicyProvider) int {
+		return GetProviderPrecedence(a.Type()) - GetProviderPrecedence(b.Type())
+	})
+	p.Providers = providers
 }
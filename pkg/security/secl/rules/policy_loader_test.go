@@ -598,6 +598,29 @@ func TestPolicyLoader_LoadPolicies(t *testing.T) {
 	}
 }
 
+func TestPolicyLoader_ProviderPrecedence(t *testing.T) {
+	bundled := dummyTypedProvider{providerType: PolicyProviderTypeBundled}
+	rc := dummyRCProvider{}
+	dir := dummyDirProvider{}
+	selfTest := dummyTypedProvider{providerType: "selfTesterPolicyProvider"}
+	workload := dummyTypedProvider{providerType: PolicyProviderTypeWorkload}
+
+	loader := &PolicyLoader{Providers: []PolicyProvider{selfTest, bundled, dir, workload, rc}}
+	loader.sortProvidersByPrecedence()
+
+	var types []string
+	for _, provider := range loader.Providers {
+		types = append(types, provider.Type())
+	}
+	assert.Equal(t, []string{
+		PolicyProviderTypeRC,
+		PolicyProviderTypeBundled,
+		PolicyProviderTypeDir,
+		PolicyProviderTypeWorkload,
+		"selfTesterPolicyProvider",
+	}, types)
+}
+
 // Utils
 
 func numAndLastIdxOfDefaultPolicies(policies []*Policy) (int, int) {
@@ -653,6 +676,15 @@ func (dummyRCProvider) Type() string {
 	return PolicyProviderTypeRC
 }
 
+type dummyTypedProvider struct {
+	dummyDirProvider
+	providerType string
+}
+
+func (d dummyTypedProvider) Type() string {
+	return d.providerType
+}
+
 type testPolicyDef struct {
 	def    PolicyDef
 	name   string
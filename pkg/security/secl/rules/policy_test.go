@@ -193,6 +193,143 @@ func TestRuleMerge(t *testing.T) {
 	})
 }
 
+func TestRuleMergeFields(t *testing.T) {
+	newRule := func() *PolicyRule {
+		return &PolicyRule{
+			Def: &RuleDefinition{
+				ID:         "test_rule",
+				Expression: `open.file.path == "/tmp/test"`,
+				Tags:       map[string]string{"severity": "low", "team": "a"},
+				Actions:    []*ActionDefinition{{Kill: &KillDefinition{Signal: "SIGKILL"}}},
+			},
+		}
+	}
+
+	override := &PolicyRule{
+		Def: &RuleDefinition{
+			ID:         "test_rule",
+			Expression: `open.file.path == "/tmp/override"`,
+			Tags:       map[string]string{"severity": "high"},
+			Actions:    []*ActionDefinition{{CoreDump: &CoreDumpDefinition{Process: true}}},
+			Combine:    OverridePolicy,
+			OverrideOptions: OverrideOptions{
+				Fields:      []OverrideField{OverrideExpressionField},
+				MergeFields: []OverrideField{OverrideActionFields, OverrideTagsField},
+			},
+		},
+	}
+
+	rule := newRule()
+	assert.NoError(t, rule.MergeWith(override))
+	assert.Equal(t, `open.file.path == "/tmp/override"`, rule.Def.Expression)
+	assert.Equal(t, map[string]string{"severity": "high", "team": "a"}, rule.Def.Tags)
+	assert.Len(t, rule.Def.Actions, 2)
+	assert.Equal(t, []*PolicyRule{override}, rule.ModifiedBy)
+
+	// without merge fields, the overriding values replace the overridden ones
+	override.Def.OverrideOptions = OverrideOptions{Fields: []OverrideField{OverrideTagsField, OverrideActionFields}}
+	rule = newRule()
+	assert.NoError(t, rule.MergeWith(override))
+	assert.Equal(t, `open.file.path == "/tmp/test"`, rule.Def.Expression)
+	assert.Equal(t, map[string]string{"severity": "high"}, rule.Def.Tags)
+	assert.Len(t, rule.Def.Actions, 1)
+
+	// a field can't be both overridden and merged
+	for _, fields := range [][]OverrideField{{OverrideActionFields}, {OverrideAllFields}} {
+		override.Def.OverrideOptions = OverrideOptions{Fields: fields, MergeFields: []OverrideField{OverrideActionFields}}
+		rule = newRule()
+		err := rule.MergeWith(override)
+		var rErr *ErrRuleLoad
+		if assert.ErrorAs(t, err, &rErr) {
+			assert.ErrorIs(t, rErr.Err, ErrOverrideFieldConflict)
+		}
+		assert.Len(t, rule.Def.Actions, 1)
+	}
+}
+
+func TestPolicyEnvsWithValue(t *testing.T) {
+	testPolicy := &PolicyDef{
+		Rules: []*RuleDefinition{{
+			ID:         "test_rule",
+			Expression: `exec.file.name == "foo"`,
+		}},
+		EnvsWithValue: []string{"LD_PRELOAD", "SHELL"},
+	}
+
+	testPolicy2 := &PolicyDef{
+		Rules: []*RuleDefinition{{
+			ID:         "test_rule2",
+			Expression: `exec.file.name == "bar"`,
+		}},
+		EnvsWithValue: []string{"SHELL", "PATH"},
+	}
+
+	tmpDir := t.TempDir()
+
+	if err := savePolicy(filepath.Join(tmpDir, "test.policy"), testPolicy); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := savePolicy(filepath.Join(tmpDir, "test2.policy"), testPolicy2); err != nil {
+		t.Fatal(err)
+	}
+
+	provider, err := NewPoliciesDirProvider(tmpDir, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rs := newRuleSet()
+	if errs := rs.LoadPolicies(NewPolicyLoader(provider), PolicyLoaderOpts{}); errs.ErrorOrNil() != nil {
+		t.Fatal(errs)
+	}
+
+	assert.ElementsMatch(t, []string{"LD_PRELOAD", "SHELL", "PATH"}, rs.GetEnvsWithValue())
+}
+
+func TestPolicyArgsScrubbing(t *testing.T) {
+	testPolicy := &PolicyDef{
+		Rules: []*RuleDefinition{{
+			ID:         "test_rule",
+			Expression: `exec.file.name == "foo"`,
+		}},
+		ArgsScrubbing: []ArgsScrubbingDefinition{{Path: "/usr/bin/mysql", DropArgs: true}},
+	}
+
+	testPolicy2 := &PolicyDef{
+		Rules: []*RuleDefinition{{
+			ID:         "test_rule2",
+			Expression: `exec.file.name == "bar"`,
+		}},
+		ArgsScrubbing: []ArgsScrubbingDefinition{{Args: []string{"--password=*"}}},
+	}
+
+	tmpDir := t.TempDir()
+
+	if err := savePolicy(filepath.Join(tmpDir, "test.policy"), testPolicy); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := savePolicy(filepath.Join(tmpDir, "test2.policy"), testPolicy2); err != nil {
+		t.Fatal(err)
+	}
+
+	provider, err := NewPoliciesDirProvider(tmpDir, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rs := newRuleSet()
+	if errs := rs.LoadPolicies(NewPolicyLoader(provider), PolicyLoaderOpts{}); errs.ErrorOrNil() != nil {
+		t.Fatal(errs)
+	}
+
+	assert.ElementsMatch(t, []ArgsScrubbingDefinition{
+		{Path: "/usr/bin/mysql", DropArgs: true},
+		{Args: []string{"--password=*"}},
+	}, rs.GetArgsScrubbing())
+}
+
 func TestActionSetVariable(t *testing.T) {
 	testPolicy := &PolicyDef{
 		Rules: []*RuleDefinition{{
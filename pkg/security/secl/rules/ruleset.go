@@ -12,6 +12,8 @@ import (
 	"reflect"
 	"slices"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/spf13/cast"
 
@@ -29,6 +31,27 @@ type Rule struct {
 	*PolicyRule
 	*eval.Rule
 	NoDiscarder bool
+
+	// disabledUntil holds the time, in nanoseconds, until which the rule isn't evaluated
+	disabledUntil atomic.Int64
+}
+
+// DisableUntil disables the evaluation of the rule until the provided time
+func (r *Rule) DisableUntil(until time.Time) {
+	r.disabledUntil.Store(until.UnixNano())
+}
+
+// isDisabled returns whether the evaluation of the rule is disabled
+func (r *Rule) isDisabled() bool {
+	until := r.disabledUntil.Load()
+	if until == 0 {
+		return false
+	}
+	if time.Now().UnixNano() < until {
+		return true
+	}
+	r.disabledUntil.CompareAndSwap(until, 0)
+	return false
 }
 
 // RuleSetListener describes the methods implemented by an object used to be
@@ -63,6 +86,8 @@ type RuleSet struct {
 	eventCollector EventCollector
 
 	OnDemandHookPoints []OnDemandHookPoint
+	ArgsScrubbing      []ArgsScrubbingDefinition
+	envsWithValue      []string
 }
 
 // ListRuleIDs returns the list of RuleIDs from the ruleset
@@ -84,6 +109,16 @@ func (rs *RuleSet) GetOnDemandHookPoints() []OnDemandHookPoint {
 	return rs.OnDemandHookPoints
 }
 
+// GetEnvsWithValue gets the environment variables whose value the loaded policies request to keep
+func (rs *RuleSet) GetEnvsWithValue() []string {
+	return rs.envsWithValue
+}
+
+// GetArgsScrubbing gets the scrubbing rules of the process arguments of the loaded policies
+func (rs *RuleSet) GetArgsScrubbing() []ArgsScrubbingDefinition {
+	return rs.ArgsScrubbing
+}
+
 // ListMacroIDs returns the list of MacroIDs from the ruleset
 func (rs *RuleSet) ListMacroIDs() []MacroID {
 	var ids []string
@@ -145,6 +180,14 @@ func (rs *RuleSet) AddRules(parsingContext *ast.ParsingContext, pRules []*Policy
 		}
 	}
 
+	if !rs.evalOpts.IndexesDisabled {
+		for eventType, bucket := range rs.eventRuleBuckets {
+			if err := bucket.buildIndexes(rs.model, rs.newFakeEvent()); err != nil {
+				rs.logger.Errorf("failed to index the rules of `%s`, evaluating all of them: %s", eventType, err)
+			}
+		}
+	}
+
 	return result
 }
 
@@ -583,7 +626,11 @@ func (rs *RuleSet) Evaluate(event eval.Event) bool {
 
 	result := false
 
-	for _, rule := range bucket.rules {
+	bucket.forEachCandidate(ctx, func(rule *Rule) {
+		if rule.isDisabled() {
+			return
+		}
+
 		utils.PprofDoWithoutContext(rule.GetPprofLabels(), func() {
 			if rule.GetEvaluator().Eval(ctx) {
 
@@ -599,7 +646,7 @@ func (rs *RuleSet) Evaluate(event eval.Event) bool {
 				result = true
 			}
 		})
-	}
+	})
 
 	// no-op in the general case, only used to collect events in functional tests
 	// for debugging purposes
@@ -775,6 +822,12 @@ func (rs *RuleSet) LoadPolicies(loader *PolicyLoader, opts PolicyLoaderOpts) *mu
 		}
 
 		rs.OnDemandHookPoints = append(rs.OnDemandHookPoints, policy.onDemandHookPoints...)
+		for _, env := range policy.envsWithValue {
+			if !slices.Contains(rs.envsWithValue, env) {
+				rs.envsWithValue = append(rs.envsWithValue, env)
+			}
+		}
+		rs.ArgsScrubbing = append(rs.ArgsScrubbing, policy.argsScrubbing...)
 	}
 
 	if err := rs.AddMacros(parsingContext, allMacros); err.ErrorOrNil() != nil {
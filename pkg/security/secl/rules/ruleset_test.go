@@ -14,6 +14,7 @@ import (
 	"strings"
 	"syscall"
 	"testing"
+	"time"
 
 	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/ast"
 	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/eval"
@@ -99,6 +100,30 @@ func TestRuleBuckets(t *testing.T) {
 	}
 }
 
+func TestRuleDisableUntil(t *testing.T) {
+	rs := newRuleSet()
+	AddTestRuleExpr(t, rs, `open.file.path == "/etc/passwd"`)
+
+	ev := model.NewFakeEvent()
+	ev.Type = uint32(model.FileOpenEventType)
+	ev.SetFieldValue("open.file.path", "/etc/passwd")
+
+	if !rs.Evaluate(ev) {
+		t.Fatal("the rule should match")
+	}
+
+	rule := rs.eventRuleBuckets["open"].rules[0]
+	rule.DisableUntil(time.Now().Add(time.Hour))
+	if rs.Evaluate(ev) {
+		t.Fatal("a disabled rule shouldn't be evaluated")
+	}
+
+	rule.DisableUntil(time.Now().Add(-time.Second))
+	if !rs.Evaluate(ev) {
+		t.Fatal("the rule should be evaluated again once its cooldown expired")
+	}
+}
+
 func TestRuleSetDiscarders(t *testing.T) {
 	handler := &testHandler{
 		filters: make(map[string]testFieldValues),
@@ -139,11 +139,11 @@ type Owner interface {
 
 type cookieSelector struct {
 	execTime int64
-	cookie   uint64
+	cookie   model.ProcessCookie
 }
 
 func (cs *cookieSelector) isSet() bool {
-	return cs.execTime != 0 && cs.cookie != 0
+	return cs.execTime != 0 && !cs.cookie.IsZero()
 }
 
 func (cs *cookieSelector) fillFromEntry(entry *model.ProcessCacheEntry) {
@@ -91,7 +91,7 @@ func protoDecodeProcessNode(p *adproto.ProcessInfo) model.Process {
 			Tid: p.Tid,
 		},
 		PPid:        p.Ppid,
-		Cookie:      p.Cookie64,
+		Cookie:      model.ProcessCookie{Lo: p.Cookie64},
 		IsThread:    p.IsThread,
 		IsExecExec:  p.IsExecChild,
 		FileEvent:   *protoDecodeFileEvent(p.File),
@@ -9,24 +9,84 @@
 package activitytree
 
 import (
+	"strings"
 	"time"
 
 	adproto "github.com/DataDog/agent-payload/v5/cws/dumpsv1"
 	"golang.org/x/text/runes"
 
+	spath "github.com/DataDog/datadog-agent/pkg/security/resolvers/path"
 	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
 )
 
-// ToProto encodes an activity tree to its protobuf representation
-func ToProto(at *ActivityTree) []*adproto.ProcessActivityNode {
+// ToProto encodes an activity tree to its protobuf representation, the user identifying components of its paths being
+// redacted when an anonymizer is provided
+func ToProto(at *ActivityTree, anonymizer *spath.Anonymizer) []*adproto.ProcessActivityNode {
 	out := make([]*adproto.ProcessActivityNode, 0, len(at.ProcessNodes))
 
 	for _, node := range at.ProcessNodes {
-		out = append(out, processActivityNodeToProto(node))
+		ppan := processActivityNodeToProto(node)
+		anonymizeProcessActivityNode(ppan, anonymizer)
+		out = append(out, ppan)
 	}
 	return out
 }
 
+// anonymizeProcessActivityNode redacts the user identifying components of the paths held by a process node and its
+// children
+func anonymizeProcessActivityNode(ppan *adproto.ProcessActivityNode, anonymizer *spath.Anonymizer) {
+	if ppan == nil || anonymizer == nil {
+		return
+	}
+
+	if ppi := ppan.Process; ppi != nil {
+		anonymizeFileInfo(ppi.File, anonymizer)
+		ppi.Args = anonymizer.AnonymizeAll(ppi.Args)
+		ppi.Argv0 = anonymizer.Anonymize(ppi.Argv0)
+		ppi.Envs = anonymizer.AnonymizeEnvs(ppi.Envs)
+	}
+
+	for _, child := range ppan.Children {
+		anonymizeProcessActivityNode(child, anonymizer)
+	}
+
+	for _, pfan := range ppan.Files {
+		anonymizeFileActivityNode(pfan, "", anonymizer)
+	}
+}
+
+// anonymizeFileActivityNode redacts the user identifying components of the paths held by a file node and its children,
+// the name of a node being the component following the path of its parent
+func anonymizeFileActivityNode(pfan *adproto.FileActivityNode, parentPath string, anonymizer *spath.Anonymizer) {
+	if pfan == nil {
+		return
+	}
+
+	nodePath := parentPath + "/" + pfan.Name
+	if anonymized := anonymizer.Anonymize(nodePath); anonymized != nodePath {
+		pfan.Name = anonymized[strings.LastIndexByte(anonymized, '/')+1:]
+	}
+	anonymizeFileInfo(pfan.File, anonymizer)
+
+	for _, child := range pfan.Children {
+		anonymizeFileActivityNode(child, nodePath, anonymizer)
+	}
+}
+
+// anonymizeFileInfo redacts the user identifying components of the path of a file, along with its basename when it was
+// one of the redacted components
+func anonymizeFileInfo(fi *adproto.FileInfo, anonymizer *spath.Anonymizer) {
+	if fi == nil {
+		return
+	}
+
+	anonymized := anonymizer.Anonymize(fi.Path)
+	if anonymized != fi.Path && fi.Basename != "" && strings.HasSuffix(fi.Path, "/"+fi.Basename) {
+		fi.Basename = anonymized[strings.LastIndexByte(anonymized, '/')+1:]
+	}
+	fi.Path = anonymized
+}
+
 func processActivityNodeToProto(pan *ProcessNode) *adproto.ProcessActivityNode {
 	if pan == nil {
 		return nil
@@ -87,7 +147,7 @@ func processNodeToProto(p *model.Process) *adproto.ProcessInfo {
 		Pid:         p.Pid,
 		Tid:         p.Tid,
 		Ppid:        p.PPid,
-		Cookie64:    p.Cookie,
+		Cookie64:    p.Cookie.Lo, // the format only holds the random half of the cookie
 		IsThread:    p.IsThread,
 		IsExecChild: p.IsExecExec,
 		File:        fileEventToProto(&p.FileEvent),
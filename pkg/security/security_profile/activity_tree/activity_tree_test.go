@@ -15,6 +15,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	spath "github.com/DataDog/datadog-agent/pkg/security/resolvers/path"
 	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
 )
 
@@ -71,6 +72,60 @@ func TestInsertFileEvent(t *testing.T) {
 	assert.Equal(t, expectedDebugOuput, debugOutput)
 }
 
+func TestToProtoAnonymization(t *testing.T) {
+	anonymizer, err := spath.NewAnonymizer([]string{`^/home/([^/]+)`})
+	assert.NoError(t, err)
+
+	pan := &ProcessNode{
+		Files: make(map[string]*FileNode),
+	}
+	pan.Process.FileEvent.PathnameStr = "/home/alice/bin/tool"
+	pan.Process.FileEvent.BasenameStr = "tool"
+	pan.Process.Argv0 = "/home/alice/bin/tool"
+	pan.Process.Argv = []string{"--config=/home/alice/tool.yaml"}
+	pan.Process.Envs = []string{"HOME=/home/alice"}
+
+	event := &model.Event{
+		BaseEvent: model.BaseEvent{
+			FieldHandlers: &model.FakeFieldHandlers{},
+		},
+		Open: model.OpenEvent{
+			File: model.FileEvent{
+				IsPathnameStrResolved: true,
+				PathnameStr:           "/home/alice/.ssh/id_rsa",
+			},
+		},
+	}
+	pan.InsertFileEvent(&event.Open.File, event, "tag", Unknown, NewActivityTreeNodeStats(), false, nil, nil)
+
+	at := &ActivityTree{ProcessNodes: []*ProcessNode{pan}}
+
+	nodes := ToProto(at, anonymizer)
+	assert.Len(t, nodes, 1)
+	process := nodes[0].Process
+	assert.Equal(t, "/home/<redacted>/bin/tool", process.File.Path)
+	assert.Equal(t, "tool", process.File.Basename)
+	assert.Equal(t, "/home/<redacted>/bin/tool", process.Argv0)
+	assert.Equal(t, []string{"--config=/home/<redacted>/tool.yaml"}, process.Args)
+	assert.Equal(t, []string{"HOME=/home/<redacted>"}, process.Envs)
+
+	home := nodes[0].Files[0]
+	assert.Equal(t, "home", home.Name)
+	user := home.Children[0]
+	assert.Equal(t, "<redacted>", user.Name)
+	file := user.Children[0].Children[0]
+	assert.Equal(t, "id_rsa", file.Name)
+	assert.Equal(t, "/home/<redacted>/.ssh/id_rsa", file.File.Path)
+
+	// the tree itself, against which the profiles are evaluated, is left untouched
+	assert.Equal(t, "/home/alice/.ssh/id_rsa", pan.Files["home"].Children["alice"].Children[".ssh"].Children["id_rsa"].File.PathnameStr)
+
+	// without anonymizer, the paths are kept as is
+	nodes = ToProto(at, nil)
+	assert.Equal(t, "/home/alice/bin/tool", nodes[0].Process.File.Path)
+	assert.Equal(t, "alice", nodes[0].Files[0].Children[0].Name)
+}
+
 func setParentRelationship(parent ProcessNodeParent, node *ProcessNode) {
 	node.Parent = parent
 	for _, child := range node.Children {
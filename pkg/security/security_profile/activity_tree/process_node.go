@@ -154,7 +154,8 @@ func (pn *ProcessNode) debug(w io.Writer, prefix string) {
 // scrubAndReleaseArgsEnvs scrubs the process args and envs, and then releases them
 func (pn *ProcessNode) scrubAndReleaseArgsEnvs(resolver *sprocess.EBPFResolver) {
 	if pn.Process.ArgsEntry != nil {
-		resolver.GetProcessArgvScrubbed(&pn.Process)
+		// only the scrubbed args are kept once the entry is released
+		pn.Process.Argv, _ = resolver.GetProcessArgvScrubbed(&pn.Process)
 		sprocess.GetProcessArgv0(&pn.Process)
 		pn.Process.ArgsEntry = nil
 
@@ -29,6 +29,7 @@ import (
 	"github.com/DataDog/datadog-agent/pkg/security/config"
 	"github.com/DataDog/datadog-agent/pkg/security/proto/api"
 	cgroupModel "github.com/DataDog/datadog-agent/pkg/security/resolvers/cgroup/model"
+	spath "github.com/DataDog/datadog-agent/pkg/security/resolvers/path"
 	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
 	"github.com/DataDog/datadog-agent/pkg/security/secl/rules"
 	"github.com/DataDog/datadog-agent/pkg/security/seclog"
@@ -690,15 +691,24 @@ func (ad *ActivityDump) ToTranscodingRequestMessage() *api.TranscodingRequestMes
 
 // Encode encodes an activity dump in the provided format
 func (ad *ActivityDump) Encode(format config.StorageFormat) (*bytes.Buffer, error) {
+	return ad.EncodeAnonymized(format, nil)
+}
+
+// EncodeAnonymized encodes an activity dump in the provided format, the user identifying components of its paths being
+// redacted when an anonymizer is provided
+func (ad *ActivityDump) EncodeAnonymized(format config.StorageFormat, anonymizer *spath.Anonymizer) (*bytes.Buffer, error) {
 	switch format {
 	case config.JSON:
-		return ad.EncodeJSON("")
+		return ad.encodeJSON("", anonymizer)
 	case config.Protobuf:
-		return ad.EncodeProtobuf()
+		return ad.encodeProtobuf(anonymizer)
 	case config.Dot:
+		if anonymizer != nil {
+			return nil, fmt.Errorf("couldn't encode activity dump [%s] as [%s]: path anonymization isn't supported", ad.GetSelectorStr(), format)
+		}
 		return ad.EncodeDOT()
 	case config.Profile:
-		return ad.EncodeProfile()
+		return ad.encodeProfile(anonymizer)
 	default:
 		return nil, fmt.Errorf("couldn't encode activity dump [%s] as [%s]: unknown format", ad.GetSelectorStr(), format)
 	}
@@ -706,10 +716,14 @@ func (ad *ActivityDump) Encode(format config.StorageFormat) (*bytes.Buffer, erro
 
 // EncodeProtobuf encodes an activity dump in the Protobuf format
 func (ad *ActivityDump) EncodeProtobuf() (*bytes.Buffer, error) {
+	return ad.encodeProtobuf(nil)
+}
+
+func (ad *ActivityDump) encodeProtobuf(anonymizer *spath.Anonymizer) (*bytes.Buffer, error) {
 	ad.Lock()
 	defer ad.Unlock()
 
-	pad := activityDumpToProto(ad)
+	pad := activityDumpToProto(ad, anonymizer)
 	defer pad.ReturnToVTPool()
 
 	raw, err := pad.MarshalVT()
@@ -721,10 +735,14 @@ func (ad *ActivityDump) EncodeProtobuf() (*bytes.Buffer, error) {
 
 // EncodeProfile encodes an activity dump in the Security Profile protobuf format
 func (ad *ActivityDump) EncodeProfile() (*bytes.Buffer, error) {
+	return ad.encodeProfile(nil)
+}
+
+func (ad *ActivityDump) encodeProfile(anonymizer *spath.Anonymizer) (*bytes.Buffer, error) {
 	ad.Lock()
 	defer ad.Unlock()
 
-	profileProto, err := ActivityDumpToSecurityProfileProto(ad)
+	profileProto, err := ActivityDumpToSecurityProfileProto(ad, anonymizer)
 	if profileProto == nil {
 		return nil, fmt.Errorf("Error while encoding security dump: %v", err)
 	}
@@ -737,10 +755,14 @@ func (ad *ActivityDump) EncodeProfile() (*bytes.Buffer, error) {
 
 // EncodeJSON encodes an activity dump in the ProtoJSON format
 func (ad *ActivityDump) EncodeJSON(indent string) (*bytes.Buffer, error) {
+	return ad.encodeJSON(indent, nil)
+}
+
+func (ad *ActivityDump) encodeJSON(indent string, anonymizer *spath.Anonymizer) (*bytes.Buffer, error) {
 	ad.Lock()
 	defer ad.Unlock()
 
-	pad := activityDumpToProto(ad)
+	pad := activityDumpToProto(ad, anonymizer)
 	defer pad.ReturnToVTPool()
 
 	opts := protojson.MarshalOptions{
@@ -15,13 +15,15 @@ import (
 	proto "github.com/DataDog/agent-payload/v5/cws/dumpsv1"
 
 	cgroupModel "github.com/DataDog/datadog-agent/pkg/security/resolvers/cgroup/model"
+	spath "github.com/DataDog/datadog-agent/pkg/security/resolvers/path"
 	activity_tree "github.com/DataDog/datadog-agent/pkg/security/security_profile/activity_tree"
 	mtdt "github.com/DataDog/datadog-agent/pkg/security/security_profile/activity_tree/metadata"
 	stime "github.com/DataDog/datadog-agent/pkg/util/ktime"
 )
 
-// ActivityDumpToSecurityProfileProto serializes an Activity Dump to a Security Profile protobuf representation
-func ActivityDumpToSecurityProfileProto(input *ActivityDump) (*proto.SecurityProfile, error) {
+// ActivityDumpToSecurityProfileProto serializes an Activity Dump to a Security Profile protobuf representation, the
+// user identifying components of its paths being redacted when an anonymizer is provided
+func ActivityDumpToSecurityProfileProto(input *ActivityDump, anonymizer *spath.Anonymizer) (*proto.SecurityProfile, error) {
 	if input == nil {
 		return nil, errors.New("imput == nil")
 	}
@@ -34,7 +36,7 @@ func ActivityDumpToSecurityProfileProto(input *ActivityDump) (*proto.SecurityPro
 	output := &proto.SecurityProfile{
 		Metadata:        mtdt.ToProto(&input.Metadata),
 		ProfileContexts: make(map[string]*proto.ProfileContext),
-		Tree:            activity_tree.ToProto(input.ActivityTree),
+		Tree:            activity_tree.ToProto(input.ActivityTree, anonymizer),
 		Selector:        cgroupModel.WorkloadSelectorToProto(wSelector),
 	}
 	timeResolver, err := stime.NewResolver()
@@ -11,11 +11,12 @@ package dump
 import (
 	adproto "github.com/DataDog/agent-payload/v5/cws/dumpsv1"
 
+	spath "github.com/DataDog/datadog-agent/pkg/security/resolvers/path"
 	activity_tree "github.com/DataDog/datadog-agent/pkg/security/security_profile/activity_tree"
 	mtdt "github.com/DataDog/datadog-agent/pkg/security/security_profile/activity_tree/metadata"
 )
 
-func activityDumpToProto(ad *ActivityDump) *adproto.SecDump {
+func activityDumpToProto(ad *ActivityDump, anonymizer *spath.Anonymizer) *adproto.SecDump {
 	if ad == nil {
 		return nil
 	}
@@ -29,7 +30,7 @@ func activityDumpToProto(ad *ActivityDump) *adproto.SecDump {
 		Metadata: mtdt.ToProto(&ad.Metadata),
 
 		Tags: make([]string, len(ad.Tags)),
-		Tree: activity_tree.ToProto(ad.ActivityTree),
+		Tree: activity_tree.ToProto(ad.ActivityTree, anonymizer),
 	}
 
 	copy(pad.Tags, ad.Tags)
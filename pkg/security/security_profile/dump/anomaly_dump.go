@@ -0,0 +1,230 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+// Package dump holds dump related files
+package dump
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+
+	"github.com/DataDog/datadog-agent/pkg/security/config"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
+	"github.com/DataDog/datadog-agent/pkg/security/seclog"
+	"github.com/DataDog/datadog-agent/pkg/security/utils"
+)
+
+// anomalyCount counts the anomalies raised by a container since the start of its window
+type anomalyCount struct {
+	count       int
+	windowStart time.Time
+}
+
+// anomalyDumpRequest is a request to dump a container raising repeated anomalies
+type anomalyDumpRequest struct {
+	containerID string
+	cgroupFlags uint64
+}
+
+// anomalyDumpLocation is the location of the activity dump capturing a container
+type anomalyDumpLocation struct {
+	name    string
+	files   []string
+	expires time.Time
+}
+
+// anomalyDumpTrigger decides when the anomalies raised by a container should trigger an activity dump of the container.
+// It has its own lock as it is used on the event path, the dumps being started by the activity dump manager.
+type anomalyDumpTrigger struct {
+	sync.Mutex
+	threshold int
+	maxPerDay int
+	anomalies *lru.Cache[string, int]
+	// pending lists the containers whose dump was requested but not started yet
+	pending map[string]bool
+	// locations holds the location of the dumps started for the anomalies, until they expire
+	locations map[string]anomalyDumpLocation
+
+	dayStart time.Time
+	dayDumps int
+}
+
+func newAnomalyDumpTrigger(threshold int, maxPerDay int) (*anomalyDumpTrigger, error) {
+	anomalies, err := lru.New[string, int](1024)
+	if err != nil {
+		return nil, err
+	}
+
+	return &anomalyDumpTrigger{
+		threshold: threshold,
+		maxPerDay: maxPerDay,
+		anomalies: anomalies,
+		pending:   make(map[string]bool),
+		locations: make(map[string]anomalyDumpLocation),
+	}, nil
+}
+
+// account accounts an anomaly of the provided container and returns true if an activity dump of the container should
+// be requested. The daily budget is only charged once the dump started, see started.
+func (t *anomalyDumpTrigger) account(containerID string, now time.Time) bool {
+	t.Lock()
+	defer t.Unlock()
+
+	if t.pending[containerID] {
+		return false
+	}
+
+	count, _ := t.anomalies.Get(containerID)
+	count++
+	t.anomalies.Add(containerID, count)
+	if count < t.threshold {
+		return false
+	}
+
+	if now.Sub(t.dayStart) >= 24*time.Hour {
+		t.dayStart = now
+		t.dayDumps = 0
+	}
+	if t.dayDumps >= t.maxPerDay {
+		// keep counting, a dump will be requested once the budget is available again
+		return false
+	}
+
+	t.pending[containerID] = true
+	return true
+}
+
+// started charges the daily budget with the dump started for the provided container, and records its location
+func (t *anomalyDumpTrigger) started(containerID string, location anomalyDumpLocation) {
+	t.Lock()
+	defer t.Unlock()
+
+	delete(t.pending, containerID)
+	t.anomalies.Remove(containerID)
+	t.dayDumps++
+	t.locations[containerID] = location
+}
+
+// failed releases the request of a dump that couldn't be started, the next anomaly requesting it again
+func (t *anomalyDumpTrigger) failed(containerID string) {
+	t.Lock()
+	defer t.Unlock()
+
+	delete(t.pending, containerID)
+}
+
+// location returns the location of the dump capturing the provided container, if any
+func (t *anomalyDumpTrigger) location(containerID string, now time.Time) (anomalyDumpLocation, bool) {
+	t.Lock()
+	defer t.Unlock()
+
+	location, ok := t.locations[containerID]
+	if ok && now.After(location.expires) {
+		delete(t.locations, containerID)
+		return anomalyDumpLocation{}, false
+	}
+	return location, ok
+}
+
+// getActiveDump (thread unsafe) returns the active dump of the provided container, if any
+func (adm *ActivityDumpManager) getActiveDump(containerID string) *ActivityDump {
+	for _, ad := range adm.activeDumps {
+		if ad.Metadata.ContainerID == containerID {
+			return ad
+		}
+	}
+	return nil
+}
+
+// HandleAnomaly accounts an anomaly raised by a container, and requests a short activity dump of the container once it
+// raised enough anomalies. The security profile context of the anomaly is filled with the location of the activity dump
+// capturing the container, once started. It doesn't take the lock of the manager as it is called on the event path.
+func (adm *ActivityDumpManager) HandleAnomaly(event *model.Event) {
+	if adm.anomalyDumps == nil {
+		return
+	}
+
+	containerID := event.FieldHandlers.ResolveContainerID(event, event.ContainerContext)
+	if containerID == "" {
+		return
+	}
+
+	now := time.Now()
+	if location, ok := adm.anomalyDumps.location(containerID, now); ok {
+		event.SecurityProfileContext.ActivityDumpName = location.name
+		event.SecurityProfileContext.ActivityDumpFiles = location.files
+		return
+	}
+
+	if !adm.anomalyDumps.account(containerID, now) {
+		return
+	}
+
+	select {
+	case adm.anomalyDumpRequests <- anomalyDumpRequest{containerID: containerID, cgroupFlags: uint64(event.CGroupContext.CGroupFlags)}:
+	default:
+		adm.anomalyDumps.failed(containerID)
+	}
+}
+
+// startAnomalyDump starts the activity dump requested by the anomalies of a container
+func (adm *ActivityDumpManager) startAnomalyDump(request anomalyDumpRequest) {
+	adm.Lock()
+	defer adm.Unlock()
+
+	duration := adm.config.RuntimeSecurity.AnomalyDetectionAutoDumpDuration
+
+	ad := adm.getActiveDump(request.containerID)
+	if ad == nil {
+		loadConfig := adm.loadController.getDefaultLoadConfig()
+		loadConfig.SetTimeout(duration)
+		if err := adm.startDumpWithConfig(request.containerID, request.cgroupFlags, utils.NewCookie(), *loadConfig); err != nil {
+			seclog.Warnf("couldn't start the activity dump of the anomalies of %s: %v", request.containerID, err)
+			adm.anomalyDumps.failed(request.containerID)
+			return
+		}
+
+		if ad = adm.getActiveDump(request.containerID); ad == nil {
+			adm.anomalyDumps.failed(request.containerID)
+			return
+		}
+		adm.anomalyDumpsStarted.Inc()
+	}
+
+	location := anomalyDumpLocation{
+		name:    ad.Metadata.Name,
+		expires: time.Now().Add(duration),
+	}
+	for _, requests := range ad.StorageRequests {
+		for _, request := range requests {
+			if request.Type == config.LocalStorage {
+				location.files = append(location.files, request.GetOutputPath(ad.Metadata.Name))
+			}
+		}
+	}
+	slices.Sort(location.files)
+
+	adm.anomalyDumps.started(request.containerID, location)
+}
+
+func (adm *ActivityDumpManager) initAnomalyDumps() error {
+	if !adm.config.RuntimeSecurity.AnomalyDetectionAutoDumpEnabled {
+		return nil
+	}
+
+	trigger, err := newAnomalyDumpTrigger(adm.config.RuntimeSecurity.AnomalyDetectionAutoDumpThreshold, adm.config.RuntimeSecurity.AnomalyDetectionAutoDumpMaxPerDay)
+	if err != nil {
+		return fmt.Errorf("couldn't create the anomaly dump trigger: %w", err)
+	}
+	adm.anomalyDumps = trigger
+	adm.anomalyDumpRequests = make(chan anomalyDumpRequest, 16)
+	return nil
+}
@@ -0,0 +1,53 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+// Package dump holds dump related files
+package dump
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnomalyDumpTrigger(t *testing.T) {
+	trigger, err := newAnomalyDumpTrigger(2, 2)
+	assert.NoError(t, err)
+
+	now := time.Now()
+
+	// the threshold is per container
+	assert.False(t, trigger.account("a", now))
+	assert.False(t, trigger.account("b", now))
+	assert.True(t, trigger.account("a", now))
+	assert.True(t, trigger.account("b", now))
+
+	// a single dump is requested at a time
+	assert.False(t, trigger.account("a", now))
+
+	// the budget is only charged by the dumps that started
+	trigger.failed("a")
+	trigger.started("b", anomalyDumpLocation{name: "dump-b", expires: now.Add(time.Minute)})
+	assert.True(t, trigger.account("a", now))
+	trigger.started("a", anomalyDumpLocation{name: "dump-a", expires: now.Add(time.Minute)})
+
+	location, ok := trigger.location("b", now)
+	assert.True(t, ok)
+	assert.Equal(t, "dump-b", location.name)
+	_, ok = trigger.location("b", now.Add(2*time.Minute))
+	assert.False(t, ok)
+
+	// the daily budget is exhausted
+	assert.False(t, trigger.account("c", now))
+	assert.False(t, trigger.account("c", now))
+	assert.False(t, trigger.account("c", now.Add(time.Hour)))
+
+	// the budget is available again the next day, and the anomalies accounted in between are kept
+	assert.True(t, trigger.account("c", now.Add(24*time.Hour)))
+	assert.False(t, trigger.account("a", now.Add(24*time.Hour)))
+}
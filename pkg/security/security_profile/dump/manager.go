@@ -34,6 +34,7 @@ import (
 	"github.com/DataDog/datadog-agent/pkg/security/proto/api"
 	"github.com/DataDog/datadog-agent/pkg/security/resolvers"
 	cgroupModel "github.com/DataDog/datadog-agent/pkg/security/resolvers/cgroup/model"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/containerutils"
 	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
 	"github.com/DataDog/datadog-agent/pkg/security/seclog"
 	activity_tree "github.com/DataDog/datadog-agent/pkg/security/security_profile/activity_tree"
@@ -83,6 +84,10 @@ type ActivityDumpManager struct {
 	hostname            string
 	lastStoppedDumpTime time.Time
 	pathsReducer        *activity_tree.PathsReducer
+
+	anomalyDumps        *anomalyDumpTrigger
+	anomalyDumpRequests chan anomalyDumpRequest
+	anomalyDumpsStarted *atomic.Uint64
 }
 
 // Start runs the ActivityDumpManager
@@ -120,6 +125,8 @@ func (adm *ActivityDumpManager) Start(ctx context.Context, wg *sync.WaitGroup) {
 			}
 		case <-silentWorkloadsTicker.C:
 			adm.handleSilentWorkloads()
+		case request := <-adm.anomalyDumpRequests:
+			adm.startAnomalyDump(request)
 		}
 	}
 }
@@ -308,9 +315,14 @@ func NewActivityDumpManager(config *config.Config, statsdClient statsd.ClientInt
 		workloadDenyList:       denyList,
 		workloadDenyListHits:   atomic.NewUint64(0),
 		pathsReducer:           activity_tree.NewPathsReducer(),
+		anomalyDumpsStarted:    atomic.NewUint64(0),
+	}
+
+	if err = adm.initAnomalyDumps(); err != nil {
+		return nil, err
 	}
 
-	adm.storage, err = NewActivityDumpStorageManager(config, statsdClient, adm, adm)
+	adm.storage, err = NewActivityDumpStorageManager(config, statsdClient, adm, adm, resolvers.PathAnonymizer)
 	if err != nil {
 		return nil, fmt.Errorf("couldn't instantiate the activity dump storage manager: %w", err)
 	}
@@ -520,13 +532,65 @@ func (adm *ActivityDumpManager) ListActivityDumps(_ *api.ActivityDumpListParams)
 	}, nil
 }
 
+// resolveDumpContainerID returns the container ID and the container flags of the workload selected by an activity
+// dump request. The activity dumps are traced in kernel space per container, so a cgroup can only be selected if it
+// belongs to a container.
+func resolveDumpContainerID(params *api.ActivityDumpParams) (string, uint64, error) {
+	if params.GetContainerID() != "" {
+		return params.GetContainerID(), 0, nil
+	}
+	if params.GetCGroupID() == "" {
+		return "", 0, nil
+	}
+
+	containerID, flags := containerutils.FindContainerID(params.GetCGroupID())
+	if containerID == "" || !containerutils.CGroupFlags(flags).IsContainer() {
+		return "", 0, fmt.Errorf("cgroup %s doesn't belong to a container", params.GetCGroupID())
+	}
+	return containerID, flags, nil
+}
+
+// parseDumpEventTypes returns the event types selected by an activity dump request, they have to be traced by the
+// activity dumps so that their kernel hooks are loaded
+func (adm *ActivityDumpManager) parseDumpEventTypes(eventTypes []string) ([]model.EventType, error) {
+	if len(eventTypes) == 0 {
+		return adm.config.RuntimeSecurity.ActivityDumpTracedEventTypes, nil
+	}
+
+	selected := make([]model.EventType, 0, len(eventTypes))
+	for _, eventTypeStr := range eventTypes {
+		eventType := config.ParseEvalEventType(eventTypeStr)
+		if !slices.Contains(adm.config.RuntimeSecurity.ActivityDumpTracedEventTypes, eventType) {
+			return nil, fmt.Errorf("event type %s isn't traced by the activity dumps", eventTypeStr)
+		}
+		if !slices.Contains(selected, eventType) {
+			selected = append(selected, eventType)
+		}
+	}
+	return selected, nil
+}
+
 // DumpActivity handles an activity dump request
 func (adm *ActivityDumpManager) DumpActivity(params *api.ActivityDumpParams) (*api.ActivityDumpMessage, error) {
 	adm.Lock()
 	defer adm.Unlock()
 
+	containerID, containerFlags, err := resolveDumpContainerID(params)
+	if err != nil {
+		errMsg := fmt.Errorf("couldn't start tracing: %w", err)
+		return &api.ActivityDumpMessage{Error: errMsg.Error()}, errMsg
+	}
+
+	eventTypes, err := adm.parseDumpEventTypes(params.GetEventTypes())
+	if err != nil {
+		errMsg := fmt.Errorf("couldn't start tracing: %w", err)
+		return &api.ActivityDumpMessage{Error: errMsg.Error()}, errMsg
+	}
+
 	newDump := NewActivityDump(adm, func(ad *ActivityDump) {
-		ad.Metadata.ContainerID = params.GetContainerID()
+		ad.Metadata.ContainerID = containerID
+		ad.Metadata.ContainerFlags = containerFlags
+		ad.LoadConfig.TracedEventTypes = eventTypes
 		dumpDuration, _ := time.ParseDuration(params.Timeout)
 		ad.SetTimeout(dumpDuration)
 
@@ -776,6 +840,12 @@ func (adm *ActivityDumpManager) SendStats() error {
 		}
 	}
 
+	if value := adm.anomalyDumpsStarted.Swap(0); value > 0 {
+		if err := adm.statsdClient.Count(metrics.MetricActivityDumpAnomalyDumps, int64(value), nil, 1.0); err != nil {
+			return fmt.Errorf("couldn't send %s metric: %w", metrics.MetricActivityDumpAnomalyDumps, err)
+		}
+	}
+
 	adm.storage.SendTelemetry()
 
 	return nil
@@ -17,6 +17,8 @@ import (
 	"github.com/DataDog/datadog-go/v5/statsd"
 
 	"github.com/DataDog/datadog-agent/pkg/security/config"
+	"github.com/DataDog/datadog-agent/pkg/security/proto/api"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
 	activity_tree "github.com/DataDog/datadog-agent/pkg/security/security_profile/activity_tree"
 	mtdt "github.com/DataDog/datadog-agent/pkg/security/security_profile/activity_tree/metadata"
 )
@@ -482,3 +484,69 @@ func TestActivityDumpManager_getOverweightDumps(t *testing.T) {
 		})
 	}
 }
+
+func TestActivityDumpManager_dumpSelection(t *testing.T) {
+	containerID := "c40dff48f1d53c3f07a50aa12bb9ae0e58c0927dc6b1d77e3f166784722642ad"
+
+	tests := []struct {
+		name        string
+		params      *api.ActivityDumpParams
+		containerID string
+		eventTypes  []model.EventType
+		err         bool
+	}{
+		{
+			"container",
+			&api.ActivityDumpParams{ContainerID: containerID},
+			containerID,
+			[]model.EventType{model.ExecEventType, model.DNSEventType},
+			false,
+		},
+		{
+			"container_cgroup",
+			&api.ActivityDumpParams{CGroupID: "/kubepods.slice/cri-containerd-" + containerID + ".scope", EventTypes: []string{"exec", "exec"}},
+			containerID,
+			[]model.EventType{model.ExecEventType},
+			false,
+		},
+		{
+			"systemd_cgroup",
+			&api.ActivityDumpParams{CGroupID: "/system.slice/cron.service"},
+			"",
+			nil,
+			true,
+		},
+		{
+			"untraced_event_type",
+			&api.ActivityDumpParams{ContainerID: containerID, EventTypes: []string{"open"}},
+			"",
+			nil,
+			true,
+		},
+	}
+
+	adm := &ActivityDumpManager{
+		config: &config.Config{
+			RuntimeSecurity: &config.RuntimeSecurityConfig{
+				ActivityDumpTracedEventTypes: []model.EventType{model.ExecEventType, model.DNSEventType},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			containerID, _, err := resolveDumpContainerID(tt.params)
+			if err == nil {
+				var eventTypes []model.EventType
+				eventTypes, err = adm.parseDumpEventTypes(tt.params.GetEventTypes())
+				assert.Equal(t, tt.eventTypes, eventTypes)
+			}
+			if tt.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.containerID, containerID)
+		})
+	}
+}
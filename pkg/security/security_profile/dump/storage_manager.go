@@ -16,6 +16,7 @@ import (
 
 	"github.com/DataDog/datadog-agent/pkg/security/config"
 	"github.com/DataDog/datadog-agent/pkg/security/metrics"
+	spath "github.com/DataDog/datadog-agent/pkg/security/resolvers/path"
 	"github.com/DataDog/datadog-agent/pkg/security/seclog"
 )
 
@@ -33,6 +34,8 @@ type ActivityDumpStorage interface {
 type ActivityDumpStorageManager struct {
 	statsdClient statsd.ClientInterface
 	storages     map[config.StorageType]ActivityDumpStorage
+	// anonymizer redacts the user identifying components of the paths of the dumps sent to the backend
+	anonymizer *spath.Anonymizer
 }
 
 // NewAgentStorageManager returns a new instance of ActivityDumpStorageManager
@@ -74,10 +77,11 @@ func NewAgentCommandStorageManager(cfg *config.Config) (*ActivityDumpStorageMana
 }
 
 // NewActivityDumpStorageManager returns a new instance of ActivityDumpStorageManager
-func NewActivityDumpStorageManager(cfg *config.Config, statsdClient statsd.ClientInterface, handler ActivityDumpHandler, m *ActivityDumpManager) (*ActivityDumpStorageManager, error) {
+func NewActivityDumpStorageManager(cfg *config.Config, statsdClient statsd.ClientInterface, handler ActivityDumpHandler, m *ActivityDumpManager, anonymizer *spath.Anonymizer) (*ActivityDumpStorageManager, error) {
 	manager := &ActivityDumpStorageManager{
 		storages:     make(map[config.StorageType]ActivityDumpStorage),
 		statsdClient: statsdClient,
+		anonymizer:   anonymizer,
 	}
 
 	storage, err := NewActivityDumpLocalStorage(cfg, m)
@@ -98,26 +102,45 @@ func NewActivityDumpStorageManager(cfg *config.Config, statsdClient statsd.Clien
 // Persist saves the provided dump to the requested storages
 func (manager *ActivityDumpStorageManager) Persist(ad *ActivityDump) error {
 
-	for format := range ad.StorageRequests {
+	for format, requests := range ad.StorageRequests {
 		// set serialization format metadata
 		ad.Serialization = format.String()
 
-		// encode the dump as the request format
-		data, err := ad.Encode(format)
-		if err != nil {
-			seclog.Errorf("couldn't persist activity dump [%s]: %v", ad.GetSelectorStr(), err)
-			continue
-		}
-
-		if err = manager.PersistRaw(ad.StorageRequests[format], ad, data); err != nil {
-			seclog.Errorf("couldn't persist activity dump [%s] in [%s]: %v", ad.GetSelectorStr(), format, err)
-			continue
+		// the paths of the dumps sent to the backend are anonymized, the local dumps being loaded back as security
+		// profiles
+		var localRequests, remoteRequests []config.StorageRequest
+		for _, request := range requests {
+			if manager.anonymizer != nil && request.Type == config.RemoteStorage {
+				remoteRequests = append(remoteRequests, request)
+			} else {
+				localRequests = append(localRequests, request)
+			}
 		}
 
+		manager.persistFormat(ad, format, localRequests, nil)
+		manager.persistFormat(ad, format, remoteRequests, manager.anonymizer)
 	}
 	return nil
 }
 
+// persistFormat encodes the provided dump in the requested format and saves it to the requested storages
+func (manager *ActivityDumpStorageManager) persistFormat(ad *ActivityDump, format config.StorageFormat, requests []config.StorageRequest, anonymizer *spath.Anonymizer) {
+	if len(requests) == 0 {
+		return
+	}
+
+	// encode the dump as the request format
+	data, err := ad.EncodeAnonymized(format, anonymizer)
+	if err != nil {
+		seclog.Errorf("couldn't persist activity dump [%s]: %v", ad.GetSelectorStr(), err)
+		return
+	}
+
+	if err = manager.PersistRaw(requests, ad, data); err != nil {
+		seclog.Errorf("couldn't persist activity dump [%s] in [%s]: %v", ad.GetSelectorStr(), format, err)
+	}
+}
+
 // PersistRaw saves the provided dump to the requested storages
 func (manager *ActivityDumpStorageManager) PersistRaw(requests []config.StorageRequest, ad *ActivityDump, raw *bytes.Buffer) error {
 	for _, request := range requests {
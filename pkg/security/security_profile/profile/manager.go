@@ -126,6 +126,9 @@ type SecurityProfileManager struct {
 	eventFiltering        map[eventFilteringEntry]*atomic.Uint64
 	pathsReducer          *activity_tree.PathsReducer
 	onLocalStorageCleanup func(files []string)
+
+	suppressionWindows  *suppressionWindows
+	suppressedAnomalies *atomic.Uint64
 }
 
 // NewSecurityProfileManager returns a new instance of SecurityProfileManager
@@ -170,6 +173,8 @@ func NewSecurityProfileManager(config *config.Config, statsdClient statsd.Client
 		cacheMiss:                  atomic.NewUint64(0),
 		eventFiltering:             make(map[eventFilteringEntry]*atomic.Uint64),
 		pathsReducer:               activity_tree.NewPathsReducer(),
+		suppressionWindows:         newSuppressionWindows(),
+		suppressedAnomalies:        atomic.NewUint64(0),
 	}
 
 	// instantiate directory provider
@@ -232,8 +237,18 @@ func (m *SecurityProfileManager) Start(ctx context.Context) {
 
 	seclog.Infof("security profile manager started")
 
-	<-ctx.Done()
-	m.stop()
+	ticker := time.NewTicker(suppressionWindowsPruneInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			m.stop()
+			return
+		case now := <-ticker.C:
+			m.suppressionWindows.prune(now)
+		}
+	}
 }
 
 // propagateWorkloadSelectorsToProviders (thread unsafe) propagates the list of workload selectors to the Security
@@ -596,6 +611,12 @@ func (m *SecurityProfileManager) SendStats() error {
 		}
 	}
 
+	if val := int64(m.suppressedAnomalies.Swap(0)); val > 0 {
+		if err := m.statsdClient.Count(metrics.MetricSecurityProfileAnomalyDetectionSuppressed, val, []string{}, 1.0); err != nil {
+			return fmt.Errorf("couldn't send MetricSecurityProfileAnomalyDetectionSuppressed: %w", err)
+		}
+	}
+
 	m.evictedVersionsLock.Lock()
 	evictedVersions := m.evictedVersions
 	m.evictedVersions = []cgroupModel.WorkloadSelector{}
@@ -826,7 +847,14 @@ func (m *SecurityProfileManager) LookupEventInProfiles(event *model.Event) {
 		} else {
 			m.incrementEventFilteringStat(event.GetEventType(), profileState, NotInProfile)
 			if m.canGenerateAnomaliesFor(event) {
-				event.AddToFlags(model.EventFlagsAnomalyDetectionEvent)
+				// anomaly detections are paused while a suppression window is open for the workload, e.g. during a
+				// deployment
+				if m.suppressionWindows.isActive(cgroupModel.WorkloadSelector{Image: selector.Image, Tag: imageTag}, time.Now()) {
+					m.suppressedAnomalies.Inc()
+					event.ResetAnomalyDetectionEvent()
+				} else {
+					event.AddToFlags(model.EventFlagsAnomalyDetectionEvent)
+				}
 			}
 		}
 	}
@@ -42,10 +42,11 @@ func SecurityProfileToProto(input *SecurityProfile) *proto.SecurityProfile {
 		return nil
 	}
 
+	// the profiles are persisted locally to be loaded back, their paths are thus kept as is
 	output := proto.SecurityProfile{
 		Metadata:        mtdt.ToProto(&input.Metadata),
 		ProfileContexts: make(map[string]*proto.ProfileContext),
-		Tree:            activity_tree.ToProto(input.ActivityTree),
+		Tree:            activity_tree.ToProto(input.ActivityTree, nil),
 		Selector:        cgroupModel.WorkloadSelectorToProto(&input.selector),
 	}
 
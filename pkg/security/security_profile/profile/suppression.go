@@ -0,0 +1,174 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+// Package profile holds profile related files
+package profile
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	cgroupModel "github.com/DataDog/datadog-agent/pkg/security/resolvers/cgroup/model"
+)
+
+// SuppressionWindow pauses the anomaly detections of the workloads matching a selector, for instance during a
+// deployment
+type SuppressionWindow struct {
+	Selector cgroupModel.WorkloadSelector `json:"selector"`
+	Until    time.Time                    `json:"until"`
+}
+
+// suppressionWindowsPruneInterval is the interval at which the expired suppression windows are forgotten
+const suppressionWindowsPruneInterval = time.Minute
+
+// suppressionWindow is an open suppression window
+type suppressionWindow struct {
+	until time.Time
+	// remote is true if the window was opened by remote config
+	remote bool
+}
+
+// suppressionWindows holds the active suppression windows
+type suppressionWindows struct {
+	sync.RWMutex
+	windows map[cgroupModel.WorkloadSelector]suppressionWindow
+}
+
+func newSuppressionWindows() *suppressionWindows {
+	return &suppressionWindows{
+		windows: make(map[cgroupModel.WorkloadSelector]suppressionWindow),
+	}
+}
+
+// set opens, or replaces, the suppression window of a selector
+func (sw *suppressionWindows) set(selector cgroupModel.WorkloadSelector, until time.Time) {
+	sw.Lock()
+	defer sw.Unlock()
+
+	sw.windows[selector] = suppressionWindow{until: until}
+}
+
+// setRemote replaces the windows previously opened by remote config with the provided ones. The windows opened
+// locally take precedence over the remote ones of the same selector.
+func (sw *suppressionWindows) setRemote(windows []SuppressionWindow) {
+	sw.Lock()
+	defer sw.Unlock()
+
+	for selector, window := range sw.windows {
+		if window.remote {
+			delete(sw.windows, selector)
+		}
+	}
+
+	for _, window := range windows {
+		if _, exists := sw.windows[window.Selector]; exists {
+			continue
+		}
+		sw.windows[window.Selector] = suppressionWindow{until: window.Until, remote: true}
+	}
+}
+
+// remove closes the suppression window of a selector, it returns false if there was none
+func (sw *suppressionWindows) remove(selector cgroupModel.WorkloadSelector) bool {
+	sw.Lock()
+	defer sw.Unlock()
+
+	_, exists := sw.windows[selector]
+	delete(sw.windows, selector)
+	return exists
+}
+
+// isActive returns true if a suppression window matching the provided selector is open
+func (sw *suppressionWindows) isActive(selector cgroupModel.WorkloadSelector, now time.Time) bool {
+	sw.RLock()
+	defer sw.RUnlock()
+
+	for windowSelector, window := range sw.windows {
+		if now.Before(window.until) && windowSelector.Match(selector) {
+			return true
+		}
+	}
+	return false
+}
+
+// prune forgets the expired suppression windows
+func (sw *suppressionWindows) prune(now time.Time) {
+	sw.Lock()
+	defer sw.Unlock()
+
+	for selector, window := range sw.windows {
+		if !now.Before(window.until) {
+			delete(sw.windows, selector)
+		}
+	}
+}
+
+// list returns the open suppression windows and forgets the expired ones
+func (sw *suppressionWindows) list(now time.Time) []SuppressionWindow {
+	sw.prune(now)
+
+	sw.RLock()
+	defer sw.RUnlock()
+
+	windows := make([]SuppressionWindow, 0, len(sw.windows))
+	for selector, window := range sw.windows {
+		windows = append(windows, SuppressionWindow{Selector: selector, Until: window.until})
+	}
+
+	sort.Slice(windows, func(i, j int) bool {
+		return windows[i].Selector.String() < windows[j].Selector.String()
+	})
+
+	return windows
+}
+
+// SuppressAnomalyDetections pauses the anomaly detections of the workloads matching the provided selector for the
+// provided duration, the default duration being used when it is 0
+func (m *SecurityProfileManager) SuppressAnomalyDetections(selector cgroupModel.WorkloadSelector, duration time.Duration) (SuppressionWindow, error) {
+	if !selector.IsReady() {
+		return SuppressionWindow{}, cgroupModel.ErrNoImageProvided
+	}
+
+	if duration == 0 {
+		duration = m.config.RuntimeSecurity.AnomalyDetectionSuppressionDefaultDuration
+	}
+	if duration < 0 || duration > m.config.RuntimeSecurity.AnomalyDetectionSuppressionMaxDuration {
+		return SuppressionWindow{}, fmt.Errorf("invalid suppression window duration %s, it must be between 0 and %s", duration, m.config.RuntimeSecurity.AnomalyDetectionSuppressionMaxDuration)
+	}
+
+	window := SuppressionWindow{
+		Selector: selector,
+		Until:    time.Now().Add(duration),
+	}
+	m.suppressionWindows.set(window.Selector, window.Until)
+
+	return window, nil
+}
+
+// ResumeAnomalyDetections closes the suppression window of the provided selector, it returns false if there was none
+func (m *SecurityProfileManager) ResumeAnomalyDetections(selector cgroupModel.WorkloadSelector) bool {
+	return m.suppressionWindows.remove(selector)
+}
+
+// ListSuppressionWindows returns the open suppression windows
+func (m *SecurityProfileManager) ListSuppressionWindows() []SuppressionWindow {
+	return m.suppressionWindows.list(time.Now())
+}
+
+// SetRemoteSuppressionWindows replaces the suppression windows opened by remote config. The windows whose duration
+// exceeds the maximum duration are shortened.
+func (m *SecurityProfileManager) SetRemoteSuppressionWindows(windows []SuppressionWindow) {
+	maxUntil := time.Now().Add(m.config.RuntimeSecurity.AnomalyDetectionSuppressionMaxDuration)
+	for i := range windows {
+		if windows[i].Until.After(maxUntil) {
+			windows[i].Until = maxUntil
+		}
+	}
+	m.suppressionWindows.setRemote(windows)
+}
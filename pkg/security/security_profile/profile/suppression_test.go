@@ -0,0 +1,99 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+// Package profile holds profile related files
+package profile
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/DataDog/datadog-agent/pkg/security/config"
+	cgroupModel "github.com/DataDog/datadog-agent/pkg/security/resolvers/cgroup/model"
+)
+
+func TestSuppressionWindows(t *testing.T) {
+	m := &SecurityProfileManager{
+		config: &config.Config{
+			RuntimeSecurity: &config.RuntimeSecurityConfig{
+				AnomalyDetectionSuppressionDefaultDuration: 15 * time.Minute,
+				AnomalyDetectionSuppressionMaxDuration:     time.Hour,
+			},
+		},
+		suppressionWindows: newSuppressionWindows(),
+	}
+
+	nginx := cgroupModel.WorkloadSelector{Image: "nginx", Tag: "*"}
+	redis := cgroupModel.WorkloadSelector{Image: "redis", Tag: "7.2"}
+
+	_, err := m.SuppressAnomalyDetections(nginx, 2*time.Hour)
+	assert.Error(t, err)
+	_, err = m.SuppressAnomalyDetections(cgroupModel.WorkloadSelector{}, 0)
+	assert.Error(t, err)
+
+	window, err := m.SuppressAnomalyDetections(nginx, 0)
+	assert.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(15*time.Minute), window.Until, time.Minute)
+	_, err = m.SuppressAnomalyDetections(redis, 30*time.Minute)
+	assert.NoError(t, err)
+
+	now := time.Now()
+	assert.True(t, m.suppressionWindows.isActive(cgroupModel.WorkloadSelector{Image: "nginx", Tag: "1.27"}, now))
+	assert.True(t, m.suppressionWindows.isActive(cgroupModel.WorkloadSelector{Image: "redis", Tag: "7.2"}, now))
+	assert.False(t, m.suppressionWindows.isActive(cgroupModel.WorkloadSelector{Image: "redis", Tag: "7.4"}, now))
+
+	// the windows expire
+	later := now.Add(20 * time.Minute)
+	assert.False(t, m.suppressionWindows.isActive(cgroupModel.WorkloadSelector{Image: "nginx", Tag: "1.27"}, later))
+	windows := m.suppressionWindows.list(later)
+	assert.Len(t, windows, 1)
+	assert.Equal(t, redis, windows[0].Selector)
+
+	assert.True(t, m.ResumeAnomalyDetections(redis))
+	assert.False(t, m.ResumeAnomalyDetections(redis))
+	assert.Empty(t, m.ListSuppressionWindows())
+}
+
+func TestRemoteSuppressionWindows(t *testing.T) {
+	m := &SecurityProfileManager{
+		config: &config.Config{
+			RuntimeSecurity: &config.RuntimeSecurityConfig{
+				AnomalyDetectionSuppressionMaxDuration: time.Hour,
+			},
+		},
+		suppressionWindows: newSuppressionWindows(),
+	}
+
+	nginx := cgroupModel.WorkloadSelector{Image: "nginx", Tag: "*"}
+	redis := cgroupModel.WorkloadSelector{Image: "redis", Tag: "*"}
+
+	now := time.Now()
+	_, err := m.SuppressAnomalyDetections(nginx, 10*time.Minute)
+	assert.NoError(t, err)
+
+	// the local window takes precedence, the remote ones are capped by the maximum duration
+	m.SetRemoteSuppressionWindows([]SuppressionWindow{
+		{Selector: nginx, Until: now.Add(30 * time.Minute)},
+		{Selector: redis, Until: now.Add(24 * time.Hour)},
+	})
+	windows := m.ListSuppressionWindows()
+	assert.Len(t, windows, 2)
+	assert.WithinDuration(t, now.Add(10*time.Minute), windows[0].Until, time.Minute)
+	assert.WithinDuration(t, now.Add(time.Hour), windows[1].Until, time.Minute)
+
+	// a new remote update replaces the previous remote windows only
+	m.SetRemoteSuppressionWindows(nil)
+	windows = m.ListSuppressionWindows()
+	assert.Len(t, windows, 1)
+	assert.Equal(t, nginx, windows[0].Selector)
+
+	// the expired windows are pruned
+	m.suppressionWindows.prune(now.Add(20 * time.Minute))
+	assert.Empty(t, m.suppressionWindows.windows)
+}
@@ -100,7 +100,7 @@ func craftFakeProcess(containerID string, test *testIteration) *model.ProcessCac
 	}
 	process.Args = "foo"
 	if test.setCookie {
-		process.Cookie = 42
+		process.Cookie = model.ProcessCookie{Lo: 42}
 	}
 
 	// setting process ancestor
@@ -120,7 +120,7 @@ func craftFakeProcess(containerID string, test *testIteration) *model.ProcessCac
 	}
 	process.Ancestor.Args = "bar"
 	if test.setCookieParent {
-		process.Ancestor.Cookie = 41
+		process.Ancestor.Cookie = model.ProcessCookie{Lo: 41}
 	}
 
 	// setting process granpa
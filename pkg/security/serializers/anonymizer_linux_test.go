@@ -0,0 +1,76 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package serializers holds serializers related files
+package serializers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	spath "github.com/DataDog/datadog-agent/pkg/security/resolvers/path"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
+)
+
+// anonymizingFieldHandlers provides the path anonymizer of the serialized events
+type anonymizingFieldHandlers struct {
+	model.FakeFieldHandlers
+	anonymizer *spath.Anonymizer
+}
+
+// GetPathAnonymizer returns the path anonymizer
+func (fh *anonymizingFieldHandlers) GetPathAnonymizer() *spath.Anonymizer {
+	return fh.anonymizer
+}
+
+func TestPathAnonymization(t *testing.T) {
+	anonymizer, err := spath.NewAnonymizer([]string{`^/home/([^/]+)`})
+	assert.NoError(t, err)
+
+	event := model.NewFakeEvent()
+	event.FieldHandlers = &anonymizingFieldHandlers{anonymizer: anonymizer}
+	process := &model.Process{
+		FileEvent: model.FileEvent{
+			PathnameStr: "/home/alice/bin/tool",
+			BasenameStr: "tool",
+		},
+		ArgvScrubbed:       []string{"--config", "/home/alice/.tool.yaml", "--log=/home/alice/tool.log"},
+		Envs:               []string{"HOME=/home/alice", "PWD=/home/alice/src", "PATH=/usr/bin:/home/alice/bin"},
+		SymlinkPathnameStr: [2]string{"/home/alice/tool", ""},
+	}
+
+	t.Run("file", func(t *testing.T) {
+		fs := newFileSerializer(&model.FileEvent{PathnameStr: "/home/alice/.ssh/id_rsa", BasenameStr: "id_rsa"}, event)
+		assert.Equal(t, "/home/<redacted>/.ssh/id_rsa", fs.Path)
+		assert.Equal(t, "id_rsa", fs.Name)
+	})
+
+	t.Run("home-directory", func(t *testing.T) {
+		fs := newFileSerializer(&model.FileEvent{PathnameStr: "/home/alice", BasenameStr: "alice"}, event)
+		assert.Equal(t, "/home/<redacted>", fs.Path)
+		assert.Equal(t, "<redacted>", fs.Name)
+	})
+
+	t.Run("process", func(t *testing.T) {
+		ps := newProcessSerializer(process, event)
+		assert.Equal(t, "/home/<redacted>/bin/tool", ps.Executable.Path)
+		assert.Equal(t, []string{"--config", "/home/<redacted>/.tool.yaml", "--log=/home/<redacted>/tool.log"}, ps.Args)
+		assert.Equal(t, []string{"HOME=/home/<redacted>", "PWD=/home/<redacted>/src", "PATH=/usr/bin:/home/<redacted>/bin"}, ps.Envs)
+		assert.Equal(t, []string{"/home/<redacted>/tool"}, ps.Symlinks)
+
+		// the process cache entry is left untouched, the rules being evaluated against the original values
+		assert.Equal(t, "/home/alice/.tool.yaml", process.ArgvScrubbed[1])
+		assert.Equal(t, "HOME=/home/alice", process.Envs[0])
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		ps := newProcessSerializer(process, model.NewFakeEvent())
+		assert.Equal(t, "/home/alice/bin/tool", ps.Executable.Path)
+		assert.Equal(t, []string{"--config", "/home/alice/.tool.yaml", "--log=/home/alice/tool.log"}, ps.Args)
+		assert.Equal(t, []string{"HOME=/home/alice", "PWD=/home/alice/src", "PATH=/usr/bin:/home/alice/bin"}, ps.Envs)
+		assert.Equal(t, []string{"/home/alice/tool"}, ps.Symlinks)
+	})
+}
@@ -25,6 +25,21 @@ type CGroupContextSerializer struct {
 	ID string `json:"id,omitempty"`
 	// CGroup manager
 	Manager string `json:"manager,omitempty"`
+	// CGroup resource usage
+	Usage *CGroupUsageSerializer `json:"usage,omitempty"`
+}
+
+// CGroupUsageSerializer serializes the resource usage of a cgroup to JSON
+// easyjson:json
+type CGroupUsageSerializer struct {
+	// Memory used by the cgroup and its descendants, in bytes
+	MemoryCurrent uint64 `json:"memory_current"`
+	// Indicator of a memory usage of the cgroup, or of one of its ancestors, above its memory.high throttle limit
+	MemoryHighExceeded bool `json:"memory_high_exceeded"`
+	// Number of processes of the cgroup and its descendants
+	PidsCurrent uint64 `json:"pids_current"`
+	// CPU time consumed by the cgroup and its descendants, in microseconds
+	CPUUsage uint64 `json:"cpu_usage_usec"`
 }
 
 // ContainerContextSerializer serializes a container context to JSON
@@ -34,6 +49,18 @@ type ContainerContextSerializer struct {
 	ID string `json:"id,omitempty"`
 	// Creation time of the container
 	CreatedAt *utils.EasyjsonTime `json:"created_at,omitempty"`
+	// Name of the image of the container
+	ImageName string `json:"image_name,omitempty"`
+	// Digest of the image of the container
+	ImageDigest string `json:"image_digest,omitempty"`
+	// Name of the pod of the container
+	PodName string `json:"pod_name,omitempty"`
+	// Namespace of the pod of the container
+	PodNamespace string `json:"pod_namespace,omitempty"`
+	// UID of the pod of the container
+	PodUID string `json:"pod_uid,omitempty"`
+	// ID of the pod sandbox of the container
+	SandboxID string `json:"sandbox_id,omitempty"`
 	// Variables values
 	Variables Variables `json:"variables,omitempty"`
 }
@@ -68,6 +95,8 @@ type EventContextSerializer struct {
 	Outcome string `json:"outcome,omitempty"`
 	// True if the event was asynchronous
 	Async bool `json:"async,omitempty"`
+	// True if the event was handled before the end of the snapshot of the processes, its lineage may be incomplete
+	PreSnapshot bool `json:"pre_snapshot,omitempty"`
 	// The list of rules that the event matched (only valid in the context of an anomaly)
 	MatchedRules []MatchedRuleSerializer `json:"matched_rules,omitempty"`
 	// Variables values
@@ -141,6 +170,21 @@ type AWSSecurityCredentialsSerializer struct {
 	Expiration string `json:"expiration"`
 }
 
+// OAuthAccessTokenSerializer serializes the scrubbed data of an OAuth access token delivered by the GCP or Azure IMDS
+// easyjson:json
+type OAuthAccessTokenSerializer struct {
+	// type is the access token type
+	Type string `json:"type,omitempty"`
+	// expires_in is the lifetime in seconds of the access token
+	ExpiresIn int `json:"expires_in,omitempty"`
+	// resource is the resource the access token grants access to
+	Resource string `json:"resource,omitempty"`
+	// client_id is the client ID of the managed identity the access token was delivered to
+	ClientID string `json:"client_id,omitempty"`
+	// fingerprint identifies the access token without disclosing it
+	Fingerprint string `json:"fingerprint"`
+}
+
 // AWSIMDSEventSerializer serializes an AWS IMDS event to JSON
 // easyjson:json
 type AWSIMDSEventSerializer struct {
@@ -168,6 +212,8 @@ type IMDSEventSerializer struct {
 
 	// AWS holds the AWS specific data parsed from the IMDS event
 	AWS *AWSIMDSEventSerializer `json:"aws,omitempty"`
+	// AccessToken holds the scrubbed data of the OAuth access token delivered by the GCP or Azure IMDS
+	AccessToken *OAuthAccessTokenSerializer `json:"access_token,omitempty"`
 }
 
 // DNSQuestionSerializer serializes a DNS question to JSON
@@ -283,6 +329,17 @@ func newIMDSEventSerializer(e *model.IMDSEvent) *IMDSEventSerializer {
 		}
 	}
 
+	var accessToken *OAuthAccessTokenSerializer
+	if len(e.AccessToken.Fingerprint) > 0 {
+		accessToken = &OAuthAccessTokenSerializer{
+			Type:        e.AccessToken.Type,
+			ExpiresIn:   e.AccessToken.ExpiresIn,
+			Resource:    e.AccessToken.Resource,
+			ClientID:    e.AccessToken.ClientID,
+			Fingerprint: e.AccessToken.Fingerprint,
+		}
+	}
+
 	return &IMDSEventSerializer{
 		Type:          e.Type,
 		CloudProvider: e.CloudProvider,
@@ -291,6 +348,7 @@ func newIMDSEventSerializer(e *model.IMDSEvent) *IMDSEventSerializer {
 		UserAgent:     e.UserAgent,
 		Server:        e.Server,
 		AWS:           aws,
+		AccessToken:   accessToken,
 	}
 }
 
@@ -12,12 +12,16 @@ package serializers
 
 import (
 	"fmt"
+	"slices"
+	"strings"
 	"syscall"
 	"time"
 
 	"golang.org/x/sys/unix"
+	"modernc.org/mathutil"
 
 	"github.com/DataDog/datadog-agent/pkg/security/events"
+	spath "github.com/DataDog/datadog-agent/pkg/security/resolvers/path"
 	sprocess "github.com/DataDog/datadog-agent/pkg/security/resolvers/process"
 	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/eval"
 	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
@@ -79,6 +83,10 @@ type FileSerializer struct {
 	MountSource string `json:"mount_source,omitempty"`
 	// MountOrigin origin of the mount
 	MountOrigin string `json:"mount_origin,omitempty"`
+	// Path of the file in the OverlayFS layer backing it
+	LayerPath string `json:"layer_path,omitempty"`
+	// Digest of the container image layer backing the file
+	LayerDigest string `json:"layer_digest,omitempty"`
 }
 
 // UserContextSerializer serializes a user context to JSON
@@ -202,6 +210,8 @@ type ProcessSerializer struct {
 	PPid *uint32 `json:"ppid,omitempty"`
 	// Thread ID
 	Tid uint32 `json:"tid,omitempty"`
+	// Token identifying the process across the agent products
+	Identity string `json:"identity,omitempty"`
 	// User ID
 	UID int `json:"uid"`
 	// Group ID
@@ -230,8 +240,16 @@ type ProcessSerializer struct {
 	Executable *FileSerializer `json:"executable,omitempty"`
 	// File information of the interpreter
 	Interpreter *FileSerializer `json:"interpreter,omitempty"`
+	// Paths of the symlinks pointing to the executable
+	Symlinks []string `json:"symlinks,omitempty"`
 	// Container context
 	Container *ContainerContextSerializer `json:"container,omitempty"`
+	// Systemd unit owning the cgroup of the process
+	SystemdUnit string `json:"systemd_unit,omitempty"`
+	// Systemd slice the unit of the process is nested in
+	SystemdSlice string `json:"systemd_slice,omitempty"`
+	// Namespaces of the process
+	Namespaces *NamespacesSerializer `json:"namespaces,omitempty"`
 	// First command line argument
 	Argv0 string `json:"argv0,omitempty"`
 	// Command line arguments
@@ -254,6 +272,39 @@ type ProcessSerializer struct {
 	Syscalls *SyscallsEventSerializer `json:"syscalls,omitempty"`
 	// List of AWS Security Credentials that the process had access to
 	AWSSecurityCredentials []*AWSSecurityCredentialsSerializer `json:"aws_security_credentials,omitempty"`
+	// List of the GCP and Azure credentials that the process had access to
+	CloudCredentials []*CloudCredentialsSerializer `json:"cloud_credentials,omitempty"`
+}
+
+// CloudCredentialsSerializer serializes the scrubbed data of the credentials acquired by a process from the GCP or
+// Azure IMDS
+// easyjson:json
+type CloudCredentialsSerializer struct {
+	// Cloud provider that delivered the credentials
+	CloudProvider string `json:"cloud_provider"`
+	// Type of the credentials
+	Type string `json:"type,omitempty"`
+	// Resource the credentials grant access to
+	Resource string `json:"resource,omitempty"`
+	// Fingerprint identifying the credentials without disclosing them
+	Fingerprint string `json:"fingerprint"`
+	// Expiration date of the credentials
+	Expiration *utils.EasyjsonTime `json:"expiration,omitempty"`
+}
+
+// NamespacesSerializer serializes the namespaces of a process to JSON
+// easyjson:json
+type NamespacesSerializer struct {
+	// Inode number of the PID namespace
+	PID uint32 `json:"pid,omitempty"`
+	// Inode number of the user namespace
+	User uint32 `json:"user,omitempty"`
+	// Inode number of the mount namespace
+	Mnt uint32 `json:"mnt,omitempty"`
+	// Inode number of the UTS namespace
+	UTS uint32 `json:"uts,omitempty"`
+	// Inode number of the network namespace
+	Net uint32 `json:"net,omitempty"`
 }
 
 // FileEventSerializer serializes a file event to JSON
@@ -331,6 +382,15 @@ type BPFProgramSerializer struct {
 	Helpers []string `json:"helpers,omitempty"`
 }
 
+// KernelSecuritySerializer serializes the security state of the kernel of the host to JSON
+// easyjson:json
+type KernelSecuritySerializer struct {
+	// Kernel lockdown mode
+	Lockdown string `json:"lockdown,omitempty"`
+	// Secure boot status
+	SecureBoot string `json:"secure_boot,omitempty"`
+}
+
 // BPFEventSerializer serializes a BPF event to JSON
 // easyjson:json
 type BPFEventSerializer struct {
@@ -340,6 +400,8 @@ type BPFEventSerializer struct {
 	Map *BPFMapSerializer `json:"map,omitempty"`
 	// BPF program
 	Program *BPFProgramSerializer `json:"program,omitempty"`
+	// Security state of the kernel of the host
+	KernelSecurity *KernelSecuritySerializer `json:"kernel_security,omitempty"`
 }
 
 // MMapEventSerializer serializes a mmap event to JSON
@@ -368,6 +430,10 @@ type MProtectEventSerializer struct {
 	VMProtection string `json:"vm_protection"`
 	// new memory segment protection
 	ReqProtection string `json:"req_protection"`
+	// memory segment size
+	Size uint64 `json:"size"`
+	// indicates if the memory segment is anonymous, not backed by a file
+	IsAnonymous bool `json:"is_anonymous"`
 }
 
 // PTraceEventSerializer serializes a mmap event to JSON
@@ -412,6 +478,8 @@ type ModuleEventSerializer struct {
 	LoadedFromMemory *bool    `json:"loaded_from_memory,omitempty"`
 	Argv             []string `json:"argv,omitempty"`
 	ArgsTruncated    *bool    `json:"args_truncated,omitempty"`
+	// Security state of the kernel of the host, only set on module load events
+	KernelSecurity *KernelSecuritySerializer `json:"kernel_security,omitempty"`
 }
 
 // SpliceEventSerializer serializes a splice event to JSON
@@ -476,6 +544,10 @@ type SecurityProfileContextSerializer struct {
 	EventInProfile bool `json:"event_in_profile"`
 	// State of the event type in this profile
 	EventTypeState string `json:"event_type_state"`
+	// Name of the activity dump capturing the workload
+	ActivityDumpName string `json:"activity_dump_name,omitempty"`
+	// Local files of the activity dump capturing the workload
+	ActivityDumpFiles []string `json:"activity_dump_files,omitempty"`
 }
 
 // SyscallSerializer serializes a syscall
@@ -521,19 +593,19 @@ func newSyscallArgsSerializer(sc *model.SyscallContext, e *model.Event) *Syscall
 
 	switch e.GetEventType() {
 	case model.FileChmodEventType:
-		path := e.FieldHandlers.ResolveSyscallCtxArgsStr1(e, sc)
+		path := anonymizePath(e, e.FieldHandlers.ResolveSyscallCtxArgsStr1(e, sc))
 		mode := e.FieldHandlers.ResolveSyscallCtxArgsInt2(e, sc)
 		return &SyscallArgsSerializer{
 			Path: &path,
 			Mode: &mode,
 		}
 	case model.FileChdirEventType, model.ExecEventType, model.FileUtimesEventType:
-		path := e.FieldHandlers.ResolveSyscallCtxArgsStr1(e, sc)
+		path := anonymizePath(e, e.FieldHandlers.ResolveSyscallCtxArgsStr1(e, sc))
 		return &SyscallArgsSerializer{
 			Path: &path,
 		}
 	case model.FileOpenEventType:
-		path := e.FieldHandlers.ResolveSyscallCtxArgsStr1(e, sc)
+		path := anonymizePath(e, e.FieldHandlers.ResolveSyscallCtxArgsStr1(e, sc))
 		flags := e.FieldHandlers.ResolveSyscallCtxArgsInt2(e, sc)
 		mode := e.FieldHandlers.ResolveSyscallCtxArgsInt3(e, sc)
 		return &SyscallArgsSerializer{
@@ -542,7 +614,7 @@ func newSyscallArgsSerializer(sc *model.SyscallContext, e *model.Event) *Syscall
 			Mode:  &mode,
 		}
 	case model.FileChownEventType:
-		path := e.FieldHandlers.ResolveSyscallCtxArgsStr1(e, sc)
+		path := anonymizePath(e, e.FieldHandlers.ResolveSyscallCtxArgsStr1(e, sc))
 		uid := e.FieldHandlers.ResolveSyscallCtxArgsInt2(e, sc)
 		gid := e.FieldHandlers.ResolveSyscallCtxArgsInt3(e, sc)
 		return &SyscallArgsSerializer{
@@ -552,7 +624,7 @@ func newSyscallArgsSerializer(sc *model.SyscallContext, e *model.Event) *Syscall
 		}
 	case model.FileUnlinkEventType:
 		dirfd := e.FieldHandlers.ResolveSyscallCtxArgsInt1(e, sc)
-		path := e.FieldHandlers.ResolveSyscallCtxArgsStr2(e, sc)
+		path := anonymizePath(e, e.FieldHandlers.ResolveSyscallCtxArgsStr2(e, sc))
 		flags := e.FieldHandlers.ResolveSyscallCtxArgsInt3(e, sc)
 		return &SyscallArgsSerializer{
 			DirFd: &dirfd,
@@ -560,15 +632,15 @@ func newSyscallArgsSerializer(sc *model.SyscallContext, e *model.Event) *Syscall
 			Flags: &flags,
 		}
 	case model.FileLinkEventType, model.FileRenameEventType:
-		path := e.FieldHandlers.ResolveSyscallCtxArgsStr1(e, sc)
-		destinationPath := e.FieldHandlers.ResolveSyscallCtxArgsStr2(e, sc)
+		path := anonymizePath(e, e.FieldHandlers.ResolveSyscallCtxArgsStr1(e, sc))
+		destinationPath := anonymizePath(e, e.FieldHandlers.ResolveSyscallCtxArgsStr2(e, sc))
 		return &SyscallArgsSerializer{
 			Path:            &path,
 			DestinationPath: &destinationPath,
 		}
 	case model.FileMountEventType:
-		sourcePath := e.FieldHandlers.ResolveSyscallCtxArgsStr1(e, sc)
-		mountPointPath := e.FieldHandlers.ResolveSyscallCtxArgsStr2(e, sc)
+		sourcePath := anonymizePath(e, e.FieldHandlers.ResolveSyscallCtxArgsStr1(e, sc))
+		mountPointPath := anonymizePath(e, e.FieldHandlers.ResolveSyscallCtxArgsStr2(e, sc))
 		fstype := e.FieldHandlers.ResolveSyscallCtxArgsStr3(e, sc)
 		return &SyscallArgsSerializer{
 			Path:            &sourcePath,
@@ -666,10 +738,12 @@ func newFileSerializer(fe *model.FileEvent, e *model.Event, forceInode ...uint64
 		inode = forceInode[0]
 	}
 
+	path, name := anonymizeFilePath(e, e.FieldHandlers.ResolveFilePath(e, fe), e.FieldHandlers.ResolveFileBasename(e, fe))
+
 	fs := &FileSerializer{
-		Path:                e.FieldHandlers.ResolveFilePath(e, fe),
+		Path:                path,
 		PathResolutionError: fe.GetPathResolutionError(),
-		Name:                e.FieldHandlers.ResolveFileBasename(e, fe),
+		Name:                name,
 		Inode:               createNumPointer(inode),
 		MountID:             createNumPointer(fe.MountID),
 		Filesystem:          e.FieldHandlers.ResolveFileFilesystem(e, fe),
@@ -684,9 +758,11 @@ func newFileSerializer(fe *model.FileEvent, e *model.Event, forceInode ...uint64
 		PackageName:         e.FieldHandlers.ResolvePackageName(e, fe),
 		PackageVersion:      e.FieldHandlers.ResolvePackageVersion(e, fe),
 		HashState:           fe.HashState.String(),
-		MountPath:           fe.MountPath,
+		MountPath:           anonymizePath(e, fe.MountPath),
 		MountSource:         model.MountSourceToString(fe.MountSource),
 		MountOrigin:         model.MountOriginToString(fe.MountOrigin),
+		LayerPath:           anonymizePath(e, e.FieldHandlers.ResolveFileLayerPath(e, fe)),
+		LayerDigest:         e.FieldHandlers.ResolveFileLayerDigest(e, fe),
 	}
 
 	// lazy hash serialization: we don't want to hash files for every event
@@ -699,6 +775,22 @@ func newFileSerializer(fe *model.FileEvent, e *model.Event, forceInode ...uint64
 	return fs
 }
 
+// newCGroupUsageSerializer returns the usage of the cgroup, nil when the cgroup usage enrichment is disabled
+func newCGroupUsageSerializer(e *model.Event, cgroup *model.CGroupContext) *CGroupUsageSerializer {
+	memoryCurrent := e.FieldHandlers.ResolveCGroupMemoryCurrent(e, cgroup)
+	pidsCurrent := e.FieldHandlers.ResolveCGroupPidsCurrent(e, cgroup)
+	if memoryCurrent == 0 && pidsCurrent == 0 {
+		return nil
+	}
+
+	return &CGroupUsageSerializer{
+		MemoryCurrent:      uint64(memoryCurrent),
+		MemoryHighExceeded: e.FieldHandlers.ResolveCGroupMemoryHighExceeded(e, cgroup),
+		PidsCurrent:        uint64(pidsCurrent),
+		CPUUsage:           uint64(e.FieldHandlers.ResolveCGroupCPUUsage(e, cgroup)),
+	}
+}
+
 func newCredentialsSerializer(ce *model.Credentials) *CredentialsSerializer {
 	return &CredentialsSerializer{
 		UID:          int(ce.UID),
@@ -719,13 +811,38 @@ func newCredentialsSerializer(ce *model.Credentials) *CredentialsSerializer {
 	}
 }
 
+func newCloudCredentialsSerializer(creds *model.CloudCredentials) *CloudCredentialsSerializer {
+	return &CloudCredentialsSerializer{
+		CloudProvider: creds.CloudProvider,
+		Type:          creds.Type,
+		Resource:      creds.Resource,
+		Fingerprint:   creds.ID,
+		Expiration:    utils.NewEasyjsonTimeIfNotZero(creds.Expiration),
+	}
+}
+
+func newNamespacesSerializer(ps *model.Process, e *model.Event) *NamespacesSerializer {
+	ns := &NamespacesSerializer{
+		PID:  uint32(e.FieldHandlers.ResolveProcessPIDNamespace(e, ps)),
+		User: uint32(e.FieldHandlers.ResolveProcessUserNamespace(e, ps)),
+		Mnt:  uint32(e.FieldHandlers.ResolveProcessMountNamespace(e, ps)),
+		UTS:  uint32(e.FieldHandlers.ResolveProcessUTSNamespace(e, ps)),
+		Net:  ps.NetNS,
+	}
+	if *ns == (NamespacesSerializer{}) {
+		return nil
+	}
+	return ns
+}
+
 func newProcessSerializer(ps *model.Process, e *model.Event) *ProcessSerializer {
 	if ps.IsNotKworker() {
-		argv := e.FieldHandlers.ResolveProcessArgvScrubbed(e, ps)
+		argv := anonymizeArgs(e, e.FieldHandlers.ResolveProcessArgvScrubbed(e, ps))
 		argvTruncated := e.FieldHandlers.ResolveProcessArgsTruncated(e, ps)
-		envs := e.FieldHandlers.ResolveProcessEnvs(e, ps)
+		envs := anonymizeEnvs(e, e.FieldHandlers.ResolveProcessEnvs(e, ps))
 		envsTruncated := e.FieldHandlers.ResolveProcessEnvsTruncated(e, ps)
 		argv0, _ := sprocess.GetProcessArgv0(ps)
+		argv0 = anonymizePath(e, argv0)
 
 		psSerializer := &ProcessSerializer{
 			ForkTime: utils.NewEasyjsonTimeIfNotZero(ps.ForkTime),
@@ -735,6 +852,7 @@ func newProcessSerializer(ps *model.Process, e *model.Event) *ProcessSerializer
 			Pid:           ps.Pid,
 			Tid:           ps.Tid,
 			PPid:          createNumPointer(ps.PPid),
+			Identity:      ps.Identity,
 			Comm:          ps.Comm,
 			TTY:           ps.TTYName,
 			Executable:    newFileSerializer(&ps.FileEvent, e),
@@ -747,12 +865,22 @@ func newProcessSerializer(ps *model.Process, e *model.Event) *ProcessSerializer
 			IsKworker:     ps.IsKworker,
 			IsExecExec:    ps.IsExecExec,
 			Source:        model.ProcessSourceToString(ps.Source),
+			SystemdUnit:   e.FieldHandlers.ResolveProcessSystemdUnit(e, ps),
+			SystemdSlice:  e.FieldHandlers.ResolveProcessSystemdSlice(e, ps),
 		}
 
 		if ps.HasInterpreter() {
 			psSerializer.Interpreter = newFileSerializer(&ps.LinuxBinprm.FileEvent, e)
 		}
 
+		for _, symlink := range ps.SymlinkPathnameStr {
+			if symlink != "" {
+				psSerializer.Symlinks = append(psSerializer.Symlinks, anonymizePath(e, symlink))
+			}
+		}
+
+		psSerializer.Namespaces = newNamespacesSerializer(ps, e)
+
 		credsSerializer := newCredentialsSerializer(&ps.Credentials)
 		// Populate legacy user / group fields
 		psSerializer.UID = credsSerializer.UID
@@ -767,10 +895,11 @@ func newProcessSerializer(ps *model.Process, e *model.Event) *ProcessSerializer
 			psSerializer.UserSession = newUserSessionContextSerializer(&ps.UserSession, e)
 		}
 
-		awsSecurityCredentials := e.FieldHandlers.ResolveAWSSecurityCredentials(e)
-		if len(awsSecurityCredentials) > 0 {
-			for _, creds := range awsSecurityCredentials {
-				psSerializer.AWSSecurityCredentials = append(psSerializer.AWSSecurityCredentials, newAWSSecurityCredentialsSerializer(&creds))
+		for _, creds := range e.FieldHandlers.ResolveCloudCredentials(e) {
+			if creds.CloudProvider == model.IMDSAWSCloudProvider {
+				psSerializer.AWSSecurityCredentials = append(psSerializer.AWSSecurityCredentials, newAWSSecurityCredentialsSerializer(&creds.AWS))
+			} else {
+				psSerializer.CloudCredentials = append(psSerializer.CloudCredentials, newCloudCredentialsSerializer(&creds))
 			}
 		}
 
@@ -865,11 +994,63 @@ func newBPFProgramSerializer(e *model.Event) *BPFProgramSerializer {
 	}
 }
 
+// PathAnonymizerProvider is implemented by the field handlers providing the anonymizer applied to the paths of the
+// serialized events
+type PathAnonymizerProvider interface {
+	GetPathAnonymizer() *spath.Anonymizer
+}
+
+// pathAnonymizerOf returns the path anonymizer of the event, nil when the paths are left untouched
+func pathAnonymizerOf(e *model.Event) *spath.Anonymizer {
+	if provider, ok := e.FieldHandlers.(PathAnonymizerProvider); ok {
+		return provider.GetPathAnonymizer()
+	}
+	return nil
+}
+
+// anonymizePath returns the path with its user identifying components redacted
+func anonymizePath(e *model.Event, path string) string {
+	return pathAnonymizerOf(e).Anonymize(path)
+}
+
+// anonymizeFilePath returns the anonymized path of a file along with its basename, the latter being redacted too
+// when it was one of the anonymized components of the path
+func anonymizeFilePath(e *model.Event, path string, name string) (string, string) {
+	anonymized := anonymizePath(e, path)
+	if anonymized == path || name == "" || !strings.HasSuffix(path, "/"+name) {
+		return anonymized, name
+	}
+	return anonymized, anonymized[strings.LastIndexByte(anonymized, '/')+1:]
+}
+
+// anonymizeArgs returns the arguments with the user identifying components of the paths they embed redacted
+func anonymizeArgs(e *model.Event, args []string) []string {
+	return pathAnonymizerOf(e).AnonymizeAll(args)
+}
+
+// anonymizeEnvs returns the environment variables with the user identifying components of the paths embedded in
+// their values redacted
+func anonymizeEnvs(e *model.Event, envs []string) []string {
+	return pathAnonymizerOf(e).AnonymizeEnvs(envs)
+}
+
+func newKernelSecuritySerializer(e *model.Event, ctx *model.KernelSecurityContext) *KernelSecuritySerializer {
+	s := &KernelSecuritySerializer{
+		Lockdown:   e.FieldHandlers.ResolveKernelLockdown(e, ctx),
+		SecureBoot: e.FieldHandlers.ResolveKernelSecureBoot(e, ctx),
+	}
+	if s.Lockdown == "" && s.SecureBoot == "" {
+		return nil
+	}
+	return s
+}
+
 func newBPFEventSerializer(e *model.Event) *BPFEventSerializer {
 	return &BPFEventSerializer{
-		Cmd:     model.BPFCmd(e.BPF.Cmd).String(),
-		Map:     newBPFMapSerializer(e),
-		Program: newBPFProgramSerializer(e),
+		Cmd:            model.BPFCmd(e.BPF.Cmd).String(),
+		Map:            newBPFMapSerializer(e),
+		Program:        newBPFProgramSerializer(e),
+		KernelSecurity: newKernelSecuritySerializer(e, &e.BPF.KernelSecurity),
 	}
 }
 
@@ -889,6 +1070,8 @@ func newMProtectEventSerializer(e *model.Event) *MProtectEventSerializer {
 		VMEnd:         fmt.Sprintf("0x%x", e.MProtect.VMEnd),
 		VMProtection:  model.VMFlag(e.MProtect.VMProtection).String(),
 		ReqProtection: model.VMFlag(e.MProtect.ReqProtection).String(),
+		Size:          e.MProtect.Size,
+		IsAnonymous:   e.MProtect.IsAnonymous,
 	}
 }
 
@@ -908,6 +1091,7 @@ func newLoadModuleEventSerializer(e *model.Event) *ModuleEventSerializer {
 		LoadedFromMemory: &loadedFromMemory,
 		Argv:             e.FieldHandlers.ResolveModuleArgv(e, &e.LoadModule),
 		ArgsTruncated:    &argsTruncated,
+		KernelSecurity:   newKernelSecuritySerializer(e, &e.LoadModule.KernelSecurity),
 	}
 }
 
@@ -960,12 +1144,12 @@ func newMountEventSerializer(e *model.Event) *MountEventSerializer {
 
 	mountSerializer := &MountEventSerializer{
 		MountPoint: &FileSerializer{
-			Path:    e.GetMountRootPath(),
+			Path:    anonymizePath(e, e.GetMountRootPath()),
 			MountID: createNumPointer(e.Mount.ParentPathKey.MountID),
 			Inode:   createNumPointer(e.Mount.ParentPathKey.Inode),
 		},
 		Root: &FileSerializer{
-			Path:    e.GetMountMountpointPath(),
+			Path:    anonymizePath(e, e.GetMountMountpointPath()),
 			MountID: createNumPointer(e.Mount.RootPathKey.MountID),
 			Inode:   createNumPointer(e.Mount.RootPathKey.Inode),
 		},
@@ -974,8 +1158,8 @@ func newMountEventSerializer(e *model.Event) *MountEventSerializer {
 		BindSrcMountID:  e.Mount.BindSrcMountID,
 		Device:          e.Mount.Device,
 		FSType:          e.Mount.GetFSType(),
-		MountPointPath:  mountPointPath,
-		MountSourcePath: mountSourcePath,
+		MountPointPath:  anonymizePath(e, mountPointPath),
+		MountSourcePath: anonymizePath(e, mountSourcePath),
 	}
 
 	// potential errors retrieved from ResolveMountPointPath and ResolveMountSourcePath
@@ -1085,27 +1269,62 @@ type DDContextSerializer struct {
 	SpanID string `json:"span_id,omitempty"`
 	// Trace ID used for APM correlation
 	TraceID string `json:"trace_id,omitempty"`
+	// Service of the process instrumented by an APM tracer
+	Service string `json:"service,omitempty"`
+	// Environment of the process instrumented by an APM tracer
+	Env string `json:"env,omitempty"`
+	// Version of the process instrumented by an APM tracer
+	Version string `json:"version,omitempty"`
+}
+
+const (
+	apmServiceEnvVar  = "DD_SERVICE"
+	apmEnvEnvVar      = "DD_ENV"
+	apmVersionEnvVar  = "DD_VERSION"
+	apmAgentURLEnvVar = "DD_TRACE_AGENT_URL"
+)
+
+func hasSpanContext(spanID uint64, traceID mathutil.Int128) bool {
+	return spanID != 0 && (traceID.Hi != 0 || traceID.Lo != 0)
+}
+
+// hasActiveTracer returns whether the process is instrumented by an APM tracer, either because the tracer
+// registered its span context or because the process is configured to report to a trace agent
+func hasActiveTracer(pce *model.ProcessCacheEntry) bool {
+	if hasSpanContext(pce.SpanID, pce.TraceID) {
+		return true
+	}
+	return pce.EnvsEntry != nil && pce.EnvsEntry.Get(apmAgentURLEnvVar) != ""
 }
 
 func newDDContextSerializer(e *model.Event) *DDContextSerializer {
 	s := &DDContextSerializer{}
-	if e.SpanContext.SpanID != 0 && (e.SpanContext.TraceID.Hi != 0 || e.SpanContext.TraceID.Lo != 0) {
+	if hasSpanContext(e.SpanContext.SpanID, e.SpanContext.TraceID) {
 		s.SpanID = fmt.Sprint(e.SpanContext.SpanID)
 		s.TraceID = fmt.Sprintf("%x%x", e.SpanContext.TraceID.Hi, e.SpanContext.TraceID.Lo)
-		return s
 	}
 
 	ctx := eval.NewContext(e)
 	it := &model.ProcessAncestorsIterator{}
 	ptr := it.Front(ctx)
 
-	for ptr != nil {
+	var tracerFound bool
+	for ptr != nil && (s.TraceID == "" || !tracerFound) {
 		pce := (*model.ProcessCacheEntry)(ptr)
 
-		if pce.SpanID != 0 && (pce.TraceID.Hi != 0 || pce.TraceID.Lo != 0) {
+		if s.TraceID == "" && hasSpanContext(pce.SpanID, pce.TraceID) {
 			s.SpanID = fmt.Sprint(pce.SpanID)
 			s.TraceID = fmt.Sprintf("%x%x", pce.TraceID.Hi, pce.TraceID.Lo)
-			break
+		}
+
+		// the unified service tags of the closest instrumented process are used to link the event to its APM service
+		if !tracerFound && hasActiveTracer(pce) {
+			tracerFound = true
+			if pce.EnvsEntry != nil {
+				s.Service = pce.EnvsEntry.Get(apmServiceEnvVar)
+				s.Env = pce.EnvsEntry.Get(apmEnvEnvVar)
+				s.Version = pce.EnvsEntry.Get(apmVersionEnvVar)
+			}
 		}
 
 		ptr = it.Next()
@@ -1129,11 +1348,13 @@ func newSecurityProfileContextSerializer(event *model.Event, e *model.SecurityPr
 	tags := make([]string, len(e.Tags))
 	copy(tags, e.Tags)
 	return &SecurityProfileContextSerializer{
-		Name:           e.Name,
-		Version:        e.Version,
-		Tags:           tags,
-		EventInProfile: event.IsInProfile(),
-		EventTypeState: e.EventTypeState.String(),
+		Name:              e.Name,
+		Version:           e.Version,
+		Tags:              tags,
+		EventInProfile:    event.IsInProfile(),
+		EventTypeState:    e.EventTypeState.String(),
+		ActivityDumpName:  e.ActivityDumpName,
+		ActivityDumpFiles: slices.Clone(e.ActivityDumpFiles),
 	}
 }
 
@@ -1166,6 +1387,7 @@ func NewEventSerializer(event *model.Event, opts *eval.Opts) *EventSerializer {
 		DDContextSerializer:   newDDContextSerializer(event),
 	}
 	s.Async = event.FieldHandlers.ResolveAsync(event)
+	s.PreSnapshot = event.IsPreSnapshot()
 
 	if !event.NetworkContext.IsZero() {
 		s.NetworkContextSerializer = newNetworkContextSerializer(event, &event.NetworkContext)
@@ -1181,6 +1403,14 @@ func NewEventSerializer(event *model.Event, opts *eval.Opts) *EventSerializer {
 			CreatedAt: utils.NewEasyjsonTimeIfNotZero(time.Unix(0, int64(ctx.CreatedAt))),
 			Variables: newVariablesContext(event, opts, "container."),
 		}
+		if metadata := ctx.GetMetadata(); metadata != nil {
+			s.ContainerContextSerializer.ImageName = metadata.ImageName
+			s.ContainerContextSerializer.ImageDigest = metadata.ImageDigest
+			s.ContainerContextSerializer.PodName = metadata.PodName
+			s.ContainerContextSerializer.PodNamespace = metadata.PodNamespace
+			s.ContainerContextSerializer.PodUID = metadata.PodUID
+			s.ContainerContextSerializer.SandboxID = metadata.SandboxID
+		}
 	}
 
 	if cgroupID := event.FieldHandlers.ResolveCGroupID(event, &event.CGroupContext); cgroupID != "" {
@@ -1188,6 +1418,7 @@ func NewEventSerializer(event *model.Event, opts *eval.Opts) *EventSerializer {
 		s.CGroupContextSerializer = &CGroupContextSerializer{
 			ID:      string(event.CGroupContext.CGroupID),
 			Manager: manager,
+			Usage:   newCGroupUsageSerializer(event, &event.CGroupContext),
 		}
 	}
 
@@ -0,0 +1,36 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package serializers holds serializers related files
+package serializers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
+)
+
+func TestPreSnapshotEvent(t *testing.T) {
+	event := model.NewFakeEvent()
+	event.Type = uint32(model.ExecEventType)
+	event.ProcessCacheEntry = model.NewPlaceholderProcessCacheEntry(1, 1, false)
+	event.ProcessContext = &event.ProcessCacheEntry.ProcessContext
+
+	s := NewEventSerializer(event, nil)
+	assert.False(t, s.PreSnapshot)
+
+	// the events handled before the end of the snapshot of the processes are flagged
+	event.AddToFlags(model.EventFlagsPreSnapshot)
+	assert.True(t, event.IsPreSnapshot())
+
+	s = NewEventSerializer(event, nil)
+	assert.True(t, s.PreSnapshot)
+
+	data, err := MarshalEvent(event, nil)
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), `"pre_snapshot":true`)
+}
@@ -44,6 +44,8 @@ type RegistrySerializer struct {
 	KeyPath string `json:"key_path,omitempty"`
 	// Value name of the key value
 	ValueName string `json:"value_name,omitempty"`
+	// Data of the key value
+	ValueData string `json:"value_data,omitempty"`
 }
 
 // ChangePermissionSerializer serializes a permission change to JSON
@@ -62,6 +64,38 @@ type ChangePermissionSerializer struct {
 	NewSd string `json:"new_sd,omitempty"`
 }
 
+// NamedPipeSerializer serializes a named pipe to JSON
+type NamedPipeSerializer struct {
+	// Named pipe name
+	Name string `json:"name,omitempty"`
+	// Named pipe path
+	Path string `json:"path,omitempty"`
+	// Server process of the named pipe
+	Server *NamedPipeServerSerializer `json:"server,omitempty"`
+}
+
+// NamedPipeServerSerializer serializes the server endpoint of a named pipe to JSON
+type NamedPipeServerSerializer struct {
+	// Process ID of the server
+	Pid uint32 `json:"pid,omitempty"`
+	// Executable path of the server
+	Path string `json:"path,omitempty"`
+}
+
+// ServiceSerializer serializes a service to JSON
+type ServiceSerializer struct {
+	// Service name
+	Name string `json:"name,omitempty"`
+	// Image path of the service
+	ImagePath string `json:"image_path,omitempty"`
+	// Start type of the service
+	StartType string `json:"start_type,omitempty"`
+	// Image path of the service before the change
+	PreviousImagePath string `json:"previous_image_path,omitempty"`
+	// Start type of the service before the change
+	PreviousStartType string `json:"previous_start_type,omitempty"`
+}
+
 // ProcessSerializer serializes a process to JSON
 type ProcessSerializer struct {
 	// Process ID
@@ -108,6 +142,8 @@ type EventSerializer struct {
 	*RegistryEventSerializer         `json:"registry,omitempty"`
 	*UserContextSerializer           `json:"usr,omitempty"`
 	*ChangePermissionEventSerializer `json:"permission_change,omitempty"`
+	*NamedPipeSerializer             `json:"named_pipe,omitempty"`
+	*ServiceSerializer               `json:"service,omitempty"`
 }
 
 func newFileSerializer(fe *model.FileEvent, e *model.Event, _ ...uint64) *FileSerializer {
@@ -140,8 +176,12 @@ func newRegistrySerializer(re *model.RegistryEvent, e *model.Event, _ ...uint64)
 		KeyName: re.KeyName,
 		KeyPath: re.KeyPath,
 	}
-	if model.EventType(e.Type) == model.SetRegistryKeyValueEventType {
+	switch model.EventType(e.Type) {
+	case model.SetRegistryKeyValueEventType:
 		rs.ValueName = e.SetRegistryKeyValue.ValueName
+		rs.ValueData = e.SetRegistryKeyValue.ValueData
+	case model.DeleteRegistryKeyValueEventType:
+		rs.ValueName = e.DeleteRegistryKeyValue.ValueName
 	}
 	return rs
 }
@@ -261,6 +301,10 @@ func NewEventSerializer(event *model.Event, opts *eval.Opts) *EventSerializer {
 		s.RegistryEventSerializer = &RegistryEventSerializer{
 			RegistrySerializer: *newRegistrySerializer(&event.DeleteRegistryKey.Registry, event),
 		}
+	case model.DeleteRegistryKeyValueEventType:
+		s.RegistryEventSerializer = &RegistryEventSerializer{
+			RegistrySerializer: *newRegistrySerializer(&event.DeleteRegistryKeyValue.Registry, event),
+		}
 	case model.ChangePermissionEventType:
 		s.ChangePermissionEventSerializer = &ChangePermissionEventSerializer{
 			ChangePermissionSerializer: ChangePermissionSerializer{
@@ -272,6 +316,36 @@ func NewEventSerializer(event *model.Event, opts *eval.Opts) *EventSerializer {
 				NewSd:      event.FieldHandlers.ResolveNewSecurityDescriptor(event, &event.ChangePermission),
 			},
 		}
+	case model.CreateNamedPipeEventType:
+		s.NamedPipeSerializer = &NamedPipeSerializer{
+			Name: event.CreateNamedPipe.Pipe.Name,
+			Path: event.CreateNamedPipe.Pipe.Path,
+		}
+	case model.ConnectNamedPipeEventType:
+		s.NamedPipeSerializer = &NamedPipeSerializer{
+			Name: event.ConnectNamedPipe.Pipe.Name,
+			Path: event.ConnectNamedPipe.Pipe.Path,
+		}
+		if event.ConnectNamedPipe.ServerPid != 0 {
+			s.NamedPipeSerializer.Server = &NamedPipeServerSerializer{
+				Pid:  event.ConnectNamedPipe.ServerPid,
+				Path: event.ConnectNamedPipe.ServerPath,
+			}
+		}
+	case model.CreateServiceEventType:
+		s.ServiceSerializer = &ServiceSerializer{
+			Name:      event.CreateService.Name,
+			ImagePath: event.CreateService.ImagePath,
+			StartType: event.CreateService.StartType,
+		}
+	case model.ModifyServiceEventType:
+		s.ServiceSerializer = &ServiceSerializer{
+			Name:              event.ModifyService.Name,
+			ImagePath:         event.ModifyService.ImagePath,
+			StartType:         event.ModifyService.StartType,
+			PreviousImagePath: event.ModifyService.PreviousImagePath,
+			PreviousStartType: event.ModifyService.PreviousStartType,
+		}
 	case model.ExecEventType:
 		s.FileEventSerializer = &FileEventSerializer{
 			FileSerializer: *newFileSerializer(&event.ProcessContext.Process.FileEvent, event),
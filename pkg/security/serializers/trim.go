@@ -0,0 +1,28 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package serializers
+
+// TrimmedField defines a field stripped from the events exceeding the size budget
+type TrimmedField string
+
+const (
+	// TrimmedEnvs defines the environment variables of the process and of its ancestors
+	TrimmedEnvs TrimmedField = "envs"
+	// TrimmedAncestorArgs defines the arguments of the parent and of the ancestors of the process
+	TrimmedAncestorArgs TrimmedField = "ancestor_args"
+	// TrimmedSymlinks defines the paths of the symlinks pointing to the executables of the process and of its ancestors
+	TrimmedSymlinks TrimmedField = "symlinks"
+	// TrimmedAncestors defines the ancestors of the process, the parent being kept
+	TrimmedAncestors TrimmedField = "ancestors"
+)
+
+// TrimmedFields lists, in stripping order, the fields stripped from the events exceeding the size budget
+var TrimmedFields = []TrimmedField{
+	TrimmedEnvs,
+	TrimmedAncestorArgs,
+	TrimmedSymlinks,
+	TrimmedAncestors,
+}
@@ -0,0 +1,44 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux || windows
+
+// Package serializers holds serializers related files
+package serializers
+
+// CompactAncestors reduces the parent and the ancestors of the process to their pid, executable path and container,
+// the process itself keeping all its fields
+func (e *EventSerializer) CompactAncestors() {
+	if e.BaseEventSerializer == nil || e.ProcessContextSerializer == nil {
+		return
+	}
+
+	// the parent is also the first ancestor, the entries are compacted in place so that both stay the same entry
+	for _, ps := range e.ProcessContextSerializer.processes()[1:] {
+		compact := ProcessSerializer{
+			Pid:       ps.Pid,
+			Container: ps.Container,
+		}
+		if ps.Executable != nil {
+			compact.Executable = &FileSerializer{Path: ps.Executable.Path}
+		}
+		*ps = compact
+	}
+}
+
+// processes returns the process, its parent and its ancestors, the process first
+func (pc *ProcessContextSerializer) processes() []*ProcessSerializer {
+	processes := make([]*ProcessSerializer, 0, len(pc.Ancestors)+2)
+	processes = append(processes, pc.ProcessSerializer)
+	if pc.Parent != nil {
+		processes = append(processes, pc.Parent)
+	}
+	for _, ancestor := range pc.Ancestors {
+		if ancestor != nil {
+			processes = append(processes, ancestor)
+		}
+	}
+	return processes
+}
@@ -0,0 +1,50 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package serializers holds serializers related files
+package serializers
+
+// Trim strips the provided field from the event. It returns false if the event doesn't hold this field.
+func (e *EventSerializer) Trim(field TrimmedField) bool {
+	if e.BaseEventSerializer == nil || e.ProcessContextSerializer == nil {
+		return false
+	}
+	pc := e.ProcessContextSerializer
+
+	var trimmed bool
+	switch field {
+	case TrimmedEnvs:
+		for _, ps := range pc.processes() {
+			if len(ps.Envs) > 0 {
+				ps.Envs = nil
+				ps.EnvsTruncated = true
+				trimmed = true
+			}
+		}
+	case TrimmedAncestorArgs:
+		for _, ps := range pc.processes()[1:] {
+			if len(ps.Args) > 0 {
+				ps.Args = nil
+				ps.ArgsTruncated = true
+				trimmed = true
+			}
+		}
+	case TrimmedSymlinks:
+		for _, ps := range pc.processes() {
+			if len(ps.Symlinks) > 0 {
+				ps.Symlinks = nil
+				trimmed = true
+			}
+		}
+	case TrimmedAncestors:
+		if len(pc.Ancestors) > 0 {
+			pc.Ancestors = nil
+			pc.TruncatedAncestors = true
+			trimmed = true
+		}
+	}
+
+	return trimmed
+}
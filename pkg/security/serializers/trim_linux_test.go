@@ -0,0 +1,104 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package serializers holds serializers related files
+package serializers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrim(t *testing.T) {
+	newProcess := func(name string) *ProcessSerializer {
+		return &ProcessSerializer{
+			Args:     []string{name, "--verbose"},
+			Envs:     []string{"HOME", "PATH"},
+			Symlinks: []string{"/bin/" + name, "/usr/bin/" + name},
+		}
+	}
+
+	process, parent, ancestor := newProcess("bash"), newProcess("sshd"), newProcess("systemd")
+	event := &EventSerializer{
+		BaseEventSerializer: &BaseEventSerializer{
+			ProcessContextSerializer: &ProcessContextSerializer{
+				ProcessSerializer: process,
+				Parent:            parent,
+				Ancestors:         []*ProcessSerializer{parent, ancestor},
+			},
+		},
+	}
+
+	assert.True(t, event.Trim(TrimmedEnvs))
+	assert.False(t, event.Trim(TrimmedEnvs))
+	for _, ps := range []*ProcessSerializer{process, parent, ancestor} {
+		assert.Empty(t, ps.Envs)
+		assert.True(t, ps.EnvsTruncated)
+	}
+
+	// the arguments of the process itself are kept
+	assert.True(t, event.Trim(TrimmedAncestorArgs))
+	assert.Equal(t, []string{"bash", "--verbose"}, process.Args)
+	assert.False(t, process.ArgsTruncated)
+	assert.Empty(t, parent.Args)
+	assert.True(t, ancestor.ArgsTruncated)
+
+	assert.True(t, event.Trim(TrimmedSymlinks))
+	assert.False(t, event.Trim(TrimmedSymlinks))
+	for _, ps := range []*ProcessSerializer{process, parent, ancestor} {
+		assert.Empty(t, ps.Symlinks)
+	}
+
+	// the parent is kept
+	assert.True(t, event.Trim(TrimmedAncestors))
+	assert.Empty(t, event.Ancestors)
+	assert.True(t, event.TruncatedAncestors)
+	assert.Equal(t, parent, event.Parent)
+
+	assert.False(t, (&EventSerializer{}).Trim(TrimmedAncestors))
+}
+
+func TestCompactAncestors(t *testing.T) {
+	newProcess := func(pid uint32, path string) *ProcessSerializer {
+		return &ProcessSerializer{
+			Pid:        pid,
+			Comm:       path,
+			Args:       []string{path, "--verbose"},
+			Envs:       []string{"HOME", "PATH"},
+			Executable: &FileSerializer{Path: path, Name: path},
+			Container:  &ContainerContextSerializer{ID: "0123456789abcdef"},
+		}
+	}
+
+	process, parent, ancestor := newProcess(3, "/bin/bash"), newProcess(2, "/usr/sbin/sshd"), newProcess(1, "/sbin/init")
+	event := &EventSerializer{
+		BaseEventSerializer: &BaseEventSerializer{
+			ProcessContextSerializer: &ProcessContextSerializer{
+				ProcessSerializer: process,
+				Parent:            parent,
+				Ancestors:         []*ProcessSerializer{parent, ancestor},
+			},
+		},
+	}
+
+	event.CompactAncestors()
+
+	// the process itself is kept as is
+	assert.Equal(t, newProcess(3, "/bin/bash"), process)
+
+	for _, ps := range []*ProcessSerializer{parent, ancestor} {
+		assert.Empty(t, ps.Args)
+		assert.Empty(t, ps.Envs)
+		assert.Empty(t, ps.Comm)
+		assert.Equal(t, "0123456789abcdef", ps.Container.ID)
+	}
+	assert.Equal(t, uint32(2), event.Parent.Pid)
+	assert.Equal(t, &FileSerializer{Path: "/usr/sbin/sshd"}, event.Parent.Executable)
+	assert.Equal(t, event.Parent, event.Ancestors[0])
+	assert.Equal(t, &FileSerializer{Path: "/sbin/init"}, event.Ancestors[1].Executable)
+
+	(&EventSerializer{}).CompactAncestors()
+}
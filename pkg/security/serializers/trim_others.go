@@ -0,0 +1,18 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build !linux && !windows
+
+// Package serializers holds serializers related files
+package serializers
+
+// Trim strips the provided field from the event. The events of this platform don't hold any of the trimmed fields.
+func (e *EventSerializer) Trim(_ TrimmedField) bool {
+	return false
+}
+
+// CompactAncestors reduces the ancestors of the process to a compact form. The events of this platform don't hold
+// any ancestor.
+func (e *EventSerializer) CompactAncestors() {}
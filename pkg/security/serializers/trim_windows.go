@@ -0,0 +1,24 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package serializers holds serializers related files
+package serializers
+
+// Trim strips the provided field from the event. It returns false if the event doesn't hold this field.
+func (e *EventSerializer) Trim(field TrimmedField) bool {
+	if e.BaseEventSerializer == nil || e.ProcessContextSerializer == nil {
+		return false
+	}
+	pc := e.ProcessContextSerializer
+
+	// windows processes don't report their arguments and environment variables separately
+	if field == TrimmedAncestors && len(pc.Ancestors) > 0 {
+		pc.Ancestors = nil
+		pc.TruncatedAncestors = true
+		return true
+	}
+
+	return false
+}
@@ -62,6 +62,7 @@ func TestChmod(t *testing.T) {
 			assertRights(t, uint16(event.Chmod.Mode), 0o707)
 			assertInode(t, getInode(t, testFile), event.Chmod.File.Inode)
 			assertRights(t, event.Chmod.File.Mode, expectedMode, "wrong initial mode")
+			assertRights(t, uint16(event.Chmod.PreviousMode), expectedMode, "wrong previous mode")
 			assertNearTime(t, event.Chmod.File.MTime)
 			assertNearTime(t, event.Chmod.File.CTime)
 
@@ -90,6 +90,8 @@ func TestChown(t *testing.T) {
 			assertRights(t, event.Chown.File.Mode, uint16(expectedMode), "wrong initial mode")
 			assert.Equal(t, uint32(prevUID), event.Chown.File.UID, "wrong initial user")
 			assert.Equal(t, uint32(prevGID), event.Chown.File.GID, "wrong initial group")
+			assert.Equal(t, int64(prevUID), event.Chown.PreviousUID, "wrong previous user")
+			assert.Equal(t, int64(prevGID), event.Chown.PreviousGID, "wrong previous group")
 			assertNearTime(t, event.Chown.File.MTime)
 			assertNearTime(t, event.Chown.File.CTime)
 
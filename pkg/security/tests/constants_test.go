@@ -23,12 +23,25 @@ var BTFHubVsRcPossiblyMissingConstants = []string{
 	constantfetch.OffsetNameNFConnStructCTNet,
 	constantfetch.OffsetNameIoKiocbStructCtx,
 	constantfetch.OffsetNameMountMntID,
+	constantfetch.OffsetNameMntNamespaceStructNS,
 }
 
 var RCVsFallbackPossiblyMissingConstants = []string{
 	constantfetch.OffsetNameIoKiocbStructCtx,
 	constantfetch.OffsetNameTaskStructPID,
 	constantfetch.OffsetNameTaskStructPIDLink,
+	constantfetch.OffsetNameTaskStructStartBoottime,
+	constantfetch.OffsetNameTaskStructNsproxy,
+	constantfetch.OffsetNameTaskStructCred,
+	constantfetch.OffsetNameCredStructUserNS,
+	constantfetch.OffsetNameNsproxyStructUTSNS,
+	constantfetch.OffsetNameNsproxyStructMntNS,
+	constantfetch.OffsetNameUPIDStructNS,
+	constantfetch.OffsetNamePIDNamespaceStructNS,
+	constantfetch.OffsetNameUserNamespaceStructNS,
+	constantfetch.OffsetNameMntNamespaceStructNS,
+	constantfetch.OffsetNameUTSNamespaceStructNS,
+	constantfetch.OffsetNameNSCommonStructInum,
 	constantfetch.OffsetNameDeviceStructNdNet,
 	constantfetch.OffsetNameMountMntID,
 }
@@ -37,6 +50,18 @@ var BTFHubVsFallbackPossiblyMissingConstants = []string{
 	constantfetch.OffsetNameNFConnStructCTNet,
 	constantfetch.OffsetNameTaskStructPID,
 	constantfetch.OffsetNameTaskStructPIDLink,
+	constantfetch.OffsetNameTaskStructStartBoottime,
+	constantfetch.OffsetNameTaskStructNsproxy,
+	constantfetch.OffsetNameTaskStructCred,
+	constantfetch.OffsetNameCredStructUserNS,
+	constantfetch.OffsetNameNsproxyStructUTSNS,
+	constantfetch.OffsetNameNsproxyStructMntNS,
+	constantfetch.OffsetNameUPIDStructNS,
+	constantfetch.OffsetNamePIDNamespaceStructNS,
+	constantfetch.OffsetNameUserNamespaceStructNS,
+	constantfetch.OffsetNameMntNamespaceStructNS,
+	constantfetch.OffsetNameUTSNamespaceStructNS,
+	constantfetch.OffsetNameNSCommonStructInum,
 	constantfetch.OffsetNameDeviceStructNdNet,
 }
 
@@ -44,6 +69,18 @@ var BTFVsFallbackPossiblyMissingConstants = []string{
 	constantfetch.OffsetNameIoKiocbStructCtx,
 	constantfetch.OffsetNameTaskStructPID,
 	constantfetch.OffsetNameTaskStructPIDLink,
+	constantfetch.OffsetNameTaskStructStartBoottime,
+	constantfetch.OffsetNameTaskStructNsproxy,
+	constantfetch.OffsetNameTaskStructCred,
+	constantfetch.OffsetNameCredStructUserNS,
+	constantfetch.OffsetNameNsproxyStructUTSNS,
+	constantfetch.OffsetNameNsproxyStructMntNS,
+	constantfetch.OffsetNameUPIDStructNS,
+	constantfetch.OffsetNamePIDNamespaceStructNS,
+	constantfetch.OffsetNameUserNamespaceStructNS,
+	constantfetch.OffsetNameMntNamespaceStructNS,
+	constantfetch.OffsetNameUTSNamespaceStructNS,
+	constantfetch.OffsetNameNSCommonStructInum,
 	constantfetch.OffsetNameDeviceStructNdNet,
 }
 
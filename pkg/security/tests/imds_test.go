@@ -667,7 +667,7 @@ func TestIMDSProcessContext(t *testing.T) {
 		},
 		{
 			ID:         "test_imds_process_context",
-			Expression: `open.file.path == "{{.Root}}/test-open" && open.flags & O_CREAT != 0`,
+			Expression: `open.file.path == "{{.Root}}/test-open" && open.flags & O_CREAT != 0 && process.cloud_credentials.providers in ["aws"]`,
 		},
 		// check dumps
 	}
@@ -723,9 +723,10 @@ func TestIMDSProcessContext(t *testing.T) {
 			assertTriggeredRule(t, rule, "test_imds_process_context")
 
 			// check if the process has the correct IMDS credentials context
-			assert.NotNil(t, event.ProcessCacheEntry.Process.AWSSecurityCredentials, "empty IMDS context")
-			if len(event.ProcessCacheEntry.Process.AWSSecurityCredentials) > 0 {
-				creds := event.ProcessCacheEntry.Process.AWSSecurityCredentials[0]
+			assert.NotNil(t, event.ProcessCacheEntry.Process.CloudCredentials, "empty IMDS context")
+			if len(event.ProcessCacheEntry.Process.CloudCredentials) > 0 {
+				assert.Equal(t, model.IMDSAWSCloudProvider, event.ProcessCacheEntry.Process.CloudCredentials[0].CloudProvider, "wrong IMDS context cloud provider")
+				creds := event.ProcessCacheEntry.Process.CloudCredentials[0].AWS
 				assert.Equal(t, testutils.AWSSecurityCredentialsTypeTestValue, creds.Type, "wrong IMDS context AWS Security Credentials Type")
 				assert.Equal(t, testutils.AWSSecurityCredentialsExpirationTestValue, creds.ExpirationRaw, "wrong IMDS context AWS Security Credentials ExpirationRaw")
 				assert.Equal(t, testutils.AWSSecurityCredentialsAccessKeyIDTestValue, creds.AccessKeyID, "wrong IMDS context AWS Security Credentials AccessKeyID")
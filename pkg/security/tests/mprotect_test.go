@@ -28,6 +28,10 @@ func TestMProtectEvent(t *testing.T) {
 			ID:         "test_mprotect",
 			Expression: `(mprotect.vm_protection & (VM_READ|VM_WRITE)) == (VM_READ|VM_WRITE) && (mprotect.req_protection & (VM_READ|VM_WRITE|VM_EXEC)) == (VM_READ|VM_WRITE|VM_EXEC) && process.file.name == "testsuite"`,
 		},
+		{
+			ID:         "test_mprotect_anonymous",
+			Expression: `mprotect.is_anonymous && mprotect.size >= 65536 && (mprotect.vm_protection & VM_WRITE) == 0 && (mprotect.req_protection & VM_EXEC) > 0 && process.file.name == "testsuite"`,
+		},
 	}
 
 	test, err := newTestModule(t, nil, ruleDefs)
@@ -65,4 +69,36 @@ func TestMProtectEvent(t *testing.T) {
 			test.validateMProtectSchema(t, event)
 		})
 	})
+
+	t.Run("mprotect-anonymous", func(t *testing.T) {
+		size := 16 * os.Getpagesize()
+		if size < 65536 {
+			size = 65536
+		}
+
+		var data []byte
+		defer func() {
+			if data != nil {
+				_ = unix.Munmap(data)
+			}
+		}()
+
+		test.WaitSignal(t, func() error {
+			data, err = unix.Mmap(-1, 0, size, unix.PROT_READ, unix.MAP_PRIVATE|unix.MAP_ANON)
+			if err != nil {
+				return fmt.Errorf("couldn't memory segment: %w", err)
+			}
+
+			if err = unix.Mprotect(data, unix.PROT_READ|unix.PROT_EXEC); err != nil {
+				return fmt.Errorf("couldn't mprotect segment: %w", err)
+			}
+			return nil
+		}, func(event *model.Event, r *rules.Rule) {
+			assert.Equal(t, "test_mprotect_anonymous", r.ID, "wrong rule triggered")
+			assert.True(t, event.MProtect.IsAnonymous, "segment should be anonymous")
+			assert.Equal(t, uint64(size), event.MProtect.Size, "wrong segment size")
+
+			test.validateMProtectSchema(t, event)
+		})
+	})
 }
@@ -13,6 +13,7 @@ import (
 	"bytes"
 	"crypto/sha256"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -73,6 +74,24 @@ func GetProcControlGroups(tgid, pid uint32) ([]ControlGroup, error) {
 	return cgroups, nil
 }
 
+// GetProcSystemdUnit returns the systemd unit owning the cgroup of the task, and the slice the unit is nested in. The
+// path of the cgroup is read from the unified hierarchy, or from the named systemd hierarchy on cgroup v1.
+func GetProcSystemdUnit(tgid, pid uint32) (string, string, error) {
+	cgroups, err := GetProcControlGroups(tgid, pid)
+	if err != nil {
+		return "", "", err
+	}
+
+	for _, cgroup := range cgroups {
+		if cgroup.ID == 0 || slices.Contains(cgroup.Controllers, "name=systemd") {
+			unit, slice := containerutils.GetSystemdUnitFromCgroup(cgroup.Path)
+			return unit, slice, nil
+		}
+	}
+
+	return "", "", nil
+}
+
 // GetProcContainerID returns the container ID which the process belongs to. Returns "" if the process does not belong
 // to a container.
 func GetProcContainerID(tgid, pid uint32) (containerutils.ContainerID, error) {
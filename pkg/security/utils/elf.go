@@ -0,0 +1,108 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package utils holds utils related files
+package utils
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"io"
+
+	"github.com/DataDog/datadog-agent/pkg/util/safeelf"
+)
+
+const (
+	// ntGNUBuildID is the type of the note holding the GNU build ID
+	ntGNUBuildID = 3
+	// maxELFNoteSize bounds the size of the notes read from an executable
+	maxELFNoteSize = 4096
+	// maxELFInterpreterSize bounds the size of the interpreter path read from an executable
+	maxELFInterpreterSize = 4096
+)
+
+// ELFMetadata holds the metadata parsed from the headers of an ELF executable
+type ELFMetadata struct {
+	// BuildID is the hex encoded GNU build ID, empty if the executable has none
+	BuildID string
+	// Interpreter is the path of the program interpreter (PT_INTERP), empty if the executable has none
+	Interpreter string
+	// IsStatic is true if the executable doesn't require a program interpreter
+	IsStatic bool
+	// IsStripped is true if the executable has no symbol table
+	IsStripped bool
+}
+
+// ParseELFMetadata parses the program headers, the notes and the section headers of the ELF executable at the given
+// path. Only the headers and the notes are read, not the whole file.
+func ParseELFMetadata(path string) (*ELFMetadata, error) {
+	f, err := safeelf.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	metadata := &ELFMetadata{
+		IsStripped: f.SectionByType(safeelf.SHT_SYMTAB) == nil,
+	}
+
+	for _, prog := range f.Progs {
+		switch prog.Type {
+		case safeelf.PT_INTERP:
+			interpreter, err := readELFProg(prog, maxELFInterpreterSize)
+			if err != nil {
+				return nil, err
+			}
+			metadata.Interpreter = string(bytes.TrimRight(interpreter, "\x00"))
+		case safeelf.PT_NOTE:
+			if metadata.BuildID != "" {
+				continue
+			}
+			notes, err := readELFProg(prog, maxELFNoteSize)
+			if err != nil {
+				return nil, err
+			}
+			metadata.BuildID = gnuBuildID(notes, f.ByteOrder)
+		}
+	}
+	metadata.IsStatic = metadata.Interpreter == ""
+
+	return metadata, nil
+}
+
+func readELFProg(prog *safeelf.Prog, maxSize uint64) ([]byte, error) {
+	data := make([]byte, min(prog.Filesz, maxSize))
+	if _, err := io.ReadFull(prog.Open(), data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// gnuBuildID returns the hex encoded GNU build ID found in the given notes, if any
+func gnuBuildID(notes []byte, order binary.ByteOrder) string {
+	align := func(n uint32) uint64 {
+		return (uint64(n) + 3) &^ 3
+	}
+
+	for len(notes) >= 12 {
+		nameSize, descSize, noteType := order.Uint32(notes[0:4]), order.Uint32(notes[4:8]), order.Uint32(notes[8:12])
+		notes = notes[12:]
+
+		if align(nameSize)+align(descSize) > uint64(len(notes)) {
+			return ""
+		}
+
+		name := notes[:nameSize]
+		desc := notes[align(nameSize) : align(nameSize)+uint64(descSize)]
+		if noteType == ntGNUBuildID && string(name) == "GNU\x00" {
+			return hex.EncodeToString(desc)
+		}
+
+		notes = notes[align(nameSize)+align(descSize):]
+	}
+
+	return ""
+}
@@ -0,0 +1,58 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package utils
+
+import (
+	"encoding/binary"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGNUBuildID(t *testing.T) {
+	note := func(name string, noteType uint32, desc []byte) []byte {
+		header := make([]byte, 12)
+		binary.LittleEndian.PutUint32(header[0:4], uint32(len(name)))
+		binary.LittleEndian.PutUint32(header[4:8], uint32(len(desc)))
+		binary.LittleEndian.PutUint32(header[8:12], noteType)
+
+		data := append(header, name...)
+		for len(data)%4 != 0 {
+			data = append(data, 0)
+		}
+		data = append(data, desc...)
+		for len(data)%4 != 0 {
+			data = append(data, 0)
+		}
+		return data
+	}
+
+	abiTag := note("GNU\x00", 1, []byte{0, 0, 0, 0, 3, 0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0})
+	buildID := note("GNU\x00", ntGNUBuildID, []byte{0xde, 0xad, 0xbe, 0xef, 0x01})
+
+	assert.Equal(t, "deadbeef01", gnuBuildID(append(abiTag, buildID...), binary.LittleEndian))
+	assert.Equal(t, "", gnuBuildID(abiTag, binary.LittleEndian))
+	assert.Equal(t, "", gnuBuildID(note("Go\x00\x00", 4, []byte("go build id")), binary.LittleEndian))
+
+	// the truncated notes are ignored
+	assert.Equal(t, "", gnuBuildID(buildID[:len(buildID)-4], binary.LittleEndian))
+}
+
+func TestParseELFMetadata(t *testing.T) {
+	const path = "/bin/ls"
+	if _, err := os.Stat(path); err != nil {
+		t.Skipf("%s not found", path)
+	}
+
+	metadata, err := ParseELFMetadata(path)
+	require.NoError(t, err)
+	assert.Equal(t, metadata.Interpreter == "", metadata.IsStatic)
+
+	_, err = ParseELFMetadata("/etc/hostname")
+	assert.Error(t, err)
+}
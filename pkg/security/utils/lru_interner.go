@@ -20,7 +20,20 @@ type LRUStringInterner struct {
 // NewLRUStringInterner returns a new LRUStringInterner, with the cache size provided
 // if the cache size is negative this function will panic
 func NewLRUStringInterner(size int) *LRUStringInterner {
-	store, err := simplelru.NewLRU[string, string](size, nil)
+	return NewLRUStringInternerWithEvictCallback(size, nil)
+}
+
+// NewLRUStringInternerWithEvictCallback returns a new LRUStringInterner, with the cache size provided, calling onEvict
+// every time a value is evicted to make room for a new one. If the cache size is negative this function will panic
+func NewLRUStringInternerWithEvictCallback(size int, onEvict func(value string)) *LRUStringInterner {
+	var evictCallback simplelru.EvictCallback[string, string]
+	if onEvict != nil {
+		evictCallback = func(_ string, value string) {
+			onEvict(value)
+		}
+	}
+
+	store, err := simplelru.NewLRU[string, string](size, evictCallback)
 	if err != nil {
 		panic(err)
 	}
@@ -0,0 +1,49 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+// Package utils holds utils related files
+package utils
+
+import (
+	"errors"
+
+	"golang.org/x/sys/unix"
+)
+
+// PeekPipe returns up to size bytes of the data buffered in the pipe at the given path, like the pipe of a process
+// opened through /proc, without consuming them. The data are duplicated with tee(2) to a pipe of the agent from which
+// they are read. An empty slice is returned when the pipe is empty.
+func PeekPipe(pipePath string, size int) ([]byte, error) {
+	// the pipe is opened in non blocking mode as opening a FIFO without writer would block
+	fd, err := unix.Open(pipePath, unix.O_RDONLY|unix.O_NONBLOCK|unix.O_CLOEXEC, 0)
+	if err != nil {
+		return nil, err
+	}
+	defer unix.Close(fd)
+
+	var fds [2]int
+	if err := unix.Pipe2(fds[:], unix.O_NONBLOCK|unix.O_CLOEXEC); err != nil {
+		return nil, err
+	}
+	defer unix.Close(fds[0])
+	defer unix.Close(fds[1])
+
+	n, err := unix.Tee(fd, fds[1], size, unix.SPLICE_F_NONBLOCK)
+	if err != nil {
+		if errors.Is(err, unix.EAGAIN) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	data := make([]byte, n)
+	read, err := unix.Read(fds[0], data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:read], nil
+}
@@ -0,0 +1,44 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+package utils
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadShebangPipe(t *testing.T) {
+	r, w, err := os.Pipe()
+	require.NoError(t, err)
+	defer r.Close()
+
+	pipePath := fmt.Sprintf("/proc/self/fd/%d", r.Fd())
+
+	// an empty pipe doesn't have any shebang
+	_, err = ReadShebang(pipePath)
+	assert.ErrorIs(t, err, ErrNoShebang)
+
+	script := "#!/bin/sh\necho hello\n"
+	_, err = w.WriteString(script)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	shebang, err := ReadShebang(pipePath)
+	require.NoError(t, err)
+	assert.Equal(t, "/bin/sh", shebang.Interpreter)
+
+	// the data of the pipe must not be consumed
+	data, err := io.ReadAll(r)
+	require.NoError(t, err)
+	assert.Equal(t, script, string(data))
+}
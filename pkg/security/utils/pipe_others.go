@@ -0,0 +1,16 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build !linux
+
+// Package utils holds utils related files
+package utils
+
+import "errors"
+
+// PeekPipe returns up to size bytes of the data buffered in the pipe at the given path without consuming them
+func PeekPipe(_ string, _ int) ([]byte, error) {
+	return nil, errors.New("not supported")
+}
@@ -8,6 +8,7 @@ package utils
 
 import (
 	"bufio"
+	"bytes"
 	"fmt"
 	"io"
 	"os"
@@ -34,6 +35,7 @@ func Getpid() uint32 {
 }
 
 var networkNamespacePattern = regexp.MustCompile(`net:\[(\d+)\]`)
+var namespacePattern = regexp.MustCompile(`^(\w+):\[(\d+)\]$`)
 
 // NetNSPath represents a network namespace path
 type NetNSPath struct {
@@ -93,6 +95,45 @@ func (path *NetNSPath) GetProcessNetworkNamespace() (uint32, error) {
 	return uint32(netns), nil
 }
 
+// GetProcessMountNamespace returns the mount namespace of a pid after parsing /proc/[pid]/ns/mnt
+func GetProcessMountNamespace(pid uint32) (uint32, error) {
+	return GetProcessNamespace(pid, "mnt")
+}
+
+// GetProcessNamespace returns the inode number of the namespace of the given type (pid, user, mnt, uts, ...) of a pid
+// after parsing /proc/[pid]/ns/[type]
+func GetProcessNamespace(pid uint32, nsType string) (uint32, error) {
+	l, err := os.Readlink(procPidPath2(pid, "ns", nsType))
+	if err != nil {
+		return 0, err
+	}
+
+	matches := namespacePattern.FindStringSubmatch(l)
+	if len(matches) <= 2 || matches[1] != nsType {
+		return 0, fmt.Errorf("couldn't parse %s namespace ID: %s", nsType, l)
+	}
+
+	nsID, err := strconv.ParseUint(matches[2], 10, 32)
+	if err != nil {
+		return 0, err
+	}
+	return uint32(nsID), nil
+}
+
+// ProcCmdline returns the command line of a pid, read from /proc
+func ProcCmdline(pid uint32) ([]string, error) {
+	data, err := os.ReadFile(procPidPath(pid, "cmdline"))
+	if err != nil {
+		return nil, err
+	}
+
+	data = bytes.TrimRight(data, "\x00")
+	if len(data) == 0 {
+		return nil, nil
+	}
+	return strings.Split(string(data), "\x00"), nil
+}
+
 // CgroupTaskPath returns the path to the cgroup file of a pid in /proc
 func CgroupTaskPath(tgid, pid uint32) string {
 	return kernel.HostProc(strconv.FormatUint(uint64(tgid), 10), "task", strconv.FormatUint(uint64(pid), 10), "cgroup")
@@ -118,6 +159,16 @@ func ProcRootPath(pid uint32) string {
 	return procPidPath(pid, "root")
 }
 
+// ProcCwdFilePath returns the path to the input file, relative to the working directory of the given pid, in /proc
+func ProcCwdFilePath(pid uint32, file string) string {
+	return procPidPath2(pid, "cwd", file)
+}
+
+// ProcFDPath returns the path to the given file descriptor of a pid in /proc
+func ProcFDPath(pid uint32, fd int) string {
+	return procPidPath2(pid, "fd", strconv.Itoa(fd))
+}
+
 // ProcRootFilePath returns the path to the input file after prepending the proc root path of the given pid
 func ProcRootFilePath(pid uint32, file string) string {
 	// if file starts with /, the result of filepath.Join will look, before cleaning, like
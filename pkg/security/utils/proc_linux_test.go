@@ -0,0 +1,35 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package utils
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetProcessNamespace(t *testing.T) {
+	pid := uint32(os.Getpid())
+
+	for _, nsType := range []string{"pid", "user", "mnt", "uts"} {
+		t.Run(nsType, func(t *testing.T) {
+			link, err := os.Readlink(fmt.Sprintf("/proc/%d/ns/%s", pid, nsType))
+			if err != nil {
+				t.Skipf("%s namespace not available: %s", nsType, err)
+			}
+
+			nsID, err := GetProcessNamespace(pid, nsType)
+			require.NoError(t, err)
+			assert.Equal(t, fmt.Sprintf("%s:[%d]", nsType, nsID), link)
+		})
+	}
+
+	_, err := GetProcessNamespace(pid, "unknown")
+	assert.Error(t, err)
+}
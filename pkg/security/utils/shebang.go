@@ -0,0 +1,104 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package utils holds utils related files
+package utils
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"path"
+)
+
+// maxShebangSize is the number of bytes read from a script to parse its `#!` line, as the kernel does (BINPRM_BUF_SIZE)
+const maxShebangSize = 256
+
+// ErrNoShebang is returned when a file doesn't start with a `#!` line
+var ErrNoShebang = errors.New("no shebang")
+
+// Shebang holds the interpreter declared by the `#!` line of a script
+type Shebang struct {
+	// Interpreter is the path of the interpreter
+	Interpreter string
+	// Arg is the optional argument passed to the interpreter
+	Arg string
+}
+
+// Name returns the name of the program interpreting the script, the interpreter started by `/usr/bin/env` included
+func (s Shebang) Name() string {
+	if name := path.Base(s.Interpreter); name != "env" || s.Arg == "" {
+		return name
+	}
+
+	// skip the options of env, `#!/usr/bin/env -S python3 -u` for example
+	for _, field := range bytes.Fields([]byte(s.Arg)) {
+		if field[0] != '-' {
+			return path.Base(string(field))
+		}
+	}
+	return "env"
+}
+
+// ParseShebang parses the `#!` line at the beginning of the given content. Like the kernel, only the first bytes of
+// the content are considered, and what follows the interpreter is passed as a single argument.
+func ParseShebang(data []byte) (Shebang, error) {
+	if !bytes.HasPrefix(data, []byte("#!")) {
+		return Shebang{}, ErrNoShebang
+	}
+
+	line := data[2:min(len(data), maxShebangSize)]
+	if end := bytes.IndexByte(line, '\n'); end >= 0 {
+		line = line[:end]
+	}
+	line = bytes.TrimSpace(line)
+
+	interpreter, arg, _ := bytes.Cut(line, []byte{' '})
+	if len(interpreter) == 0 {
+		return Shebang{}, ErrNoShebang
+	}
+
+	return Shebang{
+		Interpreter: string(interpreter),
+		Arg:         string(bytes.TrimSpace(arg)),
+	}, nil
+}
+
+// ReadShebang reads and parses the `#!` line of the regular file, or of the data buffered in the pipe, at the given
+// path. The pipes, like the heredocs passed on the standard input of a process since bash 5.1, are peeked without
+// consuming their data. The type of the file is checked before it is opened, as opening a FIFO could block.
+func ReadShebang(filePath string) (Shebang, error) {
+	info, err := os.Stat(filePath)
+	if err != nil {
+		return Shebang{}, err
+	}
+
+	switch {
+	case info.Mode().IsRegular():
+	case info.Mode()&os.ModeNamedPipe != 0:
+		data, err := PeekPipe(filePath, maxShebangSize)
+		if err != nil {
+			return Shebang{}, err
+		}
+		return ParseShebang(data)
+	default:
+		return Shebang{}, ErrNoShebang
+	}
+
+	f, err := os.Open(filePath)
+	if err != nil {
+		return Shebang{}, err
+	}
+	defer f.Close()
+
+	data := make([]byte, maxShebangSize)
+	n, err := f.ReadAt(data, 0)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return Shebang{}, err
+	}
+
+	return ParseShebang(data[:n])
+}
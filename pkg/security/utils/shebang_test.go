@@ -0,0 +1,62 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseShebang(t *testing.T) {
+	tests := []struct {
+		content     string
+		interpreter string
+		arg         string
+		name        string
+		err         error
+	}{
+		{content: "#!/bin/sh\necho hello\n", interpreter: "/bin/sh", name: "sh"},
+		{content: "#! /usr/bin/perl -w\n", interpreter: "/usr/bin/perl", arg: "-w", name: "perl"},
+		{content: "#!/usr/bin/env python3\n", interpreter: "/usr/bin/env", arg: "python3", name: "python3"},
+		{content: "#!/usr/bin/env -S python3 -u\n", interpreter: "/usr/bin/env", arg: "-S python3 -u", name: "python3"},
+		{content: "#!/usr/bin/env\n", interpreter: "/usr/bin/env", name: "env"},
+		{content: "#!/bin/bash", interpreter: "/bin/bash", name: "bash"},
+		{content: "#!" + strings.Repeat("a", 300), interpreter: strings.Repeat("a", 254), name: strings.Repeat("a", 254)},
+		{content: "#!\n", err: ErrNoShebang},
+		{content: "\x7fELF", err: ErrNoShebang},
+		{content: "", err: ErrNoShebang},
+	}
+
+	for _, test := range tests {
+		shebang, err := ParseShebang([]byte(test.content))
+		if test.err != nil {
+			assert.ErrorIs(t, err, test.err, test.content)
+			continue
+		}
+
+		require.NoError(t, err, test.content)
+		assert.Equal(t, test.interpreter, shebang.Interpreter, test.content)
+		assert.Equal(t, test.arg, shebang.Arg, test.content)
+		assert.Equal(t, test.name, shebang.Name(), test.content)
+	}
+}
+
+func TestReadShebang(t *testing.T) {
+	script := filepath.Join(t.TempDir(), "script.pl")
+	require.NoError(t, os.WriteFile(script, []byte("#!/usr/bin/perl\nsleep 10;\n"), 0o755))
+
+	shebang, err := ReadShebang(script)
+	require.NoError(t, err)
+	assert.Equal(t, "/usr/bin/perl", shebang.Interpreter)
+
+	_, err = ReadShebang(filepath.Dir(script))
+	assert.ErrorIs(t, err, ErrNoShebang)
+}
@@ -9,15 +9,24 @@
 package systemprobe
 
 import (
+	"bytes"
 	"embed"
+	"encoding/json"
 	"fmt"
 	"io"
+	"net/http"
+	"strings"
 
+	"github.com/DataDog/datadog-agent/cmd/system-probe/api/client"
 	"github.com/DataDog/datadog-agent/comp/core/status"
 	"github.com/DataDog/datadog-agent/comp/core/sysprobeconfig"
+	"github.com/DataDog/datadog-agent/pkg/eventmonitor/processtree"
 	"github.com/DataDog/datadog-agent/pkg/process/net"
+	"github.com/DataDog/datadog-agent/pkg/util/log"
 )
 
+const processTreeURL = "http://localhost/event_monitor/process_tree"
+
 // GetStatus returns the expvar stats of the system probe
 func GetStatus(stats map[string]interface{}, socketPath string) {
 	probeUtil, err := net.GetRemoteSystemProbeUtil(socketPath)
@@ -39,6 +48,25 @@ func GetStatus(stats map[string]interface{}, socketPath string) {
 	stats["systemProbeStats"] = systemProbeDetails
 }
 
+// GetProcessTree returns the live process tree reported by the event monitoring module of the system probe
+func GetProcessTree(socketPath string) (*processtree.Tree, error) {
+	resp, err := client.Get(socketPath).Get(processTreeURL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("got non-success status code: url: %s, status_code: %d", processTreeURL, resp.StatusCode)
+	}
+
+	var tree *processtree.Tree
+	if err := json.NewDecoder(resp.Body).Decode(&tree); err != nil {
+		return nil, err
+	}
+	return tree, nil
+}
+
 // Provider provides the functionality to populate the status output
 type Provider struct {
 	SocketPath string
@@ -70,16 +98,32 @@ func (Provider) Section() string {
 	return "System Probe"
 }
 
-// JSON populates the status map
-func (p Provider) JSON(_ bool, stats map[string]interface{}) error {
+// JSON populates the status map, the live process tree is only added to the verbose output
+func (p Provider) JSON(verbose bool, stats map[string]interface{}) error {
 	GetStatus(stats, p.SocketPath)
 
+	if verbose {
+		if tree := p.getProcessTree(); tree != nil && len(tree.Roots) > 0 {
+			stats["processTree"] = tree
+		}
+	}
+
 	return nil
 }
 
-// Text renders the text output
-func (p Provider) Text(_ bool, buffer io.Writer) error {
-	return status.RenderText(templatesFS, "systemprobe.tmpl", buffer, p.getStatusInfo())
+// Text renders the text output, the live process tree is only rendered in the verbose output
+func (p Provider) Text(verbose bool, buffer io.Writer) error {
+	stats := p.getStatusInfo()
+
+	if verbose {
+		if tree := p.getProcessTree(); tree != nil && len(tree.Roots) > 0 {
+			var rendered bytes.Buffer
+			processtree.Render(&rendered, tree, "    ")
+			stats["processTree"] = strings.TrimSuffix(rendered.String(), "\n")
+		}
+	}
+
+	return status.RenderText(templatesFS, "systemprobe.tmpl", buffer, stats)
 }
 
 // HTML renders the html output
@@ -87,6 +131,16 @@ func (p Provider) HTML(_ bool, _ io.Writer) error {
 	return nil
 }
 
+// getProcessTree returns the live process tree, or nil if the event monitoring module isn't running
+func (p Provider) getProcessTree() *processtree.Tree {
+	tree, err := GetProcessTree(p.SocketPath)
+	if err != nil {
+		log.Debugf("unable to query the process tree of the system probe: %v", err)
+		return nil
+	}
+	return tree
+}
+
 func (p Provider) getStatusInfo() map[string]interface{} {
 	stats := make(map[string]interface{})
 
@@ -0,0 +1,125 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+package cgroups
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/karrick/godirwalk"
+
+	"github.com/DataDog/datadog-agent/pkg/util/log"
+)
+
+const (
+	// procCgroupMountPath is where processes with a private cgroup namespace usually mount their cgroup fs
+	procCgroupMountPath = "sys/fs/cgroup"
+)
+
+// cgroupNamespaceResolver resolves the host view of the cgroup of processes running in a private cgroup namespace.
+// In that case `<proc>/<pid>/cgroup` reports a path relative to the root of the namespace, which does not match any
+// cgroup seen from the cgroup fs root. The namespace root is found by inode, from the cgroup fs mounted by the process.
+type cgroupNamespaceResolver struct {
+	procPath   string
+	cgroupRoot string
+	selfNsID   uint64
+
+	// lazily built mapping between cgroup directories inodes and their path relative to cgroupRoot
+	inodeToPath map[uint64]string
+}
+
+func newCgroupNamespaceResolver(procPath, cgroupRoot string) *cgroupNamespaceResolver {
+	if cgroupRoot == "" {
+		return nil
+	}
+
+	selfNsID, err := getProcessNamespaceInode("/proc", "self", "cgroup")
+	if err != nil {
+		log.Debugf("Unable to get self cgroup namespace inode, cgroup namespace resolution disabled, err: %v", err)
+		return nil
+	}
+
+	return &cgroupNamespaceResolver{
+		procPath:   procPath,
+		cgroupRoot: cgroupRoot,
+		selfNsID:   selfNsID,
+	}
+}
+
+// inPrivateNamespace returns whether the process runs in a cgroup namespace other than the agent one
+func (r *cgroupNamespaceResolver) inPrivateNamespace(pid string) bool {
+	nsID, err := getProcessNamespaceInode(r.procPath, pid, "cgroup")
+	if err != nil {
+		return false
+	}
+	return nsID != r.selfNsID
+}
+
+func (r *cgroupNamespaceResolver) buildInodeMapping() {
+	r.inodeToPath = make(map[uint64]string)
+
+	err := godirwalk.Walk(r.cgroupRoot, &godirwalk.Options{
+		Unsorted: true,
+		Callback: func(fullPath string, de *godirwalk.Dirent) error {
+			if !de.IsDir() {
+				return godirwalk.SkipThis
+			}
+
+			inode := inodeForPath(fullPath)
+			if inode == unknownInode {
+				return nil
+			}
+
+			relPath, err := filepath.Rel(r.cgroupRoot, fullPath)
+			if err != nil {
+				return nil
+			}
+			r.inodeToPath[inode] = relPath
+
+			return nil
+		},
+	})
+	if err != nil {
+		log.Debugf("Unable to walk cgroup fs at %s, err: %v", r.cgroupRoot, err)
+	}
+}
+
+// hostCgroupPath returns the path, relative to cgroupRoot, of the cgroup of the given process.
+// nsCgroupPath is the path read from `<proc>/<pid>/cgroup`, relative to the cgroup namespace root of the process.
+func (r *cgroupNamespaceResolver) hostCgroupPath(pid string, nsCgroupPath string) (string, bool) {
+	nsRootInode := inodeForPath(filepath.Join(r.procPath, pid, "root", procCgroupMountPath))
+	if nsRootInode == unknownInode {
+		return "", false
+	}
+
+	if r.inodeToPath == nil {
+		r.buildInodeMapping()
+	}
+
+	nsRootPath, found := r.inodeToPath[nsRootInode]
+	if !found {
+		return "", false
+	}
+
+	return filepath.Join(nsRootPath, strings.TrimLeft(nsCgroupPath, "/")), true
+}
+
+// identifier returns the cgroup identifier of a process running in a private cgroup namespace
+func (r *cgroupNamespaceResolver) identifier(pid, baseController string, filter ReaderFilter) (string, error) {
+	nsCgroupPath, err := cgroupPathFromCgroupReferences(r.procPath, pid, baseController)
+	if err != nil || nsCgroupPath == "" {
+		return "", err
+	}
+
+	hostPath, found := r.hostCgroupPath(pid, nsCgroupPath)
+	if !found {
+		return "", nil
+	}
+
+	return filter(hostPath, filepath.Base(hostPath))
+}
@@ -0,0 +1,55 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+package cgroups
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCgroupNamespaceResolver(t *testing.T) {
+	const containerID = "a51a9f7d073f848e7fc59e56e8f11524f330a2175a4ed26327da2dfe0d28015f"
+
+	cgroupRoot := t.TempDir()
+	procPath := t.TempDir()
+
+	// host view of the container cgroup
+	containerCgroup := filepath.Join(cgroupRoot, "system.slice", "docker-"+containerID+".scope")
+	require.NoError(t, os.MkdirAll(filepath.Join(containerCgroup, "init"), 0755))
+
+	// the process sees the container cgroup as the root of its cgroup namespace, mounted on /sys/fs/cgroup
+	pidRoot := filepath.Join(procPath, "42", "root", "sys", "fs")
+	require.NoError(t, os.MkdirAll(pidRoot, 0755))
+	require.NoError(t, os.Symlink(containerCgroup, filepath.Join(pidRoot, "cgroup")))
+	require.NoError(t, os.WriteFile(filepath.Join(procPath, "42", procCgroupFile), []byte("0::/\n"), 0644))
+
+	r := &cgroupNamespaceResolver{
+		procPath:   procPath,
+		cgroupRoot: cgroupRoot,
+	}
+
+	hostPath, found := r.hostCgroupPath("42", "/init")
+	assert.True(t, found)
+	assert.Equal(t, filepath.Join("system.slice", "docker-"+containerID+".scope", "init"), hostPath)
+
+	id, err := r.identifier("42", "", ContainerFilter)
+	assert.NoError(t, err)
+	assert.Equal(t, containerID, id)
+
+	// unknown processes can't be resolved
+	_, found = r.hostCgroupPath("43", "/")
+	assert.False(t, found)
+}
+
+func TestCgroupNamespaceResolverDisabled(t *testing.T) {
+	assert.Nil(t, newCgroupNamespaceResolver("/proc", ""))
+}
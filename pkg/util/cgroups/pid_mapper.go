@@ -54,6 +54,24 @@ func IdentiferFromCgroupReferences(procPath, pid, baseCgroupController string, f
 	return identifier, err
 }
 
+// cgroupPathFromCgroupReferences returns the raw cgroup path read from <proc>/<pid>/cgroup for the given controller
+func cgroupPathFromCgroupReferences(procPath, pid, baseCgroupController string) (string, error) {
+	var cgroupPath string
+
+	err := parseFile(defaultFileReader, filepath.Join(procPath, pid, procCgroupFile), func(s string) error {
+		parts := strings.SplitN(s, ":", 3)
+		// Skip potentially malformed lines
+		if len(parts) != 3 || parts[1] != baseCgroupController {
+			return nil
+		}
+
+		cgroupPath = parts[2]
+		return &stopParsingError{}
+	})
+
+	return cgroupPath, err
+}
+
 // Unfortunately, the reading of `<host_path>/sys/fs/cgroup/pids/.../cgroup.procs` is PID-namespace aware,
 // meaning that we cannot rely on it to find all PIDs belonging to a cgroup, except if the Agent runs in host PID namespace.
 type pidMapper interface {
@@ -95,6 +113,7 @@ func getPidMapper(procPath, cgroupRoot, baseController string, filter ReaderFilt
 	log.Debug("Using proc/pid for pid mapping")
 	pidMapper := &procPidMapper{
 		procPath:         procPath,
+		cgroupRoot:       cgroupRoot,
 		cgroupController: baseController,
 		readerFilter:     filter,
 	}
@@ -147,6 +166,7 @@ type procPidMapper struct {
 	lock              sync.Mutex
 	refreshTimestamp  time.Time
 	procPath          string
+	cgroupRoot        string
 	cgroupController  string
 	readerFilter      ReaderFilter
 	cgroupPidsMapping map[string][]int
@@ -159,6 +179,9 @@ func (pm *procPidMapper) refreshMapping(cacheValidity time.Duration) {
 
 	cgroupPidMapping := make(map[string][]int)
 
+	// Processes running in a private cgroup namespace report a cgroup path relative to their namespace root
+	nsResolver := newCgroupNamespaceResolver(pm.procPath, pm.cgroupRoot)
+
 	// Going through everything in `<procPath>/<pid>/cgroup`
 	err := godirwalk.Walk(pm.procPath, &godirwalk.Options{
 		AllowNonDirectory: true,
@@ -178,6 +201,12 @@ func (pm *procPidMapper) refreshMapping(cacheValidity time.Duration) {
 			if err != nil {
 				log.Debugf("Unable to parse cgroup file for pid: %s, err: %v", de.Name(), err)
 			}
+			if cgroupIdentifier == "" && nsResolver != nil && nsResolver.inPrivateNamespace(de.Name()) {
+				cgroupIdentifier, err = nsResolver.identifier(de.Name(), pm.cgroupController, pm.readerFilter)
+				if err != nil {
+					log.Debugf("Unable to resolve host cgroup for pid: %s, err: %v", de.Name(), err)
+				}
+			}
 			if cgroupIdentifier != "" {
 				cgroupPidMapping[cgroupIdentifier] = append(cgroupPidMapping[cgroupIdentifier], int(pid))
 			}
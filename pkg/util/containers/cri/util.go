@@ -143,6 +143,30 @@ func (c *CRIUtil) GetContainerStats(containerID string) (*criv1.ContainerStats,
 	return containerStats, nil
 }
 
+// GetContainerStatus returns the status of the container with the given ID
+func (c *CRIUtil) GetContainerStatus(ctx context.Context, containerID string) (*criv1.ContainerStatus, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.queryTimeout)
+	defer cancel()
+
+	r, err := c.clientV1.ContainerStatus(ctx, &criv1.ContainerStatusRequest{ContainerId: containerID})
+	if err != nil {
+		return nil, err
+	}
+	return r.GetStatus(), nil
+}
+
+// ListContainers returns the containers matching the given filter
+func (c *CRIUtil) ListContainers(ctx context.Context, filter *criv1.ContainerFilter) ([]*criv1.Container, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.queryTimeout)
+	defer cancel()
+
+	r, err := c.clientV1.ListContainers(ctx, &criv1.ListContainersRequest{Filter: filter})
+	if err != nil {
+		return nil, err
+	}
+	return r.GetContainers(), nil
+}
+
 // ListContainerStats sends a ListContainerStatsRequest to the server, and parses the returned response
 func (c *CRIUtil) ListContainerStats() (map[string]*criv1.ContainerStats, error) {
 	return c.listContainerStatsWithFilter(&criv1.ContainerStatsFilter{})
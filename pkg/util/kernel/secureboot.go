@@ -0,0 +1,74 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+package kernel
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+)
+
+// SecureBootStatus defines the secure boot status of the host
+type SecureBootStatus string
+
+const (
+	// SecureBootEnabled status
+	SecureBootEnabled SecureBootStatus = "enabled"
+	// SecureBootDisabled status
+	SecureBootDisabled SecureBootStatus = "disabled"
+	// SecureBootUnknown status
+	SecureBootUnknown SecureBootStatus = "unknown"
+)
+
+const (
+	// efiVariablesDir is the directory exposing the EFI variables, only present on the hosts booted with EFI when
+	// efivarfs is mounted
+	efiVariablesDir = "firmware/efi/efivars"
+	// secureBootVariable is the EFI variable holding the secure boot state, under the EFI global variable GUID
+	secureBootVariable = "SecureBoot-8be4df61-93ca-11d2-aa0d-00e098032b8c"
+)
+
+// getSecureBootStatus parses the content of the secure boot EFI variable: 4 bytes of attributes followed by the value
+func getSecureBootStatus(data []byte) SecureBootStatus {
+	if len(data) != 5 {
+		return SecureBootUnknown
+	}
+
+	switch data[4] {
+	case 0:
+		return SecureBootDisabled
+	case 1:
+		return SecureBootEnabled
+	}
+	return SecureBootUnknown
+}
+
+// readSecureBootStatus returns the secure boot status exposed by the provided sysfs
+func readSecureBootStatus(sysfsRoot string) SecureBootStatus {
+	// the EFI variables can't be read from a host booted without EFI, or without efivarfs mounted, for instance from
+	// a container
+	if _, err := os.Stat(filepath.Join(sysfsRoot, efiVariablesDir)); err != nil {
+		return SecureBootUnknown
+	}
+
+	data, err := os.ReadFile(filepath.Join(sysfsRoot, efiVariablesDir, secureBootVariable))
+	if err != nil {
+		// the firmware doesn't support secure boot
+		if errors.Is(err, os.ErrNotExist) {
+			return SecureBootDisabled
+		}
+		return SecureBootUnknown
+	}
+
+	return getSecureBootStatus(data)
+}
+
+// GetSecureBootStatus returns the secure boot status of the host
+func GetSecureBootStatus() SecureBootStatus {
+	return readSecureBootStatus(SysFSRoot())
+}
@@ -0,0 +1,38 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+package kernel
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecureBoot(t *testing.T) {
+	assert.Equal(t, SecureBootEnabled, getSecureBootStatus([]byte{0x06, 0x00, 0x00, 0x00, 0x01}))
+	assert.Equal(t, SecureBootDisabled, getSecureBootStatus([]byte{0x06, 0x00, 0x00, 0x00, 0x00}))
+	assert.Equal(t, SecureBootUnknown, getSecureBootStatus([]byte{0x06, 0x00, 0x00, 0x00, 0x02}))
+	assert.Equal(t, SecureBootUnknown, getSecureBootStatus([]byte{0x06}))
+}
+
+func TestReadSecureBootStatus(t *testing.T) {
+	sysfsRoot := t.TempDir()
+
+	// the host wasn't booted with EFI, or efivarfs isn't mounted
+	assert.Equal(t, SecureBootUnknown, readSecureBootStatus(sysfsRoot))
+
+	efiVariables := filepath.Join(sysfsRoot, efiVariablesDir)
+	require.NoError(t, os.MkdirAll(efiVariables, 0755))
+	assert.Equal(t, SecureBootDisabled, readSecureBootStatus(sysfsRoot))
+
+	require.NoError(t, os.WriteFile(filepath.Join(efiVariables, secureBootVariable), []byte{0x06, 0x00, 0x00, 0x00, 0x01}, 0644))
+	assert.Equal(t, SecureBootEnabled, readSecureBootStatus(sysfsRoot))
+}
@@ -9,16 +9,55 @@
 package ktime
 
 import (
+	"context"
+	"math"
+	"sort"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	_ "unsafe" // unsafe import to call nanotime() which should be 2x quick than time.Now()
 
 	"github.com/shirou/gopsutil/v3/host"
+	"golang.org/x/sys/unix"
 )
 
+const (
+	// suspendCheckInterval is the period at which the suspensions of the host are looked for
+	suspendCheckInterval = time.Second
+	// suspendThreshold is the minimal growth of the time spent suspended considered as a suspension, absorbing the
+	// imprecision of the successive reads of the clocks
+	suspendThreshold = 10 * time.Millisecond
+	// maxSuspendAnchors is the number of suspensions remembered, the timestamps older than the oldest one being
+	// converted as if they happened right before it
+	maxSuspendAnchors = 128
+)
+
+// suspendAnchor records the time spent suspended by the host once it resumed
+type suspendAnchor struct {
+	// monotonic is the kernel monotonic time of the check preceding the detection of the resume, the suspension having
+	// happened after it
+	monotonic int64
+	// suspended is the time spent suspended since boot at that time
+	suspended time.Duration
+}
+
 // Resolver converts kernel monotonic timestamps to absolute times
 type Resolver struct {
 	bootTime time.Time
+
+	// the kernel monotonic clock stops while the host is suspended, unlike the boot time clock. The suspensions
+	// detected since the start of the resolver are used to convert the timestamps taken before them.
+	lock sync.RWMutex
+	// time spent suspended at the start of the resolver
+	startSuspended time.Duration
+	// time spent suspended before the oldest anchor
+	baseline time.Duration
+	// kernel monotonic time of the previous check
+	lastCheck time.Duration
+	anchors   []suspendAnchor
+	suspended atomic.Bool
+	readClock func() (time.Duration, time.Duration, error)
 }
 
 // NewResolver returns a new time resolver
@@ -29,7 +68,8 @@ func NewResolver() (*Resolver, error) {
 	}
 
 	tr := Resolver{
-		bootTime: time.Unix(int64(bt), 0),
+		bootTime:  time.Unix(int64(bt), 0),
+		readClock: readClocks,
 	}
 	return &tr, nil
 }
@@ -38,6 +78,138 @@ func NewResolver() (*Resolver, error) {
 //go:linkname nanotime runtime.nanotime
 func nanotime() int64
 
+// readClocks returns the kernel monotonic time along with the time spent suspended since boot
+func readClocks() (time.Duration, time.Duration, error) {
+	var monotonic, boottime unix.Timespec
+	if err := unix.ClockGettime(unix.CLOCK_MONOTONIC, &monotonic); err != nil {
+		return 0, 0, err
+	}
+	if err := unix.ClockGettime(unix.CLOCK_BOOTTIME, &boottime); err != nil {
+		return 0, 0, err
+	}
+	return time.Duration(monotonic.Nano()), time.Duration(boottime.Nano() - monotonic.Nano()), nil
+}
+
+// Start looks periodically for the suspensions of the host
+func (tr *Resolver) Start(ctx context.Context) {
+	monotonic, suspended, err := tr.readClock()
+	if err != nil {
+		return
+	}
+
+	tr.lock.Lock()
+	tr.startSuspended = suspended
+	tr.baseline = suspended
+	tr.lastCheck = monotonic
+	tr.lock.Unlock()
+
+	go func() {
+		ticker := time.NewTicker(suspendCheckInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				tr.checkSuspend()
+			}
+		}
+	}()
+}
+
+// checkSuspend records an anchor when the time spent suspended grew since the previous check. The monotonic time of
+// the anchor is the one of the previous check, the timestamps taken between the previous check and the suspension being
+// thus converted as if they were taken after it, which is off by at most one check interval.
+func (tr *Resolver) checkSuspend() {
+	monotonic, suspended, err := tr.readClock()
+	if err != nil {
+		return
+	}
+
+	tr.lock.Lock()
+	defer tr.lock.Unlock()
+
+	previous := tr.lastCheck
+	tr.lastCheck = monotonic
+
+	if suspended-tr.lastSuspended() < suspendThreshold {
+		return
+	}
+
+	if len(tr.anchors) == maxSuspendAnchors {
+		tr.baseline = tr.anchors[0].suspended
+		tr.anchors = tr.anchors[1:]
+	}
+	tr.anchors = append(tr.anchors, suspendAnchor{monotonic: int64(previous), suspended: suspended})
+	tr.suspended.Store(true)
+}
+
+// lastSuspended returns the time spent suspended at the latest detected suspension
+func (tr *Resolver) lastSuspended() time.Duration {
+	if len(tr.anchors) == 0 {
+		return tr.baseline
+	}
+	return tr.anchors[len(tr.anchors)-1].suspended
+}
+
+// suspendedSince returns the time spent suspended by the host since the provided kernel monotonic timestamp
+func (tr *Resolver) suspendedSince(timestamp int64) time.Duration {
+	// avoid taking the lock for every event of the hosts never suspended
+	if !tr.suspended.Load() {
+		return 0
+	}
+
+	tr.lock.RLock()
+	defer tr.lock.RUnlock()
+
+	// the anchors are sorted by monotonic time, the first one after the timestamp is the first suspension since it
+	i := sort.Search(len(tr.anchors), func(i int) bool {
+		return tr.anchors[i].monotonic > timestamp
+	})
+
+	suspendedAt := tr.baseline
+	if i > 0 {
+		suspendedAt = tr.anchors[i-1].suspended
+	}
+	return tr.lastSuspended() - suspendedAt
+}
+
+// monotonicSince returns the kernel monotonic timestamp resolved to the provided time elapsed since the boot time, as
+// computed without the suspensions. It is the inverse of the correction applied by ResolveMonotonicTimestamp, the times
+// at which the host was suspended being converted to the monotonic time of the following anchor.
+func (tr *Resolver) monotonicSince(elapsed int64) int64 {
+	if !tr.suspended.Load() {
+		return elapsed
+	}
+
+	tr.lock.RLock()
+	defer tr.lock.RUnlock()
+
+	// the anchors split the monotonic time in ranges each one corrected by the time spent suspended since its start,
+	// they are walked from the most recent one
+	last := tr.lastSuspended()
+	upper := int64(math.MaxInt64)
+	for i := len(tr.anchors) - 1; i >= 0; i-- {
+		anchor := tr.anchors[i]
+		timestamp := min(elapsed+int64(last-anchor.suspended), upper)
+		if timestamp >= anchor.monotonic {
+			return timestamp
+		}
+		upper = anchor.monotonic - 1
+	}
+	return min(elapsed+int64(last-tr.baseline), upper)
+}
+
+// GetSuspendDrift returns the time spent suspended by the host since the start of the resolver, by which the
+// timestamps taken before the suspensions would have drifted
+func (tr *Resolver) GetSuspendDrift() time.Duration {
+	tr.lock.RLock()
+	defer tr.lock.RUnlock()
+
+	return tr.lastSuspended() - tr.startSuspended
+}
+
 func (tr *Resolver) getUptimeOffset() time.Duration {
 	return time.Since(tr.bootTime) - time.Duration(nanotime())
 }
@@ -45,7 +217,7 @@ func (tr *Resolver) getUptimeOffset() time.Duration {
 // ResolveMonotonicTimestamp converts a kernel monotonic timestamp to an absolute time
 func (tr *Resolver) ResolveMonotonicTimestamp(timestamp uint64) time.Time {
 	if timestamp > 0 {
-		offset := tr.getUptimeOffset()
+		offset := tr.getUptimeOffset() - tr.suspendedSince(int64(timestamp))
 		return tr.bootTime.Add(time.Duration(timestamp) + offset)
 	}
 	return time.Time{}
@@ -54,7 +226,7 @@ func (tr *Resolver) ResolveMonotonicTimestamp(timestamp uint64) time.Time {
 // ApplyBootTime return the time re-aligned from the boot time
 func (tr *Resolver) ApplyBootTime(timestamp time.Time) time.Time {
 	if !timestamp.IsZero() {
-		offset := tr.getUptimeOffset()
+		offset := tr.getUptimeOffset() - tr.suspendedSince(timestamp.UnixNano())
 		return timestamp.Add(time.Duration(tr.bootTime.UnixNano()) + offset)
 	}
 	return time.Time{}
@@ -63,7 +235,7 @@ func (tr *Resolver) ApplyBootTime(timestamp time.Time) time.Time {
 // ComputeMonotonicTimestamp converts an absolute time to a kernel monotonic timestamp
 func (tr *Resolver) ComputeMonotonicTimestamp(timestamp time.Time) int64 {
 	if !timestamp.IsZero() {
-		return timestamp.Sub(tr.GetBootTime()).Nanoseconds()
+		return tr.monotonicSince(timestamp.Sub(tr.GetBootTime()).Nanoseconds())
 	}
 	return 0
 }
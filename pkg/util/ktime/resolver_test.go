@@ -0,0 +1,85 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+package ktime
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSuspendedSince(t *testing.T) {
+	var monotonic, suspended time.Duration
+	tr := &Resolver{
+		bootTime: time.Now().Add(-time.Hour),
+		readClock: func() (time.Duration, time.Duration, error) {
+			return monotonic, suspended, nil
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// the host was suspended for 5s before the start of the resolver
+	monotonic, suspended = 10*time.Second, 5*time.Second
+	tr.Start(ctx)
+
+	tr.checkSuspend()
+	assert.Zero(t, tr.suspendedSince(int64(5*time.Second)))
+	assert.Zero(t, tr.GetSuspendDrift())
+
+	// suspended for 1m between the checks at 20s and 21s
+	monotonic = 20 * time.Second
+	tr.checkSuspend()
+	monotonic, suspended = 21*time.Second, 65*time.Second
+	tr.checkSuspend()
+
+	// suspended for 30s between the checks at 40s and 41s
+	monotonic = 40 * time.Second
+	tr.checkSuspend()
+	monotonic, suspended = 41*time.Second, 95*time.Second
+	tr.checkSuspend()
+
+	// the clock imprecision isn't a suspension
+	monotonic, suspended = 50*time.Second, 95*time.Second+time.Millisecond
+	tr.checkSuspend()
+
+	assert.Equal(t, 90*time.Second, tr.suspendedSince(int64(15*time.Second)))
+	assert.Equal(t, 30*time.Second, tr.suspendedSince(int64(30*time.Second)))
+	assert.Zero(t, tr.suspendedSince(int64(40*time.Second)))
+	assert.Zero(t, tr.suspendedSince(int64(45*time.Second)))
+	assert.Equal(t, 90*time.Second, tr.GetSuspendDrift())
+
+	// the computation of the monotonic timestamps is the inverse of their resolution
+	for _, timestamp := range []time.Duration{15 * time.Second, 20 * time.Second, 30 * time.Second, 40 * time.Second, 45 * time.Second} {
+		resolved := tr.ResolveMonotonicTimestamp(uint64(timestamp))
+		assert.InDelta(t, int64(timestamp), tr.ComputeMonotonicTimestamp(resolved), float64(time.Millisecond), timestamp.String())
+	}
+}
+
+func TestApplyBootTimeAfterSuspend(t *testing.T) {
+	tr, err := NewResolver()
+	require.NoError(t, err)
+
+	before := time.Unix(0, nanotime())
+	expected := tr.ApplyBootTime(before)
+
+	// simulate a suspension of 1m detected right after the timestamp
+	tr.baseline = 0
+	tr.anchors = []suspendAnchor{{monotonic: before.UnixNano() + 1, suspended: time.Minute}}
+	tr.suspended.Store(true)
+
+	assert.WithinDuration(t, expected.Add(-time.Minute), tr.ApplyBootTime(before), time.Second)
+
+	// the timestamps taken after the suspension aren't corrected
+	after := time.Unix(0, nanotime())
+	assert.WithinDuration(t, time.Now(), tr.ApplyBootTime(after), time.Second)
+}
@@ -27,6 +27,8 @@ const ET_DYN = elf.ET_DYN
 
 const PT_LOAD = elf.PT_LOAD
 const PT_TLS = elf.PT_TLS
+const PT_INTERP = elf.PT_INTERP
+const PT_NOTE = elf.PT_NOTE
 
 const EM_X86_64 = elf.EM_X86_64
 const EM_AARCH64 = elf.EM_AARCH64
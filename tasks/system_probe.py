@@ -370,7 +370,7 @@ def ninja_gpu_ebpf_programs(nw: NinjaWriter, co_re_build_dir: Path | str):
 def ninja_container_integrations_ebpf_programs(nw: NinjaWriter, co_re_build_dir):
     container_integrations_co_re_dir = os.path.join("pkg", "collector", "corechecks", "ebpf", "c", "runtime")
     container_integrations_co_re_flags = f"-I{container_integrations_co_re_dir}"
-    container_integrations_co_re_programs = ["oom-kill", "tcp-queue-length", "ebpf"]
+    container_integrations_co_re_programs = ["oom-kill", "tcp-queue-length", "run-queue-latency", "ebpf"]
 
     for prog in container_integrations_co_re_programs:
         infile = os.path.join(container_integrations_co_re_dir, f"{prog}-kern.c")
@@ -405,6 +405,7 @@ def ninja_runtime_compilation_files(nw: NinjaWriter, gobin):
     runtime_compiler_files = {
         "pkg/collector/corechecks/ebpf/probe/oomkill/oom_kill.go": "oom-kill",
         "pkg/collector/corechecks/ebpf/probe/tcpqueuelength/tcp_queue_length.go": "tcp-queue-length",
+        "pkg/collector/corechecks/ebpf/probe/runqueuelatency/run_queue_latency.go": "run-queue-latency",
         "pkg/network/usm/compile.go": "usm",
         "pkg/network/usm/sharedlibraries/compile.go": "shared-libraries",
         "pkg/network/tracer/compile.go": "conntrack",
@@ -518,6 +519,9 @@ def ninja_cgo_type_files(nw: NinjaWriter):
             "pkg/collector/corechecks/ebpf/probe/oomkill/c_types.go": [
                 "pkg/collector/corechecks/ebpf/c/runtime/oom-kill-kern-user.h",
             ],
+            "pkg/collector/corechecks/ebpf/probe/runqueuelatency/c_types.go": [
+                "pkg/collector/corechecks/ebpf/c/runtime/run-queue-latency-kern-user.h",
+            ],
             "pkg/ebpf/types.go": [
                 "pkg/ebpf/c/lock_contention.h",
             ],